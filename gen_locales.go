@@ -22,4 +22,8 @@ func main() {
 		"./internal/locale",
 		*coverageFlag,
 	)
+	gen.PluralRuleFiles(
+		"./internal/resources/data",
+		"./plural",
+	)
 }
@@ -74,6 +74,11 @@ func isRelevantFile(fn string) bool {
 
 		// number systems
 		"cldr-core/supplemental/numberingSystems.json",
+
+		// plural rules
+		"cldr-core/supplemental/plurals.json",
+		"cldr-core/supplemental/ordinals.json",
+		"cldr-core/supplemental/pluralRanges.json",
 	} {
 		if strings.HasPrefix(fn, prefix) {
 			return true
@@ -242,7 +247,7 @@ func (czf cldrZipFiles) getCurrenciesData() cldrCurrenciesData {
 		if ok {
 			currenciesData[code]["digits"] = digits.(string)
 		}
-		// Not used currently in formatting/localization, but useful to parse
+		// Rounding increment in units of the digits; 0 means no increment.
 		currenciesData[code]["rounding"] = defaultCurrencyFractionData["_rounding"].(string)
 
 		rounding, ok := currencyFractionData["_rounding"]
@@ -304,6 +309,89 @@ func (czf cldrZipFiles) getNumberingSystemsData() cldrNumberingSystemsData {
 	return numberingSystems
 }
 
+// Plural rules by language and plural category, e.g. "one" => {"i = 1 and v = 0", "@integer 1"}.
+type cldrPluralRulesData map[string]map[string]cldrPluralRule
+
+// A plural rule's condition, and the CLDR sample numbers in its category,
+// e.g. "i = 1 and v = 0" and "@integer 1". The "other" category has no condition.
+type cldrPluralRule struct {
+	condition string
+	samples   string
+}
+
+// Plural rule files by plural type, i.e. "cardinal" for counts and "ordinal" for ranks.
+var pluralRulesFiles = map[string]string{
+	"cardinal": "cldr-core/supplemental/plurals.json",
+	"ordinal":  "cldr-core/supplemental/ordinals.json",
+}
+
+func (czf cldrZipFiles) getPluralRulesData(pluralType string) cldrPluralRulesData {
+	pf, _ := czf[pluralRulesFiles[pluralType]].Open()
+
+	var fileMap map[string]map[string]any
+
+	_ = json.NewDecoder(pf).Decode(&fileMap)
+
+	languagesRules := fileMap["supplemental"]["plurals-type-"+pluralType].(map[string]any)
+	pluralRules := make(cldrPluralRulesData)
+
+	for language, rulesRaw := range languagesRules {
+		pluralRules[language] = make(map[string]cldrPluralRule)
+
+		for key, val := range rulesRaw.(map[string]any) {
+			// Each rule is followed by its @integer and @decimal sample values.
+			rule := val.(string)
+
+			i := strings.Index(rule, "@")
+			if i < 0 {
+				i = len(rule)
+			}
+
+			pluralRules[language][strings.TrimPrefix(key, "pluralRule-count-")] = cldrPluralRule{
+				condition: strings.TrimSpace(rule[:i]),
+				samples:   strings.TrimSpace(rule[i:]),
+			}
+		}
+	}
+
+	_ = pf.Close()
+
+	return pluralRules
+}
+
+// Plural categories of ranges by language and the categories of their start and end,
+// e.g. "one" => "few" => "few" for 1–3 in "ru".
+type cldrPluralRangesData map[string]map[string]map[string]string
+
+func (czf cldrZipFiles) getPluralRangesData() cldrPluralRangesData {
+	pf, _ := czf["cldr-core/supplemental/pluralRanges.json"].Open()
+
+	var fileMap map[string]map[string]any
+
+	_ = json.NewDecoder(pf).Decode(&fileMap)
+
+	languagesRanges := fileMap["supplemental"]["plurals"].(map[string]any)
+	pluralRanges := make(cldrPluralRangesData)
+
+	for language, rangesRaw := range languagesRanges {
+		pluralRanges[language] = make(map[string]map[string]string)
+
+		// e.g. "pluralRange-start-one-end-few" => "few"
+		for key, val := range rangesRaw.(map[string]any) {
+			start, end, _ := strings.Cut(strings.TrimPrefix(key, "pluralRange-start-"), "-end-")
+			if _, ok := pluralRanges[language][start]; !ok {
+				pluralRanges[language][start] = make(map[string]string)
+			}
+
+			pluralRanges[language][start][end] = val.(string)
+		}
+	}
+
+	_ = pf.Close()
+
+	return pluralRanges
+}
+
 // Actual locales data that is needed for our purposes, pulled from CLDR's recorded data.
 // Note that this uses the CLDR JSON format, which I believe actually has some errors.
 // We handle these in the parsing below in what I feel is a more sensical manner.
@@ -350,19 +438,45 @@ func (czf cldrZipFiles) getLocalesData() cldrLocalesData {
 
 		localesDataDecimalFormat := localeNumberDataFormats[fmt.Sprintf("decimalFormats-numberSystem-%s", defaultNumberingSystem)].(map[string]any)
 		localesDataCurrencyFormat := localeNumberDataFormats[fmt.Sprintf("currencyFormats-numberSystem-%s", defaultNumberingSystem)].(map[string]any)
+		localesDataPercentFormat := localeNumberDataFormats[fmt.Sprintf("percentFormats-numberSystem-%s", defaultNumberingSystem)].(map[string]any)
+		localesDataScientificFormat := localeNumberDataFormats[fmt.Sprintf("scientificFormats-numberSystem-%s", defaultNumberingSystem)].(map[string]any)
 
 		localesData[locale]["numberSystem-default"] = defaultNumberingSystem
 		localesData[locale]["numberSystem-native"] = nativeNumberingSystem
+		localesData[locale]["minimumGroupingDigits"] = localeNumberDataFormats["minimumGroupingDigits"].(string)
 
 		localesData[locale]["standard-decimalFormat"] = localesDataDecimalFormat["standard"].(string)
+		localesData[locale]["standard-percentFormat"] = localesDataPercentFormat["standard"].(string)
+		localesData[locale]["standard-scientificFormat"] = localesDataScientificFormat["standard"].(string)
 
-		localesData[locale]["accounting-moneyFormat-symbol"] = localesDataCurrencyFormat["accounting"].(string)
-		localesData[locale]["accounting-moneyFormat-alpha"] = localesData[locale]["accounting-moneyFormat-symbol"].(string)
-		aantn, ok := localesDataCurrencyFormat["accounting-alphaNextToNumber"]
-		if ok && strings.ContainsAny(aantn.(string), ";") {
-			localesData[locale]["accounting-moneyFormat-alpha"] = aantn.(string)
+		localesData[locale]["short-decimalFormat"] = localesDataDecimalFormat["short"].(map[string]any)["decimalFormat"].(map[string]any)
+		localesData[locale]["long-decimalFormat"] = localesDataDecimalFormat["long"].(map[string]any)["decimalFormat"].(map[string]any)
+
+		// Numbering systems without compact currency patterns of their own use those of latn.
+		shortCurrencyFormat, ok := localesDataCurrencyFormat["short"]
+		if !ok {
+			shortCurrencyFormat = localeNumberDataFormats["currencyFormats-numberSystem-latn"].(map[string]any)["short"]
+		}
+
+		localesData[locale]["short-moneyFormat-symbol"] = shortCurrencyFormat.(map[string]any)["standard"].(map[string]any)
+
+		// Patterns combining amounts with currency names by plural category, e.g. "unitPattern-count-one" => "{0} {1}".
+		currencyUnitFormat := localesDataCurrencyFormat
+		if _, ok := currencyUnitFormat["unitPattern-count-other"]; !ok {
+			currencyUnitFormat = localeNumberDataFormats["currencyFormats-numberSystem-latn"].(map[string]any)
+		}
+
+		currencyUnitPatterns := make(map[string]string)
+
+		for key, val := range currencyUnitFormat {
+			if count, ok := strings.CutPrefix(key, "unitPattern-count-"); ok {
+				currencyUnitPatterns[count] = val.(string)
+			}
 		}
 
+		localesData[locale]["currency-unitPatterns"] = currencyUnitPatterns
+
+		localesData[locale]["accounting-moneyFormat-symbol"] = localesDataCurrencyFormat["accounting"].(string)
 		localesData[locale]["accounting-moneyFormat-noSymbol"] = removeCurrencyPlaceholdersAndTrimSurroundingSpaces(
 			localesDataCurrencyFormat["accounting"].(string),
 		)
@@ -372,11 +486,28 @@ func (czf cldrZipFiles) getLocalesData() cldrLocalesData {
 		}
 
 		localesData[locale]["standard-moneyFormat-symbol"] = localesDataCurrencyFormat["standard"]
-		localesData[locale]["standard-moneyFormat-alpha"] = strings.Split(localesData[locale]["accounting-moneyFormat-alpha"].(string), ";")[0]
 		localesData[locale]["standard-moneyFormat-noSymbol"] = removeCurrencyPlaceholdersAndTrimSurroundingSpaces(
 			localesData[locale]["standard-moneyFormat-symbol"].(string),
 		)
 
+		// Patterns for approximate numbers and ranges, e.g. "range" => "{0}–{1}".
+		miscPatterns, ok := localeNumberDataFormats[fmt.Sprintf("miscPatterns-numberSystem-%s", defaultNumberingSystem)]
+		if !ok {
+			miscPatterns = localeNumberDataFormats["miscPatterns-numberSystem-latn"]
+		}
+
+		for key, val := range miscPatterns.(map[string]any) {
+			localesData[locale][fmt.Sprintf("misc-%s", key)] = val.(string)
+		}
+
+		// Spacing between currency symbols and numbers, e.g. "beforeCurrency" => {"insertBetween": "\u00a0", ...}.
+		currencySpacing, ok := localesDataCurrencyFormat["currencySpacing"]
+		if !ok {
+			currencySpacing = localeNumberDataFormats["currencyFormats-numberSystem-latn"].(map[string]any)["currencySpacing"]
+		}
+
+		localesData[locale]["currencySpacing"] = currencySpacing.(map[string]any)
+
 		// Separators, etc.
 		localesSymbols := localeNumberDataFormats[fmt.Sprintf("symbols-numberSystem-%s", defaultNumberingSystem)].(map[string]any)
 
@@ -407,6 +538,13 @@ func (czf cldrZipFiles) getLocalesData() cldrLocalesData {
 				currencyFormats[cur]["display-name"] = displayName.(string)
 			}
 
+			// Plural forms of the display name, e.g. "displayName-count-one" => "euro".
+			for key, val := range data {
+				if count, ok := strings.CutPrefix(key, "displayName-count-"); ok {
+					currencyFormats[cur]["display-name-"+count] = val.(string)
+				}
+			}
+
 			symbol, ok := data["symbol"]
 			if ok {
 				currencyFormats[cur]["symbol"] = symbol.(string)
@@ -42,3 +42,21 @@ func LocaleFiles(
 	))
 	slog.Info("Done!")
 }
+
+// PluralRuleFiles generates the file of CLDR cardinal and ordinal plural rules, and plural ranges, by language.
+func PluralRuleFiles(
+	dataDir string,
+	pluralFileDir string,
+) {
+	filename := fmt.Sprintf("cldr-%s.zip", cldrVersion)
+	cf, err := downloadAndOpen(filepath.Join(dataDir, filename))
+	if err != nil {
+		panic(err)
+	}
+	slog.Info(fmt.Sprintf("Generating plural rules file in %s...", pluralFileDir))
+	err = cf.writePluralRulesFile(pluralFileDir)
+	if err != nil {
+		panic(err)
+	}
+	slog.Info("Done!")
+}
@@ -9,6 +9,7 @@ package gen
 // was not a primary concern.
 import (
 	"fmt"
+	"maps"
 	"regexp"
 	"slices"
 	"strconv"
@@ -41,6 +42,14 @@ func generateNumberFormat(numfmtstr string) locale.NumberFormat {
 	numfmt.SecondaryGroupSize = uint8(sgs)
 
 	numregex := regexp.MustCompile(`[0.,#]+`)
+
+	// Digit counts are those of the positive subpattern, e.g. 1, 0 and 3 for #,##0.###.
+	whole, frac, _ := strings.Cut(numregex.FindString(strings.Split(numfmtstr, ";")[0]), ".")
+
+	numfmt.MinIntegerDigits = uint8(strings.Count(whole, "0"))
+	numfmt.MinFractionDigits = uint8(strings.Count(frac, "0"))
+	numfmt.MaxFractionDigits = uint8(len(frac))
+
 	numfmtstr = numregex.ReplaceAllString(numfmtstr, "0")
 
 	nfsc := strings.Split(numfmtstr, ";")
@@ -54,12 +63,11 @@ func generateNumberFormat(numfmtstr string) locale.NumberFormat {
 		nfs := nfsc[len(nfsc)-1]
 		negFixes = strings.Split(nfs, "0")
 	} else {
+		// Without an explicit negative subpattern, CLDR puts the minus sign before the positive prefix,
+		// e.g. -¤5.00 for ¤#,##0.00, as a "-" placeholder for the locale's minus sign,
+		// which is only known when formatting.
 		negFixes = slices.Clone(posFixes)
-	}
-	// Explicitly set a style for the negative prefix case.
-	// Negative sign always goes on the right of the prefix.
-	if negFixes[0] == posFixes[0] {
-		negFixes[0] = posFixes[0] + "-"
+		negFixes[0] = "-" + posFixes[0]
 	}
 
 	numfmt.Prefix = posFixes[0]
@@ -70,6 +78,93 @@ func generateNumberFormat(numfmtstr string) locale.NumberFormat {
 	return numfmt
 }
 
+// Convert a CLDR currency spacing rule, i.e. "beforeCurrency" or "afterCurrency", keeping its UnicodeSets as written.
+func generateCurrencySpacingRule(rule map[string]any) locale.CurrencySpacingRule {
+	return locale.CurrencySpacingRule{
+		CurrencyMatch:    rule["currencyMatch"].(string),
+		SurroundingMatch: rule["surroundingMatch"].(string),
+		InsertBetween:    rule["insertBetween"].(string),
+	}
+}
+
+// Parse a compact number pattern into its unquoted form with its integer digits
+// reduced to a single 0, and the number of integer digits, e.g. 00 Mio'.' => 0 Mio., 2.
+// Negative subpatterns are dropped, as they only ever place the minus sign after the prefix.
+func generateCompactPattern(p string) (string, uint8) {
+	sb := strings.Builder{}
+	digits, quoted := uint8(0), false
+
+	rs := []rune(p)
+	for i := 0; i < len(rs); i++ {
+		switch r := rs[i]; {
+		case r == '\'':
+			if i+1 < len(rs) && rs[i+1] == '\'' {
+				sb.WriteRune(r)
+				i++
+			} else {
+				quoted = !quoted
+			}
+		case quoted:
+			sb.WriteRune(r)
+		case r == ';':
+			return sb.String(), digits
+		case r == '0':
+			if digits == 0 {
+				sb.WriteRune(r)
+			}
+			digits++
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String(), digits
+}
+
+// Group compact number patterns keyed like "1000-count-one" by magnitude, in increasing order.
+// https://cldr.unicode.org/translation/number-currency-formats/number-and-currency-patterns#compact-decimal-formatting
+func generateCompactFormats(patterns map[string]any) []locale.CompactFormat {
+	byMagnitude := make(map[uint8]*locale.CompactFormat)
+
+	for key, val := range patterns {
+		if strings.Contains(key, "-alt-") {
+			continue
+		}
+
+		num, count, _ := strings.Cut(key, "-count-")
+		magnitude := uint8(len(num) - 1)
+
+		cf, ok := byMagnitude[magnitude]
+		if !ok {
+			cf = &locale.CompactFormat{Magnitude: magnitude}
+			byMagnitude[magnitude] = cf
+		}
+
+		// A pattern of 0 means that numbers of this magnitude are not compacted.
+		pattern, digits := generateCompactPattern(val.(string))
+		if pattern == "0" {
+			continue
+		}
+
+		if count == "other" {
+			cf.IntegerDigits = digits
+		}
+
+		if cf.Patterns == nil {
+			cf.Patterns = make(map[string]string)
+		}
+
+		cf.Patterns[count] = pattern
+	}
+
+	cfs := make([]locale.CompactFormat, 0, len(byMagnitude))
+	for _, magnitude := range slices.Sorted(maps.Keys(byMagnitude)) {
+		cfs = append(cfs, *byMagnitude[magnitude])
+	}
+
+	return cfs
+}
+
 func (c cldrData) generateNumberInfo(l string) (locale.NumberInfo, error) {
 	var nf locale.NumberInfo
 
@@ -89,16 +184,31 @@ func (c cldrData) generateNumberInfo(l string) (locale.NumberInfo, error) {
 	))
 	nf.FractionalSeparator = localeData["symbol-decimal"].(string)
 	nf.GroupingSeparator = localeData["symbol-group"].(string)
+	mgd, _ := strconv.Atoi(localeData["minimumGroupingDigits"].(string))
+	nf.MinimumGroupingDigits = uint8(mgd)
+	nf.Infinity = localeData["symbol-infinity"].(string)
+	nf.NaN = localeData["symbol-nan"].(string)
+	nf.PercentSign = localeData["symbol-percentSign"].(string)
+	nf.PerMille = localeData["symbol-perMille"].(string)
+	nf.PlusSign = localeData["symbol-plusSign"].(string)
+	nf.MinusSign = localeData["symbol-minusSign"].(string)
+	nf.Exponential = localeData["symbol-exponential"].(string)
+	nf.SuperscriptingExponent = localeData["symbol-superscriptingExponent"].(string)
+	nf.TimeSeparator = localeData["symbol-timeSeparator"].(string)
+	nf.ApproximatelySign = localeData["symbol-approximatelySign"].(string)
 	nf.Formats = locale.NumberFormats{
 		StandardDecimal: generateNumberFormat(
 			localeData["standard-decimalFormat"].(string),
 		),
+		StandardPercent: generateNumberFormat(
+			localeData["standard-percentFormat"].(string),
+		),
+		StandardScientific: generateNumberFormat(
+			localeData["standard-scientificFormat"].(string),
+		),
 		StandardCurrencySymbol: generateNumberFormat(
 			localeData["standard-moneyFormat-symbol"].(string),
 		),
-		StandardCurrencyAlpha: generateNumberFormat(
-			localeData["standard-moneyFormat-alpha"].(string),
-		),
 		StandardCurrencyNoSymbol: generateNumberFormat(
 			localeData["standard-moneyFormat-noSymbol"].(string),
 		),
@@ -106,13 +216,34 @@ func (c cldrData) generateNumberInfo(l string) (locale.NumberInfo, error) {
 		AccountingCurrencySymbol: generateNumberFormat(
 			localeData["accounting-moneyFormat-symbol"].(string),
 		),
-		AccountingCurrencyAlpha: generateNumberFormat(
-			localeData["accounting-moneyFormat-alpha"].(string),
-		),
 		AccountingCurrencyNoSymbol: generateNumberFormat(
 			localeData["accounting-moneyFormat-noSymbol"].(string),
 		),
 	}
+	nf.CurrencyUnitPatterns = generatePluralForms(localeData["currency-unitPatterns"].(map[string]string))
+	nf.MiscPatterns = locale.MiscPatterns{
+		Approximately: localeData["misc-approximately"].(string),
+		AtLeast:       localeData["misc-atLeast"].(string),
+		AtMost:        localeData["misc-atMost"].(string),
+		Range:         localeData["misc-range"].(string),
+	}
+	currencySpacing := localeData["currencySpacing"].(map[string]any)
+	nf.CurrencySpacing = locale.CurrencySpacing{
+		BeforeCurrency: generateCurrencySpacingRule(currencySpacing["beforeCurrency"].(map[string]any)),
+		AfterCurrency:  generateCurrencySpacingRule(currencySpacing["afterCurrency"].(map[string]any)),
+	}
+	nf.CompactFormats = locale.CompactFormats{
+		ShortDecimal: generateCompactFormats(
+			localeData["short-decimalFormat"].(map[string]any),
+		),
+		LongDecimal: generateCompactFormats(
+			localeData["long-decimalFormat"].(map[string]any),
+		),
+
+		ShortCurrencySymbol: generateCompactFormats(
+			localeData["short-moneyFormat-symbol"].(map[string]any),
+		),
+	}
 
 	return nf, nil
 }
@@ -133,6 +264,17 @@ func (c cldrData) generateCurrencyData(l, cur string) (locale.CurrencyData, erro
 	ds, _ := strconv.Atoi(currencydata["digits"])
 	cd.MinorDigits = uint8(ds)
 
+	// Rounding increment in units of the minor digits, e.g. 5 => 0.05 for 2 minor digits.
+	r, _ := strconv.Atoi(currencydata["rounding"])
+	cd.Rounding = uint8(r)
+
+	cds, _ := strconv.Atoi(currencydata["cashDigits"])
+	cd.CashDigits = uint8(cds)
+
+	// Rounding increment in units of the cash digits, e.g. 5 => 0.05 for 2 cash digits.
+	cr, _ := strconv.Atoi(currencydata["cashRounding"])
+	cd.CashRounding = uint8(cr)
+
 	currencyFormats := localedata["currency-formats"].(map[string]map[string]string)
 
 	currencyFormat, ok := currencyFormats[cur]
@@ -155,9 +297,37 @@ func (c cldrData) generateCurrencyData(l, cur string) (locale.CurrencyData, erro
 		cd.DisplaySymbolNarrow = symbolNarrow
 	}
 
+	cd.DisplayName = cur
+
+	displayName, ok := currencyFormat["display-name"]
+	if ok {
+		cd.DisplayName = displayName
+	}
+
+	displayNames := make(map[string]string)
+
+	for key, val := range currencyFormat {
+		if count, ok := strings.CutPrefix(key, "display-name-"); ok {
+			displayNames[count] = val
+		}
+	}
+
+	cd.DisplayNames = generatePluralForms(displayNames)
+
 	return cd, nil
 }
 
+func generatePluralForms(forms map[string]string) locale.PluralForms {
+	return locale.PluralForms{
+		Zero:  forms["zero"],
+		One:   forms["one"],
+		Two:   forms["two"],
+		Few:   forms["few"],
+		Many:  forms["many"],
+		Other: forms["other"],
+	}
+}
+
 func (c cldrData) GenerateLocaleData(l string) (locale.LocaleData, error) {
 	var ld locale.LocaleData
 
@@ -26,31 +26,81 @@ func (l Locale) Name() string {
 
 type CurrencyData struct {
 	MinorDigits         uint8
+	Rounding            uint8
+	CashDigits          uint8
+	CashRounding        uint8
 	DisplayCode         string
 	DisplaySymbol       string
 	DisplaySymbolNarrow string
+
+	// Display names by plural category, e.g. One: "euro" and Other: "euros" in "fr";
+	// DisplayName is used for categories without one.
+	DisplayName  string
+	DisplayNames PluralForms
+}
+
+// Strings by CLDR plural category; categories a locale does not distinguish are empty.
+type PluralForms struct {
+	Zero  string
+	One   string
+	Two   string
+	Few   string
+	Many  string
+	Other string
+}
+
+// Form returns the string for the plural category, e.g. "one", or the "other" one if the category has none.
+func (pf PluralForms) Form(category string) string {
+	var form string
+
+	switch category {
+	case "zero":
+		form = pf.Zero
+	case "one":
+		form = pf.One
+	case "two":
+		form = pf.Two
+	case "few":
+		form = pf.Few
+	case "many":
+		form = pf.Many
+	}
+
+	if form == "" {
+		form = pf.Other
+	}
+
+	return form
 }
 
 type NumberFormat struct {
 	PrimaryGroupSize   uint8
 	SecondaryGroupSize uint8
 
+	// Numbers of digits declared by the pattern, e.g. 1, 0 and 3 for #,##0.###.
+	MinIntegerDigits  uint8
+	MinFractionDigits uint8
+	MaxFractionDigits uint8
+
 	Prefix string
 	Suffix string
 
+	// Affixes of negative numbers, where "-" stands for the locale's minus sign.
 	NegPrefix string
 	NegSuffix string
 }
 
 type NumberFormats struct {
-	StandardDecimal NumberFormat
+	StandardDecimal    NumberFormat
+	StandardPercent    NumberFormat
+	StandardScientific NumberFormat
 
+	// Like ICU, alphabetic currency labels use the symbol formats with the locale's CurrencySpacing,
+	// rather than CLDR's alphaNextToNumber patterns.
 	StandardCurrencySymbol   NumberFormat
-	StandardCurrencyAlpha    NumberFormat
 	StandardCurrencyNoSymbol NumberFormat
 
 	AccountingCurrencySymbol   NumberFormat
-	AccountingCurrencyAlpha    NumberFormat
 	AccountingCurrencyNoSymbol NumberFormat
 }
 
@@ -59,6 +109,68 @@ type NumberInfo struct {
 	Digits              [10]string
 	FractionalSeparator string
 	GroupingSeparator   string
+	// The minimum number of digits in the highest group of a grouped number, e.g. 2 for 10 000 but 1000 in "pl".
+	MinimumGroupingDigits  uint8
+	Infinity               string
+	NaN                    string
+	PercentSign            string
+	PerMille               string
+	PlusSign               string
+	MinusSign              string
+	Exponential            string
+	SuperscriptingExponent string
+	TimeSeparator          string
+	ApproximatelySign      string
+
+	Formats        NumberFormats
+	CompactFormats CompactFormats
+
+	// Patterns combining amounts {0} with currency names {1} by plural category, e.g. Other: "{0} {1}".
+	CurrencyUnitPatterns PluralForms
+
+	MiscPatterns MiscPatterns
+
+	CurrencySpacing CurrencySpacing
+}
+
+// Rules for spacing currency symbols apart from the numbers next to them, e.g. USD 5.00 but $5.00 in "en";
+// AfterCurrency applies to symbols before numbers, and BeforeCurrency to symbols after them.
+type CurrencySpacing struct {
+	BeforeCurrency CurrencySpacingRule
+	AfterCurrency  CurrencySpacingRule
+}
+
+// A rule inserting InsertBetween between a currency symbol and a number next to each other, if the symbol's
+// character next to the number is in the UnicodeSet CurrencyMatch, e.g. "[[:^S:]&[:^Z:]]",
+// and the number's character next to the symbol is in SurroundingMatch, e.g. "[:digit:]".
+type CurrencySpacingRule struct {
+	CurrencyMatch    string
+	SurroundingMatch string
+	InsertBetween    string
+}
+
+// Patterns for numbers in context, where {0} and {1} are formatted numbers, e.g. "~{0}" for approximate numbers,
+// "{0}+" and "≤{0}" for lower and upper bounds, and "{0}–{1}" for ranges in "en".
+type MiscPatterns struct {
+	Approximately string
+	AtLeast       string
+	AtMost        string
+	Range         string
+}
+
+// Compact patterns for numbers with Magnitude + 1 whole digits, e.g. 0K for 1000 to 9999 in "en",
+// keyed by plural category or by the exact number they are reserved for, e.g. "1" => "mille" in "fr".
+// Patterns are unquoted, with their integer digits reduced to a single 0; no integer digits means no compaction.
+type CompactFormat struct {
+	Magnitude     uint8
+	IntegerDigits uint8
+	Patterns      map[string]string
+}
+
+// Compact currency patterns keep their currency placeholder ¤, e.g. ¤0K in "en".
+type CompactFormats struct {
+	ShortDecimal []CompactFormat
+	LongDecimal  []CompactFormat
 
-	Formats NumberFormats
+	ShortCurrencySymbol []CompactFormat
 }
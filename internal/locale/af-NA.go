@@ -6,183 +6,236 @@ var afNA = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{4, 2, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{5, 3, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{6, 1, map[string]string{"one": "0\u00a0m", "other": "0\u00a0m"}},
+				{7, 2, map[string]string{"one": "0\u00a0m", "other": "0\u00a0m"}},
+				{8, 3, map[string]string{"one": "0\u00a0m", "other": "0\u00a0m"}},
+				{9, 1, map[string]string{"one": "0\u00a0mjd", "other": "0\u00a0mjd"}},
+				{10, 2, map[string]string{"one": "0\u00a0mjd", "other": "0\u00a0mjd"}},
+				{11, 3, map[string]string{"one": "0\u00a0mjd", "other": "0\u00a0mjd"}},
+				{12, 1, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+				{13, 2, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+				{14, 3, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 duisend", "other": "0 duisend"}},
+				{4, 2, map[string]string{"one": "0 duisend", "other": "0 duisend"}},
+				{5, 3, map[string]string{"one": "0 duisend", "other": "0 duisend"}},
+				{6, 1, map[string]string{"one": "0 miljoen", "other": "0 miljoen"}},
+				{7, 2, map[string]string{"one": "0 miljoen", "other": "0 miljoen"}},
+				{8, 3, map[string]string{"one": "0 miljoen", "other": "0 miljoen"}},
+				{9, 1, map[string]string{"one": "0 miljard", "other": "0 miljard"}},
+				{10, 2, map[string]string{"one": "0 miljard", "other": "0 miljard"}},
+				{11, 3, map[string]string{"one": "0 miljard", "other": "0 miljard"}},
+				{12, 1, map[string]string{"one": "0 biljoen", "other": "0 biljoen"}},
+				{13, 2, map[string]string{"one": "0 biljoen", "other": "0 biljoen"}},
+				{14, 3, map[string]string{"one": "0 biljoen", "other": "0 biljoen"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0\u00a0k", "other": "¤0\u00a0k"}},
+				{4, 2, map[string]string{"one": "¤0\u00a0k", "other": "¤0\u00a0k"}},
+				{5, 3, map[string]string{"one": "¤0\u00a0k", "other": "¤0\u00a0k"}},
+				{6, 1, map[string]string{"one": "¤0\u00a0m", "other": "¤0\u00a0m"}},
+				{7, 2, map[string]string{"one": "¤0\u00a0m", "other": "¤0\u00a0m"}},
+				{8, 3, map[string]string{"one": "¤0\u00a0m", "other": "¤0\u00a0m"}},
+				{9, 1, map[string]string{"one": "¤0\u00a0mjd", "other": "¤0\u00a0mjd"}},
+				{10, 2, map[string]string{"one": "¤0\u00a0mjd", "other": "¤0\u00a0mjd"}},
+				{11, 3, map[string]string{"one": "¤0\u00a0mjd", "other": "¤0\u00a0mjd"}},
+				{12, 1, map[string]string{"one": "¤0\u00a0bn", "other": "¤0\u00a0bn"}},
+				{13, 2, map[string]string{"one": "¤0\u00a0bn", "other": "¤0\u00a0bn"}},
+				{14, 3, map[string]string{"one": "¤0\u00a0bn", "other": "¤\u00a00\u00a0bn"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "$", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "leu"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "R", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Verenigde Arabiese Emirate-dirham", PluralForms{"", "VAE-dirham", "", "", "", "VAE-dirham"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afgaanse afgani", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albanese lek", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armeense dram", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Nederlands-Antilliaanse gulde", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angolese kwanza", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentynse peso", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Australiese dollar", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Arubaanse floryn", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbeidjaanse manat", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bosnies-Herzegowiniese omskakelbare marka", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbados-dollar", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladesjiese taka", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgaarse lev", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahreinse dinar", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundiese frank", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermuda-dollar", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Broeneise dollar", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviaanse boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brasilliaanse reaal", PluralForms{"", "Brasillianse reaal", "", "", "", "Brasillianse reaal"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamiaanse dollar", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Bhoetanese ngoeltroem", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswana-pula", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Belarusiese roebel", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Belo-Russiese roebel (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Beliziese dollar", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "Kanadese dollar", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongolese frank", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Switserse frank", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Chileense peso", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Chinese joean (buiteland)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Chinese joean", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Colombiaanse peso", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Costa Ricaanse colón", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kubaanse omskakelbare peso", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kubaanse peso", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kaap Verdiese escudo", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Tsjeggiese kroon", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Djiboeti-frank", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Deense kroon", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikaanse peso", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Algeriese dinar", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Egiptiese pond", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrese nakfa", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Etiopiese birr", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "euro", "", "", "", "euro"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fidjiaanse dollar", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Falkland-eilandse pond", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Britse pond", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Georgiese lari", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghanese cedi (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ghanese cedi", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltarese pond", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambiese dalasi", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Guinese frank", PluralForms{"", "", "", "", "", ""}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guinese syli", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Guatemalaanse kwetsal", PluralForms{"", "Guatemalaanse kwetsal", "", "", "", "Guatemalaanse kwetsal"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Guyanese dollar", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Hongkongse dollar", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Hondurese lempira", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kroatiese kuna", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haïtiaanse gourde", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Hongaarse florint", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indonesiese roepia", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Israeliese nuwe sikkel", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Indiese roepee", PluralForms{"", "Indiese rupee", "", "", "", "Indiese rupee"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irakse dinar", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Iranse rial", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Yslandse kroon", PluralForms{"", "", "", "", "", ""}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italiaanse lier", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaikaanse dollar", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordaniese dinar", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Japannese jen", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Keniaanse sjieling", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kirgisiese som", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambodjaanse riel", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Comoraanse frank", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Noord-Koreaanse won", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Suid-Koreaanse won", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Koeweitse dinar", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Cayman-eilandse dollar", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kazakse tenge", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laosiaanse kip", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Libanese pond", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Sri Lankaanse roepee", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberiese dollar", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesotho loti", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litause litas", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lettiese lats", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libiese dinar", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Marokkaanse dirham", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldowiese leu", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Malgassiese ariary", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Macedoniese denar", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Mianmese kyat", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongoolse toegrik", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Macaose pataca", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritaniese ouguiya (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauritaniese ouguiya", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mauritiaanse roepee", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Malediviese rufia", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawiese kwacha", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "Meksikaanse peso", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Maleisiese ringgit", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mosambiekse metical (1980–2006)", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mosambiekse metical", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "$", "$", "Namibiese dollar", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigeriese naira", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nicaraguaanse córdoba", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Noorse kroon", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepalese roepee", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Nieu-Seelandse dollar", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omaanse rial", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panamese balboa", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peruaanse sol", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papoea-Nieu-Guinese kina", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filippynse peso", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistanse roepee", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Poolse zloty", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paraguaanse guarani", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Katarrese rial", PluralForms{"", "Katarese rial", "", "", "", "Katarese rial"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "leu", "Roemeense leu", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serwiese dinar", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Russiese roebel", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Rwandese frank", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saoedi-Arabiese riyal", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Salomonseilandse dollar", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seychellese roepee", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Soedannese pond", PluralForms{"", "", "", "", "", ""}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Soedannese pond (1957–1998)", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Sweedse kroon", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapoer-dollar", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Sint Helena-pond", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sierra Leoniese leone", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sierra Leoniese leone (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somaliese sjieling", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surinaamse dollar", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Suid-Soedanese pond", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "São Tomé en Príncipe dobra (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "São Tomé en Príncipe-dobra", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Siriese pond", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Swazilandse lilangeni", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Thaise baht", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadjikse somoni", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmeense manat", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunisiese dinar", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tongaanse pa’anga", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turkse lier (1922–2005)", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Turkse lira", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidad en Tobago-dollar", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Nuwe Taiwanese dollar", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzaniese sjieling", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Oekraïnse hriwna", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ugandese sjieling", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "VSA-dollar", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Uruguaanse peso", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Oezbekiese som", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venezolaanse bolivar", PluralForms{"", "Venezolaanse bolívar (2008–2018)", "", "", "", "Venezolaanse bolívare (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venezolaanse bolívar", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Viëtnamese dong", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatuse vatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoaanse tala", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Sentraal Afrikaanse CFA-frank", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Oos-Karibiese dollar", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Wes-Afrikaanse CFA-frank", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP-frank", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Onbekende geldeenheid", PluralForms{"", "(onbekende geldeenheid)", "", "", "", "(onbekende geldeenheid)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jemenitiese rial", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "R", "R", "Suid-Afrikaanse rand", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambiese kwacha (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambiese kwacha", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwiese dollar", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,180 +6,233 @@ var ak = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "apem 0", "other": "apem 0"}},
+				{4, 2, map[string]string{"one": "mpem 0", "other": "mpem 0"}},
+				{5, 3, map[string]string{"one": "mpem 0", "other": "mpem 0"}},
+				{6, 1, map[string]string{"one": "ɔpepem 0", "other": "ɔpepem 0"}},
+				{7, 2, map[string]string{"one": "ɔpepem 0", "other": "ɔpepem 0"}},
+				{8, 3, map[string]string{"one": "ɔpepem 0", "other": "ɔpepem 0"}},
+				{9, 1, map[string]string{"one": "ɔpepepem 0", "other": "ɔpepepem 0"}},
+				{10, 2, map[string]string{"one": "ɔpepepem 0", "other": "ɔpepepem 0"}},
+				{11, 3, map[string]string{"one": "ɔpepepem 0", "other": "ɔpepepem 0"}},
+				{12, 1, map[string]string{"one": "ɔpepepepem 0", "other": "ɔpepepepem 0"}},
+				{13, 2, map[string]string{"one": "ɔpepepepem 0", "other": "ɔpepepepem 0"}},
+				{14, 3, map[string]string{"one": "ɔpepepepem 0", "other": "ɔpepepepem 0"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{4, 2, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{5, 3, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{6, 1, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{7, 2, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{8, 3, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{9, 1, map[string]string{"other": "¤\u00a00G"}},
+				{10, 2, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0G", "other": "¤0G"}},
+				{12, 1, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{13, 2, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{14, 3, map[string]string{"one": "¤0T", "other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GH₵", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "Irak dinaa", "Irak dinaa"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "AAS", "AAS"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Ɛmirete Arab Nkabɔmu Deram", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghanfoɔ Afghani", PluralForms{"", "Afghanfoɔ Afghani", "", "", "", "Afghanfoɔ Afghani"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albania Lek", PluralForms{"", "Albania lek", "", "", "", "Albania lekë"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Amɛnia dram", PluralForms{"", "Amɛnia dram", "", "", "", "Amɛnia dram"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Nɛdɛlande Antɛlia guuda", PluralForms{"", "Nɛdɛlande Antɛlia guuda", "", "", "", "Nɛdɛlande Antɛlia guuda"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angola Kwanza", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Agɛntina peso", PluralForms{"", "Agɛntina peso", "", "", "", "Agɛntina peso"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Ɔstrelia Dɔla", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruba flɔrin", PluralForms{"", "Aruba flɔrin", "", "", "", "Aruba flɔrin"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azɛbagyan manat", PluralForms{"", "Azɛbagyan manat", "", "", "", "Azɛbagyan manat"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bɔsnia-Hɛzegɔvina nsesa maake", PluralForms{"", "Bɔsnia-Hɛzegɔvina nsesa maake", "", "", "", "Bɔsnia-Hɛzegɔvina nsesa maake"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Babadɔso dɔla", PluralForms{"", "Babadɔso dɔla", "", "", "", "Babadɔso dɔla"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladehye taka", PluralForms{"", "Bangladehye taka", "", "", "", "Bangladehye taka"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bɔɔgaria lɛv", PluralForms{"", "Bɔɔgaria lɛv", "", "", "", "Bɔɔgaria lɛva"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Baren Dina", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundi Frank", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bɛɛmuda dɔla", PluralForms{"", "Bɛɛmuda dɔla", "", "", "", "Bɛɛmuda dɔla"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunei dɔla", PluralForms{"", "Brunei dɔla", "", "", "", "Brunei dɔla"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolivia boliviano", PluralForms{"", "Bolivia boliviano", "", "", "", "Bolivia boliviano"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brazil reale", PluralForms{"", "Brazil reale", "", "", "", "Brazil reale"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahama dɔla", PluralForms{"", "Bahama dɔla", "", "", "", "Bahama dɔla"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Butanfoɔ ngutrum", PluralForms{"", "Butanfoɔ ngutrum", "", "", "", "Butanfoɔ ngutrum"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswana Pula", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Bɛlaruhyia ruble", PluralForms{"", "Bɛlaruhyia ruble", "", "", "", "Bɛlaruhyia ruble"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belize Dɔla", PluralForms{"", "Belize Dɔla", "", "", "", "Belize Dɔla"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Kanada Dɔla", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongo Frank", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Swiss Franc", PluralForms{"", "Swiss franc", "", "", "", "Swiss francs"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Kyili Peso", PluralForms{"", "Kyili Peso", "", "", "", "Kyili Peso"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kyaena yuan (offshore)", PluralForms{"", "kyaena yuan (offshore)", "", "", "", "kyaena yuan (offshore)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "kyaena yuan", PluralForms{"", "kyaena yuan", "", "", "", "kyaena yuan"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolombia peso", PluralForms{"", "Kolombia peso", "", "", "", "Kolombia peso"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kɔsta Rika kɔlɔn", PluralForms{"", "Kɔsta Rika kɔlɔn", "", "", "", "Kɔsta Rika kɔlɔn"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kuba nsesa peso", PluralForms{"", "Kuba nsesa peso", "", "", "", "Kuba nsesa peso"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kuba peso", PluralForms{"", "Kuba peso", "", "", "", "Kuba peso"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Ɛskudo", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Kyɛk koruna", PluralForms{"", "Kyɛk koruna", "", "", "", "Kyɛk koruna"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Gyebuti Frank", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Danefoɔ krone", PluralForms{"", "Danefoɔ krone", "", "", "", "Danefoɔ krone"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dɔmenika peso", PluralForms{"", "Dɔmenika peso", "", "", "", "Dɔmenika peso"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Ɔlgyeria Dina", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Egypt Pɔn", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Ɛretereya Nakfa", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Itiopia Bir", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Iro", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Figyi Dɔla", PluralForms{"", "Figyi Dɔla", "", "", "", "Figyi Dɔla"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Fɔkland Aelande Pɔn", PluralForms{"", "Fɔkland Aelande Pɔn", "", "", "", "Fɔkland Aelande Pɔn"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Breten Pɔn", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gyɔɔgyia lari", PluralForms{"", "Gyɔɔgyia lari", "", "", "", "Gyɔɔgyia lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghana Sidi (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GH₵", "GH₵", "Ghana Sidi", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gyebrotaa pɔn", PluralForms{"", "Gyebrotaa pɔn", "", "", "", "Gyebrotaa pɔn"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambia Dalasi", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gini franke", PluralForms{"", "Gini franke", "", "", "", "Gini franke"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Gini Frank", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Guatemala kwɛtsaa", PluralForms{"", "Guatemala kwɛtsaa", "", "", "", "Guatemala kwɛtsaa"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Gayana dɔla", PluralForms{"", "Gayana dɔla", "", "", "", "Gayana dɔla"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Hɔnkɔn Dɔla", PluralForms{"", "Hɔnkɔn Dɔla", "", "", "", "Hɔnkɔn Dɔla"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Hɔndura lɛmpira", PluralForms{"", "Hɔndura lɛmpira", "", "", "", "Hɔndura lɛmpira"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Krohyia kuna", PluralForms{"", "Krohyia kuna", "", "", "", "Krohyia kunas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haiti gɔɔde", PluralForms{"", "Haiti gɔɔde", "", "", "", "Haiti gɔɔde"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Hangari fɔrint", PluralForms{"", "Hangari fɔrint", "", "", "", "Hangari fɔrint"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indɔnihyia rupia", PluralForms{"", "Indɔnihyia rupia", "", "", "", "Indɔnihyia rupia"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Israel hyekel foforɔ", PluralForms{"", "Israel hyekel foforɔ", "", "", "", "Israel hyekel foforɔ"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "India Rupi", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "Irak dinaa", "Irak dinaa", "Irak dinaa", PluralForms{"", "Irak dinaa", "", "", "", "Irak dinaa"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Yiranfoɔ rial", PluralForms{"", "Yiranfoɔ rial", "", "", "", "Yiranfoɔ rial"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Icelandfoɔ Króna", PluralForms{"", "Icelandfoɔ króna", "", "", "", "Icelandfoɔ krónur"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Gyameka dɔla", PluralForms{"", "Gyameka dɔla", "", "", "", "Gyameka dɔla"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Gyɔɔdan dinaa", PluralForms{"", "Gyɔɔdan dinaa", "", "", "", "Gyɔɔdan dinaa"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Gyapan Yɛn", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenya Hyelen", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kagyɛstan som", PluralForms{"", "Kagyɛstan som", "", "", "", "Kagyɛstan som"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambodia riel", PluralForms{"", "Kambodia riel", "", "", "", "Kambodia riel"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komoro Frank", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Korea Atifi won", PluralForms{"", "Korea Atifi won", "", "", "", "Korea Atifi won"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Korea Anaafoɔ won", PluralForms{"", "Korea Anaafoɔ won", "", "", "", "Korea Anaafoɔ won"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuwait dinaa", PluralForms{"", "Kuwait dinaa", "", "", "", "Kuwait dinaa"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kayemanfo Aelande dɔla", PluralForms{"", "Kayemanfo Aelande dɔla", "", "", "", "Kayemanfo Aelande dɔla"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kagyastan tenge", PluralForms{"", "Kagyastan tenge", "", "", "", "Kagyastan tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laohyia kip", PluralForms{"", "Laohyia kip", "", "", "", "Laohyia kip"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Lɛbanon pɔn", PluralForms{"", "Lɛbanon pɔn", "", "", "", "Lɛbanon pɔn"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Sri Lankafoɔ rupee", PluralForms{"", "Sri Lankafoɔ rupee", "", "", "", "Sri Lankafoɔ rupee"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Laeberia Dɔla", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesoto Loti", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libya Dina", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Moroko Diram", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldova Leu", PluralForms{"", "Moldova leu", "", "", "", "Moldova lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madagasi Frank", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Masidonia denaa", PluralForms{"", "Masidonia denaa", "", "", "", "Masidonia denari"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Mayamaa kyat", PluralForms{"", "Mayamaa kyat", "", "", "", "Mayamaa kyat"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongoliafoɔ tugrike", PluralForms{"", "Mongoliafoɔ tugrike", "", "", "", "Mongoliafoɔ tugrike"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makaw pataka", PluralForms{"", "Makaw pataka", "", "", "", "Makaw pataka"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mɔretenia Ouguiya (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mɔretenia Ouguiya", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mɔrehyeɔs Rupi", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldivefoɔ rufiyaa", PluralForms{"", "Maldivefoɔ rufiyaa", "", "", "", "Maldivefoɔ rufiyaa"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawi Kwakya", PluralForms{"", "Malawi Kwakya", "", "", "", "Malawi Kwakya"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Mɛksiko pɛso", PluralForms{"", "Mɛksiko pɛso", "", "", "", "Mɛksiko pɛso"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaahyia ringgit", PluralForms{"", "Malaahyia ringgit", "", "", "", "Malaahyia ringgit"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambik Metical", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambik mɛtikaa", PluralForms{"", "Mozambik mɛtikaa", "", "", "", "Mozambik mɛtikaa"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibia Dɔla", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naegyeria Naira", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikaragua kɔɔdɔba", PluralForms{"", "Nikaragua kɔɔdɔba", "", "", "", "Nikaragua kɔɔdɔba"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Nɔɔwee Krone", PluralForms{"", "Nɔɔwee krone", "", "", "", "Nɔɔwee kroner"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepalfoɔ rupee", PluralForms{"", "Nepalfoɔ rupee", "", "", "", "Nepalfoɔ rupee"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "New Zealand Dɔla", PluralForms{"", "New Zealand Dɔla", "", "", "", "New Zealand Dɔla"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Oman rial", PluralForms{"", "Oman rial", "", "", "", "Oman rial"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panama baaboa", PluralForms{"", "Panama baaboa", "", "", "", "Panama baaboa"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Pɛruvia sol", PluralForms{"", "Pɛruvia sol", "", "", "", "Pɛruvia sol"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua New Gini kina", PluralForms{"", "Papua New Gini kina", "", "", "", "Papua New Gini kina"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Filipine peso", PluralForms{"", "Filipine peso", "", "", "", "Filipine peso"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistanfoɔ rupee", PluralForms{"", "Pakistanfoɔ rupee", "", "", "", "Pakistanfoɔ rupee"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Pɔlihye zloty", PluralForms{"", "Pɔlihye zloty", "", "", "", "Pɔlihye zloty"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paragayana guarani", PluralForms{"", "Paragayana guarani", "", "", "", "Paragayana guarani"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Kata riyaa", PluralForms{"", "Kata riyaa", "", "", "", "Kata riyaa"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Romania Leu", PluralForms{"", "Romania leu", "", "", "", "Romania lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Sɛɛbia dinaa", PluralForms{"", "Sɛɛbia dinaa", "", "", "", "Sɛɛbia dinaa"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rɔhyia rubuu", PluralForms{"", "Rɔhyia rubuu", "", "", "", "Rɔhyia rubuu"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Rewanda Frank", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudi Riyal", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Solomon Aeland Dɔla", PluralForms{"", "Solomon Aeland Dɔla", "", "", "", "Solomon Aeland Dɔla"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seyhyɛls Rupi", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudan Dina", PluralForms{"", "", "", "", "", ""}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudan Pɔn", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Sweden Krona", PluralForms{"", "Sweden krona", "", "", "", "Sweden kronor"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapɔɔ dɔla", PluralForms{"", "Singapɔɔ dɔla", "", "", "", "Singapɔɔ dɔla"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "St Helena Pɔn", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leone", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leone (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somailia Hyelen", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Suriname dɔla", PluralForms{"", "Suriname dɔla", "", "", "", "Suriname dɔla"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Sudan Anaafoɔ Pɔn", PluralForms{"", "Sudan Anaafoɔ Pɔn", "", "", "", "Sudan Anaafoɔ Pɔn"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Sao Tome ne Principe Dobra (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Sao Tome ne Principe Dobra", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Siria pɔn", PluralForms{"", "Siria pɔn", "", "", "", "Siria pɔn"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Tai bat", PluralForms{"", "Tai bat", "", "", "", "Tai bat"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tagyikistan somoni", PluralForms{"", "Tagyikistan somoni", "", "", "", "Tagyikistan somoni"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Tɛkmɛstan manat", PluralForms{"", "Tɛkmɛstan manat", "", "", "", "Tɛkmɛstan manat"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunisia Dina", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tonga Paʻanga", PluralForms{"", "Tonga paʻanga", "", "", "", "Tonga paʻanga"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Tɛki lira", PluralForms{"", "Tɛki lira", "", "", "", "Tɛki lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidad ne Tobago dɔla", PluralForms{"", "Trinidad ne Tobago dɔla", "", "", "", "Trinidad ne Tobago dɔla"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Taewanfoɔ dɔla foforɔ", PluralForms{"", "Taelanfoɔ dɔla foforɔ", "", "", "", "Taewanfoɔ dɔla foforɔ"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzania Hyelen", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Yukren hryvnia", PluralForms{"", "Yukren hryvnia", "", "", "", "Yukren hryvnia"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Uganda Hyelen", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "Amɛrika Dɔla", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Yurugueɛ peso", PluralForms{"", "Yurugueɛ peso", "", "", "", "Yurugueɛ peso"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Yusbɛkistan som", PluralForms{"", "Yusbɛkistan som", "", "", "", "Yusbɛkistan som"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venezuelan bolívar", PluralForms{"", "Venezuelan bolívar", "", "", "", "Venezuelan bolívars"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Viɛtnamfoɔ dɔn", PluralForms{"", "Viɛtnamfoɔ dɔn", "", "", "", "Viɛtnamfoɔ dɔn"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu vatu", PluralForms{"", "Vanuatu vatu", "", "", "", "Vanuatu vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoa Tala", PluralForms{"", "Samoa tala", "", "", "", "Samoa tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Afrika Mfinimfini Sefa", PluralForms{"", "Afrika Mfinimfini Sefa", "", "", "", "Afrika Mfinimfini Sefa"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Karibine Apueeɛ dɔla", PluralForms{"", "Karibine Apueeɛ dɔla", "", "", "", "Karibine Apueeɛ dɔla"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "AAS", "AAS", "Afrika Atɔeɛ Sefa", PluralForms{"", "Afrika Atɔeɛ Sefa", "", "", "", "Afrika Atɔeɛ Sefa"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP Franc", PluralForms{"", "CFP franc", "", "", "", "CFP francs"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "sika a yɛnnim", PluralForms{"", "(sika a yɛnnim)", "", "", "", "(sika a yɛnnim)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Yɛmɛn rial", PluralForms{"", "Yɛmɛn rial", "", "", "", "Yɛmɛn rial"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Afrika Anaafo Rand", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambia Kwacha (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambia Kwakya", PluralForms{"", "Zambia Kwakya", "", "", "", "Zambia Kwakya"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwe Dɔla", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
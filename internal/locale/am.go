@@ -6,179 +6,232 @@ var am = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0ሺ", "other": "0\u00a0ሺ"}},
+				{4, 2, map[string]string{"one": "0\u00a0ሺ", "other": "0\u00a0ሺ"}},
+				{5, 3, map[string]string{"one": "0\u00a0ሺ", "other": "0\u00a0ሺ"}},
+				{6, 1, map[string]string{"one": "0\u00a0ሚ", "other": "0\u00a0ሚ"}},
+				{7, 2, map[string]string{"one": "0\u00a0ሚ", "other": "0\u00a0ሚ"}},
+				{8, 3, map[string]string{"one": "0\u00a0ሚ", "other": "0\u00a0ሚ"}},
+				{9, 1, map[string]string{"one": "0\u00a0ቢ", "other": "0\u00a0ቢ"}},
+				{10, 2, map[string]string{"one": "0\u00a0ቢ", "other": "0\u00a0ቢ"}},
+				{11, 3, map[string]string{"one": "0\u00a0ቢ", "other": "0\u00a0ቢ"}},
+				{12, 1, map[string]string{"one": "0\u00a0ት", "other": "0\u00a0ት"}},
+				{13, 2, map[string]string{"one": "0\u00a0ት", "other": "0\u00a0ት"}},
+				{14, 3, map[string]string{"one": "0\u00a0ት", "other": "0\u00a0ት"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 ሺ", "other": "0 ሺ"}},
+				{4, 2, map[string]string{"one": "0 ሺ", "other": "0 ሺ"}},
+				{5, 3, map[string]string{"one": "0 ሺ", "other": "0 ሺ"}},
+				{6, 1, map[string]string{"one": "0 ሚሊዮን", "other": "0 ሚሊዮን"}},
+				{7, 2, map[string]string{"one": "0 ሚሊዮን", "other": "0 ሚሊዮን"}},
+				{8, 3, map[string]string{"one": "0 ሚሊዮን", "other": "0 ሚሊዮን"}},
+				{9, 1, map[string]string{"one": "0 ቢሊዮን", "other": "0 ቢሊዮን"}},
+				{10, 2, map[string]string{"one": "0 ቢሊዮን", "other": "0 ቢሊዮን"}},
+				{11, 3, map[string]string{"one": "0 ቢሊዮን", "other": "0 ቢሊዮን"}},
+				{12, 1, map[string]string{"one": "0 ትሪሊዮን", "other": "0 ትሪሊዮን"}},
+				{13, 2, map[string]string{"one": "0 ትሪሊዮን", "other": "0 ትሪሊዮን"}},
+				{14, 3, map[string]string{"one": "0 ትሪሊዮን", "other": "0 ትሪሊዮን"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0\u00a0ሺ", "other": "¤0\u00a0ሺ"}},
+				{4, 2, map[string]string{"one": "¤0\u00a0ሺ", "other": "¤0\u00a0ሺ"}},
+				{5, 3, map[string]string{"one": "¤0\u00a0ሺ", "other": "¤0\u00a0ሺ"}},
+				{6, 1, map[string]string{"one": "¤0\u00a0ሚ", "other": "¤0\u00a0ሚ"}},
+				{7, 2, map[string]string{"one": "¤0\u00a0ሚ", "other": "¤0\u00a0ሚ"}},
+				{8, 3, map[string]string{"one": "¤0\u00a0ሚ", "other": "¤0\u00a0ሚ"}},
+				{9, 1, map[string]string{"one": "¤0\u00a0ቢ", "other": "¤0\u00a0ቢ"}},
+				{10, 2, map[string]string{"one": "¤0\u00a0ቢ", "other": "¤0\u00a0ቢ"}},
+				{11, 3, map[string]string{"one": "¤0\u00a0ቢ", "other": "¤0\u00a0ቢ"}},
+				{12, 1, map[string]string{"one": "¤0\u00a0ት", "other": "¤0\u00a0ት"}},
+				{13, 2, map[string]string{"one": "¤0\u00a0ት", "other": "¤0\u00a0ት"}},
+				{14, 3, map[string]string{"one": "¤0\u00a0ት", "other": "¤0\u00a0ት"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ብር", "ብር"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "የተባበሩት የአረብ ኤምሬትስ ድርሀም", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "የአፍጋን አፍጋኒ", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "የአልባንያ ሌክ", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "የአርመን ድራም", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "ኔዘርላንድስ አንቲሊአን ጊልደር", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "የአንጎላ ኩዋንዛ", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "የአርጀንቲና ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "የአውስትራሊያ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "አሩባን ፍሎሪን", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "የአዛርባጃን ማናት", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "የቦስኒያ ሄርዞጎቪና የሚመነዘር ማርክ", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "የባርቤዶስ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "የባንግላዲሽ ታካ", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "የቡልጋሪያ ሌቭ", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "የባኽሬን ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "የብሩንዲ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "የቤርሙዳ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "የብሩኔ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "የቦሊቪያ ቦሊቪያኖ", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "የብራዚል ሪል", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "የባሃማስ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ብሁታኒዝ ንጉልትረም", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "የቦትስዋና ፑላ", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "የቤላሩስያ ሩብል", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "የቤላሩስያ ሩብል (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "የቤሊዝ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "የካናዳ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "የኮንጐ ፍራንክ ኮንጐሌዝ", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "የስዊስ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "የቺሊ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "የቻይና ዩዋን (የውጭ ምንዛሪ)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "የቻይና የን", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "የኮሎምቢያ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "የኮስታሪካ ኮሎን", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "የኩባ የሚመነዘር ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "የኩባ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "የኬፕ ቫርዲ ኤስኩዶ", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "ቼክ ሪፐብሊክ ኮሩና", PluralForms{"", "ቼክ ሪፐብሊክ ኮሩና", "", "", "", "ቼክ ሪፐብሊክ ኮሮና"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "የጅቡቲ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "የዴንማርክ ክሮን", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "የዶሚኒክ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "የአልጄሪያ ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "የግብጽ ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "የኤርትራ ናቅፋ", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ብር", "ብር", "የኢትዮጵያ ብር", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "ዩሮ", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "የፊጂ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "የፎክላንድ ደሴቶች ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "የእንግሊዝ ፓውንድ ስተርሊንግ", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "የጆርጅያ ላሪ", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "የጋና ሴዲ", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "የጋና ሲዲ", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "ጂብራልተር ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "የጋምቢያ ዳላሲ", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "የጊኒ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ጓቲማላን ኩቲዛል", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "የጉየና ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "የሆንግኮንግ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "የሃንዱራ ሌምፓአይራ", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "የክሮሽያ ኩና", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "የሃያቲ ጓርዴ", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "የሃንጋሪያን ፎሪንት", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "የኢንዶኔዥያ ሩፒሃ", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "የእስራኤል አዲስ ሽቅል", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "የሕንድ ሩፒ", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "የኢራቅ ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "የኢራን ሪአል", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "የአይስላንድ ክሮና", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "የጃማይካ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "የጆርዳን ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "የጃፓን የን", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "የኬኒያ ሺሊንግ", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "የኪርጊስታን ሶም", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "የካምቦዲያ ሬል", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "የኮሞሮ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "የሰሜን ኮሪያ ዎን", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "የደቡብ ኮሪያ ዎን", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "የኩዌት ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "የካይማን ደሴቶች ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "የካዛኪስታን ተንጌ", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "የላኦቲ ኪፕ", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "የሊባኖስ ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "የሲሪላንካ ሩፒ", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "የላይቤሪያ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "የሌሶቶ ሎቲ", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "ሊቱዌንያን ሊታስ", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "የላቲቫ ላትስ", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "የሊቢያ ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "የሞሮኮ ዲርሀም", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "ሞልዶቫን ሊኡ", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "የማደጋስካር ማላጋስይ አሪያርይ", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "የሜቆድንያ ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "የማያናማር ክያት", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "የሞንጎሊያን ቱግሪክ", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "የማካኔዝ ፓታካ", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "የሞሪቴኒያ ኦውጉያ (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "የሞሪቴኒያ ኦውጉያ", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "የሞሪሸስ ሩፒ", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "የማልዲቫ ሩፊያ", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "የማላዊ ኩዋቻ", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "የሜክሲኮ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "የማሌዥያ ሪንጊት", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "የሞዛምቢክ ሜቲካል", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "የናሚቢያ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "የናይጄሪያ ናይራ", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "የኒካራጓ ኮርዶባ", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "የኖርዌይ ክሮን", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "የኔፓል ሩፒ", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "የኒውዚላንድ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "የኦማን ሪአል", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "ፓናማኒአን ባልቦአ", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "የፔሩቪያ ሶል", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "የፓፕዋ ኒው ጊኒ ኪና", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "የፊሊፒንስ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "የፓኪስታን ሩፒ", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "የፖላንድ ዝሎቲ", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "የፓራጓይ ጉአራኒ", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "የኳታር ሪአል", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "የሮማኒያ ለው", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "የሰርቢያ ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "የሩስያ ሩብል", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "የሩዋንዳ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "የሳውዲ ሪያል", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "የሰለሞን ደሴቶች ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "የሲሼል ሩፒ", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "የሱዳን ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "የሱዳን ፓውንድ (1957–1998)", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "የስዊድን ክሮና", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "የሲንጋፖር ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "የሴይንት ሔሌና ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "የሴራሊዎን ሊዎን", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "የሴራሊዎን ሊዎን (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "የሶማሌ ሺሊንግ", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "የሰርናሜዝ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "የደቡብ ሱዳን ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "የሳኦ ቶሜ እና ፕሪንሲፔ ዶብራ (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "የሳኦ ቶሜ እና ፕሪንሲፔ ዶብራ", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "የሲሪያ ፓውንድ", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "የስዋዚላንድ ሊላንገኒ", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "የታይላንድ ባህት", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "የታጂክስታን ሶሞኒ", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "ቱርክሜኒስታኒ ማናት", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "የቱኒዚያ ዲናር", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "ቶንጋን ፓ’አንጋ", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "የቱርክ ሊራ", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "የትሪንዳድ እና ቶቤጎዶላር", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "የአዲሷ ታይዋን ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "የታንዛኒያ ሺሊንግ", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "የዩክሬን ሀሪይቭኒአ", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "የዩጋንዳ ሺሊንግ", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "የአሜሪካን ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "የኡራጓይ ፔሶ", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "የኡዝፔኪስታን ሶም", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "የቬንዝዌላ ቦሊቫር (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "የቬንዝዌላ-ቦሊቫር", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "የቭየትናም ዶንግ", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "የቫንዋንቱ ቫቱ", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "ሳሞአን ታላ", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "የመካከለኛው አፍሪካ ሴፋ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "የምዕራብ ካሪብያን ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "የምዕራብ አፍሪካ ሴፋ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "ሲ ኤፍ ፒ ፍራንክ", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "ያልታወቀ ገንዘብ", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "የየመን ሪአል", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "የደቡብ አፍሪካ ራንድ", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "የዛምቢያ ክዋቻ (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "የዛምቢያ ክዋቻ", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "የዚምቧቡዌ ዶላር", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
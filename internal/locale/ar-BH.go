@@ -6,270 +6,323 @@ var arBH = LocaleData{
 	NumberInfo{"arab",
 		[10]string{"٠", "١", "٢", "٣", "٤", "٥", "٦", "٧", "٨", "٩"},
 		"٫", "٬",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "\u200f", "\u00a0¤", "\u200f-", "\u00a0¤"}, NumberFormat{3, 3, "\u200f", "\u00a0¤", "\u200f-", "\u00a0¤"}, NumberFormat{3, 3, "\u200f", "", "\u200f-", ""}, NumberFormat{3, 3, "\u200f", "\u00a0¤", "\u200f-", "\u00a0¤"}, NumberFormat{3, 3, "\u200f", "\u00a0¤", "\u200f-", "\u00a0¤"}, NumberFormat{3, 3, "\u200f", "", "\u200f-", ""}},
+		1,
+		"∞", "ليس\u00a0رقم",
+		"٪\u061c", "؉",
+		"\u061c+", "\u061c-",
+		"اس", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "\u200f", "\u00a0¤", "-\u200f", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "\u200f", "", "-\u200f", ""}, NumberFormat{3, 3, 1, 2, 2, "\u200f", "\u00a0¤", "-\u200f", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "\u200f", "", "\u200f-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0آلاف", "many": "0\u00a0ألف", "one": "0\u00a0ألف", "other": "0\u00a0ألف", "two": "0\u00a0ألف", "zero": "0\u00a0ألف"}},
+				{4, 2, map[string]string{"few": "0\u00a0ألف", "many": "0\u00a0ألف", "one": "0\u00a0ألف", "other": "0\u00a0ألف", "two": "0\u00a0ألف", "zero": "0\u00a0ألف"}},
+				{5, 3, map[string]string{"few": "0\u00a0ألف", "many": "0\u00a0ألف", "one": "0\u00a0ألف", "other": "0\u00a0ألف", "two": "0\u00a0ألف", "zero": "0\u00a0ألف"}},
+				{6, 1, map[string]string{"one": "0\u00a0مليون", "other": "0\u00a0مليون"}},
+				{7, 2, map[string]string{"one": "0\u00a0مليون", "other": "0\u00a0مليون"}},
+				{8, 3, map[string]string{"one": "0\u00a0مليون", "other": "0\u00a0مليون"}},
+				{9, 1, map[string]string{"one": "0\u00a0مليار", "other": "0\u00a0مليار"}},
+				{10, 2, map[string]string{"one": "0\u00a0مليار", "other": "0\u00a0مليار"}},
+				{11, 3, map[string]string{"one": "0\u00a0مليار", "other": "0\u00a0مليار"}},
+				{12, 1, map[string]string{"one": "0\u00a0ترليون", "other": "0\u00a0ترليون"}},
+				{13, 2, map[string]string{"one": "0\u00a0ترليون", "other": "0\u00a0ترليون"}},
+				{14, 3, map[string]string{"one": "0\u00a0ترليون", "other": "0\u00a0ترليون"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 آلاف", "many": "0 ألف", "one": "0 ألف", "other": "0 ألف", "two": "0 ألف", "zero": "0 ألف"}},
+				{4, 2, map[string]string{"few": "0 ألف", "many": "0 ألف", "one": "0 ألف", "other": "0 ألف", "two": "0 ألف", "zero": "0 ألف"}},
+				{5, 3, map[string]string{"few": "0 ألف", "many": "0 ألف", "one": "0 ألف", "other": "0 ألف", "two": "0 ألف", "zero": "0 ألف"}},
+				{6, 1, map[string]string{"few": "0 ملايين", "many": "0 مليون", "one": "0 مليون", "other": "0 مليون", "two": "0 مليون", "zero": "0 مليون"}},
+				{7, 2, map[string]string{"few": "0 ملايين", "many": "0 مليون", "one": "0 مليون", "other": "0 مليون", "two": "0 مليون", "zero": "0 مليون"}},
+				{8, 3, map[string]string{"few": "0 مليون", "many": "0 مليون", "one": "0 مليون", "other": "0 مليون", "two": "0 مليون", "zero": "0 مليون"}},
+				{9, 1, map[string]string{"few": "0 مليار", "many": "0 مليار", "one": "0 مليار", "other": "0 مليار", "two": "0 مليار", "zero": "0 مليار"}},
+				{10, 2, map[string]string{"few": "0 مليار", "many": "0 مليار", "one": "0 مليار", "other": "0 مليار", "two": "0 مليار", "zero": "0 مليار"}},
+				{11, 3, map[string]string{"few": "0 مليار", "many": "0 مليار", "one": "0 مليار", "other": "0 مليار", "two": "0 مليار", "zero": "0 مليار"}},
+				{12, 1, map[string]string{"few": "0 ترليون", "many": "0 ترليون", "one": "0 ترليون", "other": "0 ترليون", "two": "0 ترليون", "zero": "0 ترليون"}},
+				{13, 2, map[string]string{"few": "0 ترليون", "many": "0 ترليون", "one": "0 ترليون", "other": "0 ترليون", "two": "0 ترليون", "zero": "0 ترليون"}},
+				{14, 3, map[string]string{"few": "0 ترليون", "many": "0 ترليون", "one": "0 ترليون", "other": "0 ترليون", "two": "0 ترليون", "zero": "0 ترليون"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0ألف\u00a0¤", "many": "0\u00a0ألف\u00a0¤", "one": "0\u00a0ألف\u00a0¤", "other": "0\u00a0ألف\u00a0¤", "two": "0\u00a0ألف\u00a0¤", "zero": "0\u00a0ألف\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0ألف\u00a0¤", "many": "0\u00a0ألف\u00a0¤", "one": "0\u00a0ألف\u00a0¤", "other": "0\u00a0ألف\u00a0¤", "two": "0\u00a0ألف\u00a0¤", "zero": "0\u00a0ألف\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0ألف\u00a0¤", "many": "0\u00a0ألف\u00a0¤", "one": "0\u00a0ألف\u00a0¤", "other": "0\u00a0ألف\u00a0¤", "two": "0\u00a0ألف\u00a0¤", "zero": "0\u00a0ألف\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0مليون\u00a0¤", "many": "0\u00a0مليون\u00a0¤", "one": "0\u00a0مليون\u00a0¤", "other": "0\u00a0مليون\u00a0¤", "two": "0\u00a0مليون\u00a0¤", "zero": "0\u00a0مليون\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0مليون\u00a0¤", "many": "0\u00a0مليون\u00a0¤", "one": "0\u00a0مليون\u00a0¤", "other": "0\u00a0مليون\u00a0¤", "two": "0\u00a0مليون\u00a0¤", "zero": "0\u00a0مليون\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0مليون\u00a0¤", "many": "0\u00a0مليون\u00a0¤", "one": "0\u00a0مليون\u00a0¤", "other": "0\u00a0مليون\u00a0¤", "two": "0\u00a0مليون\u00a0¤", "zero": "0\u00a0مليون\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0مليار\u00a0¤", "many": "0\u00a0مليار\u00a0¤", "one": "0\u00a0مليار\u00a0¤", "other": "0\u00a0مليار\u00a0¤", "two": "0\u00a0مليار\u00a0¤", "zero": "0\u00a0مليار\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0مليار\u00a0¤", "many": "0\u00a0مليار\u00a0¤", "one": "0\u00a0مليار\u00a0¤", "other": "0\u00a0مليار\u00a0¤", "two": "0\u00a0مليار\u00a0¤", "zero": "0\u00a0مليار\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0مليار\u00a0¤", "many": "0\u00a0مليار\u00a0¤", "one": "0\u00a0مليار\u00a0¤", "other": "0\u00a0مليار\u00a0¤", "two": "0\u00a0مليار\u00a0¤", "zero": "0\u00a0مليار\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0ترليون\u00a0¤", "many": "0\u00a0ترليون\u00a0¤", "one": "0\u00a0ترليون\u00a0¤", "other": "0\u00a0ترليون\u00a0¤", "two": "0\u00a0ترليون\u00a0¤", "zero": "0\u00a0ترليون\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0ترليون\u00a0¤", "many": "0\u00a0ترليون\u00a0¤", "one": "0\u00a0ترليون\u00a0¤", "other": "0\u00a0ترليون\u00a0¤", "two": "0\u00a0ترليون\u00a0¤", "zero": "0\u00a0ترليون\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0ترليون\u00a0¤", "many": "0\u00a0ترليون\u00a0¤", "one": "0\u00a0ترليون\u00a0¤", "other": "0\u00a0ترليون\u00a0¤", "two": "0\u00a0ترليون\u00a0¤", "zero": "0\u00a0ترليون\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "+{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "د.إ.\u200f", "د.إ.\u200f"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "AR$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "AU$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "BB$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "د.ب.\u200f", "د.ب.\u200f"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "BM$"},
-		"BND": {2, "BND", "BND", "BN$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "BS$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "BZ$"},
-		"CAD": {2, "CAD", "CA$", "CA$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "CL$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "CN¥"},
-		"COP": {0, "COP", "COP", "CO$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "CU$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "DO$"},
-		"DZD": {2, "DZD", "د.ج.\u200f", "د.ج.\u200f"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "ج.م.\u200f", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "FJ$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "UK£", "UK£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "GY$"},
-		"HKD": {2, "HKD", "HK$", "HK$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "د.ع.\u200f", "د.ع.\u200f"},
-		"IRR": {0, "IRR", "ر.إ.", "ر.إ."},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "JM$"},
-		"JOD": {3, "JOD", "د.أ.\u200f", "د.أ.\u200f"},
-		"JPY": {0, "JPY", "JP¥", "JP¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "د.ك.\u200f", "د.ك.\u200f"},
-		"KYD": {2, "KYD", "KYD", "KY$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "ل.ل.\u200f", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$LR"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "د.ل.\u200f", "د.ل.\u200f"},
-		"MAD": {2, "MAD", "د.م.\u200f", "د.م.\u200f"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "أ.م.", "أ.م."},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "MX$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "NZ$"},
-		"OMR": {3, "OMR", "ر.ع.\u200f", "ر.ع.\u200f"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "ر.ق.\u200f", "ر.ق.\u200f"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "ر.س.\u200f", "ر.س.\u200f"},
-		"SBD": {2, "SBD", "SBD", "SB$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "د.س.\u200f", "د.س.\u200f"},
-		"SDG": {2, "SDG", "ج.س.", "ج.س."},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "SR$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "ل.س.\u200f", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "د.ت.\u200f", "د.ت.\u200f"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "TT$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "US$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "UY$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "ر.ي.\u200f", "ر.ي.\u200f"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "بيستا أندوري", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "د.إ.\u200f", "د.إ.\u200f", "درهم إماراتي", PluralForms{"", "", "", "", "", ""}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "أفغاني - 1927-2002", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "أفغاني", PluralForms{"أفغاني أفغانستاني", "أفغاني أفغانستاني", "أفغاني أفغانستاني", "أفغاني أفغانستاني", "أفغاني أفغانستاني", "أفغاني أفغانستاني"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "ليك ألباني", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "درام أرميني", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "غيلدر أنتيلي هولندي", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "كوانزا أنغولي", PluralForms{"", "", "", "", "", ""}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "كوانزا أنجولي - 1977-1990", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "كوانزا أنجولي جديدة - 1990-2000", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "كوانزا أنجولي معدلة - 1995 - 1999", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "استرال أرجنتيني", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "بيزو أرجنتيني - 1983-1985", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "AR$", "بيزو أرجنتيني", PluralForms{"", "", "", "", "", ""}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "شلن نمساوي", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "AU$", "دولار أسترالي", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "فلورن أروبي", PluralForms{"", "", "", "", "", ""}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "مانات أذريبجاني", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "مانات أذربيجان", PluralForms{"مانت أذربيجاني", "مانت أذربيجاني", "مانت أذربيجاني", "مانت أذربيجاني", "مانت أذربيجاني", "مانت أذربيجاني"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "دينار البوسنة والهرسك", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "مارك البوسنة والهرسك قابل للتحويل", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "BB$", "دولار بربادوسي", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "تاكا بنغلاديشي", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "فرنك بلجيكي قابل للتحويل", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "فرنك بلجيكي", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "فرنك بلجيكي مالي", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "ليف بلغاري", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "د.ب.\u200f", "د.ب.\u200f", "دينار بحريني", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "فرنك بروندي", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "BM$", "دولار برمودي", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "BN$", "دولار بروناي", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "بوليفيانو بوليفي", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "بيزو بوليفي", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "مفدول بوليفي", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "نوفو كروزايرو برازيلي - 1967-1986", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "كروزادو برازيلي", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "كروزايرو برازيلي - 1990-1993", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ريال برازيلي", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "BS$", "دولار باهامي", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "نولتوم بوتاني", PluralForms{"", "", "", "", "", ""}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "كيات بورمي", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "بولا بتسواني", PluralForms{"", "", "", "", "", ""}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "روبل بيلاروسي جديد - 1994-1999", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "روبل بيلاروسي", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "روبل بيلاروسي (٢٠٠٠–٢٠١٦)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "BZ$", "دولار بليزي", PluralForms{"دولار بليزي", "دولار بليزي", "دولاران بليزيان", "دولار بليزي", "دولار بليزي", "دولار بليزي"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "CA$", "دولار كندي", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "فرنك كونغولي", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "فرنك سويسري", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "CL$", "بيزو تشيلي", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "يوان صيني (في الخارج)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "CN¥", "يوان صيني", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "CO$", "بيزو كولومبي", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "كولن كوستاريكي", PluralForms{"", "", "", "", "", ""}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "دينار صربي قديم", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "كرونة تشيكوسلوفاكيا", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "بيزو كوبي قابل للتحويل", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "CU$", "بيزو كوبي", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "اسكودو الرأس الأخضر", PluralForms{"", "", "", "", "", ""}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "جنيه قبرصي", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "كرونة تشيكية", PluralForms{"", "", "", "", "", ""}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "أوستمارك ألماني شرقي", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "مارك ألماني", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "فرنك جيبوتي", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "كرونة دنماركية", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "DO$", "بيزو الدومنيكان", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "د.ج.\u200f", "د.ج.\u200f", "دينار جزائري", PluralForms{"دينار جزائري", "دينار جزائري", "ديناران جزائريان", "دينارات جزائرية", "دينارًا جزائريًا", "دينار جزائري"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "كرونة استونية", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "ج.م.\u200f", "E£", "جنيه مصري", PluralForms{"جنيه مصري", "جنيه مصري", "جنيهان مصريان", "جنيهات مصرية", "جنيهًا مصريًا", "جنيه مصري"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "ناكفا أريتري", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "بيزيتا إسباني", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "بير أثيوبي", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "يورو", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "ماركا فنلندي", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "FJ$", "دولار فيجي", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "جنيه جزر فوكلاند", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "فرنك فرنسي", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "UK£", "UK£", "جنيه إسترليني", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "لارى جورجي", PluralForms{"لاري جورجي", "لاري جورجي", "لاري جورجي", "لاري جورجي", "لاري جورجي", "لاري جورجي"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "سيدي غاني", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "سيدي غانا", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "جنيه جبل طارق", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "دلاسي غامبي", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "فرنك غينيا", PluralForms{"", "", "", "", "", ""}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "سيلي غينيا", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "اكويل جونينا غينيا الاستوائيّة", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "دراخما يوناني", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "كوتزال غواتيمالا", PluralForms{"", "", "", "", "", ""}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "اسكود برتغالي غينيا", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "بيزو غينيا بيساو", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "GY$", "دولار غيانا", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "HK$", "دولار هونغ كونغ", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "ليمبيرا هنداروس", PluralForms{"ليمبيرا هندوراس", "ليمبيرا هندوراس", "ليمبيرا هندوراس", "ليمبيرا هندوراس", "ليمبيرا هندوراس", "ليمبيرا هندوراس"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "دينار كرواتي", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "كونا كرواتي", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "جوردى هايتي", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "فورينت هنغاري", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "روبية إندونيسية", PluralForms{"", "", "", "", "", ""}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "جنيه إيرلندي", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "جنيه إسرائيلي", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "شيكل إسرائيلي جديد", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "روبية هندي", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "د.ع.\u200f", "د.ع.\u200f", "دينار عراقي", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "ر.إ.", "ر.إ.", "ريال إيراني", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "كرونة أيسلندية", PluralForms{"", "", "", "", "", ""}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "ليرة إيطالية", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "JM$", "دولار جامايكي", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "د.أ.\u200f", "د.أ.\u200f", "دينار أردني", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "JP¥", "ين ياباني", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "شلن كينيي", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "سوم قيرغستاني", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "رييال كمبودي", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "فرنك جزر القمر", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "وون كوريا الشمالية", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "وون كوريا الجنوبية", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "د.ك.\u200f", "د.ك.\u200f", "دينار كويتي", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "KY$", "دولار جزر كيمن", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "تينغ كازاخستاني", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "كيب لاوسي", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "ل.ل.\u200f", "L£", "جنيه لبناني", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "روبية سريلانكية", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$LR", "دولار ليبيري", PluralForms{"دولار ليبيري", "دولار ليبيري", "دولاران ليبيريان", "دولارات ليبيرية", "دولارًا ليبيريًا", "دولار ليبيري"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "لوتي ليسوتو", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "ليتا ليتوانية", PluralForms{"", "", "", "", "", ""}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "تالوناس ليتواني", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "فرنك لوكسمبرج قابل للتحويل", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "فرنك لوكسمبرج", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "فرنك لوكسمبرج المالي", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "لاتس لاتفيا", PluralForms{"لاتس لاتفي", "لاتس لاتفي", "لاتس لاتفي", "لاتس لاتفي", "لاتس لاتفي", "لاتس لاتفي"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "روبل لاتفيا", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "د.ل.\u200f", "د.ل.\u200f", "دينار ليبي", PluralForms{"دينار ليبي", "دينار ليبي", "ديناران ليبيان", "دينارات ليبية", "دينارًا ليبيًا", "دينار ليبي"}},
+		"MAD": {2, 0, 2, 1, "MAD", "د.م.\u200f", "د.م.\u200f", "درهم مغربي", PluralForms{"درهم مغربي", "درهم مغربي", "درهمان مغربيان", "دراهم مغربية", "درهمًا مغربيًا", "درهم مغربي"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "فرنك مغربي", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "ليو مولدوفي", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "أرياري مدغشقر", PluralForms{"", "", "", "", "", ""}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "فرنك مدغشقر", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "دينار مقدوني", PluralForms{"دينار مقدوني", "دينار مقدوني", "ديناران مقدونيان", "دينارات مقدونية", "دينارًا مقدونيًا", "دينار مقدوني"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "فرنك مالي", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "كيات ميانمار", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "توغروغ منغولي", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "باتاكا ماكاوي", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "أوقية موريتانية - 1973-2017", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "أ.م.", "أ.م.", "أوقية موريتانية", PluralForms{"", "", "", "", "", ""}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "ليرة مالطية", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "جنيه مالطي", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "روبية موريشيوسية", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "روفيه جزر المالديف", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "كواشا مالاوي", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "MX$", "بيزو مكسيكي", PluralForms{"", "", "", "", "", ""}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "بيزو فضي مكسيكي - 1861-1992", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "رينغيت ماليزي", PluralForms{"", "", "", "", "", ""}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "اسكود موزمبيقي", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "متكال موزمبيقي", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "دولار ناميبي", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "نايرا نيجيري", PluralForms{"", "", "", "", "", ""}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "كوردوبة نيكاراجوا", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "قرطبة نيكاراغوا", PluralForms{"", "", "", "", "", ""}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "جلدر هولندي", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "كرونة نرويجية", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "روبية نيبالي", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "NZ$", "دولار نيوزيلندي", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "ر.ع.\u200f", "ر.ع.\u200f", "ريال عماني", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "بالبوا بنمي", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "سول بيروفي", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "كينا بابوا غينيا الجديدة", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "بيزو فلبيني", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "روبية باكستاني", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "زلوتي بولندي", PluralForms{"", "", "", "", "", ""}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "زلوتي بولندي - 1950-1995", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "اسكود برتغالي", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "غواراني باراغواي", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "ر.ق.\u200f", "ر.ق.\u200f", "ريال قطري", PluralForms{"", "", "", "", "", ""}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "دولار روديسي", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "ليو روماني قديم", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "ليو روماني", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "دينار صربي", PluralForms{"دينار صربي", "دينار صربي", "ديناران صربيان", "دينارات صربية", "دينارًا صربيًا", "دينار صربي"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "روبل روسي", PluralForms{"", "", "", "", "", ""}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "روبل روسي - 1991-1998", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "فرنك رواندي", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "ر.س.\u200f", "ر.س.\u200f", "ريال سعودي", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "SB$", "دولار جزر سليمان", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "روبية سيشيلية", PluralForms{"", "", "", "", "", ""}},
+		"SDD": {2, 0, 2, 1, "SDD", "د.س.\u200f", "د.س.\u200f", "دينار سوداني", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "ج.س.", "ج.س.", "جنيه سوداني", PluralForms{"جنيه سوداني", "جنيه سوداني", "جنيه سوداني", "جنيهات سودانية", "جنيهًا سودانيًا", "جنيه سوداني"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "جنيه سوداني قديم", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "كرونة سويدية", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "دولار سنغافوري", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "جنيه سانت هيلين", PluralForms{"", "", "", "", "", ""}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "تولار سلوفيني", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "كرونة سلوفاكية", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "ليون سيراليوني", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "ليون سيراليوني - 1964-2022", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "شلن صومالي", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "SR$", "دولار سورينامي", PluralForms{"", "", "", "", "", ""}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "جلدر سورينامي", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "جنيه جنوب السودان", PluralForms{"جنيه جنوب السودان", "جنيه جنوب السودان", "جنيهان جنوب السودان", "جنيهات جنوب السودان", "جنيهًا جنوب السودان", "جنيه جنوب السودان"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "دوبرا ساو تومي وبرينسيبي - 1977-2017", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "دوبرا ساو تومي وبرينسيبي", PluralForms{"", "", "", "", "", ""}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "روبل سوفيتي", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "كولون سلفادوري", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "ل.س.\u200f", "£", "ليرة سورية", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "ليلانجيني سوازيلندي", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "باخت تايلاندي", PluralForms{"", "", "", "", "", ""}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "روبل طاجيكستاني", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "سوموني طاجيكستاني", PluralForms{"", "", "", "", "", ""}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "مانات تركمنستاني", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "مانات تركمانستان", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "د.ت.\u200f", "د.ت.\u200f", "دينار تونسي", PluralForms{"دينار تونسي", "دينار تونسي", "ديناران تونسيان", "دينارات تونسية", "دينارًا تونسيًا", "دينار تونسي"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "بانغا تونغا", PluralForms{"", "", "", "", "", ""}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "اسكود تيموري", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "ليرة تركي", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "ليرة تركية", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "TT$", "دولار ترينداد وتوباغو", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "دولار تايواني", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "شلن تنزاني", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "هريفنيا أوكراني", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "شلن أوغندي - 1966-1987", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "شلن أوغندي", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "US$", "دولار أمريكي", PluralForms{"", "", "", "", "", ""}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "دولار أمريكي (اليوم التالي)\u200f", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "دولار أمريكي (نفس اليوم)\u200f", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "بيزو أوروجواي - 1975-1993", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "UY$", "بيزو اوروغواي", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "سوم أوزبكستاني", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "بوليفار فنزويلي - 1871-2008", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "بوليفار فنزويلي - 2008–2018", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "بوليفار فنزويلي", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "دونج فيتنامي", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "فاتو فانواتو", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "تالا ساموا", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "فرنك وسط أفريقي", PluralForms{"", "", "", "", "", ""}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "فضة", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "ذهب", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "الوحدة الأوروبية المركبة", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "الوحدة المالية الأوروبية", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "الوحدة الحسابية الأوروبية", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "(XBD)وحدة الحساب الأوروبية", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "دولار شرق الكاريبي", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "حقوق السحب الخاصة", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "وحدة النقد الأوروبية", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "فرنك فرنسي ذهبي", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "(UIC)فرنك فرنسي", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "فرنك غرب أفريقي", PluralForms{"", "", "", "", "", ""}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "بالاديوم", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "فرنك سي إف بي", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "البلاتين", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "كود اختبار العملة", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "عملة غير معروفة", PluralForms{"(عملة غير معروفة)", "(عملة غير معروفة)", "(عملة غير معروفة)", "(عملة غير معروفة)", "(عملة غير معروفة)", "(عملة غير معروفة)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "دينار يمني", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "ر.ي.\u200f", "ر.ي.\u200f", "ريال يمني", PluralForms{"", "", "", "", "", ""}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "دينار يوغسلافي", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "دينار يوغسلافي قابل للتحويل", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "راند جنوب أفريقيا -مالي", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "راند جنوب أفريقيا", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "كواشا زامبي - 1968-2012", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "كواشا زامبي", PluralForms{"", "", "", "", "", ""}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "زائير زائيري جديد", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "زائير زائيري", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "دولار زمبابوي", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "دولار زمبابوي 2009", PluralForms{"", "", "", "", "", ""}},
 	},
 }
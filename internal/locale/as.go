@@ -6,175 +6,228 @@ var as = LocaleData{
 	NumberInfo{"beng",
 		[10]string{"০", "১", "২", "৩", "৪", "৫", "৬", "৭", "৮", "৯"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0হাজাৰ", "other": "0\u00a0হাজাৰ"}},
+				{4, 2, map[string]string{"one": "0\u00a0হাজাৰ", "other": "0\u00a0হাজাৰ"}},
+				{5, 1, map[string]string{"one": "0\u00a0লাখ", "other": "0\u00a0লাখ"}},
+				{6, 1, map[string]string{"one": "0\u00a0নিযুত", "other": "0\u00a0নিযুত"}},
+				{7, 2, map[string]string{"one": "0\u00a0নিযুত", "other": "0\u00a0নিযুত"}},
+				{8, 1, map[string]string{"one": "0\u00a0নিঃ", "other": "0\u00a0নিঃ"}},
+				{9, 1, map[string]string{"one": "0\u00a0শঃ\u00a0কোঃ", "other": "0\u00a0শঃ\u00a0কোঃ"}},
+				{10, 2, map[string]string{"one": "0\u00a0শঃ\u00a0কোঃ", "other": "0\u00a0শঃ\u00a0কোঃ"}},
+				{11, 3, map[string]string{"one": "0\u00a0শঃ\u00a0কঃ", "other": "0\u00a0শঃ\u00a0কঃ"}},
+				{12, 1, map[string]string{"one": "0\u00a0শঃ\u00a0পঃ", "other": "0\u00a0শঃ\u00a0পঃ"}},
+				{13, 2, map[string]string{"one": "0\u00a0শঃ\u00a0পঃ", "other": "0\u00a0শঃ\u00a0পঃ"}},
+				{14, 3, map[string]string{"one": "0\u00a0শঃ\u00a0পঃ", "other": "0\u00a0শঃ\u00a0পঃ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 হাজাৰ", "other": "0 হাজাৰ"}},
+				{4, 2, map[string]string{"one": "0 হাজাৰ", "other": "0 হাজাৰ"}},
+				{5, 1, map[string]string{"one": "0 লাখ", "other": "0 লাখ"}},
+				{6, 1, map[string]string{"one": "0 নিযুত", "other": "0 নিযুত"}},
+				{7, 2, map[string]string{"one": "0 নিযুত", "other": "0 নিযুত"}},
+				{8, 3, map[string]string{"one": "0 নিযুত", "other": "0 নিযুত"}},
+				{9, 1, map[string]string{"one": "0 শত কোটি", "other": "0 শত কোটি"}},
+				{10, 2, map[string]string{"one": "0 শত কোটি", "other": "0 শত কোটি"}},
+				{11, 3, map[string]string{"one": "0 শত কোটি", "other": "0 শত কোটি"}},
+				{12, 1, map[string]string{"one": "0 শত পৰাৰ্দ্ধ", "other": "0 শত পৰাৰ্দ্ধ"}},
+				{13, 2, map[string]string{"one": "0 শত পৰাৰ্দ্ধ", "other": "0 শত পৰাৰ্দ্ধ"}},
+				{14, 3, map[string]string{"one": "0 শত পৰাৰ্দ্ধ", "other": "0 শত পৰাৰ্দ্ধ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a00\u00a0হাজাৰ", "other": "¤\u00a00\u00a0হাজাৰ"}},
+				{4, 2, map[string]string{"one": "¤\u00a00\u00a0হাজাৰ", "other": "¤\u00a00\u00a0হাজাৰ"}},
+				{5, 3, map[string]string{"one": "¤\u00a00\u00a0লাখ", "other": "¤\u00a00\u00a0লাখ"}},
+				{6, 1, map[string]string{"one": "¤\u00a00\u00a0নিযুত", "other": "¤\u00a00\u00a0নিযুত"}},
+				{7, 2, map[string]string{"one": "¤\u00a00\u00a0নিযুত", "other": "¤\u00a00\u00a0নিযুত"}},
+				{8, 3, map[string]string{"one": "¤\u00a00\u00a0নিযুত", "other": "¤\u00a00\u00a0নিযুত"}},
+				{9, 1, map[string]string{"one": "¤\u00a00\u00a0শত\u00a0কোটি", "other": "¤\u00a00\u00a0শত\u00a0কোটি"}},
+				{10, 2, map[string]string{"one": "¤\u00a00\u00a0শত\u00a0কোটি", "other": "¤\u00a00\u00a0শত\u00a0কোটি"}},
+				{11, 3, map[string]string{"one": "¤\u00a00\u00a0শত\u00a0কোটি", "other": "¤\u00a00\u00a0শত\u00a0কোটি"}},
+				{12, 1, map[string]string{"one": "¤\u00a00\u00a0শত\u00a0পৰাৰ্দ্ধ", "other": "¤\u00a00\u00a0শত\u00a0পৰাৰ্দ্ধ"}},
+				{13, 2, map[string]string{"one": "¤\u00a00\u00a0শত\u00a0পৰাৰ্দ্ধ", "other": "¤\u00a00\u00a0শত\u00a0পৰাৰ্দ্ধ"}},
+				{14, 3, map[string]string{"one": "¤\u00a00\u00a0শত\u00a0পৰাৰ্দ্ধ", "other": "¤\u00a00\u00a0শত\u00a0পৰাৰ্দ্ধ"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "সংযুক্ত আৰব আমিৰাত ডিৰহেম", PluralForms{"", "UAE ডিৰহেম", "", "", "", "UAE ডিৰহেম"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "আফগান আফগানী", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "আলবেনীয় লেক", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "আৰ্মেনিয়ান ড্ৰাম", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "নেডাৰলেণ্ডছ এণ্টিলিয়েন গিল্ডাৰ", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "এংগোলান কোৱাঞ্জা", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "আৰ্জেণ্টাইন পেছো", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "অষ্ট্ৰেলিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "আৰুবান ফ্ল’ৰিন", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "আজেৰবাইজানী মানাত", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "ব’ছনিয়া আৰু হাৰ্জেগ’ভিনা কনভাৰ্টিব্\u200cল মাৰ্ক", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "বাৰ্বাডিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "বাংলাদেশী টাকা", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "বুলগেৰীয় লেভ", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "বাহৰেইনী ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "বুৰুণ্ডিয়ান ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "বাৰ্মুডান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ব্ৰুনেই ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "বলিভিয়ান বলিভিয়ানো", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ব্ৰাজিলিয়ান ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "বাহামিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ভুটানী নংগলট্ৰাম", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ব’টচোৱানান পুলা", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "বেলাৰুছীয় ৰুবেল", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "বেলিজ ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "কানাডিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "কংগো ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "চুইছ ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "চিলিয়ান পেছো", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "চীনা ইউৱান (অফশ্ব’ৰ)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "চীনা ইউৱান", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "কলম্বিয়ান পেছো", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "কোষ্টা ৰিকান কোলন", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "কিউবান ৰূপান্তৰযোগ্য পেছো", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "কিউবান পেছো", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "কেপ ভাৰ্দে এছকুডো", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "চেক কোৰুনা", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "জিবুটি ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ডেনিচ ক্ৰোন", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "ড’মিনিকান পেছো", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "আলজেৰীয় ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ইজিপ্তৰ পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "এৰিট্ৰিয়ন নাক্\u200cফা", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ইথিঅ’পিয়ান বিৰ", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "ইউৰো", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ফিজিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ফকলেণ্ড দ্বীপপুঞ্জৰ পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ব্ৰিটিছ পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "জৰ্জিয়ান লাৰি", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ঘানাৰ চেডি", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "জিব্ৰাল্টৰ পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "গাম্বিয়া ডালাছি", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "গিনি ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "গুৱাটেমালা কুৱেৎজাল", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "গায়ানিজ ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "হং কং ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "হোন্দুৰান লেম্পিৰা", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "ক্ৰোৱেছিয়ান কুনা", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "হাইটিয়ান গৌৰ্ড", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "হাংগেৰীয়ান ফ’ৰিণ্ট", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ইণ্ডোনেচিয়ান ৰুপিয়াহ", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ইজৰাইলী নিউ শ্বেকেল", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "ভাৰতীয় ৰুপী", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ইৰাকী ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ইৰানীয়ান ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "আইচলেণ্ডিক ক্ৰোনা", PluralForms{"", "আইচলেণ্ডিক ক্ৰোনা", "", "", "", "আইচলেণ্ডিক ক্ৰোনাৰ"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "জামাইকান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "জৰ্ডানিয়ান ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "জাপানী য়েন", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "কেনিয়ান শ্বিলিং", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "কিৰ্গিস্তানী ছোম", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "কেম্ব’ডিয়ান ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "ক’মোৰিয়ান ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "উত্তৰ কোৰিয়াৰ ওৱান", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "দক্ষিণ কোৰিয়াৰ ওৱান", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "কুৱেইটি ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "কেইমেন দ্বীপপুঞ্জৰ ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "কাজাখস্তানী তেঞ্জ", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "লাওচিয়ান কিপ", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "লেবানীজ পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "শ্ৰীলংকান ৰুপী", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "লাইবেৰিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "লেচোথো লোটি", PluralForms{"", "লেচোথো লোটি", "", "", "", "লেচোথো লোটিচ"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "লিবিয়ান ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "মৰোক্কান ডিৰহাম", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "মোলডোভান লেউ", PluralForms{"", "মোলডোভান লেউ", "", "", "", "মোলডোভান লেই"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "মালাগাছী এৰিয়াৰী", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "মেচিডোনীয় ডেনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "ম্যানমাৰ কিয়াট", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "মঙ্গোলিয়ান টুৰ্গিক", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "মেকানীজ পাটাকা", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ম’ৰিটেনিয়ান ঔগুইয়া (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ম’ৰিটেনিয়ান ঔগুইয়া", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "মৰিচিয়ান ৰুপী", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "মালডিভিয়ান ৰুফিয়া", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "মালাউইয়ান কোৱাচা", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "মেক্সিকান পেছো", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "মালায়েচিয়ান ৰিংগিট", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "মোজাম্বিকান মেটিকল", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "নামিবিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "নাইজেৰিয়ান নাইৰা", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "নিকাৰাগুৱান কোৰ্ডোবা", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "নৰৱেজিয়ান ক্ৰোন", PluralForms{"", "নৰৱেজিয়ান ক্ৰোন", "", "", "", "নৰৱেজিয়ান ক্ৰোনাৰ"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "নেপালী ৰুপী", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "নিউজিলেণ্ড ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ওমানি ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "পানামেনিয়ান বাল্বোৱা", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "পেৰুভিয়ান ছ’ল", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "পাপুৱা নিউ গিনি কিনা", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "ফিলিপিন পেইছ’", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "পাকিস্তানী ৰুপী", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "প’লিচ জোল্টী", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "পাৰাগুয়ান গুৱাৰানি", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "কাটাৰি ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "ৰোমানীয় লেউ", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "চাৰ্বিয়ান ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ৰাছিয়ান ৰুব্\u200cল", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ৰোৱান্দান ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "চৌডি ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "চোলোমোন দ্বীপপুঞ্জৰ ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "ছেচেলৱা ৰুপী", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "চুডানী পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "চুইডিছ ক্ৰোনা", PluralForms{"", "চুইডিছ ক্ৰোনা", "", "", "", "চুইডিছ ক্ৰোনোৰ"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "ছিংগাপুৰ ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "ছেইণ্ট হেলেনা পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "চিয়েৰা লিঅ’নৰ লিঅ’ন", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "চিয়েৰা লিঅ’নৰ লিঅ’ন (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "চোমালি শ্বিলিং", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "ছুৰিনামী ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "দক্ষিণ চুডানীজ পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "চাও টোমে আৰু প্ৰিনচিপে ডোব্\u200cৰা (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "চাও টোমে আৰু প্ৰিনচিপে ডোব্\u200cৰা", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "চিৰিয়ান পাউণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "স্বাজি লিলেংগেনি", PluralForms{"", "স্বাজি লিলেংগেনি", "", "", "", "স্বাজি এমেলানজেনি"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "থাই বাত", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "তাজিকিস্তানী ছোমনি", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "তুৰ্কমেনিস্তানী মানাত", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "টুনিচিয়ান ডিনাৰ", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "টংগান পাআংগা", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "তুৰ্কীৰ লিৰা", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ট্ৰিনিডাড আৰু টোবাগো ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "নিউ টাইৱান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "টানজানিয়ান শ্বিলিং", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ইউক্ৰেইনীয় হৃভনিয়া", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "উগাণ্ডান শ্বিলিং", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "ইউ. এছ. ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "উৰুগুৱেয়ান পেছো", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "উজবেকিস্তানী ছোম", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "ভেনিজুৱেলান বলিভাৰ (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "ভেনিজুৱেলান বলিভাৰ (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "ভেনিজুৱেলান বলিভাৰ", PluralForms{"", "ভেনিজুৱেলান ব’লিভাৰ", "", "", "", "ভেনিজুৱেলান ব’লিভাৰছ্"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "ভিয়েটনামীজ ডং", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "ভানাটুৰ ভাটু", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "ছামোৱান টালা", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "মধ্য আফ্ৰিকান CFA ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "ইষ্ট কেৰিবিয়ান ডলাৰ", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "পশ্চিম আফ্ৰিকান CFA ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP ফ্ৰেংক", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "অজ্ঞাত মুদ্ৰা", PluralForms{"", "(মুদ্ৰাৰ অজ্ঞাত একক)", "", "", "", "(অজ্ঞাত মুদ্ৰা)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "য়েমেনী ৰিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "দক্ষিণ আফ্ৰিকাৰ ৰাণ্ড", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "জাম্বিয়ান কোৱাচা", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,280 +6,333 @@ var az = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{9, 1, map[string]string{"one": "0\u00a0mlrd", "other": "0\u00a0mlrd"}},
+				{10, 2, map[string]string{"one": "0\u00a0mlrd", "other": "0\u00a0mlrd"}},
+				{11, 3, map[string]string{"one": "0\u00a0mlrd", "other": "0\u00a0mlrd"}},
+				{12, 1, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+				{13, 2, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+				{14, 3, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 min", "other": "0 min"}},
+				{4, 2, map[string]string{"one": "0 min", "other": "0 min"}},
+				{5, 3, map[string]string{"one": "0 min", "other": "0 min"}},
+				{6, 1, map[string]string{"one": "0 milyon", "other": "0 milyon"}},
+				{7, 2, map[string]string{"one": "0 milyon", "other": "0 milyon"}},
+				{8, 3, map[string]string{"one": "0 milyon", "other": "0 milyon"}},
+				{9, 1, map[string]string{"one": "0 milyard", "other": "0 milyard"}},
+				{10, 2, map[string]string{"one": "0 milyard", "other": "0 milyard"}},
+				{11, 3, map[string]string{"one": "0 milyard", "other": "0 milyard"}},
+				{12, 1, map[string]string{"one": "0 trilyon", "other": "0 trilyon"}},
+				{13, 2, map[string]string{"one": "0 trilyon", "other": "0 trilyon"}},
+				{14, 3, map[string]string{"one": "0 trilyon", "other": "0 trilyon"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0K\u00a0¤", "other": "0K\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0K\u00a0¤", "other": "0K\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0K\u00a0¤", "other": "0K\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0M\u00a0¤", "other": "0M\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0M\u00a0¤", "other": "0M\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0M\u00a0¤", "other": "0M\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0G\u00a0¤", "other": "0G\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0G\u00a0¤", "other": "0G\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0G\u00a0¤", "other": "0G\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0T\u00a0¤", "other": "0T\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0T\u00a0¤", "other": "0T\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0T\u00a0¤", "other": "0T\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "₼", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "ley"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "S£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andora Pesetası", PluralForms{"", "Andora pesetası", "", "", "", "Andora pesetası"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Birləşmiş Ərəb Əmirlikləri Dirhəmi", PluralForms{"", "BƏƏ dirhəmi", "", "", "", "BƏƏ dirhəmi"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Əfqanıstan Əfqanisi (1927–2002)", PluralForms{"", "Əfqanıstan əfqanisi (1927–2002)", "", "", "", "Əfqanıstan əfqanisi (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Əfqanıstan Əfqanisi", PluralForms{"", "Əfqanıstan əfqanisi", "", "", "", "Əfqanıstan əfqanisi"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albaniya Leki (1946–1965)", PluralForms{"", "Albaniya leki (1946–1965)", "", "", "", "Albaniya leki (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albaniya Leki", PluralForms{"", "Albaniya leki", "", "", "", "Albaniya leki"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Ermənistan Dramı", PluralForms{"", "Ermənistan dramı", "", "", "", "Ermənistan dramı"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Niderland Antilyası Gilderi", PluralForms{"", "Niderland Antilyası gilderi", "", "", "", "Niderland Antilya gilderi"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Anqola Kvanzası", PluralForms{"", "Anqola kvanzası", "", "", "", "Anqola kvanzası"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Anqola Kvanzasi (1977–1990)", PluralForms{"", "Anqola kvanzasi (1977–1990)", "", "", "", "Anqola kvanzasi (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Anqola Yeni Kvanzası (1990–2000)", PluralForms{"", "Anqola yeni kvanzası (1990–2000)", "", "", "", "Anqola yeni kvanzası (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Anqola Kvanzası (1995–1999)", PluralForms{"", "Anqola kvanzası (1995–1999)", "", "", "", "Anqola kvanzası (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentina avstralı", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentina pesosu (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentina Pesosu", PluralForms{"", "Argentina pesosu", "", "", "", "Argentina pesosu"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Avstriya Şillinqi", PluralForms{"", "Avstriya şillinqi", "", "", "", "Avstriya şillinqi"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Avstraliya Dolları", PluralForms{"", "Avstraliya dolları", "", "", "", "Avstraliya dolları"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruba Florini", PluralForms{"", "Aruba florini", "", "", "", "Aruba florini"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azərbaycan Manatı (1993–2006)", PluralForms{"", "Azərbaycan manatı (1993–2006)", "", "", "", "Azərbaycan manatı (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "₼", "₼", "Azərbaycan Manatı", PluralForms{"", "Azərbaycan manatı", "", "", "", "Azərbaycan manatı"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosniya-Herseqovina Dinarı", PluralForms{"", "Bosniya-Herseqovina dinarı", "", "", "", "Bosniya-Herseqovina dinarı"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bosniya-Herseqovina Markası", PluralForms{"", "Bosniya-Herseqovina markası", "", "", "", "Bosniya-Herseqovina markası"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbados Dolları", PluralForms{"", "Barbados dolları", "", "", "", "Barbados dolları"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Banqladeş Takası", PluralForms{"", "Banqladeş takası", "", "", "", "Banqladeş takası"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belçika Frankı (deyşirik)", PluralForms{"", "Belçika frankı (deyşirik)", "", "", "", "Belçika frankı (deyşirik)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belçika Frankı", PluralForms{"", "Belçika frankı", "", "", "", "Belçika frankı"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belçika Frankı (finans)", PluralForms{"", "Belçika frankı (finans)", "", "", "", "Belçika frankı (finans)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bolqarıstan Levası", PluralForms{"", "Bolqarıstan levası", "", "", "", "Bolqarıstan levası"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bolqarıstan Levi", PluralForms{"", "Bolqarıstan levi", "", "", "", "Bolqarıstan levi"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bəhreyn Dinarı", PluralForms{"", "Bəhreyn dinarı", "", "", "", "Bəhreyn dinarı"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundi Frankı", PluralForms{"", "Burundi frankı", "", "", "", "Burundi frankı"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermuda Dolları", PluralForms{"", "Bermuda dolları", "", "", "", "Bermuda dolları"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Bruney Dolları", PluralForms{"", "Bruney dolları", "", "", "", "Bruney dolları"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviya Bolivianosu", PluralForms{"", "Boliviya bolivianosu", "", "", "", "Boliviya bolivianosu"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Boliviya pesosu", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Boliviya mvdolı", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Braziliya kruzeyro novası", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Braziliya kruzadosu", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Braziliya kruzeyrosu (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Braziliya Realı", PluralForms{"", "Braziliya realı", "", "", "", "Braziliya realı"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Braziliya kruzado novası", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Braziliya kruzeyrosu", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Baham Dolları", PluralForms{"", "Baham dolları", "", "", "", "Baham dolları"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Butan Nqultrumu", PluralForms{"", "Butan nqultrumu", "", "", "", "Butan nqultrumu"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Burmis Kyatı", PluralForms{"", "Burmis kyatı", "", "", "", "Burmis kyatı"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botsvana Pulası", PluralForms{"", "Botsvana pulası", "", "", "", "Botsvana pulası"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Belarus Yeni Rublu (1994–1999)", PluralForms{"", "Belarus yeni rublu (1994–1999)", "", "", "", "Belarus yeni rublu (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Belarus Rublu", PluralForms{"", "Belarus rublu", "", "", "", "Belarus rublu"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Belarus Rublu (2000–2016)", PluralForms{"", "Belarus rublu (2000–2016)", "", "", "", "Belarus rublu (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Beliz Dolları", PluralForms{"", "Beliz dolları", "", "", "", "Beliz dolları"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Kanada Dolları", PluralForms{"", "Kanada dolları", "", "", "", "Kanada dolları"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Konqo Frankı", PluralForms{"", "Konqo frankı", "", "", "", "Konqo frankı"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR Avro", PluralForms{"", "WIR avro", "", "", "", "WIR avro"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "İsveçrə Frankı", PluralForms{"", "İsveçrə frankı", "", "", "", "İsveçrə frankı"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR Frankası", PluralForms{"", "WIR frankası", "", "", "", "WIR frankası"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Çili Pesosu", PluralForms{"", "Çili pesosu", "", "", "", "Çili pesosu"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Çin Yuanı (ofşor)", PluralForms{"", "Çin yuanı (ofşor)", "", "", "", "Çin yuanı (ofşor)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Çin Yuanı", PluralForms{"", "Çin yuanı", "", "", "", "Çin yuanı"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolumbiya Pesosu", PluralForms{"", "Kolombiya pesosu", "", "", "", "Kolombiya pesosu"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kosta Rika Kolonu", PluralForms{"", "Kosta Rika kolonu", "", "", "", "Kosta Rika kolonu"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbiya Dinarı (2002–2006)", PluralForms{"", "Serbiya dinarı (2002–2006)", "", "", "", "Serbiya dinarı (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Çexoslavakiya Korunası", PluralForms{"", "Çexoslavakiya korunası", "", "", "", "Çexoslavakiya korunası"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kuba Çevrilən Pesosu", PluralForms{"", "Kuba çevrilən pesosu", "", "", "", "Kuba çevrilən pesosu"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kuba Pesosu", PluralForms{"", "Kuba pesosu", "", "", "", "Kuba pesosu"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kape Verde Eskudosu", PluralForms{"", "Kape Verde eskudosu", "", "", "", "Kape Verde eskudosu"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kipr Paundu", PluralForms{"", "Kipr paundu", "", "", "", "Kipr paundu"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Çexiya Korunası", PluralForms{"", "Çexiya korunası", "", "", "", "Çexiya korunası"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Şərq Almaniya Ostmarkı", PluralForms{"", "Şərq Almaniya ostmarkı", "", "", "", "Şərq Almaniya ostmarkı"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Alman Markası", PluralForms{"", "Alman markası", "", "", "", "Alman markası"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Cibuti Frankı", PluralForms{"", "Cibuti frankı", "", "", "", "Cibuti frankı"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Danimarka Kronu", PluralForms{"", "Danimarka kronu", "", "", "", "Danimarka kronu"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominika Pesosu", PluralForms{"", "Dominika pesosu", "", "", "", "Dominika pesosu"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Əlcəzair Dinarı", PluralForms{"", "Əlcəzair dinarı", "", "", "", "Əlcəzair dinarı"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ekvador Sukresi", PluralForms{"", "Ekvador sukresi", "", "", "", "Ekvador sukresi"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estoniya Krunu", PluralForms{"", "Estoniya krunu", "", "", "", "Estoniya krunu"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Misir Funtu", PluralForms{"", "Misir funtu", "", "", "", "Misir funtu"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritreya Nakfası", PluralForms{"", "Eritreya nakfası", "", "", "", "Eritreya nakfası"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "İspan Pesetası (A account)", PluralForms{"", "İspan pesetası (A account)", "", "", "", "İspan pesetası (A account)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "İspan Pesetası (dəyşirik)", PluralForms{"", "İspan pesetası (dəyşirik)", "", "", "", "İspan pesetası (dəyşirik)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "İspan Pesetası", PluralForms{"", "İspan pesetası", "", "", "", "İspan pesetası"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Efiopiya Bırrı", PluralForms{"", "Efiopiya bırrı", "", "", "", "Efiopiya bırrı"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Avro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Fin Markası", PluralForms{"", "Fin markası", "", "", "", "Fin markası"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fici Dolları", PluralForms{"", "Fici dolları", "", "", "", "Fici dolları"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Folklend Adaları Funtu", PluralForms{"", "Folklend Adaları funtu", "", "", "", "Folklend Adaları funtu"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Fransız Markası", PluralForms{"", "Fransız markası", "", "", "", "Fransız markası"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Britaniya Funtu", PluralForms{"", "Britaniya funtu", "", "", "", "Britaniya funtu"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Gürcüstan Kupon Lariti", PluralForms{"", "Gürcüstan kupon lariti", "", "", "", "Gürcüstan kupon lariti"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gürcüstan Larisi", PluralForms{"", "Gürcüstan larisi", "", "", "", "Gürcüstan larisi"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Qana Sedisi (1979–2007)", PluralForms{"", "Qana sedisi (1979–2007)", "", "", "", "Qana sedisi (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Qana Sedisi", PluralForms{"", "Qana sedisi", "", "", "", "Qana sedisi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Cəbəli-Tariq Funtu", PluralForms{"", "Cəbəli-Tariq funtu", "", "", "", "Cəbəli-Tariq funtu"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Qambiya Dalasisi", PluralForms{"", "Qambiya dalasisi", "", "", "", "Qambiya dalasisi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Qvineya Frankı", PluralForms{"", "Qvineya frankı", "", "", "", "Qvineya frankı"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Qvineya Sulisi", PluralForms{"", "Qvineya sulisi", "", "", "", "Qvineya sulisi"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekvatoriya Gvineya Ekvele Quneanası", PluralForms{"", "Ekvatoriya Gvineya ekvele quneanası", "", "", "", "Ekvatoriya Gvineya ekvele quneanası"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Yunan Draçması", PluralForms{"", "Yunan draxması", "", "", "", "Yunan draxması"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Qvatemala Küetzalı", PluralForms{"", "Qvatemala küetzalı", "", "", "", "Qvatemala küetzalı"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugal Qvineya Eskudosu", PluralForms{"", "Portugal Qvineya eskudosu", "", "", "", "Portugal Qvineya eskudosu"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Qvineya-Bisau Pesosu", PluralForms{"", "Qvineya-Bisau pesosu", "", "", "", "Qvineya-Bisau pesosu"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Qayana Dolları", PluralForms{"", "Qayana dolları", "", "", "", "Qayana dolları"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Honq Konq Dolları", PluralForms{"", "Honq Konq dolları", "", "", "", "Honq Konq dolları"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Honduras Lempirası", PluralForms{"", "Honduras lempirası", "", "", "", "Honduras lempirası"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Xorvatiya Dinarı", PluralForms{"", "Xorvatiya dinarı", "", "", "", "Xorvatiya dinarı"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Xorvatiya Kunası", PluralForms{"", "Xorvatiya kunası", "", "", "", "Xorvatiya kunası"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haiti Qourdu", PluralForms{"", "Haiti qourdu", "", "", "", "Haiti qourdu"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Macarıstan Forinti", PluralForms{"", "Macarıstan forinti", "", "", "", "Macarıstan forinti"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "İndoneziya Rupisi", PluralForms{"", "İndoneziya rupisi", "", "", "", "İndoneziya rupisi"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "İrlandiya Paundu", PluralForms{"", "İrlandiya paundu", "", "", "", "İrlandiya paundu"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "İzrail Paundu", PluralForms{"", "İzrail paundu", "", "", "", "İzrail paundu"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "İsrail Şekeli (1980–1985)", PluralForms{"", "İsrail şekeli (1980–1985)", "", "", "", "İsrail şekeli (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "İsrail Yeni Şekeli", PluralForms{"", "İsrail yeni şekeli", "", "", "", "İsrail yeni şekeli"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Hindistan Rupisi", PluralForms{"", "Hindistan rupisi", "", "", "", "Hindistan rupisi"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "İraq Dinarı", PluralForms{"", "İraq dinarı", "", "", "", "İraq dinarı"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "İran Rialı", PluralForms{"", "İran rialı", "", "", "", "İran rialı"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "İslandiya Kronu (1918–1981)", PluralForms{"", "İslandiya kronu (1918–1981)", "", "", "", "İslandiya kronu (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "İslandiya Kronu", PluralForms{"", "İslandiya kronu", "", "", "", "İslandiya kronu"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "İtaliya Lirası", PluralForms{"", "İtaliya lirası", "", "", "", "İtaliya lirası"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Yamayka Dolları", PluralForms{"", "Yamayka dolları", "", "", "", "Yamayka dolları"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "İordaniya Dinarı", PluralForms{"", "İordaniya dinarı", "", "", "", "İordaniya dinarı"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yaponiya Yeni", PluralForms{"", "Yaponiya yeni", "", "", "", "Yaponiya yeni"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Keniya Şillinqi", PluralForms{"", "Keniya şillinqi", "", "", "", "Keniya şillinqi"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Qırğızıstan Somu", PluralForms{"", "Qırğızıstan somu", "", "", "", "Qırğızıstan somu"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kamboca Rieli", PluralForms{"", "Kamboca rieli", "", "", "", "Kamboca rieli"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komor Frankı", PluralForms{"", "Komor frankı", "", "", "", "Komor frankı"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Şimali Koreya Vonu", PluralForms{"", "Şimali Koreya vonu", "", "", "", "Şimali Koreya vonu"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Cənubi Koreya Vonu", PluralForms{"", "Cənubi Koreya vonu", "", "", "", "Cənubi Koreya vonu"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Küveyt Dinarı", PluralForms{"", "Küveyt dinarı", "", "", "", "Küveyt dinarı"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kayman Adaları Dolları", PluralForms{"", "Kayman Adaları dolları", "", "", "", "Kayman Adaları dolları"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Qazaxıstan Tengesi", PluralForms{"", "Qazaxıstan tengesi", "", "", "", "Qazaxıstan tengesi"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laos Kipi", PluralForms{"", "Laos kipi", "", "", "", "Laos kipi"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Livan Funtu", PluralForms{"", "Livan funtu", "", "", "", "Livan funtu"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Şri Lanka Rupisi", PluralForms{"", "Şri Lanka rupisi", "", "", "", "Şri Lanka rupisi"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberiya Dolları", PluralForms{"", "Liberiya dolları", "", "", "", "Liberiya dolları"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesoto Lotisi", PluralForms{"", "Lesoto lotisi", "", "", "", "Lesoto lotisi"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litva Liti", PluralForms{"", "Litva liti", "", "", "", "Litva liti"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Litva Talonası", PluralForms{"", "Litva talonası", "", "", "", "Litva talonası"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luksemburq Frankası (dəyişik)", PluralForms{"", "Luksemburq dəyişik frankası", "", "", "", "Luksemburq dəyişik frankası"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luksemburq Frankası", PluralForms{"", "Luksemburq frankası", "", "", "", "Luksemburq frankası"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luksemburq Frankası (finans)", PluralForms{"", "Luksemburq finans frankası", "", "", "", "Luksemburq finans frankası"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Latviya Latı", PluralForms{"", "Latviya latı", "", "", "", "Latviya latı"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latviya Rublu", PluralForms{"", "Latviya rublu", "", "", "", "Latviya rublu"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Liviya Dinarı", PluralForms{"", "Liviya dinarı", "", "", "", "Liviya dinarı"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Mərakeş Dirhəmi", PluralForms{"", "Mərakeş dirhəmi", "", "", "", "Mərakeş dirhəmi"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Mərakeş Frankası", PluralForms{"", "Mərakeş frankası", "", "", "", "Mərakeş frankası"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldova Leyi", PluralForms{"", "Moldova leyi", "", "", "", "Moldova leyi"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madaqaskar Ariarisi", PluralForms{"", "Madaqaskar ariarisi", "", "", "", "Madaqaskar ariarisi"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madaqaskar Frankası", PluralForms{"", "Madaqaskar frankası", "", "", "", "Madaqaskar frankası"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Makedoniya Dinarı", PluralForms{"", "Makedoniya dinarı", "", "", "", "Makedoniya dinarı"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Makedoniya Dinarı (1992–1993)", PluralForms{"", "Makedoniya dinarı (1992–1993)", "", "", "", "Makedoniya dinarı (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Mali Frankı", PluralForms{"", "Mali frankı", "", "", "", "Mali frankı"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Myanma Kiyatı", PluralForms{"", "Myanmar kiyatı", "", "", "", "Myanmar kiyatı"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Monqoliya Tuqriki", PluralForms{"", "Monqoliya tuqriki", "", "", "", "Monqoliya tuqriki"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makao Patakası", PluralForms{"", "Makao patakası", "", "", "", "Makao patakası"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mavritaniya Ugiyası (1973–2017)", PluralForms{"", "Mavritaniya ugiyası (1973–2017)", "", "", "", "Mavritaniya ugiyası (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mavritaniya Ugiyası", PluralForms{"", "Mavritaniya ugiyası", "", "", "", "Mavritaniya ugiyası"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltiz Paundu", PluralForms{"", "Maltiz paundu", "", "", "", "Maltiz paundu"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mavriki Rupisi", PluralForms{"", "Mavriki rupisi", "", "", "", "Mavriki rupisi"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldiv Rufiyası", PluralForms{"", "Maldiv rufiyası", "", "", "", "Maldiv rufiyası"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malavi Kvaçası", PluralForms{"", "Malavi kvaçası", "", "", "", "Malavi kvaçası"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Meksika Pesosu", PluralForms{"", "Meksika pesosu", "", "", "", "Meksika pesosu"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Meksika gümüş pesosu", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malayziya Ringiti", PluralForms{"", "Malayziya ringiti", "", "", "", "Malayziya ringiti"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambik Eskudosu", PluralForms{"", "Mozambik eskudosu", "", "", "", "Mozambik eskudosu"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambik Metikalı (1980–2006)", PluralForms{"", "Mozambik metikalı (1980–2006)", "", "", "", "Mozambik metikalı (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambik Metikalı", PluralForms{"", "Mozambik metikalı", "", "", "", "Mozambik metikalı"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibiya Dolları", PluralForms{"", "Namibiya dolları", "", "", "", "Namibiya dolları"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigeriya Nairası", PluralForms{"", "Nigeriya nairası", "", "", "", "Nigeriya nairası"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nikaraqua kordobu", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikaraqua Kordobası", PluralForms{"", "Nikaraqua kordobası", "", "", "", "Nikaraqua kordobası"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Hollandiya Gilderi", PluralForms{"", "Hollandiya gilderi", "", "", "", "Hollandiya gilderi"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norveç Kronu", PluralForms{"", "Norveç kronu", "", "", "", "Norveç kronu"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepal Rupisi", PluralForms{"", "Nepal rupisi", "", "", "", "Nepal rupisi"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Yeni Zelandiya Dolları", PluralForms{"", "Yeni Zelandiya dolları", "", "", "", "Yeni Zelandiya dolları"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Oman Rialı", PluralForms{"", "Oman rialı", "", "", "", "Oman rialı"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panama Balboası", PluralForms{"", "Panama balboası", "", "", "", "Panama balboası"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peru Inti", PluralForms{"", "Peru inti", "", "", "", "Peru inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peru Solu", PluralForms{"", "Peru solu", "", "", "", "Peru solu"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peru Solu (1863–1965)", PluralForms{"", "Peru solu (1863–1965)", "", "", "", "Peru solu (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua Yeni Qvineya Kinası", PluralForms{"", "Papua Yeni Qvineya kinası", "", "", "", "Papua Yeni Qvineya kinası"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filippin Pesosu", PluralForms{"", "Filippin pesosu", "", "", "", "Filippin pesosu"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistan Rupisi", PluralForms{"", "Pakistan rupisi", "", "", "", "Pakistan rupisi"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Polşa Zlotısı", PluralForms{"", "Polşa zlotısı", "", "", "", "Polşa zlotısı"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Polşa Zlotısı (1950–1995)", PluralForms{"", "Polşa zlotısı (1950–1995)", "", "", "", "Polşa zlotısı (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portuqal Eskudosu", PluralForms{"", "Portuqal eskudosu", "", "", "", "Portuqal eskudosu"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paraqvay Quaranisi", PluralForms{"", "Paraqvay quaranisi", "", "", "", "Paraqvay quaranisi"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Qatar Rialı", PluralForms{"", "Qatar rialı", "", "", "", "Qatar rialı"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rodezian Dolları", PluralForms{"", "Rodezian dolları", "", "", "", "Rodezian dolları"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumıniya Leyi (1952–2006)", PluralForms{"", "Rumıniya leyi (1952–2006)", "", "", "", "Rumıniya leyi (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "ley", "Rumıniya Leyi", PluralForms{"", "Rumıniya leyi", "", "", "", "Rumıniya leyi"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbiya Dinarı", PluralForms{"", "Serbiya dinarı", "", "", "", "Serbiya dinarı"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rusiya Rublu", PluralForms{"", "Rusiya rublu", "", "", "", "Rusiya rublu"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Rusiya Rublu (1991–1998)", PluralForms{"", "Rusiya rublu (1991–1998)", "", "", "", "Rusiya rublu (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ruanda Frankı", PluralForms{"", "Ruanda frankı", "", "", "", "Ruanda frankı"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Səudiyyə Riyalı", PluralForms{"", "Səudiyyə riyalı", "", "", "", "Səudiyyə riyalı"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Solomon Adaları Dolları", PluralForms{"", "Solomon Adaları dolları", "", "", "", "Solomon Adaları dolları"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seyşel Rupisi", PluralForms{"", "Seyşel rupisi", "", "", "", "Seyşel rupisi"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudan Funtu", PluralForms{"", "Sudan funtu", "", "", "", "Sudan funtu"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "İsveç Kronu", PluralForms{"", "İsveç kronu", "", "", "", "İsveç kronu"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Sinqapur Dolları", PluralForms{"", "Sinqapur dolları", "", "", "", "Sinqapur dolları"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Müqəddəs Yelena Funtu", PluralForms{"", "Müqəddəs Yelena funtu", "", "", "", "Müqəddəs Yelena funtu"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Sloveniya Toları", PluralForms{"", "Sloveniya toları", "", "", "", "Sloveniya toları"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovak Korunası", PluralForms{"", "Slovak korunası", "", "", "", "Slovak korunası"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sierra Leon Leonu", PluralForms{"", "Sierra Leon leonu", "", "", "", "Sierra Leon leonu"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sierra Leon Leonu (1964—2022)", PluralForms{"", "Sierra Leon leonu (1964—2022)", "", "", "", "Sierra Leon leonu (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somali Şillinqi", PluralForms{"", "Somali şillinqi", "", "", "", "Somali şillinqi"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surinam Dolları", PluralForms{"", "Surinam dolları", "", "", "", "Surinam dolları"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Cənubi Sudan Funtu", PluralForms{"", "Cənubi Sudan funtu", "", "", "", "Cənubi Sudan funtu"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "San Tom və Prinsip Dobrası (1977–2017)", PluralForms{"", "San Tom və Prinsip dobrası (1977–2017)", "", "", "", "San Tom və Prinsip dobrası (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "San Tom və Prinsip Dobrası", PluralForms{"", "San Tom və Prinsip dobrası", "", "", "", "San Tom və Prinsip dobrası"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Sovet Rublu", PluralForms{"", "Sovet rublu", "", "", "", "Sovet rublu"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "El Salvador kolonu", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "S£", "Suriya Funtu", PluralForms{"", "Suriya funtu", "", "", "", "Suriya funtu"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Svazilend Lilangenini", PluralForms{"", "Svazilend lilangenini", "", "", "", "Svazilend emalangenini"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Tayland Batı", PluralForms{"", "Tayland batı", "", "", "", "Tayland batı"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tacikistan Rublu", PluralForms{"", "Tacikistan rublu", "", "", "", "Tacikistan rublu"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tacikistan Somonisi", PluralForms{"", "Tacikistan somonisi", "", "", "", "Tacikistan somonisi"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Türkmənistan Manatı (1993–2009)", PluralForms{"", "Türkmənistan manatı (1993–2009)", "", "", "", "Türkmənistan manatı (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Türkmənistan Manatı", PluralForms{"", "Türkmənistan manatı", "", "", "", "Türkmənistan manatı"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunis Dinarı", PluralForms{"", "Tunis dinarı", "", "", "", "Tunis dinarı"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tonqa Panqası", PluralForms{"", "Tonqa panqası", "", "", "", "Tonqa panqası"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timor Eskudu", PluralForms{"", "Timor eskudu", "", "", "", "Timor eskudu"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Türkiyə Lirəsi (1922–2005)", PluralForms{"", "Türkiyə lirəsi (1922–2005)", "", "", "", "Türkiyə lirəsi (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Türkiyə Lirəsi", PluralForms{"", "Türkiyə lirəsi", "", "", "", "Türkiyə lirəsi"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidad və Tobaqo Dolları", PluralForms{"", "Trinidad və Tobaqo dolları", "", "", "", "Trinidad və Tobaqo dolları"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Tayvan Yeni Dolları", PluralForms{"", "Tayvan yeni dolları", "", "", "", "Tayvan yeni dolları"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzaniya Şillinqi", PluralForms{"", "Tanzaniya şillinqi", "", "", "", "Tanzaniya şillinqi"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrayna Qrivnası", PluralForms{"", "Ukrayna qrivnası", "", "", "", "Ukrayna qrivnası"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrayna Karbovenesası", PluralForms{"", "Ukrayna karbovenesası", "", "", "", "Ukrayna karbovenesası"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Uqanda Şillinqi (1966–1987)", PluralForms{"", "Uqanda şillinqi (1966–1987)", "", "", "", "Uqanda şillinqi (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Uqanda Şillinqi", PluralForms{"", "Uqanda şillinqi", "", "", "", "Uqanda şillinqi"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "ABŞ Dolları", PluralForms{"", "ABŞ dolları", "", "", "", "ABŞ dolları"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "ABŞ dolları (yeni gün)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "ABŞ dolları (həmin gün)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Uruqvay pesosu Unidades Indexadas", PluralForms{"", "Uruqvay pesosu unidades indexadas", "", "", "", "Uruqvay pesosu unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Uruqvay Pesosu (1975–1993)", PluralForms{"", "Uruqvay pesosu (1975–1993)", "", "", "", "Uruqvay pesosu (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Uruqvay Pesosu", PluralForms{"", "Uruqvay pesosu", "", "", "", "Uruqvay pesosu"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Özbəkistan Somu", PluralForms{"", "Özbəkistan somu", "", "", "", "Özbəkistan somu"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venesuela Bolivarı (1871–2008)", PluralForms{"", "Venesuela bolivarı (1871–2008)", "", "", "", "Venesuela bolivarı (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venesuela Bolivarı (2008–2018)", PluralForms{"", "Venesuela bolivarı (2008–2018)", "", "", "", "Venesuela bolivarı (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venesuela Bolivarı", PluralForms{"", "Venesuela bolivarı", "", "", "", "Venesuela bolivarı"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Vyetnam Donqu", PluralForms{"", "Vyetnam donqu", "", "", "", "Vyetnam donqu"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vyetnam Donqu (1978–1985)", PluralForms{"", "Vyetnam donqu (1978–1985)", "", "", "", "Vyetnam donqu (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu Vatusu", PluralForms{"", "Vanuatu vatusu", "", "", "", "Vanuatu vatusu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoa Talası", PluralForms{"", "Samoa talası", "", "", "", "Samoa talası"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Kamerun Frankı", PluralForms{"", "Kamerun frankı", "", "", "", "Kamerun frankı"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "gümüş", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "qızıl", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Şərqi Karib Dolları", PluralForms{"", "Şərqi Karib dolları", "", "", "", "Şərqi Karib dolları"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Fransız Gızıl Frankı", PluralForms{"", "Fransız gızıl frankı", "", "", "", "Fransız gızıl frankı"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Fransız UİC Frankı", PluralForms{"", "Fransız UİC frankı", "", "", "", "Fransız UİC frankı"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Fil Dişi Sahili Frankı", PluralForms{"", "Fil Dişi Sahili frankı", "", "", "", "Fil Dişi Sahili frankı"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Palladium", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Fransız Polineziyası Frankı", PluralForms{"", "Fransız Polineziyası frankı", "", "", "", "Fransız Polineziyası frankı"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platinum", PluralForms{"", "platinum", "", "", "", "platinum"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Naməlum Valyuta", PluralForms{"", "(naməlum valyuta vahidi)", "", "", "", "(naməlum valyuta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Yəmən Dinarı", PluralForms{"", "Yəmən dinarı", "", "", "", "Yəmən dinarı"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Yəmən Rialı", PluralForms{"", "Yəmən rialı", "", "", "", "Yəmən rialı"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Yuqoslaviya Dinarı (1966–1990)", PluralForms{"", "Yuqoslaviya dinarı (1966–1990)", "", "", "", "Yuqoslaviya dinarı (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Yuqoslaviya Yeni Dinarı (1994–2002)", PluralForms{"", "Yuqoslaviya yeni dinarı (1994–2002)", "", "", "", "Yuqoslaviya yeni dinarı (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Yuqoslaviya Dinarı (1990–1992)", PluralForms{"", "Yuqoslaviya dinarı (1990–1992)", "", "", "", "Yuqoslaviya dinarı (1990–1992)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Cənubi Afrika Randı (finans)", PluralForms{"", "Cənubi Afrika randı (finans)", "", "", "", "Cənubi Afrika randı (finans)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Cənubi Afrika Randı", PluralForms{"", "Cənubi Afrika randı", "", "", "", "Cənubi Afrika randı"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambiya Kvaçası (1968–2012)", PluralForms{"", "Zambiya kvaçası (1968–2012)", "", "", "", "Zambiya kvaçası (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambiya Kvaçası", PluralForms{"", "Zambiya kvaçası", "", "", "", "Zambiya kvaçası"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zair Yeni Zairi (1993–1998)", PluralForms{"", "Zair yeni zairi (1993–1998)", "", "", "", "Zair yeni zairi (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zair Zairi (1971–1993)", PluralForms{"", "Zair zairi (1971–1993)", "", "", "", "Zair zairi (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabve Dolları (1980–2008)", PluralForms{"", "Zimbabve dolları (1980–2008)", "", "", "", "Zimbabve dolları (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabve Dolları (2009)", PluralForms{"", "Zimbabve dolları (2009)", "", "", "", "Zimbabve dolları (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabve Dolları (2008)", PluralForms{"", "Zimbabve dolları (2008)", "", "", "", "Zimbabve dolları (2008)"}},
 	},
 }
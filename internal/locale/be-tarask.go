@@ -6,175 +6,228 @@ var betarask = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0тыс.", "other": "0\u00a0тыс."}},
+				{4, 2, map[string]string{"one": "0\u00a0тыс.", "other": "0\u00a0тыс."}},
+				{5, 3, map[string]string{"one": "0\u00a0тыс.", "other": "0\u00a0тыс."}},
+				{6, 1, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{7, 2, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{8, 3, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{9, 1, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{10, 2, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{11, 3, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{12, 1, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+				{13, 2, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+				{14, 3, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 тысячы", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячы"}},
+				{4, 2, map[string]string{"few": "0 тысячы", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячы"}},
+				{5, 3, map[string]string{"few": "0 тысячы", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячы"}},
+				{6, 1, map[string]string{"few": "0 мільёны", "many": "0 мільёнаў", "one": "0 мільён", "other": "0 мільёна"}},
+				{7, 2, map[string]string{"few": "0 мільёны", "many": "0 мільёнаў", "one": "0 мільён", "other": "0 мільёна"}},
+				{8, 3, map[string]string{"few": "0 мільёны", "many": "0 мільёнаў", "one": "0 мільён", "other": "0 мільёна"}},
+				{9, 1, map[string]string{"few": "0 мільярды", "many": "0 мільярдаў", "one": "0 мільярд", "other": "0 мільярда"}},
+				{10, 2, map[string]string{"few": "0 мільярды", "many": "0 мільярдаў", "one": "0 мільярд", "other": "0 мільярда"}},
+				{11, 3, map[string]string{"few": "0 мільярды", "many": "0 мільярдаў", "one": "0 мільярд", "other": "0 мільярда"}},
+				{12, 1, map[string]string{"few": "0 трыльёны", "many": "0 трыльёнаў", "one": "0 трыльён", "other": "0 трыльёна"}},
+				{13, 2, map[string]string{"few": "0 трыльёны", "many": "0 трыльёнаў", "one": "0 трыльён", "other": "0 трыльёна"}},
+				{14, 3, map[string]string{"few": "0 трыльёны", "many": "0 трыльёнаў", "one": "0 трыльён", "other": "0 трыльёна"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0тыс.\u00a0¤", "many": "0\u00a0тыс.\u00a0¤", "one": "0\u00a0тыс.\u00a0¤", "other": "0\u00a0тыс.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0тыс.\u00a0¤", "many": "0\u00a0тыс.\u00a0¤", "one": "0\u00a0тыс.\u00a0¤", "other": "0\u00a0тыс.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0тыс.\u00a0¤", "many": "0\u00a0тыс.\u00a0¤", "one": "0\u00a0тыс.\u00a0¤", "other": "0\u00a0тыс.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "A$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "Bds$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "BD$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BSD": {2, "BSD", "BSD", "B$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "Br", "Br"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "BZ$"},
-		"CAD": {2, "CAD", "CAD", "CA$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "CUC$"},
-		"CUP": {2, "CUP", "CUP", "$MN"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "RD$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "FJ$"},
-		"FKP": {2, "FKP", "FKP", "FK£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "G$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "Íkr"},
-		"JMD": {2, "JMD", "JMD", "J$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "CI$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "L$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "MX$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "N$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "NZ$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "₽", "руб."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "SI$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "S$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "TT$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$U"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "EC$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "дырхам ААЭ", PluralForms{"", "дырхам ААЭ", "", "дырхамы ААЭ", "дырхамаў ААЭ", "дырхама ААЭ"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "афганскі афгані", PluralForms{"", "афганскі афгані", "", "афганскія афгані", "афганскіх афгані", "афганскага афгані"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "албанскі лек", PluralForms{"", "албанскі лек", "", "албанскія лекі", "албанскіх лекаў", "албанскага лека"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "армянскі драм", PluralForms{"", "армянскі драм", "", "армянскія драмы", "армянскіх драмаў", "армянскага драма"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "нідэрландскі антыльскі гульдэн", PluralForms{"", "нідэрландскі антыльскі гульдэн", "", "нідэрландскія антыльскія гульдэны", "нідэрландскіх антыльскіх гульдэнаў", "нідэрландскага антыльскага гульдэна"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "ангольская кванза", PluralForms{"", "ангольская кванза", "", "ангольскія кванзы", "ангольскіх кванз", "ангольскай кванзы"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "аргенцінскае песа", PluralForms{"", "аргенцінскае песа", "", "аргенцінскія песа", "аргенцінскіх песа", "аргенцінскага песа"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "A$", "аўстралійскі долар", PluralForms{"", "аўстралійскі долар", "", "аўстралійскія долары", "аўстралійскіх долараў", "аўстралійскага долара"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "арубанскі фларын", PluralForms{"", "арубанскі фларын", "", "арубанскія фларыны", "арубанскіх фларынаў", "арубанскага фларына"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "азербайджанскі манат", PluralForms{"", "азербайджанскі манат", "", "азербайджанскія манаты", "азербайджанскіх манатаў", "азербайджанскага маната"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "канверсоўная марка Босніі і Герцагавіны", PluralForms{"", "канверсоўная марка Босніі і Герцагавіны", "", "канверсоўныя маркі Босніі і Герцагавіны", "канверсоўных марак Босніі і Герцагавіны", "канверсоўнай маркі Босніі і Герцагавіны"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "Bds$", "барбадаскі долар", PluralForms{"", "барбадаскі долар", "", "барбадаскія долары", "барбадаскіх долараў", "барбадаскага долара"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "бангладэшская така", PluralForms{"", "бангладэшская така", "", "бангладэшскія такі", "бангладэшскіх так", "бангладэшскай такі"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "балгарскі леў", PluralForms{"", "балгарскі леў", "", "балгарскія левы", "балгарскіх леваў", "балгарскага лева"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "бахрэйнскі дынар", PluralForms{"", "бахрэйнскі дынар", "", "бахрэйнскія дынары", "бахрэйнскі дынараў", "бахрэйнскага дынара"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "бурундзійскі франк", PluralForms{"", "бурундзійскі франк", "", "бурундзійскія франкі", "бурундзійскіх франкаў", "бурундзійскага франка"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "BD$", "бермудскі долар", PluralForms{"", "бермудскі долар", "", "бермудскія долары", "бермудскіх долараў", "бермудскага долара"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "брунейскі долар", PluralForms{"", "брунейскі долар", "", "брунейскія долары", "брунейскіх долараў", "брунейскага долара"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "балівіяна", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "бразільскі рэал", PluralForms{"", "бразільскі рэал", "", "бразільскія рэалы", "бразільскіх рэалаў", "бразільскага рэала"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "B$", "багамскі долар", PluralForms{"", "багамскі долар", "", "багамскія долары", "багамскіх долараў", "багамскага долара"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "бутанскі нгултрум", PluralForms{"", "бутанскі нгултрум", "", "бутанскія нгултрумы", "бутанскіх нгултрумаў", "бутанскага нгултрума"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "батсванская пула", PluralForms{"", "батсванская пула", "", "батсванскія пулы", "батсванскіх пул", "батсванскай пулы"}},
+		"BYN": {2, 0, 2, 1, "BYN", "Br", "Br", "беларускі рубель", PluralForms{"", "беларускі рубель", "", "беларускія рублі", "беларускіх рублёў", "беларускага рубля"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "беларускі рубель (2000–2016)", PluralForms{"", "беларускі рубель (2000–2016)", "", "беларускія рублі (2000–2016)", "беларускіх рублёў (2000–2016)", "беларускага рубля (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "BZ$", "белізскі долар", PluralForms{"", "белізскі долар", "", "белізскія долары", "белізскіх долараў", "белізскага долара"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "CA$", "канадскі долар", PluralForms{"", "канадскі долар", "", "канадскія долары", "канадскіх долараў", "канадскага долара"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "кангалезскі франк", PluralForms{"", "кангалезскі франк", "", "кангалезскія франкі", "кангалезскіх франкаў", "кангалезскага франка"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "швейцарскі франк", PluralForms{"", "швейцарскі франк", "", "швейцарскія франкі", "швейцарскіх франкаў", "швейцарскага франка"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "чылійскае песа", PluralForms{"", "чылійскае песа", "", "чылійскія песа", "чылійскіх песа", "чылійскага песа"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "афшорны кітайскі юань", PluralForms{"", "афшорны кітайскі юань", "", "афшорныя кітайскія юані", "афшорных кітайскіх юаняў", "афшорнага кітайскага юаня"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "кітайскі юань", PluralForms{"", "кітайскі юань", "", "кітайскія юані", "кітайскіх юаняў", "кітайскага юаня"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "калумбійскае песа", PluralForms{"", "калумбійскае песа", "", "калумбійскія песа", "калумбійскіх песа", "калумбійскага песа"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "коста-рыканскі калон", PluralForms{"", "коста-рыканскі калон", "", "коста-рыканскія калоны", "коста-рыканскіх калонаў", "коста-рыканскага калона"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "CUC$", "кубінскае канверсоўнае песа", PluralForms{"", "кубінскае канверсоўнае песа", "", "кубінскія канверсоўныя песа", "кубінскіх канверсоўных песа", "кубінскага канверсоўнага песа"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$MN", "кубінскае песа", PluralForms{"", "кубінскае песа", "", "кубінскія песа", "кубінскіх песа", "кубінскага песа"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "эскуда Каба-Вердэ", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "чэшская крона", PluralForms{"", "чэшская крона", "", "чэшскія кроны", "чэшскіх крон", "чэшскай кроны"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "джыбуційскі франк", PluralForms{"", "джыбуційскі франк", "", "джыбуційскія франкі", "джыбуційскіх франкаў", "джыбуційскага франка"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "дацкая крона", PluralForms{"", "дацкая крона", "", "дацкія кроны", "дацкіх крон", "дацкай кроны"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "RD$", "дамініканскае песа", PluralForms{"", "дамініканскае песа", "", "дамініканскія песа", "дамініканскіх песа", "дамініканскага песа"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "алжырскі дынар", PluralForms{"", "алжырскі дынар", "", "алжырскія дынары", "алжырскіх дынараў", "алжырскага дынара"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "егіпецкі фунт", PluralForms{"", "егіпецкі фунт", "", "егіпецкія фунты", "егіпецкіх фунтаў", "егіпецкага фунта"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "эрытрэйская накфа", PluralForms{"", "эрытрэйская накфа", "", "эрытрэйскія накфы", "эрытрэйскіх накфаў", "эрытрэйскай накфы"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "эфіопскі быр", PluralForms{"", "эфіопскі быр", "", "эфіопскія быры", "эфіопскіх быраў", "эфіопскага быра"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "еўра", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "FJ$", "фіджыйскі долар", PluralForms{"", "фіджыйскі долар", "", "фіджыйскія долары", "фіджыйскіх долараў", "фіджыйскага долара"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "FK£", "фунт Фалклендскіх астравоў", PluralForms{"", "фунт Фалклендскіх астравоў", "", "фунты Фалклендскіх астравоў", "фунтаў Фалклендскіх астравоў", "фунта Фалклендскіх астравоў"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "брытанскі фунт стэрлінгаў", PluralForms{"", "брытанскі фунт стэрлінгаў", "", "брытанскія фунты стэрлінгаў", "брытанскіх фунтаў стэрлінгаў", "брытанскага фунта стэрлінгаў"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "грузінскі лары", PluralForms{"", "грузінскі лары", "", "грузінскія лары", "грузінскіх лары", "грузінскага лары"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ганскі седзі", PluralForms{"", "ганскі седзі", "", "ганскія седзі", "ганскіх седзі", "ганскага седзі"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "гібралтарскі фунт", PluralForms{"", "гібралтарскі фунт", "", "гібралтарскія фунты", "гібралтарскіх фунтаў", "гібралтарскага фунта"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "гамбійскі даласі", PluralForms{"", "гамбійскі даласі", "", "гамбійскія даласі", "гамбійскіх даласі", "гамбійскага даласі"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "гвінейскі франк", PluralForms{"", "гвінейскі франк", "", "гвінейскія франкі", "гвінейскіх франкаў", "гвінейскага франка"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "гватэмальскі кетсаль", PluralForms{"", "гватэмальскі кетсаль", "", "гватэмальскія кетсалі", "гватэмальскіх кетсаляў", "гватэмальскага кетсаля"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "G$", "гаянскі долар", PluralForms{"", "гаянскі долар", "", "гаянскія долары", "гаянскіх долараў", "гаянскага долара"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "ганконгскі долар", PluralForms{"", "ганконгскі долар", "", "ганконгскія долары", "ганконгскіх долараў", "ганконгскага долара"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "гандураская лемпіра", PluralForms{"", "гандураская лемпіра", "", "гандураскія лемпіры", "гандураскіх лемпір", "гандураскай лемпіры"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "харвацкая куна", PluralForms{"", "харвацкая куна", "", "харвацкія куны", "харвацкіх кун", "харвацкай куны"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "гаіцянскі гурд", PluralForms{"", "гаіцянскі гурд", "", "гаіцянскія гурды", "гаіцянскіх гурдаў", "гаіцянскага гурда"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "венгерскі форынт", PluralForms{"", "венгерскі форынт", "", "венгерскія форынты", "венгерскіх форынтаў", "венгерскага форынта"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "інданезійская рупія", PluralForms{"", "інданезійская рупія", "", "інданезійскія рупіі", "інданезійскіх рупій", "інданезійскай рупіі"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "новы ізраільскі шэкель", PluralForms{"", "новы ізраільскі шэкель", "", "новыя ізраільскія шэкелі", "новых ізраільскіх шэкеляў", "новага ізраільскага шэкеля"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "індыйская рупія", PluralForms{"", "індыйская рупія", "", "індыйскія рупіі", "індыйскіх рупій", "індыйскай рупіі"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "іракскі дынар", PluralForms{"", "іракскі дынар", "", "іракскія дынары", "іракскіх дынараў", "іракскага дынара"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "іранскі рыял", PluralForms{"", "іранскі рыял", "", "іранскія рыялы", "іранскіх рыялаў", "іранскага рыяла"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "Íkr", "ісландская крона", PluralForms{"", "ісландская крона", "", "ісландскія кроны", "ісландскіх крон", "ісландскай кроны"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "J$", "ямайскі долар", PluralForms{"", "ямайскі долар", "", "ямайскія долары", "ямайскіх долараў", "ямайскага долара"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "іарданскі дынар", PluralForms{"", "іарданскі дынар", "", "іарданскія дынары", "іарданскіх дынараў", "іарданскага дынара"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "японская іена", PluralForms{"", "японская іена", "", "японскія іены", "японскіх іен", "японскай іены"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "кенійскі шылінг", PluralForms{"", "кенійскі шылінг", "", "кенійскія шылінгі", "кенійскіх шылінгаў", "кенійскага шылінга"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "кіргізскі сом", PluralForms{"", "кіргізскі сом", "", "кіргізскія сомы", "кіргізскіх сомаў", "кіргізскага сома"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "камбаджыйскі рыель", PluralForms{"", "камбаджыйскі рыель", "", "камбаджыйскія рыелі", "камбаджыйскіх рыеляў", "камбаджыйскага рыеля"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "каморскі франк", PluralForms{"", "каморскі франк", "", "каморскія франкі", "каморскіх франкаў", "каморскага франка"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "паўночнакарэйская вона", PluralForms{"", "паўночнакарэйская вона", "", "паўночнакарэйскія воны", "паўночнакарэйскіх вон", "паўночнакарэйскай воны"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "паўднёвакарэйская вона", PluralForms{"", "паўднёвакарэйская вона", "", "паўднёвакарэйскія воны", "паўднёвакарэйскіх вон", "паўднёвакарэйскай воны"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "кувейцкі дынар", PluralForms{"", "кувейцкі дынар", "", "кувейцкія дынары", "кувейцкіх дынараў", "кувейцкага дынара"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "CI$", "долар Кайманавых астравоў", PluralForms{"", "долар Кайманавых астравоў", "", "долары Кайманавых астравоў", "долараў Кайманавых астравоў", "долара Кайманавых астравоў"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "казахстанскі тэнге", PluralForms{"", "казахстанскі тэнге", "", "казахстанскія тэнге", "казахстанскіх тэнге", "казахстанскага тэнге"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "лаоскі кіп", PluralForms{"", "лаоскі кіп", "", "лаоскія кіпы", "лаоскіх кіпаў", "лаоскага кіпа"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ліванскі фунт", PluralForms{"", "ліванскі фунт", "", "ліванскія фунты", "ліванскіх фунтаў", "ліванскага фунта"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "шры-ланкійская рупія", PluralForms{"", "шры-ланкійская рупія", "", "шры-ланкійскія рупіі", "шры-ланкійскіх рупій", "шры-ланкійскай рупіі"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "L$", "ліберыйскі долар", PluralForms{"", "ліберыйскі долар", "", "ліберыйскія долары", "ліберыйскіх долараў", "ліберыйскага долара"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "лесоцкі лоці", PluralForms{"", "лесоцкі лоці", "", "лесоцкія лоці", "лесоцкіх лоці", "лесоцкага лоці"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "лівійскі дынар", PluralForms{"", "лівійскі дынар", "", "лівійскія дынары", "лівійскіх дынараў", "лівійскага дынара"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "мараканскі дырхам", PluralForms{"", "мараканскі дырхам", "", "мараканскія дырхамы", "мараканскіх дырхамаў", "мараканскага дырхама"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "малдаўскі лей", PluralForms{"", "малдаўскі лей", "", "малдаўскія леі", "малдаўскіх леяў", "малдаўскага лея"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "малагасійскі арыяры", PluralForms{"", "малагасійскі арыяры", "", "малагасійскія арыяры", "малагасійскіх арыяры", "малагасійскага арыяры"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "македонскі дэнар", PluralForms{"", "македонскі дэнар", "", "македонскія дэнары", "македонскіх дэнараў", "македонскага дэнара"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "м’янманскі к’ят", PluralForms{"", "м’янманскі к’ят", "", "м’янманскія к’яты", "м’янманскіх к’ятаў", "м’янманскага к’ята"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "мангольскі тугрык", PluralForms{"", "мангольскі тугрык", "", "мангольскія тугрыкі", "мангольскіх тугрыкаў", "мангольскага тугрыка"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "патака Макаа", PluralForms{"", "патака Макаа", "", "патакі Макаа", "патак Макаа", "патакі Макаа"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "маўрытанская ўгія (1973–2017)", PluralForms{"", "маўрытанская ўгія (1973–2017)", "", "маўрытанскія ўгіі (1973–2017)", "маўрытанскіх угій (1973–2017)", "маўрытанскай ўгіі (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "маўрытанская угія", PluralForms{"", "маўрытанская угія", "", "маўрытанскія угіі", "маўрытанскіх угій", "маўрытанскай угіі"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "маўрыкійская рупія", PluralForms{"", "маўрыкійская рупія", "", "маўрыкійскія рупіі", "маўрыкійскіх рупій", "маўрыкійскай рупіі"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "мальдыўская руфія", PluralForms{"", "мальдыўская руфія", "", "мальдыўскія руфіі", "мальдыўскіх руфій", "мальдыўскай руфіі"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "малавійская квача", PluralForms{"", "малавійская квача", "", "малавійскія квачы", "малавійскіх квач", "малавійскай квачы"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "MX$", "мексіканскае песа", PluralForms{"", "мексіканскае песа", "", "мексіканскія песа", "мексіканскіх песа", "мексіканскага песа"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "малайзійскі рынгіт", PluralForms{"", "малайзійскі рынгіт", "", "малайзійскія рынгіты", "малайзійскіх рынгітаў", "малайзійскага рынгіта"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "мазамбікскі метыкал", PluralForms{"", "мазамбікскі метыкал", "", "мазамбікскія метыкалы", "мазамбікскіх метыкалаў", "мазамбікскага метыкала"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "N$", "намібійскі долар", PluralForms{"", "намібійскі долар", "", "намібійскія долары", "намібійскіх долараў", "намібійскага долара"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "нігерыйская наіра", PluralForms{"", "нігерыйская наіра", "", "нігерыйскія наіры", "нігерыйскіх наір", "нігерыйскай наіры"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "нікарагуанская кордаба", PluralForms{"", "нікарагуанская кордаба", "", "нікарагуанскія кордабы", "нікарагуанскіх кордаб", "нікарагуанскай кордабы"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "нарвежская крона", PluralForms{"", "нарвежская крона", "", "нарвежскія кроны", "нарвежскіх крон", "нарвежскай кроны"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "непальская рупія", PluralForms{"", "непальская рупія", "", "непальскія рупіі", "непальскіх рупій", "непальскай рупіі"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "NZ$", "новазеландскі долар", PluralForms{"", "новазеландскі долар", "", "новазеландскія долары", "новазеландскіх долараў", "новазеландскага долара"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "аманскі рыял", PluralForms{"", "аманскі рыял", "", "аманскія рыялы", "аманскіх рыялаў", "аманскага рыяла"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "панамскае бальбоа", PluralForms{"", "панамскае бальбоа", "", "панамскія бальбоа", "панамскіх бальбоа", "панамскага бальбоа"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "перуанскі соль", PluralForms{"", "перуанскі соль", "", "перуанскія солі", "перуанскіх соляў", "перуанскага соля"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "кіна Папуа-Новай Гвінеі", PluralForms{"", "кіна Папуа-Новай Гвінеі", "", "кіны Папуа-Новай Гвінеі", "кін Папуа-Новай Гвінеі", "кіны Папуа-Новай Гвінеі"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "філіпінскае песа", PluralForms{"", "філіпінскае песа", "", "філіпінскія песа", "філіпінскіх песа", "філіпінскага песа"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "пакістанская рупія", PluralForms{"", "пакістанская рупія", "", "пакістанскія рупіі", "пакістанскіх рупій", "пакістанскай рупіі"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "польскі злоты", PluralForms{"", "польскі злоты", "", "польскія злотыя", "польскіх злотых", "польскага злотага"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "парагвайскі гуарані", PluralForms{"", "парагвайскі гуарані", "", "парагвайскія гуарані", "парагвайскіх гуарані", "парагвайскага гуарані"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "катарскі рыял", PluralForms{"", "катарскі рыял", "", "катарскія рыялы", "катарскіх рыялаў", "катарскага рыяла"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "румынскі лей", PluralForms{"", "румынскі лей", "", "румынскія леі", "румынскіх леяў", "румынскага лея"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "сербскі дынар", PluralForms{"", "сербскі дынар", "", "сербскія дынары", "сербскіх дынараў", "сербскага дынара"}},
+		"RUB": {2, 0, 2, 1, "RUB", "₽", "руб.", "расійскі рубель", PluralForms{"", "расійскі рубель", "", "расійскія рублі", "расійскіх рублёў", "расійскага рубля"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "руандыйскі франк", PluralForms{"", "руандыйскі франк", "", "руандыйскія франкі", "руандыйскіх франкаў", "руандыйскага франка"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "саудаўскі рыял", PluralForms{"", "саудаўскі рыял", "", "саудаўскія рыялы", "саудаўскіх рыялаў", "саудаўскага рыяла"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "SI$", "долар Саламонавых астравоў", PluralForms{"", "долар Саламонавых астравоў", "", "долар Саламонавых астравоў", "долараў Саламонавых астравоў", "долара Саламонавых астравоў"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "сейшэльская рупія", PluralForms{"", "сейшэльская рупія", "", "сейшэльскія рупіі", "сейшэльскіх рупій", "сейшэльскай рупіі"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "суданскі фунт", PluralForms{"", "суданскі фунт", "", "суданскія фунты", "суданскіх фунтаў", "суданскага фунта"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "шведская крона", PluralForms{"", "шведская крона", "", "шведскія кроны", "шведскіх крон", "шведскай кроны"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "S$", "сінгапурскі долар", PluralForms{"", "сінгапурскі долар", "", "сінгапурскія долары", "сінгапурскіх долараў", "сінгапурскага долара"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "фунт в-ва Святой Алены", PluralForms{"", "фунт в-ва Святой Алены", "", "фунты в-ва Святой Алены", "фунтаў в-ва Святой Алены", "фунта в-ва Святой Алены"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "сьера-леонскі леонэ", PluralForms{"", "сьера-леонскі леонэ", "", "сьера-леонскія леонэ", "сьера-леонскіх леонэ", "сьера-леонскага леонэ"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "сьера-леонскі леонэ (1964—2022)", PluralForms{"", "сьера-леонскі леонэ (1964—2022)", "", "сьера-леонскія леонэ (1964—2022)", "сьера-леонскіх леонэ (1964—2022)", "сьера-леонскага леонэ (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "самалійскі шылінг", PluralForms{"", "самалійскі шылінг", "", "самалійскія шылінгі", "самалійскіх шылінгаў", "самалійскага шылінга"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "сурынамскі долар", PluralForms{"", "сурынамскі долар", "", "сурынамскія долары", "сурынамскіх долараў", "сурынамскага долара"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "паўднёвасуданскі фунт", PluralForms{"", "паўднёвасуданскі фунт", "", "паўднёвасуданскія фунты", "паўднёвасуданскіх фунтаў", "паўднёвасуданскага фунта"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "добра Сан-Тамэ і Прынсіпі (1977–2017)", PluralForms{"", "добра Сан-Тамэ і Прынсіпі (1977–2017)", "", "добры Сан-Тамэ і Прынсіпі (1977–2017)", "добраў Сан-Тамэ і Прынсіпі (1977–2017)", "добры Сан-Тамэ і Прынсіпі (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "добра Сан-Тамэ і Прынсіпі", PluralForms{"", "добра Сан-Тамэ і Прынсіпі", "", "добры Сан-Тамэ і Прынсіпі", "добраў Сан-Тамэ і Прынсіпі", "добры Сан-Тамэ і Прынсіпі"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "сірыйскі фунт", PluralForms{"", "сірыйскі фунт", "", "сірыйскія фунты", "сірыйскіх фунтаў", "сірыйскага фунта"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "свазілендскі лілангені", PluralForms{"", "свазілендскі лілангені", "", "свазілендскія лілангені", "свазілендскіх лілангені", "свазілендскага лілангені"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "тайскі бат", PluralForms{"", "тайскі бат", "", "тайскія баты", "тайскіх батаў", "тайскага бата"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "таджыкскі самані", PluralForms{"", "таджыкскі самані", "", "таджыкскія самані", "таджыкскіх самані", "таджыкскага самані"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "туркменскі манат", PluralForms{"", "туркменскі манат", "", "туркменскія манаты", "туркменскіх манатаў", "туркменскага маната"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "туніскі дынар", PluralForms{"", "туніскі дынар", "", "туніскія дынары", "туніскіх дынараў", "туніскага дынара"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "танганская паанга", PluralForms{"", "танганская паанга", "", "танганскія паангі", "танганскіх паанг", "танганскай паангі"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "турэцкая ліра", PluralForms{"", "турэцкая ліра", "", "турэцкія ліры", "турэцкіх лір", "турэцкай ліры"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "TT$", "долар Трынідада і Табага", PluralForms{"", "долар Трынідада і Табага", "", "долары Трынідада і Табага", "долараў Трынідада і Табага", "долара Трынідада і Табага"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "новы тайваньскі долар", PluralForms{"", "новы тайваньскі долар", "", "новыя тайваньскія долары", "новых тайваньскіх долараў", "новага тайваньскага долара"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "танзанійскі шылінг", PluralForms{"", "танзанійскі шылінг", "", "танзанійскія шылінгі", "танзанійскіх шылінгаў", "танзанійскага шылінга"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "украінская грыўня", PluralForms{"", "украінская грыўня", "", "украінскія грыўні", "украінскіх грыўняў", "украінскай грыўні"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "угандыйскі шылінг", PluralForms{"", "угандыйскі шылінг", "", "угандыйскія шылінгі", "угандыйскіх шылінгаў", "угандыйскага шылінга"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "долар ЗША", PluralForms{"", "долар ЗША", "", "долары ЗША", "долараў ЗША", "долара ЗША"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$U", "уругвайскае песа", PluralForms{"", "уругвайскае песа", "", "уругвайскія песа", "уругвайскіх песа", "уругвайскага песа"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "узбекскі сум", PluralForms{"", "узбекскі сум", "", "узбекскія сумы", "узбекскіх сумаў", "узбекскага сума"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "венесуальскі балівар (2008–2018)", PluralForms{"", "венесуальскі балівар (2008–2018)", "", "венесуальскія балівары (2008–2018)", "венесуальскіх балівараў (2008–2018)", "венесуальскага балівара (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "венесуэльскі балівар", PluralForms{"", "венесуэльскі балівар", "", "венесуэльскія балівары", "венесуэльскіх балівараў", "венесуэльскага балівара"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "в’етнамскі донг", PluralForms{"", "в’етнамскі донг", "", "в’етнамскія донгі", "в’етнамскіх донгаў", "в’етнамскага донга"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "вануацкі вату", PluralForms{"", "вануацкі вату", "", "вануацкія вату", "вануацкіх вату", "вануацкага вату"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "самаанская тала", PluralForms{"", "самаанская тала", "", "самаанскія талы", "самаанскіх тал", "самаанскай талы"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "цэнтральнаафрыканскі франк КФА", PluralForms{"", "цэнтральнаафрыканскі франк КФА", "", "цэнтральнаафрыканскія франкі КФА", "цэнтральнаафрыканскіх франкаў КФА", "цэнтральнаафрыканскага франка КФА"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "EC$", "усходнекарыбскі долар", PluralForms{"", "усходнекарыбскі долар", "", "усходнекарыбскія долары", "усходнекарыбскіх долараў", "усходнекарыбскага долара"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "заходнеафрыканскі франк КФА", PluralForms{"", "заходнеафрыканскі франк КФА", "", "заходнеафрыканскія франкі КФА", "заходнеафрыканскіх франкаў КФА", "заходнеафрыканскага франка КФА"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "французскі ціхаакіянскі франк", PluralForms{"", "французскі ціхаакіянскі франк", "", "французскія ціхаакіянскія франкі", "французскіх ціхаакіянскіх франкаў", "французскага ціхаакіянскага франка"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "невядомая валюта", PluralForms{"", "невядомай валюты", "", "невядомай валюты", "невядомай валюты", "невядомай валюты"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "еменскі рыал", PluralForms{"", "еменскі рыял", "", "еменскія рыялы", "еменскіх рыялаў", "еменскага рыяла"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "паўднёваафрыканскі рэнд", PluralForms{"", "паўднёваафрыканскі рэнд", "", "паўднёваафрыканскія рэнды", "паўднёваафрыканскіх рэндаў", "паўднёваафрыканскага рэнда"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "замбійская квача", PluralForms{"", "замбійская квача", "", "замбійскія квачы", "замбійскіх квач", "замбійскай квачы"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
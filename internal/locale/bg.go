@@ -6,280 +6,333 @@ var bg = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0хил.", "other": "0\u00a0хил."}},
+				{4, 2, map[string]string{"one": "0\u00a0хил.", "other": "0\u00a0хил."}},
+				{5, 3, map[string]string{"one": "0\u00a0хил.", "other": "0\u00a0хил."}},
+				{6, 1, map[string]string{"one": "0\u00a0млн.", "other": "0\u00a0млн."}},
+				{7, 2, map[string]string{"one": "0\u00a0млн.", "other": "0\u00a0млн."}},
+				{8, 3, map[string]string{"one": "0\u00a0млн.", "other": "0\u00a0млн."}},
+				{9, 1, map[string]string{"one": "0\u00a0млрд.", "other": "0\u00a0млрд."}},
+				{10, 2, map[string]string{"one": "0\u00a0млрд.", "other": "0\u00a0млрд."}},
+				{11, 3, map[string]string{"one": "0\u00a0млрд.", "other": "0\u00a0млрд."}},
+				{12, 1, map[string]string{"one": "0\u00a0трлн.", "other": "0\u00a0трлн."}},
+				{13, 2, map[string]string{"one": "0\u00a0трлн.", "other": "0\u00a0трлн."}},
+				{14, 3, map[string]string{"one": "0\u00a0трлн.", "other": "0\u00a0трлн."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 хил.", "other": "0 хиляди"}},
+				{4, 2, map[string]string{"one": "0 хиляди", "other": "0 хиляди"}},
+				{5, 3, map[string]string{"one": "0 хиляди", "other": "0 хиляди"}},
+				{6, 1, map[string]string{"one": "0 милион", "other": "0 милиона"}},
+				{7, 2, map[string]string{"one": "0 милиона", "other": "0 милиона"}},
+				{8, 3, map[string]string{"one": "0 милиона", "other": "0 милиона"}},
+				{9, 1, map[string]string{"one": "0 милиард", "other": "0 милиарда"}},
+				{10, 2, map[string]string{"one": "0 милиарда", "other": "0 милиарда"}},
+				{11, 3, map[string]string{"one": "0 милиарда", "other": "0 милиарда"}},
+				{12, 1, map[string]string{"one": "0 трилион", "other": "0 трилиона"}},
+				{13, 2, map[string]string{"one": "0 трилиона", "other": "0 трилиона"}},
+				{14, 3, map[string]string{"one": "0 трилиона", "other": "0 трилиона"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0хил.\u00a0¤", "other": "0\u00a0хил.\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0хил.\u00a0¤", "other": "0\u00a0хил.\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0хил.\u00a0¤", "other": "0\u00a0хил.\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0млн.\u00a0¤", "other": "0\u00a0млн.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0млн.\u00a0¤", "other": "0\u00a0млн.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0млн.\u00a0¤", "other": "0\u00a0млн.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0млрд.\u00a0¤", "other": "0\u00a0млрд.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0млрд.\u00a0¤", "other": "0\u00a0млрд.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0млрд.\u00a0¤", "other": "0\u00a0млрд.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0трлн.\u00a0¤", "other": "0\u00a0трлн.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0трлн.\u00a0¤", "other": "0\u00a0трлн.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0трлн.\u00a0¤", "other": "0\u00a0трлн.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥ {0}", "≤ {0}", "{0} – {1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "Af"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "AMD"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "ARS"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "AUD"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "AZN"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "BBD"},
-		"BDT": {2, "BDT", "BDT", "BDT"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "лв.", "лв."},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "BMD"},
-		"BND": {2, "BND", "BND", "BND"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "BRL"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "BSD"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "BZD"},
-		"CAD": {2, "CAD", "CAD", "CAD"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "CLP"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "CNY"},
-		"COP": {0, "COP", "COP", "COP"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "CRC"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "CUP"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "DOP"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "FJD"},
-		"FKP": {2, "FKP", "FKP", "FKP"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GHS"},
-		"GIP": {2, "GIP", "GIP", "GIP"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "GYD"},
-		"HKD": {2, "HKD", "HKD", "HKD"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "ILS", "ILS"},
-		"INR": {2, "INR", "INR", "INR"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "JMD"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "KHR"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "KRW"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "KYD"},
-		"KZT": {2, "KZT", "KZT", "KZT"},
-		"LAK": {0, "LAK", "LAK", "LAK"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "LRD"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "MNT"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "MXN"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "NAD"},
-		"NGN": {2, "NGN", "NGN", "NGN"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "NZD"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "PHP"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "PYG"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "RON"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "SBD"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "SGD"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "SRD"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "SSP"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "TRY"},
-		"TTD": {2, "TTD", "TTD", "TTD"},
-		"TWD": {2, "TWD", "TWD", "TWD"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "UAH"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "щ.д.", "$"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "UYU"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "VND"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Андорска песета", PluralForms{"", "андорска песета", "", "", "", "андорски песети"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Дирхам на Обединените арабски емирства", PluralForms{"", "дирхам на Обединените арабски емирства", "", "", "", "дирхама на Обединените арабски емирства"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Афганистански афган (1927–2002)", PluralForms{"", "афганистански афган (1927–2002)", "", "", "", "афганистански афгана (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "Af", "Афганистански афган", PluralForms{"", "афганистански афган", "", "", "", "афганистански афгана"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Албански лек", PluralForms{"", "албански лек", "", "", "", "албански лека"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "AMD", "Арменски драм", PluralForms{"", "арменски драм", "", "", "", "арменски драма"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Антилски гулден", PluralForms{"", "антилски гулден", "", "", "", "антилски гулдена"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Анголска кванза", PluralForms{"", "анголска кванза", "", "", "", "анголски кванзи"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Анголска кванца (1977–1990)", PluralForms{"", "анголска кванца (1977–1991)", "", "", "", "анголски кванци (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Анголска нова кванца (1990–2000)", PluralForms{"", "анголска нова кванца (1990–2000)", "", "", "", "анголски нови кванци (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Анголска нова кванца (1995–1999)", PluralForms{"", "анголска нова кванца (1995–1999)", "", "", "", "анголски нови кванци (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Аржентински австрал", PluralForms{"", "аржентински австрал", "", "", "", "аржентински австрала"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Аржентинско песо (1983–1985)", PluralForms{"", "аржентинско песо (1983–1985)", "", "", "", "аржентински песо (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "ARS", "Аржентинско песо", PluralForms{"", "аржентинско песо", "", "", "", "аржентински песо"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Австрийски шилинг", PluralForms{"", "австрийски шилинг", "", "", "", "австрийски шилинга"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "AUD", "Австралийски долар", PluralForms{"", "австралийски долар", "", "", "", "австралийски долара"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Арубски флорин", PluralForms{"", "арубски флорин", "", "", "", "арубски флорина"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Азербайджански манат (1993–2006)", PluralForms{"", "азербайджански манат (1993–2006)", "", "", "", "азербайджански маната (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "AZN", "Азербайджански манат", PluralForms{"", "азербайджански манат", "", "", "", "азербайджански маната"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Босна и Херцеговина-динар", PluralForms{"", "Босна и Херцеговина-динар", "", "", "", "Босна и Херцеговина-динара"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Босненска конвертируема марка", PluralForms{"", "босненска конвертируема марка", "", "", "", "босненски конвертируеми марки"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "BBD", "Барбадоски долар", PluralForms{"", "барбадоски долар", "", "", "", "барбадоски долара"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "BDT", "Бангладешка така", PluralForms{"", "бангладешка така", "", "", "", "бангладешки таки"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Белгийски франк (конвертируем)", PluralForms{"", "белгийски франк (конвертируем)", "", "", "", "белгийски франка (конвертируеми)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Белгийски франк", PluralForms{"", "белгийски франк", "", "", "", "белгийски франка"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Белгийски франк (финансов)", PluralForms{"", "белгийски франк (финансов)", "", "", "", "белгийски франка (финансови)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Български конвертируем лев (1962–1999)", PluralForms{"", "български конвертируем лев", "", "", "", "български конвертируеми лева"}},
+		"BGN": {2, 0, 2, 1, "BGN", "лв.", "лв.", "Български лев", PluralForms{"", "български лев", "", "", "", "български лева"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Бахрейнски динар", PluralForms{"", "бахрейнски динар", "", "", "", "бахрейнски динара"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Бурундийски франк", PluralForms{"", "бурундийски франк", "", "", "", "бурундийски франка"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "BMD", "Бермудски долар", PluralForms{"", "бермудски долар", "", "", "", "бермудски долара"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "BND", "Брунейски долар", PluralForms{"", "брунейски долар", "", "", "", "брунейски долара"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Боливийско боливиано", PluralForms{"", "боливийско боливиано", "", "", "", "боливийски боливиано"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Боливийско песо", PluralForms{"", "боливийско песо", "", "", "", "боливийски песо"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Боливийски мвдол", PluralForms{"", "боливийски мвдол", "", "", "", "боливийски мвдол"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Бразилско ново крузейро (1967–1986)", PluralForms{"", "бразилско ново крузейро (1967–1986)", "", "", "", "бразилско ново крузейро (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Бразилско крозадо", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Бразилско крузейро (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "BRL", "Бразилски реал", PluralForms{"", "бразилски реал", "", "", "", "бразилски реала"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Бразилско ново крозадо", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Бразилско крузейро", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "BSD", "Бахамски долар", PluralForms{"", "бахамски долар", "", "", "", "бахамски долара"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Бутански нгултрум", PluralForms{"", "бутански нгултрум", "", "", "", "бутански нгултрума"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Бирмански киат", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Ботсванска пула", PluralForms{"", "ботсванска пула", "", "", "", "ботсвански пули"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Беларуска нова рубла (1994–1999)", PluralForms{"", "беларуска нова рубла (1994–1999)", "", "", "", "беларуски нови рубли (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Беларуска рубла", PluralForms{"", "беларуска рубла", "", "", "", "беларуски рубли"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Беларуска рубла (2000–2016)", PluralForms{"", "беларуска рубла (2000–2016)", "", "", "", "беларуски рубли (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "BZD", "Белизийски долар", PluralForms{"", "белизийски долар", "", "", "", "белизийски долара"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "CAD", "Канадски долар", PluralForms{"", "канадски долар", "", "", "", "канадски долара"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Конгоански франк", PluralForms{"", "конгоански франк", "", "", "", "конгоански франка"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR евро", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Швейцарски франк", PluralForms{"", "швейцарски франк", "", "", "", "швейцарски франка"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR франк", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Условна разчетна единица на Чили", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "CLP", "Чилийско песо", PluralForms{"", "чилийско песо", "", "", "", "чилийски песо"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Китайски юан (офшорен)", PluralForms{"", "китайски юан (офшорен)", "", "", "", "китайски юана (офшорни)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "CNY", "Китайски юан", PluralForms{"", "китайски юан", "", "", "", "китайски юана"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "COP", "Колумбийско песо", PluralForms{"", "колумбийско песо", "", "", "", "колумбийски песо"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Колумбийска единица на реалната стойност", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "CRC", "Костарикански колон", PluralForms{"", "костарикански колон", "", "", "", "костарикански колона"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Стар сръбски динар", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Чехословашка конвертируема крона", PluralForms{"", "чехословашка конвертируема крона", "", "", "", "чехословашки конвертируеми крони"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Кубинско конвертируемо песо", PluralForms{"", "кубинско конвертируемо песо", "", "", "", "кубински конвертируеми песо"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "CUP", "Кубинско песо", PluralForms{"", "кубинско песо", "", "", "", "кубински песо"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Ескудо на Кабо Верде", PluralForms{"", "ескудо на Кабо Верде", "", "", "", "ескудо на Кабо Верде"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Кипърска лира", PluralForms{"", "кипърска лира", "", "", "", "кипърски лири"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Чешка крона", PluralForms{"", "чешка крона", "", "", "", "чешки крони"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Източногерманска марка", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Германска марка", PluralForms{"", "германска марка", "", "", "", "германски марки"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Джибутски франк", PluralForms{"", "джибутски франк", "", "", "", "джибутски франка"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Датска крона", PluralForms{"", "датска крона", "", "", "", "датски крони"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "DOP", "Доминиканско песо", PluralForms{"", "доминиканско песо", "", "", "", "доминикански песо"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Алжирски динар", PluralForms{"", "алжирски динар", "", "", "", "алжирски динара"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Еквадорско сукре", PluralForms{"", "еквадорско сукре", "", "", "", "еквадорско сукре"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Еквадорска банкова единица", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Естонска крона", PluralForms{"", "естонска крона", "", "", "", "естонски крони"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Египетска лира", PluralForms{"", "египетска лира", "", "", "", "египетски лири"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Еритрейска накфа", PluralForms{"", "еритрейска накфа", "", "", "", "еритрейски накфи"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Испанска песета", PluralForms{"", "испанска песета", "", "", "", "испански песети"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Етиопски бир", PluralForms{"", "етиопски бир", "", "", "", "етиопски бира"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Евро", PluralForms{"", "евро", "", "", "", "евро"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Финландска марка", PluralForms{"", "финландска марка", "", "", "", "финландски марки"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "FJD", "Фиджийски долар", PluralForms{"", "фиджийски долар", "", "", "", "фиджийски долара"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "FKP", "Фолкландска лира", PluralForms{"", "фолкландска лира", "", "", "", "фолкландски лири"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Френски франк", PluralForms{"", "френски франк", "", "", "", "френски франка"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "Британска лира", PluralForms{"", "британска лира", "", "", "", "британски лири"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Грузински купон", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Грузински лари", PluralForms{"", "грузински лари", "", "", "", "грузински лари"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ганайско седи (1979–2007)", PluralForms{"", "ганайско седи (1979–2007)", "", "", "", "ганайски седи (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GHS", "Ганайско седи", PluralForms{"", "ганайско седи", "", "", "", "ганайски седи"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "GIP", "Гибралтарска лира", PluralForms{"", "гибралтарска лира", "", "", "", "гибралтарски лири"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Гамбийско даласи", PluralForms{"", "гамбийско даласи", "", "", "", "гамбийски даласи"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Гвинейски франк", PluralForms{"", "гвинейски франк", "", "", "", "гвинейски франка"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Гвинейска сили", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Екваториално гвинейско еквеле", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Гръцка драхма", PluralForms{"", "гръцка драхма", "", "", "", "гръцки драхми"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Гватемалски кетцал", PluralForms{"", "гватемалски кетцал", "", "", "", "гватемалски кетцала"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Ескудо от Португалска Гвинея", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Гвинея-Бисау песо", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "GYD", "Гаянски долар", PluralForms{"", "гаянски долар", "", "", "", "гаянски долара"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "HKD", "Хонконгски долар", PluralForms{"", "хонконгски долар", "", "", "", "хонконгски долара"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Хондураска лемпира", PluralForms{"", "хондураска лемпира", "", "", "", "хондураски лемпири"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Хърватски динар", PluralForms{"", "хърватски динар", "", "", "", "хърватски динара"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Хърватска куна", PluralForms{"", "хърватска куна", "", "", "", "хърватски куни"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Хаитски гурд", PluralForms{"", "хаитски гурд", "", "", "", "хаитски гурда"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Унгарски форинт", PluralForms{"", "унгарски форинт", "", "", "", "унгарски форинта"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Индонезийска рупия", PluralForms{"", "индонезийска рупия", "", "", "", "индонезийски рупии"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Ирландска лира", PluralForms{"", "ирландска лира", "", "", "", "ирландски лири"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Израелска лира", PluralForms{"", "израелска лира", "", "", "", "израелски лири"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "ILS", "Израелски нов шекел", PluralForms{"", "израелски нов шекел", "", "", "", "израелски нови шекела"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "INR", "Индийска рупия", PluralForms{"", "индийска рупия", "", "", "", "индийски рупии"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Иракски динар", PluralForms{"", "иракски динар", "", "", "", "иракски динара"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Ирански риал", PluralForms{"", "ирански риал", "", "", "", "ирански риала"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Исландска крона", PluralForms{"", "исландска крона", "", "", "", "исландски крони"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Италианска лира", PluralForms{"", "италианска лира", "", "", "", "италиански лири"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "JMD", "Ямайски долар", PluralForms{"", "ямайски долар", "", "", "", "ямайски долара"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Йордански динар", PluralForms{"", "йордански динар", "", "", "", "йордански динара"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "Японска йена", PluralForms{"", "японска йена", "", "", "", "японски йени"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Кенийски шилинг", PluralForms{"", "кенийски шилинг", "", "", "", "кенийски шилинга"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Киргизстански сом", PluralForms{"", "киргизстански сом", "", "", "", "киргизстански сома"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "KHR", "Камбоджански риел", PluralForms{"", "камбоджански риел", "", "", "", "камбоджански риела"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Коморски франк", PluralForms{"", "коморски франк", "", "", "", "коморски франка"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Севернокорейски вон", PluralForms{"", "севернокорейски вон", "", "", "", "севернокорейски вона"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "KRW", "Южнокорейски вон", PluralForms{"", "южнокорейски вон", "", "", "", "южнокорейски вона"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Кувейтски динар", PluralForms{"", "кувейтски динар", "", "", "", "кувейтски динара"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "KYD", "Кайманов долар", PluralForms{"", "кайманов долар", "", "", "", "кайманови долара"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "KZT", "Казахстанско тенге", PluralForms{"", "казахстанско тенге", "", "", "", "казахстански тенге"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "LAK", "Лаоски кип", PluralForms{"", "лаоски кип", "", "", "", "лаоски кипа"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Ливанска лира", PluralForms{"", "ливанска лира", "", "", "", "ливански лири"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Шриланкска рупия", PluralForms{"", "шриланкска рупия", "", "", "", "шриланкски рупии"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "LRD", "Либерийски долар", PluralForms{"", "либерийски долар", "", "", "", "либерийски долара"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Лесотско лоти", PluralForms{"", "лесотско лоти", "", "", "", "лесотски лоти"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Литовски литас", PluralForms{"", "литовски литас", "", "", "", "литовски литаса"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Литовски талон", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Люксембургски франк", PluralForms{"", "люксембургски франк", "", "", "", "люксембургски франка"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Латвийски лат", PluralForms{"", "латвийски лат", "", "", "", "латвийски лата"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Латвийска рубла", PluralForms{"", "латвийска рубла", "", "", "", "латвийски рубли"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Либийски динар", PluralForms{"", "либийски динар", "", "", "", "либийски динара"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Марокански дирхам", PluralForms{"", "марокански дирхам", "", "", "", "марокански дирхама"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Марокански франк", PluralForms{"", "марокански франк", "", "", "", "марокански франка"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Молдовска лея", PluralForms{"", "молдовска лея", "", "", "", "молдовски леи"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Малгашко ариари", PluralForms{"", "малгашко ариари", "", "", "", "малгашки ариари"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Малгашки франк - Мадагаскар", PluralForms{"", "малгашки франк - Мадагаскар", "", "", "", "малгашки франка - Мадагаскар"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Македонски денар", PluralForms{"", "македонски денар", "", "", "", "македонски денара"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Малийски франк", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Мианмарски киат", PluralForms{"", "мианмарски киат", "", "", "", "мианмарски киата"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "MNT", "Монголски тугрик", PluralForms{"", "монголски тугрик", "", "", "", "монголски тугрика"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Патака на Макао", PluralForms{"", "патака на Макао", "", "", "", "патаки на Макао"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Мавританска угия (1973–2017)", PluralForms{"", "мавританска угия (1973–2017)", "", "", "", "мавритански угии (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Мавританска угия", PluralForms{"", "мавританска угия", "", "", "", "мавритански угии"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Малтийска лира", PluralForms{"", "малтийска лира", "", "", "", "малтийски лири"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Маврицийска рупия", PluralForms{"", "маврицийска рупия", "", "", "", "маврицийски рупии"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Малдивска руфия", PluralForms{"", "малдивска руфия", "", "", "", "малдивски руфии"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Малавийска куача", PluralForms{"", "малавийска куача", "", "", "", "малавийски куачи"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "MXN", "Мексиканско песо", PluralForms{"", "мексиканско песо", "", "", "", "мексикански песо"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Мексиканско сребърно песо (1861–1992)", PluralForms{"", "мексиканско сребърно песо (1861–1992)", "", "", "", "мексикански сребърни песо (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Мексиканска конвертируема единица (UDI)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Малайзийски рингит", PluralForms{"", "малайзийски рингит", "", "", "", "малайзийски рингита"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Мозамбикско ескудо", PluralForms{"", "мозамбикско ескудо", "", "", "", "мозамбикски ескудо"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Мозамбикски метикал (1980–2006)", PluralForms{"", "мозамбикски метикал (1980–2006)", "", "", "", "мозамбикски метикала (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Мозамбикски метикал", PluralForms{"", "мозамбикски метикал", "", "", "", "мозамбикски метикала"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "NAD", "Намибийски долар", PluralForms{"", "намибийски долар", "", "", "", "намибийски долара"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "NGN", "Нигерийска найра", PluralForms{"", "нигерийска найра", "", "", "", "нигерийски найри"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Никарагуанска кордоба (1988–1991)", PluralForms{"", "никарагуанска кордоба (1988–1991)", "", "", "", "никарагуански кордоби (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Никарагуанска кордоба", PluralForms{"", "никарагуанска кордоба", "", "", "", "никарагуански кордоби"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Холандски гулден", PluralForms{"", "холандски гулден", "", "", "", "холандски гулдена"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Норвежка крона", PluralForms{"", "норвежка крона", "", "", "", "норвежки крони"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Непалска рупия", PluralForms{"", "непалска рупия", "", "", "", "непалски рупии"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "NZD", "Новозеландски долар", PluralForms{"", "новозеландски долар", "", "", "", "новозеландски долара"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Омански риал", PluralForms{"", "омански риал", "", "", "", "омански риала"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Панамска балбоа", PluralForms{"", "панамска балбоа", "", "", "", "панамски балбоа"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Перуанско инти", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Перуански сол", PluralForms{"", "перуански сол", "", "", "", "перуански сола"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Перуански сол (1863–1965)", PluralForms{"", "перуански сол (1863–1965)", "", "", "", "перуански сол (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Папуа-новогвинейска кина", PluralForms{"", "папуа-новогвинейска кина", "", "", "", "папуа-новогвинейски кини"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "PHP", "Филипинско песо", PluralForms{"", "филипинско песо", "", "", "", "филипински песо"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Пакистанска рупия", PluralForms{"", "пакистанска рупия", "", "", "", "пакистански рупии"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Полска злота", PluralForms{"", "полска злота", "", "", "", "полски злоти"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Полска злота (1950–1995)", PluralForms{"", "полска злота (1950–1995)", "", "", "", "полски злоти (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Португалско ескудо", PluralForms{"", "португалско ескудо", "", "", "", "португалски ескудо"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "PYG", "Парагвайско гуарани", PluralForms{"", "парагвайско гуарани", "", "", "", "парагвайски гуарани"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Катарски риал", PluralForms{"", "катарски риал", "", "", "", "катарски риала"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Родезийски долар", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Стара румънска лея", PluralForms{"", "стара румънска лея", "", "", "", "стари румънски леи"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "RON", "Румънска лея", PluralForms{"", "румънска лея", "", "", "", "румънски леи"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Сръбски динар", PluralForms{"", "сръбски динар", "", "", "", "сръбски динара"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Руска рубла", PluralForms{"", "руска рубла", "", "", "", "руски рубли"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Руска рубла (1991–1998)", PluralForms{"", "руска рубла (1991–1998)", "", "", "", "руски рубли (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Руандски франк", PluralForms{"", "руандски франк", "", "", "", "руандски франка"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "саудитски риал", PluralForms{"", "саудитски риал", "", "", "", "саудитски риала"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "SBD", "Долар на Соломоновите острови", PluralForms{"", "долар на Соломоновите острови", "", "", "", "долара на Соломоновите острови"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Сейшелска рупия", PluralForms{"", "сейшелска рупия", "", "", "", "сейшелски рупии"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Судански динар", PluralForms{"", "судански динар", "", "", "", "судански динара"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Суданска лира", PluralForms{"", "суданска лира", "", "", "", "судански лири"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Шведска крона", PluralForms{"", "шведска крона", "", "", "", "шведски крони"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "SGD", "Сингапурски долар", PluralForms{"", "сингапурски долар", "", "", "", "сингапурски долара"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Лира на Света Елена", PluralForms{"", "лира на Света Елена", "", "", "", "лири на Света Елена"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Словенски толар", PluralForms{"", "словенски толар", "", "", "", "словенски толара"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Словашка крона", PluralForms{"", "словашка крона", "", "", "", "словашки крони"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Сиералеонско леоне", PluralForms{"", "сиералеонско леоне", "", "", "", "сиералеонски леоне"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Сиералеонско леоне (1964 – 2022)", PluralForms{"", "сиералеонско леоне (1964 – 2022)", "", "", "", "сиералеонски леоне (1964 – 2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Сомалийски шилинг", PluralForms{"", "сомалийски шилинг", "", "", "", "сомалийски шилинга"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "SRD", "Суринамски долар", PluralForms{"", "суринамски долар", "", "", "", "суринамски долара"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Суринамски гилдер", PluralForms{"", "суринамски гилдер", "", "", "", "суринамски гилдера"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "SSP", "Южносуданска лира", PluralForms{"", "южносуданска лира", "", "", "", "южносудански лири"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Добра на Сао Томе и Принсипи (1977–2017)", PluralForms{"", "добра на Сао Томе и Принсипи (1977–2017)", "", "", "", "добра на Сао Томе и Принсипи (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Добра на Сао Томе и Принсипи", PluralForms{"", "добра на Сао Томе и Принсипи", "", "", "", "добра на Сао Томе и Принсипи"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Съветска рубла", PluralForms{"", "съветска рубла", "", "", "", "съветски рубли"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Салвадорски колон", PluralForms{"", "салвадорски колон", "", "", "", "салвадорски колона"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Сирийска лира", PluralForms{"", "сирийска лира", "", "", "", "сирийски лири"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Свазилендски лилангени", PluralForms{"", "свазилендски лилангени", "", "", "", "свазилендски лилангени"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Тайландски бат", PluralForms{"", "тайландски бат", "", "", "", "тайландски бата"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Таджикистанска рубла", PluralForms{"", "таджикистанска рубла", "", "", "", "таджикистански рубли"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Таджикистански сомони", PluralForms{"", "таджикистански сомони", "", "", "", "таджикистански сомони"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Туркменистански манат", PluralForms{"", "туркменистански манат", "", "", "", "туркменистански маната"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Туркменски манат", PluralForms{"", "туркменски манат", "", "", "", "туркменски маната"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Тунизийски динар", PluralForms{"", "тунизийски динар", "", "", "", "тунизийски динара"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Тонганска паанга", PluralForms{"", "тонганска паанга", "", "", "", "тонгански паанги"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Тиморско ескудо", PluralForms{"", "тиморско ескудо", "", "", "", "тиморски ескудо"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Турска лира (1922–2005)", PluralForms{"", "турска лира (1922–2005)", "", "", "", "турски лири (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "TRY", "Турска лира", PluralForms{"", "турска лира", "", "", "", "турски лири"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "TTD", "Долар на Тринидад и Тобаго", PluralForms{"", "долар на Тринидад и Тобаго", "", "", "", "долара на Тринидад и Тобаго"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "TWD", "Тайвански долар", PluralForms{"", "тайвански долар", "", "", "", "тайвански долара"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Танзанийски шилинг", PluralForms{"", "танзанийски шилинг", "", "", "", "танзанийски шилинга"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "UAH", "Украинска гривня", PluralForms{"", "украинска гривня", "", "", "", "украински гривни"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Украински карбованец", PluralForms{"", "украински карбованец", "", "", "", "украински карбованеца"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Угандийски шилинг (1966–1987)", PluralForms{"", "угандийски шилинг (1966–1987)", "", "", "", "угандийски шилинга (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Угандски шилинг", PluralForms{"", "угандски шилинг", "", "", "", "угандски шилинга"}},
+		"USD": {2, 0, 2, 1, "USD", "щ.д.", "$", "Щатски долар", PluralForms{"", "щатски долар", "", "", "", "щатски долара"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Уругвайско песо (индекс на инфлацията)", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Уругвайско песо (1975–1993)", PluralForms{"", "уругвайско песо (1975–1993)", "", "", "", "уругвайски песо (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "UYU", "Уругвайско песо", PluralForms{"", "уругвайско песо", "", "", "", "уругвайски песо"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Узбекски сум", PluralForms{"", "узбекски сум", "", "", "", "узбекски сума"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Венецуелски боливар (1871–2008)", PluralForms{"", "венецуелски боливар (1871–2008)", "", "", "", "венецуелски боливара (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Венецуелски боливар", PluralForms{"", "венецуелски боливар", "", "", "", "венецуелски боливара"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Венецуелски боливар (VES)", PluralForms{"", "венецуелски боливар (VES)", "", "", "", "венецуелски боливара (VES)"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "VND", "Виетнамски донг", PluralForms{"", "виетнамски донг", "", "", "", "виетнамски донга"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Вануатско вату", PluralForms{"", "вануатско вату", "", "", "", "вануатски вату"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Самоанска тала", PluralForms{"", "самоанска тала", "", "", "", "самоански тали"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Централноафрикански франк", PluralForms{"", "централноафрикански франк", "", "", "", "централноафрикански франка"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Сребро", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Злато", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Европейска съставна единица", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Европейска валутна единица", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Европейска единица по сметка (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Европейска единица по сметка (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "Източнокарибски долар", PluralForms{"", "източнокарибски долар", "", "", "", "източнокарибски долара"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Специални права на тираж", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Еку на ЕИО", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Френски златен франк", PluralForms{"", "френски златен франк", "", "", "", "френски златна франка"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Западноафрикански франк", PluralForms{"", "западноафрикански франк", "", "", "", "западноафрикански франка"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Паладий", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP франк", PluralForms{"", "CFP франк", "", "", "", "CFP франка"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Платина", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Код резервиран за целите на тестване", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Непозната валута", PluralForms{"", "(непозната валута)", "", "", "", "(непозната валута)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Йеменски динар", PluralForms{"", "йеменски динар", "", "", "", "йеменски динара"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Йеменски риал", PluralForms{"", "йеменски риал", "", "", "", "йеменски риала"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Югославски твърд динар", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Югославски динар", PluralForms{"", "югославски динар", "", "", "", "югославски динара"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Югославски конвертируем динар", PluralForms{"", "югославски конвертируем динар", "", "", "", "югославски конвертируеми динара"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Южноафрикански ранд (финансов)", PluralForms{"", "южноафрикански ранд (финансов)", "", "", "", "южноафрикански ранда (финансови)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Южноафрикански ранд", PluralForms{"", "южноафрикански ранд", "", "", "", "южноафрикански ранда"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Замбийска квача (1968–2012)", PluralForms{"", "замбийска квача (1968–2012)", "", "", "", "замбийски квачи (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Замбийска куача", PluralForms{"", "замбийска куача", "", "", "", "замбийски куачи"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Заирско ново зайре", PluralForms{"", "заирско ново зайре", "", "", "", "заирски нови зайре"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Заирско зайре", PluralForms{"", "заирско зайре", "", "", "", "заирски зайре"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Зимбабвийски долар", PluralForms{"", "зимбабвийски долар", "", "", "", "зимбабвийски долара"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Зимбабвийски долар (2009)", PluralForms{"", "зимбабвийски долар (2009)", "", "", "", "зимбабвийски долара (2009)"}},
 	},
 }
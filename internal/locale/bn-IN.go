@@ -6,285 +6,338 @@ var bnIN = LocaleData{
 	NumberInfo{"beng",
 		[10]string{"০", "১", "২", "৩", "৪", "৫", "৬", "৭", "৮", "৯"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 2, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 2, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 2, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 2, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0হা", "other": "0\u00a0হা"}},
+				{4, 2, map[string]string{"one": "0\u00a0হা", "other": "0\u00a0হা"}},
+				{5, 1, map[string]string{"one": "0\u00a0লা", "other": "0\u00a0লা"}},
+				{6, 2, map[string]string{"one": "0\u00a0লা", "other": "0\u00a0লা"}},
+				{7, 1, map[string]string{"one": "0\u00a0কো", "other": "0\u00a0কো"}},
+				{8, 2, map[string]string{"one": "0\u00a0কো", "other": "0\u00a0কো"}},
+				{9, 3, map[string]string{"one": "0\u00a0কো", "other": "0\u00a0কো"}},
+				{10, 1, map[string]string{"one": "0\u00a0শত\u00a0কো", "other": "0শত\u00a0কো"}},
+				{11, 1, map[string]string{"one": "0কো", "other": "0কো"}},
+				{12, 1, map[string]string{"one": "0\u00a0লা.কো.", "other": "0\u00a0লা.কো."}},
+				{13, 2, map[string]string{"one": "0\u00a0লা.কো.", "other": "0\u00a0লা.কো."}},
+				{14, 3, map[string]string{"one": "0\u00a0লা.কো.", "other": "0\u00a0লা.কো."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 হাজার", "other": "0 হাজার"}},
+				{4, 2, map[string]string{"one": "0 হাজার", "other": "0 হাজার"}},
+				{5, 1, map[string]string{"one": "0 লাখ", "other": "0 লাখ"}},
+				{6, 2, map[string]string{"one": "0 লাখ", "other": "0 লাখ"}},
+				{7, 1, map[string]string{"one": "0 কোটি", "other": "0 কোটি"}},
+				{8, 2, map[string]string{"one": "0 কোটি", "other": "0 কোটি"}},
+				{9, 3, map[string]string{"one": "0 কোটি", "other": "0 কোটি"}},
+				{10, 4, map[string]string{"one": "0 কোটি", "other": "0 কোটি"}},
+				{11, 5, map[string]string{"one": "0 কোটি", "other": "0 কোটি"}},
+				{12, 1, map[string]string{"one": "0 লাখ কোটি", "other": "0 লাখ কোটি"}},
+				{13, 2, map[string]string{"one": "0 লাখ কোটি", "other": "0 লাখ কোটি"}},
+				{14, 3, map[string]string{"one": "0 লাখ কোটি", "other": "0 লাখ কোটি"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0হা¤", "other": "0\u00a0হা¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0হা¤", "other": "0\u00a0হা¤"}},
+				{5, 1, map[string]string{"one": "0\u00a0লা¤", "other": "0\u00a0লা¤"}},
+				{6, 2, map[string]string{"one": "0\u00a0লা¤", "other": "0\u00a0লা¤"}},
+				{7, 1, map[string]string{"one": "0\u00a0কো¤", "other": "0\u00a0কো¤"}},
+				{8, 2, map[string]string{"one": "0\u00a0কো¤", "other": "0\u00a0কো¤"}},
+				{9, 3, map[string]string{"one": "0\u00a0কো¤", "other": "0\u00a0কো¤"}},
+				{10, 4, map[string]string{"one": "0\u00a0কো¤", "other": "0\u00a0কো¤"}},
+				{11, 5, map[string]string{"one": "0\u00a0কো¤", "other": "0\u00a0কো¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0লা.কো.¤", "other": "0\u00a0লা.কো.¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0লা.কো.¤", "other": "0\u00a0লা.কো.¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0লা.কো.¤", "other": "0\u00a0লা.কো.¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "৳", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "এ্যান্ডোরান পেসেতা", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "সংযুক্ত আরব আমিরাত দিরহাম", PluralForms{"", "", "", "", "", ""}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "আফগানি (১৯২৭–২০০২)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "আফগান আফগানি", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "আলবেনিয়ান লেক", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "আরমেনিয়ান দ্রাম", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "নেদারল্যান্ডস অ্যান্টিলিয়ান গিল্ডার", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "এ্যাঙ্গোলান কওয়ানজা", PluralForms{"", "", "", "", "", ""}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "এ্যাঙ্গোলান কওয়ানজা (১৯৭৭–১৯৯০)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "এ্যাঙ্গোলান নতুন কওয়ানজা (১৯৯৫–২০০০)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "এ্যাঙ্গোলান কওয়ানজা (১৯৯৫–১৯৯৯)", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "আর্জেন্টিনা অস্ট্রাল", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "আর্জেন্টিনা পেসো (১৯৮৩–১৯৮৫)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "আর্জেন্টিনা পেসো", PluralForms{"", "", "", "", "", ""}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "অস্ট্রিয়ান শিলিং", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "অস্ট্রেলিয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "আরুবান গিল্ডার", PluralForms{"", "", "", "", "", ""}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "আজারবাইজান মানাত (১৯৯৩–২০০৬)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "আজারবাইজান মানাত", PluralForms{"", "", "", "", "", ""}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "বসনিয়া এবং হার্জেগোভিনা দিনার", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "বসনিয়া এবং হার্জেগোভিনা বিনিমেয় মার্ক", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "বার্বেডোজ ডলার", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "৳", "৳", "বাংলাদেশী টাকা", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "বেলজিয়ান ফ্রাঙ্ক (রূপান্তরযোগ্য)", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "বেলজিয়ান ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "বেলজিয়ান ফ্রাঙ্ক (আর্থিক)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "বুলগেরীয় হার্ড লেভ", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "বুলগেরীয় লেভ", PluralForms{"", "বুলগেরীয় লেভ", "", "", "", "বুলগেরীয় লেভা"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "বাহরাইনি দিনার", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "বুরুন্ডি ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "বারমুডান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ব্রুনেই ডলার", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "বলিভিয়ান বলিভিয়ানো", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "বলিভিয়ান পেসো", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "বলিভিয়ান মভডোল", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "ব্রাজিলিয়ান ক্রুজেয়রোনোভো (১৯৬৭–১৯৮৬)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "ব্রাজিলিয়ান ক্রুজেইডাউ", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "ব্রাজিলিয়ান ক্রুজেয়রো (১৯৯০–১৯৯৩)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ব্রাজিলিয়ান রিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "ব্রাজিলিয়ান ক্রুজেইডো নোভো", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "ব্রাজিলিয়ান ক্রুজেয়রো", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "বাহামিয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ভুটানি এনগুল্ট্রুম", PluralForms{"", "", "", "", "", ""}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "বর্মি কিয়াৎ", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "বতসোয়ানা পুলা", PluralForms{"", "", "", "", "", ""}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "বেলারুশিয়ান নিউ রুবেল (১৯৯৪–১৯৯৯)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "বেলারুশিয়ান রুবেল", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "বেলারুশিয়ান রুবেল (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "বেলিজ ডলার", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "কানাডিয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "কঙ্গোলিস ফ্র্যাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "সুইজারল্যান্ড ইউরো", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "সুইস ফ্রাঁ", PluralForms{"", "", "", "", "", ""}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "সুইজারল্যান্ড ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "চিলিয়ান উনিদাদেস দি ফোমেন্তো", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "চিলি পেসো", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "চাইনিজ ইউয়ান (অফশোর)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "চীনা য়ুয়ান", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "কলোম্বিয়ান পেসো", PluralForms{"", "", "", "", "", ""}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "উনিদাদ দি ভ্যালোর রিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "কোস্টা রিকা কোলোন", PluralForms{"", "", "", "", "", ""}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "প্রাচীন সারবিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "চেকোস্লোভাক হার্ড কোরুনা", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "কিউবান রূপান্তরযোগ্য পেসো", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "কিউবান পেসো", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "কেপ ভার্দে এসকুডো", PluralForms{"", "", "", "", "", ""}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "সাইপ্রাস পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "চেক প্রজাতন্ত্র কোরুনা", PluralForms{"", "", "", "", "", ""}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "পূর্ব জার্মান মার্ক", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "ডয়চ্ মার্ক", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "জিবুতি ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ড্যানিশ ক্রোন", PluralForms{"", "ড্যানিশ ক্রোন", "", "", "", "ড্যানিশ ক্রৌন"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "ডোমিনিকান পেসো", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "আলজেরীয় দিনার", PluralForms{"", "", "", "", "", ""}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ইকুয়াডোর সুক্রে", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ইকুয়াডোর উনিদাদেস দি ভেলর কনসতান্তে (ইউভিসি)", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "এস্তোনিয়া ক্রুনি", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "মিশরীয় পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "এরিট্রিয়েন নাকফা", PluralForms{"", "", "", "", "", ""}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "স্প্যানিশ পেসেতা (একই হিসাব)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "স্প্যানিশ পেসেতা (রূপান্তরযোগ্য হিসাব)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "স্প্যানিশ পেসেতা", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ইথিওপিয়ান বির", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "ইউরো", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "ফিনিস মার্কা", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ফিজি ডলার", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ফকল্যান্ড দ্বীপপুঞ্জ পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "ফরাসি ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ব্রিটিশ পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "জর্জিয়ান কুপন লারিট", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "জর্জিয়ান লারি", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ঘানা সেডি (১৯৭৯–২০০৭)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ঘানা সেডি", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "জিব্রাল্টার পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "গাম্বিয়া ডালাসি", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "গিনি ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "গিনি সাইলি", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ইকুয়েটোরিয়াল গিনি ইকুয়িলি", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "গ্রীক দ্রাচমা", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "গুয়াতেমালান কেৎসাল", PluralForms{"", "", "", "", "", ""}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "পর্তুগিজ গিনি এসকুডো", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "গিনি বিসাউ পেসো", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "গায়ানিজ ডলার", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "হংকং ডলার", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "হন্ডুরান লেম্পিরা", PluralForms{"", "", "", "", "", ""}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "ক্রোয়েশিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "ক্রোয়েশিয়ান কুনা", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "হাইতিয়ান গুর্দ", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "হাঙ্গেরিয়ান ফোরিন্ট", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ইন্দোনেশিয়ান রুপিয়াহ", PluralForms{"", "", "", "", "", ""}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "ইরিশ পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "ইস্রাইলি পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ইসরায়েলি নতুন শেকেল", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "ভারতীয় রুপি", PluralForms{"", "ভারতীয় টাকা", "", "", "", "ভারতীয় টাকা"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ইরাকি দিনার", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ইরানিয়ান রিয়াল", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "আইসল্যান্ডীয় ক্রোনা", PluralForms{"", "আইসল্যান্ডীয় ক্রোনা", "", "", "", "আইসল্যান্ড ক্রৌন"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "ইতালীয় লিরা", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "জামাইকান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "জর্ডানিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "জাপানি ইয়েন", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "কেনিয়ান শিলিং", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "কিরগিজস্তান সোম", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "কম্বোডিয়ান রিয়েল", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "কমোরিয়ান ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "উত্তর কোরিয়ার ওন", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "দক্ষিণ কোরিয়ান ওন", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "কুয়েতি দিনার", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "কেম্যান দ্বীপপুঞ্জের ডলার", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "কাজাখাস্তানি টেঙ্গে", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "লেউশান কিপ", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "লেবানিজ পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "শ্রীলঙ্কান রুপি", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "লিবেরিয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "লেসুটু লোটি", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "লিথুইনিয়ান লিটা", PluralForms{"", "", "", "", "", ""}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "লিথুইনিয়ান টালোন্যাস", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "লুক্সেমবার্গ রুপান্তযোগ্য ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "লুক্সেমবার্গ ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "লুক্সেমবার্গ ফাইনেনশিয়াল ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "ল্যাটভিয়ান ল্যাট্\u200cস", PluralForms{"", "", "", "", "", ""}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "ল্যাটভিয়ান রুবল", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "লিবিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "মোরোক্কান দিরহাম", PluralForms{"", "", "", "", "", ""}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "মোরোক্কান ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "মোল্ডোভান লেয়ু", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "মাদাগাস্কার আরিয়ারি", PluralForms{"", "", "", "", "", ""}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "মাদাগাস্কার ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "ম্যাসেডোনিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "মালি ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "মায়ানমার কিয়াত", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "মঙ্গোলিয়ান তুগরিক", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "ম্যাক্যাও পাটাকা", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "মৌরিতানিয়ান ওউগুইয়া (১৯৭৩–২০১৭)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "মৌরিতানিয়ান ওউগুইয়া", PluralForms{"", "", "", "", "", ""}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "মাল্টা লিরা", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "মাল্টা পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "মৌরিতানিয়ান রুপি", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "মালদিভিয়ান রুফিয়া", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "মালাউইয়ান কোয়াচা", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "মেক্সিকান পেসো", PluralForms{"", "", "", "", "", ""}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "ম্যাক্সিকান সিলভার পেসো (১৮৬১–১৯৯২)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "মেক্সিকান উনিদাদ দি ইনভার্সান (UDI)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "মালয়েশিয়ান রিঙ্গিৎ", PluralForms{"", "", "", "", "", ""}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "মোজাম্বিক এসকুডো", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "প্রাচীন মোজাম্বিক মেটিকেল", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "মোজাম্বিক মেটিকেল", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "নামিবিয়া ডলার", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "নাইজেরিয়ান নায়রা", PluralForms{"", "", "", "", "", ""}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "নিকারাগুয়ান কর্ডোবা (১৯৮৮–১৯৯১)", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "নিকারাগুয়ান কর্ডোবা", PluralForms{"", "", "", "", "", ""}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "নেদারল্যান্ড গুল্ডের", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "নরওয়েজিয়ান ক্রোন", PluralForms{"", "নরওয়েজিয়ান ক্রোন", "", "", "", "নরওয়েজিয়ান ক্রোনার"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "নেপালি রুপি", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "নিউজিল্যান্ড ডলার", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ওমানি রিয়াল", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "পানামানিয়ান বালবোয়া", PluralForms{"", "", "", "", "", ""}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "পেরুভিয়ান ইন্তি", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "পেরুভিয়ান সোল", PluralForms{"", "", "", "", "", ""}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "পেরুভিয়ান সোল (1863–1965)", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "পাপুয়া নিউ গিনিয়ান কিনা", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "ফিলিপাইন পেসো", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "পাকিস্তানি রুপি", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "পোলিশ জ্লোটি", PluralForms{"", "", "", "", "", ""}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "পোলিশ জ্লোটি (১৯৫০–১৯৯৫)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "পর্তুগিজ এসকুডো", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "প্যারাগুয়ান গুয়ারানি", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "কাতার রিয়াল", PluralForms{"", "", "", "", "", ""}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "রোডেশিয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "প্রাচীন রুমানিয়া লেয়ু", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "রুমানিয়া লেয়ু", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "সারবিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "রাশিয়ান রুবেল", PluralForms{"", "", "", "", "", ""}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "রাশিয়ান রুবল (১৯৯১–১৯৯৮)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "রুয়ান্ডান ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "সৌদি রিয়াল", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "সলোমন দ্বীপপুঞ্জ ডলার", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "সেয়চেল্লোইস রুপি", PluralForms{"", "", "", "", "", ""}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "প্রাচীন সুদানি দিনার", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "সুদানি পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "প্রাচীন সুদানি পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "সুইডিশ ক্রোনা", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "সিঙ্গাপুর ডলার", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "সেন্ট হেলেনা পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "স্লোভানিয়া টোলার", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "স্লোভাক কোরুনা", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "সিয়েরা লিয়নের লিয়ন", PluralForms{"", "সিয়েরা লিয়নের লিয়ন", "", "", "", "সিয়েরা লিয়নের লিয়ন"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "সিয়েরা লিয়নের লিয়ন (1964—2022)", PluralForms{"", "সিয়েরা লিয়নের লিয়ন (1964—2022)", "", "", "", "সিয়েরা লিয়নের লিয়ন (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "সোমালি শিলিং", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "সুরিনামিজ ডলার", PluralForms{"", "", "", "", "", ""}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "সুরিনাম গিল্ডার", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "দক্ষিণ সুদানি পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "সাও টোমে এবং প্রিন্সিপে ডোবরা (১৯৭৭–২০১৭)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "সাও টোমে এবং প্রিন্সিপে ডোবরা", PluralForms{"", "", "", "", "", ""}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "সোভিয়েত রুবল", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "এল স্যালভোডোর কোলোন", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "সিরিয়ান পাউন্ড", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "সোয়াজিল্যান্ড লিলাঙ্গেনি", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "থাই বাত", PluralForms{"", "", "", "", "", ""}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "তাজিকিস্তান রুবল", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "তাজিকিস্তান সোমোনি", PluralForms{"", "", "", "", "", ""}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "তুর্কমেনিস্টানি মানাত", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "তুর্কমেনিস্তান মানত", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "তিউনেশিয়ান দিনার", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "টোঙ্গা পা’আঙ্গা", PluralForms{"", "", "", "", "", ""}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "তিমুর এসকুডো", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "প্রাচীন তুর্কি লিরা", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "তুর্কি লিরা", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ত্রিনিদাদ এবং টোবাগো ডলার", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "নতুন তাইওয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "তাঞ্জনিয়া শিলিং", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ইউক্রেইন হৃভনিয়া", PluralForms{"", "", "", "", "", ""}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ইউক্রেইন কার্বোভ্যান্টস", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "উগান্ডান শিলিং (১৯৬৬–১৯৮৭)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "উগান্ডান শিলিং", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "মার্কিন ডলার", PluralForms{"", "", "", "", "", ""}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "মার্কিন ডলার (পরবর্তী দিন)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "মার্কিন ডলার (একই দিন)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "উরুগুয়ায়ান পেসো এন উনিদাদেস ইনডেক্সেডাস", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "উরুগুয়ে পেসো (১৯৭৫–১৯৯৩)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "উরুগুয়ে পেসো", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "উজবেকিস্তানি সোম", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "ভেনিজুয়েলান বলিভার (১৮৭১–২০০৮)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "ভেনিজুয়েলীয় বলিভার (২০০৮–২০১৮)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "ভেনিজুয়েলীয় বলিভার", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "ভিয়েতনামি ডঙ্গ", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "ভানুয়াতু ভাতু", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "সামোয়ান টালা", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "মধ্য আফ্রিকান [CFA] ফ্র্যাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "সিলভার", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "গোল্ড", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "ইউরোপীয় আর্থিক একক", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "পূর্ব ক্যারিবিয়ান ডলার", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "ইউরোপীয় মুদ্রা একক", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "ফরাসি গোল্ড ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "ফরাসি ইউআইসি - ফ্রাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "পশ্চিম আফ্রিকান [CFA] ফ্র্যাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "প্যালেডিয়াম", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "সিএফপি ফ্র্যাঙ্ক", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "প্লাটিনাম", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "অজানা মুদ্রা", PluralForms{"", "(মুদ্রার অজানা একক)", "", "", "", "(অজানা মুদ্রা)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "ইয়েমেনি দিনার", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "ইয়েমেনি রিয়াল", PluralForms{"", "", "", "", "", ""}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "যুগোশ্লাভিয় হার্ড দিনার", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "যুগোশ্লাভিয় নোভি দিনার", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "যুগোশ্লাভিয় রুপান্তরযোগ্য দিনার", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "দক্ষিণ আফ্রিকান র\u200c্যান্ড", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "দক্ষিণ আফ্রিকান রেন্ড", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "জাম্বিয়ান কওয়াচা (১৯৬৮–২০১২)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "জাম্বিয়ান কওয়াচা", PluralForms{"", "", "", "", "", ""}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "জাইরিয়ান নিউ জাইরে", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "জাইরিয়ান জাইরে", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "জিম্বাবুয়ে ডলার (১৯৮০–২০০৮)", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "জিম্বাবুয়ে ডলার (২০০৯)", PluralForms{"", "", "", "", "", ""}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "জিম্বাবুয়ে ডলার (২০০৮)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,310 +6,363 @@ var bs = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0hilj.", "other": "0\u00a0hilj."}},
+				{4, 2, map[string]string{"one": "0\u00a0hilj.", "other": "0\u00a0hilj."}},
+				{5, 3, map[string]string{"one": "0\u00a0hilj.", "other": "0\u00a0hilj."}},
+				{6, 1, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{7, 2, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{8, 3, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{9, 1, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 hiljade", "one": "0 hiljada", "other": "0 hiljada"}},
+				{4, 2, map[string]string{"few": "0 hiljade", "one": "0 hiljada", "other": "0 hiljada"}},
+				{5, 3, map[string]string{"few": "0 hiljade", "one": "0 hiljada", "other": "0 hiljada"}},
+				{6, 1, map[string]string{"few": "0 miliona", "one": "0 milion", "other": "0 miliona"}},
+				{7, 2, map[string]string{"few": "0 miliona", "one": "0 milion", "other": "0 miliona"}},
+				{8, 3, map[string]string{"few": "0 miliona", "one": "0 milion", "other": "0 miliona"}},
+				{9, 1, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{10, 2, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{11, 3, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{12, 1, map[string]string{"few": "0 biliona", "one": "0 bilion", "other": "0 biliona"}},
+				{13, 2, map[string]string{"few": "0 biliona", "one": "0 bilion", "other": "0 biliona"}},
+				{14, 3, map[string]string{"few": "0 biliona", "one": "0 bilion", "other": "0 biliona"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0hilj.\u00a0¤", "one": "0\u00a0hilj.\u00a0¤", "other": "0\u00a0hilj.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0hilj.\u00a0¤", "one": "0\u00a0hilj.\u00a0¤", "other": "0\u00a0hilj.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0hilj.\u00a0¤", "one": "0\u00a0hilj.\u00a0¤", "other": "0\u00a0hilj.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0} – {1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "KM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "kn", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "din.", "din."},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "XPF", "XPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorska pezeta", PluralForms{"", "Andorijska pezeta", "", "Andorijske pezete", "", "Andorijske pezete"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirham Ujedinjenih Arapskih Emirata", PluralForms{"", "dirham (UAE)", "", "dirhama (UAE)", "", "dirhama (UAE)"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Avganistanski avgani (1927–2002)", PluralForms{"", "Avganistanski avgan (1927–2002)", "", "Avganistanska avgana (1927–2002)", "", "Avganistanski avgan (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afganistanski afgan", PluralForms{"", "afganistanski afgan", "", "afganistanska afgana", "", "afganistanskih afgana"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albanski lek (1946–1965)", PluralForms{"", "albanski lek (1946–1965)", "", "Albanska leka (1946–1965)", "", "albanski lek (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albanski lek", PluralForms{"", "albanski lek", "", "albanska leka", "", "albanskih leka"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armenski dram", PluralForms{"", "armenski dram", "", "armenska drama", "", "armenskih drama"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Holandskoantilski gulden", PluralForms{"", "holandskoantilski gulden", "", "holandskoantilska guldena", "", "holandskoantilskih guldena"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angolska kvanza", PluralForms{"", "angolska kvanza", "", "angolske kvanze", "", "angolskih kvanzi"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolijska kvanza (1977–1991)", PluralForms{"", "Angolijska kvanza (1977–1991)", "", "Angolijske kvanze (1977–1991)", "", "Angolijskih kvanzi (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolijska nova kvanza (1990–2000)", PluralForms{"", "angolijska nova kvanza (1990–2000)", "", "angolijske nove kvanze (1990–2000)", "", "angolski novi kvanze (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolijska kvanza reajustado (1995–1999)", PluralForms{"", "angolijska kvanza reađustado (1995–1999)", "", "angalske kvanze reađustado (1995–1999)", "", "angolijskih kvanzi reađustado (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentinski austral", PluralForms{"", "argentinski austral", "", "argentinska australa", "", "argentinski australs"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "ARL", PluralForms{"", "argentinski pezos lej", "", "argentinska pezosa leja", "", "argentinskih pezosa leja"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "ARM", PluralForms{"", "argentinski pezos monedo nacional", "", "argentinska pezosa moned nacional", "", "argentinskih pezosa monedo nacional"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentinski pezo (1983–1985)", PluralForms{"", "argentinski pezo (1983–1985)", "", "argentinska pezosa (1983–1985)", "", "argentinskih pezosa (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentinski pezos", PluralForms{"", "argentinski pezos", "", "argentinska pezosa", "", "argentinskih pezosa"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austrijski šiling", PluralForms{"", "austrijski šiling", "", "austrijska šilinga", "", "austrijskih šilinga"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "Australijski dolar", PluralForms{"", "australijski dolar", "", "australijska dolara", "", "australijskih dolara"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Arubanski florin", PluralForms{"", "arubanski florin", "", "arubanska florina", "", "arubanskih florina"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azerbejdžanski manat (1993–2006)", PluralForms{"", "azerbejdžanski manat (1993–2006)", "", "azerbejdžanska manata (1993–2006)", "", "azerbejdžanskih manata (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbejdžanski manat", PluralForms{"", "azerbejdžanski manat", "", "azerbejdžanska manata", "", "azerbejdžanskih manata"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosanskohercegovački dinar", PluralForms{"", "bosanskohercegovački dinar", "", "Bosanskohercegovačka dinara", "", "bosanskohercegovačkih dinara"}},
+		"BAM": {2, 0, 2, 1, "BAM", "KM", "KM", "Bosanskohercegovačka konvertibilna marka", PluralForms{"", "bosanskohercegovačka konvertibilna marka", "", "bosanskohercegovačke konvertibilne marke", "", "bosanskohercegovačkih konvertibilnih maraka"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Bosanskohercegovački novi dinar", PluralForms{"", "bosanskohercegovački novi dinar", "", "bosanskohercegovački novi dinari", "", "bosanskohercegovački novi dinar"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbadoski dolar", PluralForms{"", "barbadoski dolar", "", "barbadoska dolara", "", "barbadoskih dolara"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladeška taka", PluralForms{"", "bangladeška taka", "", "bangladeške take", "", "bangladeških taka"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgijski frank (konvertibilni)", PluralForms{"", "belgijski franak (konvertibilni)", "", "belgijska franka (konvertibilna)", "", "belgijskih franaka (konvertibilnih)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgijski franak", PluralForms{"", "belgijski franak", "", "belgijska franka", "", "belgijskih franaka"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgijski frank (finansijski)", PluralForms{"", "belgijski franak (finansijski)", "", "belgijska franka (finansijska)", "", "belgijskih franaka (finansijskih)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bugarski tvrdi lev", PluralForms{"", "bugarski tvrdi lev", "", "bugarska tvrda leva", "", "bugarskih tvrdih leva"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Bugarski socijalistički lev", PluralForms{"", "bugarski socijalistički lev", "", "bugarska socijalistička leva", "", "bugarskih socijalističkih leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bugarski lev", PluralForms{"", "bugarski lev", "", "bugarska leva", "", "bugarskih leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Bugarski lev (1879–1952)", PluralForms{"", "bugarski lev (1879–1952)", "", "Bugarska leva (1879–1952)", "", "Bugarskih leva (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahreinski dinar", PluralForms{"", "bahreinski dinar", "", "bahreinska dinara", "", "bahreinskih dinara"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundski franak", PluralForms{"", "burundski franak", "", "burundska franka", "", "burundskih franaka"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermudski dolar", PluralForms{"", "bermudski dolar", "", "bermudska dolara", "", "bermudskih dolara"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunejski dolar", PluralForms{"", "brunejski dolar", "", "brunejska dolara", "", "brunejskih dolara"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolivijski boliviano", PluralForms{"", "bolivijski boliviano", "", "bolivijska boliviana", "", "bolivijskih boliviana"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Bolivijski boliviano (1863–1963)", PluralForms{"", "bolivijski boliviano (1863–1963)", "", "bolivijska boliviana (1863–1963)", "", "bolivijskih boliviana (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bolivijski pezo", PluralForms{"", "bolivijski pezo", "", "Bolivijska pezosa", "", "bolivijskih pezosa"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Bolivijski mvdol", PluralForms{"", "bolivijski mvdol", "", "bolivijska mvdola", "", "bolivijskih mvdola"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brazilski kruzeiro novo (1967–1986)", PluralForms{"", "brazilski novi kruzeiro (1967–1986)", "", "brazilska nova kruzeira (1967–1986)", "", "brazilskih novih kruzeira (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brazilski kruzado (1986–1989)", PluralForms{"", "brazilskih kruzado (1986–1989)", "", "brazilska kruzadosa (1986–1989)", "", "brazilskih kruzadosa (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brazilski kruzeiro (1990–1993)", PluralForms{"", "brazilski kruzeiro (1990–1993)", "", "brazilska kruzeira (1990–1993)", "", "brazilskih kruzeira (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "Brazilski real", PluralForms{"", "brazilski real", "", "brazilska reala", "", "brazilskih reala"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brazilski kruzado novo (1989–1990)", PluralForms{"", "brazilski novi kruzado (1989–1990)", "", "brazilska nova kruzada (1989–1990)", "", "brazilskih novih kruzada (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brazilski kruzeiro (1993–1994)", PluralForms{"", "brazilski kruzeiro (1993–1994)", "", "brazilijska kruzeira (1993–1994)", "", "brazilskih kruzeira (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Brazilski kruzeiro (1942–1967)", PluralForms{"", "brazilski kruzeiro (1942–1967)", "", "brazilijska kruzeira (1942–1967)", "", "brazilskih kruzeira (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamski dolar", PluralForms{"", "bahamski dolar", "", "bahamska dolara", "", "bahamskih dolara"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Butanski ngultrum", PluralForms{"", "butanski ngultrum", "", "butanska ngultruma", "", "butanskih ngultruma"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Burmanski kjat", PluralForms{"", "burmanski kjat", "", "burmanska kjata", "", "burmanskih kjata"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Bocvanska pula", PluralForms{"", "bocvanska pula", "", "bocvanske pule", "", "bocvanskih pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Beloruska nova rublja (1994–1999)", PluralForms{"", "beloruska nova rublja (1994–1999)", "", "beloruske nove rublje (1994–1999)", "", "beloruskih novih rublji (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Bjeloruska rublja", PluralForms{"", "bjeloruska rublja", "", "bjeloruske rublje", "", "bjeloruskih rubalja"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Bjeloruska rublja (2000–2016)", PluralForms{"", "bjeloruska rublja (2000–2016)", "", "bjeloruske rublje (2000–2016)", "", "bjeloruskih rubalja (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belizeanski dolar", PluralForms{"", "belizeanski dolar", "", "belizeanska dolara", "", "belizeanskih dolara"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "Kanadski dolar", PluralForms{"", "kanadski dolar", "", "kanadska dolara", "", "kanadskih dolara"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongoanski franak", PluralForms{"", "kongoanski franak", "", "kongoanska franka", "", "kongoanskih franaka"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR Evro", PluralForms{"", "WIR evro", "", "WIR evra", "", "WIR evra"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Švicarski franak", PluralForms{"", "švicarski franak", "", "švicarska franka", "", "švicarskih franaka"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR franak", PluralForms{"", "WIR franak", "", "WIR franka", "", "WIR franak"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Čileanski eskudo", PluralForms{"", "čileanski eskudo", "", "čileanska eskuda", "", "čileanskih eskuda"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Čileanski unidades de fomento", PluralForms{"", "čileanski unidades de fomentos", "", "čileanska unidades de fomentos", "", "čileanski unidades de fomentos"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Čileanski pezos", PluralForms{"", "čileanski pezos", "", "čileanska pezosa", "", "čileanskih pezosa"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Kineski juan (izvanteritorijalni)", PluralForms{"", "kineski juan (izvanteritorijalni)", "", "kineska juana (izvanteritorijalni)", "", "kineskih juana (izvanteritorijalni)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Dolar kineske narodne banke", PluralForms{"", "dolar kineske narodne banke", "", "dolara kineske narodne banke", "", "dolara kineske narodne banke"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "Kineski juan", PluralForms{"", "kineski juan", "", "kineska juana", "", "kineskih juana"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolumbijski pezos", PluralForms{"", "kolumbijski pezos", "", "kolumbijska pezosa", "", "kolumbijskih pezosa"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Kolumbijski Unidade real de valor", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kostarikanski kolon", PluralForms{"", "kostarikanski kolon", "", "kostarikanska kolona", "", "kostarikanskih kolona"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Srpski dinar (2002–2006)", PluralForms{"", "srpski dinar (2002–2006)", "", "srpska dinara (2002–2006)", "", "srpskih dinara (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Čehoslovačka tvrda koruna", PluralForms{"", "čehoslovačka tvrda kruna", "", "čehoslovačke tvrde krune", "", "čehoslovačka tvrda kruna"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kubanski konvertibilni pezos", PluralForms{"", "kubanski konvertibilni pezos", "", "kubanska konvertibilna pezosa", "", "kubanskih konvertibilnih pezosa"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubanski pezos", PluralForms{"", "kubanski pezos", "", "kubanska pezosa", "", "kubanskih pezosa"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Zelenortski eskudo", PluralForms{"", "zelenortski eskudo", "", "zelenortska eskuda", "", "zelenortskih eskuda"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kipratska funta", PluralForms{"", "kiparska funta", "", "kiparske funte", "", "kiparska funta"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Češka kruna", PluralForms{"", "češka kruna", "", "češke krune", "", "čeških kruna"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Istočnoevropska marka", PluralForms{"", "istočnonemačka marka", "", "istočnonemačke marke", "", "istočnonemačkih maraka"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Nemačka marka", PluralForms{"", "nemačka marka", "", "Nemačke marke", "", "nemačkih maraka"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Džibutski franak", PluralForms{"", "džibutski franak", "", "džibutska franka", "", "džibutskih franaka"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Danska kruna", PluralForms{"", "danska kruna", "", "danske krune", "", "danskih kruna"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikanski pezos", PluralForms{"", "dominikanski pezos", "", "dominikanska pezosa", "", "dominikanskih pezosa"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Alžirski dinar", PluralForms{"", "alžirski dinar", "", "alžirska dinara", "", "alžirskih dinara"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ekvadorijski sukr", PluralForms{"", "ekvadorska sakra", "", "ekvadorska sakra", "", "ekvadorskih sakra"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Ekvadorski unidad de valor konstantin (UVC)", PluralForms{"", "ekvadorski unidad de valor constante (UVC)", "", "ekvadorska unidad de valor constante (UVC)", "", "ekvadorski unidad de valor constante (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estonska kruna", PluralForms{"", "estonska kruna", "", "estonske krune", "", "estonskih kruna"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Egipatska funta", PluralForms{"", "egipatska funta", "", "egipatske funte", "", "egipatskih funti"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrejska nakfa", PluralForms{"", "eritrejska nakfa", "", "eritrejske nakfe", "", "eritrejskih nakfi"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Španska pezeta (račun) ESA", PluralForms{"", "španska pezeta (A račun)", "", "španske pezete (A račun)", "", "španska pezeta (A račun)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Španska pezeta (konvertibilni račun)", PluralForms{"", "španska pezeta (konvertibilan račun)", "", "španske pezete (konvertibilan račun)", "", "španska pezeta (konvertibilan račun)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Španska pezeta", PluralForms{"", "španska pezeta", "", "španska pezeta", "", "španske pezete"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Etiopski bir", PluralForms{"", "etiopski bir", "", "etiopska bira", "", "etiopskih bira"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "euro", "", "eura", "", "eura"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Finska marka", PluralForms{"", "finska marka", "", "Finske marke", "", "finskih maraka"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fidžijski dolar", PluralForms{"", "fidžijski dolar", "", "fidžijska dolara", "", "fidžijskih dolara"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Folklandska funta", PluralForms{"", "folklandska funta", "", "folklandske funte", "", "folklandskih funti"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Francuski franak", PluralForms{"", "francuski franak", "", "Francuska franka", "", "francuskih franaka"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "Britanska funta", PluralForms{"", "britanska funta", "", "britanske funte", "", "britanskih funti"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Gruzijski kupon larit", PluralForms{"", "gruzijski kupon larit", "", "gruzijska kupon larita", "", "gruzijskih kupon larita"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gruzijski lari", PluralForms{"", "gruzijski lari", "", "gruzijska larija", "", "gruzijskih larija"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ganijski cedi (1979–2007)", PluralForms{"", "ganski ced (1979–2007)", "", "ganska ceda (1979–2007)", "", "ganskih ceda (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ganski cedi", PluralForms{"", "ganski cedi", "", "ganska cedija", "", "ganskih cedija"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltarska funta", PluralForms{"", "gibraltarska funta", "", "gibraltarske funte", "", "gibraltarskih funti"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambijski dalasi", PluralForms{"", "gambijski dalasi", "", "gambijska dalasija", "", "gambijskih dalasija"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gvinejski franak", PluralForms{"", "gvinejski franak", "", "gvinejska franka", "", "gvinejskih franaka"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Gvinejski sili", PluralForms{"", "gvinejski sili", "", "gvinejska silija", "", "gvinejski silij"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Evatorijalna gvineja ekvele", PluralForms{"", "evatorijalno-gvinejski ekvele", "", "evatorijalno-gvinejska ekvela", "", "evatorijalno-gvinejskih ekvela"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Drahma", PluralForms{"", "grčka drahma", "", "grčke drahme", "", "grčkih drahmi"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Gvatemalski kecal", PluralForms{"", "gvatemalski kecal", "", "gvatemalska kecala", "", "gvatemalskih kecala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugalska Gvineja eskudo", PluralForms{"", "portugalsko-gvinejski eskudo", "", "portugalsko-gvinejska eskuda", "", "portugalsko-gvinejski eskudo"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Gvineja bisao pezo", PluralForms{"", "gvineja-bisaoški pezo", "", "gvineja-bisaoška pezosa", "", "gvinejsko-bisaoski pezos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Gvajanski dolar", PluralForms{"", "gvajanski dolar", "", "gvajanska dolara", "", "gvajanskih dolara"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "Honkonški dolar", PluralForms{"", "hongkonški dolar", "", "hongkonška dolara", "", "hongkonških dolara"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Honduraška lempira", PluralForms{"", "honduraška lempira", "", "honduraške lempire", "", "honduraških lempira"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Hrvatski dinar", PluralForms{"", "hrvatski dinar", "", "Hrvatska dinara", "", "hrvatskih dinara"}},
+		"HRK": {2, 0, 2, 1, "HRK", "kn", "kn", "Hrvatska kuna", PluralForms{"", "hrvatska kuna", "", "hrvatske kune", "", "hrvatskih kuna"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haićanski gurd", PluralForms{"", "haićanski gurd", "", "haićanska gurda", "", "haićanskih gurda"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Mađarska forinta", PluralForms{"", "mađarska forinta", "", "mađarske forinte", "", "mađarskih forinti"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indonežanska rupija", PluralForms{"", "indonežanska rupija", "", "indonežanske rupije", "", "indonežanskih rupija"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Irska funta", PluralForms{"", "irska funta", "", "irske funte", "", "irskih funti"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Izraelska funta", PluralForms{"", "izraelska funta", "", "izraelske funte", "", "izraelska funta"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "stari izraelski šekeli", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "Izraelski novi šekel", PluralForms{"", "izraelski novi šekel", "", "izraelska nova šekela", "", "izraelskih novih šekela"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Indijska rupija", PluralForms{"", "indijska rupija", "", "indijske rupije", "", "indijskih rupija"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irački dinar", PluralForms{"", "irački dinar", "", "iračka dinara", "", "iračkih dinara"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Iranski rijal", PluralForms{"", "iranski rijal", "", "iranska rijala", "", "iranskih rijala"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "stara islandska kruna", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Islandska kruna", PluralForms{"", "islandska kruna", "", "islandske krune", "", "islandskih kruna"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italijanska lira", PluralForms{"", "italijanska lira", "", "Italijanske lire", "", "italijanske lire"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamajčanski dolar", PluralForms{"", "jamajčanski dolar", "", "jamajčanska dolara", "", "jamajčanskih dolara"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordanski dinar", PluralForms{"", "jordanski dinar", "", "jordanska dinara", "", "jordanskih dinara"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Japanski jen", PluralForms{"", "japanski jen", "", "japanska jena", "", "japanskih jena"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenijski šiling", PluralForms{"", "kenijski šiling", "", "kenijska šilinga", "", "kenijskih šilinga"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kirgistanski som", PluralForms{"", "kirgistanski som", "", "kirgistanska soma", "", "kirgistanskih soma"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambodžanski rijel", PluralForms{"", "kambodžanski rijel", "", "kambodžanska rijela", "", "kambodžanskih rijela"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komorski franak", PluralForms{"", "komorski franak", "", "komorska franka", "", "komorskih franaka"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Sjevernokorejski von", PluralForms{"", "sjevernokorejski von", "", "sjevernokorejska vona", "", "sjevernokorejskih vona"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Južno-korejski hvan (1953–1962)", PluralForms{"", "južno-korejski hvan (1953–1962)", "", "južno-korejska hvana (1953–1962)", "", "južno-korejski hvana (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Južno-korejski Von (1945–1953)", PluralForms{"", "južno-korejski von (1945–1953)", "", "južno-korejska vona (1945–1953)", "", "južno-korejski von (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Južnokorejski von", PluralForms{"", "južnokorejski von", "", "južnokorejska vona", "", "južnokorejskih vona"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuvajtski dinar", PluralForms{"", "kuvajtski dinar", "", "kuvajtska dinara", "", "kuvajtskih dinara"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kajmanski dolar", PluralForms{"", "kajmanski dolar", "", "kajmanska dolara", "", "kajmanskih dolara"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kazahstanski tenge", PluralForms{"", "kazahstanski tenge", "", "kazahstanska tenga", "", "kazahstanskih tenga"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laoski kip", PluralForms{"", "laoski kip", "", "laoska kipa", "", "laoskih kipa"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Libanska funta", PluralForms{"", "libanska funta", "", "libanske funte", "", "libanskih funti"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Šrilankanska rupija", PluralForms{"", "šrilankanska rupija", "", "šrilankanske rupije", "", "šrilankanskih rupija"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberijski dolar", PluralForms{"", "liberijski dolar", "", "liberijska dolara", "", "liberijskih dolara"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesotski loti", PluralForms{"", "lesotski lotis", "", "lesotska lotisa", "", "lesotskih lotisa"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litvanski litas", PluralForms{"", "litvanski litas", "", "litvanska litasa", "", "litvanskih litasa"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Litvanski talonas", PluralForms{"", "litvanska talona", "", "litvanske talone", "", "litvanskih talona"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luksemburški konvertibilni franak", PluralForms{"", "luksemburški konvertibilni franak", "", "luksemburška konvertibilna franka", "", "luksemburški konvertibilni franak"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luksemburški franak", PluralForms{"", "luksemburški franak", "", "luksemburška franka", "", "luksemburški franci"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luksemburški finansijski franak", PluralForms{"", "luksemburški financijski franak", "", "luksemburška financijska franka", "", "luksemburški financijski franak"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Letonski lats", PluralForms{"", "letonski lats", "", "letonska latsa", "", "letonskih latsa"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latvijska rublja", PluralForms{"", "latvijska rublja", "", "latvijska rublja", "", "latvijska rublja"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libijski dinar", PluralForms{"", "libijski dinar", "", "libijska dinara", "", "libijskih dinara"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Marokanski dirham", PluralForms{"", "marokanski dirham", "", "marokanska dirhama", "", "marokanskih dirhama"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Marokanski franak", PluralForms{"", "marokanski franak", "", "marokanska franka", "", "marokanski franak"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monegaskaški franak", PluralForms{"", "monegaskaški franak", "", "monegaskaška franka", "", "monegaskaških franaka"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Moldavski kupon", PluralForms{"", "moldovanski kupon", "", "moldovanska kupona", "", "moldovanskih kupona"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldavski lej", PluralForms{"", "moldavski lej", "", "moldavska leja", "", "moldavskih leja"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Malagaški arijari", PluralForms{"", "malagaški arijari", "", "malagaška arijarija", "", "malagaških arijarija"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Malagasijski franak", PluralForms{"", "madagaskarski franak", "", "madagaskarska franka", "", "madagaskarski franaka"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Makedonski denar", PluralForms{"", "makedonski denar", "", "makedonska denara", "", "makedonskih denara"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Makedonski denar (1992–1993)", PluralForms{"", "makedonski denar (1992–1993)", "", "makedonska denara (1992–1993)", "", "makedonskih dinara (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malijanski franak", PluralForms{"", "malijski franak", "", "malijska franka", "", "malijski franak"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Mijanmarski kjat", PluralForms{"", "mijanmarski kjat", "", "mijanmarska kjata", "", "mijanmarskih kjata"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongolski tugrik", PluralForms{"", "mongolski tugrik", "", "mongolska tugrika", "", "mongolskih tugrika"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makaonska pataka", PluralForms{"", "makaonska pataka", "", "makaonske patake", "", "makaonskih pataka"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritanijska ugvija (1973–2017)", PluralForms{"", "mauritanijska ugvija (1973–2017)", "", "mauritanijske ugvije (1973–2017)", "", "mauritanijskih ugvija (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauritanijska ugvija", PluralForms{"", "mauritanijska ugvija", "", "mauritanijske ugvije", "", "mauritanijskih ugvija"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Malteška lira", PluralForms{"", "malteška lira", "", "malteške lire", "", "malteških lira"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Malteška funta", PluralForms{"", "malteška funta", "", "malteške funte", "", "malteška funta"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mauricijska rupija", PluralForms{"", "mauricijska rupija", "", "mauricijske rupije", "", "mauricijskih rupija"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldivska rufija", PluralForms{"", "maldivska rufija", "", "maldivske rufije", "", "maldivskih rufija"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malavijska kvača", PluralForms{"", "malavijska kvača", "", "malavijske kvače", "", "malavijskih kvača"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "Meksički pezos", PluralForms{"", "meksički pezos", "", "meksička pezosa", "", "meksičkih pezosa"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Meksijski srebrno pezo (1861–1992)", PluralForms{"", "meksički srebrni pezos (1861–1992)", "", "meksička srebrna pezosa (1861–1992)", "", "meksički srebrni pezos (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Meksijski unidad de inverzion", PluralForms{"", "meksički unidads de inversion (UDI)", "", "meksička unidads de inversion (UDI)", "", "meksički unidads de inversion (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malezijski ringit", PluralForms{"", "malezijski ringit", "", "malezijska ringita", "", "malezijskih ringita"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambijski eskudo", PluralForms{"", "mozambijski eskudo", "", "mozambijska eskuda", "", "mozambijski eskudo"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambijski metikal (1980–2006)", PluralForms{"", "mozambijski metikal (1980–2006)", "", "mozambijska metikala (1980–2006)", "", "mozambijski metikal (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambijski metikal", PluralForms{"", "mozambijski metikal", "", "mozambijska metikala", "", "mozambijskih metikala"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibijski dolar", PluralForms{"", "namibijski dolar", "", "namibijska dolara", "", "namibijskih dolara"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigerijska naira", PluralForms{"", "nigerijska naira", "", "nigerijske naire", "", "nigerijskih naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nikaragvanška kordoba (1988–1991)", PluralForms{"", "nikaragvanska kordoba (1988–1991)", "", "nikaragvanske kordobe (1988–1991)", "", "nikaragvanska kordoba (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikaragvanska kordoba", PluralForms{"", "nikaragvanska kordoba", "", "nikaragvanske kordobe", "", "nikaragvanskih kordoba"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Holandski gulden", PluralForms{"", "holandski gulden", "", "holandska guldena", "", "holandskih guldena"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norveška kruna", PluralForms{"", "norveška kruna", "", "norveške krune", "", "norveških kruna"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepalska rupija", PluralForms{"", "nepalska rupija", "", "nepalske rupije", "", "nepalskih rupija"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "Novozelandski dolar", PluralForms{"", "novozelandski dolar", "", "novozelandska dolara", "", "novozelandskih dolara"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omanski rijal", PluralForms{"", "omanski rijal", "", "omanska rijala", "", "omanskih rijala"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panamska balboa", PluralForms{"", "panamska balboa", "", "panamske balboe", "", "panamskih balboa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peruvijski inti", PluralForms{"", "peruanska inta", "", "peruanske inte", "", "peruanska inta"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peruanski sol", PluralForms{"", "peruanski sol", "", "peruanska sola", "", "peruanskih sola"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peruvijski sol (1863–1965)", PluralForms{"", "peruanski sol (1863–1965)", "", "peruanska sola (1863–1965)", "", "peruanski sol (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina Papue Nove Gvineje", PluralForms{"", "kina Papue Nove Gvineje", "", "kine Papue Nove Gvineje", "", "kina Papue Nove Gvineje"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filipinski pezos", PluralForms{"", "filipinski pezos", "", "filipinska pezosa", "", "filipinskih pezosa"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistanska rupija", PluralForms{"", "pakistanska rupija", "", "pakistanske rupije", "", "pakistanskih rupija"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Poljski zlot", PluralForms{"", "poljski zlot", "", "poljska zlota", "", "poljskih zlota"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Poljski zloti (1950–1995)", PluralForms{"", "poljski zlot (1950–1995)", "", "poljske zlote (1950–1995)", "", "poljski zlot (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugalski eskudo", PluralForms{"", "portugalski eskudo", "", "portugalska eskuda", "", "portugalskih eskuda"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paragvajski gvarani", PluralForms{"", "paragvajski gvarani", "", "paragvajska gvaranija", "", "paragvajskih gvaranija"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Katarski rijal", PluralForms{"", "katarski rijal", "", "katarska rijala", "", "katarskih rijala"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rodizijski dolar", PluralForms{"", "rodezijski dolar", "", "rodezijska dolara", "", "rodezijski dolar"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumunski leu (1952–2006)", PluralForms{"", "rumunski leu (1952–2006)", "", "rumunska leua (1952–2006)", "", "rumunskih leua (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Rumunski lej", PluralForms{"", "rumunski lej", "", "rumunska leja", "", "rumunskih leja"}},
+		"RSD": {2, 0, 0, 1, "RSD", "din.", "din.", "Srpski dinar", PluralForms{"", "srpski dinar", "", "srpska dinara", "", "srpskih dinara"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Ruska rublja", PluralForms{"", "ruska rublja", "", "ruske rublje", "", "ruskih rubalja"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Ruska rublja (1991–1998)", PluralForms{"", "ruska rublja (1991–1998)", "", "ruske rublje (1991–1998)", "", "ruskih rublji (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ruandski franak", PluralForms{"", "ruandski franak", "", "ruandska franka", "", "ruandskih franaka"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudijski rijal", PluralForms{"", "saudijski rijal", "", "saudijska rijala", "", "saudijskih rijala"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Solomonski dolar", PluralForms{"", "solomonski dolar", "", "solomonska dolara", "", "solomonskih dolara"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Sejšelska rupija", PluralForms{"", "sejšelska rupija", "", "sejšelske rupije", "", "sejšelskih rupija"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudanski dinar (1992–2007)", PluralForms{"", "sudanski dinar (1992–2007)", "", "sudanska dinara (1992–2007)", "", "sudanski dinar (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudanska funta", PluralForms{"", "sudanska funta", "", "sudanske funte", "", "sudanskih funti"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudanska funta (1957–1998)", PluralForms{"", "sudanska funta (1957–1998)", "", "sudanske funte (1957–1998)", "", "sudanska funta (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Švedska kruna", PluralForms{"", "švedska kruna", "", "švedske krune", "", "švedskih kruna"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapurski dolar", PluralForms{"", "singapurski dolar", "", "singapurska dolara", "", "singapurskih dolara"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Svetohelenska funta", PluralForms{"", "svetohelenska funta", "", "svetohelenske funte", "", "svetohelenskih funti"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovenski tolar", PluralForms{"", "slovenački tolar", "", "slovenačka tolara", "", "slovenačkih tolara"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovačka kruna", PluralForms{"", "slovačka kuna", "", "slovačke kune", "", "slovačkih kuna"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sijeraleonski leone", PluralForms{"", "sijeraleonski leone", "", "sijeraleonska leona", "", "sijeraleonskih leona"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sijeraleonski leone (1964—2022)", PluralForms{"", "sijeraleonski leone (1964—2022)", "", "sijeraleonska leona (1964—2022)", "", "sijeraleonskih leona (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somalski šiling", PluralForms{"", "somalski šiling", "", "somalska šilinga", "", "somalskih šilinga"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surinamski dolar", PluralForms{"", "surinamski dolar", "", "surinamska dolara", "", "surinamskih dolara"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surinamski gilder", PluralForms{"", "surinamski gulden", "", "surinamska guldena", "", "surinamski gulden"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Južnosudanska funta", PluralForms{"", "južnosudanska funta", "", "južnosudanske funte", "", "južnosudanskih funti"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra Sao Toma i Principa (1977–2017)", PluralForms{"", "dobra Sao Toma i Principa (1977–2017)", "", "dobre Sao Toma i Principa (1977–2017)", "", "dobri Sao Toma i Principa (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra Sao Toma i Principa", PluralForms{"", "dobra Sao Toma i Principa", "", "dobre Sao Toma i Principa", "", "dobri Sao Toma i Principa"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Sovjetska rublja", PluralForms{"", "sovjetska rublja", "", "sovjetske rublje", "", "sovjetske rublje"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Salvadorski kolon", PluralForms{"", "salvadorski kolon", "", "salvadorska kolona", "", "salvadorski kolon"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Sirijska funta", PluralForms{"", "sirijska funta", "", "sirijske funte", "", "sirijskih funti"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Svazilendski lilangeni", PluralForms{"", "svazilendski lilangeni", "", "svazilendska lilangena", "", "svazilendskih lilangena"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Tajlandski baht", PluralForms{"", "tajlandski baht", "", "tajlandska bahta", "", "tajlandskih bahta"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadžakistanska rublja", PluralForms{"", "tadžikistanska rublja", "", "tadžikistanske rublje", "", "tadžikistanska rublja"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadžikistanski somoni", PluralForms{"", "tadžikistanski somoni", "", "tadžikistanska somonija", "", "tadžikistanskih somonija"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmenistanski manat (1993–2009)", PluralForms{"", "turkmenistanski manat (1993–2009)", "", "turkmenistanska manata (1993–2009)", "", "turkmenistanski manat (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmenistanski manat", PluralForms{"", "turkmenistanski manat", "", "turkmenistanska manata", "", "turkmenistanskih manata"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tuniški dinar", PluralForms{"", "tuniški dinar", "", "tuniška dinara", "", "tuniških dinara"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tonganska panga", PluralForms{"", "tonganska panga", "", "tonganske pange", "", "tonganskih panga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timorški eskudo", PluralForms{"", "timorski eskudo", "", "timorska eskuda", "", "timorski eskudo"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turska lira (1922–2005)", PluralForms{"", "turska lira (1922–2005)", "", "turske lire (1922–2005)", "", "turkskih lira (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Turska lira", PluralForms{"", "turska lira", "", "turske lire", "", "turskih lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidadtobaški dolar", PluralForms{"", "trinidadtobaški dolar", "", "trinidadtobaška dolara", "", "trinidadtobaških dolara"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Novi tajvanski dolar", PluralForms{"", "novi tajvanski dolar", "", "nova tajvanska dolara", "", "novih tajvanskih dolara"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzanijski šiling", PluralForms{"", "tanzanijski šiling", "", "tanzanijska šilinga", "", "tanzanijskih šilinga"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrajinska hrivnja", PluralForms{"", "ukrajinska hrivnja", "", "ukrajinske hrivnje", "", "ukrajinskih hrivnji"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrajinski karbovaneti", PluralForms{"", "ukrajinski karbovantsiv", "", "ukrajinska karbovantsiva", "", "ukrajinski karbovantsiv"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Ugandijski šiling (1966–1987)", PluralForms{"", "ugandski šiling (1966–1987)", "", "ugandska šilinga (1966–1987)", "", "ugandski šiling (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ugandski šiling", PluralForms{"", "ugandski šiling", "", "ugandska šilinga", "", "ugandskih šilinga"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "Američki dolar", PluralForms{"", "američki dolar", "", "američka dolara", "", "američkih dolara"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "SAD dolar (sledeći dan)", PluralForms{"", "američki dolar (sledeći dan)", "", "američka dolara (sledeći dan)", "", "američki dolar (sledeći dan)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "SAD dolar (isti dan)", PluralForms{"", "američki dolar (isti dan)", "", "američka dolara (isti dan)", "", "američki dolar (isti dan)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Urugvajski pezo en unidades indeksades", PluralForms{"", "urugvajski pesos en unidades indexadas", "", "urugvajska pesosa en unidades indexadas", "", "urugvajski pesos en unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Urugvajski pezo (1975–1993)", PluralForms{"", "urugvajski pezos (1975–1993)", "", "urugvajska pezosa (1975–1993)", "", "urugvajski pezos (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Urugvajski pezos", PluralForms{"", "urugvajski pezos", "", "urugvajska pezosa", "", "urugvajskih pezosa"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Uzbekistanski som", PluralForms{"", "uzbekistanski som", "", "uzbekistanska soma", "", "uzbekistanskih soma"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venecuelanski bolivar (1871–2008)", PluralForms{"", "venecuelski bolivar (1871–2008)", "", "venecuelska bolivara (1871–2008)", "", "venecuelskih bolivara (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venecuelanski bolivar (2008–2018)", PluralForms{"", "venecuelanski bolivar (2008–2018)", "", "venecuelanska bolivara (2008–2018)", "", "venecuelanskih bolivara (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venecuelanski bolivar", PluralForms{"", "venecuelanski bolivar", "", "venecuelanska bolivara", "", "venecuelanskih bolivara"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Vijetnamski dong", PluralForms{"", "vijetnamski dong", "", "vijetnamska donga", "", "vijetnamskih donga"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vijetnamski dong (1978–1985)", PluralForms{"", "vijetnamski dong (1978–1985)", "", "vijetnamska donga (1978–1985)", "", "vijetnamski dong (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatski vatu", PluralForms{"", "vanuatski vatu", "", "vanuatska vatua", "", "vanuatskih vatua"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoanska tala", PluralForms{"", "samoanska tala", "", "samoanske tale", "", "samoanskih tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Centralnoafrički franak (CFA)", PluralForms{"", "centralnoafrički franak (CFA)", "", "centralnoafrička franka (CFA)", "", "centralnoafričkih franaka (CFA)"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Srebro", PluralForms{"", "srebro", "", "srebra", "", "srebro"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Zlato", PluralForms{"", "zlato", "", "zlata", "", "zlato"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Evropska kompozitna jedinica", PluralForms{"", "evropska složena jedinica", "", "evropske složene jedinice", "", "evropska složena jedinica"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Evropska novčana jedinica", PluralForms{"", "evropska monetarna jedinica", "", "evropske monetarne jedinice", "", "evropska monetarna jedinica"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Evropska jedinica računa (XBC)", PluralForms{"", "evropska obračunska jedinica (XBC)", "", "evropske obračunske jedinice (XBC)", "", "evropska obračunska jedinica (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Evropska jedinica računa (XBD)", PluralForms{"", "evropska obračunska jedinica (XBD)", "", "evropske obračunske jedinice (XBD)", "", "evropska obračunska jedinica (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "Istočnokaripski dolar", PluralForms{"", "istočnokaripski dolar", "", "istočnokaripska dolara", "", "istočnokaripskih dolara"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Posebna prava", PluralForms{"", "posebno crtaće pravo", "", "posebna crtaća prava", "", "posebnih crtaćih prava"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Evropska valutna jedinica", PluralForms{"", "evropska valutna jedinica", "", "evropske valutne jedinice", "", "evropskih valutnih jedinica"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Francuski zlatni frank", PluralForms{"", "francuski zlatni franak", "", "francuska zlatna franka", "", "francuskih zlatnih franaka"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Francuski UIC-frank", PluralForms{"", "francuski UIC-franak", "", "francuska UIC-franka", "", "francuskih UIC-franaka"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Zapadnoafrički franak (CFA)", PluralForms{"", "zapadnoafrički franak (CFA)", "", "zapadnoafrička franka (CFA)", "", "zapadnoafričkih franaka (CFA)"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paladijum", PluralForms{"", "paladijum", "", "paladijuma", "", "paladijuma"}},
+		"XPF": {0, 0, 0, 1, "XPF", "XPF", "XPF", "Franak (CFP)", PluralForms{"", "franak (CFP)", "", "franka (CFP)", "", "franaka (CFP)"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platina", PluralForms{"", "platina", "", "platine", "", "platina"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET fondovi", PluralForms{"", "RINET fond", "", "RINET fonda", "", "RINET fondova"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Kod testirane valute", PluralForms{"", "ispitni kod valute", "", "ispitna koda valute", "", "ispitnih kodova valute"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Nepoznata valuta", PluralForms{"", "nepoznata valuta", "", "nepoznate valute", "", "nepoznatih valuta"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jemenski dinar", PluralForms{"", "jemenski dinar", "", "jemenska dinara", "", "jemenskih dinara"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jemenski rijal", PluralForms{"", "jemenski rijal", "", "jemenska rijala", "", "jemenskih rijala"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslovenski tvrdi dinar", PluralForms{"", "jugoslovenski čvrsti dinar", "", "jugoslovenska čvrsta dinara", "", "jugoslovenskih čvstih dinara"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslovenski novi dinar", PluralForms{"", "jugoslovenski novi dinar", "", "jugoslovenska nova dinara", "", "jugoslovenskih novih dinara"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslovenski konvertibilni dinar", PluralForms{"", "jugoslovenski konvertibilni dinar", "", "jugoslovenska konvertibilna dinara", "", "jugoslovenskih konvertibilnih dinara"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Jugoslovenski reformirani dinar", PluralForms{"", "jugoslovenski reformirani dinar", "", "jugoslovenska reformirana dinara", "", "jugoslovenskih reformiranih dinara"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Južnoafrički rand (finansijski)", PluralForms{"", "južnoafrički rand (financijski)", "", "južnoafrička randa (financijska)", "", "južnoafičkih randa (financijskih)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Južnoafrički rand", PluralForms{"", "južnoafrički rand", "", "južnoafrička randa", "", "južnoafričkih randa"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambijska kvača (1968–2012)", PluralForms{"", "zambijska kvača (1968–2012)", "", "zambijske kvače (1968–2012)", "", "zambijske kvače (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambijska kvača", PluralForms{"", "zambijska kvača", "", "zambijske kvače", "", "zambijskih kvača"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zairski novi zair (1993–1998)", PluralForms{"", "zairski novi zair (1993–1998)", "", "zairska nova zaira (1993–1998)", "", "zairskih novih zaira (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zairski zair (1971–1993)", PluralForms{"", "zairski zair (1971–1993)", "", "zairska zaira (1971–1993)", "", "zairskih zaira (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabvejski dolar (1980–2008)", PluralForms{"", "zimbabvejski dolar (1980–2008)", "", "zimbabvejska dolara (1980–2008)", "", "zimbabvejski dolari (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabvejski dolar (2009)", PluralForms{"", "zimbabvejski dolaz (2009)", "", "zimbabvejska dolara (2009)", "", "zimbabvejskih dolara (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabvejski dolar (2008)", PluralForms{"", "zimbabvejski dolaz (2008)", "", "zimbabvejska dolara (2008)", "", "zimbabvejskih dolara (2008)"}},
 	},
 }
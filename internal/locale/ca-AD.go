@@ -6,310 +6,363 @@ var caAD = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{4, 2, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{5, 3, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{6, 1, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{7, 2, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{8, 3, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{9, 4, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{10, 2, map[string]string{"one": "0\u00a0kM", "other": "0\u00a0kM"}},
+				{11, 3, map[string]string{"one": "0\u00a0kM", "other": "0\u00a0kM"}},
+				{12, 1, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{13, 2, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{14, 3, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 miler", "other": "0 milers"}},
+				{4, 2, map[string]string{"one": "0 milers", "other": "0 milers"}},
+				{5, 3, map[string]string{"one": "0 milers", "other": "0 milers"}},
+				{6, 1, map[string]string{"one": "0 milió", "other": "0 milions"}},
+				{7, 2, map[string]string{"one": "0 milions", "other": "0 milions"}},
+				{8, 3, map[string]string{"one": "0 milions", "other": "0 milions"}},
+				{9, 1, map[string]string{"one": "0 miler de milions", "other": "0 milers de milions"}},
+				{10, 2, map[string]string{"one": "0 milers de milions", "other": "0 milers de milions"}},
+				{11, 3, map[string]string{"one": "0 milers de milions", "other": "0 milers de milions"}},
+				{12, 1, map[string]string{"one": "0 bilió", "other": "0 bilions"}},
+				{13, 2, map[string]string{"one": "0 bilions", "other": "0 bilions"}},
+				{14, 3, map[string]string{"one": "0 bilions", "other": "0 bilions"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0k¤", "other": "0\u00a0k¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0k¤", "other": "0\u00a0k¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0k¤", "other": "0\u00a0k¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{9, 4, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0kM¤", "other": "0\u00a0kM¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0kM¤", "other": "0\u00a0kM¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0B¤", "other": "0\u00a0B¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0B¤", "other": "0\u00a0B¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0B¤", "other": "0\u00a0B¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥ {0}", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "₧", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs F"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "XCG", "XCG"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "pesseta andorrana", PluralForms{"", "pesseta andorrana", "", "", "", "pessetes andorranes"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dírham dels Emirats Àrabs Units", PluralForms{"", "dírham dels Emirats Àrabs Units", "", "", "", "dírhams dels Emirats Àrabs Units"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afgani afganès (1927–2002)", PluralForms{"", "afgani afganès (1927–2002)", "", "", "", "afganis afganesos (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afganès", PluralForms{"", "afgani afganès", "", "", "", "afganis afganesos"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "lek albanès (1946–1965)", PluralForms{"", "lek albanès (1946–1965)", "", "", "", "lekë albanesos (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek", PluralForms{"", "lek", "", "", "", "lekë"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram", PluralForms{"", "dram", "", "", "", "drams"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "florí de les Antilles Neerlandeses", PluralForms{"", "florí de les Antilles Neerlandeses", "", "", "", "florins de les Antilles Neerlandeses"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolès", PluralForms{"", "kwanza angolès", "", "", "", "kwanzas angolesos"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angolès (1977–1991)", PluralForms{"", "kwanza angolès (1977–1991)", "", "", "", "kwanzas angolesos (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "nou kwanza angolès (1990–2000)", PluralForms{"", "nou kwanza angolès (1990–2000)", "", "", "", "nous kwanzas angolesos (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza angolès reajustat (1995–1999)", PluralForms{"", "kwanza angolès reajustat (1995–1999)", "", "", "", "kwanzas angolesos (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentí", PluralForms{"", "austral argentí", "", "", "", "australs argentins"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "peso ley argentí (1970–1983)", PluralForms{"", "peso ley argentí (1970–1983)", "", "", "", "pesos ley argentins (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "peso argentí (1981–1970)", PluralForms{"", "peso argentí moneda nacional", "", "", "", "pesos argentins moneda nacional"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentí (1983–1985)", PluralForms{"", "peso argentí (1983–1985)", "", "", "", "pesos argentins (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentí", PluralForms{"", "peso argentí", "", "", "", "pesos argentins"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "xíling austríac", PluralForms{"", "xíling austríac", "", "", "", "xílings austríacs"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "dòlar australià", PluralForms{"", "dòlar australià", "", "", "", "dòlars australians"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florí d’Aruba", PluralForms{"", "florí d’Aruba", "", "", "", "florins d’Aruba"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azerbaidjanès (1993–2006)", PluralForms{"", "manat azerbaidjanès (1993–2006)", "", "", "", "manats azerbaidjanesos (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azerbaidjanès", PluralForms{"", "manat azerbaidjanès", "", "", "", "manats azerbaidjanesos"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar de Bòsnia i Hercegovina (1992–1994)", PluralForms{"", "dinar de Bòsnia i Hercegovina (1992–1994)", "", "", "", "dinars de Bòsnia i Hercegovina (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marc convertible de Bòsnia i Hercegovina", PluralForms{"", "marc convertible de Bòsnia i Hercegovina", "", "", "", "marcs convertibles de Bòsnia i Hercegovina"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "nou dinar de Bòsnia i Hercegovina (1994–1997)", PluralForms{"", "nou dinar de Bòsnia i Hercegovina (1994–1997)", "", "", "", "nous dinars de Bòsnia i Hercegovina (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dòlar de Barbados", PluralForms{"", "dòlar de Barbados", "", "", "", "dòlars de Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franc belga (convertible)", PluralForms{"", "franc belga (convertible)", "", "", "", "francs belgues (convertibles)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "franc belga", PluralForms{"", "franc belga", "", "", "", "francs belgues"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franc belga (financer)", PluralForms{"", "franc belga (financer)", "", "", "", "francs belgues (financers)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "lev fort búlgar", PluralForms{"", "lev fort búlgar", "", "", "", "leva forts búlgars"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "lev socialista búlgar", PluralForms{"", "lev socialista búlgar", "", "", "", "leva socialistes búlgars"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev", PluralForms{"", "lev", "", "", "", "leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "lev búlgar (1879–1952)", PluralForms{"", "lev búlgar (1879–1952)", "", "", "", "leva búlgars (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar de Bahrain", PluralForms{"", "dinar de Bahrain", "", "", "", "dinars de Bahrain"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franc de Burundi", PluralForms{"", "franc de Burundi", "", "", "", "francs de Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dòlar de les Bermudes", PluralForms{"", "dòlar de les Bermudes", "", "", "", "dòlars de les Bermudes"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dòlar de Brunei", PluralForms{"", "dòlar de Brunei", "", "", "", "dòlars de Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolivià", PluralForms{"", "bolivià", "", "", "", "bolivians"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "boliviano bolivià (1863–1963)", PluralForms{"", "boliviano bolivià (1863–1963)", "", "", "", "bolivianos bolivians (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso bolivià", PluralForms{"", "peso bolivià", "", "", "", "pesos bolivians"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "MVDOL bolivià", PluralForms{"", "MVDOL bolivià", "", "", "", "MVDOL bolivians"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "cruzeiro novo brasiler (1967–1986)", PluralForms{"", "cruzeiro novo brasiler (1967–1986)", "", "", "", "cruzeiros novos brasilers (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brasiler", PluralForms{"", "cruzado brasiler", "", "", "", "cruzados brasilers"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brasiler (1990–1993)", PluralForms{"", "cruzeiro brasiler (1990–1993)", "", "", "", "cruzeiros brasilers (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "real brasiler", PluralForms{"", "real brasiler", "", "", "", "reals brasilers"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "cruzado novo brasiler", PluralForms{"", "cruzado novo brasiler", "", "", "", "cruzados novos brasilers"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro brasiler", PluralForms{"", "cruzeiro brasiler", "", "", "", "cruzeiros brasilers"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "antic cruzeiro brasiler", PluralForms{"", "antic cruzeiro brasiler", "", "", "", "antics cruzeiros brasilers"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dòlar de les Bahames", PluralForms{"", "dòlar de les Bahames", "", "", "", "dòlars de les Bahames"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum de Bhutan", PluralForms{"", "ngultrum de Bhutan", "", "", "", "ngultrums de Bhutan"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birmà", PluralForms{"", "kyat birmà", "", "", "", "kyats birmans"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula de Botswana", PluralForms{"", "pula de Botswana", "", "", "", "pules de Botswana"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "nou ruble bielorús (1994–1999)", PluralForms{"", "nou ruble bielorús (1994–1999)", "", "", "", "nous rubles bielorussos (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "ruble belarús", PluralForms{"", "ruble belarús", "", "", "", "rubles belarussos"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "ruble bielorús (2000–2016)", PluralForms{"", "ruble bielorús (2000–2016)", "", "", "", "rubles bielorussos (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dòlar de Belize", PluralForms{"", "dòlar de Belize", "", "", "", "dòlars de Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "dòlar canadenc", PluralForms{"", "dòlar canadenc", "", "", "", "dòlars canadencs"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franc congolès", PluralForms{"", "franc congolès", "", "", "", "francs congolesos"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "euro WIR", PluralForms{"", "euro WIR", "", "", "", "euros WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franc suís", PluralForms{"", "franc suís", "", "", "", "francs suïssos"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "franc WIR", PluralForms{"", "franc WIR", "", "", "", "francs WIR"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "escut xilè", PluralForms{"", "escudo xilè", "", "", "", "escudos xilens"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "unidad de fomento xilena", PluralForms{"", "unidad de fomento xilena", "", "", "", "unidades de fomento xilenes"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso xilè", PluralForms{"", "peso xilè", "", "", "", "pesos xilens"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "iuan xinès extracontinental", PluralForms{"", "iuan xinès extracontinental", "", "", "", "iuans xinesos extracontinentals"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "CNX", PluralForms{"", "dòlar del Banc Popular Xinès", "", "", "", "dòlars del Banc Popular Xinès"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "iuan", PluralForms{"", "iuan", "", "", "", "iuans"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso colombià", PluralForms{"", "peso colombià", "", "", "", "pesos colombians"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "unidad de valor real colombiana", PluralForms{"", "unidad de valor real colombiana", "", "", "", "unidades de valor real colombianes"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colon costa-riqueny", PluralForms{"", "colon costa-riqueny", "", "", "", "colons costa-riquenys"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "dinar serbi antic", PluralForms{"", "dinar serbi antic", "", "", "", "dinars serbis antics"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "corona forta txecoslovaca", PluralForms{"", "corona forta txecoslovaca", "", "", "", "corones fortes txecoslovaques"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso convertible cubà", PluralForms{"", "peso convertible cubà", "", "", "", "pesos convertibles cubans"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubà", PluralForms{"", "peso cubà", "", "", "", "pesos cubans"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escut de Cap Verd", PluralForms{"", "escut de Cap Verd", "", "", "", "escuts de Cap Verd"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "lliura xipriota", PluralForms{"", "lliura xipriota", "", "", "", "lliures xipriotes"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "corona txeca", PluralForms{"", "corona txeca", "", "", "", "corones txeques"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "marc de l’Alemanya Oriental", PluralForms{"", "marc de l’Alemanya Oriental", "", "", "", "marcs de l’Alemanya Oriental"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marc alemany", PluralForms{"", "marc alemany", "", "", "", "marcs alemanys"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franc de Djibouti", PluralForms{"", "franc de Djibouti", "", "", "", "francs de Djibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "corona danesa", PluralForms{"", "corona danesa", "", "", "", "corones daneses"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominicà", PluralForms{"", "peso dominicà", "", "", "", "pesos dominicans"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar algerià", PluralForms{"", "dinar algerià", "", "", "", "dinars algerians"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre equatorià", PluralForms{"", "sucre equatorià", "", "", "", "sucres equatorians"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "unidad de valor constante (UVC) equatoriana", PluralForms{"", "unidad de valor constante (UVC) equatoriana", "", "", "", "unidades de valor constante (UVC) equatorianes"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "corona estoniana", PluralForms{"", "corona estoniana", "", "", "", "corones estonianes"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "lliura egípcia", PluralForms{"", "lliura egípcia", "", "", "", "lliures egípcies"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreu", PluralForms{"", "nakfa eritreu", "", "", "", "nakfes eritreus"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "pesseta espanyola (compte A)", PluralForms{"", "pesseta espanyola (compte A)", "", "", "", "pessetes espanyoles (compte A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "pesseta espanyola (compte convertible)", PluralForms{"", "pesseta espanyola (compte convertible)", "", "", "", "pessetes espanyoles (compte convertible)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "₧", "₧", "pesseta espanyola", PluralForms{"", "pesseta espanyola", "", "", "", "pessetes espanyoles"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etíop", PluralForms{"", "birr etíop", "", "", "", "birrs etíops"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "marc finlandès", PluralForms{"", "marc finlandès", "", "", "", "marcs finlandesos"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dòlar fijià", PluralForms{"", "dòlar fijià", "", "", "", "dòlars fijians"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "lliura de les illes Malvines", PluralForms{"", "lliura de les illes Malvines", "", "", "", "lliures de les illes Malvines"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "franc francès", PluralForms{"", "franc francès", "", "", "", "francs francesos"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "lliura esterlina", PluralForms{"", "lliura esterlina", "", "", "", "lliures esterlines"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "cupó de lari georgià", PluralForms{"", "cupó de lari georgià", "", "", "", "cupons de lari georgians"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari", PluralForms{"", "lari", "", "", "", "laris"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi ghanès (1979–2007)", PluralForms{"", "cedi ghanès (1979–2007)", "", "", "", "cedis ghanesos (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanès", PluralForms{"", "cedi ghanès", "", "", "", "cedis ghanesos"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "lliura de Gibraltar", PluralForms{"", "lliura de Gibraltar", "", "", "", "lliures de Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambià", PluralForms{"", "dalasi gambià", "", "", "", "dalasis gambians"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franc guineà", PluralForms{"", "franc guineà", "", "", "", "francs guineans"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli guineà", PluralForms{"", "syli guineà", "", "", "", "sylis guineans"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwele de Guinea Equatorial", PluralForms{"", "ekwele de Guinea Equatorial", "", "", "", "bipkwele de Guinea Equatorial"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "dracma grega", PluralForms{"", "dracma grega", "", "", "", "dracmes gregues"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal", PluralForms{"", "quetzal", "", "", "", "quetzals"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "escut de la Guinea Portuguesa", PluralForms{"", "escut de la Guinea Portuguesa", "", "", "", "escuts de la Guinea Portuguesa"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso de Guinea Bissau", PluralForms{"", "peso de Guinea Bissau", "", "", "", "pesos de Guinea Bissau"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dòlar de Guyana", PluralForms{"", "dòlar de Guyana", "", "", "", "dòlars de Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "dòlar de Hong Kong", PluralForms{"", "dòlar de Hong Kong", "", "", "", "dòlars de Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira", PluralForms{"", "lempira", "", "", "", "lempires"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar croat", PluralForms{"", "dinar croat", "", "", "", "dinars croats"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna", PluralForms{"", "kuna", "", "", "", "kunes"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde", PluralForms{"", "gourde", "", "", "", "gourdes"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "fòrint", PluralForms{"", "fòrint", "", "", "", "fòrints"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonèsia", PluralForms{"", "rupia indonèsia", "", "", "", "rupies indonèsies"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "lliura irlandesa", PluralForms{"", "lliura irlandesa", "", "", "", "lliures irlandeses"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "lliura israeliana", PluralForms{"", "lliura israeliana", "", "", "", "lliures israelianes"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "xéquel israelià", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "nou xéquel israelià", PluralForms{"", "nou xéquel israelià", "", "", "", "nous xéquels israelians"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "rupia índia", PluralForms{"", "rupia índia", "", "", "", "rupies índies"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar iraquià", PluralForms{"", "dinar iraquià", "", "", "", "dinars iraquians"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iranià", PluralForms{"", "rial iranià", "", "", "", "rials iranians"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "corona islandesa antiga", PluralForms{"", "corona islandesa antiga", "", "", "", "corones islandeses antigues"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "corona islandesa", PluralForms{"", "corona islandesa", "", "", "", "corones islandeses"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "lira italiana", PluralForms{"", "lira italiana", "", "", "", "lires italianes"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dòlar jamaicà", PluralForms{"", "dòlar jamaicà", "", "", "", "dòlars jamaicans"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordà", PluralForms{"", "dinar jordà", "", "", "", "dinars jordans"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "ien", PluralForms{"", "ien", "", "", "", "iens"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "xíling kenyà", PluralForms{"", "xíling kenyà", "", "", "", "xílings kenyans"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirguís", PluralForms{"", "som kirguís", "", "", "", "soms kirguisos"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel cambodjà", PluralForms{"", "riel cambodjà", "", "", "", "riels cambodjans"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franc de les Comores", PluralForms{"", "franc de les Comores", "", "", "", "francs de les Comores"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won nord-coreà", PluralForms{"", "won nord-coreà", "", "", "", "wons nord-coreans"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "hwan sud-coreà (1953–1962)", PluralForms{"", "hwan sud-coreà", "", "", "", "hwans sud-coreans"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "antic won sud-coreà", PluralForms{"", "antic won sud-coreà", "", "", "", "antics wons sud-coreans"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "won sud-coreà", PluralForms{"", "won sud-coreà", "", "", "", "wons sud-coreans"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuwaitià", PluralForms{"", "dinar kuwaitià", "", "", "", "dinars kuwaitians"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dòlar de les illes Caiman", PluralForms{"", "dòlar de les illes Caiman", "", "", "", "dòlars de les illes Caiman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge", PluralForms{"", "tenge", "", "", "", "tenges"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laosià", PluralForms{"", "kip laosià", "", "", "", "kips laosians"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "lliura libanesa", PluralForms{"", "lliura libanesa", "", "", "", "lliures libaneses"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia de Sri Lanka", PluralForms{"", "rupia de Sri Lanka", "", "", "", "rupies de Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dòlar liberià", PluralForms{"", "dòlar liberià", "", "", "", "dòlars liberians"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti", PluralForms{"", "loti", "", "", "", "lotis"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litas lituà", PluralForms{"", "litas lituà", "", "", "", "litai lituans"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "talonas lituà", PluralForms{"", "talonas lituà", "", "", "", "talonai lituans"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "franc convertible luxemburguès", PluralForms{"", "franc convertible luxemburguès", "", "", "", "francs convertibles luxemburguesos"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "franc luxemburguès", PluralForms{"", "franc luxemburguès", "", "", "", "francs luxemburguesos"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "franc financer luxemburguès", PluralForms{"", "franc financer luxemburguès", "", "", "", "francs financers luxemburguesos"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lats letó", PluralForms{"", "lats letó", "", "", "", "lati letons"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "ruble letó", PluralForms{"", "ruble letó", "", "", "", "rubles letons"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libi", PluralForms{"", "dinar libi", "", "", "", "dinars libis"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dírham marroquí", PluralForms{"", "dírham marroquí", "", "", "", "dírhams marroquins"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franc marroquí", PluralForms{"", "franc marroquí", "", "", "", "francs marroquins"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "franc monegasc", PluralForms{"", "franc monegasc", "", "", "", "francs monegascos"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "cupó moldau", PluralForms{"", "cupó moldau", "", "", "", "cupons moldaus"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldau", PluralForms{"", "leu moldau", "", "", "", "lei moldaus"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary malgaix", PluralForms{"", "ariary malgaix", "", "", "", "ariarys malgaixos"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franc malgaix", PluralForms{"", "franc malgaix", "", "", "", "francs malgaixos"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinar macedoni", PluralForms{"", "dinar macedoni", "", "", "", "dinars macedonis"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "denar macedoni (1992–1993)", PluralForms{"", "denar macedoni (1992–1993)", "", "", "", "denari macedonis (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franc malià", PluralForms{"", "franc malià", "", "", "", "francs malians"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat de Myanmar", PluralForms{"", "kyat de Myanmar", "", "", "", "kyats de Myanmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tögrög mongol", PluralForms{"", "tögrög mongol", "", "", "", "tögrögs mongols"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca de Macau", PluralForms{"", "pataca de Macau", "", "", "", "pataques de Macau"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya maurità (1973–2017)", PluralForms{"", "ouguiya maurità (1973–2017)", "", "", "", "ouguiyas mauritans (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya maurità", PluralForms{"", "ouguiya maurità", "", "", "", "ouguiyas mauritans"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lira maltesa", PluralForms{"", "lira maltesa", "", "", "", "lires malteses"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "lliura maltesa", PluralForms{"", "lliura maltesa", "", "", "", "lliures malteses"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia mauriciana", PluralForms{"", "rupia mauriciana", "", "", "", "rupies mauricianes"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rupia de les Maldives", PluralForms{"", "rupia de les Maldives", "", "", "", "rupies de les Maldives"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malawià", PluralForms{"", "kwacha malawià", "", "", "", "kwacha malawians"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "peso mexicà", PluralForms{"", "peso mexicà", "", "", "", "pesos mexicans"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso de plata mexicà (1861–1992)", PluralForms{"", "peso de plata mexicà (1861–1992)", "", "", "", "pesos de plata mexicans (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "unidad de inversión (UDI) mexicana", PluralForms{"", "unidad de inversión (UDI) mexicana", "", "", "", "unidades de inversión (UDI) mexicanes"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit", PluralForms{"", "ringgit", "", "", "", "ringgits"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escut moçambiquès", PluralForms{"", "escut moçambiquès", "", "", "", "escuts moçambiquesos"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "antic metical moçambiquès", PluralForms{"", "antic metical moçambiquès", "", "", "", "antics meticals moçambiquesos"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical moçambiquès", PluralForms{"", "metical moçambiquès", "", "", "", "meticals moçambiquesos"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dòlar namibià", PluralForms{"", "dòlar namibià", "", "", "", "dòlars namibians"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigerià", PluralForms{"", "naira nigerià", "", "", "", "naires nigerians"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "córdoba nicaragüenca", PluralForms{"", "córdoba nicaragüenca", "", "", "", "córdobas nicaragüenques"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba nicaragüenc", PluralForms{"", "córdoba nicaragüenc", "", "", "", "córdobas nicaragüencs"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "florí neerlandès", PluralForms{"", "florí neerlandès", "", "", "", "florins neerlandesos"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "corona noruega", PluralForms{"", "corona noruega", "", "", "", "corones noruegues"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalesa", PluralForms{"", "rupia nepalesa", "", "", "", "rupies nepaleses"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "dòlar neozelandès", PluralForms{"", "dòlar neozelandès", "", "", "", "dòlars neozelandesos"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omanita", PluralForms{"", "rial omanita", "", "", "", "rials omanites"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa", PluralForms{"", "balboa", "", "", "", "balboes"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti peruà", PluralForms{"", "inti peruà", "", "", "", "intis peruans"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol", PluralForms{"", "sol", "", "", "", "sols"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol peruà (1863–1965)", PluralForms{"", "sol peruà (1863–1965)", "", "", "", "sols peruans (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina", PluralForms{"", "kina", "", "", "", "kines"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipí", PluralForms{"", "peso filipí", "", "", "", "pesos filipins"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia pakistanesa", PluralForms{"", "rupia pakistanesa", "", "", "", "rupies pakistaneses"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloty", PluralForms{"", "zloty", "", "", "", "zlote"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "zloty polonès (1950–1995)", PluralForms{"", "zloty polonès (1950–1995)", "", "", "", "zlote polonesos (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "escut portuguès", PluralForms{"", "escut portuguès", "", "", "", "escuts portuguesos"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guaraní", PluralForms{"", "guaraní", "", "", "", "guaranís"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial de Qatar", PluralForms{"", "rial de Qatar", "", "", "", "rials de Qatar"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dòlar rhodesià", PluralForms{"", "dòlar rhodesià", "", "", "", "dòlars rhodesians"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "antic leu romanès", PluralForms{"", "antic leu romanès", "", "", "", "antics lei romanesos"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "leu romanès", PluralForms{"", "leu romanès", "", "", "", "lei romanesos"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serbi", PluralForms{"", "dinar serbi", "", "", "", "dinars serbis"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruble", PluralForms{"", "ruble", "", "", "", "rubles"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "ruble rus (1991–1998)", PluralForms{"", "ruble rus (1991–1998)", "", "", "", "rubles russos (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franc de Ruanda", PluralForms{"", "franc de Ruanda", "", "", "", "francs de Ruanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "rial saudita", PluralForms{"", "rial saudita", "", "", "", "rials saudites"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dòlar de les illes Salomó", PluralForms{"", "dòlar de les illes Salomó", "", "", "", "dòlars de les illes Salomó"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia de les Seychelles", PluralForms{"", "rupia de les Seychelles", "", "", "", "rupies de les Seychelles"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar sudanès", PluralForms{"", "dinar sudanès", "", "", "", "dinars sudanesos"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "lliura sudanesa", PluralForms{"", "lliura sudanesa", "", "", "", "lliures sudaneses"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "antiga lliura sudanesa", PluralForms{"", "antiga lliura sudanesa", "", "", "", "antigues lliures sudaneses"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "corona sueca", PluralForms{"", "corona sueca", "", "", "", "corones sueques"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dòlar de Singapur", PluralForms{"", "dòlar de Singapur", "", "", "", "dòlars de Singapur"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "lliura de Santa Helena", PluralForms{"", "lliura de Santa Helena", "", "", "", "lliures de Santa Helena"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tolar eslovè", PluralForms{"", "tolar eslovè", "", "", "", "tolars eslovens"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "corona eslovaca", PluralForms{"", "corona eslovaca", "", "", "", "corones eslovaques"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone de Sierra Leone", PluralForms{"", "leone de Sierra Leone", "", "", "", "leones de Sierra Leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone de Sierra Leone (1964—2022)", PluralForms{"", "leone de Sierra Leone (1964—2022)", "", "", "", "leones de Sierra Leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "xíling somali", PluralForms{"", "xíling somali", "", "", "", "xílings somalis"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dòlar de Surinam", PluralForms{"", "dòlar de Surinam", "", "", "", "dòlars de Surinam"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "florí de Surinam", PluralForms{"", "florí de Surinam", "", "", "", "florins de Surinam"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "lliura del Sudan del Sud", PluralForms{"", "lliura del Sudan del Sud", "", "", "", "lliures del Sudan del Sud"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra de São Tomé i Príncipe (1977–2017)", PluralForms{"", "dobra de São Tomé i Príncipe (1977–2017)", "", "", "", "dobras de São Tomé i Príncipe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra de São Tomé i Príncipe", PluralForms{"", "dobra de São Tomé i Príncipe", "", "", "", "dobras de São Tomé i Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "ruble soviètic", PluralForms{"", "ruble soviètic", "", "", "", "rubles soviètics"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colon salvadorenc", PluralForms{"", "colón salvadorenc", "", "", "", "colones salvadorencs"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "lliura siriana", PluralForms{"", "lliura siriana", "", "", "", "lliures sirianes"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni swazi", PluralForms{"", "lilangeni swazi", "", "", "", "emalangeni swazis"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "baht", PluralForms{"", "baht", "", "", "", "bahts"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "ruble tadjik", PluralForms{"", "ruble tadjik", "", "", "", "rubles tadjiks"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tadjik", PluralForms{"", "somoni tadjik", "", "", "", "somonis tadjiks"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turcman (1993–2009)", PluralForms{"", "manat turcman (1993–2009)", "", "", "", "manats turcmans (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turcman", PluralForms{"", "manat turcman", "", "", "", "manats turcmans"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunisià", PluralForms{"", "dinar tunisià", "", "", "", "dinars tunisians"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "pa‘anga tongà", PluralForms{"", "pa‘anga tongà", "", "", "", "pa‘angas tongans"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "escut de Timor", PluralForms{"", "escut de Timor", "", "", "", "escuts de Timor"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "lira turca (1922–2005)", PluralForms{"", "lira turca (1922–2005)", "", "", "", "lires turques (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turca", PluralForms{"", "lira turca", "", "", "", "lires turques"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dòlar de Trinitat i Tobago", PluralForms{"", "dòlar de Trinitat i Tobago", "", "", "", "dòlars de Trinitat i Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "nou dòlar de Taiwan", PluralForms{"", "nou dòlar de Taiwan", "", "", "", "nous dòlars de Taiwan"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "xíling tanzà", PluralForms{"", "xíling tanzà", "", "", "", "xílings tanzans"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hrívnia", PluralForms{"", "hrívnia", "", "", "", "hrívnies"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbóvanets ucraïnès", PluralForms{"", "karbóvanets ucraïnès", "", "", "", "karbóvantsiv ucraïnesos"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "xíling ugandès (1966–1987)", PluralForms{"", "xíling ugandès (1966–1987)", "", "", "", "xílings ugandesos (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "xíling ugandès", PluralForms{"", "xíling ugandès", "", "", "", "xílings ugandesos"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "dòlar dels Estats Units", PluralForms{"", "dòlar dels Estats Units", "", "", "", "dòlars dels Estats Units"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dòlar dels Estats Units (dia següent)", PluralForms{"", "dòlar dels Estats Units (dia següent)", "", "", "", "dòlars dels Estats Units (dia següent)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dòlar dels Estats Units (mateix dia)", PluralForms{"", "dòlar dels Estats Units (mateix dia)", "", "", "", "dòlars dels Estats Units (mateix dia)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "peso uruguaià en unitats indexades", PluralForms{"", "peso uruguaià en unitats indexades", "", "", "", "pesos uruguaians en unitats indexades"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso uruguaià (1975–1993)", PluralForms{"", "peso uruguaià (1975–1993)", "", "", "", "pesos uruguaians (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguaià", PluralForms{"", "peso uruguaià", "", "", "", "pesos uruguaians"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "som uzbek", PluralForms{"", "som uzbek", "", "", "", "soms uzbeks"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "bolívar veneçolà (1871–2008)", PluralForms{"", "bolívar veneçolà (1871–2008)", "", "", "", "bolívars veneçolans (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs F", "bolívar veneçolà (2008–2018)", PluralForms{"", "bolívar veneçolà (2008–2018)", "", "", "", "bolívars veneçolans (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar veneçolà", PluralForms{"", "bolívar veneçolà", "", "", "", "bolívars veneçolans"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "dong vietnamita", PluralForms{"", "dong vietnamita", "", "", "", "dongs vietnamites"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "dong vietnamita (1978–1985)", PluralForms{"", "dong vietnamita (1978–1985)", "", "", "", "dongs vietnamites (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu de Vanuatu", PluralForms{"", "vatu de Vanuatu", "", "", "", "vatus de Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoà", PluralForms{"", "tala samoà", "", "", "", "tales samoans"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franc CFA BEAC", PluralForms{"", "franc CFA BEAC", "", "", "", "francs CFA BEAC"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "plata", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "or", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "unitat compensatòria europea", PluralForms{"", "unitat compensatòria europea", "", "", "", "unitats compensatòries europees"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "unitat monetària europea", PluralForms{"", "unitat monetària europea", "", "", "", "unitats monetàries europees"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "unitat de compte europea (XBC)", PluralForms{"", "unitat de compte europea (XBC)", "", "", "", "unitats de compte europees (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "unitat de compte europea (XBD)", PluralForms{"", "unitat de compte europea (XBD)", "", "", "", "unitats de compte europees (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "dòlar del Carib Oriental", PluralForms{"", "dòlar del Carib Oriental", "", "", "", "dòlars del Carib Oriental"}},
+		"XCG": {2, 0, 2, 1, "XCG", "XCG", "XCG", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "drets especials de gir", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "unitat de moneda europea", PluralForms{"", "unitat de moneda europea", "", "", "", "unitats de moneda europees"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franc or francès", PluralForms{"", "franc or francès", "", "", "", "francs or francesos"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franc UIC francès", PluralForms{"", "franc UIC francès", "", "", "", "francs UIC francesos"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franc CFA BCEAO", PluralForms{"", "franc CFA BCEAO", "", "", "", "francs CFA BCEAO"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "pal·ladi", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franc CFP", PluralForms{"", "franc CFP", "", "", "", "francs CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platí", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "fons RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "codi reservat per a proves", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "moneda desconeguda", PluralForms{"", "(unitat monetària desconeguda)", "", "", "", "(moneda desconeguda)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar iemenita", PluralForms{"", "dinar iemenita", "", "", "", "dinars iemenites"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial iemenita", PluralForms{"", "rial iemenita", "", "", "", "rials iemenites"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "dinar fort iugoslau", PluralForms{"", "dinar fort iugoslau", "", "", "", "dinars forts iugoslaus"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "nou dinar iugoslau", PluralForms{"", "nou dinar iugoslau", "", "", "", "nous dinars iugoslaus"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar convertible iugoslau", PluralForms{"", "dinar convertible iugoslau", "", "", "", "dinars convertibles iugoslaus"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "dinar iugoslau reformat (1992–1993)", PluralForms{"", "dinar reformat iugoslau", "", "", "", "dinars reformats iugoslaus"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand sud-africà (financer)", PluralForms{"", "rand sud-africà (financer)", "", "", "", "rands sud-africans (financers)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sud-africà", PluralForms{"", "rand sud-africà", "", "", "", "rands sud-africans"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha zambià (1968–2012)", PluralForms{"", "kwacha zambià (1968–2012)", "", "", "", "kwacha zambians (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambià", PluralForms{"", "kwacha zambià", "", "", "", "kwacha zambians"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "nou zaire zairès", PluralForms{"", "nou zaire zairès", "", "", "", "nous zaires zairesos"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zaire zairès", PluralForms{"", "zaire zairès", "", "", "", "zaires zairesos"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dòlar zimbabuès (1980–2008)", PluralForms{"", "dòlar zimbabuès (1980–2008)", "", "", "", "dòlars zimbabuesos (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dòlar zimbabuès (2009)", PluralForms{"", "dòlar zimbabuès (2009)", "", "", "", "dòlars zimbabuesos (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "dòlar zimbabuès (2008)", PluralForms{"", "dòlar zimbabuès (2008)", "", "", "", "dòlars zimbabuesos (2008)"}},
 	},
 }
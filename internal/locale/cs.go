@@ -6,312 +6,365 @@ var cs = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{4, 2, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{5, 3, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{6, 1, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{7, 2, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{8, 3, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{9, 1, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{10, 2, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{11, 3, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tisíce", "many": "0 tisíce", "one": "0 tisíc", "other": "0 tisíc"}},
+				{4, 2, map[string]string{"few": "0 tisíc", "many": "0 tisíce", "one": "0 tisíc", "other": "0 tisíc"}},
+				{5, 3, map[string]string{"few": "0 tisíc", "many": "0 tisíce", "one": "0 tisíc", "other": "0 tisíc"}},
+				{6, 1, map[string]string{"few": "0 miliony", "many": "0 milionu", "one": "0 milion", "other": "0 milionů"}},
+				{7, 2, map[string]string{"few": "0 milionů", "many": "0 milionu", "one": "0 milionů", "other": "0 milionů"}},
+				{8, 3, map[string]string{"few": "0 milionů", "many": "0 milionu", "one": "0 milionů", "other": "0 milionů"}},
+				{9, 1, map[string]string{"few": "0 miliardy", "many": "0 miliardy", "one": "0 miliarda", "other": "0 miliard"}},
+				{10, 2, map[string]string{"few": "0 miliard", "many": "0 miliardy", "one": "0 miliard", "other": "0 miliard"}},
+				{11, 3, map[string]string{"few": "0 miliard", "many": "0 miliardy", "one": "0 miliard", "other": "0 miliard"}},
+				{12, 1, map[string]string{"few": "0 biliony", "many": "0 bilionu", "one": "0 bilion", "other": "0 bilionů"}},
+				{13, 2, map[string]string{"few": "0 bilionů", "many": "0 bilionu", "one": "0 bilionů", "other": "0 bilionů"}},
+				{14, 3, map[string]string{"few": "0 bilionů", "many": "0 bilionu", "one": "0 bilionů", "other": "0 bilionů"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tis.\u00a0¤", "many": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tis.\u00a0¤", "many": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tis.\u00a0¤", "many": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mil.\u00a0¤", "many": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mil.\u00a0¤", "many": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mil.\u00a0¤", "many": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mld.\u00a0¤", "many": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mld.\u00a0¤", "many": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mld.\u00a0¤", "many": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "many": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "many": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "many": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "Kčs", "Kčs"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "Kč", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "ECU", "ECU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorrská peseta", PluralForms{"", "andorrská peseta", "", "andorrské pesety", "andorrské pesety", "andorrských peset"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "SAE dirham", PluralForms{"", "SAE dirham", "", "SAE dirhamy", "SAE dirhamu", "SAE dirhamů"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afghánský afghán (1927–2002)", PluralForms{"", "afghánský afghán (1927–2002)", "", "afghánské afghány (1927–2002)", "afghánského afghánu (1927–2002)", "afghánských afghánů (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghánský afghán", PluralForms{"", "afghánský afghán", "", "afghánské afghány", "afghánského afghánu", "afghánských afghánů"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "albánský lek (1946–1965)", PluralForms{"", "albánský lek (1946–1965)", "", "albánské leky (1946–1965)", "albánského leku (1946–1965)", "albánských leků (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albánský lek", PluralForms{"", "albánský lek", "", "albánské leky", "albánského leku", "albánských leků"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "arménský dram", PluralForms{"", "arménský dram", "", "arménské dramy", "arménského dramu", "arménských dramů"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "nizozemskoantilský gulden", PluralForms{"", "nizozemskoantilský gulden", "", "nizozemskoantilské guldeny", "nizozemskoantilského guldenu", "nizozemskoantilských guldenů"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolská kwanza", PluralForms{"", "angolská kwanza", "", "angolské kwanzy", "angolské kwanzy", "angolských kwanz"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "angolská kwanza (1977–1991)", PluralForms{"", "angolská kwanza (1977–1991)", "", "angolské kwanzy (1977–1991)", "angolské kwanzy (1977–1991)", "angolských kwanz (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolská kwanza (1990–2000)", PluralForms{"", "angolská kwanza (1990–2000)", "", "angolské kwanzy (1990–2000)", "angolské kwanzy (1990–2000)", "angolských kwanz (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "angolská kwanza (1995–1999)", PluralForms{"", "angolská nový kwanza (1995–1999)", "", "angolská kwanza (1995–1999)", "angolské kwanzy (1995–1999)", "angolských kwanz (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentinský austral", PluralForms{"", "argentinský austral", "", "argentinské australy", "argentinského australu", "argentinských australů"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "argentinské peso ley (1970–1983)", PluralForms{"", "argentinské peso ley (1970–1983)", "", "argentinská pesa ley (1970–1983)", "argentinského pesa ley (1970–1983)", "argentinských pes ley (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "argentinské peso (1881–1970)", PluralForms{"", "argentinské peso (1881–1970)", "", "argentinská pesa (1881–1970)", "argentinského pesa (1881–1970)", "argentinských pes (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentinské peso (1983–1985)", PluralForms{"", "argentinské peso (1983–1985)", "", "argentinská pesa (1983–1985)", "argentinského pesa (1983–1985)", "argentinských pes (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinské peso", PluralForms{"", "argentinské peso", "", "argentinská pesa", "argentinského pesa", "argentinských pes"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "rakouský šilink", PluralForms{"", "rakouský šilink", "", "rakouské šilinky", "rakouského šilinku", "rakouských šilinků"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "australský dolar", PluralForms{"", "australský dolar", "", "australské dolary", "australského dolaru", "australských dolarů"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubský zlatý", PluralForms{"", "arubský zlatý", "", "arubské zlaté", "arubského zlatého", "arubských zlatých"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "ázerbájdžánský manat (1993–2006)", PluralForms{"", "ázerbájdžánský manat (1993–2006)", "", "ázerbájdžánské manaty (1993–2006)", "ázerbájdžánského manatu (1993–2006)", "ázerbájdžánských manatů (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "ázerbájdžánský manat", PluralForms{"", "ázerbájdžánský manat", "", "ázerbájdžánské manaty", "ázerbájdžánského manatu", "ázerbájdžánských manatů"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosenský dinár (1992–1994)", PluralForms{"", "bosenský dinár (1992–1994)", "", "bosenské dináry (1992–1994)", "bosenského dináru (1992–1994)", "bosenských dinárů (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosenská konvertibilní marka", PluralForms{"", "bosenská konvertibilní marka", "", "bosenské konvertibilní marky", "bosenské konvertibilní marky", "bosenských konvertibilních marek"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "bosenský nový dinár (1994–1997)", PluralForms{"", "bosenský nový dinár (1994–1997)", "", "bosenské nové dináry (1994–1997)", "bosenského nového dináru (1994–1997)", "bosenských nových dinárů (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoský dolar", PluralForms{"", "barbadoský dolar", "", "barbadoské dolary", "barbadoského dolaru", "barbadoských dolarů"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladéšská taka", PluralForms{"", "bangladéšská taka", "", "bangladéšské taky", "bangladéšské taky", "bangladéšských tak"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgický konvertibilní frank", PluralForms{"", "belgický konvertibilní frank", "", "belgické konvertibilní franky", "belgického konvertibilního franku", "belgických konvertibilních franků"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgický frank", PluralForms{"", "belgický frank", "", "belgické franky", "belgického franku", "belgických franků"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgický finanční frank", PluralForms{"", "belgický finanční frank", "", "belgické finanční franky", "belgického finančního franku", "belgických finančních franků"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "bulharský tvrdý leva", PluralForms{"", "bulharský tvrdý leva", "", "bulharské tvrdé leva", "bulharského tvrdého leva", "bulharských tvrdých leva"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "bulharský socialistický leva", PluralForms{"", "bulharský socialistický leva", "", "bulharské socialistické leva", "bulharského socialistického leva", "bulharských socialistických leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bulharský leva", PluralForms{"", "bulharský leva", "", "bulharské leva", "bulharského leva", "bulharských leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "bulharský lev (1879–1952)", PluralForms{"", "bulharský lev (1879–1952)", "", "bulharské leva (1879–1952)", "bulharského leva (1879–1952)", "bulharských leva (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrajnský dinár", PluralForms{"", "bahrajnský dinár", "", "bahrajnské dináry", "bahrajnského dináru", "bahrajnských dinárů"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundský frank", PluralForms{"", "burundský frank", "", "burundské franky", "burundského franku", "burundských franků"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudský dolar", PluralForms{"", "bermudský dolar", "", "bermudské dolary", "bermudského dolaru", "bermudských dolarů"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "brunejský dolar", PluralForms{"", "brunejský dolar", "", "brunejské dolary", "brunejského dolaru", "brunejských dolarů"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolivijský boliviano", PluralForms{"", "bolivijský boliviano", "", "bolivijské bolivianos", "bolivijského boliviana", "bolivijských bolivianos"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "bolivijský boliviano (1863–1963)", PluralForms{"", "bolivijský boliviano (1863–1963)", "", "bolivijské bolivianos (1863–1963)", "bolivijského boliviana (1863–1963)", "bolivijských bolivianos (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "bolivijské peso", PluralForms{"", "bolivijské peso", "", "bolivijská pesa", "bolivijského pesa", "bolivijských pes"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "bolivijský mvdol", PluralForms{"", "bolivijský mvdol", "", "bolivijské mvdoly", "bolivijského mvdolu", "bolivijských mvdolů"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brazilské nové cruzeiro (1967–1986)", PluralForms{"", "brazilské nové cruzeiro (1967–1986)", "", "brazilská nová cruzeira (1967–1986)", "brazilského nového cruzeira (1967–1986)", "brazilských nových cruzeir (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brazilské cruzado (1986–1989)", PluralForms{"", "brazilské cruzado (1986–1989)", "", "brazilská cruzada (1986–1989)", "brazilského cruzada (1986–1989)", "brazilských cruzad (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "brazilské cruzeiro (1990–1993)", PluralForms{"", "brazilské cruzeiro (1990–1993)", "", "brazilská cruzeira (1990–1993)", "brazilského cruzeira (1990–1993)", "brazilských cruzeir (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "brazilský real", PluralForms{"", "brazilský real", "", "brazilské realy", "brazilského realu", "brazilských realů"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "brazilské nové cruzado (1989–1990)", PluralForms{"", "brazilské nové cruzado (1989–1990)", "", "brazilská nová cruzada (1989–1990)", "brazilského nového cruzada (1989–1990)", "brazilských nových cruzad (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "brazilské cruzeiro (1993–1994)", PluralForms{"", "brazilské cruzeiro (1993–1994)", "", "brazilská cruzeira (1993–1994)", "brazilského cruzeira (1993–1994)", "brazilských cruzeir (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "brazilské cruzeiro (1942–1967)", PluralForms{"", "brazilské cruzeiro (1942–1967)", "", "brazilská cruzeira (1942–1967)", "brazilského cruzeira (1942–1967)", "brazilských cruzeir (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamský dolar", PluralForms{"", "bahamský dolar", "", "bahamské dolary", "bahamského dolaru", "bahamských dolarů"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bhútánský ngultrum", PluralForms{"", "bhútánský ngultrum", "", "bhútánské ngultrumy", "bhútánského ngultrumu", "bhútánských ngultrumů"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "barmský kyat", PluralForms{"", "barmský kyat", "", "barmské kyaty", "barmského kyatu", "barmských kyatů"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botswanská pula", PluralForms{"", "botswanská pula", "", "botswanské puly", "botswanské puly", "botswanských pul"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "běloruský rubl (1994–1999)", PluralForms{"", "běloruský rubl (1994–1999)", "", "běloruské rubly (1994–1999)", "běloruského rublu (1994–1999)", "běloruských rublů (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "běloruský rubl", PluralForms{"", "běloruský rubl", "", "běloruské rubly", "běloruského rublu", "běloruských rublů"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "běloruský rubl (2000–2016)", PluralForms{"", "běloruský rubl (2000–2016)", "", "běloruské rubly (2000–2016)", "běloruského rublu (2000–2016)", "běloruských rublů (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belizský dolar", PluralForms{"", "belizský dolar", "", "belizské dolary", "belizského dolaru", "belizských dolarů"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "kanadský dolar", PluralForms{"", "kanadský dolar", "", "kanadské dolary", "kanadského dolaru", "kanadských dolarů"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "konžský frank", PluralForms{"", "konžský frank", "", "konžské franky", "konžského franku", "konžských franků"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "švýcarské WIR-euro", PluralForms{"", "švýcarské WIR-euro", "", "švýcarská WIR-eura", "švýcarského WIR-eura", "švýcarských WIR-eur"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "švýcarský frank", PluralForms{"", "švýcarský frank", "", "švýcarské franky", "švýcarského franku", "švýcarských franků"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "švýcarský WIR-frank", PluralForms{"", "švýcarský WIR-frank", "", "švýcarské WIR-franky", "švýcarského WIR-franku", "švýcarských WIR-franků"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "chilské escudo", PluralForms{"", "chilské escudo", "", "chilská escuda", "chilského escuda", "chilských escud"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "chilská účetní jednotka (UF)", PluralForms{"", "chilská účetní jednotka (UF)", "", "chilské účetní jednotky (UF)", "chilské účetní jednotky (UF)", "chilských účetních jednotek (UF)"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "chilské peso", PluralForms{"", "chilské peso", "", "chilská pesa", "chilského pesa", "chilských pes"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "čínský jüan (offshore)", PluralForms{"", "čínský jüan (offshore)", "", "čínské jüany (offshore)", "čínského jüanu (offshore)", "čínských jüanů (offshore)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "čínský dolar ČLB", PluralForms{"", "čínský dolar ČLB", "", "čínské dolary ČLB", "čínského dolaru ČLB", "čínských dolarů ČLB"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "čínský jüan", PluralForms{"", "čínský jüan", "", "čínské jüany", "čínského jüanu", "čínských jüanů"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kolumbijské peso", PluralForms{"", "kolumbijské peso", "", "kolumbijská pesa", "kolumbijského pesa", "kolumbijských pes"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "kolumbijská jednotka reálné hodnoty", PluralForms{"", "kolumbijská jednotka reálné hodnoty", "", "kolumbijské jednotky reálné hodnoty", "kolumbijské jednotky reálné hodnoty", "kolumbijských jednotek reálné hodnoty"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kostarický colón", PluralForms{"", "kostarický colón", "", "kostarické colóny", "kostarického colónu", "kostarických colónů"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "srbský dinár (2002–2006)", PluralForms{"", "srbský dinár (2002–2006)", "", "srbské dináry (2002–2006)", "srbského dináru (2002–2006)", "srbských dinárů (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "Kčs", "Kčs", "československá koruna", PluralForms{"", "československá koruna", "", "československé koruny", "československé koruny", "československých korun"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubánské konvertibilní peso", PluralForms{"", "kubánské konvertibilní peso", "", "kubánská konvertibilní pesa", "kubánského konvertibilního pesa", "kubánských konvertibilních pes"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubánské peso", PluralForms{"", "kubánské peso", "", "kubánská pesa", "kubánského pesa", "kubánských pes"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "kapverdské escudo", PluralForms{"", "kapverdské escudo", "", "kapverdská escuda", "kapverdského escuda", "kapverdských escud"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "kyperská libra", PluralForms{"", "kyperská libra", "", "kyperské libry", "kyperské libry", "kyperských liber"}},
+		"CZK": {2, 0, 0, 1, "CZK", "Kč", "Kč", "česká koruna", PluralForms{"", "česká koruna", "", "české koruny", "české koruny", "českých korun"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "východoněmecká marka", PluralForms{"", "východoněmecká marka", "", "východoněmecké marky", "východoněmecké marky", "východoněmeckých marek"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "německá marka", PluralForms{"", "německá marka", "", "německé marky", "německé marky", "německých marek"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "džibutský frank", PluralForms{"", "džibutský frank", "", "džibutské franky", "džibutského franku", "džibutských franků"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "dánská koruna", PluralForms{"", "dánská koruna", "", "dánské koruny", "dánské koruny", "dánských korun"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikánské peso", PluralForms{"", "dominikánské peso", "", "dominikánská pesa", "dominikánského pesa", "dominikánských pes"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "alžírský dinár", PluralForms{"", "alžírský dinár", "", "alžírské dináry", "alžírského dináru", "alžírských dinárů"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ekvádorský sucre", PluralForms{"", "ekvádorský sucre", "", "ekvádorské sucre", "ekvádorského sucre", "ekvádorských sucre"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ekvádorská jednotka konstantní hodnoty", PluralForms{"", "ekvádorská jednotka konstantní hodnoty", "", "ekvádorské jednotky konstantní hodnoty", "ekvádorské jednotky konstantní hodnoty", "ekvádorských jednotek konstantní hodnoty"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "estonská koruna", PluralForms{"", "estonská koruna", "", "estonské koruny", "estonské koruny", "estonských korun"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egyptská libra", PluralForms{"", "egyptská libra", "", "egyptské libry", "egyptské libry", "egyptských liber"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritrejská nakfa", PluralForms{"", "eritrejská nakfa", "", "eritrejské nakfy", "eritrejské nakfy", "eritrejských nakf"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "španělská peseta („A“ účet)", PluralForms{"", "španělská peseta („A“ účet)", "", "španělské pesety („A“ účet)", "španělské pesety („A“ účet)", "španělských peset („A“ účet)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "španělská peseta (konvertibilní účet)", PluralForms{"", "španělská peseta (konvertibilní účet)", "", "španělské pesety (konvertibilní účet)", "španělské pesety (konvertibilní účet)", "španělských peset (konvertibilní účet)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "španělská peseta", PluralForms{"", "španělská peseta", "", "španělské pesety", "španělské pesety", "španělských peset"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopský birr", PluralForms{"", "etiopský birr", "", "etiopské birry", "etiopského birru", "etiopských birrů"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "eura", "eura", "eur"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "finská marka", PluralForms{"", "finská marka", "", "finské marky", "finské marky", "finských marek"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fidžijský dolar", PluralForms{"", "fidžijský dolar", "", "fidžijské dolary", "fidžijského dolaru", "fidžijských dolarů"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklandská libra", PluralForms{"", "falklandská libra", "", "falklandské libry", "falklandské libry", "falklandských liber"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "francouzský frank", PluralForms{"", "francouzský frank", "", "francouzské franky", "francouzského franku", "francouzských franků"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "britská libra", PluralForms{"", "britská libra", "", "britské libry", "britské libry", "britských liber"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "gruzínské kuponové lari", PluralForms{"", "gruzínské kuponové lari", "", "gruzínské kuponové lari", "gruzínského kuponového lari", "gruzínských kuponových lari"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "gruzínské lari", PluralForms{"", "gruzínské lari", "", "gruzínské lari", "gruzínského lari", "gruzínských lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ghanský cedi (1979–2007)", PluralForms{"", "ghanský cedi (1979–2007)", "", "ghanské cedi (1979–2007)", "ghanského cedi (1979–2007)", "ghanských cedi (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ghanský cedi", PluralForms{"", "ghanský cedi", "", "ghanské cedi", "ghanského cedi", "ghanských cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltarská libra", PluralForms{"", "gibraltarská libra", "", "gibraltarské libry", "gibraltarské libry", "gibraltarských liber"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambijský dalasi", PluralForms{"", "gambijský dalasi", "", "gambijské dalasi", "gambijského dalasi", "gambijských dalasi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "guinejský frank", PluralForms{"", "guinejský frank", "", "guinejské franky", "guinejského franku", "guinejských franků"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "guinejský syli", PluralForms{"", "guinejský syli", "", "guinejské syli", "guinejského syli", "guinejských syli"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "rovníkovoguinejský ekwele", PluralForms{"", "rovníkovoguinejský ekwele", "", "rovníkovoguinejské ekwele", "rovníkovoguinejského ekwele", "rovníkovoguinejských ekwele"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "řecká drachma", PluralForms{"", "řecká drachma", "", "řecké drachmy", "řecké drachmy", "řeckých drachem"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "guatemalský quetzal", PluralForms{"", "guatemalský quetzal", "", "guatemalské quetzaly", "guatemalského quetzalu", "guatemalských quetzalů"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "portugalskoguinejské escudo", PluralForms{"", "portugalskoguinejské escudo", "", "portugalskoguinejská escuda", "portugalskoguinejského escuda", "portugalskoguinejských escud"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "guinejsko-bissauské peso", PluralForms{"", "guinejsko-bissauské peso", "", "guinejsko-bissauská pesa", "guinejsko-bissauského pesa", "guinejsko-bissauských pes"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "guyanský dolar", PluralForms{"", "guyanský dolar", "", "guyanské dolary", "guyanského dolaru", "guyanských dolarů"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "hongkongský dolar", PluralForms{"", "hongkongský dolar", "", "hongkongské dolary", "hongkongského dolaru", "hongkongských dolarů"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduraská lempira", PluralForms{"", "honduraská lempira", "", "honduraské lempiry", "honduraské lempiry", "honduraských lempir"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "chorvatský dinár", PluralForms{"", "chorvatský dinár", "", "chorvatské dináry", "chorvatského dináru", "chorvatských dinárů"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "chorvatská kuna", PluralForms{"", "chorvatská kuna", "", "chorvatské kuny", "chorvatské kuny", "chorvatských kun"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitský gourde", PluralForms{"", "haitský gourde", "", "haitské gourde", "haitského gourde", "haitských gourde"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "maďarský forint", PluralForms{"", "maďarský forint", "", "maďarské forinty", "maďarského forintu", "maďarských forintů"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonéská rupie", PluralForms{"", "indonéská rupie", "", "indonéské rupie", "indonéské rupie", "indonéských rupií"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "irská libra", PluralForms{"", "irská libra", "", "irské libry", "irské libry", "irských liber"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "izraelská libra", PluralForms{"", "izraelská libra", "", "izraelské libry", "izraelské libry", "izraelských liber"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "izraelský šekel (1980–1985)", PluralForms{"", "izraelský šekel (1980–1985)", "", "izraelské šekely (1980–1985)", "izraelského šekelu (1980–1985)", "izraelských šekelů (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "izraelský nový šekel", PluralForms{"", "izraelský nový šekel", "", "izraelské nové šekely", "izraelského nového šekelu", "izraelských nových šekelů"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "indická rupie", PluralForms{"", "indická rupie", "", "indické rupie", "indické rupie", "indických rupií"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irácký dinár", PluralForms{"", "irácký dinár", "", "irácké dináry", "iráckého dináru", "iráckých dinárů"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "íránský rijál", PluralForms{"", "íránský rijál", "", "íránské rijály", "íránského rijálu", "íránských rijálů"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "islandská koruna (1918–1981)", PluralForms{"", "islandská koruna (1918–1981)", "", "islandské koruny (1918–1981)", "islandské koruny (1918–1981)", "islandských korun (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandská koruna", PluralForms{"", "islandská koruna", "", "islandské koruny", "islandské koruny", "islandských korun"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "italská lira", PluralForms{"", "italská lira", "", "italské liry", "italské liry", "italských lir"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamajský dolar", PluralForms{"", "jamajský dolar", "", "jamajské dolary", "jamajského dolaru", "jamajských dolarů"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordánský dinár", PluralForms{"", "jordánský dinár", "", "jordánské dináry", "jordánského dináru", "jordánských dinárů"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "japonský jen", PluralForms{"", "japonský jen", "", "japonské jeny", "japonského jenu", "japonských jenů"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "keňský šilink", PluralForms{"", "keňský šilink", "", "keňské šilinky", "keňského šilinku", "keňských šilinků"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kyrgyzský som", PluralForms{"", "kyrgyzský som", "", "kyrgyzské somy", "kyrgyzského somu", "kyrgyzských somů"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodžský riel", PluralForms{"", "kambodžský riel", "", "kambodžské riely", "kambodžského rielu", "kambodžských rielů"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorský frank", PluralForms{"", "komorský frank", "", "komorské franky", "komorského franku", "komorských franků"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "severokorejský won", PluralForms{"", "severokorejský won", "", "severokorejské wony", "severokorejského wonu", "severokorejských wonů"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "jihokorejský hwan (1953–1962)", PluralForms{"", "jihokorejský hwan (1953–1962)", "", "jihokorejské hwany (1953–1962)", "jihokorejského hwanu (1953–1962)", "jihokorejských hwanů (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "jihokorejský won (1945–1953)", PluralForms{"", "jihokorejský won (1945–1953)", "", "jihokorejské wony (1945–1953)", "jihokorejského wonu (1945–1953)", "jihokorejských wonů (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "jihokorejský won", PluralForms{"", "jihokorejský won", "", "jihokorejské wony", "jihokorejského wonu", "jihokorejských wonů"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuvajtský dinár", PluralForms{"", "kuvajtský dinár", "", "kuvajtské dináry", "kuvajtského dináru", "kuvajtských dinárů"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "kajmanský dolar", PluralForms{"", "kajmanský dolar", "", "kajmanské dolary", "kajmanského dolaru", "kajmanských dolarů"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazašské tenge", PluralForms{"", "kazašské tenge", "", "kazašské tenge", "kazašského tenge", "kazašských tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoský kip", PluralForms{"", "laoský kip", "", "laoské kipy", "laoského kipu", "laoských kipů"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanonská libra", PluralForms{"", "libanonská libra", "", "libanonské libry", "libanonské libry", "libanonských liber"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "srílanská rupie", PluralForms{"", "srílanská rupie", "", "srílanské rupie", "srílanské rupie", "srílanských rupií"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberijský dolar", PluralForms{"", "liberijský dolar", "", "liberijské dolary", "liberijského dolaru", "liberijských dolarů"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesothský loti", PluralForms{"", "lesothský loti", "", "lesothské maloti", "lesothského loti", "lesothských maloti"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litevský litas", PluralForms{"", "litevský litas", "", "litevské lity", "litevského litu", "litevských litů"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "litevský talonas", PluralForms{"", "litevský talonas", "", "litevské talony", "litevského talonu", "litevských talonů"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "lucemburský konvertibilní frank", PluralForms{"", "lucemburský konvertibilní frank", "", "lucemburské konvertibilní franky", "lucemburského konvertibilního franku", "lucemburských konvertibilních franků"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "lucemburský frank", PluralForms{"", "lucemburský frank", "", "lucemburské franky", "lucemburského franku", "lucemburských franků"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "lucemburský finanční frank", PluralForms{"", "lucemburský finanční frank", "", "lucemburské finanční franky", "lucemburského finančního franku", "lucemburských finančních franků"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lotyšský lat", PluralForms{"", "lotyšský lat", "", "lotyšské laty", "lotyšského latu", "lotyšských latů"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "lotyšský rubl", PluralForms{"", "lotyšský rubl", "", "lotyšské rubly", "lotyšského rublu", "lotyšských rublů"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libyjský dinár", PluralForms{"", "libyjský dinár", "", "libyjské dináry", "libyjského dináru", "libyjských dinárů"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marocký dinár", PluralForms{"", "marocký dinár", "", "marocké dináry", "marockého dináru", "marockých dinárů"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "marocký frank", PluralForms{"", "marocký frank", "", "marocké franky", "marockého franku", "marockých franků"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "monacký frank", PluralForms{"", "monacký frank", "", "monacké franky", "monackého franku", "monackých franků"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "moldavský kupon", PluralForms{"", "moldavský kupon", "", "moldavské kupony", "moldavského kuponu", "moldavských kuponů"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavský leu", PluralForms{"", "moldavský leu", "", "moldavské lei", "moldavského leu", "moldavských lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "madagaskarský ariary", PluralForms{"", "madagaskarský ariary", "", "madagaskarské ariary", "madagaskarského ariary", "madagaskarských ariary"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "madagaskarský frank", PluralForms{"", "madagaskarský frank", "", "madagaskarské franky", "madagaskarského franku", "madagaskarských franků"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonský denár", PluralForms{"", "makedonský denár", "", "makedonské denáry", "makedonského denáru", "makedonských denárů"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "makedonský denár (1992–1993)", PluralForms{"", "makedonský denár (1992–1993)", "", "makedonské denáry (1992–1993)", "makedonského denáru (1992–1993)", "makedonských denárů (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "malijský frank", PluralForms{"", "malijský frank", "", "malijské franky", "malijského franku", "malijských franků"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "myanmarský kyat", PluralForms{"", "myanmarský kyat", "", "myanmarské kyaty", "myanmarského kyatu", "myanmarských kyatů"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolský tugrik", PluralForms{"", "mongolský tugrik", "", "mongolské tugriky", "mongolského tugriku", "mongolských tugriků"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "macajská pataca", PluralForms{"", "macajská pataca", "", "macajské patacy", "macajské patacy", "macajských patac"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauritánská ouguiya (1973–2017)", PluralForms{"", "mauritánská ouguiya (1973–2017)", "", "mauritánské ouguiye (1973–2017)", "mauritánské ouguiye (1973–2017)", "mauritánských ouguiyí (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauritánská ouguiya", PluralForms{"", "mauritánská ouguiya", "", "mauritánské ouguiye", "mauritánské ouguiye", "mauritánských ouguiyí"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "maltská lira", PluralForms{"", "maltská lira", "", "maltské liry", "maltské liry", "maltských lir"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "maltská libra", PluralForms{"", "maltská libra", "", "maltské libry", "maltské libry", "maltských liber"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauricijská rupie", PluralForms{"", "mauricijská rupie", "", "mauricijské rupie", "mauricijské rupie", "mauricijských rupií"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "maledivská rupie (1947–1981)", PluralForms{"", "maledivská rupie (1947–1981)", "", "maledivské rupie (1947–1981)", "maledivské rupie (1947–1981)", "maledivských rupií (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maledivská rupie", PluralForms{"", "maledivská rupie", "", "maledivské rupie", "maledivské rupie", "maledivských rupií"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malawijská kwacha", PluralForms{"", "malawijská kwacha", "", "malawijské kwachy", "malawijské kwachy", "malawijských kwach"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "mexické peso", PluralForms{"", "mexické peso", "", "mexická pesa", "mexického pesa", "mexických pes"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "mexické stříbrné peso (1861–1992)", PluralForms{"", "mexické stříbrné peso (1861–1992)", "", "mexická stříbrná pesa (1861–1992)", "mexického stříbrného pesa (1861–1992)", "mexických stříbrných pes (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "mexická investiční jednotka", PluralForms{"", "mexická investiční jednotka", "", "mexické investiční jednotky", "mexické investiční jednotky", "mexických investičních jednotek"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malajsijský ringgit", PluralForms{"", "malajsijský ringgit", "", "malajsijské ringgity", "malajsijského ringgitu", "malajsijských ringgitů"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "mosambický escudo", PluralForms{"", "mosambický escudo", "", "mosambická escuda", "mosambického escuda", "mosambických escud"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "mosambický metical (1980–2006)", PluralForms{"", "mosambický metical (1980–2006)", "", "mosambické meticaly (1980–2006)", "mosambického meticalu (1980–2006)", "mosambických meticalů (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mozambický metical", PluralForms{"", "mozambický metical", "", "mozambické meticaly", "mozambického meticalu", "mozambických meticalů"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibijský dolar", PluralForms{"", "namibijský dolar", "", "namibijské dolary", "namibijského dolaru", "namibijských dolarů"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigerijská naira", PluralForms{"", "nigerijská naira", "", "nigerijské nairy", "nigerijské nairy", "nigerijských nair"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nikaragujská córdoba (1988–1991)", PluralForms{"", "nikaragujská córdoba (1988–1991)", "", "nikaragujské córdoby (1988–1991)", "nikaragujské córdoby (1988–1991)", "nikaragujských córdob (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nikaragujská córdoba", PluralForms{"", "nikaragujská córdoba", "", "nikaragujské córdoby", "nikaragujské córdoby", "nikaragujských córdob"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "nizozemský gulden", PluralForms{"", "nizozemský gulden", "", "nizozemské guldeny", "nizozemského guldenu", "nizozemských guldenů"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "norská koruna", PluralForms{"", "norská koruna", "", "norské koruny", "norské koruny", "norských korun"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepálská rupie", PluralForms{"", "nepálská rupie", "", "nepálské rupie", "nepálské rupie", "nepálských rupií"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "novozélandský dolar", PluralForms{"", "novozélandský dolar", "", "novozélandské dolary", "novozélandského dolaru", "novozélandských dolarů"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ománský rijál", PluralForms{"", "ománský rijál", "", "ománské rijály", "ománského rijálu", "ománských rijálů"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamská balboa", PluralForms{"", "panamská balboa", "", "panamské balboy", "panamské balboy", "panamských balboí"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "peruánská inti", PluralForms{"", "peruánská inti", "", "peruánské inti", "peruánské inti", "peruánských inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruánský sol", PluralForms{"", "peruánský sol", "", "peruánské soly", "peruánského solu", "peruánských solů"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "peruánský sol (1863–1965)", PluralForms{"", "peruánský sol (1863–1965)", "", "peruánské soly (1863–1965)", "peruánského solu (1863–1965)", "peruánských solů (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papuánská nová kina", PluralForms{"", "papuánská nová kina", "", "papuánské nové kiny", "papuánské nové kiny", "papuánských nových kin"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filipínské peso", PluralForms{"", "filipínské peso", "", "filipínská pesa", "filipínského pesa", "filipínských pes"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pákistánská rupie", PluralForms{"", "pákistánská rupie", "", "pákistánské rupie", "pákistánské rupie", "pákistánských rupií"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "polský zlotý", PluralForms{"", "polský zlotý", "", "polské zloté", "polského zlotého", "polských zlotých"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "polský zlotý (1950–1995)", PluralForms{"", "polský zlotý (1950–1995)", "", "polské zloté (1950–1995)", "polského zlotého (1950–1995)", "polských zlotých (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "portugalské escudo", PluralForms{"", "portugalské escudo", "", "portugalská escuda", "portugalského escuda", "portugalských escud"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paraguajské guarani", PluralForms{"", "paraguajské guarani", "", "paraguajská guarani", "paraguajského guarani", "paraguajských guarani"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarský rijál", PluralForms{"", "katarský rijál", "", "katarské rijály", "katarského rijálu", "katarských rijálů"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "rhodéský dolar", PluralForms{"", "rhodéský dolar", "", "rhodéské dolary", "rhodéského dolaru", "rhodéských dolarů"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "rumunské leu (1952–2006)", PluralForms{"", "rumunské leu (1952–2006)", "", "rumunské lei (1952–2006)", "rumunského leu (1952–2006)", "rumunských lei (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "rumunský leu", PluralForms{"", "rumunský leu", "", "rumunské lei", "rumunského leu", "rumunských lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "srbský dinár", PluralForms{"", "srbský dinár", "", "srbské dináry", "srbského dináru", "srbských dinárů"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruský rubl", PluralForms{"", "ruský rubl", "", "ruské rubly", "ruského rublu", "ruských rublů"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "ruský rubl (1991–1998)", PluralForms{"", "ruský rubl (1991–1998)", "", "ruské rubly (1991–1998)", "ruského rublu (1991–1998)", "ruských rublů (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "rwandský frank", PluralForms{"", "rwandský frank", "", "rwandské franky", "rwandského franku", "rwandských franků"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saúdský rijál", PluralForms{"", "saúdský rijál", "", "saúdské rijály", "saúdského rijálu", "saúdských rijálů"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "šalamounský dolar", PluralForms{"", "šalamounský dolar", "", "šalamounské dolary", "šalamounského dolaru", "šalamounských dolarů"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "seychelská rupie", PluralForms{"", "seychelská rupie", "", "seychelské rupie", "seychelské rupie", "seychelských rupií"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "súdánský dinár (1992–2007)", PluralForms{"", "súdánský dinár (1992–2007)", "", "súdánské dináry (1992–2007)", "súdánského dináru (1992–2007)", "súdánských dinárů (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "súdánská libra", PluralForms{"", "súdánská libra", "", "súdánské libry", "súdánské libry", "súdánských liber"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "súdánská libra (1957–1998)", PluralForms{"", "súdánská libra (1957–1998)", "", "súdánské libry (1957–1998)", "súdánské libry (1957–1998)", "súdánských liber (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "švédská koruna", PluralForms{"", "švédská koruna", "", "švédské koruny", "švédské koruny", "švédských korun"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapurský dolar", PluralForms{"", "singapurský dolar", "", "singapurské dolary", "singapurského dolaru", "singapurských dolarů"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "svatohelenská libra", PluralForms{"", "svatohelenská libra", "", "svatohelenské libry", "svatohelenské libry", "svatohelenských liber"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "slovinský tolar", PluralForms{"", "slovinský tolar", "", "slovinské tolary", "slovinského tolaru", "slovinských tolarů"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "slovenská koruna", PluralForms{"", "slovenská koruna", "", "slovenské koruny", "slovenské koruny", "slovenských korun"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierraleonský leone", PluralForms{"", "sierraleonský leone", "", "sierraleonské leone", "sierraleonského leone", "sierraleonských leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierraleonský leone (1964—2022)", PluralForms{"", "sierraleonský leone (1964—2022)", "", "sierraleonské leone (1964—2022)", "sierraleonského leone (1964—2022)", "sierraleonských leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somálský šilink", PluralForms{"", "somálský šilink", "", "somálské šilinky", "somálského šilinku", "somálských šilinků"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamský dolar", PluralForms{"", "surinamský dolar", "", "surinamské dolary", "surinamského dolaru", "surinamských dolarů"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "surinamský zlatý", PluralForms{"", "surinamský zlatý", "", "surinamské zlaté", "surinamského zlatého", "surinamských zlatých"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "jihosúdánská libra", PluralForms{"", "jihosúdánská libra", "", "jihosúdánské libry", "jihosúdánské libry", "jihosúdánských liber"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "svatotomášská dobra (1977–2017)", PluralForms{"", "svatotomášská dobra (1977–2017)", "", "svatotomášské dobry (1977–2017)", "svatotomášské dobry (1977–2017)", "svatotomášských dober (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "svatotomášská dobra", PluralForms{"", "svatotomášská dobra", "", "svatotomášské dobry", "svatotomášské dobry", "svatotomášských dober"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "sovětský rubl", PluralForms{"", "sovětský rubl", "", "sovětské rubly", "sovětského rublu", "sovětských rublů"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "salvadorský colón", PluralForms{"", "salvadorský colón", "", "salvadorské colóny", "salvadorského colónu", "salvadorských colónů"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "syrská libra", PluralForms{"", "syrská libra", "", "syrské libry", "syrské libry", "syrských liber"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "svazijský lilangeni", PluralForms{"", "svazijský lilangeni", "", "svazijské emalangeni", "svazijského lilangeni", "svazijských emalangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "thajský baht", PluralForms{"", "thajský baht", "", "thajské bahty", "thajského bahtu", "thajských bahtů"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "tádžický rubl", PluralForms{"", "tádžický rubl", "", "tádžické rubly", "tádžického rublu", "tádžických rublů"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tádžické somoni", PluralForms{"", "tádžické somoni", "", "tádžická somoni", "tádžického somoni", "tádžických somoni"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "turkmenský manat (1993–2009)", PluralForms{"", "turkmenský manat (1993–2009)", "", "turkmenské manaty (1993–2009)", "turkmenského manatu (1993–2009)", "turkmenských manatů (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmenský manat", PluralForms{"", "turkmenský manat", "", "turkmenské manaty", "turkmenského manatu", "turkmenských manatů"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tuniský dinár", PluralForms{"", "tuniský dinár", "", "tuniské dináry", "tuniského dináru", "tuniských dinárů"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tonžská paanga", PluralForms{"", "tonžská paanga", "", "tonžské paangy", "tonžské paangy", "tonžských paang"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "timorské escudo", PluralForms{"", "timorské escudo", "", "timorská escuda", "timorského escuda", "timorských escud"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "turecká lira (1922–2005)", PluralForms{"", "turecká lira (1922–2005)", "", "turecké liry (1922–2005)", "turecké liry (1922–2005)", "tureckých lir (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turecká lira", PluralForms{"", "turecká lira", "", "turecké liry", "turecké liry", "tureckých lir"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trinidadský dolar", PluralForms{"", "trinidadský dolar", "", "trinidadské dolary", "trinidadského dolaru", "trinidadských dolarů"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "tchajwanský dolar", PluralForms{"", "tchajwanský dolar", "", "tchajwanské dolary", "tchajwanského dolaru", "tchajwanských dolarů"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanský šilink", PluralForms{"", "tanzanský šilink", "", "tanzanské šilinky", "tanzanského šilinku", "tanzanských šilinků"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrajinská hřivna", PluralForms{"", "ukrajinská hřivna", "", "ukrajinské hřivny", "ukrajinské hřivny", "ukrajinských hřiven"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ukrajinský karbovanec", PluralForms{"", "ukrajinský karbovanec", "", "ukrajinské karbovance", "ukrajinského karbovance", "ukrajinských karbovanců"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "ugandský šilink (1966–1987)", PluralForms{"", "ugandský šilink (1966–1987)", "", "ugandské šilinky (1966–1987)", "ugandského šilinku (1966–1987)", "ugandských šilinků (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandský šilink", PluralForms{"", "ugandský šilink", "", "ugandské šilinky", "ugandského šilinku", "ugandských šilinků"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "americký dolar", PluralForms{"", "americký dolar", "", "americké dolary", "amerického dolaru", "amerických dolarů"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "americký dolar (příští den)", PluralForms{"", "americký dolar (příští den)", "", "americké dolary (příští den)", "amerického dolaru (příští den)", "amerických dolarů (příští den)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "americký dolar (týž den)", PluralForms{"", "americký dolar (týž den)", "", "americké dolary (týž den)", "amerického dolaru (týž den)", "amerických dolarů (týž den)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "uruguayské peso (v indexovaných jednotkách)", PluralForms{"", "uruguayské peso (v indexovaných jednotkách)", "", "uruguayská pesa (v indexovaných jednotkách)", "uruguayského pesa (v indexovaných jednotkách)", "uruguayských pes (v indexovaných jednotkách)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "uruguayské peso (1975–1993)", PluralForms{"", "uruguayské peso (1975–1993)", "", "uruguayská pesa (1975–1993)", "uruguayského pesa (1975–1993)", "uruguayských pes (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "uruguayské peso", PluralForms{"", "uruguayské peso", "", "uruguayská pesa", "uruguayského pesa", "uruguayských pes"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "uzbecký sum", PluralForms{"", "uzbecký sum", "", "uzbecké sumy", "uzbeckého sumu", "uzbeckých sumů"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "venezuelský bolívar (1871–2008)", PluralForms{"", "venezuelský bolívar (1871–2008)", "", "venezuelské bolívary (1871–2008)", "venezuelského bolívaru (1871–2008)", "venezuelských bolívarů (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelský bolívar (2008–2018)", PluralForms{"", "venezuelský bolívar (2008–2018)", "", "venezuelské bolívary (2008–2018)", "venezuelského bolívaru (2008–2018)", "venezuelských bolívarů (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelský bolívar", PluralForms{"", "venezuelský bolívar", "", "venezuelské bolívary", "venezuelského bolívaru", "venezuelských bolívarů"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "vietnamský dong", PluralForms{"", "vietnamský dong", "", "vietnamské dongy", "vietnamského dongu", "vietnamských dongů"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "vietnamský dong (1978–1985)", PluralForms{"", "vietnamský dong (1978–1985)", "", "vietnamské dongy (1978–1985)", "vietnamského dongu (1978–1985)", "vietnamských dongů (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatský vatu", PluralForms{"", "vanuatský vatu", "", "vanuatské vatu", "vanuatského vatu", "vanuatských vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samojská tala", PluralForms{"", "samojská tala", "", "samojské taly", "samojské taly", "samojských tal"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "CFA/BEAC frank", PluralForms{"", "CFA/BEAC frank", "", "CFA/BEAC franky", "CFA/BEAC franku", "CFA/BEAC franků"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "stříbro", PluralForms{"", "trojská unce stříbra", "", "trojské unce stříbra", "trojské unce stříbra", "trojských uncí stříbra"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "zlato", PluralForms{"", "trojská unce zlata", "", "trojské unce zlata", "trojské unce zlata", "trojských uncí zlata"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "evropská smíšená jednotka", PluralForms{"", "evropská smíšená jednotka", "", "evropské smíšené jednotky", "evropské smíšené jednotky", "evropských smíšených jednotek"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "evropská peněžní jednotka", PluralForms{"", "evropská peněžní jednotka", "", "evropské peněžní jednotky", "evropské peněžní jednotky", "evropských peněžních jednotek"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "evropská jednotka účtu 9 (XBC)", PluralForms{"", "evropská jednotka účtu 9 (XBC)", "", "evropské jednotky účtu 9 (XBC)", "evropské jednotky účtu 9 (XBC)", "evropských jednotek účtu 9 (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "evropská jednotka účtu 17 (XBD)", PluralForms{"", "evropská jednotka účtu 17 (XBD)", "", "evropské jednotky účtu 17 (XBD)", "evropské jednotky účtu 17 (XBD)", "evropských jednotek účtu 17 (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "východokaribský dolar", PluralForms{"", "východokaribský dolar", "", "východokaribské dolary", "východokaribského dolaru", "východokaribských dolarů"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "SDR", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "ECU", "ECU", "evropská měnová jednotka", PluralForms{"", "ECU", "", "ECU", "ECU", "ECU"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "francouzský zlatý frank", PluralForms{"", "francouzský zlatý frank", "", "francouzské zlaté franky", "francouzského zlatého franku", "francouzských zlatých franků"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "francouzský UIC frank", PluralForms{"", "francouzský UIC frank", "", "francouzské UIC franky", "francouzského UIC franku", "francouzských UIC franků"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "CFA/BCEAO frank", PluralForms{"", "CFA/BCEAO frank", "", "CFA/BCEAO franky", "CFA/BCEAO franku", "CFA/BCEAO franků"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladium", PluralForms{"", "trojská unce palladia", "", "trojské unce palladia", "trojské unce palladia", "trojských uncí palladia"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP frank", PluralForms{"", "CFP frank", "", "CFP franky", "CFP franku", "CFP franků"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "trojská unce platiny", "", "trojské unce platiny", "trojské unce platiny", "trojských uncí platiny"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "kód fondů RINET", PluralForms{"", "", "", "", "", ""}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "sucre", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "kód zvlášť vyhrazený pro testovací účely", PluralForms{"", "kód zvlášť vyhrazený pro testovací účely", "", "kódy zvlášť vyhrazené pro testovací účely", "kódu zvlášť vyhrazeného pro testovací účely", "kódů zvlášť vyhrazených pro testovací účely"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "neznámá měna", PluralForms{"", "", "", "", "", ""}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "jemenský dinár", PluralForms{"", "jemenský dinár", "", "jemenské dináry", "jemenského dináru", "jemenských dinárů"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenský rijál", PluralForms{"", "jemenský rijál", "", "jemenské rijály", "jemenského rijálu", "jemenských rijálů"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "jugoslávský dinár (1966–1990)", PluralForms{"", "jugoslávský dinár (1966–1990)", "", "jugoslávské dináry (1966–1990)", "jugoslávského dináru (1966–1990)", "jugoslávských dinárů (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "jugoslávský nový dinár (1994–2002)", PluralForms{"", "jugoslávský nový dinár (1994–2002)", "", "jugoslávské nové dináry (1994–2002)", "jugoslávského nového dináru (1994–2002)", "jugoslávských nových dinárů (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "jugoslávský konvertibilní dinár (1990–1992)", PluralForms{"", "jugoslávský konvertibilní dinár (1990–1992)", "", "jugoslávské konvertibilní dináry (1990–1992)", "jugoslávského konvertibilního dináru (1990–1992)", "jugoslávských konvertibilních dinárů (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "jugoslávský reformovaný dinár (1992–1993)", PluralForms{"", "jugoslávský reformovaný dinár (1992–1993)", "", "jugoslávské reformované dináry (1992–1993)", "jugoslávského reformovaného dináru (1992–1993)", "jugoslávských reformovaných dinárů (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "jihoafrický finanční rand", PluralForms{"", "jihoafrický finanční rand", "", "jihoafrické finanční randy", "jihoafrického finančního randu", "jihoafrických finančních randů"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "jihoafrický rand", PluralForms{"", "jihoafrický rand", "", "jihoafrické randy", "jihoafrického randu", "jihoafrických randů"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "zambijská kwacha (1968–2012)", PluralForms{"", "zambijská kwacha (1968–2012)", "", "zambijské kwachy (1968–2012)", "zambijské kwachy (1968–2012)", "zambijských kwach (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambijská kwacha", PluralForms{"", "zambijská kwacha", "", "zambijské kwachy", "zambijské kwachy", "zambijských kwach"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zairský nový zaire (1993–1998)", PluralForms{"", "zairský nový zaire (1993–1998)", "", "zairské nové zairy (1993–1998)", "zairského nového zairu (1993–1998)", "zairských nových zairů (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zairský zaire (1971–1993)", PluralForms{"", "zairský zaire (1971–1993)", "", "zairské zairy (1971–1993)", "zairského zairu (1971–1993)", "zairských zairů (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "zimbabwský dolar (1980–2008)", PluralForms{"", "zimbabwský dolar (1980–2008)", "", "zimbabwské dolary (1980–2008)", "zimbabwského dolaru (1980–2008)", "zimbabwských dolarů (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "zimbabwský dolar (2009)", PluralForms{"", "zimbabwský dolar (2009)", "", "zimbabwské dolary (2009)", "zimbabwského dolaru (2009)", "zimbabwských dolarů (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "zimbabwský dolar (2008)", PluralForms{"", "zimbabwský dolar (2008)", "", "zimbabwské dolary (2008)", "zimbabwského dolaru (2008)", "zimbabwských dolarů (2008)"}},
 	},
 }
@@ -6,272 +6,325 @@ var cy = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0K", "many": "0K", "one": "0 mil", "other": "0 mil", "two": "0K", "zero": "0 mil"}},
+				{4, 2, map[string]string{"few": "0K", "many": "0K", "one": "0 mil", "other": "0 mil", "two": "0K", "zero": "0K"}},
+				{5, 3, map[string]string{"few": "0K", "many": "0K", "one": "0 mil", "other": "0 mil", "two": "0K", "zero": "0K"}},
+				{6, 1, map[string]string{"one": "0 miliwn", "other": "0 miliwn"}},
+				{7, 2, map[string]string{"one": "0 miliwn", "other": "0 miliwn"}},
+				{8, 3, map[string]string{"one": "0 miliwn", "other": "0 miliwn"}},
+				{9, 1, map[string]string{"one": "0 biliwn", "other": "0 biliwn"}},
+				{10, 2, map[string]string{"one": "0 biliwn", "other": "0 biliwn"}},
+				{11, 3, map[string]string{"one": "0 biliwn", "other": "0 biliwn"}},
+				{12, 1, map[string]string{"one": "0 triliwn", "other": "0 triliwn"}},
+				{13, 2, map[string]string{"one": "0 triliwn", "other": "0 triliwn"}},
+				{14, 3, map[string]string{"one": "0T", "other": "0 triliwn"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "¤0K", "many": "¤0K", "one": "¤0K", "other": "¤0K", "two": "¤0K", "zero": "¤0K"}},
+				{4, 2, map[string]string{"few": "¤0K", "many": "¤0K", "one": "¤0K", "other": "¤0K", "two": "¤0K", "zero": "¤0K"}},
+				{5, 3, map[string]string{"few": "¤0K", "many": "¤0K", "one": "¤0K", "other": "¤0K", "two": "¤0K", "zero": "¤0K"}},
+				{6, 1, map[string]string{"few": "¤0M", "many": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M", "zero": "¤0M"}},
+				{7, 2, map[string]string{"few": "¤0M", "many": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M", "zero": "¤0M"}},
+				{8, 3, map[string]string{"few": "¤0M", "many": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M", "zero": "¤0M"}},
+				{9, 1, map[string]string{"few": "¤0B", "many": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B", "zero": "¤0B"}},
+				{10, 2, map[string]string{"few": "¤0B", "many": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B", "zero": "¤0B"}},
+				{11, 3, map[string]string{"few": "¤0B", "many": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B", "zero": "¤0B"}},
+				{12, 1, map[string]string{"few": "¤0T", "many": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T", "zero": "¤0T"}},
+				{13, 2, map[string]string{"few": "¤0T", "many": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T", "zero": "¤0T"}},
+				{14, 3, map[string]string{"few": "¤0T", "many": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T", "zero": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "TK"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "BWP"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "HK$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "ZAR"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZMW"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirham Yr Emiradau Arabaidd Unedig", PluralForms{"dirham yr Emiradau Arabaidd Unedig", "dirham yr Emiradau Arabaidd Unedig", "dirham yr Emiradau Arabaidd Unedig", "dirham yr Emiradau Arabaidd Unedig", "dirham yr Emiradau Arabaidd Unedig", "dirham yr Emiradau Arabaidd Unedig"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afghani Afghanistan (1927–2002)", PluralForms{"afghani Afghanistan (1927–2002)", "afghani Afghanistan (1927–2002)", "afghani Afghanistan (1927–2002)", "afghani Afghanistan (1927–2002)", "afghani Afghanistan (1927–2002)", "afghani Afghanistan (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghani Afghanistan", PluralForms{"afghani Afghanistan", "afghani Afghanistan", "afghani Afghanistan", "afghani Afghanistan", "afghani Afghanistan", "afghani Afghanistan"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lek Albania", PluralForms{"lek Albania", "lek Albania", "lek Albania", "lek Albania", "lek Albania", "lek Albania"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dram Armenia", PluralForms{"dram Armenia", "dram Armenia", "dram Armenia", "dram Armenia", "dram Armenia", "dram Armenia"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Guilder Antilles yr Iseldiroedd", PluralForms{"guilder Antilles yr Iseldiroedd", "guilder Antilles yr Iseldiroedd", "guilder Antilles yr Iseldiroedd", "guilder Antilles yr Iseldiroedd", "guilder Antilles yr Iseldiroedd", "guilder Antilles yr Iseldiroedd"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kwanza Angola", PluralForms{"kwanza Angola", "kwanza Angola", "kwanza Angola", "kwanza Angola", "kwanza Angola", "kwanza Angola"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Kwanza Angola (1977–1991)", PluralForms{"kwanza Angola (1977 – 1991)", "kwanza Angola (1977 – 1991)", "kwanza Angola (1977 – 1991)", "kwanza Angola (1977 – 1991)", "kwanza Angola (1977 – 1991)", "kwanza Angola (1977 – 1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Kwanza Newydd Angola (1990–2000)", PluralForms{"kwanza newydd Angola (1999 – 2000)", "kwanza newydd Angola (1999 – 2000)", "kwanza newydd Angola (1999 – 2000)", "kwanza newydd Angola (1999 – 2000)", "kwanza newydd Angola (1999 – 2000)", "kwanza newydd Angola (1999 – 2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Kwanza Ailgymhwysedig Angola (1995–1999)", PluralForms{"kwanza ailgymhwysedig Angola (1995 – 1999)", "kwanza ailgymhwysedig Angola (1995 – 1999)", "kwanza ailgymhwysedig Angola (1995 – 1999)", "kwanza ailgymhwysedig Angola (1995 – 1999)", "kwanza ailgymhwysedig Angola (1995 – 1999)", "kwanza ailgymhwysedig Angola (1995 – 1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Austral yr Ariannin", PluralForms{"austral yr Ariannin", "austral yr Ariannin", "austral yr Ariannin", "austral yr Ariannin", "austral yr Ariannin", "austral yr Ariannin"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Peso Ley yr Ariannin (1970–1983)", PluralForms{"peso ley yr Ariannin (1970–1983)", "peso ley yr Ariannin (1970–1983)", "peso ley yr Ariannin (1970–1983)", "peso ley yr Ariannin (1970–1983)", "peso ley yr Ariannin (1970–1983)", "peso ley yr Ariannin (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Peso yr Ariannin (1881–1970)", PluralForms{"peso yr Ariannin (1881–1970)", "peso yr Ariannin (1881–1970)", "peso yr Ariannin (1881–1970)", "peso yr Ariannin (1881–1970)", "peso yr Ariannin (1881–1970)", "peso yr Ariannin (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Peso yr Ariannin (1983–1985)", PluralForms{"peso yr Ariannin (1983–1985)", "peso yr Ariannin (1983–1985)", "peso yr Ariannin (1983–1985)", "peso yr Ariannin (1983–1985)", "peso yr Ariannin (1983–1985)", "peso yr Ariannin (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso yr Ariannin", PluralForms{"peso yr Ariannin", "peso yr Ariannin", "peso yr Ariannin", "peso yr Ariannin", "peso yr Ariannin", "peso yr Ariannin"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Swllt Awstria", PluralForms{"swllt Awstria", "swllt Awstria", "swllt Awstria", "swllt Awstria", "swllt Awstria", "swllt Awstria"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Doler Awstralia", PluralForms{"doler Awstralia", "doler Awstralia", "ddoler Awstralia", "doler Awstralia", "doler Awstralia", "doler Awstralia"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Fflorin Aruba", PluralForms{"fflorin Aruba", "fflorin Aruba", "fflorin Aruba", "fflorin Aruba", "fflorin Aruba", "fflorin Aruba"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Manat Azerbaijan (1993–2006)", PluralForms{"manat Azerbaijan (1993–2006)", "manat Azerbaijan (1993–2006)", "manat Azerbaijan (1993–2006)", "manat Azerbaijan (1993–2006)", "manat Azerbaijan (1993–2006)", "manat Azerbaijan (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manat Azerbaijan", PluralForms{"manat Azerbaijan", "manat Azerbaijan", "manat Azerbaijan", "manat Azerbaijan", "manat Azerbaijan", "manat Azerbaijan"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Marc Trosadwy Bosnia a Hercegovina", PluralForms{"marc trosadwy Bosnia a Hercegovina", "marc trosadwy Bosnia a Hercegovina", "farc trosiadwy Bosnia a Hercegovina", "marc trosadwy Bosnia a Hercegovina", "marc trosadwy Bosnia a Hercegovina", "marc trosadwy Bosnia a Hercegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Doler Barbados", PluralForms{"doler Barbados", "ddoler Barbados", "ddoler Barbados", "doler Barbados", "doler Barbados", "doler Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "TK", "Taka Bangladesh", PluralForms{"taka Bangladesh", "taka Bangladesh", "taka Bangladesh", "taka Bangladesh", "taka Bangladesh", "taka Bangladesh"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Ffranc Gwlad Belg (arnewidiol)", PluralForms{"ffranc Gwlad Belg (arnewidiol)", "ffranc Gwlad Belg (arnewidiol)", "ffranc Gwlad Belg (arnewidiol)", "ffranc Gwlad Belg (arnewidiol)", "ffranc Gwlad Belg (arnewidiol)", "ffranc Gwlad Belg (arnewidiol)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Ffranc Gwlad Belg", PluralForms{"ffranc Gwlad Belg", "ffranc Gwlad Belg", "ffranc Gwlad Belg", "ffranc Gwlad Belg", "ffranc Gwlad Belg", "ffranc Gwlad Belg"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Ffranc Gwlad Belg (ariannol)", PluralForms{"ffranc Gwlad Belg (ariannol)", "ffranc Gwlad Belg (ariannol)", "ffranc Gwlad Belg (ariannol)", "ffranc Gwlad Belg (ariannol)", "ffranc Gwlad Belg (ariannol)", "ffranc Gwlad Belg (ariannol)"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Lev Sosialaidd Bwlgaria", PluralForms{"lev sosialaidd Bwlgaria", "lev sosialaidd Bwlgaria", "lev sosialaidd Bwlgaria", "lev sosialaidd Bwlgaria", "lev sosialaidd Bwlgaria", "lev sosialaidd Bwlgaria"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lev Bwlgaria", PluralForms{"lev Bwlgaria", "lev Bwlgaria", "lev Bwlgaria", "lev Bwlgaria", "lev Bwlgaria", "lev Bwlgaria"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Lev Bwlgaria (1879–1952)", PluralForms{"lev Bwlgaria (1879 – 1952)", "lev Bwlgaria (1879 – 1952)", "lev Bwlgaria (1879 – 1952)", "lev Bwlgaria (1879 – 1952)", "lev Bwlgaria (1879 – 1952)", "lev Bwlgaria (1879 – 1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinar Bahrain", PluralForms{"dinar Bahrain", "dinar Bahrain", "dinar Bahrain", "dinar Bahrain", "dinar Bahrain", "dinar Bahrain"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Ffranc Burundi", PluralForms{"ffranc Burundi", "ffranc Burundi", "ffranc Burundi", "ffranc Burundi", "ffranc Burundi", "ffranc Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Doler Bermuda", PluralForms{"doler Bermuda", "doler Bermuda", "ddoler Bermuda", "doler Bermuda", "doler Bermuda", "doler Bermuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Doler Brunei", PluralForms{"doler Brunei", "doler Brunei", "ddoler Brunei", "doler Brunei", "doler Brunei", "doler Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviano Bolifia", PluralForms{"boliviano Bolifia", "boliviano Bolifia", "boliviano Bolifia", "boliviano Bolifia", "boliviano Bolifia", "boliviano Bolifia"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Boliviano Bolifia (1863–1963)", PluralForms{"boliviano Bolifia (1863–1963)", "boliviano Bolifia (1863–1963)", "boliviano Bolifia (1863–1963)", "boliviano Bolifia (1863–1963)", "boliviano Bolifia (1863–1963)", "boliviano Bolifia (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Peso Bolifia", PluralForms{"peso Bolifia", "peso Bolifia", "peso Bolifia", "peso Bolifia", "peso Bolifia", "peso Bolifia"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Mvdol Bolifia", PluralForms{"mvdol Bolifia", "mvdol Bolifia", "mvdol Bolifia", "mvdol Bolifia", "mvdol Bolifia", "mvdol Bolifia"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Cruzeiro Newydd Brasil (1967–1986)", PluralForms{"cruzeiro newydd Brasil (1967–1986)", "cruzeiro newydd Brasil (1967–1986)", "cruzeiro newydd Brasil (1967–1986)", "cruzeiro newydd Brasil (1967–1986)", "cruzeiro newydd Brasil (1967–1986)", "cruzeiro newydd Brasil (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Cruzado Brasil (1986–1989)", PluralForms{"cruzado Brasil (1986–1989)", "cruzado Brasil (1986–1989)", "cruzado Brasil (1986–1989)", "cruzado Brasil (1986–1989)", "cruzado Brasil (1986–1989)", "cruzado Brasil (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Cruzeiro Brasil (1990–1993)", PluralForms{"cruzeiro Brasil (1990–1993)", "cruzeiro Brasil (1990–1993)", "cruzeiro Brasil (1990–1993)", "cruzeiro Brasil (1990–1993)", "cruzeiro Brasil (1990–1993)", "cruzeiro Brasil (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Real Brasil", PluralForms{"real Brasil", "real Brasil", "real Brasil", "real Brasil", "real Brasil", "real Brasil"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Cruzado Newydd Brasil (1989–1990)", PluralForms{"cruzado newydd Brasil (1989–1990)", "cruzado newydd Brasil (1989–1990)", "cruzado newydd Brasil (1989–1990)", "cruzado newydd Brasil (1989–1990)", "cruzado newydd Brasil (1989–1990)", "cruzado newydd Brasil (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Cruzeiro Brasil (1993–1994)", PluralForms{"cruzeiro Brasil (1993–1994)", "cruzeiro Brasil (1993–1994)", "cruzeiro Brasil (1993–1994)", "cruzeiro Brasil (1993–1994)", "cruzeiro Brasil (1993–1994)", "cruzeiro Brasil (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Cruzeiro Brasil (1942–1967)", PluralForms{"cruzeiro Brasil (1942–1967)", "cruzeiro Brasil (1942–1967)", "cruzeiro Brasil (1942–1967)", "cruzeiro Brasil (1942–1967)", "cruzeiro Brasil (1942–1967)", "cruzeiro Brasil (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Doler y Bahamas", PluralForms{"doler y Bahamas", "doler y Bahamas", "ddoler y Bahamas", "doler y Bahamas", "doler y Bahamas", "doler y Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrum Bhutan", PluralForms{"ngultrum Bhutan", "ngultrum Bhutan", "ngultrum Bhutan", "ngultrum Bhutan", "ngultrum Bhutan", "ngultrum Bhutan"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Kyat Byrma", PluralForms{"kyat Byrma", "kyat Byrma", "kyat Byrma", "kyat Byrma", "kyat Byrma", "kyat Byrma"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "BWP", "Pula Botswana", PluralForms{"pula Botswana", "pula Botswana", "pula Botswana", "pula Botswana", "pula Botswana", "pula Botswana"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Rwbl Belarws", PluralForms{"rwbl Belarws", "rwbl Belarws", "rwbl Belarws", "rwbl Belarws", "rwbl Belarws", "rwbl Belarws"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rwbl Belarws (2000–2016)", PluralForms{"rwbl Belarws (2000–2016)", "rwbl Belarws (2000–2016)", "rwbl Belarws (2000–2016)", "rwbl Belarws (2000–2016)", "rwbl Belarws (2000–2016)", "rwbl Belarws (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Doler Belize", PluralForms{"doler Belize", "doler Belize", "ddoler Belize", "doler Belize", "doler Belize", "doler Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Doler Canada", PluralForms{"doler Canada", "doler Canada", "doler Canada", "doler Canada", "doler Canada", "doler Canada"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Ffranc Congo", PluralForms{"ffranc Congo", "ffranc Congo", "ffranc Congo", "ffranc Congo", "ffranc Congo", "ffranc Congo"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Ewro WIR", PluralForms{"ewro WIR", "ewro WIR", "ewro WIR", "ewro WIR", "ewro WIR", "ewro WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Ffranc y Swistir", PluralForms{"ffranc y Swistir", "ffranc y Swistir", "ffranc y Swistir", "ffranc y Swistir", "ffranc y Swistir", "ffranc y Swistir"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Ffranc WIR", PluralForms{"ffranc WIR", "ffranc WIR", "ffranc WIR", "ffranc WIR", "ffranc WIR", "ffranc WIR"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Escudo Chile", PluralForms{"escudo Chile", "escudo Chile", "escudo Chile", "escudo Chile", "escudo Chile", "escudo Chile"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Uned Cyfrifo Chile (UF)", PluralForms{"uned cyfrifo Chile (UF)", "uned cyfrifo Chile (UF)", "uned cyfrifo Chile (UF)", "uned cyfrifo Chile (UF)", "uned cyfrifo Chile (UF)", "uned cyfrifo Chile (UF)"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso Chile", PluralForms{"peso Chile", "peso Chile", "peso Chile", "peso Chile", "peso Chile", "peso Chile"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuan Tsieina (ar y môr)", PluralForms{"yuan Tsieina (ar y môr)", "yuan Tsieina (ar y môr)", "yuan Tsieina (ar y môr)", "yuan Tsieina (ar y môr)", "yuan Tsieina (ar y môr)", "yuan Tsieina (ar y môr)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Doler Banc Pobl Tsieina", PluralForms{"doler Banc Pobl Tsieina", "ddoler Banc Pobl Tsieina", "ddoler Banc Pobl Tsieina", "doler Banc Pobl Tsieina", "doler Banc Pobl Tsieina", "doler Banc Pobl Tsieina"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuan Tsieina", PluralForms{"yuan Tsieina", "yuan Tsieina", "yuan Tsieina", "yuan Tsieina", "yuan Tsieina", "yuan Tsieina"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso Colombia", PluralForms{"peso Colombia", "peso Colombia", "peso Colombia", "peso Colombia", "peso Colombia", "peso Colombia"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Uned Gwir Werth Colombia", PluralForms{"uned gwir werth Colombia", "uned gwir werth Colombia", "uned gwir werth Colombia", "uned gwir werth Colombia", "uned gwir werth Colombia", "uned gwir werth Colombia"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colón Costa Rica", PluralForms{"colón Costa Rica", "colón Costa Rica", "colón Costa Rica", "colón Costa Rica", "colón Costa Rica", "colón Costa Rica"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso Trosadwy Ciwba", PluralForms{"peso trosadwy Ciwba", "peso trosadwy Ciwba", "peso trosadwy Ciwba", "peso trosadwy Ciwba", "peso trosadwy Ciwba", "peso trosadwy Ciwba"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso Ciwba", PluralForms{"peso Ciwba", "peso Ciwba", "peso Ciwba", "peso Ciwba", "peso Ciwba", "peso Ciwba"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Esgwdo Cabo Verde", PluralForms{"esgwdo Cabo Verde", "esgwdo Cabo Verde", "esgwdo Cabo Verde", "esgwdo Cabo Verde", "esgwdo Cabo Verde", "esgwdo Cabo Verde"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Punt Cyprus", PluralForms{"punt Cyprus", "bunt Cyprus", "bunt Cyprus", "punt Cyprus", "punt Cyprus", "punt Cyprus"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna’r Weriniaeth Tsiec", PluralForms{"koruna’r Weriniaeth Tsiec", "koruna’r Weriniaeth Tsiec", "koruna’r Weriniaeth Tsiec", "koruna’r Weriniaeth Tsiec", "koruna’r Weriniaeth Tsiec", "koruna’r Weriniaeth Tsiec"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Marc Dwyrain yr Almaen", PluralForms{"marc Dwyrain yr Almaen", "marc Dwyrain yr Almaen", "marc Dwyrain yr Almaen", "marc Dwyrain yr Almaen", "marc Dwyrain yr Almaen", "marc Dwyrain yr Almaen"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Marc yr Almaen", PluralForms{"marc yr Almaen", "marc yr Almaen", "marc yr Almaen", "marc yr Almaen", "marc yr Almaen", "marc yr Almaen"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Ffranc Djibouti", PluralForms{"ffranc Djibouti", "ffranc Djibouti", "ffranc Djibouti", "ffranc Djibouti", "ffranc Djibouti", "ffranc Djibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Krone Denmarc", PluralForms{"krone Denmarc", "krone Denmarc", "krone Denmarc", "krone Denmarc", "krone Denmarc", "krone Denmarc"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso Gweriniaeth Dominica", PluralForms{"peso Gweriniaeth Dominica", "peso Gweriniaeth Dominica", "peso Gweriniaeth Dominica", "peso Gweriniaeth Dominica", "peso Gweriniaeth Dominica", "peso Gweriniaeth Dominica"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinar Algeria", PluralForms{"dinar Algeria", "dinar Algeria", "dinar Algeria", "dinar Algeria", "dinar Algeria", "dinar Algeria"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Sucre Ecuador", PluralForms{"sucre Ecuador", "sucre Ecuador", "sucre Ecuador", "sucre Ecuador", "sucre Ecuador", "sucre Ecuador"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Uned Gwerth Gyson Ecuador", PluralForms{"uned gwerth gyson Ecuador", "uned gwerth gyson Ecuador", "uned gwerth gyson Ecuador", "uned gwerth gyson Ecuador", "uned gwerth gyson Ecuador", "uned gwerth gyson Ecuador"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Kroon Estonia", PluralForms{"kroon Estonia", "kroon Estonia", "kroon Estonia", "kroon Estonia", "kroon Estonia", "kroon Estonia"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Punt Yr Aifft", PluralForms{"punt yr Aifft", "punt yr Aifft", "bunt yr Aifft", "punt yr Aifft", "punt yr Aifft", "punt yr Aifft"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa Eritrea", PluralForms{"nakfa Eritrea", "nakfa Eritrea", "nakfa Eritrea", "nakfa Eritrea", "nakfa Eritrea", "nakfa Eritrea"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birr Ethiopia", PluralForms{"birr Ethiopia", "birr Ethiopia", "birr Ethiopia", "birr Ethiopia", "birr Ethiopia", "birr Ethiopia"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Ewro", PluralForms{"ewro", "ewro", "ewro", "ewro", "ewro", "ewro"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Markka’r Ffindir", PluralForms{"markka’r Ffindir", "markka’r Ffindir", "markka’r Ffindir", "markka’r Ffindir", "markka’r Ffindir", "markka’r Ffindir"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Doler Ffiji", PluralForms{"doler Ffiji", "doler Ffiji", "ddoler Ffiji", "doler Ffiji", "doler Ffiji", "doler Ffiji"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Punt Ynysoedd Falkland/Malvinas", PluralForms{"punt Ynysoedd Falkland/Malvinas", "punt Ynysoedd Falkland/Malvinas", "bunt Ynysoedd Falkland/Malvinas", "punt Ynysoedd Falkland/Malvinas", "punt Ynysoedd Falkland/Malvinas", "punt Ynysoedd Falkland/Malvinas"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Ffranc Ffrainc", PluralForms{"ffranc Ffrainc", "ffranc Ffrainc", "ffranc Ffrainc", "ffranc Ffrainc", "ffranc Ffrainc", "ffranc Ffrainc"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Punt Prydain", PluralForms{"punt Prydain", "bunt Prydain", "bunt Prydain", "punt Prydain", "punt Prydain", "punt Prydain"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Kupon Larit Georgia", PluralForms{"kupon larit Georgia", "kupon larit Georgia", "kupon larit Georgia", "kupon larit Georgia", "kupon larit Georgia", "kupon larit Georgia"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lari Georgia", PluralForms{"lari Georgia", "lari Georgia", "lari Georgia", "lari Georgia", "lari Georgia", "lari Georgia"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Cedi Ghana (1979–2007)", PluralForms{"cedi Ghana (1979–2007)", "cedi Ghana (1979–2007)", "cedi Ghana (1979–2007)", "cedi Ghana (1979–2007)", "cedi Ghana (1979–2007)", "cedi Ghana (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Cedi Ghana", PluralForms{"cedi Ghana", "cedi Ghana", "cedi Ghana", "cedi Ghana", "cedi Ghana", "cedi Ghana"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Punt Gibraltar", PluralForms{"punt Gibraltar", "punt Gibraltar", "bunt Gibraltar", "punt Gibraltar", "punt Gibraltar", "punt Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi Gambia", PluralForms{"dalasi Gambia", "dalasi Gambia", "dalasi Gambia", "dalasi Gambia", "dalasi Gambia", "dalasi Gambia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Ffranc Guinée", PluralForms{"ffranc Guinée", "ffranc Guinée", "ffranc Guinée", "ffranc Guinée", "ffranc Guinée", "ffranc Guinée"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Syli Guinée", PluralForms{"syli Guinée", "syli Guinée", "syli Guinée", "syli Guinée", "syli Guinée", "syli Guinée"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekwele Guinea Gyhydeddol", PluralForms{"ekwele Guinea Gyhydeddol", "ekwele Guinea Gyhydeddol", "ekwele Guinea Gyhydeddol", "ekwele Guinea Gyhydeddol", "ekwele Guinea Gyhydeddol", "ekwele Guinea Gyhydeddol"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal Guatemala", PluralForms{"quetzal Guatemala", "quetzal Guatemala", "quetzal Guatemala", "quetzal Guatemala", "quetzal Guatemala", "quetzal Guatemala"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Peso Guiné-Bissau", PluralForms{"peso Guiné-Bissau", "peso Guiné-Bissau", "peso Guiné-Bissau", "peso Guiné-Bissau", "peso Guiné-Bissau", "peso Guiné-Bissau"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Doler Guyana", PluralForms{"doler Guyana", "doler Guyana", "ddoler Guyana", "doler Guyana", "doler Guyana", "doler Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "HK$", "Doler Hong Kong", PluralForms{"doler Hong Kong", "doler Hong Kong", "ddoler Hong Kong", "doler Hong Kong", "doler Hong Kong", "doler Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira Honduras", PluralForms{"lempira Honduras", "lempira Honduras", "lempira Honduras", "lempira Honduras", "lempira Honduras", "lempira Honduras"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuna Croatia", PluralForms{"kuna Croatia", "kuna Croatia", "kuna Croatia", "kuna Croatia", "kuna Croatia", "kuna Croatia"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gourde Haiti", PluralForms{"gourde Haiti", "gourde Haiti", "gourde Haiti", "gourde Haiti", "gourde Haiti", "gourde Haiti"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Fforint Hwngari", PluralForms{"fforint Hwngari", "fforint Hwngari", "fforint Hwngari", "fforint Hwngari", "fforint Hwngari", "fforint Hwngari"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupiah Indonesia", PluralForms{"rupiah Indonesia", "rupiah Indonesia", "rupiah Indonesia", "rupiah Indonesia", "rupiah Indonesia", "rupiah Indonesia"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Punt Iwerddon", PluralForms{"punt Iwerddon", "bunt Iwerddon", "bunt Iwerddon", "punt Iwerddon", "phunt Iwerddon", "punt Iwerddon"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Punt Israel", PluralForms{"punt Israel", "bunt Israel", "bunt Israel", "punt Israel", "phunt Israel", "punt Israel"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Shegel Israel (1980–1985)", PluralForms{"shegel Israel (1980–1985)", "shegel Israel (1980–1985)", "shegel Israel (1980–1985)", "shegel Israel (1980–1985)", "shegel Israel (1980–1985)", "shegel Israel (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Shegel Newydd Israel", PluralForms{"shegel newydd Israel", "shegel newydd Israel", "shegel newydd Israel", "shegel newydd Israel", "shegel newydd Israel", "shegel newydd Israel"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rwpî India", PluralForms{"rwpî India", "rwpî India", "rwpî India", "rwpî India", "rwpî India", "rwpî India"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinar Irac", PluralForms{"dinar Irac", "dinar Irac", "dinar Irac", "dinar Irac", "dinar Irac", "dinar Irac"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial Iran", PluralForms{"rial Iran", "rial Iran", "rial Iran", "rial Iran", "rial Iran", "rial Iran"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Króna Gwlad yr Iâ (1918 – 1981)", PluralForms{"króna Gwlad yr Iâ (1918 – 1981)", "króna Gwlad yr Iâ (1918 – 1981)", "króna Gwlad yr Iâ (1918 – 1981)", "króna Gwlad yr Iâ (1918 – 1981)", "króna Gwlad yr Iâ (1918 – 1981)", "króna Gwlad yr Iâ (1918 – 1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Króna Gwlad yr Iâ", PluralForms{"króna Gwlad yr Iâ", "króna Gwlad yr Iâ", "króna Gwlad yr Iâ", "króna Gwlad yr Iâ", "króna Gwlad yr Iâ", "króna Gwlad yr Iâ"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Doler Jamaica", PluralForms{"doler Jamaica", "doler Jamaica", "ddoler Jamaica", "doler Jamaica", "doler Jamaica", "doler Jamaica"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinar Gwlad yr Iorddonen", PluralForms{"dinar Gwlad yr Iorddonen", "dinar Gwlad yr Iorddonen", "dinar Gwlad yr Iorddonen", "dinar Gwlad yr Iorddonen", "dinar Gwlad yr Iorddonen", "dinar Gwlad yr Iorddonen"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yen Japan", PluralForms{"yen Japan", "yen Japan", "yen Japan", "yen Japan", "yen Japan", "yen Japan"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Swllt Kenya", PluralForms{"swllt Kenya", "swllt Kenya", "swllt Kenya", "swllt Kenya", "swllt Kenya", "swllt Kenya"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som Kyrgyzstan", PluralForms{"som Kyrgyzstan", "som Kyrgyzstan", "som Kyrgyzstan", "som Kyrgyzstan", "som Kyrgyzstan", "som Kyrgyzstan"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riel Cambodia", PluralForms{"riel Cambodia", "riel Cambodia", "riel Cambodia", "riel Cambodia", "riel Cambodia", "riel Cambodia"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Ffranc Comoros", PluralForms{"ffranc Comoros", "ffranc Comoros", "ffranc Comoros", "ffranc Comoros", "ffranc Comoros", "ffranc Comoros"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won Gogledd Corea", PluralForms{"won Gogledd Corea", "won Gogledd Corea", "won Gogledd Corea", "won Gogledd Corea", "won Gogledd Corea", "won Gogledd Corea"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Hwan De Corea (1953–1962)", PluralForms{"hwan De Corea (1953–1962)", "hwan De Corea (1953–1962)", "hwan De Corea (1953–1962)", "hwan De Corea (1953–1962)", "hwan De Corea (1953–1962)", "hwan De Corea (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Won De Corea (1945–1953)", PluralForms{"won De Corea (1945–1953)", "won De Corea (1945–1953)", "won De Corea (1945–1953)", "won De Corea (1945–1953)", "won De Corea (1945–1953)", "won De Corea (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "Won De Corea", PluralForms{"won De Corea", "won De Corea", "won De Corea", "won De Corea", "won De Corea", "won De Corea"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinar Kuwait", PluralForms{"dinar Kuwait", "dinar Kuwait", "dinar Kuwait", "dinar Kuwait", "dinar Kuwait", "dinar Kuwait"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Doler Ynysoedd Cayman", PluralForms{"doler Ynysoedd Cayman", "doler Ynysoedd Cayman", "ddoler Ynysoedd Cayman", "doler Ynysoedd Cayman", "doler Ynysoedd Cayman", "doler Ynysoedd Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge Kazakstan", PluralForms{"tenge Kazakstan", "tenge Kazakstan", "tenge Kazakstan", "tenge Kazakstan", "tenge Kazakstan", "tenge Kazakstan"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kip Laos", PluralForms{"kip Laos", "kip Laos", "kip Laos", "kip Laos", "kip Laos", "kip Laos"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Punt Libanus", PluralForms{"punt Libanus", "punt Libanus", "bunt Libanus", "punt Libanus", "punt Libanus", "punt Libanus"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rwpî Sri Lanka", PluralForms{"rwpî Sri Lanka", "rwpî Sri Lanka", "rwpî Sri Lanka", "rwpî Sri Lanka", "rwpî Sri Lanka", "rwpî Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Doler Liberia", PluralForms{"doler Liberia", "ddoler Liberia", "ddoler Liberia", "doler Liberia", "doler Liberia", "doler Liberia"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti Lesotho", PluralForms{"loti Lesotho", "loti Lesotho", "loti Lesotho", "loti Lesotho", "loti Lesotho", "loti Lesotho"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas Lithwania", PluralForms{"litas Lithwania", "litas Lithwania", "litas Lithwania", "litas Lithwania", "litas Lithwania", "litas Lithwania"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Talonas Lithwania", PluralForms{"talonas Lithwania", "talonas Lithwania", "talonas Lithwania", "talonas Lithwania", "talonas Lithwania", "talonas Lithwania"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Ffranc Lwcsembwrg", PluralForms{"ffranc Lwcsembwrg", "ffranc Lwcsembwrg", "ffranc Lwcsembwrg", "ffranc Lwcsembwrg", "ffranc Lwcsembwrg", "ffranc Lwcsembwrg"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats Latfia", PluralForms{"lats Latfia", "lats Latfia", "lats Latfia", "lats Latfia", "lats Latfia", "lats Latfia"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Rwbl Latfia", PluralForms{"rwbl Latfia", "rwbl Latfia", "rwbl Latfia", "rwbl Latfia", "rwbl Latfia", "rwbl Latfia"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinar Libya", PluralForms{"dinar Libya", "dinar Libya", "dinar Libya", "dinar Libya", "dinar Libya", "dinar Libya"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dirham Moroco", PluralForms{"dirham Moroco", "dirham Moroco", "dirham Moroco", "dirham Moroco", "dirham Moroco", "dirham Moroco"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Ffranc Moroco", PluralForms{"ffranc Moroco", "ffranc Moroco", "ffranc Moroco", "ffranc Moroco", "ffranc Moroco", "ffranc Moroco"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Ffranc Monaco", PluralForms{"ffranc Monaco", "ffranc Monaco", "ffranc Monaco", "ffranc Monaco", "ffranc Monaco", "ffranc Monaco"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leu Moldofa", PluralForms{"leu Moldofa", "leu Moldofa", "leu Moldofa", "leu Moldofa", "leu Moldofa", "leu Moldofa"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ariary Madagascar", PluralForms{"ariary Madagascar", "ariary Madagascar", "ariary Madagascar", "ariary Madagascar", "ariary Madagascar", "ariary Madagascar"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Ffranc Madagascar", PluralForms{"ffranc Madagascar", "ffranc Madagascar", "ffranc Madagascar", "ffranc Madagascar", "ffranc Madagascar", "ffranc Madagascar"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Denar Macedonia", PluralForms{"denar Macedonia", "denar Macedonia", "denar Macedonia", "denar Macedonia", "denar Macedonia", "denar Macedonia"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Ffranc Mali", PluralForms{"ffranc Mali", "ffranc Mali", "ffranc Mali", "ffranc Mali", "ffranc Mali", "ffranc Mali"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kyat Myanmar", PluralForms{"kyat Myanmar", "kyat Myanmar", "kyat Myanmar", "kyat Myanmar", "kyat Myanmar", "kyat Myanmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik Mongolia", PluralForms{"tugrik Mongolia", "tugrik Mongolia", "tugrik Mongolia", "tugrik Mongolia", "tugrik Mongolia", "tugrik Mongolia"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca Macau", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya Mauritania (1973–2017)", PluralForms{"ouguiya Mauritania (1973–2017)", "ouguiya Mauritania (1973–2017)", "ouguiya Mauritania (1973–2017)", "ouguiya Mauritania (1973–2017)", "ouguiya Mauritania (1973–2017)", "ouguiya Mauritania (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya Mauritania", PluralForms{"ouguiya Mauritania", "ouguiya Mauritania", "ouguiya Mauritania", "ouguiya Mauritania", "ouguiya Mauritania", "ouguiya Mauritania"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rwpî Mauritius", PluralForms{"rwpî Mauritius", "rwpî Mauritius", "rwpî Mauritius", "rwpî Mauritius", "rwpî Mauritius", "rwpî Mauritius"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Rwpî’r Maldives (1947–1981)", PluralForms{"rwpî’r Maldives (1947–1981)", "rwpî’r Maldives (1947–1981)", "rwpî’r Maldives (1947–1981)", "rwpî’r Maldives (1947–1981)", "rwpî’r Maldives (1947–1981)", "rwpî’r Maldives (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyaa’r Maldives", PluralForms{"rufiyaa’r Maldives", "rufiyaa’r Maldives", "rufiyaa’r Maldives", "rufiyaa’r Maldives", "rufiyaa’r Maldives", "rufiyaa’r Maldives"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kwacha Malawi", PluralForms{"kwacha Malawi", "kwacha Malawi", "kwacha Malawi", "kwacha Malawi", "kwacha Malawi", "kwacha Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso Mecsico", PluralForms{"peso Mecsico", "peso Mecsico", "peso Mecsico", "peso Mecsico", "peso Mecsico", "peso Mecsico"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Peso Arian México (1861–1992)", PluralForms{"peso arian México (1861–1992)", "peso arian México (1861–1992)", "peso arian México (1861–1992)", "peso arian México (1861–1992)", "peso arian México (1861–1992)", "peso arian México (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Uned Fuddsoddi México", PluralForms{"uned fuddsoddi México", "uned fuddsoddi México", "uned fuddsoddi México", "uned fuddsoddi México", "uned fuddsoddi México", "uned fuddsoddi México"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringgit Malaysia", PluralForms{"ringgit Malaysia", "ringgit Malaysia", "ringgit Malaysia", "ringgit Malaysia", "ringgit Malaysia", "ringgit Malaysia"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Escudo Mozambique", PluralForms{"escudo Mozambique", "escudo Mozambique", "escudo Mozambique", "escudo Mozambique", "escudo Mozambique", "escudo Mozambique"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metical Mozambique (1980–2006)", PluralForms{"metical Mozambique (1980–2006)", "metical Mozambique (1980–2006)", "metical Mozambique (1980–2006)", "metical Mozambique (1980–2006)", "metical Mozambique (1980–2006)", "metical Mozambique (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metical Mozambique", PluralForms{"metical Mozambique", "metical Mozambique", "metical Mozambique", "metical Mozambique", "metical Mozambique", "metical Mozambique"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Doler Namibia", PluralForms{"doler Namibia", "doler Namibia", "ddoler Namibia", "doler Namibia", "doler Namibia", "doler Namibia"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naira Nigeria", PluralForms{"naira Nigeria", "naira Nigeria", "naira Nigeria", "naira Nigeria", "naira Nigeria", "naira Nigeria"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Córdoba Nicaragua (1988–1991)", PluralForms{"córdoba Nicaragua (1988–1991)", "córdoba Nicaragua (1988–1991)", "córdoba Nicaragua (1988–1991)", "córdoba Nicaragua (1988–1991)", "córdoba Nicaragua (1988–1991)", "córdoba Nicaragua (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Cordoba Nicaragwa", PluralForms{"cordoba Nicaragwa", "cordoba Nicaragwa", "cordoba Nicaragwa", "cordoba Nicaragwa", "cordoba Nicaragwa", "cordoba Nicaragwa"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Guilder yr Iseldiroedd", PluralForms{"guilder yr Iseldiroedd", "guilder yr Iseldiroedd", "guilder yr Iseldiroedd", "guilder yr Iseldiroedd", "guilder yr Iseldiroedd", "guilder yr Iseldiroedd"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Krone Norwy", PluralForms{"krone Norwy", "krone Norwy", "krone Norwy", "krone Norwy", "krone Norwy", "krone Norwy"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rwpî Nepal", PluralForms{"rwpî Nepal", "rwpî Nepal", "rwpî Nepal", "rwpî Nepal", "rwpî Nepal", "rwpî Nepal"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Doler Seland Newydd", PluralForms{"doler Seland Newydd", "doler Seland Newydd", "ddoler Seland Newydd", "doler Seland Newydd", "doler Seland Newydd", "doler Seland Newydd"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Rial Oman", PluralForms{"rial Oman", "rial Oman", "rial Oman", "rial Oman", "rial Oman", "rial Oman"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa Panama", PluralForms{"balboa Panama", "balboa Panama", "balboa Panama", "balboa Panama", "balboa Panama", "balboa Panama"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Inti Periw", PluralForms{"inti Periw", "inti Periw", "inti Periw", "inti Periw", "inti Periw", "inti Periw"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol Periw", PluralForms{"sol Periw", "sol Periw", "sol Periw", "sol Periw", "sol Periw", "sol Periw"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Sol Periw (1863–1965)", PluralForms{"sol Periw (1863–1965)", "sol Periw (1863–1965)", "sol Periw (1863–1965)", "sol Periw (1863–1965)", "sol Periw (1863–1965)", "sol Periw (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina Papua Guinea Newydd", PluralForms{"kina Papua Guinea Newydd", "kina Papua Guinea Newydd", "kina Papua Guinea Newydd", "kina Papua Guinea Newydd", "kina Papua Guinea Newydd", "kina Papua Guinea Newydd"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Peso Philipinas", PluralForms{"peso Philipinas", "peso Philipinas", "peso Philipinas", "peso Philipinas", "peso Philipinas", "peso Philipinas"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rwpî Pacistan", PluralForms{"rwpî Pacistan", "rwpî Pacistan", "rwpî Pacistan", "rwpî Pacistan", "rwpî Pacistan", "rwpî Pacistan"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Zloty Gwlad Pwyl", PluralForms{"zloty Gwlad Pwyl", "zloty Gwlad Pwyl", "zloty Gwlad Pwyl", "zloty Gwlad Pwyl", "zloty Gwlad Pwyl", "zloty Gwlad Pwyl"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guarani Paraguay", PluralForms{"guarani Paraguay", "guarani Paraguay", "guarani Paraguay", "guarani Paraguay", "guarani Paraguay", "guarani Paraguay"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Rial Qatar", PluralForms{"rial Qatar", "rial Qatar", "rial Qatar", "rial Qatar", "rial Qatar", "rial Qatar"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Doler Rhodesia", PluralForms{"doler Rhodesia", "ddoler Rhodesia", "ddoler Rhodesia", "doler Rhodesia", "doler Rhodesia", "doler Rhodesia"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Leu Rwmania", PluralForms{"leu Rwmania", "leu Rwmania", "leu Rwmania", "leu Rwmania", "leu Rwmania", "leu Rwmania"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinar Serbia", PluralForms{"dinar Serbia", "dinar Serbia", "dinar Serbia", "dinar Serbia", "dinar Serbia", "dinar Serbia"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rwbl Rwsia", PluralForms{"rwbl Rwsia", "rwbl Rwsia", "rwbl Rwsia", "rwbl Rwsia", "rwbl Rwsia", "rwbl Rwsia"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ffranc Rwanda", PluralForms{"ffranc Rwanda", "ffranc Rwanda", "ffranc Rwanda", "ffranc Rwanda", "ffranc Rwanda", "ffranc Rwanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyal Saudi Arabia", PluralForms{"riyal Saudi Arabia", "riyal Saudi Arabia", "riyal Saudi Arabia", "riyal Saudi Arabia", "riyal Saudi Arabia", "riyal Saudi Arabia"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Doler Ynysoedd Solomon", PluralForms{"doler Ynysoedd Solomon", "doler Ynysoedd Solomon", "ddoler Ynysoedd Solomon", "doler Ynysoedd Solomon", "doler Ynysoedd Solomon", "doler Ynysoedd Solomon"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rwpî Seychelles", PluralForms{"rwpî Seychelles", "rwpî Seychelles", "rwpî Seychelles", "rwpî Seychelles", "rwpî Seychelles", "rwpî Seychelles"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Dinar Sudan (1992–2007)", PluralForms{"dinar Sudan (1992–2007)", "dinar Sudan (1992–2007)", "dinar Sudan (1992–2007)", "dinar Sudan (1992–2007)", "dinar Sudan (1992–2007)", "dinar Sudan (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Punt Sudan", PluralForms{"punt Sudan", "punt Sudan", "bunt Sudan", "punt Sudan", "punt Sudan", "punt Sudan"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Punt Sudan (1957–1998)", PluralForms{"punt Sudan (1957–1998)", "bunt Sudan (1957–1998)", "bunt Sudan (1957–1998)", "punt Sudan (1957–1998)", "phunt Sudan (1957–1998)", "punt Sudan (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Krona Sweden", PluralForms{"krona Sweden", "krona Sweden", "krona Sweden", "krona Sweden", "krona Sweden", "krona Sweden"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Doler Singapore", PluralForms{"doler Singapore", "doler Singapore", "ddoler Singapore", "doler Singapore", "doler Singapore", "doler Singapore"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Punt St Helena", PluralForms{"punt St. Helena", "punt St. Helena", "bunt St. Helena", "punt St. Helena", "punt St. Helena", "punt St. Helena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leone Sierra Leone", PluralForms{"leone Sierra Leone", "leone Sierra Leone", "leone Sierra Leone", "leone Sierra Leone", "leone Sierra Leone", "leone Sierra Leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leone Sierra Leone (1964—2022)", PluralForms{"leone Sierra Leone (1964—2022)", "leone Sierra Leone (1964—2022)", "leone Sierra Leone (1964—2022)", "leone Sierra Leone (1964—2022)", "leone Sierra Leone (1964—2022)", "leone Sierra Leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Swllt Somalia", PluralForms{"swllt Somalia", "swllt Somalia", "swllt Somalia", "swllt Somalia", "swllt Somalia", "swllt Somalia"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Doler Surinam", PluralForms{"doler Surinam", "doler Surinam", "ddoler Surinam", "doler Surinam", "doler Surinam", "doler Surinam"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Guilder Surinam", PluralForms{"guilder Surinam", "guilder Surinam", "guilder Surinam", "guilder Surinam", "guilder Surinam", "guilder Surinam"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Punt De Sudan", PluralForms{"punt De Sudan", "punt De Sudan", "bunt De Sudan", "punt De Sudan", "punt De Sudan", "punt De Sudan"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra São Tomé a Príncipe (1977–2017)", PluralForms{"dobra São Tomé a Príncipe (1977–2017)", "dobra São Tomé a Príncipe (1977–2017)", "dobra São Tomé a Príncipe (1977–2017)", "dobra São Tomé a Príncipe (1977–2017)", "dobra São Tomé a Príncipe (1977–2017)", "dobra São Tomé a Príncipe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra São Tomé a Príncipe", PluralForms{"dobra São Tomé a Príncipe", "dobra São Tomé a Príncipe", "dobra São Tomé a Príncipe", "dobra São Tomé a Príncipe", "dobra São Tomé a Príncipe", "dobra São Tomé a Príncipe"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Colón El Salvador", PluralForms{"colón El Salvador", "colón El Salvador", "colón El Salvador", "colón El Salvador", "colón El Salvador", "colón El Salvador"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Punt Syria", PluralForms{"punt Syria", "punt Syria", "bunt Syria", "punt Syria", "punt Syria", "punt Syria"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni Gwlad Swazi", PluralForms{"lilangeni Gwlad Swazi", "lilangeni Gwlad Swazi", "lilangeni Gwlad Swazi", "lilangeni Gwlad Swazi", "lilangeni Gwlad Swazi", "lilangeni Gwlad Swazi"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Baht Gwlad Thai", PluralForms{"baht Gwlad Thai", "baht Gwlad Thai", "baht Gwlad Thai", "baht Gwlad Thai", "baht Gwlad Thai", "baht Gwlad Thai"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Rwbl Tajikistan", PluralForms{"rwbl Tajikistan", "rwbl Tajikistan", "rwbl Tajikistan", "rwbl Tajikistan", "rwbl Tajikistan", "rwbl Tajikistan"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni Tajikistan", PluralForms{"somoni Tajikstan", "somoni Tajikstan", "somoni Tajikstan", "somoni Tajikstan", "somoni Tajikstan", "somoni Tajikstan"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Manat Turkmenistan (1993–2009)", PluralForms{"manat Turkmenistan (1993–2009)", "manat Turkmenistan (1993–2009)", "manat Turkmenistan (1993–2009)", "manat Turkmenistan (1993–2009)", "manat Turkmenistan (1993–2009)", "manat Turkmenistan (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manat Turkmenistan", PluralForms{"manat Turkmenistan", "manat Turkmenistan", "manat Turkmenistan", "manat Turkmenistan", "manat Turkmenistan", "manat Turkmenistan"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinar Tunisia", PluralForms{"dinar Tunisia", "dinar Tunisia", "dinar Tunisia", "dinar Tunisia", "dinar Tunisia", "dinar Tunisia"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga Tonga", PluralForms{"paʻanga Tonga", "paʻanga Tonga", "paʻanga Tonga", "paʻanga Tonga", "paʻanga Tonga", "paʻanga Tonga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Escudo Timor", PluralForms{"escudo Timor", "escudo Timor", "escudo Timor", "escudo Timor", "escudo Timor", "escudo Timor"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Lira Twrci (1922–2005)", PluralForms{"lira Twrci (1922–2005)", "lira Twrci (1922–2005)", "lira Twrci (1922–2005)", "lira Twrci (1922–2005)", "lira Twrci (1922–2005)", "lira Twrci (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lira Twrci", PluralForms{"lira Twrci", "lira Twrci", "lira Twrci", "lira Twrci", "lira Twrci", "lira Twrci"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Doler Trinidad a Tobago", PluralForms{"doler Trinidad a Tobago", "doler Trinidad a Tobago", "ddoler Trinidad a Tobago", "doler Trinidad a Tobago", "doler Trinidad a Tobago", "doler Trinidad a Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Doler Newydd Taiwan", PluralForms{"doler newydd Taiwan", "doler newydd Taiwan", "ddoler newydd Taiwan", "doler newydd Taiwan", "doler newydd Taiwan", "doler newydd Taiwan"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Swllt Tanzania", PluralForms{"swllt Tanzania", "swllt Tanzania", "swllt Tanzania", "swllt Tanzania", "swllt Tanzania", "swllt Tanzania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Hryvnia Wcráin", PluralForms{"hryvnia Wcráin", "hryvnia Wcráin", "hryvnia Wcráin", "hryvnia Wcráin", "hryvnia Wcráin", "hryvnia Wcráin"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Swllt Uganda (1966–1987)", PluralForms{"swllt Uganda (1966–1987)", "swllt Uganda (1966–1987)", "swllt Uganda (1966–1987)", "swllt Uganda (1966–1987)", "swllt Uganda (1966–1987)", "swllt Uganda (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Swllt Uganda", PluralForms{"swllt Uganda", "swllt Uganda", "swllt Uganda", "swllt Uganda", "swllt Uganda", "swllt Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "Doler UDA", PluralForms{"doler UDA", "doler UDA", "ddoler UDA", "doler UDA", "doler UDA", "doler UDA"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Doler UDA (y diwrnod nesaf)", PluralForms{"doler UDA (y diwrnod nesaf)", "ddoler UDA (y diwrnod nesaf)", "ddoler UDA (y diwrnod nesaf)", "doler UDA (y diwrnod nesaf)", "doler UDA (y diwrnod nesaf)", "doler UDA (y diwrnod nesaf)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Doler UDA (yr un diwrnod)", PluralForms{"doler UDA (yr un diwrnod)", "ddoler UDA (yr un diwrnod)", "ddoler UDA (yr un diwrnod)", "doler UDA (yr un diwrnod)", "doler UDA (yr un diwrnod)", "doler UDA (yr un diwrnod)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Peso Uruguay (1975–1993)", PluralForms{"peso Uruguay (1975–1993)", "peso Uruguay (1975–1993)", "peso Uruguay (1975–1993)", "peso Uruguay (1975–1993)", "peso Uruguay (1975–1993)", "peso Uruguay (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso Uruguay", PluralForms{"peso Uruguay", "peso Uruguay", "peso Uruguay", "peso Uruguay", "peso Uruguay", "peso Uruguay"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Som Uzbekistan", PluralForms{"som Uzbekistan", "som Uzbekistan", "som Uzbekistan", "som Uzbekistan", "som Uzbekistan", "som Uzbekistan"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Bolívar Venezuela (1871–2008)", PluralForms{"bolívar Venezuela (1871–2008)", "bolívar Venezuela (1871–2008)", "bolívar Venezuela (1871–2008)", "bolívar Venezuela (1871–2008)", "bolívar Venezuela (1871–2008)", "bolívar Venezuela (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolívar Venezuela (2008–2018)", PluralForms{"bolívar Venezuela (2008–2018)", "bolívar Venezuela (2008–2018)", "bolívar Venezuela (2008–2018)", "bolívar Venezuela (2008–2018)", "bolívar Venezuela (2008–2018)", "bolívar Venezuela (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolívar Venezuela", PluralForms{"bolívar Venezuela", "bolívar Venezuela", "bolívar Venezuela", "bolívar Venezuela", "bolívar Venezuela", "bolívar Venezuela"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dong Fietnam", PluralForms{"dong Fietnam", "dong Fietnam", "dong Fietnam", "dong Fietnam", "dong Fietnam", "dong Fietnam"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Dong Fietnam (1978–1985)", PluralForms{"dong Fietnam (1978–1985)", "dong Fietnam (1978–1985)", "dong Fietnam (1978–1985)", "dong Fietnam (1978–1985)", "dong Fietnam (1978–1985)", "dong Fietnam (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu Vanuatu", PluralForms{"vatu Vanuatu", "vatu Vanuatu", "vatu Vanuatu", "vatu Vanuatu", "vatu Vanuatu", "vatu Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala Samoa", PluralForms{"tala Samoa", "tala Samoa", "tala Samoa", "tala Samoa", "tala Samoa", "tala Samoa"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Ffranc CFA Canol Affrica", PluralForms{"ffranc CFA Canol Affrica", "ffranc CFA Canol Affrica", "ffranc CFA Canol Affrica", "ffranc CFA Canol Affrica", "ffranc CFA Canol Affrica", "ffranc CFA Canol Affrica"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Arian", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Aur", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Uned Cyfansawdd Ewropeaidd", PluralForms{"uned cyfansawdd Ewropeaidd", "uned cyfansawdd Ewropeaidd", "uned cyfansawdd Ewropeaidd", "uned cyfansawdd Ewropeaidd", "uned cyfansawdd Ewropeaidd", "uned cyfansawdd Ewropeaidd"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Uned Ariannol Ewropeaidd", PluralForms{"uned ariannol Ewropeaidd", "uned ariannol Ewropeaidd", "uned ariannol Ewropeaidd", "uned ariannol Ewropeaidd", "uned ariannol Ewropeaidd", "uned ariannol Ewropeaidd"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Doler Dwyrain y Caribî", PluralForms{"doler Dwyrain y Caribî", "doler Dwyrain y Caribî", "ddoler Dwyrain y Caribî", "doler Dwyrain y Caribî", "doler Dwyrain y Caribî", "doler Dwyrain y Caribî"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Uned Arian Cyfred Ewropeaidd", PluralForms{"uned arian cyfred Ewropeaidd", "uned arian cyfred Ewropeaidd", "uned arian cyfred Ewropeaidd", "uned arian cyfred Ewropeaidd", "uned arian cyfred Ewropeaidd", "uned arian cyfred Ewropeaidd"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Ffranc CFA Gorllewin Affrica", PluralForms{"ffranc CFA Gorllewin Affrica", "ffranc CFA Gorllewin Affrica", "ffranc CFA Gorllewin Affrica", "ffranc CFA Gorllewin Affrica", "ffranc CFA Gorllewin Affrica", "ffranc CFA Gorllewin Affrica"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paladiwm", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Ffranc CFP", PluralForms{"ffranc CPF", "ffranc CPF", "ffranc CPF", "ffranc CPF", "ffranc CPF", "ffranc CPF"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platinwm", PluralForms{"", "", "", "", "", ""}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "Sucre", PluralForms{"sucre", "sucre", "sucre", "sucre", "sucre", "sucre"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "Arian Cyfred Anhysbys", PluralForms{"(arian cyfred anhysbys)", "(arian cyfred anhysbys)", "(arian cyfred anhysbys)", "(arian cyfred anhysbys)", "(arian cyfred anhysbys)", "(arian cyfred anhysbys)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Dinar Yemen", PluralForms{"dinar Yemen", "dinar Yemen", "dinar Yemen", "dinar Yemen", "dinar Yemen", "dinar Yemen"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Rial Yemen", PluralForms{"rial Yemen", "rial Yemen", "rial Yemen", "rial Yemen", "rial Yemen", "rial Yemen"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Rand (ariannol) De Affrica", PluralForms{"rand (ariannol) De Affrica", "rand (ariannol) De Affrica", "rand (ariannol) De Affrica", "rand (ariannol) De Affrica", "rand (ariannol) De Affrica", "rand (ariannol) De Affrica"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "ZAR", "Rand De Affrica", PluralForms{"rand De Affrica", "rand De Affrica", "rand De Affrica", "rand De Affrica", "rand De Affrica", "rand De Affrica"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha Zambia (1968–2012)", PluralForms{"kwacha Zambia (1968–2012)", "kwacha Zambia (1968–2012)", "kwacha Zambia (1968–2012)", "kwacha Zambia (1968–2012)", "kwacha Zambia (1968–2012)", "kwacha Zambia (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZMW", "Kwacha Zambia", PluralForms{"kwacha Zambia", "kwacha Zambia", "kwacha Zambia", "kwacha Zambia", "kwacha Zambia", "kwacha Zambia"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zaire Newydd Zaire (1993–1998)", PluralForms{"zaire newydd Zaire (1993 – 1998)", "zaire newydd Zaire (1993 – 1998)", "zaire newydd Zaire (1993 – 1998)", "zaire newydd Zaire (1993 – 1998)", "zaire newydd Zaire (1993 – 1998)", "zaire newydd Zaire (1993 – 1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zaire Zaire (1971–1993)", PluralForms{"zaire Zaire (1971 – 1993)", "zaire Zaire (1971 – 1993)", "zaire Zaire (1971 – 1993)", "zaire Zaire (1971 – 1993)", "zaire Zaire (1971 – 1993)", "zaire Zaire (1971 – 1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Doler Zimbabwe (1980–2008)", PluralForms{"doler Zimbabwe (1980–2008)", "ddoler Zimbabwe (1980–2008)", "ddoler Zimbabwe (1980–2008)", "doler Zimbabwe (1980–2008)", "doler Zimbabwe (1980–2008)", "doler Zimbabwe (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Doler Zimbabwe (2009)", PluralForms{"doler Zimbabwe (2009)", "ddoler Zimbabwe (2009)", "ddoler Zimbabwe (2009)", "doler Zimbabwe (2009)", "doler Zimbabwe (2009)", "doler Zimbabwe (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Doler Zimbabwe (2008)", PluralForms{"doler Zimbabwe (2008)", "ddoler Zimbabwe (2008)", "ddoler Zimbabwe (2008)", "doler Zimbabwe (2008)", "doler Zimbabwe (2008)", "doler Zimbabwe (2008)"}},
 	},
 }
@@ -6,283 +6,336 @@ var daGL = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		".", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0t", "other": "0\u00a0t"}},
+				{4, 2, map[string]string{"one": "0\u00a0t", "other": "0\u00a0t"}},
+				{5, 3, map[string]string{"one": "0\u00a0t", "other": "0\u00a0t"}},
+				{6, 1, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{7, 2, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{8, 3, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{9, 1, map[string]string{"one": "0\u00a0mia.", "other": "0\u00a0mia."}},
+				{10, 2, map[string]string{"one": "0\u00a0mia.", "other": "0\u00a0mia."}},
+				{11, 3, map[string]string{"one": "0\u00a0mia.", "other": "0\u00a0mia."}},
+				{12, 1, map[string]string{"one": "0\u00a0bio.", "other": "0\u00a0bio."}},
+				{13, 2, map[string]string{"one": "0\u00a0bio.", "other": "0\u00a0bio."}},
+				{14, 3, map[string]string{"one": "0\u00a0bio.", "other": "0\u00a0bio."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 tusind", "other": "0 tusind"}},
+				{4, 2, map[string]string{"one": "0 tusind", "other": "0 tusind"}},
+				{5, 3, map[string]string{"one": "0 tusind", "other": "0 tusind"}},
+				{6, 1, map[string]string{"one": "0 million", "other": "0 millioner"}},
+				{7, 2, map[string]string{"one": "0 millioner", "other": "0 millioner"}},
+				{8, 3, map[string]string{"one": "0 millioner", "other": "0 millioner"}},
+				{9, 1, map[string]string{"one": "0 milliard", "other": "0 milliarder"}},
+				{10, 2, map[string]string{"one": "0 milliarder", "other": "0 milliarder"}},
+				{11, 3, map[string]string{"one": "0 milliarder", "other": "0 milliarder"}},
+				{12, 1, map[string]string{"one": "0 billion", "other": "0 billioner"}},
+				{13, 2, map[string]string{"one": "0 billioner", "other": "0 billioner"}},
+				{14, 3, map[string]string{"one": "0 billioner", "other": "0 billioner"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0t\u00a0¤", "other": "0\u00a0t\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0t\u00a0¤", "other": "0\u00a0t\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0t\u00a0¤", "other": "0\u00a0t\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mia.\u00a0¤", "other": "0\u00a0mia.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mia.\u00a0¤", "other": "0\u00a0mia.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mia.\u00a0¤", "other": "0\u00a0mia.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0bio.\u00a0¤", "other": "0\u00a0bio.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0bio.\u00a0¤", "other": "0\u00a0bio.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0bio.\u00a0¤", "other": "0\u00a0bio.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "Br."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "kr.", "kr."},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr."},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr."},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr."},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorransk peseta", PluralForms{"", "Andorransk peseta", "", "", "", "Andorranske pesetas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham fra de Forenede Arabiske Emirater", PluralForms{"", "FAE-dirham", "", "", "", "FAE-dirham"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afghansk afghani (1927–2002)", PluralForms{"", "Afghansk afghani (1927–2002)", "", "", "", "Afghanske afghani (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghansk afghani", PluralForms{"", "afghansk afghani", "", "", "", "afghanske afghani"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albansk lek", PluralForms{"", "albansk lek", "", "", "", "albanske lek"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armensk dram", PluralForms{"", "armensk dram", "", "", "", "armenske dram"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Nederlandske Antiller-gylden", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolansk kwanza", PluralForms{"", "angolansk kwanza", "", "", "", "angolanske kwanza"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolansk kwanza (1977–1990)", PluralForms{"", "Angolansk kwanza (1977–1990)", "", "", "", "Angolanske kwanza (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolansk nye kwanza (1990–2000)", PluralForms{"", "Angolansk nye kwanza (1990–2000)", "", "", "", "Angolanske nye kwanza (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolansk kwanza (1995–1999)", PluralForms{"", "Angolansk kwanza (1995–1999)", "", "", "", "Angolanske kwanza (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentinsk austral", PluralForms{"", "Argentinsk austral", "", "", "", "Argentinske austral"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentinsk peso (1983–1985)", PluralForms{"", "Argentinsk pesos (1983–1985)", "", "", "", "Argentinske pesos (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinsk peso", PluralForms{"", "argentinsk peso", "", "", "", "argentinske pesos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Østrigsk schilling", PluralForms{"", "Østrigsk schilling", "", "", "", "Østrigske schilling"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "australsk dollar", PluralForms{"", "australsk dollar", "", "", "", "australske dollar"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubansk florin", PluralForms{"", "arubansk florin", "", "", "", "arubanske floriner"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Aserbajdsjansk manat (1993–2006)", PluralForms{"", "Aserbajdsjansk manat (1993–2006)", "", "", "", "Aserbajdsjanske manat (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "aserbajdsjansk manat", PluralForms{"", "aserbajdsjansk manat", "", "", "", "aserbajdsjanske manat"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosnien-Hercegovinsk dinar", PluralForms{"", "Bosnien-Hercegovinsk dinar", "", "", "", "Bosnien-Hercegovinske dinarer"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosnien-hercegovinsk konvertibel mark", PluralForms{"", "bosnien-hercegovinsk konvertibel mark", "", "", "", "bosnien-hercegovinske konvertible mark"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadisk dollar", PluralForms{"", "barbadisk dollar", "", "", "", "barbadiske dollar"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladeshisk taka", PluralForms{"", "bangladeshisk taka", "", "", "", "bangladeshiske taka"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgisk franc (konvertibel)", PluralForms{"", "Belgisk franc (konvertibel)", "", "", "", "Belgiske franc (konvertible)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgisk franc", PluralForms{"", "Belgisk franc", "", "", "", "Belgiske franc"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgisk franc (financial)", PluralForms{"", "Belgisk franc (financial)", "", "", "", "Belgiske franc (financial)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bulgarsk hard lev", PluralForms{"", "Bulgarsk hard lev", "", "", "", "Bulgarske hard lev"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bulgarsk lev", PluralForms{"", "bulgarsk lev", "", "", "", "bulgarske leva"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrainsk dinar", PluralForms{"", "bahrainsk dinar", "", "", "", "bahrainske dinarer"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundisk franc", PluralForms{"", "burundisk franc", "", "", "", "burundiske franc"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudansk dollar", PluralForms{"", "bermudansk dollar", "", "", "", "bermudanske dollar"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "bruneisk dollar", PluralForms{"", "bruneisk dollar", "", "", "", "bruneiske dollar"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviansk boliviano", PluralForms{"", "boliviansk boliviano", "", "", "", "bolivianske boliviano"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Boliviansk peso", PluralForms{"", "Boliviansk peso", "", "", "", "Bolivianske pesos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Boliviansk mvdol", PluralForms{"", "Boliviansk mvdol", "", "", "", "Bolivianske mvdol"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brasiliansk cruzeiro novo (1967–1986)", PluralForms{"", "Brasiliansk cruzeiro novo (1967–1986)", "", "", "", "Brasilianske cruzeiro novo (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brasiliansk cruzado (1986–1989)", PluralForms{"", "Brasiliansk cruzado (1986–1989)", "", "", "", "Brasilianske cruzado (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brasiliansk cruzeiro (1990–1993)", PluralForms{"", "Brasiliansk cruzeiro (1990–1993)", "", "", "", "Brasilianske cruzeiro (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "brasiliansk real", PluralForms{"", "brasiliansk real", "", "", "", "brasilianske realer"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brasiliansk cruzado novo (1989–1990)", PluralForms{"", "Brasiliansk cruzado novo (1989–1990)", "", "", "", "Brasilianske cruzado novo (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brasiliansk cruzeiro (1993–1994)", PluralForms{"", "Brasiliansk cruzeiro (1993–1994)", "", "", "", "Brasilianske cruzeiro (1993–1994)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamansk dollar", PluralForms{"", "bahamansk dollar", "", "", "", "bahamanske dollar"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bhutansk ngultrum", PluralForms{"", "bhutansk ngultrum", "", "", "", "bhutanske ngultrum"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Burmesisk kyat", PluralForms{"", "Burmesisk kyat", "", "", "", "Burmesiske kyat"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botswansk pula", PluralForms{"", "botswansk pula", "", "", "", "botswanske pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Hviderussisk rubel (1994–1999)", PluralForms{"", "Hviderussisk rubel (1994–1999)", "", "", "", "Hviderussiske rubler (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "Br.", "hviderussisk rubel", PluralForms{"", "hviderussisk rubel", "", "", "", "hviderussiske rubler"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "hviderussisk rubel (2000–2016)", PluralForms{"", "hviderussisk rubel (2000–2016)", "", "", "", "hviderussiske rubler (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belizisk dollar", PluralForms{"", "belizisk dollar", "", "", "", "beliziske dollar"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "canadisk dollar", PluralForms{"", "canadisk dollar", "", "", "", "canadiske dollar"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "congolesisk franc", PluralForms{"", "congolesisk franc", "", "", "", "congolesiske franc"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR euro", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "schweizerfranc", PluralForms{"", "", "", "", "", ""}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR franc", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "chilensk peso", PluralForms{"", "chilensk peso", "", "", "", "chilenske pesos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kinesisk yuan (offshore)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "kinesisk yuan", PluralForms{"", "kinesisk yuan", "", "", "", "kinesiske yuan"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "colombiansk peso", PluralForms{"", "colombiansk peso", "", "", "", "colombianske pesos"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "costaricansk colón", PluralForms{"", "costaricansk colón", "", "", "", "costaricanske colón"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbisk dinar (2002–2006)", PluralForms{"", "Serbisk dinar (2002–2006)", "", "", "", "Serbiske dinar (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Tjekkoslovakisk hard koruna", PluralForms{"", "Tjekkoslovakisk hard koruna", "", "", "", "Tjekkoslovakiske hard koruna"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "cubansk konvertibel peso", PluralForms{"", "cubansk konvertibel peso", "", "", "", "cubanske konvertible pesos"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "cubansk peso", PluralForms{"", "cubansk peso", "", "", "", "cubanske pesos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "kapverdisk escudo", PluralForms{"", "kapverdisk escudo", "", "", "", "kapverdiske escudos"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Cypriotisk pund", PluralForms{"", "Cypriotisk pund", "", "", "", "Cypriotiske pund"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "tjekkisk koruna", PluralForms{"", "tjekkisk koruna", "", "", "", "tjekkiske korunaer"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Østtysk mark", PluralForms{"", "Østtysk mark", "", "", "", "Østtyske mark"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Tysk mark", PluralForms{"", "Tysk mark", "", "", "", "Tyske mark"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "djiboutisk franc", PluralForms{"", "djiboutisk franc", "", "", "", "djiboutiske franc"}},
+		"DKK": {2, 0, 2, 50, "DKK", "kr.", "kr.", "dansk krone", PluralForms{"", "dansk krone", "", "", "", "danske kroner"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikansk peso", PluralForms{"", "dominikansk peso", "", "", "", "dominikanske pesos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "algerisk dinar", PluralForms{"", "algerisk dinar", "", "", "", "algeriske dinarer"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ecuadoriansk sucre", PluralForms{"", "Ecuadoriansk sucre", "", "", "", "Ecuadorianske sucre"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estisk kroon", PluralForms{"", "Estisk kroon", "", "", "", "Estiske kroon"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egyptisk pund", PluralForms{"", "egyptisk pund", "", "", "", "egyptiske pund"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritreisk nakfa", PluralForms{"", "eritreisk nakfa", "", "", "", "eritreiske nakfa"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Spansk peseta (A–konto)", PluralForms{"", "Spansk peseta (A–konto)", "", "", "", "Spanske peseta (A–konto)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Spansk peseta (konvertibel konto)", PluralForms{"", "Spansk peseta (konvertibel konto)", "", "", "", "Spanske peseta (konvertibel konto)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Spansk peseta", PluralForms{"", "Spansk pesetas", "", "", "", "Spanske pesetas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopisk birr", PluralForms{"", "etiopisk birr", "", "", "", "etiopiske birr"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Finsk mark", PluralForms{"", "Finsk mark", "", "", "", "Finske mark"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fijiansk dollar", PluralForms{"", "fijiansk dollar", "", "", "", "fijianske dollar"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "pund fra Falklandsøerne", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Fransk franc", PluralForms{"", "Fransk franc", "", "", "", "Franske franc"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "britisk pund", PluralForms{"", "britisk pund", "", "", "", "britiske pund"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Georgisk kupon larit", PluralForms{"", "Georgisk kupon larit", "", "", "", "Georgiske kupon larit"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "georgisk lari", PluralForms{"", "georgisk lari", "", "", "", "georgiske lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghanesisk cedi (1979–2007)", PluralForms{"", "Ghanesisk cedi (1979–2007)", "", "", "", "Ghanesiske cedi (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ghanesisk cedi", PluralForms{"", "ghanesisk cedi", "", "", "", "ghanesiske cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltarisk pund", PluralForms{"", "gibraltarisk pund", "", "", "", "gibraltariske pund"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambisk dalasi", PluralForms{"", "gambisk dalasi", "", "", "", "gambiske dalasi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "guineansk franc", PluralForms{"", "guineansk franc", "", "", "", "guineanske franc"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guineansk syli", PluralForms{"", "Guineansk syli", "", "", "", "Guineanske syli"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ækvatorialguineask ekwele", PluralForms{"", "Ækvatorialguineask ekwele", "", "", "", "Ækvatorialguineaske ekwele"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Græsk drakme", PluralForms{"", "Græsk drakmer", "", "", "", "Græske drakmer"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "guatemalansk quetzal", PluralForms{"", "guatemalansk quetzal", "", "", "", "guatemalanske quetzal"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugisisk guinea escudo", PluralForms{"", "Portugisiske guinea escudo", "", "", "", "Portugisiske guinea escudo"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guineansk peso", PluralForms{"", "Guinea-Bissau-peso", "", "", "", "Guinea-Bissau-pesos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "guyansk dollar", PluralForms{"", "guyansk dollar", "", "", "", "guyanske dollar"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "hongkongsk dollar", PluralForms{"", "hongkongsk dollar", "", "", "", "hongkongske dollar"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduransk lempira", PluralForms{"", "honduransk lempira", "", "", "", "honduranske lempira"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Kroatisk dinar", PluralForms{"", "Kroatisk dinar", "", "", "", "Kroatiske dinarer"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kroatisk kuna", PluralForms{"", "kroatisk kuna", "", "", "", "kroatiske kuna"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitisk gourde", PluralForms{"", "haitisk gourde", "", "", "", "haitiske gourde"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ungarsk forint", PluralForms{"", "ungarsk forint", "", "", "", "ungarske forinter"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonesisk rupiah", PluralForms{"", "indonesisk rupiah", "", "", "", "indonesiske rupiah"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Irsk pund", PluralForms{"", "Irsk pund", "", "", "", "Irske pund"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Israelsk pund", PluralForms{"", "Israelsk pund", "", "", "", "Israelske pund"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ny israelsk shekel", PluralForms{"", "ny israelsk shekel", "", "", "", "nye israelske shekel"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "indisk rupee", PluralForms{"", "indisk rupee", "", "", "", "indiske rupees"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irakisk dinar", PluralForms{"", "irakisk dinar", "", "", "", "irakiske dinarer"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iransk rial", PluralForms{"", "iransk rial", "", "", "", "iranske rialer"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr.", "islandsk krone", PluralForms{"", "islandsk krone", "", "", "", "islandske kroner"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italiensk lire", PluralForms{"", "Italiensk lire", "", "", "", "Italienske lire"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamaicansk dollar", PluralForms{"", "jamaicansk dollar", "", "", "", "jamaicanske dollar"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordansk dinar", PluralForms{"", "jordansk dinar", "", "", "", "jordanske dinarer"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "japansk yen", PluralForms{"", "japansk yen", "", "", "", "japanske yen"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenyansk shilling", PluralForms{"", "kenyansk shilling", "", "", "", "kenyanske shilling"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgisisk som", PluralForms{"", "kirgisisk som", "", "", "", "kirgisiske som"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "cambodjansk riel", PluralForms{"", "cambodjansk riel", "", "", "", "cambodjanske riel"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "comorisk franc", PluralForms{"", "comorisk franc", "", "", "", "comoriske franc"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "nordkoreansk won", PluralForms{"", "nordkoreansk won", "", "", "", "nordkoreanske won"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "sydkoreansk won", PluralForms{"", "sydkoreansk won", "", "", "", "sydkoreanske won"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuwaitisk dinar", PluralForms{"", "kuwaitisk dinar", "", "", "", "kuwaitiske dinarer"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "caymansk dollar", PluralForms{"", "caymansk dollar", "", "", "", "caymanske dollar"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kasakhisk tenge", PluralForms{"", "kasakhisk tenge", "", "", "", "kasakhiske tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laotisk kip", PluralForms{"", "laotisk kip", "", "", "", "laotiske kip"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanesisk pund", PluralForms{"", "libanesisk pund", "", "", "", "libanesiske pund"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "srilankansk rupee", PluralForms{"", "srilankansk rupee", "", "", "", "srilankanske rupee"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberisk dollar", PluralForms{"", "liberisk dollar", "", "", "", "liberiske dollar"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesothisk loti", PluralForms{"", "lesothisk loti", "", "", "", "lesothiske loti"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litauisk litas", PluralForms{"", "Litauisk litas", "", "", "", "Litauiske litai"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Litauisk talonas", PluralForms{"", "Litauisk talonas", "", "", "", "Litauiske talonas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luxembourgsk konvertibel franc", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luxembourgsk franc", PluralForms{"", "Luxembourgsk franc", "", "", "", "Luxembourgske franc"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luxembourgsk finansiel franc", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lettisk lat", PluralForms{"", "Lettisk lat", "", "", "", "Lettiske lats"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Lettisk rubel", PluralForms{"", "Lettisk rubel", "", "", "", "Lettiske rubler"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libysk dinar", PluralForms{"", "libysk dinar", "", "", "", "libyske dinarer"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marokkansk dirham", PluralForms{"", "marokkansk dirham", "", "", "", "marokkanske dirham"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Marokkansk franc", PluralForms{"", "Marokkansk franc", "", "", "", "Marokkanske franc"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldovisk leu", PluralForms{"", "moldovisk leu", "", "", "", "moldoviske lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "madagaskisk ariary", PluralForms{"", "madagaskisk ariary", "", "", "", "madagaskiske ariary"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madagaskisk franc", PluralForms{"", "Madagaskisk franc", "", "", "", "Madagaskiske franc"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonsk denar", PluralForms{"", "makedonsk denar", "", "", "", "makedonske denarer"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malisk franc", PluralForms{"", "Malisk franc", "", "", "", "Maliske franc"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "myanmarsk kyat", PluralForms{"", "myanmarsk kyat", "", "", "", "myanmarske kyat"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolsk tugrik", PluralForms{"", "mongolsk tugrik", "", "", "", "mongolske tugrik"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "macaosk pataca", PluralForms{"", "macaosk pataca", "", "", "", "macaoske pataca"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauritansk ouguiya (1973–2017)", PluralForms{"", "mauritansk ouguiya (1973–2017)", "", "", "", "mauritanske ouguiya (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauritansk ouguiya", PluralForms{"", "mauritansk ouguiya", "", "", "", "mauritanske ouguiya"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Maltesisk lira", PluralForms{"", "Maltesisk lira", "", "", "", "Maltesiske lira"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltesisk pund", PluralForms{"", "Maltesisk pund", "", "", "", "Maltesiske pund"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauritisk rupee", PluralForms{"", "mauritisk rupee", "", "", "", "mauritiske rupees"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldivisk rufiyaa", PluralForms{"", "maldivisk rufiyaa", "", "", "", "maldiviske rufiyaa"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malawisk kwacha", PluralForms{"", "malawisk kwacha", "", "", "", "malawiske kwacha"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "mexicansk peso", PluralForms{"", "mexicansk peso", "", "", "", "mexicanske pesos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Mexicansk silver peso (1861–1992)", PluralForms{"", "Mexicansk silver peso (1861–1992)", "", "", "", "Mexicanske silver peso (1861–1992)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malaysisk ringgit", PluralForms{"", "malaysisk ringgit", "", "", "", "malaysiske ringgit"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambiquisk escudo", PluralForms{"", "Mozambiquisk escudo", "", "", "", "Mozambiquiske escudo"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambiquisk metical (1980–2006)", PluralForms{"", "Mozambiquisk metical (1980–2006)", "", "", "", "Mozambiquiske metical (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mozambiquisk metical", PluralForms{"", "mozambiquisk metical", "", "", "", "mozambiquiske metical"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibisk dollar", PluralForms{"", "namibisk dollar", "", "", "", "namibiske dollar"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigeriansk naira", PluralForms{"", "nigeriansk naira", "", "", "", "nigerianske naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nicaraguansk cordoba (1988–1991)", PluralForms{"", "Nicaraguansk cordoba (1988–1991)", "", "", "", "Nicaraguanske cordoba (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nicaraguansk cordoba", PluralForms{"", "nicaraguansk cordoba", "", "", "", "nicaraguanske cordoba"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Hollandsk guilder", PluralForms{"", "Hollandsk gylden", "", "", "", "Hollandske gylden"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr.", "norsk krone", PluralForms{"", "norsk krone", "", "", "", "norske kroner"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalesisk rupee", PluralForms{"", "nepalesisk rupee", "", "", "", "nepalesiske rupees"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "newzealandsk dollar", PluralForms{"", "newzealandsk dollar", "", "", "", "newzealandske dollar"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omansk rial", PluralForms{"", "omansk rial", "", "", "", "omanske rialer"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamansk balboa", PluralForms{"", "panamansk balboa", "", "", "", "panamanske balboa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "peruviansk inti", PluralForms{"", "peruviansk inti", "", "", "", "peruvianske inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruansk sol", PluralForms{"", "peruansk sol", "", "", "", "peruanske soles"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "peruviansk sol (1863–1965)", PluralForms{"", "peruviansk sol (1863–1965)", "", "", "", "peruvianske sol (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papuansk kina", PluralForms{"", "papuansk kina", "", "", "", "papuanske kina"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filippinsk peso", PluralForms{"", "filippinsk peso", "", "", "", "filippinske pesos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistansk rupee", PluralForms{"", "pakistansk rupee", "", "", "", "pakistanske rupee"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "polsk zloty", PluralForms{"", "polsk zloty", "", "", "", "polske zloty"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Polsk zloty (1950–1995)", PluralForms{"", "Polsk zloty (1950–1995)", "", "", "", "Polske zloty (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugisisk escudo", PluralForms{"", "Portugisisk escudo", "", "", "", "Portugisiske escudo"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paraguaysk guarani", PluralForms{"", "paraguaysk guarani", "", "", "", "paraguayske guarani"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "qatarsk rial", PluralForms{"", "qatarsk rial", "", "", "", "qatarske rial"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumænsk leu (1952–2006)", PluralForms{"", "Rumænsk leu (1952–2006)", "", "", "", "Rumænske leu (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "rumænsk leu", PluralForms{"", "rumænsk leu", "", "", "", "rumænske lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "serbisk dinar", PluralForms{"", "serbisk dinar", "", "", "", "serbiske dinarer"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "russisk rubel", PluralForms{"", "russisk rubel", "", "", "", "russiske rubler"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Russisk rubel (1991–1998)", PluralForms{"", "Russisk rubel (1991–1998)", "", "", "", "Russiske rubler (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "rwandisk franc", PluralForms{"", "rwandisk franc", "", "", "", "rwandiske franc"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudiarabisk riyal", PluralForms{"", "saudiarabisk riyal", "", "", "", "saudiarabiske riyal"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "salomonsk dollar", PluralForms{"", "salomonsk dollar", "", "", "", "salomonske dollar"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "seychellisk rupee", PluralForms{"", "seychellisk rupee", "", "", "", "seychelliske rupees"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudansk dinar (1992–2007)", PluralForms{"", "Sudansk dinar (1992–2007)", "", "", "", "Sudanske dinar (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudansk pund", PluralForms{"", "sudansk pund", "", "", "", "sudanske pund"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudansk pund (1957–1998)", PluralForms{"", "Sudanske pund (1957–1998)", "", "", "", "Sudanske pund (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr.", "svensk krone", PluralForms{"", "svensk krone", "", "", "", "svenske kroner"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singaporeansk dollar", PluralForms{"", "singaporeansk dollar", "", "", "", "singaporeanske dollar"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "pund fra Saint Helena", PluralForms{"", "", "", "", "", ""}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovensk tolar", PluralForms{"", "Slovensk tolar", "", "", "", "Slovenske tolar"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovakisk koruna", PluralForms{"", "Slovakisk koruna", "", "", "", "Slovakiske koruna"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierraleonsk leone", PluralForms{"", "sierraleonsk leone", "", "", "", "sierraleonske leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierraleonsk leone (1964—2022)", PluralForms{"", "sierraleonsk leone (1964—2022)", "", "", "", "sierraleonske leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somalisk shilling", PluralForms{"", "somalisk shilling", "", "", "", "somaliske shilling"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamsk dollar", PluralForms{"", "surinamsk dollar", "", "", "", "surinamske dollar"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surinamsk guilder", PluralForms{"", "Surinamsk guilder", "", "", "", "Surinamske guilder"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "sydsudansk pund", PluralForms{"", "sydsudansk pund", "", "", "", "sydsudanske pund"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra fra Sao Tome og Principe (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra fra Sao Tome og Principe", PluralForms{"", "", "", "", "", ""}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Sovjetisk rubel", PluralForms{"", "Sovjetisk rubel", "", "", "", "Sovjetiske rubler"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Salvadoransk colon", PluralForms{"", "Salvadoransk colon", "", "", "", "Salvadoranske colon"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "syrisk pund", PluralForms{"", "syrisk pund", "", "", "", "syriske pund"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "swazilandsk lilangeni", PluralForms{"", "swazilandsk lilangeni", "", "", "", "swazilandske lilangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "thailandsk baht", PluralForms{"", "thailandsk baht", "", "", "", "thailandske baht"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadsjikisk rubel", PluralForms{"", "Tadsjikisk rubel", "", "", "", "Tadsjikiske rubel"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadsjikisk somoni", PluralForms{"", "tadsjikisk somoni", "", "", "", "tadsjikiske somoni"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmensk manat (1993–2009)", PluralForms{"", "Turkmensk manat (1993–2009)", "", "", "", "Turkmenske manat (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmensk manat", PluralForms{"", "turkmensk manat", "", "", "", "turkmenske manat"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tunesisk dinar", PluralForms{"", "tunesisk dinar", "", "", "", "tunesiske dinarer"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tongansk paʻanga", PluralForms{"", "tongansk paʻanga", "", "", "", "tonganske paʻanga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Escudo fra Timor", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Tyrkisk lire (1922–2005)", PluralForms{"", "Tyrkisk lire (1922–2005)", "", "", "", "Tyrkiske lire (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "tyrkisk lira", PluralForms{"", "tyrkisk lira", "", "", "", "tyrkiske lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trinidadisk dollar", PluralForms{"", "trinidadisk dollar", "", "", "", "trinidadiske dollar"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "ny taiwansk dollar", PluralForms{"", "ny taiwansk dollar", "", "", "", "nye taiwanske dollar"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanisk shilling", PluralForms{"", "tanzanisk shilling", "", "", "", "tanzaniske shilling"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrainsk grynia", PluralForms{"", "ukrainsk grynia", "", "", "", "ukrainske grynia"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrainsk karbovanetz", PluralForms{"", "Ukrainsk karbovanetz", "", "", "", "Ukrainske karbovanetz"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Ugandisk shilling (1966–1987)", PluralForms{"", "Ugandisk shilling (1966–1987)", "", "", "", "Ugandiske shilling (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandisk shilling", PluralForms{"", "ugandisk shilling", "", "", "", "ugandiske shilling"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "amerikansk dollar", PluralForms{"", "amerikansk dollar", "", "", "", "amerikanske dollar"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Amerikansk dollar (næste dag)", PluralForms{"", "Amerikansk dollar (næste dag)", "", "", "", "Amerikanske dollar (næste dag)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Amerikansk dollar (samme dag)", PluralForms{"", "Amerikansk dollar (samme dag)", "", "", "", "Amerikanske dollar (samme dag)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Uruguayansk peso (1975–1993)", PluralForms{"", "Uruguayansk peso (1975–1993)", "", "", "", "Uruguayanske peso (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "uruguayansk peso", PluralForms{"", "uruguayansk peso", "", "", "", "uruguayanske pesos"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "usbekisk sum", PluralForms{"", "usbekisk sum", "", "", "", "usbekiske sum"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venezuelansk bolivar (1871–2008)", PluralForms{"", "Venezuelansk bolivar (1871–2008)", "", "", "", "Venezuelanske bolivar (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelansk bolivar (2008–2018)", PluralForms{"", "venezuelansk bolivar (2008–2018)", "", "", "", "venezuelanske bolivarer (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelansk bolivar", PluralForms{"", "venezuelansk bolivar", "", "", "", "venezuelanske bolivarer"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "vietnamesisk dong", PluralForms{"", "vietnamesisk dong", "", "", "", "vietnamesiske dong"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuaisk vatu", PluralForms{"", "vanuaisk vatu", "", "", "", "vanuaiske vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samoansk tala", PluralForms{"", "samoansk tala", "", "", "", "samoanske tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "CFA-franc (BEAC)", PluralForms{"", "centralafrikansk CFA-franc", "", "", "", "CFA-franc (BEAC)"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Sølv", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Guld", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "EURCO", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "EMU", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "østkaribisk dollar", PluralForms{"", "østkaribisk dollar", "", "", "", "østkaribiske dollar"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "SDR", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "ECU", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Fransk guldfranc", PluralForms{"", "Fransk guldfranc", "", "", "", "Franske guldfranc"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Fransk UIC-franc", PluralForms{"", "Fransk UIC-franc", "", "", "", "Franske UIC-franc"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "CFA-franc BCEAO", PluralForms{"", "", "", "", "", ""}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Palladium", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP-franc", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platin", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET-fond", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "testvalutakode", PluralForms{"", "testvaluta", "", "", "", "testvaluta"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "ukendt valuta", PluralForms{"", "(ukendt valuta)", "", "", "", "(ukendt valuta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Yemenitisk dinar", PluralForms{"", "Yemenitisk dinar", "", "", "", "Yemenitiske dinarer"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "yemenitisk rial", PluralForms{"", "yemenitisk rial", "", "", "", "yemenitiske rial"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslavisk hard dinar (1966–1990)", PluralForms{"", "Jugoslavisk hard dinar (1966–1990)", "", "", "", "Jugoslaviske hard dinar (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslavisk noviy dinar (1994–2002)", PluralForms{"", "Jugoslavisk noviy dinar (1994–2002)", "", "", "", "Jugoslaviske noviy dinar (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslavisk konvertibel dinar (1990–1992)", PluralForms{"", "Jugoslavisk konvertibel dinar (1990–1992)", "", "", "", "Jugoslaviske konvertibel dinar (1990–1992)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Sydafrikansk rand (financial)", PluralForms{"", "Sydafrikansk rand (financial)", "", "", "", "Sydafrikanske rand (financial)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "sydafrikansk rand", PluralForms{"", "sydafrikansk rand", "", "", "", "sydafrikanske rand"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambisk kwacha (1968–2012)", PluralForms{"", "Zambisk kwacha (1968–2012)", "", "", "", "Zambiske kwacha (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambisk kwacha", PluralForms{"", "zambisk kwacha", "", "", "", "zambiske kwacha"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Ny zairisk zaire (1993–1998)", PluralForms{"", "Ny zairisk zaire (1993–1998)", "", "", "", "Ny zairiske zaire (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zairisk zaire (1971–1993)", PluralForms{"", "Zairisk zaire (1971–1993)", "", "", "", "Zairiske zaire (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwisk dollar (1980–2008)", PluralForms{"", "Zimbabwisk dollar (1980–2008)", "", "", "", "Zimbabwiske dollar (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabwisk dollar (2009)", PluralForms{"", "Zimbabwisk dollar (2009)", "", "", "", "Zimbabwiske dollar (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabwisk dollar (2008)", PluralForms{"", "Zimbabwisk dollar (2008)", "", "", "", "Zimbabwiske dollar (2008)"}},
 	},
 }
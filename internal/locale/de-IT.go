@@ -6,313 +6,366 @@ var deIT = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "·",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0Mio.", "other": "0\u00a0Mio."}},
+				{7, 2, map[string]string{"one": "0\u00a0Mio.", "other": "0\u00a0Mio."}},
+				{8, 3, map[string]string{"one": "0\u00a0Mio.", "other": "0\u00a0Mio."}},
+				{9, 1, map[string]string{"one": "0\u00a0Mrd.", "other": "0\u00a0Mrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0Mrd.", "other": "0\u00a0Mrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0Mrd.", "other": "0\u00a0Mrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0Bio.", "other": "0\u00a0Bio."}},
+				{13, 2, map[string]string{"one": "0\u00a0Bio.", "other": "0\u00a0Bio."}},
+				{14, 3, map[string]string{"one": "0\u00a0Bio.", "other": "0\u00a0Bio."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 Tausend", "other": "0 Tausend"}},
+				{4, 2, map[string]string{"one": "0 Tausend", "other": "0 Tausend"}},
+				{5, 3, map[string]string{"one": "0 Tausend", "other": "0 Tausend"}},
+				{6, 1, map[string]string{"one": "0 Million", "other": "0 Millionen"}},
+				{7, 2, map[string]string{"one": "0 Millionen", "other": "0 Millionen"}},
+				{8, 3, map[string]string{"one": "0 Millionen", "other": "0 Millionen"}},
+				{9, 1, map[string]string{"one": "0 Milliarde", "other": "0 Milliarden"}},
+				{10, 2, map[string]string{"one": "0 Milliarden", "other": "0 Milliarden"}},
+				{11, 3, map[string]string{"one": "0 Milliarden", "other": "0 Milliarden"}},
+				{12, 1, map[string]string{"one": "0 Billion", "other": "0 Billionen"}},
+				{13, 2, map[string]string{"one": "0 Billionen", "other": "0 Billionen"}},
+				{14, 3, map[string]string{"one": "0 Billionen", "other": "0 Billionen"}},
+			},
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0Mio.\u00a0¤", "other": "0\u00a0Mio.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0Mio.\u00a0¤", "other": "0\u00a0Mio.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0Mio.\u00a0¤", "other": "0\u00a0Mio.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0Mrd.\u00a0¤", "other": "0\u00a0Mrd.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0Mrd.\u00a0¤", "other": "0\u00a0Mrd.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0Mrd.\u00a0¤", "other": "0\u00a0Mrd.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0Bio.\u00a0¤", "other": "0\u00a0Bio.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0Bio.\u00a0¤", "other": "0\u00a0Bio.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0Bio.\u00a0¤", "other": "0\u00a0Bio.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "öS", "öS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGK", "BGK"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGJ", "BGJ"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "Cub$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DM", "DM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "Fl£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "F.G."},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "FC"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "F.Rw"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "SYP"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "K"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorranische Pesete", PluralForms{"", "Andorranische Pesete", "", "", "", "Andorranische Peseten"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "VAE-Dirham", PluralForms{"", "", "", "", "", ""}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afghanische Afghani (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghanischer Afghani", PluralForms{"", "Afghanischer Afghani", "", "", "", "Afghanische Afghani"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albanischer Lek (1946–1965)", PluralForms{"", "Albanischer Lek (1946–1965)", "", "", "", "Albanische Lek (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albanischer Lek", PluralForms{"", "Albanischer Lek", "", "", "", "Albanische Lek"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armenischer Dram", PluralForms{"", "Armenischer Dram", "", "", "", "Armenische Dram"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Niederländische-Antillen-Gulden", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angolanischer Kwanza", PluralForms{"", "Angolanischer Kwanza", "", "", "", "Angolanische Kwanza"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolanischer Kwanza (1977–1990)", PluralForms{"", "Angolanischer Kwanza (1977–1990)", "", "", "", "Angolanische Kwanza (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolanischer Neuer Kwanza (1990–2000)", PluralForms{"", "Angolanischer Neuer Kwanza (1990–2000)", "", "", "", "Angolanische Neue Kwanza (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolanischer Kwanza Reajustado (1995–1999)", PluralForms{"", "Angolanischer Kwanza Reajustado (1995–1999)", "", "", "", "Angolanische Kwanza Reajustado (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentinischer Austral", PluralForms{"", "Argentinischer Austral", "", "", "", "Argentinische Austral"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Argentinischer Peso Ley (1970–1983)", PluralForms{"", "Argentinischer Peso Ley (1970–1983)", "", "", "", "Argentinische Pesos Ley (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Argentinischer Peso (1881–1970)", PluralForms{"", "Argentinischer Peso (1881–1970)", "", "", "", "Argentinische Pesos (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentinischer Peso (1983–1985)", PluralForms{"", "Argentinischer Peso (1983–1985)", "", "", "", "Argentinische Peso (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentinischer Peso", PluralForms{"", "Argentinischer Peso", "", "", "", "Argentinische Pesos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "öS", "öS", "Österreichischer Schilling", PluralForms{"", "Österreichischer Schilling", "", "", "", "Österreichische Schilling"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "Australischer Dollar", PluralForms{"", "Australischer Dollar", "", "", "", "Australische Dollar"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruba-Florin", PluralForms{"", "", "", "", "", ""}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Aserbaidschan-Manat (1993–2006)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Aserbaidschan-Manat", PluralForms{"", "", "", "", "", ""}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosnien und Herzegowina Dinar (1992–1994)", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Konvertible Mark Bosnien und Herzegowina", PluralForms{"", "", "", "", "", ""}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Bosnien und Herzegowina Neuer Dinar (1994–1997)", PluralForms{"", "Bosnien und Herzegowina Neuer Dinar (1994–1997)", "", "", "", "Bosnien und Herzegowina Neue Dinar (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbados-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladesch-Taka", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgischer Franc (konvertibel)", PluralForms{"", "Belgischer Franc (konvertibel)", "", "", "", "Belgische Franc (konvertibel)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgischer Franc", PluralForms{"", "Belgischer Franc", "", "", "", "Belgische Franc"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgischer Finanz-Franc", PluralForms{"", "Belgischer Finanz-Franc", "", "", "", "Belgische Finanz-Franc"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bulgarische Lew (1962–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGK", "BGK", "Bulgarischer Lew (1952–1962)", PluralForms{"", "Bulgarischer Lew (1952–1962)", "", "", "", "Bulgarische Lew (1952–1962)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgarischer Lew", PluralForms{"", "Bulgarischer Lew", "", "", "", "Bulgarische Lew"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGJ", "BGJ", "Bulgarischer Lew (1879–1952)", PluralForms{"", "Bulgarischer Lew (1879–1952)", "", "", "", "Bulgarische Lew (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahrain-Dinar", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundi-Franc", PluralForms{"", "Burundi-Franc", "", "", "", "Burundi-Francs"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermuda-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunei-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolivianischer Boliviano", PluralForms{"", "Bolivianischer Boliviano", "", "", "", "Bolivianische Bolivianos"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Bolivianischer Boliviano (1863–1963)", PluralForms{"", "Bolivianischer Boliviano (1863–1963)", "", "", "", "Bolivianische Bolivianos (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bolivianischer Peso", PluralForms{"", "Bolivianischer Peso", "", "", "", "Bolivianische Peso"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Boliviansiche Mvdol", PluralForms{"", "Boliviansiche Mvdol", "", "", "", "Bolivianische Mvdol"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brasilianischer Cruzeiro Novo (1967–1986)", PluralForms{"", "Brasilianischer Cruzeiro Novo (1967–1986)", "", "", "", "Brasilianische Cruzeiro Novo (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brasilianischer Cruzado (1986–1989)", PluralForms{"", "Brasilianischer Cruzado (1986–1989)", "", "", "", "Brasilianische Cruzado (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brasilianischer Cruzeiro (1990–1993)", PluralForms{"", "Brasilianischer Cruzeiro (1990–1993)", "", "", "", "Brasilianische Cruzeiro (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brasilianischer Real", PluralForms{"", "Brasilianischer Real", "", "", "", "Brasilianische Real"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brasilianischer Cruzado Novo (1989–1990)", PluralForms{"", "Brasilianischer Cruzado Novo (1989–1990)", "", "", "", "Brasilianische Cruzado Novo (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brasilianischer Cruzeiro (1993–1994)", PluralForms{"", "Brasilianischer Cruzeiro (1993–1994)", "", "", "", "Brasilianische Cruzeiro (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Brasilianischer Cruzeiro (1942–1967)", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamas-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Bhutan-Ngultrum", PluralForms{"", "", "", "", "", ""}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Birmanischer Kyat", PluralForms{"", "Birmanischer Kyat", "", "", "", "Birmanische Kyat"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswanischer Pula", PluralForms{"", "Botswanischer Pula", "", "", "", "Botswanische Pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Belarus-Rubel (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Weißrussischer Rubel", PluralForms{"", "Weißrussischer Rubel", "", "", "", "Weißrussische Rubel"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Weißrussischer Rubel (2000–2016)", PluralForms{"", "Weißrussischer Rubel (2000–2016)", "", "", "", "Weißrussische Rubel (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belize-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Kanadischer Dollar", PluralForms{"", "Kanadischer Dollar", "", "", "", "Kanadische Dollar"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongo-Franc", PluralForms{"", "Kongo-Franc", "", "", "", "Kongo-Francs"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR-Euro", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Schweizer Franken", PluralForms{"", "", "", "", "", ""}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR Franken", PluralForms{"", "", "", "", "", ""}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Chilenischer Escudo", PluralForms{"", "Chilenischer Escudo", "", "", "", "Chilenische Escudo"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Chilenische Unidades de Fomento", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Chilenischer Peso", PluralForms{"", "Chilenischer Peso", "", "", "", "Chilenische Pesos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Renminbi-Yuan (Offshore)", PluralForms{"", "", "", "", "", ""}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Dollar der Chinesischen Volksbank", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Renminbi Yuan", PluralForms{"", "Chinesischer Yuan", "", "", "", "Renminbi Yuan"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolumbianischer Peso", PluralForms{"", "Kolumbianischer Peso", "", "", "", "Kolumbianische Pesos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Kolumbianische Unidades de valor real", PluralForms{"", "Kolumbianische Unidad de valor real", "", "", "", "Kolumbianische Unidades de valor real"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Costa-Rica-Colón", PluralForms{"", "", "", "", "", ""}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbischer Dinar (2002–2006)", PluralForms{"", "Serbischer Dinar (2002–2006)", "", "", "", "Serbische Dinar (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Tschechoslowakische Krone", PluralForms{"", "Tschechoslowakische Kronen", "", "", "", "Tschechoslowakische Kronen"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "Cub$", "Kubanischer Peso (konvertibel)", PluralForms{"", "Kubanischer Peso (konvertibel)", "", "", "", "Kubanische Pesos (konvertibel)"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kubanischer Peso", PluralForms{"", "Kubanischer Peso", "", "", "", "Kubanische Pesos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Cabo-Verde-Escudo", PluralForms{"", "Cabo-Verde-Escudo", "", "", "", "Cabo-Verde-Escudos"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Zypern-Pfund", PluralForms{"", "Zypern Pfund", "", "", "", "Zypern Pfund"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Tschechische Krone", PluralForms{"", "Tschechische Krone", "", "", "", "Tschechische Kronen"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Mark der DDR", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DM", "DM", "Deutsche Mark", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Dschibuti-Franc", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Dänische Krone", PluralForms{"", "Dänische Krone", "", "", "", "Dänische Kronen"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikanischer Peso", PluralForms{"", "Dominikanischer Peso", "", "", "", "Dominikanische Pesos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Algerischer Dinar", PluralForms{"", "Algerischer Dinar", "", "", "", "Algerische Dinar"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ecuadorianischer Sucre", PluralForms{"", "Ecuadorianischer Sucre", "", "", "", "Ecuadorianische Sucre"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Verrechnungseinheit für Ecuador", PluralForms{"", "Verrechnungseinheiten für Ecuador", "", "", "", "Verrechnungseinheiten für Ecuador"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estnische Krone", PluralForms{"", "Estnische Krone", "", "", "", "Estnische Kronen"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Ägyptisches Pfund", PluralForms{"", "Ägyptisches Pfund", "", "", "", "Ägyptische Pfund"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritreischer Nakfa", PluralForms{"", "Eritreischer Nakfa", "", "", "", "Eritreische Nakfa"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Spanische Peseta (A–Konten)", PluralForms{"", "Spanische Peseta (A–Konten)", "", "", "", "Spanische Peseten (A–Konten)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Spanische Peseta (konvertibel)", PluralForms{"", "Spanische Peseta (konvertibel)", "", "", "", "Spanische Peseten (konvertibel)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Spanische Peseta", PluralForms{"", "Spanische Peseta", "", "", "", "Spanische Peseten"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Äthiopischer Birr", PluralForms{"", "Äthiopischer Birr", "", "", "", "Äthiopische Birr"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Finnische Mark", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fidschi-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "Fl£", "Falkland-Pfund", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Französischer Franc", PluralForms{"", "Französischer Franc", "", "", "", "Französische Franc"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Britisches Pfund", PluralForms{"", "Britisches Pfund", "", "", "", "Britische Pfund"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Georgischer Kupon Larit", PluralForms{"", "Georgischer Kupon Larit", "", "", "", "Georgische Kupon Larit"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Georgischer Lari", PluralForms{"", "Georgischer Lari", "", "", "", "Georgische Lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghanaischer Cedi (1979–2007)", PluralForms{"", "Ghanaischer Cedi (1979–2007)", "", "", "", "Ghanaische Cedi (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "₵", "Ghanaischer Cedi", PluralForms{"", "Ghanaischer Cedi", "", "", "", "Ghanaische Cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltar-Pfund", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambia-Dalasi", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "F.G.", "Guinea-Franc", PluralForms{"", "", "", "", "", ""}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guineischer Syli", PluralForms{"", "Guineischer Syli", "", "", "", "Guineische Syli"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Äquatorialguinea-Ekwele", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Griechische Drachme", PluralForms{"", "Griechische Drachme", "", "", "", "Griechische Drachmen"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Guatemaltekischer Quetzal", PluralForms{"", "Guatemaltekischer Quetzal", "", "", "", "Guatemaltekische Quetzales"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugiesisch Guinea Escudo", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissau Peso", PluralForms{"", "Guinea-Bissau Peso", "", "", "", "Guinea-Bissau Pesos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Guyana-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Hongkong-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Honduras-Lempira", PluralForms{"", "", "", "", "", ""}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Kroatischer Dinar", PluralForms{"", "Kroatischer Dinar", "", "", "", "Kroatische Dinar"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kroatischer Kuna", PluralForms{"", "Kroatischer Kuna", "", "", "", "Kroatische Kuna"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haitianische Gourde", PluralForms{"", "Haitianische Gourde", "", "", "", "Haitianische Gourdes"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Ungarischer Forint", PluralForms{"", "Ungarischer Forint", "", "", "", "Ungarische Forint"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indonesische Rupiah", PluralForms{"", "", "", "", "", ""}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Irisches Pfund", PluralForms{"", "Irisches Pfund", "", "", "", "Irische Pfund"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Israelisches Pfund", PluralForms{"", "Israelisches Pfund", "", "", "", "Israelische Pfund"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Israelischer Schekel (1980–1985)", PluralForms{"", "Israelischer Schekel (1980–1985)", "", "", "", "Israelische Schekel (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Israelischer Neuer Schekel", PluralForms{"", "Israelischer Neuer Schekel", "", "", "", "Israelische Neue Schekel"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Indische Rupie", PluralForms{"", "Indische Rupie", "", "", "", "Indische Rupien"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irakischer Dinar", PluralForms{"", "Irakischer Dinar", "", "", "", "Irakische Dinar"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Iranischer Rial", PluralForms{"", "Iranischer Rial", "", "", "", "Iranische Rial"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Isländische Krone (1918–1981)", PluralForms{"", "Isländische Krone (1918–1981)", "", "", "", "Isländische Kronen (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Isländische Krone", PluralForms{"", "Isländische Krone", "", "", "", "Isländische Kronen"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italienische Lira", PluralForms{"", "Italienische Lira", "", "", "", "Italienische Lire"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaika-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordanischer Dinar", PluralForms{"", "Jordanischer Dinar", "", "", "", "Jordanische Dinar"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Japanischer Yen", PluralForms{"", "Japanischer Yen", "", "", "", "Japanische Yen"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenia-Schilling", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kirgisischer Som", PluralForms{"", "Kirgisischer Som", "", "", "", "Kirgisische Som"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambodschanischer Riel", PluralForms{"", "Kambodschanischer Riel", "", "", "", "Kambodschanische Riel"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "FC", "Komoren-Franc", PluralForms{"", "Komoren-Franc", "", "", "", "Komoren-Francs"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Nordkoreanischer Won", PluralForms{"", "Nordkoreanischer Won", "", "", "", "Nordkoreanische Won"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Südkoreanischer Hwan (1953–1962)", PluralForms{"", "", "", "", "", ""}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Südkoreanischer Won (1945–1953)", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Südkoreanischer Won", PluralForms{"", "Südkoreanischer Won", "", "", "", "Südkoreanische Won"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuwait-Dinar", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kaiman-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kasachischer Tenge", PluralForms{"", "Kasachischer Tenge", "", "", "", "Kasachische Tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laotischer Kip", PluralForms{"", "Laotischer Kip", "", "", "", "Laotische Kip"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Libanesisches Pfund", PluralForms{"", "Libanesisches Pfund", "", "", "", "Libanesische Pfund"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Sri-Lanka-Rupie", PluralForms{"", "Sri-Lanka-Rupie", "", "", "", "Sri-Lanka-Rupien"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberianischer Dollar", PluralForms{"", "Liberianischer Dollar", "", "", "", "Liberianische Dollar"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litauischer Litas", PluralForms{"", "Litauischer Litas", "", "", "", "Litauische Litas"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Litauischer Talonas", PluralForms{"", "Litauische Talonas", "", "", "", "Litauische Talonas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luxemburgischer Franc (konvertibel)", PluralForms{"", "Luxemburgische Franc (konvertibel)", "", "", "", "Luxemburgische Franc (konvertibel)"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luxemburgischer Franc", PluralForms{"", "Luxemburgische Franc", "", "", "", "Luxemburgische Franc"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luxemburgischer Finanz-Franc", PluralForms{"", "Luxemburgische Finanz-Franc", "", "", "", "Luxemburgische Finanz-Franc"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lettischer Lats", PluralForms{"", "Lettischer Lats", "", "", "", "Lettische Lats"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Lettischer Rubel", PluralForms{"", "Lettische Rubel", "", "", "", "Lettische Rubel"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libyscher Dinar", PluralForms{"", "Libyscher Dinar", "", "", "", "Libysche Dinar"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Marokkanischer Dirham", PluralForms{"", "Marokkanischer Dirham", "", "", "", "Marokkanische Dirham"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Marokkanischer Franc", PluralForms{"", "Marokkanische Franc", "", "", "", "Marokkanische Franc"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monegassischer Franc", PluralForms{"", "Monegassischer Franc", "", "", "", "Monegassische Franc"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Moldau-Cupon", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldau-Leu", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madagaskar-Ariary", PluralForms{"", "", "", "", "", ""}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madagaskar-Franc", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Mazedonischer Denar", PluralForms{"", "Mazedonischer Denar", "", "", "", "Mazedonische Denari"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Mazedonischer Denar (1992–1993)", PluralForms{"", "Mazedonischer Denar (1992–1993)", "", "", "", "Mazedonische Denar (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malischer Franc", PluralForms{"", "Malische Franc", "", "", "", "Malische Franc"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Myanmarischer Kyat", PluralForms{"", "Myanmarischer Kyat", "", "", "", "Myanmarische Kyat"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongolischer Tögrög", PluralForms{"", "Mongolischer Tögrög", "", "", "", "Mongolische Tögrög"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Macao-Pataca", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauretanischer Ouguiya (1973–2017)", PluralForms{"", "Mauretanischer Ouguiya (1973–2017)", "", "", "", "Mauretanische Ouguiya (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauretanischer Ouguiya", PluralForms{"", "Mauretanischer Ouguiya", "", "", "", "Mauretanische Ouguiya"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Maltesische Lira", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltesisches Pfund", PluralForms{"", "Maltesische Pfund", "", "", "", "Maltesische Pfund"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mauritius-Rupie", PluralForms{"", "Mauritius-Rupie", "", "", "", "Mauritius-Rupien"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Malediven-Rupie (alt)", PluralForms{"", "Malediven-Rupie (alt)", "", "", "", "Malediven-Rupien (alt)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Malediven-Rufiyaa", PluralForms{"", "Malediven-Rufiyaa", "", "", "", "Malediven-Rupien"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawi-Kwacha", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Mexikanischer Peso", PluralForms{"", "Mexikanischer Peso", "", "", "", "Mexikanische Pesos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Mexikanischer Silber-Peso (1861–1992)", PluralForms{"", "Mexikanische Silber-Peso (1861–1992)", "", "", "", "Mexikanische Silber-Pesos (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Mexicanischer Unidad de Inversion (UDI)", PluralForms{"", "Mexicanischer Unidad de Inversion (UDI)", "", "", "", "Mexikanische Unidad de Inversion (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaysischer Ringgit", PluralForms{"", "Malaysischer Ringgit", "", "", "", "Malaysische Ringgit"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mosambikanischer Escudo", PluralForms{"", "Mozambikanische Escudo", "", "", "", "Mozambikanische Escudo"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mosambikanischer Metical (1980–2006)", PluralForms{"", "Mosambikanischer Metical (1980–2006)", "", "", "", "Mosambikanische Meticais (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mosambikanischer Metical", PluralForms{"", "Mosambikanischer Metical", "", "", "", "Mosambikanische Meticais"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibia-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigerianischer Naira", PluralForms{"", "Nigerianischer Naira", "", "", "", "Nigerianische Naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nicaraguanischer Córdoba (1988–1991)", PluralForms{"", "Nicaraguanischer Córdoba (1988–1991)", "", "", "", "Nicaraguanische Córdoba (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nicaragua-Córdoba", PluralForms{"", "Nicaragua-Córdoba", "", "", "", "Nicaragua-Córdobas"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Niederländischer Gulden", PluralForms{"", "Niederländischer Gulden", "", "", "", "Niederländische Gulden"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norwegische Krone", PluralForms{"", "Norwegische Krone", "", "", "", "Norwegische Kronen"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepalesische Rupie", PluralForms{"", "Nepalesische Rupie", "", "", "", "Nepalesische Rupien"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Neuseeland-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omanischer Rial", PluralForms{"", "Omanischer Rial", "", "", "", "Omanische Rials"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panamaischer Balboa", PluralForms{"", "Panamaischer Balboa", "", "", "", "Panamaische Balboas"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peruanischer Inti", PluralForms{"", "Peruanische Inti", "", "", "", "Peruanische Inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peruanischer Sol", PluralForms{"", "Peruanischer Sol", "", "", "", "Peruanische Sol"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peruanischer Sol (1863–1965)", PluralForms{"", "Peruanischer Sol (1863–1965)", "", "", "", "Peruanische Sol (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua-neuguineischer Kina", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Philippinischer Peso", PluralForms{"", "Philippinischer Peso", "", "", "", "Philippinische Pesos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistanische Rupie", PluralForms{"", "Pakistanische Rupie", "", "", "", "Pakistanische Rupien"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Polnischer Złoty", PluralForms{"", "Polnischer Złoty", "", "", "", "Polnische Złoty"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Polnischer Zloty (1950–1995)", PluralForms{"", "Polnischer Zloty (1950–1995)", "", "", "", "Polnische Zloty (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugiesischer Escudo", PluralForms{"", "Portugiesische Escudo", "", "", "", "Portugiesische Escudo"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paraguayischer Guaraní", PluralForms{"", "Paraguayischer Guaraní", "", "", "", "Paraguayische Guaraníes"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Katar-Riyal", PluralForms{"", "", "", "", "", ""}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rhodesischer Dollar", PluralForms{"", "Rhodesische Dollar", "", "", "", "Rhodesische Dollar"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumänischer Leu (1952–2006)", PluralForms{"", "Rumänischer Leu (1952–2006)", "", "", "", "Rumänische Leu (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "Rumänischer Leu", PluralForms{"", "Rumänischer Leu", "", "", "", "Rumänische Leu"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbischer Dinar", PluralForms{"", "Serbischer Dinar", "", "", "", "Serbische Dinaren"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Russischer Rubel", PluralForms{"", "Russischer Rubel", "", "", "", "Russische Rubel"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "Russischer Rubel (1991–1998)", PluralForms{"", "Russischer Rubel (1991–1998)", "", "", "", "Russische Rubel (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "F.Rw", "Ruanda-Franc", PluralForms{"", "Ruanda-Franc", "", "", "", "Ruanda-Francs"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudi-Rial", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Salomonen-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seychellen-Rupie", PluralForms{"", "Seychellen-Rupie", "", "", "", "Seychellen-Rupien"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudanesischer Dinar (1992–2007)", PluralForms{"", "Sudanesischer Dinar (1992–2007)", "", "", "", "Sudanesische Dinar (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudanesisches Pfund", PluralForms{"", "Sudanesisches Pfund", "", "", "", "Sudanesische Pfund"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudanesisches Pfund (1957–1998)", PluralForms{"", "Sudanesisches Pfund (1957–1998)", "", "", "", "Sudanesische Pfund (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Schwedische Krone", PluralForms{"", "Schwedische Krone", "", "", "", "Schwedische Kronen"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapur-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "St.-Helena-Pfund", PluralForms{"", "", "", "", "", ""}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slowenischer Tolar", PluralForms{"", "Slowenischer Tolar", "", "", "", "Slowenische Tolar"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slowakische Krone", PluralForms{"", "Slowakische Kronen", "", "", "", "Slowakische Kronen"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sierra-leonischer Leone", PluralForms{"", "Sierra-leonischer Leone", "", "", "", "Sierra-leonische Leones"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sierra-leonischer Leone (1964–2022)", PluralForms{"", "Sierra-leonischer Leone (1964–2022)", "", "", "", "Sierra-leonische Leones (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somalia-Schilling", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Suriname-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Suriname Gulden", PluralForms{"", "Suriname-Gulden", "", "", "", "Suriname-Gulden"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Südsudanesisches Pfund", PluralForms{"", "Südsudanesisches Pfund", "", "", "", "Südsudanesische Pfund"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "São-toméischer Dobra (1977–2017)", PluralForms{"", "São-toméischer Dobra (1977–2017)", "", "", "", "São-toméische Dobra (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "São-toméischer Dobra", PluralForms{"", "São-toméischer Dobra", "", "", "", "São-toméische Dobras"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Sowjetischer Rubel", PluralForms{"", "Sowjetische Rubel", "", "", "", "Sowjetische Rubel"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "El Salvador Colon", PluralForms{"", "El Salvador-Colon", "", "", "", "El Salvador-Colon"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "SYP", "Syrisches Pfund", PluralForms{"", "Syrisches Pfund", "", "", "", "Syrische Pfund"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Swasiländischer Lilangeni", PluralForms{"", "Swasiländischer Lilangeni", "", "", "", "Swasiländische Emalangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Thailändischer Baht", PluralForms{"", "Thailändischer Baht", "", "", "", "Thailändische Baht"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadschikistan Rubel", PluralForms{"", "Tadschikistan-Rubel", "", "", "", "Tadschikistan-Rubel"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadschikistan-Somoni", PluralForms{"", "", "", "", "", ""}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmenistan-Manat (1993–2009)", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmenistan-Manat", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunesischer Dinar", PluralForms{"", "Tunesischer Dinar", "", "", "", "Tunesische Dinar"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tongaischer Paʻanga", PluralForms{"", "Tongaischer Paʻanga", "", "", "", "Tongaische Paʻanga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timor-Escudo", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Türkische Lira (1922–2005)", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Türkische Lira", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidad-und-Tobago-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Neuer Taiwan-Dollar", PluralForms{"", "Neuer Taiwan-Dollar", "", "", "", "Neue Taiwan-Dollar"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tansania-Schilling", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrainische Hrywnja", PluralForms{"", "Ukrainische Hrywnja", "", "", "", "Ukrainische Hrywen"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrainischer Karbovanetz", PluralForms{"", "Ukrainische Karbovanetz", "", "", "", "Ukrainische Karbovanetz"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Uganda-Schilling (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Uganda-Schilling", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "US-Dollar", PluralForms{"", "", "", "", "", ""}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "US Dollar (Nächster Tag)", PluralForms{"", "US-Dollar (Nächster Tag)", "", "", "", "US-Dollar (Nächster Tag)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "US Dollar (Gleicher Tag)", PluralForms{"", "US-Dollar (Gleicher Tag)", "", "", "", "US-Dollar (Gleicher Tag)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Uruguayischer Peso (Indexierte Rechnungseinheiten)", PluralForms{"", "Uruguayischer Peso (Indexierte Rechnungseinheiten)", "", "", "", "Uruguayische Pesos (Indexierte Rechnungseinheiten)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Uruguayischer Peso (1975–1993)", PluralForms{"", "Uruguayischer Peso (1975–1993)", "", "", "", "Uruguayische Pesos (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Uruguayischer Peso", PluralForms{"", "Uruguayischer Peso", "", "", "", "Uruguayische Pesos"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Usbekistan-Sum", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venezolanischer Bolívar (1871–2008)", PluralForms{"", "Venezolanischer Bolívar (1871–2008)", "", "", "", "Venezolanische Bolívares (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venezolanischer Bolívar (2008–2018)", PluralForms{"", "Venezolanischer Bolívar (2008–2018)", "", "", "", "Venezolanische Bolívares (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venezolanischer Bolívar", PluralForms{"", "Venezolanischer Bolívar", "", "", "", "Venezolanische Bolívares"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Vietnamesischer Dong", PluralForms{"", "Vietnamesischer Dong", "", "", "", "Vietnamesische Dong"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vietnamesischer Dong(1978–1985)", PluralForms{"", "Vietnamesischer Dong(1978–1985)", "", "", "", "Vietnamesische Dong(1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu-Vatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoanischer Tala", PluralForms{"", "Samoanischer Tala", "", "", "", "Samoanische Tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "CFA-Franc (BEAC)", PluralForms{"", "", "", "", "", ""}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Unze Silber", PluralForms{"", "Unze Silber", "", "", "", "Unzen Silber"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Unze Gold", PluralForms{"", "Unze Gold", "", "", "", "Unzen Gold"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Europäische Rechnungseinheit", PluralForms{"", "Europäische Rechnungseinheiten", "", "", "", "Europäische Rechnungseinheiten"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Europäische Währungseinheit (XBB)", PluralForms{"", "Europäische Währungseinheiten (XBB)", "", "", "", "Europäische Währungseinheiten (XBB)"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Europäische Rechnungseinheit (XBC)", PluralForms{"", "Europäische Rechnungseinheiten (XBC)", "", "", "", "Europäische Rechnungseinheiten (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Europäische Rechnungseinheit (XBD)", PluralForms{"", "Europäische Rechnungseinheiten (XBD)", "", "", "", "Europäische Rechnungseinheiten (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Ostkaribischer Dollar", PluralForms{"", "Ostkaribischer Dollar", "", "", "", "Ostkaribische Dollar"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Sonderziehungsrechte", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Europäische Währungseinheit (XEU)", PluralForms{"", "Europäische Währungseinheiten (XEU)", "", "", "", "Europäische Währungseinheiten (XEU)"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Französischer Gold-Franc", PluralForms{"", "Französische Gold-Franc", "", "", "", "Französische Gold-Franc"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Französischer UIC-Franc", PluralForms{"", "Französische UIC-Franc", "", "", "", "Französische UIC-Franc"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "CFA-Franc (BCEAO)", PluralForms{"", "CFA-Franc (BCEAO)", "", "", "", "CFA-Francs (BCEAO)"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Unze Palladium", PluralForms{"", "Unze Palladium", "", "", "", "Unzen Palladium"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP-Franc", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Unze Platin", PluralForms{"", "Unze Platin", "", "", "", "Unzen Platin"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET Funds", PluralForms{"", "", "", "", "", ""}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "SUCRE", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Testwährung", PluralForms{"", "", "", "", "", ""}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "Rechnungseinheit der AfEB", PluralForms{"", "Rechnungseinheit der AfEB", "", "", "", "Rechnungseinheiten der AfEB"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "Unbekannte Währung", PluralForms{"", "(unbekannte Währung)", "", "", "", "(unbekannte Währung)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jemen-Dinar", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jemen-Rial", PluralForms{"", "", "", "", "", ""}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslawischer Dinar (1966–1990)", PluralForms{"", "Jugoslawischer Dinar (1966–1990)", "", "", "", "Jugoslawische Dinar (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslawischer Neuer Dinar (1994–2002)", PluralForms{"", "Jugoslawischer Neuer Dinar (1994–2002)", "", "", "", "Jugoslawische Neue Dinar (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslawischer Dinar (konvertibel)", PluralForms{"", "Jugoslawische Dinar (konvertibel)", "", "", "", "Jugoslawische Dinar (konvertibel)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Jugoslawischer reformierter Dinar (1992–1993)", PluralForms{"", "Jugoslawischer reformierter Dinar (1992–1993)", "", "", "", "Jugoslawische reformierte Dinar (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Südafrikanischer Rand (Finanz)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Südafrikanischer Rand", PluralForms{"", "Südafrikanischer Rand", "", "", "", "Südafrikanische Rand"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "K", "Kwacha", PluralForms{"", "", "", "", "", ""}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zaire-Neuer Zaïre (1993–1998)", PluralForms{"", "Zaire-Neuer Zaïre (1993–1998)", "", "", "", "Zaire-Neue Zaïre (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zaire-Zaïre (1971–1993)", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Simbabwe-Dollar (1980–2008)", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Simbabwe-Dollar (2009)", PluralForms{"", "", "", "", "", ""}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Simbabwe-Dollar (2008)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,201 +6,254 @@ var dsb = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "·",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tys.", "other": "0\u00a0tys."}},
+				{4, 2, map[string]string{"one": "0\u00a0tys.", "other": "0\u00a0tys."}},
+				{5, 3, map[string]string{"one": "0\u00a0tys.", "other": "0\u00a0tys."}},
+				{6, 1, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{7, 2, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{8, 3, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{9, 1, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tysac", "one": "0 tysac", "other": "0 tysac", "two": "0 tysac"}},
+				{4, 2, map[string]string{"few": "0 tysac", "one": "0 tysac", "other": "0 tysac", "two": "0 tysac"}},
+				{5, 3, map[string]string{"few": "0 tysac", "one": "0 tysac", "other": "0 tysac", "two": "0 tysac"}},
+				{6, 1, map[string]string{"few": "0 miliony", "one": "0 milion", "other": "0 milionow", "two": "0 miliona"}},
+				{7, 2, map[string]string{"few": "0 milionow", "one": "0 milionow", "other": "0 milionow", "two": "0 milionow"}},
+				{8, 3, map[string]string{"few": "0 milionow", "one": "0 milionow", "other": "0 milionow", "two": "0 milionow"}},
+				{9, 1, map[string]string{"few": "0 miliardy", "one": "0 miliarda", "other": "0 miliardow", "two": "0 miliarźe"}},
+				{10, 2, map[string]string{"few": "0 miliardow", "one": "0 miliardow", "other": "0 miliardow", "two": "0 miliardow"}},
+				{11, 3, map[string]string{"few": "0 miliardow", "one": "0 miliardow", "other": "0 miliardow", "two": "0 miliardow"}},
+				{12, 1, map[string]string{"few": "0 biliony", "one": "0 bilion", "other": "0 bilionow", "two": "0 biliona"}},
+				{13, 2, map[string]string{"few": "0 bilionow", "one": "0 bilionow", "other": "0 bilionow", "two": "0 bilionow"}},
+				{14, 3, map[string]string{"few": "0 bilionow", "one": "0 bilionow", "other": "0 bilionow", "two": "0 bilionow"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tys.\u00a0¤", "one": "0\u00a0tys.\u00a0¤", "other": "0\u00a0tys.\u00a0¤", "two": "0\u00a0tys.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tys.\u00a0¤", "one": "0\u00a0tys.\u00a0¤", "other": "0\u00a0tys.\u00a0¤", "two": "0\u00a0tys.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tys.\u00a0¤", "one": "0\u00a0tys.\u00a0¤", "other": "0\u00a0tys.\u00a0¤", "two": "0\u00a0tys.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mio.\u00a0¤", "one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤", "two": "0\u00a0mio.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mio.\u00a0¤", "one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤", "two": "0\u00a0mio.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mio.\u00a0¤", "one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤", "two": "0\u00a0mio.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mrd.\u00a0¤", "one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤", "two": "0\u00a0mrd.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mrd.\u00a0¤", "one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤", "two": "0\u00a0mrd.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mrd.\u00a0¤", "one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤", "two": "0\u00a0mrd.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤", "two": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤", "two": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤", "two": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "zł", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorraska peseta", PluralForms{"", "andorraska peseta", "andorraskej peseśe", "andorraske pesety", "", "andorraskich pesetow"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "ZAE dirham", PluralForms{"", "ZAE dirham", "ZAE dirhama", "SAE dirhamy", "", "SAE dirhamow"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghaniski afgani", PluralForms{"", "afghaniski afgani", "afghaniskej afganija", "afghaniske afganije", "", "afghaniskich afganijow"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albański lek", PluralForms{"", "albański lek", "albańskej leka", "albańske leki", "", "albańskich lekow"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armeński dram", PluralForms{"", "armeński dram", "armeńskej drama", "armeńske dramy", "", "armeńskich dramow"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "nižozemsko-antilski gulden", PluralForms{"", "nižozemskoantilski gulden", "nižozemskoantilskej guldena", "nižozemskoantilske guldeny", "", "nižozemskoantilskich guldenow"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolska kwanza", PluralForms{"", "angolska kwanza", "angolskej kwanzy", "angolske kwanze", "", "angolskich kwanzow"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "angolska kwanza (1977–1990)", PluralForms{"", "angolska kwanza (1977–1990)", "angolskej kwanzy (1977–1990)", "angolske kwanze (1977–1990)", "", "angolskich kwanzow (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolska nowa kwanza (1990–2000)", PluralForms{"", "angolska nowa kwanza (1990–2000)", "angolskej nowej kwanzy (1990–2000)", "angolske nowe kwanze (1990–2000)", "", "angolskich nowych kwanzow (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "angolska kwanza reajustado (1995–1999)", PluralForms{"", "angolska kwanza reajustado (1995–1999)", "angolskej kwanzy reajustado (1995–1999)", "angolske kwanze reajustado (1995–1999)", "", "angolskich kwanzow reajustado (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentinski austral", PluralForms{"", "argentinski austral", "argentinskej australa", "argentinske australy", "", "argentinskich australow"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentinski peso (1983–1985)", PluralForms{"", "argentinski peso (1983–1985)", "argentinskej peso (1983–1985)", "argentinske peso (1983–1985)", "", "argentinskich peso (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinski peso", PluralForms{"", "argentinski peso", "argentinskej peso", "argentinske peso", "", "argentinskich peso"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "rakuski šiling", PluralForms{"", "rakuski šiling", "rakuskej šilinga", "rakuske šilingi", "", "rakuskich šilingow"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "awstralski dolar", PluralForms{"", "awstralski dolar", "awstralskej dolara", "awstralske dolary", "", "awstralskich dolarow"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "aruba-florin", PluralForms{"", "aruba-florin", "aruba-florina", "aruba-floriny", "", "aruba-florinow"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "azerbajdžaniski manat (1993–2006)", PluralForms{"", "azerbajdžaniski manat (1993–2006)", "azerbajdžaniskej manata (1993–2006)", "azerbajdžaniske manaty (1993–2006)", "", "azerbajdžaniskich manatow (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "azerbajdžaniski manat", PluralForms{"", "azerbajdžaniski manat", "azerbajdžaniskej manata", "azerbajdžaniske manaty", "", "azerbajdžaniskich manatow"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosniski dinar", PluralForms{"", "bosniski dinar", "bosniskej dinara", "bosniske dinary", "", "bosniskich dinarow"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosniska konwertibelna marka", PluralForms{"", "bosniska konwertibelna marka", "bosniskej konwertibelnej marce", "bosniske konwertibelne marki", "", "bosniskich konwertibelnych markow"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoski dolar", PluralForms{"", "barbadoski dolar", "barbadoskej dolara", "barbadoske dolary", "", "barbadoskich dolarow"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladešska taka", PluralForms{"", "bangladešska taka", "bangladešskej tace", "bangladešske taki", "", "bangladešskich takow"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgiski frank (konwertibelny)", PluralForms{"", "belgiski frank (konwertibelny)", "belgiskej franka (konwertibelnej)", "belgiske franki (konwertibelne)", "", "belgiskich frankow (konwertibelnych)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgiski frank", PluralForms{"", "belgiski frank", "belgiskej franka", "belgiske franki", "", "belgiskich frankow"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgiski financny frank", PluralForms{"", "belgiski financny frank", "belgiskej financnej franka", "belgiske financne franki", "", "belgiskich financnych frankow"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "bulgarski lew (1962–1999)", PluralForms{"", "bulgarski lew (1962–1999)", "bulgarskej lewa (1962–1999)", "bulgarske lewy (1962–1999)", "", "bulgarskich lewow (1962–1999)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bulgarski lew", PluralForms{"", "bulgarski lew", "bulgarskej lewa", "bulgarske lewy", "", "bulgarskich lewow"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrainski dinar", PluralForms{"", "bahrainski dinar", "bahrainskej dinara", "bahrainske dinary", "", "bahrainskich dinarow"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundiski frank", PluralForms{"", "burundiski frank", "burundiskej franka", "burundiske franki", "", "burundiskich frankow"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudaski dolar", PluralForms{"", "bermudaski dolar", "bermudaskej dolara", "bermudaske dolary", "", "bermudaskich dolarow"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "bruneiski dolar", PluralForms{"", "bruneiski dolar", "bruneiskej dolara", "bruneiske dolary", "", "bruneiskich dolarow"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliwiski boliviano", PluralForms{"", "boliwiski boliviano", "boliwiskej bolivianje", "boliwiske boliviana", "", "boliwiskich bolivianow"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "boliwiski peso", PluralForms{"", "boliwiski peso", "boliwiskej peso", "boliwiske peso", "", "boliwiskich peso"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "boliwiski mvdol", PluralForms{"", "boliwiski mvdol", "boliwiskej mvdola", "boliwiske mvdole", "", "boliwiskich mvdolow"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brazilski nowy cruzeiro (1967–1986)", PluralForms{"", "brazilski nowy cruzeiro (1967–1986)", "brazilskej nowej cruzeira (1967–1986)", "brazilske nowe cruzeiry (1967–1986)", "", "brazilskich nowych cruzeirow (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brazilski cruzado (1986–1989)", PluralForms{"", "brazilski cruzado (1986–1989)", "brazilskej cruzaźe (1986–1989)", "brazilske cruzada (1986–1989)", "", "brazilskich cruzadow (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "brazilski cruzeiro (1990–1993)", PluralForms{"", "brazilski cruzeiro (1990–1993)", "brazilskej cruzeirje (1990–1993)", "brazilske cruzeira (1990–1993)", "", "brazilskich cruzeirow (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "brazilski real", PluralForms{"", "brazilski real", "brazilskej reala", "brazilske reale", "", "brazilskich realow"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "brazilski nowy cruzado (1989–1990)", PluralForms{"", "brazilski nowy cruzado (1989–1990)", "brazilskej nowej cruzaźe (1989–1990)", "brazilske nowe cruzada", "", "brazilskich nowych cruzadow"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "brazilski cruzeiro (1993–1994)", PluralForms{"", "brazilski cruzeiro (1993–1994)", "brazilskej cruzeirje (1993–1994)", "brazilske cruzeira (1993–1994)", "", "brazilskich cruzeirow (1993–1994)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamaski dolar", PluralForms{"", "bahamaski dolar", "bahamaskej dolara", "bahamaske dolary", "", "bahamaskich dolarow"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bhutański ngultrum", PluralForms{"", "bhutański ngultrum", "bhutańskej ngultruma", "bhutańske ngultrumy", "", "bhutańskich ngultrumow"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "burmaski kyat", PluralForms{"", "burmaski kyat", "burmaskej kyata", "burmaske kyaty", "", "burmaskich kyatow"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botswaniska pula", PluralForms{"", "botswaniska pula", "botswaniskej puli", "botswaniske pule", "", "botswaniskich pulow"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "běłoruski rubl (1994–1999)", PluralForms{"", "běłoruski rubl (1994–1999)", "běłoruskej rubla (1994–1999)", "běłoruske ruble (1994–1999)", "", "běłoruskich rublow (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "běłoruski rubl", PluralForms{"", "běłoruski rubl", "běłoruskej rubla", "běłoruske ruble", "", "běłoruskich rublow"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "běłoruski rubl (2000–2016)", PluralForms{"", "běłoruski rubl (2000–2016)", "běłoruskej rubla (2000–2016)", "běłoruske ruble (2000–2016)", "", "běłoruskich rublow (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belizeski dolar", PluralForms{"", "belizeski dolar", "belizeskej dolara", "belizeske dolary", "", "belizeskich dolarow"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "kanadiski dolar", PluralForms{"", "kanadiski dolar", "kanadiskej dolara", "kanadiske dolary", "", "kanadiskich dolarow"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongoski frank", PluralForms{"", "kongoski frank", "kongoskej franka", "kongoske franki", "", "kongoskich frankow"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "šwicarski frank", PluralForms{"", "šwicarski frank", "šwicarskej franka", "šwicarske franki", "", "šwicarskich frankow"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "chilski peso", PluralForms{"", "chilski peso", "chilskej peso", "chilske peso", "", "chilskich peso"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "chinski yuan (offshore)", PluralForms{"", "chinski yuan (offshore)", "chinskej yuanaj (offshore)", "chinske yuany (offshore)", "", "chinskich yuanow (offshore)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "chinski yuan", PluralForms{"", "chinski yuan", "chinskej yuana", "chinske yuany", "", "chinskich yuanow"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kolumbiski peso", PluralForms{"", "kolumbiski peso", "kolumbiskej peso", "kolumbiske peso", "", "kolumbiskich peso"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kosta-rikański colón", PluralForms{"", "kosta-rikański colón", "kosta-rikańskej colóna", "kosta-rikańske colóny", "", "kosta-rikańskich colónow"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubański konwertibelny peso", PluralForms{"", "kubański konwertibelny peso", "kubańskej konwertibelnej peso", "kubańske konwertibelne peso", "", "kubańskich konwertibelnych peso"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubański peso", PluralForms{"", "kubański peso", "kubańskej peso", "kubańske peso", "", "kubańskich peso"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "kapverdski escudo", PluralForms{"", "kapverdski escudo", "kapverdskej escuźe", "kapverdske escuda", "", "kapverdskich escudow"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "česka krona", PluralForms{"", "česka krona", "českej kronje", "česke krony", "", "českich kronow"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "dźibutiski frank", PluralForms{"", "dźibutiski frank", "dźibutiskej franka", "dźibutiske franki", "", "dźibutiskich frankow"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "dańska krona", PluralForms{"", "dańska krona", "dańskej kronje", "dańske krony", "", "dańskich kronow"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikański peso", PluralForms{"", "dominikański peso", "dominikańskej peso", "dominikańske peso", "", "dominikańskich peso"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "algeriski dinar", PluralForms{"", "algeriski dinar", "algeriskej dinara", "algeriske dinary", "", "algeriskich dinarow"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egyptojski punt", PluralForms{"", "egyptojski punt", "egyptojskej punta", "egyptojske punty", "", "egyptojskich puntow"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritrejska nakfa", PluralForms{"", "eritrejska nakfa", "eritrejskej nakfje", "eritrejske nakfy", "", "eritrejskich nakfow"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopiski birr", PluralForms{"", "etiopiski birr", "etiopiskej birra", "etiopiske birry", "", "etiopiskich birrow"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fidźiski dolar", PluralForms{"", "fidźiski dolar", "fidźiskej dolara", "fidźiske dolary", "", "fidźiskich dolarow"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklandski punt", PluralForms{"", "falklandski punt", "falklandskej punta", "falklandske punty", "", "falklandskich puntow"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "britiski punt", PluralForms{"", "britiski punt", "britiskej punta", "britiske punty", "", "britiskich puntow"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "georgiski lari", PluralForms{"", "georgiski lari", "georgiskej lari", "georgiske lari", "", "georgiskich lari"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ghanaski cedi", PluralForms{"", "ghanaski cedi", "ghanaskej cedi", "ghanaske cedi", "", "ghanaskich cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltiski punt", PluralForms{"", "gibraltiski punt", "gibraltiskej punta", "gibraltiske punty", "", "gibraltiskich puntow"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambiski dalasi", PluralForms{"", "gambiski dalasi", "gambiskej dalasi", "gambiske dalasi", "", "gambiskich dalasi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "guineski frank", PluralForms{"", "guineski frank", "guineskej franka", "guineske franki", "", "guineskich frankow"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "guatemalski quetzal", PluralForms{"", "guatemalski quetzal", "guatemalskej quetzala", "guatemalske quetzale", "", "guatemalskich quetzalow"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissau peso", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "guyański dolar", PluralForms{"", "guyański dolar", "guyańskej dolara", "guyańske dolary", "", "guyańskich dolarow"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "hongkongski dolar", PluralForms{"", "hongkongski dolar", "hongkongskej dolara", "hongkongske dolary", "", "hongkongskich dolarow"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduraska lempira", PluralForms{"", "honduraska lempira", "honduraskej lempirje", "honduraske lempiry", "", "honduraskich lempirow"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "chorwatska kuna", PluralForms{"", "chorwatska kuna", "chorwatskej kunje", "chorwatske kuny", "", "chorwatskich kunow"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitiska gourda", PluralForms{"", "haitiska gourda", "haitiskej gourźe", "haitiske gourdy", "", "haitiskich gourdow"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "madźarski forint", PluralForms{"", "madźarski forint", "madźarskej forinta", "madźarske forinty", "", "madźarskich forintow"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indoneska rupija", PluralForms{"", "indoneska rupija", "indoneskej rupiji", "indoneske rupije", "", "indoneskich rupijow"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "israelski nowy šekel", PluralForms{"", "israelski nowy šekel", "israelskej nowej šekela", "israelske nowe šekele", "", "israelskich nowych šekelow"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "indiska rupija", PluralForms{"", "indiska rupija", "indiskej rupiji", "indiske rupije", "", "indiskich rupijow"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irakski dinar", PluralForms{"", "irakski dinar", "irakskej dinara", "irakske dinary", "", "irakskich dinarow"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "irański rial", PluralForms{"", "irański rial", "irańskej riala", "irańske riale", "", "irańskich rialow"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandska krona", PluralForms{"", "islandska krona", "islandskej kronje", "islandske krony", "", "islandskich kronow"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamaiski dolar", PluralForms{"", "jamaiski dolar", "jamaiskej dolara", "jamaiske dolary", "", "jamaiskich dolarow"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordaniski dinar", PluralForms{"", "jordaniski dinar", "jordaniskej dinara", "jordaniske dinary", "", "jordaniskich dinarow"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "japański yen", PluralForms{"", "japański yen", "japańskej yena", "japańske yeny", "", "japańskich yenow"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "keniaski šiling", PluralForms{"", "keniaski šiling", "keniaskej šilinga", "keniaske šilingi", "", "keniaskich šilingow"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgiski som", PluralForms{"", "kirgiski som", "kirgiskej soma", "kirgiske somy", "", "kirgiskich somow"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodžaski riel", PluralForms{"", "kambodžaski riel", "kambodžaskej riela", "kambodžaske riele", "", "kambodžaskich rielow"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorski frank", PluralForms{"", "komorski frank", "komorskej franka", "komorske franki", "", "komorskich frankow"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "pódpołnocnokorejski won", PluralForms{"", "pódpołnocnokorejski won", "pódpołnocnokorejskej wona", "pódpołnocnokorejske wony", "", "pódpołnocnokorejskich wonow"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "pódpołdnjowokorejski won", PluralForms{"", "pódpołdnjowokorejski won", "pódpołdnjowokorejskej wona", "pódpołdnjowokorejske wony", "", "pódpołdnjowokorejskich wonow"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuwaitski dinar", PluralForms{"", "kuwaitski dinar", "kuwaitskej dinara", "kuwaitske dinary", "", "kuwaitskich dinarow"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "kajmaniski dolar", PluralForms{"", "kajmaniski dolar", "kajmaniskej dolara", "kajmaniske dolary", "", "kajmaniskich dolarow"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazachski tenge", PluralForms{"", "kazachski tenge", "kazachskej tenge", "kazachske tenge", "", "kazachskich tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoski kip", PluralForms{"", "laoski kip", "laoskej kipa", "laoske kipy", "", "laoskich kipow"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanoński punt", PluralForms{"", "libanoński punt", "libanońskej punta", "libanońske punty", "", "libanońskich puntow"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "sri-lankaska rupija", PluralForms{"", "sri-lankaska rupija", "sri-lankaskej rupiji", "sri-lankaske rupije", "", "sri-lankaskich rupijow"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberiski dolar", PluralForms{"", "liberiski dolar", "liberiskej dolara", "liberiske dolary", "", "liberiskich dolarow"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesothiski loti", PluralForms{"", "lesothiski loti", "lesothiskej lotija", "lesothiske lotije", "", "lesothiskich lotijow"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litawski litas", PluralForms{"", "litawski litas", "litawskej litasa", "litawske litasy", "", "litawskich litasow"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "letiski lat", PluralForms{"", "letiski lat", "letiskej lata", "letiske laty", "", "letiskich latow"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libyski dinar", PluralForms{"", "libyski dinar", "libyskej dinara", "libyske dinary", "", "libyskich dinarow"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marokkoski dirham", PluralForms{"", "marokkoski dirham", "marokkoskej dirhama", "marokkoske dirhamy", "", "marokkoskich dirhamow"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldawiski leu", PluralForms{"", "moldawiski leu", "moldawiskej leu", "moldawiske leu", "", "moldawiskich leu"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "madagaskarski ariary", PluralForms{"", "madagaskarski ariary", "madagaskarskej ariary", "madagaskarske ariary", "", "madagaskarskich ariary"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedoński denar", PluralForms{"", "makedoński denar", "makedońskej denara", "makedońske denary", "", "makedońskich denarow"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "myanmarski kyat", PluralForms{"", "myanmarski kyat", "myanmarskej kyata", "myanmarske kyaty", "", "myanmarskich kyatow"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolski tugrik", PluralForms{"", "mongolski tugrik", "mongolskej tugrika", "mongolske tugriki", "", "mongolskich tugrikow"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "macaoska pataca", PluralForms{"", "macaoska pataca", "macaoskej pataca", "macaoske pataca", "", "macaoskich pataca"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauretański ouguiya (1973–2017)", PluralForms{"", "mauretański ouguiya (1973–2017)", "mauretańskej ouguiya (1973–2017)", "mauretańske ouguiya (1973–2017)", "", "mauretański ouguiya (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauretański ouguiya", PluralForms{"", "mauretański ouguiya", "mauretańskej ouguiya", "mauretańske ouguiya", "", "mauretański ouguiya"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauriciska rupija", PluralForms{"", "mauriciska rupija", "mauriciskej rupiji", "mauriciske rupije", "", "mauriciskich rupijow"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "malediwiska rupija", PluralForms{"", "malediwiska rupija", "malediwiskej rupiji", "malediwiske rupije", "", "malediwiskich rupijow"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malawiski kwacha", PluralForms{"", "malawiski kwacha", "malawiskej kwaše", "malawiske kwachy", "", "malawiskich kwachow"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "mexiski peso", PluralForms{"", "mexiski peso", "mexiskej peso", "mexiske peso", "", "mexiskich peso"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malajziski ringgit", PluralForms{"", "malajziski ringgit", "malajziskej ringgita", "malajziske ringgity", "", "malajziskich ringgitow"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozabicke escudo", PluralForms{"", "mozabicke escudo", "mozabickej escuźe", "mozabicke escuda", "", "mozabickich escud"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "mosambikski metical (1980–2006)", PluralForms{"", "mosambikski metical (1980–2006)", "mosambikskej meticala (1980–2006)", "mosambikske meticale (1980–2006)", "", "mosambikskich meticalow (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mosambikski metical", PluralForms{"", "mosambikski metical", "mosambikskej meticala", "mosambikske meticale", "", "mosambikskich meticalow"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibiski dolar", PluralForms{"", "namibiski dolar", "namibiskej dolara", "namibiske dolary", "", "namibiskich dolarow"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigeriska naira", PluralForms{"", "nigeriska naira", "nigeriskej nairje", "nigeriske nairy", "", "nigeriskich nairow"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nikaraguaska cordoba", PluralForms{"", "nikaraguaska cordoba", "nikaraguaskej cordobje", "nikaraguaske cordoby", "", "nikaraguaskich cordobow"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "norwegska krona", PluralForms{"", "norwegska krona", "norwegskej kronje", "norwegske krony", "", "norwegskich kronow"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalska rupija", PluralForms{"", "nepalska rupija", "nepalskej rupiji", "nepalske rupije", "", "nepalskich rupijow"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "nowoseelandski dolar", PluralForms{"", "nowoseelandski dolar", "nowoseelandskej dolara", "nowoseelandske dolary", "", "nowoseelandskich dolarow"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omański rial", PluralForms{"", "omański rial", "omańskej riala", "omańske riale", "", "omańskich rialow"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamaski balboa", PluralForms{"", "panamaski balboa", "panamaskej balboa", "panamaske balboa", "", "panamaskich balboa"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruski sol", PluralForms{"", "peruski sol", "peruskej sola", "peruske sole", "", "peruskich solow"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papua-neuguinejska kina", PluralForms{"", "papua-neuguinejska kina", "papua-neuguinejskej kinje", "papua-neuguinejske kiny", "", "papua-neuguinejskich kinow"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "filipinski peso", PluralForms{"", "filipinski peso", "filipinskej peso", "filipinske peso", "", "filipinskich peso"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistańska rupija", PluralForms{"", "pakistańska rupija", "pakistańskej rupiji", "pakistańske rupije", "", "pakistańskich rupijow"}},
+		"PLN": {2, 0, 2, 1, "PLN", "zł", "zł", "pólski złoty", PluralForms{"", "pólski złoty", "pólskej złotej", "pólske złote", "", "pólskich złotych"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paraguayski guarani", PluralForms{"", "paraguayski guarani", "paraguayskej guaranija", "paraguayske guaranije", "", "paraguayskich guaranijow"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarski rial", PluralForms{"", "katarski rial", "katarskej riala", "katarske riale", "", "katarskich rialow"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "rumuński leu", PluralForms{"", "rumuński leu", "rumuńskej leu", "rumuńske leu", "", "rumuńskich leu"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "serbiski dinar", PluralForms{"", "serbiski dinar", "serbiskej dinara", "serbiske dinary", "", "serbiskich dinarow"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruski rubl", PluralForms{"", "ruski rubl", "ruskej rubla", "ruske ruble", "", "ruskich rublow"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ruandiski frank", PluralForms{"", "ruandiski frank", "ruandiskej franka", "ruandiske franki", "", "ruandiskich frankow"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudi-arabiski rial", PluralForms{"", "saudi-arabiski rial", "saudi-arabiskej riala", "saudi-arabiske riale", "", "saudi-arabiskich rialow"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "salomoński dolar", PluralForms{"", "salomoński dolar", "salomońskej dolara", "salomońske dolary", "", "salomońskich dolarow"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "seychelska rupija", PluralForms{"", "seychelska rupija", "seychelskej rupiji", "seychelske rupije", "", "seychelskich rupijow"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudański punt", PluralForms{"", "sudański punt", "sudańskej punta", "sudańske punty", "", "sudańskich puntow"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "šwedska krona", PluralForms{"", "šwedska krona", "šwedskej kronje", "šwedske krony", "", "šwedskich kronow"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapurski dolar", PluralForms{"", "singapurski dolar", "singapurskej dolara", "singapurske dolary", "", "singapurskich dolarow"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "St. Helena punt", PluralForms{"", "St. Helena punt", "St. Helena punta", "St. Helena punty", "", "St. Helena puntow"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierra-leoneski leone", PluralForms{"", "sierra-leoneski leone", "sierra-leoneskej leone", "sierra-leoneske leone", "", "sierra-leoneskich leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierra-leoneski leone (1964—2022)", PluralForms{"", "sierra-leoneski leone (1964—2022)", "sierra-leoneskej leone (1964—2022)", "sierra-leoneske leone (1964—2022)", "", "sierra-leoneskich leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somaliski šiling", PluralForms{"", "somaliski šiling", "somaliskej šilinga", "somaliske šilingi", "", "somaliskich šilingow"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamski dolar", PluralForms{"", "surinamski dolar", "surinamskej dolara", "surinamske dolary", "", "surinamskich dolarow"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "pódpołdnjowosudański punt", PluralForms{"", "pódpołdnjowosudański punt", "pódpołdnjowosudańskej punta", "pódpołdnjowosudańske punty", "", "pódpołdnjowosudańskich puntow"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "são-tomeska dobra (1977–2017)", PluralForms{"", "são-tomeska dobra (1977–2017)", "são-tomeskej dobrje (1977–2017)", "são-tomeske dobry (1977–2017)", "", "são-tomeskich dobrow (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "são-tomeska dobra", PluralForms{"", "são-tomeska dobra", "são-tomeskej dobrje", "são-tomeske dobry", "", "são-tomeskich dobrow"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "el-salvadorski colón", PluralForms{"", "el-salvadorski colón", "el-salvadorskej colóna", "el-salvadorske colóny", "", "el-salvadorskich colónow"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "syriski punt", PluralForms{"", "syriski punt", "syriskej punta", "syriske punty", "", "syriskich puntow"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "swasiski lilangeni", PluralForms{"", "swasiski lilangeni", "swasiskej lilangenija", "swasiske lilangenije", "", "swasiskich lilangenijow"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "thaiski baht", PluralForms{"", "thaiski baht", "thaiskej bahta", "thaiske bahty", "", "thaiskich bahtow"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadźikiski somoni", PluralForms{"", "tadźikiski somoni", "tadźikiskej somonija", "tadźikiske somonije", "", "tadźikiskich somonijow"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmeniski manat", PluralForms{"", "turkmeniski manat", "turkmeniskej manata", "turkmeniske manaty", "", "turkmeniskich manatow"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tuneziski dinar", PluralForms{"", "tuneziski dinar", "tuneziskej dinara", "tuneziske dinary", "", "tuneziskich dinarow"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tongaski paʻanga", PluralForms{"", "tongaski pa’anga", "tongaskej pa’anga", "tongaske pa’anga", "", "tongaskich pa’anga"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turkojska lira", PluralForms{"", "turkojska lira", "turkojskej lirje", "turkojske liry", "", "turkojskich lirow"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trinidad-tobagoski dolar", PluralForms{"", "trinidad-tobagoski dolar", "trinidad-tobagoskej dolara", "trinidad-tobagoske dolary", "", "trinidad-tobagoskich dolarow"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "nowy taiwański dolar", PluralForms{"", "nowy taiwański dolar", "nowej taiwańskej dolara", "nowe taiwańske dolary", "", "nowych taiwańskich dolarow"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tansaniski šiling", PluralForms{"", "tansaniski šiling", "tansaniskej šilinga", "tansaniske šilingi", "", "tansaniskich šilingow"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrainska griwna", PluralForms{"", "ukrainska griwna", "ukrainskej griwnje", "ukrainske griwny", "", "ukrainskich griwnow"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandaski šiling", PluralForms{"", "ugandaski šiling", "ugandaskej šilinga", "ugandaske šilingi", "", "ugandaskich šilingow"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "ameriski dolar", PluralForms{"", "ameriski dolar", "ameriskej dolara", "ameriske dolary", "", "ameriskich dolarow"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "uruguayski peso", PluralForms{"", "uruguayski peso", "uruguayskej peso", "uruguayske peso", "", "uruguayskich peso"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "usbekiski sum", PluralForms{"", "usbekiski sum", "usbekiskej suma", "usbekiske sumy", "", "usbekiskich sumow"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelski bolívar (2008–2018)", PluralForms{"", "venezuelski bolívar (2008–2018)", "venezuelskej bolívara (2008–2018)", "venezuelske bolívary (2008–2018)", "", "venezuelskich bolívarow (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelski bolívar", PluralForms{"", "venezuelski bolívar", "venezuelskej bolívara", "venezuelske bolívary", "", "venezuelskich bolívarow"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "vietnamski dong", PluralForms{"", "vietnamski dong", "vietnamskej donga", "vietnamske dongi", "", "vietnamskich dongow"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatski vatu", PluralForms{"", "vanuatski vatu", "vanuatskej vatu", "vanuatske vatu", "", "vanuatskich vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samoaska tala", PluralForms{"", "samoaski tala", "samoaskej tali", "samoaske tale", "", "samoaskich talow"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "CFA-frank (BEAC)", PluralForms{"", "CFA-frank (BEAC)", "CFA-franka (BEAC)", "CFA-franki (BEAC)", "", "CFA-frankow (BEAC)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "pódzajtšnokaribiski dolar", PluralForms{"", "pódzajtšnokaribiski dolar", "pódzajtšnokaribiskej dolara", "pódzajtšnokaribiske dolary", "", "pódzajtšnokaribiskich dolarow"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "CFA-frank (BCEAO)", PluralForms{"", "CFA-frank (BCEAO)", "CFA-franka (BCEAO)", "CFA-franki (BCEAO)", "", "CFA-frankow (BCEAO)"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP-frank", PluralForms{"", "CFP-frank", "CFP-franka", "CFP-franki", "", "CFP-frankow"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "njeznate pjenjeze", PluralForms{"", "njeznate pjenjeze", "njeznate pjenjeze", "njeznate pjenjeze", "", "njeznatych pjenjez"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemeński rial", PluralForms{"", "jemeński rial", "jemeńskej riala", "jemeńske riale", "", "jemeńskich rialow"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "pódpołdnjowoafriski rand", PluralForms{"", "pódpołdnjowoafriski rand", "pódpołdnjowoafriskej randa", "pódpołdnjowoafriske randy", "", "pódpołdnjowoafriskich randow"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "sambiska kwacha", PluralForms{"", "sambiska kwacha", "sambiskej kwaše", "sambiske kwachy", "", "sambiskich kwachow"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,281 +6,334 @@ var el = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"e", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0χιλ.", "other": "0\u00a0χιλ."}},
+				{4, 2, map[string]string{"one": "0\u00a0χιλ.", "other": "0\u00a0χιλ."}},
+				{5, 3, map[string]string{"one": "0\u00a0χιλ.", "other": "0\u00a0χιλ."}},
+				{6, 1, map[string]string{"one": "0\u00a0εκ.", "other": "0\u00a0εκ."}},
+				{7, 2, map[string]string{"one": "0\u00a0εκ.", "other": "0\u00a0εκ."}},
+				{8, 3, map[string]string{"one": "0\u00a0εκ.", "other": "0\u00a0εκ."}},
+				{9, 1, map[string]string{"one": "0\u00a0δισ.", "other": "0\u00a0δισ."}},
+				{10, 2, map[string]string{"one": "0\u00a0δισ.", "other": "0\u00a0δισ."}},
+				{11, 3, map[string]string{"one": "0\u00a0δισ.", "other": "0\u00a0δισ."}},
+				{12, 1, map[string]string{"one": "0\u00a0τρισ.", "other": "0\u00a0τρισ."}},
+				{13, 2, map[string]string{"one": "0\u00a0τρισ.", "other": "0\u00a0τρισ."}},
+				{14, 3, map[string]string{"one": "0\u00a0τρισ.", "other": "0\u00a0τρισ."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 χιλιάδα", "other": "0 χιλιάδες"}},
+				{4, 2, map[string]string{"one": "0 χιλιάδες", "other": "0 χιλιάδες"}},
+				{5, 3, map[string]string{"one": "0 χιλιάδες", "other": "0 χιλιάδες"}},
+				{6, 1, map[string]string{"one": "0 εκατομμύριο", "other": "0 εκατομμύρια"}},
+				{7, 2, map[string]string{"one": "0 εκατομμύρια", "other": "0 εκατομμύρια"}},
+				{8, 3, map[string]string{"one": "0 εκατομμύρια", "other": "0 εκατομμύρια"}},
+				{9, 1, map[string]string{"one": "0 δισεκατομμύριο", "other": "0 δισεκατομμύρια"}},
+				{10, 2, map[string]string{"one": "0 δισεκατομμύρια", "other": "0 δισεκατομμύρια"}},
+				{11, 3, map[string]string{"one": "0 δισεκατομμύρια", "other": "0 δισεκατομμύρια"}},
+				{12, 1, map[string]string{"one": "0 τρισεκατομμύριο", "other": "0 τρισεκατομμύρια"}},
+				{13, 2, map[string]string{"one": "0 τρισεκατομμύρια", "other": "0 τρισεκατομμύρια"}},
+				{14, 3, map[string]string{"one": "0 τρισεκατομμύρια", "other": "0 τρισεκατομμύρια"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0χιλ.\u00a0¤", "other": "0\u00a0χιλ.\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0χιλ.\u00a0¤", "other": "0\u00a0χιλ.\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0χιλ.\u00a0¤", "other": "0\u00a0χιλ.\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0εκ.\u00a0¤", "other": "0\u00a0εκ.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0εκ.\u00a0¤", "other": "0\u00a0εκ.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0εκ.\u00a0¤", "other": "0\u00a0εκ.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0δισ.\u00a0¤", "other": "0\u00a0δισ.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0δισ.\u00a0¤", "other": "0\u00a0δισ.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0δισ.\u00a0¤", "other": "0\u00a0δισ.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0τρισ.\u00a0¤", "other": "0\u00a0τρισ.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0τρισ.\u00a0¤", "other": "0\u00a0τρισ.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0τρισ.\u00a0¤", "other": "0\u00a0τρισ.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "Δρχ", "Δρχ"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Πεσέτα Ανδόρας", PluralForms{"", "πεσέτα Ανδόρας", "", "", "", "πεσέτες Ανδόρας"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Ντιράμ Ηνωμένων Αραβικών Εμιράτων", PluralForms{"", "ντιράμ Ηνωμένων Αραβικών Εμιράτων", "", "", "", "ντιράμ Ηνωμένων Αραβικών Εμιράτων"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Αφγανί Αφγανιστάν (1927–2002)", PluralForms{"", "αφγάνι Αφγανιστάν (AFA)", "", "", "", "αφγάνι Αφγανιστάν (AFA)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Αφγάνι Αφγανιστάν", PluralForms{"", "αφγάνι Αφγανιστάν", "", "", "", "αφγάνια Αφγανιστάν"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Λεκ Αλβανίας", PluralForms{"", "λεκ Αλβανίας", "", "", "", "λεκ Αλβανίας"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Ντραμ Αρμενίας", PluralForms{"", "ντραμ Αρμενίας", "", "", "", "ντραμ Αρμενίας"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Γκίλντα Ολλανδικών Αντιλλών", PluralForms{"", "γκίλντα Ολλανδικών Αντιλλών", "", "", "", "γκίλντες Ολλανδικών Αντιλλών"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Κουάνζα Ανγκόλας", PluralForms{"", "κουάνζα Ανγκόλας", "", "", "", "κουάνζα Ανγκόλας"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Κουάνζα Ανγκόλας (1977–1990)", PluralForms{"", "κουάνζα Ανγκόλας (AOK)", "", "", "", "κουάνζα Ανγκόλας (AOK)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Νέα Κουάνζα Ανγκόλας (1990–2000)", PluralForms{"", "νέο κουάνζα Ανγκόλας (1990–2000)", "", "", "", "νέα κουάνζα Ανγκόλας (1990–2000)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Ωστράλ Αργετινής", PluralForms{"", "αουστράλ Αργεντινής", "", "", "", "αουστράλ Αργεντινής"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Πέσο Αργεντινής (1983–1985)", PluralForms{"", "πέσο Αργεντινής (ARP)", "", "", "", "πέσο Αργεντινής (ARP)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Πέσο Αργεντινής", PluralForms{"", "πέσο Αργεντινής", "", "", "", "πέσο Αργεντινής"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Σελίνι Αυστρίας", PluralForms{"", "σελίνι Αυστρίας", "", "", "", "σελίνια Αυστρίας"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Δολάριο Αυστραλίας", PluralForms{"", "δολάριο Αυστραλίας", "", "", "", "δολάρια Αυστραλίας"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Φλορίνι Αρούμπας", PluralForms{"", "φλορίνι Αρούμπας", "", "", "", "φλορίνια Αρούμπας"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Μανάτ Αζερμπαϊτζάν (1993–2006)", PluralForms{"", "μανάτ Αζερμπαϊτζάν (1993–2006)", "", "", "", "μανάτ Αζερμπαϊτζάν (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Μανάτ Αζερμπαϊτζάν", PluralForms{"", "μανάτ Αζερμπαϊτζάν", "", "", "", "μανάτ Αζερμπαϊτζάν"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Δηνάριο Βοσνίας-Ερζεγοβίνης", PluralForms{"", "δηνάριο Βοσνίας-Ερζεγοβίνης", "", "", "", "δηνάρια Βοσνίας-Ερζεγοβίνης"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Μετατρέψιμο Μάρκο Βοσνίας-Ερζεγοβίνης", PluralForms{"", "μετατρέψιμο μάρκο Βοσνίας-Ερζεγοβίνης", "", "", "", "μετατρέψιμα μάρκα Βοσνίας-Ερζεγοβίνης"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Δολάριο Μπαρμπέιντος", PluralForms{"", "δολάριο Μπαρμπέιντος", "", "", "", "δολάρια Μπαρμπέιντος"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Τάκα Μπαγκλαντές", PluralForms{"", "τάκα Μπαγκλαντές", "", "", "", "τάκα Μπαγκλαντές"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Φράγκο Βελγίου (μετατρέψιμο)", PluralForms{"", "φράγκο Βελγίου (μετατρέψιμο)", "", "", "", "φράγκα Βελγίου (μετατρέψιμα)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Φράγκο Βελγίου", PluralForms{"", "φράγκο Βελγίου", "", "", "", "φράγκα Βελγίου"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Φράγκο Βελγίου (οικονομικό)", PluralForms{"", "φράγκο Βελγίου (οικονομικό)", "", "", "", "φράγκα Βελγίου (οικονομικό)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Μεταλλικό Λεβ Βουλγαρίας", PluralForms{"", "μεταλλικό λεβ Βουλγαρίας", "", "", "", "μεταλλικά λεβ Βουλγαρίας"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Λεβ Βουλγαρίας", PluralForms{"", "λεβ Βουλγαρίας", "", "", "", "λεβ Βουλγαρίας"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Δηνάριο Μπαχρέιν", PluralForms{"", "δηνάριο Μπαχρέιν", "", "", "", "δηνάρια Μπαχρέιν"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Φράγκο Μπουρούντι", PluralForms{"", "φράγκο Μπουρούντι", "", "", "", "φράγκα Μπουρούντι"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Δολάριο Βερμούδων", PluralForms{"", "δολάριο Βερμούδων", "", "", "", "δολάρια Βερμούδων"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Δολάριο Μπρουνέι", PluralForms{"", "δολάριο Μπρουνέι", "", "", "", "δολάρια Μπρουνέι"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Μπολιβιάνο Βολιβίας", PluralForms{"", "μπολιβιάνο Βολιβίας", "", "", "", "μπολιβιάνο Βολιβίας"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Πέσο Βολιβίας", PluralForms{"", "πέσο Βολιβίας", "", "", "", "πέσο Βολιβίας"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Μβδολ Βολιβίας", PluralForms{"", "μβντολ Βολιβίας", "", "", "", "μβντολ Βολιβίας"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Νέο Κρουζιέρο Βραζιλίας (1967–1986)", PluralForms{"", "νέο κρουζέιρο Βραζιλίας (BRB)", "", "", "", "νέα κρουζέιρο Βραζιλίας (BRB)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Κρουζάντο Βραζιλίας", PluralForms{"", "κρουζάντο Βραζιλίας", "", "", "", "κρουζάντο Βραζιλίας"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Κρουζιέρο Βραζιλίας (1990–1993)", PluralForms{"", "κρουζέιρο Βραζιλίας (BRE)", "", "", "", "κρουζέιρο Βραζιλίας (BRE)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Ρεάλ Βραζιλίας", PluralForms{"", "ρεάλ Βραζιλίας", "", "", "", "ρεάλ Βραζιλίας"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Νέο Κρουζάντο Βραζιλίας", PluralForms{"", "νέο κρουζάντο Βραζιλίας", "", "", "", "νέα κρουζάντο Βραζιλίας"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Κρουζιέρο Βραζιλίας", PluralForms{"", "κρουζέιρο Βραζιλίας", "", "", "", "κρουζέιρο Βραζιλίας"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Δολάριο Μπαχαμών", PluralForms{"", "δολάριο Μπαχαμών", "", "", "", "δολάρια Μπαχαμών"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Νγκούλτρουμ Μπουτάν", PluralForms{"", "νγκούλτρουμ Μπουτάν", "", "", "", "νγκούλτρουμ Μπουτάν"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Κιατ Βιρμανίας", PluralForms{"", "κιάτ Βιρμανίας", "", "", "", "κιάτ Βιρμανίας"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Πούλα Μποτσουάνας", PluralForms{"", "πούλα Μποτσουάνας", "", "", "", "πούλα Μποτσουάνας"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Νέο Ρούβλι Λευκορωσίας (1994–1999)", PluralForms{"", "νέο ρούβλι Λευκορωσίας (1994–1999)", "", "", "", "νέα ρούβλια Λευκορωσίας (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Ρούβλι Λευκορωσίας", PluralForms{"", "ρούβλι Λευκορωσίας", "", "", "", "ρούβλια Λευκορωσίας"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Ρούβλι Λευκορωσίας (2000–2016)", PluralForms{"", "ρούβλι Λευκορωσίας (2000–2016)", "", "", "", "ρούβλια Λευκορωσίας (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Δολάριο Μπελίζ", PluralForms{"", "δολάριο Μπελίζ", "", "", "", "δολάρια Μπελίζ"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Δολάριο Καναδά", PluralForms{"", "δολάριο Καναδά", "", "", "", "δολάρια Καναδά"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Φράγκο Κονγκό", PluralForms{"", "φράγκο Κονγκό", "", "", "", "φράγκα Κονγκό"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Ευρώ WIR", PluralForms{"", "ευρώ WIR", "", "", "", "ευρώ WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Φράγκο Ελβετίας", PluralForms{"", "φράγκο Ελβετίας", "", "", "", "φράγκα Ελβετίας"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Φράγκο WIR", PluralForms{"", "φράγκο WIR", "", "", "", "φράγκα WIR"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Ουνιδάδες ντε φομέντο Χιλής", PluralForms{"", "ουνιδάδες ντε φομέντο Χιλής", "", "", "", "ουνιδάδες ντε φομέντο Χιλής"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Πέσο Χιλής", PluralForms{"", "πέσο Χιλής", "", "", "", "πέσο Χιλής"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Γουάν Κίνας (υπεράκτιο)", PluralForms{"", "γουάν Κίνας (υπεράκτιο)", "", "", "", "γουάν Κίνας (υπεράκτια)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Γουάν Κίνας", PluralForms{"", "γουάν Κίνας", "", "", "", "γουάν Κίνας"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Πέσο Κολομβίας", PluralForms{"", "πέσο Κολομβίας", "", "", "", "πέσο Κολομβίας"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Κολόν Κόστα Ρίκα", PluralForms{"", "κολόν Κόστα Ρίκα", "", "", "", "κολόν Κόστα Ρίκα"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Παλαιό Δηνάριο Σερβίας", PluralForms{"", "παλιό δινάρη Σερβίας", "", "", "", "παλιά δινάρια Σερβίας"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Σκληρή Κορόνα Τσεχοσλοβακίας", PluralForms{"", "σκληρή κορόνα Τσεχοσλοβακίας", "", "", "", "σκληρές κορόνες Τσεχοσλοβακίας"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Μετατρέψιμο πέσο Κούβας", PluralForms{"", "μετατρέψιμο πέσο Κούβας", "", "", "", "μετατρέψιμα πέσο Κούβας"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Πέσο Κούβας", PluralForms{"", "πέσο Κούβας", "", "", "", "πέσο Κούβας"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Εσκούδο Πράσινου Ακρωτηρίου", PluralForms{"", "εσκούδο Πράσινου Ακρωτηρίου", "", "", "", "εσκούδο Πράσινου Ακρωτηρίου"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Λίρα Κύπρου", PluralForms{"", "λίρα Κύπρου", "", "", "", "λίρες Κύπρου"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Κορόνα Τσεχίας", PluralForms{"", "κορόνα Τσεχίας", "", "", "", "κορόνες Τσεχίας"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Οστμάρκ Ανατολικής Γερμανίας", PluralForms{"", "όστμαρκ Ανατολικής Γερμανίας", "", "", "", "όστμαρκ Ανατολικής Γερμανίας"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Μάρκο Γερμανίας", PluralForms{"", "μάρκο Γερμανίας", "", "", "", "μάρκα Γερμανίας"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Φράγκο Τζιμπουτί", PluralForms{"", "φράγκο Τζιμπουτί", "", "", "", "φράγκα Τζιμπουτί"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Κορόνα Δανίας", PluralForms{"", "κορόνα Δανίας", "", "", "", "κορόνες Δανίας"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Πέσο Δομινικανής Δημοκρατίας", PluralForms{"", "πέσο Δομινικανής Δημοκρατίας", "", "", "", "πέσο Δομινικανής Δημοκρατίας"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Δηνάριο Αλγερίας", PluralForms{"", "δηνάριο Αλγερίας", "", "", "", "δηνάρια Αλγερίας"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Σούκρε Εκουαδόρ", PluralForms{"", "σούκρε Εκουαδόρ", "", "", "", "σούκρε Εκουαδόρ"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Κορόνα Εσθονίας", PluralForms{"", "κορόνα Εσθονίας", "", "", "", "κορόνες Εσθονίας"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Λίρα Αιγύπτου", PluralForms{"", "λίρα Αιγύπτου", "", "", "", "λίρες Αιγύπτου"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Νάκφα Ερυθραίας", PluralForms{"", "νάκφα Ερυθραίας", "", "", "", "νάκφα Ερυθραίας"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "πεσέτα Ισπανίας (λογαριασμός Α)", PluralForms{"", "πεσέτα Ισπανίας (λογαριασμός Α)", "", "", "", "πεσέτες Ισπανίας (λογαριασμός Α)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "πεσέτα Ισπανίας (μετατρέψιμος λογαριασμός)", PluralForms{"", "πεσέτα Ισπανίας (μετατρέψιμος λογαριασμός)", "", "", "", "πεσέτες Ισπανίας (μετατρέψιμες)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Πεσέτα Ισπανίας", PluralForms{"", "πεσέτα Ισπανίας", "", "", "", "πεσέτες Ισπανίας"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Μπιρ Αιθιοπίας", PluralForms{"", "μπιρ Αιθιοπίας", "", "", "", "μπιρ Αιθιοπίας"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Ευρώ", PluralForms{"", "ευρώ", "", "", "", "ευρώ"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Μάρκο Φινλανδίας", PluralForms{"", "μάρκο Φινλανδίας", "", "", "", "μάρκα Φινλανδίας"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Δολάριο Φίτζι", PluralForms{"", "δολάριο Φίτζι", "", "", "", "δολάρια Φίτζι"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Λίρα Νήσων Φόκλαντ", PluralForms{"", "λίρα Νήσων Φόκλαντ", "", "", "", "λίρες Νήσων Φόκλαντ"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Φράγκο Γαλλίας", PluralForms{"", "φράγκο Γαλλίας", "", "", "", "φράγκα Γαλλίας"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Λίρα Στερλίνα Βρετανίας", PluralForms{"", "λίρα στερλίνα Βρετανίας", "", "", "", "λίρες στερλίνες Βρετανίας"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Κούπον Λάρι Γεωργίας", PluralForms{"", "κούπον λάρι Γεωργίας", "", "", "", "κούπον λάρι Γεωργίας"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Λάρι Γεωργίας", PluralForms{"", "λάρι Γεωργίας", "", "", "", "λάρι Γεωργίας"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Σέντι Γκάνας (1979–2007)", PluralForms{"", "σέντι Γκάνας (GHC)", "", "", "", "σέντι Γκάνας (GHC)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Σέντι Γκάνας", PluralForms{"", "σέντι Γκάνας", "", "", "", "σέντι Γκάνας"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Λίρα Γιβραλτάρ", PluralForms{"", "λίρα Γιβραλτάρ", "", "", "", "λίρες Γιβραλτάρ"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Νταλάσι Γκάμπιας", PluralForms{"", "νταλάσι Γκάμπιας", "", "", "", "νταλάσι Γκάμπιας"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Φράγκο Γουινέας", PluralForms{"", "φράγκο Γουινέας", "", "", "", "φράγκα Γουινέας"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Συλί Γουινέας", PluralForms{"", "συλί Γουινέας", "", "", "", "συλί Γουινέας"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Εκγουέλε Ισημερινής Γουινέας", PluralForms{"", "εκουέλε Ισημερινής Γουινέας", "", "", "", "εκουέλε Ισημερινής Γουινέας"}},
+		"GRD": {2, 0, 2, 1, "GRD", "Δρχ", "Δρχ", "Δραχμή Ελλάδας", PluralForms{"", "δραχμή Ελλάδας", "", "", "", "δραχμές Ελλάδας"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Κουετσάλ Γουατεμάλας", PluralForms{"", "κουετσάλ Γουατεμάλας", "", "", "", "κουετσάλ Γουατεμάλας"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Γκινέα Εσκούδο Πορτογαλίας", PluralForms{"", "γκινέα εσκούδο Πορτογαλίας", "", "", "", "γκινέα εσκούδο Πορτογαλίας"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Πέσο Γουινέας-Μπισάου", PluralForms{"", "πέσο Γουινέα-Μπισάου", "", "", "", "πέσο Γουινέα-Μπισάου"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Δολάριο Γουιάνας", PluralForms{"", "δολάριο Γουιάνας", "", "", "", "δολάρια Γουιάνας"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Δολάριο Χονγκ Κονγκ", PluralForms{"", "δολάριο Χονγκ Κονγκ", "", "", "", "δολάρια Χονγκ Κονγκ"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Λεμπίρα Ονδούρας", PluralForms{"", "λεμπίρα Ονδούρας", "", "", "", "λεμπίρα Ονδούρας"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Δηνάριο Κροατίας", PluralForms{"", "δηνάριο Κροατίας", "", "", "", "δηνάρια Κροατίας"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Κούνα Κροατίας", PluralForms{"", "κούνα Κροατίας", "", "", "", "κούνα Κροατίας"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Γκουρντ Αϊτής", PluralForms{"", "γκουρντ Αϊτής", "", "", "", "γκουρντ Αϊτής"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Φιορίνι Ουγγαρίας", PluralForms{"", "φιορίνι Ουγγαρίας", "", "", "", "φιορίνια Ουγγαρίας"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Ρουπία Ινδονησίας", PluralForms{"", "ρουπία Ινδονησίας", "", "", "", "ρουπία Ινδονησίας"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Λίρα Ιρλανδίας", PluralForms{"", "λίρα Ιρλανδίας", "", "", "", "λίρες Ιρλανδίας"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Λίρα Ισραήλ", PluralForms{"", "λίρα Ισραήλ", "", "", "", "λίρες Ισραήλ"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "παλιό σεκέλ Ισραήλ", PluralForms{"", "παλιό σεκέλ Ισραήλ", "", "", "", "παλιά σεκέλ Ισραήλ"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Νέο Σέκελ Ισραήλ", PluralForms{"", "νέο σέκελ Ισραήλ", "", "", "", "νέα σέκελ Ισραήλ"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Ρουπία Ινδίας", PluralForms{"", "ρουπία Ινδίας", "", "", "", "ρουπίες Ινδίας"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Δηνάριο Ιράκ", PluralForms{"", "δηνάριο Ιράκ", "", "", "", "δηνάρια Ιράκ"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Ριάλ Ιράν", PluralForms{"", "ριάλ Ιράν", "", "", "", "ριάλ Ιράν"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Παλιά κορόνα Ισλανδίας", PluralForms{"", "Παλιά κορόνα Ισλανδίας", "", "", "", "παλιές κορόνες Ισλανδίας"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Κορόνα Ισλανδίας", PluralForms{"", "κορόνα Ισλανδίας", "", "", "", "κορόνες Ισλανδίας"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Λιρέτα Ιταλίας", PluralForms{"", "λιρέτα Ιταλίας", "", "", "", "λιρέτες Ιταλίας"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Δολάριο Τζαμάικας", PluralForms{"", "δολάριο Τζαμάικας", "", "", "", "δολάρια Τζαμάικας"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Δηνάριο Ιορδανίας", PluralForms{"", "δηνάριο Ιορδανίας", "", "", "", "δηνάρια Ιορδανίας"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Γιεν Ιαπωνίας", PluralForms{"", "γιεν Ιαπωνίας", "", "", "", "γιεν Ιαπωνίας"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Σελίνι Κένυας", PluralForms{"", "σελίνι Κένυας", "", "", "", "σελίνια Κένυας"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Σομ Κιργιζίας", PluralForms{"", "σομ Κιργιζίας", "", "", "", "σομ Κιργιζίας"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Ρίελ Καμπότζης", PluralForms{"", "ρίελ Καμπότζης", "", "", "", "ρίελ Καμπότζης"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Φράγκο Κομορών", PluralForms{"", "φράγκο Κομορών", "", "", "", "φράγκα Κομορών"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Γουόν Βόρειας Κορέας", PluralForms{"", "γουόν Βόρειας Κορέας", "", "", "", "γουόν Βόρειας Κορέας"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Γουόν Νότιας Κορέας", PluralForms{"", "γουόν Νότιας Κορέας", "", "", "", "γουόν Νότιας Κορέας"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Δηνάριο Κουβέιτ", PluralForms{"", "δηνάριο Κουβέιτ", "", "", "", "δηνάρια Κουβέιτ"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Δολάριο Νήσων Κέιμαν", PluralForms{"", "δολάριο Νήσων Κέιμαν", "", "", "", "δολάρια Νήσων Κέιμαν"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Τένγκε Καζακστάν", PluralForms{"", "τένγκε Καζακστάν", "", "", "", "τένγκε Καζακστάν"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Κιπ Λάος", PluralForms{"", "κιπ Λάος", "", "", "", "κιπ Λάος"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Λίρα Λιβάνου", PluralForms{"", "λίρα Λιβάνου", "", "", "", "λίρες Λιβάνου"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Ρουπία Σρι Λάνκα", PluralForms{"", "ρουπία Σρι Λάνκα", "", "", "", "ρουπίες Σρι Λάνκα"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Δολάριο Λιβερίας", PluralForms{"", "δολάριο Λιβερίας", "", "", "", "δολάρια Λιβερίας"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Λότι Λεσότο", PluralForms{"", "λότι Λεσότο", "", "", "", "λότι Λεσότο"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Λίτα Λιθουανίας", PluralForms{"", "λίτα Λιθουανίας", "", "", "", "λίτα Λιθουανίας"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Ταλόνας Λιθουανίας", PluralForms{"", "ταλόνας Λιθουανίας", "", "", "", "ταλόνας Λιθουανίας"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Μετατρέψιμο Φράγκο Λουξεμβούργου", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Φράγκο Λουξεμβούργου", PluralForms{"", "φράγκο Λουξεμβούργου", "", "", "", "φράγκα Λουξεμβούργου"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Οικονομικό Φράγκο Λουξεμβούργου", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Λατς Λετονίας", PluralForms{"", "λατς Λετονίας", "", "", "", "λατς Λετονίας"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Ρούβλι Λετονίας", PluralForms{"", "ρούβλι Λετονίας", "", "", "", "ρούβλια Λετονίας"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Δηνάριο Λιβύης", PluralForms{"", "δηνάριο Λιβύης", "", "", "", "δηνάρια Λιβύης"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Ντιράμ Μαρόκου", PluralForms{"", "ντιράμ Μαρόκου", "", "", "", "ντιράμ Μαρόκου"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Φράγκο Μαρόκου", PluralForms{"", "φράγκο Μαρόκου", "", "", "", "φράγκα Μαρόκου"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Λέου Μολδαβίας", PluralForms{"", "λέου Μολδαβίας", "", "", "", "λέου Μολδαβίας"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Αριάρι Μαδαγασκάρης", PluralForms{"", "αριάρι Μαδαγασκάρης", "", "", "", "αριάρι Μαδαγασκάρης"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Φράγκο Μαδαγασκάρης", PluralForms{"", "φράγκο Μαδαγασκάρης", "", "", "", "φράγκα Μαδαγασκάρης"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Δηνάριο ΠΓΔΜ", PluralForms{"", "δηνάριο ΠΓΔΜ", "", "", "", "δηνάρια ΠΓΔΜ"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Φράγκο Μαλί", PluralForms{"", "φράγκο Μαλί", "", "", "", "φράγκα Μαλί"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Κιάτ Μιανμάρ", PluralForms{"", "κιάτ Μιανμάρ", "", "", "", "κιάτ Μιανμάρ"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Τουγκρίκ Μογγολίας", PluralForms{"", "τουγκρίκ Μογγολίας", "", "", "", "τουγκρίκ Μογγολίας"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Πατάκα Μακάο", PluralForms{"", "πατάκα Μακάο", "", "", "", "πατάκα Μακάο"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ουγκίγια Μαυριτανίας (1973–2017)", PluralForms{"", "ουγκίγια Μαυριτανίας (1973–2017)", "", "", "", "ουγκίγια Μαυριτανίας (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ουγκίγια Μαυριτανίας", PluralForms{"", "ουγκίγια Μαυριτανίας", "", "", "", "ουγκίγια Μαυριτανίας"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Λιρέτα Μάλτας", PluralForms{"", "λιρέτα Μάλτας", "", "", "", "λιρέτες Μάλτας"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Λίρα Μάλτας", PluralForms{"", "λίρα Μάλτας", "", "", "", "λίρες Μάλτας"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Ρουπία Μαυρικίου", PluralForms{"", "ρουπία Μαυρικίου", "", "", "", "ρουπίες Μαυρικίου"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Ρουφίγια Μαλδίβων", PluralForms{"", "ρουφίγια Μαλδίβων", "", "", "", "ρουφίγιες Μαλδίβων"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Κουάτσα Μαλάουι", PluralForms{"", "κουάτσα Μαλάουι", "", "", "", "κουάτσα Μαλάουι"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Πέσο Μεξικού", PluralForms{"", "πέσο Μεξικού", "", "", "", "πέσο Μεξικού"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Ασημένιο Πέσο Μεξικού (1861–1992)", PluralForms{"", "ασημένιο πέσο Μεξικού (MXP)", "", "", "", "ασημένια πέσο Μεξικού (MXP)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ρινγκίτ Μαλαισίας", PluralForms{"", "ρινγκίτ Μαλαισίας", "", "", "", "ρινγκίτ Μαλαισίας"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Εσκούδο Μοζαμβίκης", PluralForms{"", "εσκούδο Μοζαμβίκης", "", "", "", "εσκούδο Μοζαμβίκης"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Παλαιό Μετικάλ Μοζαμβίκης", PluralForms{"", "παλιό μετικάλ Μοζαμβίκης", "", "", "", "παλιά μετικάλ Μοζαμβίκης"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Μετικάλ Μοζαμβίκης", PluralForms{"", "μετικάλ Μοζαμβίκης", "", "", "", "μετικάλ Μοζαμβίκης"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Δολάριο Ναμίμπιας", PluralForms{"", "δολάριο Ναμίμπιας", "", "", "", "δολάρια Ναμίμπιας"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Νάιρα Νιγηρίας", PluralForms{"", "νάιρα Νιγηρίας", "", "", "", "νάιρα Νιγηρίας"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Κόρδοβα Νικαράγουας", PluralForms{"", "κόρδοβα Νικαράγουας", "", "", "", "κόρδοβα Νικαράγουας"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Χρυσή Κόρδοβα Νικαράγουας", PluralForms{"", "χρυσή κόρδοβα Νικαράγουας", "", "", "", "χρυσές κόρδοβα Νικαράγουας"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Γκίλντα Ολλανδίας", PluralForms{"", "γκίλντα Ολλανδίας", "", "", "", "γκίλντα Ολλανδίας"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Κορόνα Νορβηγίας", PluralForms{"", "κορόνα Νορβηγίας", "", "", "", "κορόνες Νορβηγίας"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Ρουπία Νεπάλ", PluralForms{"", "ρουπία Νεπάλ", "", "", "", "ρουπίες Νεπάλ"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Δολάριο Νέας Ζηλανδίας", PluralForms{"", "δολάριο Νέας Ζηλανδίας", "", "", "", "δολάρια Νέας Ζηλανδίας"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Ριάλ Ομάν", PluralForms{"", "ριάλ Ομάν", "", "", "", "ριάλ Ομάν"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Μπαλμπόα Παναμά", PluralForms{"", "μπαλμπόα Παναμά", "", "", "", "μπαλμπόα Παναμά"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Ίντι Περού", PluralForms{"", "ίντι Περού", "", "", "", "ίντι Περού"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Σολ Περού", PluralForms{"", "σολ Περού", "", "", "", "σολ Περού"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Σολ Περού (1863–1965)", PluralForms{"", "σολ Περού (1863–1965)", "", "", "", "σολ Περού (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Κίνα Παπούας Νέας Γουινέας", PluralForms{"", "κίνα Παπούας Νέας Γουινέας", "", "", "", "κίνα Παπούας Νέας Γουινέας"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Πέσο Φιλιππίνων", PluralForms{"", "πέσο Φιλιππίνων", "", "", "", "πέσο Φιλιππίνων"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Ρουπία Πακιστάν", PluralForms{"", "ρουπία Πακιστάν", "", "", "", "ρουπίες Πακιστάν"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Ζλότι Πολωνίας", PluralForms{"", "ζλότι Πολωνίας", "", "", "", "ζλότι Πολωνίας"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Ζλότυ Πολωνίας (1950–1995)", PluralForms{"", "ζλότυ Πολωνίας (PLZ)", "", "", "", "ζλότυ Πολωνίας (PLZ)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Εσκούδο Πορτογαλίας", PluralForms{"", "εσκούδο Πορτογαλίας", "", "", "", "εσκούδο Πορτογαλίας"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Γκουαρανί Παραγουάης", PluralForms{"", "γκουαρανί Παραγουάης", "", "", "", "γκουαρανί Παραγουάης"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Ριάλ Κατάρ", PluralForms{"", "ριάλ Κατάρ", "", "", "", "ριάλ Κατάρ"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Δολάριο Ροδεσίας", PluralForms{"", "δολάριο Ροδεσίας", "", "", "", "δολάρια Ροδεσίας"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Λέι Ρουμανίας", PluralForms{"", "παλιό λέι Ρουμανίας", "", "", "", "παλιά λέι Ρουμανίας"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Λέου Ρουμανίας", PluralForms{"", "λέου Ρουμανίας", "", "", "", "λέου Ρουμανίας"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Δηνάριο Σερβίας", PluralForms{"", "δηνάριο Σερβίας", "", "", "", "δηνάρια Σερβίας"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Ρούβλι Ρωσίας", PluralForms{"", "ρούβλι Ρωσίας", "", "", "", "ρούβλια Ρωσίας"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Ρούβλι Ρωσίας (1991–1998)", PluralForms{"", "ρούβλι Ρωσίας (RUR)", "", "", "", "ρούβλια Ρωσίας (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Φράγκο Ρουάντας", PluralForms{"", "φράγκο Ρουάντας", "", "", "", "φράγκα Ρουάντας"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Ριάλ Σαουδικής Αραβίας", PluralForms{"", "ριάλ Σαουδικής Αραβίας", "", "", "", "ριάλ Σαουδικής Αραβίας"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Δολάριο Νήσων Σολομώντος", PluralForms{"", "δολάριο Νήσων Σολομώντος", "", "", "", "δολάρια Νήσων Σολομώντος"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Ρουπία Σεϋχελλών", PluralForms{"", "ρουπία Σεϋχελλών", "", "", "", "ρουπίες Σεϋχελλών"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Δηνάριο Σουδάν", PluralForms{"", "δηνάριο Σουδάν", "", "", "", "δηνάρια Σουδάν"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Λίρα Σουδάν", PluralForms{"", "λίρα Σουδάν", "", "", "", "λίρες Σουδάν"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Παλαιά Λίρα Σουδάν", PluralForms{"", "παλιά λίρα Σουδάν", "", "", "", "παλαιές λίρες Σουδάν"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Κορόνα Σουηδίας", PluralForms{"", "κορόνα Σουηδίας", "", "", "", "κορόνες Σουηδίας"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Δολάριο Σιγκαπούρης", PluralForms{"", "δολάριο Σιγκαπούρης", "", "", "", "δολάρια Σιγκαπούρης"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Λίρα Αγίας Ελένης", PluralForms{"", "λίρα Αγίας Ελένης", "", "", "", "λίρες Αγίας Ελένης"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Τόλαρ Σλοβενίας", PluralForms{"", "τόλαρ Σλοβενίας", "", "", "", "τόλαρ Σλοβ"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Κορόνα Σλοβενίας", PluralForms{"", "κορόνα Σλοβενίας", "", "", "", "κορόνες Σλοβενίας"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Λεόνε Σιέρα Λεόνε", PluralForms{"", "λεόνε Σιέρα Λεόνε", "", "", "", "λεόνε Σιέρα Λεόνε"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Λεόνε Σιέρα Λεόνε (1964—2022)", PluralForms{"", "λεόνε Σιέρα Λεόνε (1964—2022)", "", "", "", "λεόνε Σιέρα Λεόνε (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Σελίνι Σομαλίας", PluralForms{"", "σελίνι Σομαλίας", "", "", "", "σελίνια Σομαλίας"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Δολάριο Σουρινάμ", PluralForms{"", "δολάριο Σουρινάμ", "", "", "", "δολάρια Σουρινάμ"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Γκίλντα Σουρινάμ", PluralForms{"", "γκίλντα Σουρινάμ", "", "", "", "γκίλντα Σουρινάμ"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Λίρα Νότιου Σουδάν", PluralForms{"", "λίρα Νότιου Σουδάν", "", "", "", "λίρες Νότιου Σουδάν"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Ντόμπρα Σάο Τομέ και Πρίνσιπε (1977–2017)", PluralForms{"", "ντόμπρα Σάο Τομέ και Πρίνσιπε (1977–2017)", "", "", "", "ντόμπρα Σάο Τομέ και Πρίνσιπε (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Ντόμπρα Σάο Τομέ και Πρίνσιπε", PluralForms{"", "ντόμπρα Σάο Τομέ και Πρίνσιπε", "", "", "", "ντόμπρα Σάο Τομέ και Πρίνσιπε"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Σοβιετικό Ρούβλι", PluralForms{"", "σοβιετικό ρούβλι", "", "", "", "σοβιετικά ρούβλια"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Κολόν Ελ Σαλβαδόρ", PluralForms{"", "κολόν Ελ Σαλβαδόρ", "", "", "", "κολόν Ελ Σαλβαδόρ"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Λίρα Συρίας", PluralForms{"", "λίρα Συρίας", "", "", "", "λίρες Συρίας"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Λιλανγκένι Σουαζιλάνδης", PluralForms{"", "λιλανγκένι Σουαζιλάνδης", "", "", "", "λιλανγκένι Σουαζιλάνδης"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Μπατ Ταϊλάνδης", PluralForms{"", "μπατ Ταϊλάνδης", "", "", "", "μπατ Ταϊλάνδης"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Ρούβλι Τατζικιστάν", PluralForms{"", "ρούβλι Τατζικιστάν", "", "", "", "ρούβλια Τατζικιστάν"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Σομόνι Τατζικιστάν", PluralForms{"", "σομόνι Τατζικιστάν", "", "", "", "σομόνι Τατζικιστάν"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Μανάτ Τουρκμενιστάν", PluralForms{"", "μανάτ Τουρκμενιστάν", "", "", "", "μανάτ Τουρκμενιστάν"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Μάνατ Τουρκμενιστάν", PluralForms{"", "μάνατ Τουρκμενιστάν", "", "", "", "μάνατ Τουρκμενιστάν"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Δηνάριο Τυνησίας", PluralForms{"", "δηνάριο Τυνησίας", "", "", "", "δηνάρια Τυνησίας"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Παάγκα Τόνγκα", PluralForms{"", "παάγκα Τόνγκα", "", "", "", "παάγκα Τόνγκα"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Εσκούδο Τιμόρ", PluralForms{"", "εσκούδο Τιμόρ", "", "", "", "εσκούδο Τιμόρ"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Παλιά Λίρα Τουρκίας", PluralForms{"", "παλιά λίρα Τουρκίας", "", "", "", "παλιές λίρες Τουρκίας"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Λίρα Τουρκίας", PluralForms{"", "λίρα Τουρκίας", "", "", "", "λίρες Τουρκίας"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Δολάριο Τρινιντάντ και Τομπάγκο", PluralForms{"", "δολάριο Τρινιντάντ και Τομπάγκο", "", "", "", "δολάρια Τρινιντάντ και Τομπάγκο"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Νέο δολάριο Ταϊβάν", PluralForms{"", "νέο δολάριο Ταϊβάν", "", "", "", "νέα δολάρια Ταϊβάν"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Σελίνι Τανζανίας", PluralForms{"", "σελίνι Τανζανίας", "", "", "", "σελίνια Τανζανίας"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Γρίβνα Ουκρανίας", PluralForms{"", "γρίβνα Ουκρανίας", "", "", "", "γρίβνα Ουκρανίας"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Καρμποβανέτς Ουκρανίας", PluralForms{"", "καρμποβανέτς Ουκρανίας", "", "", "", "καρμποβανέτς Ουκρανίας"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Σελίνι Ουγκάντας (1966–1987)", PluralForms{"", "σελίνι Ουγκάντας (UGS)", "", "", "", "σελίνια Ουγκάντας (UGS)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Σελίνι Ουγκάντας", PluralForms{"", "σελίνι Ουγκάντας", "", "", "", "σελίνια Ουγκάντας"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Δολάριο ΗΠΑ", PluralForms{"", "δολάριο ΗΠΑ", "", "", "", "δολάρια ΗΠΑ"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Δολάριο ΗΠΑ (επόμενη ημέρα)", PluralForms{"", "δολάριο Η.Π.Α. (επόμενη ημέρα)", "", "", "", "δολάρια Η.Π.Α. (επόμενη ημέρα)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Δολάριο ΗΠΑ (ίδια ημέρα)", PluralForms{"", "δολάριο Η.Π.Α. (ίδια ημέρα)", "", "", "", "δολάρια Η.Π.Α. (ίδια ημέρα)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Πέσο Ουρουγουάης (1975–1993)", PluralForms{"", "πέσο Ουρουγουάης (UYP)", "", "", "", "πέσο Ουρουγουάης (UYP)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Πέσο Ουρουγουάης", PluralForms{"", "πέσο Ουρουγουάης", "", "", "", "πέσο Ουρουγουάης"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Σομ Ουζμπεκιστάν", PluralForms{"", "σομ Ουζμπεκιστάν", "", "", "", "σομ Ουζμπεκιστάν"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Μπολιβάρ Βενεζουέλας (1871–2008)", PluralForms{"", "μπολιβάρ Βενεζουέλας (1871–2008)", "", "", "", "μπολιβάρ Βενεζουέλας (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Μπολιβάρ Βενεζουέλας (2008–2018)", PluralForms{"", "μπολιβάρ Βενεζουέλας (2008–2018)", "", "", "", "μπολιβάρ Βενεζουέλας (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Μπολιβάρ Βενεζουέλας", PluralForms{"", "μπολιβάρ Βενεζουέλας", "", "", "", "μπολιβάρ Βενεζουέλας"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Ντονγκ Βιετνάμ", PluralForms{"", "ντονγκ Βιετνάμ", "", "", "", "ντονγκ Βιετνάμ"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Βατού Βανουάτου", PluralForms{"", "βατού Βανουάτου", "", "", "", "βατού Βανουάτου"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Τάλα Σαμόα", PluralForms{"", "τάλα Σαμόα", "", "", "", "τάλα Σαμόα"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Φράγκο CFA Κεντρικής Αφρικής", PluralForms{"", "φράγκο CFA Κεντρικής Αφρικής", "", "", "", "φράγκα CFA Κεντρικής Αφρικής"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Ευρωπαϊκή Σύνθετη Μονάδα", PluralForms{"", "ευρωπαϊκή σύνθετη μονάδα", "", "", "", "ευρωπαϊκές σύνθετες μονάδες"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Ευρωπαϊκή Νομισματική Μονάδα", PluralForms{"", "ευρωπαϊκή νομισματική μονάδα", "", "", "", "ευρωπαϊκές νομισματικές μονάδες"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Ευρωπαϊκή μονάδα λογαριασμού (XBC)", PluralForms{"", "ευρωπαϊκή μονάδα λογαριασμού (XBC)", "", "", "", "ευρωπαϊκές μονάδες λογαριασμού (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Ευρωπαϊκή μονάδα λογαριασμού (XBD)", PluralForms{"", "ευρωπαϊκή μονάδα λογαριασμού (XBD)", "", "", "", "ευρωπαϊκές μονάδες λογαριασμού (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Δολάριο Ανατολικής Καραϊβικής", PluralForms{"", "δολάριο Ανατολικής Καραϊβικής", "", "", "", "δολάρια Ανατολικής Καραϊβικής"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Ειδικά Δικαιώματα Ανάληψης", PluralForms{"", "ειδικό δικαίωμα ανάληψης", "", "", "", "ειδικά δικαιώματα ανάληψης"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Ευρωπαϊκή Συναλλαγματική Μονάδα", PluralForms{"", "ευρωπαϊκή συναλλαγματική μονάδα", "", "", "", "ευρωπαϊκές συναλλαγματικές μονάδες"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Χρυσό Φράγκο Γαλλίας", PluralForms{"", "χρυσό φράγκο Γαλλίας", "", "", "", "χρυσά φράγκα Γαλλίας"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "UIC-Φράγκο Γαλλίας", PluralForms{"", "UIC-φράγκο Γαλλίας", "", "", "", "UIC-φράγκα Γαλλίας"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Φράγκο CFA Δυτικής Αφρικής", PluralForms{"", "φράγκο CFA Δυτικής Αφρικής", "", "", "", "φράγκα CFA Δυτικής Αφρικής"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Φράγκο CFP", PluralForms{"", "φράγκο CFP", "", "", "", "φράγκα CFP"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Άγνωστο νόμισμα", PluralForms{"", "(άγνωστο νόμισμα)", "", "", "", "(άγνωστο νόμισμα)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Δηνάριο Υεμένης", PluralForms{"", "δηνάριο Υεμένης", "", "", "", "δηνάρια Υεμένης"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Ριάλ Υεμένης", PluralForms{"", "ριάλ Υεμένης", "", "", "", "ριάλ Υεμένης"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Μεταλλικό Δηνάριο Γιουγκοσλαβίας", PluralForms{"", "μεταλλικό δηνάριο Γιουγκοσλαβίας", "", "", "", "μεταλλικά δηνάρια Γιουγκοσλαβίας"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Νέο Δηνάριο Γιουγκοσλαβίας", PluralForms{"", "νέο δηνάριο Γιουγκοσλαβίας", "", "", "", "νέο δηνάριο Γιουγκοσλαβίας"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Μετατρέψιμο Δηνάριο Γιουγκοσλαβίας", PluralForms{"", "μετατρέψιμο δινάριο Γιουγκοσλαβίας", "", "", "", "μετατρέψιμο δηνάριο Γιουγκοσλαβίας"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Ραντ Νότιας Αφρικής (οικονομικό)", PluralForms{"", "ραντ Νότιας Αφρικής (οικονομικό)", "", "", "", "ραντ Νότιας Αφρικής (οικονομικό)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Ραντ Νότιας Αφρικής", PluralForms{"", "ραντ Νότιας Αφρικής", "", "", "", "ραντ Νότιας Αφρικής"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Κουάνζα Ζαΐρ (1968–2012)", PluralForms{"", "κουάτσα Ζάμπιας (1968–2012)", "", "", "", "κουάτσα Ζάμπιας (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Κουάτσα Ζάμπιας", PluralForms{"", "κουάτσα Ζάμπιας", "", "", "", "κουάτσα Ζάμπιας"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Νέο Ζαΐρ Ζαΐρ", PluralForms{"", "νέο ζαΐρ Ζαΐρ", "", "", "", "νέα ζαΐρ Ζαΐρ"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Ζαΐρ Ζαΐρ", PluralForms{"", "ζαΐρ Ζαΐρ", "", "", "", "ζαΐρ Ζαΐρ"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Δολάριο Ζιμπάμπουε", PluralForms{"", "δολάριο Ζιμπάμπουε", "", "", "", "δολάρια Ζιμπάμπουε"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Δολάριο Ζιμπάμπουε (2009)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,315 +6,368 @@ var enAG = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0K", "other": "0K"}},
+				{4, 2, map[string]string{"one": "0K", "other": "0K"}},
+				{5, 3, map[string]string{"one": "0K", "other": "0K"}},
+				{6, 1, map[string]string{"one": "0M", "other": "0M"}},
+				{7, 2, map[string]string{"one": "0M", "other": "0M"}},
+				{8, 3, map[string]string{"one": "0M", "other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"one": "0T", "other": "0T"}},
+				{13, 2, map[string]string{"one": "0T", "other": "0T"}},
+				{14, 3, map[string]string{"one": "0T", "other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 thousand", "other": "0 thousand"}},
+				{4, 2, map[string]string{"one": "0 thousand", "other": "0 thousand"}},
+				{5, 3, map[string]string{"one": "0 thousand", "other": "0 thousand"}},
+				{6, 1, map[string]string{"one": "0 million", "other": "0 million"}},
+				{7, 2, map[string]string{"one": "0 million", "other": "0 million"}},
+				{8, 3, map[string]string{"one": "0 million", "other": "0 million"}},
+				{9, 1, map[string]string{"one": "0 billion", "other": "0 billion"}},
+				{10, 2, map[string]string{"one": "0 billion", "other": "0 billion"}},
+				{11, 3, map[string]string{"one": "0 billion", "other": "0 billion"}},
+				{12, 1, map[string]string{"one": "0 trillion", "other": "0 trillion"}},
+				{13, 2, map[string]string{"one": "0 trillion", "other": "0 trillion"}},
+				{14, 3, map[string]string{"one": "0 trillion", "other": "0 trillion"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{4, 2, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{5, 3, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{6, 1, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{7, 2, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{8, 3, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{9, 1, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{10, 2, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{12, 1, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{13, 2, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{14, 3, map[string]string{"one": "¤0T", "other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "{0} {1}", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UYW": {4, "UYW", "UYW", "UYW"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VED": {2, "VED", "VED", "VED"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorran Peseta", PluralForms{"", "Andorran peseta", "", "", "", "Andorran pesetas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "United Arab Emirates Dirham", PluralForms{"", "UAE dirham", "", "", "", "UAE dirhams"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afghan Afghani (1927–2002)", PluralForms{"", "Afghan afghani (1927–2002)", "", "", "", "Afghan afghanis (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghan Afghani", PluralForms{"", "Afghan afghani", "", "", "", "Afghan afghanis"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albanian Lek (1946–1965)", PluralForms{"", "Albanian lek (1946–1965)", "", "", "", "Albanian lekë (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albanian Lek", PluralForms{"", "Albanian lek", "", "", "", "Albanian lekë"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armenian Dram", PluralForms{"", "Armenian dram", "", "", "", "Armenian drams"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Netherlands Antillean Guilder", PluralForms{"", "Netherlands Antillean guilder", "", "", "", "Netherlands Antillean guilders"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angolan Kwanza", PluralForms{"", "Angolan kwanza", "", "", "", "Angolan kwanzas"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolan Kwanza (1977–1991)", PluralForms{"", "Angolan kwanza (1977–1991)", "", "", "", "Angolan kwanzas (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolan New Kwanza (1990–2000)", PluralForms{"", "Angolan new kwanza (1990–2000)", "", "", "", "Angolan new kwanzas (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolan Readjusted Kwanza (1995–1999)", PluralForms{"", "Angolan readjusted kwanza (1995–1999)", "", "", "", "Angolan readjusted kwanzas (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentine Austral", PluralForms{"", "Argentine austral", "", "", "", "Argentine australs"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Argentine Peso Ley (1970–1983)", PluralForms{"", "Argentine peso ley (1970–1983)", "", "", "", "Argentine pesos ley (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Argentine Peso (1881–1970)", PluralForms{"", "Argentine peso (1881–1970)", "", "", "", "Argentine pesos (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentine Peso (1983–1985)", PluralForms{"", "Argentine peso (1983–1985)", "", "", "", "Argentine pesos (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentine Peso", PluralForms{"", "Argentine peso", "", "", "", "Argentine pesos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austrian Schilling", PluralForms{"", "Austrian schilling", "", "", "", "Austrian schillings"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Australian Dollar", PluralForms{"", "Australian dollar", "", "", "", "Australian dollars"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruban Florin", PluralForms{"", "Aruban florin", "", "", "", "Aruban florin"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azerbaijani Manat (1993–2006)", PluralForms{"", "Azerbaijani manat (1993–2006)", "", "", "", "Azerbaijani manats (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbaijani Manat", PluralForms{"", "Azerbaijani manat", "", "", "", "Azerbaijani manats"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosnia-Herzegovina Dinar (1992–1994)", PluralForms{"", "Bosnia-Herzegovina dinar (1992–1994)", "", "", "", "Bosnia-Herzegovina dinars (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bosnia-Herzegovina Convertible Mark", PluralForms{"", "Bosnia-Herzegovina convertible mark", "", "", "", "Bosnia-Herzegovina convertible marks"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Bosnia-Herzegovina New Dinar (1994–1997)", PluralForms{"", "Bosnia-Herzegovina new dinar (1994–1997)", "", "", "", "Bosnia-Herzegovina new dinars (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbadian Dollar", PluralForms{"", "Barbadian dollar", "", "", "", "Barbadian dollars"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladeshi Taka", PluralForms{"", "Bangladeshi taka", "", "", "", "Bangladeshi takas"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgian Franc (convertible)", PluralForms{"", "Belgian franc (convertible)", "", "", "", "Belgian francs (convertible)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgian Franc", PluralForms{"", "Belgian franc", "", "", "", "Belgian francs"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgian Franc (financial)", PluralForms{"", "Belgian franc (financial)", "", "", "", "Belgian francs (financial)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bulgarian Hard Lev", PluralForms{"", "Bulgarian hard lev", "", "", "", "Bulgarian hard leva"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Bulgarian Socialist Lev", PluralForms{"", "Bulgarian socialist lev", "", "", "", "Bulgarian socialist leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgarian Lev", PluralForms{"", "Bulgarian lev", "", "", "", "Bulgarian leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Bulgarian Lev (1879–1952)", PluralForms{"", "Bulgarian lev (1879–1952)", "", "", "", "Bulgarian leva (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahraini Dinar", PluralForms{"", "Bahraini dinar", "", "", "", "Bahraini dinars"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundian Franc", PluralForms{"", "Burundian franc", "", "", "", "Burundian francs"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermudian Dollar", PluralForms{"", "Bermudian dollar", "", "", "", "Bermudian dollars"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunei Dollar", PluralForms{"", "Brunei dollar", "", "", "", "Brunei dollars"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolivian Boliviano", PluralForms{"", "Bolivian boliviano", "", "", "", "Bolivian bolivianos"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Bolivian Boliviano (1863–1963)", PluralForms{"", "Bolivian boliviano (1863–1963)", "", "", "", "Bolivian bolivianos (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bolivian Peso", PluralForms{"", "Bolivian peso", "", "", "", "Bolivian pesos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Bolivian Mvdol", PluralForms{"", "Bolivian mvdol", "", "", "", "Bolivian mvdols"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brazilian New Cruzeiro (1967–1986)", PluralForms{"", "Brazilian new cruzeiro (1967–1986)", "", "", "", "Brazilian new cruzeiros (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brazilian Cruzado (1986–1989)", PluralForms{"", "Brazilian cruzado (1986–1989)", "", "", "", "Brazilian cruzados (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brazilian Cruzeiro (1990–1993)", PluralForms{"", "Brazilian cruzeiro (1990–1993)", "", "", "", "Brazilian cruzeiros (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brazilian Real", PluralForms{"", "Brazilian real", "", "", "", "Brazilian reals"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brazilian New Cruzado (1989–1990)", PluralForms{"", "Brazilian new cruzado (1989–1990)", "", "", "", "Brazilian new cruzados (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brazilian Cruzeiro (1993–1994)", PluralForms{"", "Brazilian cruzeiro (1993–1994)", "", "", "", "Brazilian cruzeiros (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Brazilian Cruzeiro (1942–1967)", PluralForms{"", "Brazilian cruzeiro (1942–1967)", "", "", "", "Brazilian cruzeiros (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamian Dollar", PluralForms{"", "Bahamian dollar", "", "", "", "Bahamian dollars"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Bhutanese Ngultrum", PluralForms{"", "Bhutanese ngultrum", "", "", "", "Bhutanese ngultrums"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Burmese Kyat", PluralForms{"", "Burmese kyat", "", "", "", "Burmese kyats"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswanan Pula", PluralForms{"", "Botswanan pula", "", "", "", "Botswanan pulas"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Belarusian New Rouble (1994–1999)", PluralForms{"", "Belarusian new rouble (1994–1999)", "", "", "", "Belarusian new roubles (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Belarusian Rouble", PluralForms{"", "Belarusian rouble", "", "", "", "Belarusian roubles"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Belarusian Rouble (2000–2016)", PluralForms{"", "Belarusian rouble (2000–2016)", "", "", "", "Belarusian roubles (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belize Dollar", PluralForms{"", "Belize dollar", "", "", "", "Belize dollars"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Canadian Dollar", PluralForms{"", "Canadian dollar", "", "", "", "Canadian dollars"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Congolese Franc", PluralForms{"", "Congolese franc", "", "", "", "Congolese francs"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR Euro", PluralForms{"", "WIR euro", "", "", "", "WIR euros"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Swiss Franc", PluralForms{"", "Swiss franc", "", "", "", "Swiss francs"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR Franc", PluralForms{"", "WIR franc", "", "", "", "WIR francs"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Chilean Escudo", PluralForms{"", "Chilean escudo", "", "", "", "Chilean escudos"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Chilean Unit of Account (UF)", PluralForms{"", "Chilean unit of account (UF)", "", "", "", "Chilean units of account (UF)"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Chilean Peso", PluralForms{"", "Chilean peso", "", "", "", "Chilean pesos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Chinese Yuan (offshore)", PluralForms{"", "Chinese yuan (offshore)", "", "", "", "Chinese yuan (offshore)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Chinese People’s Bank Dollar", PluralForms{"", "Chinese People’s Bank dollar", "", "", "", "Chinese People’s Bank dollars"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Chinese Yuan", PluralForms{"", "Chinese yuan", "", "", "", "Chinese yuan"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Colombian Peso", PluralForms{"", "Colombian peso", "", "", "", "Colombian pesos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Colombian Real Value Unit", PluralForms{"", "Colombian real value unit", "", "", "", "Colombian real value units"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Costa Rican Colón", PluralForms{"", "Costa Rican colón", "", "", "", "Costa Rican colóns"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbian Dinar (2002–2006)", PluralForms{"", "Serbian dinar (2002–2006)", "", "", "", "Serbian dinars (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Czechoslovak Hard Koruna", PluralForms{"", "Czechoslovak hard koruna", "", "", "", "Czechoslovak hard korunas"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Cuban Convertible Peso", PluralForms{"", "Cuban convertible peso", "", "", "", "Cuban convertible pesos"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Cuban Peso", PluralForms{"", "Cuban peso", "", "", "", "Cuban pesos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Cape Verdean Escudo", PluralForms{"", "Cape Verdean escudo", "", "", "", "Cape Verdean escudos"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Cypriot Pound", PluralForms{"", "Cypriot pound", "", "", "", "Cypriot pounds"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Czech Koruna", PluralForms{"", "Czech koruna", "", "", "", "Czech korunas"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "East German Mark", PluralForms{"", "East German mark", "", "", "", "East German marks"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "German Mark", PluralForms{"", "German mark", "", "", "", "German marks"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Djiboutian Franc", PluralForms{"", "Djiboutian franc", "", "", "", "Djiboutian francs"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Danish Krone", PluralForms{"", "Danish krone", "", "", "", "Danish kroner"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominican Peso", PluralForms{"", "Dominican peso", "", "", "", "Dominican pesos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Algerian Dinar", PluralForms{"", "Algerian dinar", "", "", "", "Algerian dinars"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ecuadorian Sucre", PluralForms{"", "Ecuadorian sucre", "", "", "", "Ecuadorian sucres"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Ecuadorian Unit of Constant Value", PluralForms{"", "Ecuadorian unit of constant value", "", "", "", "Ecuadorian units of constant value"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estonian Kroon", PluralForms{"", "Estonian kroon", "", "", "", "Estonian kroons"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Egyptian Pound", PluralForms{"", "Egyptian pound", "", "", "", "Egyptian pounds"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrean Nakfa", PluralForms{"", "Eritrean nakfa", "", "", "", "Eritrean nakfas"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Spanish Peseta (A account)", PluralForms{"", "Spanish peseta (A account)", "", "", "", "Spanish pesetas (A account)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Spanish Peseta (convertible account)", PluralForms{"", "Spanish peseta (convertible account)", "", "", "", "Spanish pesetas (convertible account)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Spanish Peseta", PluralForms{"", "Spanish peseta", "", "", "", "Spanish pesetas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Ethiopian Birr", PluralForms{"", "Ethiopian birr", "", "", "", "Ethiopian birr"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Finnish Markka", PluralForms{"", "Finnish markka", "", "", "", "Finnish markkas"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fijian Dollar", PluralForms{"", "Fijian dollar", "", "", "", "Fijian dollars"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Falkland Islands Pound", PluralForms{"", "Falkland Islands pound", "", "", "", "Falkland Islands pounds"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "French Franc", PluralForms{"", "French franc", "", "", "", "French francs"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "British Pound", PluralForms{"", "British pound", "", "", "", "British pounds"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Georgian Kupon Larit", PluralForms{"", "Georgian kupon larit", "", "", "", "Georgian kupon larits"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Georgian Lari", PluralForms{"", "Georgian lari", "", "", "", "Georgian laris"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghanaian Cedi (1979–2007)", PluralForms{"", "Ghanaian cedi (1979–2007)", "", "", "", "Ghanaian cedis (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ghanaian Cedi", PluralForms{"", "Ghanaian cedi", "", "", "", "Ghanaian cedis"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltar Pound", PluralForms{"", "Gibraltar pound", "", "", "", "Gibraltar pounds"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambian Dalasi", PluralForms{"", "Gambian dalasi", "", "", "", "Gambian dalasis"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Guinean Franc", PluralForms{"", "Guinean franc", "", "", "", "Guinean francs"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guinean Syli", PluralForms{"", "Guinean syli", "", "", "", "Guinean sylis"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Equatorial Guinean Ekwele", PluralForms{"", "Equatorial Guinean ekwele", "", "", "", "Equatorial Guinean ekwele"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Greek Drachma", PluralForms{"", "Greek drachma", "", "", "", "Greek drachmas"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Guatemalan Quetzal", PluralForms{"", "Guatemalan quetzal", "", "", "", "Guatemalan quetzals"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portuguese Guinea Escudo", PluralForms{"", "Portuguese Guinea escudo", "", "", "", "Portuguese Guinea escudos"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissau Peso", PluralForms{"", "Guinea-Bissau peso", "", "", "", "Guinea-Bissau pesos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Guyanaese Dollar", PluralForms{"", "Guyanaese dollar", "", "", "", "Guyanaese dollars"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Hong Kong Dollar", PluralForms{"", "Hong Kong dollar", "", "", "", "Hong Kong dollars"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Honduran Lempira", PluralForms{"", "Honduran lempira", "", "", "", "Honduran lempiras"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Croatian Dinar", PluralForms{"", "Croatian dinar", "", "", "", "Croatian dinars"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Croatian Kuna", PluralForms{"", "Croatian kuna", "", "", "", "Croatian kunas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haitian Gourde", PluralForms{"", "Haitian gourde", "", "", "", "Haitian gourdes"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Hungarian Forint", PluralForms{"", "Hungarian forint", "", "", "", "Hungarian forints"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indonesian Rupiah", PluralForms{"", "Indonesian rupiah", "", "", "", "Indonesian rupiahs"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Irish Pound", PluralForms{"", "Irish pound", "", "", "", "Irish pounds"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Israeli Pound", PluralForms{"", "Israeli pound", "", "", "", "Israeli pounds"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Israeli Shekel (1980–1985)", PluralForms{"", "Israeli shekel (1980–1985)", "", "", "", "Israeli shekels (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Israeli New Shekel", PluralForms{"", "Israeli new shekel", "", "", "", "Israeli new shekels"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Indian Rupee", PluralForms{"", "Indian rupee", "", "", "", "Indian rupees"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Iraqi Dinar", PluralForms{"", "Iraqi dinar", "", "", "", "Iraqi dinars"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Iranian Rial", PluralForms{"", "Iranian rial", "", "", "", "Iranian rials"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Icelandic Króna (1918–1981)", PluralForms{"", "Icelandic króna (1918–1981)", "", "", "", "Icelandic krónur (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Icelandic Króna", PluralForms{"", "Icelandic króna", "", "", "", "Icelandic krónur"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italian Lira", PluralForms{"", "Italian lira", "", "", "", "Italian liras"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaican Dollar", PluralForms{"", "Jamaican dollar", "", "", "", "Jamaican dollars"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordanian Dinar", PluralForms{"", "Jordanian dinar", "", "", "", "Jordanian dinars"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Japanese Yen", PluralForms{"", "Japanese yen", "", "", "", "Japanese yen"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenyan Shilling", PluralForms{"", "Kenyan shilling", "", "", "", "Kenyan shillings"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kyrgystani Som", PluralForms{"", "Kyrgystani som", "", "", "", "Kyrgystani soms"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Cambodian Riel", PluralForms{"", "Cambodian riel", "", "", "", "Cambodian riels"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Comorian Franc", PluralForms{"", "Comorian franc", "", "", "", "Comorian francs"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "North Korean Won", PluralForms{"", "North Korean won", "", "", "", "North Korean won"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "South Korean Hwan (1953–1962)", PluralForms{"", "South Korean hwan (1953–1962)", "", "", "", "South Korean hwan (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "South Korean Won (1945–1953)", PluralForms{"", "South Korean won (1945–1953)", "", "", "", "South Korean won (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "South Korean Won", PluralForms{"", "South Korean won", "", "", "", "South Korean won"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuwaiti Dinar", PluralForms{"", "Kuwaiti dinar", "", "", "", "Kuwaiti dinars"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Cayman Islands Dollar", PluralForms{"", "Cayman Islands dollar", "", "", "", "Cayman Islands dollars"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kazakhstani Tenge", PluralForms{"", "Kazakhstani tenge", "", "", "", "Kazakhstani tenges"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laotian Kip", PluralForms{"", "Laotian kip", "", "", "", "Laotian kips"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Lebanese Pound", PluralForms{"", "Lebanese pound", "", "", "", "Lebanese pounds"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Sri Lankan Rupee", PluralForms{"", "Sri Lankan rupee", "", "", "", "Sri Lankan rupees"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberian Dollar", PluralForms{"", "Liberian dollar", "", "", "", "Liberian dollars"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesotho Loti", PluralForms{"", "Lesotho loti", "", "", "", "Lesotho maloti"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Lithuanian Litas", PluralForms{"", "Lithuanian litas", "", "", "", "Lithuanian litai"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Lithuanian Talonas", PluralForms{"", "Lithuanian talonas", "", "", "", "Lithuanian talonases"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luxembourgian Convertible Franc", PluralForms{"", "Luxembourgian convertible franc", "", "", "", "Luxembourgian convertible francs"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luxembourgian Franc", PluralForms{"", "Luxembourgian franc", "", "", "", "Luxembourgian francs"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luxembourg Financial Franc", PluralForms{"", "Luxembourg financial franc", "", "", "", "Luxembourg financial francs"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Latvian Lats", PluralForms{"", "Latvian lats", "", "", "", "Latvian lati"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latvian Rouble", PluralForms{"", "Latvian rouble", "", "", "", "Latvian roubles"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libyan Dinar", PluralForms{"", "Libyan dinar", "", "", "", "Libyan dinars"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Moroccan Dirham", PluralForms{"", "Moroccan dirham", "", "", "", "Moroccan dirhams"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Moroccan Franc", PluralForms{"", "Moroccan franc", "", "", "", "Moroccan francs"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monegasque Franc", PluralForms{"", "Monegasque franc", "", "", "", "Monegasque francs"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Moldovan Cupon", PluralForms{"", "Moldovan cupon", "", "", "", "Moldovan cupon"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldovan Leu", PluralForms{"", "Moldovan leu", "", "", "", "Moldovan lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Malagasy Ariary", PluralForms{"", "Malagasy ariary", "", "", "", "Malagasy ariary"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Malagasy Franc", PluralForms{"", "Malagasy franc", "", "", "", "Malagasy francs"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Macedonian Denar", PluralForms{"", "Macedonian denar", "", "", "", "Macedonian denari"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Macedonian Denar (1992–1993)", PluralForms{"", "Macedonian denar (1992–1993)", "", "", "", "Macedonian denari (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malian Franc", PluralForms{"", "Malian franc", "", "", "", "Malian francs"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Myanmar Kyat", PluralForms{"", "Myanmar kyat", "", "", "", "Myanmar kyats"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongolian Tugrik", PluralForms{"", "Mongolian tugrik", "", "", "", "Mongolian tugriks"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Macanese Pataca", PluralForms{"", "Macanese pataca", "", "", "", "Macanese patacas"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritanian Ouguiya (1973–2017)", PluralForms{"", "Mauritanian ouguiya (1973–2017)", "", "", "", "Mauritanian ouguiyas (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauritanian Ouguiya", PluralForms{"", "Mauritanian ouguiya", "", "", "", "Mauritanian ouguiyas"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Maltese Lira", PluralForms{"", "Maltese lira", "", "", "", "Maltese lira"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltese Pound", PluralForms{"", "Maltese pound", "", "", "", "Maltese pounds"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mauritian Rupee", PluralForms{"", "Mauritian rupee", "", "", "", "Mauritian rupees"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Maldivian Rupee (1947–1981)", PluralForms{"", "Maldivian rupee (1947–1981)", "", "", "", "Maldivian rupees (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldivian Rufiyaa", PluralForms{"", "Maldivian rufiyaa", "", "", "", "Maldivian rufiyaa"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawian Kwacha", PluralForms{"", "Malawian kwacha", "", "", "", "Malawian kwachas"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Mexican Peso", PluralForms{"", "Mexican peso", "", "", "", "Mexican pesos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Mexican Silver Peso (1861–1992)", PluralForms{"", "Mexican silver peso (1861–1992)", "", "", "", "Mexican silver pesos (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Mexican Investment Unit", PluralForms{"", "Mexican investment unit", "", "", "", "Mexican investment units"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaysian Ringgit", PluralForms{"", "Malaysian ringgit", "", "", "", "Malaysian ringgits"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambican Escudo", PluralForms{"", "Mozambican escudo", "", "", "", "Mozambican escudos"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambican Metical (1980–2006)", PluralForms{"", "Mozambican metical (1980–2006)", "", "", "", "Mozambican meticals (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambican Metical", PluralForms{"", "Mozambican metical", "", "", "", "Mozambican meticals"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibian Dollar", PluralForms{"", "Namibian dollar", "", "", "", "Namibian dollars"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigerian Naira", PluralForms{"", "Nigerian naira", "", "", "", "Nigerian nairas"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nicaraguan Córdoba (1988–1991)", PluralForms{"", "Nicaraguan córdoba (1988–1991)", "", "", "", "Nicaraguan córdobas (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nicaraguan Córdoba", PluralForms{"", "Nicaraguan córdoba", "", "", "", "Nicaraguan córdobas"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Dutch Guilder", PluralForms{"", "Dutch guilder", "", "", "", "Dutch guilders"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norwegian Krone", PluralForms{"", "Norwegian krone", "", "", "", "Norwegian kroner"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepalese Rupee", PluralForms{"", "Nepalese rupee", "", "", "", "Nepalese rupees"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "New Zealand Dollar", PluralForms{"", "New Zealand dollar", "", "", "", "New Zealand dollars"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omani Rial", PluralForms{"", "Omani rial", "", "", "", "Omani rials"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panamanian Balboa", PluralForms{"", "Panamanian balboa", "", "", "", "Panamanian balboas"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peruvian Inti", PluralForms{"", "Peruvian inti", "", "", "", "Peruvian intis"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peruvian Sol", PluralForms{"", "Peruvian sol", "", "", "", "Peruvian soles"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peruvian Sol (1863–1965)", PluralForms{"", "Peruvian sol (1863–1965)", "", "", "", "Peruvian soles (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua New Guinean Kina", PluralForms{"", "Papua New Guinean kina", "", "", "", "Papua New Guinean kina"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Philippine Peso", PluralForms{"", "Philippine peso", "", "", "", "Philippine pesos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistani Rupee", PluralForms{"", "Pakistani rupee", "", "", "", "Pakistani rupees"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Polish Zloty", PluralForms{"", "Polish zloty", "", "", "", "Polish zlotys"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Polish Zloty (1950–1995)", PluralForms{"", "Polish zloty (PLZ)", "", "", "", "Polish zlotys (PLZ)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portuguese Escudo", PluralForms{"", "Portuguese escudo", "", "", "", "Portuguese escudos"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paraguayan Guarani", PluralForms{"", "Paraguayan guarani", "", "", "", "Paraguayan guaranis"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Qatari Riyal", PluralForms{"", "Qatari riyal", "", "", "", "Qatari riyals"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rhodesian Dollar", PluralForms{"", "Rhodesian dollar", "", "", "", "Rhodesian dollars"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Romanian Leu (1952–2006)", PluralForms{"", "Romanian leu (1952–2006)", "", "", "", "Romanian Lei (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Romanian Leu", PluralForms{"", "Romanian leu", "", "", "", "Romanian lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbian Dinar", PluralForms{"", "Serbian dinar", "", "", "", "Serbian dinars"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Russian Rouble", PluralForms{"", "Russian rouble", "", "", "", "Russian roubles"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Russian Rouble (1991–1998)", PluralForms{"", "Russian rouble (1991–1998)", "", "", "", "Russian roubles (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Rwandan Franc", PluralForms{"", "Rwandan franc", "", "", "", "Rwandan francs"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudi Riyal", PluralForms{"", "Saudi riyal", "", "", "", "Saudi riyals"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Solomon Islands Dollar", PluralForms{"", "Solomon Islands dollar", "", "", "", "Solomon Islands dollars"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seychellois Rupee", PluralForms{"", "Seychellois rupee", "", "", "", "Seychellois rupees"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudanese Dinar (1992–2007)", PluralForms{"", "Sudanese dinar (1992–2007)", "", "", "", "Sudanese dinars (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudanese Pound", PluralForms{"", "Sudanese pound", "", "", "", "Sudanese pounds"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudanese Pound (1957–1998)", PluralForms{"", "Sudanese pound (1957–1998)", "", "", "", "Sudanese pounds (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Swedish Krona", PluralForms{"", "Swedish krona", "", "", "", "Swedish kronor"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapore Dollar", PluralForms{"", "Singapore dollar", "", "", "", "Singapore dollars"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "St Helena Pound", PluralForms{"", "St Helena pound", "", "", "", "St Helena pounds"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovenian Tolar", PluralForms{"", "Slovenian tolar", "", "", "", "Slovenian tolars"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovak Koruna", PluralForms{"", "Slovak koruna", "", "", "", "Slovak korunas"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sierra Leonean Leone", PluralForms{"", "Sierra Leonean leone", "", "", "", "Sierra Leonean leones"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sierra Leonean Leone (1964—2022)", PluralForms{"", "Sierra Leonean leone (1964—2022)", "", "", "", "Sierra Leonean leones (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somali Shilling", PluralForms{"", "Somali shilling", "", "", "", "Somali shillings"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surinamese Dollar", PluralForms{"", "Surinamese dollar", "", "", "", "Surinamese dollars"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surinamese Guilder", PluralForms{"", "Surinamese guilder", "", "", "", "Surinamese guilders"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "South Sudanese Pound", PluralForms{"", "South Sudanese pound", "", "", "", "South Sudanese pounds"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "São Tomé & Príncipe Dobra (1977–2017)", PluralForms{"", "São Tomé & Príncipe dobra (1977–2017)", "", "", "", "São Tomé & Príncipe dobras (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "São Tomé & Príncipe Dobra", PluralForms{"", "São Tomé & Príncipe dobra", "", "", "", "São Tomé & Príncipe dobras"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Soviet Rouble", PluralForms{"", "Soviet rouble", "", "", "", "Soviet roubles"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Salvadoran Colón", PluralForms{"", "Salvadoran colón", "", "", "", "Salvadoran colones"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Syrian Pound", PluralForms{"", "Syrian pound", "", "", "", "Syrian pounds"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Swazi Lilangeni", PluralForms{"", "Swazi lilangeni", "", "", "", "Swazi emalangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Thai Baht", PluralForms{"", "Thai baht", "", "", "", "Thai baht"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tajikistani Rouble", PluralForms{"", "Tajikistani rouble", "", "", "", "Tajikistani roubles"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tajikistani Somoni", PluralForms{"", "Tajikistani somoni", "", "", "", "Tajikistani somonis"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmenistani Manat (1993–2009)", PluralForms{"", "Turkmenistani manat (1993–2009)", "", "", "", "Turkmenistani manat (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmenistani Manat", PluralForms{"", "Turkmenistani manat", "", "", "", "Turkmenistani manat"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunisian Dinar", PluralForms{"", "Tunisian dinar", "", "", "", "Tunisian dinars"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tongan Paʻanga", PluralForms{"", "Tongan paʻanga", "", "", "", "Tongan paʻanga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timorese Escudo", PluralForms{"", "Timorese escudo", "", "", "", "Timorese escudos"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turkish Lira (1922–2005)", PluralForms{"", "Turkish lira (1922–2005)", "", "", "", "Turkish Lira (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Turkish Lira", PluralForms{"", "Turkish lira", "", "", "", "Turkish Lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidad & Tobago Dollar", PluralForms{"", "Trinidad & Tobago dollar", "", "", "", "Trinidad & Tobago dollars"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "New Taiwan Dollar", PluralForms{"", "New Taiwan dollar", "", "", "", "New Taiwan dollars"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzanian Shilling", PluralForms{"", "Tanzanian shilling", "", "", "", "Tanzanian shillings"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrainian Hryvnia", PluralForms{"", "Ukrainian hryvnia", "", "", "", "Ukrainian hryvnias"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrainian Karbovanets", PluralForms{"", "Ukrainian karbovanets", "", "", "", "Ukrainian karbovantsiv"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Ugandan Shilling (1966–1987)", PluralForms{"", "Ugandan shilling (1966–1987)", "", "", "", "Ugandan shillings (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ugandan Shilling", PluralForms{"", "Ugandan shilling", "", "", "", "Ugandan shillings"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "US Dollar", PluralForms{"", "US dollar", "", "", "", "US dollars"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "US Dollar (Next day)", PluralForms{"", "US dollar (next day)", "", "", "", "US dollars (next day)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "US Dollar (Same day)", PluralForms{"", "US dollar (same day)", "", "", "", "US dollars (same day)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Uruguayan Peso (Indexed Units)", PluralForms{"", "Uruguayan peso (indexed units)", "", "", "", "Uruguayan pesos (indexed units)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Uruguayan Peso (1975–1993)", PluralForms{"", "Uruguayan peso (1975–1993)", "", "", "", "Uruguayan pesos (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Uruguayan Peso", PluralForms{"", "Uruguayan peso", "", "", "", "Uruguayan pesos"}},
+		"UYW": {4, 0, 4, 1, "UYW", "UYW", "UYW", "Uruguayan Nominal Wage Index Unit", PluralForms{"", "Uruguayan nominal wage index unit", "", "", "", "Uruguayan nominal wage index units"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Uzbekistani Som", PluralForms{"", "Uzbekistani som", "", "", "", "Uzbekistani som"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venezuelan Bolívar (1871–2008)", PluralForms{"", "Venezuelan bolívar (1871–2008)", "", "", "", "Venezuelan bolívars (1871–2008)"}},
+		"VED": {2, 0, 2, 1, "VED", "VED", "VED", "Bolívar Soberano", PluralForms{"", "Bolívar Soberano", "", "", "", "Bolívar Soberanos"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venezuelan Bolívar (2008–2018)", PluralForms{"", "Venezuelan bolívar (2008–2018)", "", "", "", "Venezuelan bolívars (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venezuelan Bolívar", PluralForms{"", "Venezuelan bolívar", "", "", "", "Venezuelan bolívars"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Vietnamese Dong", PluralForms{"", "Vietnamese dong", "", "", "", "Vietnamese dong"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vietnamese Dong (1978–1985)", PluralForms{"", "Vietnamese dong (1978–1985)", "", "", "", "Vietnamese dong (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu Vatu", PluralForms{"", "Vanuatu vatu", "", "", "", "Vanuatu vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoan Tala", PluralForms{"", "Samoan tala", "", "", "", "Samoan tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Central African CFA Franc", PluralForms{"", "Central African CFA franc", "", "", "", "Central African CFA francs"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Silver", PluralForms{"", "troy ounce of silver", "", "", "", "troy ounces of silver"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Gold", PluralForms{"", "troy ounce of gold", "", "", "", "troy ounces of gold"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "European Composite Unit", PluralForms{"", "European composite unit", "", "", "", "European composite units"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "European Monetary Unit", PluralForms{"", "European monetary unit", "", "", "", "European monetary units"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "European Unit of Account (XBC)", PluralForms{"", "European unit of account (XBC)", "", "", "", "European units of account (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "European Unit of Account (XBD)", PluralForms{"", "European unit of account (XBD)", "", "", "", "European units of account (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "$", "$", "East Caribbean Dollar", PluralForms{"", "East Caribbean dollar", "", "", "", "East Caribbean dollars"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "Caribbean guilder", PluralForms{"", "Caribbean guilder", "", "", "", "Caribbean guilders"}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Special Drawing Rights", PluralForms{"", "special drawing rights", "", "", "", "special drawing rights"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "European Currency Unit", PluralForms{"", "European currency unit", "", "", "", "European currency units"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "French Gold Franc", PluralForms{"", "French gold franc", "", "", "", "French gold francs"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "French UIC-Franc", PluralForms{"", "French UIC-franc", "", "", "", "French UIC-francs"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "West African CFA Franc", PluralForms{"", "West African CFA franc", "", "", "", "West African CFA francs"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Palladium", PluralForms{"", "troy ounce of palladium", "", "", "", "troy ounces of palladium"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP Franc", PluralForms{"", "CFP franc", "", "", "", "CFP francs"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platinum", PluralForms{"", "troy ounce of platinum", "", "", "", "troy ounces of platinum"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET Funds", PluralForms{"", "RINET Funds unit", "", "", "", "RINET Funds units"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "Sucre", PluralForms{"", "Sucre", "", "", "", "Sucres"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Testing Currency Code", PluralForms{"", "Testing Currency unit", "", "", "", "Testing Currency units"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "ADB Unit of Account", PluralForms{"", "ADB unit of account", "", "", "", "ADB units of account"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Unknown Currency", PluralForms{"", "(unknown unit of currency)", "", "", "", "(unknown currency)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Yemeni Dinar", PluralForms{"", "Yemeni dinar", "", "", "", "Yemeni dinars"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Yemeni Rial", PluralForms{"", "Yemeni rial", "", "", "", "Yemeni rials"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Yugoslavian Hard Dinar (1966–1990)", PluralForms{"", "Yugoslavian hard dinar (1966–1990)", "", "", "", "Yugoslavian hard dinars (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Yugoslavian New Dinar (1994–2002)", PluralForms{"", "Yugoslavian new dinar (1994–2002)", "", "", "", "Yugoslavian new dinars (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Yugoslavian Convertible Dinar (1990–1992)", PluralForms{"", "Yugoslavian convertible dinar (1990–1992)", "", "", "", "Yugoslavian convertible dinars (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Yugoslavian Reformed Dinar (1992–1993)", PluralForms{"", "Yugoslavian reformed dinar (1992–1993)", "", "", "", "Yugoslavian reformed dinars (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "South African Rand (financial)", PluralForms{"", "South African rand (financial)", "", "", "", "South African rands (financial)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "South African Rand", PluralForms{"", "South African rand", "", "", "", "South African rand"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambian Kwacha (1968–2012)", PluralForms{"", "Zambian kwacha (1968–2012)", "", "", "", "Zambian kwachas (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambian Kwacha", PluralForms{"", "Zambian kwacha", "", "", "", "Zambian kwachas"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zairean New Zaire (1993–1998)", PluralForms{"", "Zairean new zaire (1993–1998)", "", "", "", "Zairean new zaires (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zairean Zaire (1971–1993)", PluralForms{"", "Zairean zaire (1971–1993)", "", "", "", "Zairean zaires (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwean Dollar (1980–2008)", PluralForms{"", "Zimbabwean dollar (1980–2008)", "", "", "", "Zimbabwean dollars (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "Zimbabwean Gold", PluralForms{"", "Zimbabwean gold", "", "", "", "Zimbabwean gold"}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabwean Dollar (2009–2024)", PluralForms{"", "Zimbabwean dollar (2009–2024)", "", "", "", "Zimbabwean dollars (2009–2024)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabwean Dollar (2008)", PluralForms{"", "Zimbabwean dollar (2008)", "", "", "", "Zimbabwean dollars (2008)"}},
 	},
 }
@@ -6,291 +6,344 @@ var esBO = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0K", "other": "0\u00a0K"}},
+				{4, 2, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{5, 3, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{6, 1, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{7, 2, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{8, 3, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{9, 4, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{10, 2, map[string]string{"one": "0\u00a0mil\u00a0M", "other": "0\u00a0mil\u00a0M"}},
+				{11, 3, map[string]string{"one": "0\u00a0mil\u00a0M", "other": "0\u00a0mil\u00a0M"}},
+				{12, 1, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{13, 2, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{14, 3, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 mil", "other": "0 mil"}},
+				{4, 2, map[string]string{"one": "0 mil", "other": "0 mil"}},
+				{5, 3, map[string]string{"one": "0 mil", "other": "0 mil"}},
+				{6, 1, map[string]string{"one": "0 millón", "other": "0 millones"}},
+				{7, 2, map[string]string{"one": "0 millones", "other": "0 millones"}},
+				{8, 3, map[string]string{"one": "0 millones", "other": "0 millones"}},
+				{9, 1, map[string]string{"one": "0 mil millones", "other": "0 mil millones"}},
+				{10, 2, map[string]string{"one": "0 mil millones", "other": "0 mil millones"}},
+				{11, 3, map[string]string{"one": "0 mil millones", "other": "0 mil millones"}},
+				{12, 1, map[string]string{"one": "0 billón", "other": "0 billones"}},
+				{13, 2, map[string]string{"one": "0 billones", "other": "0 billones"}},
+				{14, 3, map[string]string{"one": "0 billones", "other": "0 billones"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0\u00a0K", "other": "¤0\u00a0K"}},
+				{4, 2, map[string]string{"one": "¤0\u00a0K", "other": "¤0\u00a0K"}},
+				{5, 3, map[string]string{"one": "¤0\u00a0K", "other": "¤0\u00a0K"}},
+				{6, 1, map[string]string{"one": "¤0\u00a0M", "other": "¤0\u00a0M"}},
+				{7, 2, map[string]string{"one": "¤0\u00a0M", "other": "¤0\u00a0M"}},
+				{8, 3, map[string]string{"one": "¤0\u00a0M", "other": "¤0\u00a0M"}},
+				{9, 4, map[string]string{"one": "¤0\u00a0M", "other": "¤0\u00a0M"}},
+				{10, 2, map[string]string{"one": "¤0\u00a0MRD", "other": "¤0\u00a0MRD"}},
+				{11, 3, map[string]string{"one": "¤0\u00a0MRD", "other": "¤0\u00a0MRD"}},
+				{12, 1, map[string]string{"one": "¤0\u00a0B", "other": "¤0\u00a0B"}},
+				{13, 2, map[string]string{"one": "¤0\u00a0B", "other": "¤0\u00a0B"}},
+				{14, 3, map[string]string{"one": "¤0\u00a0B", "other": "¤0\u00a0B"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "Más de {0}", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "Bs", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "₧", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "EUR", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "FK£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "SD£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "S£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UYW": {4, "UYW", "UYW", "UYW"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "BsF"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "XAF", "XAF"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "XOF", "XOF"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "peseta andorrana", PluralForms{"", "peseta andorrana", "", "", "", "pesetas andorranas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dírham de los Emiratos Árabes Unidos", PluralForms{"", "dírham de los Emiratos Árabes Unidos", "", "", "", "dírhams de los Emiratos Árabes Unidos"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afgani (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afgano", PluralForms{"", "afgani afgano", "", "", "", "afganis afganos"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albanés", PluralForms{"", "lek albanés", "", "", "", "leks albaneses"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armenio", PluralForms{"", "dram armenio", "", "", "", "drams armenios"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "florín de las Antillas Neerlandesas", PluralForms{"", "florín de las Antillas Neerlandesas", "", "", "", "florines de las Antillas Neerlandesas"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kuanza angoleño", PluralForms{"", "kuanza angoleño", "", "", "", "kuanzas angoleños"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angoleño (1977–1990)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "nuevo kwanza angoleño (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza reajustado angoleño (1995–1999)", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentino", PluralForms{"", "austral argentino", "", "", "", "australes argentinos"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentino (1983–1985)", PluralForms{"", "peso argentino (ARP)", "", "", "", "pesos argentinos (ARP)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentino", PluralForms{"", "peso argentino", "", "", "", "pesos argentinos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "chelín austriaco", PluralForms{"", "chelín austriaco", "", "", "", "chelines austriacos"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "dólar australiano", PluralForms{"", "dólar australiano", "", "", "", "dólares australianos"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florín arubeño", PluralForms{"", "florín arubeño", "", "", "", "florines arubeños"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azerí (1993–2006)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azerbaiyano", PluralForms{"", "manat azerbaiyano", "", "", "", "manats azerbaiyanos"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar bosnio", PluralForms{"", "dinar bosnio", "", "", "", "dinares bosnios"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marco convertible de Bosnia y Herzegovina", PluralForms{"", "marco convertible de Bosnia y Herzegovina", "", "", "", "marcos convertibles de Bosnia y Herzegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dólar barbadense", PluralForms{"", "dólar barbadense", "", "", "", "dólares barbadenses"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bangladesí", PluralForms{"", "taka bangladesí", "", "", "", "takas bangladesíes"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franco belga (convertible)", PluralForms{"", "franco belga (convertible)", "", "", "", "francos belgas (convertibles)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "franco belga", PluralForms{"", "franco belga", "", "", "", "francos belgas"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franco belga (financiero)", PluralForms{"", "franco belga (financiero)", "", "", "", "francos belgas (financieros)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "lev fuerte búlgaro", PluralForms{"", "lev fuerte búlgaro", "", "", "", "leva fuertes búlgaros"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "leva búlgara", PluralForms{"", "leva búlgara", "", "", "", "levas búlgaras"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar bareiní", PluralForms{"", "dinar bareiní", "", "", "", "dinares bareiníes"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franco burundés", PluralForms{"", "franco burundés", "", "", "", "francos burundeses"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dólar de Bermudas", PluralForms{"", "dólar de Bermudas", "", "", "", "dólares de Bermudas"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dólar bruneano", PluralForms{"", "dólar bruneano", "", "", "", "dólares bruneanos"}},
+		"BOB": {2, 0, 2, 1, "BOB", "Bs", "Bs", "boliviano", PluralForms{"", "boliviano", "", "", "", "bolivianos"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso boliviano", PluralForms{"", "peso boliviano", "", "", "", "pesos bolivianos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "MVDOL boliviano", PluralForms{"", "MVDOL boliviano", "", "", "", "MVDOL bolivianos"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "nuevo cruceiro brasileño (1967–1986)", PluralForms{"", "nuevo cruzado brasileño (BRB)", "", "", "", "nuevos cruzados brasileños (BRB)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brasileño", PluralForms{"", "cruzado brasileño", "", "", "", "cruzados brasileños"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruceiro brasileño (1990–1993)", PluralForms{"", "cruceiro brasileño (BRE)", "", "", "", "cruceiros brasileños (BRE)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "real brasileño", PluralForms{"", "real brasileño", "", "", "", "reales brasileños"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "nuevo cruzado brasileño", PluralForms{"", "nuevo cruzado brasileño", "", "", "", "nuevos cruzados brasileños"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruceiro brasileño", PluralForms{"", "cruceiro brasileño", "", "", "", "cruceiros brasileños"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dólar bahameño", PluralForms{"", "dólar bahameño", "", "", "", "dólares bahameños"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "gultrum butanés", PluralForms{"", "gultrum butanés", "", "", "", "gultrums butaneses"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birmano", PluralForms{"", "kyat birmano", "", "", "", "kyat birmanos"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula botsuano", PluralForms{"", "pula botsuano", "", "", "", "pulas botsuanos"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "nuevo rublo bielorruso (1994–1999)", PluralForms{"", "nuevo rublo bielorruso", "", "", "", "nuevos rublos bielorrusos"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "rublo bielorruso", PluralForms{"", "rublo bielorruso", "", "", "", "rublos bielorrusos"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "rublo bielorruso (2000–2016)", PluralForms{"", "rublo bielorruso (2000–2016)", "", "", "", "rublos bielorrusos (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dólar beliceño", PluralForms{"", "dólar beliceño", "", "", "", "dólares beliceños"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "dólar canadiense", PluralForms{"", "dólar canadiense", "", "", "", "dólares canadienses"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franco congoleño", PluralForms{"", "franco congoleño", "", "", "", "francos congoleños"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "euro WIR", PluralForms{"", "euro WIR", "", "", "", "euros WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franco suizo", PluralForms{"", "franco suizo", "", "", "", "francos suizos"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "franco WIR", PluralForms{"", "franco WIR", "", "", "", "francos WIR"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "unidad de fomento chilena", PluralForms{"", "unidad de fomento chilena", "", "", "", "unidades de fomento chilenas"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso chileno", PluralForms{"", "peso chileno", "", "", "", "pesos chilenos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "yuan chino (extracontinental)", PluralForms{"", "yuan chino (extracontinental)", "", "", "", "yuanes chinos (extracontinentales)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "yuan renminbi", PluralForms{"", "yuan renminbi", "", "", "", "yuanes renminbi"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso colombiano", PluralForms{"", "peso colombiano", "", "", "", "pesos colombianos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "unidad de valor real colombiana", PluralForms{"", "unidad de valor real", "", "", "", "unidades de valor reales"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colón costarricense", PluralForms{"", "colón costarricense", "", "", "", "colones costarricenses"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "antiguo dinar serbio", PluralForms{"", "antiguo dinar serbio", "", "", "", "antiguos dinares serbios"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "corona fuerte checoslovaca", PluralForms{"", "corona fuerte checoslovaca", "", "", "", "coronas fuertes checoslovacas"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cubano convertible", PluralForms{"", "peso cubano convertible", "", "", "", "pesos cubanos convertibles"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubano", PluralForms{"", "peso cubano", "", "", "", "pesos cubanos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo de Cabo Verde", PluralForms{"", "escudo de Cabo Verde", "", "", "", "escudos de Cabo Verde"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "libra chipriota", PluralForms{"", "libra chipriota", "", "", "", "libras chipriotas"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "corona checa", PluralForms{"", "corona checa", "", "", "", "coronas checas"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "ostmark de Alemania del Este", PluralForms{"", "marco de la República Democrática Alemana", "", "", "", "marcos de la República Democrática Alemana"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marco alemán", PluralForms{"", "marco alemán", "", "", "", "marcos alemanes"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franco yibutiano", PluralForms{"", "franco yibutiano", "", "", "", "francos yibutianos"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "corona danesa", PluralForms{"", "corona danesa", "", "", "", "coronas danesas"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominicano", PluralForms{"", "peso dominicano", "", "", "", "pesos dominicanos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar argelino", PluralForms{"", "dinar argelino", "", "", "", "dinares argelinos"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre ecuatoriano", PluralForms{"", "sucre ecuatoriano", "", "", "", "sucres ecuatorianos"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "unidad de valor constante (UVC) ecuatoriana", PluralForms{"", "unidad de valor constante (UVC) ecuatoriana", "", "", "", "unidades de valor constante (UVC) ecuatorianas"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "corona estonia", PluralForms{"", "corona estonia", "", "", "", "coronas estonias"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "libra egipcia", PluralForms{"", "libra egipcia", "", "", "", "libras egipcias"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreo", PluralForms{"", "nakfa eritreo", "", "", "", "nakfas eritreos"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "peseta española (cuenta A)", PluralForms{"", "peseta española (cuenta A)", "", "", "", "pesetas españolas (cuenta A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "peseta española (cuenta convertible)", PluralForms{"", "peseta española (cuenta convertible)", "", "", "", "pesetas españolas (cuenta convertible)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "₧", "₧", "peseta española", PluralForms{"", "peseta española", "", "", "", "pesetas españolas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "bir etíope", PluralForms{"", "bir etíope", "", "", "", "bires etíopes"}},
+		"EUR": {2, 0, 2, 1, "EUR", "EUR", "€", "euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "marco finlandés", PluralForms{"", "marco finlandés", "", "", "", "marcos finlandeses"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dólar fiyiano", PluralForms{"", "dólar fiyiano", "", "", "", "dólares fiyianos"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "FK£", "libra malvinense", PluralForms{"", "libra malvinense", "", "", "", "libras malvinenses"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "franco francés", PluralForms{"", "franco francés", "", "", "", "francos franceses"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "libra esterlina", PluralForms{"", "libra esterlina", "", "", "", "libras esterlinas"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "kupon larit georgiano", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari georgiano", PluralForms{"", "lari georgiano", "", "", "", "laris georgianos"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi ghanés (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanés", PluralForms{"", "cedi ghanés", "", "", "", "cedis ghaneses"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "libra gibraltareña", PluralForms{"", "libra gibraltareña", "", "", "", "libras gibraltareñas"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambiano", PluralForms{"", "dalasi gambiano", "", "", "", "dalasis gambianos"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franco guineano", PluralForms{"", "franco guineano", "", "", "", "francos guineanos"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli guineano", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekuele de Guinea Ecuatorial", PluralForms{"", "ekuele de Guinea Ecuatorial", "", "", "", "ekueles de Guinea Ecuatorial"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "dracma griego", PluralForms{"", "dracma griego", "", "", "", "dracmas griegos"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal guatemalteco", PluralForms{"", "quetzal guatemalteco", "", "", "", "quetzales guatemaltecos"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "escudo de Guinea Portuguesa", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso de Guinea-Bissáu", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dólar guyanés", PluralForms{"", "dólar guyanés", "", "", "", "dólares guyaneses"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "dólar hongkonés", PluralForms{"", "dólar hongkonés", "", "", "", "dólares hongkoneses"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira hondureño", PluralForms{"", "lempira hondureño", "", "", "", "lempiras hondureños"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar croata", PluralForms{"", "dinar croata", "", "", "", "dinares croatas"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna croata", PluralForms{"", "kuna croata", "", "", "", "kunas croatas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitiano", PluralForms{"", "gourde haitiano", "", "", "", "gourdes haitianos"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forinto húngaro", PluralForms{"", "forinto húngaro", "", "", "", "forintos húngaros"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonesia", PluralForms{"", "rupia indonesia", "", "", "", "rupias indonesias"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "libra irlandesa", PluralForms{"", "libra irlandesa", "", "", "", "libras irlandesas"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "libra israelí", PluralForms{"", "libra israelí", "", "", "", "libras israelíes"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "nuevo séquel israelí", PluralForms{"", "nuevo séquel israelí", "", "", "", "nuevos séqueles israelíes"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "rupia india", PluralForms{"", "rupia india", "", "", "", "rupias indias"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar iraquí", PluralForms{"", "dinar iraquí", "", "", "", "dinares iraquíes"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iraní", PluralForms{"", "rial iraní", "", "", "", "riales iraníes"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "corona islandesa", PluralForms{"", "corona islandesa", "", "", "", "coronas islandesas"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "lira italiana", PluralForms{"", "lira italiana", "", "", "", "liras italianas"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dólar jamaicano", PluralForms{"", "dólar jamaicano", "", "", "", "dólares jamaicanos"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordano", PluralForms{"", "dinar jordano", "", "", "", "dinares jordanos"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "yen japonés", PluralForms{"", "yen japonés", "", "", "", "yenes japoneses"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "chelín keniano", PluralForms{"", "chelín keniano", "", "", "", "chelines kenianos"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirguís", PluralForms{"", "som kirguís", "", "", "", "soms kirguises"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel camboyano", PluralForms{"", "riel camboyano", "", "", "", "rieles camboyanos"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franco comorense", PluralForms{"", "franco comorense", "", "", "", "francos comorenses"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won norcoreano", PluralForms{"", "won norcoreano", "", "", "", "wons norcoreanos"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "won surcoreano", PluralForms{"", "won surcoreano", "", "", "", "wons surcoreanos"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuwaití", PluralForms{"", "dinar kuwaití", "", "", "", "dinares kuwaitíes"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dólar de las Islas Caimán", PluralForms{"", "dólar de las Islas Caimán", "", "", "", "dólares de las Islas Caimán"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazajo", PluralForms{"", "tenge kazajo", "", "", "", "tengues kazajos"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laosiano", PluralForms{"", "kip laosiano", "", "", "", "kips laosianos"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libra libanesa", PluralForms{"", "libra libanesa", "", "", "", "libras libanesas"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia esrilanquesa", PluralForms{"", "rupia esrilanquesa", "", "", "", "rupias esrilanquesas"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dólar liberiano", PluralForms{"", "dólar liberiano", "", "", "", "dólares liberianos"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti lesotense", PluralForms{"", "loti lesotense", "", "", "", "lotis lesotenses"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litas lituano", PluralForms{"", "litas lituana", "", "", "", "litas lituanas"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "talonas lituano", PluralForms{"", "talonas lituana", "", "", "", "talonas lituanas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "franco convertible luxemburgués", PluralForms{"", "franco convertible luxemburgués", "", "", "", "francos convertibles luxemburgueses"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "franco luxemburgués", PluralForms{"", "franco luxemburgués", "", "", "", "francos luxemburgueses"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "franco financiero luxemburgués", PluralForms{"", "franco financiero luxemburgués", "", "", "", "francos financieros luxemburgueses"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lats letón", PluralForms{"", "lats letón", "", "", "", "lats letónes"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "rublo letón", PluralForms{"", "rublo letón", "", "", "", "rublos letones"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libio", PluralForms{"", "dinar libio", "", "", "", "dinares libios"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dírham marroquí", PluralForms{"", "dírham marroquí", "", "", "", "dírhams marroquíes"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franco marroquí", PluralForms{"", "franco marroquí", "", "", "", "francos marroquíes"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldavo", PluralForms{"", "leu moldavo", "", "", "", "leus moldavos"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariari malgache", PluralForms{"", "ariari malgache", "", "", "", "ariaris malgaches"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franco malgache", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinar macedonio", PluralForms{"", "dinar macedonio", "", "", "", "dinares macedonios"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franco malí", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kiat de Myanmar", PluralForms{"", "kiat de Myanmar", "", "", "", "kiats de Myanmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongol", PluralForms{"", "tugrik mongol", "", "", "", "tugriks mongoles"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca macaense", PluralForms{"", "pataca macaense", "", "", "", "patacas macaenses"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "uguiya (1973–2017)", PluralForms{"", "uguiya (1973–2017)", "", "", "", "uguiyas (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "uguiya mauritano", PluralForms{"", "uguiya mauritano", "", "", "", "uguiyas mauritanos"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lira maltesa", PluralForms{"", "lira maltesa", "", "", "", "liras maltesas"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "libra maltesa", PluralForms{"", "libra maltesa", "", "", "", "libras maltesas"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia mauriciana", PluralForms{"", "rupia mauriciana", "", "", "", "rupias mauricianas"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rufiya maldiva", PluralForms{"", "rufiya maldiva", "", "", "", "rufiyas maldivas"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malauí", PluralForms{"", "kwacha malauí", "", "", "", "kwachas malauíes"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "peso mexicano", PluralForms{"", "peso mexicano", "", "", "", "pesos mexicanos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso de plata mexicano (1861–1992)", PluralForms{"", "peso de plata mexicano (MXP)", "", "", "", "pesos de plata mexicanos (MXP)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "unidad de inversión (UDI) mexicana", PluralForms{"", "unidad de inversión (UDI) mexicana", "", "", "", "unidades de inversión (UDI) mexicanas"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringit malasio", PluralForms{"", "ringit malasio", "", "", "", "ringits malasios"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escudo mozambiqueño", PluralForms{"", "escudo mozambiqueño", "", "", "", "escudos mozambiqueños"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "antiguo metical mozambiqueño", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambiqueño", PluralForms{"", "metical mozambiqueño", "", "", "", "meticales mozambiqueños"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dólar namibio", PluralForms{"", "dólar namibio", "", "", "", "dólares namibios"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeriano", PluralForms{"", "naira nigeriano", "", "", "", "nairas nigerianos"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "córdoba nicaragüense (1988–1991)", PluralForms{"", "córdoba nicaragüense (1988–1991)", "", "", "", "córdobas nicaragüenses (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba nicaragüense", PluralForms{"", "córdoba nicaragüense", "", "", "", "córdobas nicaragüenses"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "florín neerlandés", PluralForms{"", "florín neerlandés", "", "", "", "florines neerlandeses"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "corona noruega", PluralForms{"", "corona noruega", "", "", "", "coronas noruegas"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalí", PluralForms{"", "rupia nepalí", "", "", "", "rupias nepalíes"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "dólar neozelandés", PluralForms{"", "dólar neozelandés", "", "", "", "dólares neozelandeses"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omaní", PluralForms{"", "rial omaní", "", "", "", "riales omaníes"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panameño", PluralForms{"", "balboa panameño", "", "", "", "balboas panameños"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti peruano", PluralForms{"", "inti peruano", "", "", "", "intis peruanos"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol peruano", PluralForms{"", "sol peruano", "", "", "", "soles peruanos"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol peruano (1863–1965)", PluralForms{"", "sol peruano (1863–1965)", "", "", "", "soles peruanos (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina papú", PluralForms{"", "kina papú", "", "", "", "kinas papúes"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipino", PluralForms{"", "peso filipino", "", "", "", "pesos filipinos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia pakistaní", PluralForms{"", "rupia pakistaní", "", "", "", "rupias pakistaníes"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "esloti polaco", PluralForms{"", "esloti polaco", "", "", "", "eslotis polacos"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "zloty polaco (1950–1995)", PluralForms{"", "zloty polaco (PLZ)", "", "", "", "zlotys polacos (PLZ)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "escudo portugués", PluralForms{"", "escudo portugués", "", "", "", "escudos portugueses"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guaraní paraguayo", PluralForms{"", "guaraní paraguayo", "", "", "", "guaraníes paraguayos"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial catarí", PluralForms{"", "rial catarí", "", "", "", "riales cataríes"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dólar rodesiano", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "antiguo leu rumano", PluralForms{"", "antiguo leu rumano", "", "", "", "antiguos lei rumanos"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "leu rumano", PluralForms{"", "leu rumano", "", "", "", "leus rumanos"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serbio", PluralForms{"", "dinar serbio", "", "", "", "dinares serbios"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rublo ruso", PluralForms{"", "rublo ruso", "", "", "", "rublos rusos"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "rublo ruso (1991–1998)", PluralForms{"", "rublo ruso (RUR)", "", "", "", "rublos rusos (RUR)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franco ruandés", PluralForms{"", "franco ruandés", "", "", "", "francos ruandeses"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "rial saudí", PluralForms{"", "rial saudí", "", "", "", "riales saudíes"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dólar salomonense", PluralForms{"", "dólar salomonense", "", "", "", "dólares salomonenses"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia seychellense", PluralForms{"", "rupia seychellense", "", "", "", "rupias seychellenses"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar sudanés", PluralForms{"", "dinar sudanés", "", "", "", "dinares sudaneses"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "libra sudanesa", PluralForms{"", "libra sudanesa", "", "", "", "libras sudanesas"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "libra sudanesa antigua", PluralForms{"", "libra sudanesa antigua", "", "", "", "libras sudanesas antiguas"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "corona sueca", PluralForms{"", "corona sueca", "", "", "", "coronas suecas"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dólar singapurense", PluralForms{"", "dólar singapurense", "", "", "", "dólares singapurenses"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "libra de Santa Elena", PluralForms{"", "libra de Santa Elena", "", "", "", "libras de Santa Elena"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tólar esloveno", PluralForms{"", "tólar esloveno", "", "", "", "tólares eslovenos"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "corona eslovaca", PluralForms{"", "corona eslovaca", "", "", "", "coronas eslovacas"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone", PluralForms{"", "leone", "", "", "", "leones"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leones (1964—2022)", PluralForms{"", "leone (1964—2022)", "", "", "", "leones (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "chelín somalí", PluralForms{"", "chelín somalí", "", "", "", "chelines somalíes"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dólar surinamés", PluralForms{"", "dólar surinamés", "", "", "", "dólares surinameses"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "florín surinamés", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "SD£", "libra sursudanesa", PluralForms{"", "libra sursudanesa", "", "", "", "libras sursudanesas"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra (1977–2017)", PluralForms{"", "dobra (1977–2017)", "", "", "", "dobras (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra santotomense", PluralForms{"", "dobra santotomense", "", "", "", "dobras santotomenses"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "rublo soviético", PluralForms{"", "rublo soviético", "", "", "", "rublos soviéticos"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colón salvadoreño", PluralForms{"", "colón salvadoreño", "", "", "", "colones salvadoreños"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "S£", "libra siria", PluralForms{"", "libra siria", "", "", "", "libras sirias"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni esuatiní", PluralForms{"", "lilangeni esuatiní", "", "", "", "lilangenis esuatiníes"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "baht tailandes", PluralForms{"", "baht tailandes", "", "", "", "bahts tailandeses"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "rublo tayiko", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tayiko", PluralForms{"", "somoni tayiko", "", "", "", "somonis tayikos"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turcomano (1993–2009)", PluralForms{"", "manat turcomano (1993–2009)", "", "", "", "manats turcomanos (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turcomano", PluralForms{"", "manat turcomano", "", "", "", "manats turcomanos"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunecino", PluralForms{"", "dinar tunecino", "", "", "", "dinares tunecinos"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "paanga tongano", PluralForms{"", "paanga tongano", "", "", "", "paangas tonganos"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "escudo timorense", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "lira turca (1922–2005)", PluralForms{"", "lira turca (1922–2005)", "", "", "", "liras turcas (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turca", PluralForms{"", "lira turca", "", "", "", "liras turcas"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dólar de Trinidad y Tobago", PluralForms{"", "dólar de Trinidad y Tobago", "", "", "", "dólares de Trinidad y Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "nuevo dólar taiwanés", PluralForms{"", "nuevo dólar taiwanés", "", "", "", "nuevos dólares taiwaneses"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "chelín tanzano", PluralForms{"", "chelín tanzano", "", "", "", "chelines tanzanos"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "grivna ucraniana", PluralForms{"", "grivna ucraniana", "", "", "", "grivnas ucranianas"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbovanet ucraniano", PluralForms{"", "karbovanet ucraniano", "", "", "", "karbovanets ucranianos"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "chelín ugandés (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "chelín ugandés", PluralForms{"", "chelín ugandés", "", "", "", "chelines ugandeses"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "dólar estadounidense", PluralForms{"", "dólar estadounidense", "", "", "", "dólares estadounidenses"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dólar estadounidense (día siguiente)", PluralForms{"", "dólar estadounidense (día siguiente)", "", "", "", "dólares estadounidenses (día siguiente)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dólar estadounidense (mismo día)", PluralForms{"", "dólar estadounidense (mismo día)", "", "", "", "dólares estadounidenses (mismo día)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "peso uruguayo en unidades indexadas", PluralForms{"", "peso uruguayo en unidades indexadas", "", "", "", "pesos uruguayos en unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso uruguayo (1975–1993)", PluralForms{"", "peso uruguayo (UYP)", "", "", "", "pesos uruguayos (UYP)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguayo", PluralForms{"", "peso uruguayo", "", "", "", "pesos uruguayos"}},
+		"UYW": {4, 0, 4, 1, "UYW", "UYW", "UYW", "unidad previsional uruguayo", PluralForms{"", "unidad previsional uruguayo", "", "", "", "unidades previsionales uruguayos"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "som uzbeko", PluralForms{"", "som uzbeko", "", "", "", "soms uzbekos"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "bolívar venezolano (1871–2008)", PluralForms{"", "bolívar venezolano (1871–2008)", "", "", "", "bolívares venezolanos (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "BsF", "bolívar venezolano (2008–2018)", PluralForms{"", "bolívar venezolano (2008–2018)", "", "", "", "bolívares venezolanos (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar venezolano", PluralForms{"", "bolívar venezolano", "", "", "", "bolívares venezolanos"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "dong vietnamita", PluralForms{"", "dong vietnamita", "", "", "", "dongs vietnamitas"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu vanuatense", PluralForms{"", "vatu vanuatense", "", "", "", "vatus vanuatenses"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoano", PluralForms{"", "tala samoano", "", "", "", "talas samoanos"}},
+		"XAF": {0, 0, 0, 1, "XAF", "XAF", "XAF", "franco CFA de África Central", PluralForms{"", "franco CFA de África Central", "", "", "", "francos CFA de África Central"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "plata", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "oro", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "unidad compuesta europea", PluralForms{"", "unidad compuesta europea", "", "", "", "unidades compuestas europeas"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "unidad monetaria europea", PluralForms{"", "unidad monetaria europea", "", "", "", "unidades monetarias europeas"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "unidad de cuenta europea (XBC)", PluralForms{"", "unidad de cuenta europea (XBC)", "", "", "", "unidades de cuenta europeas (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "unidad de cuenta europea (XBD)", PluralForms{"", "unidad de cuenta europea (XBD)", "", "", "", "unidades de cuenta europeas (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "dólar del Caribe Oriental", PluralForms{"", "dólar del Caribe Oriental", "", "", "", "dólares del Caribe Oriental"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "florín caribeño", PluralForms{"", "florín caribeño", "", "", "", "florines caribeños"}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "derechos especiales de giro", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "unidad de moneda europea", PluralForms{"", "unidad de moneda europea", "", "", "", "unidades de moneda europeas"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franco oro francés", PluralForms{"", "franco oro francés", "", "", "", "francos oro franceses"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franco UIC francés", PluralForms{"", "franco UIC francés", "", "", "", "francos UIC franceses"}},
+		"XOF": {0, 0, 0, 1, "XOF", "XOF", "XOF", "franco CFA de África Occidental", PluralForms{"", "franco CFA de África Occidental", "", "", "", "francos CFA de África Occidental"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "paladio", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franco CFP", PluralForms{"", "franco CFP", "", "", "", "francos CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platino", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "fondos RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "código reservado para pruebas", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "moneda desconocida", PluralForms{"", "(unidad de moneda desconocida)", "", "", "", "(moneda desconocida)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar yemení", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial yemení", PluralForms{"", "rial yemení", "", "", "", "riales yemeníes"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "dinar fuerte yugoslavo", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "super dinar yugoslavo", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar convertible yugoslavo", PluralForms{"", "dinar convertible yugoslavo", "", "", "", "dinares convertibles yugoslavos"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand sudafricano (financiero)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sudafricano", PluralForms{"", "rand sudafricano", "", "", "", "rands sudafricanos"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha zambiano (1968–2012)", PluralForms{"", "kwacha zambiano (1968–2012)", "", "", "", "kwachas zambianos (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kuacha zambiano", PluralForms{"", "kuacha zambiano", "", "", "", "kuachas zambianos"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "nuevo zaire zaireño", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zaire zaireño", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dólar de Zimbabue", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dólar zimbabuense", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,288 +6,341 @@ var et = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"×10^", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tuh", "other": "0\u00a0tuh"}},
+				{4, 2, map[string]string{"one": "0\u00a0tuh", "other": "0\u00a0tuh"}},
+				{5, 3, map[string]string{"one": "0\u00a0tuh", "other": "0\u00a0tuh"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{9, 1, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{10, 2, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{11, 3, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{12, 1, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+				{13, 2, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+				{14, 3, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 tuhat", "other": "0 tuhat"}},
+				{4, 2, map[string]string{"one": "0 tuhat", "other": "0 tuhat"}},
+				{5, 3, map[string]string{"one": "0 tuhat", "other": "0 tuhat"}},
+				{6, 1, map[string]string{"one": "0 miljon", "other": "0 miljonit"}},
+				{7, 2, map[string]string{"one": "0 miljonit", "other": "0 miljonit"}},
+				{8, 3, map[string]string{"one": "0 miljonit", "other": "0 miljonit"}},
+				{9, 1, map[string]string{"one": "0 miljard", "other": "0 miljardit"}},
+				{10, 2, map[string]string{"one": "0 miljardit", "other": "0 miljardit"}},
+				{11, 3, map[string]string{"one": "0 miljardit", "other": "0 miljardit"}},
+				{12, 1, map[string]string{"one": "0 triljon", "other": "0 triljonit"}},
+				{13, 2, map[string]string{"one": "0 triljonit", "other": "0 triljonit"}},
+				{14, 3, map[string]string{"one": "0 triljonit", "other": "0 triljonit"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tuh\u00a0¤", "other": "0\u00a0tuh\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0tuh\u00a0¤", "other": "0\u00a0tuh\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0tuh\u00a0¤", "other": "0\u00a0tuh\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0trln\u00a0¤", "other": "0\u00a0trln\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0trln\u00a0¤", "other": "0\u00a0trln\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0trln\u00a0¤", "other": "0\u00a0trln\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~ {0}", "≥{0}", "≤ {0}", "{0}‒{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "kr", "kr"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorra peseeta", PluralForms{"", "Andorra peseeta", "", "", "", "Andorra peseetat"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Araabia Ühendemiraatide dirhem", PluralForms{"", "Araabia Ühendemiraatide dirhem", "", "", "", "Araabia Ühendemiraatide dirhemit"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afganistani afgaani (1927–2002)", PluralForms{"", "Afganistani afgaani (1927–2002)", "", "", "", "Afganistani afgaanit (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afganistani afgaani", PluralForms{"", "Afganistani afgaani", "", "", "", "Afganistani afgaanit"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albaania lekk (1946–1965)", PluralForms{"", "Albaania lekk (1946–1965)", "", "", "", "Albaania lekki (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albaania lekk", PluralForms{"", "Albaania lekk", "", "", "", "Albaania lekki"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armeenia dramm", PluralForms{"", "Armeenia dramm", "", "", "", "Armeenia drammi"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Hollandi Antillide kulden", PluralForms{"", "Hollandi Antillide kulden", "", "", "", "Hollandi Antillide kuldnat"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angola kvanza", PluralForms{"", "Angola kvanza", "", "", "", "Angola kvanzat"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angola kvanza (1977–1990)", PluralForms{"", "Angola kvanza (1977–1990)", "", "", "", "Angola kvanzat (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angola kvanza (1990–2000)", PluralForms{"", "Angola kvanza (1990–2000)", "", "", "", "Angola kvanzat (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angola reformitud kvanza, 1995–1999", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentina austral", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Argentina peeso (1881–1970)", PluralForms{"", "Argentina peeso (1881–1970)", "", "", "", "Argentina peesot (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentina peeso (1983–1985)", PluralForms{"", "Argentina peeso (1983–1985)", "", "", "", "Argentina peesot (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentina peeso", PluralForms{"", "Argentina peeso", "", "", "", "Argentina peesot"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austria šilling", PluralForms{"", "Austria šilling", "", "", "", "Austria šillingit"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "Austraalia dollar", PluralForms{"", "Austraalia dollar", "", "", "", "Austraalia dollarit"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruba kulden", PluralForms{"", "Aruba kulden", "", "", "", "Aruba kuldnat"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Aserbaidžaani manat (1993–2006)", PluralForms{"", "Aserbaidžaani manat (1993–2006)", "", "", "", "Aserbaidžaani manatit (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Aserbaidžaani manat", PluralForms{"", "Aserbaidžaani manat", "", "", "", "Aserbaidžaani manatit"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosnia ja Hertsegoviina dinaar (1992–1994)", PluralForms{"", "Bosnia ja Hertsegoviina dinaar (1992–1994)", "", "", "", "Bosnia ja Hertsegoviina dinaari (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bosnia ja Hertsegoviina konverteeritav mark", PluralForms{"", "Bosnia ja Hertsegoviina konverteeritav mark", "", "", "", "Bosnia ja Hertsegoviina konverteeritavat marka"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Bosnia ja Hertsegoviina uus dinaar (1994–1997)", PluralForms{"", "Bosnia ja Hertsegoviina uus dinaar (1994–1997)", "", "", "", "Bosnia ja Hertsegoviina uut dinaari (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbadose dollar", PluralForms{"", "Barbadose dollar", "", "", "", "Barbadose dollarit"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladeshi taka", PluralForms{"", "Bangladeshi taka", "", "", "", "Bangladeshi takat"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgia konverteeritav frank", PluralForms{"", "Belgia konverteeritav frank", "", "", "", "Belgia konverteeritavat franki"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgia frank", PluralForms{"", "Belgia frank", "", "", "", "Belgia franki"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgia arveldusfrank", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bulgaaria püsiv leev", PluralForms{"", "Bulgaaria kõva leev", "", "", "", "Bulgaaria kõva leevi"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgaaria leev", PluralForms{"", "Bulgaaria leev", "", "", "", "Bulgaaria leevi"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Bulgaaria leev (1879–1952)", PluralForms{"", "Bulgaaria leev (1879–1952)", "", "", "", "Bulgaaria leevi (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahreini dinaar", PluralForms{"", "Bahreini dinaar", "", "", "", "Bahreini dinaari"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundi frank", PluralForms{"", "Burundi frank", "", "", "", "Burundi franki"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermuda dollar", PluralForms{"", "Bermuda dollar", "", "", "", "Bermuda dollarit"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunei dollar", PluralForms{"", "Brunei dollar", "", "", "", "Brunei dollarit"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliivia boliviaano", PluralForms{"", "Boliivia boliviaano", "", "", "", "Boliivia boliviaanot"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Boliivia boliviaano (1863–1963)", PluralForms{"", "Boliivia boliviaano (1863–1963)", "", "", "", "Boliivia boliviaanot (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Boliivia peeso", PluralForms{"", "Boliivia peeso", "", "", "", "Boliivia peesot"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brasiilia uus kruseiro (1967–1986)", PluralForms{"", "Brasiilia uus kruseiro (1967–1986)", "", "", "", "Brasiilia uut kruseirot (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brasiilia krusado", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brasiilia kruseiro (1990–1993)", PluralForms{"", "Brasiilia kruseiro (1990–1993)", "", "", "", "Brasiilia kruseirot (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brasiilia reaal", PluralForms{"", "Brasiilia reaal", "", "", "", "Brasiilia reaali"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brasiilia kruseiro (1993–1994)", PluralForms{"", "Brasiilia kruseiro (1993–1994)", "", "", "", "Brasiilia kruseirot (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Brasiilia kruseiro (1942–1967)", PluralForms{"", "Brasiilia kruseiro (1942–1967)", "", "", "", "Brasiilia kruseirot (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahama dollar", PluralForms{"", "Bahama dollar", "", "", "", "Bahama dollarit"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Bhutani ngultrum", PluralForms{"", "Bhutani ngultrum", "", "", "", "Bhutani ngultrumit"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Birma kjatt", PluralForms{"", "Birma kjatt", "", "", "", "Birma kjatti"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswana pula", PluralForms{"", "Botswana pula", "", "", "", "Botswana pulat"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Valgevene uus rubla (1994–1999)", PluralForms{"", "Valgevene uus rubla (1994–1999)", "", "", "", "Valgevene uut rubla (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Valgevene rubla", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Valgevene rubla (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belize’i dollar", PluralForms{"", "Belize’i dollar", "", "", "", "Belize’i dollarit"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Kanada dollar", PluralForms{"", "Kanada dollar", "", "", "", "Kanada dollarit"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongo frank", PluralForms{"", "Kongo frank", "", "", "", "Kongo franki"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Šveitsi frank", PluralForms{"", "Šveitsi frank", "", "", "", "Šveitsi franki"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Tšiili eskuudo", PluralForms{"", "Tšiili eskuudo", "", "", "", "Tšiili eskuudot"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Tšiili peeso", PluralForms{"", "Tšiili peeso", "", "", "", "Tšiili peesot"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Hiina jüaan (välismaine turg)", PluralForms{"", "Hiina jüaan (välismaine turg)", "", "", "", "Hiina jüaani (välismaine turg)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Hiina jüaan", PluralForms{"", "Hiina jüaan", "", "", "", "Hiina jüaani"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Colombia peeso", PluralForms{"", "Colombia peeso", "", "", "", "Colombia peesot"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Costa Rica koloon", PluralForms{"", "Costa Rica koloon", "", "", "", "Costa Rica kolooni"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbia dinaar (2002–2006)", PluralForms{"", "Serbia dinaar (2002–2006)", "", "", "", "Serbia dinaari (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Tšehhoslovakkia kõva kroon", PluralForms{"", "Tšehhoslovakkia kõva kroon", "", "", "", "Tšehhoslovakkia kõva krooni"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kuuba konverteeritav peeso", PluralForms{"", "Kuuba konverteeritav peeso", "", "", "", "Kuuba konverteeritavat peesot"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kuuba peeso", PluralForms{"", "Kuuba peeso", "", "", "", "Kuuba peesot"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Cabo Verde eskuudo", PluralForms{"", "Cabo Verde eskuudo", "", "", "", "Cabo Verde eskuudot"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Küprose nael", PluralForms{"", "Küprose nael", "", "", "", "Küprose naela"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Tšehhi kroon", PluralForms{"", "Tšehhi kroon", "", "", "", "Tšehhi krooni"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Ida-Saksa mark", PluralForms{"", "Ida-Saksa mark", "", "", "", "Ida-Saksa marka"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Saksa mark", PluralForms{"", "Saksa mark", "", "", "", "Saksa marka"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Djibouti frank", PluralForms{"", "Djibouti frank", "", "", "", "Djibouti franki"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Taani kroon", PluralForms{"", "Taani kroon", "", "", "", "Taani krooni"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikaani peeso", PluralForms{"", "Dominikaani peeso", "", "", "", "Dominikaani peesot"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Alžeeria dinaar", PluralForms{"", "Alžeeria dinaar", "", "", "", "Alžeeria dinaari"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ecuadori sukre", PluralForms{"", "Ecuadori sukre", "", "", "", "Ecuadori sukret"}},
+		"EEK": {2, 0, 2, 1, "EEK", "kr", "kr", "Eesti kroon", PluralForms{"", "Eesti kroon", "", "", "", "Eesti krooni"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Egiptuse nael", PluralForms{"", "Egiptuse nael", "", "", "", "Egiptuse naela"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrea nakfa", PluralForms{"", "Eritrea nakfa", "", "", "", "Eritrea nakfat"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Hispaania peseeta", PluralForms{"", "Hispaania peseeta", "", "", "", "Hispaania peseetat"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Etioopia birr", PluralForms{"", "Etioopia birr", "", "", "", "Etioopia birri"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "eurot"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Soome mark", PluralForms{"", "Soome mark", "", "", "", "Soome marka"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fidži dollar", PluralForms{"", "Fidži dollar", "", "", "", "Fidži dollarit"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Falklandi saarte nael", PluralForms{"", "Falklandi saarte nael", "", "", "", "Falklandi saarte naela"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Prantsuse frank", PluralForms{"", "Prantsuse frank", "", "", "", "Prantsuse franki"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Suurbritannia naelsterling", PluralForms{"", "Suurbritannia naelsterling", "", "", "", "Suurbritannia naelsterlingit"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gruusia lari", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghana sedi (1979–2007)", PluralForms{"", "Ghana sedi (1979–2007)", "", "", "", "Ghana sedit (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ghana sedi", PluralForms{"", "Ghana sedi", "", "", "", "Ghana sedit"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltari nael", PluralForms{"", "Gibraltari nael", "", "", "", "Gibraltari naela"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambia dalasi", PluralForms{"", "Gambia dalasi", "", "", "", "Gambia dalasit"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Guinea frank", PluralForms{"", "Guinea frank", "", "", "", "Guinea franki"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guinea syli", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Kreeka drahm", PluralForms{"", "Kreeka drahm", "", "", "", "Kreeka drahmi"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Guatemala ketsaal", PluralForms{"", "Guatemala ketsaal", "", "", "", "Guatemala ketsaali"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugali Guinea eskuudo", PluralForms{"", "Portugali Guinea eskuudo", "", "", "", "Portugali Guinea eskuudot"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissau peeso", PluralForms{"", "Guinea-Bissau peeso", "", "", "", "Guinea-Bissau peesot"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Guyana dollar", PluralForms{"", "Guyana dollar", "", "", "", "Guyana dollarit"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Hongkongi dollar", PluralForms{"", "Hongkongi dollar", "", "", "", "Hongkongi dollarit"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Hondurase lempiira", PluralForms{"", "Hondurase lempiira", "", "", "", "Hondurase lempiirat"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Horvaatia dinaar", PluralForms{"", "Horvaatia dinaar", "", "", "", "Horvaatia dinaari"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Horvaatia kuna", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haiti gurd", PluralForms{"", "Haiti gurd", "", "", "", "Haiti gurdi"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Ungari forint", PluralForms{"", "Ungari forint", "", "", "", "Ungari forintit"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indoneesia ruupia", PluralForms{"", "Indoneesia ruupia", "", "", "", "Indoneesia ruupiat"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Iiri nael", PluralForms{"", "Iiri nael", "", "", "", "Iiri naela"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Iisraeli nael", PluralForms{"", "Iisraeli nael", "", "", "", "Iisraeli naela"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Iisraeli seekel (1980–1985)", PluralForms{"", "Iisraeli seekel (1980–1985)", "", "", "", "Iisraeli seekelit (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Iisraeli uus seekel", PluralForms{"", "Iisraeli uus seekel", "", "", "", "Iisraeli uut seeklit"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "India ruupia", PluralForms{"", "India ruupia", "", "", "", "India ruupiat"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Iraagi dinaar", PluralForms{"", "Iraagi dinaar", "", "", "", "Iraagi dinaari"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Iraani riaal", PluralForms{"", "Iraani riaal", "", "", "", "Iraani riaali"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Islandi kroon (1918–1981)", PluralForms{"", "Islandi kroon (1918–1981)", "", "", "", "Islandi krooni (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Islandi kroon", PluralForms{"", "Islandi kroon", "", "", "", "Islandi krooni"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Itaalia liir", PluralForms{"", "Itaalia liir", "", "", "", "Itaalia liiri"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaica dollar", PluralForms{"", "Jamaica dollar", "", "", "", "Jamaica dollarit"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordaania dinaar", PluralForms{"", "Jordaania dinaar", "", "", "", "Jordaania dinaari"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Jaapani jeen", PluralForms{"", "Jaapani jeen", "", "", "", "Jaapani jeeni"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Keenia šilling", PluralForms{"", "Keenia šilling", "", "", "", "Keenia šillingit"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kõrgõzstani somm", PluralForms{"", "Kõrgõzstani somm", "", "", "", "Kõrgõzstani sommi"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambodža riaal", PluralForms{"", "Kambodža riaal", "", "", "", "Kambodža riaali"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komoori frank", PluralForms{"", "Komoori frank", "", "", "", "Komoori franki"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Põhja-Korea vonn", PluralForms{"", "Põhja-Korea vonn", "", "", "", "Põhja-Korea vonni"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Lõuna-Korea vonn (1945–1953)", PluralForms{"", "Lõuna-Korea vonn (1945–1953)", "", "", "", "Lõuna-Korea vonni (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Lõuna-Korea vonn", PluralForms{"", "Lõuna-Korea vonn", "", "", "", "Lõuna-Korea vonni"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuveidi dinaar", PluralForms{"", "Kuveidi dinaar", "", "", "", "Kuveidi dinaari"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kaimanisaarte dollar", PluralForms{"", "Kaimanisaarte dollar", "", "", "", "Kaimanisaarte dollarit"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kasahstani tenge", PluralForms{"", "Kasahstani tenge", "", "", "", "Kasahstani tenget"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laose kiip", PluralForms{"", "Laose kiip", "", "", "", "Laose kiipi"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Liibanoni nael", PluralForms{"", "Liibanoni nael", "", "", "", "Liibanoni naela"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Sri Lanka ruupia", PluralForms{"", "Sri Lanka ruupia", "", "", "", "Sri Lanka ruupiat"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Libeeria dollar", PluralForms{"", "Libeeria dollar", "", "", "", "Libeeria dollarit"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesotho loti", PluralForms{"", "Lesotho loti", "", "", "", "Lesotho lotit"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Leedu litt", PluralForms{"", "Leedu litt", "", "", "", "Leedu litti"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luksemburgi konverteeritav frank", PluralForms{"", "Luksemburgi konverteeritav frank", "", "", "", "Luksemburgi konverteeritavat franki"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luksemburgi frank", PluralForms{"", "Luksemburgi frank", "", "", "", "Luksemburgi franki"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Läti latt", PluralForms{"", "Läti latt", "", "", "", "Läti latti"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Läti rubla", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Liibüa dinaar", PluralForms{"", "Liibüa dinaar", "", "", "", "Liibüa dinaari"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Maroko dirhem", PluralForms{"", "Maroko dirhem", "", "", "", "Maroko dirhemit"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Maroko frank", PluralForms{"", "Maroko frank", "", "", "", "Maroko franki"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monaco frank", PluralForms{"", "Monaco frank", "", "", "", "Monaco franki"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldova leu", PluralForms{"", "Moldova leu", "", "", "", "Moldova leud"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madagaskari ariari", PluralForms{"", "Madagaskari ariari", "", "", "", "Madagaskari ariarit"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madagaskari frank", PluralForms{"", "Madagaskari frank", "", "", "", "Madagaskar franki"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Makedoonia dinaar", PluralForms{"", "Makedoonia dinaar", "", "", "", "Makedoonia dinaari"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Makedoonia dinaar (1992–1993)", PluralForms{"", "Makedoonia dinaar (1992–1993)", "", "", "", "Makedoonia dinaari (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Mali frank", PluralForms{"", "Mali frank", "", "", "", "Mali franki"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Myanmari kjatt", PluralForms{"", "Myanmari kjatt", "", "", "", "Myanmari kjatti"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongoolia tugrik", PluralForms{"", "Mongoolia tugrik", "", "", "", "Mongoolia tugrikut"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Macau pataaka", PluralForms{"", "Macau pataaka", "", "", "", "Macau pataakat"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritaania ugia (1973–2017)", PluralForms{"", "Mauritaania ugia (1973–2017)", "", "", "", "Mauritaania ugiat (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauritaania ugia", PluralForms{"", "Mauritaania ugia", "", "", "", "Mauritaania ugiat"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Malta liir", PluralForms{"", "Malta liir", "", "", "", "Malta liiri"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Malta nael", PluralForms{"", "Malta nael", "", "", "", "Malta naela"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mauritiuse ruupia", PluralForms{"", "Mauritiuse ruupia", "", "", "", "Mauritiuse ruupiat"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Maldiivi ruupia (1947–1981)", PluralForms{"", "Maldiivi ruupia (1947–1981)", "", "", "", "Maldiivi ruupiat (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldiivi ruupia", PluralForms{"", "Maldiivi ruupia", "", "", "", "Maldiivi ruupiat"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawi kvatša", PluralForms{"", "Malawi kvatša", "", "", "", "Malawi kvatšat"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Mehhiko peeso", PluralForms{"", "Mehhiko peeso", "", "", "", "Mehhiko peesot"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Mehhiko peeso (1861–1992)", PluralForms{"", "Mehhiko peeso (1861–1992)", "", "", "", "Mehhiko peesot (1861–1992)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaisia ringgit", PluralForms{"", "Malaisia ringgit", "", "", "", "Malaisia ringgitit"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mosambiigi eskuudo", PluralForms{"", "Mosambiigi eskuudo", "", "", "", "Mosambiigi eskuudot"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mosambiigi metikal (1980–2006)", PluralForms{"", "Mosambiigi metikal (1980–2006)", "", "", "", "Mosambiigi metikali (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mosambiigi metikal", PluralForms{"", "Mosambiigi metikal", "", "", "", "Mosambiigi metikali"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namiibia dollar", PluralForms{"", "Namiibia dollar", "", "", "", "Namiibia dollarit"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigeeria naira", PluralForms{"", "Nigeeria naira", "", "", "", "Nigeeria nairat"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nicaragua kordoba (1988–1991)", PluralForms{"", "Nicaragua kordoba (1988–1991)", "", "", "", "Nicaragua kordobat (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nicaragua kordoba", PluralForms{"", "Nicaragua kordoba", "", "", "", "Nicaragua kordobat"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Hollandi kulden", PluralForms{"", "Hollandi kulden", "", "", "", "Hollandi kuldnat"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norra kroon", PluralForms{"", "Norra kroon", "", "", "", "Norra krooni"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepali ruupia", PluralForms{"", "Nepali ruupia", "", "", "", "Nepali ruupiat"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Uus-Meremaa dollar", PluralForms{"", "Uus-Meremaa dollar", "", "", "", "Uus-Meremaa dollarit"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omaani riaal", PluralForms{"", "Omaani riaal", "", "", "", "Omaani riaali"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panama balboa", PluralForms{"", "Panama balboa", "", "", "", "Panama balboad"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peruu inti", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peruu soll", PluralForms{"", "Peruu soll", "", "", "", "Peruu solli"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peruu soll (1863–1965)", PluralForms{"", "Peruu soll (1863–1965)", "", "", "", "Peruu solli (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Paapua Uus-Guinea kina", PluralForms{"", "Paapua Uus-Guinea kina", "", "", "", "Paapua Uus-Guinea kinat"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filipiini peeso", PluralForms{"", "Filipiini peeso", "", "", "", "Filipiini peesot"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistani ruupia", PluralForms{"", "Pakistani ruupia", "", "", "", "Pakistani ruupiat"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Poola zlott", PluralForms{"", "Poola zlott", "", "", "", "Poola zlotti"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Poola zlott (1950–1995)", PluralForms{"", "Poola zlott (1950–1995)", "", "", "", "Poola zlotti (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugali eskuudo", PluralForms{"", "Portugali eskuudo", "", "", "", "Portugali eskuudot"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paraguay guaranii", PluralForms{"", "Paraguay guaranii", "", "", "", "Paraguay guaraniid"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Katari riaal", PluralForms{"", "Katari riaal", "", "", "", "Katari riaali"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rodeesia dollar", PluralForms{"", "Rodeesia dollar", "", "", "", "Rodeesia dollarit"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumeenia leu (1952–2006)", PluralForms{"", "Rumeenia leu (1952–2006)", "", "", "", "Rumeenia leud (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Rumeenia leu", PluralForms{"", "Rumeenia leu", "", "", "", "Rumeenia leud"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbia dinaar", PluralForms{"", "Serbia dinaar", "", "", "", "Serbia dinaari"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Venemaa rubla", PluralForms{"", "", "", "", "", ""}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Venemaa rubla (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Rwanda frank", PluralForms{"", "Rwanda frank", "", "", "", "Rwanda franki"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudi Araabia riaal", PluralForms{"", "Saudi Araabia riaal", "", "", "", "Saudi Araabia riaali"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Saalomoni Saarte dollar", PluralForms{"", "Saalomoni Saarte dollar", "", "", "", "Saalomoni Saarte dollarit"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seišelli ruupia", PluralForms{"", "Seišelli ruupia", "", "", "", "Seišelli ruupiat"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudaani dinaar (1992–2007)", PluralForms{"", "Sudaani dinaar (1992–2007)", "", "", "", "Sudaani dinaari (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudaani nael", PluralForms{"", "Sudaani nael", "", "", "", "Sudaani naela"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudaani nael (1957–1998)", PluralForms{"", "Sudaani nael (1957–1998)", "", "", "", "Sudaani naela (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Rootsi kroon", PluralForms{"", "Rootsi kroon", "", "", "", "Rootsi krooni"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapuri dollar", PluralForms{"", "Singapuri dollar", "", "", "", "Singapuri dollarit"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Saint Helena nael", PluralForms{"", "Saint Helena nael", "", "", "", "Saint Helena naela"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Sloveenia tolar", PluralForms{"", "Sloveenia tolar", "", "", "", "Sloveenia tolarit"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovaki kroon", PluralForms{"", "Slovaki kroon", "", "", "", "Slovaki krooni"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sierra Leone leoone", PluralForms{"", "Sierra Leone leoone", "", "", "", "Sierra Leone leoonet"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sierra Leone leoone (1964–2022)", PluralForms{"", "Sierra Leone leoone (1964–2022)", "", "", "", "Sierra Leone leoonet (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somaalia šilling", PluralForms{"", "Somaalia šilling", "", "", "", "Somaalia šillingit"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Suriname dollar", PluralForms{"", "Suriname dollar", "", "", "", "Suriname dollarit"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Suriname kulden", PluralForms{"", "Suriname kulden", "", "", "", "Suriname kuldnat"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Lõuna-Sudaani nael", PluralForms{"", "Lõuna-Sudaani nael", "", "", "", "Lõuna-Sudaani naela"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "São Tomé ja Príncipe dobra (1977–2017)", PluralForms{"", "São Tomé ja Príncipe dobra (1977–2017)", "", "", "", "São Tomé ja Príncipe dobrat (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "São Tomé ja Príncipe dobra", PluralForms{"", "São Tomé ja Príncipe dobra", "", "", "", "São Tomé ja Príncipe dobrat"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "NSVL-i rubla", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "El Salvadori koloon", PluralForms{"", "El Salvadori koloon", "", "", "", "El Salvadori kolooni"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Süüria nael", PluralForms{"", "Süüria nael", "", "", "", "Süüria naela"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Svaasimaa lilangeni", PluralForms{"", "Svaasimaa lilangeni", "", "", "", "Svaasimaa lilangenit"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Tai baat", PluralForms{"", "Tai baat", "", "", "", "Tai baati"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadžikistani rubla", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadžikistani somoni", PluralForms{"", "Tadžikistani somoni", "", "", "", "Tadžikistani somonit"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Türkmenistani manat (1993–2009)", PluralForms{"", "Türkmenistani manat (1993–2009)", "", "", "", "Türkmenistani manatit (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Türkmenistani manat", PluralForms{"", "Türkmenistani manat", "", "", "", "Türkmenistani manatit"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tuneesia dinaar", PluralForms{"", "Tuneesia dinaar", "", "", "", "Tuneesia dinaari"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tonga pa’anga", PluralForms{"", "Tonga pa’anga", "", "", "", "Tonga pa’angat"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timori eskuudo", PluralForms{"", "Timori eskuudo", "", "", "", "Timori eskuudot"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Türgi liir (1922–2005)", PluralForms{"", "Türgi liir (1922–2005)", "", "", "", "Türgi liiri (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Türgi liir", PluralForms{"", "Türgi liir", "", "", "", "Türgi liiri"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidadi ja Tobago dollar", PluralForms{"", "Trinidadi ja Tobago dollar", "", "", "", "Trinidadi ja Tobago dollarit"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "uus Taiwani dollar", PluralForms{"", "uus Taiwani dollar", "", "", "", "uut Taiwani dollarit"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tansaania šilling", PluralForms{"", "Tansaania šilling", "", "", "", "Tansaania šillingit"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukraina grivna", PluralForms{"", "Ukraina grivna", "", "", "", "Ukraina grivnat"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukraina karbovanets", PluralForms{"", "Ukraina karbovanetsi", "", "", "", "Ukraina karbovanetsi"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Uganda šilling (1966–1987)", PluralForms{"", "Uganda šilling (1966–1987)", "", "", "", "Uganda šillingit (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Uganda šilling", PluralForms{"", "Uganda šilling", "", "", "", "Uganda šillingit"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "USA dollar", PluralForms{"", "USA dollar", "", "", "", "USA dollarit"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "USA järgmise päeva dollar", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "USA sama päeva dollar", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Uruguay peeso (1975–1993)", PluralForms{"", "Uruguay peeso (1975–1993)", "", "", "", "Uruguay peesot (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Uruguay peeso", PluralForms{"", "Uruguay peeso", "", "", "", "Uruguay peesot"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Usbekistani somm", PluralForms{"", "Usbekistani somm", "", "", "", "Usbekistani sommi"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venezuela boliivar (1871–2008)", PluralForms{"", "Venezuela boliivar (1871–2008)", "", "", "", "Venezuela boliivarit (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venezuela boliivar (2008–2018)", PluralForms{"", "Venezuela boliivar (2008–2018)", "", "", "", "Venezuela boliivarit (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venezuela boliivar", PluralForms{"", "Venezuela boliivar", "", "", "", "Venezuela boliivarit"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Vietnami dong", PluralForms{"", "Vietnami dong", "", "", "", "Vietnami dongi"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vietnami dong (1978–1985)", PluralForms{"", "Vietnami dong (1978–1985)", "", "", "", "Vietnami dongi (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu vatu", PluralForms{"", "Vanuatu vatu", "", "", "", "Vanuatu vatut"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoa taala", PluralForms{"", "Samoa taala", "", "", "", "Samoa taalat"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Kesk-Aafrika CFA frank", PluralForms{"", "Kesk-Aafrika CFA frank", "", "", "", "Kesk-Aafrika CFA franki"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "hõbe", PluralForms{"", "troiunts hõbedat", "", "", "", "troiuntsi hõbedat"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "kuld", PluralForms{"", "troiunts kulda", "", "", "", "troiuntsi kulda"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "EURCO", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Euroopa rahaühik", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Euroopa rahaline arvestusühik (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Euroopa rahaline arvestusühik (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Ida-Kariibi dollar", PluralForms{"", "Ida-Kariibi dollar", "", "", "", "Ida-Kariibi dollarit"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Rahvusvahelise Valuutafondi arvestusühik", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "eküü", PluralForms{"", "eküü", "", "", "", "eküüd"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Prantsuse kuldfrank", PluralForms{"", "Prantsuse kuldfrank", "", "", "", "Prantsuse kuldfranki"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Prantsuse UIC-frank", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Lääne-Aafrika CFA frank", PluralForms{"", "Lääne-Aafrika CFA frank", "", "", "", "Lääne-Aafrika CFA franki"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "pallaadium", PluralForms{"", "troiunts pallaadiumit", "", "", "", "troiuntsi pallaadiumit"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP frank", PluralForms{"", "CFP frank", "", "", "", "CFP franki"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "plaatina", PluralForms{"", "troiunts plaatinat", "", "", "", "troiuntsi plaatinat"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "vääringute testkood", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "määramata rahaühik", PluralForms{"", "(määramata rahaühik)", "", "", "", "(määramata rahaühikut)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jeemeni dinaar", PluralForms{"", "Jeemeni dinaar", "", "", "", "Jeemeni dinaari"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jeemeni riaal", PluralForms{"", "Jeemeni riaal", "", "", "", "Jeemeni riaali"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslaavia kõva dinaar (1966–1990)", PluralForms{"", "Jugoslaavia kõva dinaar (1966–1990)", "", "", "", "Jugoslaavia kõva dinaar (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslaavia uus dinaar (1994–2002)", PluralForms{"", "Jugoslaavia uus dinaar (1994–2002)", "", "", "", "Jugoslaavia uut dinaari (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslaavia konverteeritav dinaar (1990–1992)", PluralForms{"", "Jugoslaavia konverteeritav dinaar (1990–1992)", "", "", "", "Jugoslaavia konverteeritavat dinaari (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Jugoslaavia reformitud dinaar (1992–1993)", PluralForms{"", "Jugoslaavia reformitud dinaar (1992–1993)", "", "", "", "Jugoslaavia reformitud dinaari (1992–1993)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Lõuna-Aafrika rand", PluralForms{"", "Lõuna-Aafrika rand", "", "", "", "Lõuna-Aafrika randi"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Sambia kvatša (1968–2012)", PluralForms{"", "Sambia kvatša (1968–2012)", "", "", "", "Sambia kvatšat (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Sambia kvatša", PluralForms{"", "Sambia kvatša", "", "", "", "Sambia kvatšat"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Sairi zaire", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwe dollar (1980–2008)", PluralForms{"", "Zimbabwe dollar (1980–2008)", "", "", "", "Zimbabwe dollarit (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabwe dollar (2009)", PluralForms{"", "Zimbabwe dollar (2009)", "", "", "", "Zimbabwe dollarit (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabwe dollar (2008)", PluralForms{"", "Zimbabwe dollar (2008)", "", "", "", "Zimbabwe dollarit (2008)"}},
 	},
 }
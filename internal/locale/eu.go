@@ -6,315 +6,365 @@ var eu = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "%\u00a0", "", "-%\u00a0", ""}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{7, 2, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{8, 3, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{9, 4, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{10, 5, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{11, 6, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{12, 1, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{13, 2, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{14, 3, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+			},
+			[]CompactFormat{
+				{6, 1, map[string]string{"one": "0 milioi", "other": "0 milioi"}},
+				{7, 2, map[string]string{"one": "0 milioi", "other": "0 milioi"}},
+				{8, 3, map[string]string{"one": "0 milioi", "other": "0 milioi"}},
+				{9, 4, map[string]string{"one": "0 milioi", "other": "0 milioi"}},
+				{10, 5, map[string]string{"one": "0 milioi", "other": "0 milioi"}},
+				{11, 6, map[string]string{"one": "0 milioi", "other": "0 milioi"}},
+				{12, 1, map[string]string{"one": "0 bilioi", "other": "0 bilioi"}},
+				{13, 2, map[string]string{"one": "0 bilioi", "other": "0 bilioi"}},
+				{14, 3, map[string]string{"one": "0 bilioi", "other": "0 bilioi"}},
+			},
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{9, 4, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{10, 5, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{11, 6, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0B\u00a0¤", "other": "0\u00a0B\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0B\u00a0¤", "other": "0\u00a0B\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0B\u00a0¤", "other": "0\u00a0B\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "₧", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UYW": {4, "UYW", "UYW", "UYW"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VED": {2, "VED", "VED", "VED"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "pezeta andorratarra", PluralForms{"", "pezeta andorratar", "", "", "", "pezeta andorratar"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Arabiar Emirerri Batuetako dirhama", PluralForms{"", "Arabiar Emirerri Batuetako dirham", "", "", "", "Arabiar Emirerri Batuetako dirham"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afghani afgandarra (1927–2002)", PluralForms{"", "afghani afgandar (1927–2002)", "", "", "", "afghani afgandar (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afganiarra", PluralForms{"", "afgani afganiar", "", "", "", "afgani afganiar"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "lek albaniarra (1946–1965)", PluralForms{"", "lek albaniar (1946–1965)", "", "", "", "lek albaniar (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albaniarra", PluralForms{"", "lek albaniar", "", "", "", "lek albaniar"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armeniarra", PluralForms{"", "dram armeniar", "", "", "", "dram armeniar"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "florin antillarra", PluralForms{"", "florin antillar", "", "", "", "florin antillar"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolarra", PluralForms{"", "kwanza angolar", "", "", "", "kwanza angolar"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angolarra (1977–1991)", PluralForms{"", "kwanza angolar (1977–1991)", "", "", "", "kwanza angolar (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "kwanza angolar berria (1990–2000)", PluralForms{"", "kwanza angolar berri (1990–2000)", "", "", "", "kwanza angolar berri (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza angolar birdoitua (1995–1999)", PluralForms{"", "kwanza angolar birdoitu (1995–1999)", "", "", "", "kwanza angolar birdoitu (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentinarra", PluralForms{"", "austral argentinar", "", "", "", "austral argentinar"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "peso ley argentinarra (1970–1983)", PluralForms{"", "peso ley argentinar (1970–1983)", "", "", "", "peso ley argentinar (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "peso argentinarra (1981–1970)", PluralForms{"", "peso argentinar (1981–1970)", "", "", "", "peso argentinar (1981–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentinarra (1983–1985)", PluralForms{"", "peso argentinar (1983–1985)", "", "", "", "peso argentinar (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentinarra", PluralForms{"", "peso argentinar", "", "", "", "peso argentinar"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "txelin austriarra", PluralForms{"", "txelin austriar", "", "", "", "txelin austriar"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "dolar australiarra", PluralForms{"", "dolar australiar", "", "", "", "dolar australiar"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florin arubarra", PluralForms{"", "florin arubar", "", "", "", "florin arubar"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azerbaijandarra (1993–2006)", PluralForms{"", "manat azerbaijandar (1993–2006)", "", "", "", "manat azerbaijandar (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azerbaijandarra", PluralForms{"", "manat azerbaijandar", "", "", "", "manat azerbaijandar"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar bosnia-herzegovinarra (1992–1994)", PluralForms{"", "dinar bosnia-herzegovinar (1992–1994)", "", "", "", "dinar bosnia-herzegovinar (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marko bihurgarri bosniarra", PluralForms{"", "marko bihurgarri bosniar", "", "", "", "marko bihurgarri bosniar"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "dinar bosnia-herzegovinar berria (1994–1997)", PluralForms{"", "dinar bosnia-herzegovinar berri (1994–1997)", "", "", "", "dinar bosnia-herzegovinar berri (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dolar barbadostarra", PluralForms{"", "dolar barbadostar", "", "", "", "dolar barbadostar"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bangladeshtarra", PluralForms{"", "taka bangladeshtar", "", "", "", "taka bangladeshtar"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franko belgikarra (bihurgarria)", PluralForms{"", "franko belgikar (bihurgarria)", "", "", "", "franko belgikar (bihurgarria)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "franko belgikarra", PluralForms{"", "franko belgikar", "", "", "", "franko belgikar"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franko belgikarra (finantzarioa)", PluralForms{"", "franko belgikar (finantzarioa)", "", "", "", "franko belgikar (finantzarioa)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Lev bulgariar indartsua", PluralForms{"", "Lev bulgariar indartsu", "", "", "", "Lev bulgariar indartsu"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Lev bulgariar sozialista", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev bulgariarra", PluralForms{"", "lev bulgariar", "", "", "", "lev bulgariar"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Lev bulgariarra (1879–1952)", PluralForms{"", "Lev bulgariar (1879–1952)", "", "", "", "Lev bulgariar (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar bahraindarra", PluralForms{"", "dinar bahraindar", "", "", "", "dinar bahraindar"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franko burundiarra", PluralForms{"", "franko burundiar", "", "", "", "franko burundiar"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dolar bermudarra", PluralForms{"", "dolar bermudar", "", "", "", "dolar bermudar"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dolar bruneitarra", PluralForms{"", "dolar bruneitar", "", "", "", "dolar bruneitar"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano boliviarra", PluralForms{"", "boliviano boliviar", "", "", "", "boliviano boliviar"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "boliviano boliviarra (1863–1963)", PluralForms{"", "boliviano boliviar (1863–1963)", "", "", "", "boliviano boliviar (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso boliviarra", PluralForms{"", "peso boliviar", "", "", "", "peso boliviar"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "mvdol boliviarra", PluralForms{"", "mvdol boliviar", "", "", "", "mvdol boliviar"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "cruzeiro brasildar berria (1967–1986)", PluralForms{"", "cruzeiro brasildar berri (1967–1986)", "", "", "", "cruzeiro brasildar berri (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brasildarra (1986–1989)", PluralForms{"", "cruzado brasildar (1986–1989)", "", "", "", "cruzado brasildar (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brasildarra (1990–1993)", PluralForms{"", "cruzeiro brasildar (1990–1993)", "", "", "", "cruzeiro brasildar (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "erreal brasildarra", PluralForms{"", "erreal brasildar", "", "", "", "erreal brasildar"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "cruzado brasildar berria (1989–1990)", PluralForms{"", "cruzado brasildar berri (1989–1990)", "", "", "", "cruzado brasildar berri (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro brasildar berria (1993–1994)", PluralForms{"", "cruzeiro brasildar berri (1993–1994)", "", "", "", "cruzeiro brasildar berri (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "cruzeiro brasildarra (1942–1967)", PluralForms{"", "cruzeiro brasildar (1942–1967)", "", "", "", "cruzeiro brasildar (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dolar bahamarra", PluralForms{"", "dolar bahamar", "", "", "", "dolar bahamar"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum bhutandarra", PluralForms{"", "ngultrum bhutandar", "", "", "", "ngultrum bhutandar"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birmaniarra", PluralForms{"", "kyat birmaniar", "", "", "", "kyat birmaniar"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula botswanarra", PluralForms{"", "pula botswanar", "", "", "", "pula botswanar"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "errublo bielorrusiarra (1994–1999)", PluralForms{"", "errublo bielorrusiar (1994–1999)", "", "", "", "errublo bielorrusiar (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "errublo bielorrusiarra", PluralForms{"", "errublo bielorrusiar", "", "", "", "errublo bielorrusiar"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Bielorrusiako errubloa (2000–2016)", PluralForms{"", "Bielorrusiako errublo (2000–2016)", "", "", "", "Bielorrusiako errublo (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dolar belizetarra", PluralForms{"", "dolar belizetar", "", "", "", "dolar belizetar"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "dolar kanadarra", PluralForms{"", "dolar kanadar", "", "", "", "dolar kanadar"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franko kongoarra", PluralForms{"", "franko kongoar", "", "", "", "franko kongoar"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR euroa", PluralForms{"", "WIR euro", "", "", "", "WIR euro"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franko suitzarra", PluralForms{"", "franko suitzar", "", "", "", "franko suitzar"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR frankoa", PluralForms{"", "WIR franko", "", "", "", "WIR franko"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "ezkutu txiletarra", PluralForms{"", "ezkutu txiletar", "", "", "", "ezkutu txiletar"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "kontu-unitate txiletarra (UF)", PluralForms{"", "kontu-unitate txiletar (UF)", "", "", "", "kontu-unitate txiletar (UF)"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso txiletarra", PluralForms{"", "peso txiletar", "", "", "", "peso txiletar"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "yuan txinatarra (itsasoz haraindikoa)", PluralForms{"", "yuan txinatar (itsasoz haraindikoa)", "", "", "", "yuan txinatar (itsasoz haraindikoa)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Txinako Herri Bankuaren dolarra", PluralForms{"", "Txinako Herri Bankuaren dolar", "", "", "", "Txinako Herri Bankuaren dolar"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "yuan txinatarra", PluralForms{"", "yuan txinatar", "", "", "", "yuan txinatar"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso kolonbiarra", PluralForms{"", "peso kolonbiar", "", "", "", "peso kolonbiar"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "erreal kolonbiarraren balio-unitatea", PluralForms{"", "erreal kolonbiarraren balio-unitate", "", "", "", "erreal kolonbiarraren balio-unitate"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colon costarricarra", PluralForms{"", "colon costarricar", "", "", "", "colon costarricar"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "dinar serbiarra (2002–2006)", PluralForms{"", "dinar serbiar (2002–2006)", "", "", "", "dinar serbiar (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Txekoslovakiako koroa indartsua", PluralForms{"", "Txekoslovakiako koroa indartsu", "", "", "", "Txekoslovakiako koroa indartsu"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso bihurgarri kubatarra", PluralForms{"", "peso bihurgarri kubatar", "", "", "", "peso bihurgarri kubatar"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso kubatarra", PluralForms{"", "peso kubatar", "", "", "", "peso kubatar"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "ezkutu caboverdetarra", PluralForms{"", "ezkutu caboverdetar", "", "", "", "ezkutu caboverdetar"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "libera zipretarra", PluralForms{"", "libera zipretar", "", "", "", "libera zipretar"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "koroa txekiarra", PluralForms{"", "koroa txekiar", "", "", "", "koroa txekiar"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Ekialdeko Alemaniako markoa", PluralForms{"", "Ekialdeko Alemaniako marko", "", "", "", "Ekialdeko Alemaniako marko"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marko alemana", PluralForms{"", "marko aleman", "", "", "", "marko aleman"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franko djibutiarra", PluralForms{"", "franko djibutiar", "", "", "", "franko djibutiar"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "koroa danimarkarra", PluralForms{"", "koroa danimarkar", "", "", "", "koroa danimarkar"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominikarra", PluralForms{"", "peso dominikar", "", "", "", "peso dominikar"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar aljeriarra", PluralForms{"", "dinar aljeriar", "", "", "", "dinar aljeriar"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sukre ekuadortarra", PluralForms{"", "sukre ekuadortar", "", "", "", "sukre ekuadortar"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "balio-unitate konstante ekuadortarra", PluralForms{"", "balio-unitate konstante ekuadortar", "", "", "", "balio-unitate konstante ekuadortar"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "kroon estoniarra", PluralForms{"", "kroon estoniar", "", "", "", "kroon estoniar"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "libera egiptoarra", PluralForms{"", "libera egiptoar", "", "", "", "libera egiptoar"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritrearra", PluralForms{"", "nakfa eritrear", "", "", "", "nakfa eritrear"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "pezeta espainiarra (A kontua)", PluralForms{"", "pezeta espainiar (A kontua)", "", "", "", "pezeta espainiar (A kontua)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "pezeta espainiarra (kontu bihurgarria)", PluralForms{"", "pezeta espainiar (kontu bihurgarria)", "", "", "", "pezeta espainiar (kontu bihurgarria)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "₧", "₧", "pezeta espainiarra", PluralForms{"", "pezeta espainiar", "", "", "", "pezeta espainiar"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etiopiarra", PluralForms{"", "birr etiopiar", "", "", "", "birr etiopiar"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euroa", PluralForms{"", "euro", "", "", "", "euro"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "markka finlandiarra", PluralForms{"", "markka finlandiar", "", "", "", "markka finlandiar"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dolar fijiarra", PluralForms{"", "dolar fijiar", "", "", "", "dolar fijiar"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "libera falklandarra", PluralForms{"", "libera falklandar", "", "", "", "libera falklandar"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "libera frantsesa", PluralForms{"", "libera frantses", "", "", "", "libera frantses"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "libera esterlina", PluralForms{"", "", "", "", "", ""}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "kupon larit georgiarra", PluralForms{"", "kupon larit georgiar", "", "", "", "kupon larit georgiar"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari georgiarra", PluralForms{"", "lari georgiar", "", "", "", "lari georgiar"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi ghanatarra (1979–2007)", PluralForms{"", "cedi ghanatar (1979–2007)", "", "", "", "cedi ghanatar (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanatarra", PluralForms{"", "cedi ghanatar", "", "", "", "cedi ghanatar"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "libera gibraltartarra", PluralForms{"", "libera gibraltartar", "", "", "", "libera gibraltartar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambiarra", PluralForms{"", "dalasi gambiar", "", "", "", "dalasi gambiar"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franko ginearra", PluralForms{"", "franko ginear", "", "", "", "franko ginear"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli ginearra", PluralForms{"", "syli ginear", "", "", "", "syli ginear"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwele ekuatoreginearra", PluralForms{"", "ekwele ekuatoreginear", "", "", "", "ekwele ekuatoreginear"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "drakma greziarra", PluralForms{"", "drakma greziar", "", "", "", "drakma greziar"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ketzal guatemalarra", PluralForms{"", "ketzal guatemalar", "", "", "", "ketzal guatemalar"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Gineako ezkutu portugesa", PluralForms{"", "Gineako ezkutu portuges", "", "", "", "Gineako ezkutu portuges"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso gineabissautarra", PluralForms{"", "peso gineabissautar", "", "", "", "peso gineabissautar"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dolar guyanarra", PluralForms{"", "dolar guyanar", "", "", "", "dolar guyanar"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "dolar hongkongtarra", PluralForms{"", "dolar hongkongtar", "", "", "", "dolar hongkongtar"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira hodurastarra", PluralForms{"", "lempira hodurastar", "", "", "", "lempira hodurastar"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar kroaziarra", PluralForms{"", "dinar kroaziar", "", "", "", "dinar kroaziar"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna kroaziarra", PluralForms{"", "kuna kroaziar", "", "", "", "kuna kroaziar"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitiarra", PluralForms{"", "gourde haitiar", "", "", "", "gourde haitiar"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forint hungariarra", PluralForms{"", "forint hungariar", "", "", "", "forint hungariar"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "errupia indonesiarra", PluralForms{"", "errupia indonesiar", "", "", "", "errupia indonesiar"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "libera irlandarra", PluralForms{"", "libera irlandar", "", "", "", "libera irlandar"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "libera israeldarra", PluralForms{"", "libera israeldar", "", "", "", "libera israeldar"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "shekel israeldarra (1980–1985)", PluralForms{"", "shekel israeldar (1980–1985)", "", "", "", "shekel israeldar (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "shekel israeldar berria", PluralForms{"", "shekel israeldar berri", "", "", "", "shekel israeldar berri"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "errupia indiarra", PluralForms{"", "errupia indiar", "", "", "", "errupia indiar"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar irakiarra", PluralForms{"", "dinar irakiar", "", "", "", "dinar irakiar"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial irandarra", PluralForms{"", "rial irandar", "", "", "", "rial irandar"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "koroa islandiarra (1918–1981)", PluralForms{"", "koroa islandiar (1918–1981)", "", "", "", "koroa islandiar (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "koroa islandiarra", PluralForms{"", "koroa islandiar", "", "", "", "koroa islandiar"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "lira italiarra", PluralForms{"", "lira italiar", "", "", "", "lira italiar"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dolar jamaikarra", PluralForms{"", "dolar jamaikar", "", "", "", "dolar jamaikar"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordaniarra", PluralForms{"", "dinar jordaniar", "", "", "", "dinar jordaniar"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "yen japoniarra", PluralForms{"", "yen japoniar", "", "", "", "yen japoniar"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "txelin kenyarra", PluralForms{"", "txelin kenyar", "", "", "", "txelin kenyar"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirgizistandarra", PluralForms{"", "som kirgizistandar", "", "", "", "som kirgizistandar"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel kanbodiarra", PluralForms{"", "riel kanbodiar", "", "", "", "riel kanbodiar"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franko komoretarra", PluralForms{"", "franko komoretar", "", "", "", "franko komoretar"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won iparkorearra", PluralForms{"", "won iparkorear", "", "", "", "won iparkorear"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "hwan hegokorearra (1953–1962)", PluralForms{"", "hwan hegokorear (1953–1962)", "", "", "", "hwan hegokorear (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "won hegokorearra (1945–1953)", PluralForms{"", "won hegokorear (1945–1953)", "", "", "", "won hegokorear (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "won hegokorearra", PluralForms{"", "won hegokorear", "", "", "", "won hegokorear"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuwaitarra", PluralForms{"", "dinar kuwaitar", "", "", "", "dinar kuwaitar"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dolar kaimandarra", PluralForms{"", "dolar kaimandar", "", "", "", "dolar kaimandar"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazakhstandarra", PluralForms{"", "tenge kazakhstandar", "", "", "", "tenge kazakhstandar"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laostarra", PluralForms{"", "kip laostar", "", "", "", "kip laostar"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libera libanoarra", PluralForms{"", "libera libanoar", "", "", "", "libera libanoar"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "errupia srilankarra", PluralForms{"", "errupia srilankar", "", "", "", "errupia srilankar"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dolar liberiarra", PluralForms{"", "dolar liberiar", "", "", "", "dolar liberiar"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti lesothoarra", PluralForms{"", "loti lesothoar", "", "", "", "loti lesothoar"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Lituaniako litasa", PluralForms{"", "Lituaniako litas", "", "", "", "Lituaniako litas"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Lituaniako talonasa", PluralForms{"", "Lituaniako talonas", "", "", "", "Lituaniako talonas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luxenburgoko franko bihurgarria", PluralForms{"", "Luxenburgoko franko bihurgarri", "", "", "", "Luxenburgoko franko bihurgarri"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luxenburgoko frankoa", PluralForms{"", "Luxenburgoko franko", "", "", "", "Luxenburgoko franko"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luxenburgoko finantza-frankoa", PluralForms{"", "Luxenburgoko finantza-franko", "", "", "", "Luxenburgoko finantza-franko"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Letoniako latsa", PluralForms{"", "Letoniako lats", "", "", "", "Letoniako lats"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Letoniako errubloa", PluralForms{"", "Letoniako errublo", "", "", "", "Letoniako errublo"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libiarra", PluralForms{"", "dinar libiar", "", "", "", "dinar libiar"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marokoarra", PluralForms{"", "dirham marokoar", "", "", "", "dirham marokoar"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franko marokoarra", PluralForms{"", "franko marokoar", "", "", "", "franko marokoar"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monakoko frankoa", PluralForms{"", "Monakoko franko", "", "", "", "Monakoko franko"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "kupoi moldaviarra", PluralForms{"", "kupoi moldaviar", "", "", "", "kupoi moldaviar"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldaviarra", PluralForms{"", "leu moldaviar", "", "", "", "leu moldaviar"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary madagaskartarra", PluralForms{"", "ariary madagaskartar", "", "", "", "ariary madagaskartar"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franko malagasiarra", PluralForms{"", "franko malagasiar", "", "", "", "franko malagasiar"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinar mazedoniarra", PluralForms{"", "dinar mazedoniar", "", "", "", "dinar mazedoniar"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "dinar mazedoniarra (1992–1993)", PluralForms{"", "dinar mazedoniar (1992–1993)", "", "", "", "dinar mazedoniar (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franko maliarra", PluralForms{"", "franko maliar", "", "", "", "franko maliar"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat myanmartarra", PluralForms{"", "kyat myanmartar", "", "", "", "kyat myanmartar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongoliarra", PluralForms{"", "tugrik mongoliar", "", "", "", "tugrik mongoliar"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca macauarra", PluralForms{"", "pataca macauar", "", "", "", "pataca macauar"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritaniako ouguiya (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "uguiya mauritaniarra", PluralForms{"", "uguiya mauritaniar", "", "", "", "uguiya mauritaniar"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lira maltarra", PluralForms{"", "lira maltar", "", "", "", "lira maltar"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "libera maltar", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "errupia mauriziarra", PluralForms{"", "errupia mauriziar", "", "", "", "errupia mauriziar"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "errupia maldivarra (1947–1981)", PluralForms{"", "errupia maldivar (1947–1981)", "", "", "", "errupia maldivar (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rufiyaa maldivarra", PluralForms{"", "rufiyaa maldivar", "", "", "", "rufiyaa maldivar"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malawiarra", PluralForms{"", "kwacha malawiar", "", "", "", "kwacha malawiar"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "peso mexikarra", PluralForms{"", "peso mexikar", "", "", "", "peso mexikar"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Zilar-peso amerikarra (1861–1992)", PluralForms{"", "Zilar-peso amerikar (1861–1992)", "", "", "", "Zilar-peso amerikar (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Inbertsio-unitate mexikarra", PluralForms{"", "Inbertsio-unitate mexikar", "", "", "", "Inbertsio-unitate mexikar"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malaysiarra", PluralForms{"", "ringgit malaysiar", "", "", "", "ringgit malaysiar"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "ezkutu mozambiketarra", PluralForms{"", "ezkutu mozambiketar", "", "", "", "ezkutu mozambiketar"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "metikal mozambiketarra", PluralForms{"", "metikal mozambiketar", "", "", "", "metikal mozambiketar"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambiketarra", PluralForms{"", "metical mozambiketar", "", "", "", "metical mozambiketar"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dolar namibiarra", PluralForms{"", "dolar namibiar", "", "", "", "dolar namibiar"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeriarra", PluralForms{"", "naira nigeriar", "", "", "", "naira nigeriar"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "kordoba nikaraguar (1988–1991)", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "cordoba nikaraguarra", PluralForms{"", "cordoba nikaraguar", "", "", "", "cordoba nikaraguar"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "gilder herbeheretarra", PluralForms{"", "gilder herbeheretar", "", "", "", "gilder herbeheretar"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "koroa norvegiarra", PluralForms{"", "koroa norvegiar", "", "", "", "koroa norvegiar"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "errupia nepaldarra", PluralForms{"", "errupia nepaldar", "", "", "", "errupia nepaldar"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "dolar zeelandaberritarra", PluralForms{"", "dolar zeelandaberritar", "", "", "", "dolar zeelandaberritar"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omandarra", PluralForms{"", "rial omandar", "", "", "", "rial omandar"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panamarra", PluralForms{"", "balboa panamar", "", "", "", "balboa panamar"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti perutarra", PluralForms{"", "inti perutar", "", "", "", "inti perutar"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol perutarra", PluralForms{"", "sol perutar", "", "", "", "sol perutar"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol perutarra (1863–1965)", PluralForms{"", "sol perutar (1863–1965)", "", "", "", "sol perutar (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina gineaberriarra", PluralForms{"", "kina gineaberriar", "", "", "", "kina gineaberriar"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipinarra", PluralForms{"", "peso filipinar", "", "", "", "peso filipinar"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "errupia pakistandarra", PluralForms{"", "errupia pakistandar", "", "", "", "errupia pakistandar"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloty poloniarra", PluralForms{"", "zloty poloniar", "", "", "", "zloty poloniar"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "zloty poloniarra (1950–1995)", PluralForms{"", "zloty poloniar (PLZ)", "", "", "", "zloty poloniar (PLZ)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "ezkutu portugesa", PluralForms{"", "ezkutu portuges", "", "", "", "ezkutu portuges"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guarani paraguaitarra", PluralForms{"", "guarani paraguaitar", "", "", "", "guarani paraguaitar"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "riyal qatartarra", PluralForms{"", "riyal qatartar", "", "", "", "riyal qatartar"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dolar rhodesiarra", PluralForms{"", "dolar rhodesiar", "", "", "", "dolar rhodesiar"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "leu errumaniarra (1952–2006)", PluralForms{"", "leu errumaniar (1952–2006)", "", "", "", "leu errumaniar (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "leu errumaniarra", PluralForms{"", "leu errumaniar", "", "", "", "leu errumaniar"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serbiarra", PluralForms{"", "dinar serbiar", "", "", "", "dinar serbiar"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "errublo errusiarra", PluralForms{"", "errublo errusiar", "", "", "", "errublo errusiar"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "errublo errusiarra (1991–1998)", PluralForms{"", "errublo errusiar (1991–1998)", "", "", "", "errublo errusiar (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franko ruandarra", PluralForms{"", "franko ruandar", "", "", "", "franko ruandar"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "riyal saudiarabiarra", PluralForms{"", "riyal saudiarabiar", "", "", "", "riyal saudiarabiar"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dolar salomondarra", PluralForms{"", "dolar salomondar", "", "", "", "dolar salomondar"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "errupia seychelletarra", PluralForms{"", "errupia seychelletar", "", "", "", "errupia seychelletar"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar sudandarra (1992–2007)", PluralForms{"", "dinar sudandar (1992–2007)", "", "", "", "dinar sudandar (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "libera sudandarra", PluralForms{"", "libera sudandar", "", "", "", "libera sudandar"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "libera sudandarra (1957–1998)", PluralForms{"", "libera sudandar (1957–1998)", "", "", "", "libera sudandar (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "koroa suediarra", PluralForms{"", "koroa suediar", "", "", "", "koroa suediar"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dolar singapurtarra", PluralForms{"", "dolar singapurtar", "", "", "", "dolar singapurtar"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Santa Helenako libera", PluralForms{"", "", "", "", "", ""}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tolar esloveniarra", PluralForms{"", "tolar esloveniar", "", "", "", "tolar esloveniar"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "koroa eslovakiarra", PluralForms{"", "koroa eslovakiar", "", "", "", "koroa eslovakiar"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone sierraleonar berria", PluralForms{"", "leone sierraleonar berri", "", "", "", "leone sierraleonar berri"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone sierraleonarra", PluralForms{"", "leone sierraleonar", "", "", "", "leone sierraleonar"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "txelin somaliarra", PluralForms{"", "txelin somaliar", "", "", "", "txelin somaliar"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dolar surinamdarra", PluralForms{"", "dolar surinamdar", "", "", "", "dolar surinamdar"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "gilder surinamdarra", PluralForms{"", "gilder surinamdar", "", "", "", "gilder surinamdar"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "libera hegosudandarra", PluralForms{"", "libera hegosudandar", "", "", "", "libera hegosudandar"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Sao Tomeko eta Principeko dobra (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra saotometarra", PluralForms{"", "dobra saotometar", "", "", "", "dobra saotometar"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "errublo sovietarra", PluralForms{"", "errublo sovietar", "", "", "", "errublo sovietar"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "kolon salvadortarra", PluralForms{"", "kolon salvadortar", "", "", "", "kolon salvadortar"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "libera siriarra", PluralForms{"", "libera siriar", "", "", "", "libera siriar"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni swazilandiarra", PluralForms{"", "lilangeni swazilandiar", "", "", "", "lilangeni swazilandiar"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "baht thailandiarra", PluralForms{"", "baht thailandiar", "", "", "", "baht thailandiar"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "errublo tajikistandarra", PluralForms{"", "errublo tajikistandar", "", "", "", "errublo tajikistandar"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tajikistandarra", PluralForms{"", "somoni tajikistandar", "", "", "", "somoni tajikistandar"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turkmenistandarra (1993–2009)", PluralForms{"", "manat turkmenistandar (1993–2009)", "", "", "", "manat turkmenistandar (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turkmenistandarra", PluralForms{"", "manat turkmenistandar", "", "", "", "manat turkmenistandar"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunisiarra", PluralForms{"", "dinar tunisiar", "", "", "", "dinar tunisiar"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "paʻanga tongatarra", PluralForms{"", "paʻanga tongatar", "", "", "", "paʻanga tongatar"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "ezkutu timortarra", PluralForms{"", "ezkutu timortar", "", "", "", "ezkutu timortar"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "lira turkiarra (1922–2005)", PluralForms{"", "lira turkiar (1922–2005)", "", "", "", "lira turkiar (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turkiarra", PluralForms{"", "lira turkiar", "", "", "", "lira turkiar"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dolar trinitatearra", PluralForms{"", "dolar trinitatear", "", "", "", "dolar trinitatear"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "dolar taiwandar berria", PluralForms{"", "dolar taiwandar berri", "", "", "", "dolar taiwandar berri"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "txelin tanzaniarra", PluralForms{"", "txelin tanzaniar", "", "", "", "txelin tanzaniar"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hryvnia ukrainarra", PluralForms{"", "hryvnia ukrainar", "", "", "", "hryvnia ukrainar"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbovanets ukrainarra", PluralForms{"", "karbovanets ukrainar", "", "", "", "karbovanets ukrainar"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "txelin ugandarra (1966–1987)", PluralForms{"", "txelin ugandar (1966–1987)", "", "", "", "txelin ugandar (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "txelin ugandarra", PluralForms{"", "txelin ugandar", "", "", "", "txelin ugandar"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "dolar estatubatuarra", PluralForms{"", "dolar estatubatuar", "", "", "", "dolar estatubatuar"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dolar estatubatuar (Hurrengo eguna)", PluralForms{"", "dolar estatubatuar (hurrengo eguna)", "", "", "", "dolar estatubatuar (hurrengo eguna)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dolar estatubatuar (Egun berean)", PluralForms{"", "dolar estatubatuar (egun berean)", "", "", "", "dolar estatubatuar (egun berean)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "peso uruguaitarra (unitate indexatuak)", PluralForms{"", "peso uruguaitar (unitate indexatuak)", "", "", "", "peso uruguaitar (unitate indexatuak)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso uruguaitarra (1975–1993)", PluralForms{"", "peso uruguaitar (1975–1993)", "", "", "", "peso uruguaitar (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguaitarra", PluralForms{"", "peso uruguaitar", "", "", "", "peso uruguaitar"}},
+		"UYW": {4, 0, 4, 1, "UYW", "UYW", "UYW", "soldata nominalaren indize-unitate uruguaitarra", PluralForms{"", "soldata nominalaren indize-unitate uruguaitar", "", "", "", "soldata nominalaren indize-unitate uruguaitar"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "sum uzbekistandarra", PluralForms{"", "sum uzbekistandar", "", "", "", "sum uzbekistandar"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venezuelako bolivarra (1871–2008)", PluralForms{"", "Venezuelako bolivar (1871–2008)", "", "", "", "Venezuelako bolivar (1871–2008)"}},
+		"VED": {2, 0, 2, 1, "VED", "VED", "VED", "bolivar subiraua", PluralForms{"", "bolivar subirau", "", "", "", "bolivar subirau"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venezuelako bolivarra (2008–2018)", PluralForms{"", "Venezuelako bolivar (2008–2018)", "", "", "", "Venezuelako bolivar (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolivar venezuelarra", PluralForms{"", "bolivar venezuelar", "", "", "", "bolivar venezuelar"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "dong vietnamdarra", PluralForms{"", "dong vietnamdar", "", "", "", "dong vietnamdar"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "dong vietnamdar (1978–1985)", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu vanuatuarra", PluralForms{"", "vatu vanuatuar", "", "", "", "vatu vanuatuar"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoarra", PluralForms{"", "tala samoar", "", "", "", "tala samoar"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Afrika erdialdeko CFA frankoa", PluralForms{"", "Afrika erdialdeko CFA franko", "", "", "", "Afrika erdialdeko CFA franko"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "zilarra", PluralForms{"", "zilarrezko troy ontza", "", "", "", "zilarrezko troy ontza"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "urrea", PluralForms{"", "urrezko troy ontza", "", "", "", "urrezko troy ontza"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Europako unitate konposatua", PluralForms{"", "Europako unitate konposatu", "", "", "", "Europako unitate konposatu"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Europako moneta-unitatea", PluralForms{"", "Europako moneta-unitate", "", "", "", "Europako moneta-unitate"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Europako kontu-unitatea (XBC)", PluralForms{"", "Europako kontu-unitate (XBC)", "", "", "", "Europako kontu-unitate (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Europako kontu-unitatea (XBD)", PluralForms{"", "Europako kontu-unitate (XBD)", "", "", "", "Europako kontu-unitate (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "dolar ekikaribearra", PluralForms{"", "dolar ekikaribear", "", "", "", "dolar ekikaribear"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "igorpen-eskubide berezia", PluralForms{"", "igorpen-eskubide berezi", "", "", "", "igorpen-eskubide berezi"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Europako dibisa-unitatea", PluralForms{"", "Europako dibisa-unitate", "", "", "", "Europako dibisa-unitate"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "urrezko libera frantsesa", PluralForms{"", "urrezko libera frantses", "", "", "", "urrezko libera frantses"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "UIC libera frantsesa", PluralForms{"", "UIC libera frantses", "", "", "", "UIC libera frantses"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Afrika mendebaldeko CFA frankoa", PluralForms{"", "Afrika mendebaldeko CFA franko", "", "", "", "Afrika mendebaldeko CFA franko"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "paladioa", PluralForms{"", "paladiozko troy ontza", "", "", "", "paladiozko troy ontza"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP frankoa", PluralForms{"", "CFP franko", "", "", "", "CFP franko"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platinozko troy ontza", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET funtsak", PluralForms{"", "RINET funtsen unitate", "", "", "", "RINET funtsen unitate"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "sucrea", PluralForms{"", "sucre", "", "", "", "sucre"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "aztertzeko dibisa-unitatea", PluralForms{"", "aztertzeko dibisa-unitate", "", "", "", "aztertzeko dibisa-unitate"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "ADB kontu-unitatea", PluralForms{"", "ADB kontu-unitate", "", "", "", "ADB kontu-unitate"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "moneta ezezaguna", PluralForms{"", "(moneta ezezaguna)", "", "", "", "(moneta ezezaguna)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar yemendarra", PluralForms{"", "dinar yemendar", "", "", "", "dinar yemendar"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial yemendarra", PluralForms{"", "rial yemendar", "", "", "", "rial yemendar"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "dinar yugoslaviar indartsua (1966–1990)", PluralForms{"", "dinar yugoslaviar indartsu (1966–1990)", "", "", "", "dinar yugoslaviar indartsu (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "dinar yugoslaviar berria (1994–2002)", PluralForms{"", "dinar yugoslaviar berri (1994–2002)", "", "", "", "dinar yugoslaviar berri (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar yugoslaviar bihurgarria (1990–1992)", PluralForms{"", "dinar yugoslaviar bihurgarri (1990–1992)", "", "", "", "dinar yugoslaviar bihurgarri (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "dinar yugoslaviar erreformatua (1992–1993)", PluralForms{"", "dinar yugoslaviar erreformatu (1992–1993)", "", "", "", "dinar yugoslaviar erreformatu (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand hegoafrikarra (finantzarioa)", PluralForms{"", "rand hegoafrikar (finantzarioa)", "", "", "", "rand hegoafrikar (finantzarioa)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand hegoafrikarra", PluralForms{"", "rand hegoafrikar", "", "", "", "rand hegoafrikar"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambiako kwacha (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambiarra", PluralForms{"", "kwacha zambiar", "", "", "", "kwacha zambiar"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zaire berri zairetarra (1993–1998)", PluralForms{"", "zaire berri zairetar (1993–1998)", "", "", "", "zaire berri zairetar (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zaire zairetarra (1971–1993)", PluralForms{"", "zaire zairetar (1971–1993)", "", "", "", "zaire zairetar (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dolar zimbabwetarra (1980–2008)", PluralForms{"", "dolar zimbabwetar (1980–2008)", "", "", "", "dolar zimbabwetar (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dolar zimbabwetarra (2009)", PluralForms{"", "dolar zimbabwetar (2009)", "", "", "", "dolar zimbabwetar (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "dolar zimbabwetarra (2008)", PluralForms{"", "dolar zimbabwetar (2008)", "", "", "", "dolar zimbabwetar (2008)"}},
 	},
 }
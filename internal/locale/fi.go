@@ -6,314 +6,367 @@ var fi = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "epäluku",
+		"%", "‰",
+		"+", "−",
+		"E", "×",
+		".", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0t.", "other": "0\u00a0t."}},
+				{4, 2, map[string]string{"one": "0\u00a0t.", "other": "0\u00a0t."}},
+				{5, 3, map[string]string{"one": "0\u00a0t.", "other": "0\u00a0t."}},
+				{6, 1, map[string]string{"one": "0\u00a0milj.", "other": "0\u00a0milj."}},
+				{7, 2, map[string]string{"one": "0\u00a0milj.", "other": "0\u00a0milj."}},
+				{8, 3, map[string]string{"one": "0\u00a0milj.", "other": "0\u00a0milj."}},
+				{9, 1, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0bilj.", "other": "0\u00a0bilj."}},
+				{13, 2, map[string]string{"one": "0\u00a0bilj.", "other": "0\u00a0bilj."}},
+				{14, 3, map[string]string{"one": "0\u00a0bilj.", "other": "0\u00a0bilj."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 tuhat", "other": "0 tuhatta"}},
+				{4, 2, map[string]string{"one": "0 tuhatta", "other": "0 tuhatta"}},
+				{5, 3, map[string]string{"one": "0 tuhatta", "other": "0 tuhatta"}},
+				{6, 1, map[string]string{"one": "0 miljoona", "other": "0 miljoonaa"}},
+				{7, 2, map[string]string{"one": "0 miljoonaa", "other": "0 miljoonaa"}},
+				{8, 3, map[string]string{"one": "0 miljoonaa", "other": "0 miljoonaa"}},
+				{9, 1, map[string]string{"one": "0 miljardi", "other": "0 miljardia"}},
+				{10, 2, map[string]string{"one": "0 miljardia", "other": "0 miljardia"}},
+				{11, 3, map[string]string{"one": "0 miljardia", "other": "0 miljardia"}},
+				{12, 1, map[string]string{"one": "0 biljoona", "other": "0 biljoonaa"}},
+				{13, 2, map[string]string{"one": "0 biljoonaa", "other": "0 biljoonaa"}},
+				{14, 3, map[string]string{"one": "0 biljoonaa", "other": "0 biljoonaa"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0t.\u00a0¤", "other": "0\u00a0t.\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0t.\u00a0¤", "other": "0\u00a0t.\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0t.\u00a0¤", "other": "0\u00a0t.\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0milj.\u00a0¤", "other": "0\u00a0milj.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0milj.\u00a0¤", "other": "0\u00a0milj.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0milj.\u00a0¤", "other": "0\u00a0milj.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0bilj.\u00a0¤", "other": "0\u00a0bilj.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0bilj.\u00a0¤", "other": "0\u00a0bilj.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0bilj.\u00a0¤", "other": "0\u00a0bilj.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "vähintään {0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "AOA"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "ARS"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "AUD"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "BAM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "BBD"},
-		"BDT": {2, "BDT", "BDT", "BDT"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "BMD"},
-		"BND": {2, "BND", "BND", "BND"},
-		"BOB": {2, "BOB", "BOB", "BOB"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "BRL"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "BSD"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "BWP"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "BZD"},
-		"CAD": {2, "CAD", "CAD", "CAD"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "CLP"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "CNY"},
-		"COP": {0, "COP", "COP", "COP"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "CRC"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "CUC"},
-		"CUP": {2, "CUP", "CUP", "CUP"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "CZK"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "DKK"},
-		"DOP": {2, "DOP", "DOP", "DOP"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "EGP"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "ESP"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "mk", "mk"},
-		"FJD": {2, "FJD", "FJD", "FJD"},
-		"FKP": {2, "FKP", "FKP", "FKP"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "GEL"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "GIP"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "GNF"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "GTQ"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "GYD"},
-		"HKD": {2, "HKD", "HKD", "HKD"},
-		"HNL": {2, "HNL", "HNL", "HNL"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "HRK"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "HUF"},
-		"IDR": {0, "IDR", "IDR", "IDR"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "ILS", "ILS"},
-		"INR": {2, "INR", "INR", "INR"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "ISK"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "JMD"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "KHR"},
-		"KMF": {0, "KMF", "KMF", "KMF"},
-		"KPW": {0, "KPW", "KPW", "KPW"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "KRW"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "KYD"},
-		"KZT": {2, "KZT", "KZT", "KZT"},
-		"LAK": {0, "LAK", "LAK", "LAK"},
-		"LBP": {0, "LBP", "LBP", "LBP"},
-		"LKR": {2, "LKR", "LKR", "LKR"},
-		"LRD": {2, "LRD", "LRD", "LRD"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "LTL"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "LVL"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "MGA"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "MMK"},
-		"MNT": {2, "MNT", "MNT", "MNT"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "MUR"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "MXN"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "MYR"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "NAD"},
-		"NGN": {2, "NGN", "NGN", "NGN"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "NIO"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "NOK"},
-		"NPR": {2, "NPR", "NPR", "NPR"},
-		"NZD": {2, "NZD", "NZD", "NZD"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "PHP"},
-		"PKR": {0, "PKR", "PKR", "PKR"},
-		"PLN": {2, "PLN", "PLN", "PLN"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "PYG"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "RON"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RWF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "SBD"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "SEK"},
-		"SGD": {2, "SGD", "SGD", "SGD"},
-		"SHP": {2, "SHP", "SHP", "SHP"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "SRD"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "SSP"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "STD"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "SYP"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "THB"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "TOP"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "TRY"},
-		"TTD": {2, "TTD", "TTD", "TTD"},
-		"TWD": {2, "TWD", "TWD", "TWD"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "UAH"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "UYU"},
-		"UYW": {4, "UYW", "UYW", "UYW"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "VEF"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "VND"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "XCD"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "XPF", "XPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "ZAR"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZMW"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorran peseta", PluralForms{"", "Andorran peseta", "", "", "", "Andorran pesetaa"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Arabiemiirikuntien dirhami", PluralForms{"", "Arabiemiirikuntien dirhami", "", "", "", "Arabiemiirikuntien dirhamia"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afganistanin afgaani (1927–2002)", PluralForms{"", "Afganistanin afgaani (1927–2002)", "", "", "", "Afganistanin afgaania (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afganistanin afgaani", PluralForms{"", "Afganistanin afgaani", "", "", "", "Afganistanin afgaania"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albanian lek (1946–1965)", PluralForms{"", "Albanian lek (1946–1965)", "", "", "", "Albanian lekiä (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albanian lek", PluralForms{"", "Albanian lek", "", "", "", "Albanian lekiä"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armenian dram", PluralForms{"", "Armenian dram", "", "", "", "Armenian dramia"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Alankomaiden Antillien guldeni", PluralForms{"", "Alankomaiden Antillien guldeni", "", "", "", "Alankomaiden Antillien guldenia"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "AOA", "Angolan kwanza", PluralForms{"", "Angolan kwanza", "", "", "", "Angolan kwanzaa"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolan kwanza (1977–1991)", PluralForms{"", "Angolan kwanza (1977–1990)", "", "", "", "Angolan kwanzaa (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolan uusi kwanza (1990–2000)", PluralForms{"", "Angolan uusi kwanza (1990–2000)", "", "", "", "Angolan uutta kwanzaa (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolan kwanza reajustado (1995–1999)", PluralForms{"", "Angolan kwanza reajustado (1995–1999)", "", "", "", "Angolan kwanza reajustadoa (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentiinan austral", PluralForms{"", "Argentiinan austral", "", "", "", "Argentiinan australia"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Argentiinan ley-peso (1970–1983)", PluralForms{"", "Argentiinan ley-peso (1970–1983)", "", "", "", "Argentiinan ley-pesoa (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Argentiinan peso (1881–1970)", PluralForms{"", "Argentiinan peso (1881–1970)", "", "", "", "Argentiinan pesoa (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentiinan peso (1983–1985)", PluralForms{"", "Argentiinan peso (1983–1985)", "", "", "", "Argentiinan pesoa (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "ARS", "Argentiinan peso", PluralForms{"", "Argentiinan peso", "", "", "", "Argentiinan pesoa"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Itävallan šillinki", PluralForms{"", "Itävallan šillinki", "", "", "", "Itävallan šillinkiä"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "AUD", "Australian dollari", PluralForms{"", "Australian dollari", "", "", "", "Australian dollaria"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruban floriini", PluralForms{"", "Aruban floriini", "", "", "", "Aruban floriinia"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azerbaidžanin manat (1993–2006)", PluralForms{"", "Azerbaidžanin manat (1993–2006)", "", "", "", "Azerbaidžanin manatia (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbaidžanin manat", PluralForms{"", "Azerbaidžanin manat", "", "", "", "Azerbaidžanin manatia"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosnia-Hertsegovinan dinaari (1992–1994)", PluralForms{"", "Bosnia-Hertsegovinan dinaari (1992–1994)", "", "", "", "Bosnia-Hertsegovinan dinaaria (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "BAM", "Bosnia-Hertsegovinan vaihdettava markka", PluralForms{"", "Bosnia-Hertsegovinan vaihdettava markka", "", "", "", "Bosnia-Hertsegovinan vaihdettavaa markkaa"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Bosnia-Hertsegovinan uusi dinaari (1994–1997)", PluralForms{"", "Bosnia-Hertsegovinan uusi dinaari (1994–1997)", "", "", "", "Bosnia-Hertsegovinan uutta dinaaria (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "BBD", "Barbadosin dollari", PluralForms{"", "Barbadosin dollari", "", "", "", "Barbadosin dollaria"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "BDT", "Bangladeshin taka", PluralForms{"", "Bangladeshin taka", "", "", "", "Bangladeshin takaa"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgian vaihdettava frangi", PluralForms{"", "Belgian vaihdettava frangi", "", "", "", "Belgian vaihdettavaa frangia"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgian frangi", PluralForms{"", "Belgian frangi", "", "", "", "Belgian frangia"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgian rahoitusfrangi", PluralForms{"", "Belgian rahoitusfrangi", "", "", "", "Belgian rahoitusfrangia"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bulgarian kova lev", PluralForms{"", "Bulgarian kova lev", "", "", "", "Bulgarian kovaa leviä"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Bulgarian sosialistinen lev", PluralForms{"", "Bulgarian sosialistinen lev", "", "", "", "Bulgarian sosialistista leviä"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgarian lev", PluralForms{"", "Bulgarian lev", "", "", "", "Bulgarian leviä"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Bulgarian lev (1879–1952)", PluralForms{"", "Bulgarian lev (1879–1952)", "", "", "", "Bulgarian leviä (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahrainin dinaari", PluralForms{"", "Bahrainin dinaari", "", "", "", "Bahrainin dinaaria"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundin frangi", PluralForms{"", "Burundin frangi", "", "", "", "Burundin frangia"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "BMD", "Bermudan dollari", PluralForms{"", "Bermudan dollari", "", "", "", "Bermudan dollaria"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "BND", "Brunein dollari", PluralForms{"", "Brunein dollari", "", "", "", "Brunein dollaria"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "BOB", "Bolivian boliviano", PluralForms{"", "Bolivian boliviano", "", "", "", "Bolivian bolivianoa"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Bolivian boliviano (1863–1963)", PluralForms{"", "Bolivian boliviano (1863–1963)", "", "", "", "Bolivian bolivianoa (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bolivian peso", PluralForms{"", "Bolivian peso", "", "", "", "Bolivian pesoa"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Bolivian mvdol", PluralForms{"", "Bolivian mvdol", "", "", "", "Bolivian mvdol’ia"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brasilian uusi cruzeiro (1967–1986)", PluralForms{"", "Brasilian uusi cruzeiro (1967–1986)", "", "", "", "Brasilian uutta cruzeiroa (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brasilian cruzado (1986–1989)", PluralForms{"", "Brasilian cruzado (1986–1989)", "", "", "", "Brasilian cruzadoa (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brasilian cruzeiro (1990–1993)", PluralForms{"", "Brasilian cruzeiro (1990–1993)", "", "", "", "Brasilian cruzeiroa (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "BRL", "Brasilian real", PluralForms{"", "Brasilian real", "", "", "", "Brasilian realia"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brasilian uusi cruzado (1989–1990)", PluralForms{"", "Brasilian uusi cruzado (1989–1990)", "", "", "", "Brasilian uutta cruzadoa (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brasilian cruzeiro (1993–1994)", PluralForms{"", "Brasilian cruzeiro (1993–1994)", "", "", "", "Brasilian cruzeiroa (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Brasilian cruzeiro (1942–1967)", PluralForms{"", "Brasilian cruzeiro (1942–1967)", "", "", "", "Brasilian cruzeiroa (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "BSD", "Bahaman dollari", PluralForms{"", "Bahaman dollari", "", "", "", "Bahaman dollaria"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Bhutanin ngultrum", PluralForms{"", "Bhutanin ngultrum", "", "", "", "Bhutanin ngultrumia"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Burman kyat", PluralForms{"", "Burman kyat", "", "", "", "Burman kyatia"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "BWP", "Botswanan pula", PluralForms{"", "Botswanan pula", "", "", "", "Botswanan pulaa"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Valko-Venäjän uusi rupla (1994–1999)", PluralForms{"", "Valko-Venäjän uusi rupla (1994–1999)", "", "", "", "Valko-Venäjän uutta ruplaa (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Valko-Venäjän rupla", PluralForms{"", "Valko-Venäjän rupla", "", "", "", "Valko-Venäjän ruplaa"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Valko-Venäjän rupla (2000–2016)", PluralForms{"", "Valko-Venäjän rupla (2000–2016)", "", "", "", "Valko-Venäjän ruplaa (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "BZD", "Belizen dollari", PluralForms{"", "Belizen dollari", "", "", "", "Belizen dollaria"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "CAD", "Kanadan dollari", PluralForms{"", "Kanadan dollari", "", "", "", "Kanadan dollaria"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongon frangi", PluralForms{"", "Kongon frangi", "", "", "", "Kongon frangia"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Sveitsin WIR-euro", PluralForms{"", "Sveitsin WIR-euro", "", "", "", "Sveitsin WIR-euroa"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Sveitsin frangi", PluralForms{"", "Sveitsin frangi", "", "", "", "Sveitsin frangia"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Sveitsin WIR-frangi", PluralForms{"", "Sveitsin WIR-frangi", "", "", "", "Sveitsin WIR-frangia"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Chilen escudo", PluralForms{"", "Chilen escudo", "", "", "", "Chilen escudoa"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Chilen unidades de fomento", PluralForms{"", "Chilen unidades de fomento", "", "", "", "Chilen unidades de fomentoa"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "CLP", "Chilen peso", PluralForms{"", "Chilen peso", "", "", "", "Chilen pesoa"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Kiinan juan (offshore)", PluralForms{"", "Kiinan juan (offshore)", "", "", "", "Kiinan juania (offshore)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Kiinan kansanpankin dollari", PluralForms{"", "Kiinan kansanpankin dollari", "", "", "", "Kiinan kansanpankin dollaria"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "CNY", "Kiinan juan", PluralForms{"", "Kiinan juan", "", "", "", "Kiinan juania"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "COP", "Kolumbian peso", PluralForms{"", "Kolumbian peso", "", "", "", "Kolumbian pesoa"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Kolumbian unidad de valor real", PluralForms{"", "Kolumbian unidad de valor real", "", "", "", "Kolumbian unidad de valor realia"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "CRC", "Costa Rican colón", PluralForms{"", "Costa Rican colón", "", "", "", "Costa Rican colónia"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbian dinaari (2002–2006)", PluralForms{"", "Serbian dinaari (2002–2006)", "", "", "", "Serbian dinaaria (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Tšekkoslovakian kova koruna", PluralForms{"", "Tšekkoslovakian kova koruna", "", "", "", "Tšekkoslovakian kovaa korunaa"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "CUC", "Kuuban vaihdettava peso", PluralForms{"", "Kuuban vaihdettava peso", "", "", "", "Kuuban vaihdettavaa pesoa"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "CUP", "Kuuban peso", PluralForms{"", "Kuuban peso", "", "", "", "Kuuban pesoa"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kap Verden escudo", PluralForms{"", "Kap Verden escudo", "", "", "", "Kap Verden escudoa"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kyproksen punta", PluralForms{"", "Kyproksen punta", "", "", "", "Kyproksen puntaa"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "CZK", "Tšekin koruna", PluralForms{"", "Tšekin koruna", "", "", "", "Tšekin korunaa"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Itä-Saksan markka", PluralForms{"", "Itä-Saksan markka", "", "", "", "Itä-Saksan markkaa"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Saksan markka", PluralForms{"", "Saksan markka", "", "", "", "Saksan markkaa"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Djiboutin frangi", PluralForms{"", "Djiboutin frangi", "", "", "", "Djiboutin frangia"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "DKK", "Tanskan kruunu", PluralForms{"", "Tanskan kruunu", "", "", "", "Tanskan kruunua"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "DOP", "Dominikaanisen tasavallan peso", PluralForms{"", "Dominikaanisen tasavallan peso", "", "", "", "Dominikaanisen tasavallan pesoa"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Algerian dinaari", PluralForms{"", "Algerian dinaari", "", "", "", "Algerian dinaaria"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ecuadorin sucre", PluralForms{"", "Ecuadorin sucre", "", "", "", "Ecuadorin sucrea"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Ecuadorin UVC", PluralForms{"", "Ecuadorin UVC", "", "", "", "Ecuadorin UVC’ta"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Viron kruunu", PluralForms{"", "Viron kruunu", "", "", "", "Viron kruunua"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "EGP", "Egyptin punta", PluralForms{"", "Egyptin punta", "", "", "", "Egyptin puntaa"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrean nakfa", PluralForms{"", "Eritrean nakfa", "", "", "", "Eritrean nakfaa"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Espanjan peseta (A-tili)", PluralForms{"", "Espanjan peseta (A-tili)", "", "", "", "Espanjan pesetaa (A-tili)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Espanjan peseta (vaihdettava tili)", PluralForms{"", "Espanjan peseta (vaihdettava tili)", "", "", "", "Espanjan pesetaa (vaihdettava tili)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "ESP", "Espanjan peseta", PluralForms{"", "Espanjan peseta", "", "", "", "Espanjan pesetaa"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Etiopian birr", PluralForms{"", "Etiopian birr", "", "", "", "Etiopian birriä"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "euroa"}},
+		"FIM": {2, 0, 2, 1, "FIM", "mk", "mk", "Suomen markka", PluralForms{"", "Suomen markka", "", "", "", "Suomen markkaa"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "FJD", "Fidžin dollari", PluralForms{"", "Fidžin dollari", "", "", "", "Fidžin dollaria"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "FKP", "Falklandinsaarten punta", PluralForms{"", "Falklandinsaarten punta", "", "", "", "Falklandinsaarten puntaa"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Ranskan frangi", PluralForms{"", "Ranskan frangi", "", "", "", "Ranskan frangia"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Englannin punta", PluralForms{"", "Englannin punta", "", "", "", "Englannin puntaa"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Georgian kuponkilari", PluralForms{"", "Georgian kuponkilari", "", "", "", "Georgian kuponkilaria"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "GEL", "Georgian lari", PluralForms{"", "Georgian lari", "", "", "", "Georgian laria"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ghanan cedi (1979–2007)", PluralForms{"", "Ghanan cedi (1979–2007)", "", "", "", "Ghanan cediä (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ghanan cedi", PluralForms{"", "Ghanan cedi", "", "", "", "Ghanan cediä"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "GIP", "Gibraltarin punta", PluralForms{"", "Gibraltarin punta", "", "", "", "Gibraltarin puntaa"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambian dalasi", PluralForms{"", "Gambian dalasi", "", "", "", "Gambian dalasia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "GNF", "Guinean frangi", PluralForms{"", "Guinean frangi", "", "", "", "Guinean frangia"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guinean syli", PluralForms{"", "Guinean syli", "", "", "", "Guinean syliä"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Päiväntasaajan Guinean ekwele", PluralForms{"", "Päiväntasaajan Guinean ekwele", "", "", "", "Päiväntasaajan Guinean ekweleä"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Kreikan drakma", PluralForms{"", "Kreikan drakma", "", "", "", "Kreikan drakmaa"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "GTQ", "Guatemalan quetzal", PluralForms{"", "Guatemalan quetzal", "", "", "", "Guatemalan quetzalia"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugalin Guinean escudo", PluralForms{"", "Portugalin Guinean escudo", "", "", "", "Portugalin Guinean escudoa"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissaun peso", PluralForms{"", "Guinea-Bissaun peso", "", "", "", "Guinea-Bissaun pesoa"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "GYD", "Guyanan dollari", PluralForms{"", "Guyanan dollari", "", "", "", "Guyanan dollaria"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "HKD", "Hongkongin dollari", PluralForms{"", "Hongkongin dollari", "", "", "", "Hongkongin dollaria"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "HNL", "Hondurasin lempira", PluralForms{"", "Hondurasin lempira", "", "", "", "Hondurasin lempiraa"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Kroatian dinaari", PluralForms{"", "Kroatian dinaari", "", "", "", "Kroatian dinaaria"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "HRK", "Kroatian kuna", PluralForms{"", "Kroatian kuna", "", "", "", "Kroatian kunaa"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haitin gourde", PluralForms{"", "Haitin gourde", "", "", "", "Haitin gourdea"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "HUF", "Unkarin forintti", PluralForms{"", "Unkarin forintti", "", "", "", "Unkarin forinttia"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "IDR", "Indonesian rupia", PluralForms{"", "Indonesian rupia", "", "", "", "Indonesian rupiaa"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Irlannin punta", PluralForms{"", "Irlannin punta", "", "", "", "Irlannin puntaa"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Israelin punta", PluralForms{"", "Israelin punta", "", "", "", "Israelin puntaa"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Israelin sekeli (1980–1985)", PluralForms{"", "Israelin sekeli (1980–1985)", "", "", "", "Israelin sekeliä (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "ILS", "Israelin uusi sekeli", PluralForms{"", "Israelin uusi sekeli", "", "", "", "Israelin uutta sekeliä"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "INR", "Intian rupia", PluralForms{"", "Intian rupia", "", "", "", "Intian rupiaa"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irakin dinaari", PluralForms{"", "Irakin dinaari", "", "", "", "Irakin dinaaria"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Iranin rial", PluralForms{"", "Iranin rial", "", "", "", "Iranin rialia"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Islannin kruunu (1918–1981)", PluralForms{"", "Islannin kruunu (1918–1981)", "", "", "", "Islannin kruunua (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "ISK", "Islannin kruunu", PluralForms{"", "Islannin kruunu", "", "", "", "Islannin kruunua"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italian liira", PluralForms{"", "Italian liira", "", "", "", "Italian liiraa"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "JMD", "Jamaikan dollari", PluralForms{"", "Jamaikan dollari", "", "", "", "Jamaikan dollaria"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordanian dinaari", PluralForms{"", "Jordanian dinaari", "", "", "", "Jordanian dinaaria"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Japanin jeni", PluralForms{"", "Japanin jeni", "", "", "", "Japanin jeniä"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenian šillinki", PluralForms{"", "Kenian šillinki", "", "", "", "Kenian šillinkiä"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kirgisian som", PluralForms{"", "Kirgisian som", "", "", "", "Kirgisian somia"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "KHR", "Kambodžan riel", PluralForms{"", "Kambodžan riel", "", "", "", "Kambodžan rieliä"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "KMF", "Komorien frangi", PluralForms{"", "Komorien frangi", "", "", "", "Komorien frangia"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "KPW", "Pohjois-Korean won", PluralForms{"", "Pohjois-Korean won", "", "", "", "Pohjois-Korean wonia"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Etelä-Korean hwan (1953–1962)", PluralForms{"", "Etelä-Korean hwan (1953–1962)", "", "", "", "Etelä-Korean hwania (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Etelä-Korean won (1945–1953)", PluralForms{"", "Etelä-Korean won (1945–1953)", "", "", "", "Etelä-Korean wonia (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "KRW", "Etelä-Korean won", PluralForms{"", "Etelä-Korean won", "", "", "", "Etelä-Korean wonia"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuwaitin dinaari", PluralForms{"", "Kuwaitin dinaari", "", "", "", "Kuwaitin dinaaria"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "KYD", "Caymansaarten dollari", PluralForms{"", "Caymansaarten dollari", "", "", "", "Caymansaarten dollaria"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "KZT", "Kazakstanin tenge", PluralForms{"", "Kazakstanin tenge", "", "", "", "Kazakstanin tengeä"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "LAK", "Laosin kip", PluralForms{"", "Laosin kip", "", "", "", "Laosin kipiä"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "LBP", "Libanonin punta", PluralForms{"", "Libanonin punta", "", "", "", "Libanonin puntaa"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "LKR", "Sri Lankan rupia", PluralForms{"", "Sri Lankan rupia", "", "", "", "Sri Lankan rupiaa"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "LRD", "Liberian dollari", PluralForms{"", "Liberian dollari", "", "", "", "Liberian dollaria"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesothon loti", PluralForms{"", "Lesothon loti", "", "", "", "Lesothon lotia"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "LTL", "Liettuan liti", PluralForms{"", "Liettuan liti", "", "", "", "Liettuan litiä"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Liettuan talonas", PluralForms{"", "Liettuan talonas", "", "", "", "Liettuan talonasia"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luxemburgin vaihdettava frangi", PluralForms{"", "Luxemburgin vaihdettava frangi", "", "", "", "Luxemburgin vaihdettavaa frangia"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luxemburgin frangi", PluralForms{"", "Luxemburgin frangi", "", "", "", "Luxemburgin frangia"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luxemburgin rahoitusfrangi", PluralForms{"", "Luxemburgin rahoitusfrangi", "", "", "", "Luxemburgin rahoitusfrangia"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "LVL", "Latvian lati", PluralForms{"", "Latvian lati", "", "", "", "Latvian latia"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latvian rupla", PluralForms{"", "Latvian rupla", "", "", "", "Latvian ruplaa"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libyan dinaari", PluralForms{"", "Libyan dinaari", "", "", "", "Libyan dinaaria"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Marokon dirhami", PluralForms{"", "Marokon dirhami", "", "", "", "Marokon dirhamia"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Marokon frangi", PluralForms{"", "Marokon frangi", "", "", "", "Marokon frangia"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monacon frangi", PluralForms{"", "Monacon frangi", "", "", "", "Monacon frangia"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Moldovan kuponkileu", PluralForms{"", "Moldovan kuponkileu", "", "", "", "Moldovan kuponkileuta"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldovan leu", PluralForms{"", "Moldovan leu", "", "", "", "Moldovan leuta"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "MGA", "Madagaskarin ariary", PluralForms{"", "Madagaskarin ariary", "", "", "", "Madagaskarin ariarya"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madagaskarin frangi", PluralForms{"", "Madagaskarin frangi", "", "", "", "Madagaskarin frangia"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Makedonian denaari", PluralForms{"", "Makedonian denaari", "", "", "", "Makedonian denaaria"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Makedonian dinaari (1992–1993)", PluralForms{"", "Makedonian dinaari (1992–1993)", "", "", "", "Makedonian dinaaria (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malin frangi", PluralForms{"", "Malin frangi", "", "", "", "Malin frangia"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "MMK", "Myanmarin kyat", PluralForms{"", "Myanmarin kyat", "", "", "", "Myanmarin kyatia"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "MNT", "Mongolian tugrik", PluralForms{"", "Mongolian tugrik", "", "", "", "Mongolian tugrikia"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Macaon pataca", PluralForms{"", "Macaon pataca", "", "", "", "Macaon patacaa"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritanian ouguiya (1973–2017)", PluralForms{"", "Mauritanian ouguiya (1973–2017)", "", "", "", "Mauritanian ouguiyaa (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauritanian ouguiya", PluralForms{"", "Mauritanian ouguiya", "", "", "", "Mauritanian ouguiyaa"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Maltan liira", PluralForms{"", "Maltan liira", "", "", "", "Maltan liiraa"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltan punta", PluralForms{"", "Maltan punta", "", "", "", "Maltan puntaa"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "MUR", "Mauritiuksen rupia", PluralForms{"", "Mauritiuksen rupia", "", "", "", "Mauritiuksen rupiaa"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Malediivien rupia (1947–1981)", PluralForms{"", "Malediivien rupia (1947–1981)", "", "", "", "Malediivien rupiaa (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Malediivien rufiyaa", PluralForms{"", "Malediivien rufiyaa", "", "", "", "Malediivien rufiyaata"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawin kwacha", PluralForms{"", "Malawin kwacha", "", "", "", "Malawin kwachaa"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "MXN", "Meksikon peso", PluralForms{"", "Meksikon peso", "", "", "", "Meksikon pesoa"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Meksikon hopeapeso (1861–1992)", PluralForms{"", "Meksikon hopeapeso (1861–1992)", "", "", "", "Meksikon hopeapesoa (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Meksikon UDI", PluralForms{"", "Meksikon UDI", "", "", "", "Meksikon UDI’ta"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "MYR", "Malesian ringgit", PluralForms{"", "Malesian ringgit", "", "", "", "Malesian ringgitiä"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mosambikin escudo", PluralForms{"", "Mosambikin escudo", "", "", "", "Mosambikin escudoa"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mosambikin metical (1980–2006)", PluralForms{"", "Mosambikin metical (1980–2006)", "", "", "", "Mosambikin meticalia (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mosambikin metical", PluralForms{"", "Mosambikin metical", "", "", "", "Mosambikin meticalia"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "NAD", "Namibian dollari", PluralForms{"", "Namibian dollari", "", "", "", "Namibian dollaria"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "NGN", "Nigerian naira", PluralForms{"", "Nigerian naira", "", "", "", "Nigerian nairaa"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nicaraguan córdoba (1988–1991)", PluralForms{"", "Nicaraguan córdoba (1988–1991)", "", "", "", "Nicaraguan córdobaa (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "NIO", "Nicaraguan córdoba", PluralForms{"", "Nicaraguan córdoba", "", "", "", "Nicaraguan córdobaa"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Alankomaiden guldeni", PluralForms{"", "Alankomaiden guldeni", "", "", "", "Alankomaiden guldenia"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "NOK", "Norjan kruunu", PluralForms{"", "Norjan kruunu", "", "", "", "Norjan kruunua"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "NPR", "Nepalin rupia", PluralForms{"", "Nepalin rupia", "", "", "", "Nepalin rupiaa"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "NZD", "Uuden-Seelannin dollari", PluralForms{"", "Uuden-Seelannin dollari", "", "", "", "Uuden-Seelannin dollaria"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omanin rial", PluralForms{"", "Omanin rial", "", "", "", "Omanin rialia"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panaman balboa", PluralForms{"", "Panaman balboa", "", "", "", "Panaman balboaa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Perun inti", PluralForms{"", "Perun inti", "", "", "", "Perun intiä"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Perun sol", PluralForms{"", "Perun sol", "", "", "", "Perun solia"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Perun sol (1863–1965)", PluralForms{"", "Perun sol (1863–1965)", "", "", "", "Perun solia (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua-Uuden-Guinean kina", PluralForms{"", "Papua-Uuden-Guinean kina", "", "", "", "Papua-Uuden-Guinean kinaa"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "PHP", "Filippiinien peso", PluralForms{"", "Filippiinien peso", "", "", "", "Filippiinien pesoa"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "PKR", "Pakistanin rupia", PluralForms{"", "Pakistanin rupia", "", "", "", "Pakistanin rupiaa"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "PLN", "Puolan złoty", PluralForms{"", "Puolan złoty", "", "", "", "Puolan złotya"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Puolan złoty (1950–1995)", PluralForms{"", "Puolan złoty (1950–1995)", "", "", "", "Puolan złotya (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugalin escudo", PluralForms{"", "Portugalin escudo", "", "", "", "Portugalin escudoa"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "PYG", "Paraguayn guarani", PluralForms{"", "Paraguayn guarani", "", "", "", "Paraguayn guarania"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Qatarin rial", PluralForms{"", "Qatarin rial", "", "", "", "Qatarin rialia"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rhodesian dollari", PluralForms{"", "Rhodesian dollari", "", "", "", "Rhodesian dollaria"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Romanian leu (1952–2006)", PluralForms{"", "Romanian leu (1952–2006)", "", "", "", "Romanian leuta (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "RON", "Romanian leu", PluralForms{"", "Romanian leu", "", "", "", "Romanian leuta"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbian dinaari", PluralForms{"", "Serbian dinaari", "", "", "", "Serbian dinaaria"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Venäjän rupla", PluralForms{"", "Venäjän rupla", "", "", "", "Venäjän ruplaa"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Venäjän rupla (1991–1998)", PluralForms{"", "Venäjän rupla (1991–1998)", "", "", "", "Venäjän ruplaa (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RWF", "Ruandan frangi", PluralForms{"", "Ruandan frangi", "", "", "", "Ruandan frangia"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudi-Arabian rial", PluralForms{"", "Saudi-Arabian rial", "", "", "", "Saudi-Arabian rialia"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "SBD", "Salomonsaarten dollari", PluralForms{"", "Salomonsaarten dollari", "", "", "", "Salomonsaarten dollaria"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seychellien rupia", PluralForms{"", "Seychellien rupia", "", "", "", "Seychellien rupiaa"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudanin dinaari (1992–2007)", PluralForms{"", "Sudanin dinaari (1992–2007)", "", "", "", "Sudanin dinaaria (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudanin punta", PluralForms{"", "Sudanin punta", "", "", "", "Sudanin puntaa"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudanin punta (1957–1998)", PluralForms{"", "Sudanin punta (1957–1998)", "", "", "", "Sudanin puntaa (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "SEK", "Ruotsin kruunu", PluralForms{"", "Ruotsin kruunu", "", "", "", "Ruotsin kruunua"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "SGD", "Singaporen dollari", PluralForms{"", "Singaporen dollari", "", "", "", "Singaporen dollaria"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "SHP", "Saint Helenan punta", PluralForms{"", "Saint Helenan punta", "", "", "", "Saint Helenan puntaa"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovenian tolar", PluralForms{"", "Slovenian tolar", "", "", "", "Slovenian tolaria"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovakian koruna", PluralForms{"", "Slovakian koruna", "", "", "", "Slovakian korunaa"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sierra Leonen leone", PluralForms{"", "Sierra Leonen leone", "", "", "", "Sierra Leonen leonea"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sierra Leonen leone (1964–2022)", PluralForms{"", "Sierra Leonen leone (1964–2022)", "", "", "", "Sierra Leonen leonea (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somalian šillinki", PluralForms{"", "Somalian šillinki", "", "", "", "Somalian šillinkiä"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "SRD", "Surinamen dollari", PluralForms{"", "Surinamen dollari", "", "", "", "Surinamen dollaria"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surinamen guldeni", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "SSP", "Etelä-Sudanin punta", PluralForms{"", "Etelä-Sudanin punta", "", "", "", "Etelä-Sudanin puntaa"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "São Tomén ja Príncipen dobra (1977–2017)", PluralForms{"", "São Tomén ja Príncipen dobra (1977–2017)", "", "", "", "São Tomén ja Príncipen dobraa (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "STD", "São Tomén ja Príncipen dobra", PluralForms{"", "São Tomén ja Príncipen dobra", "", "", "", "São Tomén ja Príncipen dobraa"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Neuvostoliiton rupla", PluralForms{"", "Neuvostoliiton rupla", "", "", "", "Neuvostoliiton ruplaa"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "El Salvadorin colón", PluralForms{"", "El Salvadorin colón", "", "", "", "El Salvadorin colónia"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "SYP", "Syyrian punta", PluralForms{"", "Syyrian punta", "", "", "", "Syyrian puntaa"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Swazimaan lilangeni", PluralForms{"", "Swazimaan lilangeni", "", "", "", "Swazimaan lilangenia"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "THB", "Thaimaan baht", PluralForms{"", "Thaimaan baht", "", "", "", "Thaimaan bahtia"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadžikistanin rupla", PluralForms{"", "Tadžikistanin rupla", "", "", "", "Tadžikistanin ruplaa"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadžikistanin somoni", PluralForms{"", "Tadžikistanin somoni", "", "", "", "Tadžikistanin somonia"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmenistanin manat (1993–2009)", PluralForms{"", "Turkmenistanin manat (1993–2009)", "", "", "", "Turkmenistanin manatia (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmenistanin manat", PluralForms{"", "Turkmenistanin manat", "", "", "", "Turkmenistanin manatia"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunisian dinaari", PluralForms{"", "Tunisian dinaari", "", "", "", "Tunisian dinaaria"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "TOP", "Tongan pa’anga", PluralForms{"", "Tongan pa’anga", "", "", "", "Tongan pa’angaa"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timorin escudo", PluralForms{"", "Timorin escudo", "", "", "", "Timorin escudoa"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turkin liira (1922–2005)", PluralForms{"", "Turkin liira (1922–2005)", "", "", "", "Turkin liiraa (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "TRY", "Turkin liira", PluralForms{"", "Turkin liira", "", "", "", "Turkin liiraa"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "TTD", "Trinidadin ja Tobagon dollari", PluralForms{"", "Trinidadin ja Tobagon dollari", "", "", "", "Trinidadin ja Tobagon dollaria"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "TWD", "Taiwanin uusi dollari", PluralForms{"", "Taiwanin uusi dollari", "", "", "", "Taiwanin uutta dollaria"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tansanian šillinki", PluralForms{"", "Tansanian šillinki", "", "", "", "Tansanian šillinkiä"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "UAH", "Ukrainan hryvnia", PluralForms{"", "Ukrainan hryvnia", "", "", "", "Ukrainan hryvniaa"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrainan karbovanetz", PluralForms{"", "Ukrainan karbovanetz", "", "", "", "Ukrainan karbovanetzia"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Ugandan šillinki (1966–1987)", PluralForms{"", "Ugandan šillinki (1966–1987)", "", "", "", "Ugandan šillinkiä (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ugandan šillinki", PluralForms{"", "Ugandan šillinki", "", "", "", "Ugandan šillinkiä"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Yhdysvaltain dollari", PluralForms{"", "Yhdysvaltain dollari", "", "", "", "Yhdysvaltain dollaria"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Yhdysvaltain dollari (seuraava päivä)", PluralForms{"", "Yhdysvaltain dollari (seuraava päivä)", "", "", "", "Yhdysvaltain dollaria (seuraava päivä)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Yhdysvaltain dollari (sama päivä)", PluralForms{"", "Yhdysvaltain dollari (sama päivä)", "", "", "", "Yhdysvaltain dollaria (sama päivä)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Uruguayn peso en unidades indexadas", PluralForms{"", "Uruguayn peso en unidades indexadas", "", "", "", "Uruguayn pesoa en unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Uruguayn peso (1975–1993)", PluralForms{"", "Uruguayn peso (1975–1993)", "", "", "", "Uruguayn pesoa (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "UYU", "Uruguayn peso", PluralForms{"", "Uruguayn peso", "", "", "", "Uruguayn pesoa"}},
+		"UYW": {4, 0, 4, 1, "UYW", "UYW", "UYW", "Uruguayn nimellinen palkkaindeksiyksikkö", PluralForms{"", "Uruguayn nimellinen palkkaindeksiyksikkö", "", "", "", "Uruguayn nimellistä palkkaindeksiyksikköä"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Uzbekistanin som", PluralForms{"", "Uzbekistanin som", "", "", "", "Uzbekistanin somia"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venezuelan bolívar (1871–2008)", PluralForms{"", "Venezuelan bolívar (1871–2008)", "", "", "", "Venezuelan bolívaria (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "VEF", "Venezuelan bolívar (2008–2018)", PluralForms{"", "Venezuelan bolívar (2008–2018)", "", "", "", "Venezuelan bolívaria (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venezuelan suvereeni bolívar", PluralForms{"", "Venezuelan suvereeni bolívar", "", "", "", "Venezuelan suvereenia bolívaria"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "VND", "Vietnamin dong", PluralForms{"", "Vietnamin dong", "", "", "", "Vietnamin dongia"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vietnamin dong (1978–1985)", PluralForms{"", "Vietnamin dong (1978–1985)", "", "", "", "Vietnamin dongia (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatun vatu", PluralForms{"", "Vanuatun vatu", "", "", "", "Vanuatun vatua"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoan tala", PluralForms{"", "Samoan tala", "", "", "", "Samoan talaa"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "CFA-frangi BEAC", PluralForms{"", "CFA-frangi BEAC", "", "", "", "CFA-frangia BEAC"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "hopea", PluralForms{"", "troy-unssi hopeaa", "", "", "", "troy-unssia hopeaa"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "kulta", PluralForms{"", "troy-unssi kultaa", "", "", "", "troy-unssia kultaa"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "EURCO", PluralForms{"", "EURCO", "", "", "", "EURCO’a"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Euroopan rahayksikkö (EMU)", PluralForms{"", "Euroopan rahayksikkö (EMU)", "", "", "", "Euroopan rahayksikköä (EMU)"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "EUA (XBC)", PluralForms{"", "EUA (XBC)", "", "", "", "EUA’ta (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "EUA (XBD)", PluralForms{"", "EUA (XBD)", "", "", "", "EUA’ta (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "XCD", "Itä-Karibian dollari", PluralForms{"", "Itä-Karibian dollari", "", "", "", "Itä-Karibian dollaria"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "erityisnosto-oikeus (SDR)", PluralForms{"", "erityisnosto-oikeus (SDR)", "", "", "", "erityisnosto-oikeutta (SDR)"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Euroopan valuuttayksikkö (ECU)", PluralForms{"", "Euroopan valuuttayksikkö (ECU)", "", "", "", "Euroopan valuuttayksikköä (ECU)"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Ranskan kultafrangi", PluralForms{"", "Ranskan kultafrangi", "", "", "", "Ranskan kultafrangia"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Ranskan UIC-frangi", PluralForms{"", "Ranskan UIC-frangi", "", "", "", "Ranskan UIC-frangia"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "CFA-frangi BCEAO", PluralForms{"", "CFA-frangi BCEAO", "", "", "", "CFA-frangia BCEAO"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladium", PluralForms{"", "troy-unssi palladiumia", "", "", "", "troy-unssia palladiumia"}},
+		"XPF": {0, 0, 0, 1, "XPF", "XPF", "XPF", "CFP-frangi", PluralForms{"", "CFP-frangi", "", "", "", "CFP-frangia"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "troy-unssi platinaa", "", "", "", "troy-unssia platinaa"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET-rahastot", PluralForms{"", "RINET-rahastoyksikkö", "", "", "", "RINET-rahastoyksikköä"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "etelä-amerikkalaisen ALBA:n laskentayksikkö sucre", PluralForms{"", "sucre", "", "", "", "sucrea"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "testaustarkoitukseen varattu valuuttakoodi", PluralForms{"", "testaustarkoitukseen varattu valuuttakoodi", "", "", "", "testaustarkoitukseen varattua valuuttakoodia"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "afrikkalainen AfDB-laskentayksikkö", PluralForms{"", "AfDB-laskentayksikkö", "", "", "", "AfDB-laskentayksikköä"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "tuntematon rahayksikkö", PluralForms{"", "tuntematon rahayksikkö", "", "", "", "tuntematonta rahayksikköä"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jemenin dinaari", PluralForms{"", "Jemenin dinaari", "", "", "", "Jemenin dinaaria"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jemenin rial", PluralForms{"", "Jemenin rial", "", "", "", "Jemenin rialia"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslavian kova dinaari (1966–1990)", PluralForms{"", "Jugoslavian kova dinaari (1966–1990)", "", "", "", "Jugoslavian kovaa dinaaria (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslavian uusi dinaari (1994–2002)", PluralForms{"", "Jugoslavian uusi dinaari (1994–2002)", "", "", "", "Jugoslavian uutta dinaaria (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslavian vaihdettava dinaari (1990–1992)", PluralForms{"", "Jugoslavian vaihdettava dinaari (1990–1992)", "", "", "", "Jugoslavian vaihdettavaa dinaaria (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Jugoslavian uudistettu dinaari (1992–1993)", PluralForms{"", "Jugoslavian uudistettu dinaari (1992–1993)", "", "", "", "Jugoslavian uudistettua dinaaria (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Etelä-Afrikan rahoitusrandi", PluralForms{"", "Etelä-Afrikan rahoitusrandi", "", "", "", "Etelä-Afrikan rahoitusrandia"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "ZAR", "Etelä-Afrikan randi", PluralForms{"", "Etelä-Afrikan randi", "", "", "", "Etelä-Afrikan randia"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Sambian kwacha (1968–2012)", PluralForms{"", "Sambian kwacha (1968–2012)", "", "", "", "Sambian kwachaa (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZMW", "Sambian kwacha", PluralForms{"", "Sambian kwacha", "", "", "", "Sambian kwachaa"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zairen uusi zaire (1993–1998)", PluralForms{"", "Zairen uusi zaire (1993–1998)", "", "", "", "Zairen uutta zairea (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zairen zaire (1971–1993)", PluralForms{"", "Zairen zaire (1971–1993)", "", "", "", "Zairen zairea (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwen dollari (1980–2008)", PluralForms{"", "Zimbabwen dollari (1980–2008)", "", "", "", "Zimbabwen dollaria (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabwen dollari (2009)", PluralForms{"", "Zimbabwen dollari (2009)", "", "", "", "Zimbabwen dollaria (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabwen dollari (2008)", PluralForms{"", "Zimbabwen dollari (2008)", "", "", "", "Zimbabwen dollaria (2008)"}},
 	},
 }
@@ -6,292 +6,345 @@ var frBJ = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u202f",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "≃",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{4, 2, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{5, 3, map[string]string{"one": "0\u00a0k", "other": "0\u00a0k"}},
+				{6, 1, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{7, 2, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{8, 3, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{9, 1, map[string]string{"one": "0\u00a0Md", "other": "0\u00a0Md"}},
+				{10, 2, map[string]string{"one": "0\u00a0Md", "other": "0\u00a0Md"}},
+				{11, 3, map[string]string{"one": "0\u00a0Md", "other": "0\u00a0Md"}},
+				{12, 1, map[string]string{"one": "0\u00a0Bn", "other": "0\u00a0Bn"}},
+				{13, 2, map[string]string{"one": "0\u00a0Bn", "other": "0\u00a0Bn"}},
+				{14, 3, map[string]string{"one": "0\u00a0Bn", "other": "0\u00a0Bn"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"1": "mille", "one": "0 millier", "other": "0 mille"}},
+				{4, 2, map[string]string{"one": "0 mille", "other": "0 mille"}},
+				{5, 3, map[string]string{"one": "0 mille", "other": "0 mille"}},
+				{6, 1, map[string]string{"one": "0 million", "other": "0 millions"}},
+				{7, 2, map[string]string{"one": "0 million", "other": "0 millions"}},
+				{8, 3, map[string]string{"one": "0 million", "other": "0 millions"}},
+				{9, 1, map[string]string{"one": "0 milliard", "other": "0 milliards"}},
+				{10, 2, map[string]string{"one": "0 milliard", "other": "0 milliards"}},
+				{11, 3, map[string]string{"one": "0 milliard", "other": "0 milliards"}},
+				{12, 1, map[string]string{"one": "0 billion", "other": "0 billions"}},
+				{13, 2, map[string]string{"one": "0 billion", "other": "0 billions"}},
+				{14, 3, map[string]string{"one": "0 billion", "other": "0 billions"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0k\u00a0¤", "other": "0\u00a0k\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0k\u00a0¤", "other": "0\u00a0k\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0k\u00a0¤", "other": "0\u00a0k\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0Md\u00a0¤", "other": "0\u00a0Md\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0Md\u00a0¤", "other": "0\u00a0Md\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0Md\u00a0¤", "other": "0\u00a0Md\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0Bn\u00a0¤", "other": "0\u00a0Bn\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0Bn\u00a0¤", "other": "0\u00a0Bn\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0Bn\u00a0¤", "other": "0\u00a0Bn\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "$AR", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "$AU", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "FB", "FB"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "$BM", "$"},
-		"BND": {2, "BND", "$BN", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "$BZ", "$"},
-		"CAD": {2, "CAD", "$CA", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "$CL", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "$CO", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "£CY", "£CY"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "£E"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "$FJ", "$"},
-		"FKP": {2, "FKP", "£FK", "£"},
-		"FRF": {2, "FRF", "F", "F"},
-		"GBP": {2, "GBP", "£GB", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "£GI", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "£IE", "£IE"},
-		"ILP": {2, "ILP", "£IL", "£IL"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "₤IT", "₤IT"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "FC"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "£LB", "£L"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "£MT", "£MT"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "$MX", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "$NA", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "$C"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "$NZ", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "$RH", "$RH"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "FR"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "$SB", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "$SG", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "$SR", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "$T"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "$TT", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$US", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "$UY", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "$WS", "$WS"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "FCFP", "FCFP"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "Kw"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "peseta andorrane", PluralForms{"", "peseta andorrane", "", "", "", "pesetas andorranes"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham des Émirats arabes unis", PluralForms{"", "dirham des Émirats arabes unis", "", "", "", "dirhams des Émirats arabes unis"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afghani (1927–2002)", PluralForms{"", "afghani (1927–2002)", "", "", "", "afghanis (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghani afghan", PluralForms{"", "afghani afghan", "", "", "", "afghanis afghan"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "lek albanais (1947–1961)", PluralForms{"", "lek albanais (1947–1961)", "", "", "", "leks albanais (1947–1961)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albanais", PluralForms{"", "lek albanais", "", "", "", "leks albanais"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram arménien", PluralForms{"", "dram arménien", "", "", "", "drams arméniens"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "florin antillais", PluralForms{"", "florin antillais", "", "", "", "florins antillais"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolais", PluralForms{"", "kwanza angolais", "", "", "", "kwanzas angolais"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angolais (1977–1990)", PluralForms{"", "kwanza angolais (1977–1990)", "", "", "", "kwanzas angolais (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "nouveau kwanza angolais (1990–2000)", PluralForms{"", "nouveau kwanza angolais (1990–2000)", "", "", "", "nouveaux kwanzas angolais (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza angolais réajusté (1995–1999)", PluralForms{"", "kwanza angolais réajusté (1995–1999)", "", "", "", "kwanzas angolais réajustés (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentin", PluralForms{"", "austral argentin", "", "", "", "australs argentins"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentin (1983–1985)", PluralForms{"", "peso argentin (1983–1985)", "", "", "", "pesos argentins (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "$AR", "$", "peso argentin", PluralForms{"", "peso argentin", "", "", "", "pesos argentins"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "schilling autrichien", PluralForms{"", "schilling autrichien", "", "", "", "schillings autrichiens"}},
+		"AUD": {2, 0, 2, 1, "AUD", "$AU", "$", "dollar australien", PluralForms{"", "dollar australien", "", "", "", "dollars australiens"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florin arubais", PluralForms{"", "florin arubais", "", "", "", "florins arubais"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azéri (1993–2006)", PluralForms{"", "manat azéri (1993–2006)", "", "", "", "manats azéris (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azéri", PluralForms{"", "manat azéri", "", "", "", "manats azéris"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar bosniaque", PluralForms{"", "dinar bosniaque", "", "", "", "dinars bosniaques"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "mark convertible bosniaque", PluralForms{"", "mark convertible bosniaque", "", "", "", "marks convertibles bosniaques"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dollar barbadien", PluralForms{"", "dollar barbadien", "", "", "", "dollars barbadiens"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bangladeshi", PluralForms{"", "taka bangladeshi", "", "", "", "takas bangladeshis"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franc belge (convertible)", PluralForms{"", "franc belge (convertible)", "", "", "", "francs belges (convertibles)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "FB", "FB", "franc belge", PluralForms{"", "franc belge", "", "", "", "francs belges"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franc belge (financier)", PluralForms{"", "franc belge (financier)", "", "", "", "francs belges (financiers)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "lev bulgare (1962–1999)", PluralForms{"", "lev bulgare (1962–1999)", "", "", "", "levs bulgares (1962–1999)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev bulgare", PluralForms{"", "lev bulgare", "", "", "", "levs bulgares"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar bahreïni", PluralForms{"", "dinar bahreïni", "", "", "", "dinars bahreïnis"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franc burundais", PluralForms{"", "franc burundais", "", "", "", "francs burundais"}},
+		"BMD": {2, 0, 2, 1, "BMD", "$BM", "$", "dollar bermudien", PluralForms{"", "dollar bermudien", "", "", "", "dollars bermudiens"}},
+		"BND": {2, 0, 2, 1, "BND", "$BN", "$", "dollar brunéien", PluralForms{"", "dollar brunéien", "", "", "", "dollars brunéiens"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano bolivien", PluralForms{"", "boliviano bolivien", "", "", "", "bolivianos boliviens"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso bolivien", PluralForms{"", "peso bolivien", "", "", "", "pesos boliviens"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "mvdol bolivien", PluralForms{"", "mvdol bolivien", "", "", "", "mvdols boliviens"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "nouveau cruzeiro brésilien (1967–1986)", PluralForms{"", "nouveau cruzeiro brésilien (1967–1986)", "", "", "", "nouveaux cruzeiros brésiliens (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brésilien (1986–1989)", PluralForms{"", "cruzado brésilien (1986–1989)", "", "", "", "cruzados brésiliens (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brésilien (1990–1993)", PluralForms{"", "cruzeiro brésilien (1990–1993)", "", "", "", "cruzeiros brésiliens (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "réal brésilien", PluralForms{"", "réal brésilien", "", "", "", "réals brésiliens"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "nouveau cruzado", PluralForms{"", "nouveau cruzado brésilien (1989–1990)", "", "", "", "nouveaux cruzados brésiliens (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro", PluralForms{"", "cruzeiro réal brésilien (1993–1994)", "", "", "", "cruzeiros réals brésiliens (1993–1994)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dollar bahaméen", PluralForms{"", "dollar bahaméen", "", "", "", "dollars bahaméens"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum bouthanais", PluralForms{"", "ngultrum bouthanais", "", "", "", "ngultrums bouthanais"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birman", PluralForms{"", "kyat birman", "", "", "", "kyats birmans"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula botswanais", PluralForms{"", "pula botswanais", "", "", "", "pulas botswanais"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "nouveau rouble biélorusse (1994–1999)", PluralForms{"", "nouveau rouble biélorusse (1994–1999)", "", "", "", "nouveaux roubles biélorusses (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "rouble biélorusse", PluralForms{"", "rouble biélorusse", "", "", "", "roubles biélorusses"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "rouble biélorusse (2000–2016)", PluralForms{"", "rouble biélorusse (2000–2016)", "", "", "", "roubles biélorusses (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "$BZ", "$", "dollar bélizéen", PluralForms{"", "dollar bélizéen", "", "", "", "dollars bélizéens"}},
+		"CAD": {2, 0, 2, 5, "CAD", "$CA", "$", "dollar canadien", PluralForms{"", "dollar canadien", "", "", "", "dollars canadiens"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franc congolais", PluralForms{"", "franc congolais", "", "", "", "francs congolais"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "euro WIR", PluralForms{"", "euro WIR", "", "", "", "euros WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franc suisse", PluralForms{"", "franc suisse", "", "", "", "francs suisses"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "franc WIR", PluralForms{"", "franc WIR", "", "", "", "francs WIR"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "unité d’investissement chilienne", PluralForms{"", "unité d’investissement chilienne", "", "", "", "unités d’investissement chiliennes"}},
+		"CLP": {0, 0, 0, 1, "CLP", "$CL", "$", "peso chilien", PluralForms{"", "peso chilien", "", "", "", "pesos chiliens"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "yuan chinois (zone extracôtière)", PluralForms{"", "yuan chinois (zone extracôtière)", "", "", "", "yuans chinois (zone extracôtière)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "yuan renminbi chinois", PluralForms{"", "yuan renminbi chinois", "", "", "", "yuans renminbi chinois"}},
+		"COP": {0, 0, 0, 1, "COP", "$CO", "$", "peso colombien", PluralForms{"", "peso colombien", "", "", "", "pesos colombiens"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "unité de valeur réelle colombienne", PluralForms{"", "unité de valeur réelle colombienne", "", "", "", "unités de valeur réelle colombiennes"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colón costaricain", PluralForms{"", "colón costaricain", "", "", "", "colóns costaricains"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "dinar serbo-monténégrin", PluralForms{"", "dinar serbo-monténégrin", "", "", "", "dinars serbo-monténégrins"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "couronne forte tchécoslovaque", PluralForms{"", "couronne forte tchécoslovaque", "", "", "", "couronnes fortes tchécoslovaques"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cubain convertible", PluralForms{"", "peso cubain convertible", "", "", "", "pesos cubains convertibles"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubain", PluralForms{"", "peso cubain", "", "", "", "pesos cubains"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo capverdien", PluralForms{"", "escudo capverdien", "", "", "", "escudos capverdiens"}},
+		"CYP": {2, 0, 2, 1, "CYP", "£CY", "£CY", "livre chypriote", PluralForms{"", "livre chypriote", "", "", "", "livres chypriotes"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "couronne tchèque", PluralForms{"", "couronne tchèque", "", "", "", "couronnes tchèques"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "mark est-allemand", PluralForms{"", "mark est-allemand", "", "", "", "marks est-allemands"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "mark allemand", PluralForms{"", "mark allemand", "", "", "", "marks allemands"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franc djiboutien", PluralForms{"", "franc djiboutien", "", "", "", "francs djiboutiens"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "couronne danoise", PluralForms{"", "couronne danoise", "", "", "", "couronnes danoises"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominicain", PluralForms{"", "peso dominicain", "", "", "", "pesos dominicains"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar algérien", PluralForms{"", "dinar algérien", "", "", "", "dinars algériens"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre équatorien", PluralForms{"", "sucre équatorien", "", "", "", "sucres équatoriens"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "unité de valeur constante équatoriale (UVC)", PluralForms{"", "unité de valeur constante équatorienne (UVC)", "", "", "", "unités de valeur constante équatoriennes (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "couronne estonienne", PluralForms{"", "couronne estonienne", "", "", "", "couronnes estoniennes"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "£E", "livre égyptienne", PluralForms{"", "livre égyptienne", "", "", "", "livres égyptiennes"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nafka érythréen", PluralForms{"", "nafka érythréen", "", "", "", "nafkas érythréens"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "peseta espagnole (compte A)", PluralForms{"", "peseta espagnole (compte A)", "", "", "", "pesetas espagnoles (compte A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "peseta espagnole (compte convertible)", PluralForms{"", "peseta espagnole (compte convertible)", "", "", "", "pesetas espagnoles (compte convertible)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "peseta espagnole", PluralForms{"", "peseta espagnole", "", "", "", "pesetas espagnoles"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr éthiopien", PluralForms{"", "birr éthiopien", "", "", "", "birrs éthiopiens"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "mark finlandais", PluralForms{"", "mark finlandais", "", "", "", "marks finlandais"}},
+		"FJD": {2, 0, 2, 1, "FJD", "$FJ", "$", "dollar fidjien", PluralForms{"", "dollar fidjien", "", "", "", "dollars fidjiens"}},
+		"FKP": {2, 0, 2, 1, "FKP", "£FK", "£", "livre des îles Malouines", PluralForms{"", "livre des îles Malouines", "", "", "", "livres des îles Malouines"}},
+		"FRF": {2, 0, 2, 1, "FRF", "F", "F", "franc français", PluralForms{"", "franc français", "", "", "", "francs français"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£GB", "£", "livre sterling", PluralForms{"", "livre sterling", "", "", "", "livres sterling"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "coupon de lari géorgien", PluralForms{"", "coupon de lari géorgien", "", "", "", "coupons de lari géorgiens"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari géorgien", PluralForms{"", "lari géorgien", "", "", "", "lari géorgiens"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cédi", PluralForms{"", "cédi ghanéen (1967–2007)", "", "", "", "cédis ghanéens (1967–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cédi ghanéen", PluralForms{"", "cédi ghanéen", "", "", "", "cédis ghanéens"}},
+		"GIP": {2, 0, 2, 1, "GIP", "£GI", "£", "livre de Gibraltar", PluralForms{"", "livre de Gibraltar", "", "", "", "livres de Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambien", PluralForms{"", "dalasi gambien", "", "", "", "dalasis gambiens"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franc guinéen", PluralForms{"", "franc guinéen", "", "", "", "francs guinéens"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli guinéen", PluralForms{"", "syli guinéen", "", "", "", "sylis guinéens"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwélé équatoguinéen", PluralForms{"", "ekwélé équatoguinéen", "", "", "", "ekwélés équatoguinéens"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "drachme grecque", PluralForms{"", "drachme grecque", "", "", "", "drachmes grecques"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal guatémaltèque", PluralForms{"", "quetzal guatémaltèque", "", "", "", "quetzals guatémaltèques"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "escudo de Guinée portugaise", PluralForms{"", "escudo de Guinée portugaise", "", "", "", "escudos de Guinée portugaise"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso bissau-guinéen", PluralForms{"", "peso bissau-guinéen", "", "", "", "pesos bissau-guinéens"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dollar du Guyana", PluralForms{"", "dollar du Guyana", "", "", "", "dollars du Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "dollar de Hong Kong", PluralForms{"", "dollar de Hong Kong", "", "", "", "dollars de Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira hondurien", PluralForms{"", "lempira hondurien", "", "", "", "lempiras honduriens"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar croate", PluralForms{"", "dinar croate", "", "", "", "dinars croates"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna croate", PluralForms{"", "kuna croate", "", "", "", "kunas croates"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haïtienne", PluralForms{"", "gourde haïtienne", "", "", "", "gourdes haïtiennes"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forint hongrois", PluralForms{"", "forint hongrois", "", "", "", "forints hongrois"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "roupie indonésienne", PluralForms{"", "roupie indonésienne", "", "", "", "roupies indonésiennes"}},
+		"IEP": {2, 0, 2, 1, "IEP", "£IE", "£IE", "livre irlandaise", PluralForms{"", "livre irlandaise", "", "", "", "livres irlandaises"}},
+		"ILP": {2, 0, 2, 1, "ILP", "£IL", "£IL", "livre israélienne", PluralForms{"", "livre israélienne", "", "", "", "livres israéliennes"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "nouveau shekel israélien", PluralForms{"", "nouveau shekel israélien", "", "", "", "nouveaux shekels israéliens"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "roupie indienne", PluralForms{"", "roupie indienne", "", "", "", "roupies indiennes"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar irakien", PluralForms{"", "dinar irakien", "", "", "", "dinars irakiens"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "riyal iranien", PluralForms{"", "riyal iranien", "", "", "", "riyals iraniens"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "couronne islandaise", PluralForms{"", "couronne islandaise", "", "", "", "couronnes islandaises"}},
+		"ITL": {0, 0, 0, 1, "ITL", "₤IT", "₤IT", "lire italienne", PluralForms{"", "lire italienne", "", "", "", "lires italiennes"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dollar jamaïcain", PluralForms{"", "dollar jamaïcain", "", "", "", "dollars jamaïcains"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordanien", PluralForms{"", "dinar jordanien", "", "", "", "dinars jordaniens"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "yen japonais", PluralForms{"", "yen japonais", "", "", "", "yens japonais"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "shilling kényan", PluralForms{"", "shilling kényan", "", "", "", "shillings kényans"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirghize", PluralForms{"", "som kirghize", "", "", "", "soms kirghizes"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel cambodgien", PluralForms{"", "riel cambodgien", "", "", "", "riels cambodgiens"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "FC", "franc comorien", PluralForms{"", "franc comorien", "", "", "", "francs comoriens"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won nord-coréen", PluralForms{"", "won nord-coréen", "", "", "", "wons nord-coréens"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "won sud-coréen", PluralForms{"", "won sud-coréen", "", "", "", "wons sud-coréens"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar koweïtien", PluralForms{"", "dinar koweïtien", "", "", "", "dinar koweïtiens"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dollar des îles Caïmans", PluralForms{"", "dollar des îles Caïmans", "", "", "", "dollars des îles Caïmans"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazakh", PluralForms{"", "tenge kazakh", "", "", "", "tenges kazakhs"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laotien", PluralForms{"", "kip laotien", "", "", "", "kips laotiens"}},
+		"LBP": {0, 0, 0, 1, "LBP", "£LB", "£L", "livre libanaise", PluralForms{"", "livre libanaise", "", "", "", "livres libanaises"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "roupie srilankaise", PluralForms{"", "roupie srilankaise", "", "", "", "roupies srilankaises"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dollar libérien", PluralForms{"", "dollar libérien", "", "", "", "dollars libériens"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti lesothan", PluralForms{"", "loti lesothan", "", "", "", "maloti lesothans"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litas lituanien", PluralForms{"", "litas lituanien", "", "", "", "litas lituaniens"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "talonas lituanien", PluralForms{"", "talonas lituanien", "", "", "", "talonas lituaniens"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "franc convertible luxembourgeois", PluralForms{"", "franc convertible luxembourgeois", "", "", "", "francs convertibles luxembourgeois"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "franc luxembourgeois", PluralForms{"", "franc luxembourgeois", "", "", "", "francs luxembourgeois"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "franc financier luxembourgeois", PluralForms{"", "franc financier luxembourgeois", "", "", "", "francs financiers luxembourgeois"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lats letton", PluralForms{"", "lats letton", "", "", "", "lats lettons"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "rouble letton", PluralForms{"", "rouble letton", "", "", "", "roubles lettons"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libyen", PluralForms{"", "dinar libyen", "", "", "", "dinars libyens"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marocain", PluralForms{"", "dirham marocain", "", "", "", "dirhams marocains"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franc marocain", PluralForms{"", "franc marocain", "", "", "", "francs marocains"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldave", PluralForms{"", "leu moldave", "", "", "", "leus moldaves"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary malgache", PluralForms{"", "ariary malgache", "", "", "", "ariarys malgaches"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franc malgache", PluralForms{"", "franc malgache", "", "", "", "francs malgaches"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "denar macédonien", PluralForms{"", "denar macédonien", "", "", "", "denars macédoniens"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franc malien", PluralForms{"", "franc malien", "", "", "", "francs maliens"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat myanmarais", PluralForms{"", "kyat myanmarais", "", "", "", "kyats myanmarais"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongol", PluralForms{"", "tugrik mongol", "", "", "", "tugriks mongols"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca macanaise", PluralForms{"", "pataca macanaise", "", "", "", "patacas macanaises"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya mauritanien (1973–2017)", PluralForms{"", "ouguiya mauritanien (1973–2017)", "", "", "", "ouguiyas mauritaniens (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya mauritanien", PluralForms{"", "ouguiya mauritanien", "", "", "", "ouguiyas mauritaniens"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lire maltaise", PluralForms{"", "lire maltaise", "", "", "", "lires maltaises"}},
+		"MTP": {2, 0, 2, 1, "MTP", "£MT", "£MT", "livre maltaise", PluralForms{"", "livre maltaise", "", "", "", "livres maltaises"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "roupie mauricienne", PluralForms{"", "roupie mauricienne", "", "", "", "roupies mauriciennes"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rufiyaa maldivienne", PluralForms{"", "rufiyaa maldivienne", "", "", "", "rufiyaas maldiviennes"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malawite", PluralForms{"", "kwacha malawite", "", "", "", "kwachas malawites"}},
+		"MXN": {2, 0, 2, 1, "MXN", "$MX", "$", "peso mexicain", PluralForms{"", "peso mexicain", "", "", "", "pesos mexicains"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso d’argent mexicain (1861–1992)", PluralForms{"", "peso d’argent mexicain (1861–1992)", "", "", "", "pesos d’argent mexicains (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "unité de conversion mexicaine (UDI)", PluralForms{"", "unité de conversion mexicaine (UDI)", "", "", "", "unités de conversion mexicaines (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malais", PluralForms{"", "ringgit malais", "", "", "", "ringgits malais"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escudo mozambicain", PluralForms{"", "escudo mozambicain", "", "", "", "escudos mozambicains"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "métical", PluralForms{"", "metical mozambicain (1980–2006)", "", "", "", "meticais mozambicains (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambicain", PluralForms{"", "metical mozambicain", "", "", "", "meticais mozambicains"}},
+		"NAD": {2, 0, 2, 1, "NAD", "$NA", "$", "dollar namibien", PluralForms{"", "dollar namibien", "", "", "", "dollars namibiens"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigérian", PluralForms{"", "naira nigérian", "", "", "", "nairas nigérians"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "cordoba", PluralForms{"", "córdoba nicaraguayen (1912–1988)", "", "", "", "córdobas nicaraguayens (1912–1988)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "$C", "córdoba oro nicaraguayen", PluralForms{"", "córdoba oro nicaraguayen", "", "", "", "córdobas oro nicaraguayens"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "florin néerlandais", PluralForms{"", "florin néerlandais", "", "", "", "florins néerlandais"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "couronne norvégienne", PluralForms{"", "couronne norvégienne", "", "", "", "couronnes norvégiennes"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "roupie népalaise", PluralForms{"", "roupie népalaise", "", "", "", "roupies népalaises"}},
+		"NZD": {2, 0, 2, 1, "NZD", "$NZ", "$", "dollar néo-zélandais", PluralForms{"", "dollar néo-zélandais", "", "", "", "dollars néo-zélandais"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "riyal omanais", PluralForms{"", "riyal omanais", "", "", "", "riyals omanis"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panaméen", PluralForms{"", "balboa panaméen", "", "", "", "balboas panaméens"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti péruvien", PluralForms{"", "inti péruvien", "", "", "", "intis péruviens"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol péruvien", PluralForms{"", "sol péruvien", "", "", "", "sols péruviens"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol péruvien (1863–1985)", PluralForms{"", "sol péruvien (1863–1985)", "", "", "", "sols péruviens (1863–1985)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina papouan-néo-guinéen", PluralForms{"", "kina papouan-néo-guinéen", "", "", "", "kinas papouan-néo-guinéens"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso philippin", PluralForms{"", "peso philippin", "", "", "", "pesos philippins"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "roupie pakistanaise", PluralForms{"", "roupie pakistanaise", "", "", "", "roupies pakistanaises"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloty polonais", PluralForms{"", "zloty polonais", "", "", "", "zlotys polonais"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "zloty (1950–1995)", PluralForms{"", "zloty polonais (1950–1995)", "", "", "", "zlotys polonais (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "escudo portugais", PluralForms{"", "escudo portugais", "", "", "", "escudos portugais"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guaraní paraguayen", PluralForms{"", "guaraní paraguayen", "", "", "", "guaranís paraguayens"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "riyal qatari", PluralForms{"", "riyal qatari", "", "", "", "riyals qataris"}},
+		"RHD": {2, 0, 2, 1, "RHD", "$RH", "$RH", "dollar rhodésien", PluralForms{"", "dollar rhodésien", "", "", "", "dollars rhodésiens"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "ancien leu roumain", PluralForms{"", "leu roumain (1952–2005)", "", "", "", "lei roumains (1952–2005)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "leu roumain", PluralForms{"", "leu roumain", "", "", "", "lei roumains"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serbe", PluralForms{"", "dinar serbe", "", "", "", "dinars serbes"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rouble russe", PluralForms{"", "rouble russe", "", "", "", "roubles russes"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "rouble russe (1991–1998)", PluralForms{"", "rouble russe (1991–1998)", "", "", "", "roubles russes (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "FR", "franc rwandais", PluralForms{"", "franc rwandais", "", "", "", "francs rwandais"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "riyal saoudien", PluralForms{"", "riyal saoudien", "", "", "", "riyals saoudiens"}},
+		"SBD": {2, 0, 2, 1, "SBD", "$SB", "$", "dollar des îles Salomon", PluralForms{"", "dollar des îles Salomon", "", "", "", "dollars des îles Salomon"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "roupie des Seychelles", PluralForms{"", "roupie des Seychelles", "", "", "", "roupies des Seychelles"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar soudanais", PluralForms{"", "dinar soudanais (1992–2007)", "", "", "", "dinars soudanais (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "livre soudanaise", PluralForms{"", "livre soudanaise", "", "", "", "livres soudanaises"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "livre soudanaise (1956–2007)", PluralForms{"", "livre soudanaise (1956–2007)", "", "", "", "livres soudanaises (1956–2007)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "couronne suédoise", PluralForms{"", "couronne suédoise", "", "", "", "couronnes suédoises"}},
+		"SGD": {2, 0, 2, 1, "SGD", "$SG", "$", "dollar de Singapour", PluralForms{"", "dollar de Singapour", "", "", "", "dollars de Singapour"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "livre de Sainte-Hélène", PluralForms{"", "livre de Sainte-Hélène", "", "", "", "livres de Sainte-Hélène"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tolar slovène", PluralForms{"", "tolar slovène", "", "", "", "tolars slovènes"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "couronne slovaque", PluralForms{"", "couronne slovaque", "", "", "", "couronnes slovaques"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone sierra-léonais", PluralForms{"", "leone sierra-léonais", "", "", "", "leones sierra-léonais"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone sierra-léonais (1964—2022)", PluralForms{"", "leone sierra-léonais (1964—2022)", "", "", "", "leones sierra-léonais (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "shilling somalien", PluralForms{"", "shilling somalien", "", "", "", "shillings somaliens"}},
+		"SRD": {2, 0, 2, 1, "SRD", "$SR", "$", "dollar surinamais", PluralForms{"", "dollar surinamais", "", "", "", "dollars surinamais"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "florin surinamais", PluralForms{"", "florin surinamais", "", "", "", "florins surinamais"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "livre sud-soudanaise", PluralForms{"", "livre sud-soudanaise", "", "", "", "livres sud-soudanaises"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra santoméen (1977–2017)", PluralForms{"", "dobra santoméen (1977–2017)", "", "", "", "dobras santoméens (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra santoméen", PluralForms{"", "dobra santoméen", "", "", "", "dobras santoméens"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "rouble soviétique", PluralForms{"", "rouble soviétique", "", "", "", "roubles soviétiques"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colón salvadorien", PluralForms{"", "colón salvadorien", "", "", "", "colóns salvadoriens"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "livre syrienne", PluralForms{"", "livre syrienne", "", "", "", "livres syriennes"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni swazi", PluralForms{"", "lilangeni swazi", "", "", "", "lilangenis swazis"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "baht thaïlandais", PluralForms{"", "baht thaïlandais", "", "", "", "bahts thaïlandais"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "rouble tadjik", PluralForms{"", "rouble tadjik", "", "", "", "roubles tadjiks"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tadjik", PluralForms{"", "somoni tadjik", "", "", "", "somonis tadjiks"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turkmène", PluralForms{"", "manat turkmène", "", "", "", "manats turkmènes"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "nouveau manat turkmène", PluralForms{"", "nouveau manat turkmène", "", "", "", "nouveaux manats turkmènes"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunisien", PluralForms{"", "dinar tunisien", "", "", "", "dinars tunisiens"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "$T", "pa’anga tongan", PluralForms{"", "pa’anga tongan", "", "", "", "pa’angas tongans"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "escudo timorais", PluralForms{"", "escudo timorais", "", "", "", "escudos timorais"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "livre turque (1844–2005)", PluralForms{"", "livre turque (1844–2005)", "", "", "", "livres turques (1844–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "livre turque", PluralForms{"", "livre turque", "", "", "", "livres turques"}},
+		"TTD": {2, 0, 2, 1, "TTD", "$TT", "$", "dollar de Trinité-et-Tobago", PluralForms{"", "dollar de Trinité-et-Tobago", "", "", "", "dollars de Trinité-et-Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "nouveau dollar taïwanais", PluralForms{"", "nouveau dollar taïwanais", "", "", "", "nouveaux dollars taïwanais"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "shilling tanzanien", PluralForms{"", "shilling tanzanien", "", "", "", "shillings tanzaniens"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hryvnia ukrainienne", PluralForms{"", "hryvnia ukrainienne", "", "", "", "hryvnias ukrainiennes"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbovanetz", PluralForms{"", "karbovanets ukrainien (1992–1996)", "", "", "", "karbovanets ukrainiens (1992–1996)"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "shilling ougandais (1966–1987)", PluralForms{"", "shilling ougandais (1966–1987)", "", "", "", "shillings ougandais (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "shilling ougandais", PluralForms{"", "shilling ougandais", "", "", "", "shillings ougandais"}},
+		"USD": {2, 0, 2, 1, "USD", "$US", "$", "dollar des États-Unis", PluralForms{"", "dollar des États-Unis", "", "", "", "dollars des États-Unis"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dollar des Etats-Unis (jour suivant)", PluralForms{"", "dollar des États-Unis (jour suivant)", "", "", "", "dollars des États-Unis (jour suivant)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dollar des Etats-Unis (jour même)", PluralForms{"", "dollar des États-Unis (jour même)", "", "", "", "dollars des États-Unis (jour même)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "peso uruguayen (unités indexées)", PluralForms{"", "peso uruguayen (unités indexées)", "", "", "", "pesos uruguayen (unités indexées)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso uruguayen (1975–1993)", PluralForms{"", "peso uruguayen (1975–1993)", "", "", "", "pesos uruguayens (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "$UY", "$", "peso uruguayen", PluralForms{"", "peso uruguayen", "", "", "", "pesos uruguayens"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "sum ouzbek", PluralForms{"", "sum ouzbek", "", "", "", "sums ouzbeks"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "bolivar vénézuélien (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "bolivar vénézuélien (2008–2018)", PluralForms{"", "bolivar vénézuélien (2008–2018)", "", "", "", "bolivars vénézuéliens (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolivar vénézuélien", PluralForms{"", "bolivar vénézuélien", "", "", "", "bolivars vénézuéliens"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "dông vietnamien", PluralForms{"", "dông vietnamien", "", "", "", "dôngs vietnamiens"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu vanuatuan", PluralForms{"", "vatu vanuatuan", "", "", "", "vatus vanuatuans"}},
+		"WST": {2, 0, 2, 1, "WST", "$WS", "$WS", "tala samoan", PluralForms{"", "tala samoan", "", "", "", "talas samoans"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franc CFA (BEAC)", PluralForms{"", "franc CFA (BEAC)", "", "", "", "francs CFA (BEAC)"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "argent", PluralForms{"", "once troy d’argent", "", "", "", "onces troy d’argent"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "or", PluralForms{"", "once troy d’or", "", "", "", "onces troy d’or"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "unité européenne composée", PluralForms{"", "unité composée européenne (EURCO)", "", "", "", "unités composées européennes (EURCO)"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "unité monétaire européenne", PluralForms{"", "unité monétaire européenne (UME–6)", "", "", "", "unités monétaires européennes (UME–6)"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "unité de compte européenne (XBC)", PluralForms{"", "unité de compte 9 européenne (UEC–9)", "", "", "", "unités de compte 9 européennes (UEC–9)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "unité de compte européenne (XBD)", PluralForms{"", "unité de compte 17 européenne (UEC–17)", "", "", "", "unités de compte 17 européennes (UEC–17)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "dollar des Caraïbes orientales", PluralForms{"", "dollar des Caraïbes orientales", "", "", "", "dollars des Caraïbes orientales"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "florin caribéen", PluralForms{"", "florin caribéen", "", "", "", "florins caribéens"}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "droit de tirage spécial", PluralForms{"", "droit de tirage spécial", "", "", "", "droits de tirage spéciaux"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "unité de compte européenne (ECU)", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franc or", PluralForms{"", "franc or", "", "", "", "francs or"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franc UIC", PluralForms{"", "franc UIC", "", "", "", "francs UIC"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franc CFA (BCEAO)", PluralForms{"", "franc CFA (BCEAO)", "", "", "", "francs CFA (BCEAO)"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladium", PluralForms{"", "once troy de palladium", "", "", "", "onces troy de palladium"}},
+		"XPF": {0, 0, 0, 1, "XPF", "FCFP", "FCFP", "franc CFP", PluralForms{"", "franc CFP", "", "", "", "francs CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platine", PluralForms{"", "once troy de platine", "", "", "", "onces troy de platine"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "type de fonds RINET", PluralForms{"", "unité de fonds RINET", "", "", "", "unités de fonds RINET"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "(devise de test)", PluralForms{"", "(devise de test)", "", "", "", "(devises de test)"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "devise inconnue ou non valide", PluralForms{"", "devise inconnue", "", "", "", "devises inconnues"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar du Yémen", PluralForms{"", "dinar nord-yéménite", "", "", "", "dinars nord-yéménites"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "riyal yéménite", PluralForms{"", "riyal yéménite", "", "", "", "riyals yéménites"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "nouveau dinar yougoslave", PluralForms{"", "dinar fort yougoslave (1966–1989)", "", "", "", "dinars forts yougoslaves (1966–1989)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "dinar yougoslave Noviy", PluralForms{"", "nouveau dinar yougoslave (1994–2003)", "", "", "", "nouveaux dinars yougoslaves (1994–2003)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar yougoslave convertible", PluralForms{"", "dinar convertible yougoslave (1990–1992)", "", "", "", "dinars convertibles yougoslaves (1990–1992)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand sud-africain (financier)", PluralForms{"", "rand sud-africain (financier)", "", "", "", "rands sud-africains (financiers)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sud-africain", PluralForms{"", "rand sud-africain", "", "", "", "rands sud-africains"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha zambien (1968–2012)", PluralForms{"", "kwacha zambien (1968–2012)", "", "", "", "kwachas zambiens (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "Kw", "kwacha zambien", PluralForms{"", "kwacha zambien", "", "", "", "kwachas zambiens"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "nouveau zaïre zaïrien", PluralForms{"", "nouveau zaïre zaïrien", "", "", "", "nouveaux zaïres zaïriens"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zaïre zaïrois", PluralForms{"", "zaïre zaïrois", "", "", "", "zaïres zaïrois"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dollar zimbabwéen", PluralForms{"", "dollar zimbabwéen", "", "", "", "dollars zimbabwéens"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dollar zimbabwéen (2009)", PluralForms{"", "dollar zimbabwéen (2009)", "", "", "", "dollars zimbabwéens (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "dollar zimbabwéen (2008)", PluralForms{"", "dollar zimbabwéen (2008)", "", "", "", "dollars zimbabwéens (2008)"}},
 	},
 }
@@ -6,292 +6,345 @@ var ga = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0k", "other": "0k"}},
+				{4, 2, map[string]string{"one": "0k", "other": "0k"}},
+				{5, 3, map[string]string{"one": "0k", "other": "0k"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 mhíle", "many": "0 míle", "one": "0 mhíle", "other": "0 míle", "two": "0 mhíle"}},
+				{4, 2, map[string]string{"few": "0 míle", "many": "0 míle", "one": "0 míle", "other": "0 míle", "two": "0 míle"}},
+				{5, 3, map[string]string{"few": "0 míle", "many": "0 míle", "one": "0 míle", "other": "0 míle", "two": "0 míle"}},
+				{6, 1, map[string]string{"few": "0 mhilliún", "many": "0 milliún", "one": "0 mhilliún", "other": "0 milliún", "two": "0 mhilliún"}},
+				{7, 2, map[string]string{"few": "0 milliún", "many": "0 milliún", "one": "0 milliún", "other": "0 milliún", "two": "0 milliún"}},
+				{8, 3, map[string]string{"few": "0 milliún", "many": "0 milliún", "one": "0 milliún", "other": "0 milliún", "two": "0 milliún"}},
+				{9, 1, map[string]string{"few": "0 bhilliún", "many": "0 mbilliún", "one": "0 bhilliún", "other": "0 billiún", "two": "0 bhilliún"}},
+				{10, 2, map[string]string{"few": "0 billiún", "many": "0 mbilliún", "one": "0 billiún", "other": "0 billiún", "two": "0 billiún"}},
+				{11, 3, map[string]string{"few": "0 billiún", "many": "0 billiún", "one": "0 billiún", "other": "0 billiún", "two": "0 billiún"}},
+				{12, 1, map[string]string{"few": "0 thrilliún", "many": "0 dtrilliún", "one": "0 trilliún", "other": "0 trilliún", "two": "0 thrilliún"}},
+				{13, 2, map[string]string{"few": "0 trilliún", "many": "0 dtrilliún", "one": "0 trilliún", "other": "0 trilliún", "two": "0 trilliún"}},
+				{14, 3, map[string]string{"few": "0 trilliún", "many": "0 trilliún", "one": "0 trilliún", "other": "0 trilliún", "two": "0 trilliún"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "¤0k", "many": "¤0k", "one": "¤0k", "other": "¤0k", "two": "¤0k"}},
+				{4, 2, map[string]string{"few": "¤0k", "many": "¤0k", "one": "¤0k", "other": "¤0k", "two": "¤0k"}},
+				{5, 3, map[string]string{"few": "¤0k", "many": "¤0k", "one": "¤0k", "other": "¤0k", "two": "¤0k"}},
+				{6, 1, map[string]string{"few": "¤0M", "many": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M"}},
+				{7, 2, map[string]string{"few": "¤0M", "many": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M"}},
+				{8, 3, map[string]string{"few": "¤0M", "many": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M"}},
+				{9, 1, map[string]string{"few": "¤0B", "many": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B"}},
+				{10, 2, map[string]string{"few": "¤0B", "many": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B"}},
+				{11, 3, map[string]string{"few": "¤0B", "many": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B"}},
+				{12, 1, map[string]string{"few": "¤0T", "many": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T"}},
+				{13, 2, map[string]string{"few": "¤0T", "many": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T"}},
+				{14, 3, map[string]string{"few": "¤0T", "many": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Peseta Andóra", PluralForms{"", "pheseta Andóra", "pheseta Andóra", "pheseta Andóra", "bpeseta Andóra", "peseta Andóra"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirham Aontas na nÉimíríochtaí Arabacha", PluralForms{"", "dirham Aontas na nÉimíríochtaí Arabacha", "dhirham Aontas na nÉimíríochtaí Arabacha", "dhirham Aontas na nÉimíríochtaí Arabacha", "ndirham Aontas na nÉimíríochtaí Arabacha", "dirham Aontas na nÉimíríochtaí Arabacha"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afgainí (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghani na hAfganastáine", PluralForms{"", "afghani na hAfganastáine", "afghani na hAfganastáine", "afghani na hAfganastáine", "n-afghani na hAfganastáine", "afghani na hAfganastáine"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Lek na hAlbáine (1946–1965)", PluralForms{"", "lek na hAlbáine (1946–1965)", "lek na hAlbáine (1946–1965)", "lek na hAlbáine (1946–1965)", "lek na hAlbáine (1946–1965)", "lek na hAlbáine (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lek na hAlbáine", PluralForms{"", "lek na hAlbáine", "lek na hAlbáine", "lek na hAlbáine", "lek na hAlbáine", "Lek na hAlbáine"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dram na hAirméine", PluralForms{"", "dram na hAirméine", "dhram na hAirméine", "dhram na hAirméine", "ndram na hAirméine", "dram na hAirméine"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Gildear Aintillí na hÍsiltíre", PluralForms{"", "ghildear Aintillí na hÍsiltíre", "ghildear Aintillí na hÍsiltíre", "ghildear Aintillí na hÍsiltíre", "ngildear Aintillí na hÍsiltíre", "gildear Aintillí na hÍsiltíre"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kwanza Angóla", PluralForms{"", "kwanza Angóla", "kwanza Angóla", "kwanza Angóla", "kwanza Angóla", "kwanza Angóla"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Kwanza Angólach (1977–1990)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Kwanza Nua Angólach (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Kwanza Reajustado Angólach (1995–1999)", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Austral Airgintíneach", PluralForms{"", "", "", "", "", ""}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Peso Ley na hAirgintíne (1970–1983)", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Peso na hAirgintíne (1881–1970)", PluralForms{"", "pheso na hAirgintíne (1881–1970)", "pheso na hAirgintíne (1881–1970)", "pheso na hAirgintíne (1881–1970)", "bpeso na hAirgintíne (1881–1970)", "peso na hAirgintíne (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Peso na hAirgintíne (1983–1985)", PluralForms{"", "pheso na hAirgintíne (1983–1985)", "pheso na hAirgintíne (1983–1985)", "pheso na hAirgintíne (1983–1985)", "bpeso na hAirgintíne (1983–1985)", "peso na hAirgintíne (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso na hAirgintíne", PluralForms{"", "pheso na hAirgintíne", "pheso na hAirgintíne", "pheso na hAirgintíne", "bpeso na hAirgintíne", "peso na hAirgintíne"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Scilling na hOstaire", PluralForms{"", "Scilling Ostarach", "Scilling Ostarach", "Scilling Ostarach", "Scilling Ostarach", "Scilling Ostarach"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dollar na hAstráile", PluralForms{"", "dollar na hAstráile", "dhollar na hAstráile", "dhollar na hAstráile", "ndollar na hAstráile", "dollar na hAstráile"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Flóirín Arúba", PluralForms{"", "fhlóirín Arúba", "fhlóirín Arúba", "fhlóirín Arúba", "bhflóirín Arúba", "flóirín Arúba"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Manat na hAsarbaiseáine (1993–2006)", PluralForms{"", "mhanat na hAsarbaiseáine (1993–2006)", "mhanat na hAsarbaiseáine (1993–2006)", "mhanat na hAsarbaiseáine (1993–2006)", "manat na hAsarbaiseáine (1993–2006)", "manat na hAsarbaiseáine (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manat na hAsarbaiseáine", PluralForms{"", "mhanat na hAsarbaiseáine", "mhanat na hAsarbaiseáine", "mhanat na hAsarbaiseáine", "manat na hAsarbaiseáine", "manat na hAsarbaiseáine"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Dínear Bhoisnia-Heirseagaivéin (1992–1994)", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Marg Inmhalartaithe na Boisnia-Heirseagaivéine", PluralForms{"", "mharg inmhalartaithe na Boisnia-Heirseagaivéine", "mharg inmhalartaithe na Boisnia-Heirseagaivéine", "mharg inmhalartaithe na Boisnia-Heirseagaivéine", "marg inmhalartaithe na Boisnia-Heirseagaivéine", "marg inmhalartaithe na Boisnia-Heirseagaivéine"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Dollar Bharbadós", PluralForms{"", "dollar Bharbadós", "dhollar Bharbadós", "dhollar Bharbadós", "ndollar Bharbadós", "dollar Bharbadós"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka na Banglaidéise", PluralForms{"", "taka na Banglaidéise", "thaka na Banglaidéise", "thaka na Banglaidéise", "dtaka na Banglaidéise", "taka na Banglaidéise"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Franc na Beilge (inmhalartaithe)", PluralForms{"", "Franc Beilgeach (inathraithe)", "Franc Beilgeach (inathraithe)", "Franc Beilgeach (inathraithe)", "Franc Beilgeach (inathraithe)", "Franc Beilgeach (inathraithe)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Franc Beilgeach", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Franc na Beilge (airgeadais)", PluralForms{"", "Franc Beilgeach (airgeadúil)", "Franc Beilgeach (airgeadúil)", "Franc Beilgeach (airgeadúil)", "Franc Beilgeach (airgeadúil)", "Franc Beilgeach (airgeadúil)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Lev Crua na Bulgáire", PluralForms{"", "lev crua na Bulgáire", "lev chrua na Bulgáire", "lev chrua na Bulgáire", "lev chrua na Bulgáire", "lev crua na Bulgáire"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "BGM", PluralForms{"", "lev sóisialach na Bulgáire", "lev sóisialach na Bulgáire", "lev sóisialach na Bulgáire", "lev sóisialach na Bulgáire", "lev sóisialach na Bulgáire"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lev na Bulgáire", PluralForms{"", "lev na Bulgáire", "lev na Bulgáire", "lev na Bulgáire", "lev na Bulgáire", "lev na Bulgáire"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Lev na Bulgáire (1879–1952)", PluralForms{"", "lev na Bulgáire (1879–1952)", "lev na Bulgáire (1879–1952)", "lev na Bulgáire (1879–1952)", "lev na Bulgáire (1879–1952)", "lev na Bulgáire (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinar Bhairéin", PluralForms{"", "dinar Bhairéin", "dhinar Bhairéin", "dhinar Bhairéin", "ndinar Bhairéin", "dinar Bhairéin"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Franc na Burúine", PluralForms{"", "fhranc na Burúine", "fhranc na Burúine", "fhranc na Burúine", "bhfranc na Burúine", "franc na Burúine"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dollar Bheirmiúda", PluralForms{"", "dollar Bheirmiúda", "dhollar Bheirmiúda", "dhollar Bheirmiúda", "ndollar Bheirmiúda", "dollar Bheirmiúda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dollar Bhrúiné", PluralForms{"", "dollar Bhrúiné", "dhollar Bhrúiné", "dhollar Bhrúiné", "ndollar Bhrúiné", "dollar Bhrúiné"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviano", PluralForms{"", "bholiviano", "bholiviano", "bholiviano", "mboliviano", "boliviano"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Peso na Bolaive", PluralForms{"", "pheso na Bolaive", "pheso na Bolaive", "pheso na Bolaive", "bpeso na Bolaive", "peso na Bolaive"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Mvdol na Bolaive", PluralForms{"", "mvdol na Bolaive", "mvdol na Bolaive", "mvdol na Bolaive", "mvdol na Bolaive", "mvdol na Bolaive"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Cruzeiro Nua na Brasaíle (1967–1986)", PluralForms{"", "chruzeiro nua na Brasaíle (1967–1986)", "chruzeiro nua na Brasaíle (1967–1986)", "chruzeiro nua na Brasaíle (1967–1986)", "gcruzeiro nua na Brasaíle (1967–1986)", "cruzeiro nua na Brasaíle (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Cruzado na Brasaíle (1986–1989)", PluralForms{"", "chruzado na Brasaíle (1986–1989)", "chruzado na Brasaíle (1986–1989)", "chruzado na Brasaíle (1986–1989)", "gcruzado na Brasaíle (1986–1989)", "cruzado na Brasaíle (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Cruzeiro na Brasaíle (1990–1993)", PluralForms{"", "chruzeiro na Brasaíle (1990–1993)", "chruzeiro na Brasaíle (1990–1993)", "chruzeiro na Brasaíle (1990–1993)", "gcruzeiro na Brasaíle (1990–1993)", "cruzeiro na Brasaíle (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Real na Brasaíle", PluralForms{"", "real na Brasaíle", "real na Brasaíle", "real na Brasaíle", "real na Brasaíle", "real na Brasaíle"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Cruzado Nua na Brasaíle (1989–1990)", PluralForms{"", "chruzado nua na Brasaíle (1989–1990)", "chruzado nua na Brasaíle (1989–1990)", "chruzado nua na Brasaíle (1989–1990)", "gcruzado nua na Brasaíle (1989–1990)", "cruzado nua na Brasaíle (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Cruzeiro na Brasaíle (1993–1994)", PluralForms{"", "chruzeiro na Brasaíle (1993–1994)", "chruzeiro na Brasaíle (1993–1994)", "chruzeiro na Brasaíle (1993–1994)", "gcruzeiro na Brasaíle (1993–1994)", "cruzeiro na Brasaíle (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Cruzeiro na Brasaíle (1942–1967)", PluralForms{"", "chruzeiro na Brasaíle (1942–1967)", "chruzeiro na Brasaíle (1942–1967)", "chruzeiro na Brasaíle (1942–1967)", "gcruzeiro na Brasaíle (1942–1967)", "cruzeiro na Brasaíle (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dollar na mBahámaí", PluralForms{"", "dollar na mBahámaí", "dhollar na mBahámaí", "dhollar na mBahámaí", "ndollar na mBahámaí", "dollar na mBahámaí"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrum na Bútáine", PluralForms{"", "ngultrum na Bútáine", "ngultrum na Bútáine", "ngultrum na Bútáine", "ngultrum na Bútáine", "ngultrum na Bútáine"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Kyat Bhurma", PluralForms{"", "kyat Bhurma", "kyat Bhurma", "kyat Bhurma", "kyat Bhurma", "kyat Bhurma"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula na Botsuáine", PluralForms{"", "phula na Botsuáine", "phula na Botsuáine", "phula na Botsuáine", "bpula na Botsuáine", "pula na Botsuáine"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Rúbal Nua na Bealarúise (1994–1999)", PluralForms{"", "rúbal nua na Bealarúise (1994–1999)", "rúbal nua na Bealarúise (1994–1999)", "rúbal nua na Bealarúise (1994–1999)", "rúbal nua na Bealarúise (1994–1999)", "rúbal nua na Bealarúise (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Rúbal na Bealarúise", PluralForms{"", "rúbal na Bealarúise", "rúbal na Bealarúise", "rúbal na Bealarúise", "rúbal na Bealarúise", "rúbal na Bealarúise"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rúbal na Bealarúise (2000–2016)", PluralForms{"", "rúbal na Bealarúise (2000–2016)", "rúbal na Bealarúise (2000–2016)", "rúbal na Bealarúise (2000–2016)", "rúbal na Bealarúise (2000–2016)", "rúbal na Bealarúise (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dollar na Beilíse", PluralForms{"", "dollar na Beilíse", "dhollar na Beilíse", "dhollar na Beilíse", "ndollar na Beilíse", "dollar na Beilíse"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dollar Cheanada", PluralForms{"", "dollar Cheanada", "dhollar Cheanada", "dhollar Cheanada", "ndollar Cheanada", "dollar Cheanada"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Franc an Chongó", PluralForms{"", "fhranc an Chongó", "fhranc an Chongó", "fhranc an Chongó", "bhfranc an Chongó", "franc an Chongó"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Euro WIR", PluralForms{"", "WIR euro", "euro WIR", "euro WIR", "euro WIR", "euro WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Franc na hEilvéise", PluralForms{"", "fhranc na hEilvéise", "fhranc na hEilvéise", "fhranc na hEilvéise", "bhfranc na hEilvéise", "franc na hEilvéise"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Franc WIR", PluralForms{"", "fhranc WIR amháin", "fhranc WIR", "fhranc WIR", "bhfranc WIR", "franc WIR"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Escudo na Sile", PluralForms{"", "escudo na Sile", "escudo na Sile", "escudo na Sile", "n-escudo na Sile", "escudo na Sile"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Unidades de Fomento na Sile", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso na Sile", PluralForms{"", "pheso na Sile", "pheso na Sile", "pheso na Sile", "bpeso na Sile", "peso na Sile"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuan na Síne (seachairgeadra)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuan na Síne", PluralForms{"", "yuan na Síne", "yuan na Síne", "yuan na Síne", "yuan na Síne", "yuan na Síne"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso na Colóime", PluralForms{"", "pheso na Colóime", "pheso na Colóime", "pheso na Colóime", "bpeso na Colóime", "peso na Colóime"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colón Chósta Ríce", PluralForms{"", "cholón Chósta Ríce", "cholón Chósta Ríce", "cholón Chósta Ríce", "gcolón Chósta Ríce", "colón Chósta Ríce"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Dinar na Seirbia (2002–2006)", PluralForms{"", "dinar na Seirbia (2002–2006)", "dhinar na Seirbia (2002–2006)", "dhinar na Seirbia (2002–2006)", "ndinar na Seirbia (2002–2006)", "dinar na Seirbia (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Koruna Crua na Seicslóvaice", PluralForms{"", "koruna chrua na Seicslóvaice", "koruna chrua na Seicslóvaice", "koruna chrua na Seicslóvaice", "koruna chrua na Seicslóvaice", "koruna crua na Seicslóvaice"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso Inmhalartaithe Chúba", PluralForms{"", "pheso inmhalartaithe Chúba", "pheso inmhalartaithe Chúba", "pheso inmhalartaithe Chúba", "bpeso inmhalartaithe Chúba", "peso inmhalartaithe Chúba"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso Chúba", PluralForms{"", "pheso Chúba", "pheso Chúba", "pheso Chúba", "bpeso Chúba", "peso Chúba"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Escudo Rinn Verde", PluralForms{"", "escudo Rinn Verde", "escudo Rinn Verde", "escudo Rinn Verde", "n-escudo Rinn Verde", "escudo Rinn Verde"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Punt na Cipire", PluralForms{"", "phunt na Cipire", "phunt na Cipire", "phunt na Cipire", "bpunt na Cipire", "punt na Cipire"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna Phoblacht na Seice", PluralForms{"", "koruna Phoblacht na Seice", "koruna Phoblacht na Seice", "koruna Phoblacht na Seice", "koruna Phoblacht na Seice", "koruna Phoblacht na Seice"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Marc Ghearmáin an Oirthir", PluralForms{"", "Ostmark na hOirGhearmáine", "Ostmark na hOirGhearmáine", "Ostmark na hOirGhearmáine", "Ostmark na hOirGhearmáine", "Ostmark na hOirGhearmáine"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Deutsche Mark", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Franc Djibouti", PluralForms{"", "fhranc Djibouti", "fhranc Djibouti", "fhranc Djibouti", "bhfranc Djibouti", "franc Djibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Coróin na Danmhairge", PluralForms{"", "choróin na Danmhairge", "choróin na Danmhairge", "choróin na Danmhairge", "gcoróin na Danmhairge", "coróin na Danmhairge"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso na Poblachta Doiminicí", PluralForms{"", "pheso na Poblachta Doiminicí", "pheso na Poblachta Doiminicí", "pheso na Poblachta Doiminicí", "bpeso na Poblachta Doiminicí", "peso na Poblachta Doiminicí"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinar na hAilgéire", PluralForms{"", "dinar na hAilgéire", "dhinar na hAilgéire", "dhinar na hAilgéire", "ndinar na hAilgéire", "dinar na hAilgéire"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Sucre Eacuadóir", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Unidad de Valor Constante (UVC) Eacuadóir", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Kroon na hEastóine", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Punt na hÉigipte", PluralForms{"", "phunt na hÉigipte", "phunt na hÉigipte", "phunt na hÉigipte", "bpunt na hÉigipte", "punt na hÉigipte"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa na hEiritré", PluralForms{"", "nakfa na hEiritré", "nakfa na hEiritré", "nakfa na hEiritré", "nakfa na hEiritré", "nakfa na hEiritré"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Peseta na Spáinne", PluralForms{"", "pheseta na Spáinne", "pheseta na Spáinne", "pheseta na Spáinne", "bpeseta na Spáinne", "peseta na Spáinne"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birr na hAetóipe", PluralForms{"", "bhirr na hAetóipe", "bhirr na hAetóipe", "bhirr na hAetóipe", "mbirr na hAetóipe", "birr na hAetóipe"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "euro", "euro", "euro", "euro", "euro"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Markka Fionnlannach", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dollar Fhidsí", PluralForms{"", "dollar Fhidsí", "dhollar Fhidsí", "dhollar Fhidsí", "ndollar Fhidsí", "dollar Fhidsí"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Punt Oileáin Fháclainne", PluralForms{"", "phunt Oileáin Fháclainne", "phunt Oileáin Fháclainne", "phunt Oileáin Fháclainne", "bpunt Oileáin Fháclainne", "punt Oileáin Fháclainne"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Franc na Fraince", PluralForms{"", "Franc Francach", "Franc Francach", "Franc Francach", "Franc Francach", "Franc Francach"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Punt Steirling", PluralForms{"", "phunt steirling", "phunt steirling", "phunt steirling", "bpunt steirling", "punt steirling"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Kupon Larit na Grúise", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lari na Seoirsia", PluralForms{"", "lari na Seoirsia", "lari na Seoirsia", "lari na Seoirsia", "lari na Seoirsia", "lari na Seoirsia"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Cedi Ghána (1979–2007)", PluralForms{"", "chedi Ghána (1979–2007)", "chedi Ghána (1979–2007)", "chedi Ghána (1979–2007)", "gcedi Ghána (1979–2007)", "cedi Ghána (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Cedi Ghána", PluralForms{"", "chedi Ghána", "chedi Ghána", "chedi Ghána", "gcedi Ghána", "cedi Ghána"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Punt Ghiobráltar", PluralForms{"", "phunt Ghiobráltar", "phunt Ghiobráltar", "phunt Ghiobráltar", "bpunt Ghiobráltar", "punt Ghiobráltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi na Gaimbia", PluralForms{"", "dalasi na Gaimbia", "dhalasi na Gaimbia", "dhalasi na Gaimbia", "ndalasi na Gaimbia", "dalasi na Gaimbia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Franc na Guine", PluralForms{"", "fhranc na Guine", "fhranc na Guine", "fhranc na Guine", "bhfranc na Guine", "franc na Guine"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Syli Guine", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekwele Guineana na Guine Meánchiorclaí", PluralForms{"", "Ekwele Guineana na Guine Meánchiorclaí", "Ekwele Guineana na Guine Meánchiorclaí", "Ekwele Guineana na Guine Meánchiorclaí", "Ekwele Guineana na Guine Meánchiorclaí", "Ekwele Guineana na Guine Meánchriosaí"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Drachma Gréagach", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal Ghuatamala", PluralForms{"", "quetzal Ghuatamala", "quetzal Ghuatamala", "quetzal Ghuatamala", "quetzal Ghuatamala", "quetzal Ghuatamala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Escudo na Guine Portaingéalaí", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Peso Guine-Bhissau", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dollar na Guáine", PluralForms{"", "dollar na Guáine", "dhollar na Guáine", "dhollar na Guáine", "ndollar na Guáine", "dollar na Guáine"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dollar Hong Cong", PluralForms{"", "dollar Hong Cong", "dhollar Hong Cong", "dhollar Hong Cong", "ndollar Hong Cong", "dollar Hong Cong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira Hondúras", PluralForms{"", "lempira Hondúras", "lempira Hondúras", "lempira Hondúras", "lempira Hondúras", "lempira Hondúras"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Dínear na Cróite", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuna na Cróite", PluralForms{"", "kuna na Cróite", "kuna na Cróite", "kuna na Cróite", "kuna na Cróite", "kuna na Cróite"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gourde Háítí", PluralForms{"", "ghourde Háítí", "ghourde Háítí", "ghourde Háítí", "ngourde Háítí", "gourde Háítí"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Forint na hUngáire", PluralForms{"", "fhorint na hUngáire", "fhorint na hUngáire", "fhorint na hUngáire", "bhforint na hUngáire", "forint na hUngáire"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupiah na hIndinéise", PluralForms{"", "rupiah na hIndinéise", "rupiah na hIndinéise", "rupiah na hIndinéise", "rupiah na hIndinéise", "rupiah na hIndinéise"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Punt Éireannach", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Punt Iosraelach", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Seiceal Nua Iosrael", PluralForms{"", "seiceal nua Iosrael", "sheiceal nua Iosrael", "sheiceal nua Iosrael", "seiceal nua Iosrael", "seiceal nua Iosrael"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rúipí na hIndia", PluralForms{"", "rúipí na hIndia", "rúipí na hIndia", "rúipí na hIndia", "rúipí na hIndia", "rúipí na hIndia"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinar na hIaráice", PluralForms{"", "dinar na hIaráice", "dhinar na hIaráice", "dhinar na hIaráice", "ndinar na hIaráice", "dinar na hIaráice"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial na hIaráine", PluralForms{"", "rial na hIaráine", "rial na hIaráine", "rial na hIaráine", "rial na hIaráine", "rial na hIaráine"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Króna na hÍoslainne", PluralForms{"", "króna na hÍoslainne", "króna na hÍoslainne", "króna na hÍoslainne", "króna na hÍoslainne", "króna na hÍoslainne"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Lira na hIodáile", PluralForms{"", "lira na hIodáile", "lira na hIodáile", "lira na hIodáile", "lira na hIodáile", "lira na hIodáile"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dollar na hIamáice", PluralForms{"", "dollar na hIamáice", "dhollar na hIamáice", "dhollar na hIamáice", "ndollar na hIamáice", "dollar na hIamáice"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinar na hIordáine", PluralForms{"", "dinar na hIordáine", "dhinar na hIordáine", "dhinar na hIordáine", "ndinar na hIordáine", "dinar na hIordáine"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Yen na Seapáine", PluralForms{"", "yen na Seapáine", "yen na Seapáine", "yen na Seapáine", "yen na Seapáine", "yen na Seapáine"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Scilling na Céinia", PluralForms{"", "scilling na Céinia", "scilling na Céinia", "scilling na Céinia", "scilling na Céinia", "scilling na Céinia"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som na Cirgeastáine", PluralForms{"", "som na Cirgeastáine", "shom na Cirgeastáine", "shom na Cirgeastáine", "som na Cirgeastáine", "som na Cirgeastáine"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riel na Cambóide", PluralForms{"", "riel na Cambóide", "riel na Cambóide", "riel na Cambóide", "riel na Cambóide", "riel na Cambóide"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Franc Oileáin Chomóra", PluralForms{"", "fhranc Oileáin Chomóra", "fhranc Oileáin Chomóra", "fhranc Oileáin Chomóra", "bhfranc Oileáin Chomóra", "franc Oileáin Chomóra"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won na Cóiré Thuaidh", PluralForms{"", "won na Cóiré Thuaidh", "won na Cóiré Thuaidh", "won na Cóiré Thuaidh", "won na Cóiré Thuaidh", "won na Cóiré Thuaidh"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Won na Cóiré Theas", PluralForms{"", "won na Cóiré Theas", "won na Cóiré Theas", "won na Cóiré Theas", "won na Cóiré Theas", "won na Cóiré Theas"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinar Chuáit", PluralForms{"", "dinar Chuáit", "dhinar Chuáit", "dhinar Chuáit", "ndinar Chuáit", "dinar Chuáit"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dollar Oileáin Cayman", PluralForms{"", "dollar Oileáin Cayman", "dhollar Oileáin Cayman", "dhollar Oileáin Cayman", "ndollar Oileáin Cayman", "dollar Oileáin Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge na Casacstáine", PluralForms{"", "tenge na Casacstáine", "thenge na Casacstáine", "thenge na Casacstáine", "dtenge na Casacstáine", "tenge na Casacstáine"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kip Laos", PluralForms{"", "kip Laos", "kip Laos", "kip Laos", "kip Laos", "kip Laos"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Punt na Liobáine", PluralForms{"", "phunt na Liobáine", "phunt na Liobáine", "phunt na Liobáine", "bpunt na Liobáine", "punt na Liobáine"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rúipí Shrí Lanca", PluralForms{"", "rúipí Shrí Lanca", "rúipí Shrí Lanca", "rúipí Shrí Lanca", "rúipí Shrí Lanca", "rúipí Shrí Lanca"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dollar na Libéire", PluralForms{"", "dollar na Libéire", "dhollar na Libéire", "dhollar na Libéire", "ndollar na Libéire", "dollar na Libéire"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti Leosóta", PluralForms{"", "loti Leosóta", "Loti Leosóta", "loti Leosóta", "loti Leosóta", "loti Leosóta"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas na Liotuáine", PluralForms{"", "litas na Liotuáine", "litas na Liotuáine", "litas na Liotuáine", "litas na Liotuáine", "litas na Liotuáine"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Talonas Liotuánach", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Franc Inmhalartach Lucsamburgach", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Franc Lucsamburg", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats na Laitvia", PluralForms{"", "lats na Laitvia", "lats na Laitvia", "lats na Laitvia", "lats na Laitvia", "lats na Laitvia"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Rúbal na Laitvia", PluralForms{"", "Rúbal Laitviach", "Rúbal Laitviach", "Rúbal Laitviach", "Rúbal Laitviach", "Rúbal Laitviach"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinar na Libia", PluralForms{"", "dinar na Libia", "dhinar na Libia", "dhinar na Libia", "ndinar na Libia", "dinar na Libia"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dirham Mharacó", PluralForms{"", "dirham Mharacó", "dhirham Mharacó", "dhirham Mharacó", "ndirham Mharacó", "dirham Mharacó"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Franc Mharacó", PluralForms{"", "fhranc Mharacó", "fhranc Mharacó", "fhranc Mharacó", "bhfranc Mharacó", "franc Mharacó"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leu na Moldóive", PluralForms{"", "leu na Moldóive", "leu na Moldóive", "leu na Moldóive", "leu na Moldóive", "leu na Moldóive"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ariary Mhadagascar", PluralForms{"", "ariary Mhadagascar", "ariary Mhadagascar", "ariary Mhadagascar", "n-ariary Mhadagascar", "ariary Mhadagascar"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Franc Madagascar", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Denar na Macadóine", PluralForms{"", "denar na Macadóine", "dhenar na Macadóine", "dhenar na Macadóine", "ndenar na Macadóine", "denar na Macadóine"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Franc Mhailí", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kyat Mhaenmar", PluralForms{"", "kyat Mhaenmar", "kyat Mhaenmar", "kyat Mhaenmar", "kyat Mhaenmar", "kyat Mhaenmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik na Mongóile", PluralForms{"", "tugrik na Mongóile", "thugrik na Mongóile", "thugrik na Mongóile", "dtugrik na Mongóile", "tugrik na Mongóile"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataca Mhacao", PluralForms{"", "phataca Mhacao", "phataca Mhacao", "phataca Mhacao", "bpataca Mhacao", "pataca Mhacao"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya na Máratáine (1973–2017)", PluralForms{"", "ouguiya na Máratáine (1973–2017)", "ouguiya na Máratáine (1973–2017)", "ouguiya na Máratáine (1973–2017)", "n-ouguiya na Máratáine (1973–2017)", "ouguiya na Máratáine (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya na Máratáine", PluralForms{"", "ouguiya na Máratáine", "ouguiya na Máratáine", "ouguiya na Máratáine", "n-ouguiya na Máratáine", "ouguiya na Máratáine"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Lira Mhálta", PluralForms{"", "lira Mhálta", "lira Mhálta", "lira Mhálta", "lira Mhálta", "lira Mhálta"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Punt Mhálta", PluralForms{"", "phunt Mhálta", "phunt Mhálta", "phunt Mhálta", "bpunt Mhálta", "punt Mhálta"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rúipí Oileán Mhuirís", PluralForms{"", "rúipí Oileán Mhuirís", "rúipí Oileán Mhuirís", "rúipí Oileán Mhuirís", "rúipí Oileán Mhuirís", "rúipí Oileán Mhuirís"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Rúipí Oileáin Mhaildíve", PluralForms{"", "rúipí Oileáin Mhaildíve", "rúipí Oileáin Mhaildíve", "rúipí Oileáin Mhaildíve", "rúipí Oileáin Mhaildíve", "rúipí Oileáin Mhaildíve"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyaa Oileáin Mhaildíve", PluralForms{"", "rufiyaa Oileáin Mhaildíve", "rufiyaa Oileáin Mhaildíve", "rufiyaa Oileáin Mhaildíve", "rufiyaa Oileáin Mhaildíve", "rufiyaa Oileáin Mhaildíve"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kwacha na Maláive", PluralForms{"", "kwacha na Maláive", "kwacha na Maláive", "kwacha na Maláive", "kwacha na Maláive", "kwacha na Maláive"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso Mheicsiceo", PluralForms{"", "pheso Mheicsiceo", "pheso Mheicsiceo", "pheso Mheicsiceo", "bpeso Mheicsiceo", "peso Mheicsiceo"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Peso Airgid Mheicsiceo (1861–1992)", PluralForms{"", "pheso airgid Mheicsiceo (1861–1992)", "pheso airgid Mheicsiceo (1861–1992)", "pheso airgid Mheicsiceo (1861–1992)", "bpeso airgid Mheicsiceo (1861–1992)", "peso airgid Mheicsiceo (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Aonad Infheistíochta Meicsiceach", PluralForms{"", "Unidad de Inversion (UDI) Meicsiceo", "Unidad de Inversion (UDI) Meicsiceo", "Unidad de Inversion (UDI) Meicsiceo", "Unidad de Inversion (UDI) Meicsiceo", "Unidad de Inversion (UDI) Meicsiceo"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringgit na Malaeisia", PluralForms{"", "ringgit na Malaeisia", "ringgit na Malaeisia", "ringgit na Malaeisia", "ringgit na Malaeisia", "ringgit na Malaeisia"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Escudo Mósaimbíce", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metical Mósaimbíce", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metical Mhósaimbíc", PluralForms{"", "mhetical Mhósaimbíc", "mhetical Mhósaimbíc", "mhetical Mhósaimbíc", "metical Mhósaimbíc", "metical Mhósaimbíc"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dollar na Namaibe", PluralForms{"", "dollar na Namaibe", "dhollar na Namaibe", "dhollar na Namaibe", "ndollar na Namaibe", "dollar na Namaibe"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naira na Nigéire", PluralForms{"", "naira na Nigéire", "naira na Nigéire", "naira na Nigéire", "naira na Nigéire", "naira na Nigéire"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Córdoba Nicearagua (1988–1991)", PluralForms{"", "chórdoba Nicearagua (1988–1991)", "chórdoba Nicearagua (1988–1991)", "chórdoba Nicearagua (1988–1991)", "gcórdoba Nicearagua (1988–1991)", "córdoba Nicearagua (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Córdoba Nicearagua", PluralForms{"", "chórdoba Nicearagua", "chórdoba Nicearagua", "chórdoba Nicearagua", "gcórdoba Nicearagua", "córdoba Nicearagua"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Guilder Ísiltíreach", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Coróin na hIorua", PluralForms{"", "choróin na hIorua", "choróin na hIorua", "choróin na hIorua", "gcoróin na hIorua", "coróin na hIorua"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rúipí Neipeal", PluralForms{"", "rúipí Neipeal", "rúipí Neipeal", "rúipí Neipeal", "rúipí Neipeal", "rúipí Neipeal"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dollar na Nua-Shéalainne", PluralForms{"", "dollar na Nua-Shéalainne", "dhollar na Nua-Shéalainne", "dhollar na Nua-Shéalainne", "ndollar na Nua-Shéalainne", "dollar na Nua-Shéalainne"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Rial Óman", PluralForms{"", "rial Óman", "rial Óman", "rial Óman", "rial Óman", "rial Óman"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa Phanama", PluralForms{"", "bhalboa Phanama", "bhalboa Phanama", "bhalboa Phanama", "mbalboa Phanama", "balboa Phanama"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Inti Pheiriú", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol Pheiriú", PluralForms{"", "sol Pheiriú", "shol Pheiriú", "sol Pheiriú", "sol Pheiriú", "Sol Pheiriú"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Sol Pheiriú (1863–1965)", PluralForms{"", "sol Pheiriú (1863–1965)", "shol Pheiriú (1863–1965)", "shol Pheiriú (1863–1965)", "sol Pheiriú (1863–1965)", "sol Pheiriú (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina Nua-Ghuine Phapua", PluralForms{"", "kina Nua-Ghuine Phapua", "kina Nua-Ghuine Phapua", "kina Nua-Ghuine Phapua", "kina Nua-Ghuine Phapua", "kina Nua-Ghuine Phapua"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Peso na nOileán Filipíneach", PluralForms{"", "pheso na nOileán Filipíneach", "pheso na nOileán Filipíneach", "pheso na nOileán Filipíneach", "bpeso na nOileán Filipíneach", "peso na nOileán Filipíneach"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rúipí na Pacastáine", PluralForms{"", "rúipí na Pacastáine", "rúipí na Pacastáine", "rúipí na Pacastáine", "rúipí na Pacastáine", "rúipí na Pacastáine"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Zloty na Polainne", PluralForms{"", "zloty na Polainne", "zloty na Polainne", "zloty na Polainne", "zloty na Polainne", "zloty na Polainne"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Zloty Polannach (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Escudo na Portaingéile", PluralForms{"", "escudo na Portaingéile", "escudo na Portaingéile", "escudo na Portaingéile", "n-escudo na Portaingéile", "escudo na Portaingéile"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guaraní Pharagua", PluralForms{"", "ghuaraní Pharagua", "ghuaraní Pharagua", "ghuaraní Pharagua", "nguaraní Pharagua", "guaraní Pharagua"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Riyal Chatar", PluralForms{"", "riyal Chatar", "riyal Chatar", "riyal Chatar", "riyal Chatar", "riyal Chatar"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Leu na Rómáine (1952–2006)", PluralForms{"", "leu na Rómáine (1952–2006)", "leu na Rómáine (1952–2006)", "leu na Rómáine (1952–2006)", "leu na Rómáine (1952–2006)", "leu na Rómáine (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Leu na Rómáine", PluralForms{"", "leu na Rómáine", "leu na Rómáine", "leu na Rómáine", "leu na Rómáine", "leu na Rómáine"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinar na Seirbia", PluralForms{"", "dinar na Seirbia", "dhinar na Seirbia", "dhinar na Seirbia", "ndinar na Seirbia", "dinar na Seirbia"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rúbal na Rúise", PluralForms{"", "rúbal na Rúise", "rúbal na Rúise", "rúbal na Rúise", "rúbal na Rúise", "rúbal na Rúise"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "Rúbal na Rúise (1991–1998)", PluralForms{"", "rúbal na Rúise (1991–1998)", "rúbal na Rúise (1991–1998)", "rúbal na Rúise (1991–1998)", "rúbal na Rúise (1991–1998)", "rúbal na Rúise (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Franc Ruanda", PluralForms{"", "fhranc Ruanda", "fhranc Ruanda", "fhranc Ruanda", "bhfranc Ruanda", "franc Ruanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyal na hAraibe Sádaí", PluralForms{"", "riyal na hAraibe Sádaí", "riyal na hAraibe Sádaí", "riyal na hAraibe Sádaí", "riyal na hAraibe Sádaí", "riyal na hAraibe Sádaí"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dollar Oileáin Sholomón", PluralForms{"", "dollar Oileáin Sholomón", "dhollar Oileáin Sholomón", "dhollar Oileáin Sholomón", "ndollar Oileáin Sholomón", "dollar Oileáin Sholomón"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rúipí na Séiséal", PluralForms{"", "rúipí na Séiséal", "rúipí na Séiséal", "rúipí na Séiséal", "rúipí na Séiséal", "rúipí na Séiséal"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Dinar na Súdáine (1992–2007)", PluralForms{"", "dinar na Súdáine (1992–2007)", "dhinar na Súdáine (1992–2007)", "dhinar na Súdáine (1992–2007)", "ndinar na Súdáine (1992–2007)", "dinar na Súdáine (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Punt na Súdáine", PluralForms{"", "phunt na Súdáine", "phunt na Súdáine", "phunt na Súdáine", "bpunt na Súdáine", "punt na Súdáine"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Punt na Súdáine (1957–1998)", PluralForms{"", "phunt na Súdáine (1957–1998)", "phunt na Súdáine (1957–1998)", "phunt na Súdáine (1957–1998)", "bpunt na Súdáine (1957–1998)", "punt na Súdáine (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Coróin na Sualainne", PluralForms{"", "choróin na Sualainne", "choróin na Sualainne", "choróin na Sualainne", "gcoróin na Sualainne", "coróin na Sualainne"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dollar Shingeapór", PluralForms{"", "dollar Shingeapór", "dhollar Shingeapór", "dhollar Shingeapór", "ndollar Shingeapór", "dollar Shingeapór"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Punt San Héilin", PluralForms{"", "phunt San Héilin", "phunt San Héilin", "phunt San Héilin", "bpunt San Héilin", "punt San Héilin"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Tolar na Slóivéine", PluralForms{"", "tolar na Slóivéine", "tholar na Slóivéine", "tholar na Slóivéine", "dtolar na Slóivéine", "tolar na Slóivéine"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Koruna na Slóvaice", PluralForms{"", "koruna na Slóvaice", "koruna na Slóvaice", "koruna na Slóvaice", "koruna na Slóvaice", "koruna na Slóvaice"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leone Shiarra Leon", PluralForms{"", "leone Shiarra Leon", "leone Shiarra Leon", "leone Shiarra Leon", "leone Shiarra Leon", "leone Shiarra Leon"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leone Shiarra Leon (1964—2022)", PluralForms{"", "leone Shiarra Leon (1964—2022)", "leone Shiarra Leon (1964—2022)", "leone Shiarra Leon (1964—2022)", "leone Shiarra Leon (1964—2022)", "leone Shiarra Leon (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Scilling na Somáile", PluralForms{"", "scilling na Somáile", "scilling na Somáile", "scilling na Somáile", "scilling na Somáile", "scilling na Somáile"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dollar Shuranam", PluralForms{"", "dollar Shuranam", "dhollar Shuranam", "dhollar Shuranam", "ndollar Shuranam", "dollar Shuranam"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Gildear Shuranam", PluralForms{"", "ghildear Shuranam", "ghildear Shuranam", "ghildear Shuranam", "ngildear Shuranam", "gildear Shuranam"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Punt na Súdáine Theas", PluralForms{"", "phunt na Súdáine Theas", "phunt na Súdáine Theas", "phunt na Súdáine Theas", "bpunt na Súdáine Theas", "punt na Súdáine Theas"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra São Tomé agus Príncipe (1977–2017)", PluralForms{"", "dobra São Tomé agus Príncipe (1977–2017)", "dhobra São Tomé agus Príncipe (1977–2017)", "dhobra São Tomé agus Príncipe (1977–2017)", "ndobra São Tomé agus Príncipe (1977–2017)", "dobra São Tomé agus Príncipe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra São Tomé agus Príncipe", PluralForms{"", "dobra São Tomé agus Príncipe", "dhobra São Tomé agus Príncipe", "dhobra São Tomé agus Príncipe", "ndobra São Tomé agus Príncipe", "dobra São Tomé agus Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Rúbal an Aontais Shóivéadaigh", PluralForms{"", "rúbal an Aontais Shóivéadaigh", "rúbal an Aontais Shóivéadaigh", "rúbal an Aontais Shóivéadaigh", "rúbal an Aontais Shóivéadaigh", "rúbal an Aontais Shóivéadaigh"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Colón na Salvadóire", PluralForms{"", "cholón na Salvadóire", "cholón na Salvadóire", "cholón na Salvadóire", "gcolón na Salvadóire", "colón na Salvadóire"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Punt na Siria", PluralForms{"", "phunt na Siria", "phunt na Siria", "phunt na Siria", "bpunt na Siria", "punt na Siria"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni na Suasalainne", PluralForms{"", "lilangeni na Suasalainne", "lilangeni na Suasalainne", "lilangeni na Suasalainne", "lilangeni na Suasalainne", "lilangeni na Suasalainne"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Baht na Téalainne", PluralForms{"", "bhaht na Téalainne", "bhaht na Téalainne", "bhaht na Téalainne", "mbaht na Téalainne", "baht na Téalainne"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Rúbal na Táidsíceastáine", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni na Táidsíceastáine", PluralForms{"", "somoni na Táidsíceastáine", "shomoni na Táidsíceastáine", "shomoni na Táidsíceastáine", "somoni na Táidsíceastáine", "somoni na Táidsíceastáine"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Manat na Tuircméanastáine (1993–2009)", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manat na Tuircméanastáine", PluralForms{"", "mhanat na Tuircméanastáine", "mhanat na Tuircméanastáine", "mhanat na Tuircméanastáine", "manat na Tuircméanastáine", "manat na Tuircméanastáine"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinar na Túinéise", PluralForms{"", "dinar na Túinéise", "dhinar na Túinéise", "dhinar na Túinéise", "ndinar na Túinéise", "dinar na Túinéise"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga Thonga", PluralForms{"", "phaʻanga Thonga", "phaʻanga Thonga", "phaʻanga Thonga", "bpaʻanga Thonga", "paʻanga Thonga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Escudo Tíomóir", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Lira na Tuirce (1922–2005)", PluralForms{"", "lira na Tuirce (1922–2005)", "lira na Tuirce (1922–2005)", "lira na Tuirce (1922–2005)", "lira na Tuirce (1922–2005)", "lira na Tuirce (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lira na Tuirce", PluralForms{"", "lira na Tuirce", "lira na Tuirce", "lira na Tuirce", "lira na Tuirce", "lira na Tuirce"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dollar Oileán na Tríonóide agus Tobága", PluralForms{"", "dollar Oileán na Tríonóide agus Tobága", "dhollar Oileán na Tríonóide agus Tobága", "dhollar Oileán na Tríonóide agus Tobága", "ndollar Oileán na Tríonóide agus Tobága", "dollar Oileán na Tríonóide agus Tobága"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Dollar Nua na Téaváine", PluralForms{"", "dollar nua na Téaváine", "dhollar nua na Téaváine", "dhollar nua na Téaváine", "ndollar nua na Téaváine", "dollar nua na Téaváine"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Scilling na Tansáine", PluralForms{"", "scilling na Tansáine", "scilling na Tansáine", "scilling na Tansáine", "scilling na Tansáine", "scilling na Tansáine"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Hryvnia na hÚcráine", PluralForms{"", "hryvnia na hÚcráine", "hryvnia na hÚcráine", "hryvnia na hÚcráine", "hryvnia na hÚcráine", "hryvnia na hÚcráine"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Karbovanets Úcránach", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Scilling Uganda (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Scilling Uganda", PluralForms{"", "scilling Uganda", "scilling Uganda", "scilling Uganda", "scilling Uganda", "scilling Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dollar S.A.M.", PluralForms{"", "dollar S.A.M.", "dhollar S.A.M.", "dhollar S.A.M.", "ndollar S.A.M.", "dollar S.A.M."}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Dollar S.A.M. (an chéad lá eile)", PluralForms{"", "dollar S.A.M. (an chéad lá eile)", "dhollar S.A.M. (an chéad lá eile)", "dhollar S.A.M. (an chéad lá eile)", "ndollar S.A.M. (an chéad lá eile)", "dollar S.A.M. (an chéad lá eile)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Dollar S.A.M. (an lá céanna)", PluralForms{"", "dollar S.A.M. (an lá céanna)", "dhollar S.A.M. (an lá céanna)", "dhollar S.A.M. (an lá céanna)", "ndollar S.A.M. (an lá céanna)", "dollar S.A.M. (an lá céanna)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Peso Uragua (1975–1993)", PluralForms{"", "pheso Uragua (1975–1993)", "pheso Uragua (1975–1993)", "pheso Uragua (1975–1993)", "bpeso Uragua (1975–1993)", "peso Uragua (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso Uragua", PluralForms{"", "pheso Uragua", "pheso Uragua", "pheso Uragua", "bpeso Uragua", "peso Uragua"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Sum na hÚisbéiceastáine", PluralForms{"", "sum na hÚisbéiceastáine", "shum na hÚisbéiceastáine", "shum na hÚisbéiceastáine", "sum na hÚisbéiceastáine", "sum na hÚisbéiceastáine"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Bolívar Veiniséala (1871–2008)", PluralForms{"", "bholívar Veiniséala (1871–2008)", "bholívar Veiniséala (1871–2008)", "bholívar Veiniséala (1871–2008)", "mbolívar Veiniséala (1871–2008)", "bolívar Veiniséala (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolívar Veiniséala (2008–2018)", PluralForms{"", "bholívar Veiniséala (2008–2018)", "bholívar Veiniséala (2008–2018)", "bholívar Veiniséala (2008–2018)", "mbolívar Veiniséala (2008–2018)", "bolívar Veiniséala (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolívar Veiniséala", PluralForms{"", "bholívar Veiniséala", "bholívar Veiniséala", "bholívar Veiniséala", "mbolívar Veiniséala", "bolívar Veiniséala"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dong Vítneam", PluralForms{"", "dong Vítneam", "dhong Vítneam", "dhong Vítneam", "ndong Vítneam", "Dong Vítneam"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Dong Vítneam (1978–1985)", PluralForms{"", "dong Vítneam (1978–1985)", "dhong Vítneam (1978–1985)", "dhong Vítneam (1978–1985)", "ndong Vítneam (1978–1985)", "dong Vítneam (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu Vanuatú", PluralForms{"", "vatu Vanuatú", "vatu Vanuatú", "vatu Vanuatú", "vatu Vanuatú", "vatu Vanuatú"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala Shamó", PluralForms{"", "tala Shamó", "thala Shamó", "thala Shamó", "dtala Shamó", "tala Shamó"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Franc CFA na hAfraice Láir", PluralForms{"", "fhranc CFA na hAfraice Láir", "fhranc CFA na hAfraice Láir", "fhranc CFA na hAfraice Láir", "bhfranc CFA na hAfraice Láir", "franc CFA na hAfraice Láir"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Airgead", PluralForms{"", "unsa troí airgid", "unsa troí airgid", "unsaí troí airgid", "unsaí troí airgid", "unsaí troí airgid"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Ór", PluralForms{"", "unsa troí óir", "unsa troí óir", "unsaí troí óir", "unsaí troí óir", "unsaí troí óir"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Aonad Ilchodach Eorpach", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Aonad Airgeadaíochta Eorpach", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Aonad Cuntais Eorpach (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Aonad Cuntais Eorpach (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dollar na Cairibe Thoir", PluralForms{"", "dollar na Cairibe Thoir", "dhollar na Cairibe Thoir", "dhollar na Cairibe Thoir", "ndollar na Cairibe Thoir", "dollar na Cairibe Thoir"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Cearta Speisialta Tarraingthe", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Aonad Airgeadra Eorpach", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Franc Ór Francach", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "UIC-Franc Francach", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Franc CFA Iarthar na hAfraice", PluralForms{"", "fhranc CFA Iarthar na hAfraice", "fhranc CFA Iarthar na hAfraice", "fhranc CFA Iarthar na hAfraice", "bhfranc CFA Iarthar na hAfraice", "franc CFA Iarthar na hAfraice"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Pallaidiam", PluralForms{"", "unsa troí pallaidiam", "unsa troí pallaidiam", "unsaí troí pallaidiam", "unsaí troí pallaidiam", "unsaí troí pallaidiam"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Franc CFP", PluralForms{"", "fhranc CFP", "fhranc CFP", "fhranc CFP", "bhfranc CFP", "franc CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platanam", PluralForms{"", "unsa troí platanaim", "unsa troí platanaim", "unsaí troí platanaim", "unsaí troí platanaim", "unsaí troí platanaim"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "Airgeadra Anaithnid", PluralForms{"", "(airgeadra anaithnid)", "(airgeadra anaithnid)", "(airgeadra anaithnid)", "(airgeadra anaithnid)", "(airgeadra anaithnid)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Dínear Éimin", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Rial Éimin", PluralForms{"", "rial Éimin", "rial Éimin", "rial Éimin", "rial Éimin", "rial Éimin"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Dínear Crua Iúgslavach (1966–1990)", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Dínear Nua Iúgslavach (1994–2002)", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Dinar Inmhalartaithe Iúgslavach (1990–1992)", PluralForms{"", "Dínear Inathraithe Iúgslavach", "Dínear Inathraithe Iúgslavach", "Dínear Inathraithe Iúgslavach", "Dínear Inathraithe Iúgslavach", "Dínear Inathraithe Iúgslavach"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Dinar Leasaithe na hIúgsláive (1992–1993)", PluralForms{"", "Dinar Leasaithe na hIúgsláive (1992–1993)", "Dinar Leasaithe na hIúgsláive (1992–1993)", "Dinar Leasaithe na hIúgsláive (1992–1993)", "Dinar Leasaithe na hIúgsláive (1992–1993)", "Dinars Leasaithe na hIúgsláive (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Rand na hAfraice Theas (airgeadúil)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Rand na hAfraice Theas", PluralForms{"", "rand na hAfraice Theas", "rand na hAfraice Theas", "rand na hAfraice Theas", "rand na hAfraice Theas", "rand na hAfraice Theas"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha Saimbiach (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kwacha na Saimbia", PluralForms{"", "kwacha na Saimbia", "kwacha na Saimbia", "kwacha na Saimbia", "kwacha na Saimbia", "kwacha na Saimbia"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zaire Nua Sáíreach", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zaire Sáíreach", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dollar Siombábach (1980–2008)", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Dollar na Siombáibe (2009)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,315 +6,368 @@ var gd = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 mìltean", "one": "0 mhìle", "other": "0 mìle", "two": "0 mhìle"}},
+				{4, 2, map[string]string{"few": "0 mìltean", "one": "0 mhìle", "other": "0 mìle", "two": "0 mhìle"}},
+				{5, 3, map[string]string{"few": "0 mìltean", "one": "0 mhìle", "other": "0 mìle", "two": "0 mhìle"}},
+				{6, 1, map[string]string{"few": "0 milleanan", "one": "0 mhillean", "other": "0 millean", "two": "0 mhillean"}},
+				{7, 2, map[string]string{"few": "0 milleanan", "one": "0 mhillean", "other": "0 millean", "two": "0 mhillean"}},
+				{8, 3, map[string]string{"few": "0 milleanan", "one": "0 mhillean", "other": "0 millean", "two": "0 mhillean"}},
+				{9, 1, map[string]string{"few": "0 billeanan", "one": "0 bhillean", "other": "0 billean", "two": "0 bhillean"}},
+				{10, 2, map[string]string{"few": "0 billeanan", "one": "0 bhillean", "other": "0 billean", "two": "0 bhillean"}},
+				{11, 3, map[string]string{"few": "0 billeanan", "one": "0 bhillean", "other": "0 billean", "two": "0 bhillean"}},
+				{12, 1, map[string]string{"few": "0 trilleanan", "one": "0 trillean", "other": "0 trillean", "two": "0 thrillean"}},
+				{13, 2, map[string]string{"few": "0 trilleanan", "one": "0 trillean", "other": "0 trillean", "two": "0 thrillean"}},
+				{14, 3, map[string]string{"few": "0 trilleanan", "one": "0 trillean", "other": "0 trillean", "two": "0 thrillean"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "¤0K", "one": "¤0K", "other": "¤0K", "two": "¤0K"}},
+				{4, 2, map[string]string{"few": "¤0K", "one": "¤0K", "other": "¤0K", "two": "¤0K"}},
+				{5, 3, map[string]string{"few": "¤0K", "one": "¤0K", "other": "¤0K", "two": "¤0K"}},
+				{6, 1, map[string]string{"few": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M"}},
+				{7, 2, map[string]string{"few": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M"}},
+				{8, 3, map[string]string{"few": "¤0M", "one": "¤0M", "other": "¤0M", "two": "¤0M"}},
+				{9, 1, map[string]string{"few": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B"}},
+				{10, 2, map[string]string{"few": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B"}},
+				{11, 3, map[string]string{"few": "¤0B", "one": "¤0B", "other": "¤0B", "two": "¤0B"}},
+				{12, 1, map[string]string{"few": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T"}},
+				{13, 2, map[string]string{"few": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T"}},
+				{14, 3, map[string]string{"few": "¤0T", "one": "¤0T", "other": "¤0T", "two": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "leu"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UYW": {4, "UYW", "UYW", "UYW"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VED": {2, "VED", "VED", "VED"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Peseta Andorrach", PluralForms{"", "pheseta Andorrach", "pheseta Andorrach", "peseta Andorrach", "", "peseta Andorrach"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirham nan Iomaratan Arabach Aonaichte", PluralForms{"", "dirham nan IAA", "dhirham nan IAA", "dirham nan IAA", "", "dirham nan IAA"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afghani Afghanach (1927–2002)", PluralForms{"", "afghani Afghanach (1927–2002)", "afghani Afghanach (1927–2002)", "afghani Afghanach (1927–2002)", "", "afghani Afghanach (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghani Afghanach", PluralForms{"", "afghani Afghanach", "afghani Afghanach", "afghani Afghanach", "", "afghani Afghanach"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Lek Albàineach (1946–1965)", PluralForms{"", "lek Albàineach (1946–1965)", "lek Albàineach (1946–1965)", "lek Albàineach (1946–1965)", "", "lek Albàineach (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lek Albàineach", PluralForms{"", "lek Albàineach", "lek Albàineach", "lek Albàineach", "", "lek Albàineach"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dram Airmeineach", PluralForms{"", "dram Airmeineach", "dhram Airmeineach", "dram Airmeineach", "", "dram Airmeineach"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Gulden Eileanan Aintilia nan Tìrean Ìsle", PluralForms{"", "ghulden Eileanan Aintilia nan Tìrean Ìsle", "ghulden Eileanan Aintilia nan Tìrean Ìsle", "gulden Eileanan Aintilia nan Tìrean Ìsle", "", "gulden Eileanan Aintilia nan Tìrean Ìsle"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kwanza Angòlach", PluralForms{"", "kwanza Angòlach", "kwanza Angòlach", "kwanza Angòlach", "", "kwanza Angòlach"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Kwanza Angòlach (1977–1991)", PluralForms{"", "kwanza Angòlach (1977–1991)", "kwanza Angòlach (1977–1991)", "kwanza Angòlach (1977–1991)", "", "kwanza Angòlach (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Kwanza ùr Angòlach (1990–2000)", PluralForms{"", "kwanza ùr Angòlach (1990–2000)", "kwanza ùr Angòlach (1990–2000)", "kwanza ùr Angòlach (1990–2000)", "", "kwanza ùr Angòlach (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Kwanza ath-ghleusaichte Angòlach (1995–1999)", PluralForms{"", "kwanza ath-ghleusaichte Angòlach (1995–1999)", "kwanza ath-ghleusaichte Angòlach (1995–1999)", "kwanza ath-ghleusaichte Angòlach (1995–1999)", "", "kwanza ath-ghleusaichte Angòlach (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Austral Argantaineach", PluralForms{"", "austral Argantaineach", "austral Argantaineach", "austral Argantaineach", "", "austral Argantaineach"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Peso ley Argantaineach (1970–1983)", PluralForms{"", "pheso ley Argantaineach (1970–1983)", "pheso ley Argantaineach (1970–1983)", "pesothan ley Argantaineach (1970–1983)", "", "peso ley Argantaineach (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Peso Argantaineach (1881–1970)", PluralForms{"", "pheso Argantaineach (1881–1970)", "pheso Argantaineach (1881–1970)", "pesothan Argantaineach (1881–1970)", "", "peso Argantaineach (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Peso Argantaineach (1983–1985)", PluralForms{"", "pheso Argantaineach (1983–1985)", "pheso Argantaineach (1983–1985)", "pesothan Argantaineach (1983–1985)", "", "peso Argantaineach (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso Argantaineach", PluralForms{"", "pheso Argantaineach", "pheso Argantaineach", "pesothan Argantaineach", "", "peso Argantaineach"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Schilling Ostaireach", PluralForms{"", "schilling Ostaireach", "schilling Ostaireach", "schilling Ostaireach", "", "schilling Ostaireach"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dolar Astràilianach", PluralForms{"", "dolar Astràilianach", "dholar Astràilianach", "dolaran Astràilianach", "", "dolar Astràilianach"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Florin Arùbach", PluralForms{"", "fhlorin Arùbach", "fhlorin Arùbach", "florin Arùbach", "", "florin Arùbach"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Manat Asarbaideànach (1993–2006)", PluralForms{"", "mhanat Asarbaideànach (1993–2006)", "mhanat Asarbaideànach (1993–2006)", "manat Asarbaideànach (1993–2006)", "", "manat Asarbaideànach (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manat Asarbaideànach", PluralForms{"", "mhanat Asarbaideànach", "mhanat Asarbaideànach", "manat Asarbaideànach", "", "manat Asarbaideànach"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Dinar Bhosna agus Hearsagobhana (1992–1994)", PluralForms{"", "dinar Bhosna agus Hearsagobhana (1992–1994)", "dhinar Bhosna agus Hearsagobhana (1992–1994)", "dinar Bhosna agus Hearsagobhana (1992–1994)", "", "dinar Bhosna agus Hearsagobhana (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Mark iompachail Bhosna agus Hearsagobhana", PluralForms{"", "mhark iompachail Bhosna agus Hearsagobhana", "mhark iompachail Bhosna agus Hearsagobhana", "mark iompachail Bhosna agus Hearsagobhana", "", "mark iompachail Bhosna agus Hearsagobhana"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Dinar ùr Bhosna agus Hearsagobhana (1994–1997)", PluralForms{"", "dinar ùr Bhosna agus Hearsagobhana (1994–1997)", "dhinar ùr Bhosna agus Hearsagobhana (1994–1997)", "dinar ùr Bhosna agus Hearsagobhana (1994–1997)", "", "dinar ùr Bhosna agus Hearsagobhana (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Dolar Barbadach", PluralForms{"", "dolar Barbadach", "dholar Barbadach", "dolaran Barbadach", "", "dolar Barbadach"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka Bangladaiseach", PluralForms{"", "taka Bangladaiseach", "thaka Bangladaiseach", "taka Bangladaiseach", "", "taka Bangladaiseach"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Franc Beilgeach (iompachail)", PluralForms{"", "fhranc Beilgeach (iompachail)", "fhranc Beilgeach (iompachail)", "franc Beilgeach (iompachail)", "", "franc Beilgeach (iompachail)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Franc Beilgeach", PluralForms{"", "fhranc Beilgeach", "fhranc Beilgeach", "franc Beilgeach", "", "franc Beilgeach"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Franc Beilgeach (ionmhasail)", PluralForms{"", "fhranc Beilgeach (ionmhasail)", "fhranc Beilgeach (ionmhasail)", "franc Beilgeach (ionmhasail)", "", "franc Beilgeach (ionmhasail)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Lev cruaidh Bulgarach", PluralForms{"", "lev cruaidh Bulgarach", "lev cruaidh Bulgarach", "lev cruaidh Bulgarach", "", "lev cruaidh Bulgarach"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Lev sòisealach Bulgarach", PluralForms{"", "lev sòisealach Bulgarach", "lev sòisealach Bulgarach", "lev sòisealach Bulgarach", "", "lev sòisealach Bulgarach"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lev Bulgarach", PluralForms{"", "lev Bulgarach", "lev Bulgarach", "lev Bulgarach", "", "lev Bulgarach"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Lev Bulgarach (1879–1952)", PluralForms{"", "lev Bulgarach (1879–1952)", "lev Bulgarach (1879–1952)", "lev Bulgarach (1879–1952)", "", "lev Bulgarach (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinar Bachraineach", PluralForms{"", "dinar Bachraineach", "dhinar Bachraineach", "dinar Bachraineach", "", "dinar Bachraineach"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Franc Burundaidheach", PluralForms{"", "fhranc Burundaidheach", "fhranc Burundaidheach", "franc Burundaidheach", "", "franc Burundaidheach"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dolar Bearmùdach", PluralForms{"", "dolar Bearmùdach", "dholar Bearmùdach", "dolaran Bearmùdach", "", "dolar Bearmùdach"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dolar Brùnaigheach", PluralForms{"", "dolar Brùnaigheach", "dholar Brùnaigheach", "dolaran Brùnaigheach", "", "dolar Brùnaigheach"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviano Boilibhiach", PluralForms{"", "bholiviano Boilibhiach", "bholiviano Boilibhiach", "boliviano Boilibhiach", "", "boliviano Boilibhiach"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Boliviano Boilibhiach (1863–1963)", PluralForms{"", "bholiviano Boilibhiach (1863–1963)", "bholiviano Boilibhiach (1863–1963)", "boliviano Boilibhiach (1863–1963)", "", "boliviano Boilibhiach (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Peso Boilibhiach", PluralForms{"", "pheso Boilibhiach", "pheso Boilibhiach", "pesothan Boilibhiach", "", "peso Boilibhiach"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Mvdol Boilibhiach", PluralForms{"", "mvdol Boilibhiach", "mvdol Boilibhiach", "mvdol Boilibhiach", "", "mvdol Boilibhiach"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Cruzeiro ùr Braisileach (1967–1986)", PluralForms{"", "chruzeiro ùr Braisileach (1967–1986)", "chruzeiro ùr Braisileach (1967–1986)", "cruzeiro ùr Braisileach (1967–1986)", "", "cruzeiro ùr Braisileach (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Cruzado Braisileach (1986–1989)", PluralForms{"", "chruzado Braisileach (1986–1989)", "chruzado Braisileach (1986–1989)", "cruzado Braisileach (1986–1989)", "", "cruzado Braisileach (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Cruzeiro Braisileach (1990–1993)", PluralForms{"", "chruzeiro Braisileach (1990–1993)", "chruzeiro Braisileach (1990–1993)", "cruzeiro Braisileach (1990–1993)", "", "cruzeiro Braisileach (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Real Braisileach", PluralForms{"", "real Braisileach", "real Braisileach", "real Braisileach", "", "real Braisileach"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Cruzado ùr Braisileach (1989–1990)", PluralForms{"", "chruzado ùr Braisileach (1989–1990)", "chruzado ùr Braisileach (1989–1990)", "cruzado ùr Braisileach (1989–1990)", "", "cruzado ùr Braisileach (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Cruzeiro Braisileach (1993–1994)", PluralForms{"", "chruzeiro Braisileach (1993–1994)", "chruzeiro Braisileach (1993–1994)", "cruzeiro Braisileach (1993–1994)", "", "cruzeiro Braisileach (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Cruzeiro Braisileach (1942–1967)", PluralForms{"", "chruzeiro Braisileach (1942–1967)", "chruzeiro Braisileach (1942–1967)", "cruzeiro Braisileach (1942–1967)", "", "cruzeiro Braisileach (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dolar Bathamach", PluralForms{"", "dolar Bathamach", "dholar Bathamach", "dolaran Bathamach", "", "dolar Bathamach"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrum Butànach", PluralForms{"", "ngultrum Butànach", "ngultrum Butànach", "ngultrum Butànach", "", "ngultrum Butànach"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Kyat Burmach", PluralForms{"", "kyat Burmach", "kyat Burmach", "kyat Burmach", "", "kyat Burmach"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula Botsuanach", PluralForms{"", "phula Botsuanach", "phula Botsuanach", "pula Botsuanach", "", "pula Botsuanach"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Rùbal ùr Bealaruiseach (1994–1999)", PluralForms{"", "rùbal ùr Bealaruiseach (1994–1999)", "rùbal ùr Bealaruiseach (1994–1999)", "rùbalan ùra Bealaruiseach (1994–1999)", "", "rùbal ùr Bealaruiseach (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Rùbal Bealaruiseach", PluralForms{"", "rùbal Bealaruiseach", "rùbal Bealaruiseach", "rùbalan Bealaruiseach", "", "rùbal Bealaruiseach"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rùbal Bealaruiseach (2000–2016)", PluralForms{"", "rùbal Bealaruiseach (2000–2016)", "rùbal Bealaruiseach (2000–2016)", "rùbalan Bealaruiseach (2000–2016)", "", "rùbal Bealaruiseach (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dolar Beilìseach", PluralForms{"", "dolar Beilìseach", "dholar Beilìseach", "dolaran Beilìseach", "", "dolar Beilìseach"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dolar Canadach", PluralForms{"", "dolar Canadach", "dholar Canadach", "dolaran Canadach", "", "dolar Canadach"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Franc Congothach", PluralForms{"", "fhranc Congothach", "fhranc Congothach", "franc Congothach", "", "franc Congothach"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Eòro WIR", PluralForms{"", "Eòro WIR", "Eòro WIR", "Eòrothan WIR", "", "Eòro WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Franc Eilbheiseach", PluralForms{"", "fhranc Eilbheiseach", "fhranc Eilbheiseach", "franc Eilbheiseach", "", "franc Eilbheiseach"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Franc WIR", PluralForms{"", "fhranc WIR", "fhranc WIR", "franc WIR", "", "franc WIR"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Escudo Sileach", PluralForms{"", "escudo Sileach", "escudo Sileach", "escudo Sileach", "", "escudo Sileach"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Aonad cunntasachd Sileach (UF)", PluralForms{"", "aonad cunntasachd Sileach (UF)", "aonad cunntasachd Sileach (UF)", "aonadan cunntasachd Sileach (UF)", "", "aonad cunntasachd Sileach (UF)"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso Sileach", PluralForms{"", "pheso Sileach", "pheso Sileach", "pesothan Sileach", "", "peso Sileach"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuan Sìneach (far-thìreach)", PluralForms{"", "yuan Sìneach (far-thìreach)", "yuan Sìneach (far-thìreach)", "yuan Sìneach (far-thìreach)", "", "yuan Sìneach (far-thìreach)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Dolar an t-sluagh-bhanca Shìnich", PluralForms{"", "dolar an t-sluagh-bhanca Shìnich", "dholar an t-sluagh-bhanca Shìnich", "dolaran an t-sluagh-bhanca Shìnich", "", "dolar an t-sluagh-bhanca Shìnich"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuan Sìneach", PluralForms{"", "yuan Sìneach", "yuan Sìneach", "yuan Sìneach", "", "yuan Sìneach"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso Coloimbeach", PluralForms{"", "pheso Coloimbeach", "pheso Coloimbeach", "pesothan Coloimbeach", "", "peso Coloimbeach"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Aonad fìor-luach Coloimbeach", PluralForms{"", "aonad fìor-luach Coloimbeach", "aonad fìor-luach Coloimbeach", "aonadan fìor-luach Coloimbeach", "", "aonad fìor-luach Coloimbeach"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colón Costa Rìceach", PluralForms{"", "cholón Chosta Rìcea", "cholón Costa Rìceach", "colón Costa Rìceach", "", "colón Costa Rìceach"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Dinar Sèirbeach (2002–2006)", PluralForms{"", "dinar Sèirbeach (2002–2006)", "dhinar Sèirbeach (2002–2006)", "dinar Sèirbeach (2002–2006)", "", "dinar Sèirbeach (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Koruna cruaidh Seic-Slòbhacach", PluralForms{"", "koruna cruaidh Seic-Slòbhacach", "koruna cruaidh Seic-Slòbhacach", "koruna cruaidh Seic-Slòbhacach", "", "koruna cruaidh Seic-Slòbhacach"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso iompachail Cùbach", PluralForms{"", "pheso iompachail Cùbach", "pheso iompachail Cùbach", "pesothan iompachail Cùbach", "", "peso iompachail Cùbach"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso Cùbach", PluralForms{"", "pheso Cùbach", "pheso Cùbach", "pesothan Cùbach", "", "peso Cùbach"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Escudo a’ Chip Uaine", PluralForms{"", "escudo a’ Chip Uaine", "escudo a’ Chip Uaine", "escudo a’ Chip Uaine", "", "escudo a’ Chip Uaine"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Punnd Cìoprasach", PluralForms{"", "phunnd Cìoprasach", "phunnd Cìoprasach", "puinnd Chìoprasach", "", "punnd Cìoprasach"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna Seiceach", PluralForms{"", "koruna Seiceach", "koruna Seiceach", "koruna Seiceach", "", "koruna Seiceach"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Mark na Gearmailte an Ear", PluralForms{"", "mhark na Gearmailte an Ear", "mhark na Gearmailte an Ear", "mark na Gearmailte an Ear", "", "mark na Gearmailte an Ear"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Mark Gearmailteach", PluralForms{"", "mhark Gearmailteach", "mhark Gearmailteach", "mark Gearmailteach", "", "mark Gearmailteach"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Franc Diobùtaidheach", PluralForms{"", "fhranc Diobùtaidheach", "fhranc Diobùtaidheach", "franc Diobùtaidheach", "", "franc Diobùtaidheach"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Krone Danmhairgeach", PluralForms{"", "krone Danmhairgeach", "krone Danmhairgeach", "kroner Danmhairgeach", "", "krone Danmhairgeach"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso Doiminiceach", PluralForms{"", "pheso Doiminiceach", "pheso Doiminiceach", "pesothan Doiminiceach", "", "peso Doiminiceach"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinar Aildireach", PluralForms{"", "dinar Aildireach", "dhinar Aildireach", "dinar Aildireach", "", "dinar Aildireach"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Sucre Eacuadorach", PluralForms{"", "sucre Eacuadorach", "shucre Eacuadorach", "sucre Eacuadorach", "", "sucre Eacuadorach"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Aonad luach chunbhalaich Eacuadorach", PluralForms{"", "aonad luach chunbhalaich Eacuadorach", "aonad luach chunbhalaich Eacuadorach", "aonadan luach chunbhalaich Eacuadorach", "", "aonad luach chunbhalaich Eacuadorach"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Kroon Eastoineach", PluralForms{"", "kroon Eastoineach", "kroon Eastoineach", "kroon Eastoineach", "", "kroon Eastoineach"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Punnd Èipheiteach", PluralForms{"", "phunnd Èipheiteach", "phunnd Èipheiteach", "puinnd Èipheiteach", "", "punnd Èipheiteach"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa Eartrach", PluralForms{"", "nakfa Eartrach", "nakfa Eartrach", "nakfa Eartrach", "", "nakfa Eartrach"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Peseta Spàinnteach (cunntas A)", PluralForms{"", "pheseta Spàinnteach (cunntas A)", "pheseta Spàinnteach (cunntas A)", "peseta Spàinnteach (cunntas A)", "", "peseta Spàinnteach (cunntas A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Peseta Spàinnteach (cunntas iompachail)", PluralForms{"", "pheseta Spàinnteach (cunntas iompachail)", "pheseta Spàinnteach (cunntas iompachail)", "peseta Spàinnteach (cunntas iompachail)", "", "peseta Spàinnteach (cunntas iompachail)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Peseta Spàinnteach", PluralForms{"", "pheseta Spàinnteach", "pheseta Spàinnteach", "peseta Spàinnteach", "", "peseta Spàinnteach"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birr Itiopach", PluralForms{"", "bhirr Itiopach", "bhirr Itiopach", "birr Itiopach", "", "birr Itiopach"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Eòro", PluralForms{"", "Eòro", "Eòro", "Eòrothan", "", "Eòro"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Markka Fionnlannach", PluralForms{"", "mharkka Fionnlannach", "mharkka Fionnlannach", "markka Fionnlannach", "", "markka Fionnlannach"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dolar Fìditheach", PluralForms{"", "dolar Fìditheach", "dholar Fìditheach", "dolaran Fìditheach", "", "dolar Fìditheach"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Punnd Fàclannach", PluralForms{"", "phunnd Fàclannach", "phunnd Fàclannach", "puinnd Fhàclannach", "", "punnd Fàclannach"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Franc Frangach", PluralForms{"", "fhranc Frangach", "fhranc Frangach", "franc Frangach", "", "franc Frangach"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Punnd Sasannach", PluralForms{"", "phunnd Sasannach", "phunnd Sasannach", "puinnd Shasannach", "", "punnd Sasannach"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Kupon larit Cairtbheileach", PluralForms{"", "kupon larit Cairtbheileach", "kupon larit Cairtbheileach", "kupon larit Cairtbheileach", "", "kupon larit Cairtbheileach"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lari Cairtbheileach", PluralForms{"", "lari Cairtbheileach", "lari Cairtbheileach", "lari Cairtbheileach", "", "lari Cairtbheileach"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Cedi Gànach (1979–2007)", PluralForms{"", "chedi Gànach (1979–2007)", "chedi Gànach (1979–2007)", "cedi Gànach (1979–2007)", "", "cedi Gànach (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Cedi Gànach", PluralForms{"", "chedi Gànach", "chedi Gànach", "cedi Gànach", "", "cedi Gànach"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Punnd Diobraltarach", PluralForms{"", "phunnd Diobraltarach", "phunnd Diobraltarach", "puinnd Dhiobraltarach", "", "punnd Diobraltarach"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi Gaimbitheach", PluralForms{"", "dalasi Gaimbitheach", "dhalasi Gaimbitheach", "dalasi Gaimbitheach", "", "dalasi Gaimbitheach"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Franc Ginitheach", PluralForms{"", "fhranc Ginitheach", "fhranc Ginitheach", "franc Ginitheach", "", "franc Ginitheach"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Syli Ginitheach", PluralForms{"", "syli Ginitheach", "shyli Ginitheach", "syli Ginitheach", "", "syli Ginitheach"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekwele Gini Meadhan-Chriosaich", PluralForms{"", "ekwele Gini Meadhan-Chriosaich", "ekwele Gini Meadhan-Chriosaich", "ekwele Gini Meadhan-Chriosaich", "", "ekwele Gini Meadhan-Chriosaich"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Drachma Greugach", PluralForms{"", "dhrachma Greugach", "dhrachma Greugach", "drachma Greugach", "", "drachma Greugach"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal Guatamalach", PluralForms{"", "quetzal Guatamalach", "quetzal Guatamalach", "quetzal Guatamalach", "", "quetzal Guatamalach"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Escudo Gini na Portagaile", PluralForms{"", "escudo Gini na Portagaile", "escudo Gini na Portagaile", "escudo Gini na Portagaile", "", "escudo Gini na Portagaile"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Peso Gini-Biosothach", PluralForms{"", "pheso Gini-Biosothach", "pheso Gini-Biosothach", "pesothan Gini-Biosothach", "", "peso Gini-Biosothach"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dolar Guidheànach", PluralForms{"", "dolar Guidheànach", "dholar Guidheànach", "dolaran Guidheànach", "", "dolar Guidheànach"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dolar Hong Kong", PluralForms{"", "dolar Hong Kong", "dholar Hong Kong", "dolaran Hong Kong", "", "dolar Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira Hondùrach", PluralForms{"", "lempira Hondùrach", "lempira Hondùrach", "lempira Hondùrach", "", "lempira Hondùrach"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Dinar Cròthaiseach", PluralForms{"", "dinar Cròthaiseach", "dhinar Cròthaiseach", "dinar Cròthaiseach", "", "dinar Cròthaiseach"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuna Cròthaiseach", PluralForms{"", "kuna Cròthaiseach", "kuna Cròthaiseach", "kuna Cròthaiseach", "", "kuna Cròthaiseach"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gourde Haidhteach", PluralForms{"", "ghourde Haidhteach", "ghourde Haidhteach", "gourde Haidhteach", "", "gourde Haidhteach"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Forint Ungaireach", PluralForms{"", "fhorint Ungaireach", "fhorint Ungaireach", "forint Ungaireach", "", "forint Ungaireach"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupiah Innd-Innseach", PluralForms{"", "rupiah Innd-Innseach", "rupiah Innd-Innseach", "rupiah Innd-Innseach", "", "rupiah Innd-Innseach"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Punnd Èireannach", PluralForms{"", "phunnd Èireannach", "phunnd Èireannach", "puinnd Èireannach", "", "punnd Èireannach"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Punnd Iosraeleach", PluralForms{"", "phunnd Iosraeleach", "phunnd Iosraeleach", "puinnd Iosraeleach", "", "punnd Iosraeleach"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Secel Iosraeleach (1980–1985)", PluralForms{"", "shecel Iosraeleach (1980–1985)", "shecel Iosraeleach (1980–1985)", "secelean Iosraeleach (1980–1985)", "", "secel Iosraeleach (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Secel ùr Iosraeleach", PluralForms{"", "shecel ùr Iosraeleach", "shecel ùr Iosraeleach", "secelean ùra Iosraeleach", "", "secel ùr Iosraeleach"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupee Innseanach", PluralForms{"", "rupee Innseanach", "rupee Innseanach", "rupee Innseanach", "", "rupee Innseanach"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinar Ioràcach", PluralForms{"", "dinar Ioràcach", "dhinar Ioràcach", "dinar Ioràcach", "", "dinar Ioràcach"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial Iorànach", PluralForms{"", "rial Iorànach", "rial Iorànach", "rial Iorànach", "", "rial Iorànach"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Króna Innis Tìleach (1918–1981)", PluralForms{"", "króna Innis Tìleach (1918–1981)", "króna Innis Tìleach (1918–1981)", "krónur Innis Tìleach (1918–1981)", "", "króna Innis Tìleach (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Króna Innis Tìleach", PluralForms{"", "króna Innis Tìleach", "króna Innis Tìleach", "krónur Innis Tìleach", "", "króna Innis Tìleach"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Lira Eadailteach", PluralForms{"", "lira Eadailteach", "lira Eadailteach", "lira Eadailteach", "", "lira Eadailteach"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dolar Diameugach", PluralForms{"", "dolar Diameugach", "dholar Diameugach", "dolaran Diameugach", "", "dolar Diameugach"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinar Iòrdanach", PluralForms{"", "dinar Iòrdanach", "dhinar Iòrdanach", "dinar Iòrdanach", "", "dinar Iòrdanach"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yen Seapanach", PluralForms{"", "yen Seapanach", "yen Seapanach", "yen Seapanach", "", "yen Seapanach"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Shilling Ceineach", PluralForms{"", "shilling Ceineach", "shilling Ceineach", "shilling Ceineach", "", "shilling Ceineach"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som Cìorgasach", PluralForms{"", "som Cìorgasach", "shom Cìorgasach", "som Cìorgasach", "", "som Cìorgasach"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riel Cambuideach", PluralForms{"", "riel Cambuideach", "riel Cambuideach", "riel Cambuideach", "", "riel Cambuideach"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Franc Comorosach", PluralForms{"", "fhranc Comorosach", "fhranc Comorosach", "franc Comorosach", "", "franc Comorosach"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won Choirèa a Tuath", PluralForms{"", "won Choirèa a Tuath", "won Choirèa a Tuath", "won Choirèa a Tuath", "", "won Choirèa a Tuath"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Hwan Choirèa a Deas (1953–1962)", PluralForms{"", "hwan Choirèa a Deas (1953–1962)", "hwan Choirèa a Deas (1953–1962)", "hwan Choirèa a Deas (1953–1962)", "", "hwan Choirèa a Deas (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Won Choirèa a Deas (1945–1953)", PluralForms{"", "won Choirèa a Deas (1945–1953)", "won Choirèa a Deas (1945–1953)", "won Choirèa a Deas (1945–1953)", "", "won Choirèa a Deas (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Won Choirèa a Deas", PluralForms{"", "won Choirèa a Deas", "won Choirèa a Deas", "won Choirèa a Deas", "", "won Choirèa a Deas"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinar Cuibhèiteach", PluralForms{"", "dinar Cuibhèiteach", "dhinar Cuibhèiteach", "dinar Cuibhèiteach", "", "dinar Cuibhèiteach"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dolar Caimeanach", PluralForms{"", "dolar Caimeanach", "dholar Caimeanach", "dolaran Caimeanach", "", "dolar Caimeanach"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge Casachach", PluralForms{"", "tenge Casachach", "thenge Casachach", "tenge Casachach", "", "tenge Casachach"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kip Làthosach", PluralForms{"", "kip Làthosach", "kip Làthosach", "kip Làthosach", "", "kip Làthosach"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Punnd Leabanach", PluralForms{"", "phunnd Leabanach", "phunnd Leabanach", "puinnd Leabanach", "", "punnd Leabanach"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rupee Sri Lancach", PluralForms{"", "rupee Sri Lancach", "rupee Sri Lancach", "rupee Sri Lancach", "", "rupee Sri Lancach"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dolar Libèireach", PluralForms{"", "dolar Libèireach", "dholar Libèireach", "dolaran Libèireach", "", "dolar Libèireach"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti Leasotach", PluralForms{"", "loti Leasotach", "loti Leasotach", "loti Leasotach", "", "loti Leasotach"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas Liotuaineach", PluralForms{"", "litas Liotuaineach", "litas Liotuaineach", "litas Liotuaineach", "", "litas Liotuaineach"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Talonas Liotuaineach", PluralForms{"", "talonas Liotuaineach", "thalonas Liotuaineach", "talonas Liotuaineach", "", "talonas Liotuaineach"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Franc iompachail Lugsamburgach", PluralForms{"", "fhranc iompachail Lugsamburgach", "fhranc iompachail Lugsamburgach", "franc iompachail Lugsamburgach", "", "franc iompachail Lugsamburgach"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Franc Lugsamburgach", PluralForms{"", "fhranc Lugsamburgach", "fhranc Lugsamburgach", "franc Lugsamburgach", "", "franc Lugsamburgach"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Franc ionmhasail Lugsamburgach", PluralForms{"", "fhranc ionmhasail Lugsamburgach", "fhranc ionmhasail Lugsamburgach", "franc ionmhasail Lugsamburgach", "", "franc ionmhasail Lugsamburgach"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats Laitbheach", PluralForms{"", "lats Laitbheach", "lats Laitbheach", "lats Laitbheach", "", "lats Laitbheach"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Rùbal Laitbheach", PluralForms{"", "rùbal Laitbheach", "rùbal Laitbheach", "rùbalan Laitbheach", "", "rùbal Laitbheach"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinar Libitheach", PluralForms{"", "dinar Libitheach", "dhinar Libitheach", "dinar Libitheach", "", "dinar Libitheach"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dirham Morocach", PluralForms{"", "dirham Morocach", "dhirham Morocach", "dirham Morocach", "", "dirham Morocach"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Franc Morocach", PluralForms{"", "fhranc Morocach", "fhranc Morocach", "franc Morocach", "", "franc Morocach"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Franc Monacach", PluralForms{"", "fhranc Monacach", "fhranc Monacach", "franc Monacach", "", "franc Monacach"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Cupon Moldobhach", PluralForms{"", "chupon Moldobhach", "chupon Moldobhach", "cupon Moldobhach", "", "cupon Moldobhach"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leu Moldobhach", PluralForms{"", "leu Moldobhach", "leu Moldobhach", "leu Moldobhach", "", "leu Moldobhach"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ariary Madagasgarach", PluralForms{"", "ariary Madagasgarach", "ariary Madagasgarach", "ariary Madagasgarach", "", "ariary Madagasgarach"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Franc Madagasgarach", PluralForms{"", "fhranc Madagasgarach", "fhranc Madagasgarach", "franc Madagasgarach", "", "franc Madagasgarach"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Denar Masadonach", PluralForms{"", "denar Masadonach", "dhenar Masadonach", "denar Masadonach", "", "denar Masadonach"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Denar Masadonach (1992–1993)", PluralForms{"", "denar Masadonach (1992–1993)", "dhenar Masadonach (1992–1993)", "denar Masadonach (1992–1993)", "", "denar Masadonach (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Franc Màilitheach", PluralForms{"", "fhranc Màilitheach", "fhranc Màilitheach", "franc Màilitheach", "", "franc Màilitheach"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kyat Miànmarach", PluralForms{"", "kyat Miànmarach", "kyat Miànmarach", "kyat Miànmarach", "", "kyat Miànmarach"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik Mongolach", PluralForms{"", "tugrik Mongolach", "thugrik Mongolach", "tugrik Mongolach", "", "tugrik Mongolach"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataca Macàthuach", PluralForms{"", "phataca Macàthuach", "phataca Macàthuach", "pataca Macàthuach", "", "pataca Macàthuach"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya Moratàineach (1973–2017)", PluralForms{"", "ouguiya Moratàineach (1973–2017)", "ouguiya Moratàineach (1973–2017)", "ouguiya Moratàineach (1973–2017)", "", "ouguiya Moratàineach (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya Moratàineach", PluralForms{"", "ouguiya Moratàineach", "ouguiya Moratàineach", "ouguiya Moratàineach", "", "ouguiya Moratàineach"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Lira Maltach", PluralForms{"", "lira Maltach", "lira Maltach", "lira Maltach", "", "lira Maltach"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Punnd Maltach", PluralForms{"", "phunnd Maltach", "phunnd Maltach", "puinnd Mhaltach", "", "punnd Maltach"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rupee Moiriseasach", PluralForms{"", "rupee Moiriseasach", "rupee Moiriseasach", "rupee Moiriseasach", "", "rupee Moiriseasach"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Rupee Maladaibheach", PluralForms{"", "rupee Maladaibheach", "rupee Maladaibheach", "rupee Maladaibheach", "", "rupee Maladaibheach"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyaa Maladaibheach", PluralForms{"", "rufiyaa Maladaibheach", "rufiyaa Maladaibheach", "rufiyaa Maladaibheach", "", "rufiyaa Maladaibheach"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kwacha Malabhaidheach", PluralForms{"", "kwacha Malabhaidheach", "kwacha Malabhaidheach", "kwacha Malabhaidheach", "", "kwacha Malabhaidheach"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso Meagsagach", PluralForms{"", "pheso Meagsagach", "pheso Meagsagach", "pesothan Meagsagach", "", "peso Meagsagach"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Peso airgid Meagsagach (1861–1992)", PluralForms{"", "pheso airgid Meagsagach (1861–1992)", "pheso airgid Meagsagach (1861–1992)", "pesothan airgid Meagsagach (1861–1992)", "", "peso airgid Meagsagach (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Aonad inbheistidh Meagsagach", PluralForms{"", "aonad inbheistidh Meagsagach", "aonad inbheistidh Meagsagach", "aonadan inbheistidh Meagsagach", "", "aonad inbheistidh Meagsagach"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringgit Malaidheach", PluralForms{"", "ringgit Malaidheach", "ringgit Malaidheach", "ringgit Malaidheach", "", "ringgit Malaidheach"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Escudo Mòsaimbiceach", PluralForms{"", "escudo Mòsaimbiceach", "escudo Mòsaimbiceach", "escudo Mòsaimbiceach", "", "escudo Mòsaimbiceach"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metical Mòsaimbiceach (1980–2006)", PluralForms{"", "mhetical Mòsaimbiceach (1980–2006)", "mhetical Mòsaimbiceach (1980–2006)", "metical Mòsaimbiceach (1980–2006)", "", "metical Mòsaimbiceach (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metical Mòsaimbiceach", PluralForms{"", "mhetical Mòsaimbiceach", "mhetical Mòsaimbiceach", "metical Mòsaimbiceach", "", "metical Mòsaimbiceach"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dolar Naimibitheach", PluralForms{"", "dolar Naimibitheach", "dholar Naimibitheach", "dolaran Naimibitheach", "", "dolar Naimibitheach"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naira Nigèiriach", PluralForms{"", "naira Nigèiriach", "naira Nigèiriach", "naira Nigèiriach", "", "naira Nigèiriach"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Córdoba Niocaragach (1988–1991)", PluralForms{"", "chórdoba Niocaragach (1988–1991)", "chórdoba Niocaragach (1988–1991)", "córdoba Niocaragach (1988–1991)", "", "córdoba Niocaragach (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Córdoba Niocaragach", PluralForms{"", "chórdoba Niocaragach", "chórdoba Niocaragach", "córdoba Niocaragach", "", "córdoba Niocaragach"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Gulden Duitseach", PluralForms{"", "ghulden Duitseach", "ghulden Duitseach", "gulden Duitseach", "", "gulden Duitseach"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Krone Nirribheach", PluralForms{"", "krone Nirribheach", "krone Nirribheach", "kroner Nirribheach", "", "krone Nirribheach"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rupee Neapàlach", PluralForms{"", "rupee Neapàlach", "rupee Neapàlach", "rupee Neapàlach", "", "rupee Neapàlach"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dolar Shealainn Nuaidh", PluralForms{"", "dolar Shealainn Nuaidh", "dholar Shealainn Nuaidh", "dolaran Shealainn Nuaidh", "", "dolar Shealainn Nuaidh"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Rial Omànach", PluralForms{"", "rial Omànach", "rial Omànach", "rial Omànach", "", "rial Omànach"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa Panamach", PluralForms{"", "bhalboa Panamach", "bhalboa Panamach", "balboa Panamach", "", "balboa Panamach"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Inti Pearùthach", PluralForms{"", "inti Pearùthach", "inti Pearùthach", "inti Pearùthach", "", "inti Pearùthach"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol Pearùthach", PluralForms{"", "sol Pearùthach", "shol Pearùthach", "sol Pearùthach", "", "sol Pearùthach"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Sol Pearùthach (1863–1965)", PluralForms{"", "sol Pearùthach (1863–1965)", "shol Pearùthach (1863–1965)", "sol Pearùthach (1863–1965)", "", "sol Pearùthach (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina Ghini Nuaidh Paputhaiche", PluralForms{"", "kina Ghini Nuaidh Paputhaiche", "kina Ghini Nuaidh Paputhaiche", "kina Ghini Nuaidh Paputhaiche", "", "kina Ghini Nuaidh Paputhaiche"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Peso Filipineach", PluralForms{"", "pheso Filipineach", "pheso Filipineach", "pesothan Filipineach", "", "peso Filipineach"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rupee Pagastànach", PluralForms{"", "rupee Pagastànach", "rupee Pagastànach", "rupee Pagastànach", "", "rupee Pagastànach"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Złoty Pòlainneach", PluralForms{"", "złoty Pòlainneach", "złoty Pòlainneach", "złoty Pòlainneach", "", "złoty Pòlainneach"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Złoty Pòlainneach (1950–1995)", PluralForms{"", "złoty Pòlainneach (1950–1995)", "złoty Pòlainneach (1950–1995)", "złoty Pòlainneach (1950–1995)", "", "złoty Pòlainneach (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Escudo Portagaileach", PluralForms{"", "escudo Portagaileach", "escudo Portagaileach", "escudo Portagaileach", "", "escudo Portagaileach"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guaraní Paraguaidheach", PluralForms{"", "ghuaraní Paraguaidheach", "ghuaraní Paraguaidheach", "guaraní Paraguaidheach", "", "guaraní Paraguaidheach"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Rial Catarach", PluralForms{"", "rial Catarach", "rial Catarach", "rial Catarach", "", "rial Catarach"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Dolar Rhodesiach", PluralForms{"", "dolar Rhodesiach", "dholar Rhodesiach", "dolaran Rhodesiach", "", "dolar Rhodesiach"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Leu Romàineach (1952–2006)", PluralForms{"", "leu Romàineach (1952–2006)", "leu Romàineach (1952–2006)", "leu Romàineach (1952–2006)", "", "leu Romàineach (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "leu", "Leu Romàineach", PluralForms{"", "leu Romàineach", "leu Romàineach", "leu Romàineach", "", "leu Romàineach"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinar Sèirbeach", PluralForms{"", "dinar Sèirbeach", "dhinar Sèirbeach", "dinar Sèirbeach", "", "dinar Sèirbeach"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rùbal Ruiseach", PluralForms{"", "rùbal Ruiseach", "rùbal Ruiseach", "rùbalan Ruiseach", "", "rùbal Ruiseach"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "Rùbal Ruiseach (1991–1998)", PluralForms{"", "rùbal Ruiseach (1991–1998)", "rùbal Ruiseach (1991–1998)", "rùbalan Ruiseach (1991–1998)", "", "rùbal Ruiseach (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Franc Rubhandach", PluralForms{"", "fhranc Rubhandach", "fhranc Rubhandach", "franc Rubhandach", "", "franc Rubhandach"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyal Sabhdach", PluralForms{"", "riyal Sabhdach", "riyal Sabhdach", "riyal Sabhdach", "", "riyal Sabhdach"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dolar Eileanan Sholaimh", PluralForms{"", "dolar Eileanan Sholaimh", "dholar Eileanan Sholaimh", "dolaran Eileanan Sholaimh", "", "dolar Eileanan Sholaimh"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rupee Seiseallach", PluralForms{"", "rupee Seiseallach", "rupee Seiseallach", "rupee Seiseallach", "", "rupee Seiseallach"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Dinar Sudànach (1992–2007)", PluralForms{"", "dinar Sudànach (1992–2007)", "dhinar Sudànach (1992–2007)", "dinar Sudànach (1992–2007)", "", "dinar Sudànach (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Punnd Sudànach", PluralForms{"", "phunnd Sudànach", "phunnd Sudànach", "puinnd Shudànach", "", "punnd Sudànach"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Punnd Sudànach (1957–1998)", PluralForms{"", "phunnd Sudànach (1957–1998)", "phunnd Sudànach (1957–1998)", "puinnd Shudànach (1957–1998)", "", "punnd Sudànach (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Krona Suaineach", PluralForms{"", "krona Suaineach", "krona Suaineach", "kronor Suaineach", "", "krona Suaineach"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dolar Singeapòrach", PluralForms{"", "dolar Singeapòrach", "dholar Singeapòrach", "dolaran Singeapòrach", "", "dolar Singeapòrach"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Punnd Eilean Naomh Eilidh", PluralForms{"", "phunnd Eilean Naomh Eilidh", "phunnd Eilean Naomh Eilidh", "puinnd Eilean Naomh Eilidh", "", "punnd Eilean Naomh Eilidh"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Tolar Slòbhaineach", PluralForms{"", "tolar Slòbhaineach", "tholar Slòbhaineach", "tolar Slòbhaineach", "", "tolar Slòbhaineach"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Koruna Slòbhacach", PluralForms{"", "koruna Slòbhacach", "koruna Slòbhacach", "koruna Slòbhacach", "", "koruna Slòbhacach"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leone Siarra Leòmhannach ùr", PluralForms{"", "leone Siarra Leòmhannach ùr", "leone Siarra Leòmhannach ùr", "leone Siarra Leòmhannach ùr", "", "leone Siarra Leòmhannach ùr"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leone Siarra Leòmhannach", PluralForms{"", "leone Siarra Leòmhannach", "leone Siarra Leòmhannach", "leone Siarra Leòmhannach", "", "leone Siarra Leòmhannach"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Shilling Somàilitheach", PluralForms{"", "shilling Somàilitheach", "shilling Somàilitheach", "shilling Somàilitheach", "", "shilling Somàilitheach"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dolar Suranamach", PluralForms{"", "dolar Suranamach", "dholar Suranamach", "dolaran Suranamach", "", "dolar Suranamach"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Gulden Suranamach", PluralForms{"", "ghulden Suranamach", "ghulden Suranamach", "gulden Suranamach", "", "gulden Suranamach"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Punnd Sudàin a Deas", PluralForms{"", "phunnd Sudàin a Deas", "phunnd Sudàin a Deas", "puinnd Shudàin a Deas", "", "punnd Sudàin a Deas"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra São Tomé agus Príncipe (1977–2017)", PluralForms{"", "dobra São Tomé agus Príncipe (1977–2017)", "dhobra São Tomé agus Príncipe (1977–2017)", "dobra São Tomé agus Príncipe (1977–2017)", "", "dobra São Tomé agus Príncipe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra São Tomé agus Príncipe", PluralForms{"", "dobra São Tomé agus Príncipe", "dhobra São Tomé agus Príncipe", "dobra São Tomé agus Príncipe", "", "dobra São Tomé agus Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Rùbal Sovietach", PluralForms{"", "rùbal Sovietach", "rùbal Sovietach", "rùbalan Sovietach", "", "rùbal Sovietach"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Colón Salbhadorach", PluralForms{"", "cholón Salbhadorach", "cholón Salbhadorach", "colón Salbhadorach", "", "colón Salbhadorach"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Punnd Siridheach", PluralForms{"", "phunnd Siridheach", "phunnd Siridheach", "puinnd Shiridheach", "", "punnd Siridheach"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni Suasaidheach", PluralForms{"", "lilangeni Suasaidheach", "lilangeni Suasaidheach", "lilangeni Suasaidheach", "", "lilangeni Suasaidheach"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Baht Tàidheach", PluralForms{"", "bhaht Tàidheach", "bhaht Tàidheach", "baht Tàidheach", "", "baht Tàidheach"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Rùbal Taidigeach", PluralForms{"", "rùbal Taidigeach", "rùbal Taidigeach", "rùbalan Taidigeach", "", "rùbal Taidigeach"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni Taidigeach", PluralForms{"", "somoni Taidigeach", "shomoni Taidigeach", "somoni Taidigeach", "", "somoni Taidigeach"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Manat Turcmanach (1993–2009)", PluralForms{"", "mhanat Turcmanach (1993–2009)", "mhanat Turcmanach (1993–2009)", "manat Turcmanach (1993–2009)", "", "manat Turcmanach (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manat Turcmanach", PluralForms{"", "mhanat Turcmanach", "mhanat Turcmanach", "manat Turcmanach", "", "manat Turcmanach"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinar Tuiniseach", PluralForms{"", "dinar Tuiniseach", "dhinar Tuiniseach", "dinar Tuiniseach", "", "dinar Tuiniseach"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga Tongach", PluralForms{"", "phaʻanga Tongach", "phaʻanga Tongach", "paʻanga Tongach", "", "paʻanga Tongach"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Escudo Tìomorach", PluralForms{"", "escudo Tìomorach", "escudo Tìomorach", "escudo Tìomorach", "", "escudo Tìomorach"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Lira Turcach (1922–2005)", PluralForms{"", "lira Turcach (1922–2005)", "lira Turcach (1922–2005)", "lira Turcach (1922–2005)", "", "lira Turcach (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lira Turcach", PluralForms{"", "lira Turcach", "lira Turcach", "lira Turcach", "", "lira Turcach"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dolar Thrianaid agus Thobago", PluralForms{"", "dolar Thrianaid agus Thobago", "dholar Thrianaid agus Thobago", "dolaran Thrianaid agus Thobago", "", "dolar Thrianaid agus Thobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Dolar ùr Taidh-Bhànach", PluralForms{"", "dolar ùr Taidh-Bhànach", "dholar ùr Taidh-Bhànach", "dolaran ùra Taidh-Bhànach", "", "dolar ùr Taidh-Bhànach"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Shilling Tansanaidheach", PluralForms{"", "shilling Tansanaidheach", "shilling Tansanaidheach", "shilling Tansanaidheach", "", "shilling Tansanaidheach"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Hryvnia Ucràineach", PluralForms{"", "hryvnia Ucràineach", "hryvnia Ucràineach", "hryvnia Ucràineach", "", "hryvnia Ucràineach"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Karbovanets Ucràineach", PluralForms{"", "karbovanets Ucràineach", "karbovanets Ucràineach", "karbovanets Ucràineach", "", "karbovanets Ucràineach"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Shilling Ugandach (1966–1987)", PluralForms{"", "shilling Ugandach (1966–1987)", "shilling Ugandach (1966–1987)", "shilling Ugandach (1966–1987)", "", "shilling Ugandach (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Shilling Ugandach", PluralForms{"", "shilling Ugandach", "shilling Ugandach", "shilling Ugandach", "", "shilling Ugandach"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dolar nan Stàitean Aonaichte", PluralForms{"", "dolar nan Stàitean Aonaichte", "dholar nan Stàitean Aonaichte", "dolaran nan Stàitean Aonaichte", "", "dolar nan Stàitean Aonaichte"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Dolar nan SA (an ath–latha)", PluralForms{"", "dolar nan SA (an ath–latha)", "dholar nan SA (an ath–latha)", "dolaran nan SA (an ath–latha)", "", "dolar nan SA (an ath–latha)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Dolar nan SA (an aon latha)", PluralForms{"", "dolar nan SA (an aon latha)", "dholar nan SA (an aon latha)", "dolaran nan SA (an aon latha)", "", "dolar nan SA (an aon latha)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Peso Uruguaidheach (aonadan inneacsaichte)", PluralForms{"", "pheso Uruguaidheach (aonadan inneacsaichte)", "pheso Uruguaidheach (aonadan inneacsaichte)", "pesothan Uruguaidheach (aonadan inneacsaichte)", "", "peso Uruguaidheach (aonadan inneacsaichte)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Peso Uruguaidheach (1975–1993)", PluralForms{"", "pheso Uruguaidheach (1975–1993)", "pheso Uruguaidheach (1975–1993)", "pesothan Uruguaidheach (1975–1993)", "", "peso Uruguaidheach (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso Uruguaidheach", PluralForms{"", "pheso Uruguaidheach", "pheso Uruguaidheach", "pesothan Uruguaidheach", "", "peso Uruguaidheach"}},
+		"UYW": {4, 0, 4, 1, "UYW", "UYW", "UYW", "Aonad inneacs tuarastail ainmeach Uruguaidh", PluralForms{"", "aonad inneacs tuarastail ainmeach Uruguaidh", "aonad inneacs tuarastail ainmeach Uruguaidh", "aonadan inneacs tuarastail ainmeach Uruguaidh", "", "aonad inneacs tuarastail ainmeach Uruguaidh"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Som Usbagach", PluralForms{"", "som Usbagach", "shom Usbagach", "som Usbagach", "", "som Usbagach"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Bolívar Bheinisealach (1871–2008)", PluralForms{"", "bholívar Bheinisealach (1871–2008)", "bholívar Bheinisealach (1871–2008)", "bolívar Bheinisealach (1871–2008)", "", "bolívar Bheinisealach (1871–2008)"}},
+		"VED": {2, 0, 2, 1, "VED", "VED", "VED", "Bolívar Soberano", PluralForms{"", "bholívar Soberano", "bholívar Soberano", "bolívar Soberano", "", "bolívar Soberano"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolívar Bheinisealach (2008–2018)", PluralForms{"", "bholívar Bheinisealach (2008–2018)", "bholívar Bheinisealach (2008–2018)", "bolívar Bheinisealach (2008–2018)", "", "bolívar Bheinisealach (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolívar Bheinisealach", PluralForms{"", "bholívar Bheinisealach", "bholívar Bheinisealach", "bolívar Bheinisealach", "", "bolívar Bheinisealach"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dong Bhiet-Namach", PluralForms{"", "dong Bhiet-Namach", "dhong Bhiet-Namach", "dong Bhiet-Namach", "", "dong Bhiet-Namach"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Dong Bhiet-Namach (1978–1985)", PluralForms{"", "dong Bhiet-Namach (1978–1985)", "dhong Bhiet-Namach (1978–1985)", "dong Bhiet-Namach (1978–1985)", "", "dong Bhiet-Namach (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu Vanuatuthach", PluralForms{"", "vatu Vanuatuthach", "vatu Vanuatuthach", "vatu Vanuatuthach", "", "vatu Vanuatuthach"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala Samothach", PluralForms{"", "tala Samothach", "thala Samothach", "tala Samothach", "", "tala Samothach"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Franc CFA Meadhan-Afragach", PluralForms{"", "fhranc CFA Meadhan-Afragach", "fhranc CFA Meadhan-Afragach", "franc CFA Meadhan-Afragach", "", "franc CFA Meadhan-Afragach"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Airgead", PluralForms{"", "unnsa tròidh airgid", "unnsa tròidh airgid", "unnsachan tròidh airgid", "", "unnsa tròidh airgid"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Òr", PluralForms{"", "unnsa tròidh òir", "unnsa tròidh òir", "unnsachan tròidh òir", "", "unnsa tròidh òir"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Aonad co-dhèanta Eòrpach", PluralForms{"", "aonad co-dhèanta Eòrpach", "aonad co-dhèanta Eòrpach", "aonadan co-dhèanta Eòrpach", "", "aonad co-dhèanta Eòrpach"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Aonad airgid Eòrpach", PluralForms{"", "aonad airgid Eòrpach", "aonad airgid Eòrpach", "aonadan airgid Eòrpach", "", "aonad airgid Eòrpach"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Aonad cunntasachd Eòrpach (XBC)", PluralForms{"", "aonad cunntasachd Eòrpach (XBC)", "aonad cunntasachd Eòrpach (XBC)", "aonadan cunntasachd Eòrpach (XBC)", "", "aonad cunntasachd Eòrpach (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Aonad cunntasachd Eòrpach (XBD)", PluralForms{"", "aonad cunntasachd Eòrpach (XBD)", "aonad cunntasachd Eòrpach (XBD)", "aonadan cunntasachd Eòrpach (XBD)", "", "aonad cunntasachd Eòrpach (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dolar Caraibeach earach", PluralForms{"", "dolar Caraibeach earach", "dholar Caraibeach earach", "dolaran Caraibeach earach", "", "dolar Caraibeach earach"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "Gulden Caraibeach", PluralForms{"", "ghulden Caraibeach", "ghulden Caraibeach", "gulden Caraibeach", "", "gulden Caraibeach"}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Còir tarraing shònraichte", PluralForms{"", "chòir tarraing shònraichte", "chòir tarraing shònraichte", "còirichean tarraing sònraichte", "", "còir tarraing shònraichte"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Aonad airgeadra Eòrpach", PluralForms{"", "aonad airgeadra Eòrpach", "aonad airgeadra Eòrpach", "aonadan airgeadra Eòrpach", "", "aonad airgeadra Eòrpach"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Franc òir Frangach", PluralForms{"", "fhranc òir Frangach", "fhranc òir Frangach", "franc òir Frangach", "", "franc òir Frangach"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Franc UIC Frangach", PluralForms{"", "fhranc UIC Frangach", "fhranc UIC Frangach", "franc UIC Frangach", "", "franc UIC Frangach"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Franc CFA Afraga an Iar", PluralForms{"", "fhranc CFA Afraga an Iar", "fhranc CFA Afraga an Iar", "franc CFA Afraga an Iar", "", "franc CFA Afraga an Iar"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Pallaideam", PluralForms{"", "unnsa tròidh pallaideim", "unnsa tròidh pallaideim", "unnsachan tròidh pallaideim", "", "unnsa tròidh pallaideim"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Franc CFP", PluralForms{"", "fhranc CFP", "fhranc CFP", "franc CFP", "", "franc CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platanam", PluralForms{"", "unnsa tròidh platanaim", "unnsa tròidh platanaim", "unnsachan tròidh platanaim", "", "unnsa tròidh platanaim"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "Aonad maoine RINET", PluralForms{"", "aonad maoine RINET", "aonad maoine RINET", "aonadan maoine RINET", "", "aonad maoine RINET"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "Sucre", PluralForms{"", "sucre", "sucre", "sucre", "", "sucre"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Còd airgeadra fo dheuchainn", PluralForms{"", "aonad airgeadra fo dheuchainn", "aonad airgeadra fo dheuchainn", "aonadan airgeadra fo dheuchainn", "", "aonad airgeadra fo dheuchainn"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "Aonad cunntasachd ADB", PluralForms{"", "aonad cunntasachd ADB", "aonad cunntasachd ADB", "aonadan cunntasachd ADB", "", "aonad cunntasachd ADB"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "Airgeadra neo-aithnichte", PluralForms{"", "(aonad airgeadra neo–aithnichte)", "(aonad airgeadra neo–aithnichte)", "(aonadan airgeadra neo–aithnichte)", "", "(aonad airgeadra neo–aithnichte)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Dinar Eamanach", PluralForms{"", "dinar Eamanach", "dhinar Eamanach", "dinar Eamanach", "", "dinar Eamanach"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Rial Eamanach", PluralForms{"", "rial Eamanach", "rial Eamanach", "rial Eamanach", "", "rial Eamanach"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Dinar cruaidh Iùgoslabhach (1966–1990)", PluralForms{"", "dinar cruaidh Iùgoslabhach (1966–1990)", "dhinar cruaidh Iùgoslabhach (1966–1990)", "dinar cruaidh Iùgoslabhach (1966–1990)", "", "dinar cruaidh Iùgoslabhach (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Dinar ùr Iùgoslabhach (1994–2002)", PluralForms{"", "dinar ùr Iùgoslabhach (1994–2002)", "dhinar ùr Iùgoslabhach (1994–2002)", "dinar ùr Iùgoslabhach (1994–2002)", "", "dinar ùr Iùgoslabhach (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Dinar iompachail Iùgoslabhach (1990–1992)", PluralForms{"", "dinar iompachail Iùgoslabhach (1990–1992)", "dhinar iompachail Iùgoslabhach (1990–1992)", "dinar iompachail Iùgoslabhach (1990–1992)", "", "dinar iompachail Iùgoslabhach (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Dinar ath-leasaichte Iùgoslabhach (1992–1993)", PluralForms{"", "dinar ath-leasaichte Iùgoslabhach (1992–1993)", "dhinar ath-leasaichte Iùgoslabhach (1992–1993)", "dinar ath-leasaichte Iùgoslabhach (1992–1993)", "", "dinar ath-leasaichte Iùgoslabhach (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Rand Afraga a Deas (ionmhasail)", PluralForms{"", "rand Afraga a Deas (ionmhasail)", "rand Afraga a Deas (ionmhasail)", "rand Afraga a Deas (ionmhasail)", "", "rand Afraga a Deas (ionmhasail)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Rand Afraga a Deas", PluralForms{"", "rand Afraga a Deas", "rand Afraga a Deas", "rand Afraga a Deas", "", "rand Afraga a Deas"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha Sàimbitheach (1968–2012)", PluralForms{"", "kwacha Sàimbitheach (1968–2012)", "kwacha Sàimbitheach (1968–2012)", "kwacha Sàimbitheach (1968–2012)", "", "kwacha Sàimbitheach (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kwacha Sàimbitheach", PluralForms{"", "kwacha Sàimbitheach", "kwacha Sàimbitheach", "kwacha Sàimbitheach", "", "kwacha Sàimbitheach"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zaïre ùr Zaïreach (1993–1998)", PluralForms{"", "zaïre ùr Zaïreach (1993–1998)", "zaïre ùr Zaïreach (1993–1998)", "zaïre ùr Zaïreach (1993–1998)", "", "zaïre ùr Zaïreach (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zaïre Zaïreach (1971–1993)", PluralForms{"", "zaïre Zaïreach (1971–1993)", "zaïre Zaïreach (1971–1993)", "zaïre Zaïreach (1971–1993)", "", "zaïre Zaïreach (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dolar Sìombabuthach (1980–2008)", PluralForms{"", "dolar Sìombabuthach (1980–2008)", "dholar Sìombabuthach (1980–2008)", "dolaran Sìombabuthach (1980–2008)", "", "dolar Sìombabuthach (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Dolar Sìombabuthach (2009)", PluralForms{"", "dolar Sìombabuthach (2009)", "dholar Sìombabuthach (2009)", "dolaran Sìombabuthach (2009)", "", "dolar Sìombabuthach (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Dolar Sìombabuthach (2008)", PluralForms{"", "dolar Sìombabuthach (2008)", "dholar Sìombabuthach (2008)", "dolaran Sìombabuthach (2008)", "", "dolar Sìombabuthach (2008)"}},
 	},
 }
@@ -6,221 +6,271 @@ var gl = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{7, 2, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{8, 3, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{9, 4, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{10, 5, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{11, 6, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{12, 1, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{13, 2, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+				{14, 3, map[string]string{"one": "0\u00a0B", "other": "0\u00a0B"}},
+			},
+			[]CompactFormat{
+				{6, 1, map[string]string{"one": "0 millón", "other": "0 millóns"}},
+				{7, 2, map[string]string{"one": "0 millóns", "other": "0 millóns"}},
+				{8, 3, map[string]string{"one": "0 millóns", "other": "0 millóns"}},
+				{9, 4, map[string]string{"one": "0 millóns", "other": "0 millóns"}},
+				{10, 5, map[string]string{"one": "0 millóns", "other": "0 millóns"}},
+				{11, 6, map[string]string{"one": "0 millóns", "other": "0 millóns"}},
+				{12, 1, map[string]string{"one": "0 billón", "other": "0 billóns"}},
+				{13, 2, map[string]string{"one": "0 billóns", "other": "0 billóns"}},
+				{14, 3, map[string]string{"one": "0 billóns", "other": "0 billóns"}},
+			},
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{9, 4, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{10, 5, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{11, 6, map[string]string{"one": "0\u00a0M¤", "other": "0\u00a0M¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0B¤", "other": "0\u00a0B¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0B¤", "other": "0\u00a0B¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0B¤", "other": "0\u00a0B¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "Br"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "₧", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "FC"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "$MX", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "руб"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "ƒ", "ƒ"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "peseta andorrana", PluralForms{"", "peseta andorrana", "", "", "", "pesetas andorranas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham dos Emiratos Árabes Unidos", PluralForms{"", "dirham dos Emiratos Árabes Unidos", "", "", "", "dirhams dos Emiratos Árabes Unidos"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afgán", PluralForms{"", "afgani afgán", "", "", "", "afganis afgáns"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albanés", PluralForms{"", "lek albanés", "", "", "", "lekë albaneses"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armenio", PluralForms{"", "dram armenio", "", "", "", "drams armenios"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "florín das Antillas Neerlandesas", PluralForms{"", "florín das Antillas Neerlandesas", "", "", "", "floríns das Antillas Neerlandesas"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolano", PluralForms{"", "kwanza angolano", "", "", "", "kwanzas angolanos"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Peso arxentino (1983–1985)", PluralForms{"", "peso arxentino (ARP)", "", "", "", "pesos arxentinos (ARP)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso arxentino", PluralForms{"", "peso arxentino", "", "", "", "pesos arxentinos"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "dólar australiano", PluralForms{"", "dólar australiano", "", "", "", "dólares australianos"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florín de Aruba", PluralForms{"", "florín de Aruba", "", "", "", "floríns de Aruba"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat acerbaixano", PluralForms{"", "manat acerbaixano", "", "", "", "manats acerbaixanos"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marco convertible de Bosnia e Hercegovina", PluralForms{"", "marco convertible de Bosnia e Hercegovina", "", "", "", "marcos convertibles de Bosnia e Hercegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dólar de Barbados", PluralForms{"", "dólar de Barbados", "", "", "", "dólares de Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka de Bangladesh", PluralForms{"", "taka de Bangladesh", "", "", "", "takas de Bangladesh"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Franco belga (convertible)", PluralForms{"", "franco belga (convertible)", "", "", "", "francos belgas (convertibles)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Franco belga", PluralForms{"", "franco belga", "", "", "", "francos belgas"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Franco belga (financeiro)", PluralForms{"", "franco belga (financeiro)", "", "", "", "francos belgas (financeiros)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev búlgaro", PluralForms{"", "lev búlgaro", "", "", "", "leva búlgaros"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar de Bahrain", PluralForms{"", "dinar de Bahrain", "", "", "", "dinares de Bahrain"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franco burundiano", PluralForms{"", "franco burundiano", "", "", "", "francos burundianos"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dólar bermudano", PluralForms{"", "dólar bermudano", "", "", "", "dólares bermudanos"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dólar de Brunei", PluralForms{"", "dólar de Brunei", "", "", "", "dólares de Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano", PluralForms{"", "boliviano", "", "", "", "bolivianos"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Peso boliviano", PluralForms{"", "peso boliviano", "", "", "", "pesos bolivianos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "MVDOL boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Cruzeiro novo brasileiro (1967–1986)", PluralForms{"", "cruzeiro novo brasileiro", "", "", "", "cruzeiros novos brasileiros"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Cruzado brasileiro", PluralForms{"", "cruzado brasileiro", "", "", "", "cruzados brasileiros"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Cruzeiro brasileiro (1990–1993)", PluralForms{"", "cruzeiro brasileiro (BRE)", "", "", "", "cruzeiros brasileiros (BRE)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "real brasileiro", PluralForms{"", "real brasileiro", "", "", "", "reais brasileiros"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Cruzado novo brasileiro", PluralForms{"", "cruzado novo brasileiro", "", "", "", "cruzados novos brasileiros"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Cruzeiro brasileiro", PluralForms{"", "cruzeiro brasileiro", "", "", "", "cruzeiros brasileiros"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dólar bahamés", PluralForms{"", "dólar bahamés", "", "", "", "dólares bahameses"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum butanés", PluralForms{"", "ngultrum butanés", "", "", "", "ngultrums butaneses"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula botswaniano", PluralForms{"", "pula botswaniano", "", "", "", "pulas botswanianos"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "Br", "rublo belaruso", PluralForms{"", "rublo belaruso", "", "", "", "rublos belarusos"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rublo bielorruso (2000–2016)", PluralForms{"", "rublo bielorruso (2000–2016)", "", "", "", "rublos bielorrusos (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dólar belizense", PluralForms{"", "dólar belizense", "", "", "", "dólares belizenses"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "dólar canadense", PluralForms{"", "dólar canadense", "", "", "", "dólares canadenses"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franco congolés", PluralForms{"", "franco congolés", "", "", "", "francos congoleses"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franco suízo", PluralForms{"", "franco suízo", "", "", "", "francos suízos"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Unidades de fomento chilenas", PluralForms{"", "unidade de fomento chilena", "", "", "", "unidades de fomento chilenas"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso chileno", PluralForms{"", "peso chileno", "", "", "", "pesos chilenos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "iuán chinés (extracontinental)", PluralForms{"", "iuán chinés (extracontinental)", "", "", "", "iuáns chineses (extracontinentais)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "iuán chinés", PluralForms{"", "iuán chinés", "", "", "", "iuáns chineses"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso colombiano", PluralForms{"", "peso colombiano", "", "", "", "pesos colombianos"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colón costarriqueño", PluralForms{"", "colón costarriqueño", "", "", "", "colóns costarriqueños"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cubano convertible", PluralForms{"", "peso cubano convertible", "", "", "", "pesos cubanos convertibles"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubano", PluralForms{"", "peso cubano", "", "", "", "pesos cubanos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo caboverdiano", PluralForms{"", "escudo caboverdiano", "", "", "", "escudos caboverdianos"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "coroa checa", PluralForms{"", "coroa checa", "", "", "", "coroas checas"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Marco alemán", PluralForms{"", "marco alemán", "", "", "", "marcos alemáns"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franco djibutiano", PluralForms{"", "franco djibutiano", "", "", "", "francos djibutianos"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "coroa dinamarquesa", PluralForms{"", "coroa dinamarquesa", "", "", "", "coroas dinamarquesas"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominicano", PluralForms{"", "peso dominicano", "", "", "", "pesos dominicanos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar alxeriano", PluralForms{"", "dinar alxeriano", "", "", "", "dinares alxerianos"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Sucre ecuatoriano", PluralForms{"", "sucre ecuatoriano", "", "", "", "sucres ecuatorianos"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Unidade de valor constante ecuatoriana", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "libra exipcia", PluralForms{"", "libra exipcia", "", "", "", "libras exipcias"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreo", PluralForms{"", "nakfa eritreo", "", "", "", "nakfas eritreos"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Peseta española (conta A)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Peseta española (conta convertible)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "₧", "₧", "Peseta española", PluralForms{"", "peseta", "", "", "", "pesetas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etíope", PluralForms{"", "birr etíope", "", "", "", "birres etíopes"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dólar fixiano", PluralForms{"", "dólar fixiano", "", "", "", "dólares fixianos"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "libra das Illas Malvinas", PluralForms{"", "libra das Illas Malvinas", "", "", "", "libras das Illas Malvinas"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Franco francés", PluralForms{"", "franco francés", "", "", "", "francos franceses"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "libra esterlina", PluralForms{"", "libra esterlina", "", "", "", "libras esterlinas"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari xeorxiano", PluralForms{"", "lari xeorxiano", "", "", "", "laris xeorxianos"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanés", PluralForms{"", "cedi ghanés", "", "", "", "cedis ghaneses"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "libra xibraltareña", PluralForms{"", "libra xibraltareña", "", "", "", "libras xibraltareñas"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambiano", PluralForms{"", "dalasi gambiano", "", "", "", "dalasis gambianos"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franco guineano", PluralForms{"", "franco guineano", "", "", "", "francos guineanos"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Syli guineano", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekwele guineana", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Dracma grego", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal guatemalteco", PluralForms{"", "quetzal guatemalteco", "", "", "", "quetzais guatemaltecos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dólar güianés", PluralForms{"", "dólar güianés", "", "", "", "dólares güianeses"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "dólar de Hong Kong", PluralForms{"", "dólar de Hong Kong", "", "", "", "dólares de Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira hondureño", PluralForms{"", "lempira hondureño", "", "", "", "lempiras hondureños"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna croata", PluralForms{"", "kuna croata", "", "", "", "kunas croatas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitiana", PluralForms{"", "gourde haitiana", "", "", "", "gourdes haitianas"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "florín húngaro", PluralForms{"", "florín húngaro", "", "", "", "floríns húngaros"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonesia", PluralForms{"", "rupia indonesia", "", "", "", "rupias indonesias"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Libra irlandesa", PluralForms{"", "libra irlandesa", "", "", "", "libras irlandesas"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "novo shequel israelí", PluralForms{"", "novo shequel israelí", "", "", "", "novos shequeis israelís"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "rupia india", PluralForms{"", "rupia india", "", "", "", "rupias indias"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar iraquí", PluralForms{"", "dinar iraquí", "", "", "", "dinares iraquíes"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iraniano", PluralForms{"", "rial iraniano", "", "", "", "riales iranianos"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "coroa islandesa", PluralForms{"", "coroa islandesa", "", "", "", "coroas islandesas"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Lira italiana", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dólar xamaicano", PluralForms{"", "dólar xamaicano", "", "", "", "dólares xamaicanos"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar xordano", PluralForms{"", "dinar xordano", "", "", "", "dinares xordanos"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "ien xaponés", PluralForms{"", "ien xaponés", "", "", "", "iens xaponeses"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "xilin kenyano", PluralForms{"", "xilin kenyano", "", "", "", "xilins kenyanos"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirguiz", PluralForms{"", "som kirguiz", "", "", "", "soms kirguiz"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel camboxano", PluralForms{"", "riel camboxano", "", "", "", "rieis camboxanos"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "FC", "franco comoriano", PluralForms{"", "franco comoriano", "", "", "", "francos comorianos"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won norcoreano", PluralForms{"", "won norcoreano", "", "", "", "wons norcoreanos"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "won surcoreano", PluralForms{"", "won surcoreano", "", "", "", "wons surcoreanos"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuwaití", PluralForms{"", "dinar kuwaití", "", "", "", "dinares kuwaitís"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dólar das Illas Caimán", PluralForms{"", "dólar das Illas Caimán", "", "", "", "dólares das Illas Caimán"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazako", PluralForms{"", "tenge kazako", "", "", "", "tenges kazakos"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laosiano", PluralForms{"", "kip laosiano", "", "", "", "kips laosianos"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libra libanesa", PluralForms{"", "libra libanesa", "", "", "", "libras libanesas"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia srilankesa", PluralForms{"", "rupia srilankesa", "", "", "", "rupias srilankesas"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dólar liberiano", PluralForms{"", "dólar liberiano", "", "", "", "dólares liberianos"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti de Lesoto", PluralForms{"", "loti de Lesoto", "", "", "", "lotis de Lesoto"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas lituana", PluralForms{"", "litas lituana", "", "", "", "litas lituanas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Franco convertible luxemburgués", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Franco luxemburgués", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Franco financeiro luxemburgués", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats letón", PluralForms{"", "lats letón", "", "", "", "lats letóns"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libio", PluralForms{"", "dinar libio", "", "", "", "dinares libios"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marroquí", PluralForms{"", "dirham marroquí", "", "", "", "dirhams marroquís"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Franco marroquí", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldovo", PluralForms{"", "leu moldovo", "", "", "", "lei moldovos"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary malgaxe", PluralForms{"", "ariary malgaxe", "", "", "", "ariarys malgaxes"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinar macedonio", PluralForms{"", "dinar macedonio", "", "", "", "dinares macedonios"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat birmano", PluralForms{"", "kyat birmano", "", "", "", "kyats birmanos"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongol", PluralForms{"", "tugrik mongol", "", "", "", "tugriks mongois"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca macaense", PluralForms{"", "pataca macaense", "", "", "", "patacas macaenses"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya mauritano (1973–2017)", PluralForms{"", "ouguiya mauritano (1973–2017)", "", "", "", "ouguiyas mauritanos (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya mauritano", PluralForms{"", "ouguiya mauritano", "", "", "", "ouguiyas mauritanos"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia mauriciana", PluralForms{"", "rupia mauriciana", "", "", "", "rupias mauricianas"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rupia maldivana", PluralForms{"", "rupia maldivana", "", "", "", "rupias maldivanas"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha de Malawi", PluralForms{"", "kwacha de Malawi", "", "", "", "kwachas de Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "$MX", "$", "peso mexicano", PluralForms{"", "peso mexicano", "", "", "", "pesos mexicanos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Peso de prata mexicano (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Unidade de inversión mexicana", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malaio", PluralForms{"", "ringgit malaio", "", "", "", "ringgits malaios"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambicano", PluralForms{"", "metical mozambicano", "", "", "", "meticais mozambicanos"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dólar namibio", PluralForms{"", "dólar namibio", "", "", "", "dólares namibios"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nixeriano", PluralForms{"", "naira nixeriano", "", "", "", "nairas nixerianos"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Córdoba nicaragüense", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba nicaraguano", PluralForms{"", "córdoba nicaraguano", "", "", "", "córdobas nicaraguanos"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Florín holandés", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "coroa norueguesa", PluralForms{"", "coroa norueguesa", "", "", "", "coroas norueguesas"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalesa", PluralForms{"", "rupia nepalesa", "", "", "", "rupias nepalesas"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "dólar neozelandés", PluralForms{"", "dólar neozelandés", "", "", "", "dólares neozelandeses"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omaní", PluralForms{"", "rial omaní", "", "", "", "riais omanís"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panameño", PluralForms{"", "balboa panameño", "", "", "", "balboas panameños"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Inti peruano", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol peruano", PluralForms{"", "sol peruano", "", "", "", "soles peruanos"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Sol peruano (1863–1965)", PluralForms{"", "sol peruano (1863–1965)", "", "", "", "soles peruanos (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina de Papúa-Nova Guinea", PluralForms{"", "kina de Papúa-Nova Guinea", "", "", "", "kinas de Papúa-Nova Guinea"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipino", PluralForms{"", "peso filipino", "", "", "", "pesos filipinos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia paquistaní", PluralForms{"", "rupia paquistaní", "", "", "", "rupias paquistanís"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloty polaco", PluralForms{"", "zloty polaco", "", "", "", "zlotys polacos"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Escudo portugués", PluralForms{"", "escudo portugués", "", "", "", "escudos portugueses"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guaraní paraguaio", PluralForms{"", "guaraní paraguaio", "", "", "", "guaranís paraguaios"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial qatarí", PluralForms{"", "rial qatarí", "", "", "", "riais qatarís"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "leu romanés", PluralForms{"", "leu romanés", "", "", "", "lei romaneses"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serbio", PluralForms{"", "dinar serbio", "", "", "", "dinares serbios"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "руб", "rublo ruso", PluralForms{"", "rublo ruso", "", "", "", "rublos rusos"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Rublo ruso (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franco ruandés", PluralForms{"", "franco ruandés", "", "", "", "francos ruandeses"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "rial saudita", PluralForms{"", "rial saudita", "", "", "", "riais sauditas"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dólar das Illas Salomón", PluralForms{"", "dólar das Illas Salomón", "", "", "", "dólares das Illas Salomón"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia de Seychelles", PluralForms{"", "rupia de Seychelles", "", "", "", "rupias de Seychelles"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "libra sudanesa", PluralForms{"", "libra sudanesa", "", "", "", "libras sudanesas"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "coroa sueca", PluralForms{"", "coroa sueca", "", "", "", "coroas suecas"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dólar de Singapur", PluralForms{"", "dólar de Singapur", "", "", "", "dólares de Singapur"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "libra de Santa Helena", PluralForms{"", "libra de Santa Helena", "", "", "", "libras de Santa Helena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone de Serra Leoa", PluralForms{"", "leone de Serra Leoa", "", "", "", "leones de Serra Leoa"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone de Serra Leoa (1964–2022)", PluralForms{"", "leone de Serra Leoa (1964–2022)", "", "", "", "leones de Serra Leoa (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "xilin somalí", PluralForms{"", "xilin somalí", "", "", "", "xilins somalís"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dólar surinamés", PluralForms{"", "dólar surinamés", "", "", "", "dólares surinamés"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "libra sursudanesa", PluralForms{"", "libra sursudanesa", "", "", "", "libras sursudanesa"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra de São Tomé e Príncipe (1977–2017)", PluralForms{"", "dobra de São Tomé e Príncipe (1977–2017)", "", "", "", "dobras de São Tomé e Príncipe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra de San Tomé e Príncipe", PluralForms{"", "dobra de San Tomé e Príncipe", "", "", "", "dobras de San Tomé e Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Rublo soviético", PluralForms{"", "rublo soviético", "", "", "", "rublos soviéticos"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Colón salvadoreño", PluralForms{"", "colón salvadoreño", "", "", "", "colóns salvadoreños"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "libra siria", PluralForms{"", "libra siria", "", "", "", "libras sirias"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni de Swazilandia", PluralForms{"", "lilangeni de Swazilandia", "", "", "", "lilangenis de Swazilandia"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "baht tailandés", PluralForms{"", "baht tailandés", "", "", "", "bahts tailandeses"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni taxiquistano", PluralForms{"", "somoni taxiquistano", "", "", "", "somonis taxiquistanos"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turkmeno", PluralForms{"", "manat turkmeno", "", "", "", "manats turkmenos"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunisiano", PluralForms{"", "dinar tunisiano", "", "", "", "dinares tunisianos"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "paʻanga tongano", PluralForms{"", "paʻanga tongano", "", "", "", "pa’angas tonganos"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turca", PluralForms{"", "lira turca", "", "", "", "liras turcas"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dólar trinitense", PluralForms{"", "dólar trinitense", "", "", "", "dólares trinitenses"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "novo dólar taiwanés", PluralForms{"", "novo dólar taiwanés", "", "", "", "novos dólares taiwaneses"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "xilin tanzano", PluralForms{"", "xilin tanzano", "", "", "", "xilins tanzanos"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hrivna ucraína", PluralForms{"", "hrivna ucraína", "", "", "", "hrivnas ucraínas"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "xilin ugandés", PluralForms{"", "xilin ugandés", "", "", "", "xilins ugandeses"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "dólar estadounidense", PluralForms{"", "dólar estadounidense", "", "", "", "dólares estadounidenses"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Peso en unidades indexadas uruguaio", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Peso uruguaio (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguaio", PluralForms{"", "peso uruguaio", "", "", "", "pesos uruguaios"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "som uzbeko", PluralForms{"", "som uzbeko", "", "", "", "soms uzbekos"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Bolívar venezolano (1871–2008)", PluralForms{"", "bolívar venezolano (1871–2008)", "", "", "", "bolívares venezolanos (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolívar venezolano (2008–2018)", PluralForms{"", "bolívar venezolano (2008–2018)", "", "", "", "bolívares venezolanos (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar venezolano", PluralForms{"", "bolívar venezolano", "", "", "", "bolívares venezolanos"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "dong vietnamita", PluralForms{"", "dong vietnamita", "", "", "", "dongs vietnamitas"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu vanuatiano", PluralForms{"", "vatu vanuatiano", "", "", "", "vatus vanuatianos"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoano", PluralForms{"", "tala samoano", "", "", "", "talas samoanos"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franco CFA (BEAC)", PluralForms{"", "franco CFA (BEAC)", "", "", "", "francos CFA (BEAC)"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Prata", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Ouro", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "dólar do Caribe Oriental", PluralForms{"", "dólar do Caribe Oriental", "", "", "", "dólares do Caribe Oriental"}},
+		"XCG": {2, 0, 2, 1, "XCG", "ƒ", "ƒ", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franco CFA (BCEAO)", PluralForms{"", "franco CFA (BCEAO)", "", "", "", "francos CFA (BCEAO)"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paladio", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franco CFP", PluralForms{"", "franco CFP", "", "", "", "francos CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platino", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "moeda descoñecida", PluralForms{"", "(moeda descoñecida)", "", "", "", "(moedas descoñecidas)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial iemení", PluralForms{"", "rial iemení", "", "", "", "riais iemenís"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand surafricano", PluralForms{"", "rand surafricano", "", "", "", "rands surafricanos"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha zambiano (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambiano", PluralForms{"", "kwacha zambiano", "", "", "", "kwachas zambianos"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
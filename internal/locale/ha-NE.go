@@ -6,179 +6,232 @@ var haNE = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0K", "other": "0K"}},
+				{4, 2, map[string]string{"one": "0D", "other": "0D"}},
+				{5, 3, map[string]string{"one": "0D", "other": "0D"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "Dubu 0", "other": "Dubu 0"}},
+				{4, 2, map[string]string{"one": "Dubu 0", "other": "Dubu 0"}},
+				{5, 3, map[string]string{"one": "Dubu 0", "other": "Dubu 0"}},
+				{6, 1, map[string]string{"one": "Miliyan 0", "other": "Miliyan 0"}},
+				{7, 2, map[string]string{"one": "Miliyan 0", "other": "Miliyan 0"}},
+				{8, 3, map[string]string{"one": "Miliyan 0", "other": "Miliyan 0"}},
+				{9, 1, map[string]string{"one": "Biliyan 0", "other": "Biliyan 0"}},
+				{10, 2, map[string]string{"one": "Biliyan 0", "other": "Biliyan 0"}},
+				{11, 3, map[string]string{"one": "Biliyan 0", "other": "Biliyan 0"}},
+				{12, 1, map[string]string{"one": "Triliyan 0", "other": "Triliyan 0"}},
+				{13, 2, map[string]string{"one": "Triliyan 0", "other": "Triliyan 0"}},
+				{14, 3, map[string]string{"one": "Triliyan 0", "other": "Triliyan 0"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a00D", "other": "¤\u00a00D"}},
+				{4, 2, map[string]string{"one": "¤\u00a00D", "other": "¤\u00a00D"}},
+				{5, 3, map[string]string{"one": "¤\u00a00K", "other": "¤\u00a00K"}},
+				{6, 1, map[string]string{"other": "¤\u00a00M"}},
+				{7, 2, map[string]string{"other": "¤\u00a00M"}},
+				{8, 3, map[string]string{"other": "¤\u00a00M"}},
+				{9, 1, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{10, 2, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{12, 1, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{13, 2, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{14, 3, map[string]string{"one": "¤0T", "other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "₦", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Kuɗin Haɗaɗɗiyar Daular Larabawa", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghani na ƙasar Afghanistan", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Kuɗin Albania", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Kuɗin Armenia", PluralForms{"", "kuɗin Armenia", "", "", "", "Kuɗin Armenia"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Antillean Guilder na ƙasar Netherlands", PluralForms{"", "Antillean guilder na ƙasar Netherlands", "", "", "", "Antillean Guilder na ƙasar Netherlands"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kuɗin Angola", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso na ƙasar Argentina", PluralForms{"", "peso na ƙasar Argentina", "", "", "", "Peso na ƙasar Argentina"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dalar Ostareliya", PluralForms{"", "Dalolin Ostareliya", "", "", "", "Dalolin Ostareliya"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Florin na yankin Aruba", PluralForms{"", "florin na yankin Aruba", "", "", "", "Florin na yankin Aruba"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Kuɗin Azerbaijani", PluralForms{"", "kuɗin Azerbaijani", "", "", "", "Kuɗin Azerbaijani"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Kuɗaɗen Bosnia da Herzegovina", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Dalar ƙasar Barbados", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka na ƙasar Bangladesh", PluralForms{"", "taka na ƙasar Bangladesh", "", "", "", "Taka na ƙasar Bangladesh"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Kuɗin Bulgeria", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Kuɗin Baharan", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Kuɗin Burundi", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dalar ƙasar Bermuda", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dalar Brunei", PluralForms{"", "Dalolin Brunei", "", "", "", "Dalolin Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boloviano na ƙasar Bolivia", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Ril Kudin Birazil", PluralForms{"", "Ril Kuɗin Birazil", "", "", "", "Ril Kuɗin Birazil"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dalar ƙasar Bahamas", PluralForms{"", "dalar ƙasar Bahamas", "", "", "", "Dalar ƙasar Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrum na ƙasar Bhutan", PluralForms{"", "ngultrum na ƙasar Bhutan", "", "", "", "Ngultrum na ƙasar Bhutan"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Kuɗin Baswana", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Kuɗin Belarus", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dalar ƙasar Belize", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dalar Kanada", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kuɗin Kongo", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Kuɗin Suwizalan", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso na ƙasar Chile", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuwan na ƙasar Sin (na wajen ƙasa)", PluralForms{"", "yuwan na ƙasar Sin (na wajen ƙasa)", "", "", "", "yuwan na ƙasar Sin (na wajen ƙasa)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuwan na ƙasar Sin", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso na ƙasar Columbia", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colón na ƙasar Costa Rica", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso mai fuska biyu na ƙasar Kuba", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso na ƙasar Kuba", PluralForms{"", "peso na ƙasar Cuba", "", "", "", "Peso na ƙasar Kuba"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kuɗin Tsibiran Kap Barde", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Kuɗin Czech", PluralForms{"", "kuɗin Czech", "", "", "", "Kuɗin Czech"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Kuɗin Jibuti", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Krone na ƙasar Denmark", PluralForms{"", "krone na ƙasar Denmark", "", "", "", "Krone na ƙasar Denmark"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso na jamhuriyar Dominica", PluralForms{"", "peso na jamhuriyar Dominica", "", "", "", "Peso na jamhuriyar Dominica"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Kuɗin Aljeriya", PluralForms{"", "Dinarin Aljeriya", "", "", "", "Dinarin Aljeriya"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Fam kin Masar", PluralForms{"", "Fam na Masar", "", "", "", "Fam na Masar"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Kuɗin Eritireya", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Kuɗin Habasha", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Yuro", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dalar Fiji", PluralForms{"", "Dalolin Fiji", "", "", "", "Dalolin Fiji"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Fam na ƙasar Tsibirai na Falkland", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Fam na Ingila", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Kuɗin Georgia", PluralForms{"", "kuɗin Georgia", "", "", "", "Kuɗin Georgia"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Cedi", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Sidi na Ghana", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Kuɗin Gibraltal", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Kuɗin Gambiya", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Kuɗin Guinea", PluralForms{"", "", "", "", "", ""}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Kuɗin Gini", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal na ƙasar Guatemala", PluralForms{"", "quetzal na ƙasar Guatemala", "", "", "", "Quetzal na ƙasar Guatemala"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dalar Guyana", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dalar Hong Kong", PluralForms{"", "dalar Hong Kong", "", "", "", "Dalar Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira na ƙasar Honduras", PluralForms{"", "lempira na ƙasar Honduras", "", "", "", "Lempira na ƙasar Honduras"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuɗin Croatia", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gourde na ƙasar Haiti", PluralForms{"", "gourde na ƙasar Haiti", "", "", "", "Gourde na ƙasar Haiti"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Kuɗin Hungary", PluralForms{"", "kuɗin Hungary", "", "", "", "Kuɗin Hungary"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupiah na ƙasar Indonesia", PluralForms{"", "rupiah na ƙasar Indonesia", "", "", "", "Rupiah na ƙasar Indonesia"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Sabbin Kuɗin Israʼila", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Kuɗin Indiya", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinarin Iraqi", PluralForms{"", "dinarin Iraqi", "", "", "", "Dinarin Iraqi"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Riyal na ƙasar Iran", PluralForms{"", "Riyal-riyal na ƙasar Iran", "", "", "", "Riyal-riyal na ƙasar Iran"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Króna na ƙasar Iceland", PluralForms{"", "króna na ƙasar Iceland", "", "", "", "Króna na ƙasar Iceland"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dalar Jamaica", PluralForms{"", "dalar Jamaica", "", "", "", "Dalar Jamaica"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinarin Jordan", PluralForms{"", "dinarin Jordan", "", "", "", "Dinarin Jordan"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Yen na ƙasar Japan", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Sulen Kenya", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som na ƙasar Kyrgystani", PluralForms{"", "som na ƙasar Kyrgystani", "", "", "", "Som na ƙasar Kyrgystani"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riel na ƙasar Cambodia", PluralForms{"", "riel na ƙasar Cambodia", "", "", "", "Riel na ƙasar Cambodia"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Kuɗin Kwamoras", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won na ƙasar Koriya ta Arewa", PluralForms{"", "won na ƙasar Koriya ta Arewa", "", "", "", "won na ƙasar Koriya ta Arewa"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Won na Koriya ta Kudu", PluralForms{"", "won na Koriya ta Kudu", "", "", "", "won na Koriya ta Kudu"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinarin Kuwaiti", PluralForms{"", "dinarin Kuwaiti", "", "", "", "Dinarin Kuwaiti"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dalar ƙasar Tsibirai na Cayman", PluralForms{"", "dalar ƙasar Tsibirai na Cayman", "", "", "", "Dalar ƙasar Tsibirai na Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge na ƙasar Kazkhstan", PluralForms{"", "tenge na ƙasar Kazakhstan", "", "", "", "Tenge na ƙasar Kazkhstan"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kuɗin Laos", PluralForms{"", "kuɗin Laos", "", "", "", "Kuɗin Laos"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Kuɗin Lebanon", PluralForms{"", "kuɗin Lebanon", "", "", "", "Kuɗin Lebanon"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rupee na ƙasar Sri Lanka", PluralForms{"", "rupee na ƙasar Sri Lanka", "", "", "", "Rupee na ƙasar Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dalar Laberiya", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Kuɗin Lesoto", PluralForms{"", "Kuɗaɗen Lesoto", "", "", "", "Kuɗaɗen Lesoto"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Kuɗin Libiya", PluralForms{"", "Dinarin Libiya", "", "", "", "Dinarin Libiya"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Kuɗin Maroko", PluralForms{"", "Dirhamin Maroko", "", "", "", "Dirhamomin Maroko"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Kuɗaɗen Moldova", PluralForms{"", "Kuɗaɗen Moldova", "", "", "", "kuɗaɗen Moldova"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Kuɗin Madagaskar", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Dinarin Macedonia", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kuɗin Myanmar", PluralForms{"", "kuɗin Myanmar", "", "", "", "Kuɗin Myanmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik na Mongolia", PluralForms{"", "tugrik na Mongoliya", "", "", "", "Tugrik na Mongolia"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataca na ƙasar Macao", PluralForms{"", "pataca na ƙasar Macao", "", "", "", "Pataca na ƙasar Macao"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Kuɗin Moritaniya (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Kuɗin Moritaniya", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Kuɗin Moritus", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyaa na ɓasar Maldives", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kuɗin Malawi", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso na ƙasar Mekziko", PluralForms{"", "peso na ƙasar Mekziko", "", "", "", "peso na ƙasar Mekziko"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Kuɗin Malaysia", PluralForms{"", "kuɗin Malaysia", "", "", "", "Kuɗin Malaysia"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Kuɗin Mozambik", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metical na ƙasar Mozambique", PluralForms{"", "metical na ƙasar Mozambique", "", "", "", "Metical na ƙasar Mozambique"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dalar Namibiya", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "₦", "₦", "Nairar Najeriya", PluralForms{"", "Nairar Nijeriya", "", "", "", "Nairorin Najeriya"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Córdoba na ƙasar Nicaragua", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Krone na ƙasar Norway", PluralForms{"", "krone na ƙasar Norway", "", "", "", "Krone na ƙasar Norway"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rupee na Nepal", PluralForms{"", "rupee na Nepal", "", "", "", "Rupee na Nepal"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dalar New Zealand", PluralForms{"", "Dalolin New Zealand", "", "", "", "Dalolin New Zealand"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Riyal ɗin Oman", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa na ƙasar Panama", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol na ƙasar Peru", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina na ƙasar Papua Sabon Guinea", PluralForms{"", "kina na ƙasar Papua Sabon Guinea", "", "", "", "Kina na ƙasar Papua Sabon Guinea"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Kuɗin Philippine", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rupee na ƙasar Pakistan", PluralForms{"", "rupee na ƙasar Pakistan", "", "", "", "Rupee na ƙasar Pakistan"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Kuɗin Polan", PluralForms{"", "kuɗin Polan", "", "", "", "kuɗaɗen Polan"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guarani na ƙasar Paraguay", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Riyal ɗin Qatar", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Kuɗin Romania", PluralForms{"", "kuɗin Romania", "", "", "", "Kuɗin Romania"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinarin Serbia", PluralForms{"", "dinarin Serbia", "", "", "", "Dinarin Serbia"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Ruble na ƙasar Rasha", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Kuɗin Ruwanda", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyal", PluralForms{"", "Riyal ɗin Saudiyya", "", "", "", "Riyal"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dalar Tsibirai na Solomon", PluralForms{"", "Dalolin Tsibirai na Solomon", "", "", "", "Dalolin Tsibirai na Solomon"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Kuɗin Saishal", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Fam na Sudan", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Krona na ƙasar Sweden", PluralForms{"", "krona na ƙasar Sweden", "", "", "", "Krona na ƙasar Sweden"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dalar Singapore", PluralForms{"", "Dalolin Singapore", "", "", "", "Dalolin Singapore"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Fam kin San Helena", PluralForms{"", "Fam na San Helena", "", "", "", "fam na San Helena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Kuɗin Salewo", PluralForms{"", "Kuɗin Saliyo", "", "", "", "Kuɗin Saliyo"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Kuɗin Salewo (1964—2022)", PluralForms{"", "Kuɗin Saliyo (1964—2022)", "", "", "", "Kuɗin Saliyo (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Sulen Somaliya", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dalar ƙasar Suriname", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Fam na Kudancin Sudan", PluralForms{"", "fam na Kudancin Sudan", "", "", "", "Fam na Kudancin Sudan"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Kuɗin Sawo Tome da Paransip (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Kuɗin Sawo Tome da Paransip", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Kuɗin Siriya", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Kuɗin Lilangeni", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Baht na ƙasar Thailand", PluralForms{"", "baht na ƙasar Thailand", "", "", "", "Baht na ƙasar Thailand"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni na ƙasar Tajikistan", PluralForms{"", "somoni na ƙasar Tajikistan", "", "", "", "Somoni na ƙasar Tajikistan"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manat na ƙasar Turkmenistan", PluralForms{"", "manat na ƙasar Turkmenistan", "", "", "", "Manat na ƙasar Turkmenistan"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Kuɗin Tunisiya", PluralForms{"", "Dinarin Tunusiya", "", "", "", "Dinarin Tunusiya"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga na ƙasar Tonga", PluralForms{"", "paʻanga na ƙasar Tonga", "", "", "", "Paʻanga na ƙasar Tonga"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Kuɗin Turkiyya", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dalar ƙasar Trinidad da Tobago", PluralForms{"", "dalar ƙasar Trinidad da Tobago", "", "", "", "Dalar ƙasar Trinidad da Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Sabuwar Dalar Taiwan", PluralForms{"", "Sabuwar dalar Taiwan", "", "", "", "Sabuwar Dalar Taiwan"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Sulen Tanzaniya", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Kudin Ukrainian", PluralForms{"", "kuɗin Ukrain", "", "", "", "Kuɗin Ukrain"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Sule Yuganda", PluralForms{"", "Sulallan Yuganda", "", "", "", "Sulallan Yuganda"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dalar Amurka", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso na ƙasar Uruguay", PluralForms{"", "peso na ƙasar Uruguay", "", "", "", "Peso na ƙasar Uruguay"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Som na ƙasar Uzbekistan", PluralForms{"", "som na ƙasar Uzbekistan", "", "", "", "Som na ƙasar Uzbekistan"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolívar na ƙasar Venezuela", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Kuɗin Vietnam", PluralForms{"", "kuɗin Vietnam", "", "", "", "Kuɗin Vietnam"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu da ƙasar Vanuatu", PluralForms{"", "vatu na ƙasar Vanuatu", "", "", "", "Vatu da ƙasar Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala na ƙasar Samoa", PluralForms{"", "tala na ƙasar Samoa", "", "", "", "Tala na ƙasar Samoa"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Kuɗin Sefa na Afirka Ta Tsakiya", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dalar Gabashin Karebiyan", PluralForms{"", "dalar Gabashin Karebiyan", "", "", "", "dalar Gabashin Karebiyan"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Kuɗin Sefa na Afirka Ta Yamma", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Kuɗin CFP franc", PluralForms{"", "kuɗin CFP franc", "", "", "", "Kuɗin CFP franc"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Kudin da ba a sani ba", PluralForms{"", "(kuɗin sashe da ba a sani ba)", "", "", "", "(Kudin da ba a sani ba)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Riyal ɗin Yemen", PluralForms{"", "riyal ɗin Yemen", "", "", "", "Riyal ɗin Yemen"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Kuɗin Afirka Ta Kudu", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kuɗin Zambiya (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kuɗin Zambiya", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dalar zimbabuwe", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
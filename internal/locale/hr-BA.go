@@ -6,311 +6,364 @@ var hrBA = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{4, 2, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{5, 3, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{6, 1, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{7, 2, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{8, 3, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{9, 1, map[string]string{"one": "0\u00a0mlr.", "other": "0\u00a0mlr."}},
+				{10, 2, map[string]string{"one": "0\u00a0mlr.", "other": "0\u00a0mlr."}},
+				{11, 3, map[string]string{"one": "0\u00a0mlr.", "other": "0\u00a0mlr."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tisuće", "one": "0 tisuća", "other": "0 tisuća"}},
+				{4, 2, map[string]string{"few": "0 tisuće", "one": "0 tisuća", "other": "0 tisuća"}},
+				{5, 3, map[string]string{"few": "0 tisuće", "one": "0 tisuća", "other": "0 tisuća"}},
+				{6, 1, map[string]string{"few": "0 milijuna", "one": "0 milijun", "other": "0 milijuna"}},
+				{7, 2, map[string]string{"few": "0 milijuna", "one": "0 milijun", "other": "0 milijuna"}},
+				{8, 3, map[string]string{"few": "0 milijuna", "one": "0 milijun", "other": "0 milijuna"}},
+				{9, 1, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{10, 2, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{11, 3, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{12, 1, map[string]string{"few": "0 bilijuna", "one": "0 bilijun", "other": "0 bilijuna"}},
+				{13, 2, map[string]string{"few": "0 bilijuna", "one": "0 bilijun", "other": "0 bilijuna"}},
+				{14, 3, map[string]string{"few": "0 bilijuna", "one": "0 bilijun", "other": "0 bilijuna"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mlr.\u00a0¤", "one": "0\u00a0mlr.\u00a0¤", "other": "0\u00a0mlr.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mlr.\u00a0¤", "one": "0\u00a0mlr.\u00a0¤", "other": "0\u00a0mlr.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mlr.\u00a0¤", "one": "0\u00a0mlr.\u00a0¤", "other": "0\u00a0mlr.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0} – {1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "KM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "kn", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "XPF", "XPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorska pezeta", PluralForms{"", "andorska pezeta", "", "andorske pezete", "", "andorskih pezeta"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "UAE dirham", PluralForms{"", "UAE dirham", "", "UAE dirhama", "", "UAE dirhama"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afganistanski afgani (1927.–2002.)", PluralForms{"", "afganistanski afgan (1927.–2002.)", "", "afganistanska afgana (1927.–2002.)", "", "afganistanskih afgana (1927.–2002.)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afganistanski afgani", PluralForms{"", "afganistanski afgan", "", "afganistanska afgana", "", "afganistanskih afgana"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "stari albanski lek", PluralForms{"", "stari albanski lek", "", "stara albanska leka", "", "starih albanskih leka"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albanski lek", PluralForms{"", "albanski lek", "", "albanska leka", "", "albanskih leka"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armenski dram", PluralForms{"", "armenski dram", "", "armenska drama", "", "armenskih drama"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "nizozemskoantilski gulden", PluralForms{"", "nizozemskoantilski gulden", "", "nizozemskoantilska guldena", "", "nizozemskoantilskih guldena"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolska kvanza", PluralForms{"", "angolska kvanza", "", "angolske kvanze", "", "angolskih kvanzi"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "angolska kvanza (1977.–1990.)", PluralForms{"", "angolska kvanza (1977.–1990.)", "", "angolske kvanze (1977.–1990.)", "", "angolskih kvanzi (1977.–1990.)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolska nova kvanza (1990.–2000.)", PluralForms{"", "angolska nova kvanza (1990.–2000.)", "", "angolske nove kvanze (1990.–2000.)", "", "angolskih novih kvanzi (1990.–2000.)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "angolska kvanza (1995.–1999.)", PluralForms{"", "angolska kvanza (1995.–1999.)", "", "angolske kvanze (1995.–1999.)", "", "angolskih kvanzi (1995.–1999.)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentinski austral", PluralForms{"", "argentinski austral", "", "argentinska australa", "", "argentinskih australa"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "argentinski pezo lej (1970.–1983.)", PluralForms{"", "argentinski pezo lej (1970.–1983.)", "", "argentinska pezo leja (1970.–1983.)", "", "argentinskih pezo leja (1970.–1983.)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "argentinski pezo (1881.–1970.)", PluralForms{"", "argentinski pezo (1881.–1970.)", "", "argentinska peza (1881.–1970.)", "", "argentinskih peza (1881.–1970.)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentinski pezo (1983.–1985.)", PluralForms{"", "argentinski pezo (1983.–1985.)", "", "argentinska peza (1983.–1985.)", "", "argentinskih peza (1983.–1985.)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinski pezo", PluralForms{"", "argentinski pezos", "", "argentinska pezosa", "", "argentinskih pezosa"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "austrijski šiling", PluralForms{"", "austrijski šiling", "", "austrijska šilinga", "", "austrijskih šilinga"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "australski dolar", PluralForms{"", "australski dolar", "", "australska dolara", "", "australskih dolara"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arupski florin", PluralForms{"", "arupski florin", "", "arupska florina", "", "arupskih florina"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "azerbajdžanski manat (1993.–2006.)", PluralForms{"", "azerbajdžanski manat (1993.–2006.)", "", "azerbajdžanska manata (1993.–2006.)", "", "azerbajdžanskih manata (1993.–2006.)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "azerbajdžanski manat", PluralForms{"", "azerbajdžanski manat", "", "azerbajdžanska manata", "", "azerbajdžanskih manata"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosansko-hercegovački dinar", PluralForms{"", "bosansko-hercegovački dinar", "", "bosansko-hercegovačka dinara", "", "bosansko-hercegovačkih dinara"}},
+		"BAM": {2, 0, 2, 1, "BAM", "KM", "KM", "konvertibilna marka", PluralForms{"", "konvertibilna marka", "", "konvertibilne marke", "", "konvertibilnih maraka"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "bosansko-hercegovački novi dinar", PluralForms{"", "bosansko-hercegovački novi dinar", "", "bosansko-hercegovačka nova dinara", "", "bosansko-hercegovačkih novih dinara"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoski dolar", PluralForms{"", "barbadoski dolar", "", "barbadoska dolara", "", "barbadoskih dolara"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladeška taka", PluralForms{"", "bangladeška taka", "", "bangladeške take", "", "bangladeških taka"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgijski franak (konvertibilan)", PluralForms{"", "belgijski franak (konvertibilan)", "", "belgijska franka (konvertibilna)", "", "belgijskih franaka (konvertibilnih)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgijski franak", PluralForms{"", "belgijski franak", "", "belgijska franka", "", "belgijskih franaka"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgijski franak (financijski)", PluralForms{"", "belgijski franak (financijski)", "", "belgijska franka (financijska)", "", "belgijskih franaka (financijskih)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "bugarski čvrsti lev", PluralForms{"", "bugarski čvrsti lev", "", "bugarska čvrsta leva", "", "bugarskih čvrstih leva"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "bugarski socijalistički lev", PluralForms{"", "bugarski socijalistički lev", "", "bugarska socijalistička leva", "", "bugarskih socijalističkih leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bugarski lev", PluralForms{"", "bugarski lev", "", "bugarska leva", "", "bugarskih leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "stari bugarski lev", PluralForms{"", "stari bugarski lev", "", "stara bugarska leva", "", "starih bugarskih leva"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahreinski dinar", PluralForms{"", "bahreinski dinar", "", "bahreinska dinara", "", "bahreinskih dinara"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundski franak", PluralForms{"", "burundski franak", "", "burundska franka", "", "burundskih franaka"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudski dolar", PluralForms{"", "bermudski dolar", "", "bermudska dolara", "", "bermudskih dolara"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "brunejski dolar", PluralForms{"", "brunejski dolar", "", "brunejska dolara", "", "brunejskih dolara"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolivijski bolivijano", PluralForms{"", "bolivijski bolivijano", "", "bolivijska bolivijana", "", "bolivijskih bolivijana"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "stari bolivijski bolivijano", PluralForms{"", "stari bolivijski bolivijano", "", "stara bolivijska bolivijana", "", "starih bolivijskih bolivijana"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "bolivijski pezo", PluralForms{"", "bolivijski pezo", "", "bolivijska peza", "", "bolivijskih peza"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "bolivijski mvdol", PluralForms{"", "bolivijski mvdol", "", "bolivijska mvdola", "", "bolivijskih mvdola"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brazilski novi cruzeiro (1967.–1986.)", PluralForms{"", "brazilski novi cruzeir (1967.–1986.)", "", "brazilska nova cruzeira (1967.–1986.)", "", "brazilskih novih cruzeira (1967.–1986.)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brazilski cruzado", PluralForms{"", "brazilski cruzad", "", "brazilska cruzada", "", "brazilskih cruzada"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "brazilski cruzeiro (1990.–1993.)", PluralForms{"", "brazilski cruzeir (1990.–1993.)", "", "brazilska cruzeira (1990.–1993.)", "", "brazilskih cruzeira (1990.–1993.)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "brazilski real", PluralForms{"", "brazilski real", "", "brazilska reala", "", "brazilskih reala"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "brazilski novi cruzado", PluralForms{"", "brazilski novi cruzad", "", "brazilska nova cruzada", "", "brazilskih novih cruzada"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "brazilski cruzeiro", PluralForms{"", "brazilski cruzeiro", "", "brazilska cruzeira", "", "brazilskih cruzeira"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamski dolar", PluralForms{"", "bahamski dolar", "", "bahamska dolara", "", "bahamskih dolara"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "butanski ngultrum", PluralForms{"", "butanski ngultrum", "", "butanska ngultruma", "", "butanskih ngultruma"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "burmanski kyat", PluralForms{"", "burmanski kyat", "", "burmanska kyata", "", "burmanskih kyata"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "bocvanska pula", PluralForms{"", "bocvanska pula", "", "bocvanske pule", "", "bocvanskih pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "bjeloruska nova rublja (1994–1999)", PluralForms{"", "bjeloruska nova rublja (1994–1999)", "", "bjeloruske nove rublje (1994–1999)", "", "bjeloruskih novih rublji (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "bjeloruski rubalj", PluralForms{"", "bjeloruski rubalj", "", "bjeloruska rublja", "", "bjeloruskih rubalja"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "bjeloruska rublja (2000–2016)", PluralForms{"", "bjeloruska rublja (2000–2016)", "", "bjeloruske rublje (2000–2016)", "", "bjeloruskih rublji (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belizeanski dolar", PluralForms{"", "belizeanski dolar", "", "belizeanska dolara", "", "belizeanskih dolara"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "kanadski dolar", PluralForms{"", "kanadski dolar", "", "kanadska dolara", "", "kanadskih dolara"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongoanski franak", PluralForms{"", "kongoanski franak", "", "kongoanska franka", "", "kongoanskih franaka"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR euro", PluralForms{"", "WIR euro", "", "WIR eura", "", "WIR eura"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "švicarski franak", PluralForms{"", "švicarski franak", "", "švicarska franka", "", "švicarskih franaka"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR franak", PluralForms{"", "WIR franak", "", "WIR franka", "", "WIR franaka"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "čileanski eskudo", PluralForms{"", "čileanski eskudo", "", "čileanska eskuda", "", "čileanskih eskuda"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "čileanski unidades de fomentos", PluralForms{"", "čileanski unidades de fomentos", "", "čileanska unidades de fomentos", "", "čileanskih unidades de fomentos"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "čileanski pezo", PluralForms{"", "čileanski pezo", "", "čileanska peza", "", "čileanskih peza"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kineski juan (offshore)", PluralForms{"", "kineski juan (offshore)", "", "kineska juana (offshore)", "", "kineskih juana (offshore)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "kineski narodni dolar", PluralForms{"", "kineski narodni dolar", "", "kineska narodna dolara", "", "kineskih narodnih dolara"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "kineski yuan", PluralForms{"", "kineski yuan", "", "kineska yuana", "", "kineskih yuana"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kolumbijski pezo", PluralForms{"", "kolumbijski pezo", "", "kolumbijska peza", "", "kolumbijskih peza"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "unidad de valor real", PluralForms{"", "unidad de valor real", "", "unidad de valor reala", "", "unidad de valor reala"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kostarikanski kolon", PluralForms{"", "kostarikanski kolon", "", "kostarikanska kolona", "", "kostarikanskih kolona"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "stari srpski dinar", PluralForms{"", "stari srpski dinar", "", "stara srpska dinara", "", "starih srpskih dinara"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "čehoslovačka kruna", PluralForms{"", "čehoslovačka kruna", "", "čehoslovačke krune", "", "čehoslovačkih kruna"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubanski konvertibilni pezo", PluralForms{"", "kubanski konvertibilni pezo", "", "kubanska konvertibilna peza", "", "kubanskih konvertibilnih peza"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubanski pezo", PluralForms{"", "kubanski pezo", "", "kubanska peza", "", "kubanskih peza"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "zelenortski eskudo", PluralForms{"", "zelenortski eskudo", "", "zelenortska eskuda", "", "zelenortskih eskuda"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "ciparska funta", PluralForms{"", "ciparska funta", "", "ciparske funte", "", "ciparskih funti"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "češka kruna", PluralForms{"", "češka kruna", "", "češke krune", "", "čeških kruna"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "istočnonjemačka marka", PluralForms{"", "istočnonjemačka marka", "", "istočnonjemačke marke", "", "istočnonjemačkih marki"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "njemačka marka", PluralForms{"", "njemačka marka", "", "njemačke marke", "", "njemačkih marki"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "džibutski franak", PluralForms{"", "džibutski franak", "", "džibutska franka", "", "džibutskih franaka"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "danska kruna", PluralForms{"", "danska kruna", "", "danske krune", "", "danskih kruna"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikanski pezo", PluralForms{"", "dominikanski pezo", "", "dominikanska peza", "", "dominikanskih peza"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "alžirski dinar", PluralForms{"", "alžirski dinar", "", "alžirska dinara", "", "alžirskih dinara"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ekvatorska sukra", PluralForms{"", "evatorska sucra", "", "ekvatorske sucre", "", "ekvatorskih sucri"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ekvatorski unidad de valor constante (UVC)", PluralForms{"", "ekvatorski unidad de valor constante (UVC)", "", "ekvatorska unidad de valor constante (UVC)", "", "ekvatorskih unidad de valor constante (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "estonska kruna", PluralForms{"", "estonska kruna", "", "estonske krune", "", "estonskih kruna"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egipatska funta", PluralForms{"", "egipatska funta", "", "egipatske funte", "", "egipatskih funti"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritrejska nakfa", PluralForms{"", "eritrejska nakfa", "", "eritrejske nakfe", "", "eritrejskih nakfi"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "španjolska pezeta (A račun)", PluralForms{"", "španjolska pezeta (A račun)", "", "španjolske pezete (A račun)", "", "španjolskih pezeta (A račun)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "španjolska pezeta (konvertibilni račun)", PluralForms{"", "španjolska pezeta (konvertibilan račun)", "", "španjolske pezete (konvertibilan račun)", "", "španjolskih pezeta (konvertibilan račun)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "španjolska pezeta", PluralForms{"", "španjolska pezeta", "", "španjolske pezete", "", "španjolskih pezeta"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopski bir", PluralForms{"", "etiopski bir", "", "etiopska bira", "", "etiopskih bira"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "eura", "", "eura"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "finska marka", PluralForms{"", "finska marka", "", "finske marke", "", "finskih marki"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fidžijski dolar", PluralForms{"", "fidžijski dolar", "", "fidžijska dolara", "", "fidžijskih dolara"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklandska funta", PluralForms{"", "falklandska funta", "", "falklandske funte", "", "falklandskih funti"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "francuski franak", PluralForms{"", "francuski franak", "", "francuska franka", "", "francuskih franaka"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "britanska funta", PluralForms{"", "britanska funta", "", "britanske funte", "", "britanskih funti"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "gruzijski kupon larit", PluralForms{"", "gruzijski kupon larit", "", "gruzijska kupon larita", "", "gruzijskih kupon larita"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "gruzijski lari", PluralForms{"", "gruzijski lar", "", "gruzijska lara", "", "gruzijskih lara"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ganski cedi (1979.–2007.)", PluralForms{"", "ganski cedi (1979.–2007.)", "", "ganska ceda (1979.–2007.)", "", "ganskih ceda (1979.–2007.)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ganski cedi", PluralForms{"", "ganski cedi", "", "ganska ceda", "", "ganskih ceda"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltarska funta", PluralForms{"", "gibraltarska funta", "", "gibraltarske funte", "", "gibraltarskih funti"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambijski dalas", PluralForms{"", "gambijski dalas", "", "gambijska dalasa", "", "gambijskih dalasa"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "gvinejski franak", PluralForms{"", "gvinejski franak", "", "gvinejska franka", "", "gvinejskih franaka"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "gvinejski syli", PluralForms{"", "gvinejski syli", "", "gvinejska sylija", "", "gvinejskih sylija"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekvatorski gvinejski ekwele", PluralForms{"", "ekvatorski gvinejski ekwele", "", "ekvatorski gvinejska ekwele", "", "ekvatorskih gvinejskih ekwele"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "grčka drahma", PluralForms{"", "grčka drahma", "", "grčke drahme", "", "grčkih drahmi"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "gvatemalski kvecal", PluralForms{"", "gvatemalski kvecal", "", "gvatemalska kvecala", "", "gvatemalskih kvecala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "portugalski gvinejski eskudo", PluralForms{"", "portugalski gvinejski eskudo", "", "portugalska gvinejska eskuda", "", "portugalskih gvinejskih eskuda"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "gvinejskobisauski pezo", PluralForms{"", "gvinejskobisauski pezo", "", "gvinejskobisauska peza", "", "gvinejskobisauskih peza"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "gvajanski dolar", PluralForms{"", "gvajanski dolar", "", "gvajanska dolara", "", "gvajanskih dolara"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "hongkonški dolar", PluralForms{"", "hongkonški dolar", "", "hongkonška dolara", "", "hongkonških dolara"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduraška lempira", PluralForms{"", "honduraška lempira", "", "honduraške lempire", "", "honduraških lempira"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "hrvatski dinar", PluralForms{"", "hrvatski dinar", "", "hrvatska dinara", "", "hrvatskih dinara"}},
+		"HRK": {2, 0, 2, 1, "HRK", "kn", "kn", "hrvatska kuna", PluralForms{"", "hrvatska kuna", "", "hrvatske kune", "", "hrvatskih kuna"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haićanski gourd", PluralForms{"", "haićanski gourd", "", "haićanska gourda", "", "haićanskih gourda"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "mađarska forinta", PluralForms{"", "mađarska forinta", "", "mađarske forinte", "", "mađarskih forinti"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonezijska rupija", PluralForms{"", "indonezijska rupija", "", "indonezijske rupije", "", "indonezijskih rupija"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "irska funta", PluralForms{"", "irska funta", "", "irske funte", "", "irskih funti"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "izraelska funta", PluralForms{"", "izraelska funta", "", "izraelske funte", "", "izraelskih funti"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "stari izraelski šekel", PluralForms{"", "stari izraelski šekel", "", "stara izraelska šekela", "", "starih izraelskih šekela"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "novi izraelski šekel", PluralForms{"", "novi izraelski šekel", "", "nova izraelska šekela", "", "novih izraelskih šekela"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "indijska rupija", PluralForms{"", "indijska rupija", "", "indijske rupije", "", "indijskih rupija"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irački dinar", PluralForms{"", "irački dinar", "", "iračka dinara", "", "iračkih dinara"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iranski rijal", PluralForms{"", "iranski rijal", "", "iranska rijala", "", "iranskih rijala"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "stara islandska kruna", PluralForms{"", "stara islandska kruna", "", "stare islandske krune", "", "starih islandskih kruna"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandska kruna", PluralForms{"", "islandska kruna", "", "islandske krune", "", "islandskih kruna"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "talijanska lira", PluralForms{"", "talijanska lira", "", "talijanske lire", "", "talijanskih lira"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamajčanski dolar", PluralForms{"", "jamajčanski dolar", "", "jamajčanska dolara", "", "jamajčanskih dolara"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordanski dinar", PluralForms{"", "jordanski dinar", "", "jordanska dinara", "", "jordanskih dinara"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "japanski jen", PluralForms{"", "japanski jen", "", "japanska jena", "", "japanskih jena"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenijski šiling", PluralForms{"", "kenijski šiling", "", "kenijska šilinga", "", "kenijskih šilinga"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgiski som", PluralForms{"", "kirgijski som", "", "kirgijska soma", "", "kirgijskih soma"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodžanski rijal", PluralForms{"", "kambodžanski rijal", "", "kambodžanska rijala", "", "kambodžanskih rijala"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorski franak", PluralForms{"", "komorski franak", "", "komorska franka", "", "komorskih franaka"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "sjevernokorejski won", PluralForms{"", "sjevernokorejski won", "", "sjevernokorejska wona", "", "sjevernokorejskih wona"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "južnokorejski hvan", PluralForms{"", "južnokorejski hvan", "", "južnokorejska hvana", "", "južnokorejskih hvana"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "stari južnokorejski von", PluralForms{"", "stari južnokorejski von", "", "stara južnokorejska vona", "", "starih južnokorejskih vona"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "južnokorejski won", PluralForms{"", "južnokorejski won", "", "južnokorejska wona", "", "južnokorejskih wona"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuvajtski dinar", PluralForms{"", "kuvajtski dinar", "", "kuvajtska dinara", "", "kuvajtskih dinara"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "kajmanski dolar", PluralForms{"", "kajmanski dolar", "", "kajmanska dolara", "", "kajmanskih dolara"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazahstanski tenge", PluralForms{"", "kazahstanski tenge", "", "kazahstanska tengea", "", "kazahstanskih tengea"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoski kip", PluralForms{"", "laoski kip", "", "laoska kipa", "", "laoskih kipa"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanonska funta", PluralForms{"", "libanonska funta", "", "libanonske funte", "", "libanonskih funti"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "šrilankanska rupija", PluralForms{"", "šrilankanska rupija", "", "šrilankanske rupije", "", "šrilankanskih rupija"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberijski dolar", PluralForms{"", "liberijski dolar", "", "liberijska dolara", "", "liberijskih dolara"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesotski loti", PluralForms{"", "lesotski loti", "", "lesotska lotija", "", "lesotskih lotija"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litavski litas", PluralForms{"", "litavski litas", "", "litavska litasa", "", "litavskih litasa"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "litavski talonas", PluralForms{"", "litavski talonas", "", "litavska talonasa", "", "litavskih talonasa"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "luksemburški konvertibilni franak", PluralForms{"", "luksemburški konvertibilni franak", "", "luksemburška konvertibilna franka", "", "luksemburških konvertibilnih franaka"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "luksemburški franak", PluralForms{"", "luksemburški franak", "", "luksemburška franka", "", "luksemburških franaka"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "luksemburški financijski franak", PluralForms{"", "luksemburški financijski franak", "", "luksemburška financijska franka", "", "luksemburških financijskih franaka"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "letonski lats", PluralForms{"", "letonski lats", "", "letonska latsa", "", "letonskih latsa"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "letonska rublja", PluralForms{"", "letonska rublja", "", "letonske rublje", "", "letonskih rublji"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libijski dinar", PluralForms{"", "libijski dinar", "", "libijska dinara", "", "libijskih dinara"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marokanski dirham", PluralForms{"", "marokanski dirham", "", "marokanska dirhama", "", "marokanskih dirhama"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "marokanski franak", PluralForms{"", "marokanski franak", "", "marokanska franka", "", "marokanskih franaka"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "moldavski kupon", PluralForms{"", "moldavski kupon", "", "moldavska kupona", "", "moldavskih kupona"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavski lej", PluralForms{"", "moldavski lej", "", "moldavska leja", "", "moldavskih leja"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "malgaški arijari", PluralForms{"", "malgaški arijari", "", "malgaška arijarija", "", "malgaških arijarija"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "madagaskarski franak", PluralForms{"", "madagaskarski franak", "", "madagaskarska franka", "", "madagaskarskih franaka"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonski denar", PluralForms{"", "makedonski denar", "", "makedonska denara", "", "makedonskih denara"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "stari makedonski denar", PluralForms{"", "stari makedonski denar", "", "stara makedonska denara", "", "starih makedonski denara"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "malijski franak", PluralForms{"", "malijski franak", "", "malijska franka", "", "malijskih franaka"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "mjanmarski kjat", PluralForms{"", "mjanmarski kjat", "", "mjanmarska kjata", "", "mjanmarskih kjata"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolski tugrik", PluralForms{"", "mongolski tugrik", "", "mongolska tugrika", "", "mongolskih tugrika"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "makaoška pataka", PluralForms{"", "makaoška pataka", "", "makaoške patake", "", "makaoških pataka"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauritanijska ouguja (1973. – 2017.)", PluralForms{"", "mauritanijska ouguja (1973. – 2017.)", "", "mauritanijske ouguje (1973. – 2017.)", "", "mauritanijskih ouguja (1973. – 2017.)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauretanska ouguja", PluralForms{"", "mauretanska ouguja", "", "mauretanske ouguje", "", "mauretanskih ouguja"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "malteška lira", PluralForms{"", "malteška lira", "", "malteške lire", "", "malteških lira"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "malteška funta", PluralForms{"", "malteška funta", "", "malteške funte", "", "malteških funti"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauricijska rupija", PluralForms{"", "mauricijska rupija", "", "mauricijske rupije", "", "mauricijskih rupija"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "maldivijska rupija", PluralForms{"", "maldivijska rupija", "", "maldivijske rupije", "", "maldivijskih rupija"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldivijska rufija", PluralForms{"", "maldivijska rufija", "", "maldivijske rufije", "", "maldivijskih rufija"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malavijska kvača", PluralForms{"", "malavijska kvača", "", "malavijske kvače", "", "malavijskih kvača"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "meksički pezo", PluralForms{"", "meksički pezo", "", "meksička peza", "", "meksičkih peza"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "meksički srebrni pezo (1861–1992)", PluralForms{"", "meksički srebrni pezo (1861–1992)", "", "meksička srebrna peza (1861–1992)", "", "meksičkih srebrnih peza (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "meksički unidad de inversion (UDI)", PluralForms{"", "meksički unidads de inversion (UDI)", "", "meksička unidads de inversion (UDI)", "", "meksičkih unidads de inversion (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malezijski ringit", PluralForms{"", "malezijski ringit", "", "malezijska ringita", "", "malezijskih ringita"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "mozambijski eskudo", PluralForms{"", "mozambijski eskudo", "", "mozambijska eskuda", "", "mozambijskih eskuda"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "stari mozambijski metikal", PluralForms{"", "stari mozambijski metikal", "", "stara mozambijska metikala", "", "starih mozambijskih metikala"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mozambički metikal", PluralForms{"", "mozambijski metikal", "", "mozambijska metikala", "", "mozambijskih metikala"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibijski dolar", PluralForms{"", "namibijski dolar", "", "namibijska dolara", "", "namibijskih dolara"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigerijska naira", PluralForms{"", "nigerijska naira", "", "nigerijske naire", "", "nigerijskih naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nikaragvanska kordoba", PluralForms{"", "nikaragvanska kordoba", "", "nikaragvanske kordobe", "", "nikaragvanskih kordoba"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nikaragvanska zlatna kordoba", PluralForms{"", "nikaragvanska zlatna kordoba", "", "nikaragvanske zlatne kordobe", "", "nikaragvanskih zlatnih kordoba"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "nizozemski gulden", PluralForms{"", "nizozemski gulden", "", "nizozemska guldena", "", "nizozemskih guldena"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "norveška kruna", PluralForms{"", "norveška kruna", "", "norveške krune", "", "norveških kruna"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalska rupija", PluralForms{"", "nepalska rupija", "", "nepalske rupije", "", "nepalskih rupija"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "novozelandski dolar", PluralForms{"", "novozelandski dolar", "", "novozelandska dolara", "", "novozelandskih dolara"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omanski rijal", PluralForms{"", "omanski rijal", "", "omanska rijala", "", "omanskih rijala"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamska balboa", PluralForms{"", "panamska balboa", "", "panamske balboe", "", "panamskih balboa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "peruanski inti", PluralForms{"", "peruanski inti", "", "peruanske inti", "", "peruanskih inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruanski sol", PluralForms{"", "peruanski sol", "", "peruanska sola", "", "peruanskih sola"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "peruanski sol (1863–1965)", PluralForms{"", "peruanski sol (1863–1965)", "", "peruanska sola (1863–1965)", "", "peruanskih sola (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina Papue Nove Gvineje", PluralForms{"", "kina Papue Nove Gvineje", "", "kine Papue Nove Gvineje", "", "kina Papue Nove Gvineje"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filipinski pezo", PluralForms{"", "filipinski pezo", "", "filipinska peza", "", "filipinskih peza"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistanska rupija", PluralForms{"", "pakistanska rupija", "", "pakistanske rupije", "", "pakistanskih rupija"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "poljska zlota", PluralForms{"", "poljska zlota", "", "poljske zlote", "", "poljskih zlota"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "poljska zlota (1950.–1995.)", PluralForms{"", "poljska zlota (1950.–1995.)", "", "poljske zlote (1950.–1995.)", "", "poljskih zlota (1950.–1995.)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "portugalski eskudo", PluralForms{"", "portugalski eskudo", "", "portugalska eskuda", "", "portugalskih eskuda"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paragvajski gvarani", PluralForms{"", "paragvajski gvarani", "", "paragvajska gvaranija", "", "paragvajskih gvaranija"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarski rial", PluralForms{"", "katarski rijal", "", "katarska rijala", "", "katarskih rijala"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "rodezijski dolar", PluralForms{"", "rodezijski dolar", "", "rodezijska dolara", "", "rodezijskih dolara"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "starorumunjski lek", PluralForms{"", "stari rumunjski lej", "", "stara rumunjska leja", "", "starih rumunjskih leja"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "rumunjski lej", PluralForms{"", "rumunjski lej", "", "rumunjska leja", "", "rumunjskih leja"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "srpski dinar", PluralForms{"", "srpski dinar", "", "srpska dinara", "", "srpskih dinara"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruski rubalj", PluralForms{"", "ruski rubalj", "", "ruska rublja", "", "ruskih rubalja"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "ruska rublja (1991.–1998.)", PluralForms{"", "ruska rublja (1991.–1998.)", "", "ruske rublje (1991.–1998.)", "", "ruskih rublji (1991.–1998.)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ruandski franak", PluralForms{"", "ruandski franak", "", "ruandska franka", "", "ruandskih franaka"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudijski rijal", PluralForms{"", "saudijski rijal", "", "saudijska rijala", "", "saudijskih rijala"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "solmonskootočni dolar", PluralForms{"", "solomonskootočni dolar", "", "solomonskootočna dolara", "", "solomonskootočnih dolara"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "sejšelska rupija", PluralForms{"", "sejšelska rupija", "", "sejšelske rupije", "", "sejšelskih rupija"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "sudanski dinar", PluralForms{"", "sudanski dinar", "", "sudanska dinara", "", "sudanskih dinara"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudanska funta", PluralForms{"", "sudanska funta", "", "sudanske funte", "", "sudanskih funti"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "stara sudanska funta", PluralForms{"", "stara sudanska funta", "", "stare sudanske funte", "", "starih sudanskih funti"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "švedska kruna", PluralForms{"", "švedska kruna", "", "švedske krune", "", "švedskih kruna"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapurski dolar", PluralForms{"", "singapurski dolar", "", "singapurska dolara", "", "singapurskih dolara"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "svetohelenska funta", PluralForms{"", "svetohelenska funta", "", "svetohelenske funte", "", "svetohelenskih funti"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "slovenski tolar", PluralForms{"", "slovenski tolar", "", "slovenska tolara", "", "slovenskih tolara"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "slovačka kruna", PluralForms{"", "slovačka kruna", "", "slovačke krune", "", "slovačkih kruna"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sijeraleonski leone", PluralForms{"", "sijeraleonski leon", "", "sijarelonska leona", "", "sijeraleonskih leona"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sijeraleonski leone (1964—2022)", PluralForms{"", "sijeraleonski leone (1964—2022)", "", "sijeraleonskih leona (1964—2022)", "", "sijeraleonskih leona (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somalijski šiling", PluralForms{"", "somalijski šiling", "", "somalijska šilinga", "", "somalijskih šilinga"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamski dolar", PluralForms{"", "surinamski dolar", "", "surinamska dolara", "", "surinamskih dolara"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "surinamski gulden", PluralForms{"", "surinamski gulden", "", "surinamska guldena", "", "surinamskih guldena"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "južnosudanska funta", PluralForms{"", "južnosudanska funta", "", "južnosudanske funte", "", "južnosudanskih funti"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra Svetog Tome i Principa (1977–2017)", PluralForms{"", "dobra Svetog Tome i Principa (1977–2017)", "", "dobre Svetog Tome i Principa (1977–2017)", "", "dobri Svetog Tome i Principa (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra Svetog Tome i Principa", PluralForms{"", "dobra Svetog Tome i Principa", "", "dobre Svetog Tome i Principa", "", "dobri Svetog Tome i Principa"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "sovjetska rublja", PluralForms{"", "sovjetska rublja", "", "sovjetske rublje", "", "sovjetskih rublji"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "salvadorski kolon", PluralForms{"", "salvadorski kolon", "", "salvadorska kolona", "", "salvadorskih kolona"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "sirijska funta", PluralForms{"", "sirijska funta", "", "sirijske funte", "", "sirijskih funti"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "svazi lilangeni", PluralForms{"", "svazi lilangeni", "", "svazi lilangena", "", "svazi lilangena"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "tajlandski baht", PluralForms{"", "tajlandski baht", "", "tajlandska bahta", "", "tajlandskih bahta"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "tajikistanska rublja", PluralForms{"", "tadžikistanska rublja", "", "tadžikistanske rublje", "", "tadžikistanskih rublji"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadžikistanski somoni", PluralForms{"", "tadžikistanski somoni", "", "tadžikistanska somona", "", "tadžikistanskih somona"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "turkmenistanski manat (1993.–2009.)", PluralForms{"", "turkmenistanski manat (1993.–2009.)", "", "turkmenistanska manata (1993.–2009.)", "", "turkmenistanskih manata (1993.–2009.)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmenistanski manat", PluralForms{"", "turkmenistanski manat", "", "turkmenistanska manata", "", "turkmenistanskih manata"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tuniski dinar", PluralForms{"", "tuniski dinar", "", "tuniska dinara", "", "tuniskih dinara"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tongaška pa’anga", PluralForms{"", "tongaška pa’anga", "", "tongaške pa’ange", "", "tongaških pa’angi"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "timorski eskudo", PluralForms{"", "timorski eskudo", "", "timorska eskuda", "", "timorskih eskuda"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "turska lira (1922.–2005.)", PluralForms{"", "turska lira (1922.–2005.)", "", "turske lire (1922.–2005.)", "", "turskih lira (1922.–2005.)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turska lira", PluralForms{"", "turska lira", "", "turske lire", "", "turskih lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trininadtobaški dolar", PluralForms{"", "trinidadtobaški dolar", "", "trinidadtobaška dolara", "", "trinidadtobaških dolara"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "novotajvanski dolar", PluralForms{"", "novotajvanski dolar", "", "novotajvanska dolara", "", "novotajvanskih dolara"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanijski šiling", PluralForms{"", "tanzanijski šiling", "", "tanzanijska šilinga", "", "tanzanijskih šilinga"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrajinska hrivnja", PluralForms{"", "ukrajinska hrivnja", "", "ukrajinske hrivnje", "", "ukrajinskih hrivnji"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ukrajinski karbovanet", PluralForms{"", "ukrajinski karbovantsiv", "", "ukrajinska karbovantsiva", "", "ukrajinskih karbovantsiva"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "ugandski šiling (1966.–1987.)", PluralForms{"", "ugandski šiling (1966.–1987.)", "", "ugandska šilinga (1966.–1987.)", "", "ugandskih šilinga (1966.–1987.)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandski šiling", PluralForms{"", "ugandski šiling", "", "ugandska šilinga", "", "ugandskih šilinga"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "američki dolar", PluralForms{"", "američki dolar", "", "američka dolara", "", "američkih dolara"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "američki dolar (sljedeći dan)", PluralForms{"", "američki dolar (sljedeći dan)", "", "američka dolara (sljedeći dan)", "", "američkih dolara (sljedeći dan)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "američki dolar (isti dan)", PluralForms{"", "američki dolar (isti dan)", "", "američka dolara (isti dan)", "", "američkih dolara (isti dan)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "urugvajski pezo en unidades indexadas", PluralForms{"", "urugvajski pesos en unidades indexadas", "", "urugvajska pesosa en unidades indexadas", "", "urugvajskih pesosa en unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "urugvajski pezo (1975.–1993.)", PluralForms{"", "urugvajski pezo (1975.–1993.)", "", "urugvajska peza (1975.–1993.)", "", "urugvajskih peza (1975.–1993.)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "urugvajski pezo", PluralForms{"", "urugvajski pezo", "", "urugvajska pezosa", "", "urugvajskih pezosa"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "uzbekistanski som", PluralForms{"", "uzbekistanski som", "", "uzbekistanska soma", "", "uzbekistanskih soma"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "venezuelanski bolivar (1871.–2008.)", PluralForms{"", "venezuelanski bolivar (1871.–2008.)", "", "venezuelanska bolivara (1871.–2008.)", "", "venezuelanskih bolivara (1871.–2008.)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelanski bolivar (2008. – 2018.)", PluralForms{"", "venezuelanski bolivar (2008. – 2018.)", "", "venezuelanska bolivara (2008. – 2018.)", "", "venezuelanskih bolivara (2008. – 2018.)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelanski bolivar", PluralForms{"", "venezuelanski bolivar", "", "venezuelanska bolivara", "", "venezuelanskih bolivara"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "vijetnamski dong", PluralForms{"", "vijetnamski dong", "", "vijetnamska donga", "", "vijetnamskih donga"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "vijetnamski dong (1978.–1985.)", PluralForms{"", "vijetnamski dong (1978.–1985.)", "", "vijetnamska donga (1978.–1985.)", "", "vijetnamskih donga (1978.–1985.)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatski vatu", PluralForms{"", "vanuatski vatu", "", "vanuatska vatua", "", "vanuatskih vatua"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samoanska tala", PluralForms{"", "samoanska tala", "", "samoanske tale", "", "samoanskih tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "CFA franak BEAC", PluralForms{"", "CFA franak BEAC", "", "CFA franka BEAC", "", "CFA franaka BEAC"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "srebro", PluralForms{"", "srebro", "", "srebra", "", "srebra"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "zlato", PluralForms{"", "zlato", "", "zlata", "", "zlata"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Europska složena jedinica", PluralForms{"", "europska složena jedinica", "", "europske složene jedinice", "", "europskih složenih jedinica"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Europska monetarna jedinica", PluralForms{"", "europska monetarna jedinica", "", "europske monetarne jedinice", "", "europskih monetarnih jedinica"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "europska obračunska jedinica (XBC)", PluralForms{"", "europska obračunska jedinica (XBC)", "", "europske obračunske jedinice (XBC)", "", "europskih obračunskih jedinica (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "europska obračunska jedinica (XBD)", PluralForms{"", "europska obračunska jedinica (XBD)", "", "europske obračunske jedinice (XBD)", "", "europskih obračunskih jedinica (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "istočnokaripski dolar", PluralForms{"", "istočnokaripski dolar", "", "istočnokaripska dolara", "", "istočnokaripskih dolara"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "posebna crtaća prava", PluralForms{"", "posebno crtaće pravo", "", "poseebna crtaća prava", "", "posebnih crtaćih prava"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "europska monetarna jedinica (ECU)", PluralForms{"", "europska monetarna jedinica (ECU)", "", "europske monetarne jedinice (ECU)", "", "europskih monetarnih jedinica (ECU)"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "francuski zlatni franak", PluralForms{"", "francuski zlatni franak", "", "francuska zlatna franka", "", "francuskih zlatnih franaka"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "francuski UIC-franak", PluralForms{"", "francuski UIC-franak", "", "francuska UIC-franka", "", "francuskih UIC-franaka"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "CFA franak BCEAO", PluralForms{"", "CFA franak BCEAO", "", "CFA franka BCEAO", "", "CFA franaka BCEAO"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "paladij", PluralForms{"", "paladij", "", "paladija", "", "paladija"}},
+		"XPF": {0, 0, 0, 1, "XPF", "XPF", "XPF", "CFP franak", PluralForms{"", "CFP franak", "", "CFP franka", "", "CFP franaka"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "platina", "", "platine", "", "platina"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET fondovi", PluralForms{"", "RINET fond", "", "RINET fonda", "", "RINET fondova"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "sukre", PluralForms{"", "sukra", "", "sukre", "", "sukri"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "ispitni kod valute", PluralForms{"", "ispitni kod vlaute", "", "ispitna koda valute", "", "ispitnih kodova valute"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "obračunska jedinica ADB", PluralForms{"", "obračunska jedinica ADB", "", "obračunske jedinice ADB", "", "obračunskih jedinica ADB"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "nepoznata valuta", PluralForms{"", "(nepoznata valuta)", "", "(nepoznata valuta)", "", "(nepoznata valuta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "jemenski dinar", PluralForms{"", "jemenski dinar", "", "jemenska dinara", "", "jemenskih dinara"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenski rijal", PluralForms{"", "jemenski rijal", "", "jemenska rijala", "", "jemenskih rijala"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "jugoslavenski čvrsti dinar", PluralForms{"", "jugoslavenski čvrsti dinar", "", "jugoslavenska čvrsta dinara", "", "jugoslavenskih čvrstih dinara"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "jugoslavenski novi dinar", PluralForms{"", "jugoslavenski novi dinar", "", "jugoslavenska nova dinara", "", "jugoslavenskih novih dinara"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "jugoslavenski konvertibilni dinar", PluralForms{"", "jugoslavenski konvertibilni dinar", "", "jugoslavenska konvertibilna dinara", "", "jugoslavenskih konvertibilnih dinara"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "jugoslavenski reformirani dinar", PluralForms{"", "jugoslavenski reformirani dinar", "", "jugoslavenska reformirana dinara", "", "jugoslavenskih reformiranih dinara"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "južnoafrički rand (financijski)", PluralForms{"", "južnoafrički rand (financijski)", "", "južnoafrička randa (financijska)", "", "južnoafričkih randa (financijskih)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "južnoafrički rand", PluralForms{"", "južnoafrički rand", "", "južnoafrička randa", "", "južnoafričkih randa"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "zambijska kvača (1968–2012)", PluralForms{"", "zambijska kvača (1968–2012)", "", "zambijske kvače (1968–2012)", "", "zambijskih kvača (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambijska kvača", PluralForms{"", "zambijska kvača", "", "zambijske kvače", "", "zambijskih kvača"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zairski novi zair", PluralForms{"", "zairski novi zair", "", "zairska nova zaira", "", "zairskih novih zaira"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zairski zair", PluralForms{"", "zairski zair", "", "zairska zaira", "", "zairskih zaira"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "zimbabveanski dolar (1980.–2008.)", PluralForms{"", "zimbabveanski dolar (1980.–2008.)", "", "zimbabveanska dolara (1980.–2008.)", "", "zimbabveanskih dolara (1980.–2008.)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "zimbabveanski dolar (2009)", PluralForms{"", "zimbabveanski dolar (2009)", "", "zimbabveanska dolara (2009)", "", "zimbabveanskih dolara (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "zimbabveanski dolar (2008)", PluralForms{"", "zimbabveanski dolar (2008)", "", "zimbabveanska dolara (2008)", "", "zimbabveanskih dolara (2008)"}},
 	},
 }
@@ -6,172 +6,225 @@ var ig = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "¤0K"}},
+				{4, 2, map[string]string{"other": "¤0K"}},
+				{5, 3, map[string]string{"other": "¤0K"}},
+				{6, 1, map[string]string{"other": "¤0M"}},
+				{7, 2, map[string]string{"other": "¤0M"}},
+				{8, 3, map[string]string{"other": "¤0M"}},
+				{9, 1, map[string]string{"other": "¤0G"}},
+				{10, 2, map[string]string{"other": "¤0G"}},
+				{11, 3, map[string]string{"other": "¤0G"}},
+				{12, 1, map[string]string{"other": "¤0T"}},
+				{13, 2, map[string]string{"other": "¤0T"}},
+				{14, 3, map[string]string{"other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "₦", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Ego Dirham obodo United Arab Emirates", PluralForms{"", "", "", "", "", "Ego dirhams obodo UAE"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Ego Afghani Obodo Afghanistan", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Ego Lek Obodo Albania", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Ego Dram obodo Armenia", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Ego Antillean Guilder obodo Netherlands", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Ego Kwanza obodo Angola", PluralForms{"", "", "", "", "", "Ego kwanzas obodo Angola"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Ego Peso obodo Argentina", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Ego Dollar obodo Australia", PluralForms{"", "", "", "", "", "Ego dollars obodo Australia"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Ego Florin obodo Aruba", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Ego Manat obodo Azerbaijan", PluralForms{"", "", "", "", "", "Ego manats obodo Azerbaijan"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Akara mgbanwe ego obodo Bosnia-Herzegovina", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Ego Dollar obodo Barbados", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Ego Taka obodo Bangladesh", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Ego Lev mba Bulgaria", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Ego Dinar Obodo Bahrain", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Ego Franc obodo Burundi", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dollar Bermuda", PluralForms{"", "", "", "", "", "Dollars Bermuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Ego Dollar obodo Brunei", PluralForms{"", "", "", "", "", "Ego dollars obodo Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Ego Boliviano obodo Bolivia", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Real Brazil", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Ego Dollar Obodo Bahamas", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ego Ngultrum obodo Bhutan", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Ego Pula obodo Bostwana", PluralForms{"", "", "", "", "", "Ego pulas obodo Bostwana"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Ego Ruble mba Belarus", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dollar Belize", PluralForms{"", "", "", "", "", "Dollars Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dollar Canada", PluralForms{"", "", "", "", "", "Dollars Canada"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Ego Franc obodo Congo", PluralForms{"", "", "", "", "", "Ego francs mba Congo"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Ego Franc mba Switzerland", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Ego Peso obodo Chile", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Ego Yuan Obodo China (ndị bi na mmiri)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuan China", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Ego Peso obodo Columbia", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Ego Colón obodo Costa Rica", PluralForms{"", "", "", "", "", "Ego colóns obodo Costa Rica"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Ego Peso e nwere ike ịgbanwe nke obodo Cuba", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Ego Peso obodo Cuba", PluralForms{"", "", "", "", "", "Ego pesos obodo Cuba"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Escudo Caboverdiano", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Ego Koruna obodo Czech", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Ego Franc obodo Djibouti", PluralForms{"", "", "", "", "", "ego francs obodo Djibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Ego Krone Obodo Denmark", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Ego Peso Obodo Dominica", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Ego Dinar Obodo Algeria", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Ego Pound obodo Egypt", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Ego Nakfa obodo Eritrea", PluralForms{"", "", "", "", "", "Ego nakfas obodo Eritrea"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Ego Birr obodo Ethiopia", PluralForms{"", "", "", "", "", "Ego birrs obodo Ethiopia"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "", "", "", "", "euro"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Ego Dollar obodo Fiji", PluralForms{"", "", "", "", "", "Ego dollars obodo Fijian"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Ego Pound obodo Falkland Islands", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Pound British", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Ego Lari Obodo Georgia", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ego Cedi obodo Ghana", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Ego Pound obodo Gibraltar", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Ego Dalasi obodo Gambia", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Ego Franc obodo Guinea", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Ego Quetzal obodo Guatemala", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Ego Dollar obodo Guyana", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Ego Dollar Obodo Honk Kong", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Ego Lempira obodo Honduras", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Ego Kuna obodo Croatia", PluralForms{"", "", "", "", "", "Ego kunas obodo Croatia"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Ego Gourde obodo Haiti", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Ego Forint obodo Hungary", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Ego Rupiah Obodo Indonesia", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Ego Shekel ọhụrụ obodo Israel", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupee India", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Ego Dinar obodo Iraq", PluralForms{"", "", "", "", "", "Ego dinars obodo Iraq"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Ego Rial obodo Iran", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Ego Króna obodo Iceland", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Ego Dollar obodo Jamaica", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Ego Dinar Obodo Jordan", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Yen Japan", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Ego Shilling obodo Kenya", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Ego Som Obodo Kyrgyzstan", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Ego Riel obodo Cambodia", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Ego Franc obodo Comoros", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Ego Won Obodo North Korea", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Ego Won Obodo South Korea", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Ego Dinar Obodo Kuwait", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Ego Dollar obodo Cayman Islands", PluralForms{"", "", "", "", "", "Ego dollars obodo Cayman Islands"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Ego Tenge obodo Kazakhstani", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Ego Kip Obodo Laos", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Ego Pound obodo Lebanon", PluralForms{"", "", "", "", "", "Ego Pound Obodo Lebanon"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Ego Rupee obodo Sri Lanka", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Ego Dollar obodo Liberia", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Ego loti obodo Lesotho", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Ego Dinar obodo Libya", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Ego Dirham obodo Morocco", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Ego Leu obodo Moldova", PluralForms{"", "", "", "", "", "Ego leu mba Moldova"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ego Ariary obodo Madagascar", PluralForms{"", "", "", "", "", "Ego ariaries obodo Madagascar"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Ego Denar Obodo Macedonia", PluralForms{"", "", "", "", "", "Ego denari mba Macedonia"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Ego Kyat obodo Myanmar", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Ego Turgik Obodo Mongolia", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Ego Pataca ndị Obodo Macanese", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ego Ouguiya Obodo Mauritania", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Ego Rupee obodo Mauritania", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Ego Rufiyaa obodo Moldova", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Ego Kwacha obodo Malawi", PluralForms{"", "", "", "", "", "Ego kwachas obodo Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Ego Peso obodo Mexico", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ego Ringgit obodo Malaysia", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Ego Metical obodo Mozambique", PluralForms{"", "", "", "", "", "Ego meticals obodo Mozambique"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Ego Dollar obodo Namibia", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "₦", "₦", "Naịra", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Ego Córodoba obodo Nicaragua", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Ego Krone Obodo Norway", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Ego Rupee obodo Nepal", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Ego Dollar obodo New Zealand", PluralForms{"", "", "", "", "", "Ego dollars obodo New Zealand"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Ego Rial obodo Oman", PluralForms{"", "", "", "", "", "Ego rials Obodo Oman"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Ego Balboa obodo Panama", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Ego Sol obodo Peru", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Ego Kina obodo Papua New Guinea", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Ego Piso obodo Philippine", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Ego Rupee obodo Pakistan", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Ego Zloty mba Poland", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Ego Guarani obodo Paraguay", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Ego Rial obodo Qatar", PluralForms{"", "", "", "", "", "Ego rials obodo Qatar"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Ego Leu obodo Romania", PluralForms{"", "", "", "", "", "Ego leu Obodo Romania"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Ego Dinar obodo Serbia", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Ruble Russia", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ego Franc obodo Rwanda", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Ego Riyal obodo Saudi", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Ego Dollar obodo Solomon Islands", PluralForms{"", "", "", "", "", "Ego dollars obodo Solomon Islands"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Ego Rupee obodo Seychelles", PluralForms{"", "", "", "", "", "Ego rupees obodo Seychelles"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Ego Pound obodo Sudan", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Ego Krona Obodo Sweden", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Ego Dollar obodo Singapore", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Ego Pound obodo St Helena", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Ego Leone obodo Sierra Leone", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Ego Leone obodo Sierra Leone (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Ego shilling obodo Somali", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dollar Surinamese", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Ego Pound obodo South Sudan", PluralForms{"", "", "", "", "", "Ego pounds mba ọdịda anyanwụ Sudan"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Ego Dobra nke obodo Sāo Tomé na Principe", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Ego Pound obodo Syria", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Ego Lilangeni obodo Swaziland", PluralForms{"", "", "", "", "", "Ego emalangeni obodo Swaziland"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Ego Baht obodo Thai", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Who Somoni obodo Tajikistan", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Ego Manat Obodo Turkmenistan", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Ego Dinar Obodo Tunisia", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Ego paʻanga obodo Tonga", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Ego Lira obodo Turkey", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dollar Trinidad & Tobago", PluralForms{"", "", "", "", "", "Dollars Trinidad & Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Dollar obodo New Taiwan", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Ego Shilling Obodo Tanzania", PluralForms{"", "", "", "", "", "Ego Shillings Obodo Tanzania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ego Hryvnia obodo Ukraine", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ego Shilling obodo Uganda", PluralForms{"", "", "", "", "", "Ego shillings obodo Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dollar US", PluralForms{"", "", "", "", "", "Dollars US"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Ego Peso obodo Uruguay", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Ego Som obodo Uzbekistan", PluralForms{"", "", "", "", "", "Ego som obodo Uzbekistan"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Ego Bolivar obodo Venezuela", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Ego Dong obodo Vietnam", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Ego Vatu obodo Vanuatu", PluralForms{"", "", "", "", "", "Ego Vanuatu vatus obodo Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Ego Tala obodo Samoa", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Ego Franc mba etiti Africa", PluralForms{"", "", "", "", "", "Ego francs mba etiti Africa"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Ego Dollar obodo East Carribbean", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Ego CFA Franc obodo West Africa", PluralForms{"", "", "", "", "", "Ego CFA francs mba ọdịda anyanwụ Afrịka"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Ego Franc obodo CFP", PluralForms{"", "", "", "", "", "Ego francs obodo CFP"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Ego Amaghị", PluralForms{"", "", "", "", "", "(ego amaghị)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Ego Rial obodo Yemeni", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Ego Rand obodo South Africa", PluralForms{"", "", "", "", "", "Ego rand obodo South Africa"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Ego Kwacha Obodo Zambia", PluralForms{"", "", "", "", "", "Ego kwachas obodo Zambia"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
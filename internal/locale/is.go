@@ -6,238 +6,291 @@ var is = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0þ.", "other": "0\u00a0þ."}},
+				{4, 2, map[string]string{"one": "0\u00a0þ.", "other": "0\u00a0þ."}},
+				{5, 3, map[string]string{"one": "0\u00a0þ.", "other": "0\u00a0þ."}},
+				{6, 1, map[string]string{"one": "0\u00a0m.", "other": "0\u00a0m."}},
+				{7, 2, map[string]string{"one": "0\u00a0m.", "other": "0\u00a0m."}},
+				{8, 3, map[string]string{"one": "0\u00a0m.", "other": "0\u00a0m."}},
+				{9, 1, map[string]string{"one": "0\u00a0ma.", "other": "0\u00a0ma."}},
+				{10, 2, map[string]string{"one": "0\u00a0ma.", "other": "0\u00a0ma."}},
+				{11, 3, map[string]string{"one": "0\u00a0ma.", "other": "0\u00a0ma."}},
+				{12, 1, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+				{13, 2, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+				{14, 3, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 þúsund", "other": "0 þúsund"}},
+				{4, 2, map[string]string{"one": "0 þúsund", "other": "0 þúsund"}},
+				{5, 3, map[string]string{"one": "0 þúsund", "other": "0 þúsund"}},
+				{6, 1, map[string]string{"one": "0 milljón", "other": "0 milljónir"}},
+				{7, 2, map[string]string{"one": "0 milljón", "other": "0 milljónir"}},
+				{8, 3, map[string]string{"one": "0 milljón", "other": "0 milljónir"}},
+				{9, 1, map[string]string{"one": "0 milljarður", "other": "0 milljarðar"}},
+				{10, 2, map[string]string{"one": "0 milljarður", "other": "0 milljarðar"}},
+				{11, 3, map[string]string{"one": "0 milljarður", "other": "0 milljarðar"}},
+				{12, 1, map[string]string{"one": "0 billjón", "other": "0 billjónir"}},
+				{13, 2, map[string]string{"one": "0 billjón", "other": "0 billjónir"}},
+				{14, 3, map[string]string{"one": "0 billjón", "other": "0 billjónir"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0þ.\u00a0¤", "other": "0\u00a0þ.\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0þ.\u00a0¤", "other": "0\u00a0þ.\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0þ.\u00a0¤", "other": "0\u00a0þ.\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0m.\u00a0¤", "other": "0\u00a0m.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0m.\u00a0¤", "other": "0\u00a0m.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0m.\u00a0¤", "other": "0\u00a0m.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0ma.\u00a0¤", "other": "0\u00a0ma.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0ma.\u00a0¤", "other": "0\u00a0ma.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0ma.\u00a0¤", "other": "0\u00a0ma.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0bn\u00a0¤", "other": "0\u00a0bn\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0bn\u00a0¤", "other": "0\u00a0bn\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0bn\u00a0¤", "other": "0\u00a0bn\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr."},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "EUR", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "kr.", "kr."},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr."},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr."},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "XCG", "XCG"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorrskur peseti", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "arabískt dírham", PluralForms{"", "arabískt dírham", "", "", "", "arabísk dírhöm"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani", PluralForms{"", "afgani", "", "", "", "afganar"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albanskt lek", PluralForms{"", "albanskt lek", "", "", "", "albönsk lek"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armenskt dramm", PluralForms{"", "armenskt dramm", "", "", "", "armensk drömm"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "hollenskt Antillugyllini", PluralForms{"", "hollenskt Antillugyllini", "", "", "", "hollensk Antillugyllini"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angólsk kvansa", PluralForms{"", "angólsk kvansa", "", "", "", "angólskar kvönsur"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentine Austral", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentískur pesi (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentínskur pesi", PluralForms{"", "argentínskur pesi", "", "", "", "argentínskir pesar"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austurrískur skildingur", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "ástralskur dalur", PluralForms{"", "ástralskur dalur", "", "", "", "ástralskir dalir"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arúbönsk flórína", PluralForms{"", "arúbönsk flórína", "", "", "", "arúbanskar flórínur"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "aserskt manat", PluralForms{"", "aserskt manat", "", "", "", "asersk manöt"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "skiptanlegt Bosníu og Hersegóvínu-mark", PluralForms{"", "skiptanlegt Bosníu og Hersegóvínu-mark", "", "", "", "skiptanleg Bosníu og Hersegóvínu-mörk"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoskur dalur", PluralForms{"", "barbadoskur dalur", "", "", "", "barbadoskir dalir"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladessk taka", PluralForms{"", "bangladessk taka", "", "", "", "bangladesskar tökur"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgískur franki", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Lef", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "búlgarskt lef", PluralForms{"", "búlgarskt lef", "", "", "", "búlgörsk lef"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bareinskur denari", PluralForms{"", "bareinskur denari", "", "", "", "bareinskir denarar"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "búrúndískur franki", PluralForms{"", "búrúndískur franki", "", "", "", "búrúndískir frankar"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermúdadalur", PluralForms{"", "Bermúdadalur", "", "", "", "Bermúdadalir"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "brúneiskur dalur", PluralForms{"", "brúneiskur dalur", "", "", "", "brúneiskir dalir"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bólivíani", PluralForms{"", "bólivíani", "", "", "", "bólivíanar"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bólivískur pesi", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Bolivian Mvdol", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "brasilískt ríal", PluralForms{"", "brasilískt ríal", "", "", "", "brasilísk ríöl"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamadalur", PluralForms{"", "Bahamadalur", "", "", "", "Bahamadalir"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bútanskt núltrum", PluralForms{"", "bútanskt núltrum", "", "", "", "bútönsk núltrum"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Búrmverskt kjat", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botsvönsk púla", PluralForms{"", "botsvönsk púla", "", "", "", "botsvanskar púlur"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "hvítrússnesk rúbla", PluralForms{"", "hvítrússnesk rúbla", "", "", "", "hvítrússneskar rúblur"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "hvítrússnesk rúbla (2000–2016)", PluralForms{"", "hvítrússnesk rúbla (2000–2016)", "", "", "", "hvítrússneskar rúblur (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belískur dalur", PluralForms{"", "belískur dalur", "", "", "", "belískir dalir"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "Kanadadalur", PluralForms{"", "Kanadadalur", "", "", "", "Kanadadalir"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongóskur franki", PluralForms{"", "kongóskur franki", "", "", "", "kongóskir frankar"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "svissneskur franki", PluralForms{"", "svissneskur franki", "", "", "", "svissneskir frankar"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Chilean Unidades de Fomento", PluralForms{"", "síleskt Unidad de Fomento", "", "", "", "síleskt Unidad de Fomento"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "síleskur pesi", PluralForms{"", "síleskur pesi", "", "", "", "síleskir pesar"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kínverskt júan (utan heimalands)", PluralForms{"", "kínverskt júan (utan heimalands)", "", "", "", "kínversk júön (utan heimalands)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "kínverskt júan", PluralForms{"", "kínverskt júan", "", "", "", "kínversk júön"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kólumbískur pesi", PluralForms{"", "kólumbískur pesi", "", "", "", "kólumbískir pesar"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kostarískt kólon", PluralForms{"", "kostarískt kólon", "", "", "", "kostarísk kólon"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Tékknesk króna, eldri", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kúbverskur skiptanlegur pesi", PluralForms{"", "kúbverskur skiptanlegur pesi", "", "", "", "kúbverskir skiptanlegir pesar"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kúbverskur pesi", PluralForms{"", "kúbverskur pesi", "", "", "", "kúbverskir pesar"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "grænhöfðeyskur skúti", PluralForms{"", "grænhöfðeyskur skúti", "", "", "", "grænhöfðeyskir skútar"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kýpverskt pund", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "tékknesk króna", PluralForms{"", "tékknesk króna", "", "", "", "tékkneskar krónur"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Austurþýskt mark", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Þýskt mark", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "djíbútískur franki", PluralForms{"", "djíbútískur franki", "", "", "", "djíbútískir frankar"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr.", "dönsk króna", PluralForms{"", "dönsk króna", "", "", "", "danskar krónur"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dóminískur pesi", PluralForms{"", "dóminískur pesi", "", "", "", "dóminískir pesar"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "alsírskur denari", PluralForms{"", "alsírskur denari", "", "", "", "alsírskir denarar"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ecuador Sucre", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Eistnesk króna", PluralForms{"", "eistnesk króna", "", "", "", "eistneskar krónur"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egypskt pund", PluralForms{"", "egypskt pund", "", "", "", "egypsk pund"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "erítresk nakfa", PluralForms{"", "erítresk nakfa", "", "", "", "erítreskar nökfur"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Spænskur peseti", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "eþíópískt birr", PluralForms{"", "eþíópískt birr", "", "", "", "eþíópísk birr"}},
+		"EUR": {2, 0, 2, 1, "EUR", "EUR", "€", "evra", PluralForms{"", "evra", "", "", "", "evrur"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Finnskt mark", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fídjískur dalur", PluralForms{"", "fídjískur dalur", "", "", "", "fídjískir dalir"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklenskt pund", PluralForms{"", "falklenskt pund", "", "", "", "falklensk pund"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Franskur franki", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "sterlingspund", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "georgískur lari", PluralForms{"", "georgískur lari", "", "", "", "georgískir larar"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ganverskur sedi", PluralForms{"", "ganverskur sedi", "", "", "", "ganverskir sedar"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gíbraltarspund", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambískur dalasi", PluralForms{"", "gambískur dalasi", "", "", "", "gambískir dalasar"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gíneufranki", PluralForms{"", "Gíneufranki", "", "", "", "Gíneufrankar"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Drakma", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "gvatemalskt kvesal", PluralForms{"", "gvatemalskt kvesal", "", "", "", "gvatemölsk kvesöl"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portúgalskur, gíneskur skúti", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "gvæjanskur dalur", PluralForms{"", "gvæjanskur dalur", "", "", "", "gvæjanskir dalir"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Hong Kong-dalur", PluralForms{"", "Hong Kong-dalur", "", "", "", "Hong Kong-dalir"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "hondúrsk lempíra", PluralForms{"", "hondúrsk lempíra", "", "", "", "hondúrskar lempírur"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "króatísk kúna", PluralForms{"", "króatísk kúna", "", "", "", "króatískar kúnur"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haítískur gúrdi", PluralForms{"", "haítískur gúrdi", "", "", "", "haítískir gúrdar"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ungversk fórinta", PluralForms{"", "ungversk fórinta", "", "", "", "ungverskar fórintur"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indónesísk rúpía", PluralForms{"", "indónesísk rúpía", "", "", "", "indónesískar rúpíur"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Írskt pund", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Ísraelskt pund", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "nýr ísraelskur sikill", PluralForms{"", "nýr ísraelskur sikill", "", "", "", "nýir ísraelskir siklar"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "indversk rúpía", PluralForms{"", "indversk rúpía", "", "", "", "indverskar rúpíur"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "írakskur denari", PluralForms{"", "írakskur denari", "", "", "", "írakskir denarar"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "íranskt ríal", PluralForms{"", "íranskt ríal", "", "", "", "írönsk ríöl"}},
+		"ISK": {0, 0, 0, 1, "ISK", "kr.", "kr.", "íslensk króna", PluralForms{"", "íslensk króna", "", "", "", "íslenskar krónur"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Ítölsk líra", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamaískur dalur", PluralForms{"", "jamaískur dalur", "", "", "", "jamaískir dalir"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jórdanskur denari", PluralForms{"", "jórdanskur denari", "", "", "", "jórdanskir denarar"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "japanskt jen", PluralForms{"", "japanskt jen", "", "", "", "japönsk jen"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenískur skildingur", PluralForms{"", "kenískur skildingur", "", "", "", "kenískir skildingar"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgiskt som", PluralForms{"", "kirgiskt som", "", "", "", "kirgisk som"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambódískt ríal", PluralForms{"", "kambódískt ríal", "", "", "", "kambódísk ríöl"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "kómoreyskur franki", PluralForms{"", "kómoreyskur franki", "", "", "", "kómoreyskir frankar"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "norðurkóreskt vonn", PluralForms{"", "norðurkóreskt vonn", "", "", "", "norðurkóresk vonn"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "suðurkóreskt vonn", PluralForms{"", "suðurkóreskt vonn", "", "", "", "suðurkóresk vonn"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kúveiskur denari", PluralForms{"", "kúveiskur denari", "", "", "", "kúveiskir denarar"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "caymaneyskur dalur", PluralForms{"", "caymaneyskur dalur", "", "", "", "caymaneyskir dalir"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kasakst tengi", PluralForms{"", "kasakst tengi", "", "", "", "kasöksk tengi"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoskt kip", PluralForms{"", "laoskt kip", "", "", "", "laosk kip"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "líbanskt pund", PluralForms{"", "líbanskt pund", "", "", "", "líbönsk pund"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "srílönsk rúpía", PluralForms{"", "srílönsk rúpía", "", "", "", "srílanskar rúpíur"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "líberískur dalur", PluralForms{"", "líberískur dalur", "", "", "", "líberískir dalir"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesótóskur lóti", PluralForms{"", "lesótóskur lóti", "", "", "", "lesótóskir lótar"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litháískt lít", PluralForms{"", "litháískt lít", "", "", "", "litháísk lít"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Lithuanian Talonas", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Lúxemborgarfranki", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lettneskt lat", PluralForms{"", "lettneskt lat", "", "", "", "lettnesk löt"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Lettnesk rúbla", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "líbískur denari", PluralForms{"", "líbískur denari", "", "", "", "líbískir denarar"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marokkóskt dírham", PluralForms{"", "marokkóskt dírham", "", "", "", "marokkósk dírhöm"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Marokkóskur franki", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavískt lei", PluralForms{"", "moldavískt lei", "", "", "", "moldavísk lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madagaskararjari", PluralForms{"", "Madagaskararjari", "", "", "", "Madagaskararjarar"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madagaskur franki", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedónskur denari", PluralForms{"", "makedónskur denari", "", "", "", "makedónskir denarar"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malískur franki", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "mjanmarskt kjat", PluralForms{"", "mjanmarskt kjat", "", "", "", "mjanmörsk kjöt"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongólskur túríkur", PluralForms{"", "mongólskur túríkur", "", "", "", "mongólskir túríkar"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "makaósk pataka", PluralForms{"", "makaósk pataka", "", "", "", "makaóskar patökur"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "márítönsk úgía (1973–2017)", PluralForms{"", "máritönsk úgía (1973–2017)", "", "", "", "máritanskar úgíur (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "márítönsk úgía", PluralForms{"", "máritönsk úgía", "", "", "", "máritanskar úgíur"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Meltnesk líra", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltneskt pund", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "máritísk rúpía", PluralForms{"", "máritísk rúpía", "", "", "", "máritískar rúpíur"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldíveysk rúpía", PluralForms{"", "maldíveysk rúpía", "", "", "", "maldíveyskar rúpíur"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malavísk kvaka", PluralForms{"", "malavísk kvaka", "", "", "", "malavískar kvökur"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "mexíkóskur pesi", PluralForms{"", "mexíkóskur pesi", "", "", "", "mexíkóskir pesar"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Mexíkóskur silfurpesi (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Mexíkóskur pesi, UDI", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malasískt ringit", PluralForms{"", "malasískt ringit", "", "", "", "malasísk ringit"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mósambískur skúti", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mósambískt metikal", PluralForms{"", "mósambískt metikal", "", "", "", "mósambísk metiköl"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibískur dalur", PluralForms{"", "namibískur dalur", "", "", "", "namibískir dalir"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nígerísk næra", PluralForms{"", "nígerísk næra", "", "", "", "nígerískar nærur"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Níkarögsk kordóva (1988–1991)", PluralForms{"", "Níkarögsk kordóva (1988–1991)", "", "", "", "Níkaragskar kordóvur (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "níkarögsk kordóva", PluralForms{"", "níkarögsk kordóva", "", "", "", "níkaragskar kordóvur"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Hollenskt gyllini", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr.", "norsk króna", PluralForms{"", "norsk króna", "", "", "", "norskar krónur"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepölsk rúpía", PluralForms{"", "nepölsk rúpía", "", "", "", "nepalskar rúpíur"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "nýsjálenskur dalur", PluralForms{"", "nýsjálenskur dalur", "", "", "", "nýsjálenskir dalir"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ómanskt ríal", PluralForms{"", "ómanskt ríal", "", "", "", "ómönsk ríöl"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balbói", PluralForms{"", "balbói", "", "", "", "balbóar"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "perúskt sól", PluralForms{"", "perúskt sól", "", "", "", "perúsk sól"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papúsk kína", PluralForms{"", "papúsk kína", "", "", "", "papúskar kínur"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filippseyskur pesi", PluralForms{"", "filippseyskur pesi", "", "", "", "filippseyskir pesar"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistönsk rúpía", PluralForms{"", "pakistönsk rúpía", "", "", "", "pakistanskar rúpíur"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "pólskt slot", PluralForms{"", "pólskt slot", "", "", "", "pólsk slot"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Slot", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portúgalskur skúti", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paragvæskt gvaraní", PluralForms{"", "paragvæskt gvaraní", "", "", "", "paragvæsk gvaraní"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarskt ríal", PluralForms{"", "katarskt ríal", "", "", "", "katörsk ríöl"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rúmenskt lei (1952–2006)", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "rúmenskt lei", PluralForms{"", "rúmenskt lei", "", "", "", "rúmensk lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "serbneskur denari", PluralForms{"", "serbneskur denari", "", "", "", "serbneskir denarar"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rússnesk rúbla", PluralForms{"", "rússnesk rúbla", "", "", "", "rússneskar rúblur"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Rússnesk rúbla (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "rúandskur franki", PluralForms{"", "rúandskur franki", "", "", "", "rúandskir frankar"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "sádíarabískt ríal", PluralForms{"", "sádiarabískt ríal", "", "", "", "sádiarabísk ríöl"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "salómonseyskur dalur", PluralForms{"", "salómonseyskur dalur", "", "", "", "salómonseyskir dalir"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seychellesrúpía", PluralForms{"", "Seychellesrúpía", "", "", "", "Seychellesrúpíur"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Súdanskur denari", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "súdanskt pund", PluralForms{"", "súdanskt pund", "", "", "", "súdönsk pund"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Súdanskt pund (1957–1998)", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr.", "sænsk króna", PluralForms{"", "sænsk króna", "", "", "", "sænskar krónur"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapúrskur dalur", PluralForms{"", "singapúrskur dalur", "", "", "", "singapúrskir dalir"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "helenskt pund", PluralForms{"", "helenskt pund", "", "", "", "helensk pund"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slóvenskur dalur", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slóvakísk króna", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "síerraleónsk ljóna", PluralForms{"", "síerraleónsk ljóna", "", "", "", "síerraleónskar ljónur"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "síerraleónsk ljóna (1964—2022)", PluralForms{"", "síerraleónsk ljóna (1964—2022)", "", "", "", "síerraleónskar ljónur (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "sómalískur skildingur", PluralForms{"", "sómalískur skildingur", "", "", "", "sómalískir skildingar"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Súrínamdalur", PluralForms{"", "Súrínamdalur", "", "", "", "Súrínamdalir"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Suriname Guilder", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "suðursúdanskt pund", PluralForms{"", "suðursúdanskt pund", "", "", "", "suðursúdönsk pund"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Saó Tóme og Prinsípe-dóbra (1977–2017)", PluralForms{"", "Saó Tóme og Prinsípe-dóbra (1977–2017)", "", "", "", "Saó Tóme og Prinsípe-dóbrur (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Saó Tóme og Prinsípe-dóbra", PluralForms{"", "Saó Tóme og Prinsípe-dóbra", "", "", "", "Saó Tóme og Prinsípe-dóbrur"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Soviet Rouble", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "El Salvador Colon", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "sýrlenskt pund", PluralForms{"", "sýrlenskt pund", "", "", "", "sýrlensk pund"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "svasílenskur lílangeni", PluralForms{"", "svasílenskur lílangeni", "", "", "", "svasílenskir lílangenar"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "taílenskt bat", PluralForms{"", "taílenskt bat", "", "", "", "taílensk böt"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadsjiksk rúbla", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadsjikskur sómóni", PluralForms{"", "tadsjikskur sómóni", "", "", "", "tadsjikskir sómónar"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Túrkmenskt manat (1993–2009)", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "túrkmenskt manat", PluralForms{"", "túrkmenskt manat", "", "", "", "túrkmensk manöt"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "túniskur denari", PluralForms{"", "túniskur denari", "", "", "", "túniskir denarar"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tongapanga", PluralForms{"", "Tongapanga", "", "", "", "Tongapöngur"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Tímorskur skúti", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Tyrknesk líra (1922–2005)", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "tyrknesk líra", PluralForms{"", "tyrknesk líra", "", "", "", "tyrkneskar lírur"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trínidad og Tóbagó-dalur", PluralForms{"", "Trínidad og Tóbagó-dalur", "", "", "", "Trínidad og Tóbagó-dalir"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "taívanskur dalur", PluralForms{"", "taívanskur dalur", "", "", "", "taívanskir dalir"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tansanískur skildingur", PluralForms{"", "tansanískur skildingur", "", "", "", "tansanískir skildingar"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "úkraínsk hrinja", PluralForms{"", "úkraínsk hrinja", "", "", "", "úkraínskar hrinjur"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrainian Karbovanetz", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "úgandskur skildingur", PluralForms{"", "úgandskur skildingur", "", "", "", "úgandskir skildingar"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "Bandaríkjadalur", PluralForms{"", "Bandaríkjadalur", "", "", "", "Bandaríkjadalir"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Bandaríkjadalur (næsta dag)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Bandaríkjadalur (sama dag)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "úrúgvæskur pesi", PluralForms{"", "úrúgvæskur pesi", "", "", "", "úrúgvæskir pesar"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "úsbekskt súm", PluralForms{"", "úsbekskt súm", "", "", "", "úsbeksk súm"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Bolívar í Venesúela (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venesúelskur bólívari (2008–2018)", PluralForms{"", "venesúelskur bólívari (2008–2018)", "", "", "", "venesúelskir bólívarar (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venesúelskur bólívari", PluralForms{"", "venesúelskur bólívari", "", "", "", "venesúelskir bólívarar"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "víetnamskt dong", PluralForms{"", "víetnamskt dong", "", "", "", "víetnömsk dong"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanúatúskt vatú", PluralForms{"", "vanúatúskt vatú", "", "", "", "vanúatúsk vatú"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samóatala", PluralForms{"", "Samóatala", "", "", "", "Samóatölur"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "miðafrískur franki", PluralForms{"", "miðafrískur franki", "", "", "", "miðafrískir frankar"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "austurkarabískur dalur", PluralForms{"", "austurkarabískur dalur", "", "", "", "austurkarabískir dalir"}},
+		"XCG": {2, 0, 2, 1, "XCG", "XCG", "XCG", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Sérstök dráttarréttindi", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Franskur gullfranki", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Franskur franki, UIC", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "vesturafrískur franki", PluralForms{"", "vesturafrískur franki", "", "", "", "vesturafrískir frankar"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "pólinesískur franki", PluralForms{"", "pólinesískur franki", "", "", "", "pólinesískir frankar"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "óþekktur gjaldmiðill", PluralForms{"", "(óþekkt mynteining gjaldmiðils)", "", "", "", "(óþekktur gjaldmiðill)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jemenskur denari", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenskt ríal", PluralForms{"", "jemenskt ríal", "", "", "", "jemensk ríöl"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Júgóslavneskur denari", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Rand (viðskipta)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "suðurafrískt rand", PluralForms{"", "suðurafrískt rand", "", "", "", "suðurafrísk rönd"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambian Kwacha (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "sambísk kvaka", PluralForms{"", "sambísk kvaka", "", "", "", "sambískar kvökur"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Simbabveskur dalur", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,285 +6,338 @@ var itSM = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0K", "other": "0K"}},
+				{4, 2, map[string]string{"one": "0K", "other": "0K"}},
+				{5, 3, map[string]string{"one": "0K", "other": "0K"}},
+				{6, 1, map[string]string{"one": "0\u00a0Mln", "other": "0\u00a0Mln"}},
+				{7, 2, map[string]string{"one": "0\u00a0Mln", "other": "0\u00a0Mln"}},
+				{8, 3, map[string]string{"one": "0\u00a0Mln", "other": "0\u00a0Mln"}},
+				{9, 1, map[string]string{"one": "0\u00a0Mld", "other": "0\u00a0Mld"}},
+				{10, 2, map[string]string{"one": "0\u00a0Mld", "other": "0\u00a0Mld"}},
+				{11, 3, map[string]string{"one": "0\u00a0Mld", "other": "0\u00a0Mld"}},
+				{12, 1, map[string]string{"one": "0\u00a0Bln", "other": "0\u00a0Bln"}},
+				{13, 2, map[string]string{"one": "0\u00a0Bln", "other": "0\u00a0Bln"}},
+				{14, 3, map[string]string{"one": "0\u00a0Bln", "other": "0\u00a0Bln"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "mille", "other": "0 mila"}},
+				{4, 2, map[string]string{"one": "0 mila", "other": "0 mila"}},
+				{5, 3, map[string]string{"one": "0 mila", "other": "0 mila"}},
+				{6, 1, map[string]string{"one": "0 milione", "other": "0 milioni"}},
+				{7, 2, map[string]string{"one": "0 milioni", "other": "0 milioni"}},
+				{8, 3, map[string]string{"one": "0 milioni", "other": "0 milioni"}},
+				{9, 1, map[string]string{"one": "0 miliardo", "other": "0 miliardi"}},
+				{10, 2, map[string]string{"one": "0 miliardi", "other": "0 miliardi"}},
+				{11, 3, map[string]string{"one": "0 miliardi", "other": "0 miliardi"}},
+				{12, 1, map[string]string{"one": "0 mille miliardi", "other": "0 mila miliardi"}},
+				{13, 2, map[string]string{"one": "0 mila miliardi", "other": "0 mila miliardi"}},
+				{14, 3, map[string]string{"one": "0 mila miliardi", "other": "0 mila miliardi"}},
+			},
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0Mln\u00a0¤", "other": "0\u00a0Mln\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0Mln\u00a0¤", "other": "0\u00a0Mln\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0Mln\u00a0¤", "other": "0\u00a0Mln\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0Mld\u00a0¤", "other": "0\u00a0Mld\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0Mld\u00a0¤", "other": "0\u00a0Mld\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0Mld\u00a0¤", "other": "0\u00a0Mld\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0Bln\u00a0¤", "other": "0\u00a0Bln\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0Bln\u00a0¤", "other": "0\u00a0Bln\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0Bln\u00a0¤", "other": "0\u00a0Bln\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "Br"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "£E"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "NKr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cf", "Cf"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "peseta andorrana", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham degli Emirati Arabi Uniti", PluralForms{"", "dirham degli EAU", "", "", "", "dirham degli EAU"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afgani (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghani", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albanese", PluralForms{"", "lek albanese", "", "", "", "lekë albanesi"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armeno", PluralForms{"", "dram armeno", "", "", "", "dram armeni"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "fiorino delle Antille olandesi", PluralForms{"", "fiorino delle Antille olandesi", "", "", "", "fiorini delle Antille olandesi"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolano", PluralForms{"", "kwanza angolano", "", "", "", "kwanzas angolani"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angolano (1977–1990)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "nuovo kwanza angolano (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza reajustado angolano (1995–1999)", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentino", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentino (vecchio Cod.)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentino", PluralForms{"", "peso argentino", "", "", "", "pesos argentini"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "scellino austriaco", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "dollaro australiano", PluralForms{"", "dollaro australiano", "", "", "", "dollari australiani"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "fiorino di Aruba", PluralForms{"", "fiorino di Aruba", "", "", "", "fiorini di Aruba"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azero (1993–2006)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azero", PluralForms{"", "manat azero", "", "", "", "manat azeri"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar Bosnia-Herzegovina", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marco convertibile della Bosnia-Herzegovina", PluralForms{"", "marco convertibile della Bosnia-Herzegovina", "", "", "", "marchi convertibili della Bosnia-Herzegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dollaro di Barbados", PluralForms{"", "dollaro di Barbados", "", "", "", "dollari di Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bangladese", PluralForms{"", "taka bengalese", "", "", "", "taka bengalesi"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franco belga (convertibile)", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "franco belga", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franco belga (finanziario)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "lev bulgaro (1962–1999)", PluralForms{"", "lev bulgaro (1962–1999)", "", "", "", "leva bulgari (1962–1999)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev bulgaro", PluralForms{"", "lev bulgaro", "", "", "", "leva bulgari"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinaro del Bahrein", PluralForms{"", "dinaro del Bahrein", "", "", "", "dinari del Bahrein"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franco del Burundi", PluralForms{"", "franco del Burundi", "", "", "", "franchi del Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dollaro delle Bermuda", PluralForms{"", "dollaro delle Bermuda", "", "", "", "dollari delle Bermuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dollaro del Brunei", PluralForms{"", "dollaro del Brunei", "", "", "", "dollari del Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano", PluralForms{"", "boliviano", "", "", "", "boliviani"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "mvdol boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "cruzeiro novo brasiliano (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brasiliano", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brasiliano (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "real brasiliano", PluralForms{"", "real brasiliano", "", "", "", "real brasiliani"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "cruzado novo brasiliano", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro brasiliano", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dollaro delle Bahamas", PluralForms{"", "dollaro delle Bahamas", "", "", "", "dollari delle Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum bhutanese", PluralForms{"", "ngultrum bhutanese", "", "", "", "ngultrum bhutanesi"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birmano", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula del Botswana", PluralForms{"", "", "", "", "", ""}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "nuovo rublo bielorusso (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "Br", "rublo bielorusso", PluralForms{"", "rublo bielorusso", "", "", "", "rubli bielorussi"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "rublo bielorusso (2000–2016)", PluralForms{"", "rublo bielorusso (2000–2016)", "", "", "", "rubli bielorussi (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dollaro del Belize", PluralForms{"", "dollaro del Belize", "", "", "", "dollari del Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "dollaro canadese", PluralForms{"", "dollaro canadese", "", "", "", "dollari canadesi"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franco congolese", PluralForms{"", "franco congolese", "", "", "", "franchi congolesi"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franco svizzero", PluralForms{"", "franco svizzero", "", "", "", "franchi svizzeri"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "unidades de fomento chilene", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso cileno", PluralForms{"", "peso cileno", "", "", "", "pesos cileni"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "renmimbi cinese offshore", PluralForms{"", "renmimbi cinese offshore", "", "", "", "renmimbi cinesi offshore"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "yuan cinese", PluralForms{"", "yuan cinese", "", "", "", "yuan cinesi"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso colombiano", PluralForms{"", "peso colombiano", "", "", "", "pesos colombiani"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colón costaricano", PluralForms{"", "colón costaricano", "", "", "", "colón costaricani"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "antico dinaro serbo", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "corona forte cecoslovacca", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cubano convertibile", PluralForms{"", "peso cubano convertibile", "", "", "", "pesos cubani convertibili"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubano", PluralForms{"", "peso cubano", "", "", "", "pesos cubani"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo capoverdiano", PluralForms{"", "escudo capoverdiano", "", "", "", "escudos capoverdiani"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "sterlina cipriota", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "corona ceca", PluralForms{"", "corona ceca", "", "", "", "corone ceche"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "ostmark della Germania Orientale", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marco tedesco", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franco di Gibuti", PluralForms{"", "franco di Gibuti", "", "", "", "franchi di Gibuti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "corona danese", PluralForms{"", "corona danese", "", "", "", "corone danesi"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominicano", PluralForms{"", "peso dominicano", "", "", "", "pesos dominicani"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinaro algerino", PluralForms{"", "dinaro algerino", "", "", "", "dinari algerini"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre dell’Ecuador", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "unidad de valor constante (UVC) dell’Ecuador", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "corona dell’Estonia", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "£E", "sterlina egiziana", PluralForms{"", "sterlina egiziana", "", "", "", "sterline egiziane"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreo", PluralForms{"", "nakfa eritreo", "", "", "", "nakfa eritrei"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "peseta spagnola account", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "peseta spagnola account convertibile", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "peseta spagnola", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etiope", PluralForms{"", "birr etiope", "", "", "", "birr etiopi"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "markka finlandese", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dollaro delle Figi", PluralForms{"", "dollaro delle Figi", "", "", "", "dollari delle Figi"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "sterlina delle Falkland", PluralForms{"", "sterlina delle Falkland", "", "", "", "sterline delle Falkland"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "franco francese", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "sterlina britannica", PluralForms{"", "sterlina britannica", "", "", "", "sterline britanniche"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "kupon larit georgiano", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari georgiano", PluralForms{"", "lari georgiano", "", "", "", "lari georgiani"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi del Ghana", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanese", PluralForms{"", "cedi ghanese", "", "", "", "cedi ghanesi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "sterlina di Gibilterra", PluralForms{"", "sterlina di Gibilterra", "", "", "", "sterline di Gibilterra"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambiano", PluralForms{"", "dalasi gambiano", "", "", "", "dalasi gambiani"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franco della Guinea", PluralForms{"", "franco della Guinea", "", "", "", "franchi della Guinea"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli della Guinea", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwele della Guinea Equatoriale", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "dracma greca", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal guatemalteco", PluralForms{"", "quetzal guatemalteco", "", "", "", "quetzal guatemaltechi"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "escudo della Guinea portoghese", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso della Guinea-Bissau", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dollaro della Guyana", PluralForms{"", "dollaro della Guyana", "", "", "", "dollari della Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "dollaro di Hong Kong", PluralForms{"", "dollaro di Hong Kong", "", "", "", "dollari di Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira honduregna", PluralForms{"", "lempira honduregna", "", "", "", "lempire honduregne"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinaro croato", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna croata", PluralForms{"", "kuna croata", "", "", "", "kune croate"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitiano", PluralForms{"", "gourde haitiano", "", "", "", "gourde haitiani"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "fiorino ungherese", PluralForms{"", "fiorino ungherese", "", "", "", "fiorini ungheresi"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonesiana", PluralForms{"", "rupia indonesiana", "", "", "", "rupie indonesiane"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "sterlina irlandese", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "sterlina israeliana", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "nuovo siclo israeliano", PluralForms{"", "nuovo siclo israeliano", "", "", "", "nuovi sicli israeliani"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "rupia indiana", PluralForms{"", "rupia indiana", "", "", "", "rupie indiane"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinaro iracheno", PluralForms{"", "dinaro iracheno", "", "", "", "dinari iracheni"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iraniano", PluralForms{"", "rial iraniano", "", "", "", "rial iraniani"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "corona islandese", PluralForms{"", "corona islandese", "", "", "", "corone islandesi"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "lira italiana", PluralForms{"", "lire italiane", "", "", "", "lire italiane"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dollaro giamaicano", PluralForms{"", "dollaro giamaicano", "", "", "", "dollari giamaicani"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinaro giordano", PluralForms{"", "dinaro giordano", "", "", "", "dinari giordani"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "yen giapponese", PluralForms{"", "yen giapponese", "", "", "", "yen giapponesi"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "scellino keniota", PluralForms{"", "scellino keniota", "", "", "", "scellini kenioti"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirghiso", PluralForms{"", "som kirghiso", "", "", "", "som kirghisi"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel cambogiano", PluralForms{"", "riel cambogiano", "", "", "", "riel cambogiani"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franco comoriano", PluralForms{"", "franco comoriano", "", "", "", "franchi comoriani"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won nordcoreano", PluralForms{"", "won nordcoreano", "", "", "", "won nordcoreani"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "won sudcoreano", PluralForms{"", "won sudcoreano", "", "", "", "won sudcoreani"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinaro kuwaitiano", PluralForms{"", "dinaro kuwaitiano", "", "", "", "dinari kuwaitiani"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dollaro delle Isole Cayman", PluralForms{"", "dollaro delle Isole Cayman", "", "", "", "dollari delle Isole Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazako", PluralForms{"", "tenge kazako", "", "", "", "tenge kazaki"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laotiano", PluralForms{"", "kip laotiano", "", "", "", "kip laotiani"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "lira libanese", PluralForms{"", "lira libanese", "", "", "", "lire libanesi"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia di Sri Lanka", PluralForms{"", "rupia di Sri Lanka", "", "", "", "rupie di Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dollaro liberiano", PluralForms{"", "dollaro liberiano", "", "", "", "dollari liberiani"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti del Lesotho", PluralForms{"", "loti del Lesotho", "", "", "", "maloti del Lesotho"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litas lituano", PluralForms{"", "litas lituano", "", "", "", "litas lituani"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "talonas lituani", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "franco convertibile del Lussemburgo", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "franco del Lussemburgo", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "franco finanziario del Lussemburgo", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lats lettone", PluralForms{"", "lats lettone", "", "", "", "lati lettoni"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "rublo lettone", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinaro libico", PluralForms{"", "dinaro libico", "", "", "", "dinari libici"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marocchino", PluralForms{"", "dirham marocchino", "", "", "", "dirham marocchini"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franco marocchino", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldavo", PluralForms{"", "leu moldavo", "", "", "", "lei moldavi"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary malgascio", PluralForms{"", "ariary malgascio", "", "", "", "ariary malgasci"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franco malgascio", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinaro macedone", PluralForms{"", "dinaro macedone", "", "", "", "dinari macedoni"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "dinaro macedone (1992–1993)", PluralForms{"", "dinaro macedone (1992–1993)", "", "", "", "dinari macedoni (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franco di Mali", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat di Myanmar", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongolo", PluralForms{"", "tugrik mongolo", "", "", "", "tugrik mongoli"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca di Macao", PluralForms{"", "pataca di Macao", "", "", "", "patacas di Macao"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya della Mauritania (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya della Mauritania", PluralForms{"", "", "", "", "", ""}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lira maltese", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "sterlina maltese", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia mauriziana", PluralForms{"", "rupia mauriziana", "", "", "", "rupie mauriziane"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rufiyaa delle Maldive", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malawiano", PluralForms{"", "kwacha malawiano", "", "", "", "kwacha malawiani"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "peso messicano", PluralForms{"", "peso messicano", "", "", "", "pesos messicani"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso messicano d’argento (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "unidad de inversion (UDI) messicana", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malese", PluralForms{"", "ringgit malese", "", "", "", "ringgit malesi"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escudo del Mozambico", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambicano", PluralForms{"", "metical mozambicano", "", "", "", "metical mozambicani"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dollaro namibiano", PluralForms{"", "dollaro namibiano", "", "", "", "dollari namibiani"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeriana", PluralForms{"", "naira nigeriana", "", "", "", "naire nigeriane"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "cordoba nicaraguense", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba nicaraguense", PluralForms{"", "córdoba nicaraguense", "", "", "", "córdoba nicaraguensi"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "fiorino olandese", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "NKr", "corona norvegese", PluralForms{"", "corona norvegese", "", "", "", "corone norvegesi"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalese", PluralForms{"", "rupia nepalese", "", "", "", "rupie nepalesi"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "dollaro neozelandese", PluralForms{"", "dollaro neozelandese", "", "", "", "dollari neozelandesi"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omanita", PluralForms{"", "rial omanita", "", "", "", "rial omaniti"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panamense", PluralForms{"", "balboa panamense", "", "", "", "balboa panamensi"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti peruviano", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol peruviano", PluralForms{"", "sol peruviano", "", "", "", "sol peruviani"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol peruviano (1863–1965)", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina papuana", PluralForms{"", "kina papuana", "", "", "", "kina papuane"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "peso filippino", PluralForms{"", "peso filippino", "", "", "", "pesos filippini"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia pakistana", PluralForms{"", "rupia pakistana", "", "", "", "rupie pakistane"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloty polacco", PluralForms{"", "zloty polacco", "", "", "", "zloty polacchi"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "złoty Polacco (1950–1995)", PluralForms{"", "złoty polacco (1950–1995)", "", "", "", "złoty polacchi (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "escudo portoghese", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guaraní paraguayano", PluralForms{"", "guaraní paraguayano", "", "", "", "guaraní paraguayani"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial qatariano", PluralForms{"", "rial qatariano", "", "", "", "rial qatariani"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dollaro della Rhodesia", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "leu della Romania", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "leu rumeno", PluralForms{"", "leu rumeno", "", "", "", "lei rumeni"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinaro serbo", PluralForms{"", "dinaro serbo", "", "", "", "dinara serbi"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rublo russo", PluralForms{"", "rublo russo", "", "", "", "rubli russi"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "rublo della CSI", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franco ruandese", PluralForms{"", "franco ruandese", "", "", "", "franchi ruandesi"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "riyal saudita", PluralForms{"", "riyal saudita", "", "", "", "riyal sauditi"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dollaro delle Isole Salomone", PluralForms{"", "dollaro delle Isole Salomone", "", "", "", "dollari delle Isole Salomone"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia delle Seychelles", PluralForms{"", "rupia delle Seychelles", "", "", "", "rupie delle Seychelles"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinaro sudanese", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sterlina sudanese", PluralForms{"", "sterlina sudanese", "", "", "", "sterline sudanesi"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "corona svedese", PluralForms{"", "corona svedese", "", "", "", "corone svedesi"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dollaro di Singapore", PluralForms{"", "dollaro di Singapore", "", "", "", "dollari di Singapore"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "sterlina di Sant’Elena", PluralForms{"", "sterlina di Sant’Elena", "", "", "", "sterline di Sant’Elena"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tallero sloveno", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "corona slovacca", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone della Sierra Leone", PluralForms{"", "leone della Sierra Leone", "", "", "", "leoni della Sierra Leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone della Sierra Leone (1964–2022)", PluralForms{"", "leone della Sierra Leone (1964–2022)", "", "", "", "leoni della Sierra Leone (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "scellino somalo", PluralForms{"", "scellino somalo", "", "", "", "scellini somali"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dollaro del Suriname", PluralForms{"", "dollaro del Suriname", "", "", "", "dollari del Suriname"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "fiorino del Suriname", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "sterlina sud-sudanese", PluralForms{"", "sterlina sud-sudanese", "", "", "", "sterline sud-sudanesi"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra di Sao Tomé e Principe (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra di Sao Tomé e Príncipe", PluralForms{"", "", "", "", "", ""}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "rublo sovietico", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colón salvadoregno", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "lira siriana", PluralForms{"", "lira siriana", "", "", "", "lire siriane"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni", PluralForms{"", "lilangeni", "", "", "", "emalangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "baht thailandese", PluralForms{"", "baht thailandese", "", "", "", "baht thailandesi"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "rublo del Tajikistan", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tagiko", PluralForms{"", "somoni tagiko", "", "", "", "somoni tagiki"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turkmeno (1993–2009)", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turkmeno", PluralForms{"", "manat turkmeno", "", "", "", "manat turkmeni"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinaro tunisino", PluralForms{"", "dinaro tunisino", "", "", "", "dinari tunisini"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "paʻanga tongano", PluralForms{"", "paʻanga tongano", "", "", "", "paʻanga tongani"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "escudo di Timor", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "lira turca (1922–2005)", PluralForms{"", "lira turca (1922–2005)", "", "", "", "lire turche (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turca", PluralForms{"", "lira turca", "", "", "", "lire turche"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dollaro di Trinidad e Tobago", PluralForms{"", "dollaro di Trinidad e Tobago", "", "", "", "dollari di Trinidad e Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "nuovo dollaro taiwanese", PluralForms{"", "nuovo dollaro taiwanese", "", "", "", "nuovi dollari taiwanesi"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "scellino della Tanzania", PluralForms{"", "scellino della Tanzania", "", "", "", "scellini della Tanzania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "grivnia ucraina", PluralForms{"", "grivnia ucraina", "", "", "", "grivnie ucraine"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbovanetz ucraino", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "scellino ugandese (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "scellino ugandese", PluralForms{"", "scellino ugandese", "", "", "", "scellini ugandesi"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "dollaro statunitense", PluralForms{"", "dollaro statunitense", "", "", "", "dollari statunitensi"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dollaro statunitense (next day)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dollaro statunitense (same day)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "peso uruguaiano in unità indicizzate", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso uruguaiano (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguayano", PluralForms{"", "peso uruguayano", "", "", "", "pesos uruguayani"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "sum uzbeco", PluralForms{"", "sum uzbeco", "", "", "", "sum uzbechi"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "bolivar venezuelano (1871–2008)", PluralForms{"", "bolivar venezuelano (1871–2008)", "", "", "", "bolivares venezuelani (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "bolívar venezuelano (2008–2018)", PluralForms{"", "bolívar venezuelano (2008–2018)", "", "", "", "bolívares venezuelani (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar venezuelano", PluralForms{"", "bolívar venezuelano", "", "", "", "bolívares venezuelani"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "dong vietnamita", PluralForms{"", "dong vietnamita", "", "", "", "dong vietnamiti"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu di Vanuatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoano", PluralForms{"", "tala samoano", "", "", "", "tala samoani"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franco CFA BEAC", PluralForms{"", "franco CFA BEAC", "", "", "", "franchi CFA BEAC"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "argento", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "oro", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "unità composita europea", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "unità monetaria europea", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "unità di acconto europea (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "unità di acconto europea (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "dollaro dei Caraibi orientali", PluralForms{"", "dollaro dei Caraibi orientali", "", "", "", "dollari dei Caraibi orientali"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cf", "Cf", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "diritti speciali di incasso", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franco oro francese", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franco UIC francese", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franco CFA BCEAO", PluralForms{"", "franco CFA BCEAO", "", "", "", "franchi CFA BCEAO"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladio", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franco CFP", PluralForms{"", "franco CFP", "", "", "", "franchi CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platino", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "fondi RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "codice di verifica della valuta", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "valuta sconosciuta", PluralForms{"", "(valuta sconosciuta)", "", "", "", "(valute sconosciute)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinaro dello Yemen", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "riyal yemenita", PluralForms{"", "rial yemenita", "", "", "", "rial yemeniti"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "dinaro forte yugoslavo", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "dinaro noviy yugoslavo", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinaro convertibile yugoslavo", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand sudafricano (finanziario)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sudafricano", PluralForms{"", "rand sudafricano", "", "", "", "rand sudafricani"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha dello Zambia (1968–2012)", PluralForms{"", "kwacha zambiano (1968–2012)", "", "", "", "kwacha zambiani (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambiano", PluralForms{"", "kwacha zambiano", "", "", "", "kwacha zambiani"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "nuovo zaire dello Zaire", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zaire dello Zaire", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dollaro dello Zimbabwe", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dollaro zimbabwiano (2009)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
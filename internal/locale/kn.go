@@ -6,176 +6,229 @@ var kn = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0ಸಾ", "other": "0ಸಾ"}},
+				{4, 2, map[string]string{"one": "0ಸಾ", "other": "0ಸಾ"}},
+				{5, 3, map[string]string{"one": "0ಸಾ", "other": "0ಸಾ"}},
+				{6, 1, map[string]string{"one": "0ಮಿ", "other": "0ಮಿ"}},
+				{7, 2, map[string]string{"one": "0ಮಿ", "other": "0ಮಿ"}},
+				{8, 3, map[string]string{"one": "0ಮಿ", "other": "0ಮಿ"}},
+				{9, 1, map[string]string{"one": "0ಬಿ", "other": "0ಬಿ"}},
+				{10, 2, map[string]string{"one": "0ಬಿ", "other": "0ಬಿ"}},
+				{11, 3, map[string]string{"one": "0ಬಿ", "other": "0ಬಿ"}},
+				{12, 1, map[string]string{"one": "0ಟ್ರಿ", "other": "0ಟ್ರಿ"}},
+				{13, 2, map[string]string{"one": "0ಟ್ರಿ", "other": "0ಟ್ರಿ"}},
+				{14, 3, map[string]string{"one": "0ಟ್ರಿ", "other": "0ಟ್ರಿ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 ಸಾವಿರ", "other": "0 ಸಾವಿರ"}},
+				{4, 2, map[string]string{"one": "0 ಸಾವಿರ", "other": "0 ಸಾವಿರ"}},
+				{5, 3, map[string]string{"one": "0 ಸಾವಿರ", "other": "0 ಸಾವಿರ"}},
+				{6, 1, map[string]string{"one": "0 ಮಿಲಿಯನ್", "other": "0 ಮಿಲಿಯನ್"}},
+				{7, 2, map[string]string{"one": "0 ಮಿಲಿಯನ್", "other": "0 ಮಿಲಿಯನ್"}},
+				{8, 3, map[string]string{"one": "0 ಮಿಲಿಯನ್", "other": "0 ಮಿಲಿಯನ್"}},
+				{9, 1, map[string]string{"one": "0 ಬಿಲಿಯನ್", "other": "0 ಬಿಲಿಯನ್"}},
+				{10, 2, map[string]string{"one": "0 ಬಿಲಿಯನ್", "other": "0 ಬಿಲಿಯನ್"}},
+				{11, 3, map[string]string{"one": "0 ಬಿಲಿಯನ್", "other": "0 ಬಿಲಿಯನ್"}},
+				{12, 1, map[string]string{"one": "0 ಟ್ರಿಲಿಯನ್\u200c", "other": "0 ಟ್ರಿಲಿಯನ್\u200c"}},
+				{13, 2, map[string]string{"one": "0 ಟ್ರಿಲಿಯನ್\u200c", "other": "0 ಟ್ರಿಲಿಯನ್\u200c"}},
+				{14, 3, map[string]string{"one": "0 ಟ್ರಿಲಿಯನ್\u200c", "other": "0 ಟ್ರಿಲಿಯನ್\u200c"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0ಸಾ", "other": "¤0ಸಾ"}},
+				{4, 2, map[string]string{"one": "¤0ಸಾ", "other": "¤0ಸಾ"}},
+				{5, 3, map[string]string{"one": "¤0ಸಾ", "other": "¤0ಸಾ"}},
+				{6, 1, map[string]string{"one": "¤0ಮಿ", "other": "¤0ಮಿ"}},
+				{7, 2, map[string]string{"one": "¤0ಮಿ", "other": "¤0ಮಿ"}},
+				{8, 3, map[string]string{"one": "¤0ಮಿ", "other": "¤0ಮಿ"}},
+				{9, 1, map[string]string{"one": "¤0ಬಿ", "other": "¤0ಬಿ"}},
+				{10, 2, map[string]string{"one": "¤0ಬಿ", "other": "¤0ಬಿ"}},
+				{11, 3, map[string]string{"one": "¤0ಬಿ", "other": "¤0ಬಿ"}},
+				{12, 1, map[string]string{"one": "¤0ಟ್ರಿ", "other": "¤0ಟ್ರಿ"}},
+				{13, 2, map[string]string{"one": "¤0ಟ್ರಿ", "other": "¤0ಟ್ರಿ"}},
+				{14, 3, map[string]string{"one": "¤0ಟ್ರಿ", "other": "¤0ಟ್ರಿ"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "ಲೀ"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "ಸಂಯುಕ್ತ ಅರಬ್\u200c ಎಮಿರೇಟ್\u200c\u200cಗಳ ದಿರಾಮ್\u200c\u200c", PluralForms{"", "ಯುಎಇ ದಿರಾಮ್", "", "", "", "ಯುಎಇ ದಿರಾಮ್\u200cಗಳು"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "ಅಫ್\u200cಘನ್ ಅಫಘಾನಿ", PluralForms{"", "ಅಫ್\u200cಘನ್ ಅಫಘಾನಿ", "", "", "", "ಅಫ್\u200cಘನ್ ಅಫಘಾನಿಗಳು"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "ಅಲ್\u200cಬೇನಿಯನ್ ಲೆಕ್", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "ಅರ್ಮೆನಿಯನ್ ಡ್ರಾಮ್", PluralForms{"", "ಅರ್ಮೆನಿಯನ್ ಡ್ರಾಮ್", "", "", "", "ಅರ್ಮೆನಿಯನ್ ಡ್ರಾಮ್\u200cಗಳು"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "ನೆದರ್ಲೆಂಡ್ಸ್ ಆಂಟಿಲಿಯನ್ ಗಿಲ್ಡರ್", PluralForms{"", "ನೆದರ್ಲೆಂಡ್ಸ್ ಆಂಟಿಲಿಯನ್ ಗಿಲ್ಡರ್", "", "", "", "ನೆದರ್ಲೆಂಡ್ಸ್ ಆಂಟಿಲಿಯನ್ ಗಿಲ್ಡರ್\u200cಗಳು"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "ಅಂಗೋಲಾದ ಕ್ವಾನ್ಝಾ", PluralForms{"", "ಅಂಗೋಲಾದ ಕ್ವಾನ್ಝಾ", "", "", "", "ಅಂಗೋಲಾದ ಕ್ವಾನ್ಝಾಗಳು"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "ಅರ್ಜೆಂಟಿನಾ ಪೆಸೊ", PluralForms{"", "ಅರ್ಜೆಂಟಿನಾ ಪೆಸೊ", "", "", "", "ಅರ್ಜೆಂಟಿನಾ ಪೆಸೊಗಳು"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ಆಸ್ಟ್ರೇಲಿಯನ್ ಡಾಲರ್\u200c", PluralForms{"", "ಆಸ್ಟ್ರೇಲಿಯನ್ ಡಾಲರ್\u200c", "", "", "", "ಆಸ್ಟ್ರೇಲಿಯನ್ ಡಾಲರ್\u200c\u200cಗಳು"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "ಅರುಬನ್ ಫ್ಲೊರೀನ್\u200c\u200c", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "ಅಝರ್\u200cಬೈಜಾನಿ ಮನಾತ್", PluralForms{"", "ಅಝರ್\u200cಬೈಜಾನಿ ಮನಾತ್", "", "", "", "ಅಝರ್\u200cಬೈಜಾನಿ ಮನಾತ್\u200cಗಳು"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "ಬೋಸ್ನಿಯಾ-ಹರ್ಜ್\u200cಗೋವಿನ ಪರಿವರ್ತನೀಯ ಗುರುತು", PluralForms{"", "ಬೋಸ್ನಿಯಾ-ಹರ್ಜ್\u200cಗೋವಿನ ಪರಿವರ್ತನೀಯ ಗುರುತು", "", "", "", "ಬೋಸ್ನಿಯಾ-ಹರ್ಜ್\u200cಗೋವಿನ ಪರಿವರ್ತನೀಯ ಗುರುತುಗಳು"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "ಬರ್ಬಾಡಿಯನ್ ಡಾಲರ್", PluralForms{"", "ಬರ್ಬಾಡಿಯನ್ ಡಾಲರ್", "", "", "", "ಬರ್ಬಾಡಿಯನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "ಬಾಂಗ್ಲಾದೇಶದ ಟಾಕಾ", PluralForms{"", "ಬಾಂಗ್ಲಾದೇಶದ ಟಾಕಾ", "", "", "", "ಬಾಂಗ್ಲಾದೇಶದ ಟಾಕಾಗಳು"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "ಬಲ್ಗೇರಿಯನ್ ಲೆವ್", PluralForms{"", "ಬಲ್ಗೇರಿಯನ್ ಲೆವ್", "", "", "", "ಬಲ್ಗೇರಿಯನ್ ಲೀವಾ"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "ಬಹ್\u200c\u200cರೈನಿ ದಿನಾರ್", PluralForms{"", "ಬಹ್\u200c\u200cರೈನಿ ದಿನಾರ್", "", "", "", "ಬಹ್\u200c\u200cರೈನಿ ದಿನಾರ್\u200cಗಳು"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "ಬುರುಂದಿಯನ್ ಫ್ರಾಂಕ್", PluralForms{"", "ಬುರುಂದಿಯನ್ ಫ್ರಾಂಕ್", "", "", "", "ಬುರುಂದಿಯನ್ ಫ್ರಾಂಕ್\u200dಗಳು"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "ಬರ್ಮುಡನ್ ಡಾಲರ್", PluralForms{"", "ಬರ್ಮುಡನ್ ಡಾಲರ್", "", "", "", "ಬರ್ಮುಡನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ಬ್ರೂನಿ ಡಾಲರ್", PluralForms{"", "ಬ್ರೂನಿ ಡಾಲರ್", "", "", "", "ಬ್ರೂನಿ ಡಾಲರ್\u200cಗಳು"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "ಬೊಲಿವಿಯಾದ ಬೊಲಿವಿಯಾನೊ", PluralForms{"", "ಬೊಲಿವಿಯಾದ ಬೊಲಿವಿಯಾನೊ", "", "", "", "ಬೊಲಿವಿಯಾದ ಬೊಲಿವಿಯಾನೊಗಳು"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ಬ್ರೆಜಿಲಿಯನ್\u200c ರಿಯಲ್", PluralForms{"", "ಬ್ರೆಜಿಲಿಯನ್\u200c ರಿಯಲ್", "", "", "", "ಬ್ರೆಜಿಲಿಯನ್ ರಿಯಲ್\u200dಗಳು"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "ಬಹಾಮಿಯನ್ ಡಾಲರ್", PluralForms{"", "ಬಹಾಮಿಯನ್ ಡಾಲರ್", "", "", "", "ಬಹಾಮಿಯನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ಭೂತಾನೀಸ್ ನುಲ್ತರಮ್", PluralForms{"", "ಭೂತಾನೀಸ್ ನುಲ್ತರಮ್", "", "", "", "ಭೂತಾನೀಸ್ ನುಲ್ತರಮ್\u200cಗಳು"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ಬೋಟ್ಸ್\u200cವಾನನ್ ಪುಲಾ", PluralForms{"", "ಬೋಟ್ಸ್\u200cವಾನನ್ ಪುಲಾ", "", "", "", "ಬೋಟ್ಸ್\u200cವಾನನ್ ಪುಲಾಗಳು"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "ಬೆಲಾರುಸಿಯನ್ ರೂಬಲ್", PluralForms{"", "ಬೆಲಾರುಸಿಯನ್ ರೂಬಲ್", "", "", "", "ಬೆಲಾರುಸಿಯನ್ ರೂಬಲ್\u200dಗಳು"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "ಬೆಲಾರುಸಿಯನ್ ರೂಬಲ್ (2000–2016)", PluralForms{"", "ಬೆಲಾರುಸಿಯನ್ ರೂಬಲ್ (2000–2016)", "", "", "", "ಬೆಲಾರುಸಿಯನ್ ರೂಬಲ್\u200dಗಳು (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "ಬೆಲೀಜ್ ಡಾಲರ್", PluralForms{"", "ಬೆಲೀಜ್ ಡಾಲರ್", "", "", "", "ಬೆಲೀಜ್ ಡಾಲರ್\u200c\u200dಗಳು"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "ಕೆನಡಾದ ಡಾಲರ್", PluralForms{"", "ಕೆನಡಾದ ಡಾಲರ್", "", "", "", "ಕೆನಡಾದ ಡಾಲರ್\u200cಗಳು"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "ಕಾಂಗೋಲೀಸ್ ಫ್ರಾಂಕ್", PluralForms{"", "ಕಾಂಗೋಲೀಸ್ ಫ್ರಾಂಕ್", "", "", "", "ಕಾಂಗೋಲೀಸ್ ಫ್ರಾಂಕ್\u200d\u200cಗಳು"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "ಸ್ವಿಸ್ ಫ್ರಾಂಕ್", PluralForms{"", "ಸ್ವಿಸ್ ಫ್ರಾಂಕ್", "", "", "", "ಸ್ವಿಸ್ ಫ್ರಾಂಕ್\u200dಗಳು"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "ಚಿಲಿಯ ಪೆಸೊ", PluralForms{"", "ಚಿಲಿಯ ಪೆಸೊ", "", "", "", "ಚಿಲಿಯ ಪೆಸೊಗಳು"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "ಚೈನೀಸ್ ಯುವಾನ್ (ಆಫ್\u200cಶೋರ್)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "ಚೈನೀಸ್ ಯುವಾನ್", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "ಕೊಲೊಂಬಿಯೋದ ಪೆಸೊ", PluralForms{"", "ಕೊಲೊಂಬಿಯೋದ ಪೆಸೊ", "", "", "", "ಕೊಲೊಂಬಿಯೋದ ಪೆಸೊಗಳು"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "ಕೋಸ್ಟ ರಿಕನ್ ಕೊಲನ್", PluralForms{"", "ಕೋಸ್ಟಾ ರಿಕಾದ ಕೊಲೊನ್\u200c", "", "", "", "ಕೋಸ್ಟಾ ರಿಕಾದ ಕೊಲೊನ್\u200cಗಳು"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "ಕ್ಯುಬಾದ ಪರಿವರ್ತನೀಯ ಪೆಸೊ", PluralForms{"", "ಕ್ಯೂಬಾದ ಪರಿವರ್ತನೀಯ ಪೆಸೊ", "", "", "", "ಕ್ಯೂಬಾದ ಪರಿವರ್ತನೀಯ ಪೆಸೊಗಳು"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "ಕ್ಯೂಬಾದ ಪೆಸೊ", PluralForms{"", "ಕ್ಯೂಬಾದ ಪೆಸೊ", "", "", "", "ಕ್ಯೂಬಾದ ಪೆಸೊಗಳು"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "ಕೇಪ್ ವರ್ಡಿನ್ ಎಸ್\u200cಕೂಡೊ", PluralForms{"", "ಕೇಪ್ ವರ್ಡಿನ್ ಎಸ್\u200cಕೂಡೊ", "", "", "", "ಕೇಪ್ ವರ್ಡಿನ್ ಎಸ್\u200cಕೂಡೊಗಳು"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "ಝೆಕ್ ಗಣರಾಜ್ಯ ಕೊರೂನ", PluralForms{"", "ಝೆಕ್ ಗಣರಾಜ್ಯ ಕೊರೂನ", "", "", "", "ಝೆಕ್ ಗಣರಾಜ್ಯ ಕೊರೂನಗಳು"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "ಜಿಬೊಟಿಯನ್ ಫ್ರಾಂಕ್", PluralForms{"", "ಜಿಬೊಟಿಯನ್ ಫ್ರಾಂಕ್", "", "", "", "ಜಿಬೊಟಿಯನ್ ಫ್ರಾಂಕ್\u200dಗಳು"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ಡ್ಯಾನಿಶ್ ಕ್ರೋನ್", PluralForms{"", "ಡ್ಯಾನಿಶ್ ಕ್ರೋನ್", "", "", "", "ಡ್ಯಾನಿಶ್ ಕ್ರೋನರ್"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "ಡೊಮಿನಿಕನ್ ಪೆಸೊ", PluralForms{"", "ಡೊಮಿನಿಕನ್ ಪೆಸೊ", "", "", "", "ಡೊಮಿನಿಕನ್ ಪೆಸೊಗಳು"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "ಅಲ್ಜೀರಿಯನ್ ದಿನಾರ್", PluralForms{"", "ಅಲ್ಜೀರಿಯನ್ ದಿನಾರ್", "", "", "", "ಅಲ್ಜೀರಿಯನ್ ದಿನಾರ್\u200cಗಳು"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ಈಜಿಪ್ಷಿಯನ್ ಪೌಂಡ್\u200d", PluralForms{"", "ಈಜಿಪ್ಷಿಯನ್ ಪೌಂಡ್\u200d", "", "", "", "ಈಜಿಪ್ಷಿಯನ್ ಪೌಂಡ್\u200dಗಳು"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "ಎರಿತ್ರಿಯನ್ ನಕ್ಫಾ", PluralForms{"", "ಎರಿತ್ರಿಯನ್ ನಕ್ಫಾ", "", "", "", "ಎರಿತ್ರಿಯನ್ ನಕ್ಫಾಗಳು"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ಇಥಿಯೋಪಿಯನ್ ಬಿರ್", PluralForms{"", "ಇಥಿಯೋಪಿಯನ್ ಬಿರ್", "", "", "", "ಇಥಿಯೋಪಿಯನ್ ಬಿರ್\u200cಗಳು"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "ಯೂರೊ", PluralForms{"", "ಯೂರೊ", "", "", "", "ಯೂರೊಗಳು"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ಫಿಜಿಯನ್ ಡಾಲರ್", PluralForms{"", "ಫಿಜಿಯನ್ ಡಾಲರ್", "", "", "", "ಫಿಜಿಯನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ಫಾಕ್\u200cಲ್ಯಾಂಡ್ ದ್ವೀಪಗಳ ಪೌಂಡ್", PluralForms{"", "ಫಾಕ್\u200cಲ್ಯಾಂಡ್ ದ್ವೀಪಗಳ ಪೌಂಡ್", "", "", "", "ಫಾಕ್\u200cಲ್ಯಾಂಡ್ ದ್ವೀಪಗಳ ಪೌಂಡ್\u200dಗಳು"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ಬ್ರಿಟಿಷ್ ಪೌಂಡ್", PluralForms{"", "ಬ್ರಿಟಿಷ್ ಪೌಂಡ್", "", "", "", "ಬ್ರಿಟಿಷ್ ಪೌಂಡ್\u200dಗಳು"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "ಜಾರ್ಜಿಯಾದ ಲಾರಿ", PluralForms{"", "ಜಾರ್ಜಿಯಾದ ಲಾರಿ", "", "", "", "ಜಾರ್ಜಿಯಾದ ಲಾರಿಗಳು"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ಘಾನಾದ ಸೆದಿ", PluralForms{"", "ಘಾನಾದ ಸೆದಿ", "", "", "", "ಘಾನಾದ ಸೆದಿಗಳು"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "ಗಿಬ್ರಾಲ್ಟರ್ ಪೌಂಡ್", PluralForms{"", "ಗಿಬ್ರಾಲ್ಟರ್ ಪೌಂಡ್", "", "", "", "ಗಿಬ್ರಾಲ್ಟರ್ ಪೌಂಡ್\u200dಗಳು"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "ಗ್ಯಾಂಬಿಯಾದ ದಲಾಸಿ", PluralForms{"", "ಗ್ಯಾಂಬಿಯಾದ ದಲಾಸಿ", "", "", "", "ಗ್ಯಾಂಬಿಯಾದ ದಲಾಸಿಗಳು"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "ಗಿನಿಯನ್ ಫ್ರಾಂಕ್", PluralForms{"", "ಗಿನಿಯನ್ ಫ್ರಾಂಕ್", "", "", "", "ಗಿನಿಯನ್ ಫ್ರಾಂಕ್\u200cಗಳು"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ಗ್ವಾಟೆಮಾಲಾದ ಕುಯಿಟ್ಸಲ್\u200c\u200c", PluralForms{"", "ಗ್ವಾಟೆಮಾಲಾದ ಕುಯಿಟ್ಸಲ್\u200c\u200c", "", "", "", "ಗ್ವಾಟೆಮಾಲಾದ ಕುಯಿಟ್ಸಲ್\u200c\u200c\u200dಗಳು"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "ಗುಯಾನೀಸ್\u200c ಡಾಲರ್\u200c", PluralForms{"", "ಗುಯಾನೀಸ್\u200c ಡಾಲರ್\u200c", "", "", "", "ಗುಯಾನೀಸ್\u200c ಡಾಲರ್\u200c\u200cಗಳು"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "ಹಾಂಗ್ ಕಾಂಗ್ ಡಾಲರ್", PluralForms{"", "ಹಾಂಗ್ ಕಾಂಗ್ ಡಾಲರ್", "", "", "", "ಹಾಂಗ್ ಕಾಂಗ್ ಡಾಲರ್\u200cಗಳು"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "ಹೊಂಡುರಾನ್\u200c ಲೆಂಪಿರಾ", PluralForms{"", "ಹೊಂಡುರಾನ್\u200c ಲೆಂಪಿರಾ", "", "", "", "ಹೊಂಡುರಾನ್\u200c ಲೆಂಪಿರಾಗಳು"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "ಕ್ರೊಯೆಷ್ಯಾದ ಕೂನಾ", PluralForms{"", "ಕ್ರೊಯೆಷ್ಯಾದ ಕೂನಾ", "", "", "", "ಕ್ರೊಯೆಷ್ಯಾದ ಕೂನಾಗಳು"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "ಹೈಟಿಯ ಗೋರ್ದೆ", PluralForms{"", "ಹೈಟಿಯ ಗೋರ್ದೆ", "", "", "", "ಹೈಟಿಯ ಗೋರ್ದೆಗಳು"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ಹಂಗೇರಿಯನ್ ಫೋರಿಂಟ್", PluralForms{"", "ಹಂಗೇರಿಯನ್ ಫೋರಿಂಟ್", "", "", "", "ಹಂಗೇರಿಯನ್ ಫೋರಿಂಟ್\u200dಗಳು"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ಇಂಡೊನೇಷ್ಯಾ ರುಪೈ", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ಇಸ್ರೇಲಿ ನ್ಯೂ ಶೇಖಲ್", PluralForms{"", "ಇಸ್ರೇಲಿ ನ್ಯೂ ಶೇಖಲ್", "", "", "", "ಇಸ್ರೇಲಿ ನ್ಯೂ ಶೇಖಲ್\u200dಗಳು"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "ಭಾರತೀಯ ರೂಪಾಯಿ", PluralForms{"", "ಭಾರತೀಯ ರೂಪಾಯಿ", "", "", "", "ಭಾರತೀಯ ರೂಪಾಯಿಗಳು"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ಇರಾಕಿ ದಿನಾರ್", PluralForms{"", "ಇರಾಕಿ ದಿನಾರ್", "", "", "", "ಇರಾಕಿ ದಿನಾರ್\u200cಗಳು"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ಇರಾನಿಯನ್ ರಿಯಲ್", PluralForms{"", "ಇರಾನಿಯನ್ ರಿಯಲ್", "", "", "", "ಇರಾನಿಯನ್ ರಿಯಲ್\u200cಗಳು"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ಐಸ್\u200cಲ್ಯಾಂಡಿಕ್ ಕ್ರೋನಾ", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ಜಮೈಕನ್ ಡಾಲರ್", PluralForms{"", "ಜಮೈಕನ್ ಡಾಲರ್", "", "", "", "ಜಮೈಕನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "ಜೋರ್ಡಾನಿಯನ್ ದಿನಾರ್", PluralForms{"", "ಜೋರ್ಡಾನಿಯನ್ ದಿನಾರ್", "", "", "", "ಜೋರ್ಡಾನಿಯನ್ ದಿನಾರ್\u200cಗಳು"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "ಜಪಾನೀಸ್ ಯೆನ್", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "ಕೀನ್ಯಾದ ಶಿಲ್ಲಿಂಗ್\u200c", PluralForms{"", "ಕೀನ್ಯಾದ ಶಿಲ್ಲಿಂಗ್\u200c", "", "", "", "ಕೀನ್ಯಾದ ಶಿಲ್ಲಿಂಗ್\u200cಗಳು"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "ಕಿರ್ಗಿಸ್ತಾನಿ ಸೋಮ್", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "ಕಾಂಬೋಡಿಯನ್ ರಿಯಲ್", PluralForms{"", "ಕಾಂಬೋಡಿಯನ್ ರಿಯಲ್", "", "", "", "ಕಾಂಬೋಡಿಯನ್ ರಿಯಲ್\u200dಗಳು"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "ಕೊಮೊರಿಯನ್ ಫ್ರಾಂಕ್", PluralForms{"", "ಕೊಮೊರಿಯನ್ ಫ್ರಾಂಕ್", "", "", "", "ಕೊಮೊರಿಯನ್ ಫ್ರಾಂಕ್\u200dಗಳು"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "ಉತ್ತರ ಕೊರಿಯನ್ ವೋನ್", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "ದಕ್ಷಿಣ ಕೊರಿಯನ್ ವೊನ್", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "ಕುವೈತೀ ದಿನಾರ್", PluralForms{"", "ಕುವೈತೀ ದಿನಾರ್", "", "", "", "ಕುವೈತೀ ದಿನಾರ್\u200cಗಳು"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "ಕೆಮ್ಯಾನ್\u200c ಐಲ್ಯಾಂಡ್\u200cನ ಡಾಲರ್\u200c", PluralForms{"", "ಕೆಮ್ಯಾನ್\u200c ಐಲ್ಯಾಂಡ್\u200cನ ಡಾಲರ್\u200c", "", "", "", "ಕೆಮ್ಯಾನ್\u200c ಐಲ್ಯಾಂಡ್\u200cನ ಡಾಲರ್\u200c\u200cಗಳು"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "ಕಜಾಕಿಸ್ತಾನಿ ತೆಂಗೆ", PluralForms{"", "ಕಜಾಕಿಸ್ತಾನಿ ತೆಂಗೆ", "", "", "", "ಕಜಾಕಿಸ್ತಾನಿ ತೆಂಗೆಗಳು"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "ಲೋಟಿಯನ್ ಕಿಪ್", PluralForms{"", "ಲೋಟಿಯನ್ ಕಿಪ್", "", "", "", "ಲೋಟಿಯನ್ ಕಿಪ್\u200dಗಳು"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ಲೆಬೆನೀಸ್ ಪೌಂಡ್", PluralForms{"", "ಲೆಬೆನೀಸ್ ಪೌಂಡ್", "", "", "", "ಲೆಬೆನೀಸ್ ಪೌಂಡ್\u200dಗಳು"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "ಶ್ರೀಲಂಕಾದ ರುಪೀ", PluralForms{"", "ಶ್ರೀಲಂಕಾದ ರುಪೀ", "", "", "", "ಶ್ರೀಲಂಕಾದ ರುಪೀಗಳು"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "ಲಿಬೇರಿಯನ್ ಡಾಲರ್", PluralForms{"", "ಲಿಬೇರಿಯನ್ ಡಾಲರ್", "", "", "", "ಲಿಬೇರಿಯನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "ಲೆಸೊತೊ ಲೊತಿ", PluralForms{"", "ಲೆಸೊತೊ ಲೊತಿ", "", "", "", "ಲೆಸೊತೊ ಲೊತಿಗಳು"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "ಲಿಥುನಿಯನ್\u200c ಲಿತಾಸ್\u200c", PluralForms{"", "ಲಿಥುನಿಯನ್\u200c ಲಿತಾಸ್\u200c", "", "", "", "ಲಿಥುನಿಯನ್\u200c ಲಿತಾಯ್"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "ಲ್ಯಾಟ್ವಿಯನ್ ಲ್ಯಾಟ್ಸ್", PluralForms{"", "ಲ್ಯಾಟ್ವಿಯನ್ ಲ್ಯಾಟ್ಸ್", "", "", "", "ಲ್ಯಾಟ್ವಿಯನ್ ಲ್ಯಾಟಿ"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "ಲಿಬಿಯಾದ ದಿನಾರ್\u200c", PluralForms{"", "ಲಿಬಿಯಾದ ದಿನಾರ್\u200c", "", "", "", "ಲಿಬಿಯಾದ ದಿನಾರ್\u200c\u200dಗಳು"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "ಮೊರೊಕನ್ ದಿರ್\u200cಹಮ್", PluralForms{"", "ಮೊರೊಕನ್ ದಿರ್\u200cಹಮ್", "", "", "", "ಮೊರೊಕನ್ ದಿರ್\u200cಹಮ್\u200cಗಳು"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "ಮಲ್ದೋವಾದ ಲೆವೂ", PluralForms{"", "ಮಲ್ದೋವಾದ ಲೆವೂ", "", "", "", "ಮಲ್ದೋವಾದ ಲೆಯ್"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ಮಲಗಾಸಿ ಅರಿಯಾರಿ", PluralForms{"", "ಮಲಗಾಸಿ ಅರಿಯಾರಿ", "", "", "", "ಮಲಗಾಸಿ ಅರಿಯಾರಿಗಳು"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "ಮೆಸಡೋನಿಯನ್ ದಿನಾರ್", PluralForms{"", "ಮೆಸಡೋನಿಯನ್ ದಿನಾರ್", "", "", "", "ಮೆಸಡೋನಿಯನ್ ದಿನಾರಿ"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "ಮ್ಯಾನ್ಮಾರ್ ಕ್ಯಾಟ್", PluralForms{"", "ಮ್ಯಾನ್ಮಾರ್ ಕ್ಯಾಟ್", "", "", "", "ಮ್ಯಾನ್ಮಾರ್ ಕ್ಯಾಟ್\u200dಗಳು"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "ಮಂಗೋಲಿಯಾದ ತುಗ್ರಿಕ್\u200c\u200c", PluralForms{"", "ಮಂಗೋಲಿಯಾದ ತುಗ್ರಿಕ್\u200c\u200c", "", "", "", "ಮಂಗೋಲಿಯಾದ ತುಗ್ರಿಕ್\u200c\u200c\u200cಗಳು"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "ಮಕಾನಿಸ್ ಪಟಾಕಾ", PluralForms{"", "ಮಕಾನಿಸ್ ಪಟಾಕಾ", "", "", "", "ಮಕಾನಿಸ್ ಪಟಾಕಾಗಳು"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ಮೌರೀಶಿಯನಿಯನ್ ಒಗಿಯ (1973–2017)", PluralForms{"", "ಮೌರೀಶಿಯನಿಯನ್ ಒಗಿಯ (1973–2017)", "", "", "", "ಮೌರೀಶಿಯನಿಯನ್ ಒಗಿಯಗಳು (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ಮೌರೀಶಿಯನಿಯನ್ ಒಗಿಯ", PluralForms{"", "ಮೌರೀಶಿಯನಿಯನ್ ಒಗಿಯ", "", "", "", "ಮೌರೀಶಿಯನಿಯನ್ ಒಗಿಯಗಳು"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "ಮಾರಿಷಿಯನ್ ರುಪಿ", PluralForms{"", "ಮಾರಿಷಿಯನ್ ರುಪಿ", "", "", "", "ಮಾರಿಷಿಯನ್ ರುಪಿಗಳು"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "ಮಾಲ್ಡೀವಿಯನ್ ರುಫಿಯಾ", PluralForms{"", "ಮಾಲ್ಡೀವಿಯನ್ ರುಫಿಯಾ", "", "", "", "ಮಾಲ್ಡೀವಿಯನ್ ರುಫಿಯಾಗಳು"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "ಮಲಾವಿಯ ಕ್ವಾಚ", PluralForms{"", "ಮಲಾವಿಯ ಕ್ವಾಚ", "", "", "", "ಮಲಾವಿಯ ಕ್ವಾಚಗಳು"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "ಮೆಕ್ಸಿಕೊದ ಪೆಸೊ", PluralForms{"", "ಮೆಕ್ಸಿಕೊದ ಪೆಸೊ", "", "", "", "ಮೆಕ್ಸಿಕೊದ ಪೆಸೊಗಳು"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ಮಲೇಶಿಯನ್ ರಿಂಗಿಟ್", PluralForms{"", "ಮಲೇಶಿಯನ್ ರಿಂಗಿಟ್", "", "", "", "ಮಲೇಶಿಯನ್ ರಿಂಗಿಟ್\u200cಗಳು"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "ಮೊಝಾಂಬಿಕನ್ ಮೆಟಿಕಲ್", PluralForms{"", "ಮೊಝಾಂಬಿಕನ್ ಮೆಟಿಕಲ್", "", "", "", "ಮೊಝಾಂಬಿಕನ್ ಮೆಟಿಕಲ್\u200dಗಳು"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "ನಮೀಬಿಯನ್ ಡಾಲರ್", PluralForms{"", "ನಮೀಬಿಯನ್ ಡಾಲರ್", "", "", "", "ನಮೀಬಿಯನ್ ಡಾಲರ್\u200c\u200dಗಳು"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "ನೈಜೀರಿಯಾದ ನೇರಾ", PluralForms{"", "ನೈಜೀರಿಯಾದ ನೇರಾ", "", "", "", "ನೈಜೀರಿಯಾದ ನೇರಾಗಳು"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "ನಿಕಾರಗ್ವಾದ ಕರ್ದೊಬಾ", PluralForms{"", "ನಿಕಾರಗ್ವಾದ ಕರ್ದೊಬಾ", "", "", "", "ನಿಕಾರಗ್ವಾದ ಕರ್ದೊಬಾಗಳು"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "ನಾರ್ವೇಯ ಕ್ರೋನ್", PluralForms{"", "ನಾರ್ವೇಯ ಕ್ರೋನ್", "", "", "", "ನಾರ್ವೇಯ ಕ್ರೋನರ್"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "ನೇಪಾಳದ ರುಪೀ", PluralForms{"", "ನೇಪಾಳದ ರುಪೀ", "", "", "", "ನೇಪಾಳದ ರುಪೀಗಳು"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "ನ್ಯೂಜಿಲ್ಯಾಂಡ್ ಡಾಲರ್", PluralForms{"", "ನ್ಯೂಜಿಲ್ಯಾಂಡ್ ಡಾಲರ್", "", "", "", "ನ್ಯೂಜಿಲ್ಯಾಂಡ್ ಡಾಲರ್\u200cಗಳು"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ಒಮಾನಿ ರಿಯಲ್", PluralForms{"", "ಒಮಾನಿ ರಿಯಲ್", "", "", "", "ಒಮಾನಿ ರಿಯಲ್\u200dಗಳು"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "ಪನಾಮಾನಿಯನ್ ಬಲ್ಬೋವಾ", PluralForms{"", "ಪನಾಮಾನಿಯನ್ ಬಲ್ಬೋವಾ", "", "", "", "ಪನಾಮಾನಿಯನ್ ಬಲ್ಬೋವಾಗಳು"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "ಪೆರುವಿಯನ್ ಸೊಲ್", PluralForms{"", "ಪೆರುವಿಯನ್ ಸೊಲ್", "", "", "", "ಪೆರುವಿಯನ್ ಸೊಲ್\u200dಗಳು"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "ಪಪುವಾ ನ್ಯೂ ಗಿನಿಯನ್ ಕಿನಾ", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "ಫಿಲಿಪ್ಪೈನ್ ಪಿಸೊ", PluralForms{"", "ಫಿಲಿಪ್ಪೈನ್ ಪಿಸೊ", "", "", "", "ಫಿಲಿಪ್ಪೈನ್ ಪಿಸೊಗಳು"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "ಪಾಕಿಸ್ತಾನದ ರುಪೀ", PluralForms{"", "ಪಾಕಿಸ್ತಾನದ ರುಪೀ", "", "", "", "ಪಾಕಿಸ್ತಾನದ ರುಪೀಗಳು"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "ಪೊಲಿಶ್ ಝ್ಲೋಟಿ", PluralForms{"", "ಪೊಲಿಶ್ ಝ್ಲೋಟಿ", "", "", "", "ಪೊಲಿಶ್ ಝ್ಲೋಟಿಗಳು"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "ಪೆರುಗ್ವೇಯ ಗ್ವಾರನೀ", PluralForms{"", "ಪೆರುಗ್ವೇಯ ಗ್ವಾರನೀ", "", "", "", "ಪೆರುಗ್ವೇಯ ಗ್ವಾರನೀಗಳು"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "ಖತಾರಿ ರಿಯಲ್", PluralForms{"", "ಖತಾರಿ ರಿಯಲ್", "", "", "", "ಖತಾರಿ ರಿಯಲ್\u200dಗಳು"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "ಲೀ", "ರೊಮೇನಿಯನ್ ಲೆವು", PluralForms{"", "ರೊಮೇನಿಯನ್ ಲೆವು", "", "", "", "ರೊಮೇನಿಯನ್ ಲೆಯ್"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "ಸೆರ್ಬಿಯನ್ ದಿನಾರ್", PluralForms{"", "ಸೆರ್ಬಿಯನ್ ದಿನಾರ್", "", "", "", "ಸೆರ್ಬಿಯನ್ ದಿನಾರ್\u200cಗಳು"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ರಶಿಯನ್ ರೂಬಲ್", PluralForms{"", "ರಶಿಯನ್ ರೂಬಲ್", "", "", "", "ರಶಿಯನ್ ರೂಬಲ್\u200dಗಳು"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ರುವಾಂಡನ್ ಫ್ರಾಂಕ್", PluralForms{"", "ರುವಾಂಡನ್ ಫ್ರಾಂಕ್", "", "", "", "ರುವಾಂಡನ್ ಫ್ರಾಂಕ್\u200dಗಳು"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "ಸೌದಿ ರಿಯಾಲ್", PluralForms{"", "ಸೌದಿ ರಿಯಾಲ್", "", "", "", "ಸೌದಿ ರಿಯಾಲ್\u200dಗಳು"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "ಸೊಲೊಮನ್ ದ್ವೀಪಗಳ ಡಾಲರ್", PluralForms{"", "ಸೊಲೊಮನ್ ದ್ವೀಪಗಳ ಡಾಲರ್", "", "", "", "ಸೊಲೊಮನ್ ದ್ವೀಪಗಳ ಡಾಲರ್\u200cಗಳು"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "ಸೆಚೊಲಿಯೊಸ್ ರುಪಿ", PluralForms{"", "ಸೆಚೊಲಿಯೊಸ್ ರುಪಿ", "", "", "", "ಸೆಚೊಲಿಯೊಸ್ ರುಪಿಗಳು"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "ಸುಡಾನೀಸ್ ಪೌಂಡ್", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "ಸ್ವೀಡಿಷ್ ಕ್ರೋನಾ", PluralForms{"", "ಸ್ವೀಡಿಷ್ ಕ್ರೋನಾ", "", "", "", "ಸ್ವೀಡಿಷ್ ಕ್ರೋನರ್"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "ಸಿಂಗಾಪುರ್ ಡಾಲರ್\u200c", PluralForms{"", "ಸಿಂಗಾಪುರ್ ಡಾಲರ್\u200c", "", "", "", "ಸಿಂಗಾಪುರ್ ಡಾಲರ್\u200c\u200cಗಳು"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "ಸೇಂಟ್ ಹೆಲೇನಾ ಪೌಂಡ್", PluralForms{"", "ಸೇಂಟ್ ಹೆಲೇನಾ ಪೌಂಡ್", "", "", "", "ಸೇಂಟ್ ಹೆಲೇನಾ ಪೌಂಡ್\u200cಗಳು"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "ಸಿಯೆರಾ ಲಿಯೋನಿಯನ್ ಲಿಯೋನ್", PluralForms{"", "ಸಿಯೆರಾ ಲಿಯೋನಿಯನ್ ಲಿಯೋನ್", "", "", "", "ಸಿಯೆರಾ ಲಿಯೋನಿಯನ್ ಲಿಯೋನ್\u200cಗಳು"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "ಸಿಯೆರಾ ಲಿಯೋನಿಯನ್ ಲಿಯೋನ್ (1964—2022)", PluralForms{"", "ಸಿಯೆರಾ ಲಿಯೋನಿಯನ್ ಲಿಯೋನ್ (1964—2022)", "", "", "", "ಸಿಯೆರಾ ಲಿಯೋನಿಯನ್ ಲಿಯೋನ್\u200cಗಳು (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "ಸೊಮಾಲಿ ಶಿಲ್ಲಿಂಗ್", PluralForms{"", "ಸೊಮಾಲಿ ಶಿಲ್ಲಿಂಗ್", "", "", "", "ಸೊಮಾಲಿ ಶಿಲ್ಲಿಂಗ್\u200dಗಳು"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "ಸುರಿನಾಮೀಸ್ ಡಾಲರ್", PluralForms{"", "ಸುರಿನಾಮೀಸ್ ಡಾಲರ್", "", "", "", "ಸುರಿನಾಮೀಸ್ ಡಾಲರ್\u200cಗಳು"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "ದಕ್ಷಿಣ ಸೂಡಾನೀಸ್ ಪೌಂಡ್\u200d", PluralForms{"", "ದಕ್ಷಿಣ ಸೂಡಾನೀಸ್ ಪೌಂಡ್\u200d", "", "", "", "ದಕ್ಷಿಣ ಸೂಡಾನೀಸ್ ಪೌಂಡ್\u200d\u200dಗಳು"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "ಸಾವೊ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪ್ ದೊಬ್ರಾ (1977–2017)", PluralForms{"", "ಸಾವೊ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪ್ ದೊಬ್ರಾ (1977–2017)", "", "", "", "ಸಾವೊ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪ್ ದೊಬ್ರಾಗಳು (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "ಸಾವೊ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪ್ ದೊಬ್ರಾ", PluralForms{"", "ಸಾವೊ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪ್ ದೊಬ್ರಾ", "", "", "", "ಸಾವೊ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪ್ ದೊಬ್ರಾಗಳು"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "ಸಿರಿಯನ್ ಪೌಂಡ್", PluralForms{"", "ಸಿರಿಯನ್ ಪೌಂಡ್", "", "", "", "ಸಿರಿಯನ್ ಪೌಂಡ್\u200cಗಳು"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "ಸ್ವಾಜಿ ಲಿಲಂಗೆನಿ", PluralForms{"", "ಸ್ವಾಜಿ ಲಿಲಂಗೆನಿ", "", "", "", "ಸ್ವಾಜಿ ಎಮಲಂಗೆನಿ"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "ಥಾಯ್ ಬಹ್ತ್", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "ತಜಕಿಸ್ತಾನಿ ಸೊಮೋನಿ", PluralForms{"", "ತಜಕಿಸ್ತಾನಿ ಸೊಮೋನಿ", "", "", "", "ತಜಕಿಸ್ತಾನಿ ಸೊಮೋನಿಗಳು"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "ಟರ್ಕ್\u200dಮೆನಿಸ್ತಾನ್ ಮನಾತ್", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "ಟ್ಯುನೀಷಿಯನ್\u200c ದಿನಾರ್", PluralForms{"", "ಟ್ಯುನೀಷಿಯನ್\u200c ದಿನಾರ್\u200c", "", "", "", "ಟ್ಯುನೀಷಿಯನ್\u200c ದಿನಾರ್\u200cಗಳು"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "ಟೊಂಗಾ ಪಾಂಗ", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "ಟರ್ಕಿಶ್ ಲಿರಾ", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ಟ್ರಿನಿಡಾಡ್ ಮತ್ತು ಟೊಬಾಗೊ ಡಾಲರ್", PluralForms{"", "ಟ್ರಿನಿಡಾಡ್ ಮತ್ತು ಟೊಬಾಗೊ ಡಾಲರ್", "", "", "", "ಟ್ರಿನಿಡಾಡ್ ಮತ್ತು ಟೊಬಾಗೊ ಡಾಲರ್\u200cಗಳು"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "ನ್ಯೂ ತೈವಾನ್ ಡಾಲರ್", PluralForms{"", "ನ್ಯೂ ತೈವಾನ್ ಡಾಲರ್", "", "", "", "ನ್ಯೂ ತೈವಾನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "ತಾನ್\u200cಜೇನಿಯನ್ ಶಿಲ್ಲಿಂಗ್", PluralForms{"", "ತಾನ್\u200cಜೇನಿಯನ್ ಶಿಲ್ಲಿಂಗ್", "", "", "", "ತಾನ್\u200cಜೇನಿಯನ್ ಶಿಲ್ಲಿಂಗ್\u200dಗಳು"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ಉಕ್ರೇನಿಯನ್ ಹ್ರಿವ್ನೀಯ", PluralForms{"", "ಉಕ್ರೇನಿಯನ್ ಹ್ರಿವ್ನೀಯ", "", "", "", "ಉಕ್ರೇನಿಯನ್ ಹ್ರಿವ್ನೀಯಗಳು"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ಉಗಾಂಡನ್ ಶಿಲ್ಲಿಂಗ್", PluralForms{"", "ಉಗಾಂಡನ್ ಶಿಲ್ಲಿಂಗ್", "", "", "", "ಉಗಾಂಡನ್ ಶಿಲ್ಲಿಂಗ್\u200dಗಳು"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "ಅಮೆರಿಕದ ಡಾಲರ್\u200c", PluralForms{"", "ಅಮೆರಿಕದ ಡಾಲರ್\u200c", "", "", "", "ಅಮೆರಿಕದ ಡಾಲರ್\u200c\u200cಗಳು"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "ಉರುಗ್ವೆಯ ಪೆಸೊ", PluralForms{"", "ಉರುಗ್ವೆಯ ಪೆಸೊ", "", "", "", "ಉರುಗ್ವೆಯ ಪೆಸೊಗಳು"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "ಉಜ್ಬೇಕಿಸ್ತಾನ್ ಸೊಮ್", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "ವೆನಿಜುಲಿಯನ್ ಬೊಲಿವರ್ (2008–2018)", PluralForms{"", "ವೆನಿಜುಲಿಯನ್ ಬೊಲಿವರ್ (2008–2018)", "", "", "", "ವೆನಿಜುಲಿಯನ್ ಬೊಲಿವರ್\u200cಗಳು (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "ವೆನಿಜುಲಿಯನ್ ಬೊಲಿವರ್", PluralForms{"", "ವೆನಿಜುಲಿಯನ್ ಬೊಲಿವರ್", "", "", "", "ವೆನಿಜುಲಿಯನ್ ಬೊಲಿವರ್\u200cಗಳು"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "ವಿಯೆಟ್ನಾಮೀಸ್ ಡಾಂಗ್", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "ವನೂತು ವತು", PluralForms{"", "ವನೂತು ವತು", "", "", "", "ವನೂತು ವತುಗಳು"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "ಸಮೋನ್ ತಲಾ", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "ಮಧ್ಯ ಆಫ್ರಿಕನ್ CFA ಫ್ರಾಂಕ್", PluralForms{"", "ಮಧ್ಯ ಆಫ್ರಿಕನ್ CFA ಫ್ರಾಂಕ್", "", "", "", "ಮಧ್ಯ ಆಫ್ರಿಕನ್ CFA ಫ್ರಾಂಕ್\u200cಗಳು"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "ಪೂರ್ವ ಕೆರೀಬಿಯನ್ ಡಾಲರ್", PluralForms{"", "ಪೂರ್ವ ಕೆರೀಬಿಯನ್ ಡಾಲರ್", "", "", "", "ಪೂರ್ವ ಕೆರೀಬಿಯನ್ ಡಾಲರ್\u200cಗಳು"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "ಪಶ್ಚಿಮ ಆಫ್ರಿಕಾದ CFA ಫ್ರಾಂಕ್", PluralForms{"", "ಪಶ್ಚಿಮ ಆಫ್ರಿಕಾದ CFA ಫ್ರಾಂಕ್", "", "", "", "ಪಶ್ಚಿಮ ಆಫ್ರಿಕಾದ CFA ಫ್ರಾಂಕ್\u200cಗಳು"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "[CFP] ಫ್ರಾಂಕ್", PluralForms{"", "[CFP] ಫ್ರಾಂಕ್", "", "", "", "[CFP] ಫ್ರಾಂಕ್\u200cಗಳು"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "ಅಪರಿಚಿತ ಕರೆನ್ಸಿ", PluralForms{"", "(ಅಪರಿಚಿತ ಕರೆನ್ಸಿ ಯುನಿಟ್)", "", "", "", "(ಅಪರಿಚಿತ ಕರೆನ್ಸಿ)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "ಯೆಮೆನಿ ರಿಯಲ್", PluralForms{"", "ಯೆಮೆನಿ ರಿಯಲ್", "", "", "", "ಯೆಮೆನಿ ರಿಯಲ್\u200dಗಳು"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "ದಕ್ಷಿಣ ಆಫ್ರಿಕನ್ ರಾಂಡ್", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "ಜಾಂಬಿಯಾ ಕ್ವಾಚ (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "ಜಾಂಬಿಯಾ ಕ್ವಾಚ", PluralForms{"", "ಜಾಂಬಿಯಾ ಕ್ವಾಚ", "", "", "", "ಜಾಂಬಿಯಾ ಕ್ವಾಚಗಳು"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,172 +6,225 @@ var kok = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 2, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤", "", "¤-", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0हज", "other": "0हज"}},
+				{4, 2, map[string]string{"one": "0हज", "other": "0हज"}},
+				{5, 1, map[string]string{"one": "0लाख", "other": "0लाख"}},
+				{6, 2, map[string]string{"one": "0लाख", "other": "0लाख"}},
+				{7, 1, map[string]string{"one": "0कोटी", "other": "0कोटी"}},
+				{8, 2, map[string]string{"one": "0कोटी", "other": "0कोटी"}},
+				{9, 1, map[string]string{"one": "0अब्ज", "other": "0अब्ज"}},
+				{10, 2, map[string]string{"one": "0अब्ज", "other": "0अब्ज"}},
+				{11, 1, map[string]string{"one": "0निख", "other": "0निख"}},
+				{12, 2, map[string]string{"one": "0निख", "other": "0निख"}},
+				{13, 3, map[string]string{"one": "0निख", "other": "0निख"}},
+				{14, 1, map[string]string{"one": "0हज.निख.", "other": "0हज.निख."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0हज", "other": "0 हजार"}},
+				{4, 2, map[string]string{"one": "0हज", "other": "0 हजार"}},
+				{5, 1, map[string]string{"one": "0लाख", "other": "0 लाख"}},
+				{6, 2, map[string]string{"one": "0लाख", "other": "0 लाख"}},
+				{7, 1, map[string]string{"one": "0कोटी", "other": "0 कोटी"}},
+				{8, 2, map[string]string{"one": "0कोटी", "other": "0 कोटी"}},
+				{9, 1, map[string]string{"one": "0अब्ज", "other": "0 अब्ज"}},
+				{10, 2, map[string]string{"one": "0अब्ज", "other": "0 अब्ज"}},
+				{11, 1, map[string]string{"one": "0निख", "other": "0 निखर्व"}},
+				{12, 2, map[string]string{"one": "0निख", "other": "0 निखर्व"}},
+				{13, 3, map[string]string{"one": "0निख", "other": "0 निखर्व"}},
+				{14, 1, map[string]string{"one": "0हज.निख.", "other": "0 हजार निखर्व"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "¤0K"}},
+				{4, 2, map[string]string{"other": "¤0K"}},
+				{5, 3, map[string]string{"other": "¤0K"}},
+				{6, 1, map[string]string{"other": "¤0M"}},
+				{7, 2, map[string]string{"other": "¤0M"}},
+				{8, 3, map[string]string{"other": "¤0M"}},
+				{9, 1, map[string]string{"other": "¤0B"}},
+				{10, 2, map[string]string{"other": "¤0B"}},
+				{11, 3, map[string]string{"other": "¤0B"}},
+				{12, 1, map[string]string{"other": "¤0T"}},
+				{13, 2, map[string]string{"other": "¤0T"}},
+				{14, 3, map[string]string{"other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "रॉन", "लेई"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "युनाइटेड अरब इमीरॅट्स दिरहम", PluralForms{"", "", "", "", "", "युएई दिरहम्स"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "अफगाण अफगाणी", PluralForms{"", "", "", "", "", "अफगाण अफगाणीस"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "अल्बेनियन लेक", PluralForms{"", "", "", "", "", "अल्बेनियन लेके"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "अर्मेनियन ड्राम", PluralForms{"", "", "", "", "", "अर्मेनियन ड्राम्स"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "नॅदरलँड अँटिलियन गिल्डर", PluralForms{"", "", "", "", "", "नॅदरलँड अँटिलियन गिल्डर्स"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "अंगोलन क्वॉन्ज", PluralForms{"", "", "", "", "", "अंगोलन क्वॉन्ज्स"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "अर्जेंटिना पेसो", PluralForms{"", "", "", "", "", "अर्जेंटिना पेसोस"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ऑस्ट्रेलियाई डॉलर", PluralForms{"", "", "", "", "", "ऑस्ट्रेलियाई डॉलर्स"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "अरुबान फ्लोरिन", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "अज़रबैजानी मनात", PluralForms{"", "", "", "", "", "अज़रबैजानी मनात्स"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "बोस्निया-हेर्जेगोविना रुपांतरीत मार्क", PluralForms{"", "", "", "", "", "बोस्निया-हेर्जेगोविना रुपांतरीत मार्क्स"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "बार्बाडियान डॉलर", PluralForms{"", "", "", "", "", "बार्बाडियान डॉलर्स"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "बांगलादेशी टाका", PluralForms{"", "", "", "", "", "बांगलादेशी टाकास"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "बल्गेरियन लेव", PluralForms{"", "", "", "", "", "बल्गेरियन लेवा"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "बहरिनी डिनार", PluralForms{"", "", "", "", "", "बहरिनी डिनार्स"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "बुरुंडी फ्रँक", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "बरमुदान डॉलर", PluralForms{"", "", "", "", "", "बरमुदान डॉलर्स"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ब्रूनेई डॉलर", PluralForms{"", "", "", "", "", "ब्रूनेई डॉलर्स"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "बोलिव्हियन बोलिवियानो", PluralForms{"", "", "", "", "", "बोलिव्हियन बोलिवियानोस"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ब्राझिलियन रियाल", PluralForms{"", "", "", "", "", "ब्राझिलियन रियाल्स"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "बहामियन डॉलर", PluralForms{"", "", "", "", "", "बहामियन डॉलर्स"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "भुतानीज नागल्ट्रम", PluralForms{"", "", "", "", "", "भुतानीज नागल्ट्रम्स"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "बोत्सवाना पुला", PluralForms{"", "", "", "", "", "बोत्सवाना पुलास"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "बैलोरुसियन् रूबल", PluralForms{"", "", "", "", "", "बैलोरुसियन् रूबल्स"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "बेलिझ डॉलर", PluralForms{"", "", "", "", "", "बेलिझ डॉलर्स"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "कॅनाडियन डॉलर", PluralForms{"", "", "", "", "", "कॅनाडियन डॉलर्स"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "काँगोलिसी फ्रँक", PluralForms{"", "", "", "", "", "काँगोलिसी फ्रँक्स"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "स्विस फ्रँक", PluralForms{"", "", "", "", "", "स्विस फ्रँक्स"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "चिली पेसो", PluralForms{"", "", "", "", "", "चिली पेसोस"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "चिनी युआन (ऑफशोर)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "चिनी युआन", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "कोलंबियन पेसो", PluralForms{"", "", "", "", "", "कोलंबियन पेसोस"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "कोस्ता रिका कॉलॉन", PluralForms{"", "", "", "", "", "कोस्ता रिका कॉलॉन्स"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "क्युबान रुपांतरीत पेसो", PluralForms{"", "", "", "", "", "क्युबान रुपांतरीत पेसोस"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "क्युबान पेसो", PluralForms{"", "", "", "", "", "क्युबान पेसोस"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "केप वर्दे एस्कुडो", PluralForms{"", "", "", "", "", "केप वर्दे एस्कुडो्स"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "चेक कोरुना", PluralForms{"", "", "", "", "", "चेक कोरुनास"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "जिबूती फ्रँक", PluralForms{"", "", "", "", "", "जिबूती फ्रँक्स"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "डॅनिश क्रोन", PluralForms{"", "", "", "", "", "डॅनिश क्रोनर"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "डोमिनिकन पेसो", PluralForms{"", "", "", "", "", "डोमिनिकन पेसोस"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "अल्जेरियाई डिनार", PluralForms{"", "", "", "", "", "अल्जेरियाई डिनार्स"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ईजिप्ती पावंड", PluralForms{"", "", "", "", "", "ईजिप्ती पावंड्स"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "इरिट्रियन नाक्फा", PluralForms{"", "", "", "", "", "इरिट्रियन नाक्फास"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "इथियोपियाई बिरर", PluralForms{"", "", "", "", "", "इथियोपियाई बिरर्स"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "युरो", PluralForms{"", "", "", "", "", "युरोस"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "फिजी डॉलर", PluralForms{"", "", "", "", "", "फिजी डॉलर्स"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "फ़ॉकलैंड आइलैंड्स पावंड", PluralForms{"", "", "", "", "", "फ़ॉकलैंड आइलैंड्स पावंड्स"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ब्रिटिश पावंड", PluralForms{"", "", "", "", "", "ब्रिटिश पावंड्स"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "जॉर्जियन लारी", PluralForms{"", "", "", "", "", "जॉर्जियन लारीस"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "घानाई सेडी", PluralForms{"", "", "", "", "", "घानाई सेडीस"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "जिब्राल्टर पावंड", PluralForms{"", "", "", "", "", "जिब्राल्टर पावंड्स"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "गॅम्बियन दलासी", PluralForms{"", "", "", "", "", "गॅम्बियन दलासीस"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "गिनीन फ्रँक", PluralForms{"", "", "", "", "", "गिनीन फ्रँक्स"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ग्वाटेमाला कुएट्झल", PluralForms{"", "", "", "", "", "ग्वाटेमाला कुएट्झल्स"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "गयाना डॉलर", PluralForms{"", "", "", "", "", "गयाना डॉलर्स"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "हाँग काँग डॉलर", PluralForms{"", "", "", "", "", "हाँग काँग डॉलर्स"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "होंडुरान लेम्पिरा", PluralForms{"", "", "", "", "", "होंडुरान लेम्पिरास"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "क्रोयेषियन् कुना", PluralForms{"", "", "", "", "", "क्रोयेषियन् कुनास"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "हैतीयन गौर्डे", PluralForms{"", "", "", "", "", "हैतीयन गौर्डेस"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "हंगेरियन फोरिंट", PluralForms{"", "", "", "", "", "हंगेरियन फोरिंट्स"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "इंडोनेशियन रुपिया", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "इस्त्रायली न्यु शेकेल", PluralForms{"", "", "", "", "", "इस्त्रायली न्यु शेकेल्स"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "भारतीय रुपया", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "इराकी डिनार", PluralForms{"", "", "", "", "", "इराकी डिनार्स"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ईरानी रियाल", PluralForms{"", "", "", "", "", "ईरानी रियाल्स"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "आईस्लान्डिक क्रोना", PluralForms{"", "", "", "", "", "आईस्लान्डिक क्रोनुर"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "जमैकन डॉलर", PluralForms{"", "", "", "", "", "जमैकन डॉलर्स"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "जॉर्डनियन डिनार", PluralForms{"", "", "", "", "", "जॉर्डनियन डिनार्स"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "जपानी येन", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "केनयाई शिलिंग", PluralForms{"", "", "", "", "", "केनयाई शिलिंग्स"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "किरगिझस्तान सोम", PluralForms{"", "", "", "", "", "किरगिझस्तान सोम्स"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "कंबोडियन रियाल", PluralForms{"", "", "", "", "", "कंबोडियन रियाल्स"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "कोमोरियन फ्रँक", PluralForms{"", "", "", "", "", "कोमोरियन फ्रँक्स"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "उत्तर कोरियन वॉन", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "दक्षिण कोरियन वॉन", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "कुवेती डिनार", PluralForms{"", "", "", "", "", "कुवेती डिनार्स"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "कैमेन आइलैंड्स डॉलर", PluralForms{"", "", "", "", "", "कैमेन आइलैंड्स डॉलर्स"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "कझाकस्तानी टेंग", PluralForms{"", "", "", "", "", "कझाकस्तानी टेंग्स"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "लाओ किप", PluralForms{"", "", "", "", "", "लाओ किप्स"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "लिबानेस पावंड", PluralForms{"", "", "", "", "", "लिबानेस पावंड्स"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "श्री लंका रुपया", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "लायबेरियन डॉलर", PluralForms{"", "", "", "", "", "लायबेरियन डॉलर्स"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "लिसोथो लोटि", PluralForms{"", "", "", "", "", "लिसोथो लोटिस"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "लीबियान डिनार", PluralForms{"", "", "", "", "", "लीबियान डिनार्स"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "मोरक्कन दिरहम", PluralForms{"", "", "", "", "", "मोरक्कन दिरहम्स"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "मोल्दोवान लियू", PluralForms{"", "", "", "", "", "मोल्दोवान लेई"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "मलागासी एरियारी", PluralForms{"", "", "", "", "", "मलागासी एरियारीस"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "मसीडोनियन् डिनर", PluralForms{"", "", "", "", "", "मसीडोनियन् डिनारी"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "म्यानमार क्यात", PluralForms{"", "", "", "", "", "म्यानमार क्यात्स"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "मंगोलियन तुगरिक", PluralForms{"", "", "", "", "", "मंगोलियन तुगरिक्स"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "मकानेसे पटका", PluralForms{"", "", "", "", "", "मकानेसे पटकास"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "मॉरिटानिया उगिया", PluralForms{"", "", "", "", "", "मॉरिटानिया उगियास"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "मॉरिशस रुपी", PluralForms{"", "", "", "", "", "मॉरिशस रुपया"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "मालदिवी रुफिया", PluralForms{"", "", "", "", "", "मालदिवी रुफियास"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "मलावियन क्वाचा", PluralForms{"", "", "", "", "", "मलावियन क्वाचास"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "मेक्सिकन पेसो", PluralForms{"", "", "", "", "", "मेक्सिकन पेसोस"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "मलेशियाई रिंग्गित", PluralForms{"", "", "", "", "", "मलेशियाई रिंग्गित्स"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "मोझांबिकन मेटिकल", PluralForms{"", "", "", "", "", "मोझांबिकन मेटिकल्स"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "नामीबिया डॉलर", PluralForms{"", "", "", "", "", "नामीबिया डॉलर्स"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "नायजेरियन नायरा", PluralForms{"", "", "", "", "", "नायजेरियन नायरास"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "निकारागुआन कॉर्डोबा", PluralForms{"", "", "", "", "", "निकारागुआन कॉर्डोबास"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "नॉर्वेगन क्रोन", PluralForms{"", "", "", "", "", "नॉर्वेगन क्रोनर"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "नेपाळी रुपया", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "न्युझीलॅन्ड डॉलर", PluralForms{"", "", "", "", "", "न्युझीलॅन्ड डॉलर्स"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ओमानी रियाल", PluralForms{"", "", "", "", "", "ओमानी रियाल्स"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "पानामानियन बाल्बोआ", PluralForms{"", "", "", "", "", "पानामानियन बाल्बोआस"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "पेरिवियन सोल", PluralForms{"", "", "", "", "", "पेरुवियन सोल्स"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "पापुआ न्यु गिनी किना", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "फिलिपिनी पेसो", PluralForms{"", "", "", "", "", "फिलिपिनी पेसोस"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "पाकिस्तानी रुपया", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "पोलिष झ्लोटी", PluralForms{"", "", "", "", "", "पोलिष झ्लोटी्स"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "पराग्वेन गौरानी", PluralForms{"", "", "", "", "", "पराग्वेन गौरानीस"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "कतारी रियाल", PluralForms{"", "", "", "", "", "कतारी रियाल्स"}},
+		"RON": {2, 0, 2, 1, "RON", "रॉन", "लेई", "रोमानियन् लियू", PluralForms{"", "", "", "", "", "रोमानियन् लेई"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "सर्बियन डिनार", PluralForms{"", "", "", "", "", "सर्बियन डिनार्स"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "रुसी रुबल", PluralForms{"", "", "", "", "", "रुसी रुबल्स"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "रवांडा फ्रँक", PluralForms{"", "", "", "", "", "रवांडा फ्रँक्स"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "सौदी रियाल", PluralForms{"", "", "", "", "", "सौदी रियाल्स"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "सोलोमन आयलँड्स डॉलर", PluralForms{"", "", "", "", "", "सोलोमन आयलँड डॉलर्स"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "सेशेल्लोइस रुपी", PluralForms{"", "", "", "", "", "सेशेल्लोइस रुपया"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "सुदानी पावंड", PluralForms{"", "", "", "", "", "सुदानी पावंड्स"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "स्वीदीष क्रोन", PluralForms{"", "", "", "", "", "स्वीदीष क्रोनोर"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "सिंगापूरी डॉलर", PluralForms{"", "", "", "", "", "सिंगापूरी डॉलर्स"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "सेंट हेलिना पावंड", PluralForms{"", "", "", "", "", "सेंट हेलिना पावंड्स"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "सिएरा लियॉनी लियॉन", PluralForms{"", "", "", "", "", "सिएरा लियॉनी लियॉन्स"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "सिएरा लियॉनी लियॉन (1964—2022)", PluralForms{"", "", "", "", "", "सिएरा लियॉनी लियॉन्स (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "सोमाली शिलिंग", PluralForms{"", "", "", "", "", "सोमाली शिलिंग्स"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "सुरीनामी डॉलर", PluralForms{"", "", "", "", "", "सुरीनामी डॉलर्स"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "दक्षिण सुडानी पावंड", PluralForms{"", "", "", "", "", "दक्षिण सुडानी पावंड्स"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "साओ टोम आनी प्रिन्सिप डोब्रा", PluralForms{"", "", "", "", "", "साओ टोम आनी प्रिन्सिप डोब्रास"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "सिरियन पावंड", PluralForms{"", "", "", "", "", "सिरियन पावंड्स"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "स्वाजी लिलांगेनी", PluralForms{"", "", "", "", "", "स्वाजी एमालांगेनी"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "थाई बाट", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "ताजिकिस्तानी सोमोनी", PluralForms{"", "", "", "", "", "ताजिकिस्तानी सोमोनीस"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "तुर्कमेनिस्तानी मनत", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "ट्यूनीशियन डिनार", PluralForms{"", "", "", "", "", "ट्यूनीशियन डिनार्स"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "टोंगन पांगा", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "तुर्किश लायरा", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ट्रिनीडाड आनी टोबॅगो डॉलर", PluralForms{"", "", "", "", "", "ट्रिनीडाड आनी टोबॅगो डॉलर्स"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "न्यू तायवान डॉलर", PluralForms{"", "", "", "", "", "न्यू तायवान डॉलर्स"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "तंजानिया शिलिंग", PluralForms{"", "", "", "", "", "तंजानिया शिलिंग्स"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "युक्रेनियन् रिव्निया", PluralForms{"", "", "", "", "", "युक्रेनियन् रिव्नियास"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "युगांडा शिलिंग", PluralForms{"", "", "", "", "", "युगांडा शिलिंग्स"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "युएस डॉलर", PluralForms{"", "", "", "", "", "युएस डॉलर्स"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "उरुग्वेन पेसो", PluralForms{"", "", "", "", "", "उरुग्वेन पेसोस"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "उज़्बेकिस्तानी सोम", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "विनेझुएला बोलिव्हर", PluralForms{"", "", "", "", "", "विनेझुएला बोलिव्हर्स"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "वियतनामी डोंग", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "वानूआतू वातू", PluralForms{"", "", "", "", "", "वानूआतू वातूस"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "समोआई टाला", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "मध्य अफ्रीकी सीएफए फ्रँक", PluralForms{"", "", "", "", "", "मध्य अफ्रीकी सीएफए फ्रँक्स"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "उदेंत कॅरिबियन डॉलर", PluralForms{"", "", "", "", "", "उदेंत कॅरिबियन डॉलर्स"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "अस्तंत आफ्रिकी सीएफए फ्रँक", PluralForms{"", "", "", "", "", "अस्तंत आफ्रिकी सीएफए फ्रँक्स"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "सीएफपी फ्रँक", PluralForms{"", "", "", "", "", "सीएफपी फ्रँक्स"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "अज्ञात चलन", PluralForms{"", "", "", "", "", "(अज्ञात चलन)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "येमेनी रियाल", PluralForms{"", "", "", "", "", "येमेनी रियाल्स"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "दक्षिण आफ्रिकन रँड", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "झांबियन क्वाचा", PluralForms{"", "", "", "", "", "झांबियन क्वाचास"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,313 +6,366 @@ var lt = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"×10^", "×",
+		":", "∼",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tūkst.", "other": "0\u00a0tūkst."}},
+				{4, 2, map[string]string{"one": "0\u00a0tūkst.", "other": "0\u00a0tūkst."}},
+				{5, 3, map[string]string{"one": "0\u00a0tūkst.", "other": "0\u00a0tūkst."}},
+				{6, 1, map[string]string{"one": "0\u00a0mln.", "other": "0\u00a0mln."}},
+				{7, 2, map[string]string{"one": "0\u00a0mln.", "other": "0\u00a0mln."}},
+				{8, 3, map[string]string{"one": "0\u00a0mln.", "other": "0\u00a0mln."}},
+				{9, 1, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0trln.", "other": "0\u00a0trln."}},
+				{13, 2, map[string]string{"one": "0\u00a0trln.", "other": "0\u00a0trln."}},
+				{14, 3, map[string]string{"one": "0\u00a0trln.", "other": "0\u00a0trln."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tūkstančiai", "many": "0 tūkstančio", "one": "0 tūkstantis", "other": "0 tūkstančių"}},
+				{4, 2, map[string]string{"few": "0 tūkstančiai", "many": "0 tūkstančio", "one": "0 tūkstantis", "other": "0 tūkstančių"}},
+				{5, 3, map[string]string{"few": "0 tūkstančiai", "many": "0 tūkstančio", "one": "0 tūkstantis", "other": "0 tūkstančių"}},
+				{6, 1, map[string]string{"few": "0 milijonai", "many": "0 milijono", "one": "0 milijonas", "other": "0 milijonų"}},
+				{7, 2, map[string]string{"few": "0 milijonai", "many": "0 milijono", "one": "0 milijonas", "other": "0 milijonų"}},
+				{8, 3, map[string]string{"few": "0 milijonai", "many": "0 milijono", "one": "0 milijonas", "other": "0 milijonų"}},
+				{9, 1, map[string]string{"few": "0 milijardai", "many": "0 milijardo", "one": "0 milijardas", "other": "0 milijardų"}},
+				{10, 2, map[string]string{"few": "0 milijardai", "many": "0 milijardo", "one": "0 milijardas", "other": "0 milijardų"}},
+				{11, 3, map[string]string{"few": "0 milijardai", "many": "0 milijardo", "one": "0 milijardas", "other": "0 milijardų"}},
+				{12, 1, map[string]string{"few": "0 trilijonai", "many": "0 trilijono", "one": "0 trilijonas", "other": "0 trilijonų"}},
+				{13, 2, map[string]string{"few": "0 trilijonai", "many": "0 trilijono", "one": "0 trilijonas", "other": "0 trilijonų"}},
+				{14, 3, map[string]string{"few": "0 trilijonai", "many": "0 trilijono", "one": "0 trilijonas", "other": "0 trilijonų"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tūkst.\u00a0¤", "many": "0\u00a0tūkst.\u00a0¤", "one": "0\u00a0tūkst.\u00a0¤", "other": "0\u00a0tūkst.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tūkst.\u00a0¤", "many": "0\u00a0tūkst.\u00a0¤", "one": "0\u00a0tūkst.\u00a0¤", "other": "0\u00a0tūkst.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tūkst.\u00a0¤", "many": "0\u00a0tūkst.\u00a0¤", "one": "0\u00a0tūkst.\u00a0¤", "other": "0\u00a0tūkst.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mln.\u00a0¤", "many": "0\u00a0mln.\u00a0¤", "one": "0\u00a0mln.\u00a0¤", "other": "0\u00a0mln.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mln.\u00a0¤", "many": "0\u00a0mln.\u00a0¤", "one": "0\u00a0mln.\u00a0¤", "other": "0\u00a0mln.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mln.\u00a0¤", "many": "0\u00a0mln.\u00a0¤", "one": "0\u00a0mln.\u00a0¤", "other": "0\u00a0mln.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "many": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "many": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "many": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0trln.\u00a0¤", "many": "0\u00a0trln.\u00a0¤", "one": "0\u00a0trln.\u00a0¤", "other": "0\u00a0trln.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0trln.\u00a0¤", "many": "0\u00a0trln.\u00a0¤", "one": "0\u00a0trln.\u00a0¤", "other": "0\u00a0trln.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0trln.\u00a0¤", "many": "0\u00a0trln.\u00a0¤", "one": "0\u00a0trln.\u00a0¤", "other": "0\u00a0trln.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "BDT"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "Br"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "ILS", "ILS"},
-		"INR": {2, "INR", "INR", "INR"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "KHR"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "LAK"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "MNT"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zl"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "Gs"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "rb"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "VND"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "XAF", "XAF"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "XOF", "XOF"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "XPF", "XPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andoros peseta", PluralForms{"", "Andoros peseta", "", "Andoros pesetos", "Andoros pesetos", "Andoros pesetos"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Jungtinių Arabų Emyratų dirhamas", PluralForms{"", "JAE dirhamas", "", "JAE dirhamai", "JAE dirhamo", "JAE dirhamų"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afganistano afganis (1927–2002)", PluralForms{"", "Afganistano afganis (1927–2002)", "", "Afganistano afganiai (1927–2002)", "Afganistano afganio (1927–2002)", "Afganistano afganių (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afganistano afganis", PluralForms{"", "Afganistano afganis", "", "Afganistano afganiai", "Afganistano afganio", "Afganistano afganių"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Albanijos lekas (1946–1965)", PluralForms{"", "Albanijos lekas (1946–1965)", "", "Albanijos lekai (1946–1965)", "Albanijos leko (1946–1965)", "Albanijos lekų (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albanijos lekas", PluralForms{"", "Albanijos lekas", "", "Albanijos lekai", "Albanijos leko", "Albanijos lekų"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armėnijos dramas", PluralForms{"", "Armėnijos dramas", "", "Armėnijos dramai", "Armėnijos dramo", "Armėnijos dramų"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Olandijos Antilų guldenas", PluralForms{"", "Olandijos Antilų guldenas", "", "Olandijos Antilų guldenai", "Olandijos Antilų guldeno", "Olandijos Antilų guldenų"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angolos kvanza", PluralForms{"", "Angolos kvanza", "", "Angolos kvanzos", "Angolos kvanzos", "Angolos kvanzų"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolos kvanza (1977–1990)", PluralForms{"", "Angolos kvanza (1977–1990)", "", "Angolos kvanzos (1977–1990)", "Angolos kvanzos (1977–1990)", "Angolos kvanzų (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolos naujoji kvanza (1990–2000)", PluralForms{"", "Angolos naujoji kvanza (1990–2000)", "", "Angolos naujosios kvanzos (1990–2000)", "Angolos naujosios kvanzos (1990–2000)", "Angolos naujųjų kvanzų (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolos patikslinta kvanza (1995–1999)", PluralForms{"", "Angolos patikslinta kvanza (1995–1999)", "", "Angolos patikslintos kvanzos (1995–1999)", "Angolos patikslintos kvanzos (1995–1999)", "Angolos patikslintų kvanzų (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentinos australs", PluralForms{"", "Argentinos austral", "", "Argentinos australs", "Argentinos australs", "Argentinos australs"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Argentinos pesos ley (1970–1983)", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Argentinos pesai (1881–1970)", PluralForms{"", "Argentinos pesas (1881–1970)", "", "Argentinos pesai (1881–1970)", "Argentinos peso (1881–1970)", "Argentinos pesų (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentinos pesas (1983–1985)", PluralForms{"", "Argentinos pesas (1983–1985)", "", "Argentinos pesai (1983–1985)", "Argentinos pesai (1983–1985)", "Argentinos pesai (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentinos pesas", PluralForms{"", "Argentinos pesas", "", "Argentinos pesai", "Argentinos peso", "Argentinos pesų"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austrijos šilingas", PluralForms{"", "Austrijos šilingas", "", "Austrijos šilingai", "Austrijos šilingo", "Austrijos šilingų"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "Australijos doleris", PluralForms{"", "Australijos doleris", "", "Australijos doleriai", "Australijos dolerio", "Australijos dolerių"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Arubos guldenas", PluralForms{"", "Arubos guldenas", "", "Arubos guldenai", "Arubos guldeno", "Arubos guldenų"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azerbaidžano manatas (1993–2006)", PluralForms{"", "Azerbaidžano manatas (1993–2006)", "", "Azerbaidžano manatai (1993–2006)", "Azerbaidžano manato (1993–2006)", "Azerbaidžano manatų (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbaidžano manatas", PluralForms{"", "Azerbaidžano manatas", "", "Azerbaidžano manatai", "Azerbaidžano manato", "Azerbaidžano manatų"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosnijos ir Hercegovinos dinaras (1992–1994)", PluralForms{"", "Bosnijos ir Hercegovinos dinaras (1992–1994)", "", "Bosnijos ir Hercegovinos dinarai (1992–1994)", "Bosnijos ir Hercegovinos dinaro (1992–1994)", "Bosnijos ir Hercegovinos dinarų (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bosnijos ir Hercegovinos konvertuojamoji markė", PluralForms{"", "Bosnijos ir Hercegovinos konvertuojamoji markė", "", "Bosnijos ir Hercegovinos konvertuojamosios markės", "Bosnijos ir Hercegovinos konvertuojamosios markės", "Bosnijos ir Hercegovinos konvertuojamųjų markių"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Bosnijos ir Hercegovinos naujasis dinaras (1994–1997)", PluralForms{"", "Bosnijos ir Hercegovinos naujasis dinaras (1994–1997)", "", "Bosnijos ir Hercegovinos naujieji dinarai (1994–1997)", "Bosnijos ir Hercegovinos naujojo dinaro (1994–1997)", "Bosnijos ir Hercegovinos naujųjų dinarų (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbadoso doleris", PluralForms{"", "Barbadoso doleris", "", "Barbadoso doleriai", "Barbadoso dolerio", "Barbadoso dolerių"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "BDT", "Bangladešo taka", PluralForms{"", "Bangladešo taka", "", "Bangladešo takos", "Bangladešo takos", "Bangladešo takų"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgijos frankas (konvertuojamas)", PluralForms{"", "Belgijos frankas (konvertuojamas)", "", "Belgijos frankai (konvertuojami)", "Belgijos franko (konvertuojamo)", "Belgijos frankų (konvertuojamų)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgijos frankas", PluralForms{"", "Belgijos frankas", "", "Belgijos frankai", "Belgijos franko", "Belgijos frankų"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgijos frankas (finansinis)", PluralForms{"", "Belgijos frankas (finansinis)", "", "Belgijos frankai (finansiniai)", "Belgijos franko (finansinio)", "Belgijos frankų (finansinių)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bulgarijos levas (1962–1999)", PluralForms{"", "Bulgarijos levas (1962–1999)", "", "Bulgarijos levai (1962–1999)", "Bulgarijos levo (1962–1999)", "Bulgarijos levų (1962–1999)"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Bulgarų socialistų leva", PluralForms{"", "Bulgarų socialistų lev", "", "Bulgarų socialistų leva", "Bulgarų socialistų leva", "Bulgarų socialistų leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgarijos levas", PluralForms{"", "Bulgarijos levas", "", "Bulgarijos levai", "Bulgarijos levo", "Bulgarijos levų"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Bulgarijos levas (1879–1952)", PluralForms{"", "Bulgarijos levas (1879–1952)", "", "Bulgarijos levai (1879–1952)", "Bulgarijos levo (1879–1952)", "Bulgarijos levų (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahreino dinaras", PluralForms{"", "Bahreino dinaras", "", "Bahreino dinarai", "Bahreino dinaro", "Bahreino dinarų"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundžio frankas", PluralForms{"", "Burundžio frankas", "", "Burundžio frankai", "Burundžio franko", "Burundžio frankų"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermudos doleris", PluralForms{"", "Bermudos doleris", "", "Bermudos doleriai", "Bermudos dolerio", "Bermudos dolerių"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunėjaus doleris", PluralForms{"", "Brunėjaus doleris", "", "Brunėjaus doleriai", "Brunėjaus dolerio", "Brunėjaus dolerių"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolivijos bolivijanas", PluralForms{"", "Bolivijos bolivijanas", "", "Bolivijos bolivijanai", "Bolivijos bolivijano", "Bolivijos bolivijanų"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Bolivijos bolivijanas (1863–1963)", PluralForms{"", "Bolivijos bolivijanas (1863–1963)", "", "Bolivijos bolivijanai (1863–1963)", "Bolivijos bolivijano (1863–1963)", "Bolivijos bolivijanų (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bolivijos pesas", PluralForms{"", "Bolivijos pesas", "", "Bolivijos pesai", "Bolivijos peso", "Bolivijos pesų"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Bolivijos mvdol", PluralForms{"", "Bolivijos mvdol", "", "Boliviečių mvdols", "Bolivijos mvdol", "Bolivijos mvdol"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brazilijos naujieji kruzeirai (1967–1986)", PluralForms{"", "Brazilijos naujasis kruzeiras (1967–1986)", "", "Brazilijos naujieji kruzeirai (1967–1986)", "Brazilijos naujasis kruzeiro (1967–1986)", "Brazilijos naujųjų kruzeirų (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brazilijos kruzadai (1986–1989)", PluralForms{"", "Brazilijos kruzadas (1986–1989)", "", "Brazilijos kruzadai (1986–1989)", "Brazilijos kruzado (1986–1989)", "Brazilijos kruzadų (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brazilijos kruzeiras (1990–1993)", PluralForms{"", "Brazilijos kruzeiras (1990–1993)", "", "Brazilijos kruzeirai (1990–1993)", "Brazilijos kruzeirai (1990–1993)", "Brazilijos kruzeirai (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "Brazilijos realas", PluralForms{"", "Brazilijos realas", "", "Brazilijos realai", "Brazilijos realo", "Brazilijos realų"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brazilijos naujiejis kruzadai (1989–1990)", PluralForms{"", "Brazilijos naujasis kruzadas (1989–1990)", "", "Brazilijos naujieji kruzadai (1989–1990)", "Brazilijos naujojo kruzado (1989–1990)", "Brazilijos naujųjų kruzadų (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brazilijos kruzeiras (1993–1994)", PluralForms{"", "Brazilijos kruzeiras (1993–1994)", "", "Brazilijos kruzeirai (1993–1994)", "Brazilijos kruzeiro (1993–1994)", "Brazilijos kruzeirų (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Brazilijos kruzeirai (1942–1967)", PluralForms{"", "Brazilijos kruzeiras (1942–1967)", "", "Brazilijos kruzeirai (1942–1967)", "Brazilijos kruzeiro (1942–1967)", "Brazilijos kruzeirų (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamų doleris", PluralForms{"", "Bahamų doleris", "", "Bahamų doleriai", "Bahamų dolerio", "Bahamų dolerių"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Butano ngultrumas", PluralForms{"", "Butano ngultrumas", "", "Butano ngultrumai", "Butano ngultrumo", "Butano ngultrumų"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Birmos kijatas", PluralForms{"", "Birmos kijatas", "", "Birmos kijatai", "Birmos kijato", "Birmos kijatų"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botsvanos pula", PluralForms{"", "Botsvanos pula", "", "Botsvanos pulos", "Botsvanos pulos", "Botsvanos pulų"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Baltarusijos naujasis rublis (1994–1999)", PluralForms{"", "Baltarusijos naujasis rublis (1994–1999)", "", "Baltarusijos naujieji rubliai (1994–1999)", "Baltarusijos naujojo rublio (1994–1999)", "Baltarusijos naujųjų rublių"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "Br", "Baltarusijos rublis", PluralForms{"", "Baltarusijos rublis", "", "Baltarusijos rubliai", "Baltarusijos rublio", "Baltarusijos rublių"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Baltarusijos rublis (2000–2016)", PluralForms{"", "Baltarusijos rublis (2000–2016)", "", "Baltarusijos rubliai (2000–2016)", "Baltarusijos rublio (2000–2016)", "Baltarusijos rublių (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belizo doleris", PluralForms{"", "Belizo doleris", "", "Belizo doleriai", "Belizo dolerio", "Belizo dolerių"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "Kanados doleris", PluralForms{"", "Kanados doleris", "", "Kanados doleriai", "Kanados dolerio", "Kanados dolerių"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongo frankas", PluralForms{"", "Kongo frankas", "", "Kongo frankai", "Kongo franko", "Kongo frankų"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR eurai", PluralForms{"", "WIR euras", "", "WIR eurai", "WIR euro", "WIR eurų"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Šveicarijos frankas", PluralForms{"", "Šveicarijos frankas", "", "Šveicarijos frankai", "Šveicarijos franko", "Šveicarijos frankų"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR frankas", PluralForms{"", "WIR frankas", "", "WIR frankai", "WIR franko", "WIR frankų"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Čilės eskudai", PluralForms{"", "Čilės eskudas", "", "Čilės eskudai", "Čilės eskudo", "Čilės eskudų"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Čiliečių unidades de fomentos", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Čilės pesas", PluralForms{"", "Čilės pesas", "", "Čilės pesai", "Čilės peso", "Čilės pesų"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Kinijos Užsienio juanis", PluralForms{"", "Kinijos Užsienio juanis", "", "Kinijos Užsienio juaniai", "Kinijos Užsienio juanio", "Kinijos Užsienio juanių"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Kinijos \"People\" banko doleris", PluralForms{"", "Kinijos \"People\" banko doleris", "", "Kinijos \"People\" banko doleriai", "Kinijos \"People\" banko dolerio", "Kinijos \"People\" banko dolerių"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "Kinijos ženminbi juanis", PluralForms{"", "Kinijos ženminbi juanis", "", "Kinijos ženminbi juaniai", "Kinijos ženminbi juanio", "Kinijos ženminbi juanių"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolumbijos pesas", PluralForms{"", "Kolumbijos pesas", "", "Kolumbijos pesai", "Kolumbijos peso", "Kolumbijos pesų"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "unidad de valor realai", PluralForms{"", "unidad de valor realas", "", "unidad de valor realai", "unidad de valor realai", "unidad de valor realai"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kosta Rikos kolonas", PluralForms{"", "Kosta Rikos kolonas", "", "Kosta Rikos kolonai", "Kosta Rikos kolono", "Kosta Rikos kolonų"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Serbijos dinaras (2002–2006)", PluralForms{"", "Serbijos dinaras (2002–2006)", "", "Serbijos dinarai (2002–2006)", "Serbijos dinaro (2002–2006)", "Serbijos dinarų (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Čekoslovakų sunkusis korunas", PluralForms{"", "Čekoslovakų sunkusis korunas", "", "Čekoslovakų sunkieji korunai", "Čekoslovakų sunkiejio koruno", "Čekoslovakų sunkiejių korunų"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kubos konvertuojamasis pesas", PluralForms{"", "Kubos konvertuojamasis pesas", "", "Kubos konvertuojamieji pesai", "Kubos konvertuojamojo peso", "Kubos konvertuojamųjų pesų"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kubos pesas", PluralForms{"", "Kubos pesas", "", "Kubos pesai", "Kubos peso", "Kubos pesų"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Žaliojo Kyšulio eskudas", PluralForms{"", "Žaliojo Kyšulio eskudas", "", "Žaliojo Kyšulio eskudai", "Žaliojo Kyšulio eskudo", "Žaliojo Kyšulio eskudų"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kipro svaras", PluralForms{"", "Kipro svaras", "", "Kipro svarai", "Kipro svaro", "Kipro svarų"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Čekijos krona", PluralForms{"", "Čekijos krona", "", "Čekijos kronos", "Čekijos kronos", "Čekijos kronų"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Rytų Vokietijos markė", PluralForms{"", "Rytų Vokietijos markė", "", "Rytų Vokietijos markės", "Rytų Vokietijos markės", "Rytų Vokietijos markės"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Vokietijos markė", PluralForms{"", "Vokietijos markė", "", "Vokietijos markės", "Vokietijos markės", "Vokietijos markės"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Džibučio frankas", PluralForms{"", "Džibučio frankas", "", "Džibučio frankai", "Džibučio franko", "Džibučio frankų"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Danijos krona", PluralForms{"", "Danijos krona", "", "Danijos kronos", "Danijos kronos", "Danijos kronų"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikos pesas", PluralForms{"", "Dominikos pesas", "", "Dominikos pesai", "Dominikos peso", "Dominikos pesų"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Alžyro dinaras", PluralForms{"", "Alžyro dinaras", "", "Alžyro dinarai", "Alžyro dinaro", "Alžyro dinarų"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ekvadoro sukrė", PluralForms{"", "Ekvadoro sucre", "", "Ekvadoro sucres", "Ekvadoro sucres", "Ekvadoro sucres"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Ekvadoro constante (UVC)", PluralForms{"", "Ekvadoro unidads de narsa Constante (UVC)", "", "Ekvadoro unidads de narsa Constante (UVC)", "Ekvadoro unidads de narsa Constante (UVC)", "Ekvadoro unidads de narsa Constante (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estijos krona", PluralForms{"", "Estijos krona", "", "Estijos kronos", "Estijos kronos", "Estijos kronų"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Egipto svaras", PluralForms{"", "Egipto svaras", "", "Egipto svarai", "Egipto svaro", "Egipto svarų"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrėjos nakfa", PluralForms{"", "Eritrėjos nakfa", "", "Eritrėjos nakfos", "Eritrėjos nakfos", "Eritrėjos nakfų"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Ispanų pesetai (A sąskaita)", PluralForms{"", "Ispanų pesetas (A sąskaita)", "", "Ispanų pesetai (A sąskaita)", "Ispanų pesetai (A sąskaita)", "Ispanų pesetai (A sąskaita)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Ispanų pesetai (konvertuojama sąskaita)", PluralForms{"", "Ispanų pesetas (konvertuojama sąskaita)", "", "Ispanų pesetai (konvertuojama sąskaita)", "Ispanų pesetai (konvertuojama sąskaita)", "Ispanų pesetai (konvertuojama sąskaita)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Ispanijos peseta", PluralForms{"", "Ispanų pesetas", "", "Ispanų pesetai", "Ispanų pesetai", "Ispanų pesetai"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Etiopijos biras", PluralForms{"", "Etiopijos biras", "", "Etiopijos birai", "Etiopijos biro", "Etiopijos birų"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euras", PluralForms{"", "euras", "", "eurai", "euro", "eurų"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Suomijos markė", PluralForms{"", "Suomijos markė", "", "Suomijos markės", "Suomijos markės", "Suomijos markės"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fidžio doleris", PluralForms{"", "Fidžio doleris", "", "Fidžio doleriai", "Fidžio dolerio", "Fidžio dolerių"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Falklando salų svaras", PluralForms{"", "Falklando salų svaras", "", "Falklando salų svarai", "Falklando salų svaro", "Falklando salų svarų"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Prancūzijos frankas", PluralForms{"", "Prancūzijos frankas", "", "Prancūzijos frankai", "Prancūzijos franko", "Prancūzijos frankų"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "Didžiosios Britanijos svaras", PluralForms{"", "Didžiosios Britanijos svaras", "", "Didžiosios Britanijos svarai", "Didžiosios Britanijos svaro", "Didžiosios Britanijos svarų"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Gruzinų kupon larits", PluralForms{"", "Gruzinų kupon larit", "", "Gruzinų kupon larits", "Gruzinų kupon larits", "Gruzinų kupon larits"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gruzijos laris", PluralForms{"", "Gruzijos laris", "", "Gruzijos lariai", "Gruzijos lario", "Gruzijos larių"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ganos sedis (1979–2007)", PluralForms{"", "Ganos sedis (1979–2007)", "", "Ganos sedžiai (1979–2007)", "Ganos sedžio (1979–2007)", "Ganos sedžių (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ganos sedis", PluralForms{"", "Ganos sedis", "", "Ganos sedžiai", "Ganos sedžio", "Ganos sedžių"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltaro svaras", PluralForms{"", "Gibraltaro svaras", "", "Gibraltaro svarai", "Gibraltaro svaro", "Gibraltaro svarų"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambijos dalasis", PluralForms{"", "Gambijos dalasis", "", "Gambijos dalasiai", "Gambijos dalasio", "Gambijos dalasių"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gvinėjos frankas", PluralForms{"", "Gvinėjos frankas", "", "Gvinėjos frankai", "Gvinėjos franko", "Gvinėjos frankų"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Guinean sylis", PluralForms{"", "Gvinėjos sylis", "", "Gvinėjos syliai", "Gvinėjos sylio", "Gvinėjos sylio"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Pusiaujo Guinean ekwele", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Graikijos drachma", PluralForms{"", "Graikijos drachma", "", "Graikijos drachmos", "Graikijos drachmos", "Graikijos drachmos"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Gvatemalos ketcalis", PluralForms{"", "Gvatemalos ketcalis", "", "Gvatemalos ketcaliai", "Gvatemalos ketcalio", "Gvatemalos ketcalių"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugalų Gvinėjos eskudas", PluralForms{"", "Portugalijos Gvinėjos eskudas", "", "Portugalijos Gvinėjos eskudai", "Portugalijos Gvinėjos eskudo", "Portugalijos Gvinėjos eskudų"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Gvinėjos-Bisau pesas", PluralForms{"", "Bisau Gvinėjos pesas", "", "Bisau Gvinėjos pesai", "Bisau Gvinėjos peso", "Bisau Gvinėjos pesai"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Gajanos doleris", PluralForms{"", "Gajanos doleris", "", "Gajanos doleriai", "Gajanos dolerio", "Gajanos dolerių"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "Honkongo doleris", PluralForms{"", "Honkongo doleris", "", "Honkongo doleriai", "Honkongo dolerio", "Honkongo dolerių"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Hondūro lempira", PluralForms{"", "Hondūro lempira", "", "Hondūro lempiros", "Hondūro lempiros", "Hondūro lempirų"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Kroatijos dinaras", PluralForms{"", "Kroatijos dinaras", "", "Krotaijos dinarai", "Kroatijos dinaro", "Kroatijos dinarų"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kroatijos kuna", PluralForms{"", "Kroatijos kuna", "", "Kroatijos kunos", "Kroatijos kunos", "Kroatijos kunų"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haičio gurdas", PluralForms{"", "Haičio gurdas", "", "Haičio gurdai", "Haičio gurdo", "Haičio gurdų"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Vengrijos forintas", PluralForms{"", "Vengrijos forintas", "", "Vengrijos forintai", "Vengrijos forinto", "Vengrijos forintų"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indonezijos rupija", PluralForms{"", "Indonezijos rupija", "", "Indonezijos rupijos", "Indonezijos rupijos", "Indonezijos rupijų"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Airijos svaras", PluralForms{"", "Airijos svaras", "", "Airijos svarai", "Airijos svaro", "Airijos svarų"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Izraelio svaras", PluralForms{"", "Izraelio svaras", "", "Izraelio svarai", "Izraelio svaro", "Izraelio svarų"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Izraelio šekelis (1980–1985)", PluralForms{"", "Izraelio šekelis (1980–1985)", "", "Izraelio šekeliai (1980–1985)", "Izraelio šekelio (1980–1985)", "Izraelio šekelių (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "ILS", "Izraelio naujasis šekelis", PluralForms{"", "Izraelio naujasis šekelis", "", "Izraelio naujieji šekeliai", "Izraelio naujojo šekelio", "Izraelio naujųjų šekelių"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "INR", "Indijos rupija", PluralForms{"", "Indijos rupija", "", "Indijos rupijos", "Indijos rupijos", "Indijos rupijų"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irako dinaras", PluralForms{"", "Irako dinaras", "", "Irako dinarai", "Irako dinaro", "Irako dinarų"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Irano rialas", PluralForms{"", "Irano rialas", "", "Irano rialai", "Irano rialo", "Irano rialų"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Islandijos krona (1918–1981)", PluralForms{"", "Islandijos krona (1918–1981)", "", "Islandijos kronos (1918–1981)", "Islandijos kronos (1918–1981)", "Islandijos kronų (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Islandijos krona", PluralForms{"", "Islandijos krona", "", "Islandijos kronos", "Islandijos kronos", "Islandijos kronų"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italijos lira", PluralForms{"", "Italijos lira", "", "Italijos liros", "Italijos liros", "Italijos lirų"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaikos doleris", PluralForms{"", "Jamaikos doleris", "", "Jamaikos doleriai", "Jamaikos dolerio", "Jamaikos dolerių"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordanijos dinaras", PluralForms{"", "Jordanijos dinaras", "", "Jordanijos dinarai", "Jordanijos dinaro", "Jordanijos dinarų"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "Japonijos jena", PluralForms{"", "Japonijos jena", "", "Japonijos jenos", "Japonijos jenos", "Japonijos jenų"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenijos šilingas", PluralForms{"", "Kenijos šilingas", "", "Kenijos šilingai", "Kenijos šilingo", "Kenijos šilingų"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kirgizijos somas", PluralForms{"", "Kirgizijos somas", "", "Kirgizijos somai", "Kirgizijos somo", "Kirgizijos somų"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "KHR", "Kambodžos rielis", PluralForms{"", "Kambodžos rielis", "", "Kambodžos rieliai", "Kambodžos rielio", "Kambodžos rielių"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komoro frankas", PluralForms{"", "Komoro frankas", "", "Komoro frankai", "Komoro franko", "Komoro frankų"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Šiaurės Korėjos vonas", PluralForms{"", "Šiaurės Korėjos vonas", "", "Šiaurės Korėjos vonai", "Šiaurės Korėjos vono", "Šiaurės Korėjos vonų"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Pietų Korėjos hwanas (1953–1962)", PluralForms{"", "Pietų Korėjos hwanas (1953–1962)", "", "Pietų Korėjos hwanai (1953–1962)", "Pietų Korėjos hwano (1953–1962)", "Pietų Korėjos hwanų (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Pietų Korėjos vonas (1945–1953)", PluralForms{"", "Pietų Korėjos vonas (1945–1953)", "", "Pietų Korėjos vonai (1945–1953)", "Pietų Korėjos vono (1945–1953)", "Pietų Korėjos vonų (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "Pietų Korėjos vonas", PluralForms{"", "Pietų Korėjos vonas", "", "Pietų Korėjos vonai", "Pietų Korėjos vono", "Pietų Korėjos vonų"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuveito dinaras", PluralForms{"", "Kuveito dinaras", "", "Kuveito dinarai", "Kuveito dinaro", "Kuveito dinarų"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kaimanų salų doleris", PluralForms{"", "Kaimanų salų doleris", "", "Kaimanų salų doleriai", "Kaimanų salų dolerio", "Kaimanų salų dolerių"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kazachstano tengė", PluralForms{"", "Kazachstano tengė", "", "Kazachstano tengės", "Kazachstano tengės", "Kazachstano tengių"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "LAK", "Laoso kipas", PluralForms{"", "Laoso kipas", "", "Laoso kipai", "Laoso kipo", "Laoso kipų"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Libano svaras", PluralForms{"", "Libano svaras", "", "Libano svarai", "Libano svaro", "Libano svarų"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Šri Lankos rupija", PluralForms{"", "Šri Lankos rupija", "", "Šri Lankos rupijos", "Šri Lankos rupijos", "Šri Lankos rupijų"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberijos doleris", PluralForms{"", "Liberijos doleris", "", "Liberijos doleriai", "Liberijos dolerio", "Liberijos dolerių"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesoto lotis", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Lietuvos litas", PluralForms{"", "Lietuvos litas", "", "Lietuvos litai", "Lietuvos lito", "Lietuvos litų"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Lietuvos talonas", PluralForms{"", "Lietuvos talonas", "", "Lietuvos talonai", "Lietuvos talonai", "Lietuvos talonai"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Liuksemburgo konvertuojamas frankas", PluralForms{"", "Liuksemburgo konvertuojas frankas", "", "Liuksemburgo konvertuojami frankai", "Liuksemburgo konvertuojamo franko", "Liuksemburgo konvertuojamų frankų"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Liuksemburgo frankas", PluralForms{"", "Liuksemburgo frankas", "", "Liuksemburgo frankai", "Liuksemburgo franko", "Liuksemburgo frankų"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Liuksemburgo finansinis frankas", PluralForms{"", "Liuksemburgo finansinis frankas", "", "Liuksemburgo finansiniai frankai", "Liuksemburgo finansinio franko", "Liuksemburgo finansinių frankų"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Latvijos latas", PluralForms{"", "Latvijos latas", "", "Latvijos latai", "Latvijos lato", "Latvijos latų"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latvijos rublis", PluralForms{"", "Latvijos rublis", "", "Latvijos rubliai", "Latvijos rublio", "Latvijos rublių"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Libijos dinaras", PluralForms{"", "Libijos dinaras", "", "Libijos dinarai", "Libijos dinaro", "Libijos dinarų"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Maroko dirhamas", PluralForms{"", "Maroko dirhamas", "", "Maroko dirhamai", "Maroko dirhamo", "Maroko dirhamų"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Maroko frankas", PluralForms{"", "Maroko frankas", "", "Maroko frankai", "Maroko franko", "Maroko frankų"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Monegasque frankas", PluralForms{"", "Monegasque frankas", "", "Monegasque frankai", "Monegasque franko", "Monegasque frankų"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Moldovų cupon", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldovos lėja", PluralForms{"", "Moldovos lėja", "", "Moldovos lėjos", "Moldovos lėjos", "Moldovos lėjų"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madagaskaro ariaris", PluralForms{"", "Madagaskaro ariaris", "", "Madagaskaro ariariai", "Madagaskaro ariario", "Madagaskaro ariarių"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Madagaskaro frankas", PluralForms{"", "Madagaskaro frankas", "", "Madagaskaro frankai", "Madagaskaro franko", "Madagaskaro frankų"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Makedonijos denaras", PluralForms{"", "Makedonijos denaras", "", "Makedonijos denarai", "Makedonijos denaro", "Makedonijos denarų"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Makedonijos denaras (1992–1993)", PluralForms{"", "Makedonijos denaras (1992–1993)", "", "Makedonijos denarai (1992–1993)", "Makedonijos denaro (1992–1993)", "Makedonijos denarų (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malio frankas", PluralForms{"", "Malio frankas", "", "Malio frankai", "Malio franko", "Malio frankų"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Mianmaro kijatas", PluralForms{"", "Mianmaro kijatas", "", "Mianmaro kijatai", "Mianmaro kijato", "Mianmaro kijatų"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "MNT", "Mongolijos tugrikas", PluralForms{"", "Mongolijos tugrikas", "", "Mongolijos tugrikai", "Mongolijos tugriko", "Mongolijos tugrikų"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makao pataka", PluralForms{"", "Makao pataka", "", "Makao patakos", "Makao patakos", "Makao patakų"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritanijos ugija (1973–2017)", PluralForms{"", "Mauritanijos ugija (1973–2017)", "", "Mauritanijos ugijos (1973–2017)", "Mauritanijos ugijos (1973–2017)", "Mauritanijos ugijų (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauritanijos ugija", PluralForms{"", "Mauritanijos ugija", "", "Mauritanijos ugijos", "Mauritanijos ugijos", "Mauritanijos ugijų"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Maltos lira", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltos svaras", PluralForms{"", "Maltos svaras", "", "Maltos svarai", "Maltos svaro", "Maltos svarų"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mauricijaus rupija", PluralForms{"", "Mauricijaus rupija", "", "Mauricijaus rupijos", "Mauricijaus rupijos", "Mauricijaus rupijų"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Maldyvų rupija", PluralForms{"", "Maldyvų rupija", "", "Maldyvų rupijos", "Maldyvų rupijos", "Maldyvų rupijos"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldyvų rufija", PluralForms{"", "Maldyvų rufija", "", "Maldyvų rufijos", "Maldyvų rufijos", "Maldyvų rufijų"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malavio kvača", PluralForms{"", "Malavio kvača", "", "Malavio kvačos", "Malavio kvačos", "Malavio kvačų"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "Meksikos pesas", PluralForms{"", "Meksikos pesas", "", "Meksikos pesai", "Meksikos peso", "Meksikos pesų"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Meksikos sidabrinis pesas (1861–1992)", PluralForms{"", "Meksikos sidabrinis pesas (1861–1992)", "", "Meksikos sidabriniai pesai (1861–1992)", "Meksikos sidabrino peso (1861–1992)", "Meksikos sidabrinių pesų (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Meksikos United de Inversion (UDI)", PluralForms{"", "Meksikos unidad de inversija (UDI)", "", "Meksikos unidads de inversija (UDI)", "Meksikos unidads de inversija (UDI)", "Meksikos unidads de inversija (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaizijos ringitas", PluralForms{"", "Malaizijos ringitas", "", "Malaizijos ringitai", "Malaizijos ringito", "Malaizijos ringitų"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambiko eskudas", PluralForms{"", "Mozambiko eskudas", "", "Mozambiko eskudai", "Mozambiko eskudo", "Mozambiko eskudų"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambiko metikalis (1980–2006)", PluralForms{"", "Mozambiko metikalis (1980–2006)", "", "Mozambiko metikaliai (1980–2006)", "Mozambiko metikalio (1980–2006)", "Mozambiko metikalių (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambiko metikalis", PluralForms{"", "Mozambiko metikalis", "", "Mozambiko metikaliai", "Mozambiko metikalio", "Mozambiko metikalių"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibijos doleris", PluralForms{"", "Namibijos doleris", "", "Namibijos doleriai", "Namibijos dolerio", "Namibijos dolerių"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigerijos naira", PluralForms{"", "Nigerijos naira", "", "Nigerijos nairos", "Nigerijos nairos", "Nigerijos nairų"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Nikaragvos kardoba (1988–1991)", PluralForms{"", "Nikaragvos kordoba (1988–1991)", "", "Nikaragvos kordobai (1988–1991)", "Nikaragvos kordobos (1988–1991)", "Nikaragvos kordobų (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikaragvos kordoba", PluralForms{"", "Nikaragvos kordoba", "", "Nikaragvos kordobos", "Nikaragvos kordobos", "Nikaragvos kordobų"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Nyderlandų guldenas", PluralForms{"", "Nyderlandų guldenas", "", "Nyderlandų guldenai", "Nyderlandų guldeno", "Nyderlandų guldenų"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norvegijos krona", PluralForms{"", "Norvegijos krona", "", "Norvegijos kronos", "Norvegijos kronos", "Norvegijos kronų"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepalo rupija", PluralForms{"", "Nepalo rupija", "", "Nepalo rupijos", "Nepalo rupijos", "Nepalo rupijų"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "Naujosios Zelandijos doleris", PluralForms{"", "Naujosios Zelandijos doleris", "", "Naujosios Zelandijos doleriai", "Naujosios Zelandijos dolerio", "Naujosios Zelandijos dolerių"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omano rialas", PluralForms{"", "Omano rialas", "", "Omano rialai", "Omano rialo", "Omano rialų"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panamos balboja", PluralForms{"", "Panamos balboja", "", "Panamos balbojos", "Panamos balbojos", "Panamos balbojų"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peru intis", PluralForms{"", "Peru inti", "", "Peru intis", "Peru intis", "Peru intis"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peru solis", PluralForms{"", "Peru solis", "", "Peru soliai", "Peru solio", "Peru solių"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peru solis (1863–1965)", PluralForms{"", "Peru solis (1863–1965)", "", "Peru soliai (1863–1965)", "Peru solio (1863–1965)", "Peru solių (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua Naujosios Gvinėjos kina", PluralForms{"", "Papua Naujosios Gvinėjos kina", "", "Papua Naujosios Gvinėjos kinos", "Papua Naujosios Gvinėjos kinos", "Papua Naujosios Gvinėjos kinų"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filipinų pesas", PluralForms{"", "Filipinų pesas", "", "Filipinų pesai", "Filipinų peso", "Filipinų pesų"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistano rupija", PluralForms{"", "Pakistano rupija", "", "Pakistano rupijos", "Pakistano rupijos", "Pakistano rupijų"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zl", "Lenkijos zlotas", PluralForms{"", "Lenkijos zlotas", "", "Lenkijos zlotai", "Lenkijos zloto", "Lenkijos zlotų"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Lenkijos zlotas (1950–1995)", PluralForms{"", "Lenkijos zlotas (1950–1995)", "", "Lenkijos zlotai (1950–1995)", "Lenkijos zloto (1950–1995)", "Lenkijos zlotų (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugalijos eskudas", PluralForms{"", "Portugalijos eskudas", "", "Portugalijos eskudai", "Portugalijos eskudo", "Portugalijos eskudų"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "Gs", "Paragvajaus guaranis", PluralForms{"", "Paragvajaus guaranis", "", "Paragvajaus guaraniai", "Paragvajaus guaranio", "Paragvajaus guaranių"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Kataro rialas", PluralForms{"", "Kataro rialas", "", "Kataro rialai", "Kataro rialo", "Kataro rialų"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rodezijos doleris", PluralForms{"", "Rodezijos doleris", "", "Rodezijos doleriai", "Rodezijos dolerio", "Rodezijos dolerių"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumunijos lėja (1952–2006)", PluralForms{"", "Rumunijos lėja (1952–2006)", "", "Rumunijos lėjos (1952–2006)", "Rumunijos lėjos (1952–2006)", "Rumunijos lėjų (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Rumunijos lėja", PluralForms{"", "Rumunijos lėja", "", "Rumunijos lėjos", "Rumunijos lėjos", "Rumunijos lėjų"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbijos dinaras", PluralForms{"", "Serbijos dinaras", "", "Serbijos dinarai", "Serbijos dinaro", "Serbijos dinarų"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "rb", "Rusijos rublis", PluralForms{"", "Rusijos rublis", "", "Rusijos rubliai", "Rusijos rublio", "Rusijos rublių"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Rusijos rublis (1991–1998)", PluralForms{"", "Rusijos rublis (1991–1998)", "", "Rusijos rubliai (1991–1998)", "Rusijos rublio (1991–1998)", "Rusijos rublių (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ruandos frankas", PluralForms{"", "Ruandos frankas", "", "Ruandos frankai", "Ruandos franko", "Ruandos frankų"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saudo Arabijos rijalas", PluralForms{"", "Saudo Arabijos rijalas", "", "Saudo Arabijos rijalai", "Saudo Arabijos rijalo", "Saudo Arabijos rijalų"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Saliamono salų doleris", PluralForms{"", "Saliamono salų doleris", "", "Saliamono salų doleriai", "Saliamono salų dolerio", "Saliamono salų dolerių"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seišelių rupija", PluralForms{"", "Seišelių rupija", "", "Seišelių rupijos", "Seišelių rupijos", "Seišelių rupijų"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Sudano dinaras (1992–2007)", PluralForms{"", "Sudano dinaras (1992–2007)", "", "Sudano dinarai (1992–2007)", "Sudano dinaro (1992–2007)", "Sudano dinarų (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudano svaras", PluralForms{"", "Sudano svaras", "", "Sudano svarai", "Sudano svaro", "Sudano svarų"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Sudano svaras (1957–1998)", PluralForms{"", "Sudano svaras (1957–1998)", "", "Sudano svarai (1957–1998)", "Sudano svaro (1957–1998)", "Sudano svarų (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Švedijos krona", PluralForms{"", "Švedijos krona", "", "Švedijos kronos", "Švedijos kronos", "Švedijos kronų"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapūro doleris", PluralForms{"", "Singapūro doleris", "", "Singapūro doleriai", "Singapūro dolerio", "Singapūro dolerių"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Šv. Elenos salų svaras", PluralForms{"", "Šv. Elenos salų svaras", "", "Šv. Elenos salų svarai", "Šv. Elenos salų svaro", "Šv. Elenos salų svarų"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovėnijos tolaras", PluralForms{"", "Slovėnijos tolars", "", "Slovėnijos tolars", "Slovėnijos tolar", "Slovėnijos tolar"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovakijos krona", PluralForms{"", "Slovakijos krona", "", "Slovakijos kronos", "Slovakijos kronos", "Slovakijos kronų"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Siera Leonės leonė", PluralForms{"", "Siera Leonės leonė", "", "Siera Leonės leonės", "Siera Leonės leonės", "Siera Leonės leonių"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Siera Leonės leonė (1964—2022)", PluralForms{"", "Siera Leonės leonė (1964—2022)", "", "Siera Leonės leonės (1964—2022)", "Siera Leonės leonės (1964—2022)", "Siera Leonės leonių (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somalio šilingas", PluralForms{"", "Somalio šilingas", "", "Somalio šilingai", "Somalio šilingo", "Somalio šilingų"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surimano doleris", PluralForms{"", "Surimano doleris", "", "Surimano doleriai", "Surimano dolerio", "Surimano dolerių"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surimano guldenas", PluralForms{"", "Surimano guldenas", "", "Surimano guldenai", "Surimano guldeno", "Surimano guldenų"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Pietų Sudano svaras", PluralForms{"", "Pietų Sudano svaras", "", "Pietų Sudano svarai", "Pietų Sudano svaro", "Pietų Sudano svarų"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "San Tomės ir Principės dobra (1977–2017)", PluralForms{"", "San Tomės ir Principės dobra (1977–2017)", "", "San Tomės ir Principės dobros (1977–2017)", "San Tomės ir Principės dobros (1977–2017)", "Sao Tomės ir Principės dobrų (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "San Tomės ir Principės dobra", PluralForms{"", "San Tomės ir Principės dobra", "", "San Tomės ir Principės dobros", "San Tomės ir Principės dobros", "Sao Tomės ir Principės dobrų"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Sovietų rublis", PluralForms{"", "Sovietų rublis", "", "Sovietų rubliai", "Sovietų rublio", "Sovietų rublių"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Salvadoro kolonas", PluralForms{"", "Salvadoro kolonas", "", "Salvadoro kolonai", "Salvadoro kolonai", "Salvadoro kolonai"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Sirijos svaras", PluralForms{"", "Sirijos svaras", "", "Sirijos svarai", "Sirijos svaro", "Sirijos svarų"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Svazilando lilangenis", PluralForms{"", "Svazilando lilangenis", "", "Svazilando lilangeniai", "Svazilendo lilangenio", "Svazilendo lilangenių"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Tailando batas", PluralForms{"", "Tailando batas", "", "Tailando batai", "Tailando bato", "Tailando batų"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadžikistano rublis", PluralForms{"", "Tadžikistano rublis", "", "Tadžikistano rubliai", "Tadžikistano rublio", "Tadžikistano rublių"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadžikistano somonis", PluralForms{"", "Tadžikistano somonis", "", "Tadžikistano somoniai", "Tadžikistano somonio", "Tadžikistano somonių"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmėnistano manatas (1993–2009)", PluralForms{"", "Turkmėnistano manatas (1993–2009)", "", "Turkmėnistano manatai (1993–2009)", "Turkmėnistano manato (1993–2009)", "Turkmėnistano manatų (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmėnistano manatas", PluralForms{"", "Turkmėnistano manatas", "", "Turkmėnistano manatai", "Turkmėnistano manato", "Turkmėnistano manatų"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tuniso dinaras", PluralForms{"", "Tuniso dinaras", "", "Tuniso dinarai", "Tuniso dinaro", "Tuniso dinarų"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tongo paanga", PluralForms{"", "Tongo paanga", "", "Tongo paangos", "Tongo paangos", "Tongo paangų"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timoro eskudas", PluralForms{"", "Timoro eskudas", "", "Timoro eskudai", "Timoro eskudo", "Timoro eskudų"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turkijos lira (1922–2005)", PluralForms{"", "Turkijos lira (1922–2005)", "", "Turkijos liros (1922–2005)", "Turkijos liros (1922–2005)", "Turkijos lirų (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Turkijos lira", PluralForms{"", "Turkijos lira", "", "Turkijos liros", "Turkijos liros", "Turkijos lirų"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidado ir Tobago doleris", PluralForms{"", "Trinidado ir Tobago doleris", "", "Trinidado ir Tobago doleriai", "Trinidado ir Tobago dolerio", "Trinidado ir Tobago dolerių"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "$", "Taivano naujasis doleris", PluralForms{"", "Taivano naujasis doleris", "", "Taivano naujieji doleriai", "Taivano naujojo dolerio", "Taivano naujųjų dolerių"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzanijos šilingas", PluralForms{"", "Tanzanijos šilingas", "", "Tanzanijos šilingai", "Tanzanijos šilingo", "Tanzanijos šilingų"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrainos grivina", PluralForms{"", "Ukrainos grivina", "", "Ukrainos grivinos", "Ukrainos grivinos", "Ukrainos grivinų"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrainos karbovanecas", PluralForms{"", "Ukrainos karbovanets", "", "Ukrainos karbovantsiv", "Ukrainos karbovantsiv", "Ukrainos karbovantsiv"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Ugandos šilingas (1966–1987)", PluralForms{"", "Ugandos šilingas (1966–1987)", "", "Ugandos šilingai (1966–1987)", "Ugandos šilingo (1966–1987)", "Ugandos šilingų (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ugandos šilingas", PluralForms{"", "Ugandos šilingas", "", "Ugandos šilingai", "Ugandos šilingo", "Ugandos šilingų"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "JAV doleris", PluralForms{"", "JAV doleris", "", "JAV doleriai", "JAV dolerio", "JAV dolerių"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "JAV doleris (kitos dienos)", PluralForms{"", "JAV doleris (kitą dieną)", "", "JAV doleriai (kitą dieną)", "JAV dolerio (kitą dieną)", "JAV dolerių (kitą dieną)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "JAV doleris (šios dienos)", PluralForms{"", "JAV doleris (tą pačią dieną)", "", "JAV doleriai (tą pačią dieną)", "JAV dolerio (tą pačią dieną)", "JAV dolerių (tą pačią dieną)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Urugvajaus pesai en unidades indexadas", PluralForms{"", "Urugvajaus pesas en unidades indexadas", "", "Uragvajaus pesai en unidades indexadas", "Urugvajaus pesai en unidades indexadas", "Urugvajaus pesai en unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Urugvajaus pesas (1975–1993)", PluralForms{"", "Urugvajaus pesas (1975–1993)", "", "Urugvajaus pesai (1975–1993)", "Urugvajaus peso (1975–1993)", "Urugvajaus pesų (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Urugvajaus pesas", PluralForms{"", "Urugvajaus pesas", "", "Urugvajaus pesai", "Urugvajaus peso", "Urugvajaus pesų"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Uzbekistano sumas", PluralForms{"", "Uzbekistano sumas", "", "Uzbekistano sumai", "Uzbekistano sumo", "Uzbekistano sumų"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venesuelos bolivaras (1871–2008)", PluralForms{"", "Venesuelos bolivaras (1871–2008)", "", "Venesuelos bolivarai (1871–2008)", "Venesuelos bolivaro (1871–2008)", "Venesuelos bolivarų (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venesuelos bolivaras (2008–2018)", PluralForms{"", "Venesuelos bolivaras (2008–2018)", "", "Venesuelos bolivarai (2008–2018)", "Venesuelos bolivaro (2008–2018)", "Venesuelos bolivarų (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venesuelos bolivaras", PluralForms{"", "Venesuelos bolivaras", "", "Venesuelos bolivarai", "Venesuelos bolivaro", "Venesuelos bolivarų"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "VND", "Vietnamo dongas", PluralForms{"", "Vietnamo dongas", "", "Vietnamo dongai", "Vietnamo dongo", "Vietnamo dongų"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vietnamo dongas (1978–1985)", PluralForms{"", "Vietnamo dongas (1978–1985)", "", "Vietnamo dongai (1978–1985)", "Vietnamo dongo (1978–1985)", "Vietnamo dongų (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu vatas", PluralForms{"", "Vanuatu vatas", "", "Vanuatu vatai", "Vanuatu vato", "Vanuatu vatų"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoa tala", PluralForms{"", "Samoa tala", "", "Samoa talos", "Samoa talos", "Samoa talų"}},
+		"XAF": {0, 0, 0, 1, "XAF", "XAF", "XAF", "CFA BEAC frankas", PluralForms{"", "CFA BEAC frankas", "", "CFA BEAC frankai", "CFA BEAC franko", "CFA BEAC frankų"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Sidabras", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Auksas", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Europos suvestinės vienetas", PluralForms{"", "Europos suvestinės vienetas", "", "Europos suvestinės vienetai", "Europos suvestinės vienetai", "Europos suvestinės vienetai"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Europos piniginis vienetas", PluralForms{"", "Europos piniginis vienetas", "", "Europos piniginiai vienetai", "Europos piniginiai vienetai", "Europos piniginiai vienetai"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Europos valiutos / apskaitos vienetas (XBC)", PluralForms{"", "Europos valiutos / apskaitos vienetas (XBC)", "", "Europos valiutos / apskaitos vienetai (XBC)", "Europos valiutos / apskaitos vienetai (XBC)", "Europos valiutos / apskaitos vienetai (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Europos valiutos / apskaitos vienetas (XBD)", PluralForms{"", "Europos valiutos / apskaitos vienetas (XBD)", "", "Europos valiutos / apskaitos vienetas (XBD)", "Europos valiutos / apskaitos vienetai (XBD)", "Europos valiutos / apskaitos vienetai (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "Rytų Karibų doleris", PluralForms{"", "Rytų Karibų doleris", "", "Rytų Karibų doleriai", "Rytų Karibų dolerio", "Rytų Karibų dolerių"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "SDR tarptautinis valiutos fondas", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Europos piniginis vienetas (1993–1999)", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Aukso frankas", PluralForms{"", "Aukso frankas", "", "Aukso frankai", "Aukso franko", "Aukso frankų"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Prancūzijos UIC - frankas", PluralForms{"", "Prancūzijos UIC - frankas", "", "Prancūzijos UIC - frankai", "Prancūzijos UIC - franko", "Prancūzijos UIC - frankų"}},
+		"XOF": {0, 0, 0, 1, "XOF", "XOF", "XOF", "CFA BCEAO frankas", PluralForms{"", "CFA BCEAO frankas", "", "CFA BCEAO frankai", "CFA BCEAO franko", "CFA BCEAO frankų"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paladis", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "XPF", "XPF", "CFP frankas", PluralForms{"", "CFP frankas", "", "CFP frankai", "CFP franko", "CFP frankų"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platina", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET fondai", PluralForms{"", "RINET fondas", "", "RINET fondai", "RINET fondai", "RINET fondai"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "Sukrė", PluralForms{"", "Sukrė", "", "Sukrės", "Sukrės", "Sukrių"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Tikrinamas valiutos kodas", PluralForms{"", "", "", "", "", ""}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "Azijos plėtros banko apskaitos vienetas", PluralForms{"", "Azijos plėtros banko apskaitos vienetas", "", "Azijos plėtros banko apskaitos vienetai", "Azijos plėtros banko apskaitos vieneto", "Azijos plėtros banko apskaitos vienetų"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "nežinoma valiuta", PluralForms{"", "(nežinoma valiuta)", "", "(nežinoma valiuta)", "(nežinoma valiuta)", "(nežinoma valiuta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jemeno dinaras", PluralForms{"", "Jemeno dinaras", "", "Jemeno dinarai", "Jemeno dinaro", "Jemeno dinarų"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jemeno rialas", PluralForms{"", "Jemeno rialas", "", "Jemeno rialai", "Jemeno rialo", "Jemeno rialų"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslavijos kietasis dinaras (1966–1990)", PluralForms{"", "Jugoslavijos kietasis dinaras (1966–1990)", "", "Jugoslavijos kietieji dinarai (1966–1990)", "Jugoslavijos kietiejo dinaro (1966–1990)", "Jugoslavijos kietiejų dinarų (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslavijos naujasis dinaras (1994–2002)", PluralForms{"", "Jugoslavijos naujasis dinaras (1994–2002)", "", "Jugoslavijos naujieji dinarai (1994–2002)", "Jugoslavijos naujojo dinaro (1994–2002)", "Jugoslavijos naujųjų dinarų (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslavijos konvertuojamas dinaras (1990–1992)", PluralForms{"", "Jugoslavijos konvertuojamas dinaras (1990–1992)", "", "Jugoslavijos konvertuojami dinarai (1990–1992)", "Jugoslavijos konvertuojamo dinaro (1990–1992)", "Jugoslavijos konvertuojamų dinarų (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Jugoslavijos reformuotas dinaras (1992–1993)", PluralForms{"", "Jugoslavijos reformuotas dinaras (1992–1993)", "", "Jugoslavijos reformuoti dinarai (1992–1993)", "Jugoslavijos reformuoto dinaro (1992–1993)", "Jugoslavijos reformuotų dinarų (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Pietų Afrikos finansinis randas", PluralForms{"", "Pietų Afrikos randas (finansinis)", "", "Pietų Afrikos randai (finansinis)", "Pietų Afrikos rando (finansinis)", "Pietų Afrikos randų (finansinis)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Pietų Afrikos Respublikos randas", PluralForms{"", "Pietų Afrikos Respublikos randas", "", "Pietų Afrikos Respublikos randai", "Pietų Afrikos Respublikos rando", "Pietų Afrikos Respublikos randų"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambijos kvača (1968–2012)", PluralForms{"", "Zambijos kvača (1968–2012)", "", "Zambijos kvačos (1968–2012)", "Zambijos kvačos (1968–2012)", "Zambijos kvačų (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambijos kvača", PluralForms{"", "Zambijos kvača", "", "Zambijos kvačos", "Zambijos kvačos", "Zambijos kvačų"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zairo naujasis zairas (1993–1998)", PluralForms{"", "Zairo naujasis zairas (1993–1998)", "", "Zairo naujieji zairai (1993–1998)", "Zairo naujojo zairo (1993–1998)", "Zairo naujųjų zairų (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zairo zairas (1971–1993)", PluralForms{"", "Zairo zairas (1971–1993)", "", "Zairo zairai (1971–1993)", "Zairo zairo (1971–1993)", "Zairo zairų (1971–1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabvės doleris (1980–2008)", PluralForms{"", "Zimbabvės doleris (1980–2008)", "", "Zimbabvės doleriai (1980–2008)", "Zimbabvės dolerio (1980–2008)", "Zimbabvės dolerių (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabvės doleris (2009)", PluralForms{"", "Zimbabvės doleris (2009)", "", "Zimbabvės doleriai (2009)", "Zimbabvės dolerio (2009)", "Zimbabvės dolerių (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabvės doleris (2008)", PluralForms{"", "Zimbabvės doleris (2008)", "", "Zimbabvės doleriai (2008)", "Zimbabvės dolerio (2008)", "Zimbabvės dolerių (2008)"}},
 	},
 }
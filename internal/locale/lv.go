@@ -6,220 +6,273 @@ var lv = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		2,
+		"∞", "NS",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tūkst.", "other": "0\u00a0tūkst."}},
+				{4, 2, map[string]string{"one": "0\u00a0tūkst.", "other": "0\u00a0tūkst."}},
+				{5, 3, map[string]string{"one": "0\u00a0tūkst.", "other": "0\u00a0tūkst."}},
+				{6, 1, map[string]string{"one": "0\u00a0milj.", "other": "0\u00a0milj."}},
+				{7, 2, map[string]string{"one": "0\u00a0milj.", "other": "0\u00a0milj."}},
+				{8, 3, map[string]string{"one": "0\u00a0milj.", "other": "0\u00a0milj."}},
+				{9, 1, map[string]string{"one": "0\u00a0mljrd.", "other": "0\u00a0mljrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mljrd.", "other": "0\u00a0mljrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mljrd.", "other": "0\u00a0mljrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0trilj.", "other": "0\u00a0trilj."}},
+				{13, 2, map[string]string{"one": "0\u00a0trilj.", "other": "0\u00a0trilj."}},
+				{14, 3, map[string]string{"one": "0\u00a0trilj.", "other": "0\u00a0trilj."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 tūkstotis", "other": "0 tūkstoši", "zero": "0 tūkstošu"}},
+				{4, 2, map[string]string{"one": "0 tūkstotis", "other": "0 tūkstoši", "zero": "0 tūkstoši"}},
+				{5, 3, map[string]string{"one": "0 tūkstotis", "other": "0 tūkstoši", "zero": "0 tūkstoši"}},
+				{6, 1, map[string]string{"one": "0 miljons", "other": "0 miljoni", "zero": "0 miljonu"}},
+				{7, 2, map[string]string{"one": "0 miljons", "other": "0 miljoni", "zero": "0 miljoni"}},
+				{8, 3, map[string]string{"one": "0 miljons", "other": "0 miljoni", "zero": "0 miljoni"}},
+				{9, 1, map[string]string{"one": "0 miljards", "other": "0 miljardi", "zero": "0 miljardu"}},
+				{10, 2, map[string]string{"one": "0 miljards", "other": "0 miljardi", "zero": "0 miljardi"}},
+				{11, 3, map[string]string{"one": "0 miljards", "other": "0 miljardi", "zero": "0 miljardi"}},
+				{12, 1, map[string]string{"one": "0 triljons", "other": "0 triljoni", "zero": "0 triljonu"}},
+				{13, 2, map[string]string{"one": "0 triljons", "other": "0 triljoni", "zero": "0 triljoni"}},
+				{14, 3, map[string]string{"one": "0 triljons", "other": "0 triljoni", "zero": "0 triljoni"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tūkst.\u00a0¤", "other": "0\u00a0tūkst.\u00a0¤", "zero": "0\u00a0tūkst.\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0tūkst.\u00a0¤", "other": "0\u00a0tūkst.\u00a0¤", "zero": "0\u00a0tūkst.\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0tūkst.\u00a0¤", "other": "0\u00a0tūkst.\u00a0¤", "zero": "0\u00a0tūkst.\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0milj.\u00a0¤", "other": "0\u00a0milj.\u00a0¤", "zero": "0\u00a0milj.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0milj.\u00a0¤", "other": "0\u00a0milj.\u00a0¤", "zero": "0\u00a0milj.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0milj.\u00a0¤", "other": "0\u00a0milj.\u00a0¤", "zero": "0\u00a0milj.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mljrd.\u00a0¤", "other": "0\u00a0mljrd.\u00a0¤", "zero": "0\u00a0mljrd.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mljrd.\u00a0¤", "other": "0\u00a0mljrd.\u00a0¤", "zero": "0\u00a0mljrd.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mljrd.\u00a0¤", "other": "0\u00a0mljrd.\u00a0¤", "zero": "0\u00a0mljrd.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0trilj.\u00a0¤", "other": "0\u00a0trilj.\u00a0¤", "zero": "0\u00a0trilj.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0trilj.\u00a0¤", "other": "0\u00a0trilj.\u00a0¤", "zero": "0\u00a0trilj.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0trilj.\u00a0¤", "other": "0\u00a0trilj.\u00a0¤", "zero": "0\u00a0trilj.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GHS"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "Ls", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Apvienoto Arābu Emirātu dirhēms", PluralForms{"AAE dirhēmi", "AAE dirhēms", "", "", "", "AAE dirhēmi"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afganistānas afgāns", PluralForms{"Afganistānas afgāni", "Afganistānas afgāns", "", "", "", "Afganistānas afgāni"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albānijas leks", PluralForms{"Albānijas leki", "Albānijas leks", "", "", "", "Albānijas leki"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armēnijas drams", PluralForms{"Armēnijas drami", "Armēnijas drams", "", "", "", "Armēnijas drami"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Nīderlandes Antiļu guldenis", PluralForms{"Nīderlandes Antiļu guldeņi", "Nīderlandes Antiļu guldenis", "", "", "", "Nīderlandes Antiļu guldeņi"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angolas kvanza", PluralForms{"Angolas kvanzas", "Angolas kvanza", "", "", "", "Angolas kvanzas"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentīnas peso", PluralForms{"", "", "", "", "", ""}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austrijas šiliņš", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "Austrālijas dolārs", PluralForms{"Austrālijas dolāri", "Austrālijas dolārs", "", "", "", "Austrālijas dolāri"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Arubas guldenis", PluralForms{"Arubas guldeņi", "Arubas guldenis", "", "", "", "Arubas guldeņi"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azerbaidžānas manats (1993–2006)", PluralForms{"Azerbaidžānas manati (1993–2006)", "Azerbaidžānas manats (1993–2006)", "", "", "", "Azerbaidžānas manati (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbaidžānas manats", PluralForms{"Azerbaidžānas manati", "Azerbaidžānas manats", "", "", "", "Azerbaidžānas manati"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bosnijas un Hercogovinas konvertējamā marka", PluralForms{"Bosnijas un Hercogovinas konvertējamās markas", "Bosnijas un Hercogovinas konvertējamā marka", "", "", "", "Bosnijas un Hercogovinas konvertējamās markas"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbadosas dolārs", PluralForms{"Barbadosas dolāri", "Barbadosas dolārs", "", "", "", "Barbadosas dolāri"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladešas taka", PluralForms{"Bangladešas takas", "Bangladešas taka", "", "", "", "Bangladešas takas"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Beļģijas franks", PluralForms{"Beļģijas franki", "Beļģijas franks", "", "", "", "Beļģijas franki"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bulgārijas leva", PluralForms{"Bulgārijas levas", "Bulgārijas leva", "", "", "", "Bulgārijas levas"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahreinas dinārs", PluralForms{"Bahreinas dināri", "Bahreinas dinārs", "", "", "", "Bahreinas dināri"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundi franks", PluralForms{"Burundi franki", "Burundi franks", "", "", "", "Burundi franki"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermudu dolārs", PluralForms{"Bermudu dolāri", "Bermudu dolārs", "", "", "", "Bermudu dolāri"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunejas dolārs", PluralForms{"Brunejas dolāri", "Brunejas dolārs", "", "", "", "Brunejas dolāri"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolīvijas boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brazīlijas reāls", PluralForms{"Brazīlijas reāli", "Brazīlijas reāls", "", "", "", "Brazīlijas reāli"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahamu dolārs", PluralForms{"Bahamu dolāri", "Bahamu dolārs", "", "", "", "Bahamu dolāri"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Butānas ngultrums", PluralForms{"Butānas ngultrumi", "Butānas ngultrums", "", "", "", "Butānas ngultrumi"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botsvanas pula", PluralForms{"Botsvanas pulas", "Botsvanas pula", "", "", "", "Botsvanas pulas"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Baltkrievijas rubelis", PluralForms{"Baltkrievijas rubeļi", "Baltkrievijas rubelis", "", "", "", "Baltkrievijas rubeļi"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Baltkrievijas rubelis (2000–2016)", PluralForms{"Baltkrievijas rubeļi (2000–2016)", "Baltkrievijas rubelis (2000–2016)", "", "", "", "Baltkrievijas rubeļi (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Belizas dolārs", PluralForms{"Belizas dolāri", "Belizas dolārs", "", "", "", "Belizas dolāri"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Kanādas dolārs", PluralForms{"Kanādas dolāri", "Kanādas dolārs", "", "", "", "Kanādas dolāri"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "KDR franks", PluralForms{"KDR franki", "KDR franks", "", "", "", "KDR franki"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Šveices franks", PluralForms{"Šveices franki", "Šveices franks", "", "", "", "Šveices franki"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Čīles peso", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Ķīnas juaņa (ofšors)", PluralForms{"Ķīnas juaņa (ofšors)", "Ķīnas juaņa (ofšors)", "", "", "", "Ķīnas juaņas (ofšors)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Ķīnas juaņs", PluralForms{"Ķīnas juaņi", "Ķīnas juaņs", "", "", "", "Ķīnas juaņi"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolumbijas peso", PluralForms{"", "", "", "", "", ""}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Kolumbijas reāls", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kostarikas kolons", PluralForms{"Kostarikas koloni", "Kostarikas kolons", "", "", "", "Kostarikas koloni"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kubas konvertējamais peso", PluralForms{"Kubas konvertējamie peso", "Kubas konvertējamais peso", "", "", "", "Kubas konvertējamie peso"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kubas peso", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kaboverdes eskudo", PluralForms{"", "", "", "", "", ""}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kipras mārciņa", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Čehijas krona", PluralForms{"Čehijas kronas", "Čehijas krona", "", "", "", "Čehijas kronas"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Vācijas marka", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Džibutijas franks", PluralForms{"Džibutijas franki", "Džibutijas franks", "", "", "", "Džibutijas franki"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Dānijas krona", PluralForms{"Dānijas kronas", "Dānijas krona", "", "", "", "Dānijas kronas"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikānas peso", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Alžīrijas dinārs", PluralForms{"Alžīrijas dināri", "Alžīrijas dinārs", "", "", "", "Alžīrijas dināri"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Igaunijas krona", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Ēģiptes mārciņa", PluralForms{"Ēģiptes mārciņas", "Ēģiptes mārciņa", "", "", "", "Ēģiptes mārciņas"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritrejas nakfa", PluralForms{"Eritrejas nakfas", "Eritrejas nakfa", "", "", "", "Eritrejas nakfas"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Spānijas peseta", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Etiopijas birs", PluralForms{"Etiopijas biri", "Etiopijas birs", "", "", "", "Etiopijas biri"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "eiro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Somijas marka", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fidži dolārs", PluralForms{"Fidži dolāri", "Fidži dolārs", "", "", "", "Fidži dolāri"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Folklenda Salu mārciņa", PluralForms{"Folklenda Salu mārciņas", "Folklenda Salu mārciņa", "", "", "", "Folklenda Salu mārciņas"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Francijas franks", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Lielbritānijas mārciņa", PluralForms{"Lielbritānijas mārciņas", "Lielbritānijas mārciņa", "", "", "", "Lielbritānijas mārciņas"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gruzijas lari", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GHS", "Ganas sedi", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltāra mārciņa", PluralForms{"Gibraltāra mārciņas", "Gibraltāra mārciņa", "", "", "", "Gibraltāra mārciņas"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambijas dalasi", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gvinejas franks", PluralForms{"Gvinejas franki", "Gvinejas franks", "", "", "", "Gvinejas franki"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Grieķijas drahma", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Gvatemalas ketsals", PluralForms{"Gvatemalas ketsali", "Gvatemalas ketsals", "", "", "", "Gvatemalas ketsali"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Gajānas dolārs", PluralForms{"Gajānas dolāri", "Gajānas dolārs", "", "", "", "Gajānas dolāri"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Honkongas dolārs", PluralForms{"Honkongas dolāri", "Honkongas dolārs", "", "", "", "Honkongas dolāri"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Hondurasas lempīra", PluralForms{"Hondurasas lempīras", "Hondurasas lempīra", "", "", "", "Hondurasas lempīras"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Horvātijas kuna", PluralForms{"Horvātijas kunas", "Horvātijas kuna", "", "", "", "Horvātijas kunas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haiti gurds", PluralForms{"Haiti gurdi", "Haiti gurds", "", "", "", "Haiti gurdi"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Ungārijas forints", PluralForms{"Ungārijas forinti", "Ungārijas forints", "", "", "", "Ungārijas forinti"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indonēzijas rūpija", PluralForms{"Indonēzijas rūpijas", "Indonēzijas rūpija", "", "", "", "Indonēzijas rūpijas"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Īrijas mārciņa", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Izraēlas šekelis", PluralForms{"Izraēlas šekeļi", "Izraēlas šekelis", "", "", "", "Izraēlas šekeļi"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Indijas rūpija", PluralForms{"Indijas rūpijas", "Indijas rūpija", "", "", "", "Indijas rūpijas"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irākas dinārs", PluralForms{"Irākas dināri", "Irākas dinārs", "", "", "", "Irākas dināri"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Irānas riāls", PluralForms{"Irānas riāli", "Irānas riāls", "", "", "", "Irānas riāli"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Islandes krona", PluralForms{"Islandes kronas", "Islandes krona", "", "", "", "Islandes kronas"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Itālijas lira", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaikas dolārs", PluralForms{"Jamaikas dolāri", "Jamaikas dolārs", "", "", "", "Jamaikas dolāri"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jordānas dinārs", PluralForms{"Jordānas dināri", "Jordānas dinārs", "", "", "", "Jordānas dināri"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Japānas jena", PluralForms{"Japānas jenas", "Japānas jena", "", "", "", "Japānas jenas"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kenijas šiliņš", PluralForms{"Kenijas šiliņi", "Kenijas šiliņš", "", "", "", "Kenijas šiliņi"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kirgizstānas soms", PluralForms{"Kirgizstānas somi", "Kirgizstānas soms", "", "", "", "Kirgizstānas somi"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambodžas riels", PluralForms{"Kambodžas rieli", "Kambodžas riels", "", "", "", "Kambodžas rieli"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komoru franks", PluralForms{"Komoru franki", "Komoru franks", "", "", "", "Komoru franki"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Ziemeļkorejas vona", PluralForms{"Ziemeļkorejas vonas", "Ziemeļkorejas vona", "", "", "", "Ziemeļkorejas vonas"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Dienvidkorejas vona", PluralForms{"Dienvidkorejas vonas", "Dienvidkorejas vona", "", "", "", "Dienvidkorejas vonas"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuveitas dinārs", PluralForms{"Kuveitas dināri", "Kuveitas dinārs", "", "", "", "Kuveitas dināri"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kaimanu salu dolārs", PluralForms{"Kaimanu salu dolāri", "Kaimanu salu dolārs", "", "", "", "Kaimanu salu dolāri"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kazahstānas tenge", PluralForms{"Kazahstānas tenges", "Kazahstānas tenge", "", "", "", "Kazahstānas tenges"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laosas kips", PluralForms{"Laosas kipi", "Laosas kips", "", "", "", "Laosas kipi"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Libānas mārciņa", PluralForms{"Libānas mārciņas", "Libānas mārciņa", "", "", "", "Libānas mārciņas"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Šrilankas rūpija", PluralForms{"Šrilankas rūpijas", "Šrilankas rūpija", "", "", "", "Šrilankas rūpijas"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Libērijas dolārs", PluralForms{"Libērijas dolāri", "Libērijas dolārs", "", "", "", "Libērijas dolāri"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesoto loti", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Lietuvas lits", PluralForms{"Lietuvas liti", "Lietuvas lits", "", "", "", "Lietuvas liti"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luksemburgas franks", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "Ls", "Ls", "Latvijas lats", PluralForms{"Latvijas lati", "Latvijas lats", "", "", "", "Latvijas lati"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latvijas rublis", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Lībijas dinārs", PluralForms{"Lībijas dināri", "Lībijas dinārs", "", "", "", "Lībijas dināri"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Marokas dirhēms", PluralForms{"Marokas dirhēmi", "Marokas dirhēms", "", "", "", "Marokas dirhēmi"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldovas leja", PluralForms{"Moldovas lejas", "Moldovas leja", "", "", "", "Moldovas lejas"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Madagaskaras ariari", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Maķedonijas denārs", PluralForms{"Maķedonijas denāri", "Maķedonijas denārs", "", "", "", "Maķedonijas denāri"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "CFA (Āfrikas) franks", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Mjanmas kjats", PluralForms{"Mjanmas kjati", "Mjanmas kjats", "", "", "", "Mjanmas kjati"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongolijas tugriks", PluralForms{"Mongolijas tugriki", "Mongolijas tugriks", "", "", "", "Mongolijas tugriki"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makao pataka", PluralForms{"Makao patakas", "Makao pataka", "", "", "", "Makao patakas"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritānijas ugija (1973–2017)", PluralForms{"Mauritānijas ugijas (1973–2017)", "Mauritānijas ugija (1973–2017)", "", "", "", "Mauritānijas ugijas (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mauritānijas ugija", PluralForms{"Mauritānijas ugijas", "Mauritānijas ugija", "", "", "", "Mauritānijas ugijas"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Maltas lira", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Maltas mārciņa", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Maurīcijas rūpija", PluralForms{"Maurīcijas rūpijas", "Maurīcijas rūpija", "", "", "", "Maurīcijas rūpijas"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldīvijas rūfija", PluralForms{"Maldīvijas rūfijas", "Maldīvijas rūfija", "", "", "", "Maldīvijas rūfijas"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malāvijas kvača", PluralForms{"Malāvijas kvačas", "Malāvijas kvača", "", "", "", "Malāvijas kvačas"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Meksikas peso", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaizijas ringits", PluralForms{"Malaizijas ringiti", "Malaizijas ringits", "", "", "", "Malaizijas ringiti"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambikas eskudo", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Mozambikas vecais metikals", PluralForms{"Mozambikas vecie metikali", "Mozambikas vecais metikals", "", "", "", "Mozambikas vecie metikali"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambikas metikals", PluralForms{"Mozambikas metikali", "Mozambikas metikals", "", "", "", "Mozambikas metikali"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namībijas dolārs", PluralForms{"Namībijas dolāri", "Namībijas dolārs", "", "", "", "Namībijas dolāri"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigērijas naira", PluralForms{"Nigērijas nairas", "Nigērijas naira", "", "", "", "Nigērijas nairas"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikaragvas kordoba", PluralForms{"Nikaragvas kordobas", "Nikaragvas kordoba", "", "", "", "Nikaragvas kordobas"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Nīderlandes guldenis", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norvēģijas krona", PluralForms{"Norvēģijas kronas", "Norvēģijas krona", "", "", "", "Norvēģijas kronas"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepālas rūpija", PluralForms{"Nepālas rūpijas", "Nepālas rūpija", "", "", "", "Nepālas rūpijas"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Jaunzēlandes dolārs", PluralForms{"Jaunzēlandes dolāri", "Jaunzēlandes dolārs", "", "", "", "Jaunzēlandes dolāri"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omānas riāls", PluralForms{"Omānas riāli", "Omānas riāls", "", "", "", "Omānas riāli"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panamas balboa", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peru sols", PluralForms{"Peru soli", "Peru sols", "", "", "", "Peru soli"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua-Jaungvinejas kina", PluralForms{"Papua-Jaungvinejas kinas", "Papua-Jaungvinejas kina", "", "", "", "Papua-Jaungvinejas kinas"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filipīnu peso", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakistānas rūpija", PluralForms{"Pakistānas rūpijas", "Pakistānas rūpija", "", "", "", "Pakistānas rūpijas"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Polijas zlots", PluralForms{"Polijas zloti", "Polijas zlots", "", "", "", "Polijas zloti"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugāles eskudo", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paragvajas guarani", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Kataras riāls", PluralForms{"Kataras riāli", "Kataras riāls", "", "", "", "Kataras riāli"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumānijas vecā leja", PluralForms{"Rumānijas vecās levas", "Rumānijas vecā leva", "", "", "", "Rumānijas vecās levas"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Rumānijas leja", PluralForms{"Rumānijas lejas", "Rumānijas leja", "", "", "", "Rumānijas lejas"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serbijas dinārs", PluralForms{"Serbijas dināri", "Serbijas dinārs", "", "", "", "Serbijas dināri"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Krievijas rublis", PluralForms{"Krievijas rubļi", "Krievijas rublis", "", "", "", "Krievijas rubļi"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ruandas franks", PluralForms{"Ruandas franki", "Ruandas franks", "", "", "", "Ruandas franki"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saūda Arābijas riāls", PluralForms{"Saūda riāli", "Saūda riāls", "", "", "", "Saūda riāli"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Zālamana Salu dolārs", PluralForms{"Zālamana Salu dolāri", "Zālamana Salu dolārs", "", "", "", "Zālamana Salu dolāri"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seišelu salu rūpija", PluralForms{"Seišelu salu rūpijas", "Seišelu salu rūpija", "", "", "", "Seišelu salu rūpijas"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudānas mārciņa", PluralForms{"Sudānas mārciņas", "Sudānas mārciņa", "", "", "", "Sudānas mārciņas"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Zviedrijas krona", PluralForms{"Zviedrijas kronas", "Zviedrijas krona", "", "", "", "Zviedrijas kronas"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapūras dolārs", PluralForms{"Singapūras dolāri", "Singapūras dolārs", "", "", "", "Singapūras dolāri"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Sv.Helēnas salas mārciņa", PluralForms{"Sv.Helēnas salas mārciņas", "Sv.Helēnas salas mārciņa", "", "", "", "Sv.Helēnas salas mārciņas"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovēnijas tolars", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovakijas krona", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sjerraleones leone", PluralForms{"Sjerraleones leones", "Sjerraleones leone", "", "", "", "Sjerraleones leones"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sjerraleones leone (1964—2022)", PluralForms{"Sjerraleones leones (1964—2022)", "Sjerraleones leone (1964—2022)", "", "", "", "Sjerraleones leones (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somālijas šiliņš", PluralForms{"Somālijas šiliņi", "Somālijas šiliņš", "", "", "", "Somālijas šiliņi"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surinamas dolārs", PluralForms{"Surinamas dolāri", "Surinamas dolārs", "", "", "", "Surinamas dolāri"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surinamas guldenis", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Dienvidsudānas mārciņa", PluralForms{"Dienvidsudānas mārciņas", "Dienvidsudānas mārciņa", "", "", "", "Dienvidsudānas mārciņas"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Santome un Prinsipi dobra (1977–2017)", PluralForms{"Santome un Prinsipi dobras (1977–2017)", "Santome un Prinsipi dobra (1977–2017)", "", "", "", "Santome un Prinsipi dobras (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Santome un Prinsipi dobra", PluralForms{"Santome un Prinsipi dobras", "Santome un Prinsipi dobra", "", "", "", "Santome un Prinsipi dobras"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Salvadoras kolons", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Sīrijas mārciņa", PluralForms{"Sīrijas mārciņas", "Sīrijas mārciņa", "", "", "", "Sīrijas mārciņas"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Svazilendas lilangeni", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Taizemes bāts", PluralForms{"Taizemes bāti", "Taizemes bāts", "", "", "", "Taizemes bāti"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tadžikistānas somons", PluralForms{"Tadžikistānas somoni", "Tadžikistānas somons", "", "", "", "Tadžikistānas somoni"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmenistānas manats (1993–2009)", PluralForms{"Turkmenistānas manati (1993–2009)", "Turkmenistānas manats (1993–2009)", "", "", "", "Turkmenistānas manati (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Turkmenistānas manats", PluralForms{"Turkmenistānas manati", "Turkmenistānas manats", "", "", "", "Turkmenistānas manati"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunisijas dinārs", PluralForms{"Tunisijas dināri", "Tunisijas dinārs", "", "", "", "Tunisijas dināri"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tongas paanga", PluralForms{"Tongas paangas", "Tongas paanga", "", "", "", "Tongas paangas"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turcijas lira (1922–2005)", PluralForms{"Turcijas liras (1922–2005)", "Turcijas lira (1922–2005)", "", "", "", "Turcijas liras (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Turcijas lira", PluralForms{"Turcijas liras", "Turcijas lira", "", "", "", "Turcijas liras"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidādas un Tobāgo dolārs", PluralForms{"Trinidādas un Tobāgo dolāri", "Trinidādas un Tobāgo dolārs", "", "", "", "Trinidādas un Tobāgo dolāri"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Taivānas jaunais dolārs", PluralForms{"Taivānas jaunie dolāri", "Taivānas jaunais dolārs", "", "", "", "Taivānas jaunie dolāri"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzānijas šiliņš", PluralForms{"Tanzānijas šiliņi", "Tanzānijas šiliņš", "", "", "", "Tanzānijas šiliņi"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrainas grivna", PluralForms{"Ukrainas grivnas", "Ukrainas grivna", "", "", "", "Ukrainas grivnas"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ugandas šiliņš", PluralForms{"Ugandas šiliņi", "Ugandas šiliņš", "", "", "", "Ugandas šiliņi"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "ASV dolārs", PluralForms{"ASV dolāri", "ASV dolārs", "", "", "", "ASV dolāri"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Urugvajas peso", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Uzbekistānas sums", PluralForms{"Uzbekistānas sumi", "Uzbekistānas sums", "", "", "", "Uzbekistānas sumi"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venecuēlas bolivārs (1871–2008)", PluralForms{"Venecuēlas bolivāri (1871–2008)", "Venecuēlas bolivārs (1871–2008)", "", "", "", "Venecuēlas bolivāri (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venecuēlas bolivārs (2008–2018)", PluralForms{"Venecuēlas bolivāri (2008–2018)", "Venecuēlas bolivārs (2008–2018)", "", "", "", "Venecuēlas bolivāri (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Venecuēlas bolivārs", PluralForms{"Venecuēlas bolivāri", "Venecuēlas bolivārs", "", "", "", "Venecuēlas bolivāri"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Vjetnamas dongi", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuatu vatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoa tala", PluralForms{"Samoa talas", "Samoa tala", "", "", "", "Samoa talas"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Centrālāfrikas CFA franks", PluralForms{"Centrālāfrikas CFA franki", "Centrālāfrikas CFA franks", "", "", "", "Centrālāfrikas CFA franki"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "sudrabs", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "zelts", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Eiropas norēķinu vienība EURCO", PluralForms{"Eiropas norēķinu vienības EURCO", "Eiropas norēķinu vienība EURCO", "", "", "", "Eiropas norēķinu vienības EURCO"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Eiropas naudas vienība", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Eiropas norēķinu vienība (XBC)", PluralForms{"Eiropas norēķinu vienības (XBC)", "Eiropas norēķinu vienība (XBC)", "", "", "", "Eiropas norēķinu vienības (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Eiropas norēķinu vienība (XBD)", PluralForms{"Eiropas norēķinu vienības (XBD)", "Eiropas norēķinu vienība (XBD)", "", "", "", "Eiropas norēķinu vienības (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Austrumkarību dolārs", PluralForms{"Austrumkarību dolāri", "Austrumkarību dolārs", "", "", "", "Austrumkarību dolāri"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Speciālās aizņēmuma tiesības", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Eiropas norēķinu vienība", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Francijas zelta franks", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Francijas UIC franks", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Rietumāfrikas CFA franks", PluralForms{"Rietumāfrikas CFA franki", "Rietumāfrikas CFA franks", "", "", "", "Rietumāfrikas CFA franki"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "pallādijs", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP franks", PluralForms{"CFP franki", "CFP franks", "", "", "", "CFP franki"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platīns", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Testa valūtas kods", PluralForms{"testa valūtas kods", "testa valūtas kods", "", "", "", "testa valūtas kods"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Nezināma valūta", PluralForms{"(nezināma valūta)", "(nezināma valūta)", "", "", "", "(nezināma valūta)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Jemenas riāls", PluralForms{"Jemenas riāli", "Jemenas riāls", "", "", "", "Jemenas riāli"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Dienvidāfrikas rends", PluralForms{"Dienvidāfrikas rendi", "Dienvidāfrikas rends", "", "", "", "Dienvidāfrikas rendi"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambijas kvača (1968–2012)", PluralForms{"Zambijas kvačas (1968–2012)", "Zambijas kvača (1968–2012)", "", "", "", "Zambijas kvačas (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambijas kvača", PluralForms{"Zambijas kvačas", "Zambijas kvača", "", "", "", "Zambijas kvačas"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabves dolārs", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabves dolārs (2009)", PluralForms{"Zimbabves dollāri (2009)", "Zimbabves dollārs (2009)", "", "", "", "Zimbabves dollāri (2009)"}},
 	},
 }
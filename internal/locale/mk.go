@@ -6,240 +6,293 @@ var mk = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0илј.", "other": "0\u00a0илј."}},
+				{4, 2, map[string]string{"one": "0\u00a0илј.", "other": "0\u00a0илј."}},
+				{5, 3, map[string]string{"one": "0\u00a0илј.", "other": "0\u00a0илј."}},
+				{6, 1, map[string]string{"one": "0\u00a0мил.", "other": "0\u00a0мил."}},
+				{7, 2, map[string]string{"one": "0\u00a0мил.", "other": "0\u00a0мил."}},
+				{8, 3, map[string]string{"one": "0\u00a0М", "other": "0\u00a0М"}},
+				{9, 1, map[string]string{"one": "0\u00a0милј.", "other": "0\u00a0милј."}},
+				{10, 2, map[string]string{"one": "0\u00a0милј.", "other": "0\u00a0милј."}},
+				{11, 3, map[string]string{"one": "0\u00a0мј.", "other": "0\u00a0ми."}},
+				{12, 1, map[string]string{"one": "0\u00a0бил.", "other": "0\u00a0бил."}},
+				{13, 2, map[string]string{"one": "0\u00a0бил.", "other": "0\u00a0бил."}},
+				{14, 3, map[string]string{"one": "0\u00a0бил.", "other": "0\u00a0бил."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 илјада", "other": "0 илјади"}},
+				{4, 2, map[string]string{"one": "0 илјада", "other": "0 илјади"}},
+				{5, 3, map[string]string{"one": "0 илјада", "other": "0 илјади"}},
+				{6, 1, map[string]string{"one": "0 милион", "other": "0 милиони"}},
+				{7, 2, map[string]string{"one": "0 милион", "other": "0 милиони"}},
+				{8, 3, map[string]string{"one": "0 милион", "other": "0 милиони"}},
+				{9, 1, map[string]string{"one": "0 милијарда", "other": "0 милијарди"}},
+				{10, 2, map[string]string{"one": "0 милијарда", "other": "0 милијарди"}},
+				{11, 3, map[string]string{"one": "0 милијарда", "other": "0 милијарди"}},
+				{12, 1, map[string]string{"one": "0 билион", "other": "0 билиони"}},
+				{13, 2, map[string]string{"one": "0 билион", "other": "0 билиони"}},
+				{14, 3, map[string]string{"one": "0 билион", "other": "0 билиони"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0илј.\u00a0¤", "other": "0\u00a0илј.\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0илј.\u00a0¤", "other": "0\u00a0илј.\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0илј.\u00a0¤", "other": "0\u00a0илј.\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0мил.\u00a0¤", "other": "0\u00a0мил.\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0мил.\u00a0¤", "other": "0\u00a0мил.\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0мил.\u00a0¤", "other": "0\u00a0мил.\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0милј.\u00a0¤", "other": "0\u00a0милј.\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0милј.\u00a0¤", "other": "0\u00a0милј.\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0милј.\u00a0¤", "other": "0\u00a0милј.\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0бил.\u00a0¤", "other": "0\u00a0бил.\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0бил.\u00a0¤", "other": "0\u00a0бил.\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0бил.\u00a0¤", "other": "0\u00a0бил.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}\u2009–\u2009{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "ден.", "ден."},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Андорска Пезета", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Дирхам на Обединети Арапски Емирати", PluralForms{"", "Дирхам на Обединети Арапски Емирати", "", "", "", "Дирхами на Обединети Арапски Емирати"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Авгани (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Авганистански авгани", PluralForms{"", "Авгани", "", "", "", "Авгани"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Албански лек", PluralForms{"", "Албански лек", "", "", "", "Албански леки"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Ерменски драм", PluralForms{"", "Ерменски драм", "", "", "", "Ерменски драми"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Холандски антилски гилдер", PluralForms{"", "Холандски антилски гилдер", "", "", "", "Холандски антилски гилдери"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Анголска кванза", PluralForms{"", "Анголска кванза", "", "", "", "Анголски кванзи"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Анголска Кванза (1977–1990)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Анголска нова Кванза (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Аргентински Пезос (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Аргентински пезос", PluralForms{"", "Аргентински пезос", "", "", "", "Аргентински пезоси"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Австралиски Шилинг", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "Австралиски долар", PluralForms{"", "Австралиски долар", "", "", "", "Австралиски долари"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Арубиски флорин", PluralForms{"", "Арубиски флорин", "", "", "", "Арубиски флорини"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Азербејџански манат", PluralForms{"", "Азербејџански манат", "", "", "", "Азербејџански манати"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Босанско-Херцеговски Динар", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Босанско-херцеговска конвертибилна марка", PluralForms{"", "Босанско-херцеговска конвертибилна марка", "", "", "", "Босанско-херцеговски конвертибилни марки"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Барбадоски долар", PluralForms{"", "Барбадоски долар", "", "", "", "Барбадоски долари"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Бангладешка така", PluralForms{"", "Бангладешка така", "", "", "", "Бангладешки таки"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Белгиски Франк (конвертибилен)", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Белгиски Франк", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Белгиски Франк (финансиски)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Бугарски цврст лев", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Бугарски лев", PluralForms{"", "Бугарски лев", "", "", "", "Бугарски левови"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Бахреински динар", PluralForms{"", "Бахреински динар", "", "", "", "Бахреински динари"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Бурундиски франк", PluralForms{"", "Бурундиски франк", "", "", "", "Бурундиски франци"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Бермудски долар", PluralForms{"", "Бермудски долар", "", "", "", "Бермудски долари"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Брунејски долар", PluralForms{"", "Брунејски долар", "", "", "", "Брунејски долари"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Боливиски боливиано", PluralForms{"", "Боливиски боливиано", "", "", "", "Боливиски боливиани"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Бразилски реал", PluralForms{"", "Бразилски реал", "", "", "", "Бразилски реали"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Бахамски долар", PluralForms{"", "Бахамски долар", "", "", "", "Бахамски долари"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Бутански нгултрум", PluralForms{"", "Бутански нгултрум", "", "", "", "Бутански нгултруми"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Боцванска пула", PluralForms{"", "Боцванска пула", "", "", "", "Боцвански пули"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Белоруска нова рубља (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Белоруска рубља", PluralForms{"", "Белоруска рубља", "", "", "", "Белоруски рубљи"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Белоруска рубља (2000–2016)", PluralForms{"", "Белоруска рубља (2000–2016)", "", "", "", "Белоруски рубљи (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Белизиски долар", PluralForms{"", "Белизиски долар", "", "", "", "Белизиски долари"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Канадски долар", PluralForms{"", "Канадски долар", "", "", "", "Канадски долари"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Конголски франк", PluralForms{"", "Конголски франк", "", "", "", "Конголски франци"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Швајцарски франк", PluralForms{"", "Швајцарски франк", "", "", "", "Швајцарски франци"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Чилеански пезос", PluralForms{"", "Чилеански пезос", "", "", "", "Чилеански пезоси"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Кинески јуан (офшор)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "Кинески јуан", PluralForms{"", "Кинески јуан", "", "", "", "Кинески јуани"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Колумбиски пезос", PluralForms{"", "Колумбиски пезос", "", "", "", "Колумбиски пезоси"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Костарикански колон", PluralForms{"", "Костарикански колон", "", "", "", "Костарикански колони"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Чехословачка цврста корона", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Кубански конвертибилен пезос", PluralForms{"", "Кубански конвертибилен пезос", "", "", "", "Кубански конвертибилни пезоси"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Кубански пезос", PluralForms{"", "Кубански пезос", "", "", "", "Кубански пезоси"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Зелено’ртски ескудо", PluralForms{"", "Зелено’ртски ескудо", "", "", "", "Зелено’ртски ескуди"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Кипарска фунта", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Чешка круна", PluralForms{"", "Чешка круна", "", "", "", "Чешки круни"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Германска Марка", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Џибутски франк", PluralForms{"", "Џибутски франк", "", "", "", "Џибутски франци"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Данска круна", PluralForms{"", "Данска круна", "", "", "", "Дански круни"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Доминикански пезос", PluralForms{"", "Доминикански пезос", "", "", "", "Доминикански пезоси"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Алжирски динар", PluralForms{"", "Алжирски динар", "", "", "", "Алжирски динари"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Египетска фунта", PluralForms{"", "Египетска фунта", "", "", "", "Египетски фунти"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Еритрејска накфа", PluralForms{"", "Еритрејска накфа", "", "", "", "Еритрејски накфи"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Шпанска Пезета", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Етиописки бир", PluralForms{"", "Етиописки бир", "", "", "", "Етиописки бири"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Евро", PluralForms{"", "евро", "", "", "", "евра"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Финска марка", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Фиџиски долар", PluralForms{"", "Фиџиски долар", "", "", "", "Фиџиски долари"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Фолкландска фунта", PluralForms{"", "Фолкландска фунта", "", "", "", "Фолкландски фунти"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Француски франк", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "Британска фунта", PluralForms{"", "Британска фунта", "", "", "", "Британски фунти"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Грузиски лари", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ганајски Седи", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Гански седи", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Гибралтарска фунта", PluralForms{"", "Гибралтарска фунта", "", "", "", "Гибралтарски фунти"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Гамбиски даласи", PluralForms{"", "Гамбиски Даласи", "", "", "", "Гамбиски Даласи"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Гвинејски франк", PluralForms{"", "Гвинејски франк", "", "", "", "Гвинејски франци"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Грчка драхма", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Гватемалски кветцал", PluralForms{"", "Гватемалски кветцал", "", "", "", "Гватемалски кветцали"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Гвинејски Бисау пезос", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Гвајански долар", PluralForms{"", "Гвајански долар", "", "", "", "Гвајански долари"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "Хонгконшки долар", PluralForms{"", "Хонгконшки долар", "", "", "", "Хонгконшки долари"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Хондурска лемпира", PluralForms{"", "Хондурска лемпира", "", "", "", "Хондурски лемпири"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Хрватски динар", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Хрватска куна", PluralForms{"", "Хрватска куна", "", "", "", "Хрватски куни"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Хаитски гурд", PluralForms{"", "Хаитски гурд", "", "", "", "Хаитски гурди"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Унгарска форинта", PluralForms{"", "Унгарска форинта", "", "", "", "Унгарски форинти"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Индонезиска рупија", PluralForms{"", "Индонезиска рупија", "", "", "", "Индонезиски рупии"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Ирска фунта", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Изрелска фунта", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "Израелски нов шекел", PluralForms{"", "Израелски нов шекел", "", "", "", "Израелски нови шекели"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "Индиска рупија", PluralForms{"", "Индиска рупија", "", "", "", "Индиски рупии"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Ирачки динар", PluralForms{"", "Ирачки динар", "", "", "", "Ирачки динари"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Ирански риал", PluralForms{"", "Ирански риал", "", "", "", "Ирански риали"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Исландска крона", PluralForms{"", "Исландска крона", "", "", "", "Исландски крони"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Италијанска лира", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Јамајкански долар", PluralForms{"", "Јамајкански долар", "", "", "", "Јамајкански долари"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Јордански динар", PluralForms{"", "Јордански динар", "", "", "", "Јордански динари"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "Јапонски јен", PluralForms{"", "Јапонски јен", "", "", "", "Јапонски јени"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Кениски шилинг", PluralForms{"", "Кениски шилинг", "", "", "", "Кениски шилинзи"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Киргистански сом", PluralForms{"", "Киргистански сом", "", "", "", "Киргистански соми"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Камбоџиски рел", PluralForms{"", "Камбоџиски рел", "", "", "", "Камбоџиски рели"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Коморски франк", PluralForms{"", "Коморски франк", "", "", "", "Коморски франци"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Северно корејски вон", PluralForms{"", "Северно корејски вон", "", "", "", "Северно корејски вони"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "Јужно корејски вон", PluralForms{"", "Јужно корејски вон", "", "", "", "Јужно корејски вони"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Кувајтски динар", PluralForms{"", "Кувајтски динар", "", "", "", "Кувајтски динари"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Кајмански долар", PluralForms{"", "Кајмански долар", "", "", "", "Кајмански долари"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Казахстанска тенга", PluralForms{"", "Казахстанска тенга", "", "", "", "Казахстански тенги"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Лаоски кип", PluralForms{"", "Лаоски кип", "", "", "", "Лаоски кипови"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Либанска фунта", PluralForms{"", "Либанска фунта", "", "", "", "Либански фунти"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Шриланканска рупија", PluralForms{"", "Шриланканска рупија", "", "", "", "Шриланкански рупии"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Либериски долар", PluralForms{"", "Либериски долар", "", "", "", "Либериски долари"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Лесотско лоти", PluralForms{"", "Лесотски лоти", "", "", "", "Лесотски лоти"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Литваниска лита", PluralForms{"", "", "", "", "", ""}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Литваниски литаз", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Луксембуршки франк", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Латвијски лат", PluralForms{"", "", "", "", "", ""}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Латвијска рубља", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Либијски динар", PluralForms{"", "Либијски динар", "", "", "", "Либијски динари"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Марокански дирхам", PluralForms{"", "Марокански дирхам", "", "", "", "Марокански дирхами"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Марокански франк", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Молдавски леу", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Малагасиски ариари", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "ден.", "ден.", "Македонски денар", PluralForms{"", "Македонски денар", "", "", "", "Македонски денари"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Малски франк", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Мјанмарски киат", PluralForms{"", "Мјанмарски киат", "", "", "", "Мјанмарски киати"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Монголиски тугрик", PluralForms{"", "Монголиски тугрик", "", "", "", "Монголиски тугрици"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Макао патака", PluralForms{"", "Макао патака", "", "", "", "Макао патаки"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Мавританска угија (1973–2017)", PluralForms{"", "Мавританска угија (1973–2017)", "", "", "", "Мавритански угии (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Мавританска угија", PluralForms{"", "Мавританска угија", "", "", "", "Мавритански угии"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Малтешка лира", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Малтешка фунта", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Мавританска рупија", PluralForms{"", "Мавританска рупија", "", "", "", "Мавритански рупии"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Малдивска руфија", PluralForms{"", "Малдивска руфија", "", "", "", "Малдивски руфии"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Малависка квача", PluralForms{"", "Малависка квача", "", "", "", "Малависки квачи"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Мексикански пезос", PluralForms{"", "Мексикански пезос", "", "", "", "Мексикански пезоси"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Мексикански сребрен пезос (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Малезиски рингит", PluralForms{"", "Малезиски рингит", "", "", "", "Малезиски рингити"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Мозамбиско ескудо", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Стар мозамбиски метикал", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Мозамбиски метикал", PluralForms{"", "Мозамбиски метикал", "", "", "", "Мозамбиски метикали"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Намибиски долар", PluralForms{"", "Намибиски долар", "", "", "", "Намибиски долари"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Нигериска наира", PluralForms{"", "Нигериска наира", "", "", "", "Нигериски наири"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Никарагванска кордоба (1988–1991)", PluralForms{"", "Никарагванска кордоба (1988–1991)", "", "", "", "Никарагвански кордоби (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Никарагванска кордоба", PluralForms{"", "Никарагванска кордоба", "", "", "", "Никарагвански кордоби"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Холандски гилдер", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Норвешка круна", PluralForms{"", "Норвешка круна", "", "", "", "Норвешки круни"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Непалска рупија", PluralForms{"", "Непалска рупија", "", "", "", "Непалски рупии"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "Новозеландски долар", PluralForms{"", "Новозеландски долар", "", "", "", "Новозеландски долари"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Омански риал", PluralForms{"", "Омански риал", "", "", "", "Омански риали"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Панамска балбоа", PluralForms{"", "Панамска балбоа", "", "", "", "Панамски балбои"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Перуански сол", PluralForms{"", "Перуански сол", "", "", "", "Перуански соли"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Перуански сол (1863–1965)", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Папуа новогвинејска кина", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Филипински пезос", PluralForms{"", "Филипински пезос", "", "", "", "Филипински пезоси"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Пакистанска рупија", PluralForms{"", "Пакистанска рупија", "", "", "", "Пакистански рупии"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Полска злота", PluralForms{"", "Полска злота", "", "", "", "Полски злоти"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Полска злота (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Португалско ескудо", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Парагвајска гуарана", PluralForms{"", "Парагвајска гуарана", "", "", "", "Парагвајски гуарани"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Катарски риал", PluralForms{"", "Катарски риал", "", "", "", "Катарски риали"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Романска леи (1952–2006)", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Романски леу", PluralForms{"", "Романски леу", "", "", "", "Романски леи"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Српски динар", PluralForms{"", "Српски динар", "", "", "", "Српски динари"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Руска рубља", PluralForms{"", "Руска рубља", "", "", "", "Руски рубљи"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Руска рубља (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Руандски франк", PluralForms{"", "Руандски франк", "", "", "", "Руандски франци"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Саудиски ријал", PluralForms{"", "Саудиски ријал", "", "", "", "Саудиски ријали"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Соломонски долар", PluralForms{"", "Соломонски долар", "", "", "", "Соломонски долари"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Сејшелска рупија", PluralForms{"", "Сејшелска рупија", "", "", "", "Сејшелски рупии"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Стар судански динар", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Суданска фунта", PluralForms{"", "Суданска фунта", "", "", "", "Судански фунти"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Стара суданска фунта", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Шведска круна", PluralForms{"", "Шведска круна", "", "", "", "Шведски круни"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Сингапурски долар", PluralForms{"", "Сингапурски долар", "", "", "", "Сингапурски долари"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Фунта на Света Елена", PluralForms{"", "Фунта на Света Елена", "", "", "", "Фунти на Света Елена"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Словенечки толар", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Словачка круна", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Сиералеонско леоне", PluralForms{"", "Сиералеонско леоне", "", "", "", "Сиералеонски леони"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Сиералеонско леоне (1964 – 2022)", PluralForms{"", "Сиералеонско леоне (1964 – 2022)", "", "", "", "Сиералеонски леони (1964 – 2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Сомалијски шилинг", PluralForms{"", "Сомалијски шилинг", "", "", "", "Сомалијски шилинзи"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Суринамски долар", PluralForms{"", "Суринамски долар", "", "", "", "Суринамски долари"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Суринамски гилдер", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Јужносуданска фунта", PluralForms{"", "Јужносуданска фунта", "", "", "", "Јужносудански фунти"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Добра на Саун Томе и Принсип (1977–2017)", PluralForms{"", "Добра на Саун Томе и Принсип (1977–2017)", "", "", "", "Добри на Саун Томе и Принсип (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Добра на Сао Томе и Принсипе", PluralForms{"", "Добра на Сао Томе и Принсипе", "", "", "", "Добри на Сао Томе и Принсипе"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Советска рубља", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Салвадорски колон", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Сиријска фунта", PluralForms{"", "Сиријска фунта", "", "", "", "Сиријски фунти"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Свазилендски лиланген", PluralForms{"", "Свазилендски лиланген", "", "", "", "Свазилендски лилангени"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Таи бат", PluralForms{"", "", "", "", "", ""}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Таџикистанска рубља", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Таџикистански сомони", PluralForms{"", "", "", "", "", ""}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Туркменистански манат", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Туркменист. манат", PluralForms{"", "Туркменистански манати", "", "", "", "Туркменистански манати"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Тунизиски динар", PluralForms{"", "Тунизиски динар", "", "", "", "Тунизиски динари"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Тонганска панга", PluralForms{"", "", "", "", "", ""}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Тиморски ескудо", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Турска лира (1922–2005)", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Турска лира", PluralForms{"", "Турска лира", "", "", "", "Турски лири"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Долар на Тринидад и Тобаго", PluralForms{"", "Долар на Тринидад и Тобаго", "", "", "", "Долари на Тринидад и Тобаго"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "Тајвански нов долар", PluralForms{"", "Тајвански нов долар", "", "", "", "Тајвански нови долари"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Танзаниски шилинг", PluralForms{"", "Танзаниски шилинг", "", "", "", "Танзаниски шилинзи"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Украинска хривнија", PluralForms{"", "Украинска хривнија", "", "", "", "Украински хривнии"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Угандиски шилинг (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Угандиски шилинг", PluralForms{"", "Угандиски шилинг", "", "", "", "Угандиски шилинзи"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "Американски долар", PluralForms{"", "Американски долар", "", "", "", "Американски долари"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "САД долар (Next day)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "САД долар (Same day)", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Уругвајски пезос (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Уругвајски пезос", PluralForms{"", "Уругвајски пезос", "", "", "", "Уругвајски пезоси"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Узбекистански сом", PluralForms{"", "Узбекистански сом", "", "", "", "Узбекистански соми"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Венецуелски боливар (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Венецуелски боливар (2008–2018)", PluralForms{"", "Венецуелски боливар (2008–2018)", "", "", "", "Венецуелски боливари (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Венецуелски боливар", PluralForms{"", "Венецуелски боливар", "", "", "", "Венецуелски боливари"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "Виетнамски донг", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Ванатски вату", PluralForms{"", "Ванатски вату", "", "", "", "Ванатски ватуи"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Самоанска тала", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Централноафрикански франк", PluralForms{"", "Централноафрикански франк", "", "", "", "Централноафрикански франци"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Источнокарипски долар", PluralForms{"", "Источнокарипски долар", "", "", "", "Источнокарипски долари"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Западноафрикански франк", PluralForms{"", "Западноафрикански франк", "", "", "", "Западноафрикански франци"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "ЦФП франк", PluralForms{"", "ЦФП франк", "", "", "", "ЦФП франци"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Непозната валута", PluralForms{"", "", "", "", "", ""}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Јеменски динар", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Јеменски риал", PluralForms{"", "Јеменски риал", "", "", "", "Јеменски риали"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Југословенски динар", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Југословенски конвертибилен динар", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Јужно афрички ранд(финансиски)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Јужноафрикански ранд", PluralForms{"", "Јужноафрикански ранд", "", "", "", "Јужноафрикански ранди"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Замбијска квача (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Замбијска квача", PluralForms{"", "Замбијска квача", "", "", "", "Замбијски квачи"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Заирско новозаире", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Заирско заире", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Зимбабвиски долар", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
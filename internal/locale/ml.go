@@ -6,289 +6,342 @@ var ml = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 ആയിരം", "other": "0 ആയിരം"}},
+				{4, 2, map[string]string{"one": "0 ആയിരം", "other": "0 ആയിരം"}},
+				{5, 3, map[string]string{"one": "0 ആയിരം", "other": "0 ആയിരം"}},
+				{6, 1, map[string]string{"one": "0 ദശലക്ഷം", "other": "0 ദശലക്ഷം"}},
+				{7, 2, map[string]string{"one": "0 ദശലക്ഷം", "other": "0 ദശലക്ഷം"}},
+				{8, 3, map[string]string{"one": "0 ദശലക്ഷം", "other": "0 ദശലക്ഷം"}},
+				{9, 1, map[string]string{"one": "0 ബില്യൺ", "other": "0 ബില്യൺ"}},
+				{10, 2, map[string]string{"one": "0 ബില്യൺ", "other": "0 ബില്യൺ"}},
+				{11, 3, map[string]string{"one": "0 ബില്യൺ", "other": "0 ബില്യൺ"}},
+				{12, 1, map[string]string{"one": "0 ട്രില്യൺ", "other": "0 ട്രില്യൺ"}},
+				{13, 2, map[string]string{"one": "0 ട്രില്യൺ", "other": "0 ട്രില്യൺ"}},
+				{14, 3, map[string]string{"one": "0 ട്രില്യൺ", "other": "0 ട്രില്യൺ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{4, 2, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{5, 3, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{6, 1, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{7, 2, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{8, 3, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{9, 1, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{10, 2, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{12, 1, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{13, 2, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{14, 3, map[string]string{"one": "¤0T", "other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "അൻഡോറൻ പെസെയ്റ്റ", PluralForms{"", "അൻഡോറൻ പെസെയ്റ്റ", "", "", "", "അൻഡോറൻ പെസെയ്റ്റാസ്"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "യുണൈറ്റഡ് അറബ് എമിറേറ്റ്സ് ദിർഹം", PluralForms{"", "യു.എ.ഇ. ദിർഹം", "", "", "", "യു.എ.ഇ. ദിർഹം"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "അഫ്ഘാനി (1927–2002)", PluralForms{"", "അഫ്ഘാനി (AFA)", "", "", "", "അഫ്ഘാനിസ് (AFA)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "അഫ്\u200cഗാൻ അഫ്\u200c\u200cഗാനി", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "അൽബേനിയൻ ലെക്", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "അർമേനിയൻ ഡ്രാം", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "നെതർലാൻഡ്\u200cസ് ആന്റിലൻ ഗിൽഡർ", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "അംഗോളൻ \u200dക്വാൻസ", PluralForms{"", "അംഗോളൻ ക്വാൻസ", "", "", "", "അംഗോളൻ ക്വാൻസ"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "അംഗോളൻ \u200dക്വാൻസ (1977–1990)", PluralForms{"", "അംഗോളൻ ക്വാൻസാ (AOK)", "", "", "", "അംഗോളൻ ക്വാൻസാസ് (AOK"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "അംഗോളൻ \u200dന്യൂ ക്വാൻസ (1990–2000)", PluralForms{"", "അംഗോളൻ ന്യൂ ക്വാൻസാ (AON)", "", "", "", "അംഗോളൻ ന്യൂ ക്വാൻസാസ് (AON)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "അംഗോളൻ \u200dക്വാൻസ റിയാജസ്റ്റാഡോ (1995–1999)", PluralForms{"", "അംഗോളൻ ക്വാൻസ റീഅഡ്ജസ്റ്റാഡോ (AOR)", "", "", "", "അംഗോളൻ ക്വാൻസാസ് റീഅഡ്ജസ്റ്റാഡോ (AOR)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "അർജൻറീൻ ഓസ്ട്രൽ", PluralForms{"", "അർജൻറീൻ ഓസ്ട്രൽ", "", "", "", "അർജൻറീൻ ഓസ്ട്രൽസ്"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "അർജൻറീൻ പെയ്സോ (1983–1985)", PluralForms{"", "അർജൻറീൻ പെയ്സോ (ARP)", "", "", "", "അർജൻറീൻ പെയ്സോസ് (ARP)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "അർജൻറീൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "ഓസ്ട്രേലിയൻ ഷില്ലിംഗ്", PluralForms{"", "ഓസ്ട്രിയൻ ഷില്ലിംഗ്", "", "", "", "ഓസ്ട്രിയൻ ഷില്ലിംഗ്സ്"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ഓസ്ട്രേലിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "അറൂബൻ ഫ്ലോറിൻ", PluralForms{"", "", "", "", "", ""}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "അസർബയ്ജാനിയൻ മനത് (1993–2006)", PluralForms{"", "അസർബയ്ജാൻ മനത്\u200c (AZM)", "", "", "", "അസർബയ്ജാൻ മനത്\u200cസ് (AZM)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "അസർബൈജാനി മനത്", PluralForms{"", "", "", "", "", ""}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "ബോസ്നിയ-ഹെർസഗോവിന ദിനാർ", PluralForms{"", "ബോസ്നിയ ഹെർസഗോവിന ദിനാർ", "", "", "", "ബോസ്നിയ ഹെർസഗോവിന ദിനാർസ്"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "ബോസ്\u200cനിയ-ഹെർസഗോവിന കൺവേർട്ടബിൾ മാർക്ക്", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "ബാർബേഡിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "ബംഗ്ലാദേശി ടാക്ക", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "ബെൽജിയൻ ഫ്രാങ്ക് (കൈമാറ്റം ചെയ്യാവുന്നത്)", PluralForms{"", "ബെൽജിയൻ ഫ്രാങ്ക് (കൈമാറ്റം ചെയ്യാവുന്നത്)", "", "", "", "ബെൽജിയൻ ഫ്രാങ്ക്സ് (കൈമാറ്റം ചെയ്യാവുന്നത്)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "ബെൽജിയൻ ഫ്രാങ്ക്", PluralForms{"", "ബെൽജിയൻ ഫ്രാങ്ക്", "", "", "", "ബെൽജിയൻ ഫ്രാങ്ക്സ്"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "ബൽജിയൻ ഫ്രാങ്ക്", PluralForms{"", "ബെൽജിയൻ ഫ്രാങ്ക് (ഫിനാൻഷ്യൽ)", "", "", "", "ബെൽജിയൻ ഫ്രാങ്ക്സ്(ഫിനാൻഷ്യൽ)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "ബൾഗേറിയൻ ഹാർഡ് ലെവ്", PluralForms{"", "ബൾഗേറിയൻ ഹാർഡ് ലെവ്", "", "", "", "ബൾഗേറിയൻ ഹാർഡ് ലെവ്സ്"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "ബൾഗേറിയൻ ലെവ്", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "ബഹ്റൈനി ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "ബറുണ്ടിയൻ ഫ്രാങ്ക്", PluralForms{"", "ബുറുണ്ടിയൻ ഫ്രാങ്ക്", "", "", "", "ബറുണ്ടിയൻ ഫ്രാങ്ക്"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "ബെർമുഡൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ബ്രൂണൈ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "ബൊളീവിയൻ ബൊളിവിയാനോ", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "ബൊളീവിയൻ പെയ്സോ", PluralForms{"", "ബൊളീവിയൻ പെയ്സോ", "", "", "", "ബൊളീവിയൻ പെയ്സോസ്"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "ബൊളീവിയൻ എംവിഡോൾ", PluralForms{"", "ബൊളീവിയൻ എംവിഡോ", "", "", "", "ബൊളീവിയൻ എംവിഡോസ്"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "ബ്രസീലിയൻ ക്രുസെയ്റോ നോവോ (1967–1986)", PluralForms{"", "ബ്രസീലിയൻ ക്രുസെയ്റോ നോവോ (BRB)", "", "", "", "ബ്രസീലിയൻ ക്രുസെയ്റോസ് നോവോ (BRB)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "ബ്രസീലിയൻ ക്രുസാഡോ", PluralForms{"", "ബ്രസീലിയൻ ക്രുസാഡോ", "", "", "", "ബ്രസീലിയൻ ക്രുസാഡോസ്"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "ബ്രസീലിയൻ ക്രുസെയ്റോ (1990–1993)", PluralForms{"", "ബ്രസീലിയൻ ക്രുസെയ്റോ (BRE)", "", "", "", "ബ്രസീലിയൻ ക്രുസെയ്റോസ് (BRE)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ബ്രസീലിയൻ റിയാൽ", PluralForms{"", "", "", "", "", ""}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "ബ്രസീലിയൻ ക്രുസാഡോ നോവോ", PluralForms{"", "ബ്രസീലിയൻ ക്രുസാഡോ നോവോ", "", "", "", "ബ്രസീലിയൻ ക്രുസാഡോ നോവോസ്"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "ബ്രസീലിയൻ ക്രുസെയ്റോ", PluralForms{"", "ബ്രസീലിയൻ ക്രുസെയ്റോ", "", "", "", "ബ്രസീലിയൻ ക്രുസെയ്റോസ്"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "ബഹാമിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ഭൂട്ടാനീസ് ഗൾട്രം", PluralForms{"", "", "", "", "", ""}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "ബർമീസ് ചാറ്റ്", PluralForms{"", "ബർമീസ് ചാറ്റ്", "", "", "", "ബർമീസ് ചാറ്റ്സ്"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ബോട്\u200cസ്വാനൻ പ്യുല", PluralForms{"", "", "", "", "", ""}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "ബെലാറഷ്യൻ ന്യൂ റൂബിൾ (1994–1999)", PluralForms{"", "ബെലാറഷ്യൻ ന്യൂ റൂബിൾ (BYB)", "", "", "", "ബെലാറഷ്യൻ ന്യൂ റൂബിൾസ് (BYB)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "ബെലാറുഷ്യൻ റൂബിൾ", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "ബെലാറുഷ്യൻ റൂബിൾ (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "ബെലീസ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "കനേഡിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "കോങ്കളീസ് ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR യൂറോ", PluralForms{"", "WIR യൂറോ", "", "", "", "WIR യൂറോസ്"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "സ്വിസ് ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR ഫ്രാങ്ക്", PluralForms{"", "WIR ഫ്രാങ്ക്", "", "", "", "WIR ഫ്രാങ്ക്സ്"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "ചിലിയൻ യൂണിഡാഡ്സ് ഡി ഫോമെൻറോ", PluralForms{"", "ചിലിയൻ യൂണിഡാഡ്സ് ഡി ഫോമെൻറോ", "", "", "", "ചിലിയൻ യൂണിഡാഡ്സ് ഡി ഫോമെൻറോസ്"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "ചിലിയൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "ചൈനീസ് യുവാൻ (ഓഫ്\u200cഷോർ)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "ചൈനീസ് യുവാൻ", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "കൊളംബിയൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "യൂണിഡാഡ് ഡി വാലർ റിയൽ", PluralForms{"", "യൂണിഡാഡ് ഡി വാലർ റിയൽ", "", "", "", "യൂണിഡാഡ് ഡി വാലർ റിയൽസ്"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "കോസ്റ്റാ റിക്കൻ കോളൻ", PluralForms{"", "", "", "", "", ""}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "പ്രാചീന സെർബിയൻ ദിനാർ", PluralForms{"", "പ്രാചീന സെർബിയൻ ദിനാർ", "", "", "", "പ്രാചീന സെർബിയൻ ദിനാർസ്"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "ചെക്കോസ്ലൊവാക്ക് ഹാർഡ് കൊരൂന", PluralForms{"", "ചെക്കോസ്ലൊവാക്ക് ഹാർഡ് കൊരൂന", "", "", "", "ചെക്കോസ്ലൊവാക്ക് ഹാർഡ് കൊരൂനാസ്"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "ക്യൂബൻ കൺവേർട്ടബിൾ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "ക്യൂബൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "കേപ് വെർദിയൻ എസ്\u200cക്യുഡോ", PluralForms{"", "കേപ് വെർദിയൻ എസ്\u200cക്യുഡോ", "", "", "", "കേപ് വെർദിയൻ എസ്\u200cക്യുഡോസ്"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "സൈപ്രസ് പൌണ്ട്", PluralForms{"", "സൈപ്രസ് പൌണ്ട്", "", "", "", "സൈപ്രസ് പൌണ്ട്സ്"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "ചെക്ക് റിപ്പബ്ലിക് കൊരുണ", PluralForms{"", "", "", "", "", ""}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "കിഴക്കൻ ജർമൻ ഓസ്റ്റ്മാർക്ക്", PluralForms{"", "കിഴക്കൻ ജർമൻ ഓസ്റ്റ്മാർക്ക്", "", "", "", "കിഴക്കൻ ജർമൻ ഓസ്റ്റ്മാർക്ക്സ്"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "ജർമൻ മാർക്ക്", PluralForms{"", "ജർമൻ മാർക്ക്", "", "", "", "ജർമൻ മാർക്ക്സ്"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "ദിജിബൗട്ടിയൻ ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ഡാനിഷ് ക്രോണെ", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "ഡൊമിനിക്കൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "അൾജീരിയൻ ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ഇക്വഡോർ സൂക്രേ", PluralForms{"", "ഇക്വഡോർ സൂക്രേ", "", "", "", "ഇക്വഡോർ സൂക്രേസ്"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ഇക്വഡോർ യൂണിഡാഡ് വാലർ കോൺസ്റ്റൻറെ (UVC)", PluralForms{"", "ഇക്വഡോർ യൂണിഡാഡ് ഡി വാലർ കോൺസ്റ്റൻറെ (UVC)", "", "", "", "ഇക്വഡോർ യൂണിഡാഡ്സ് ഡി വാലർ കോൺസ്റ്റൻറെ (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "എസ്റ്റൌനിയൻ ക്രൂൺ", PluralForms{"", "എസ്റ്റൌനിയൻ ക്രൂൺ", "", "", "", "എസ്റ്റൌനിയൻ ക്രൂൺസ്"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ഈജിപ്\u200cഷ്യൻ പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "എറിത്രിയൻ നക്ഫ", PluralForms{"", "എറിത്രിയൻ നക്ഫ", "", "", "", "എറിത്രിയൻ നക്\u200cഫ"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "സ്പാനിഷ് പസെയ്റ്റ (A അക്കൌണ്ട്)", PluralForms{"", "സ്പാനിഷ് പസെയ്റ്റ (A അക്കൌണ്ട്)", "", "", "", "സ്പാനിഷ് പസെയ്റ്റാസ് (A അക്കൌണ്ട്)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "സ്പാനിഷ് പസെയ്റ്റ (കൈമാറ്റം ചെയ്യാവുന്ന അക്കൌണ്ട്)", PluralForms{"", "സ്പാനിഷ് പസെയ്റ്റ (കൈമാറ്റം ചെയ്യാവുന്ന അക്കൌണ്ട്)", "", "", "", "സ്പാനിഷ് പസെയ്റ്റാസ് (കൈമാറ്റം ചെയ്യാവുന്ന അക്കൌണ്ട്)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "സ്പാനിഷ് പസെയ്റ്റ", PluralForms{"", "സ്പാനിഷ് പസെയ്റ്റ", "", "", "", "സ്പാനിഷ് പസെയ്റ്റാസ്"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "എത്യോപ്യൻ ബിർ", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "യൂറോ", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "ഫിന്നിഷ് മാർക്ക", PluralForms{"", "ഫിന്നിഷ് മാർക്ക", "", "", "", "ഫിന്നിഷ് മാർക്കാസ്"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ഫിജിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ഫാക്ക്\u200cലാന്റ് ദ്വീപുകളുടെ പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "ഫ്രാങ്ക്", PluralForms{"", "ഫ്രെഞ്ച് ഫ്രാങ്ക്", "", "", "", "ഫ്രെഞ്ച് ഫ്രാങ്ക്സ്"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ബ്രിട്ടീഷ് പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "ജോർ\u200dജ്ജിയൻ ക്യൂപോൺ ലാരിറ്റ്", PluralForms{"", "ജോർ\u200dജ്ജിയൻ ക്യൂപോൺ ലാരിറ്റ്", "", "", "", "ജോർ\u200dജ്ജിയൻ ക്യൂപോൺ ലാരിറ്റ്സ്"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "ജോർജ്ജിയൻ ലാറി", PluralForms{"", "ജോർജ്ജിയൻ ലാറി", "", "", "", "ജോർജ്ജിയൻ ലാറിസ്"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ഘാന കെഡി (1979–2007)", PluralForms{"", "ഘാന കെഡി (GHC)", "", "", "", "ഘാന കെഡിസ് (GHC)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ഘാനയൻ കെഡി", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "ജിബ്രാൾട്ടർ പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "ഗാംബിയൻ ദലാസി", PluralForms{"", "ഗാംബിയൻ ദലാസി", "", "", "", "ഗാംബിയൻ ദലാസിസ്"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "ഗിനിയൻ ഫ്രാങ്ക്", PluralForms{"", "ഗിനിയൻ ഫ്രാങ്ക്", "", "", "", "ഗിനിയൻ ഫ്രാങ്ക്സ്"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "ഗിനിയ സൈലി", PluralForms{"", "ഗിനിയ സൈലി", "", "", "", "ഗിനിയ സൈലിസ്"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ഇക്വിറ്റോറിയൽ ഗിനിയ എക്വീലെ ഗിനിയാന", PluralForms{"", "ഇക്വിറ്റോറിയൽ ഗിനി എക്വീലെ", "", "", "", "ഇക്വിറ്റോറിയൽ ഗിനി എക്വീലെ"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "ഗ്രീക്ക് ഡ്രാക്ക്മ", PluralForms{"", "ഗ്രീക്ക് ഡ്രാക്ക്മ", "", "", "", "ഗ്രീക്ക് ഡ്രാക്ക്മാസ്"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ഗ്വാട്ടിമാലൻ ക്വെറ്റ്\u200cസൽ", PluralForms{"", "", "", "", "", ""}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "പോർച്ചുഗീസ് ഗിനി എസ്ക്യൂഡോ", PluralForms{"", "പോർച്ചുഗീസ് ഗിനി എസ്ക്യൂഡോ", "", "", "", "പോർച്ചുഗീസ് ഗിനി എസ്ക്യൂഡോസ്"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "ഗിനിയ-ബിസാവു പെയ്സോ", PluralForms{"", "ഗിനി-ബിസാവു പെയ്സോ", "", "", "", "ഗിനി-ബിസാവു പെയ്സോസ്"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "ഗയാനീസ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "ഹോങ്കോങ്ങ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "ഹോണ്ടുറൻ ലെംപിറ", PluralForms{"", "", "", "", "", ""}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "ക്രൊയേഷ്യൻ ദിനാർ", PluralForms{"", "ക്രൊയേഷ്യൻ ദിനാർ", "", "", "", "ക്രൊയേഷ്യൻ ദിനാർസ്"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "ക്രൊയേഷൻ ക്യുന", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "ഹെയ്\u200cതിയൻ ഗൂർഡ്", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ഹംഗേറിയൻ ഫോറിന്റ്", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ഇന്തോനേഷ്യൻ റുപിയ", PluralForms{"", "", "", "", "", ""}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "ഐറിഷ് പൌണ്ട്", PluralForms{"", "ഐറിഷ് പൌണ്ട്", "", "", "", "ഐറിഷ് പൌണ്ട്സ്"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "ഇസ്രയേലി പൌണ്ട്", PluralForms{"", "ഇസ്രയേലി പൌണ്ട്", "", "", "", "ഇസ്രയേലി പൌണ്ട്സ്"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ഇസ്രായേലി ന്യൂ ഷെക്കെൽ", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "ഇന്ത്യൻ രൂപ", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ഇറാഖി ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ഇറാനിയൻ റിയാൽ", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ഐസ്\u200cലാൻഡിക് ക്രോണ", PluralForms{"", "", "", "", "", ""}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "ഇറ്റാലിയൻ ലിറ", PluralForms{"", "ഇറ്റാലിയൻ ലിറ", "", "", "", "ഇറ്റാലിയൻ ലിറാസ്"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ജമൈക്കൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "ജോർദ്ദാനിയൻ ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "ജാപ്പനീസ് യെൻ", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "കെനിയൻ ഷില്ലിംഗ്", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "കിർഗിസ്ഥാനി സോം", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "കംബോഡിയൻ റീൽ", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "കൊമോറിയൻ ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "ഉത്തര കൊറിയൻ വോൺ", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "ദക്ഷിണ കൊറിയൻ വോൺ", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "കുവൈറ്റി ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "കേമാൻ ഐലൻഡ്\u200cസ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "കസാക്കിസ്ഥാനി ടെംഗെ", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "ലാവോഷിയൻ കിപ്", PluralForms{"", "ലാവോഷ്യൻ കിപ്\u200c", "", "", "", "ലാവോഷിയൻ കിപ്"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ലെബനീസ് പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "ശ്രീലങ്കൻ റുപ്പീ", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "ലൈബീരിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "ലെസോതോ ലോത്തി", PluralForms{"", "ലെസോതോ ലോത്തി", "", "", "", "ലെസോതോ ലോത്തിസ്\u200c"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "ലിത്വാനിയൻ ലിറ്റാസ്", PluralForms{"", "", "", "", "", ""}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "ലിത്വാനിയൻ തലോനാസ്", PluralForms{"", "ലിത്വാനിയൻ തലോനാസ്", "", "", "", "ലിത്വാനിയൻ തലോനാസെസ്\u200c"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "ലക്സംബർഗ് കൺവേർട്ടിബിൾ ഫ്രാങ്ക്", PluralForms{"", "ലക്സംബർഗ് കൈമാറ്റം ചെയ്യാവുന്ന ഫ്രാങ്ക്\u200c", "", "", "", "ലക്സംബർഗ് കൈമാറ്റം ചെയ്യാവുന്ന ഫ്രാങ്ക്\u200cസ്\u200c"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "ലക്സംബർഗ് ഫ്രാങ്ക്", PluralForms{"", "ലക്സംബർഗ് ഫ്രാങ്ക്", "", "", "", "ലക്സംബർഗ് ഫ്രാങ്ക്\u200cസ്\u200c"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "ലക്സംബർഗ് ഫിനാൻഷ്യൽ ഫ്രാങ്ക്", PluralForms{"", "ലക്സംബർഗ് ഫിനാൻഷ്യൽ ഫ്രാങ്ക്", "", "", "", "ലക്സംബർഗ് ഫിനാൻഷ്യൽ ഫ്രാങ്ക്\u200cസ്\u200c"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "ലാറ്റ്വിയൻ ലാറ്റ്സ്", PluralForms{"", "", "", "", "", ""}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "ലാറ്റ്വിയൻ റൂബിൾ", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "ലിബിയൻ ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "മൊറോക്കൻ ദിർഹം", PluralForms{"", "", "", "", "", ""}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "മൊറോക്കൻ ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "മൊൾഡോവൻ ലിയു", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "മഡഗാസി ഏരിയറി", PluralForms{"", "", "", "", "", ""}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "മഡഗാസ്കർ ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "മാസിഡോണിയൻ ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "മാലി ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "മ്യാൻമാർ ക്യാട്", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "മംഗോളിയൻ തുഗ്രിക്", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "മകാനീസ് പതാക്ക", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "മൗറിറ്റേനിയൻ ഔഗിയ (1973–2017)", PluralForms{"", "മൗറിറ്റേനിയൻ ഔഗിയ (1973–2017)", "", "", "", "മൗറിറ്റേനിയൻ ഔഗിയസ് (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "മൗറിറ്റേനിയൻ ഔഗിയ", PluralForms{"", "മൗറിറ്റേനിയൻ ഔഗിയ", "", "", "", "മൗറിറ്റേനിയൻ ഔഗിയസ്"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "മൽത്തീസ് ലിറ", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "മൽത്തീസ് പൌണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "മൗറീഷ്യൻ റുപ്പീ", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "മാൽദീവിയൻ റുഫിയ", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "മലാവിയൻ ക്വച്ചാ", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "മെക്സിക്കൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "മെക്സിക്കൻ സിൽവർ പെയ്സോ (1861–1992)", PluralForms{"", "മെക്സിക്കൻ സിൽവർ പെയ്സോ (MXP)", "", "", "", "മെക്സിക്കൻ സിൽവർ പെയ്സോസ് (MXP)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "മെക്സിക്കൻ യൂണിഡാഡ് ഡി ഇൻവെർഷൻ (UDI)", PluralForms{"", "മെക്സിക്കൻ യൂണിഡാഡ് ഡി ഇൻവെർഷൻ (UDI)", "", "", "", "മെക്സിക്കൻ യൂണിഡാഡ്സ് ഡി ഇൻവെർഷൻ (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "മലേഷ്യൻ റിംഗിറ്റ്", PluralForms{"", "", "", "", "", ""}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "മൊസാന്പിക്ക് എസ്ക്യുഡോ", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "ഓൾഡ് മൊസാന്പിക്ക് മെറ്റിക്കൽ", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "മൊസാംബിക്കൻ മെറ്റിക്കൽ", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "നമീബിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "നൈജീരിയൻ നൈറ", PluralForms{"", "", "", "", "", ""}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "നികരാഗ്വൻ കൊർഡോബ", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "നിക്കരാഗ്വൻ കോർഡോബ", PluralForms{"", "", "", "", "", ""}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "നെതർലൻഡ്സ് ഗിൽഡർ", PluralForms{"", "നെതർലൻഡ്സ് ഗിൽഡർ", "", "", "", "നെതർലൻഡ്സ് ഗിൽഡേഴ്സ്"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "നോർവീജിയൻ ക്രോണെ", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "നേപ്പാളീസ് റുപ്പീ", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "ന്യൂസിലാന്റ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ഒമാനി റിയാൽ", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "പനാമനിയൻ ബാൽബോവ", PluralForms{"", "", "", "", "", ""}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "പെറൂവിയൻ ഇൻറി", PluralForms{"", "പെറൂവിയൻ ഇൻറി", "", "", "", "പെറൂവിയൻ ഇൻറിസ്"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "പെറുവിയൻ സോൾ", PluralForms{"", "", "", "", "", ""}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "പെറൂവിയൻ സോൾ (1863–1965)", PluralForms{"", "പെറൂവിയൻ സോൾ (1863–1965)", "", "", "", "പെറൂവിയൻ സോൾസ് (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "പാപ്പുവ ന്യൂ ഗിനിയൻ കിന", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "ഫിലിപ്പീനി പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "പാക്കിസ്ഥാനി റുപ്പീ", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "പോളിഷ് സ്ലോട്ടി", PluralForms{"", "", "", "", "", ""}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "പോളിഷ് സ്ലോട്ടി (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "പോർച്ചുഗീസ് എസ്ക്യുഡോ", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "പരാഗ്വേയൻ ഗ്വരനീ", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "ഖത്തർ റിയാൽ", PluralForms{"", "", "", "", "", ""}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "റൊഡേഷ്യൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "പ്രാചീന റൊമേനിയൻ ലിയു", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "റൊമാനിയൻ ലെയു", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "സെർബിയൻ ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "റഷ്യൻ റൂബിൾ", PluralForms{"", "", "", "", "", ""}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "റഷ്യൻ റൂബിൾ (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "റുവാണ്ടൻ ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "സൗദി റിയാൽ", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "സോളമൻ ദ്വീപ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "സീഷെലോയിസ് റുപ്പീ", PluralForms{"", "", "", "", "", ""}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "പ്രാചീന സുഡാനീസ് ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "സുഡാനീസ് പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "പ്രാചീന സുഡാനീസ് പൌണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "സ്വീഡിഷ് ക്രോണ", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "സിംഗപ്പൂർ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "സെന്റ് ഹെലീന പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "സ്ലൊവേനിയ റ്റോളർ", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "സ്ലോവാക് കൊരൂന", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "സിയെറ ലിയോണിയൻ ലിയോൺ", PluralForms{"", "സിയെറ ലിയോണിയൻ ലിയോൺ", "", "", "", "സിയെറ ലിയോണിയൻ ലിയോണസ്"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "സിയെറ ലിയോണിയൻ ലിയോൺ (1964—2022)", PluralForms{"", "സിയെറ ലിയോണിയൻ ലിയോൺ (1964—2022)", "", "", "", "സിയെറ ലിയോണിയൻ ലിയോണസ് (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "സോമാലി ഷില്ലിംഗ്", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "സുരിനെയിമിസ് ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "സൂരിനാം ഗിൽഡർ", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "ദക്ഷിണ സുഡാനീസ് പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "സാവോ ടോമി ആൻഡ് പ്രിൻസിപെ ഡോബ്ര (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "സാവോ ടോമി ആൻഡ് പ്രിൻസിപെ ഡോബ്ര", PluralForms{"", "", "", "", "", ""}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "സോവിയറ്റ് റൂബിൾ", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "എൽ സാൽവഡോർ കോളൻ", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "സിറിയൻ പൗണ്ട്", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "സ്വാസി ലിലാംഗനി", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "തായ് ബട്ട്", PluralForms{"", "", "", "", "", ""}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "താജിക്കിസ്ഥാൻ റൂബിൾ", PluralForms{"", "തജിക്സ്ഥാൻ റൂബിൾ", "", "", "", "തജിക്സ്ഥാൻ റൂബിൾസ്"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "താജിക്കിസ്ഥാനി സോംനി", PluralForms{"", "താജിക്കിസ്ഥാനി സോംനി", "", "", "", "താജിക്കിസ്ഥാനി സൊമോനി"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "തുർക്മെനിസ്ഥാൻ മനത്", PluralForms{"", "തുർക്മെനിസ്ഥാൻ മനത്", "", "", "", "തുർക്മെനിസ്ഥാൻ മനത്\u200cസ്"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "തുർക്ക്\u200cമെനിസ്ഥാനി മനത്", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "ടുണീഷ്യൻ ദിനാർ", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "ടോംഗൻ പാംഗ", PluralForms{"", "", "", "", "", ""}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "തിമോർ എസ്ക്യൂഡോ", PluralForms{"", "തിമോർ എസ്ക്യൂഡോ", "", "", "", "തിമോർ എസ്ക്യൂഡോസ്"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "പ്രാചീന തുർക്കിഷ് ലിറ", PluralForms{"", "പ്രാചീന തുർക്കിഷ് ലിറ", "", "", "", "പ്രാചീന തുർക്കിഷ് ലിറാസ്"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "ടർക്കിഷ് ലിറ", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ട്രിനിഡാഡ് അന്റ് ടുബാഗോ ഡോളർ", PluralForms{"", "ട്രിനിഡാഡ് ആന്റ് ടുബാഗോ ഡോളർ", "", "", "", "ട്രിനിഡാഡ് ആന്റ് ടുബാഗോ ഡോളർ"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "ന്യൂ തായ്\u200cവാൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "ടാൻസാനിയൻ ഷില്ലിംഗ്", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ഉക്രേനിയൻ ഹ്രിവ്\u200cനിയ", PluralForms{"", "ഉക്രേനിയൻ ഹ്രിവ്നിയ", "", "", "", "ഉക്രേനിയൻ ഹ്രിവ്\u200cനിയ"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "യുക്രേനിയൻ കാർബോവാനെസ്", PluralForms{"", "ഉക്രേനിയൻ കാർബോവാനെസ്", "", "", "", "ഉക്രേനിയൻ കാർബോവാനെസ്"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "ഉഗാണ്ടൻ ഷില്ലിംഗ് (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ഉഗാണ്ടൻ ഷില്ലിംഗ്", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "യു.എസ്. ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "യുഎസ് ഡോളർ (അടുത്ത ദിവസം)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "യുഎസ് ഡോളർ (അതേ ദിവസം)", PluralForms{"", "യുഎസ് ഡോളർ (അതേ ദിവസം)", "", "", "", "യുഎസ് ഡോളേഴ്സ് (അതേ ദിവസം)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "ഉറുഗ്വേ പെയ്സോ എൻ യൂണിഡാഡ്സ്", PluralForms{"", "ഉറുഗ്വേ പെയ്സോ എൻ യൂണിഡാഡ്സ് ഇൻഡെക്സാഡാസ്", "", "", "", "ഉറുഗ്വേ പെയ്സോസ് എൻ യൂണിഡാഡ്സ് ഇൻഡെക്സാഡാസ്"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "ഉറുഗ്വേ പെയ്സോ (1975–1993)", PluralForms{"", "ഉറുഗ്വേ പെയ്സോ (UYP)", "", "", "", "ഉറുഗ്വേ പെയ്സോസ് (UYP)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "ഉറുഗ്വേയൻ പെസോ", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "ഉസ്\u200cബെക്കിസ്ഥാനി സോം", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "വെനസ്വേലൻ ബോലിവർ (1871–2008)", PluralForms{"", "വെനസ്വേലൻ ബോലിവർ (1871–2008)", "", "", "", "വെനസ്വേലൻ ബോലിവർസ് (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "വെനിസ്വേലൻ ബൊളീവർ (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "വെനിസ്വേലൻ ബൊളീവർ", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "വിയറ്റ്നാമീസ് ഡോങ്", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "വന്വാതു വാതു", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "സമോവൻ താല", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "മദ്ധ്യ ആഫ്രിക്കൻ [CFA] ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "വെള്ളി", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "സ്വർണ്ണം", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "യൂറോപ്യൻ കോന്പസിറ്റ് യൂണിറ്റ്", PluralForms{"", "യൂറോപ്യൻ കോന്പസിറ്റ് യൂണിറ്റ്", "", "", "", "യൂറോപ്യൻ കോന്പസിറ്റ് യൂണിറ്റ്സ്"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "യൂറോപ്യൻ മോണിറ്ററി യൂണിറ്റ്", PluralForms{"", "യൂറോപ്യൻ മോണിറ്ററി യൂണിറ്റ്", "", "", "", "യൂറോപ്യൻ മോണിറ്ററി യൂണിറ്റ്സ്"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "യൂറോപ്യൻ യൂണിറ്റ് ഓഫ് അക്കൌണ്ട് (XBC)", PluralForms{"", "യൂറോപ്യൻ യൂണിറ്റ് ഓഫ് അക്കൌണ്ട് (XBC)", "", "", "", "യൂറോപ്യൻ യൂണിറ്റ്സ് ഓഫ് അക്കൌണ്ട് (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "യൂറോപ്യൻ യൂണിറ്റ് ഓഫ് അക്കൌണ്ട് (XBD)", PluralForms{"", "യൂറോപ്യൻ യൂണിറ്റ് ഓഫ് അക്കൌണ്ട് (XBD)", "", "", "", "യൂറോപ്യൻ യൂണിറ്റ്സ് ഓഫ് അക്കൌണ്ട് (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "കിഴക്കൻ കരീബിയൻ ഡോളർ", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "സ്പെഷ്യൽ ഡ്രോയിംഗ് റൈറ്റ്സ്", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "യൂറോപ്യൻ നാണയ യൂണിറ്റ്", PluralForms{"", "യൂറോപ്യൻ നാണയ യൂണിറ്റ്", "", "", "", "യൂറോപ്യൻ നാണയ യൂണിറ്റ്സ്"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "ഫ്രെഞ്ച് ഗോൾഡ് ഫ്രാങ്ക്", PluralForms{"", "ഫ്രെഞ്ച് ഗോൾഡ് ഫ്രാങ്ക്", "", "", "", "ഫ്രെഞ്ച് ഗോൾഡ് ഫ്രാങ്ക്സ്"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "ഫ്രെഞ്ച് UIC-ഫ്രാങ്ക്", PluralForms{"", "ഫ്രെഞ്ച് UIC-ഫ്രാങ്ക്", "", "", "", "ഫ്രെഞ്ച് UIC-ഫ്രാങ്ക്സ്"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "പശ്ചിമ ആഫ്രിക്കൻ [CFA] ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "പലാഡിയം", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP ഫ്രാങ്ക്", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "പ്ലാറ്റിനം", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET ഫണ്ട്സ്", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "ടെസ്റ്റിംഗിനുള്ള കറൻസി കോഡ്", PluralForms{"", "റ്റെസ്റ്റിംഗ് കറൻസി കോഡ്", "", "", "", "റ്റെസ്റ്റിംഗ് കറൻസി കോഡ്"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "അജ്ഞാത നാണയം", PluralForms{"", "(അജ്ഞാത നാണയം\u200c)", "", "", "", "(അജ്ഞാത നാണയം)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "യമനി ദിനാർ", PluralForms{"", "യമനി ദിനാർ", "", "", "", "യമനി ദിനാർസ്"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "യെമനി റിയാൽ", PluralForms{"", "", "", "", "", ""}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "യൂഗോസ്ലേവിയൻ ഹാർഡ് ദിനാർ", PluralForms{"", "യൂഗോസ്ലേവിയൻ ഹാർഡ് ദിനാർ", "", "", "", "യൂഗോസ്ലേവിയൻ ഹാർഡ് ദിനാർസ്"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "യൂഗോസ്ലേവിയൻ നോവി ദിനാർ", PluralForms{"", "യൂഗോസ്ലേവിയൻ നോവി ദിനാർ", "", "", "", "യൂഗോസ്ലേവിയൻ നോവി ദിനാർസ്"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "യൂഗോസ്ലേവിയൻ കൺവേർട്ടിബിൾ ദിനാർ", PluralForms{"", "യൂഗോസ്ലേവിയൻ കൺവേർട്ടിബിൾ ദിനാർ", "", "", "", "യൂഗോസ്ലേവിയൻ കൺവേർട്ടിബിൾ ദിനാർസ്"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "ദക്ഷിണാഫ്രിക്കൻ റാൻഡ് (ഫിനാൻഷ്യൽ)", PluralForms{"", "ദക്ഷിണാഫ്രിക്കൻ റാൻഡ് (ഫിനാൻഷ്യൽ)", "", "", "", "ദക്ഷിണാഫ്രിക്കൻ റാൻഡ്സ് (ഫിനാൻഷ്യൽ)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "ദക്ഷിണാഫ്രിക്കൻ റാൻഡ്", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "സാംബിയൻ ക്വാച (1968–2012)", PluralForms{"", "സാംബിയൻ ക്വാച (1968–2012)", "", "", "", "സാംബിയൻ ക്വാചാസ് (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "സാംബിയൻ ക്വാച്ച", PluralForms{"", "", "", "", "", ""}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "സൈറിയൻ ന്യൂ സൈർ", PluralForms{"", "സൈറിയൻ ന്യൂ സൈർ", "", "", "", "സൈറിയൻ ന്യൂ സൈർസ്"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "സൈറിയൻ സൈർ", PluralForms{"", "സൈറിയൻ സൈർ", "", "", "", "സൈറിയൻ സൈർസ്"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "സിംബാബ്\u200cവെ ഡോളർ", PluralForms{"", "സിംബാബ്\u200cവെ ഡോളർ", "", "", "", "സിംബാബ്\u200cവെ ഡോളേഴ്സ്"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
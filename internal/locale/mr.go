@@ -6,176 +6,229 @@ var mr = LocaleData{
 	NumberInfo{"deva",
 		[10]string{"०", "१", "२", "३", "४", "५", "६", "७", "८", "९"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "[", "]", "-[", "]"}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0ह", "other": "0\u00a0ह"}},
+				{4, 2, map[string]string{"one": "0\u00a0ह", "other": "0\u00a0ह"}},
+				{5, 1, map[string]string{"one": "0\u00a0लाख", "other": "0\u00a0लाख"}},
+				{6, 2, map[string]string{"one": "0\u00a0लाख", "other": "0\u00a0लाख"}},
+				{7, 1, map[string]string{"one": "0\u00a0कोटी", "other": "0\u00a0कोटी"}},
+				{8, 2, map[string]string{"one": "0\u00a0कोटी", "other": "0\u00a0कोटी"}},
+				{9, 1, map[string]string{"one": "0\u00a0अब्ज", "other": "0\u00a0अब्ज"}},
+				{10, 2, map[string]string{"one": "0\u00a0अब्ज", "other": "0\u00a0अब्ज"}},
+				{11, 1, map[string]string{"one": "0\u00a0खर्व", "other": "0\u00a0खर्व"}},
+				{12, 2, map[string]string{"one": "0\u00a0खर्व", "other": "0\u00a0खर्व"}},
+				{13, 1, map[string]string{"one": "0\u00a0पद्म", "other": "0\u00a0पद्म"}},
+				{14, 2, map[string]string{"one": "0\u00a0पद्म", "other": "0\u00a0पद्म"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 हजार", "other": "0 हजार"}},
+				{4, 2, map[string]string{"one": "0 हजार", "other": "0 हजार"}},
+				{5, 1, map[string]string{"one": "0 लाख", "other": "0 लाख"}},
+				{6, 2, map[string]string{"one": "0 लाख", "other": "0 लाख"}},
+				{7, 1, map[string]string{"one": "0 कोटी", "other": "0 कोटी"}},
+				{8, 2, map[string]string{"one": "0 कोटी", "other": "0 कोटी"}},
+				{9, 1, map[string]string{"one": "0 अब्ज", "other": "0 अब्ज"}},
+				{10, 2, map[string]string{"one": "0 अब्ज", "other": "0 अब्ज"}},
+				{11, 1, map[string]string{"one": "0 खर्व", "other": "0 खर्व"}},
+				{12, 2, map[string]string{"one": "0 खर्व", "other": "0 खर्व"}},
+				{13, 1, map[string]string{"one": "0 पद्म", "other": "0 पद्म"}},
+				{14, 2, map[string]string{"one": "0 पद्म", "other": "0 पद्म"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0\u00a0ह", "other": "¤0\u00a0ह"}},
+				{4, 2, map[string]string{"one": "¤0\u00a0ह", "other": "¤0\u00a0ह"}},
+				{5, 1, map[string]string{"one": "¤0\u00a0लाख", "other": "¤0\u00a0लाख"}},
+				{6, 2, map[string]string{"one": "¤0\u00a0लाख", "other": "¤0\u00a0लाख"}},
+				{7, 1, map[string]string{"one": "¤0\u00a0कोटी", "other": "¤0\u00a0कोटी"}},
+				{8, 2, map[string]string{"one": "¤0\u00a0कोटी", "other": "¤0\u00a0कोटी"}},
+				{9, 1, map[string]string{"one": "¤0\u00a0अब्ज", "other": "¤0\u00a0अब्ज"}},
+				{10, 2, map[string]string{"one": "¤0\u00a0अब्ज", "other": "¤0\u00a0अब्ज"}},
+				{11, 1, map[string]string{"one": "¤0\u00a0खर्व", "other": "¤0\u00a0खर्व"}},
+				{12, 2, map[string]string{"one": "¤0\u00a0खर्व", "other": "¤0\u00a0खर्व"}},
+				{13, 1, map[string]string{"one": "¤0\u00a0पद्म", "other": "¤0\u00a0पद्म"}},
+				{14, 2, map[string]string{"one": "¤0\u00a0पद्म", "other": "¤0\u00a0पद्म"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "संयुक्त अरब अमीरात दिरहॅम", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "अफगाण अफगाणी", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "अल्बानियन लेक", PluralForms{"", "अल्बानियन लेक", "", "", "", "अल्बानियन लेके"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "आर्मेनियन द्रॅम", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "नेदरलँडचा अँटिलीन गिल्डर", PluralForms{"", "नेदरलँडचा अँटिलीन गिल्डर", "", "", "", "नेदरलँडचे अँटिलीन गिल्डर्स"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "अँगोलन क्वॅन्झा", PluralForms{"", "अँगोलन क्वॅन्झा", "", "", "", "अँगोलन क्वॅन्झास"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "अर्जेंटाइन पेसो", PluralForms{"", "अर्जेंटाइन पेसो", "", "", "", "अर्जेंटाइन पेसोज"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ऑस्ट्रेलियन डॉलर", PluralForms{"", "ऑस्ट्रेलियन डॉलर", "", "", "", "ऑस्ट्रेलियन डॉलर्स"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "अरुबा फ्लोरिन", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "अझरबैझानी मानाट", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "बोस्निया-हर्जेगोविना विनिमय मार्क", PluralForms{"", "बोस्निया-हर्जेगोविना विनिमय मार्क", "", "", "", "बोस्निया-हर्जेगोविना विनिमय मार्क्स"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "बार्बाडियन डॉलर", PluralForms{"", "बार्बाडियन डॉलर", "", "", "", "बार्बाडियन डॉलर्स"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "बांगलादेशी टका", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "बल्गेरियन लेव", PluralForms{"", "बल्गेरियन लेव", "", "", "", "बल्गेरियन लेवा"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "बाहरिनी दिनार", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "बुरुंडियन फ्रँक", PluralForms{"", "बुरुंडियन फ्रँक", "", "", "", "बुरुंडियन फ्रँक्स"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "बर्मुडा डॉलर", PluralForms{"", "बर्मुडा डॉलर", "", "", "", "बर्मुडा डॉलर्स"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ब्रुनेई डॉलर", PluralForms{"", "ब्रुनेई डॉलर", "", "", "", "ब्रुनेई डॉलर्स"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "बोलिव्हियन बोलिव्हियानो", PluralForms{"", "बोलिव्हियन बोलिव्हियानो", "", "", "", "बोलिव्हियन बोलिव्हियानोज"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ब्राझिलियन रियाल", PluralForms{"", "ब्राझिलियन रियाल", "", "", "", "ब्राझिलियन रियाल्स"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "बहामी डॉलर", PluralForms{"", "बहामी डॉलर", "", "", "", "बहामी डॉलर्स"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "भूतानी एंगल्ट्रम", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "बोट्सवानन पुला", PluralForms{"", "बोट्सवानन पुला", "", "", "", "बोट्सवाना पुलाज"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "बेलारुशियन रुबल", PluralForms{"", "बेलारुशियन रुबल", "", "", "", "बेलारुशियन रुबल्स"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "बेलारुशियन रुबल (2000–2016)", PluralForms{"", "बेलारुशियन रुबल (2000–2016)", "", "", "", "बेलारुशियन रुबल्स (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "बेलीझ डॉलर", PluralForms{"", "बेलीझ डॉलर", "", "", "", "बेलीझ डॉलर्स"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "कॅनडियन डॉलर", PluralForms{"", "कॅनडियन डॉलर", "", "", "", "कॅनडियन डॉलर्स"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "काँगोलीज फ्रँक", PluralForms{"", "काँगोलीज फ्रँक", "", "", "", "काँगोलीज फ्रँक्स"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "स्विस फ्रँक", PluralForms{"", "स्विस फ्रँक", "", "", "", "स्विस फ्रँक्स"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "चिली पेसो", PluralForms{"", "चिली पेसो", "", "", "", "चिली पेसोज"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "चिनी युआन (ऑफशोर)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "चीनी युआन", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "कोलंबियन पेसो", PluralForms{"", "कोलंबियन पेसो", "", "", "", "कोलंबियन पेसोज"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "कोस्टारिकन कोलोन", PluralForms{"", "कोस्टारिकन कोलोन", "", "", "", "कोस्टारिकन कोलोन्स"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "क्यूबन विनिमय पेसो", PluralForms{"", "क्यूबन विनिमय पेसो", "", "", "", "क्यूबन विनिमय पेसोज"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "क्यूबन पेसो", PluralForms{"", "क्यूबन पेसो", "", "", "", "क्यूबन पेसोज"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "केप व्हर्डेयन एस्कुडो", PluralForms{"", "केप व्हर्डेयन एस्कुडो", "", "", "", "केप व्हर्डेयन एस्कुडोज"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "झेक प्रजासत्ताक कोरुना", PluralForms{"", "झेक प्रजासत्ताक कोरुना", "", "", "", "झेक प्रजासत्ताक कोरुनाज"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "जिबौटियन फ्रँक", PluralForms{"", "जिबौटियन फ्रँक", "", "", "", "जिबौटियन फ्रँक्स"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "डॅनिश क्रोन", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "डोमिनिकन पेसो", PluralForms{"", "डोमिनिकन पेसो", "", "", "", "डोमिनिकन पेसोज"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "अल्जेरियन दिनार", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "इजिप्शियन पाउंड", PluralForms{"", "इजिप्शियन पाउंड", "", "", "", "इजिप्शियन पाउंड्स"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "इरिट्रियन नाक्फा", PluralForms{"", "इरिट्रियन नाक्फा", "", "", "", "इरिट्रियन नाक्फाज"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "इथिओपियन बिर", PluralForms{"", "इथिओपियन बिर", "", "", "", "इथिओपियन बिर्स"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "युरो", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "फिजियन डॉलर", PluralForms{"", "फिजियन डॉलर्स", "", "", "", "फिजियन डॉलर्स"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "फॉकलंड आयलंड पाउंड", PluralForms{"", "फॉकलंड आयलंड पाउंड", "", "", "", "फॉकलंड आयलंड पाउंड्स"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ब्रिटिश पाऊंड", PluralForms{"", "ब्रिटिश पाऊंड", "", "", "", "ब्रिटिश पाऊंड्स"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "जॉर्जियन लारी", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "घानीयन सेडी", PluralForms{"", "घानीयन सेडी", "", "", "", "घानीयन सेडीस"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "जिब्राल्टर पाउंड", PluralForms{"", "जिब्राल्टर पाउंड", "", "", "", "जिब्राल्टर पाउंड्स"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "गाम्बियन डालासी", PluralForms{"", "गाम्बियन डालासी", "", "", "", "गाम्बियन डालासीस"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "गिनी फ्रँक", PluralForms{"", "गिनी फ्रँक", "", "", "", "गिनी फ्रँक्स"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ग्वाटेमालाचे क्वेत्झाल", PluralForms{"", "ग्वाटेमालाचे क्वेत्झाल", "", "", "", "ग्वाटेमालाचे क्वेत्झाल्स"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "गयाना डॉलर", PluralForms{"", "गयाना डॉलर", "", "", "", "गयाना डॉलर्स"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "हाँगकाँग डॉलर", PluralForms{"", "हाँगकाँग डॉलर", "", "", "", "हाँगकाँग डॉलर्स"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "होन्डुरन लेंपिरा", PluralForms{"", "होन्डुरन लेंपिरा", "", "", "", "होन्डुरन लेंपिराज"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "क्रोएशियन कूना", PluralForms{"", "क्रोएशियन कूना", "", "", "", "क्रोएशियन कूनाज"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "हैती गोअर्ड", PluralForms{"", "हैती गोअर्ड", "", "", "", "हैती गोअर्ड्स"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "हंगेरियन फॉरिन्ट", PluralForms{"", "हंगेरियन फॉरिन्ट", "", "", "", "हंगेरियन फॉरिन्ट्स"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "इंडोनेशियन रुपिया", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ईस्त्रायली न्यू शेकेल", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "भारतीय रुपया", PluralForms{"", "भारतीय रुपया", "", "", "", "भारतीय रुपये"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "इराकी दिनार", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "इराणी रियाल", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "आइसलँडिक क्रोना", PluralForms{"", "आइसलँडिक क्रोना", "", "", "", "आइसलँडिक क्रोन्युर"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "जमैकन डॉलर", PluralForms{"", "जमैकन डॉलर", "", "", "", "जमैकन डॉलर्स"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "जॉर्डनियन दिनार", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "जपानी येन", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "केनियन शिलिंग", PluralForms{"", "केनियन शिलिंग", "", "", "", "केनियन शिलिंग्स"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "किरगिस्तानी सॉम", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "कंबोडियन रियेल", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "कोमोरियन फ्रँक", PluralForms{"", "कोमोरियन फ्रँक", "", "", "", "कोमोरियन फ्रँक्स"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "उत्तर कोरियन वॉन", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "दक्षिण कोरियन वॉन", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "कुवैती दिनार", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "केमेन आयलॅंड डॉलर", PluralForms{"", "केमेन आयलॅंड डॉलर", "", "", "", "केमेन आयलॅंड डॉलर्स"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "कझाकिस्तानी तेंगे", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "लाओशियन किप", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "लेबनीज पाउंड", PluralForms{"", "लेबनीज पाउंड", "", "", "", "लेबनीज पाउंड्स"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "श्रीलंकन रुपया", PluralForms{"", "श्रीलंकन रुपया", "", "", "", "श्रीलंकन रुपये"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "लाइबेरियन डॉलर", PluralForms{"", "लाइबेरियन डॉलर", "", "", "", "लाइबेरियन डॉलर्स"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "लेसोटो लोटी", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "लिथुआनियन लिटास", PluralForms{"", "लिथुआनियन लिटास", "", "", "", "लिथुआनियन लिटाई"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "लाट्व्हियन लाट्झ", PluralForms{"", "लाट्व्हियन लाट्झ", "", "", "", "लाट्व्हियन लाटी"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "लिबियाचा दिनार", PluralForms{"", "लिबियाचा दिनार", "", "", "", "लिबियन दिनार"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "मोरोक्को दिरहॅम", PluralForms{"", "मोरोक्को दिरहॅम", "", "", "", "मोरोक्कन दिरहाम"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "मोल्डोवन लेउ", PluralForms{"", "मोल्डोवन लेउ", "", "", "", "मोल्डोवन लेई"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "मालागासी एरियारी", PluralForms{"", "मालागासी एरियारी", "", "", "", "मालागासी एरियारीज"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "मॅसेडोनियन देनार", PluralForms{"", "मॅसेडोनियन देनार", "", "", "", "मॅसेडोनियन देनारी"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "म्यानमार क्याट", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "मंगोलियन टुग्रिक", PluralForms{"", "मंगोलियन टुग्रिक", "", "", "", "मंगोलियन टुग्रिक्स"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "मॅकॅनीज् पटाका", PluralForms{"", "मॅकॅनीज् पटाका", "", "", "", "मॅकॅनीज् पटाकाज"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "मॉरिटानियन ओगिया (1973–2017)", PluralForms{"", "मॉरिटानियन ओगिया (1973–2017)", "", "", "", "मॉरिटानियन ओगियाज (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "मॉरिटानियन ओगिया", PluralForms{"", "मॉरिटानियन ओगिया", "", "", "", "मॉरिटानियन ओगियाज"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "मॉरिशियन रुपी", PluralForms{"", "मॉरिशियन रुपी", "", "", "", "मॉरिशियन रुपीज"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "मालदीवियन रुफिया", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "मालावियन क्वाचा", PluralForms{"", "मालावियन क्वाचा", "", "", "", "मालावियन क्वाचास"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "मेक्सिको पेसो", PluralForms{"", "मेक्सिको पेसो", "", "", "", "मेक्सिको पेसोज"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "मलेशियन रिंगिट", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "मोझांबिकन मेटिकल", PluralForms{"", "मोझांबिकन मेटिकल", "", "", "", "मोझांबिकन मेटिकल्स"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "नमिबियन डॉलर", PluralForms{"", "नमिबियन डॉलर", "", "", "", "नमिबियन डॉलर्स"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "नायजेरियन नायरा", PluralForms{"", "नायजेरियन नायरा", "", "", "", "नायजेरियन नायराज"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "निकाराग्वन कोर्डोबा", PluralForms{"", "निकाराग्वन कोर्डोबा", "", "", "", "निकाराग्वन कोर्डोबाज"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "नॉर्वेजियन क्रोन", PluralForms{"", "नॉर्वेजियन क्रोन", "", "", "", "नॉर्वेजियन क्रोनर"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "नेपाळी रुपया", PluralForms{"", "नेपाळी रुपया", "", "", "", "नेपाळी रुपये"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "न्यूझीलँड डॉलर", PluralForms{"", "न्यूझीलँड डॉलर", "", "", "", "न्यूझीलँड डॉलर्स"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ओमानी रियाल", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "पनामा बाल्बोआ", PluralForms{"", "पनामा बाल्बोआ", "", "", "", "पनामा बाल्बोआज"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "पेरुवियन सोल", PluralForms{"", "पेरुवियन सोल", "", "", "", "पेरुवियन सोल्स"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "पापुआ न्यू गिनीयन किना", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "फिलिपिनी पेसो", PluralForms{"", "फिलिपिनी पेसो", "", "", "", "फिलिपिनी पेसोज"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "पाकिस्तानी रुपया", PluralForms{"", "पाकिस्तानी रुपया", "", "", "", "पाकिस्तानी रुपये"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "पोलिश झ्लॉटी", PluralForms{"", "पोलिश झ्लॉटी", "", "", "", "पोलिश झ्लॉटीज"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "पराग्वे ग्वारानी", PluralForms{"", "पराग्वे ग्वारानी", "", "", "", "पराग्वे ग्वारानीज"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "कतारी रियाल", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "रोमानियन लेऊ", PluralForms{"", "रोमानियन लेऊ", "", "", "", "रोमानियन लेई"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "सर्बियन दिनार", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "रशियन रुबल", PluralForms{"", "रशियन रुबल", "", "", "", "रशियन रुबल्स"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "रवांडा फ्रँक", PluralForms{"", "रवांडा फ्रँक", "", "", "", "रवांडा फ्रँक्स"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "सौदी रियाल", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "सोलोमन आयलँड्स डॉलर", PluralForms{"", "सोलोमन आयलँड्स डॉलर", "", "", "", "सोलोमन आयलँड्स डॉलर्स"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "सेशेलोईस रुपी", PluralForms{"", "सेशेलोईस रुपी", "", "", "", "सेशेलोईस रुपीज"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "सुदानी पाउंड", PluralForms{"", "सुदानी पाउंड", "", "", "", "सुदानी पाउंड्स"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "स्वीडिश क्रोना", PluralForms{"", "स्वीडिश क्रोना", "", "", "", "स्वीडिश क्रोनोर"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "सिंगापूर डॉलर", PluralForms{"", "सिंगापूर डॉलर", "", "", "", "सिंगापूर डॉलर्स"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "सेंट हेलेना पाउंड", PluralForms{"", "सेंट हेलेना पाउंड", "", "", "", "सेंट हेलेना पाउंड्स"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "सिएरा लिऑनचा लिऑन", PluralForms{"", "सिएरा लिऑनचा लिऑन", "", "", "", "सिएरा लिऑनचे लिऑन्स"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "सिएरा लिऑनचा लिऑन (1964—2022)", PluralForms{"", "सिएरा लिऑनचा लिऑन (1964—2022)", "", "", "", "सिएरा लिऑनचे लिऑन्स (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "सोमाली शिलिंग", PluralForms{"", "सोमाली शिलिंग्स", "", "", "", "सोमाली शिलिंग"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "सुरिनामी डॉलर", PluralForms{"", "सुरिनामी डॉलर", "", "", "", "सुरिनामी डॉलर्स"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "दक्षिण सुदानी पाउंड", PluralForms{"", "दक्षिण सुदानी पाउंड", "", "", "", "दक्षिण सुदानी पाउंड्स"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "साओ टोम आणि प्रिन्सिपे डोबरा (1977–2017)", PluralForms{"", "साओ टोम आणि प्रिन्सिपे डोबरा (1977–2017)", "", "", "", "साओ टोम आणि प्रिन्सिपे डोबराज (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "साओ टोम आणि प्रिन्सिपे डोबरा", PluralForms{"", "साओ टोम आणि प्रिन्सिपे डोबरा", "", "", "", "साओ टोम आणि प्रिन्सिपे डोबराज"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "सीरियन पाउंड", PluralForms{"", "सीरियन पाउंड", "", "", "", "सीरियन पाउंड्स"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "स्वाझी लीलांगेनी", PluralForms{"", "स्वाझी लीलांगेनी", "", "", "", "स्वाझी एमालांगेनी"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "थाई बाहत", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "ताजकीस्तानी सोमोनी", PluralForms{"", "ताजकीस्तानी सोमोनी", "", "", "", "ताजकीस्तानी सोमोनीज"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "तुर्कमेनिस्तानी मानाट", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "ट्यूनिशियन दिनार", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "टोंगन पाआंगा", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "तुर्की लिरा", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "त्रिनिदाद आणि टोबॅगो डॉलर", PluralForms{"", "त्रिनिदाद आणि टोबॅगो डॉलर", "", "", "", "त्रिनिदाद आणि टोबॅगो डॉलर्स"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "न्यू तैवान डॉलर", PluralForms{"", "न्यू तैवान डॉलर", "", "", "", "न्यू तैवान डॉलर्स"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "टांझानियन शिलिंग", PluralForms{"", "टांझानियन शिलिंग", "", "", "", "टांझानियन शिलिंग्स"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "युक्रेनियन रिवनिया", PluralForms{"", "युक्रेनियन रिवनिया", "", "", "", "युक्रेनियन रिवनियाज"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "युगांडा शिलिंग", PluralForms{"", "युगांडा शिलिंग", "", "", "", "युगांडा शिलिंग्स"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "यूएस डॉलर", PluralForms{"", "यूएस डॉलर", "", "", "", "यूएस डॉलर्स"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "उरुग्वेचा पेसो", PluralForms{"", "उरुग्वेचा पेसो", "", "", "", "उरुग्वेचे पेसोज"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "उझबेकिस्तानी सोम", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "व्हेनेझुएला बोलिव्हार (2008–2018)", PluralForms{"", "व्हेनेझुएला बोलिव्हार (2008–2018)", "", "", "", "व्हेनेझुएला बोलिव्हार्स (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "व्हेनेझुएला बोलिव्हार", PluralForms{"", "व्हेनेझुएला बोलिव्हार", "", "", "", "व्हेनेझुएला बोलिव्हार्स"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "व्हिएतनामी डोंग", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "वानुआतु वाटु", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "सामोअन टाला", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "मध्य आफ्रिकन [CFA] फ्रँक", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "पूर्व कॅरीबियन डॉलर", PluralForms{"", "पूर्व कॅरीबियन डॉलर", "", "", "", "पूर्व कॅरीबियन डॉलर्स"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "पश्चिम आफ्रिकन [CFA] फ्रँक", PluralForms{"", "पश्चिम आफ्रिकन [CFA] फ्रँक", "", "", "", "पश्चिम आफ्रिकन [CFA] फ्रँक्स"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "[CFP] फ्रँक", PluralForms{"", "[CFP] फ्रँक", "", "", "", "[CFP] फ्रँक्स"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "अज्ञात चलन", PluralForms{"", "(चलनाचे अज्ञात एकक)", "", "", "", "(अज्ञात चलन)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "येमेनी रियाल", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "दक्षिण आफ्रिकी रँड", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "झांबियन क्वाचा (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "झांबियन क्वाचा", PluralForms{"", "झांबियन क्वाचा", "", "", "", "झांबियन क्वाचास"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,177 +6,230 @@ var neIN = LocaleData{
 	NumberInfo{"deva",
 		[10]string{"०", "१", "२", "३", "४", "५", "६", "७", "८", "९"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0हजार", "other": "0\u00a0हजार"}},
+				{4, 2, map[string]string{"one": "0\u00a0हजार", "other": "0\u00a0हजार"}},
+				{5, 1, map[string]string{"one": "0\u00a0लाख", "other": "0\u00a0लाख"}},
+				{6, 2, map[string]string{"one": "0\u00a0लाख", "other": "0\u00a0लाख"}},
+				{7, 1, map[string]string{"one": "0\u00a0करोड", "other": "0\u00a0करोड"}},
+				{8, 2, map[string]string{"one": "0\u00a0करोड", "other": "0\u00a0करोड"}},
+				{9, 1, map[string]string{"one": "0\u00a0अरब", "other": "0\u00a0अरब"}},
+				{10, 2, map[string]string{"one": "0\u00a0अरब", "other": "0\u00a0अरब"}},
+				{11, 1, map[string]string{"one": "0\u00a0खरब", "other": "0\u00a0खरब"}},
+				{12, 2, map[string]string{"one": "0\u00a0खरब", "other": "0\u00a0खरब"}},
+				{13, 1, map[string]string{"one": "0\u00a0शंख", "other": "0\u00a0शंख"}},
+				{14, 2, map[string]string{"one": "0\u00a0शंख", "other": "0\u00a0शंख"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 हजार", "other": "0 हजार"}},
+				{4, 2, map[string]string{"one": "0 हजार", "other": "0 हजार"}},
+				{5, 1, map[string]string{"one": "0 लाख", "other": "0 लाख"}},
+				{6, 1, map[string]string{"one": "0 करोड", "other": "0 करोड"}},
+				{7, 2, map[string]string{"one": "0 करोड", "other": "0 करोड"}},
+				{8, 3, map[string]string{"one": "0 करोड", "other": "0 करोड"}},
+				{9, 1, map[string]string{"one": "0 अरब", "other": "0 अरब"}},
+				{10, 2, map[string]string{"one": "0 अरब", "other": "0 अरब"}},
+				{11, 3, map[string]string{"one": "0 अरब", "other": "0 अरब"}},
+				{12, 2, map[string]string{"one": "0 खरब", "other": "0 खरब"}},
+				{13, 1, map[string]string{"one": "0 शंख", "other": "0 शंख"}},
+				{14, 2, map[string]string{"one": "0 शंख", "other": "0 शंख"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a00\u00a0हजार", "other": "¤\u00a00\u00a0हजार"}},
+				{4, 2, map[string]string{"one": "¤\u00a00\u00a0हजार", "other": "¤\u00a00\u00a0हजार"}},
+				{5, 1, map[string]string{"one": "¤\u00a00\u00a0लाख", "other": "¤\u00a00\u00a0लाख"}},
+				{6, 2, map[string]string{"one": "¤\u00a00\u00a0लाख", "other": "¤\u00a00\u00a0लाख"}},
+				{7, 1, map[string]string{"one": "¤\u00a00\u00a0करोड", "other": "¤\u00a00\u00a0करोड"}},
+				{8, 2, map[string]string{"one": "¤\u00a00\u00a0करोड", "other": "¤\u00a00\u00a0करोड"}},
+				{9, 1, map[string]string{"one": "¤\u00a00\u00a0अरब", "other": "¤\u00a00\u00a0अरब"}},
+				{10, 2, map[string]string{"one": "¤\u00a00\u00a0अरब", "other": "¤\u00a00\u00a0अरब"}},
+				{11, 1, map[string]string{"one": "¤\u00a00\u00a0खरब", "other": "¤\u00a00\u00a0खरब"}},
+				{12, 2, map[string]string{"one": "¤\u00a00\u00a0खरब", "other": "¤\u00a00\u00a0खरब"}},
+				{13, 1, map[string]string{"one": "¤\u00a00\u00a0शंख", "other": "¤\u00a00\u00a0शंख"}},
+				{14, 2, map[string]string{"one": "¤\u00a00\u00a0शंख", "other": "¤\u00a00\u00a0शंख"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "नेरू", "रू"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "संयुक्त अरब एमिराट्स डिर्हाम", PluralForms{"", "", "", "", "", ""}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "अफ्गानी(१९२७–२००२)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "अफ्गान अफ्गानी", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "अल्बानियन लेक", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "आर्मेनियाली ड्राम", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "नेदरल्याण्ड्स एन्टिलियन गिल्डर", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "एङ्गोलान क्वान्जा", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "अर्जेन्टिनी पेसो", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "अष्ट्रेलियन डलर", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "आरूबन फ्लोरिन", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "अजरबैजानी मानात", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "बोस्निया-हर्जगोभिनिया रूपान्तरयोग्य मार्क", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "बर्बाडियन डलर", PluralForms{"", "बार्बाडियन डलर", "", "", "", "बर्बाडियन डलर"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "बङ्गलादेशी टाका", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "बुल्गारियाली लेभ", PluralForms{"", "बुल्गारियाली लेभ", "", "", "", "बुल्गारियाली लेभा"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "बाहारैनी डिनार", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "बुरूण्डियाली फ्रान्क", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "बर्मुडन डलर", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ब्रुनाई डलर", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "बोलिभियन बोलिभियानो", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ब्राजिलियन रियल", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "बहामियाली डलर", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "भुटानी एन्\u200cगुल्ट्रुम", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "बोट्सवानान पुला", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "बेलारूसी रूबल", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "बेलारूसी रूबल (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "वेलिज डलर", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "क्यानाडियाली डलर", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "कङ्गोली फ्रान्क", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "स्विस् फ्रैङ्क", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "चिलियन पेसो", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "चिनियाँ युआन(तटवर्ती)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "चिनिँया युआन", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "कोलम्वियन पेसो", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "कोष्टारिकन कोलोन", PluralForms{"", "कोष्टारिकन कोलोन", "", "", "", "कोष्टारिकन कोलोनहरू"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "क्यूवाली रूपान्तरणयोग्य पेसो", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "क्यूवाली पेसो", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "केप भर्डियन एस्कुडो", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "चेख गणतञ्त्र कोरूना", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "जिबौंटियाली फ्रान्क", PluralForms{"", "जिबौटियाली फ्रान्क", "", "", "", "जिबौंटियाली फ्रान्क"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ड्यानिश क्रोन", PluralForms{"", "ड्यानिश क्रोन", "", "", "", "ड्यानिश क्रोनर"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "डोमिनिकन पेसो", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "अल्जेरियाली डिनार", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "इजिप्सियन पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "एरिट्रियन नाक्फा", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "इथियोपियाली बिर", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "युरो", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "फिजीयाली डलर", PluralForms{"", "फिजीयाली डलर", "", "", "", "फिजीयाली डलरहरू"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "फक्\u200cल्याण्ड टापुहरूका पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "बेलायती पाउण्ड स्टर्लिङ", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "जर्जियाली लारी", PluralForms{"", "जर्जियाली लारी", "", "", "", "जर्जियन लारी"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "घानाली सेडी", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "जिब्राल्टर पाउण्ड", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "गाम्वियाली डालासी", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "गिनियाली फ्रान्क", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ग्वाटेमाला क्वेट्जाल", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "गाइनिज डलर", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "हङकङ डलर", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "होन्डुरान लेम्पिरा", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "क्रोएशियाली कुना", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "हैटियाली गुर्ड", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "हङ्गेरियन फोरिन्ट", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "इण्डोनेशियाली रूपियाँ", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "इजरायली नयाँ शेकेल", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "भारतीय रूपिँया", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "इराकी डिनार", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "इरानियाली रियाल", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "आइसल्याण्डिक क्रोना", PluralForms{"", "आइसल्याण्डिक क्रोना", "", "", "", "आइसल्याण्डिक क्रोनर"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "जमाइकाली डलर", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "जोर्डानियाली डलर", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "जापानी येन", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "केन्याली शिलिङ", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "किर्गिस्तानी सोम", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "कम्बोडिनेयाली रियल", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "कोमोरियन फ्रान्क", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "उत्तर कोरियाली वन", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "दक्षिण कोरियाली वन", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "कुवेती डिनार", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "केम्यान टापुहरूका डलर", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "काजाखस्तानी टेन्ज", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "लाओशियन किप", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "लेबनाली पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "श्रीलङ्काली रूपिया", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "लिबेरियाली डलर", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "लेसोथो लोटी", PluralForms{"", "लेसोथो लोटी", "", "", "", "लेसोथो लोटिस"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "लिथुनियाली लिटास", PluralForms{"", "लिथुनियाली लिटास", "", "", "", "लिथुनियाली लिटाई"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "लाट्भियाली लाट्स", PluralForms{"", "लाट्भियाली लाट्स", "", "", "", "लाट्भियाली लाटी"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "लिवियाली डिनार", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "मोरोक्काली डिर्\u200cहाम", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "माल्डोभन लेउ", PluralForms{"", "माल्डोभन लेउ", "", "", "", "माल्डोभन लेई"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "मालागासी एरिआरी", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "म्यासेडोनियाली डेनार", PluralForms{"", "म्यासेडोनियाली डेनार", "", "", "", "म्यासेडोनियाली डेनारी"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "म्यान्मार क्याट", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "मङ्गोलियाली टुग्रिक", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "माकानिज पटाका", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "माउरिटानियानली औगुइया (१९७३–२०१७)", PluralForms{"", "माउरिटानियाली औगुइया (१९७३–२०१७)", "", "", "", "माउरिटानियानली औगुइया (१९७३–२०१७)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "माउरिटानियानली औगुइया", PluralForms{"", "माउरिटानियाली औगुइया", "", "", "", "माउरिटानियानली औगुइया"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "माउरिटियन रूपी", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "मालडिभियाली रूफियाँ", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "मलाविअन क्वाचा", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "मेक्सिकन पेसो", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "मलेशियाली रिङ्गेट", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "मोजाम्विकन मेटिकल", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "नामिबियन डलर", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "नाइजेरियन नाइरा", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "निकारागुवान कोर्डोवा", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "नर्वेजियाली क्रोन", PluralForms{"", "नर्वेजियाली क्रोन", "", "", "", "नर्वेजियाली क्रोनर"}},
+		"NPR": {2, 0, 2, 1, "NPR", "नेरू", "रू", "नेपाली रूपैयाँ", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "न्यूजिल्याण्ड डलर", PluralForms{"", "न्यूजिल्याण्ड डलर", "", "", "", "न्यूजिल्याण्ड डलरहरू"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ओमनी रियल", PluralForms{"", "ओमनी रियाल", "", "", "", "ओमनी रियल"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "पानामानियाली बाल्बोआ", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "पेरूभियाली सोल", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "पपुआ न्यू गिनियाली किना", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "फिलिपिनी पेसो", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "पाकिस्तानी रूपियाँ", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "पोलिश ज्लोटाई", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "पारागुयाली गुरानी", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "कतारी रियल", PluralForms{"", "कतारी रियाल", "", "", "", "कतारी रियल"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "रोमानियाली लेऊ", PluralForms{"", "रोमानियाली लेऊ", "", "", "", "रोमानियाली लेई"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "सर्बियाली डिनार", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "रूसी रूबल", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "र्\u200cवाण्डाली फ्रान्क", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "साउदी रियालहरू", PluralForms{"", "साउदी रियाल", "", "", "", "साउदी रियाल"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "सोलोमन टापुहरूका डलर", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "सेचेलोइस रूपी", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "सुडानी पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "स्विडिश क्रोना", PluralForms{"", "स्विडिश क्रोना", "", "", "", "स्विडिश क्रोनर"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "सिङ्गापुर डलर", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "सेन्ट हेलेना पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "सियरा लियोनेन लियोन", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "सियरा लियोनेन लियोन (१९६४—२०२२)", PluralForms{"", "सियरा लियोनेन लियोन (१९६४—२०२२)", "", "", "", "सियरा लियोनेन लियोन (१९६४—२०२२)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "सोमाली शिलिङ", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "सुरिनामिज डलर", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "दक्षिण सुडानी पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "साओ टोम र प्रिन्सिप डोब्रा (१९७७–२०१७)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "साओ टोम र प्रिन्सिप डोब्रा", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "सिरियाली पाउन्ड", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "स्वाजी लिलान्गेनी", PluralForms{"", "स्वाजी लिलान्गेनी", "", "", "", "स्वाजी इमालेन्गेनी"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "थाई भाट", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "ताजिक्स्तानी सोमोनी", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "टुर्क्मेनिस्तानी मानात", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "टुनिसियाली डिनार", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "टङ्गन पाङ्गा", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "टर्किश लिरा", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "त्रिनिडाड र टोबागो डलर", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "नयाँ ताइवान डलर", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "ताञ्जानियाली शिलिङ", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "युक्रेनी हिर्भिनिया", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "युगाण्डाली शिलिङ", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "अमेरिकी डलर", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "उरूगुवायाली पेसो", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "उज्बेकिस्तान सोम", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "भेनेजुएलन बोलिभर (२००८–२०१८)", PluralForms{"", "भेनेजुएलन बोलिभर", "", "", "", "भेनेजुएलन बोलिभर"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "भेनेजुएलन बोलिभर-2", PluralForms{"", "भेनेजुएलन बोलिभर मुद्रा", "", "", "", "भेनेजुएलन बोलिभर-3"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "भियतनामी डङ्", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "भानुआतू भातु", PluralForms{"", "भानुआतू भातु", "", "", "", "भानुआतू भातुहरू"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "सामोआन ताला", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "सीएफ्\u200cए फ्रान्क बीइएसी", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "पूर्वी क्यारिबियन डलर", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "सीएफ्\u200cए फ्रान्क बीसीइएओ", PluralForms{"", "सीएफ्\u200cए फ्रान्क बीसीइएओ", "", "", "", "सीऐफ्\u200cए फ्रान्क्स बीसीइएओ"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "सीएफ्\u200cपी फ्रान्क", PluralForms{"", "सीएफ्\u200cपी फ्रान्क", "", "", "", "सीएफ्\u200cपी फ्रान्कहरू"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "अज्ञात मुद्रा", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "येमेनी रियाल", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "दक्षिण अफ्रिकी र्\u200dयान्ड", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "जाम्बियाली क्वाचा (१९६८–२०१२)", PluralForms{"", "जाम्बियाली क्वाचा (१९६८–२०१२)", "", "", "", "जाम्बियाली क्वाचाहरू (१९६८–२०१२)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "जाम्बियाली क्वाचा", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,313 +6,366 @@ var no = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"E", "×",
+		":", "ca.",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0k", "other": "0k"}},
+				{4, 2, map[string]string{"one": "0k", "other": "0k"}},
+				{5, 3, map[string]string{"one": "0k", "other": "0k"}},
+				{6, 1, map[string]string{"one": "0\u00a0mill.", "other": "0\u00a0mill."}},
+				{7, 2, map[string]string{"one": "0\u00a0mill.", "other": "0\u00a0mill."}},
+				{8, 3, map[string]string{"one": "0\u00a0mill.", "other": "0\u00a0mill."}},
+				{9, 1, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0bill.", "other": "0\u00a0bill."}},
+				{13, 2, map[string]string{"one": "0\u00a0bill.", "other": "0\u00a0bill."}},
+				{14, 3, map[string]string{"one": "0\u00a0bill.", "other": "0\u00a0bill."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 tusen", "other": "0 tusen"}},
+				{4, 2, map[string]string{"one": "0 tusen", "other": "0 tusen"}},
+				{5, 3, map[string]string{"one": "0 tusen", "other": "0 tusen"}},
+				{6, 1, map[string]string{"one": "0 million", "other": "0 millioner"}},
+				{7, 2, map[string]string{"one": "0 millioner", "other": "0 millioner"}},
+				{8, 3, map[string]string{"one": "0 millioner", "other": "0 millioner"}},
+				{9, 1, map[string]string{"one": "0 milliard", "other": "0 milliarder"}},
+				{10, 2, map[string]string{"one": "0 milliarder", "other": "0 milliarder"}},
+				{11, 3, map[string]string{"one": "0 milliarder", "other": "0 milliarder"}},
+				{12, 1, map[string]string{"one": "0 billion", "other": "0 billioner"}},
+				{13, 2, map[string]string{"one": "0 billioner", "other": "0 billioner"}},
+				{14, 3, map[string]string{"one": "0 billioner", "other": "0 billioner"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a00k", "other": "¤\u00a00k"}},
+				{4, 2, map[string]string{"one": "¤\u00a00k", "other": "¤\u00a00k"}},
+				{5, 3, map[string]string{"one": "¤\u00a00k", "other": "¤\u00a00k"}},
+				{6, 1, map[string]string{"one": "¤\u00a00\u00a0mill.", "other": "¤\u00a00\u00a0mill."}},
+				{7, 2, map[string]string{"one": "¤\u00a00\u00a0mill.", "other": "¤\u00a00\u00a0mill."}},
+				{8, 3, map[string]string{"one": "¤\u00a00\u00a0mill.", "other": "¤\u00a00\u00a0mill."}},
+				{9, 1, map[string]string{"one": "¤\u00a00\u00a0mrd.", "other": "¤\u00a00\u00a0mrd."}},
+				{10, 2, map[string]string{"one": "¤\u00a00\u00a0mrd.", "other": "¤\u00a00\u00a0mrd."}},
+				{11, 3, map[string]string{"one": "¤\u00a00\u00a0mrd.", "other": "¤\u00a00\u00a0mrd."}},
+				{12, 1, map[string]string{"one": "¤\u00a00\u00a0bill.", "other": "¤\u00a00\u00a0bill."}},
+				{13, 2, map[string]string{"one": "¤\u00a00\u00a0bill.", "other": "¤\u00a00\u00a0bill."}},
+				{14, 3, map[string]string{"one": "¤\u00a00\u00a0bill.", "other": "¤\u00a00\u00a0bill."}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"ca. {0}", "≥ {0}", "≤ {0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "kr", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "XAF", "XAF"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "XPF", "XPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorranske pesetas", PluralForms{"", "andorransk pesetas", "", "", "", "andorranske pesetas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "emiratarabiske dirham", PluralForms{"", "emiratarabisk dirham", "", "", "", "emiratarabiske dirham"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afgansk afghani (1927–2002)", PluralForms{"", "afghansk afghani (1927–2002)", "", "", "", "afghanske afghani (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghanske afghani", PluralForms{"", "afghansk afghani", "", "", "", "afghanske afghani"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "albanske lek (1946–1965)", PluralForms{"", "albansk lek (1946–1965)", "", "", "", "albanske lek (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albanske lek", PluralForms{"", "albansk lek", "", "", "", "albanske lek"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armenske dram", PluralForms{"", "armensk dram", "", "", "", "armenske dram"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "nederlandske antillegylden", PluralForms{"", "nederlandsk antillegylden", "", "", "", "nederlandske antillegylden"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolanske kwanza", PluralForms{"", "angolansk kwanza", "", "", "", "angolanske kwanza"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "angolanske kwanza (1977–1990)", PluralForms{"", "angolansk kwanza (1977–1990)", "", "", "", "angolanske kwanza (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolanske nye kwanza (1990–2000)", PluralForms{"", "angolansk ny kwanza", "", "", "", "angolanske nye kwanza (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "angolanske omjusterte kwanza (1995–1999)", PluralForms{"", "angolansk kwanza reajustado (1995–1999)", "", "", "", "angolanske omjusterte kwanza (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentinske australer", PluralForms{"", "argentinsk austral", "", "", "", "argentinske australer"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "argentinske peso ley", PluralForms{"", "argentinsk peso ley", "", "", "", "argentinske peso ley"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "argentinsk pesos (1881–1970)", PluralForms{"", "argentinsk pesos (1881–1970)", "", "", "", "argentinske pesos (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentinske pesos (1983–1985)", PluralForms{"", "argentinsk pesos (1983–1985)", "", "", "", "argentinske pesos (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinske pesos", PluralForms{"", "argentinsk peso", "", "", "", "argentinske pesos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "østerrikske shilling", PluralForms{"", "østerriksk schilling", "", "", "", "østerrikske schilling"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "australske dollar", PluralForms{"", "australsk dollar", "", "", "", "australske dollar"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubiske floriner", PluralForms{"", "arubisk florin", "", "", "", "arubiske floriner"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "aserbajdsjanske manat (1993–2006)", PluralForms{"", "aserbajdsjansk manat (1993–2006)", "", "", "", "aserbajdsjanske manat (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "aserbajdsjanske manat", PluralForms{"", "aserbajdsjansk manat", "", "", "", "aserbajdsjanske manat"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosnisk-hercegovinske dinarer (1992–1994)", PluralForms{"", "bosnisk-hercegovinsk dinar (1992–1994)", "", "", "", "bosnisk-hercegovinske dinarer (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosnisk-hercegovinske konvertible mark", PluralForms{"", "bosnisk-hercegovinsk konvertibel mark", "", "", "", "bosnisk-hercegovinske konvertible mark"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "nye bosnisk-hercegovinske dinarer (1994–1997)", PluralForms{"", "ny bosnisk-hercegovinsk dinar (1994–1997)", "", "", "", "nye bosnisk-hercegovinske dinarer (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadiske dollar", PluralForms{"", "barbadisk dollar", "", "", "", "barbadiske dollar"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladeshiske taka", PluralForms{"", "bangladeshisk taka", "", "", "", "bangladeshiske taka"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgiske franc (konvertible)", PluralForms{"", "belgisk franc (konvertibel)", "", "", "", "belgiske franc (konvertible)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgiske franc", PluralForms{"", "belgisk franc", "", "", "", "belgiske franc"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgiske franc (finansielle)", PluralForms{"", "belgisk franc (finansiell)", "", "", "", "belgiske franc (finansielle)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "bulgarske lev (hard)", PluralForms{"", "bulgarsk lev (hard)", "", "", "", "bulgarske lev (hard)"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "bulgarske lev (sosialist)", PluralForms{"", "bulgarsk lev (sosialist)", "", "", "", "bulgarske lev (sosialist)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bulgarske lev", PluralForms{"", "bulgarsk lev", "", "", "", "bulgarske lev"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "bulgarske lev (1879–1952)", PluralForms{"", "bulgarsk lev (1879–1952)", "", "", "", "bulgarske lev (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrainske dinarer", PluralForms{"", "bahrainsk dinar", "", "", "", "bahrainske dinarer"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundiske franc", PluralForms{"", "burundisk franc", "", "", "", "burundiske franc"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudiske dollar", PluralForms{"", "bermudisk dollar", "", "", "", "bermudiske dollar"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "bruneiske dollar", PluralForms{"", "bruneisk dollar", "", "", "", "bruneiske dollar"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolivianske boliviano", PluralForms{"", "boliviansk boliviano", "", "", "", "bolivianske boliviano"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "bolivianske boliviano (1863–1963)", PluralForms{"", "boliviansk boliviano (1863–1963)", "", "", "", "bolivianske boliviano (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "bolivianske pesos", PluralForms{"", "boliviansk pesos", "", "", "", "bolivianske pesos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "bolivianske mvdol", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brasilianske cruzeiro novo (1967–1986)", PluralForms{"", "brasiliansk cruzeiro novo (1967–1986)", "", "", "", "brasilianske cruzeiro novo (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brasilianske cruzados (1986–1989)", PluralForms{"", "brasiliansk cruzado (1986–1989)", "", "", "", "brasilianske cruzado (1986–1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "brasilianske cruzeiro (1990–1993)", PluralForms{"", "brasiliansk cruzeiro (1990–1993)", "", "", "", "brasilianske cruzeiro (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "brasilianske real", PluralForms{"", "brasiliansk real", "", "", "", "brasilianske real"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "brasilianske cruzado novo (1989–1990)", PluralForms{"", "brasiliansk cruzado novo (1989–1990)", "", "", "", "brasilianske cruzado novo (1989–1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "brasilianske cruzeiro (1993–1994)", PluralForms{"", "brasiliansk cruzeiro (1993–1994)", "", "", "", "brasilianske cruzeiro (1993–1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "brasilianske cruzeiro (1942–1967)", PluralForms{"", "brasiliansk cruzeiro (1942–1967)", "", "", "", "brasilianske cruzeiro (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamanske dollar", PluralForms{"", "bahamansk dollar", "", "", "", "bahamanske dollar"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bhutanske ngultrum", PluralForms{"", "bhutansk ngultrum", "", "", "", "bhutanske ngultrum"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "burmesiske kyat", PluralForms{"", "burmesisk kyat", "", "", "", "burmesiske kyat"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botswanske pula", PluralForms{"", "botswansk pula", "", "", "", "botswanske pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "hviterussiske nye rubler (1994–1999)", PluralForms{"", "hviterussisk ny rubel (1994–1999)", "", "", "", "hviterussiske nye rubler (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "nye belarusiske rubler", PluralForms{"", "ny belarusisk rubel", "", "", "", "nye belarusiske rubler"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "hviterussiske rubler (2000–2016)", PluralForms{"", "hviterussisk rubel (2000–2016)", "", "", "", "hviterussiske rubler (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "beliziske dollar", PluralForms{"", "belizisk dollar", "", "", "", "beliziske dollar"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "kanadiske dollar", PluralForms{"", "kanadisk dollar", "", "", "", "kanadiske dollar"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongolesiske franc", PluralForms{"", "kongolesisk franc", "", "", "", "kongolesiske franc"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR euro", PluralForms{"", "WIR-euro", "", "", "", "WIR-euro"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "sveitsiske franc", PluralForms{"", "sveitsisk franc", "", "", "", "sveitsiske franc"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR franc", PluralForms{"", "WIR-franc", "", "", "", "WIR-franc"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "chilenske escudo", PluralForms{"", "chilensk escudo", "", "", "", "chilenske escudo"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "chilenske unidades de fomento", PluralForms{"", "chilensk unidades de fomento", "", "", "", "chilenske unidades de fomento"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "chilenske pesos", PluralForms{"", "chilensk peso", "", "", "", "chilenske pesos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kinesiske yuan (offshore)", PluralForms{"", "kinesisk yuan (offshore)", "", "", "", "kinesiske yuan (offshore)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Kinas folkebank dollar", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "kinesiske yuan", PluralForms{"", "kinesisk yuan", "", "", "", "kinesiske yuan"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "colombianske pesos", PluralForms{"", "colombiansk peso", "", "", "", "colombianske pesos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "colombianske unidad de valor real", PluralForms{"", "colombiansk unidad de valor real", "", "", "", "colombianske unidad de valor real"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "costaricanske colón", PluralForms{"", "costaricansk colón", "", "", "", "costaricanske colón"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "serbiske dinarer (2002–2006)", PluralForms{"", "serbisk dinar (2002–2006)", "", "", "", "serbiske dinarer (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "tsjekkoslovakiske koruna (hard)", PluralForms{"", "tsjekkoslovakisk koruna (hard)", "", "", "", "tsjekkoslovakiske koruna (hard)"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubanske konvertible pesos", PluralForms{"", "kubansk konvertibel peso", "", "", "", "kubanske konvertible pesos"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubanske pesos", PluralForms{"", "kubansk peso", "", "", "", "kubanske pesos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "kappverdiske escudos", PluralForms{"", "kappverdisk escudo", "", "", "", "kappverdiske escudos"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "kypriotiske pund", PluralForms{"", "kypriotisk pund", "", "", "", "kypriotiske pund"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "tsjekkiske koruna", PluralForms{"", "tsjekkisk koruna", "", "", "", "tsjekkiske koruna"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "østtyske mark", PluralForms{"", "østtysk mark", "", "", "", "østtyske mark"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "tyske mark", PluralForms{"", "tysk mark", "", "", "", "tyske mark"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "djiboutiske franc", PluralForms{"", "djiboutisk franc", "", "", "", "djiboutiske franc"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "danske kroner", PluralForms{"", "dansk krone", "", "", "", "danske kroner"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikanske pesos", PluralForms{"", "dominikansk peso", "", "", "", "dominikanske pesos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "algeriske dinarer", PluralForms{"", "algerisk dinar", "", "", "", "algeriske dinarer"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ecuadorianske sucre", PluralForms{"", "ecuadoriansk sucre", "", "", "", "ecuadorianske sucre"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ecuadorianske unidad de valor constante (UVC)", PluralForms{"", "ecuadoriansk unidad de valor constante (UVC)", "", "", "", "ecuadorianske unidad de valor constante (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "estiske kroon", PluralForms{"", "estisk kroon", "", "", "", "estiske kroner"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egyptiske pund", PluralForms{"", "egyptisk pund", "", "", "", "egyptiske pund"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritreiske nakfa", PluralForms{"", "eritreisk nakfa", "", "", "", "eritreiske nakfa"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "spanske peseta (A–konto)", PluralForms{"", "spansk peseta (A–konto)", "", "", "", "spanske peseta (A–konto)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "spanske peseta (konvertibel konto)", PluralForms{"", "spansk peseta (konvertibel konto)", "", "", "", "spanske peseta (konvertibel konto)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "spanske peseta", PluralForms{"", "spansk peseta", "", "", "", "spanske peseta"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopiske birr", PluralForms{"", "etiopisk birr", "", "", "", "etiopiske birr"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "finske mark", PluralForms{"", "finsk mark", "", "", "", "finske mark"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fijianske dollar", PluralForms{"", "fijiansk dollar", "", "", "", "fijianske dollar"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklandspund", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "franske franc", PluralForms{"", "fransk franc", "", "", "", "franske franc"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "britiske pund", PluralForms{"", "britisk pund", "", "", "", "britiske pund"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "georgiske kupon larit", PluralForms{"", "georgisk kupon larit", "", "", "", "georgiske kupon larit"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "georgiske lari", PluralForms{"", "georgisk lari", "", "", "", "georgiske lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ghanesisk cedi (1979–2007)", PluralForms{"", "ghanesisk cedi (1979–2007)", "", "", "", "ghanesiske cedi (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ghanesiske cedi", PluralForms{"", "ghanesisk cedi", "", "", "", "ghanesiske cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltarske pund", PluralForms{"", "gibraltarsk pund", "", "", "", "gibraltarske pund"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambiske dalasi", PluralForms{"", "gambisk dalasi", "", "", "", "gambiske dalasi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "guineanske franc", PluralForms{"", "guineansk franc", "", "", "", "guineanske franc"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "guineanske syli", PluralForms{"", "guineansk syli", "", "", "", "guineanske syli"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekvatorialguineanske ekwele guineana", PluralForms{"", "ekvatorialguineansk ekwele guineana", "", "", "", "ekvatorialguineanske ekwele guineana"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "greske drakmer", PluralForms{"", "gresk drakme", "", "", "", "greske drakmer"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "guatemalanske quetzal", PluralForms{"", "guatemalansk quetzal", "", "", "", "guatemalanske quetzal"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "portugisiske guinea escudo", PluralForms{"", "portugisisk guinea escudo", "", "", "", "portugisiske guinea escudo"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissau-pesos", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "guyanske dollar", PluralForms{"", "guyansk dollar", "", "", "", "guyanske dollar"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "Hongkong-dollar", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduranske lempira", PluralForms{"", "honduransk lempira", "", "", "", "honduranske lempira"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "kroatiske dinarer", PluralForms{"", "kroatisk dinar", "", "", "", "kroatiske dinarer"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kroatiske kuna", PluralForms{"", "kroatisk kuna", "", "", "", "kroatiske kuna"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitiske gourde", PluralForms{"", "haitisk gourde", "", "", "", "haitiske gourde"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ungarske forinter", PluralForms{"", "ungarsk forint", "", "", "", "ungarske forinter"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonesiske rupier", PluralForms{"", "indonesisk rupi", "", "", "", "indonesiske rupier"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "irske pund", PluralForms{"", "irsk pund", "", "", "", "irske pund"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "israelske pund", PluralForms{"", "israelsk pund", "", "", "", "israelske pund"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "israelske shekler (1980–1985)", PluralForms{"", "israelsk shekel (1980–1985)", "", "", "", "israelske shekler (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "nye israelske shekler", PluralForms{"", "ny israelsk shekel", "", "", "", "nye israelske shekler"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "indiske rupier", PluralForms{"", "indisk rupi", "", "", "", "indiske rupier"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irakske dinarer", PluralForms{"", "iraksk dinar", "", "", "", "irakske dinarer"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iranske rialer", PluralForms{"", "iransk rial", "", "", "", "iranske rialer"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "islandske kroner (1918–1981)", PluralForms{"", "islandsk krone (1918–1981)", "", "", "", "islandske kroner (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandske kroner", PluralForms{"", "islandsk krone", "", "", "", "islandske kroner"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "italienske lire", PluralForms{"", "italiensk lire", "", "", "", "italienske lire"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamaikanske dollar", PluralForms{"", "jamaikansk dollar", "", "", "", "jamaikanske dollar"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordanske dinarer", PluralForms{"", "jordansk dinar", "", "", "", "jordanske dinarer"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "japanske yen", PluralForms{"", "japansk yen", "", "", "", "japanske yen"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenyanske shilling", PluralForms{"", "kenyansk shilling", "", "", "", "kenyanske shilling"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgisiske som", PluralForms{"", "kirgisisk som", "", "", "", "kirgisiske som"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodsjanske riel", PluralForms{"", "kambodsjansk riel", "", "", "", "kambodsjanske riel"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komoriske franc", PluralForms{"", "komorisk franc", "", "", "", "komoriske franc"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "nordkoreanske won", PluralForms{"", "nordkoreansk won", "", "", "", "nordkoreanske won"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "sørkoreanske hwan (1953–1962)", PluralForms{"", "sørkoreansk hwan (1953–1962)", "", "", "", "sørkoreanske hwan (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "sørkoreanske won (1945–1953)", PluralForms{"", "sørkoreansk won (1945–1953)", "", "", "", "sørkoreanske won (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "sørkoreanske won", PluralForms{"", "sørkoreansk won", "", "", "", "sørkoreanske won"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuwaitiske dinarer", PluralForms{"", "kuwaitisk dinar", "", "", "", "kuwaitiske dinarer"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "caymanske dollar", PluralForms{"", "caymansk dollar", "", "", "", "caymanske dollar"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kasakhstanske tenge", PluralForms{"", "kasakhstansk tenge", "", "", "", "kasakhstanske tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laotiske kip", PluralForms{"", "laotisk kip", "", "", "", "laotiske kip"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanesiske pund", PluralForms{"", "libanesisk pund", "", "", "", "libanesiske pund"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "srilankiske rupier", PluralForms{"", "srilankisk rupi", "", "", "", "srilankiske rupier"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberiske dollar", PluralForms{"", "liberisk dollar", "", "", "", "liberiske dollar"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesothiske loti", PluralForms{"", "lesothisk loti", "", "", "", "lesothiske loti"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litauiske litas", PluralForms{"", "litauisk lita", "", "", "", "litauiske lita"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "litauiske talonas", PluralForms{"", "litauisk talonas", "", "", "", "litauiske talonas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "luxemburgske konvertible franc", PluralForms{"", "luxemburgsk konvertibel franc", "", "", "", "luxemburgske konvertible franc"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "luxemburgske franc", PluralForms{"", "luxemburgsk franc", "", "", "", "luxemburgske franc"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "luxemburgske finansielle franc", PluralForms{"", "luxemburgsk finansiell franc", "", "", "", "luxemburgske finansielle franc"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "latviske lats", PluralForms{"", "latvisk lats", "", "", "", "latviske lats"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "latviske rubler", PluralForms{"", "latvisk rubel", "", "", "", "latviske rubler"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libyske dinarer", PluralForms{"", "libysk dinar", "", "", "", "libyske dinarer"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marokkanske dirham", PluralForms{"", "marokkansk dirham", "", "", "", "marokkanske dirham"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "marokkanske franc", PluralForms{"", "marokkansk franc", "", "", "", "marokkanske franc"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "monegaskiske franc", PluralForms{"", "monegaskisk franc", "", "", "", "monegaskiske franc"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "moldovske cupon", PluralForms{"", "moldovsk cupon", "", "", "", "moldovske cupon"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldovske leu", PluralForms{"", "moldovsk leu", "", "", "", "moldovske lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "madagassiske ariary", PluralForms{"", "madagassisk ariary", "", "", "", "madagassiske ariary"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "madagassiske franc", PluralForms{"", "madagassisk franc", "", "", "", "madagassiske franc"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonske denarer", PluralForms{"", "makedonsk denar", "", "", "", "makedonske denarer"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "makedonske denarer (1992–1993)", PluralForms{"", "makedonsk denar (1992–1993)", "", "", "", "makedonske denarer (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "maliske franc", PluralForms{"", "malisk franc", "", "", "", "maliske franc"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "myanmarske kyat", PluralForms{"", "myanmarsk kyat", "", "", "", "myanmarske kyat"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolske tugrik", PluralForms{"", "mongolsk tugrik", "", "", "", "mongolske tugrik"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "makaoiske pataca", PluralForms{"", "makaoisk pataca", "", "", "", "makaoiske pataca"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauritanske ouguiya (1973–2017)", PluralForms{"", "mauritansk ouguiya (1973–2017)", "", "", "", "mauritanske ouguiya (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauritanske ouguiya", PluralForms{"", "mauritansk ouguiya", "", "", "", "mauritanske ouguiya"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "maltesiske lira", PluralForms{"", "maltesisk lira", "", "", "", "maltesiske lira"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "maltesiske pund", PluralForms{"", "maltesisk pund", "", "", "", "maltesiske pund"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauritiske rupier", PluralForms{"", "mauritisk rupi", "", "", "", "mauritiske rupier"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "maldiviske rupier", PluralForms{"", "maldivisk rupi", "", "", "", "maldiviske rupier"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldiviske rufiyaa", PluralForms{"", "maldivisk rufiyaa", "", "", "", "maldiviske rufiyaa"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malawiske kwacha", PluralForms{"", "malawisk kwacha", "", "", "", "malawiske kwacha"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "meksikanske pesos", PluralForms{"", "meksikansk peso", "", "", "", "meksikanske pesos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "meksikanske sølvpesos (1861–1992)", PluralForms{"", "meksikansk sølvpesos (1860–1992)", "", "", "", "meksikanske sølvpesos (1860–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "meksikanske unidad de inversion (UDI)", PluralForms{"", "meksikansk unidad de inversion (UDI)", "", "", "", "meksikanske unidad de inversion (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malaysiske ringgit", PluralForms{"", "malaysisk ringgit", "", "", "", "malaysiske ringgit"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "mosambikiske escudo", PluralForms{"", "mosambikisk escudo", "", "", "", "mosambikiske escudo"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "gamle mosambikiske metical", PluralForms{"", "gammel mosambikisk metical", "", "", "", "gamle mosambikiske metical"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mosambikiske metical", PluralForms{"", "mosambikisk metical", "", "", "", "mosambikiske metical"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibiske dollar", PluralForms{"", "namibisk dollar", "", "", "", "namibiske dollar"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigerianske naira", PluralForms{"", "nigeriansk naira", "", "", "", "nigerianske naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nicaraguanske cordoba (1988–1991)", PluralForms{"", "nicaraguansk cordoba (1988–1991)", "", "", "", "nicaraguanske cordoba (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nicaraguanske córdoba", PluralForms{"", "nicaraguansk córdoba", "", "", "", "nicaraguanske córdoba"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "nederlandske gylden", PluralForms{"", "nederlandsk gylden", "", "", "", "nederlandske gylden"}},
+		"NOK": {2, 0, 0, 1, "NOK", "kr", "kr", "norske kroner", PluralForms{"", "norsk krone", "", "", "", "norske kroner"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalske rupier", PluralForms{"", "nepalsk rupi", "", "", "", "nepalske rupier"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "newzealandske dollar", PluralForms{"", "newzealandsk dollar", "", "", "", "newzealandske dollar"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omanske rialer", PluralForms{"", "omansk rial", "", "", "", "omanske rialer"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamanske balboa", PluralForms{"", "panamansk balboa", "", "", "", "panamanske balboa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "peruanske inti", PluralForms{"", "peruansk inti", "", "", "", "peruanske inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruanske sol", PluralForms{"", "peruansk sol", "", "", "", "peruanske sol"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "peruanske sol (1863–1965)", PluralForms{"", "peruansk sol (1863–1965)", "", "", "", "peruanske sol (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papuanske kina", PluralForms{"", "papuansk kina", "", "", "", "papuanske kina"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filippinske pesos", PluralForms{"", "filippinsk peso", "", "", "", "filippinske pesos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistanske rupier", PluralForms{"", "pakistansk rupi", "", "", "", "pakistanske rupier"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "polske zloty", PluralForms{"", "polsk zloty", "", "", "", "polske zloty"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "polske zloty (1950–1995)", PluralForms{"", "polsk zloty (1950–1995)", "", "", "", "polske zloty (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "portugisiske escudo", PluralForms{"", "portugisisk escudo", "", "", "", "portugisiske escudo"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paraguayanske guarani", PluralForms{"", "paraguayansk guarani", "", "", "", "paraguayanske guarani"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "qatarske rialer", PluralForms{"", "qatarsk rial", "", "", "", "qatarske rialer"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "rhodesiske dollar", PluralForms{"", "rhodesisk dollar", "", "", "", "rhodesiske dollar"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "rumenske leu (1952–2006)", PluralForms{"", "rumensk leu (1952–2006)", "", "", "", "rumenske leu (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "rumenske leu", PluralForms{"", "rumensk leu", "", "", "", "rumenske lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "serbiske dinarer", PluralForms{"", "serbisk dinar", "", "", "", "serbiske dinarer"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "russiske rubler", PluralForms{"", "russisk rubel", "", "", "", "russiske rubler"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "russiske rubler (1991–1998)", PluralForms{"", "russisk rubel (1991–1998)", "", "", "", "russiske rubler (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "rwandiske franc", PluralForms{"", "rwandisk franc", "", "", "", "rwandiske franc"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudiarabiske riyaler", PluralForms{"", "saudiarabisk riyal", "", "", "", "saudiarabiske riyaler"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "salomonske dollar", PluralForms{"", "salomonsk dollar", "", "", "", "salomonske dollar"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "seychelliske rupier", PluralForms{"", "seychellisk rupi", "", "", "", "seychelliske rupier"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "sudanesiske dinarer (1992–2007)", PluralForms{"", "sudanesisk dinar (1992–2007)", "", "", "", "sudanesiske dinarer (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudanske pund", PluralForms{"", "sudansk pund", "", "", "", "sudanske pund"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "sudanesiske pund", PluralForms{"", "sudansk pund (1957–1998)", "", "", "", "sudanske pund (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "svenske kroner", PluralForms{"", "svensk krone", "", "", "", "svenske kroner"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singaporske dollar", PluralForms{"", "singaporsk dollar", "", "", "", "singaporske dollar"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "sankthelenske pund", PluralForms{"", "sankthelensk pund", "", "", "", "sankthelenske pund"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "slovenske tolar", PluralForms{"", "slovensk tolar", "", "", "", "slovenske tolar"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "slovakiske koruna", PluralForms{"", "slovakisk koruna", "", "", "", "slovakiske koruna"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierraleonsk leone", PluralForms{"", "sierraleonsk leone", "", "", "", "sierraleonske leoner"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierraleonsk leone (1964–2022)", PluralForms{"", "sierraleonsk leone (1964–2022)", "", "", "", "sierraleonske leoner (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somaliske shilling", PluralForms{"", "somalisk shilling", "", "", "", "somaliske shilling"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamske dollar", PluralForms{"", "surinamsk dollar", "", "", "", "surinamske dollar"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "surinamske gylden", PluralForms{"", "surinamsk gylden", "", "", "", "surinamske gylden"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "sørsudanske pund", PluralForms{"", "sørsudansk pund", "", "", "", "sørsudanske pund"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "saotomesiske dobra (1977–2017)", PluralForms{"", "saotomesisk dobra (1977–2017)", "", "", "", "saotomesiske dobra (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "saotomesiske dobra", PluralForms{"", "saotomesisk dobra", "", "", "", "saotomesiske dobra"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "sovjetiske rubler", PluralForms{"", "sovjetisk rubel", "", "", "", "sovjetiske rubler"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "salvadoranske colon", PluralForms{"", "salvadoransk colon", "", "", "", "salvadoranske colon"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "syriske pund", PluralForms{"", "syrisk pund", "", "", "", "syriske pund"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "swazilandske lilangeni", PluralForms{"", "swazilandsk lilangeni", "", "", "", "swazilandske lilangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "thailandske baht", PluralForms{"", "thailandsk baht", "", "", "", "thailandske baht"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "tadsjikiske rubler", PluralForms{"", "tadsjikisk rubel", "", "", "", "tadsjikiske rubler"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadsjikiske somoni", PluralForms{"", "tadsjikisk somoni", "", "", "", "tadsjikiske somoni"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "turkmenske manat (1993–2009)", PluralForms{"", "turkmensk manat (1993–2009)", "", "", "", "turkmenske manat (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmenske manat", PluralForms{"", "turkmensk manat", "", "", "", "turkmenske manat"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tunisiske dinarer", PluralForms{"", "tunisisk dinar", "", "", "", "tunisiske dinarer"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tonganske paʻanga", PluralForms{"", "tongansk paʻanga", "", "", "", "tonganske paʻanga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "timoresiske escudo", PluralForms{"", "timoresisk escudo", "", "", "", "timoresiske escudo"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "tyrkiske lire (1922–2005)", PluralForms{"", "tyrkisk lire (1922–2005)", "", "", "", "tyrkiske lire (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "tyrkiske lire", PluralForms{"", "tyrkisk lire", "", "", "", "tyrkiske lire"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trinidadiske dollar", PluralForms{"", "trinidadisk dollar", "", "", "", "trinidadiske dollar"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "nye taiwanske dollar", PluralForms{"", "ny taiwansk dollar", "", "", "", "nye taiwanske dollar"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanianske shilling", PluralForms{"", "tanzaniansk shilling", "", "", "", "tanzanianske shilling"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrainske hryvnia", PluralForms{"", "ukrainsk hryvnia", "", "", "", "ukrainske hryvnia"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ukrainske karbovanetz", PluralForms{"", "ukrainsk karbovanetz", "", "", "", "ukrainske karbovanetz"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "ugandiske shilling (1966–1987)", PluralForms{"", "ugandisk shilling (1966–1987)", "", "", "", "ugandiske shilling (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandiske shilling", PluralForms{"", "ugandisk shilling", "", "", "", "ugandiske shilling"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "amerikanske dollar", PluralForms{"", "amerikansk dollar", "", "", "", "amerikanske dollar"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "amerikanske dollar (neste dag)", PluralForms{"", "amerikansk dollar (neste dag)", "", "", "", "amerikanske dollar (neste dag)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "amerikanske dollar (samme dag)", PluralForms{"", "amerikansk dollar (samme dag)", "", "", "", "amerikanske dollar (samme dag)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "uruguyanske pesos (indekserte enheter)", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "uruguayanske pesos (1975–1993)", PluralForms{"", "uruguayansk peso (1975–1993)", "", "", "", "uruguayanske pesos (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "uruguayanske pesos", PluralForms{"", "uruguyansk peso", "", "", "", "uruguayanske pesos"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "usbekiske som", PluralForms{"", "usbekisk som", "", "", "", "usbekiske som"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "venezuelanske bolivar (1871–2008)", PluralForms{"", "venezuelansk bolivar (1871–2008)", "", "", "", "venezuelanske bolivar (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelanske bolivar (2008–2018)", PluralForms{"", "venezuelansk bolivar (2008–2018)", "", "", "", "venezuelanske bolivar (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelanske bolivar", PluralForms{"", "venezuelansk bolivar", "", "", "", "venezuelanske bolivar"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "vietnamesiske dong", PluralForms{"", "vietnamesisk dong", "", "", "", "vietnamesiske dong"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "vietnamesiske dong (1978–1985)", PluralForms{"", "vietnamesisk dong (1978–1985)", "", "", "", "vietnamesiske dong (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatiske vatu", PluralForms{"", "vanuatisk vatu", "", "", "", "vanuatiske vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samoanske tala", PluralForms{"", "samoansk tala", "", "", "", "samoanske tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "XAF", "XAF", "sentralafrikanske CFA-franc", PluralForms{"", "sentralafrikansk CFA-franc", "", "", "", "sentralafrikanske CFA-franc"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "sølv", PluralForms{"", "unse sølv", "", "", "", "unser sølv"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "gull", PluralForms{"", "unse gull", "", "", "", "unser gull"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "europeisk sammensatt enhet", PluralForms{"", "europeisk sammensatt enhet", "", "", "", "europeiske sammensatte enheter"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "europeisk monetær enhet", PluralForms{"", "europeisk monetær enhet", "", "", "", "europeiske monetære enheter"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "europeisk kontoenhet (XBC)", PluralForms{"", "europeisk kontoenhet (XBC)", "", "", "", "europeiske kontoenheter"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "europeisk kontoenhet (XBD)", PluralForms{"", "europeisk kontoenhet (XBD)", "", "", "", "europeiske kontoenheter (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "østkaribiske dollar", PluralForms{"", "østkaribisk dollar", "", "", "", "østkaribiske dollar"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "spesielle trekkrettigheter", PluralForms{"", "spesiell trekkrettighet", "", "", "", "spesielle trekkrettigheter"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "europeisk valutaenhet", PluralForms{"", "europeisk valutaenhet", "", "", "", "europeiske valutaenheter"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franske gullfranc", PluralForms{"", "fransk gullfranc", "", "", "", "franske gullfranc"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franske UIC-franc", PluralForms{"", "fransk UIC-franc", "", "", "", "franske UIC-franc"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "vestafrikanske CFA-franc", PluralForms{"", "vestafrikansk CFA-franc", "", "", "", "vestafrikanske CFA-franc"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladium", PluralForms{"", "unse palladium", "", "", "", "unser palladium"}},
+		"XPF": {0, 0, 0, 1, "XPF", "XPF", "XPF", "CFP-franc", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "unse platina", "", "", "", "unser platina"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET-fond", PluralForms{"", "", "", "", "", ""}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "sucre", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "testvalutakode", PluralForms{"", "testvaluta", "", "", "", "testvaluta"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "ADB-kontoenhet", PluralForms{"", "ADB-kontoenhet", "", "", "", "ADB-kontoenheter"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "ukjent valuta", PluralForms{"", "(ukjent valuta)", "", "", "", "(ukjent valuta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "jemenittiske dinarer", PluralForms{"", "jemenittisk dinar", "", "", "", "jemenittiske dinarer"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenittiske rialer", PluralForms{"", "jemenittisk rial", "", "", "", "jemenittiske rialer"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "jugoslaviske dinarer (hard)", PluralForms{"", "jugoslavisk dinar (hard)", "", "", "", "jugoslaviske dinarer (hard)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "jugoslaviske noviy-dinarer", PluralForms{"", "jugoslavisk noviy-dinar", "", "", "", "jugoslaviske noviy-dinarer"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "jugoslaviske konvertible dinarer", PluralForms{"", "jugoslavisk konvertibel dinar", "", "", "", "jugoslaviske konvertible dinarer"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "jugoslaviske reformerte dinarer (1992–1993)", PluralForms{"", "jugoslavisk reformert dinar (1992–1993)", "", "", "", "jugoslaviske reformerte dinarer (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "sørafrikanske rand (finansielle)", PluralForms{"", "sørafrikansk rand (finansiell)", "", "", "", "sørafrikanske rand (finansielle)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "sørafrikanske rand", PluralForms{"", "sørafrikansk rand", "", "", "", "sørafrikanske rand"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "zambiske kwacha (1968–2012)", PluralForms{"", "zambisk kwacha (1968–2012)", "", "", "", "zambiske kwacha (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambiske kwacha", PluralForms{"", "zambisk kwacha", "", "", "", "zambiske kwacha"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zairiske nye zaire", PluralForms{"", "zairisk ny zaire", "", "", "", "zairiske nye zaire"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zairiske zaire", PluralForms{"", "zairisk zaire", "", "", "", "zairiske zaire"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "zimbabwiske dollar (1980–2008)", PluralForms{"", "zimbabwisk dollar (1980–2008)", "", "", "", "zimbabwiske dollar (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "zimbabwisk dollar (2009)", PluralForms{"", "zimbabwisk dollar (2009)", "", "", "", "zimbabwiske dollar (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "zimbabwisk dollar (2008)", PluralForms{"", "zimbabwisk dollar (2008)", "", "", "", "zimbabwiske dollar (2008)"}},
 	},
 }
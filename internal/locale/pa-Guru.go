@@ -6,199 +6,252 @@ var paGuru = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "[", "]", "-[", "]"}, NumberFormat{3, 2, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0ਹਜ਼ਾਰ", "other": "0\u00a0ਹਜ਼ਾਰ"}},
+				{4, 2, map[string]string{"one": "0\u00a0ਹਜ਼ਾਰ", "other": "0\u00a0ਹਜ਼ਾਰ"}},
+				{5, 1, map[string]string{"one": "0\u00a0ਲੱਖ", "other": "0\u00a0ਲੱਖ"}},
+				{6, 2, map[string]string{"one": "0\u00a0ਲੱਖ", "other": "0\u00a0ਲੱਖ"}},
+				{7, 1, map[string]string{"one": "0\u00a0ਕਰੋੜ", "other": "0\u00a0ਕਰੋੜ"}},
+				{8, 2, map[string]string{"one": "0\u00a0ਕਰੋੜ", "other": "0\u00a0ਕਰੋੜ"}},
+				{9, 1, map[string]string{"one": "0\u00a0ਅਰਬ", "other": "0\u00a0ਅਰਬ"}},
+				{10, 2, map[string]string{"one": "0\u00a0ਅਰਬ", "other": "0\u00a0ਅਰਬ"}},
+				{11, 1, map[string]string{"one": "0\u00a0ਖਰਬ", "other": "0\u00a0ਖਰਬ"}},
+				{12, 2, map[string]string{"one": "0\u00a0ਖਰਬ", "other": "0\u00a0ਖਰਬ"}},
+				{13, 1, map[string]string{"one": "0\u00a0ਨੀਲ", "other": "0\u00a0ਨੀਲ"}},
+				{14, 2, map[string]string{"one": "0\u00a0ਨੀਲ", "other": "0\u00a0ਨੀਲ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 ਹਜ਼ਾਰ", "other": "0 ਹਜ਼ਾਰ"}},
+				{4, 2, map[string]string{"one": "0 ਹਜ਼ਾਰ", "other": "0 ਹਜ਼ਾਰ"}},
+				{5, 1, map[string]string{"one": "0 ਲੱਖ", "other": "0 ਲੱਖ"}},
+				{6, 2, map[string]string{"one": "0 ਲੱਖ", "other": "0 ਲੱਖ"}},
+				{7, 1, map[string]string{"one": "0 ਕਰੋੜ", "other": "0 ਕਰੋੜ"}},
+				{8, 2, map[string]string{"one": "0 ਕਰੋੜ", "other": "0 ਕਰੋੜ"}},
+				{9, 1, map[string]string{"one": "0 ਅਰਬ", "other": "0 ਅਰਬ"}},
+				{10, 2, map[string]string{"one": "0 ਅਰਬ", "other": "0 ਅਰਬ"}},
+				{11, 1, map[string]string{"one": "0 ਖਰਬ", "other": "0 ਖਰਬ"}},
+				{12, 2, map[string]string{"one": "0 ਖਰਬ", "other": "0 ਖਰਬ"}},
+				{13, 1, map[string]string{"one": "0 ਨੀਲ", "other": "0 ਨੀਲ"}},
+				{14, 2, map[string]string{"one": "0 ਨੀਲ", "other": "0 ਨੀਲ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a00\u00a0ਹਜ਼ਾਰ", "other": "¤\u00a00\u00a0ਹਜ਼ਾਰ"}},
+				{4, 2, map[string]string{"one": "¤\u00a00\u00a0ਹਜ਼ਾਰ", "other": "¤\u00a00\u00a0ਹਜ਼ਾਰ"}},
+				{5, 1, map[string]string{"one": "¤\u00a00\u00a0ਲੱਖ", "other": "¤\u00a00\u00a0ਲੱਖ"}},
+				{6, 2, map[string]string{"one": "¤\u00a00\u00a0ਲੱਖ", "other": "¤\u00a00\u00a0ਲੱਖ"}},
+				{7, 1, map[string]string{"one": "¤\u00a00\u00a0ਕਰੋੜ", "other": "¤\u00a00\u00a0ਕਰੋੜ"}},
+				{8, 2, map[string]string{"one": "¤\u00a00\u00a0ਕਰੋੜ", "other": "¤\u00a00\u00a0ਕਰੋੜ"}},
+				{9, 1, map[string]string{"one": "¤\u00a00\u00a0ਅਰਬ", "other": "¤\u00a00\u00a0ਅਰਬ"}},
+				{10, 2, map[string]string{"one": "¤\u00a00\u00a0ਅਰਬ", "other": "¤\u00a00\u00a0ਅਰਬ"}},
+				{11, 1, map[string]string{"one": "¤\u00a00\u00a0ਖਰਬ", "other": "¤\u00a00\u00a0ਖਰਬ"}},
+				{12, 2, map[string]string{"one": "¤\u00a00\u00a0ਖਰਬ", "other": "¤\u00a00\u00a0ਖਰਬ"}},
+				{13, 1, map[string]string{"one": "¤\u00a00\u00a0ਨੀਲ", "other": "¤\u00a00\u00a0ਨੀਲ"}},
+				{14, 2, map[string]string{"one": "¤\u00a00\u00a0ਨੀਲ", "other": "¤\u00a00\u00a0ਨੀਲ"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "ਸੰਯੁਕਤ ਅਰਬ ਅਮੀਰਾਤ ਦਿਰਹਾਮ", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "ਅਫ਼ਗਾਨ ਅਫ਼ਗਾਨੀ", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "ਅਲਬਾਨੀਆਈ ਲੇਕ", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "ਅਰਮੀਨੀਆਈ ਦਰਾਮ", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "ਨੀਦਰਲੈਂਡਸ ਐਂਟੀਲੀਅਨ ਗਿਲਡਰ", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "ਅੰਗੋਲਾ ਕਵਾਂਜਾ", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "ਅਰਜਨਟੀਨੀ ਅਸਟਰਾਲ", PluralForms{"", "", "", "", "", ""}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "ਅਰਜਨਟੀਨੀ ਪੇਸੋ ਲੇ (1970–1983)", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "ਅਰਜਨਟੀਨੀ ਪੇਸੋ (1881–1970)", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "ਅਰਜਨਟੀਨੀ ਪੇਸੋ (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "ਅਰਜਨਟੀਨੀ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ਆਸਟ੍ਰੇਲੀਆਈ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "ਅਰੂਬਨ ਫਲੋਰਿਨ", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "ਅਜ਼ਰਬਾਈਜਾਨ ਮਾਨਤ", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "ਬੋਸਨੀਆ-ਹਰਜ਼ੇਗੋਵੀਨਾ ਬਦਲਣਯੋਗ ਮਾਰਕ", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "ਬਾਰਬਾਡੀਅਨ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "ਬੰਗਲਾਦੇਸ਼ੀ ਟਕਾ", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "ਬੁਲਗਾਰੀਆਈ ਲੇਵ", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "ਬਹਿਰੀਨੀ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "ਬੁਰੁੰਡੀਆਈ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "ਬਰਮੂਡਾ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ਬਰੂਨੇਈ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "ਬੋਲੀਵੀਅਨ ਬੋਲੀਵੀਅਨੋ", PluralForms{"", "", "", "", "", ""}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "ਬੋਲੀਵੀਆਈ ਬੋਲੀਵੀਅਨੋ (1863–1963)", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "ਬੋਲੀਵੀਆਈ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "ਬੋਲੀਵੀਆਈ ਮਵਡੋਲ", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਨਿਊ ਕਰੁਜ਼ਿਰੋਸ (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਕਰੁਜ਼ਾਡੂ (1986–1989)", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਕਰੁਜ਼ਿਰੋਸ (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਰੀਅਲ", PluralForms{"", "", "", "", "", ""}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਨਿਊ ਕਰੁਜ਼ਾਡੂ (1989–1990)", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਕਰੁਜ਼ਿਰੋਸ (1993–1994)", PluralForms{"", "", "", "", "", ""}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "ਬ੍ਰਾਜ਼ੀਲੀਆਈ ਕਰੁਜ਼ਿਰੋਸ (1942–1967)", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "ਬਾਹਾਮੀਅਨ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ਭੂਟਾਨੀ ਐਂਗਲਟ੍ਰਮ", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ਬੋਟਸਵਾਨਾ ਪੁਲਾ", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "ਬੇਲਾਰੂਸੀ ਰੂਬਲ", PluralForms{"", "", "", "", "", ""}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "ਬੇਲਾਰੂਸੀ ਰੂਬਲ (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "ਬੇਲੀਜ਼ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "ਕੇਨੇਡਿਆਈ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "ਕਾਂਗੋਲੀਜ਼ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "ਸਵਿਸ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "ਚਿਲੀ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "ਚੀਨੀ ਯੁਆਨ (ਔਫ਼ਸ਼ੋਰ)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "ਚੀਨੀ ਯੁਆਨ", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "ਕੋਲੰਬਿਆਈ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "ਕੋਸਟਾ ਰੀਕਨ ਕੋਲਨ", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "ਕਿਊਬਨ ਬਦਲਣਯੋਗ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "ਕਿਊਬਨ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "ਕੇਪ ਵਰਡੀਅਨ ਸਕੂਡੋ", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "ਚੈਕ ਗਣਰਾਜ ਕੋਰੁਨਾ", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "ਜਰਮਨ ਮਾਰਕ", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "ਜ਼ੀਬੂਤੀਅਨ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ਡੈਨਿਸ਼ ਕਰੌਨ", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "ਡੌਮਿਨਿਕਨ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "ਅਲਜੀਰਿਆਈ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ਮਿਸਰੀ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "ਇਰੀਟ੍ਰਿਆਈ ਨਾਫ਼ਾ", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ਇਥੋਪੀਆਈ ਬਿਰ", PluralForms{"", "", "", "", "", ""}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "ਯੂਰੋ", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ਫ਼ਿਜ਼ੀ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ਫ਼ਾਕਲੈਂਡ ਆਈਲੈਂਡਸ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "ਬ੍ਰਿਟਿਸ਼ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "ਜਾਰਜੀਆਈ ਲਾਰੀ", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ਘਾਨਾਈ ਸੇਡੀ", PluralForms{"", "", "", "", "", ""}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "ਜਿਬਰਾਲਟਰ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "ਗੈਂਬੀਆਈ ਦਲਾਸੀ", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "ਗਿਨੀ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ਗੁਆਟੇਮਾਲਾ ਕੁਏਟਜ਼ਲ", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "ਗੁਆਨਾਆਈ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "ਹਾਂਗ ਕਾਂਗ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "ਹਾਨਡੂਰਨ ਲੇਮਪਿਰਾ", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "ਕਰੋਏਸ਼ੀਆਈ ਕੁਨਾ", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "ਹੈਤੀ ਗੌਰਡੇ", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ਹੰਗਰੀ ਫੋਰਿੰਟ", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ਇੰਡੋਨੇਸ਼ੀਆਈ ਰੁਪਿਆਹ", PluralForms{"", "", "", "", "", ""}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "ਆਇਰਿਸ਼ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "ਇਜ਼ਰਾਈਲੀ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ਇਜ਼ਰਾਈਲੀ ਨਵੀਂ ਸ਼ੇਕੇਲ", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "ਭਾਰਤੀ ਰੁਪਇਆ", PluralForms{"", "ਭਾਰਤੀ ਰੁਪਇਆ", "", "", "", "ਭਾਰਤੀ ਰੁਪਏ"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ਇਰਾਕੀ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ਈਰਾਨੀ ਰਿਆਲ", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ਆਈਸਲੈਂਡੀ ਕਰੋਨਾ", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ਜਮਾਇਕਨ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "ਜਾਰਡਨ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "ਜਪਾਨੀ ਯੇਨ", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "ਕੀਨੀਆਈ ਸ਼ਿਲਿੰਗ", PluralForms{"", "", "", "", "", ""}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "ਕਿਰਗਿਸਤਾਨੀ ਸੋਮ", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "ਕੰਬੋਡੀਆਈ ਰੀਅਲ", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "ਕੋਮੋਰੀਅਨ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "ਉੱਤਰੀ ਕੋਰੀਆਈ ਵੋਨ", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "ਦੱਖਣੀ ਕੋਰੀਆਈ ਵੋਨ", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "ਕੁਵੈਤੀ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "ਕੇਮੈਨ ਆਈਲੈਂਡਸ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "ਕਜ਼ਾਖਸਤਾਨੀ ਤੇਂਗੇ", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "ਲਾਓਟਿਆਈ ਕਿਪ", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ਲੈਬਨਾਨੀ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "ਸ੍ਰੀਲੰਕਾਈ ਰੁਪਇਆ", PluralForms{"", "ਸ੍ਰੀਲੰਕਾਈ ਰੁਪਇਆ", "", "", "", "ਸ੍ਰੀਲੰਕਾਈ ਰੁਪਏ"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "ਲਾਈਬੀਰੀਆਈ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "ਲੇਸੋਥੋ ਲੋਟੀ", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "ਲਿਥੁਆਨੀਆਈ ਲਿਤਾਸ", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "ਲਾਟਵਿਆਈ ਲਾਟਸ", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "ਲੀਬੀਆਈ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "ਮੋਰੱਕਨ ਦਿਰਹਾਮ", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "ਮੋਲਡੋਵਨ ਲੇਉ", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ਮਾਲਾਗਾਸੀ ਅਰਾਇਰੀ", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "ਮੈਕਡੋਨੀਆਈ ਡੇਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "ਮਿਆਂਮਾਰ ਕਿਆਤ", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "ਮੰਗੋਲੀਆਈ ਤੁਗਰਿਕ", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "ਮੇਕਾਨੀ ਪਟਾਕਾ", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ਮੋਰਿਟਾਨੀਆਈ ਊਗੀਆ (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ਮੋਰਿਟਾਨੀਆਈ ਊਗੀਆ", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "ਮੌਰਿਸ਼ੀਆਈ ਰੁਪਇਆ", PluralForms{"", "ਮੌਰਿਸ਼ੀਆਈ ਰੁਪਇਆ", "", "", "", "ਮੌਰਿਸ਼ੀਆਈ ਰੁਪਏ"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "ਮਾਲਦੀਵੀ ਰੁਫੀਆ", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "ਮਾਲਾਵੀਆਈ ਕਵਾਚਾ", PluralForms{"", "", "", "", "", ""}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "ਮੈਕਸੀਕਨ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ਮਲੇਸ਼ੀਆਈ ਰਿੰਗਿਟ", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "ਮੋਜ਼ਾਮਬੀਕਨ ਮੈਟੀਕਲ", PluralForms{"", "", "", "", "", ""}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "ਨਾਮੀਬੀਆਈ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "ਨਾਇਜੀਰੀਆਈ ਨਾਇਰਾ", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "ਨਿਕਾਰਾਗੁਆਈ ਕੋਰਡੋਬਾ", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "ਨਾਰਵੇਜੀਆਈ ਕਰੌਨ", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "ਨੇਪਾਲੀ ਰੁਪਇਆ", PluralForms{"", "ਨੇਪਾਲੀ ਰੁਪਇਆ", "", "", "", "ਨੇਪਾਲੀ ਰੁਪਏ"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "ਨਿਊਜ਼ੀਲੈਂਡ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ਓਮਾਨੀ ਰਿਆਲ", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "ਪਨਾਮੇਨੀਅਨ ਬਾਲਬੋਆ", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "ਪੇਰੂਵੀਅਨ ਸੋਲ", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "ਪਾਪੂਆ ਨਿਊ ਗਿਨੀਆਈ ਕੀਨਾ", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "ਫਿਲਿਪੀਨੀ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "ਪਾਕਿਸਤਾਨੀ ਰੁਪਇਆ", PluralForms{"", "ਪਾਕਿਸਤਾਨੀ ਰੁਪਇਆ", "", "", "", "ਪਾਕਿਸਤਾਨੀ ਰੁਪਏ"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "ਪੋਲੈਂਡੀ ਜ਼ਲੌਟੀ", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "ਪੈਰਾਗੁਵਾਇਨ ਗੁਆਰਾਨੀ", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "ਕਤਰੀ ਰਿਆਲ", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "ਰੋਮਾਨੀਆਈ ਲੇਉ", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "ਸਰਬੀਆਈ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ਰੂਸੀ ਰੂਬਲ", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ਰਵਾਂਡਨ ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "ਸਾਊਦੀ ਰਿਆਲ", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "ਸੋਲੋਮਨ ਆਈਲੈਂਡਸ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "ਸੇਸ਼ਲਸ ਰੁਪਇਆ", PluralForms{"", "ਸੇਸ਼ਲਸ ਰੁਪਇਆ", "", "", "", "ਸੇਸ਼ਲਸ ਰੁਪਏ"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "ਸੂਡਾਨੀ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "ਸਵੀਡਿਸ਼ ਕਰੋਨਾ", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "ਸਿੰਗਾਪੁਰ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "ਸੇਂਟ ਹੇਲੇਨਾ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "ਸਿਏਰਾ ਲਿਓਨੀਅਨ ਲਿਓਨ", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "ਸਿਏਰਾ ਲਿਓਨੀਅਨ ਲਿਓਨ (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "ਸੋਮਾਲੀ ਸ਼ਿਲਿੰਗ", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "ਸੂਰੀਨਾਮੀ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "ਦੱਖਣੀ ਸੂਡਾਨੀ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "ਸਾਉ ਟੋਮੀ ਐਂਡ ਪ੍ਰਿੰਸਪੀ ਡੋਬਰਾ (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "ਸਾਉ ਟੋਮੀ ਐਂਡ ਪ੍ਰਿੰਸਪੀ ਡੋਬਰਾ", PluralForms{"", "", "", "", "", ""}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "ਸੋਵੀਅਤ ਰੂਬਲ", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "ਸੀਰੀਆਈ ਪੌਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "ਸਵਾਜ਼ੀ ਲਾਇਲੈਂਗਨੀ", PluralForms{"", "", "", "", "", ""}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "ਥਾਈ ਬਾਹਤ", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "ਤਾਜਿਕਿਸਤਾਨੀ ਸੋਮੋਨੀ", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "ਤੁਰਕਮੇਨਿਸਤਾਨੀ ਮਾਨਤ", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "ਟਿਉਨੀਸ਼ੀਆਈ ਦਿਨਾਰ", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "ਟੌਂਗਨ ਪੈਂਗਾ", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "ਤੁਰਕੀ ਲੀਰਾ", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ਟ੍ਰਿਨੀਡਾਡ ਅਤੇ ਟੋਬਾਗੋ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "ਨਵਾਂ ਤਾਇਵਾਨ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "ਤਨਜ਼ਾਨੀਆਈ ਸ਼ਿਲਿੰਗ", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ਯੂਕਰੇਨੀਆਈ ਰਿਵਨਿਆ", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ਯੂਗਾਂਡੀਆਈ ਸ਼ਿਲਿੰਗ", PluralForms{"", "", "", "", "", ""}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "ਯੂ.ਐਸ. ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "ਉਰੂਗੁਵਾਇਨ ਪੇਸੋ (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "ਉਰੂਗੁਵਾਇਨ ਪੇਸੋ", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "ਉਜ਼ਬੇਕਿਸਤਾਨ ਸੋਮ", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "ਵੇਨੇਜ਼ੂਏਲਨ ਬੋਲੀਵਰ (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "ਵੇਨੇਜ਼ੂਏਲਨ ਬੋਲੀਵਰ (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "ਵੇਨੇਜ਼ੂਏਲਨ ਬੋਲੀਵਰ", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "ਵੀਅਤਨਾਮੀ ਡੋਂਗ", PluralForms{"", "", "", "", "", ""}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "ਵੀਅਤਨਾਮੀ ਡੋਂਗ (1978–1985)", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "ਵਾਨੂਆਟੂ ਵਾਟੂ", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "ਸਾਮੋਆਈ ਤਾਲਾ", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "ਕੇਂਦਰੀ ਅਫ਼ਰੀਕੀ [CFA] ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "ਚਾਂਦੀ", PluralForms{"", "ਚਾਂਦੀ ਦਾ ਟਰੌਏ ਔਂਸ", "", "", "", "ਚਾਂਦੀ ਦਾ ਟਰੌਏ ਔਂਸ"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "ਸੋਨਾ", PluralForms{"", "ਸੋਨੇ ਦਾ ਟਰੌਏ ਔਂਸ", "", "", "", "ਸੋਨੇ ਦਾ ਟਰੌਏ ਔਂਸ"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "ਯੂਰਪੀ ਵਿੱਤੀ ਇਕਾਈ", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "ਪੂਰਬੀ ਕੈਰੇਬੀਅਨ ਡਾਲਰ", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "ਯੂਰਪੀ ਮੁਦਰਾ ਇਕਾਈ", PluralForms{"", "ਯੂਰਪੀਅਨ ਮੁਦਰਾ ਇਕਾਈ", "", "", "", "ਯੂਰਪੀ ਮੁਦਰਾ ਇਕਾਈ"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "ਪੱਛਮੀ ਅਫ਼ਰੀਕੀ (CFA) ਫ੍ਰੈਂਕ", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "ਫ੍ਰੈਂਕ (CFP)", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "ਅਗਿਆਤ ਮੁਦਰਾ", PluralForms{"", "(ਮੁਦਰਾ ਦੀ ਅਗਿਆਤ ਇਕਾਈ)", "", "", "", "(ਅਗਿਆਤ ਮੁਦਰਾ)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "ਯਮਨੀ ਰਿਆਲ", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "ਦੱਖਣੀ ਅਫਰੀਕੀ ਰੈਂਡ", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "ਜ਼ਾਮਬੀਆਈ ਕਵਾਚਾ", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
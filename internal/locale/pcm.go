@@ -6,172 +6,225 @@ var pcm = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 Taúzan", "other": "0 Taúzan"}},
+				{4, 2, map[string]string{"one": "0 Taúzan", "other": "0 Taúzan"}},
+				{5, 3, map[string]string{"one": "0 Taúzan", "other": "0 Taúzan"}},
+				{6, 1, map[string]string{"one": "0 Míliọn", "other": "0 Míliọn"}},
+				{7, 2, map[string]string{"one": "0 Míliọn", "other": "0 Míliọn"}},
+				{8, 3, map[string]string{"one": "0 Míliọn", "other": "0 Míliọn"}},
+				{9, 1, map[string]string{"one": "0 Bíliọn", "other": "0 Bíliọn"}},
+				{10, 2, map[string]string{"one": "0 Bíliọn", "other": "0 Bíliọn"}},
+				{11, 3, map[string]string{"one": "0 Bíliọn", "other": "0 Bíliọn"}},
+				{12, 1, map[string]string{"one": "0 Tríliọn", "other": "0 Tríliọn"}},
+				{13, 2, map[string]string{"one": "0 Tríliọn", "other": "0 Tríliọn"}},
+				{14, 3, map[string]string{"one": "0 Tríliọn", "other": "0 Tríliọn"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{4, 2, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{5, 3, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{6, 1, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{7, 2, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{8, 3, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{9, 1, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{10, 2, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{12, 1, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{13, 2, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{14, 3, map[string]string{"one": "¤0T", "other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "p."},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "KA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "₦", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Yunaítẹ́d Áráb Ẹ́míréts Dírham", PluralForms{"", "Yunaítẹ́d Áráb Ẹ́míréts dírham", "", "", "", "Yunaítẹ́d Áráb Ẹ́míréts dírhams"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afgán Afgáni", PluralForms{"", "Afgán afgáni", "", "", "", "Afgán afgánis"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Albéniá Lẹk", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Armẹ́niá Dram", PluralForms{"", "Armẹ́nia ́dram", "", "", "", "Armẹ́niá drams"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Nẹ́dalánds Antílián Gílda", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angólá Kwánza", PluralForms{"", "Angólá kwánza", "", "", "", "Angólá kwánzas"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Ajẹntína Pẹ́so", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Ọstréliá Dọ́la", PluralForms{"", "Ọstréliá dọ́la", "", "", "", "Ọstréliá dọ́las"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Arúba Flọ́rin", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azẹrbaiján Mánat", PluralForms{"", "Azẹrbaiján mánat", "", "", "", "Azẹrbaiján mánats"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Bọ́sniá an Hẹzẹgovína Mak Wé Pẹ́sin Fít Chenj", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbédọs Dọ́la", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladẹ́sh Táka", PluralForms{"", "Bangladẹ́sh táka", "", "", "", "Bangladẹ́sh táka"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bọlgériá Lẹv", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Baréin Dínar", PluralForms{"", "Baréin dínar", "", "", "", "Baréin dínars"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burúndí Frank", PluralForms{"", "Burúndí frank", "", "", "", "Burúndí franks"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bẹmiúda Dọ́la", PluralForms{"", "", "", "", "", ""}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Brunẹí Dọ́la", PluralForms{"", "Brunẹí dọ́la", "", "", "", "Brunẹí dọ́las"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolíviá Boliviáno", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brazíl Rẹal", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bahámas Dọ́la", PluralForms{"", "", "", "", "", ""}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Bután Ngúltrum", PluralForms{"", "Bután ngúltrum", "", "", "", "Bután ngúltrums"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswáná Púla", PluralForms{"", "Botswáná púla", "", "", "", "Botswáná púlas"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "p.", "Bẹlarús Rúbul", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Bẹliz Dọ́la", PluralForms{"", "", "", "", "", ""}},
+		"CAD": {2, 0, 2, 5, "CAD", "KA$", "$", "Kánádá Dọ́la", PluralForms{"", "", "", "", "", ""}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kóngó Frank", PluralForms{"", "Kóngó frank", "", "", "", "Kóngó franks"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Swís Frank", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Chílí Pẹ́so", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Chaíná Yuan (ples-dẹm aúsaíd chaína)", PluralForms{"", "Chaíná yuan (ples-dẹm aúsaíd chaína)", "", "", "", "Chaíná yuans (ples-dẹm aúsaíd chaína)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Chaíná Yuan", PluralForms{"", "Chaíná yuan", "", "", "", "Chaíná yuans"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolómbiá Pẹ́so", PluralForms{"", "Kolómbiá pẹ́so", "", "", "", "Kolómbiá pẹ́sos"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kósta Ríka Kólọn", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Kiúbá Pẹ́so Wé Pẹ́sin Fít Chenj", PluralForms{"", "", "", "", "", ""}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kiúbá Pẹ́so", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kép Vẹ́d Ẹskúdo", PluralForms{"", "Kép Vẹ́d ẹskúdo", "", "", "", "Kép Vẹ́d ẹskúdos"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Chẹ́k Kórúna", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Jibútí Frank", PluralForms{"", "Jibútí frank", "", "", "", "Jibútí franks"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Dẹ́nmák Króna", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dọmíníkan Pẹ́so", PluralForms{"", "", "", "", "", ""}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Aljíria Dínar", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Íjípt Paund", PluralForms{"", "", "", "", "", ""}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Ẹritrẹá Nákfa", PluralForms{"", "Ẹritrẹá nákfa", "", "", "", "Ẹritrẹá nákfas"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Ẹtiópiá Berr", PluralForms{"", "Ẹtiópiá berr", "", "", "", "Ẹtiópiá berrs"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Yúro", PluralForms{"", "eúro", "", "", "", "eúros"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fíjí Dọ́la", PluralForms{"", "Fíjí dọ́la", "", "", "", "Fíjí dọ́las"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Fọlkland Aílands Paund", PluralForms{"", "Fọlkland Aílands paund", "", "", "", "Fọlkland Aílands paunds"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Brítísh Páund", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Jọ́jiá Lári", PluralForms{"", "Jọ́jiá lári", "", "", "", "Jọ́jiá láris"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Ganá Sídi", PluralForms{"", "Ganá sídi", "", "", "", "Ganá sídis"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Jibrọ́lta Páund", PluralForms{"", "", "", "", "", ""}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gámbiá Dalási", PluralForms{"", "Gámbiá dalási", "", "", "", "Gámbiá dalásis"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gíní Frank", PluralForms{"", "Gíní frank", "", "", "", "Gíní franks"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Guátẹmála Kwuẹ́tzal", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Giyána Dọ́la", PluralForms{"", "Giyána dọ́la", "", "", "", "Giyána dọ́las"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Họng Kọ́ng Dọ́la", PluralForms{"", "Họng Kọ́ng dọ́la", "", "", "", "Họng Kọ́ng dọ́las"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Họndúrán Lẹmpíra", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kroéshia Kúna", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Haíti Gourd", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Họngériá Fọ́rint", PluralForms{"", "", "", "", "", ""}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indoníshiá Rupia", PluralForms{"", "Indoníshiá rupia", "", "", "", "Indoníshiá rupias"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Ízrẹ́l Niú Shẹ́kẹl", PluralForms{"", "Ízrẹ́l niú shẹ́kẹl", "", "", "", "Ízrẹ́l niú shẹ́kẹls"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Índiá Rúpi", PluralForms{"", "Índiá rúpi", "", "", "", "Índiá rúpis"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Irák Dínar", PluralForms{"", "Irák dínar", "", "", "", "Irák dínars"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Irán Rial", PluralForms{"", "Irán rial", "", "", "", "Irán rials"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Aíslánd Króna", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Jamaíka Dọla", PluralForms{"", "", "", "", "", ""}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Jọ́dán Dínar", PluralForms{"", "Jọ́dán dínar", "", "", "", "Jọ́dán dínars"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Japán Yẹn", PluralForms{"", "Japán yẹn", "", "", "", "Japán yẹns"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Kẹ́nyá Shílin", PluralForms{"", "Kẹ́nyá shílin", "", "", "", "Kẹ́nyá shílins"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Kẹjístan Som", PluralForms{"", "Kẹjístan som", "", "", "", "Kẹjístan soms"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kambódiá Riẹl", PluralForms{"", "Kambódiá riẹl", "", "", "", "Kambódiá riẹls"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Kọ́mọ́ros Frank", PluralForms{"", "Kọ́mọ́ros frank", "", "", "", "Kọ́mọ́ros franks"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Nọ́t Koriá Wọn", PluralForms{"", "Nọ́t Koriá wọn", "", "", "", "Nọ́t Koriá wọns"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Saút Koriá Wọn", PluralForms{"", "Saút Koriá wọn", "", "", "", "Saút Koriá wọns"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuwét Dínar", PluralForms{"", "Kuwét dínar", "", "", "", "Kuwét dínars"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kéman Aílands Dọla", PluralForms{"", "", "", "", "", ""}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Kazakstan Tẹ́nj", PluralForms{"", "Kazakstan tẹ́nj", "", "", "", "Kazakstan tẹ́njs"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laós Kip", PluralForms{"", "Laós kip", "", "", "", "Laós kips"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Lẹ́bánọ́n Paund", PluralForms{"", "Lẹ́bánọ́n paund", "", "", "", "Lẹ́bánọ́n paunds"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Sri Lánká Rúpi", PluralForms{"", "Sri Lánká rúpi", "", "", "", "Sri Lánká rúpis"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Laibẹ́riá Dọ́la", PluralForms{"", "Laibẹ́riá dọ́la", "", "", "", "Laibẹ́riá dọ́las"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lẹsóto Lọ́ti", PluralForms{"", "Lẹsóto Lọ́ti", "", "", "", "Lẹsóto Lọ́tis"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Líbia Dínar", PluralForms{"", "", "", "", "", ""}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Morọko Dírham", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Mọldóva Lu", PluralForms{"", "", "", "", "", ""}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Malagásí Ariári", PluralForms{"", "Malagásí ariári", "", "", "", "Malagásí ariáris"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Masẹdónia Dínar", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Miánmá Kiat", PluralForms{"", "Miánmá kiat", "", "", "", "Miánmá kiats"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mọngóliá Túgrik", PluralForms{"", "Mọngóliá túgrik", "", "", "", "Mọngóliá túgriks"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makáo Pátáka", PluralForms{"", "Makáo pátáka", "", "", "", "Makáo pátákas"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mọriténiá Uguíya", PluralForms{"", "Mọriténiá uguíya", "", "", "", "Mọriténiá uguíyas"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mọríshọ́s Rúpi", PluralForms{"", "Mọríshọ́s rúpi", "", "", "", "Mọríshọ́s rúpis"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Mọ́ldívs Rúfíya", PluralForms{"", "Mọ́ldívs rúfíya", "", "", "", "Mọ́ldívs rúfíyas"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Maláwi ́Kwácha", PluralForms{"", "Maláwi ́kwácha", "", "", "", "Maláwí kwáchas"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Mẹ́ksíko Pẹ́so", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Maléshiá Ríngit", PluralForms{"", "Maléshiá ríngit", "", "", "", "Maléshiá ríngits"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozámbík Métíkal", PluralForms{"", "Mozámbík métíkal", "", "", "", "Mozámbík métíkals"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namíbiá Dọ́la", PluralForms{"", "Namíbiá dọ́la", "", "", "", "Namíbiá dọ́las"}},
+		"NGN": {2, 0, 2, 1, "NGN", "₦", "₦", "Naijíriá Naíra", PluralForms{"", "Naijíriá naíra", "", "", "", "Naijíriá naíras"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikarágwua Kordóba", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Nọ́wé Króna", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nẹ́pál Rúpi", PluralForms{"", "Nẹ́pál rúpi", "", "", "", "Nẹ́pál rúpis"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Niú Zílánd Dọ́las", PluralForms{"", "Niú Zílánd dọ́la", "", "", "", "Niú Zílánd dọ́las"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Omán Rial", PluralForms{"", "Omán rial", "", "", "", "Omán rials"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Pánáma Balbóa", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Pẹrúvián Sol", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Pápuá Niú Gíni Kína", PluralForms{"", "Pápuá Niú Gíni kína", "", "", "", "Pápuá Niú Gíni kínas"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Fílípíns Píso", PluralForms{"", "Fílípíns píso", "", "", "", "Fílípíns písos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Pakístán Rúpi", PluralForms{"", "Pakístán rúpi", "", "", "", "Pakístán rúpis"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Pólánd Zílọ́ti", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Páragwuá Guaráni", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Kata Ríal", PluralForms{"", "Kata ríal", "", "", "", "Kata ríals"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Roméniá Lu", PluralForms{"", "", "", "", "", ""}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Sẹrbia Dínar", PluralForms{"", "", "", "", "", ""}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rọ́shiá Rúbul", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ruwándá Frank", PluralForms{"", "Ruwándá frank", "", "", "", "Ruwándá franks"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saúdí Arébiá Riyal", PluralForms{"", "Saúdí Arébiá riyal", "", "", "", "Saúdí Arébiá riyals"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Sólómọ́n Aílands Dọ́la", PluralForms{"", "Sólómọ́n Aílands dọ́la", "", "", "", "Sólómọ́n Aílands dọ́las"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Sẹ́chẹ́ls Rúpi", PluralForms{"", "Sẹ́chẹ́ls rúpi", "", "", "", "Sẹ́chẹ́ls rúpis"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudan Paund", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Swídẹ́n Króna", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapọ́ Dọ́la", PluralForms{"", "Singapọ́ dọ́la", "", "", "", "Singapọ́ dọ́las"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Sent Hẹlẹ́ná Paund", PluralForms{"", "Sent Hẹlẹ́ná paund", "", "", "", "Sent Hẹlẹ́ná paunds"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Siẹ́ra Líoniá Liọn", PluralForms{"", "Siẹ́ra Líoniá liọn", "", "", "", "Siẹ́ra Líoniá liọns"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Siẹ́ra Líoniá Liọn (1964—2022)", PluralForms{"", "Siẹ́ra Líoniá liọn (1964—2022)", "", "", "", "Siẹ́ra Líoniá liọns (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Sọmáliá Shílin", PluralForms{"", "Sọmáliá shílin", "", "", "", "Sọmáliá shílins"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Súrínám Dọla", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Saút Sudán Paund", PluralForms{"", "Saút Sudán paund", "", "", "", "Saút Sudán paunds"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Sao Tómẹ & Prínsípẹ Dóbra", PluralForms{"", "Sao Tómẹ & Prínsípẹ dóbra", "", "", "", "Sao Tómẹ & Prínsípẹ dóbras"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Síriá Paund", PluralForms{"", "Síriá paund", "", "", "", "Síriá paunds"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Swází Lilánjẹ́ni", PluralForms{"", "Swází lilánjẹ́ni", "", "", "", "Swází ẹmalánjẹ́ni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Taílánd Baht", PluralForms{"", "Taílánd baht", "", "", "", "Taílánd bahts"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Tajíkstan Sómóni", PluralForms{"", "Tajíkstan sómóni", "", "", "", "Tajíkstan sómónis"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Tọkmẹ́nístán Mánat", PluralForms{"", "Tọkmẹ́nístán mánat", "", "", "", "Tọkmẹ́nístán mánats"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tuníshia Dínar", PluralForms{"", "", "", "", "", ""}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tónga Pánga", PluralForms{"", "Tónga pánga", "", "", "", "Tónga pángas"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Tọ́kí Líra", PluralForms{"", "Tọ́kí líra", "", "", "", "Tọ́kí líras"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trínídad & Tobágo Dọ́la", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Niú Taiwán Dọ́la", PluralForms{"", "Niú Taiwán dọ́la", "", "", "", "Niú Taiwán dọ́las"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzániá Shílin", PluralForms{"", "Tanzániá shílin", "", "", "", "Tanzániá shílins"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Yukrẹín Rívnia", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Yugándá Shílin", PluralForms{"", "Yugándá shílin", "", "", "", "Yugándá shílins"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "US Dọ́la", PluralForms{"", "US Dọ́la", "", "", "", "Amẹ́ríká Dọ́la"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Yurugwaí Pẹ́so", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Uzbẹ́kistan Som", PluralForms{"", "Uzbẹ́kistan som", "", "", "", "Uzbẹ́kistan soms"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Vẹnẹzuẹlá Bolívar", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Viẹ́tnám Dọng", PluralForms{"", "Viẹ́tnám dọng", "", "", "", "Viẹ́tnám dọngs"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vanuátú Vátu", PluralForms{"", "Vanuátú vátu", "", "", "", "Vanuátú vátus"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samóa Tála", PluralForms{"", "Samóa tála", "", "", "", "Samóa tálas"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Sẹ́ntrál Áfríká Frank", PluralForms{"", "Sẹ́ntrál Áfríká frank", "", "", "", "Sẹ́ntrál Áfríká franks"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Íst Karíbián Dọla", PluralForms{"", "", "", "", "", ""}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Wẹ́st Afríká Sẹ́fa Frank", PluralForms{"", "Wẹ́st Afríká Sẹ́fa frank", "", "", "", "Wẹ́st Afríká Sẹ́fa franks"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Frẹ́nch Poliníshiá Frank", PluralForms{"", "Frẹ́nch Poliníshiá frank", "", "", "", "Frẹ́nch Poliníshiá franks"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Mọní Wé Pípul Nọ́ No", PluralForms{"", "mọní wé pípul nọ́ no", "", "", "", "(mọní wé pípul nọ́ no)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Yẹ́mẹ́n Rial", PluralForms{"", "Yẹ́mẹ́n rial", "", "", "", "Yẹ́mẹ́n rials"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Saút Áfríká Rand", PluralForms{"", "Saút Áfríká rand", "", "", "", "Saút Áfríká rands"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zámbiá Kwácha", PluralForms{"", "Zámbiá kwácha", "", "", "", "Zámbiá kwáchas"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
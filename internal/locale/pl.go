@@ -6,278 +6,331 @@ var pl = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tys.", "other": "0\u00a0tys."}},
+				{4, 2, map[string]string{"one": "0\u00a0tys.", "other": "0\u00a0tys."}},
+				{5, 3, map[string]string{"one": "0\u00a0tys.", "other": "0\u00a0tys."}},
+				{6, 1, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{9, 1, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{10, 2, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{11, 3, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{12, 1, map[string]string{"one": "0\u00a0bln", "other": "0\u00a0bln"}},
+				{13, 2, map[string]string{"one": "0\u00a0bln", "other": "0\u00a0bln"}},
+				{14, 3, map[string]string{"one": "0\u00a0bln", "other": "0\u00a0bln"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tysiące", "many": "0 tysięcy", "one": "0 tysiąc", "other": "0 tysiąca"}},
+				{4, 2, map[string]string{"few": "0 tysiące", "many": "0 tysięcy", "one": "0 tysiąc", "other": "0 tysiąca"}},
+				{5, 3, map[string]string{"few": "0 tysiące", "many": "0 tysięcy", "one": "0 tysiąc", "other": "0 tysiąca"}},
+				{6, 1, map[string]string{"few": "0 miliony", "many": "0 milionów", "one": "0 milion", "other": "0 miliona"}},
+				{7, 2, map[string]string{"few": "0 miliony", "many": "0 milionów", "one": "0 milion", "other": "0 miliona"}},
+				{8, 3, map[string]string{"few": "0 miliony", "many": "0 milionów", "one": "0 milion", "other": "0 miliona"}},
+				{9, 1, map[string]string{"few": "0 miliardy", "many": "0 miliardów", "one": "0 miliard", "other": "0 miliarda"}},
+				{10, 2, map[string]string{"few": "0 miliardy", "many": "0 miliardów", "one": "0 miliard", "other": "0 miliarda"}},
+				{11, 3, map[string]string{"few": "0 miliardy", "many": "0 miliardów", "one": "0 miliard", "other": "0 miliarda"}},
+				{12, 1, map[string]string{"few": "0 biliony", "many": "0 bilionów", "one": "0 bilion", "other": "0 biliona"}},
+				{13, 2, map[string]string{"few": "0 biliony", "many": "0 bilionów", "one": "0 bilion", "other": "0 biliona"}},
+				{14, 3, map[string]string{"few": "0 biliony", "many": "0 bilionów", "one": "0 bilion", "other": "0 biliona"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tys.\u00a0¤", "many": "0\u00a0tys.\u00a0¤", "one": "0\u00a0tys.\u00a0¤", "other": "0\u00a0tys.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tys.\u00a0¤", "many": "0\u00a0tys.\u00a0¤", "one": "0\u00a0tys.\u00a0¤", "other": "0\u00a0tys.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tys.\u00a0¤", "many": "0\u00a0tys.\u00a0¤", "one": "0\u00a0tys.\u00a0¤", "other": "0\u00a0tys.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mln\u00a0¤", "many": "0\u00a0mln\u00a0¤", "one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mln\u00a0¤", "many": "0\u00a0mln\u00a0¤", "one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mln\u00a0¤", "many": "0\u00a0mln\u00a0¤", "one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mld\u00a0¤", "many": "0\u00a0mld\u00a0¤", "one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mld\u00a0¤", "many": "0\u00a0mld\u00a0¤", "one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mld\u00a0¤", "many": "0\u00a0mld\u00a0¤", "one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bln\u00a0¤", "many": "0\u00a0bln\u00a0¤", "one": "0\u00a0bln\u00a0¤", "other": "0\u00a0bln\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bln\u00a0¤", "many": "0\u00a0bln\u00a0¤", "one": "0\u00a0bln\u00a0¤", "other": "0\u00a0bln\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bln\u00a0¤", "many": "0\u00a0bln\u00a0¤", "one": "0\u00a0bln\u00a0¤", "other": "0\u00a0bln\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "zł", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lej"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "XCG", "XCG"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "peseta andorska", PluralForms{"", "peseta andorska", "", "pesety andorskie", "peset andorskich", "peseta andorska"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham ZEA", PluralForms{"", "dirham ZEA", "", "dirhamy ZEA", "dirhamów ZEA", "dirhama ZEA"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afgani (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afgańskie", PluralForms{"", "afgani afgańskie", "", "afgani afgańskie", "afgani afgańskich", "afgani afgańskiego"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albański", PluralForms{"", "lek albański", "", "leki albańskie", "leków albańskich", "leka albańskiego"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armeński", PluralForms{"", "dram armeński", "", "dramy armeńskie", "dramów armeńskich", "drama armeńskiego"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "gulden antylski", PluralForms{"", "gulden antylski", "", "guldeny antylskie", "guldenów antylskich", "guldena antylskiego"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolska", PluralForms{"", "kwanza angolska", "", "kwanzy angolskie", "kwanz angolskich", "kwanzy angolskiej"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angolańska (1977–1990)", PluralForms{"", "kwanza angolańska (1977–1990)", "", "kwanzy angolańskie (1977–1990)", "kwanz angolańskich (1977–1990)", "kwanza angolańska (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "nowa kwanza angolańska (1990–2000)", PluralForms{"", "nowa kwanza angolańska (1990–2000)", "", "nowe kwanzy angolańskie (1990–2000)", "nowych kwanz angolańskich (1990–2000)", "nowa kwanza angolańska (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza angolańska Reajustado (1995–1999)", PluralForms{"", "kwanza angolańska Reajustado (1995–1999)", "", "kwanzy angolańskie Reajustado (1995–1999)", "kwanz angolańskich Reajustado (1995–1999)", "kwanza angolańska Reajustado (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentyński", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentyńskie (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentyńskie", PluralForms{"", "peso argentyńskie", "", "pesos argentyńskie", "pesos argentyńskich", "peso argentyńskiego"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "szyling austriacki", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "dolar australijski", PluralForms{"", "dolar australijski", "", "dolary australijskie", "dolarów australijskich", "dolara australijskiego"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florin arubański", PluralForms{"", "florin arubański", "", "floriny arubańskie", "florinów arubańskich", "florina arubańskiego"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azerbejdżański", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azerski", PluralForms{"", "manat azerski", "", "manaty azerskie", "manatów azerskich", "manata azerskiego"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar Bośni i Hercegowiny", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marka zamienna Bośni i Hercegowiny", PluralForms{"", "marka zamienna Bośni i Hercegowiny", "", "marki zamienne Bośni i Hercegowiny", "marek zamiennych Bośni i Hercegowiny", "marki zamiennej Bośni i Hercegowiny"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dolar barbadoski", PluralForms{"", "dolar barbadoski", "", "dolary barbadoskie", "dolarów barbadoskich", "dolara barbadoskiego"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bengalska", PluralForms{"", "taka bengalska", "", "taka bengalskie", "taka bengalskich", "taka bengalskiej"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "frank belgijski (zamienny)", PluralForms{"", "frank belgijski (wymienialny)", "", "franki belgijskie (wymienialne)", "franków belgijskich (wymienialnych)", "frank belgijski (zamienny)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "frank belgijski", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "frank belgijski (finansowy)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "lew bułgarski wymienny", PluralForms{"", "lew bułgarski wymienny", "", "lewy bułgarskie wymienne", "lewów bułgarskich wymiennych", "lewa bułgarskiego wymiennego"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "lew bułgarski socjalistyczny", PluralForms{"", "lew bułgarski socjalistyczny", "", "lewy bułgarskie socjalistyczne", "lewów bułgarskich socjalistycznych", "lewa bułgarskiego socjalistycznego"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lew bułgarski", PluralForms{"", "lew bułgarski", "", "lewy bułgarskie", "lewów bułgarskich", "lewa bułgarskiego"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "lew bułgarski (1879–1952)", PluralForms{"", "lew bułgarski (1879–1952)", "", "lewy bułgarskie (1879–1952)", "lewów bułgarskich (1879–1952)", "lewa bułgarskiego (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar bahrański", PluralForms{"", "dinar bahrański", "", "dinary bahrańskie", "dinarów bahrańskich", "dinara bahrańskiego"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "frank burundyjski", PluralForms{"", "frank burundyjski", "", "franki burundyjskie", "franków burundyjskich", "franka burundyjskiego"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dolar bermudzki", PluralForms{"", "dolar bermudzki", "", "dolary bermudzkie", "dolarów bermudzkich", "dolara bermudzkiego"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dolar brunejski", PluralForms{"", "dolar brunejski", "", "dolary brunejskie", "dolarów brunejskich", "dolara brunejskiego"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano boliwijskie", PluralForms{"", "boliviano boliwijskie", "", "boliviano boliwijskie", "boliviano boliwijskich", "boliviano boliwijskiego"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso boliwijskie", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "mvdol boliwijski", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "cruzeiro novo brazylijskie (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brazylijskie", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brazylijskie (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "real brazylijski", PluralForms{"", "real brazylijski", "", "reale brazylijskie", "reali brazylijskich", "reala brazylijskiego"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "nowe cruzado brazylijskie", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro brazylijskie", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dolar bahamski", PluralForms{"", "dolar bahamski", "", "dolary bahamskie", "dolarów bahamskich", "dolara bahamskiego"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum bhutański", PluralForms{"", "ngultrum bhutański", "", "ngultrum bhutańskie", "ngultrum bhutańskich", "ngultrum bhutańskiego"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birmański", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula botswańska", PluralForms{"", "pula botswańska", "", "pule botswańskie", "pul botswańskich", "puli botswańskiej"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "rubel białoruski (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "rubel białoruski", PluralForms{"", "rubel białoruski", "", "ruble białoruskie", "rubli białoruskich", "rubla białoruskiego"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "rubel białoruski (2000–2016)", PluralForms{"", "rubel białoruski (2000–2016)", "", "ruble białoruskie (2000–2016)", "rubli białoruskich (2000–2016)", "rubla białoruskiego (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dolar belizeński", PluralForms{"", "dolar belizeński", "", "dolary belizeńskie", "dolarów belizeńskich", "dolara belizeńskiego"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "dolar kanadyjski", PluralForms{"", "dolar kanadyjski", "", "dolary kanadyjskie", "dolarów kanadyjskich", "dolara kanadyjskiego"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "frank kongijski", PluralForms{"", "frank kongijski", "", "franki kongijskie", "franków kongijskich", "franka kongijskiego"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "frank szwajcarski", PluralForms{"", "frank szwajcarski", "", "franki szwajcarskie", "franków szwajcarskich", "franka szwajcarskiego"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso chilijskie", PluralForms{"", "peso chilijskie", "", "pesos chilijskie", "pesos chilijskich", "peso chilijskiego"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "juan chiński (rynek zewnętrzny)", PluralForms{"", "juan chiński (rynek zewnętrzny)", "", "juany chińskie (rynek zewnętrzny)", "juanów chińskich (rynek zewnętrzny)", "juana chińskiego (rynek zewnętrzny)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "juan chiński", PluralForms{"", "juan chiński", "", "juany chińskie", "juanów chińskich", "juana chińskiego"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso kolumbijskie", PluralForms{"", "peso kolumbijskie", "", "pesos kolumbijskie", "pesos kolumbijskich", "peso kolumbijskiego"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colon kostarykański", PluralForms{"", "colon kostarykański", "", "colony kostarykańskie", "colonów kostarykańskich", "colona kostarykańskiego"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "stary dinar serbski", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "korona czechosłowacka", PluralForms{"", "korona czechosłowacka", "", "korony czechosłowackie", "koron czechosłowackich", "korona czechosłowacka"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso kubańskie wymienialne", PluralForms{"", "peso kubańskie wymienialne", "", "pesos kubańskie wymienialne", "pesos kubańskich wymienialnych", "peso kubańskiego wymienialnego"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso kubańskie", PluralForms{"", "peso kubańskie", "", "pesos kubańskie", "pesos kubańskich", "peso kubańskiego"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo zielonoprzylądkowe", PluralForms{"", "escudo zielonoprzylądkowe", "", "escudo zielonoprzylądkowe", "escudo zielonoprzylądkowych", "escudo zielonoprzylądkowego"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "funt cypryjski", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "korona czeska", PluralForms{"", "korona czeska", "", "korony czeskie", "koron czeskich", "korony czeskiej"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "wschodnia marka wschodnioniemiecka", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marka niemiecka", PluralForms{"", "marka niemiecka", "", "marki niemieckie", "marek niemieckich", "marka niemiecka"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "frank dżibutyjski", PluralForms{"", "frank dżibutyjski", "", "franki dżibutyjskie", "franków dżibutyjskich", "franka dżibutyjskiego"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "korona duńska", PluralForms{"", "korona duńska", "", "korony duńskie", "koron duńskich", "korony duńskiej"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominikańskie", PluralForms{"", "peso dominikańskie", "", "pesos dominikańskie", "pesos dominikańskich", "peso dominikańskiego"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar algierski", PluralForms{"", "dinar algierski", "", "dinary algierskie", "dinarów algierskich", "dinara algierskiego"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre ekwadorski", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "korona estońska", PluralForms{"", "korona estońska", "", "korony estońskie", "koron estońskich", "korona estońska"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "funt egipski", PluralForms{"", "funt egipski", "", "funty egipskie", "funtów egipskich", "funta egipskiego"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa erytrejska", PluralForms{"", "nakfa erytrejska", "", "nakfy erytrejskie", "nakf erytrejskich", "nakfy erytrejskiej"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "peseta hiszpańska (Konto A)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "peseta hiszpańska (konto wymienne)", PluralForms{"", "peseta hiszpańska (konto wymienialne)", "", "pesety hiszpańskie (konto wymienialne)", "peset hiszpańskich (konto wymienialne)", "peseta hiszpańska (konto wymienne)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "peseta hiszpańska", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etiopski", PluralForms{"", "birr etiopski", "", "birry etiopskie", "birrów etiopskich", "birra etiopskiego"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "marka fińska", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dolar fidżyjski", PluralForms{"", "dolar fidżyjski", "", "dolary fidżyjskie", "dolarów fidżyjskich", "dolara fidżyjskiego"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "funt falklandzki", PluralForms{"", "funt falklandzki", "", "funty falklandzkie", "funtów falklandzkich", "funta falklandzkiego"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "frank francuski", PluralForms{"", "frank francuski", "", "franki francuskie", "franków francuskich", "frank francuski"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "funt szterling", PluralForms{"", "funt szterling", "", "funty szterlingi", "funtów szterlingów", "funta szterlinga"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "kupon gruziński larit", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari gruzińskie", PluralForms{"", "lari gruzińskie", "", "lari gruzińskie", "lari gruzińskich", "lari gruzińskiego"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi ghańskie (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghańskie", PluralForms{"", "cedi ghańskie", "", "cedi ghańskie", "cedi ghańskich", "cedi ghańskiego"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "funt gibraltarski", PluralForms{"", "funt gibraltarski", "", "funty gibraltarskie", "funtów gibraltarskich", "funta gibraltarskiego"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambijskie", PluralForms{"", "dalasi gambijskie", "", "dalasi gambijskie", "dalasi gambijskich", "dalasi gambijskiego"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "frank gwinejski", PluralForms{"", "frank gwinejski", "", "franki gwinejskie", "franków gwinejskich", "franka gwinejskiego"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli gwinejskie", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwele gwinejskie Gwinei Równikowej", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "drachma grecka", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal gwatemalski", PluralForms{"", "quetzal gwatemalski", "", "quetzale gwatemalskie", "quetzali gwatemalskich", "quetzala gwatemalskiego"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "escudo Gwinea Portugalska", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso Guinea-Bissau", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dolar gujański", PluralForms{"", "dolar gujański", "", "dolary gujańskie", "dolarów gujańskich", "dolara gujańskiego"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "dolar hongkoński", PluralForms{"", "dolar hongkoński", "", "dolary hongkońskie", "dolarów hongkońskich", "dolara hongkońskiego"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira honduraska", PluralForms{"", "lempira honduraska", "", "lempiry honduraskie", "lempir honduraskich", "lempiry honduraskiej"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar chorwacki", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna chorwacka", PluralForms{"", "kuna chorwacka", "", "kuny chorwackie", "kun chorwackich", "kuny chorwackiej"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitański", PluralForms{"", "gourde haitański", "", "gourde haitańskie", "gourde haitańskich", "gourde haitańskiego"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forint węgierski", PluralForms{"", "forint węgierski", "", "forinty węgierskie", "forintów węgierskich", "forinta węgierskiego"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonezyjska", PluralForms{"", "rupia indonezyjska", "", "rupie indonezyjskie", "rupii indonezyjskich", "rupii indonezyjskiej"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "funt irlandzki", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "funt izraelski", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "nowy szekel izraelski", PluralForms{"", "nowy szekel izraelski", "", "nowe szekle izraelskie", "nowych szekli izraelskich", "nowego szekla izraelskiego"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "rupia indyjska", PluralForms{"", "rupia indyjska", "", "rupie indyjskie", "rupii indyjskich", "rupii indyjskiej"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar iracki", PluralForms{"", "dinar iracki", "", "dinary irackie", "dinarów irackich", "dinara irackiego"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial irański", PluralForms{"", "rial irański", "", "riale irańskie", "riali irańskich", "riala irańskiego"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "korona islandzka", PluralForms{"", "korona islandzka", "", "korony islandzkie", "koron islandzkich", "korony islandzkiej"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "lir włoski", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dolar jamajski", PluralForms{"", "dolar jamajski", "", "dolary jamajskie", "dolarów jamajskich", "dolara jamajskiego"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordański", PluralForms{"", "dinar jordański", "", "dinary jordańskie", "dinarów jordańskich", "dinara jordańskiego"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "jen japoński", PluralForms{"", "jen japoński", "", "jeny japońskie", "jenów japońskich", "jena japońskiego"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "szyling kenijski", PluralForms{"", "szyling kenijski", "", "szylingi kenijskie", "szylingów kenijskich", "szylinga kenijskiego"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirgiski", PluralForms{"", "som kirgiski", "", "somy kirgiskie", "somów kirgiskich", "soma kirgiskiego"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel kambodżański", PluralForms{"", "riel kambodżański", "", "riele kambodżańskie", "rieli kambodżańskich", "riela kambodżańskiego"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "frank komoryjski", PluralForms{"", "frank komoryjski", "", "franki komoryjskie", "franków komoryjskich", "franka komoryjskiego"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won północnokoreański", PluralForms{"", "won północnokoreański", "", "wony północnokoreańskie", "wonów północnokoreańskich", "wona północnokoreańskiego"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "won południowokoreański", PluralForms{"", "won południowokoreański", "", "wony południowokoreańskie", "wonów południowokoreańskich", "wona południowokoreańskiego"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuwejcki", PluralForms{"", "dinar kuwejcki", "", "dinary kuwejckie", "dinarów kuwejckich", "dinara kuwejckiego"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dolar kajmański", PluralForms{"", "dolar kajmański", "", "dolary kajmańskie", "dolarów kajmańskich", "dolara kajmańskiego"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazachskie", PluralForms{"", "tenge kazachskie", "", "tenge kazachskie", "tenge kazachskich", "tenge kazachskiego"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laotański", PluralForms{"", "kip laotański", "", "kipy laotańskie", "kipów laotańskich", "kipa laotańskiego"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "funt libański", PluralForms{"", "funt libański", "", "funty libańskie", "funtów libańskich", "funta libańskiego"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia lankijska", PluralForms{"", "rupia lankijska", "", "rupie lankijskie", "rupii lankijskich", "rupii lankijskiej"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dolar liberyjski", PluralForms{"", "dolar liberyjski", "", "dolary liberyjskie", "dolarów liberyjskich", "dolara liberyjskiego"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti sotyjskie", PluralForms{"", "loti sotyjskie", "", "loti sotyjskie", "loti sotyjskich", "loti sotyjskiego"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "lit litewski", PluralForms{"", "lit litewski", "", "lity litewskie", "litów litewskich", "lita litewskiego"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "talon litewski", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "frank luksemburski", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "łat łotewski", PluralForms{"", "łat łotewski", "", "łaty łotewskie", "łatów łotewskich", "łata łotewskiego"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "rubel łotewski", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libijski", PluralForms{"", "dinar libijski", "", "dinary libijskie", "dinarów libijskich", "dinara libijskiego"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marokański", PluralForms{"", "dirham marokański", "", "dirhamy marokańskie", "dirhamów marokańskich", "dirhama marokańskiego"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "frank marokański", PluralForms{"", "frank marokański", "", "franki marokańskie", "franków marokańskich", "frank marokański"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "lej mołdawski", PluralForms{"", "lej mołdawski", "", "leje mołdawskie", "lejów mołdawskich", "leja mołdawskiego"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary malgaski", PluralForms{"", "ariary malgaski", "", "ariary malgaskie", "ariary malgaskich", "ariary malgaskiego"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "frank malgaski", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "denar macedoński", PluralForms{"", "denar macedoński", "", "denary macedońskie", "denarów macedońskich", "denara macedońskiego"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "frank malijski", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kiat birmański", PluralForms{"", "kiat birmański", "", "kiaty birmańskie", "kiatów birmańskich", "kiata birmańskiego"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongolski", PluralForms{"", "tugrik mongolski", "", "tugriki mongolskie", "tugrików mongolskich", "tugrika mongolskiego"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca Makau", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya mauretańska (1973–2017)", PluralForms{"", "ouguiya mauretańska (1973–2017)", "", "ouguiya mauretańskie (1973–2017)", "ouguiya mauretańskich (1973–2017)", "ouguiya mauretańskiej (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ugija mauretańska", PluralForms{"", "ugija mauretańska", "", "ugija mauretańskie", "ugija mauretańskich", "ugija mauretańskiej"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lira maltańska", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "funt maltański", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia maurytyjska", PluralForms{"", "rupia maurytyjska", "", "rupie maurytyjskie", "rupii maurytyjskich", "rupii maurytyjskiej"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rupia malediwska", PluralForms{"", "rupia malediwska", "", "rupie malediwskie", "rupii malediwskich", "rupii malediwskiej"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malawijska", PluralForms{"", "kwacha malawijska", "", "kwachy malawijskie", "kwach malawijskich", "kwachy malawijskiej"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "peso meksykańskie", PluralForms{"", "peso meksykańskie", "", "pesos meksykańskie", "pesos meksykańskich", "peso meksykańskiego"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso srebrne meksykańskie (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malezyjski", PluralForms{"", "ringgit malezyjski", "", "ringgity malezyjskie", "ringgitów malezyjskich", "ringgita malezyjskiego"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escudo mozambickie", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "metical Mozambik", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambicki", PluralForms{"", "metical mozambicki", "", "meticale mozambickie", "meticali mozambickich", "meticala mozambickiego"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dolar namibijski", PluralForms{"", "dolar namibijski", "", "dolary namibijskie", "dolarów namibijskich", "dolara namibijskiego"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeryjska", PluralForms{"", "naira nigeryjska", "", "nairy nigeryjskie", "nair nigeryjskich", "nairy nigeryjskiej"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "cordoba nikaraguańska (1988–1991)", PluralForms{"", "cordoba nikaraguańska (1988–1991)", "", "cordoby nikaraguańskie (1988–1991)", "cordob nikaraguańskich (1988–1991)", "cordoby nikaraguańskiej (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "cordoba nikaraguańska", PluralForms{"", "cordoba nikaraguańska", "", "cordoby nikaraguańskie", "cordob nikaraguańskich", "cordoby nikaraguańskiej"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "gulden holenderski", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "korona norweska", PluralForms{"", "korona norweska", "", "korony norweskie", "koron norweskich", "korony norweskiej"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalska", PluralForms{"", "rupia nepalska", "", "rupie nepalskie", "rupii nepalskich", "rupii nepalskiej"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "dolar nowozelandzki", PluralForms{"", "dolar nowozelandzki", "", "dolary nowozelandzkie", "dolarów nowozelandzkich", "dolara nowozelandzkiego"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omański", PluralForms{"", "rial omański", "", "riale omańskie", "riali omańskich", "riala omańskiego"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panamski", PluralForms{"", "balboa panamski", "", "balboa panamskie", "balboa panamskich", "balboa panamskiego"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti peruwiański", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol peruwiański", PluralForms{"", "sol peruwiański", "", "sole peruwiańskie", "soli peruwiańskich", "sola peruwiańskiego"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol peruwiański (1863–1965)", PluralForms{"", "sol peruwiański (1863–1965)", "", "sole peruwiańskie (1863–1965)", "soli peruwiańskich (1863–1965)", "sola peruwiańskiego (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina papuańska", PluralForms{"", "kina papuaska", "", "kina papuaskie", "kina papuaskich", "kina papuaskiej"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipińskie", PluralForms{"", "peso filipińskie", "", "pesos filipińskie", "pesos filipińskich", "peso filipińskiego"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia pakistańska", PluralForms{"", "rupia pakistańska", "", "rupie pakistańskie", "rupii pakistańskich", "rupii pakistańskiej"}},
+		"PLN": {2, 0, 2, 1, "PLN", "zł", "zł", "złoty polski", PluralForms{"", "złoty polski", "", "złote polskie", "złotych polskich", "złotego polskiego"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "złoty polski (1950–1995)", PluralForms{"", "złoty polski (1950–1995)", "", "złote polskie (1950–1995)", "złotych polskich (1950–1995)", "złotego polskiego (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "escudo portugalskie", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guarani paragwajskie", PluralForms{"", "guarani paragwajskie", "", "guarani paragwajskie", "guarani paragwajskich", "guarani paragwajskiego"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial katarski", PluralForms{"", "rial katarski", "", "riale katarskie", "riali katarskich", "riala katarskiego"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dolar rodezyjski", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "lej rumuński (1952–2006)", PluralForms{"", "lej rumuński (1952–2006)", "", "lei rumuńskie (1952–2006)", "lei rumuńskich (1952–2006)", "leja rumuńskiego (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lej", "lej rumuński", PluralForms{"", "lej rumuński", "", "leje rumuńskie", "lejów rumuńskich", "leja rumuńskiego"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serbski", PluralForms{"", "dinar serbski", "", "dinary serbskie", "dinarów serbskich", "dinara serbskiego"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rubel rosyjski", PluralForms{"", "rubel rosyjski", "", "ruble rosyjskie", "rubli rosyjskich", "rubla rosyjskiego"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "rubel rosyjski (1991–1998)", PluralForms{"", "rubel rosyjski (1991–1998)", "", "ruble rosyjskie (1991–1998)", "rubli rosyjskich (1991–1998)", "rubla rosyjskiego (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "frank ruandyjski", PluralForms{"", "frank ruandyjski", "", "franki ruandyjskie", "franków ruandyjskich", "franka ruandyjskiego"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "rial saudyjski", PluralForms{"", "rial saudyjski", "", "riale saudyjskie", "riali saudyjskich", "riala saudyjskiego"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dolar Wysp Salomona", PluralForms{"", "dolar Wysp Salomona", "", "dolary Wysp Salomona", "dolarów Wysp Salomona", "dolara Wysp Salomona"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia seszelska", PluralForms{"", "rupia seszelska", "", "rupie seszelskie", "rupii seszelskich", "rupii seszelskiej"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar sudański", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "funt sudański", PluralForms{"", "funt sudański", "", "funty sudańskie", "funtów sudańskich", "funta sudańskiego"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "funt sudański (1957–1998)", PluralForms{"", "funt sudański (1957–1998)", "", "funty sudańskie (1957–1998)", "funtów sudańskich (1957–1998)", "funta sudańskiego (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "korona szwedzka", PluralForms{"", "korona szwedzka", "", "korony szwedzkie", "koron szwedzkich", "korony szwedzkiej"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dolar singapurski", PluralForms{"", "dolar singapurski", "", "dolary singapurskie", "dolarów singapurskich", "dolara singapurskiego"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "funt Świętej Heleny", PluralForms{"", "funt Świętej Heleny", "", "funty Świętej Heleny", "funtów Świętej Heleny", "funta Świętej Heleny"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tolar słoweński", PluralForms{"", "tolar słoweński", "", "tolary słoweńskie", "tolarów słoweńskich", "tolar słoweński"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "korona słowacka", PluralForms{"", "korona słowacka", "", "korony słowackie", "koron słowackich", "korona słowacka"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone sierraleoński", PluralForms{"", "leone sierraleoński", "", "leone sierraleońskie", "leone sierraleońskich", "leone sierraleońskiego"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone sierraleoński (1964—2022)", PluralForms{"", "leone sierraleoński (1964—2022)", "", "leone sierraleońskie (1964—2022)", "leone sierraleońskich (1964—2022)", "leone sierraleońskiego (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "szyling somalijski", PluralForms{"", "szyling somalijski", "", "szylingi somalijskie", "szylingów somalijskich", "szylinga somalijskiego"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dolar surinamski", PluralForms{"", "dolar surinamski", "", "dolary surinamskie", "dolarów surinamskich", "dolara surinamskiego"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "gulden surinamski", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "funt południowosudański", PluralForms{"", "funt południowosudański", "", "funty południowosudańskie", "funtów południowosudańskich", "funta południowosudańskiego"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra Wysp Świętego Tomasza i Książęcej (1977–2017)", PluralForms{"", "dobra Wysp Świętego Tomasza i Książęcej (1977–2017)", "", "dobry Wysp Świętego Tomasza i Książęcej (1977–2017)", "dobr Wysp Świętego Tomasza i Książęcej (1977–2017)", "dobry Wysp Świętego Tomasza i Książęcej (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra Wysp Świętego Tomasza i Książęcej", PluralForms{"", "dobra Wysp Świętego Tomasza i Książęcej", "", "dobry Wysp Świętego Tomasza i Książęcej", "dobr Wysp Świętego Tomasza i Książęcej", "dobry Wysp Świętego Tomasza i Książęcej"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "rubel radziecki", PluralForms{"", "rubel radziecki", "", "ruble radzieckie", "rubli radzieckich", "rubel radziecki"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colon salwadorski", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "funt syryjski", PluralForms{"", "funt syryjski", "", "funty syryjskie", "funtów syryjskich", "funta syryjskiego"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni Suazi", PluralForms{"", "lilangeni Suazi", "", "emalangeni Suazi", "emalangeni Suazi", "emalangeni Suazi"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "baht tajski", PluralForms{"", "baht tajski", "", "bahty tajskie", "bahtów tajskich", "bahta tajskiego"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "rubel tadżycki", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tadżyckie", PluralForms{"", "somoni tadżyckie", "", "somoni tadżyckie", "somoni tadżyckich", "somoni tadżyckiego"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turkmeński (1993–2009)", PluralForms{"", "manat turkmeński (1993–2009)", "", "manaty turkmeńskie (1993–2009)", "manatów turkmeńskich (1993–2009)", "manata turkmeńskiego (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turkmeński", PluralForms{"", "manat turkmeński", "", "manaty turkmeńskie", "manatów turkmeńskich", "manata turkmeńskiego"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunezyjski", PluralForms{"", "dinar tunezyjski", "", "dinary tunezyjskie", "dinarów tunezyjskich", "dinara tunezyjskiego"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "pa’anga tongijska", PluralForms{"", "pa’anga tongijska", "", "pa’anga tongijskie", "pa’anga tongijskich", "pa’anga tongijskiej"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "escudo timorskie", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "lira turecka (1922–2005)", PluralForms{"", "lira turecka (1922–2005)", "", "liry tureckie (1922–2005)", "lir tureckich (1922–2005)", "liry tureckiej (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turecka", PluralForms{"", "lira turecka", "", "liry tureckie", "lir tureckich", "liry tureckiej"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dolar trynidadzki", PluralForms{"", "dolar trynidadzki", "", "dolary trynidadzkie", "dolarów trynidadzkich", "dolara trynidadzkiego"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "nowy dolar tajwański", PluralForms{"", "nowy dolar tajwański", "", "nowe dolary tajwańskie", "nowych dolarów tajwańskich", "nowego dolara tajwańskiego"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "szyling tanzański", PluralForms{"", "szyling tanzański", "", "szylingi tanzańskie", "szylingów tanzańskich", "szylinga tanzańskiego"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hrywna ukraińska", PluralForms{"", "hrywna ukraińska", "", "hrywny ukraińskie", "hrywien ukraińskich", "hrywny ukraińskiej"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbowaniec ukraiński", PluralForms{"", "karbowaniec ukraiński", "", "karbowańce ukraińskie", "karbowańców ukraińskich", "karbowaniec ukraiński"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "szyling ugandyjski (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "szyling ugandyjski", PluralForms{"", "szyling ugandyjski", "", "szylingi ugandyjskie", "szylingów ugandyjskich", "szylinga ugandyjskiego"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "dolar amerykański", PluralForms{"", "dolar amerykański", "", "dolary amerykańskie", "dolarów amerykańskich", "dolara amerykańskiego"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso urugwajskie (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso urugwajskie", PluralForms{"", "peso urugwajskie", "", "pesos urugwajskie", "pesos urugwajskich", "peso urugwajskiego"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "som uzbecki", PluralForms{"", "som uzbecki", "", "somy uzbeckie", "somów uzbeckich", "soma uzbeckiego"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "boliwar wenezuelski (1871–2008)", PluralForms{"", "boliwar wenezuelski (1871–2008)", "", "boliwary wenezuelskie (1871–2008)", "boliwarów wenezuelskich (1871–2008)", "boliwary wenezuelskiego (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "boliwar wenezuelski (2008–2018)", PluralForms{"", "boliwar wenezuelski (2008–2018)", "", "boliwary wenezuelskie (2008–2018)", "boliwarów wenezuelskich (2008–2018)", "boliwara wenezuelskiego (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "boliwar wenezuelski", PluralForms{"", "boliwar wenezuelski", "", "boliwary wenezuelskie", "boliwarów wenezuelskich", "boliwara wenezuelskiego"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "dong wietnamski", PluralForms{"", "dong wietnamski", "", "dongi wietnamskie", "dongów wietnamskich", "donga wietnamskiego"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu wanuackie", PluralForms{"", "vatu wanuackie", "", "vatu wanuackie", "vatu wanuackich", "vatu wanuackiego"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoańskie", PluralForms{"", "tala samoańskie", "", "tala samoańskie", "tala samoańskich", "tala samoańskiego"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "frank CFA BEAC", PluralForms{"", "frank CFA BEAC", "", "franki CFA BEAC", "franków CFA BEAC", "franka CFA BEAC"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "srebro", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "złoto", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "europejska jednostka rozrachunkowa (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "europejska jednostka rozrachunkowa (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "dolar wschodniokaraibski", PluralForms{"", "dolar wschodniokaraibski", "", "dolary wschodniokaraibskie", "dolarów wschodniokaraibskich", "dolara wschodniokaraibskiego"}},
+		"XCG": {2, 0, 2, 1, "XCG", "XCG", "XCG", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "specjalne prawa ciągnienia", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "ECU", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "frank złoty francuski", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "UIC-frank francuski", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "frank CFA", PluralForms{"", "frank CFA", "", "franki CFA", "franków CFA", "franka CFA"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "pallad", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "frank CFP", PluralForms{"", "frank CFP", "", "franki CFP", "franków CFP", "franka CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platyna", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "testowy kod waluty", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "nieznana waluta", PluralForms{"", "(nieznana waluta)", "", "(nieznana waluta)", "(nieznana waluta)", "(nieznana waluta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar jemeński", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial jemeński", PluralForms{"", "rial jemeński", "", "riale jemeńskie", "riali jemeńskich", "riala jemeńskiego"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "nowy dinar jugosławiański", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar jugosławiański wymienny", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand południowoafrykański (finansowy)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand południowoafrykański", PluralForms{"", "rand południowoafrykański", "", "randy południowoafrykańskie", "randów południowoafrykańskich", "randa południowoafrykańskiego"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha zambijska (1968–2012)", PluralForms{"", "kwacha zambijska (1968–2012)", "", "kwacha zambijskie (1968–2012)", "kwacha zambijskich (1968–2012)", "kwacha zambijskiej (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambijska", PluralForms{"", "kwacha zambijska", "", "kwachy zambijskie", "kwach zambijskich", "kwachy zambijskiej"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "nowy zair zairski", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zair zairski", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dolar Zimbabwe (1980–2008)", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dolar Zimbabwe (2009)", PluralForms{"", "", "", "", "", ""}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "dolar Zimbabwe (2008)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
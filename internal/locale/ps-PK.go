@@ -6,173 +6,217 @@ var psPK = LocaleData{
 	NumberInfo{"arabext",
 		[10]string{"۰", "۱", "۲", "۳", "۴", "۵", "۶", "۷", "۸", "۹"},
 		"٫", "٬",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"٪", "؉",
+		"\u200e+\u200e", "\u200e-\u200e",
+		"×۱۰^", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0G", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{9, 1, map[string]string{"one": "0G", "other": "0G"}},
+				{10, 2, map[string]string{"one": "0G", "other": "0G"}},
+				{11, 3, map[string]string{"one": "0G", "other": "0G"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0K\u00a0¤", "other": "0K\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0K\u00a0¤", "other": "0K\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0K\u00a0¤", "other": "0K\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0M\u00a0¤", "other": "0M\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0M\u00a0¤", "other": "0M\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0M\u00a0¤", "other": "0M\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0G\u00a0¤", "other": "0G\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0G\u00a0¤", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{12, 1, map[string]string{"one": "0T\u00a0¤", "other": "0T\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0T\u00a0¤", "other": "0T\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0T\u00a0¤", "other": "0T\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "؋", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "Rs", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "متحده عرب امارات درهم", PluralForms{"", "UAE درهم", "", "", "", "UAE درهمې"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "افغانۍ (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "؋", "؋", "افغانۍ", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "البانوي لک", PluralForms{"", "البانوي لک", "", "", "", "البانوي لکي"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "ارمينيايي ډرام", PluralForms{"", "ارمينيايي ډرام", "", "", "", "ارمينيايي ډرامز"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "هالېنډي انټيليايي ګيلډر", PluralForms{"", "هالېنډې انټيليايي ګيلډر", "", "", "", "هالېنډي انټيليايي ګيلډر"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "انګولي کوانزا", PluralForms{"", "انګولي کوانزا", "", "", "", "انګولي کوانزې"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "ارجنټاين پسو", PluralForms{"", "ارجنټاين پسو", "", "", "", "ارجنټاين پسوز"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "آسترالوي ډالر", PluralForms{"", "آسترالوي ډالر", "", "", "", "آسترالوي ډالرې"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "اروبايي فلورن", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "آزربايجاني منت", PluralForms{"", "آزربايجاني منت", "", "", "", "آزربايجاني منتس"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "بوسنيا هرزګوينيايي بدلېدونکې مارک", PluralForms{"", "بوسنيا هرزګوينيايي بدلېدونکي مارک", "", "", "", "بوسنيا هرزګوينيايي بدلېدونکي مارکس"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "باربيډين ډالر", PluralForms{"", "باربيډين ډالر", "", "", "", "باربيډين ډالرې"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "بنګالۍ ټاکه", PluralForms{"", "بنګالۍ ټاکه", "", "", "", "بنګالۍ ټاکاس"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "بلغاري ليو", PluralForms{"", "بلغاري ليو", "", "", "", "بلغاري ليوا"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "بحريني دينار", PluralForms{"", "بحريني دينار", "", "", "", "بحريني دينارونه"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "برونډي فرانک", PluralForms{"", "برونډي فرانک", "", "", "", "برونډي فرانکس"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "برمودا ډالر", PluralForms{"", "برمودي ډالر", "", "", "", "برمودي ډالرې"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "برونايي ډالر", PluralForms{"", "برونايي ډالر", "", "", "", "برونايي ډالرې"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "بوليوي بوليويانو", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "برازيلي ريل", PluralForms{"", "برازيلي ريل", "", "", "", "برازيلي ريلز"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "بهاماسي ډالر", PluralForms{"", "بهاماسي ډالر", "", "", "", "بهاماسي ډالرې"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "بهوټانۍ انګولټرم", PluralForms{"", "بهوټانۍ انګولټرم", "", "", "", "بهوټانۍ انګولټرمس"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "بوټسواني پولا", PluralForms{"", "بوټسواني پولا", "", "", "", "بوټسواني پولاز"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "بلاروسي روبل", PluralForms{"", "بلاروسي روبل", "", "", "", "بلاروسي روبلز"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "بليز ډالر", PluralForms{"", "بليز ډالر", "", "", "", "بليز ډالرې"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "کاناډايي ډالر", PluralForms{"", "کاناډايي ډالر", "", "", "", "کاناډايي ډالرې"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "کانګولي فرانک", PluralForms{"", "کانګولي فرانک", "", "", "", "کانګولي فرانکس"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "سويسي فرانک", PluralForms{"", "سويسي فرانک", "", "", "", "سويسي فرانکس"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "چلي پسو", PluralForms{"", "چلي پسو", "", "", "", "چلي پسوز"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "چيني يوان (آف شور)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "چيني يوان", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "کولمبين پسو", PluralForms{"", "کولمبين پسو", "", "", "", "کولمبين پسوز"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "کوسټا ريکن کولون", PluralForms{"", "کوسټا ريکن کولون", "", "", "", "کوسټا ريکن کولونز"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "کيوبايي بدلېدونکي پسو", PluralForms{"", "کيوبايي بدلېدونکې پسو", "", "", "", "کيوبايي بدلېدونکې پسوز"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "کيوبايي پسو", PluralForms{"", "کيوبايي پسو", "", "", "", "کيوبايي پسوز"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "کيپ وردين اسکوډو", PluralForms{"", "کيپ وردين اسکوډو", "", "", "", "کيپ وردين اسکوډوز"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "چيک کرونا", PluralForms{"", "چيک کرونا", "", "", "", "چيک کروناز"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "جبوتي فرانک", PluralForms{"", "جبوتي فرانک", "", "", "", "جبوتي فرانکس"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ډنمارکي کرون", PluralForms{"", "ډنمارکي کرون", "", "", "", "ډنمارکي کرونر"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "دومينيکا پسو", PluralForms{"", "دومينيکا پسو", "", "", "", "دومينيکا پسوز"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "الجيرين دينار", PluralForms{"", "الجيرين دينار", "", "", "", "الجيرين دينارې"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "مصري پونډ", PluralForms{"", "مصري پونډ", "", "", "", "مصري پونډونه"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "ايريټرين نکفا", PluralForms{"", "ايريټرين نکفا", "", "", "", "ايريټرين نکفاس"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ايتهوپيايي بر", PluralForms{"", "ايتهوپيايي بر", "", "", "", "ايتهوپيايي برز"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "يورو", PluralForms{"", "يورو", "", "", "", "يوروز"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "فجي ډالر", PluralForms{"", "فجي ډالر", "", "", "", "فجي ډالرې"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "پاکلېنډ ټاپوګانو پونډ", PluralForms{"", "پاکلېنډ ټاپوګانو پونډ", "", "", "", "پاکلېنډ ټاپوګانو پونډز"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "برتانوې پونډ", PluralForms{"", "برتانوې پونډ", "", "", "", "برتانوې پونډونه"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "جارجیاېي لارې", PluralForms{"", "جارجیا لاري", "", "", "", "جارجیاېي لارېز"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ګانين سيډي", PluralForms{"", "ګانين سيډي", "", "", "", "ګانين سيډيز"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "جبل الطارقي پونډ", PluralForms{"", "جبل الطارقي پونډ", "", "", "", "جبل الطارقي پونډونه"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "ګيمبين دلاسې", PluralForms{"", "ګيمبين دلاسې", "", "", "", "ګيمبين دلاسېز"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "ګنې فرانک", PluralForms{"", "ګنې فرانک", "", "", "", "ګنې فرانکس"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ګويټيمالن کوټزل", PluralForms{"", "ګويټيمالن کوټزل", "", "", "", "ګويټيمالن کوټزلز"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "ګايانيز ډالر", PluralForms{"", "ګايانيز ډالر", "", "", "", "ګايانيز ډالرز"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "هانګ کانګ ډالر", PluralForms{"", "هانګ کانګ ډالر", "", "", "", "هانګ کانګ ډالرې"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "هونډوران ليمپيرا", PluralForms{"", "هونډوران ليمپيرا", "", "", "", "هونډوران ليمپيراز"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "کروشيايي کونا", PluralForms{"", "کروشيايي کونا", "", "", "", "کروشيايي کوناز"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "هيټي ګورډ", PluralForms{"", "هيټي ګورډ", "", "", "", "هيټي ګورډز"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "هنګري فورنټ", PluralForms{"", "هنګري فورنټ", "", "", "", "هنګري فورنټز"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "انډونيشي روپيا", PluralForms{"", "انډونيشي روپيا", "", "", "", "انډونيشي روپۍ"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "اسرايلي نيو شيکل", PluralForms{"", "اسرايلي نيو شيکل", "", "", "", "اسرايلي نيو شيکلز"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "هندي روپۍ", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "عراقي دينار", PluralForms{"", "عراقي دينار", "", "", "", "عراقي دينارونه"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ايراني ريال", PluralForms{"", "ايراني ريال", "", "", "", "ايراني ريالونه"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "آيسلېنډي کرونا", PluralForms{"", "آيسلېنډي کرونا", "", "", "", "آيسلېنډي کرونر"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "جمايکايي ډالر", PluralForms{"", "جمايکايي ډالر", "", "", "", "جمايکايي ډالرې"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "اردني دينار", PluralForms{"", "اردني دينار", "", "", "", "اردني دينارونه"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "جاپاني ين", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "کينيايي شيلنګ", PluralForms{"", "کينيايي شيلنګ", "", "", "", "کينيايي شيلنګز"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "کرغزستاني سوم", PluralForms{"", "کرغزستاني سوم", "", "", "", "کرغزستاني سومز"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "کمبوډي ريل", PluralForms{"", "کمبوډي ريل", "", "", "", "کمبوډي ريلس"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "کوموري فرانک", PluralForms{"", "کوموري فرانک", "", "", "", "کوموري فرانکس"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "شمالي کوريايي وان", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "جنوبي کوريايي وان", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "کويتي دينار", PluralForms{"", "کويتي دينار", "", "", "", "کويتي دينارونه"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "کيمن ټاپوګانو ډالر", PluralForms{"", "کيمن ټاپوګانو ډالر", "", "", "", "کيمن ټاپوګانو ډالرې"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "قازقستاني ټينج", PluralForms{"", "قازقستاني ټينج", "", "", "", "قازقستاني ټينجز"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "لاشې کپ", PluralForms{"", "لاشې کپ", "", "", "", "لاشې کپس"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "لبناني پونډ", PluralForms{"", "لبناني پونډ", "", "", "", "لبناني پونډونه"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "سري لنکن روپۍ", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "لايبيرين ډالر", PluralForms{"", "لايبيرين ډالر", "", "", "", "لايبيرين ډالرې"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesotho Loti", PluralForms{"", "Lesotho loti", "", "", "", "Lesotho lotis"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "ليبياېي دينار", PluralForms{"", "ليبياېي دينار", "", "", "", "ليبياېي دينارونه"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "مراکشي درهم", PluralForms{"", "مراکشي درهم", "", "", "", "مراکشي درهمونه"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "مالډوي ليو", PluralForms{"", "مالډوي ليو", "", "", "", "مالډوي لي"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ملاګاسي ارياري", PluralForms{"", "ملاګاسي ارياري", "", "", "", "ملاګاسي ارياريز"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "مسيډونايي دينار", PluralForms{"", "مسيډونايي دينار", "", "", "", "مسيډونايي دينارې"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "ميانماري کيات", PluralForms{"", "ميانماري کيات", "", "", "", "ميانماري کياتې"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "منګوليايي توګريک", PluralForms{"", "منګوليايي توګريک", "", "", "", "منګوليايي توګريکس"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "مکانيس پټاکا", PluralForms{"", "مکانيس پټاکا", "", "", "", "مکانيز پټاکاز"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "موريشيسي ډالر", PluralForms{"", "موريشيسي ډالر", "", "", "", "موريشيسي ډالرې"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "موريشيسي روپۍ", PluralForms{"", "", "", "", "", ""}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "مالديپي روپيا", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "ملاوي کواچا", PluralForms{"", "ملاوي کواچا", "", "", "", "ملاوي کواچاز"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "ميکسيکن پيسو", PluralForms{"", "ميکسيکن پيسو", "", "", "", "ميکسيکن پيسوز"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ملايشي رنګټ", PluralForms{"", "ملايشي رنګټ", "", "", "", "ملايشي رنګټې"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "موزمبيقي ميټيکل", PluralForms{"", "موزمبيقي ميټيکل", "", "", "", "موزمبيقي ميټيکلز"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "نيميبيايي ډالر", PluralForms{"", "بيميبيايي ډالر", "", "", "", "نيميبيايي ډالرې"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "نايجيري نايرا", PluralForms{"", "نايجيري نايرا", "", "", "", "نايجيري نايراز"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "نيکاراګون کورډوبا", PluralForms{"", "نيکاراګون کورډوبا", "", "", "", "نيکاراګون کورډوباز"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "نارويجين کرون", PluralForms{"", "نارويجين کرون", "", "", "", "نارويجين کرونر"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "نيپالي روپۍ", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "نيوزي لينډي ډالر", PluralForms{"", "نيوزي لينډي ډالر", "", "", "", "نيوزي لينډي ډالرې"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "عماني ريال", PluralForms{"", "عماني ريال", "", "", "", "عماني ريالونه"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "پانامۍ بالبوا", PluralForms{"", "پانامۍ بالبوا", "", "", "", "پانامۍ بالبوې"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "پيروين سول", PluralForms{"", "پيروين سول", "", "", "", "پيروين سولز"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "پاپوا نيوګاني کينا", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "فلپاينۍ پیسو", PluralForms{"", "فلپاينۍ پیسو", "", "", "", "فلپاينۍ پیسوز"}},
+		"PKR": {0, 0, 0, 1, "PKR", "Rs", "Rs", "پاکستانۍ کلداره", PluralForms{"", "پاکستانۍ کلداره", "", "", "", "پاکستانۍ کلدارے"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "پولينډي زلوټي", PluralForms{"", "پولينډي زلوټي", "", "", "", "پولينډي زلوټيز"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "پيراګوين ګوراني", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "قطري ريال", PluralForms{"", "قطري ريال", "", "", "", "قطري ریالونه"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "روماني ليو", PluralForms{"", "روماني ليو", "", "", "", "روماني لي"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "سربيايي دينار", PluralForms{"", "سربيايي دينار", "", "", "", "سربيايي دينارې"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "روسي روبل", PluralForms{"", "روسي روبل", "", "", "", "روسي روبلز"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "روانډي فرانک", PluralForms{"", "روانډي فرانک", "", "", "", "روانډي فرانکس"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "سعودي ريال", PluralForms{"", "سعودي ريال", "", "", "", "سعودي ريالونه"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "سولومن ټاپوګانو ډالر", PluralForms{"", "سولومن ټاپوګانو ډالر", "", "", "", "سولومن ټاپوګانو ډالرې"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "سيچيليسي روپۍ", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "سوډاني پونډ", PluralForms{"", "سوډاني پونډ", "", "", "", "سوډاني پونډونه"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "سويډني کرونا", PluralForms{"", "سويډني کرونا", "", "", "", "سويډني کرونر"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "سنګاپور ډالر", PluralForms{"", "سنګاپور ډالر", "", "", "", "سنګاپور ډالرې"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "سينټ هيلينا پونډ", PluralForms{"", "سينټ هيلينا پونډ", "", "", "", "سينټ هيلينا پونډونه"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "سيرا ليوني ليون", PluralForms{"", "سيرا ليوني ليون", "", "", "", "سيرا ليوني ليونونه"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "سيرا ليوني ليون - 1964-2022", PluralForms{"", "سيرا ليوني ليون - 1964-2022", "", "", "", "سيرا ليوني ليونونه - 1964-2022"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "سومالي شيلنګ", PluralForms{"", "سومالي شيلنګ", "", "", "", "سومالي شيلنګز"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "سورينيمي ډالر", PluralForms{"", "سورينيمي ډالر", "", "", "", "سورينيمي ډالرې"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "جنوب سوډاني پونډ", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "ساو ټوم او پرينسپي ډوبرا", PluralForms{"", "ساو ټوم او پرينسپي ډوبرا", "", "", "", "ساو ټوم او پرينسپي ډوبراس"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "سوريايي پونډ", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "سوازي ليلانګيني", PluralForms{"", "سوازي ليلانګيني", "", "", "", "سوازي ايمالانګيني"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "تهايي بات", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "تاجکستاني سوموني", PluralForms{"", "تاجکستاني سوموني", "", "", "", "تاجکستاني سومونيونه"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "ترکمانستاني منت", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "تيونسې دينار", PluralForms{"", "تيونسې دينار", "", "", "", "تونسي دينار"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "ټونګن پانګا", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "ترکي ليرا", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ټرينيډاډ او ټوباګو ډالر", PluralForms{"", "ټرينيډاډ او ټوباګو ډالر", "", "", "", "ټرينيډاډ او ټوباګو ډالرې"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "نيو تائيواني ډالر", PluralForms{"", "نيو تائيواني ډالر", "", "", "", "نيو تائيواني ډالرې"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "تنزاني شيلنګ", PluralForms{"", "تنزاني شيلنګ", "", "", "", "تنزاني شيلنګز"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "اوکرايني هريونيا", PluralForms{"", "اوکرايني هريونيا", "", "", "", "اوکرايني هريونياز"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "يوګانډي شيلنګ", PluralForms{"", "يوګانډي شيلنګ", "", "", "", "يوګانډي شيلنګز"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "امريکايي ډالر", PluralForms{"", "امريکايي ډالر", "", "", "", "امريکايي ډالرې"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "يوراګوي پسو", PluralForms{"", "يوراګوي پسو", "", "", "", "يوراګوي پسوز"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "ازبکستاني سوم", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "وينزويلي بوليوار", PluralForms{"", "وينزويلي بوليوار", "", "", "", "وينزويلي بوليوارز"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "ويتنامي ډونګ", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "ونواتو واتو", PluralForms{"", "ونواتو واتو", "", "", "", "ونواتو واتوس"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "سموون تالا", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "مرکزي افريقايي CFA فرانک", PluralForms{"", "مرکزي افريقايي CFA فرانک", "", "", "", "مرکزي افريقايي CFA فرانکس"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "ختيځ کربين ډالر", PluralForms{"", "ختيځ کربين ډالر", "", "", "", "ختيځ کربين ډالرې"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "ختيځ افريقايي CFA فرانک", PluralForms{"", "ختيځ افريقايي CFA فرانک", "", "", "", "ختيځ افريقايي CFA فرانکس"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP فرانک", PluralForms{"", "CFP فرانک", "", "", "", "CFP فرانکس"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "نامعلومه مروجه پېسے", PluralForms{"", "(د نامعلومه مروجه پېسو واحد)", "", "", "", "(نامعلومه مروجه پېسے)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "يمني ريال", PluralForms{"", "يمني ريال", "", "", "", "يمني ريالونه"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "جنوبي افريقاېي رنډ", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "زيمبي کواچا", PluralForms{"", "زيمبي کواچا", "", "", "", "زيمبي کواچاز"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
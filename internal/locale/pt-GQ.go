@@ -6,310 +6,363 @@ var ptGQ = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0mil", "other": "0\u00a0mil"}},
+				{4, 2, map[string]string{"one": "0\u00a0mil", "other": "0\u00a0mil"}},
+				{5, 3, map[string]string{"one": "0\u00a0mil", "other": "0\u00a0mil"}},
+				{6, 1, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{7, 2, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{8, 3, map[string]string{"one": "0\u00a0M", "other": "0\u00a0M"}},
+				{9, 1, map[string]string{"one": "0\u00a0mM", "other": "0\u00a0mM"}},
+				{10, 2, map[string]string{"one": "0\u00a0mM", "other": "0\u00a0mM"}},
+				{11, 3, map[string]string{"one": "0\u00a0mM", "other": "0\u00a0mM"}},
+				{12, 1, map[string]string{"one": "0\u00a0Bi", "other": "0\u00a0Bi"}},
+				{13, 2, map[string]string{"one": "0\u00a0Bi", "other": "0\u00a0Bi"}},
+				{14, 3, map[string]string{"one": "0\u00a0Bi", "other": "0\u00a0Bi"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 mil", "other": "0 mil"}},
+				{4, 2, map[string]string{"one": "0 mil", "other": "0 mil"}},
+				{5, 3, map[string]string{"one": "0 mil", "other": "0 mil"}},
+				{6, 1, map[string]string{"one": "0 milhão", "other": "0 milhões"}},
+				{7, 2, map[string]string{"one": "0 milhões", "other": "0 milhões"}},
+				{8, 3, map[string]string{"one": "0 milhões", "other": "0 milhões"}},
+				{9, 1, map[string]string{"one": "0 mil milhões", "other": "0 mil milhões"}},
+				{10, 2, map[string]string{"one": "0 mil milhões", "other": "0 mil milhões"}},
+				{11, 3, map[string]string{"one": "0 mil milhões", "other": "0 mil milhões"}},
+				{12, 1, map[string]string{"one": "0 bilião", "other": "0 biliões"}},
+				{13, 2, map[string]string{"one": "0 biliões", "other": "0 biliões"}},
+				{14, 3, map[string]string{"one": "0 biliões", "other": "0 biliões"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0mil\u00a0¤", "other": "0\u00a0mil\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0mil\u00a0¤", "other": "0\u00a0mil\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0mil\u00a0¤", "other": "0\u00a0mil\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0M\u00a0¤", "other": "0\u00a0M\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mM\u00a0¤", "other": "0\u00a0mM\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mM\u00a0¤", "other": "0\u00a0mM\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mM\u00a0¤", "other": "0\u00a0mM\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0B\u00a0¤", "other": "0\u00a0B\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0B\u00a0¤", "other": "0\u00a0B\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0B\u00a0¤", "other": "0\u00a0B\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "+{0}", "≤{0}", "{0} - {1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "\u200b", "\u200b"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Peseta de Andorra", PluralForms{"", "Peseta de Andorra", "", "", "", "Pesetas de Andorra"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham dos Emirados Árabes Unidos", PluralForms{"", "dirham dos Emirados Árabes Unidos", "", "", "", "sdirham dos Emirados Árabes Unidos"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Afeghani (1927–2002)", PluralForms{"", "Afegane do Afeganistão (AFA)", "", "", "", "Afeganes do Afeganistão (AFA)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afegâni afegão", PluralForms{"", "afegâni afegão", "", "", "", "afegânis afegãos"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "Lek Albanês (1946–1965)", PluralForms{"", "Lek Albanês (1946–1965)", "", "", "", "Leks Albaneses (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albanês", PluralForms{"", "lek albanês", "", "", "", "leks albaneses"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram arménio", PluralForms{"", "dram arménio", "", "", "", "drams arménios"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "florim das Antilhas Holandesas", PluralForms{"", "florim das Antilhas Holandesas", "", "", "", "florins das Antilhas Holandesas"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolano", PluralForms{"", "kwanza angolano", "", "", "", "kwanzas angolanos"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Cuanza angolano (1977–1990)", PluralForms{"", "Kwanza angolano (AOK)", "", "", "", "Kwanzas angolanos (AOK)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Novo cuanza angolano (1990–2000)", PluralForms{"", "Novo kwanza angolano (AON)", "", "", "", "Novos kwanzas angolanos (AON)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Cuanza angolano reajustado (1995–1999)", PluralForms{"", "Kwanza angolano reajustado (AOR)", "", "", "", "Kwanzas angolanos reajustados (AOR)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Austral argentino", PluralForms{"", "Austral argentino", "", "", "", "Austrais argentinos"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Peso lei argentino (1970–1983)", PluralForms{"", "Peso lei argentino (1970–1983)", "", "", "", "Pesos lei argentinos (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Peso argentino (1881–1970)", PluralForms{"", "Peso argentino (1881–1970)", "", "", "", "Pesos argentinos (1881–1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Peso argentino (1983–1985)", PluralForms{"", "Peso argentino (1983–1985)", "", "", "", "Pesos argentinos (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentino", PluralForms{"", "peso argentino", "", "", "", "pesos argentinos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Xelim austríaco", PluralForms{"", "Schilling australiano", "", "", "", "Schillings australianos"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "dólar australiano", PluralForms{"", "dólar australiano", "", "", "", "dólares australianos"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florim de Aruba", PluralForms{"", "florim de Aruba", "", "", "", "florins de Aruba"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Manat azerbaijano (1993–2006)", PluralForms{"", "Manat do Azeibaijão (1993–2006)", "", "", "", "Manats do Azeibaijão (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azeri", PluralForms{"", "manat azeri", "", "", "", "manats azeris"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Dinar da Bósnia-Herzegóvina", PluralForms{"", "Dinar da Bósnia Herzegovina", "", "", "", "Dinares da Bósnia Herzegovina"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marco bósnio-herzegóvino conversível", PluralForms{"", "marco bósnio-herzegóvino conversível", "", "", "", "marcos bósnio-herzegóvinos conversíveis"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Novo dinar da Bósnia-Herzegovina (1994–1997)", PluralForms{"", "Novo dinar da Bósnia-Herzegovina", "", "", "", "Novos dinares da Bósnia-Herzegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dólar barbadense", PluralForms{"", "dólar barbadense", "", "", "", "dólares barbadenses"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bengali", PluralForms{"", "taka bengali", "", "", "", "takas bengalis"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Franco belga (convertível)", PluralForms{"", "Franco belga (conversível)", "", "", "", "Francos belgas (conversíveis)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Franco belga", PluralForms{"", "Franco belga", "", "", "", "Francos belgas"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Franco belga (financeiro)", PluralForms{"", "Franco belga (financeiro)", "", "", "", "Francos belgas (financeiros)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Lev forte búlgaro", PluralForms{"", "Lev forte búlgaro", "", "", "", "Levs fortes búlgaros"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Lev socialista búlgaro", PluralForms{"", "Lev socialista búlgaro", "", "", "", "Levs socialistas búlgaros"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev búlgaro", PluralForms{"", "lev búlgaro", "", "", "", "levs búlgaros"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Lev búlgaro (1879–1952)", PluralForms{"", "Lev búlgaro (1879–1952)", "", "", "", "Levs búlgaros (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar baremita", PluralForms{"", "dinar baremita", "", "", "", "dinares baremitas"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franco burundiano", PluralForms{"", "franco burundiano", "", "", "", "francos burundianos"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dólar bermudense", PluralForms{"", "dólar bermudense", "", "", "", "dólares bermudense"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dólar bruneano", PluralForms{"", "dólar bruneano", "", "", "", "dólares bruneanos"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano", PluralForms{"", "boliviano", "", "", "", "bolivianos"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Boliviano (1863–1963)", PluralForms{"", "Boliviano (1863–1963)", "", "", "", "Bolivianos (1863–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Peso boliviano", PluralForms{"", "Peso boliviano", "", "", "", "Pesos bolivianos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Mvdol boliviano", PluralForms{"", "Mvdol boliviano", "", "", "", "Mvdols bolivianos"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Cruzeiro novo brasileiro (1967–1986)", PluralForms{"", "Cruzeiro novo brasileiro (BRB)", "", "", "", "Cruzeiros novos brasileiros (BRB)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Cruzado brasileiro (1986–1989)", PluralForms{"", "Cruzado brasileiro", "", "", "", "Cruzados brasileiros"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Cruzeiro brasileiro (1990–1993)", PluralForms{"", "Cruzeiro brasileiro (BRE)", "", "", "", "Cruzeiros brasileiros (BRE)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "real brasileiro", PluralForms{"", "real brasileiro", "", "", "", "reais brasileiros"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Cruzado novo brasileiro (1989–1990)", PluralForms{"", "Cruzado novo brasileiro", "", "", "", "Cruzados novos brasileiros"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Cruzeiro brasileiro (1993–1994)", PluralForms{"", "Cruzeiro brasileiro", "", "", "", "Cruzeiros brasileiros"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Cruzeiro brasileiro (1942–1967)", PluralForms{"", "Cruzeiro brasileiro antigo", "", "", "", "Cruzeiros brasileiros antigos"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dólar das Bahamas", PluralForms{"", "dólar das Bahamas", "", "", "", "dólares das Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum butanês", PluralForms{"", "ngultrum butanês", "", "", "", "ngultrumes butaneses"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Kyat birmanês", PluralForms{"", "Kyat burmês", "", "", "", "Kyats burmeses"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula de Botswana", PluralForms{"", "pula de Botswana", "", "", "", "pulas de Botswana"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Rublo novo bielorusso (1994–1999)", PluralForms{"", "Novo rublo bielorusso (BYB)", "", "", "", "Novos rublos bielorussos (BYB)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "rublo bielorrusso", PluralForms{"", "rublo bielorrusso", "", "", "", "rublos bielorrussos"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rublo bielorrusso (2000–2016)", PluralForms{"", "Rublo bielorrusso (2000–2016)", "", "", "", "Rublos bielorrussos (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dólar belizense", PluralForms{"", "dólar belizense", "", "", "", "dólares belizense"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "dólar canadiano", PluralForms{"", "dólar canadiano", "", "", "", "dólares canadianos"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franco congolês", PluralForms{"", "franco congolês", "", "", "", "francos congoleses"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Euro WIR", PluralForms{"", "Euro WIR", "", "", "", "Euros WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franco suíço", PluralForms{"", "franco suíço", "", "", "", "francos suíços"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Franco WIR", PluralForms{"", "Franco WIR", "", "", "", "Francos WIR"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Escudo chileno", PluralForms{"", "Escudo chileno", "", "", "", "Escudos chilenos"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Unidades de Fomento chilenas", PluralForms{"", "Unidade de fomento chilena", "", "", "", "Unidades de fomento chilenas"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso chileno", PluralForms{"", "peso chileno", "", "", "", "pesos chilenos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "yuan offshore", PluralForms{"", "yuan offshore", "", "", "", "yuans offshore"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "Dólar do Banco Popular da China", PluralForms{"", "Dólar do Banco Popular da China", "", "", "", "Dólares do Banco Popular da China"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "yuan", PluralForms{"", "yuan", "", "", "", "yuans"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso colombiano", PluralForms{"", "peso colombiano", "", "", "", "pesos colombianos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Unidade de Valor Real", PluralForms{"", "Unidade de valor real", "", "", "", "Unidades de valor real"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colon costa-riquenho", PluralForms{"", "colon costa-riquenho", "", "", "", "colons costa-riquenho"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Dinar sérvio (2002–2006)", PluralForms{"", "Dinar antigo da Sérvia", "", "", "", "Dinares antigos da Sérvia"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Coroa Forte checoslovaca", PluralForms{"", "Coroa forte tchecoslovaca", "", "", "", "Coroas fortes tchecoslovacas"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cubano conversível", PluralForms{"", "peso cubano conversível", "", "", "", "pesos cubanos conversíveis"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubano", PluralForms{"", "peso cubano", "", "", "", "pesos cubanos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo cabo-verdiano", PluralForms{"", "escudo cabo-verdiano", "", "", "", "escudos cabo-verdianos"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Libra de Chipre", PluralForms{"", "Libra cipriota", "", "", "", "Libras cipriotas"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "coroa checa", PluralForms{"", "coroa checa", "", "", "", "coroas checas"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Ostmark da Alemanha Oriental", PluralForms{"", "Marco da Alemanha Oriental", "", "", "", "Marcos da Alemanha Oriental"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Marco alemão", PluralForms{"", "Marco alemão", "", "", "", "Marcos alemães"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franco jibutiano", PluralForms{"", "franco jibutiano", "", "", "", "francos jibutianos"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "coroa dinamarquesa", PluralForms{"", "coroa dinamarquesa", "", "", "", "coroas dinamarquesas"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominicano", PluralForms{"", "peso dominicano", "", "", "", "pesos dominicanos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar argelino", PluralForms{"", "dinar argelino", "", "", "", "dinares argelinos"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Sucre equatoriano", PluralForms{"", "Sucre equatoriano", "", "", "", "Sucres equatorianos"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Unidad de Valor Constante (UVC) do Equador", PluralForms{"", "Unidade de valor constante equatoriana (UVC)", "", "", "", "Unidades de valor constante equatorianas (UVC)"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Coroa estoniana", PluralForms{"", "Coroa estoniana", "", "", "", "Coroas estonianas"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "libra egípcia", PluralForms{"", "libra egípcia", "", "", "", "libras egípcias"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreia", PluralForms{"", "nakfa eritreia", "", "", "", "nakfas eritreias"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Peseta espanhola (conta A)", PluralForms{"", "Peseta espanhola (conta A)", "", "", "", "Pesetas espanholas (conta A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Peseta espanhola (conta conversível)", PluralForms{"", "Peseta espanhola (conta conversível)", "", "", "", "Pesetas espanholas (conta conversível)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Peseta espanhola", PluralForms{"", "Peseta espanhola", "", "", "", "Pesetas espanholas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etíope", PluralForms{"", "birr etíope", "", "", "", "birres etíopes"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Marca finlandesa", PluralForms{"", "Marco finlandês", "", "", "", "Marcos finlandeses"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dólar fijiano", PluralForms{"", "dólar fijiano", "", "", "", "dólares fijianos"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "libra das Ilhas Falkland", PluralForms{"", "libra das Ilhas Falkland", "", "", "", "libras das Ilhas Falkland"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Franco francês", PluralForms{"", "Franco francês", "", "", "", "Francos franceses"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "libra esterlina britânica", PluralForms{"", "libra esterlina britânica", "", "", "", "libras esterlinas britânicas"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Cupom Lari georgiano", PluralForms{"", "Kupon larit da Geórgia", "", "", "", "Kupon larits da Geórgia"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari georgiano", PluralForms{"", "lari georgiano", "", "", "", "laris georgianos"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Cedi de Gana (1979–2007)", PluralForms{"", "Cedi de Gana (1979–2007)", "", "", "", "Cedis de Gana (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ganês", PluralForms{"", "cedi ganês", "", "", "", "cedis ganeses"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "libra de Gibraltar", PluralForms{"", "libra de Gibraltar", "", "", "", "libras de Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambiano", PluralForms{"", "dalasi gambiano", "", "", "", "dalasis gambianos"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franco guineense", PluralForms{"", "franco guineense", "", "", "", "francos guineenses"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Syli da Guiné", PluralForms{"", "Syli guineano", "", "", "", "Sylis guineanos"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekwele da Guiné Equatorial", PluralForms{"", "Ekwele da Guiné Equatorial", "", "", "", "Ekweles da Guiné Equatorial"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Dracma grego", PluralForms{"", "Dracma grego", "", "", "", "Dracmas gregos"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal da Guatemala", PluralForms{"", "quetzal da Guatemala", "", "", "", "quetzales da Guatemala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Escudo da Guiné Portuguesa", PluralForms{"", "Escudo da Guiné Portuguesa", "", "", "", "Escudos da Guinéa Portuguesa"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Peso da Guiné-Bissau", PluralForms{"", "Peso de Guiné-Bissau", "", "", "", "Pesos de Guiné-Bissau"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dólar da Guiana", PluralForms{"", "dólar da Guiana", "", "", "", "dólares da Guiana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "dólar de Hong Kong", PluralForms{"", "dólar de Hong Kong", "", "", "", "dólares de Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira das Honduras", PluralForms{"", "lempira das Honduras", "", "", "", "lempiras das Honduras"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Dinar croata", PluralForms{"", "Dinar croata", "", "", "", "Dinares croatas"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna croata", PluralForms{"", "kuna croata", "", "", "", "kunas croatas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitiano", PluralForms{"", "gourde haitiano", "", "", "", "gourdes haitianos"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forint húngaro", PluralForms{"", "forint húngaro", "", "", "", "forints húngaros"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonésia", PluralForms{"", "rupia indonésia", "", "", "", "rupias indonésias"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Libra irlandesa", PluralForms{"", "Libra irlandesa", "", "", "", "Libras irlandesas"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Libra israelita", PluralForms{"", "Libra israelita", "", "", "", "Libras israelitas"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Sheqel antigo israelita", PluralForms{"", "Sheqel antigo israelita", "", "", "", "Sheqels antigos israelitas"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "sheqel novo israelita", PluralForms{"", "sheqel novo israelita", "", "", "", "sheqels novos israelitas"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "rupia indiana", PluralForms{"", "rupia indiana", "", "", "", "rupias indianas"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar iraquiano", PluralForms{"", "dinar iraquiano", "", "", "", "dinares iraquianos"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iraniano", PluralForms{"", "rial iraniano", "", "", "", "riais iranianos"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Coroa antiga islandesa", PluralForms{"", "Coroa antiga islandesa", "", "", "", "Coroas antigas islandesas"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "coroa islandesa", PluralForms{"", "coroa islandesa", "", "", "", "coroas islandesas"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Lira italiana", PluralForms{"", "Lira italiana", "", "", "", "Liras italianas"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dólar jamaicano", PluralForms{"", "dólar jamaicano", "", "", "", "dólares jamaicanos"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordaniano", PluralForms{"", "dinar jordaniano", "", "", "", "dinares jordanianos"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "iene japonês", PluralForms{"", "iene japonês", "", "", "", "ienes japoneses"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "xelim queniano", PluralForms{"", "xelim queniano", "", "", "", "xelins quenianos"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som quirguiz", PluralForms{"", "som quirguiz", "", "", "", "somes quirguizes"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel cambojano", PluralForms{"", "riel cambojano", "", "", "", "rieles cambojanos"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franco comoriano", PluralForms{"", "franco comoriano", "", "", "", "francos comorianos"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won norte-coreano", PluralForms{"", "won norte-coreano", "", "", "", "wons norte-coreanos"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Hwan da Coreia do Sul (1953–1962)", PluralForms{"", "Hwan da Coreia do Sul", "", "", "", "Hwans da Coreia do Sul"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Won da Coreia do Sul (1945–1953)", PluralForms{"", "Won antigo da Coreia do Sul", "", "", "", "Wons antigos da Coreia do Sul"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "won sul-coreano", PluralForms{"", "won sul-coreano", "", "", "", "wons sul-coreano"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuwaitiano", PluralForms{"", "dinar kuwaitiano", "", "", "", "dinares kuwaitianos"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dólar das Ilhas Caimão", PluralForms{"", "dólar das Ilhas Caimão", "", "", "", "dólares das Ilhas Caimão"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge cazaque", PluralForms{"", "tenge cazaque", "", "", "", "tenges cazaques"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laosiano", PluralForms{"", "kip laosiano", "", "", "", "kips laosianos"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libra libanesa", PluralForms{"", "libra libanesa", "", "", "", "libras libanesas"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia do Sri Lanka", PluralForms{"", "rupia do Sri Lanka", "", "", "", "rupias do Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dólar liberiano", PluralForms{"", "dólar liberiano", "", "", "", "dólares liberianos"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti lesotiano", PluralForms{"", "loti lesotiano", "", "", "", "lotis lesotianos"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas da Lituânia", PluralForms{"", "Litas da Lituânia", "", "", "", "Litas da Lituânia"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Talonas lituano", PluralForms{"", "Talonas lituanas", "", "", "", "Talonases lituanas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Franco conversível de Luxemburgo", PluralForms{"", "Franco conversível de Luxemburgo", "", "", "", "Francos conversíveis de Luxemburgo"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Franco luxemburguês", PluralForms{"", "Franco de Luxemburgo", "", "", "", "Francos de Luxemburgo"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Franco financeiro de Luxemburgo", PluralForms{"", "Franco financeiro de Luxemburgo", "", "", "", "Francos financeiros de Luxemburgo"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats da Letónia", PluralForms{"", "Lats da Letónia", "", "", "", "Lats da Letónia"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Rublo letão", PluralForms{"", "Rublo da Letônia", "", "", "", "Rublos da Letônia"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar líbio", PluralForms{"", "dinar líbio", "", "", "", "dinares líbios"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marroquino", PluralForms{"", "dirham marroquino", "", "", "", "dirhams marroquinos"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Franco marroquino", PluralForms{"", "Franco marroquino", "", "", "", "Francos marroquinos"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Franco monegasco", PluralForms{"", "Franco monegasco", "", "", "", "Francos monegascos"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Cupon moldávio", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldavo", PluralForms{"", "leu moldavo", "", "", "", "leus moldavos"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariari malgaxe", PluralForms{"", "ariari malgaxe", "", "", "", "ariaris malgaxes"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Franco de Madagascar", PluralForms{"", "Franco de Madagascar", "", "", "", "Francos de Madagascar"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinar macedónio", PluralForms{"", "dinar macedónio", "", "", "", "dinares macedónios"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Dinar macedônio (1992–1993)", PluralForms{"", "Dinar macedônio (1992–1993)", "", "", "", "Dinares macedônios (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Franco de Mali", PluralForms{"", "Franco de Mali", "", "", "", "Francos de Mali"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat de Mianmar", PluralForms{"", "kyat de Mianmar", "", "", "", "kyats de Mianmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongol", PluralForms{"", "tugrik mongol", "", "", "", "tugriks mongóis"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca macaense", PluralForms{"", "pataca macaense", "", "", "", "patacas macaenses"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya mauritana (1973–2017)", PluralForms{"", "ouguiya mauritana (1973–2017)", "", "", "", "ouguiyas mauritanas (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya mauritana", PluralForms{"", "ouguiya mauritana", "", "", "", "ouguiyas mauritanas"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Lira maltesa", PluralForms{"", "Lira Maltesa", "", "", "", "Liras maltesas"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Libra maltesa", PluralForms{"", "Libra maltesa", "", "", "", "Libras maltesas"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia mauriciana", PluralForms{"", "rupia mauriciana", "", "", "", "rupias mauricianas"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rupia maldivana", PluralForms{"", "rupia maldivana", "", "", "", "rupias maldivanas"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malauiano", PluralForms{"", "kwacha malauiano", "", "", "", "kwachas malauianos"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "peso mexicano", PluralForms{"", "peso mexicano", "", "", "", "pesos mexicanos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Peso Plata mexicano (1861–1992)", PluralForms{"", "Peso de prata mexicano (1861–1992)", "", "", "", "Pesos de prata mexicanos (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Unidad de Inversion (UDI) mexicana", PluralForms{"", "Unidade de investimento mexicana (UDI)", "", "", "", "Unidades de investimento mexicanas (UDI)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malaio", PluralForms{"", "ringgit malaio", "", "", "", "ringgits malaios"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Escudo de Moçambique", PluralForms{"", "Escudo de Moçambique", "", "", "", "Escudos de Moçambique"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metical de Moçambique (1980–2006)", PluralForms{"", "Metical antigo de Moçambique", "", "", "", "Meticales antigos de Moçambique"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical moçambicano", PluralForms{"", "metical moçambicano", "", "", "", "meticais moçambicanos"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dólar namibiano", PluralForms{"", "dólar namibiano", "", "", "", "dólares namibianos"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeriana", PluralForms{"", "naira nigeriana", "", "", "", "nairas nigerianas"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Córdoba nicaraguano (1988–1991)", PluralForms{"", "Córdoba nicaraguano (1988–1991)", "", "", "", "Córdobas nicaraguano (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba nicaraguano", PluralForms{"", "córdoba nicaraguano", "", "", "", "córdobas nicaraguanos"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Florim holandês", PluralForms{"", "Florim holandês", "", "", "", "Florins holandeses"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "coroa norueguesa", PluralForms{"", "coroa norueguesa", "", "", "", "coroas norueguesas"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalesa", PluralForms{"", "rupia nepalesa", "", "", "", "rupias nepalesas"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "dólar neozelandês", PluralForms{"", "dólar neozelandês", "", "", "", "dólares neozelandeses"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omanense", PluralForms{"", "rial omanense", "", "", "", "riais omanenses"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa do Panamá", PluralForms{"", "balboa do Panamá", "", "", "", "balboas do Panamá"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Inti peruano", PluralForms{"", "Inti peruano", "", "", "", "Intis peruanos"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol peruano", PluralForms{"", "sol peruano", "", "", "", "sóis peruanos"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Sol peruano (1863–1965)", PluralForms{"", "Sol peruano (1863–1965)", "", "", "", "Soles peruanos (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina papuásia", PluralForms{"", "kina papuásia", "", "", "", "kinas papuásias"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipino", PluralForms{"", "peso filipino", "", "", "", "pesos filipinos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia paquistanesa", PluralForms{"", "rupia paquistanesa", "", "", "", "rupias paquistanesas"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloti polaco", PluralForms{"", "zloti polaco", "", "", "", "zlotis polacos"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Zloti polonês (1950–1995)", PluralForms{"", "Zloti polonês (1950–1995)", "", "", "", "Zlotis poloneses (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "\u200b", "\u200b", "escudo português", PluralForms{"", "escudo português", "", "", "", "escudos portugueses"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guarani paraguaio", PluralForms{"", "guarani paraguaio", "", "", "", "guaranis paraguaios"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial catarense", PluralForms{"", "rial catarense", "", "", "", "riais catarenses"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Dólar rodesiano", PluralForms{"", "Dólar da Rodésia", "", "", "", "Dólares da Rodésia"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Leu romeno (1952–2006)", PluralForms{"", "Leu antigo da Romênia", "", "", "", "Leus antigos da Romênia"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "leu romeno", PluralForms{"", "leu romeno", "", "", "", "leus romenos"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar sérvio", PluralForms{"", "dinar sérvio", "", "", "", "dinares sérvios"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rublo russo", PluralForms{"", "rublo russo", "", "", "", "rublos russos"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Rublo russo (1991–1998)", PluralForms{"", "Rublo russo (1991–1998)", "", "", "", "Rublos russos (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franco ruandês", PluralForms{"", "franco ruandês", "", "", "", "francos ruandeses"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "rial saudita", PluralForms{"", "rial saudita", "", "", "", "riais sauditas"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dólar das Ilhas Salomão", PluralForms{"", "dólar das Ilhas Salomão", "", "", "", "dólares das Ilhas Salomão"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia seichelense", PluralForms{"", "rupia seichelense", "", "", "", "rupias seichelenses"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Dinar sudanês (1992–2007)", PluralForms{"", "Dinar antigo do Sudão", "", "", "", "Dinares antigos do Sudão"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "libra sudanesa", PluralForms{"", "libra sudanesa", "", "", "", "libras sudanesas"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Libra sudanesa (1957–1998)", PluralForms{"", "Libra antiga sudanesa", "", "", "", "Libras antigas sudanesas"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "coroa sueca", PluralForms{"", "coroa sueca", "", "", "", "coroas suecas"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dólar singapuriano", PluralForms{"", "dólar singapuriano", "", "", "", "dólares singapurianos"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "libra santa-helenense", PluralForms{"", "libra santa-helenense", "", "", "", "libras santa-helenenses"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Tolar Bons esloveno", PluralForms{"", "Tolar da Eslovênia", "", "", "", "Tolares da Eslovênia"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Coroa eslovaca", PluralForms{"", "Coroa eslovaca", "", "", "", "Coroas eslovacas"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone de Serra Leoa", PluralForms{"", "leone de Serra Leoa", "", "", "", "leones de Serra Leoa"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone de Serra Leoa (1964—2022)", PluralForms{"", "leone de Serra Leoa (1964—2022)", "", "", "", "leones de Serra Leoa (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "xelim somali", PluralForms{"", "xelim somali", "", "", "", "xelins somalis"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dólar do Suriname", PluralForms{"", "dólar do Suriname", "", "", "", "dólares do Suriname"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Florim do Suriname", PluralForms{"", "Florim do Suriname", "", "", "", "Florins do Suriname"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "libra sul-sudanesa", PluralForms{"", "libra sul-sudanesa", "", "", "", "libras sul-sudanesas"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra de São Tomé e Príncipe (1977–2017)", PluralForms{"", "Dobra de São Tomé e Príncipe (1977–2017)", "", "", "", "Dobras de São Tomé e Príncipe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra de São Tomé e Príncipe", PluralForms{"", "dobra de São Tomé e Príncipe", "", "", "", "dobras de São Tomé e Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Rublo soviético", PluralForms{"", "Rublo soviético", "", "", "", "Rublos soviéticos"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Colom salvadorenho", PluralForms{"", "Colon de El Salvador", "", "", "", "Colons de El Salvador"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "libra síria", PluralForms{"", "libra síria", "", "", "", "libras sírias"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni suázi", PluralForms{"", "lilangeni suázi", "", "", "", "lilangenis suázis"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "baht tailandês", PluralForms{"", "baht tailandês", "", "", "", "bahts tailandeses"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Rublo do Tadjiquistão", PluralForms{"", "Rublo do Tajaquistão", "", "", "", "Rublos do Tajaquistão"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tajique", PluralForms{"", "somoni tajique", "", "", "", "somonis tajiques"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Manat do Turcomenistão (1993–2009)", PluralForms{"", "Manat do Turcomenistão (1993–2009)", "", "", "", "Manats do Turcomenistão (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turcomeno", PluralForms{"", "manat turcomeno", "", "", "", "manats turcomenos"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunisino", PluralForms{"", "dinar tunisino", "", "", "", "dinares tunisinos"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "paʻanga tonganesa", PluralForms{"", "paʻanga tonganesa", "", "", "", "paʻangas tonganesas"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Escudo timorense", PluralForms{"", "Escudo do Timor", "", "", "", "Escudos do Timor"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Lira turca (1922–2005)", PluralForms{"", "Lira turca antiga", "", "", "", "Liras turcas antigas"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira turca", PluralForms{"", "lira turca", "", "", "", "liras turcas"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dólar de Trindade e Tobago", PluralForms{"", "dólar de Trindade e Tobago", "", "", "", "dólares de Trindade e Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "novo dólar taiwanês", PluralForms{"", "novo dólar taiwanês", "", "", "", "novos dólares taiwaneses"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "xelim tanzaniano", PluralForms{"", "xelim tanzaniano", "", "", "", "xelins tanzanianos"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hryvnia ucraniano", PluralForms{"", "hryvnia ucraniano", "", "", "", "hryvnias ucranianos"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Karbovanetz ucraniano", PluralForms{"", "Karbovanetz da Ucrânia", "", "", "", "Karbovanetzs da Ucrânia"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Xelim ugandense (1966–1987)", PluralForms{"", "Shilling de Uganda (1966–1987)", "", "", "", "Shillings de Uganda (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "xelim ugandense", PluralForms{"", "xelim ugandense", "", "", "", "xelins ugandenses"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "dólar dos Estados Unidos", PluralForms{"", "dólar dos Estados Unidos", "", "", "", "dólares dos Estados Unidos"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Dólar norte-americano (Dia seguinte)", PluralForms{"", "Dólar americano (dia seguinte)", "", "", "", "Dólares americanos (dia seguinte)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Dólar norte-americano (Mesmo dia)", PluralForms{"", "Dólar americano (mesmo dia)", "", "", "", "Dólares americanos (mesmo dia)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Peso uruguaio en unidades indexadas", PluralForms{"", "Peso uruguaio em unidades indexadas", "", "", "", "Pesos uruguaios em unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Peso uruguaio (1975–1993)", PluralForms{"", "Peso uruguaio (1975–1993)", "", "", "", "Pesos uruguaios (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguaio", PluralForms{"", "peso uruguaio", "", "", "", "pesos uruguaios"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "som uzbeque", PluralForms{"", "som uzbeque", "", "", "", "somes uzbeques"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Bolívar venezuelano (1871–2008)", PluralForms{"", "Bolívar venezuelano (1871–2008)", "", "", "", "Bolívares venezuelanos (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "bolívar (2008–2018)", PluralForms{"", "bolívar (2008–2018)", "", "", "", "bolívares (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar", PluralForms{"", "bolívar", "", "", "", "bolívares"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "dong vietnamita", PluralForms{"", "dong vietnamita", "", "", "", "dongs vietnamitas"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Dong vietnamita (1978–1985)", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu de Vanuatu", PluralForms{"", "vatu de Vanuatu", "", "", "", "vatus de Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoano", PluralForms{"", "tala samoano", "", "", "", "talas samoanos"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franco CFA (BEAC)", PluralForms{"", "franco CFA (BEAC)", "", "", "", "francos CFA (BEAC)"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Prata", PluralForms{"", "Prata", "", "", "", "Pratas"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Ouro", PluralForms{"", "Ouro", "", "", "", "Ouros"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Unidade Composta Europeia", PluralForms{"", "Unidade de composição europeia", "", "", "", "Unidades de composição europeias"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Unidade Monetária Europeia", PluralForms{"", "Unidade monetária europeia", "", "", "", "Unidades monetárias europeias"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Unidade de Conta Europeia (XBC)", PluralForms{"", "Unidade europeia de conta (XBC)", "", "", "", "Unidades europeias de conta (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Unidade de Conta Europeia (XBD)", PluralForms{"", "Unidade europeia de conta (XBD)", "", "", "", "Unidades europeias de conta (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "dólar das Caraíbas Orientais", PluralForms{"", "dólar das Caraíbas Orientais", "", "", "", "dólares das Caraíbas Orientais"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Direitos Especiais de Giro", PluralForms{"", "Direitos de desenho especiais", "", "", "", "Direitos de desenho especiais"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Unidade de Moeda Europeia", PluralForms{"", "Unidade de moeda europeia", "", "", "", "Unidades de moedas europeias"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Franco-ouro francês", PluralForms{"", "Franco de ouro francês", "", "", "", "Francos de ouro franceses"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Franco UIC francês", PluralForms{"", "Franco UIC francês", "", "", "", "Francos UIC franceses"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franco CFA (BCEAO)", PluralForms{"", "franco CFA (BCEAO)", "", "", "", "francos CFA (BCEAO)"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paládio", PluralForms{"", "Paládio", "", "", "", "Paládios"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franco CFP", PluralForms{"", "franco CFP", "", "", "", "francos CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platina", PluralForms{"", "Platina", "", "", "", "Platinas"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "Fundos RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Código de Moeda de Teste", PluralForms{"", "Código de moeda de teste", "", "", "", "Códigos de moeda de teste"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "moeda desconhecida", PluralForms{"", "(moeda desconhecida)", "", "", "", "(moedas desconhecidas)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Dinar iemenita", PluralForms{"", "Dinar do Iêmen", "", "", "", "Dinares do Iêmen"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial iemenita", PluralForms{"", "rial iemenita", "", "", "", "riais iemenitas"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Dinar forte iugoslavo (1966–1990)", PluralForms{"", "Dinar forte iugoslavo", "", "", "", "Dinares fortes iugoslavos"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Dinar noviy iugoslavo (1994–2002)", PluralForms{"", "Dinar noviy da Iugoslávia", "", "", "", "Dinares noviy da Iugoslávia"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Dinar conversível iugoslavo (1990–1992)", PluralForms{"", "Dinar conversível da Iugoslávia", "", "", "", "Dinares conversíveis da Iugoslávia"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Dinar reformado iugoslavo (1992–1993)", PluralForms{"", "Dinar iugoslavo reformado", "", "", "", "Dinares iugoslavos reformados"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Rand sul-africano (financeiro)", PluralForms{"", "Rand da África do Sul (financeiro)", "", "", "", "Rands da África do Sul (financeiro)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sul-africano", PluralForms{"", "rand sul-africano", "", "", "", "rands sul-africanos"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha zambiano (1968–2012)", PluralForms{"", "Kwacha zambiano (1968–2012)", "", "", "", "Kwachas zambianos (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambiano", PluralForms{"", "kwacha zambiano", "", "", "", "kwachas zambianos"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zaire Novo zairense (1993–1998)", PluralForms{"", "Novo zaire do Zaire", "", "", "", "Novos zaires do Zaire"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zaire zairense (1971–1993)", PluralForms{"", "Zaire do Zaire", "", "", "", "Zaires do Zaire"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dólar do Zimbábue (1980–2008)", PluralForms{"", "Dólar do Zimbábue", "", "", "", "Dólares do Zimbábue"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Dólar do Zimbábue (2009)", PluralForms{"", "Dólar do Zimbábue (2009)", "", "", "", "Dólares do Zimbábue (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Dólar do Zimbábue (2008)", PluralForms{"", "Dólar do Zimbábue (2008)", "", "", "", "Dólares do Zimbábue (2008)"}},
 	},
 }
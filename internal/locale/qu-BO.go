@@ -6,172 +6,225 @@ var quBO = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "¤\u00a00K"}},
+				{4, 2, map[string]string{"other": "¤\u00a00K"}},
+				{5, 3, map[string]string{"other": "¤\u00a00K"}},
+				{6, 1, map[string]string{"other": "¤\u00a00M"}},
+				{7, 2, map[string]string{"other": "¤\u00a00M"}},
+				{8, 3, map[string]string{"other": "¤\u00a00M"}},
+				{9, 1, map[string]string{"other": "¤\u00a00G"}},
+				{10, 2, map[string]string{"other": "¤\u00a00G"}},
+				{11, 3, map[string]string{"other": "¤\u00a00G"}},
+				{12, 1, map[string]string{"other": "¤\u00a00T"}},
+				{13, 2, map[string]string{"other": "¤\u00a00T"}},
+				{14, 3, map[string]string{"other": "¤\u00a00T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBG", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "DBM", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "Bs", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "DBZ", "$"},
-		"CAD": {2, "CAD", "$CA", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GHC"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$US", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirham de Emiratos Árabes Unidos", PluralForms{"", "", "", "", "", "UAE dirhams"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afgani Afgano", PluralForms{"", "", "", "", "", "Afgani afgano"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lek albanés", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dram Armenio", PluralForms{"", "", "", "", "", "drams Armenios"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Florín Antillano Neerlandés", PluralForms{"", "", "", "", "", "Florín antillano neerlandés"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kwanza Angoleño", PluralForms{"", "", "", "", "", "Kwanza angoleño"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso Argentino", PluralForms{"", "", "", "", "", "Peso argentino"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dólar Australiano", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Florín Arubeño", PluralForms{"", "", "", "", "", "Florín arubeño"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manat Azerbaiyano", PluralForms{"", "", "", "", "", "manats Azerbaiyanos"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Marco Bosnioherzegovino", PluralForms{"", "", "", "", "", "Marco bosnioherzegovino"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBG", "$", "Dólar de Barbados", PluralForms{"", "", "", "", "", ""}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka Bangladesí", PluralForms{"", "", "", "", "", "Taka bangladesí"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lev", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinar Bareiní", PluralForms{"", "", "", "", "", "Dinar bareiní"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Franco Burundés", PluralForms{"", "", "", "", "", "Franco burundés"}},
+		"BMD": {2, 0, 2, 1, "BMD", "DBM", "$", "Dólar Bermudeño", PluralForms{"", "", "", "", "", "Dólar bermudeño"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dólar de Brunéi", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "Bs", "Bs", "Boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Real Brasileño", PluralForms{"", "", "", "", "", "Real brasileño"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dólar Bahameño", PluralForms{"", "", "", "", "", "Dólar bahameño"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrum Butanés", PluralForms{"", "", "", "", "", "Ngultrum butanés"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula Botswano", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Nuevo Rublo Bielorruso", PluralForms{"", "", "", "", "", "Nuevo rublo bielorruso"}},
+		"BZD": {2, 0, 2, 1, "BZD", "DBZ", "$", "Dólar Beliceño", PluralForms{"", "", "", "", "", "Dólar beliceño"}},
+		"CAD": {2, 0, 2, 5, "CAD", "$CA", "$", "Dólar Canadiense", PluralForms{"", "", "", "", "", "Dólar canadiense"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Franco Congoleño", PluralForms{"", "", "", "", "", "Franco congoleño"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Franco Suizo", PluralForms{"", "", "", "", "", "Franco suizo"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso Chileno", PluralForms{"", "", "", "", "", "Peso chileno"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuan Chino (offshore)", PluralForms{"", "", "", "", "", "Yuan chino (offshore)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuan Chino", PluralForms{"", "", "", "", "", "Yuan chino"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso Colombiano", PluralForms{"", "", "", "", "", "Peso colombiano"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colón Costarricense", PluralForms{"", "", "", "", "", "Colón costarricense"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso Cubano Convertible", PluralForms{"", "", "", "", "", "Peso cubano convertible"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso Cubano", PluralForms{"", "", "", "", "", "Peso cubano"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Escudo Caboverdiano", PluralForms{"", "", "", "", "", "Escudo caboverdiano"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Corona Checa", PluralForms{"", "", "", "", "", "Corona checa"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Franco Yibutiano", PluralForms{"", "", "", "", "", "Franco yibutiano"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Corona Danesa", PluralForms{"", "", "", "", "", "Corona danesa"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso Dominicano", PluralForms{"", "", "", "", "", "Peso dominicano"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinar Argelino", PluralForms{"", "", "", "", "", "Dinar argelino"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Libra Egipcia", PluralForms{"", "", "", "", "", "Libra egipcia"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa Eritreano", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birr Etíope", PluralForms{"", "", "", "", "", "Birr etíope"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "", "", "", "", "euro"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dólar Fiyiano", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Libra Malvinense", PluralForms{"", "", "", "", "", "Libra malvinense"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Libra Esterlina", PluralForms{"", "", "", "", "", "Libra esterlina"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lari Georgiano", PluralForms{"", "", "", "", "", "Lari georgiano"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GHC", "Cedi Ganés", PluralForms{"", "", "", "", "", "Cedi ganés"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Libra Gibraltareña", PluralForms{"", "", "", "", "", "Libra gibraltareña"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Franco Guineano", PluralForms{"", "", "", "", "", "Franco guineano"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal Guatemalteco", PluralForms{"", "", "", "", "", "Quetzal guatemalteco"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dólar Guyanés", PluralForms{"", "", "", "", "", "Dólar guyanés"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dólar de Hong Kong", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira Hondureño", PluralForms{"", "", "", "", "", "Lempira hondureño"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuna Croata", PluralForms{"", "", "", "", "", "Kuna croata"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gourde Haitiano", PluralForms{"", "", "", "", "", "Gourde haitiano"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Florín Húngaro", PluralForms{"", "", "", "", "", "Florín húngaro"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupia Indonesia", PluralForms{"", "", "", "", "", "Rupia indonesia"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Nuevo Séquel", PluralForms{"", "", "", "", "", "Nuevo séquel"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupia India", PluralForms{"", "", "", "", "", "Rupia india"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinar Iraquí", PluralForms{"", "", "", "", "", "Dinar iraquí"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial Iraní", PluralForms{"", "", "", "", "", "Rial iraní"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Corona Islandesa", PluralForms{"", "", "", "", "", "Corona islandesa"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dólar Jamaiquino", PluralForms{"", "", "", "", "", "Dólar jamaiquino"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinar Jordano", PluralForms{"", "", "", "", "", "Dinar jordano"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yen Japonés", PluralForms{"", "", "", "", "", "Yen japonés"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Chelín Keniano", PluralForms{"", "", "", "", "", "Chelín keniano"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som Kirguís", PluralForms{"", "", "", "", "", "Som kirguís"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riel Camboyano", PluralForms{"", "", "", "", "", "Riel camboyano"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Franco Comorense", PluralForms{"", "", "", "", "", "Franco comorense"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won Norcoreano", PluralForms{"", "", "", "", "", "Won norcoreano"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Won Surcoreano", PluralForms{"", "", "", "", "", "Won surcoreano"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinar Kuwaití", PluralForms{"", "", "", "", "", "Dinar kuwaití"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dólar de las Islas Caimán", PluralForms{"", "", "", "", "", "Dólar de las islas caimán"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge Kazajo", PluralForms{"", "", "", "", "", "Tenge kazajo"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kip Laosiano", PluralForms{"", "", "", "", "", "Kip laosiano"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Libra Libanesa", PluralForms{"", "", "", "", "", "Libra libanesa"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rupia de Sri Lanka", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dólar Liberiano", PluralForms{"", "", "", "", "", "Dólar liberiano"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesoto Loti Qullqi", PluralForms{"", "", "", "", "", "Lesoto lotis qullqi"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinar Libio", PluralForms{"", "", "", "", "", "Dinar libio"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dírham Marroquí", PluralForms{"", "", "", "", "", "Dírham marroquí"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leu Moldavo", PluralForms{"", "", "", "", "", "Leu moldavo"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ariary Malgache", PluralForms{"", "", "", "", "", "Ariary malgache"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Dinar Macedonio", PluralForms{"", "", "", "", "", "Dinar macedonio"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kyat Birmano", PluralForms{"", "", "", "", "", "Kyat birmano"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik Mongol", PluralForms{"", "", "", "", "", "Tugrik mongol"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataca Macaense", PluralForms{"", "", "", "", "", "Pataca macaense"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Uguiya Mauritano", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rupia de Mauricio", PluralForms{"", "", "", "", "", "Rupia de mauricio"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rupia de Maldivas", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kwacha Malauí", PluralForms{"", "", "", "", "", "Kwacha malauí"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso Mexicano", PluralForms{"", "", "", "", "", "Peso mexicano"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringgit Malayo", PluralForms{"", "", "", "", "", "Ringgit malayo"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metical Mozambiqueño", PluralForms{"", "", "", "", "", "Metical mozambiqueño"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dólar Namibio", PluralForms{"", "", "", "", "", "Dólar namibio"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naira Nigeriano", PluralForms{"", "", "", "", "", "Naira nigeriano"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Córdova Nicaragüense", PluralForms{"", "", "", "", "", "Córdova nicaragüense"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Corona Noruega", PluralForms{"", "", "", "", "", "Corona noruega"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rupia Nepalí", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dólar Neozelandés", PluralForms{"", "", "", "", "", "Dólar neozelandés"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Rial Omaní", PluralForms{"", "", "", "", "", "Rial omaní"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa Panameño", PluralForms{"", "", "", "", "", "Balboa panameño"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol Peruano", PluralForms{"", "", "", "", "", "Sol peruano"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina Papuano", PluralForms{"", "", "", "", "", "Kina papuano"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Peso Filipino", PluralForms{"", "", "", "", "", "Peso filipino"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rupia Pakistaní", PluralForms{"", "", "", "", "", "Rupia pakistaní"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Zloty", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guaraní Paraguayo", PluralForms{"", "", "", "", "", "Guaraní paraguayo"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Riyal Catarí", PluralForms{"", "", "", "", "", "Riyal catarí"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Leu Rumano", PluralForms{"", "", "", "", "", "Leu rumano"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinar Serbio", PluralForms{"", "", "", "", "", "Dinar serbio"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rublo Ruso", PluralForms{"", "", "", "", "", "Rublo ruso"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Franco Ruandés", PluralForms{"", "", "", "", "", "Franco ruandés"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyal Saudí", PluralForms{"", "", "", "", "", "Riyal saudí"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dólar de las Islas Salomón", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rupia de Seychelles", PluralForms{"", "", "", "", "", "Rupia de seychelles"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Libra Sudanesa", PluralForms{"", "", "", "", "", "Libra sudanesa"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Corona Sueca", PluralForms{"", "", "", "", "", "Corona sueca"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dólar de Singapur", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Libra de Santa Helena", PluralForms{"", "", "", "", "", "Libra de santa helena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leone qullqi de Sierra Leona", PluralForms{"", "", "", "", "", "Leone qullqi de Sierra Leona"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leone qullqi de Sierra Leona (1964–2022)", PluralForms{"", "", "", "", "", "Leones qullqi de Sierra Leona (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Chelín Somalí", PluralForms{"", "", "", "", "", "Chelín somalí"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dólar Surinamés", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Libra Sursudanesa", PluralForms{"", "", "", "", "", "Libra sursudanesa"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra Santotomense", PluralForms{"", "", "", "", "", "Dobra santotomense"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Libra Siria", PluralForms{"", "", "", "", "", "Libra siria"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni Swazi", PluralForms{"", "", "", "", "", "Lilangeni swazi"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Baht Tailandés", PluralForms{"", "", "", "", "", "Baht tailandés"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni Tayiko", PluralForms{"", "", "", "", "", "Somoni tayiko"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manat Turcomano", PluralForms{"", "", "", "", "", "Manat turcomano"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinar Tunecino", PluralForms{"", "", "", "", "", "Dinar tunecino"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga Tongano", PluralForms{"", "", "", "", "", "Paʻanga tongano"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lira Turca", PluralForms{"", "", "", "", "", "Lira turca"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dólar de Trinidad y Tobago", PluralForms{"", "", "", "", "", "Dólar de trinidad y tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Nuevo Dólar Taiwanés", PluralForms{"", "", "", "", "", "Nuevo dólar taiwanés"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Chelín Tanzano", PluralForms{"", "", "", "", "", "Chelín tanzano"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Grivna", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Chelín Ugandés", PluralForms{"", "", "", "", "", "Chelín ugandés"}},
+		"USD": {2, 0, 2, 1, "USD", "$US", "$", "Dólar Americano", PluralForms{"", "", "", "", "", "Dólar americano"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso Uruguayo", PluralForms{"", "", "", "", "", "Peso uruguayo"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Som Ubzeko", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolívar Venezolano", PluralForms{"", "", "", "", "", "Bolívar venezolano"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dong Vietnamita", PluralForms{"", "", "", "", "", "Dong vietnamita"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu Vanuatu", PluralForms{"", "", "", "", "", "Vatu vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala Samoano", PluralForms{"", "", "", "", "", "Tala samoano"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Franco CFA de África Central", PluralForms{"", "", "", "", "", "Franco CFA de áfrica central"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dólar del Caribe Oriental", PluralForms{"", "", "", "", "", "Dólar del caribe oriental"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Franco CFA de África Occidental", PluralForms{"", "", "", "", "", "Franco CFA de áfrica occidental"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Franco CFP", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Mana riqsisqa Qullqi", PluralForms{"", "", "", "", "", "Mana riqsisqa qullqi"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Rial Yemení", PluralForms{"", "", "", "", "", "Rial yemení"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Rand Sudafricano", PluralForms{"", "", "", "", "", "Rand sudafricano"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kwacha Zambiano", PluralForms{"", "", "", "", "", "Kwacha zambiano"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,308 +6,361 @@ var rm = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u202f",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"E", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0\u00a0miu.", "other": "0\u00a0miu."}},
+				{7, 2, map[string]string{"one": "0\u00a0miu.", "other": "0\u00a0miu."}},
+				{8, 3, map[string]string{"one": "0\u00a0miu.", "other": "0\u00a0miu."}},
+				{9, 1, map[string]string{"one": "0\u00a0mia.", "other": "0\u00a0mia."}},
+				{10, 2, map[string]string{"one": "0\u00a0mia.", "other": "0\u00a0mia."}},
+				{11, 3, map[string]string{"one": "0\u00a0mia.", "other": "0\u00a0mia."}},
+				{12, 1, map[string]string{"one": "0\u00a0biu.", "other": "0\u00a0biu."}},
+				{13, 2, map[string]string{"one": "0\u00a0biu.", "other": "0\u00a0biu."}},
+				{14, 3, map[string]string{"one": "0T\u00a0biu.", "other": "0\u00a0biu."}},
+			},
+			[]CompactFormat{
+				{3, 0, nil},
+				{4, 0, nil},
+				{5, 0, nil},
+				{6, 1, map[string]string{"one": "0 milliun", "other": "0 milliuns"}},
+				{7, 2, map[string]string{"one": "0 milliuns", "other": "0 milliuns"}},
+				{8, 3, map[string]string{"one": "0 milliuns", "other": "0 milliuns"}},
+				{9, 1, map[string]string{"one": "0 milliarda", "other": "0 milliardas"}},
+				{10, 2, map[string]string{"one": "0 milliardas", "other": "0 milliardas"}},
+				{11, 3, map[string]string{"one": "0 milliardas", "other": "0 milliardas"}},
+				{12, 1, map[string]string{"one": "0 billiun", "other": "0 billiuns"}},
+				{13, 2, map[string]string{"one": "0 billiuns", "other": "0 billiuns"}},
+				{14, 3, map[string]string{"one": "0 billiuns", "other": "0 billiuns"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "¤\u00a00K"}},
+				{4, 2, map[string]string{"other": "¤\u00a00K"}},
+				{5, 3, map[string]string{"other": "¤\u00a00K"}},
+				{6, 1, map[string]string{"other": "¤\u00a00M"}},
+				{7, 2, map[string]string{"other": "¤\u00a00M"}},
+				{8, 3, map[string]string{"other": "¤\u00a00M"}},
+				{9, 1, map[string]string{"other": "¤\u00a00G"}},
+				{10, 2, map[string]string{"other": "¤\u00a00G"}},
+				{11, 3, map[string]string{"other": "¤\u00a00G"}},
+				{12, 1, map[string]string{"other": "¤\u00a00T"}},
+				{13, 2, map[string]string{"other": "¤\u00a00T"}},
+				{14, 3, map[string]string{"other": "¤\u00a00T"}},
+			},
+		},
+		PluralForms{"", "{0} {1}", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "peseta andorrana", PluralForms{"", "peseta andorrana", "", "", "", "pesetas andorranas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham dals Emirats Arabs Unids", PluralForms{"", "dirham dals Emirats Arabs Unids", "", "", "", "dirhams dals Emirats Arabs Unids"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afgani afgan (1927–2002)", PluralForms{"", "afgani afgan (1927–2002)", "", "", "", "afganis afgans (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afgan", PluralForms{"", "afgani afgan", "", "", "", "afganis afgans"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "lek albanais (1947–1961)", PluralForms{"", "lek albanais (1947–1961)", "", "", "", "leks albanais (1947–1961)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek albanais", PluralForms{"", "lek albanais", "", "", "", "leks albanais"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armen", PluralForms{"", "dram armen", "", "", "", "drams armens"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "flurin da las Antillas Ollandaisas", PluralForms{"", "flurin da las Antillas Ollandaisas", "", "", "", "flurins da las Antillas Ollandaisas"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angolan", PluralForms{"", "kwanza angolan", "", "", "", "kwanzas angolans"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "kwanza angolan (1977–1991)", PluralForms{"", "kwanza angolan (1977–1991)", "", "", "", "kwanzas angolans (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "nov kwanza angolan (1990–2000)", PluralForms{"", "nov kwanza angolan (1990–2000)", "", "", "", "novs kwanzas angolans (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "kwanza angolan reagiustà (1995–1999)", PluralForms{"", "kwanza angolan reagiustà (1995–1999)", "", "", "", "kwanzas angolans reagiustads (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "austral argentin", PluralForms{"", "", "", "", "", ""}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "peso argentin ley", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "peso argentin moneda nacional", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentin (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentin", PluralForms{"", "pesos argentins", "", "", "", "pesos argentins"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "schilling austriac", PluralForms{"", "schilling austriac", "", "", "", "schillings austriacs"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "dollar australian", PluralForms{"", "dollar australian", "", "", "", "dollars australians"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "flurin da l’Aruba", PluralForms{"", "flurins da l’Aruba", "", "", "", "flurins da l’Aruba"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat aserbaidschan (1993–2006)", PluralForms{"", "manat aserbaidschan (1993–2006)", "", "", "", "manats aserbaidschans (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat aserbaidschan", PluralForms{"", "manat aserbaidschan", "", "", "", "manats aserbaidschans"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar da la Bosnia-Erzegovina (1992–1994)", PluralForms{"", "dinar da la Bosnia-Erzegovina (1992–1994)", "", "", "", "dinars da la Bosnia-Erzegovina (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marc convertibel da la Bosnia-Erzegovina", PluralForms{"", "marc convertibel da la Bosnia-Erzegovina", "", "", "", "marcs convertibel da la Bosnia-Erzegovina"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "nov dinar da la Bosnia-Erzegovina (1994–1997)", PluralForms{"", "nov dinar da la Bosnia-Erzegovina (1994–1997)", "", "", "", "novs dinars da la Bosnia-Erzegovina (1994–1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dollar da Barbados", PluralForms{"", "dollars da Barbados", "", "", "", "dollars da Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka bangladais", PluralForms{"", "taka bangladais", "", "", "", "takas bangladais"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franc beltg (convertibel)", PluralForms{"", "franc beltg (convertibel)", "", "", "", "francs beltgs (convertibels)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "franc beltg", PluralForms{"", "franc beltg", "", "", "", "francs beltgs"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franc beltg (finanzial)", PluralForms{"", "franc beltg (finanzial)", "", "", "", "francs beltgs (finanzial)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "lev bulgar dir", PluralForms{"", "lev bulgar dir", "", "", "", "levs bulgars dirs"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "lev bulgar socialistic", PluralForms{"", "lev bulgar socialistic", "", "", "", "levs bulgars socialistics"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "lev bulgar", PluralForms{"", "lev bulgar", "", "", "", "levs bulgars"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "lev bulgar (1879–1952)", PluralForms{"", "lev bulgar (1879–1952)", "", "", "", "levs bulgars (1879–1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar dal Bahrain", PluralForms{"", "dinar dal Bahrain", "", "", "", "dinars dal Bahrain"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franc dal Burundi", PluralForms{"", "franc dal Burundi", "", "", "", "francs dal Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dollar da las Bermudas", PluralForms{"", "dollars da las Bermudas", "", "", "", "dollars da las Bermudas"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dollar dal Brunei", PluralForms{"", "dollar dal Brunei", "", "", "", "dollars dal Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano bolivian", PluralForms{"", "boliviano bolivian", "", "", "", "bolivianos bolivians"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "vegl boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso bolivian", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "mvdol bolivian", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "cruzeiro novo brasilian (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "cruzado brasilian", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brasilian (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "real brasilian", PluralForms{"", "real brasilian", "", "", "", "reals brasilians"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "cruzado novo brasilian", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro brasilian", PluralForms{"", "", "", "", "", ""}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "vegl cruzeiro brasilian", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dollar da las Bahamas", PluralForms{"", "dollars da las Bahamas", "", "", "", "dollars da las Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum butanais", PluralForms{"", "ngultrum butanais", "", "", "", "ngultrums butanais"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat burmais", PluralForms{"", "kyat burmais", "", "", "", "kyats burmais"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula da la Botswana", PluralForms{"", "pula da la Botswana", "", "", "", "pulas da la Botswana"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "rubel bieloruss (1994–1999)", PluralForms{"", "rubel bieloruss (1994–1999)", "", "", "", "rubels bieloruss (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "rubel bieloruss", PluralForms{"", "rubel bieloruss", "", "", "", "rubels bieloruss"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "rubel bieloruss (2000–2016)", PluralForms{"", "rubel bieloruss (2000–2016)", "", "", "", "rubels bieloruss (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dollar dal Belize", PluralForms{"", "dollars dal Belize", "", "", "", "dollars dal Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "dollar canadais", PluralForms{"", "dollar canadais", "", "", "", "dollars canadais"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franc congolais", PluralForms{"", "franc congolais", "", "", "", "francs congolais"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "euro WIR", PluralForms{"", "euro WIR", "", "", "", "euros WIR"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franc svizzer", PluralForms{"", "franc svizzer", "", "", "", "francs svizzers"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "franc WIR", PluralForms{"", "franc WIR", "", "", "", "francs WIR"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "escudo chilen", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "unidades de fomento chilenas", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso chilen", PluralForms{"", "pesos chilens", "", "", "", "pesos chilens"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "yuan chinais (offshore)", PluralForms{"", "yuan chinais (offshore)", "", "", "", "yuans chinais (offshore)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "yuan chinais", PluralForms{"", "yuan chinais", "", "", "", "yuans chinais"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso columbian", PluralForms{"", "pesos columbians", "", "", "", "pesos columbians"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "unidad de valor real", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colon da la Costa Rica", PluralForms{"", "colons da la Costa Rica", "", "", "", "colons da la Costa Rica"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "dinar serb (2002–2006)", PluralForms{"", "dinar serb (2002–2006)", "", "", "", "dinars serbs (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "cruna tschecoslovaca", PluralForms{"", "cruna tschecoslovaca", "", "", "", "crunas tschecoslovacas"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cuban convertibel", PluralForms{"", "peso cuban convertibel", "", "", "", "pesos cubans convertibels"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cuban", PluralForms{"", "pesos cubans", "", "", "", "pesos cubans"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo dal Cap Verd", PluralForms{"", "escudo dal Cap Verd", "", "", "", "escudos dal Cap Verd"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "glivra cipriota", PluralForms{"", "glivra cipriota", "", "", "", "glivras cipriotas"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "cruna tscheca", PluralForms{"", "cruna tcheca", "", "", "", "crunas tschecas"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "marc da la Germania da l’Ost", PluralForms{"", "marc da la Germania da l’Ost", "", "", "", "marcs da la Germania da l’Ost"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marc tudestg", PluralForms{"", "marc tudestg", "", "", "", "marcs tudestgs"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franc dal Dschibuti", PluralForms{"", "franc dal Dschibuti", "", "", "", "francs dal Dschibuti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "cruna danaisa", PluralForms{"", "cruna danaisa", "", "", "", "crunas danaisas"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominican", PluralForms{"", "pesos dominicans", "", "", "", "pesos dominicans"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar algerian", PluralForms{"", "dinar algerian", "", "", "", "dinars algerians"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre equadorian", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "unitad da scuntrada da l’Ecuador", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "cruna estona", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "glivra egipziana", PluralForms{"", "glivra egipziana", "", "", "", "glivras egipzianas"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreic", PluralForms{"", "nakfa eritreic", "", "", "", "nakfas eritreics"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "peseta spagnola (conto A)", PluralForms{"", "peseta spagnola (conto A)", "", "", "", "pesetas spagnolas (conto A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "peseta spagnola (conto convertibel)", PluralForms{"", "peseta spagnola (conto convertibel)", "", "", "", "pesetas spagnolas (conto convertibel)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "peseta spagnola", PluralForms{"", "peseta spagnola", "", "", "", "pesetas spagnolas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etiopic", PluralForms{"", "birr etiopic", "", "", "", "birrs etiopics"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "", "", "euros"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "marc finlandais", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dollar dal Fidschi", PluralForms{"", "dollar dal Fidschi", "", "", "", "dollars dal Fidschi"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "glivra dal Falkland", PluralForms{"", "glivras dal Falkland", "", "", "", "glivras dal Falkland"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "franc franzos", PluralForms{"", "franc franzos", "", "", "", "francs franzos"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "glivra britannica", PluralForms{"", "glivra britannica", "", "", "", "glivras britannicas"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "kupon larit georgian", PluralForms{"", "kupon larit georgian", "", "", "", "kupon larits georgians"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari georgian", PluralForms{"", "lari georgian", "", "", "", "laris georgians"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi ghanais (1979–2007)", PluralForms{"", "cedi ghanais (1979–2007)", "", "", "", "cedis ghanais (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanais", PluralForms{"", "cedi ghanais", "", "", "", "cedis ghanais"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "glivra da Gibraltar", PluralForms{"", "glivra da Gibraltar", "", "", "", "glivras da Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi gambic", PluralForms{"", "dalasi gambic", "", "", "", "dalasis gambics"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franc da la Guinea", PluralForms{"", "franc da la Guinea", "", "", "", "francs da la Guinea"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "syli da la Guinea", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwele da la Guinea Equatoriala", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "drachma greca", PluralForms{"", "drachma greca", "", "", "", "drachmas grecas"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal da la Guatemala", PluralForms{"", "quetzals da la Guatemala", "", "", "", "quetzals da la Guatemala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "escudo da la Guinea Portugaisa", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso da la Guinea-Bissau", PluralForms{"", "peso da la Guinea-Bissau", "", "", "", "pesos da la Guinea-Bissau"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dollar da la Guyana", PluralForms{"", "dollars da la Guyana", "", "", "", "dollars da la Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "dollar da Hongkong", PluralForms{"", "dollar da Hongkong", "", "", "", "dollars da Hongkong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira hondurian", PluralForms{"", "lempira hondurian", "", "", "", "lempiras hondurians"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar croat", PluralForms{"", "dinar croat", "", "", "", "dinars croats"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna croata", PluralForms{"", "kuna croata", "", "", "", "kunas croatas"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde haitian", PluralForms{"", "gourdes haitians", "", "", "", "gourdes haitians"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forint ungarais", PluralForms{"", "forint ungarais", "", "", "", "forints ungarais"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupia indonaisa", PluralForms{"", "rupia indonaisa", "", "", "", "rupias indonaisas"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "glivra indonaisa", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "glivra israeliana", PluralForms{"", "glivra israeliana", "", "", "", "glivras israelianas"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "schekel israelian (1980–1985)", PluralForms{"", "schekel israelian (1980–1985)", "", "", "", "schekels israelians (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "nov schekel israelian", PluralForms{"", "nov schekel israelian", "", "", "", "novs schekels israelians"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "rupia indica", PluralForms{"", "rupia indica", "", "", "", "rupias indicas"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar iracais", PluralForms{"", "dinar iracais", "", "", "", "dinars iracais"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iranais", PluralForms{"", "rial iranais", "", "", "", "rials iranais"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "veglia cruna islandaisa", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "cruna islandaisa", PluralForms{"", "cruna islandaisa", "", "", "", "crunas islandaisas"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "lira taliana", PluralForms{"", "lira taliana", "", "", "", "liras talianas"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dollar giamaican", PluralForms{"", "dollar giamaican", "", "", "", "dollars giamaicans"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar jordanic", PluralForms{"", "dinar jordanic", "", "", "", "dinars jordanics"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "yen giapunais", PluralForms{"", "yen giapunais", "", "", "", "yens giapunais"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "schilling kenian", PluralForms{"", "schilling kenian", "", "", "", "schillings kenians"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kirgis", PluralForms{"", "som kirgis", "", "", "", "soms kirgis"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel cambodschan", PluralForms{"", "riel cambodschan", "", "", "", "riels cambodschans"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franc comorian", PluralForms{"", "franc comorian", "", "", "", "francs comorians"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won da la Corea dal Nord", PluralForms{"", "won da la Corea dal Nord", "", "", "", "wons da la Corea dal Nord"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "hwan da la Corea dal Sid (1953–1962)", PluralForms{"", "hwan da la Corea dal Sid (1953–1962)", "", "", "", "hwans da la Corea dal Sid (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "won da la Corea dal Sid (1945–1953)", PluralForms{"", "won da la Corea dal Sid (1945–1953)", "", "", "", "wons da la Corea dal Sid (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "won da la Corea dal Sid", PluralForms{"", "won da la Corea dal Sid", "", "", "", "wons da la Corea dal Sid"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar dal Kuwait", PluralForms{"", "dinar dal Kuwait", "", "", "", "dinars dal Kuwait"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dollar da las Inslas Cayman", PluralForms{"", "dollar da las Inslas Cayman", "", "", "", "dollars da las Inslas Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kasac", PluralForms{"", "tenge kasac", "", "", "", "tenges kasacs"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laot", PluralForms{"", "kip laot", "", "", "", "kips laots"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "glivra libanaisa", PluralForms{"", "glivra libanaisa", "", "", "", "glivras libanaisas"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupia da la Sri Lanka", PluralForms{"", "rupia da la Sri Lanka", "", "", "", "rupias da la Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dollar liberian", PluralForms{"", "dollar liberian", "", "", "", "dollars liberians"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti dal Lesotho", PluralForms{"", "loti dal Lesotho", "", "", "", "lotis dal Lesotho"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litas lituan", PluralForms{"", "", "", "", "", ""}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "talonas lituan", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "franc convertibel luxemburgais", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "franc luxemburgais", PluralForms{"", "franc luxemburgais", "", "", "", "francs luxemburgais"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "franc finanzial luxemburgais", PluralForms{"", "franc finanzial luxemburgais", "", "", "", "francs finanzials luxemburgais"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lats letton", PluralForms{"", "", "", "", "", ""}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "rubel letton", PluralForms{"", "rubel letton", "", "", "", "rubels lettons"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libic", PluralForms{"", "dinar libic", "", "", "", "dinars libics"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marocan", PluralForms{"", "dirham marocan", "", "", "", "dirhams marocans"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franc marocan", PluralForms{"", "franc marocan", "", "", "", "francs marocans"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "franc monegass", PluralForms{"", "franc monegass", "", "", "", "francs monegass"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "cupon moldav", PluralForms{"", "cupon moldav", "", "", "", "cupons moldavs"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldav", PluralForms{"", "leu moldav", "", "", "", "leus moldavs"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary madagasc", PluralForms{"", "ariary madagasc", "", "", "", "ariarys madagascs"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franc madagasc", PluralForms{"", "franc madagasc", "", "", "", "francs madagascs"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "dinar macedon", PluralForms{"", "dinar macedon", "", "", "", "dinars macedons"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "dinar macedon (1992–1993)", PluralForms{"", "dinar macedon (1992–1993)", "", "", "", "dinars macedons (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franc dal Mali", PluralForms{"", "franc dal Mali", "", "", "", "francs dal Mali"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat dal Myanmar", PluralForms{"", "kyat dal Myanmar", "", "", "", "kyats dal Myanmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongolic", PluralForms{"", "tugrik mongolic", "", "", "", "tugriks mongolics"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca dal Macao", PluralForms{"", "pataca dal Macao", "", "", "", "patacas dal Macao"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya da la Mauretania (1973–2017)", PluralForms{"", "ouguiya da la Mauretania (1973–2017)", "", "", "", "ouguiyas da la Mauretania (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya da la Mauretania", PluralForms{"", "ouguiya da la Mauretania", "", "", "", "ouguiyas da la Mauretania"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "lira maltaisa", PluralForms{"", "lira maltaisa", "", "", "", "liras maltaisas"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "glivra maltaisa", PluralForms{"", "glivra maltaisa", "", "", "", "glivras maltaisas"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupia dal Mauritius", PluralForms{"", "rupia dal Mauritius", "", "", "", "rupias dal Mauritius"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "rupia da las Maledivas (1947–1981)", PluralForms{"", "rupia da las Maledivas (1947–1981)", "", "", "", "rupias da las Maledivas (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rufiyaa da las Maledivas", PluralForms{"", "rufiyaa da las Maledivas", "", "", "", "rufiyaas da las Maledivas"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha dal Malawi", PluralForms{"", "kwacha dal Malawi", "", "", "", "kwachas dal Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "peso mexican", PluralForms{"", "peso mexican", "", "", "", "pesos mexicans"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso d’argient mexican (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "unidad de inversion mexicana (UDI)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malaisic", PluralForms{"", "ringgit malaisic", "", "", "", "ringgits malaisics"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escudo dal Mosambic", PluralForms{"", "escudo dal Mosambic", "", "", "", "escudos dal Mosambic"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "metical dal Mosambic (1980–2006)", PluralForms{"", "metical dal Mosambic (1980–2006)", "", "", "", "meticals dal Mosambic (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical dal Mosambic", PluralForms{"", "metical dal Mosambic", "", "", "", "meticals dal Mosambic"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dollar namibian", PluralForms{"", "dollar namibian", "", "", "", "dollars namibians"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeriana", PluralForms{"", "naira nigeriana", "", "", "", "nairas nigerianas"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "cordoba nicaraguan", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba nicaraguan", PluralForms{"", "córdoba nicaraguan", "", "", "", "córdobas nicaraguans"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "flurin ollandais", PluralForms{"", "flurin ollandais", "", "", "", "flurins ollandais"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "cruna norvegiaisa", PluralForms{"", "cruna norvegiaisa", "", "", "", "crunas norvegiaisas"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupia nepalaisa", PluralForms{"", "rupia nepalaisa", "", "", "", "rupias nepalaisas"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "dollar da la Nova Zelanda", PluralForms{"", "dollar da la Nova Zelanda", "", "", "", "dollars da la Nova Zelanda"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial da l’Oman", PluralForms{"", "rial da l’Oman", "", "", "", "rials da l’Oman"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa dal Panama", PluralForms{"", "balboas dal Panama", "", "", "", "balboas dal Panama"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti peruan", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol peruan", PluralForms{"", "soles peruans", "", "", "", "soles peruans"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol peruan (1863–1965)", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina da la Papua Nova Guinea", PluralForms{"", "kina da la Papua Nova Guinea", "", "", "", "kinas da la Papua Nova Guinea"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "peso filippin", PluralForms{"", "peso filippin", "", "", "", "pesos filippins"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupia pakistana", PluralForms{"", "rupia pakistana", "", "", "", "rupias pakistanas"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zloty polac", PluralForms{"", "zloty polac", "", "", "", "zlotys polacs"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "zloty polac (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "escudo portugais", PluralForms{"", "escudo portugais", "", "", "", "escudos portugais"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guarani paraguaian", PluralForms{"", "guaranis paraguaians", "", "", "", "guaranis paraguaians"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial da Katar", PluralForms{"", "rial da Katar", "", "", "", "rials da Katar"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dollar rodesian", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "leu rumen (1952–2006)", PluralForms{"", "leu rumen (1952–2006)", "", "", "", "leus rumens (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "leu rumen", PluralForms{"", "leu rumen", "", "", "", "leus rumens"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar serb", PluralForms{"", "dinar serb", "", "", "", "dinars serbs"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rubel russ", PluralForms{"", "rubel russ", "", "", "", "rubels russ"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "rubel russ (vegl)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franc ruandais", PluralForms{"", "franc ruandais", "", "", "", "francs ruandais"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "riyal saudit", PluralForms{"", "riyal saudit", "", "", "", "riyals saudits"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dollar da las Inslas da Salomon", PluralForms{"", "dollar da las Inslas da Salomon", "", "", "", "dollars da las Inslas da Salomon"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupia da las Seychellas", PluralForms{"", "rupia da las Seychellas", "", "", "", "rupias da las Seychellas"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar sudanais (1992–2007)", PluralForms{"", "dinar sudanais (1992–2007)", "", "", "", "dinars sudanais (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "glivra sudanaisa", PluralForms{"", "glivra sudanaisa", "", "", "", "glivras sudanaisas"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "glivra sudanaisa (1957–1998)", PluralForms{"", "glivra sudanaisa (1957–1998)", "", "", "", "glivras sudanaisas (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "cruna svedaisa", PluralForms{"", "cruna svedaisa", "", "", "", "crunas svedaisas"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dollar dal Singapur", PluralForms{"", "dollar dal Singapur", "", "", "", "dollars dal Singapur"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "glivra da Sontg’Elena", PluralForms{"", "glivra da Sontg’Elena", "", "", "", "glivras da Sontg’Elena"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tolar sloven", PluralForms{"", "tolar sloven", "", "", "", "tolars slovens"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "cruna slovaca", PluralForms{"", "cruna slovaca", "", "", "", "crunas slovacas"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone da la Sierra Leone", PluralForms{"", "leone da la Sierra Leone", "", "", "", "leones da la Sierra Leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone da la Sierra Leone (1964—2022)", PluralForms{"", "leone da la Sierra Leone (1964—2022)", "", "", "", "leones da la Sierra Leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "schilling somalian", PluralForms{"", "schilling somalian", "", "", "", "schillings somalians"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dollar surinam", PluralForms{"", "dollars surinams", "", "", "", "dollars surinams"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "flurin surinam", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "glivra sidsudanaisa", PluralForms{"", "glivra sidsudanaisa", "", "", "", "glivras sidsudanaisas"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra da São Tomé e Principe (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra da São Tomé e Principe", PluralForms{"", "dobra da São Tomé e Príncipe", "", "", "", "dobras da São Tomé e Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "rubel sovietic", PluralForms{"", "rubel sovietic", "", "", "", "rubels sovietics"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colon da l’El Salvador", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "glivra siriana", PluralForms{"", "glivra siriana", "", "", "", "glivras sirianas"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni dal Swaziland", PluralForms{"", "lilangeni dal Swaziland", "", "", "", "emalangenis dal Swaziland"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "baht tailandais", PluralForms{"", "baht tailandais", "", "", "", "bahts tailandais"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "rubel tadschic", PluralForms{"", "rubel tadschic", "", "", "", "rubels tadschics"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tadschic", PluralForms{"", "somoni tadschic", "", "", "", "somonis tadschics"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turkmen (1993–2009)", PluralForms{"", "manat turkmen (1993–2009)", "", "", "", "manats turkmens (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turkmen", PluralForms{"", "manat turkmen", "", "", "", "manats turkmens"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunesian", PluralForms{"", "dinar tunesian", "", "", "", "dinars tunesians"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "paʻanga da Tonga", PluralForms{"", "paʻanga da Tonga", "", "", "", "pa’angas da Tonga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "escudo dal Timor", PluralForms{"", "escudo dal Timor", "", "", "", "escudos dal Timor"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "lira tirca (1922–2005)", PluralForms{"", "lira tirca (1922–2005)", "", "", "", "liras tircas (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "lira tirca", PluralForms{"", "lira tirca", "", "", "", "liras tircas"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dollar da Trinidad e Tobago", PluralForms{"", "dollars da Trinidad e Tobago", "", "", "", "dollars da Trinidad e Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "nov dollar taiwanais", PluralForms{"", "nov dollar taiwanais", "", "", "", "novs dollars taiwanais"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "schilling tansanian", PluralForms{"", "schilling tansanian", "", "", "", "schillings tansanians"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "hryvnia ucranaisa", PluralForms{"", "hryvnia ucranaisa", "", "", "", "hryvnias ucranaisas"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "karbovanets ucranais", PluralForms{"", "karbovanets ucranais", "", "", "", "karbovantsiv ucranais"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "schilling ugandais (1966–1987)", PluralForms{"", "schilling ugandais (1966–1987)", "", "", "", "schillings ugandais (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "schilling ugandais", PluralForms{"", "schilling ugandais", "", "", "", "schillings ugandais"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "dollar da l’USA", PluralForms{"", "dollar da l’USA", "", "", "", "dollars da l’USA"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dollar dals Stadis Unids da l’America (proxim di)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dollar dals Stadis Unids da l’America (medem di)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "peso da l’Uruguay (unidades indexadas)", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "nov peso da l’Uruguay (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso da l’Uruguay", PluralForms{"", "peso da l’Uruguai", "", "", "", "pesos da l’Uruguai"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "som usbec", PluralForms{"", "som usbec", "", "", "", "soms usbecs"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "bolivar venezuelan (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "bolivar venezuelan (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar venezuelan", PluralForms{"", "bolívar venezuelan", "", "", "", "bolívars venezuelans"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "dong vietnamais", PluralForms{"", "dong vietnamais", "", "", "", "dongs vietnamais"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "dong vietnamais (1978–1985)", PluralForms{"", "dong vietnamais (1978–1985)", "", "", "", "dongs vietnamais (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu dal Vanuatu", PluralForms{"", "vatu dal Vanuatu", "", "", "", "vatus dal Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala da la Samoa", PluralForms{"", "tala da la Samoa", "", "", "", "talas da la Samoa"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franc CFA da l’Africa Centrala", PluralForms{"", "franc CFA da l’Africa Centrala", "", "", "", "francs CFA da l’Africa Centrala"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "argient", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "aur", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "unitad europeica cumponida", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "unitad dal quint europeica (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "unitad dal quint europeica (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "dollar da la Caribica Orientala", PluralForms{"", "dollar da la Caribica Orientala", "", "", "", "dollars da la Caribica Orientala"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "dretgs da prelevaziun spezials", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franc d’aur franzos", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franc UIC franzos", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franc CFA da l’Africa dal Vest", PluralForms{"", "franc CFA da l’Africa dal Vest", "", "", "", "francs CFA da l’Africa dal Vest"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladi", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franc CFP", PluralForms{"", "franc CFP", "", "", "", "francs CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platin", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "fonds RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "code per verifitgar la valuta", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "valuta nunenconuschenta", PluralForms{"", "(unitad nunenconuschenta da la valuta)", "", "", "", "(valuta nunenconuschenta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar jemenit", PluralForms{"", "dinar jemenit", "", "", "", "dinars jemenits"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial jemenit", PluralForms{"", "rial jemenit", "", "", "", "rials jemenits"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "dinar jugoslav dir (1966–1990)", PluralForms{"", "dinar jugoslav dir (1966–1990)", "", "", "", "dinars jugoslavs dirs (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "nov dinar jugoslav (1994–2002)", PluralForms{"", "nov dinar jugoslav (1994–2002)", "", "", "", "novs dinars jugoslavs (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar jugoslav convertibel (1990–1992)", PluralForms{"", "dinar jugoslav convertibel (1990–1992)", "", "", "", "dinars jugoslavs convertibels (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "dinar jugoslav refurmà (1992–1993)", PluralForms{"", "dinar jugoslav refurmà (1992–1993)", "", "", "", "dinars jugoslavs refurmads (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand sidafrican (finanzial)", PluralForms{"", "rand sidafrican (finanzial)", "", "", "", "rands sidafricans (finanzial)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sidafrican", PluralForms{"", "rand sidafrican", "", "", "", "rands sidafricans"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha da la Sambia (1968–2012)", PluralForms{"", "kwacha da la Sambia (1968–2012)", "", "", "", "kwachas da la Sambia (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha da la sambia", PluralForms{"", "kwacha da la Sambia", "", "", "", "kwachas da la Sambia"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "nov zaire dal Zaire (1993–1998)", PluralForms{"", "nov zaire dal Zaire (1993–1998)", "", "", "", "novs zaires dal Zaire (1993–1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zaire dal Zaire", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dollar dal Simbabwe (1980–2008)", PluralForms{"", "dollar dal Simbabwe (1980–2008)", "", "", "", "dollars dal Simbabwe (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dollar dal Simbabwe (2009)", PluralForms{"", "dollar dal Simbabwe (2009)", "", "", "", "dollars dal Simbabwe (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "dollar dal Simbabwe (2008)", PluralForms{"", "dollar dal Simbabwe (2008)", "", "", "", "dollars dal Simbabwe (2008)"}},
 	},
 }
@@ -6,262 +6,315 @@ var ro = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0K", "other": "0\u00a0K"}},
+				{4, 2, map[string]string{"one": "0\u00a0K", "other": "0\u00a0K"}},
+				{5, 3, map[string]string{"one": "0\u00a0K", "other": "0\u00a0K"}},
+				{6, 1, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{7, 2, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{8, 3, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{9, 1, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{10, 2, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{11, 3, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{12, 1, map[string]string{"one": "0\u00a0tril.", "other": "0\u00a0tril."}},
+				{13, 2, map[string]string{"one": "0\u00a0tril.", "other": "0\u00a0tril."}},
+				{14, 3, map[string]string{"one": "0\u00a0tril.", "other": "0\u00a0tril."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 mii", "one": "0 mie", "other": "0 de mii"}},
+				{4, 2, map[string]string{"few": "0 mii", "one": "0 mie", "other": "0 de mii"}},
+				{5, 3, map[string]string{"few": "0 mii", "one": "0 mie", "other": "0 de mii"}},
+				{6, 1, map[string]string{"few": "0 milioane", "one": "0 milion", "other": "0 de milioane"}},
+				{7, 2, map[string]string{"few": "0 milioane", "one": "0 milion", "other": "0 de milioane"}},
+				{8, 3, map[string]string{"few": "0 milioane", "one": "0 milion", "other": "0 de milioane"}},
+				{9, 1, map[string]string{"few": "0 miliarde", "one": "0 miliard", "other": "0 de miliarde"}},
+				{10, 2, map[string]string{"few": "0 miliarde", "one": "0 miliard", "other": "0 de miliarde"}},
+				{11, 3, map[string]string{"few": "0 miliarde", "one": "0 miliard", "other": "0 de miliarde"}},
+				{12, 1, map[string]string{"few": "0 trilioane", "one": "0 trilion", "other": "0 de trilioane"}},
+				{13, 2, map[string]string{"few": "0 trilioane", "one": "0 trilion", "other": "0 de trilioane"}},
+				{14, 3, map[string]string{"few": "0 trilioane", "one": "0 trilion", "other": "0 de trilioane"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0mii\u00a0¤", "one": "0\u00a0mie\u00a0¤", "other": "0\u00a0mii\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0mii\u00a0¤", "one": "0\u00a0mii\u00a0¤", "other": "0\u00a0mii\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0mii\u00a0¤", "one": "0\u00a0mii\u00a0¤", "other": "0\u00a0mii\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0tril.\u00a0¤", "one": "0\u00a0tril.\u00a0¤", "other": "0\u00a0tril.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0tril.\u00a0¤", "one": "0\u00a0tril.\u00a0¤", "other": "0\u00a0tril.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0tril.\u00a0¤", "one": "0\u00a0tril.\u00a0¤", "other": "0\u00a0tril.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "{0} {1}", "", "{0} {1}", "", "{0} de {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0} - {1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "EUR", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "pesetă andorrană", PluralForms{"", "pesetă andorrană", "", "pesete andorrane", "", "pesete andorrane"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham din Emiratele Arabe Unite", PluralForms{"", "dirham din Emiratele Arabe Unite", "", "dirhami din Emiratele Arabe Unite", "", "dirhami din Emiratele Arabe Unite"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani afgan", PluralForms{"", "afgani afgan", "", "afgani afgani", "", "afgani afgani"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "lek", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "dram armenesc", PluralForms{"", "dram armenesc", "", "drami armenești", "", "drami armenești"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "gulden neerlandez antilez", PluralForms{"", "gulden neerlandez antilez", "", "guldeni neerlandezi antilezi", "", "guldeni neerlandezi antilezi"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kwanza angoleză", PluralForms{"", "kwanza angoleză", "", "kwanze angoleze", "", "kwanze angoleze"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "peso argentinian (1983–1985)", PluralForms{"", "peso argentinian (1983–1985)", "", "pesos argentinieni (1983–1985)", "", "pesos argentinieni (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "peso argentinian", PluralForms{"", "peso argentinian", "", "pesos argentinieni", "", "pesos argentinieni"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "șiling austriac", PluralForms{"", "șiling austriac", "", "șilingi austrieci", "", "șilingi austrieci"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "dolar australian", PluralForms{"", "dolar australian", "", "dolari australieni", "", "dolari australieni"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "florin aruban", PluralForms{"", "florin aruban", "", "florini arubani", "", "florini arubani"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "manat azer (1993–2006)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "manat azer", PluralForms{"", "manat azer", "", "manați azeri", "", "manați azeri"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "dinar Bosnia-Herțegovina (1992–1994)", PluralForms{"", "dinar Bosnia-Herțegovina (1992–1994)", "", "dinari Bosnia-Herțegovina", "", "dinari Bosnia-Herțegovina (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "marcă convertibilă", PluralForms{"", "marcă convertibilă", "", "mărci convertibile", "", "mărci convertibile"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "dolar din Barbados", PluralForms{"", "dolar din Barbados", "", "dolari din Barbados", "", "dolari din Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "taka din Bangladesh", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "franc belgian (convertibil)", PluralForms{"", "franc belgian (convertibil)", "", "franci belgieni (convertibili)", "", "franci belgieni (convertibili)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "franc belgian", PluralForms{"", "franc belgian", "", "franci belgieni", "", "franci belgieni"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "franc belgian (financiar)", PluralForms{"", "franc belgian (financiar)", "", "franci belgieni (financiari)", "", "franci belgieni (financiari)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "leva", PluralForms{"", "leva", "", "leve", "", "leve"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "dinar din Bahrain", PluralForms{"", "dinar din Bahrain", "", "dinari din Bahrain", "", "dinari din Bahrain"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "franc burundez", PluralForms{"", "franc burundez", "", "franci burundezi", "", "franci burundezi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "dolar din Bermuda", PluralForms{"", "dolar din Bermuda", "", "dolari din Bermuda", "", "dolari din Bermuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "dolar din Brunei", PluralForms{"", "dolar din Brunei", "", "dolari din Brunei", "", "dolari Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviano", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "peso bolivian", PluralForms{"", "peso bolivian", "", "pesos bolivieni", "", "pesos bolivieni"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "mvdol bolivian", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "cruzeiro brazilian (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "real", PluralForms{"", "real", "", "reali", "", "reali"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "cruzeiro brazilian (1993–1994)", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "dolar din Bahamas", PluralForms{"", "dolar din Bahamas", "", "dolari din Bahamas", "", "dolari din Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ngultrum din Bhutan", PluralForms{"", "", "", "", "", ""}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "kyat birman", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "pula Botswana", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "rublă belarusă", PluralForms{"", "rublă belarusă", "", "ruble belaruse", "", "ruble belaruse"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "rublă belarusă (2000–2016)", PluralForms{"", "rublă belarusă (2000–2016)", "", "ruble belaruse (2000–2016)", "", "ruble belaruse (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "dolar din Belize", PluralForms{"", "dolar din Belize", "", "dolari din Belize", "", "dolari din Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "dolar canadian", PluralForms{"", "dolar canadian", "", "dolari canadieni", "", "dolari canadieni"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "franc congolez", PluralForms{"", "franc congolez", "", "franci congolezi", "", "franci congolezi"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "franc elvețian", PluralForms{"", "franc elvețian", "", "franci elvețieni", "", "franci elvețieni"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "peso chilian", PluralForms{"", "peso chilian", "", "pesos chilieni", "", "pesos chilieni"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "yuan chinezesc (offshore)", PluralForms{"", "yuan chinezesc (offshore)", "", "yuani chinezești (offshore)", "", "yuani chinezești (offshore)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "yuan chinezesc", PluralForms{"", "yuan chinezesc", "", "yuani chinezești", "", "yuani chinezești"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "peso columbian", PluralForms{"", "peso columbian", "", "pesos columbieni", "", "pesos columbieni"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "colón costarican", PluralForms{"", "colón costarican", "", "colóni costaricani", "", "colóni costaricani"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "dinar Serbia și Muntenegru (2002–2006)", PluralForms{"", "dinar Serbia și Muntenegru (2002–2006)", "", "dinari Serbia și Muntenegru (2002–2006)", "", "dinari Serbia și Muntenegru (2002–2006)"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "peso cubanez convertibil", PluralForms{"", "peso cubanez convertibil", "", "pesos cubanezi convertibili", "", "pesos cubanezi convertibili"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "peso cubanez", PluralForms{"", "peso cubanez", "", "pesos cubanezi", "", "pesos cubanezi"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "escudo caboverdian", PluralForms{"", "escudo caboverdian", "", "escudo caboverdieni", "", "escudo caboverdieni"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "liră cipriotă", PluralForms{"", "liră cipriotă", "", "lire cipriote", "", "lire cipriote"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "coroană cehă", PluralForms{"", "coroană cehă", "", "coroane cehe", "", "coroane cehe"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "marcă est-germană", PluralForms{"", "marcă est-germană", "", "mărci est-germane", "", "mărci est-germane"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "marcă germană", PluralForms{"", "marcă germană", "", "mărci germane", "", "mărci germane"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "franc djiboutian", PluralForms{"", "franc djiboutian", "", "franci djiboutieni", "", "franci djiboutieni"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "coroană daneză", PluralForms{"", "coroană daneză", "", "coroane daneze", "", "coroane daneze"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "peso dominican", PluralForms{"", "peso dominican", "", "pesos dominicani", "", "pesos dominicani"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "dinar algerian", PluralForms{"", "dinar algerian", "", "dinari algerieni", "", "dinari algerieni"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "sucre Ecuador", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "coroană estoniană", PluralForms{"", "coroană estoniană", "", "coroane estoniene", "", "coroane estoniene"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "liră egipteană", PluralForms{"", "liră egipteană", "", "lire egiptene", "", "lire egiptene"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "nakfa eritreeană", PluralForms{"", "nakfa eritreeană", "", "nakfa eritreene", "", "nakfa eritreene"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "peseta spaniolă (cont A)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "peseta spaniolă (cont convertibil)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "pesetă spaniolă", PluralForms{"", "pesetă spaniolă", "", "pesete spaniole", "", "pesete spaniole"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "birr etiopian", PluralForms{"", "birr etiopian", "", "birri etiopieni", "", "birri etiopieni"}},
+		"EUR": {2, 0, 2, 1, "EUR", "EUR", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "marcă finlandeză", PluralForms{"", "mărci finlandeze", "", "mărci finlandeze", "", "mărci finlandeze"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "dolar fijian", PluralForms{"", "dolar fijian", "", "dolari fijieni", "", "dolari fijieni"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "liră din Insulele Falkland", PluralForms{"", "liră din Insulele Falkland", "", "lire din Insulele Falkland", "", "lire din Insulele Falkland"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "franc francez", PluralForms{"", "franc francez", "", "franci francezi", "", "franci francezi"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "liră sterlină", PluralForms{"", "liră sterlină", "", "lire sterline", "", "lire sterline"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "lari georgian", PluralForms{"", "lari georgian", "", "lari georgieni", "", "lari georgieni"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "cedi Ghana (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "cedi ghanez", PluralForms{"", "cedi ghanez", "", "cedi ghanezi", "", "cedi ghanezi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "liră din Gibraltar", PluralForms{"", "liră din Gibraltar", "", "lire din Gibraltar", "", "lire Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "dalasi din Gambia", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "franc guineean", PluralForms{"", "franc guineean", "", "franci guineeni", "", "franci guineeni"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "drahmă grecească", PluralForms{"", "drahmă grecească", "", "drahme grecești", "", "drahme grecești"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "quetzal guatemalez", PluralForms{"", "quetzal guatemalez", "", "quetzali guatemalezi", "", "quetzali guatemalezi"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso Guineea-Bissau", PluralForms{"", "peso Guineea-Bissau", "", "pesos Guineea-Bissau", "", "pesos Guineea-Bissau"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "dolar guyanez", PluralForms{"", "dolar guyanez", "", "dolari guyanezi", "", "dolari guyanezi"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "dolar din Hong Kong", PluralForms{"", "dolar din Hong Kong", "", "dolari din Hong Kong", "", "dolari din Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "lempira honduriană", PluralForms{"", "lempiră honduriană", "", "lempire honduriene", "", "lempire honduriene"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "dinar croat", PluralForms{"", "dinar croat", "", "dinari croați", "", "dinari croați"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kuna", PluralForms{"", "", "", "", "", ""}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "gourde din Haiti", PluralForms{"", "", "", "", "", ""}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "forint", PluralForms{"", "forint", "", "forinți", "", "forinți"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "rupie indoneziană", PluralForms{"", "rupie indoneziană", "", "rupii indoneziene", "", "rupii indoneziene"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "liră irlandeză", PluralForms{"", "liră irlandeză", "", "lire irlandeze", "", "lire irlandeze"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "liră israeliană", PluralForms{"", "liră israeliană", "", "lire israeliene", "", "lire israeliene"}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "shekel israelian nou", PluralForms{"", "shekel israelian nou", "", "shekeli israelieni noi", "", "shekeli israelieni noi"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "rupie indiană", PluralForms{"", "rupie indiană", "", "rupii indiene", "", "rupii indiene"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "dinar irakian", PluralForms{"", "dinar irakian", "", "dinari irakieni", "", "dinari irakieni"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "rial iranian", PluralForms{"", "rial iranian", "", "riali iranieni", "", "riali iranieni"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "coroană islandeză", PluralForms{"", "coroană islandeză", "", "coroane islandeze", "", "coroane islandeze"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "liră italiană", PluralForms{"", "liră italiană", "", "lire italiene", "", "lire italiene"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "dolar jamaican", PluralForms{"", "dolar jamaican", "", "dolari jamaicani", "", "dolari jamaicani"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "dinar iordanian", PluralForms{"", "dinar iordanian", "", "dinari iordanieni", "", "dinari iordanieni"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "yen japonez", PluralForms{"", "yen japonez", "", "yeni japonezi", "", "yeni japonezi"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "șiling kenyan", PluralForms{"", "șiling kenyan", "", "șilingi kenyeni", "", "șilingi kenyeni"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "som kârgâz", PluralForms{"", "som kârgâz", "", "somi kârgâzi", "", "somi kârgâzi"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "riel cambodgian", PluralForms{"", "riel cambodgian", "", "rieli cambodgieni", "", "rieli cambodgieni"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "franc comorian", PluralForms{"", "franc comorian", "", "franci comorieni", "", "franci comorieni"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "won nord-coreean", PluralForms{"", "won nord-coreean", "", "woni nord-coreeni", "", "woni nord-coreeni"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "won sud-coreean", PluralForms{"", "won sud-coreean", "", "woni sud-coreeni", "", "woni sud-coreeni"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "dinar kuweitian", PluralForms{"", "dinar kuweitian", "", "dinari kuweitieni", "", "dinari kuweitieni"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "dolar din Insulele Cayman", PluralForms{"", "dolar din Insulele Cayman", "", "dolari din Insulele Cayman", "", "dolari din Insulele Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "tenge kazahă", PluralForms{"", "tenge kazahă", "", "tenge kazahe", "", "tenge kazahe"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "kip laoțian", PluralForms{"", "kip laoțian", "", "kipi laoțieni", "", "kipi laoțieni"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "liră libaneză", PluralForms{"", "liră libaneză", "", "lire libaneze", "", "lire libaneze"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "rupie srilankeză", PluralForms{"", "rupie srilankeză", "", "rupii srilankeze", "", "rupii srilankeze"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "dolar liberian", PluralForms{"", "dolar liberian", "", "dolari liberieni", "", "dolari liberieni"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "loti lesothian", PluralForms{"", "loti lesothian", "", "maloti lesothieni", "", "maloti lesothieni"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litu lituanian", PluralForms{"", "litu lituanian", "", "lite lituaniene", "", "lite lituaniene"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "franc convertibil luxemburghez", PluralForms{"", "franc convertibil luxemburghez", "", "franci convertibili luxemburghezi", "", "franci convertibili luxemburghezi"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "franc luxemburghez", PluralForms{"", "franc luxemburghez", "", "franci luxemburghezi", "", "franci luxemburghezi"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "franc financiar luxemburghez", PluralForms{"", "franc financiar luxemburghez", "", "franci financiari luxemburghezi", "", "franci financiari luxemburghezi"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lats letonian", PluralForms{"", "lats letonian", "", "lats letonieni", "", "lats letonieni"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "rublă Letonia", PluralForms{"", "rublă Letonia", "", "ruble Letonia", "", "ruble Letonia"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "dinar libian", PluralForms{"", "dinar libian", "", "dinari libieni", "", "dinari libieni"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "dirham marocan", PluralForms{"", "dirham marocan", "", "dirhami marocani", "", "dirhami marocani"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "franc marocan", PluralForms{"", "franc marocan", "", "franci marocani", "", "franci marocani"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "leu moldovenesc", PluralForms{"", "leu moldovenesc", "", "lei moldovenești", "", "lei moldovenești"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ariary malgaș", PluralForms{"", "ariary malgaș", "", "ariary malgași", "", "ariary malgași"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "franc Madagascar", PluralForms{"", "franc Madagascar", "", "franci Madagascar", "", "franci Madagascar"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "denar", PluralForms{"", "denar", "", "denari", "", "denari"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "franc Mali", PluralForms{"", "franc Mali", "", "franci Mali", "", "franci Mali"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "kyat din Myanmar", PluralForms{"", "kyat din Myanmar", "", "kyați din Myanmar", "", "kyați din Myanmar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "tugrik mongol", PluralForms{"", "tugrik mongol", "", "tugrici mongoli", "", "tugrici mongoli"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "pataca din Macao", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "ouguiya mauritană (1973–2017)", PluralForms{"", "ouguiya mauritană (1973–2017)", "", "ouguiya mauritane (1973–2017)", "", "ouguiya mauritane (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ouguiya mauritană", PluralForms{"", "ouguiya mauritană", "", "ouguiya mauritane", "", "ouguiya mauritane"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "liră malteză", PluralForms{"", "liră malteză", "", "lire malteze", "", "lire malteze"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "rupie mauritiană", PluralForms{"", "rupie mauritiană", "", "rupii mauritiene", "", "rupii mauritiene"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "rufiyaa maldiviană", PluralForms{"", "rufiyaa maldiviană", "", "rufiyaa maldiviene", "", "rufiyaa maldiviene"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "kwacha malawiană", PluralForms{"", "kwacha malawiană", "", "kwache malawiene", "", "kwache malawiene"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "peso mexican", PluralForms{"", "peso mexican", "", "pesos mexicani", "", "pesos mexicani"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "peso mexican de argint (1861–1992)", PluralForms{"", "peso mexican de argint (1861–1992)", "", "pesos mexicani de argint (1861–1992", "", "pesos mexicani de argint (1861–1992)"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ringgit malaiezian", PluralForms{"", "ringgit malaiezian", "", "ringgit malaiezieni", "", "ringgit malaiezieni"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "escudo Mozambic", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "metical Mozambic vechi", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "metical mozambican", PluralForms{"", "metical mozambican", "", "meticali mozambicani", "", "meticali mozambicani"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "dolar namibian", PluralForms{"", "dolar namibian", "", "dolari namibieni", "", "dolari namibieni"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "naira nigeriană", PluralForms{"", "naira nigeriană", "", "naire nigeriene", "", "naire nigeriene"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "cordoba nicaraguană (1988–1991)", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "córdoba oro", PluralForms{"", "córdoba oro", "", "córdobe oro", "", "córdobe oro"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "gulden olandez", PluralForms{"", "gulden olandez", "", "guldeni olandezi", "", "guldeni olandezi"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "coroană norvegiană", PluralForms{"", "coroană norvegiană", "", "coroane norvegiene", "", "coroane norvegiene"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "rupie nepaleză", PluralForms{"", "rupie nepaleză", "", "rupii nepaleze", "", "rupii nepaleze"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "dolar neozeelandez", PluralForms{"", "dolar neozeelandez", "", "dolari neozeelandezi", "", "dolari neozeelandezi"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "rial omanez", PluralForms{"", "rial omanez", "", "riali omanezi", "", "riali omanezi"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "balboa panameză", PluralForms{"", "balboa panameză", "", "balboa panameze", "", "balboa panameze"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "inti peruvian", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "sol", PluralForms{"", "sol", "", "soli", "", "soli"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "sol peruvian (1863–1965)", PluralForms{"", "sol peruvian (1863–1965)", "", "soli peruvieni (1863–1965)", "", "soli peruvieni (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina din Papua-Noua Guinee", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "peso filipinez", PluralForms{"", "peso filipinez", "", "pesos filipinezi", "", "pesos filipinezi"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "rupie pakistaneză", PluralForms{"", "rupie pakistaneză", "", "rupii pakistaneze", "", "rupii pakistaneze"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "zlot", PluralForms{"", "zlot", "", "zloți", "", "zloți"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "zlot polonez (1950–1995)", PluralForms{"", "zlot polonez (1950–1995)", "", "zloți polonezi (1950–1995)", "", "zloți polonezi (1950–1995)"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "guarani", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "rial qatarian", PluralForms{"", "rial qatarian", "", "riali qatarieni", "", "riali qatarieni"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "dolar rhodesian", PluralForms{"", "dolar rhodesian", "", "dolari rhodesieni", "", "dolari rhodesieni"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "leu românesc (1952–2006)", PluralForms{"", "leu românesc (1952–2006)", "", "lei românești (1952–2006)", "", "lei românești (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "leu românesc", PluralForms{"", "leu românesc", "", "lei românești", "", "lei românești"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "dinar sârbesc", PluralForms{"", "dinar sârbesc", "", "dinari sârbești", "", "dinari sârbești"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rublă rusească", PluralForms{"", "rublă rusească", "", "ruble rusești", "", "ruble rusești"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "franc rwandez", PluralForms{"", "franc rwandez", "", "franci rwandezi", "", "franci rwandezi"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "rial saudit", PluralForms{"", "rial saudit", "", "riali saudiți", "", "riali saudiți"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "dolar din Insulele Solomon", PluralForms{"", "dolar din Insulele Solomon", "", "dolari din Insulele Solomon", "", "dolari din Insulele Solomon"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "rupie seychelleză", PluralForms{"", "rupie seychelleză", "", "rupii seychelleze", "", "rupii seychelleze"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "dinar sudanez", PluralForms{"", "dinar sudanez", "", "dinari sudanezi", "", "dinari sudanezi"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "liră sudaneză", PluralForms{"", "liră sudaneză", "", "lire sudaneze", "", "lire sudaneze"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "liră sudaneză (1957–1998)", PluralForms{"", "liră sudaneză (1957–1998)", "", "lire sudaneze (1957–1998)", "", "lire sudaneze (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "coroană suedeză", PluralForms{"", "coroană suedeză", "", "coroane suedeze", "", "coroane suedeze"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "dolar singaporez", PluralForms{"", "dolar singaporez", "", "dolari singaporezi", "", "dolari singaporezi"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "liră din Sfânta Elena (Sfânta Elena și Ascension)", PluralForms{"", "liră din Sfânta Elena (Sfânta Elena și Ascension)", "", "lire din Sfânta Elena (Sfânta Elena și Ascension)", "", "lire din Sfânta Elena (Sfânta Elena și Ascension)"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "tolar sloven", PluralForms{"", "tolar sloven", "", "tolari sloveni", "", "tolari sloveni"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "coroană slovacă", PluralForms{"", "coroană slovacă", "", "coroane slovace", "", "coroane slovace"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "leone din Sierra Leone", PluralForms{"", "leone din Sierra Leone", "", "leoni din Sierra Leone", "", "leoni din Sierra Leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "leone din Sierra Leone (1964—2022)", PluralForms{"", "leone din Sierra Leone (1964—2022)", "", "leoni din Sierra Leone (1964—2022)", "", "leoni din Sierra Leone (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "șiling somalez", PluralForms{"", "șiling somalez", "", "șilingi somalezi", "", "șilingi somalezi"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "dolar surinamez", PluralForms{"", "dolar surinamez", "", "dolari surinamezi", "", "dolari surinamezi"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "gulden Surinam", PluralForms{"", "gulden Surinam", "", "guldeni Surinam", "", "guldeni Surinam"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "liră din Sudanul de Sud", PluralForms{"", "liră din Sudanul de Sud", "", "lire din Sudanul de Sud", "", "lire din Sudanul de Sud"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "dobra Sao Tome și Principe (1977–2017)", PluralForms{"", "dobra Sao Tome și Principe (1977–2017)", "", "dobre Sao Tome și Principe (1977–2017)", "", "dobre Sao Tome și Principe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra din São Tomé și Príncipe", PluralForms{"", "dobra din São Tomé și Príncipe", "", "dobre din São Tomé și Príncipe", "", "dobre din São Tomé și Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "rublă sovietică", PluralForms{"", "rublă sovietică", "", "ruble sovietice", "", "ruble sovietice"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "colon El Salvador", PluralForms{"", "colon El Salvador", "", "coloni El Salvador", "", "coloni El Salvador"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "liră siriană", PluralForms{"", "liră siriană", "", "lire siriene", "", "lire siriene"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "lilangeni din Swaziland", PluralForms{"", "lilangeni din Swaziland", "", "emalangeni din Swaziland", "", "emalangeni din Swaziland"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "baht thailandez", PluralForms{"", "baht thailandez", "", "bahți thailandezi", "", "bahți thailandezi"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "rublă Tadjikistan", PluralForms{"", "rublă Tadjikistan", "", "ruble Tadjikistan", "", "ruble Tadjikistan"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "somoni tadjic", PluralForms{"", "somoni tajdic", "", "somoni tadjici", "", "somoni tadjici"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "manat turkmen (1993–2009)", PluralForms{"", "manat turkmen (1993–2009)", "", "manat turkmeni (1993–2009)", "", "manat turkmeni (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "manat turkmen", PluralForms{"", "manat turkmen", "", "manat turkmeni", "", "manat turkmeni"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "dinar tunisian", PluralForms{"", "dinar tunisian", "", "dinari tunisieni", "", "dinari tunisieni"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "pa’anga tongană", PluralForms{"", "pa’anga tongană", "", "pa’anga tongane", "", "pa’anga tongane"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "liră turcească (1922–2005)", PluralForms{"", "liră turcească (1922–2005)", "", "liră turcească (1922–2005)", "", "lire turcești (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "liră turcească", PluralForms{"", "liră turcească", "", "lire turcești", "", "lire turcești"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dolar din Trinidad și Tobago", PluralForms{"", "dolar din Trinidad și Tobago", "", "dolari din Trinidad și Tobago", "", "dolari din Trinidad și Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "dolar nou din Taiwan", PluralForms{"", "dolar nou din Taiwan", "", "dolari noi din Taiwan", "", "dolari noi Taiwan"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "șiling tanzanian", PluralForms{"", "șiling tanzanian", "", "șilingi tanzanieni", "", "șilingi tanzanieni"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "grivnă", PluralForms{"", "grivnă", "", "grivne", "", "grivne"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "carboavă ucraineană", PluralForms{"", "carboavă ucraineană", "", "carboave ucrainiene", "", "carboave ucrainiene"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "șiling ugandez (1966–1987)", PluralForms{"", "șiling ugandez (1966–1987)", "", "șilingi ugandezi (1966–1987)", "", "șilingi ugandezi (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "șiling ugandez", PluralForms{"", "șiling ugandez", "", "șilingi ugandezi", "", "șilingi ugandezi"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "dolar american", PluralForms{"", "dolar american", "", "dolari americani", "", "dolari americani"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "dolar american (ziua următoare)", PluralForms{"", "dolar american (ziua următoare)", "", "dolari americani (ziua următoare)", "", "dolari americani (ziua următoare)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "dolar american (aceeași zi)", PluralForms{"", "dolar american (aceeași zi)", "", "dolari americani (aceeași zi)", "", "dolari americani (aceeași zi)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "peso Uruguay (1975–1993)", PluralForms{"", "peso Uruguay (1975–1993)", "", "pesos Uruguay (1975–1993)", "", "pesos Uruguay (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "peso uruguayan", PluralForms{"", "peso uruguayan", "", "pesos uruguayeni", "", "pesos uruguayeni"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "sum Uzbekistan", PluralForms{"", "", "", "", "", ""}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "bolivar Venezuela (1871–2008)", PluralForms{"", "bolivar Venezuela (1871–2008)", "", "bolivari Venezuela (1871–2008)", "", "bolivari Venezuela (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "bolivar venezuelean (2008–2018)", PluralForms{"", "bolivar venezuelean (2008–2018)", "", "bolivari venezueleni (2008–2018)", "", "bolivari venezueleni (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "bolívar soberano", PluralForms{"", "bolívar soberano", "", "bolívari soberano", "", "bolívari soberano"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "dong vietnamez", PluralForms{"", "dong vietnamez", "", "dongi vietnamezi", "", "dongi vietnamezi"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vatu din Vanuatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "tala samoană", PluralForms{"", "tala samoană", "", "tala samoane", "", "tala samoană"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "franc CFA BEAC", PluralForms{"", "franc CFA BEAC", "", "franci CFA BEAC", "", "franci CFA central-africani"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "argint", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "aur", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "unitate compusă europeană", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "unitate monetară europeană", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "unitate de cont europeană (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "unitate de cont europeană (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "dolar est-caraib", PluralForms{"", "dolar est-caraib", "", "dolari est-caraibi", "", "dolari est-caraibi"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "drepturi speciale de tragere", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "unitate de monedă europeană", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "franc francez de aur", PluralForms{"", "franc francez de aur", "", "franci francezi de aur", "", "franci francezi de aur"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "franc UIC francez", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "franc CFA BCEAO", PluralForms{"", "franc CFA BCEAO", "", "franci CFA BCEAO", "", "franci CFA BCEAO"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "paladiu", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "franc CFP", PluralForms{"", "franc CFP", "", "franci CFP", "", "franci CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platină", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "cod monetar de test", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "monedă necunoscută", PluralForms{"", "(unitate monetară necunoscută)", "", "(monedă necunoscută)", "", "(monedă necunoscută)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "dinar Yemen", PluralForms{"", "dinar Yemen", "", "dinari Yemen", "", "dinari Yemen"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "rial yemenit", PluralForms{"", "rial yemenit", "", "riali yemeniți", "", "riali yemeniți"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "dinar iugoslav greu", PluralForms{"", "dinar iugoslav greu", "", "dinari iugoslavi grei", "", "dinari iugoslavi grei"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "dinar iugoslav nou", PluralForms{"", "dinar iugoslav nou", "", "dinari iugoslavi noi", "", "dinari iugoslavi noi"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "dinar iugoslav convertibil", PluralForms{"", "dinar iugoslav convertibil", "", "dinari iugoslavi convertibili", "", "dinari iugoslavi convertibili"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "rand sud-african (financiar)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "rand sud-african", PluralForms{"", "rand sud-african", "", "ranzi sud-africani", "", "ranzi sud-africani"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "kwacha zambian (1968–2012)", PluralForms{"", "kwacha zambiană (1968–2012)", "", "kwache zambiene (1968–2012)", "", "kwache zambiene (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "kwacha zambian", PluralForms{"", "kwacha zambian", "", "kwache zambiene", "", "kwache zambiene"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zair nou", PluralForms{"", "zair nou", "", "zairi noi", "", "zairi noi"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "dolar Zimbabwe (1980–2008)", PluralForms{"", "dolar Zimbabwe (1980–2008)", "", "dolari Zimbabwe (1980–2008)", "", "dolari Zimbabwe (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "dolar Zimbabwe (2009)", PluralForms{"", "", "", "", "", ""}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "dolar Zimbabwe (2008)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,290 +6,343 @@ var ruKZ = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "не\u00a0число",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0тыс.", "other": "0\u00a0тыс."}},
+				{4, 2, map[string]string{"one": "0\u00a0тыс.", "other": "0\u00a0тыс."}},
+				{5, 3, map[string]string{"one": "0\u00a0тыс.", "other": "0\u00a0тыс."}},
+				{6, 1, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{7, 2, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{8, 3, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{9, 1, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{10, 2, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{11, 3, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{12, 1, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+				{13, 2, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+				{14, 3, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 тысячи", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячи"}},
+				{4, 2, map[string]string{"few": "0 тысячи", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячи"}},
+				{5, 3, map[string]string{"few": "0 тысячи", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячи"}},
+				{6, 1, map[string]string{"few": "0 миллиона", "many": "0 миллионов", "one": "0 миллион", "other": "0 миллиона"}},
+				{7, 2, map[string]string{"few": "0 миллиона", "many": "0 миллионов", "one": "0 миллион", "other": "0 миллиона"}},
+				{8, 3, map[string]string{"few": "0 миллиона", "many": "0 миллионов", "one": "0 миллион", "other": "0 миллиона"}},
+				{9, 1, map[string]string{"few": "0 миллиарда", "many": "0 миллиардов", "one": "0 миллиард", "other": "0 миллиарда"}},
+				{10, 2, map[string]string{"few": "0 миллиарда", "many": "0 миллиардов", "one": "0 миллиард", "other": "0 миллиарда"}},
+				{11, 3, map[string]string{"few": "0 миллиарда", "many": "0 миллиардов", "one": "0 миллиард", "other": "0 миллиарда"}},
+				{12, 1, map[string]string{"few": "0 триллиона", "many": "0 триллионов", "one": "0 триллион", "other": "0 триллиона"}},
+				{13, 2, map[string]string{"few": "0 триллиона", "many": "0 триллионов", "one": "0 триллион", "other": "0 триллиона"}},
+				{14, 3, map[string]string{"few": "0 триллиона", "many": "0 триллионов", "one": "0 триллион", "other": "0 триллиона"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0тыс.\u00a0¤", "many": "0\u00a0тыс.\u00a0¤", "one": "0\u00a0тыс.\u00a0¤", "other": "0\u00a0тыс.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0тыс.\u00a0¤", "many": "0\u00a0тыс.\u00a0¤", "one": "0\u00a0тыс.\u00a0¤", "other": "0\u00a0тыс.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0тыс.\u00a0¤", "many": "0\u00a0тыс.\u00a0¤", "one": "0\u00a0тыс.\u00a0¤", "other": "0\u00a0тыс.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "ლ"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "₸", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "₽", "₽"},
-		"RUR": {2, "RUR", "р.", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "ТМТ", "ТМТ"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "₴", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg", "Cg"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "XXXX", "XXXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Андоррская песета", PluralForms{"", "андоррская песета", "", "андоррские песеты", "андоррских песет", "андоррских песет"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "дирхам ОАЭ", PluralForms{"", "дирхам ОАЭ", "", "дирхама ОАЭ", "дирхамов ОАЭ", "дирхама ОАЭ"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Афгани (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "афгани", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "албанский лек", PluralForms{"", "албанский лек", "", "албанских лека", "албанских леков", "албанского лека"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "армянский драм", PluralForms{"", "армянский драм", "", "армянских драма", "армянских драмов", "армянского драма"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "нидерландский антильский гульден", PluralForms{"", "нидерландский антильский гульден", "", "нидерландских антильских гульдена", "нидерландских антильских гульденов", "нидерландского антильского гульдена"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "ангольская кванза", PluralForms{"", "ангольская кванза", "", "ангольские кванзы", "ангольских кванз", "ангольской кванзы"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Ангольская кванза (1977–1990)", PluralForms{"", "ангольских кванз (1977–1991)", "", "ангольские кванзы (1977–1991)", "ангольских кванз (1977–1991)", "ангольских кванз (1977–1991)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Ангольская новая кванза (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Ангольская кванза реюстадо (1995–1999)", PluralForms{"", "ангольских кванз реюстадо (1995–1999)", "", "ангольские кванзы реюстадо (1995–1999)", "ангольских кванз реюстадо (1995–1999)", "ангольских кванз реюстадо (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Аргентинский аустрал", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Аргентинское песо (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "аргентинский песо", PluralForms{"", "аргентинский песо", "", "аргентинских песо", "аргентинских песо", "аргентинского песо"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Австрийский шиллинг", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "австралийский доллар", PluralForms{"", "австралийский доллар", "", "австралийских доллара", "австралийских долларов", "австралийского доллара"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "арубанский флорин", PluralForms{"", "арубанский флорин", "", "арубанских флорина", "арубанских флоринов", "арубанского флорина"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Старый азербайджанский манат", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "азербайджанский манат", PluralForms{"", "азербайджанский манат", "", "азербайджанских маната", "азербайджанских манатов", "азербайджанского маната"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Динар Боснии и Герцеговины", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "конвертируемая марка Боснии и Герцеговины", PluralForms{"", "конвертируемая марка Боснии и Герцеговины", "", "конвертируемые марки Боснии и Герцеговины", "конвертируемых марок Боснии и Герцеговины", "конвертируемой марки Боснии и Герцеговины"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "барбадосский доллар", PluralForms{"", "барбадосский доллар", "", "барбадосских доллара", "барбадосских долларов", "барбадосского доллара"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "бангладешская така", PluralForms{"", "бангладешская така", "", "бангладешские таки", "бангладешских так", "бангладешской таки"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Бельгийский франк (конвертируемый)", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Бельгийский франк", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Бельгийский франк (финансовый)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Лев", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "болгарский лев", PluralForms{"", "болгарский лев", "", "болгарских лева", "болгарских левов", "болгарского лева"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "бахрейнский динар", PluralForms{"", "бахрейнский динар", "", "бахрейнских динара", "бахрейнских динаров", "бахрейнского динара"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "бурундийский франк", PluralForms{"", "бурундийский франк", "", "бурундийских франка", "бурундийских франков", "бурундийского франка"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "бермудский доллар", PluralForms{"", "бермудский доллар", "", "бермудских доллара", "бермудских долларов", "бермудского доллара"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "брунейский доллар", PluralForms{"", "брунейский доллар", "", "брунейских доллара", "брунейских долларов", "брунейского доллара"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "боливийский боливиано", PluralForms{"", "боливийский боливиано", "", "боливийских боливиано", "боливийских боливиано", "боливийского боливиано"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Боливийское песо", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Боливийский мвдол", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Бразильский новый крузейро (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Бразильское крузадо", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Бразильский крузейро (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "бразильский реал", PluralForms{"", "бразильский реал", "", "бразильских реала", "бразильских реалов", "бразильского реала"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Бразильское новое крузадо", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Бразильский крузейро", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "багамский доллар", PluralForms{"", "багамский доллар", "", "багамских доллара", "багамских долларов", "багамского доллара"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "бутанский нгултрум", PluralForms{"", "бутанский нгултрум", "", "бутанских нгултрума", "бутанских нгултрумов", "бутанского нгултрума"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Джа", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ботсванская пула", PluralForms{"", "ботсванская пула", "", "ботсванские пулы", "ботсванских пул", "ботсванской пулы"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Белорусский рубль (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "белорусский рубль", PluralForms{"", "белорусский рубль", "", "белорусских рубля", "белорусских рублей", "белорусского рубля"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Белорусский рубль (2000–2016)", PluralForms{"", "белорусский рубль (2000–2016)", "", "белорусских рубля (2000–2016)", "белорусских рублей (2000–2016)", "белорусского рубля (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "белизский доллар", PluralForms{"", "белизский доллар", "", "белизских доллара", "белизских долларов", "белизского доллара"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "канадский доллар", PluralForms{"", "канадский доллар", "", "канадских доллара", "канадских долларов", "канадского доллара"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "конголезский франк", PluralForms{"", "конголезский франк", "", "конголезских франка", "конголезских франков", "конголезского франка"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR евро", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "швейцарский франк", PluralForms{"", "швейцарский франк", "", "швейцарских франка", "швейцарских франков", "швейцарского франка"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR франк", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Условная расчетная единица Чили", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "чилийский песо", PluralForms{"", "чилийский песо", "", "чилийских песо", "чилийских песо", "чилийского песо"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "китайский офшорный юань", PluralForms{"", "китайский офшорный юань", "", "китайских офшорных юаня", "китайских офшорных юаней", "китайского офшорного юаня"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "китайский юань", PluralForms{"", "китайский юань", "", "китайских юаня", "китайских юаней", "китайского юаня"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "колумбийский песо", PluralForms{"", "колумбийский песо", "", "колумбийских песо", "колумбийских песо", "колумбийского песо"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Единица реальной стоимости Колумбии", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "костариканский колон", PluralForms{"", "костариканский колон", "", "костариканских колона", "костариканских колонов", "костариканского колона"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Старый Сербский динар", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Чехословацкая твердая крона", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "кубинский конвертируемый песо", PluralForms{"", "кубинский конвертируемый песо", "", "кубинских конвертируемых песо", "кубинских конвертируемых песо", "кубинского конвертируемого песо"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "кубинский песо", PluralForms{"", "кубинский песо", "", "кубинских песо", "кубинских песо", "кубинского песо"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "эскудо Кабо-Верде", PluralForms{"", "", "", "", "", ""}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Кипрский фунт", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "чешская крона", PluralForms{"", "чешская крона", "", "чешские кроны", "чешских крон", "чешской кроны"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Восточногерманская марка", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Немецкая марка", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "франк Джибути", PluralForms{"", "франк Джибути", "", "франка Джибути", "франков Джибути", "франка Джибути"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "датская крона", PluralForms{"", "датская крона", "", "датские кроны", "датских крон", "датской кроны"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "доминиканский песо", PluralForms{"", "доминиканский песо", "", "доминиканских песо", "доминиканских песо", "доминиканского песо"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "алжирский динар", PluralForms{"", "алжирский динар", "", "алжирских динара", "алжирских динаров", "алжирского динара"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Эквадорский сукре", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Постоянная единица стоимости Эквадора", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Эстонская крона", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "египетский фунт", PluralForms{"", "египетский фунт", "", "египетских фунта", "египетских фунтов", "египетского фунта"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "эритрейская накфа", PluralForms{"", "эритрейская накфа", "", "эритрейские накфы", "эритрейских накф", "эритрейской накфы"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Испанская песета (А)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Испанская песета (конвертируемая)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Испанская песета", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "эфиопский быр", PluralForms{"", "эфиопский быр", "", "эфиопских быра", "эфиопских быров", "эфиопского быра"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "евро", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Финская марка", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "доллар Фиджи", PluralForms{"", "доллар Фиджи", "", "доллара Фиджи", "долларов Фиджи", "доллара Фиджи"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "фунт Фолклендских островов", PluralForms{"", "фунт Фолклендских островов", "", "фунта Фолклендских островов", "фунтов Фолклендских островов", "фунта Фолклендских островов"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Французский франк", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "британский фунт стерлингов", PluralForms{"", "британский фунт стерлингов", "", "британских фунта стерлингов", "британских фунтов стерлингов", "британского фунта стерлингов"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Грузинский купон", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "ლ", "грузинский лари", PluralForms{"", "грузинский лари", "", "грузинских лари", "грузинских лари", "грузинского лари"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ганский седи (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ганский седи", PluralForms{"", "ганский седи", "", "ганских седи", "ганских седи", "ганского седи"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "гибралтарский фунт", PluralForms{"", "гибралтарский фунт", "", "гибралтарских фунта", "гибралтарских фунтов", "гибралтарского фунта"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "гамбийский даласи", PluralForms{"", "гамбийский даласи", "", "гамбийских даласи", "гамбийских даласи", "гамбийского даласи"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "гвинейский франк", PluralForms{"", "гвинейский франк", "", "гвинейских франка", "гвинейских франков", "гвинейского франка"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Гвинейская сили", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Эквеле экваториальной Гвинеи", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Греческая драхма", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "гватемальский кетсаль", PluralForms{"", "гватемальский кетсаль", "", "гватемальских кетсаля", "гватемальских кетсалей", "гватемальского кетсаля"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Эскудо Португальской Гвинеи", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Песо Гвинеи-Бисау", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "гайанский доллар", PluralForms{"", "гайанский доллар", "", "гайанских доллара", "гайанских долларов", "гайанского доллара"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "гонконгский доллар", PluralForms{"", "гонконгский доллар", "", "гонконгских доллара", "гонконгских долларов", "гонконгского доллара"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "гондурасская лемпира", PluralForms{"", "гондурасская лемпира", "", "гондурасские лемпиры", "гондурасских лемпир", "гондурасской лемпиры"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Хорватский динар", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "хорватская куна", PluralForms{"", "хорватская куна", "", "хорватские куны", "хорватских кун", "хорватской куны"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "гаитянский гурд", PluralForms{"", "гаитянский гурд", "", "гаитянских гурда", "гаитянских гурдов", "гаитянского гурда"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "венгерский форинт", PluralForms{"", "венгерский форинт", "", "венгерских форинта", "венгерских форинтов", "венгерского форинта"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "индонезийская рупия", PluralForms{"", "индонезийская рупия", "", "индонезийские рупии", "индонезийских рупий", "индонезийской рупии"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Ирландский фунт", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Израильский фунт", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "новый израильский шекель", PluralForms{"", "новый израильский шекель", "", "новых израильских шекеля", "новых израильских шекелей", "нового израильского шекеля"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "индийская рупия", PluralForms{"", "индийская рупия", "", "индийские рупии", "индийских рупий", "индийской рупии"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "иракский динар", PluralForms{"", "иракский динар", "", "иракских динара", "иракских динаров", "иракского динара"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "иранский риал", PluralForms{"", "иранский риал", "", "иранских риала", "иранских риалов", "иранского риала"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "исландская крона", PluralForms{"", "исландская крона", "", "исландские кроны", "исландских крон", "исландской кроны"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Итальянская лира", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ямайский доллар", PluralForms{"", "ямайский доллар", "", "ямайских доллара", "ямайских долларов", "ямайского доллара"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "иорданский динар", PluralForms{"", "иорданский динар", "", "иорданских динара", "иорданских динаров", "иорданского динара"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "японская иена", PluralForms{"", "японская иена", "", "японские иены", "японских иен", "японской иены"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "кенийский шиллинг", PluralForms{"", "кенийский шиллинг", "", "кенийских шиллинга", "кенийских шиллингов", "кенийского шиллинга"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "киргизский сом", PluralForms{"", "киргизский сом", "", "киргизских сома", "киргизских сомов", "киргизского сома"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "камбоджийский риель", PluralForms{"", "камбоджийский риель", "", "камбоджийских риеля", "камбоджийских риелей", "камбоджийского риеля"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "коморский франк", PluralForms{"", "коморский франк", "", "коморских франка", "коморских франков", "коморского франка"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "северокорейская вона", PluralForms{"", "северокорейская вона", "", "северокорейские воны", "северокорейских вон", "северокорейской воны"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "южнокорейская вона", PluralForms{"", "южнокорейская вона", "", "южнокорейские воны", "южнокорейских вон", "южнокорейской воны"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "кувейтский динар", PluralForms{"", "кувейтский динар", "", "кувейтских динара", "кувейтских динаров", "кувейтского динара"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "доллар Островов Кайман", PluralForms{"", "доллар Островов Кайман", "", "доллара Островов Кайман", "долларов Островов Кайман", "доллара Островов Кайман"}},
+		"KZT": {2, 0, 2, 1, "KZT", "₸", "₸", "казахский тенге", PluralForms{"", "казахский тенге", "", "казахских тенге", "казахских тенге", "казахского тенге"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "лаосский кип", PluralForms{"", "лаосский кип", "", "лаосских кипа", "лаосских кипов", "лаосского кипа"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ливанский фунт", PluralForms{"", "ливанский фунт", "", "ливанских фунта", "ливанских фунтов", "ливанского фунта"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "шри-ланкийская рупия", PluralForms{"", "шри-ланкийская рупия", "", "шри-ланкийские рупии", "шри-ланкийских рупий", "шри-ланкийской рупии"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "либерийский доллар", PluralForms{"", "либерийский доллар", "", "либерийских доллара", "либерийских долларов", "либерийского доллара"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "лоти", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Литовский лит", PluralForms{"", "литовский лит", "", "литовских лита", "литовских литов", "литовского лита"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Литовский талон", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Конвертируемый франк Люксембурга", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Люксембургский франк", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Финансовый франк Люксембурга", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Латвийский лат", PluralForms{"", "латвийский лат", "", "латвийских лата", "латвийских латов", "латвийского лата"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Латвийский рубль", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "ливийский динар", PluralForms{"", "ливийский динар", "", "ливийских динара", "ливийских динаров", "ливийского динара"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "марокканский дирхам", PluralForms{"", "марокканский дирхам", "", "марокканских дирхама", "марокканских дирхамов", "марокканского дирхама"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Марокканский франк", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "молдавский лей", PluralForms{"", "молдавский лей", "", "молдавских лея", "молдавских леев", "молдавского лея"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "малагасийский ариари", PluralForms{"", "малагасийский ариари", "", "малагасийских ариари", "малагасийских ариари", "малагасийского ариари"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Малагасийский франк", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "македонский денар", PluralForms{"", "македонский денар", "", "македонских денара", "македонских денаров", "македонского денара"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Малийский франк", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "мьянманский кьят", PluralForms{"", "мьянманский кьят", "", "мьянманских кьята", "мьянманских кьятов", "мьянманского кьята"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "монгольский тугрик", PluralForms{"", "монгольский тугрик", "", "монгольских тугрика", "монгольских тугриков", "монгольского тугрика"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "патака Макао", PluralForms{"", "патака Макао", "", "патаки Макао", "патак Макао", "патаки Макао"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "мавританская угия (1973–2017)", PluralForms{"", "мавританская угия (1973–2017)", "", "мавританские угии (1973–2017)", "мавританских угий (1973–2017)", "мавританской угии (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "мавританская угия", PluralForms{"", "мавританская угия", "", "мавританские угии", "мавританских угий", "мавританской угии"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Мальтийская лира", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Мальтийский фунт", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "маврикийская рупия", PluralForms{"", "маврикийская рупия", "", "маврикийские рупии", "маврикийских рупий", "маврикийской рупии"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "мальдивская руфия", PluralForms{"", "мальдивская руфия", "", "мальдивские руфии", "мальдивских руфий", "мальдивской руфии"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "малавийская квача", PluralForms{"", "малавийская квача", "", "малавийские квачи", "малавийских квач", "малавийской квачи"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "мексиканский песо", PluralForms{"", "мексиканский песо", "", "мексиканских песо", "мексиканских песо", "мексиканского песо"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Мексиканское серебряное песо (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Мексиканская пересчетная единица (UDI)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "малайзийский ринггит", PluralForms{"", "малайзийский ринггит", "", "малайзийских ринггита", "малайзийских ринггитов", "малайзийского ринггита"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Мозамбикское эскудо", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Старый мозамбикский метикал", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "мозамбикский метикал", PluralForms{"", "мозамбикский метикал", "", "мозамбикских метикала", "мозамбикских метикалов", "мозамбикского метикала"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "доллар Намибии", PluralForms{"", "доллар Намибии", "", "доллара Намибии", "долларов Намибии", "доллара Намибии"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "нигерийская найра", PluralForms{"", "нигерийская найра", "", "нигерийские найры", "нигерийских найр", "нигерийской найры"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Никарагуанская кордоба (1988–1991)", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "никарагуанская кордоба", PluralForms{"", "никарагуанская кордоба", "", "никарагуанские кордобы", "никарагуанских кордоб", "никарагуанской кордобы"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Нидерландский гульден", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "норвежская крона", PluralForms{"", "норвежская крона", "", "норвежские кроны", "норвежских крон", "норвежской кроны"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "непальская рупия", PluralForms{"", "непальская рупия", "", "непальские рупии", "непальских рупий", "непальской рупии"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "новозеландский доллар", PluralForms{"", "новозеландский доллар", "", "новозеландских доллара", "новозеландских долларов", "новозеландского доллара"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "оманский риал", PluralForms{"", "оманский риал", "", "оманских риала", "оманских риалов", "оманского риала"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "панамский бальбоа", PluralForms{"", "панамский бальбоа", "", "панамских бальбоа", "панамских бальбоа", "панамского бальбоа"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Перуанское инти", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "перуанский соль", PluralForms{"", "перуанский соль", "", "перуанских соля", "перуанских солей", "перуанского соля"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Перуанский соль (1863–1965)", PluralForms{"", "перуанский соль (1863–1965)", "", "перуанских соля (1863–1965)", "перуанских солей (1863–1965)", "перуанского соля (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "кина Папуа – Новой Гвинеи", PluralForms{"", "кина Папуа – Новой Гвинеи", "", "кины Папуа – Новой Гвинеи", "кин Папуа – Новой Гвинеи", "кины Папуа – Новой Гвинеи"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "филиппинский песо", PluralForms{"", "филиппинский песо", "", "филиппинских песо", "филиппинских песо", "филиппинского песо"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "пакистанская рупия", PluralForms{"", "пакистанская рупия", "", "пакистанские рупии", "пакистанских рупий", "пакистанской рупии"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "польский злотый", PluralForms{"", "польский злотый", "", "польских злотых", "польских злотых", "польского злотого"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Злотый", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Португальское эскудо", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "парагвайский гуарани", PluralForms{"", "парагвайский гуарани", "", "парагвайских гуарани", "парагвайских гуарани", "парагвайского гуарани"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "катарский риал", PluralForms{"", "катарский риал", "", "катарских риала", "катарских риалов", "катарского риала"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Родезийский доллар", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Старый Румынский лей", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "румынский лей", PluralForms{"", "румынский лей", "", "румынских лея", "румынских леев", "румынского лея"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "сербский динар", PluralForms{"", "сербский динар", "", "сербских динара", "сербских динаров", "сербского динара"}},
+		"RUB": {2, 0, 2, 1, "RUB", "₽", "₽", "российский рубль", PluralForms{"", "российский рубль", "", "российских рубля", "российских рублей", "российского рубля"}},
+		"RUR": {2, 0, 2, 1, "RUR", "р.", "р.", "Российский рубль (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "франк Руанды", PluralForms{"", "франк Руанды", "", "франка Руанды", "франков Руанды", "франка Руанды"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "саудовский риял", PluralForms{"", "саудовский риял", "", "саудовских рияла", "саудовских риялов", "саудовского рияла"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "доллар Соломоновых Островов", PluralForms{"", "доллар Соломоновых Островов", "", "доллара Соломоновых Островов", "долларов Соломоновых Островов", "доллара Соломоновых Островов"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "сейшельская рупия", PluralForms{"", "сейшельская рупия", "", "сейшельские рупии", "сейшельских рупий", "сейшельской рупии"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Суданский динар", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "суданский фунт", PluralForms{"", "суданский фунт", "", "суданских фунта", "суданских фунтов", "суданского фунта"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Старый суданский фунт", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "шведская крона", PluralForms{"", "шведская крона", "", "шведские кроны", "шведских крон", "шведской кроны"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "сингапурский доллар", PluralForms{"", "сингапурский доллар", "", "сингапурских доллара", "сингапурских долларов", "сингапурского доллара"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "фунт острова Святой Елены", PluralForms{"", "фунт острова Святой Елены", "", "фунта острова Святой Елены", "фунтов острова Святой Елены", "фунта острова Святой Елены"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Словенский толар", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Словацкая крона", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "леоне", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "леоне (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "сомалийский шиллинг", PluralForms{"", "сомалийский шиллинг", "", "сомалийских шиллинга", "сомалийских шиллингов", "сомалийского шиллинга"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "суринамский доллар", PluralForms{"", "суринамский доллар", "", "суринамских доллара", "суринамских долларов", "суринамского доллара"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Суринамский гульден", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "южносуданский фунт", PluralForms{"", "южносуданский фунт", "", "южносуданских фунта", "южносуданских фунтов", "южносуданского фунта"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "добра Сан-Томе и Принсипи (1977–2017)", PluralForms{"", "добра Сан-Томе и Принсипи (1977–2017)", "", "добры Сан-Томе и Принсипи (1977–2017)", "добр Сан-Томе и Принсипи (1977–2017)", "добры Сан-Томе и Принсипи (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "добра Сан-Томе и Принсипи", PluralForms{"", "добра Сан-Томе и Принсипи", "", "добры Сан-Томе и Принсипи", "добр Сан-Томе и Принсипи", "добры Сан-Томе и Принсипи"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Рубль СССР", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Сальвадорский колон", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "сирийский фунт", PluralForms{"", "сирийский фунт", "", "сирийских фунта", "сирийских фунтов", "сирийского фунта"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "свазилендский лилангени", PluralForms{"", "свазилендский лилангени", "", "свазилендских лилангени", "свазилендских лилангени", "свазилендского лилангени"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "таиландский бат", PluralForms{"", "таиландский бат", "", "таиландских бата", "таиландских батов", "таиландского бата"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Таджикский рубль", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "таджикский сомони", PluralForms{"", "таджикский сомони", "", "таджикских сомони", "таджикских сомони", "таджикского сомони"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Туркменский манат", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "ТМТ", "ТМТ", "новый туркменский манат", PluralForms{"", "новый туркменский манат", "", "новых туркменских маната", "новых туркменских манатов", "нового туркменского маната"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "тунисский динар", PluralForms{"", "тунисский динар", "", "тунисских динара", "тунисских динаров", "тунисского динара"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "тонганская паанга", PluralForms{"", "тонганская паанга", "", "тонганские паанги", "тонганских паанг", "тонганской паанги"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Тиморское эскудо", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Турецкая лира (1922–2005)", PluralForms{"", "турецкая лира (1922–2005)", "", "турецкие лиры (1922–2005)", "турецких лир (1922–2005)", "турецкой лиры (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "турецкая лира", PluralForms{"", "турецкая лира", "", "турецкие лиры", "турецких лир", "турецкой лиры"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "доллар Тринидада и Тобаго", PluralForms{"", "доллар Тринидада и Тобаго", "", "доллара Тринидада и Тобаго", "долларов Тринидада и Тобаго", "доллара Тринидада и Тобаго"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "новый тайваньский доллар", PluralForms{"", "новый тайваньский доллар", "", "новых тайваньских доллара", "новых тайваньских долларов", "нового тайваньского доллара"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "танзанийский шиллинг", PluralForms{"", "танзанийский шиллинг", "", "танзанийских шиллинга", "танзанийских шиллингов", "танзанийского шиллинга"}},
+		"UAH": {2, 0, 2, 1, "UAH", "₴", "₴", "украинская гривна", PluralForms{"", "украинская гривна", "", "украинские гривны", "украинских гривен", "украинской гривны"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Карбованец (украинский)", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Старый угандийский шиллинг", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "угандийский шиллинг", PluralForms{"", "угандийский шиллинг", "", "угандийских шиллинга", "угандийских шиллингов", "угандийского шиллинга"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "доллар США", PluralForms{"", "доллар США", "", "доллара США", "долларов США", "доллара США"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Доллар США следующего дня", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Доллар США текущего дня", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Уругвайский песо (индекс инфляции)", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Уругвайское старое песо (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "уругвайский песо", PluralForms{"", "уругвайский песо", "", "уругвайских песо", "уругвайских песо", "уругвайского песо"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "узбекский сум", PluralForms{"", "узбекский сум", "", "узбекских сума", "узбекских сумов", "узбекского сума"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Венесуэльский боливар (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "венесуэльский боливар (2008–2018)", PluralForms{"", "венесуэльский боливар (2008–2018)", "", "венесуэльских боливара (2008–2018)", "венесуэльских боливаров (2008–2018)", "венесуэльского боливара (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "венесуэльский боливар", PluralForms{"", "венесуэльский боливар", "", "венесуэльских боливара", "венесуэльских боливаров", "венесуэльского боливара"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "вьетнамский донг", PluralForms{"", "вьетнамский донг", "", "вьетнамских донга", "вьетнамских донгов", "вьетнамского донга"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "вату Вануату", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "самоанская тала", PluralForms{"", "самоанская тала", "", "самоанские талы", "самоанских тал", "самоанской талы"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "франк КФА BEAC", PluralForms{"", "франк КФА ВЕАС", "", "франка КФА ВЕАС", "франков КФА ВЕАС", "франка КФА ВЕАС"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Серебро", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Золото", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Европейская составная единица", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Европейская денежная единица", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "расчетная единица европейского валютного соглашения (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "расчетная единица европейского валютного соглашения (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "восточно-карибский доллар", PluralForms{"", "восточно-карибский доллар", "", "восточно-карибских доллара", "восточно-карибских долларов", "восточно-карибского доллара"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg", "Cg", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "СДР (специальные права заимствования)", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "ЭКЮ (единица европейской валюты)", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Французский золотой франк", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Французский UIC-франк", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "франк КФА ВСЕАО", PluralForms{"", "франк КФА ВСЕАО", "", "франка КФА ВСЕАО", "франков КФА ВСЕАО", "франка КФА ВСЕАО"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Палладий", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "французский тихоокеанский франк", PluralForms{"", "французский тихоокеанский франк", "", "французских тихоокеанских франка", "французских тихоокеанских франков", "французского тихоокеанского франка"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Платина", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "единица RINET-фондов", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "тестовый валютный код", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXXX", "XXXX", "неизвестная валюта", PluralForms{"", "единица неизвестной валюты", "", "единицы неизвестной валюты", "единиц неизвестной валюты", "единицы неизвестной валюты"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Йеменский динар", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "йеменский риал", PluralForms{"", "йеменский риал", "", "йеменских риала", "йеменских риалов", "йеменского риала"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Югославский твердый динар", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Югославский новый динар", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Югославский динар", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Южноафриканский рэнд (финансовый)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "южноафриканский рэнд", PluralForms{"", "южноафриканский рэнд", "", "южноафриканских рэнда", "южноафриканских рэндов", "южноафриканского рэнда"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Квача (замбийская) (1968–2012)", PluralForms{"", "замбийская квача (1968–2012)", "", "замбийские квачи (1968–2012)", "замбийских квач (1968–2012)", "замбийской квачи (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "замбийская квача", PluralForms{"", "замбийская квача", "", "замбийские квачи", "замбийских квач", "замбийской квачи"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Новый заир", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Заир", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Доллар Зимбабве", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Доллар Зимбабве (2009)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
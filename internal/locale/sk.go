@@ -6,313 +6,366 @@ var sk = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"e", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{4, 2, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{5, 3, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{6, 1, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{7, 2, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{8, 3, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{9, 1, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{10, 2, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{11, 3, map[string]string{"one": "0\u00a0mld.", "other": "0\u00a0mld."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tisíce", "many": "0 tisíca", "one": "0 tisíc", "other": "0 tisíc"}},
+				{4, 2, map[string]string{"few": "0 tisíc", "many": "0 tisíca", "one": "0 tisíc", "other": "0 tisíc"}},
+				{5, 3, map[string]string{"few": "0 tisíc", "many": "0 tisíca", "one": "0 tisíc", "other": "0 tisíc"}},
+				{6, 1, map[string]string{"few": "0 milióny", "many": "0 milióna", "one": "0 milión", "other": "0 miliónov"}},
+				{7, 2, map[string]string{"few": "0 miliónov", "many": "0 milióna", "one": "0 miliónov", "other": "0 miliónov"}},
+				{8, 3, map[string]string{"few": "0 miliónov", "many": "0 milióna", "one": "0 miliónov", "other": "0 miliónov"}},
+				{9, 1, map[string]string{"few": "0 miliardy", "many": "0 miliardy", "one": "0 miliarda", "other": "0 miliárd"}},
+				{10, 2, map[string]string{"few": "0 miliárd", "many": "0 miliardy", "one": "0 miliárd", "other": "0 miliárd"}},
+				{11, 3, map[string]string{"few": "0 miliárd", "many": "0 miliardy", "one": "0 miliárd", "other": "0 miliárd"}},
+				{12, 1, map[string]string{"few": "0 bilióny", "many": "0 bilióna", "one": "0 bilión", "other": "0 biliónov"}},
+				{13, 2, map[string]string{"few": "0 biliónov", "many": "0 bilióna", "one": "0 biliónov", "other": "0 biliónov"}},
+				{14, 3, map[string]string{"few": "0 biliónov", "many": "0 bilióna", "one": "0 biliónov", "other": "0 biliónov"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tis.\u00a0¤", "many": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tis.\u00a0¤", "many": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tis.\u00a0¤", "many": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mil.\u00a0¤", "many": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mil.\u00a0¤", "many": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mil.\u00a0¤", "many": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mld.\u00a0¤", "many": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mld.\u00a0¤", "many": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mld.\u00a0¤", "many": "0\u00a0mld.\u00a0¤", "one": "0\u00a0mld.\u00a0¤", "other": "0\u00a0mld.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "many": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "many": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "many": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0} – {1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "NIS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg", "Cg"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorrská peseta", PluralForms{"", "andorrská peseta", "", "andorrské pesety", "andorrskej pesety", "andorrských pesiet"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "SAE dirham", PluralForms{"", "SAE dirham", "", "SAE dirhamy", "SAE dirhamu", "SAE dirhamov"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afganský afgání (1927 – 2002)", PluralForms{"", "afganský afgání (1927 – 2002)", "", "afganské afgání (1927 – 2002)", "afganského afgání (1927 – 2002)", "afganských afgání (1927 – 2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afganský afgání", PluralForms{"", "afganský afgání", "", "afganské afgání", "afganského afgání", "afganských afgání"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "albánsky lek (1946 – 1965)", PluralForms{"", "albánsky lek (1946 – 1965)", "", "albánske leky (1946 – 1965)", "albánskeho leku (1946 – 1965)", "albánskych lekov (1946 – 1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albánsky lek", PluralForms{"", "albánsky lek", "", "albánske leky", "albánskeho leku", "albánskych lekov"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "arménsky dram", PluralForms{"", "arménsky dram", "", "arménske dramy", "arménskeho dramu", "arménskych dramov"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "antilský gulden", PluralForms{"", "antilský gulden", "", "antilské guldeny", "antilského guldena", "antilských guldenov"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolská kwanza", PluralForms{"", "angolská kwanza", "", "angolské kwanzy", "angolskej kwanzy", "angolských kwánz"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "angolská kwanza (1977 – 1990)", PluralForms{"", "angolská kwanza (1977 – 1990)", "", "angolské kwanzy (1977 – 1990)", "angolskej kwanzy (1977 – 1990)", "angolských kwánz (1977 – 1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolská nová kwanza (1990 – 2000)", PluralForms{"", "angolská nová kwanza (1990 – 2000)", "", "angolské nové kwanzy (1990 – 2000)", "angolskej novej kwanzy (1990 – 2000)", "angolských nových kwánz (1990 – 2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "angolská upravená kwanza (1995 – 1999)", PluralForms{"", "angolská upravená kwanza (1995 – 1999)", "", "angolské upravené kwanzy (1995 – 1999)", "angolskej upravenej kwanzy (1995 – 1999)", "angolských upravených kwánz (1995 – 1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentínsky austral", PluralForms{"", "argentínsky austral", "", "argentínske australy", "argentínskeho australu", "argentínskych australov"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "argentínske peso ley (1970 – 1983)", PluralForms{"", "argentínske peso ley (1970 – 1983)", "", "argentínske pesos ley (1970 – 1983)", "argentínskeho pesa ley (1970 – 1983)", "argentínskych pesos ley (1970 – 1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "argentínske peso (1881 – 1970)", PluralForms{"", "argentínske peso (1881 – 1970)", "", "argentínske pesos (1881 – 1970)", "argentínskeho pesa (1881 – 1970)", "argentínskych pesos (1881 – 1970)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentínske peso (1983 – 1985)", PluralForms{"", "argentínske peso (1983 – 1985)", "", "argentínske pesos (1983 – 1985)", "argentínskeho pesa (1983 – 1985)", "argentínskych pesos (1983 – 1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentínske peso", PluralForms{"", "argentínske peso", "", "argentínske pesos", "argentínskeho pesa", "argentínskych pesos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "rakúsky šiling", PluralForms{"", "rakúsky šiling", "", "rakúske šilingy", "rakúskeho šilingu", "rakúskych šilingov"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "austrálsky dolár", PluralForms{"", "austrálsky dolár", "", "austrálske doláre", "austrálskeho dolára", "austrálskych dolárov"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubský gulden", PluralForms{"", "arubský gulden", "", "arubské guldeny", "arubského guldena", "arubských guldenov"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "azerbajdžanský manat (1993–2006)", PluralForms{"", "azerbajdžanský manat (1993–2006)", "", "azerbajdžanské manaty (1993–2006)", "azerbajdžanského manatu (1993–2006)", "azerbajdžanských manatov (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "azerbajdžanský manat", PluralForms{"", "azerbajdžanský manat", "", "azerbajdžanské manaty", "azerbajdžanského manatu", "azerbajdžanských manatov"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosniansko-hercegovinský dinár (1992 – 1994)", PluralForms{"", "bosniansko-hercegovinský dinár (1992 – 1994)", "", "bosniansko-hercegovinské dináre (1992 – 1994)", "bosniansko-hercegovinského dinára (1992 – 1994)", "bosniansko-hercegovinských dinárov (1992 – 1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosniansko-hercegovinská konvertibilná marka", PluralForms{"", "bosniansko-hercegovinská konvertibilná marka", "", "bosniansko-hercegovinské konvertibilné marky", "bosniansko-hercegovinskej konvertibilnej marky", "bosniansko-hercegovinských konvertibilných mariek"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "bosniansko-hercegovinský nový dinár (1994 – 1997)", PluralForms{"", "bosniansko-hercegovinský nový dinár (1994 – 1997)", "", "bosniansko-hercegovinské nové dináre (1994 – 1997)", "bosniansko-hercegovinského nového dinára (1994 – 1997)", "bosniansko-hercegovinské nové dináre (1994 – 1997)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoský dolár", PluralForms{"", "barbadoský dolár", "", "barbadoské doláre", "barbadoského dolára", "barbadoských dolárov"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladéšska taka", PluralForms{"", "bangladéšska taka", "", "bangladéšske taky", "bangladéšskej taky", "bangladéšskych ták"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgický frank (konvertibilný)", PluralForms{"", "belgický frank (konvertibilný)", "", "belgické franky (konvertibilné)", "belgického franku (konvertibilného)", "belgických frankov (konvertibilných)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgický frank", PluralForms{"", "belgický frank", "", "belgické franky", "belgického franku", "belgických frankov"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgický frank (finančný)", PluralForms{"", "belgický frank (finančný)", "", "belgické franky (finančné)", "belgického franku (finančného)", "belgických frankov (finančných)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "bulharský tvrdý lev", PluralForms{"", "bulharský tvrdý lev", "", "bulharské tvrdé leva", "bulharského tvrdého leva", "bulharských tvrdých leva"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "bulharský socialistický lev", PluralForms{"", "bulharský socialistický lev", "", "bulharské socialistické leva", "bulharského socialistického leva", "bulharských socialistických leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bulharský lev", PluralForms{"", "bulharský lev", "", "bulharské leva", "bulharského leva", "bulharských leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "bulharský lev (1879 – 1952)", PluralForms{"", "bulharský lev (1879 – 1952)", "", "bulharské leva (1879 – 1952)", "bulharského leva (1879 – 1952)", "bulharských leva (1879 – 1952)"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrajnský dinár", PluralForms{"", "bahrajnský dinár", "", "bahrajnské dináre", "bahrajnského dinára", "bahrajnských dinárov"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundský frank", PluralForms{"", "burundský frank", "", "burundské franky", "burundského franku", "burundských frankov"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudský dolár", PluralForms{"", "bermudský dolár", "", "bermudské doláre", "bermudského dolára", "bermudských dolárov"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "brunejský dolár", PluralForms{"", "brunejský dolár", "", "brunejské doláre", "brunejského dolára", "brunejských dolárov"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolívijské boliviano", PluralForms{"", "bolívijské boliviano", "", "bolívijské boliviana", "bolívijského boliviana", "bolívijských bolivian"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "bolívijské boliviano (1863 – 1963)", PluralForms{"", "bolívijské boliviano (1863 – 1963)", "", "bolívijské boliviana (1863 – 1963)", "bolívijského boliviana (1863 – 1963)", "bolívijských bolivian (1863 – 1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "bolívijské peso", PluralForms{"", "bolívijské peso", "", "bolívijské pesos", "bolívijského pesa", "bolívijských pesos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "bolívijské MVDOL", PluralForms{"", "bolívijský mvdol", "", "bolívijské mvdoly", "bolívijského mvdolu", "bolívijských mvdolov"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brazílske nové cruzeiro (1967 – 1986)", PluralForms{"", "brazílske nové cruzeiro (1967 – 1986)", "", "brazílske nové cruzeirá (1967 – 1986)", "brazílskeho nového cruzeira (1967 – 1986)", "brazílskych nových cruzeir (1967 – 1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brazílske cruzado (1986 – 1989)", PluralForms{"", "brazílske cruzado (1986 – 1989)", "", "brazílske cruzadá (1986 – 1989)", "brazílskeho cruzada (1986 – 1989)", "brazílskych cruzad (1986 – 1989)"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "brazílske cruzeiro (1990 – 1993)", PluralForms{"", "brazílske cruzeiro (1990 – 1993)", "", "brazílske cruzeirá (1990 – 1993)", "brazílskeho cruzeira (1990 – 1993)", "brazílskych cruzeir (1990 – 1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "brazílsky real", PluralForms{"", "brazílsky real", "", "brazílske realy", "brazílskeho realu", "brazílskych realov"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "brazílske nové cruzado (1989 – 1990)", PluralForms{"", "brazílske nové cruzado (1989 – 1990)", "", "brazílske nové cruzadá (1989 – 1990)", "brazílskeho nového cruzada (1989 – 1990)", "brazílskych nových cruzad (1989 – 1990)"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brazílske cruzeiro", PluralForms{"", "brazílske cruzeiro (1993 – 1994)", "", "brazílske cruzeirá (1993 – 1994)", "brazílskeho cruzeira (1993 – 1994)", "brazílskych cruzeir (1993 – 1994)"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "brazílske cruzeiro (1942 – 1967)", PluralForms{"", "brazílske cruzeiro (1942 – 1967)", "", "brazílske cruzeirá (1942 – 1967)", "brazílskeho cruzeira (1942 – 1967)", "brazílskych cruzeir (1942 – 1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamský dolár", PluralForms{"", "bahamský dolár", "", "bahamské doláre", "bahamského dolára", "bahamských dolárov"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bhutánsky ngultrum", PluralForms{"", "bhutánsky ngultrum", "", "bhutánske ngultrumy", "bhutánskeho ngultrumu", "bhutánskych ngultrumov"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "barmský kyat", PluralForms{"", "barmský kyat", "", "barmské kyaty", "barmského kyatu", "barmských kyatov"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botswanská pula", PluralForms{"", "botswanská pula", "", "botswanské puly", "botswanskej puly", "botswanských púl"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "bieloruský rubeľ (1994 – 1999)", PluralForms{"", "bieloruský rubeľ (1994 – 1999)", "", "bieloruské ruble (1994 – 1999)", "bieloruského rubľa (1994 – 1999)", "bieloruských rubľov (1994 – 1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "bieloruský rubeľ", PluralForms{"", "bieloruský rubeľ", "", "bieloruské ruble", "bieloruského rubľa", "bieloruských rubľov"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "bieloruský rubeľ (2000–2016)", PluralForms{"", "bieloruský rubeľ (2000–2016)", "", "bieloruské ruble (2000–2016)", "bieloruského rubľa (2000–2016)", "bieloruských rubľov (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belizský dolár", PluralForms{"", "belizský dolár", "", "belizské doláre", "belizského dolára", "belizských dolárov"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "kanadský dolár", PluralForms{"", "kanadský dolár", "", "kanadské doláre", "kanadského dolára", "kanadských dolárov"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "konžský frank", PluralForms{"", "konžský frank", "", "konžské franky", "konžského franku", "konžských frankov"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "švajčiarske WIR euro", PluralForms{"", "švajčiarske WIR euro", "", "švajčiarske WIR eurá", "švajčiarskeho WIR eura", "švajčiarskych WIR eur"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "švajčiarsky frank", PluralForms{"", "švajčiarsky frank", "", "švajčiarske franky", "švajčiarskeho franku", "švajčiarskych frankov"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "švajčiarsky WIR frank", PluralForms{"", "švajčiarsky WIR frank", "", "švajčiarske WIR franky", "švajčiarskeho WIR franku", "švajčiarskych WIR frankov"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "čilské escudo", PluralForms{"", "čilské escudo", "", "čilské escudá", "čilského escuda", "čilských escúd"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "čilská účtovná jednotka (UF)", PluralForms{"", "čilská účtovná jednotka (UF)", "", "čilské účtovné jednotky (UF)", "čilskej účtovnej jednotky (UF)", "čilských účtovných jednotiek (UF)"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "čilské peso", PluralForms{"", "čilské peso", "", "čilské pesos", "čilského pesa", "čilských pesos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "čínsky jüan (pobrežný)", PluralForms{"", "čínsky jüan (pobrežný)", "", "čínske jüany (pobrežné)", "čínskeho jüana (pobrežného)", "čínskych jüanov (pobrežných)"}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "čínsky dolár ČĽB", PluralForms{"", "čínsky dolár ČĽB", "", "čínske doláre ČĽB", "čínskeho dolára ČĽB", "čínskych dolárov ČĽB"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "čínsky jüan", PluralForms{"", "čínsky jüan", "", "čínske jüany", "čínskeho jüana", "čínskych jüanov"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kolumbijské peso", PluralForms{"", "kolumbijské peso", "", "kolumbijské pesos", "kolumbijského pesa", "kolumbijských pesos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "kolumbijská jednotka reálnej hodnoty", PluralForms{"", "kolumbijská jednotka reálnej hodnoty", "", "kolumbijské jednotky reálnej hodnoty", "kolumbijskej jednotky reálnej hodnoty", "kolumbijských jednotiek reálnej hodnoty"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kostarický colón", PluralForms{"", "kostarický colón", "", "kostarické colóny", "kostarického colóna", "kostarických colónov"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "srbský dinár (2002 – 2006)", PluralForms{"", "srbský dinár (2002 – 2006)", "", "srbské dináre (2002 – 2006)", "srbského dinára (2002 – 2006)", "srbských dinárov (2002 – 2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "československá koruna", PluralForms{"", "československá koruna", "", "československé koruny", "československej koruny", "československých korún"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubánske konvertibilné peso", PluralForms{"", "kubánske konvertibilné peso", "", "kubánske konvertibilné pesos", "kubánskeho konvertibilného pesa", "kubánskych konvertibilných pesos"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubánske peso", PluralForms{"", "kubánske peso", "", "kubánske pesos", "kubánskeho pesa", "kubánskych pesos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "kapverdské escudo", PluralForms{"", "kapverdské escudo", "", "kapverdské escudá", "kapverdského escuda", "kapverdských escúd"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "cyperská libra", PluralForms{"", "cyperská libra", "", "cyperské libry", "cyperskej libry", "cyperských libier"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "česká koruna", PluralForms{"", "česká koruna", "", "české koruny", "českej koruny", "českých korún"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "východonemecká marka", PluralForms{"", "východonemecká marka", "", "východonemecké marky", "východonemeckej marky", "východonemeckých mariek"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "nemecká marka", PluralForms{"", "nemecká marka", "", "nemecké marky", "nemeckej marky", "nemeckých mariek"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "džibutský frank", PluralForms{"", "džibutský frank", "", "džibutské franky", "džibutského franku", "džibutských frankov"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "dánska koruna", PluralForms{"", "dánska koruna", "", "dánske koruny", "dánskej koruny", "dánskych korún"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikánske peso", PluralForms{"", "dominikánske peso", "", "dominikánske pesos", "dominikánskeho pesa", "dominikánske pesos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "alžírsky dinár", PluralForms{"", "alžírsky dinár", "", "alžírske dináre", "alžírskeho dinára", "alžírskych dinárov"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ekvádorský sucre", PluralForms{"", "ekvádorský sucre", "", "ekvádorské sucre", "ekvádorského sucre", "ekvádorských sucre"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ekvádorská jednotka konštantnej hodnoty", PluralForms{"", "ekvádorská jednotka konštantnej hodnoty", "", "ekvádorské jednotky konštantnej hodnoty", "ekvádorskej jednotky konštantnej hodnoty", "ekvádorských jednotiek konštantnej hodnoty"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "estónska koruna", PluralForms{"", "estónska koruna", "", "estónske koruny", "estónskej koruny", "estónskych korún"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egyptská libra", PluralForms{"", "egyptská libra", "", "egyptské libry", "egyptskej libry", "egyptských libier"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritrejská nakfa", PluralForms{"", "eritrejská nakfa", "", "eritrejské nakfy", "eritrejskej nakfy", "eritrejských nakief"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "španielska peseta (účet A)", PluralForms{"", "španielska peseta (účet A)", "", "španielske pesety (účet A)", "španielskej pesety (účet A)", "španielskych pesiet (účet A)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "španielska peseta (konvertibilný účet)", PluralForms{"", "španielska peseta (konvertibilný účet)", "", "španielske pesety (konvertibilný účet)", "španielskej pesety (konvertibilný účet)", "španielskych pesiet (konvertibilný účet)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "španielska peseta", PluralForms{"", "španielska peseta", "", "španielske pesety", "španielskej pesety", "španielskych pesiet"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiópsky birr", PluralForms{"", "etiópsky birr", "", "etiópske birry", "etiópskeho birru", "etiópskych birrov"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "euro", "", "eurá", "eura", "eur"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "fínska marka", PluralForms{"", "fínska marka", "", "fínske marky", "fínskej marky", "fínskych mariek"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fidžijský dolár", PluralForms{"", "fidžijský dolár", "", "fidžijské doláre", "fidžijského dolára", "fidžijských dolárov"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklandská libra", PluralForms{"", "falklandská libra", "", "falklandské libry", "falklandskej libry", "falklandských libier"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "francúzsky frank", PluralForms{"", "francúzsky frank", "", "francúzske franky", "francúzskeho franku", "francúzskych frankov"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "britská libra", PluralForms{"", "britská libra", "", "britské libry", "britskej libry", "britských libier"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "gruzínske kupónové lari", PluralForms{"", "gruzínske kupónové lari", "", "gruzínske kupónové lari", "gruzínskeho kupónového lari", "gruzínskych kupónových lari"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "gruzínske lari", PluralForms{"", "gruzínske lari", "", "gruzínske lari", "gruzínskeho lari", "gruzínskych lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ghanské cedi (1979 – 2007)", PluralForms{"", "ghanské cedi (1979 – 2007)", "", "ghanské cedi (1979 – 2007)", "ghanského cedi (1979 – 2007)", "ghanských cedi (1979 – 2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ghanské cedi", PluralForms{"", "ghanské cedi", "", "ghanské cedi", "ghanského cedi", "ghanských cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltárska libra", PluralForms{"", "gibraltárska libra", "", "gibraltárske libry", "gibraltárskej libry", "gibraltárskych libier"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambijské dalasi", PluralForms{"", "gambijské dalasi", "", "gambijské dalasi", "gambijského dalasi", "gambijských dalasi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "guinejský frank", PluralForms{"", "guinejský frank", "", "guinejské franky", "guinejského franku", "guinejských frankov"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "guinejské syli", PluralForms{"", "guinejské syli", "", "guinejské syli", "guinejského syli", "guinejských syli"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "rovníkovoguinejský ekwele", PluralForms{"", "rovníkovoguinejský ekwele", "", "rovníkovoguinejské ekwele", "rovníkovoguinejského ekwele", "rovníkovoguinejských ekwele"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "grécka drachma", PluralForms{"", "grécka drachma", "", "grécke drachmy", "gréckej drachmy", "gréckych drachiem"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "guatemalský quetzal", PluralForms{"", "guatemalský quetzal", "", "guatemalské quetzaly", "guatemalského quetzala", "guatemalských quetzalov"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "portugalsko-guinejské escudo", PluralForms{"", "portugalsko-guinejské escudo", "", "portugalsko-guinejské escudá", "portugalsko-guinejského escuda", "portugalsko-guinejských escúd"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "guinejsko-bissauské peso", PluralForms{"", "guinejsko-bissauské peso", "", "guinejsko-bissauské pesos", "guinejsko-bissauského pesa", "guinejsko-bissauských pesos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "guyanský dolár", PluralForms{"", "guyanský dolár", "", "guyanské doláre", "guyanského dolára", "guyanských dolárov"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "hongkonský dolár", PluralForms{"", "hongkonský dolár", "", "hongkonské doláre", "hongkonského dolára", "hongkonských dolárov"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduraská lempira", PluralForms{"", "honduraská lempira", "", "honduraské lempiry", "honduraskej lempiry", "honduraských lempír"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "chorvátsky dinár", PluralForms{"", "chorvátsky dinár", "", "chorvátske dináre", "chorvátskeho dinára", "chorvátskych dinárov"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "chorvátska kuna", PluralForms{"", "chorvátska kuna", "", "chorvátske kuny", "chorvátskej kuny", "chorvátskych kún"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitské gourde", PluralForms{"", "haitské gourde", "", "haitské gourde", "haitského gourde", "haitských gourde"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "maďarský forint", PluralForms{"", "maďarský forint", "", "maďarské forinty", "maďarského forinta", "maďarských forintov"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonézska rupia", PluralForms{"", "indonézska rupia", "", "indonézske rupie", "indonézskej rupie", "indonézskych rupií"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "írska libra", PluralForms{"", "írska libra", "", "írske libry", "írskej libry", "írskych libier"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "izraelská libra", PluralForms{"", "izraelská libra", "", "izraelské libry", "izraelskej libry", "izraelských libier"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "izraelský šekel (1980 – 1985)", PluralForms{"", "izraelský šekel (1980 – 1985)", "", "izraelské šekely (1980 – 1985)", "izraelského šekela (1980 – 1985)", "izraelských šekelov (1980 – 1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "NIS", "₪", "izraelský šekel", PluralForms{"", "izraelský šekel", "", "izraelské šekely", "izraelského šekela", "izraelských šekelov"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "indická rupia", PluralForms{"", "indická rupia", "", "indické rupie", "indickej rupie", "indických rupií"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "iracký dinár", PluralForms{"", "iracký dinár", "", "iracké dináre", "irackého dinára", "irackých dinárov"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iránsky rial", PluralForms{"", "iránsky rial", "", "iránske rialy", "iránskeho rialu", "iránskych rialov"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "islandská koruna (1918 – 1981)", PluralForms{"", "islandská koruna (1918 – 1981)", "", "islandské koruny (1918 – 1981)", "islandskej koruny (1918 – 1981)", "islandských korún (1918 – 1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandská koruna", PluralForms{"", "islandská koruna", "", "islandské koruny", "islandskej koruny", "islandských korún"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "talianska líra", PluralForms{"", "talianska líra", "", "talianske líry", "talianskej líry", "talianskych lír"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamajský dolár", PluralForms{"", "jamajský dolár", "", "jamajské doláre", "jamajského dolára", "jamajských dolárov"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordánsky dinár", PluralForms{"", "jordánsky dinár", "", "jordánske dináre", "jordánskeho dinára", "jordánskych dinárov"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "japonský jen", PluralForms{"", "japonský jen", "", "japonské jeny", "japonského jenu", "japonských jenov"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenský šiling", PluralForms{"", "kenský šiling", "", "kenské šilingy", "kenského šilingu", "kenských šilingov"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgizský som", PluralForms{"", "kirgizský som", "", "kirgizské somy", "kirgizského somu", "kirgizských somov"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodžský riel", PluralForms{"", "kambodžský riel", "", "kambodžské riely", "kambodžského rielu", "kambodžských rielov"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorský frank", PluralForms{"", "komorský frank", "", "komorské franky", "komorského franku", "komorských frankov"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "severokórejský won", PluralForms{"", "severokórejský won", "", "severokórejské wony", "severokórejskeho wonu", "severokórejských wonov"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "juhokórejský hwan (1953 – 1962)", PluralForms{"", "juhokórejský hwan (1953 – 1962)", "", "juhokórejské hwany (1953 – 1962)", "juhokórejského hwanu (1953 – 1962)", "juhokórejských hwanov (1953 – 1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "juhokórejský won (1945 – 1953)", PluralForms{"", "juhokórejský won (1945 – 1953)", "", "juhokórejské wony (1945 – 1953)", "juhokórejského wonu (1945 – 1953)", "juhokórejských wonov (1945 – 1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "juhokórejský won", PluralForms{"", "juhokórejský won", "", "juhokórejské wony", "juhokórejského wonu", "juhokórejských wonov"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuvajtský dinár", PluralForms{"", "kuvajtský dinár", "", "kuvajtské dináre", "kuvajtského dinára", "kuvajtských dinárov"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "kajmanský dolár", PluralForms{"", "kajmanský dolár", "", "kajmanské doláre", "kajmanského dolára", "kajmanských dolárov"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazašské tenge", PluralForms{"", "kazašské tenge", "", "kazašské tenge", "kazašského tenge", "kazašských tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoský kip", PluralForms{"", "laoský kip", "", "laoské kipy", "laoského kipu", "laoských kipov"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanonská libra", PluralForms{"", "libanonská libra", "", "libanonské libry", "libanonskej libry", "libanonských libier"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "srílanská rupia", PluralForms{"", "srílanská rupia", "", "srílanské rupie", "srílanskej rupie", "srílanských rupií"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "libérijský dolár", PluralForms{"", "libérijský dolár", "", "libérijské doláre", "libérijského dolára", "libérijských dolárov"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesothský loti", PluralForms{"", "lesothský loti", "", "lesothské loti", "lesothského loti", "lesothských loti"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litovský litas", PluralForms{"", "litovský litas", "", "litovské lity", "litovského litu", "litovských litov"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "litovský talonas", PluralForms{"", "litovský talonas", "", "litovské talony", "litovského talonu", "litovských talonov"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "luxemburský frank (konvertibilný)", PluralForms{"", "luxemburský frank (konvertibilný)", "", "luxemburské franky (konvertibilné)", "luxemburského franku (konvertibilného)", "luxemburských frankov (konvertibilných)"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "luxemburský frank", PluralForms{"", "luxemburský frank", "", "luxemburské franky", "luxemburského franku", "luxemburských frankov"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "luxemburský frank (finančný)", PluralForms{"", "luxemburský frank (finančný)", "", "luxemburské franky (finančné)", "luxemburského franku (finančného)", "luxemburských frankov (finančných)"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lotyšský lat", PluralForms{"", "lotyšský lat", "", "lotyšské laty", "lotyšského latu", "lotyšských latov"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "lotyšský rubeľ", PluralForms{"", "lotyšský rubeľ", "", "lotyšské ruble", "lotyšského rubľa", "lotyšských rubľov"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "líbyjský dinár", PluralForms{"", "líbyjský dinár", "", "líbyjské dináre", "líbyjského dinára", "líbyjských dinárov"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marocký dirham", PluralForms{"", "marocký dirham", "", "marocké dirhamy", "marockého dirhamu", "marockých dirhamov"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "marocký frank", PluralForms{"", "marocký frank", "", "marocké franky", "marockého franku", "marockých frankov"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "monacký frank", PluralForms{"", "monacký frank", "", "monacké franky", "monackého franku", "monackých frankov"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "moldavský kupón", PluralForms{"", "moldavský kupón", "", "moldavské kupóny", "moldavského kupónu", "moldavských kupónov"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavský lei", PluralForms{"", "moldavský lei", "", "moldavské lei", "moldavského lei", "moldavských lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "malgašský ariary", PluralForms{"", "malgašský ariary", "", "malgašské ariary", "malgašského ariary", "malgašských ariary"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "madagaskarský frank", PluralForms{"", "madagaskarský frank", "", "madagaskarské franky", "madagaskarského franku", "madagaskarských frankov"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "macedónsky denár", PluralForms{"", "macedónsky denár", "", "macedónske denáre", "macedónskeho denára", "macedónskych denárov"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "macedónsky denár (1992 – 1993)", PluralForms{"", "macedónsky denár (1992 – 1993)", "", "macedónske denáre (1992 – 1993)", "macedónskeho denára (1992 – 1993)", "macedónskych denárov (1992 – 1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "malijský frank", PluralForms{"", "malijský frank", "", "malijské franky", "malijského franku", "malijské franky"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "mjanmarský kyat", PluralForms{"", "mjanmarský kyat", "", "mjanmarské kyaty", "mjanmarského kyatu", "mjanmarských kyatov"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolský tugrik", PluralForms{"", "mongolský tugrik", "", "mongolské tugriky", "mongolského tugrika", "mongolských tugrikov"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "macajská pataca", PluralForms{"", "macajská pataca", "", "macajské patacy", "macajskej patacy", "macajských patác"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauritánska ukija (1973–2017)", PluralForms{"", "mauritánska ukija (1973–2017)", "", "mauritánske ukije (1973–2017)", "mauritánskej ukije (1973–2017)", "mauritánskych ukijí (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauritánska ouguiya", PluralForms{"", "mauritánska ouguiya", "", "mauritánske ouguiye", "mauritánskej ouguiye", "mauritánskych ouguiyí"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "maltská líra", PluralForms{"", "maltská líra", "", "maltské líry", "maltskej líry", "maltských lír"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "maltská libra", PluralForms{"", "maltská libra", "", "maltské libry", "maltskej libry", "maltských libier"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "maurícijská rupia", PluralForms{"", "maurícijská rupia", "", "maurícijské rupie", "maurícijskej rupie", "maurícijských rupií"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "maldivská rupia (1947 – 1981)", PluralForms{"", "maldivská rupia (1947 – 1981)", "", "maldivské rupie (1947 – 1981)", "maldivskej rupie (1947 – 1981)", "maldivských rupií (1947 – 1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldivská rupia", PluralForms{"", "maldivská rupia", "", "maldivské rupie", "maldivskej rupie", "maldivských rupií"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malawijská kwacha", PluralForms{"", "malawijská kwacha", "", "malawijské kwachy", "malawijskej kwachy", "malawijských kwách"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "mexické peso", PluralForms{"", "mexické peso", "", "mexické pesos", "mexického pesa", "mexických pesos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "mexické strieborné peso (1861 – 1992)", PluralForms{"", "mexické strieborné peso (1861 – 1992)", "", "mexické strieborné pesos (1861 – 1992)", "mexického strieborného pesa (1861 – 1992)", "mexických strieborných pesos (1861 – 1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "mexická investičná jednotka", PluralForms{"", "mexická investičná jednotka", "", "mexické investičné jednotky", "mexickej investičnej jednotky", "mexických investičných jednotiek"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malajzijský ringgit", PluralForms{"", "malajzijský ringgit", "", "malajzijské ringgity", "malajzijského ringgitu", "malajzijských ringgitov"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "mozambické escudo", PluralForms{"", "mozambické escudo", "", "mozambické escudá", "mozambického escuda", "mozambických escúd"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "mozambický metical (1980 – 2006)", PluralForms{"", "mozambický metical (1980–2006)", "", "mozambické meticaly (1980–2006)", "mozambického meticalu (1980–2006)", "mozambických meticalov (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mozambický metical", PluralForms{"", "mozambický metical", "", "mozambické meticaly", "mozambického meticalu", "mozambických meticalov"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namíbijský dolár", PluralForms{"", "namíbijský dolár", "", "namíbijské doláre", "namíbijského dolára", "namíbijských dolárov"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigérijská naira", PluralForms{"", "nigérijská naira", "", "nigérijské nairy", "nigérijskej nairy", "nigérijských nair"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nikaragujská córdoba (1988 – 1991)", PluralForms{"", "nikaragujská córdoba (1988–1991)", "", "nikaragujské córdoby (1988–1991)", "nikaragujskej córdoby (1988–1991)", "nikaragujských córdob (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nikaragujská córdoba", PluralForms{"", "nikaragujská córdoba", "", "nikaragujské córdoby", "nikaragujskej córdoby", "nikaragujských córdob"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "holandský gulden", PluralForms{"", "holandský gulden", "", "holandské guldeny", "holandského guldena", "holandských guldenov"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "nórska koruna", PluralForms{"", "nórska koruna", "", "nórske koruny", "nórskej koruny", "nórskych korún"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepálska rupia", PluralForms{"", "nepálska rupia", "", "nepálske rupie", "nepálskej rupie", "nepálskych rupií"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "novozélandský dolár", PluralForms{"", "novozélandský dolár", "", "novozélandské doláre", "novozélandského dolára", "novozélandských dolárov"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ománsky rial", PluralForms{"", "ománsky rial", "", "ománske rialy", "ománskeho rialu", "ománskych rialov"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamská balboa", PluralForms{"", "panamská balboa", "", "panamské balboy", "panamskej balboy", "panamských balboí"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "peruánsky inti", PluralForms{"", "peruánsky inti", "", "peruánske inti", "peruánskeho inti", "peruánskych inti"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruánsky sol", PluralForms{"", "peruánsky sol", "", "peruánske soly", "peruánskeho sola", "peruánskych solov"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "peruánsky sol (1863 – 1965)", PluralForms{"", "peruánsky sol (1863–1965)", "", "peruánske soly (1863–1965)", "peruánskeho sola (1863–1965)", "peruánskych solov (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papuánska kina", PluralForms{"", "papuánska kina", "", "papuánske kiny", "papuánskej kiny", "papuánskych kín"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filipínske peso", PluralForms{"", "filipínske peso", "", "filipínske pesos", "filipínskeho pesa", "filipínskych pesos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistanská rupia", PluralForms{"", "pakistanská rupia", "", "pakistanské rupie", "pakistanskej rupie", "pakistanských rupií"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "poľský zlotý", PluralForms{"", "poľský zlotý", "", "poľské zloté", "poľského zlotého", "poľských zlotých"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "poľský zlotý (1950 – 1995)", PluralForms{"", "poľský zlotý (1950 – 1995)", "", "poľské zloté (1950 – 1995)", "poľského zlotého (1950 – 1995)", "poľských zlotých (1950 – 1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "portugalské escudo", PluralForms{"", "portugalské escudo", "", "portugalské escudá", "portugalského escuda", "portugalských escúd"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paraguajské guaraní", PluralForms{"", "paraguajské guaraní", "", "paraguajské guaraní", "paraguajského guaraní", "paraguajských guaraní"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarský rial", PluralForms{"", "katarský rial", "", "katarské rialy", "katarského rialu", "katarských rialov"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "rodézsky dolár", PluralForms{"", "rodézsky dolár", "", "rodézske doláre", "rodézskeho dolára", "rodézskych dolárov"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "rumunský lei (1952 – 2006)", PluralForms{"", "rumunský lei (1952 – 2006)", "", "rumunské lei (1952 – 2006)", "rumunského lei (1952 – 2006)", "rumunských lei (1952 – 2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "rumunský lei", PluralForms{"", "rumunský lei", "", "rumunské lei", "rumunského lei", "rumunských lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "srbský dinár", PluralForms{"", "srbský dinár", "", "srbské dináre", "srbského dinára", "srbských dinárov"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruský rubeľ", PluralForms{"", "ruský rubeľ", "", "ruské ruble", "ruského rubľa", "ruských rubľov"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "ruský rubeľ (1991 – 1998)", PluralForms{"", "ruský rubeľ (1991 – 1998)", "", "ruské ruble (1991 – 1998)", "ruského rubľa (1991 – 1998)", "ruských rubľov (1991 – 1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "rwandský frank", PluralForms{"", "rwandský frank", "", "rwandské franky", "rwandského franku", "rwandských frankov"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudskoarabský rial", PluralForms{"", "saudskoarabský rial", "", "saudskoarabské rialy", "saudskoarabského rialu", "saudskoarabských rialov"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "šalamúnsky dolár", PluralForms{"", "šalamúnsky dolár", "", "šalamúnske doláre", "šalamúnskeho dolára", "šalamúnskych dolárov"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "seychelská rupia", PluralForms{"", "seychelská rupia", "", "seychelské rupie", "seychelskej rupie", "seychelských rupií"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "sudánsky dinár (1992 – 2007)", PluralForms{"", "sudánsky dinár (1992 – 2007)", "", "sudánske dináre (1992 – 2007)", "sudánskeho dinára (1992 – 2007)", "sudánskych dinárov (1992 – 2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudánska libra", PluralForms{"", "sudánska libra", "", "sudánske libry", "sudánskej libry", "sudánskych libier"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "sudánska libra (1957 – 1998)", PluralForms{"", "sudánska libra (1957 – 1998)", "", "sudánske libry (1957 – 1998)", "sudánskej libry (1957 – 1998)", "sudánskych libier (1957 – 1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "švédska koruna", PluralForms{"", "švédska koruna", "", "švédske koruny", "švédskej koruny", "švédskych korún"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapurský dolár", PluralForms{"", "singapurský dolár", "", "singapurské doláre", "singapurského dolára", "singapurských dolárov"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "svätohelenská libra", PluralForms{"", "svätohelenská libra", "", "svätohelenské libry", "svätohelenskej libry", "svätohelenských libier"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "slovinský toliar", PluralForms{"", "slovinský toliar", "", "slovinské toliare", "slovinského toliara", "slovinských toliarov"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "slovenská koruna", PluralForms{"", "slovenská koruna", "", "slovenské koruny", "slovenskej koruny", "slovenských korún"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierraleonský leone", PluralForms{"", "sierraleonský leone", "", "sierraleonské leone", "sierraleonského leone", "sierraleonských leone"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierraleonský leone (1964 – 2022)", PluralForms{"", "sierraleonský leone (1964 – 2022)", "", "sierraleonské leone (1964 – 2022)", "sierraleonského leone (1964 – 2022)", "sierraleonských leone (1964 – 2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somálsky šiling", PluralForms{"", "somálsky šiling", "", "somálske šilingy", "somálskeho šilingu", "somálskych šilingov"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamský dolár", PluralForms{"", "surinamský dolár", "", "surinamské doláre", "surinamského dolára", "surinamských dolárov"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "surinamský zlatý", PluralForms{"", "surinamský zlatý", "", "surinamské zlaté", "surinamského zlatého", "surinamských zlatých"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "juhosudánska libra", PluralForms{"", "juhosudánska libra", "", "juhosudánske libry", "juhosudánskej libry", "juhosudánskych libier"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "svätotomášska dobra (1977–2017)", PluralForms{"", "svätotomášska dobra (1977–2017)", "", "svätotomášske dobry (1977–2017)", "svätotomášskej dobry (1977–2017)", "svätotomášskych dobier (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "svätotomášska dobra", PluralForms{"", "svätotomášska dobra", "", "svätotomášske dobry", "svätotomášskej dobry", "svätotomášskych dobier"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "sovietsky rubeľ", PluralForms{"", "sovietsky rubeľ", "", "sovietske ruble", "sovietskeho rubľa", "sovietskych rubľov"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "salvádorský colón", PluralForms{"", "salvádorský colón", "", "salvádorské colóny", "salvádorského colóna", "salvádorských colónov"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "sýrska libra", PluralForms{"", "sýrska libra", "", "sýrske libry", "sýrskej libry", "sýrskych libier"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "svazijský lilangeni", PluralForms{"", "svazijský lilangeni", "", "svazijské lilangeni", "svazijského lilangeni", "svazijských lilangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "thajský baht", PluralForms{"", "thajský baht", "", "thajské bahty", "thajského bahtu", "thajských bahtov"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "tadžický rubeľ", PluralForms{"", "tadžický rubeľ", "", "tadžické ruble", "tadžického rubľa", "tadžických rubľov"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadžické somoni", PluralForms{"", "tadžické somoni", "", "tadžické somoni", "tadžického somoni", "tadžických somoni"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "turkménsky manat (1993 – 2009)", PluralForms{"", "turkménsky manat (1993 – 2009)", "", "turkménske manaty (1993 – 2009)", "turkménskeho manatu (1993 – 2009)", "turkménskych manatov (1993 – 2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkménsky manat", PluralForms{"", "turkménsky manat", "", "turkménske manaty", "turkménskeho manatu", "turkménskych manatov"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tuniský dinár", PluralForms{"", "tuniský dinár", "", "tuniské dináre", "tuniského dinára", "tuniských dinárov"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tongská paʻanga", PluralForms{"", "tongská pa’anga", "", "tongské pa’anga", "tongského pa’anga", "tongských pa’anga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "timorské escudo", PluralForms{"", "timorské escudo", "", "timorské escudá", "timorského escuda", "timorských escúd"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "turecká líra (1922 – 2005)", PluralForms{"", "turecká líra (1922 – 2005)", "", "turecké líry (1922 – 2005)", "tureckej líry (1922 – 2005)", "tureckých lír (1922 – 2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turecká líra", PluralForms{"", "turecká líra", "", "turecké líry", "tureckej líry", "tureckých lír"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trinidadsko-tobažský dolár", PluralForms{"", "trinidadsko-tobažský dolár", "", "trinidadsko-tobažské doláre", "trinidadsko-tobažského dolára", "trinidadsko-tobažských dolárov"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "nový taiwanský dolár", PluralForms{"", "nový taiwanský dolár", "", "nové taiwanské doláre", "nového taiwanského dolára", "nových taiwanských dolárov"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzánsky šiling", PluralForms{"", "tanzánsky šiling", "", "tanzánske šilingy", "tanzánskeho šilingu", "tanzánskych šilingov"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrajinská hrivna", PluralForms{"", "ukrajinská hrivna", "", "ukrajinské hrivny", "ukrajinskej hrivny", "ukrajinských hrivien"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ukrajinský karbovanec", PluralForms{"", "ukrajinský karbovanec", "", "ukrajinské karbovance", "ukrajinského karbovanca", "ukrajinských karbovancov"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "ugandský šiling (1966 – 1987)", PluralForms{"", "ugandský šiling (1966 – 1987)", "", "ugandské šilingy (1966 – 1987)", "ugandského šilingu (1966 – 1987)", "ugandských šilingov (1966 – 1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandský šiling", PluralForms{"", "ugandský šiling", "", "ugandské šilingy", "ugandského šilingu", "ugandských šilingov"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "americký dolár", PluralForms{"", "americký dolár", "", "americké doláre", "amerického dolára", "amerických dolárov"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "americký dolár (ďalší deň)", PluralForms{"", "americký dolár (ďalší deň)", "", "americké doláre (ďalší deň)", "amerického dolára (ďalší deň)", "amerických dolárov (ďalší deň)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "americký dolár (rovnaký deň)", PluralForms{"", "americký dolár (rovnaký deň)", "", "americké doláre (rovnaký deň)", "amerického dolára (rovnaký deň)", "amerických dolárov (rovnaký deň)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "uruguajské peso (v indexovaných jednotkách)", PluralForms{"", "uruguajské peso (v indexovaných jednotkách)", "", "uruguajské pesos (v indexovaných jednotkách)", "uruguajského pesa (v indexovaných jednotkách)", "uruguajských pesos (v indexovaných jednotkách)"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "uruguajské peso (1975 – 1993)", PluralForms{"", "uruguajské peso (1975 – 1993)", "", "uruguajské pesos (1975 – 1993)", "uruguajského pesa (1975 – 1993)", "uruguajských pesos (1975 – 1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "uruguajské peso", PluralForms{"", "uruguajské peso", "", "uruguajské pesos", "uruguajského pesa", "uruguajských pesos"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "uzbecký sum", PluralForms{"", "uzbecký sum", "", "uzbecké sumy", "uzbeckého sumu", "uzbeckých sumov"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "venezuelský bolívar (1871 – 2008)", PluralForms{"", "venezuelský bolívar (1871 – 2008)", "", "venezuelské bolívary (1871 – 2008)", "venezuelského bolívaru (1871 – 2008)", "venezuelských bolívarov (1871 – 2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelský bolívar (2008–2018)", PluralForms{"", "venezuelský bolívar (2008–2018)", "", "venezuelské bolívary (2008–2018)", "venezuelského bolívaru (2008–2018)", "venezuelských bolívarov (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelský bolívar", PluralForms{"", "venezuelský bolívar", "", "venezuelské bolívary", "venezuelského bolívaru", "venezuelských bolívarov"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "vietnamský dong", PluralForms{"", "vietnamský dong", "", "vietnamské dongy", "vietnamského dongu", "vietnamských dongov"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "vietnamský dong (1978 – 1985)", PluralForms{"", "vietnamský dong (1978 – 1985)", "", "vietnamské dongy (1978 – 1985)", "vietnamského dongu (1978 – 1985)", "vietnamských dongov (1978 – 1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatské vatu", PluralForms{"", "vanuatské vatu", "", "vanuatské vatu", "vanuatského vatu", "vanuatských vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samojská tala", PluralForms{"", "samojská tala", "", "samojské taly", "samojskej taly", "samojských tál"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "stredoafrický frank", PluralForms{"", "stredoafrický frank", "", "stredoafrické franky", "stredoafrického franku", "stredoafrických frankov"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "striebro", PluralForms{"", "trójska unca striebra", "", "trójske unce striebra", "trójskej unce striebra", "trójskych uncí striebra"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "zlato", PluralForms{"", "trójska unca zlata", "", "trójske unce zlata", "trójskej unce zlata", "trójskych uncí zlata"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "európska zmiešaná jednotka", PluralForms{"", "európska zmiešaná jednotka", "", "európske zmiešané jednotky", "európskej zmiešanej jednotky", "európskych zmiešaných jednotiek"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "európska peňažná jednotka", PluralForms{"", "európska peňažná jednotka", "", "európske peňažné jednotky", "európskej peňažnek jednotky", "európskych peňažných jednotiek"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "európska jednotka účtu 9 (XBC)", PluralForms{"", "európska jednotka účtu 9 (XBC)", "", "európske jednotky účtu 9 (XBC)", "európskej jednotky účtu 9 (XBC)", "európskych jednotiek účtu 9 (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "európska jednotka účtu 17 (XBC)", PluralForms{"", "európska jednotka účtu 17 (XBC)", "", "európske jednotky účtu 17 (XBC)", "európskej jednotky účtu 17 (XBC)", "európskych jednotiek účtu 17 (XBC)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "východokaribský dolár", PluralForms{"", "východokaribský dolár", "", "východokaribské doláre", "východokaribského dolára", "východokaribských dolárov"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg", "Cg", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "SDR", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "európska menová jednotka", PluralForms{"", "európska menová jednotka", "", "európske menové jednotky", "európskej menovej jednotky", "európskych menových jednotiek"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "francúzsky zlatý frank", PluralForms{"", "francúzsky zlatý frank", "", "francúzske zlaté franky", "francúzskeho zlatého franku", "francúzskych zlatých frankov"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "francúzsky UIC frank", PluralForms{"", "francúzsky UIC frank", "", "francúzske UIC franky", "francúzskeho UIC franku", "francúzskych UIC frankov"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "západoafrický frank", PluralForms{"", "západoafrický frank", "", "západoafrické franky", "západoafrického franku", "západoafrických frankov"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "paládium", PluralForms{"", "trójska unca paládia", "", "trójske unce paládia", "trójskej unce paládia", "trójskych uncí paládia"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP frank", PluralForms{"", "CFP frank", "", "CFP franky", "CFP franku", "CFP frankov"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "trójska unca platiny", "", "trójske unce platiny", "trójskej unce platiny", "trójskej unce platiny"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "fondy RINET", PluralForms{"", "jednotka fondov RINET", "", "jednotky fondov RINET", "jednotky fondov RINET", "jednotiek fondov RINET"}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "sucre", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "kód testovacej meny", PluralForms{"", "jednotka testovacej meny", "", "jednotky testovacej meny", "jednotky testovacej meny", "jednotiek testovacej meny"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "jednotka účtu ADB", PluralForms{"", "jednotka účtu ADB", "", "jednotky účtu ADB", "jednotky účtu ADB", "jednotiek účtu ADB"}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "neznáma mena", PluralForms{"", "(neznáma mena)", "", "(neznáma mena)", "(neznáma mena)", "(neznáma mena)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "jemenský dinár", PluralForms{"", "jemenský dinár", "", "jemenské dináre", "jemenského dinára", "jemenských dinárov"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenský rial", PluralForms{"", "jemenský rial", "", "jemenské rialy", "jemenského rialu", "jemenských rialov"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Juhoslávsky dinár [YUD]", PluralForms{"", "juhoslovanský dinár (1966 – 1990)", "", "juhoslovanské dináre (1966 – 1990)", "juhoslovanského dinára (1966 – 1990)", "juhoslovanských dinárov (1966 – 1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "juhoslovanský nový dinár (1994 – 2002)", PluralForms{"", "juhoslovanský nový dinár (1994 – 2002)", "", "juhoslovanské nové dináre (1994 – 2002)", "juhoslovanského nového dinára (1994 – 2002)", "juhoslovanských nových dinárov (1994 – 2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "juhoslovanský konvertibilný dinár (1990 – 1992)", PluralForms{"", "juhoslovanský konvertibilný dinár (1990 – 1992)", "", "juhoslovanské konvertibilné dináre (1990 – 1992)", "juhoslovanského konvertibilného dinára (1990 – 1992)", "juhoslovanských konvertibilných dinárov (1990 – 1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "juhoslovanský reformovaný dinár (1992 – 1993)", PluralForms{"", "juhoslovanský reformovaný dinár (1992 – 1993)", "", "juhoslovanské reformované dináre (1992 – 1993)", "juhoslovanského reformovaného dinára (1992 – 1993)", "juhoslovanských reformovaných dinárov (1992 – 1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "juhoafrický rand (finančný)", PluralForms{"", "juhoafrický rand (finančný)", "", "juhoafrické randy (finančné)", "juhoafrického randu (finančného)", "juhoafrických randov (finančných)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "juhoafrický rand", PluralForms{"", "juhoafrický rand", "", "juhoafrické randy", "juhoafrického randu", "juhoafrických randov"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "zambijská kwacha (1968 – 2012)", PluralForms{"", "zambijská kwacha (1968 – 2012)", "", "zambijské kwachy (1968 – 2012)", "zambijskej kwachy (1968 – 2012)", "zambijských kwách (1968 – 2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambijská kwacha", PluralForms{"", "zambijská kwacha", "", "zambijské kwachy", "zambijskej kwachy", "zambijských kwách"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zairský nový zaire (1993 – 1998)", PluralForms{"", "zairský nový zaire (1993 – 1998)", "", "zairské nové zairy (1993 – 1998)", "zairského nového zairu (1993 – 1998)", "zairských nových zairov (1993 – 1998)"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zairský zaire (1971 – 1993)", PluralForms{"", "zairský zaire (1971 – 1993)", "", "zairské zairy (1971 – 1993)", "zairského zairu (1971 – 1993)", "zairských zairov (1971 – 1993)"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "zimbabwiansky dolár (1980 – 2008)", PluralForms{"", "zimbabwiansky dolár (1980 – 2008)", "", "zimbabwianske doláre (1980 – 2008)", "zimbabwianskeho dolára (1980 – 2008)", "zimbabwianskych dolárov (1980 – 2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "zimbabwiansky dolár (2009)", PluralForms{"", "zimbabwiansky dolár (2009)", "", "zimbabwianske doláre (2009)", "zimbabwianskeho dolára (2009)", "zimbabwianskych dolárov (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "zimbabwiansky dolár (2008)", PluralForms{"", "zimbabwiansky dolár (2008)", "", "zimbabwianske doláre (2008)", "zimbabwianskeho dolára (2008)", "zimbabwianskych dolárov (2008)"}},
 	},
 }
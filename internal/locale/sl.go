@@ -6,288 +6,341 @@ var sl = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"e", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{4, 2, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{5, 3, map[string]string{"one": "0\u00a0tis.", "other": "0\u00a0tis."}},
+				{6, 1, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{7, 2, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{8, 3, map[string]string{"one": "0\u00a0mio.", "other": "0\u00a0mio."}},
+				{9, 1, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mrd.", "other": "0\u00a0mrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 tisoč", "one": "0 tisoč", "other": "0 tisoč", "two": "0 tisoč"}},
+				{4, 2, map[string]string{"few": "0 tisoč", "one": "0 tisoč", "other": "0 tisoč", "two": "0 tisoč"}},
+				{5, 3, map[string]string{"few": "0 tisoč", "one": "0 tisoč", "other": "0 tisoč", "two": "0 tisoč"}},
+				{6, 1, map[string]string{"few": "0 milijoni", "one": "0 milijon", "other": "0 milijonov", "two": "0 milijona"}},
+				{7, 2, map[string]string{"few": "0 milijona", "one": "0 milijon", "other": "0 milijonov", "two": "0 milijona"}},
+				{8, 3, map[string]string{"few": "0 milijoni", "one": "0 milijon", "other": "0 milijonov", "two": "0 milijona"}},
+				{9, 1, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijard", "two": "0 milijardi"}},
+				{10, 2, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijard", "two": "0 milijardi"}},
+				{11, 3, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijard", "two": "0 milijardi"}},
+				{12, 1, map[string]string{"few": "0 bilijoni", "one": "0 bilijon", "other": "0 bilijonov", "two": "0 bilijona"}},
+				{13, 2, map[string]string{"few": "0 bilijoni", "one": "0 bilijon", "other": "0 bilijonov", "two": "0 bilijona"}},
+				{14, 3, map[string]string{"few": "0 bilijoni", "one": "0 bilijon", "other": "0 bilijonov", "two": "0 bilijona"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤", "two": "0\u00a0tis.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤", "two": "0\u00a0tis.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0tis.\u00a0¤", "one": "0\u00a0tis.\u00a0¤", "other": "0\u00a0tis.\u00a0¤", "two": "0\u00a0tis.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mio.\u00a0¤", "one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤", "two": "0\u00a0mio.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mio.\u00a0¤", "one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤", "two": "0\u00a0mio.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mio.\u00a0¤", "one": "0\u00a0mio.\u00a0¤", "other": "0\u00a0mio.\u00a0¤", "two": "0\u00a0mio.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mrd.\u00a0¤", "one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤", "two": "0\u00a0mrd.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mrd.\u00a0¤", "one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤", "two": "0\u00a0mrd.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mrd.\u00a0¤", "one": "0\u00a0mrd.\u00a0¤", "other": "0\u00a0mrd.\u00a0¤", "two": "0\u00a0mrd.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤", "two": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤", "two": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤", "two": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~ {0}", "≥ {0}", "≤ {0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorska peseta", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham Združenih arabskih emiratov", PluralForms{"", "dirham Združenih arabskih emiratov", "dirhama Združenih arabskih emiratov", "dirhami Združenih arabskih emiratov", "", "dirhamov Združenih arabskih emiratov"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "stari afganistanski afgani (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afgani", PluralForms{"", "afgani", "afganija", "afganiji", "", "afganijev"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albanski lek", PluralForms{"", "albanski lek", "albanska leka", "albanski leki", "", "albanskih lekov"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armenski dram", PluralForms{"", "armenski dram", "armenska drama", "armenski drami", "", "armenskih dramov"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "nizozemsko-antilski gulden", PluralForms{"", "nizozemsko-antilski gulden", "nizozemsko-antilska guldna", "nizozemsko-antilski guldni", "", "nizozemsko-antilskih guldnov"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolska kvanza", PluralForms{"", "angolska kvanza", "angolski kvanzi", "angolske kvanze", "", "angolskih kvanz"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "stara angolska kvanza (1977–1990)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolska nova kvanza (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "konvertibilna angolska kvanza (1995–1999)", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentinski avstral", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentinski peso (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinski peso", PluralForms{"", "argentinski peso", "argentinska pesa", "argentinski pesi", "", "argentinskih pesov"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "avstrijski šiling", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "avstralski dolar", PluralForms{"", "avstralski dolar", "avstralska dolarja", "avstralski dolarji", "", "avstralskih dolarjev"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubski florin", PluralForms{"", "arubski florin", "arubska florina", "arubski florini", "", "arubskih florinov"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "stari azerbajdžanski manat (1993–2006)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "azerbajdžanski manat", PluralForms{"", "azerbajdžanski manat", "azerbajdžanska manata", "azerbajdžanski manati", "", "azerbajdžanskih manatov"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosansko-hercegovski dinar", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosansko-hercegovska konvertibilna marka", PluralForms{"", "bosansko-hercegovska konvertibilna marka", "bosansko-hercegovski konvertibilni marki", "bosansko-hercegovske konvertibilne marke", "", "bosansko-hercegovskih konvertibilnih mark"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoški dolar", PluralForms{"", "barbadoški dolar", "barbadoška dolarja", "barbadoški dolarji", "", "barbadoških dolarjev"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladeška taka", PluralForms{"", "bangladeška taka", "bangladeški taki", "bangladeške take", "", "bangladeških tak"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgijski konvertibilni frank", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgijski frank", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgijski finančni frank", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "stari bolgarski lev", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bolgarski lev", PluralForms{"", "bolgarski lev", "bolgarska leva", "bolgarski levi", "", "bolgarskih levov"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrajnski dinar", PluralForms{"", "bahrajnski dinar", "bahrajnska dinarja", "bahrajnski dinarji", "", "bahrajnskih dinarjev"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundski frank", PluralForms{"", "burundski frank", "burundska franka", "burundski franki", "", "burundskih frankov"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudski dolar", PluralForms{"", "bermudski dolar", "bermudska dolarja", "bermudski dolarji", "", "bermudskih dolarjev"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "brunejski dolar", PluralForms{"", "brunejski dolar", "brunejska dolarja", "brunejski dolarji", "", "brunejskih dolarjev"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolivijski boliviano", PluralForms{"", "bolivijski boliviano", "bolivijska boliviana", "bolivijski boliviani", "", "bolivijskih bolivianov"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "bolivijski peso", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "bolivijski mvdol", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brazilski novi kruzeiro (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brazilski kruzado", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "stari brazilski kruzeiro (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "brazilski real", PluralForms{"", "brazilski real", "brazilska reala", "brazilski reali", "", "brazilskih realov"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "novi brazilski kruzado", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "brazilski kruzeiro", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamski dolar", PluralForms{"", "bahamski dolar", "bahamska dolarja", "bahamski dolarji", "", "bahamskih dolarjev"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "butanski ngultrum", PluralForms{"", "butanski ngultrum", "butanska ngultruma", "butanski ngultrumi", "", "butanskih ngultrumov"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "burmanski kjat", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "bocvanska pula", PluralForms{"", "bocvanska pula", "bocvanski puli", "bocvanske pule", "", "bocvanskih pul"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "beloruski novi rubelj (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "beloruski rubelj", PluralForms{"", "beloruski rubelj", "beloruska rublja", "beloruski rublji", "", "beloruskih rubljev"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "beloruski rubelj (2000–2016)", PluralForms{"", "beloruski rubelj (2000–2016)", "beloruska rublja (2000–2016)", "beloruski rublji (2000–2016)", "", "beloruskih rubljev (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "belizejski dolar", PluralForms{"", "belizejski dolar", "belizejska dolarja", "belizejski dolarji", "", "belizejskih dolarjev"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "kanadski dolar", PluralForms{"", "kanadski dolar", "kanadska dolarja", "kanadski dolarji", "", "kanadskih dolarjev"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongovski frank", PluralForms{"", "kongovski frank", "kongovska franka", "kongovski franki", "", "kongovskih frankov"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "evro WIR", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "švicarski frank", PluralForms{"", "švicarski frank", "švicarska franka", "švicarski franki", "", "švicarskih frankov"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "frank WIR", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "čilski unidades de fomento", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "čilski peso", PluralForms{"", "čilski peso", "čilska pesa", "čilski pesi", "", "čilskih pesov"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kitajski juan (offshore)", PluralForms{"", "kitajski juan renminbi (offshore)", "kitajska juana renminbi (offshore)", "kitajski juani renminbi (offshore)", "", "kitajskih juanov renminbi (offshore)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "kitajski juan", PluralForms{"", "kitajski juan renminbi", "kitajska juana renmibi", "kitajski juani renminbi", "", "kitajskih juanov renminbi"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kolumbijski peso", PluralForms{"", "kolumbijski peso", "kolumbijska pesa", "kolumbijski pesi", "", "kolumbijskih pesov"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "kolumbijska enota realne vrednosti", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kostariški kolon", PluralForms{"", "kostariški kolon", "kostariška kolona", "kostariški koloni", "", "kostariških kolonov"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "stari srbski dinar", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "češkoslovaška krona", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubanski konvertibilni peso", PluralForms{"", "kubanski konvertibilni peso", "kubanska konvertibilna pesa", "kubanski konvertibilni pesi", "", "kubanskih konvertibilnih pesov"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubanski peso", PluralForms{"", "kubanski peso", "kubanska pesa", "kubanski pesi", "", "kubanskih pesov"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "zelenortski eskudo", PluralForms{"", "zelenortski eskudo", "zelenortska eskuda", "zelenortski eskudi", "", "zelenortskih eskudov"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "ciprski funt", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "češka krona", PluralForms{"", "češka krona", "češki kroni", "češke krone", "", "čeških kron"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "vzhodnonemška marka", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "nemška marka", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "džibutski frank", PluralForms{"", "džibutski frank", "džibutska franka", "džibutski franki", "", "džibutskih frankov"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "danska krona", PluralForms{"", "danska krona", "danski kroni", "danske krone", "", "danskih kron"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikanski peso", PluralForms{"", "dominikanski peso", "dominikanska pesa", "dominikanski pesi", "", "dominikanskih pesov"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "alžirski dinar", PluralForms{"", "alžirski dinar", "alžirska dinarja", "alžirski dinarji", "", "alžirskih dinarjev"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ekvadorski sukre", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ekvadorska enota realne vrednosti (UVC)", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "estonska krona", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egiptovski funt", PluralForms{"", "egiptovski funt", "egiptovska funta", "egiptovski funti", "", "egiptovskih funtov"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritrejska nakfa", PluralForms{"", "eritrejska nakfa", "eritrejski nakfi", "eritrejske nakfe", "", "eritrejskih nakf"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "španska pezeta (račun A)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "španska pezeta (račun B)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "španska pezeta", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopski bir", PluralForms{"", "etiopski bir", "etiopska bira", "etiopski biri", "", "etiopskih birov"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "evro", PluralForms{"", "evro", "evra", "evri", "", "evrov"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "finska marka", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fidžijski dolar", PluralForms{"", "fidžijski dolar", "fidžijska dolarja", "fidžijski dolarji", "", "fidžijskih dolarjev"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "falklandski funt", PluralForms{"", "falklandski funt", "falklandska funta", "falklandski funti", "", "falklandskih funtov"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "francoski frank", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "britanski funt", PluralForms{"", "britanski funt", "britanska funta", "britanski funti", "", "britanskih funtov"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "gruzijski bon lari", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "gruzijski lari", PluralForms{"", "gruzijski lari", "gruzijska larija", "gruzijski lariji", "", "gruzijskih larijev"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "stari ganski cedi (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ganski cedi", PluralForms{"", "ganski cedi", "ganska ceda", "ganski cedi", "", "ganskih cedov"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltarski funt", PluralForms{"", "gibraltarski funt", "gibraltarska funta", "gibraltarski funti", "", "gibraltarskih funtov"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambijski dalasi", PluralForms{"", "gambijski dalasi", "gambijska dalasa", "gambijski dalasi", "", "gambijskih dalasov"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "gvinejski frank", PluralForms{"", "gvinejski frank", "gvinejska franka", "gvinejski franki", "", "gvinejskih frankov"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "gvinejski sili", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekwele Ekvatorialne Gvineje", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "grška drahma", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "gvatemalski kecal", PluralForms{"", "gvatemalski kecal", "gvatemalska kecala", "gvatemalski kecali", "", "gvatemalskih kecalov"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "eskudo Portugalske Gvineje", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "peso Gvineje Bissau", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "gvajanski dolar", PluralForms{"", "gvajanski dolar", "gvajanska dolarja", "gvajanski dolarji", "", "gvajanskih dolarjev"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "hongkonški dolar", PluralForms{"", "hongkonški dolar", "hongkonška dolarja", "hongkonški dolarji", "", "hongkonških dolarjev"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduraška lempira", PluralForms{"", "honduraška lempira", "honduraški lempiri", "honduraške lempire", "", "honduraških lempir"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "hrvaški dinar", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "hrvaška kuna", PluralForms{"", "hrvaška kuna", "hrvaški kuni", "hrvaške kune", "", "hrvaških kun"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitijski gurd", PluralForms{"", "haitijski gurd", "haitijska gurda", "haitijski gurdi", "", "haitijskih gurdov"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "madžarski forint", PluralForms{"", "madžarski forint", "madžarska forinta", "madžarski forinti", "", "madžarskih forintov"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonezijska rupija", PluralForms{"", "indonezijska rupija", "indonezijski rupiji", "indonezijske rupije", "", "indonezijskih rupij"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "irski funt", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "izraelski funt", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "izraelski šekel", PluralForms{"", "izraelski šekel", "izraelska šekla", "izraelski šekli", "", "izraelskih šeklov"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "indijska rupija", PluralForms{"", "indijska rupija", "indijski rupiji", "indijske rupije", "", "indijskih rupij"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "iraški dinar", PluralForms{"", "iraški dinar", "iraška dinarja", "iraški dinarji", "", "iraških dinarjev"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iranski rial", PluralForms{"", "iranski rial", "iranska riala", "iranski riali", "", "iranskih rialov"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandska krona", PluralForms{"", "islandska krona", "islandski kroni", "islandske krone", "", "islandskih kron"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "italijanska lira", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamajški dolar", PluralForms{"", "jamajški dolar", "jamajška dolarja", "jamajški dolarji", "", "jamajških dolarjev"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordanski dinar", PluralForms{"", "jordanski dinar", "jordanska dinarja", "jordanski dinarji", "", "jordanskih dinarjev"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "japonski jen", PluralForms{"", "japonski jen", "japonska jena", "japonski jeni", "", "japonskih jenov"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenijski šiling", PluralForms{"", "kenijski šiling", "kenijska šilinga", "kenijski šilingi", "", "kenijskih šilingov"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgiški som", PluralForms{"", "kirgiški som", "kirgiška soma", "kirgiški somi", "", "kirgiških somov"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kamboški riel", PluralForms{"", "kamboški riel", "kamboška riela", "kamboški rieli", "", "kamboških rielov"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorski frank", PluralForms{"", "komorski frank", "komorska franka", "komorski franki", "", "komorskih frankov"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "severnokorejski von", PluralForms{"", "severnokorejski von", "severnokorejska vona", "severnokorejski voni", "", "severnokorejskih vonov"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "južnokorejski von", PluralForms{"", "južnokorejski von", "južnokorejska vona", "južnokorejski voni", "", "južnokorejskih vonov"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuvajtski dinar", PluralForms{"", "kuvajtski dinar", "kuvajtska dinarja", "kuvajtski dinarji", "", "kuvajtskih dinarjev"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "kajmanski dolar", PluralForms{"", "kajmanski dolar", "kajmanska dolarja", "kajmanski dolarji", "", "kajmanskih dolarjev"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazahstanski tenge", PluralForms{"", "kazahstanski tenge", "kazahstanska tenga", "kazahstanski tenge", "", "kazahstanskih tengov"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoški kip", PluralForms{"", "laoški kip", "laoška kipa", "laoški kipi", "", "laoških kipov"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanonski funt", PluralForms{"", "libanonski funt", "libanonska funta", "libanonski funti", "", "libanonskih funtov"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "šrilanška rupija", PluralForms{"", "šrilanška rupija", "šrilanški rupiji", "šrilanške rupije", "", "šrilanških rupij"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberijski dolar", PluralForms{"", "liberijski dolar", "liberijska dolarja", "liberijski dolarji", "", "liberijskih dolarjev"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesotski loti", PluralForms{"", "lesotski loti", "lesotska lota", "lesotski loti", "", "lesotskih lotov"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litovski litas", PluralForms{"", "", "", "", "", ""}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "litvanski litas", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "luksemburški konvertibilni frank", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "luksemburški frank", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "luksemburški finančni frank", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "latvijski lats", PluralForms{"", "", "", "", "", ""}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "latvijski rubelj", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libijski dinar", PluralForms{"", "libijski dinar", "libijska dinarja", "libijski dinarji", "", "libijskih dinarjev"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "maroški dirham", PluralForms{"", "maroški dirham", "maroška dirhama", "maroški dirhami", "", "maroških dirhamov"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "maroški frank", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavski lev", PluralForms{"", "moldavski lev", "moldavska leva", "moldavski levi", "", "moldavskih levov"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "madagaskarski ariari", PluralForms{"", "madagaskarski ariari", "madagaskarska ariarija", "madagaskarski ariariji", "", "madagaskarskih ariarijev"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "malgaški frank", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonski denar", PluralForms{"", "makedonski denar", "makedonska denarja", "makedonski denarji", "", "makedonskih denarjev"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "malijski frank", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "mjanmarski kjat", PluralForms{"", "mjanmarski kjat", "mjanmarska kjata", "mjanmarski kjati", "", "mjanmarskih kjatov"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolski tugrik", PluralForms{"", "mongolski tugrik", "mongolska tugrika", "mongolski tugriki", "", "mongolskih tugrikov"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "macajska pataka", PluralForms{"", "macajska pataka", "macajski pataki", "macajske patake", "", "macajskih patak"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mavretanska uguija (1973–2017)", PluralForms{"", "mavretanska uguija (1973–2017)", "mavretanski uguiji (1973–2017)", "mavretanske uguije (1973–2017)", "", "mavretanskih uguij (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mavretanska uguija", PluralForms{"", "mavretanska uguija", "mavretanski uguiji", "mavretanske uguije", "", "mavretanskih uguij"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "malteška lira", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "malteški funt", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mavricijska rupija", PluralForms{"", "mavricijska rupija", "mavricijski rupiji", "mavricijske rupije", "", "mavricijskih rupij"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldivska rufija", PluralForms{"", "maldivska rufija", "maldivski rufiji", "maldivske rufije", "", "maldivskih rufij"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malavijska kvača", PluralForms{"", "malavijska kvača", "malavijski kvači", "malavijske kvače", "", "malavijskih kvač"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "mehiški peso", PluralForms{"", "mehiški peso", "mehiška pesa", "mehiški pesi", "", "mehiških pesov"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "mehiški srebrni peso (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "mehiška inverzna enota (UDI)", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malezijski ringit", PluralForms{"", "malezijski ringit", "malezijska ringita", "malezijski ringiti", "", "malezijskih ringitov"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "mozambiški eskudo", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "stari mozambiški metikal", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mozambiški metikal", PluralForms{"", "mozambiški metikal", "mozambiška metikala", "mozambiški metikali", "", "mozambiških metikalov"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibijski dolar", PluralForms{"", "namibijski dolar", "namibijska dolarja", "namibijski dolarji", "", "namibijskih dolarjev"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigerijska naira", PluralForms{"", "nigerijska naira", "nigerijski nairi", "nigerijske naire", "", "nigerijskih nair"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nikaraška kordova", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nikaragovska kordova", PluralForms{"", "nikaragovska kordova", "nikaragovski kordovi", "nikaragovske kordove", "", "nikaragovskih kordov"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "nizozemski gulden", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "norveška krona", PluralForms{"", "norveška krona", "norveški kroni", "norveške krone", "", "norveških kron"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalska rupija", PluralForms{"", "nepalska rupija", "nepalski rupiji", "nepalske rupije", "", "nepalskih rupij"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "novozelandski dolar", PluralForms{"", "novozelandski dolar", "novozelandska dolarja", "novozelandski dolarji", "", "novozelandskih dolarjev"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omanski rial", PluralForms{"", "omanski rial", "omanska riala", "omanski riali", "", "omanskih rialov"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamska balboa", PluralForms{"", "panamska balboa", "panamski balboi", "panamske balboe", "", "panamskih balbov"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "perujski inti", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "perujski sol", PluralForms{"", "perujski sol", "perujska sola", "perujski soli", "", "perujskih solov"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "perujski sol (1863–1965)", PluralForms{"", "perujski sol (1863–1965)", "perujska sola (1863–1965)", "perujski soli (1863–1965)", "", "perujskih solov (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "kina Papue Nove Gvineje", PluralForms{"", "kina Papue Nove Gvineje", "kini Papue Nove Gvineje", "kine Papue Nove Gvineje", "", "kin Papue Nove Gvineje"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filipinski peso", PluralForms{"", "filipinski peso", "filipinska pesa", "filipinski pesi", "", "filipinskih pesov"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistanska rupija", PluralForms{"", "pakistanska rupija", "pakistanski rupiji", "pakistanske rupije", "", "pakistanskih rupij"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "poljski zlot", PluralForms{"", "poljski zlot", "poljska zlota", "poljski zloti", "", "poljskih zlotov"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "stari poljski zlot (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "portugalski eskudo", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paragvajski gvarani", PluralForms{"", "paragvajski gvarani", "paragvajska gvaranija", "paragvajski gvarani", "", "paragvajskih gvaranijev"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarski rial", PluralForms{"", "katarski rial", "katarska riala", "katarski riali", "", "katarskih rialov"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "rodezijski dolar", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "stari romunski leu", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "romunski lev", PluralForms{"", "romunski lev", "romunska leva", "romunski levi", "", "romunskih levov"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "srbski dinar", PluralForms{"", "srbski dinar", "srbska dinarja", "srbski dinarji", "", "srbskih dinarjev"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruski rubelj", PluralForms{"", "ruski rubelj", "ruska rublja", "ruski rublji", "", "ruskih rubljev"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "ruski rubelj (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ruandski frank", PluralForms{"", "ruandski frank", "ruandska franka", "ruandski franki", "", "ruandskih frankov"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudski rial", PluralForms{"", "saudski rial", "saudska riala", "saudski riali", "", "saudskih rialov"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "solomonski dolar", PluralForms{"", "solomonski dolar", "solomonska dolarja", "solomonski dolarji", "", "solomonskih dolarjev"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "sejšelska rupija", PluralForms{"", "sejšelska rupija", "sejšelski rupiji", "sejšelske rupije", "", "sejšelskih rupij"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "stari sudanski dinar", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudanski funt", PluralForms{"", "sudanski funt", "sudanska funta", "sudanski funti", "", "sudanskih funtov"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "stari sudanski funt", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "švedska krona", PluralForms{"", "švedska krona", "švedski kroni", "švedske krone", "", "švedskih kron"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapurski dolar", PluralForms{"", "singapurski dolar", "singapurska dolarja", "singapurski dolarji", "", "singapurskih dolarjev"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "funt Sv. Helene", PluralForms{"", "funt Sv. Helene", "funta Sv. Helene", "funti Sv. Helene", "", "funtov Sv. Helene"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "slovenski tolar", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "slovaška krona", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierraleonski leone", PluralForms{"", "sierraleonski leone", "sierraleonska leona", "sierraleonski leoni", "", "sierraleonskih leonov"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierraleonski leone (1964—2022)", PluralForms{"", "sierraleonski leone (1964—2022)", "sierraleonska leona (1964—2022)", "sierraleonski leoni (1964—2022)", "", "sierraleonskih leonov (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somalski šiling", PluralForms{"", "somalski šiling", "somalska šilinga", "somalski šilingi", "", "somalskih šilingov"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamski dolar", PluralForms{"", "surinamski dolar", "surinamska dolarja", "surinamski dolarji", "", "surinamskih dolarjev"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "surinamski gulden", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "južnosudanski funt", PluralForms{"", "južnosudanski funt", "južnosudanska funta", "južnosudanski funti", "", "južnosudanskih funtov"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "saotomejska dobra (1977–2017)", PluralForms{"", "saotomejska dobra (1977–2017)", "saotomejski dobri (1977–2017)", "saotomejske dobre (1977–2017)", "", "saotomejskih dober (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "dobra Svetega Tomaža in Princa", PluralForms{"", "dobra Svetega Tomaža in Princa", "dobri Svetega Tomaža in Princa", "dobre Svetega Tomaža in Princa", "", "dober Svetega Tomaža in Princa"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "sovjetski rubelj", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "salvadorski kolon", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "sirijski funt", PluralForms{"", "sirijski funt", "sirijska funta", "sirijski funti", "", "sirijskih funtov"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "esvatinski lilangeni", PluralForms{"", "esvatinski lilangeni", "esvatinska lilangenija", "esvatinski lilangeni", "", "esvatinskih lilangenijev"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "tajski baht", PluralForms{"", "tajski baht", "tajska bahta", "tajski bahti", "", "tajskih bahtov"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "tadžikistanski rubelj", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadžikistanski somoni", PluralForms{"", "tadžikistanski somoni", "tadžikistanska somona", "tadžikistanski somoni", "", "tadžikistanskih somonov"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "turkmenski manat", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmenistanski manat", PluralForms{"", "turkmenistanski manat", "turkmenistanska manata", "turkmenistanski manati", "", "turkmenistanskih manatov"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tunizijski dinar", PluralForms{"", "tunizijski dinar", "tunizijska dinarja", "tunizijski dinarji", "", "tunizijskih dinarjev"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tongovska paanga", PluralForms{"", "tongovska paanga", "tongovski paangi", "tongovske paange", "", "tongovskih paang"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "timorski eskudo", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "stara turška lira", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turška lira", PluralForms{"", "turška lira", "turški liri", "turške lire", "", "turških lir"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "dolar Trinidada in Tobaga", PluralForms{"", "dolar Trinidada in Tobaga", "dolarja Trinidada in Tobaga", "dolarji Trinidada in Tobaga", "", "dolarjev Trinidada in Tobaga"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "novi tajvanski dolar", PluralForms{"", "novi tajvanski dolar", "nova tajvanska dolarja", "novi tajvanski dolarji", "", "novih tajvanskih dolarjev"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanijski šiling", PluralForms{"", "tanzanijski šiling", "tanzanijska šilinga", "tanzanijski šilingi", "", "tanzanijskih šilingov"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrajinska grivna", PluralForms{"", "ukrajinska grivna", "ukrajinski grivni", "ukrajinske grivne", "", "ukrajinskih grivn"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ukrajinski karbovanci", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "stari ugandski šiling (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandski šiling", PluralForms{"", "ugandski šiling", "ugandska šilinga", "ugandski šilingi", "", "ugandskih šilingov"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "ameriški dolar", PluralForms{"", "ameriški dolar", "ameriška dolarja", "ameriški dolarji", "", "ameriških dolarjev"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "ameriški dolar, naslednji dan", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "ameriški dolar, isti dan", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "stari urugvajski peso (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "urugvajski peso", PluralForms{"", "urugvajski peso", "urugvajska pesa", "urugvajski pesi", "", "urugvajskih pesov"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "uzbeški sum", PluralForms{"", "uzbeški sum", "uzbeška suma", "uzbeški sumi", "", "uzbeških sumov"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "venezuelski bolivar (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelski bolivar (2008–2018)", PluralForms{"", "venezuelski bolivar (2008–2018)", "venezuelska bolivarja (2008–2018)", "venezuelski bolivarji (2008–2018)", "", "venezuelskih bolivarjev (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelski bolivar", PluralForms{"", "venezuelski bolivar", "venezuelska bolivarja", "venezuelski bolivarji", "", "venezuelskih bolivarjev"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "vientnamski dong", PluralForms{"", "vientnamski dong", "vietnamska donga", "vietnamski dongi", "", "vietnamskih dongov"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatujski vatu", PluralForms{"", "vanuatujski vatu", "vanuatujska vatuja", "vanuatujski vati", "", "vanuatujskih vatujev"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samoanska tala", PluralForms{"", "samoanska tala", "samoanski tali", "samoanske tale", "", "samoanskih tal"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "srednjeafriški frank CFA", PluralForms{"", "srednjeafriški frank CFA", "srednjeafriška franka CFA", "srednjeafriški franki CFA", "", "srednjeafriških frankov CFA"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "srebro", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "zlato", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "evropska sestavljena enota", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "evropska monetarna enota", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "evropska obračunska enota (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "evropska obračunska enota (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "vzhodnokaribski dolar", PluralForms{"", "vzhodnokaribski dolar", "vzhodnokaribska dolarja", "vzhodnokaribski dolarji", "", "vzhodnokaribskih dolarjev"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "posebne pravice črpanja", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "evropska denarna enota", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "zlati frank", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "frank UIC", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "zahodnoafriški frank CFA", PluralForms{"", "zahodnoafriški frank CFA", "zahodnoafriška franka CFA", "zahodnoafriški franki CFA", "", "zahodnoafriških frankov CFA"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "paladij", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "frank CFP", PluralForms{"", "frank CFP", "franka CFP", "franki CFP", "", "frankov CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "koda za potrebe testiranja", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "neznana valuta", PluralForms{"", "(neznana enota valute)", "(neznana valuta)", "(neznana valuta)", "", "(neznana valuta)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "jemenski dinar", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenski rial", PluralForms{"", "jemenski rial", "jemenska riala", "jemenski riali", "", "jemenskih rialov"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "stari jugoslovanski dinar", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "novi jugoslovanski dinar", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "jugoslovanski konvertibilni dinar", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "južnoafriški finančni rand", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "južnoafriški rand", PluralForms{"", "južnoafriški rand", "južnoafriška randa", "južnoafriški randi", "", "južnoafriških randov"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "zambijska kvača (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambijska kvača", PluralForms{"", "zambijska kvača", "zambijski kvači", "zambijske kvače", "", "zambijskih kvač"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zairski novi zaire", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zairski zaire", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "zimbabvejski dolar", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "zimbabvejski dolar (2009)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
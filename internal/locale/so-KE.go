@@ -6,184 +6,237 @@ var soKE = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"one": "0B", "other": "0B"}},
+				{10, 2, map[string]string{"one": "0B", "other": "0B"}},
+				{11, 3, map[string]string{"one": "0B", "other": "0B"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 kun", "other": "0 Kun"}},
+				{4, 2, map[string]string{"one": "0 Kun", "other": "0 Kun"}},
+				{5, 3, map[string]string{"one": "0 Kun", "other": "0 Kun"}},
+				{6, 1, map[string]string{"one": "0 Milyan", "other": "0 Milyan"}},
+				{7, 2, map[string]string{"one": "0 Milyan", "other": "0 Milyan"}},
+				{8, 3, map[string]string{"one": "0 Milyan", "other": "0 Milyan"}},
+				{9, 1, map[string]string{"one": "0 Bilyan", "other": "0 Bilyan"}},
+				{10, 2, map[string]string{"one": "0 Bilyan", "other": "0 Bilyan"}},
+				{11, 3, map[string]string{"one": "0 Bilyan", "other": "0 Bilyan"}},
+				{12, 1, map[string]string{"one": "0 Tirilyan", "other": "0 Tirilyan"}},
+				{13, 2, map[string]string{"one": "0 Tirilyan", "other": "0 Tirilyan"}},
+				{14, 3, map[string]string{"one": "0 Tirilyan", "other": "0 Tirilyan"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{4, 2, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{5, 3, map[string]string{"one": "¤0K", "other": "¤0K"}},
+				{6, 1, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{7, 2, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{8, 3, map[string]string{"one": "¤0M", "other": "¤0M"}},
+				{9, 1, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{10, 2, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{11, 3, map[string]string{"one": "¤0B", "other": "¤0B"}},
+				{12, 1, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{13, 2, map[string]string{"one": "¤0T", "other": "¤0T"}},
+				{14, 3, map[string]string{"one": "¤0T", "other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "{0} {1}", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "DBB", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "Ksh", "Ksh"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "S", "S"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirhamka Isutaga Imaaraatka Carabta", PluralForms{"", "dirhamka Isutaga Imaaraatka Carabta", "", "", "", "dirhamka Isutaga Imaaraatka Carabta"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afgan Afgani", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lekta Albaniya", PluralForms{"", "lekta Abaniya", "", "", "", "lekta Albaniya"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Daraamka Armeniya", PluralForms{"", "daraamka Armeniya", "", "", "", "daraamka Armeniya"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Galdarka Nadarlaan Antiliyaan", PluralForms{"", "galdarka Nadarlaan Antiliyaan", "", "", "", "galdarada Nadarlaan Antiliyaan"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kawansada Angola", PluralForms{"", "kawansada Angola", "", "", "", "kawansada Angola"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentine Austral", PluralForms{"", "Argentine Austral", "", "", "", "Argentine Australs"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Beesada Ley ee Arjentiin (1970–1983)", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Beesada Ley ee Arjentiin (1881–1970)", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Beesada Ley ee Arjentiin (1883–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Beesada Arjentiin", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Doolarka Astaraaliya", PluralForms{"", "doolarka Astaraaliya", "", "", "", "doolarada Astaraaliya"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Foloorinta Aruban", PluralForms{"", "foloorinta Aruban", "", "", "", "foloorinta Aruban"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manaata Asarbeyjan", PluralForms{"", "manaata Asarbeyjan", "", "", "", "manaata Asarbeyjan"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Diinaarka BBosnia-Hersogofina 1.00 konfatibal maakta Bosnia-Hersogofina 1 konfatibal maaka Bosnia-Hersogofina (1992–1994)", PluralForms{"", "Diinaarka BBosnia-Hersogofina (1992–1994)", "", "", "", "Diinaarka BBosnia-Hersogofina 1.00 konfatibal maakta Bosnia-Hersogofina 1 konfatibal maaka Bosnia-Hersogofina (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Konfatibal Maakta Bosnia-Hersogofina", PluralForms{"", "konfatibal maakta Bosnia-Hersogofina", "", "", "", "konfatibal maakta Bosnia-Hersogofina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "DBB", "$", "Doolarka Barbaadiyaan", PluralForms{"", "doolarka Barbaadiyaan", "", "", "", "doolarada Barbaadiyaan"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka Bangledesh", PluralForms{"", "taka Bangledesh", "", "", "", "taka Bangledesh"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lefta Bulgariya", PluralForms{"", "lefta Bulgariya", "", "", "", "lefta Bulgariya"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinaarka Baxreyn", PluralForms{"", "dinaarka Baxreyn", "", "", "", "dinaarka Baxreyn"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Faranka Burundi", PluralForms{"", "faranka Burundi", "", "", "", "faranka Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Doolarka Barmuuda", PluralForms{"", "doolarka Barmuuda", "", "", "", "Doolarka Barmuuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Doolarka Buruney", PluralForms{"", "doolarka Buruney", "", "", "", "doolarada Buruney"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bolifiyanada Bolifiya", PluralForms{"", "", "", "", "", ""}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Bolifiyaabka Bolifiyaano(1863–1963)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Realka Barasil", PluralForms{"", "Realka Barasil", "", "", "", "Realada Barasil"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Doolarka Bahamaas", PluralForms{"", "doolarka Bahamaas", "", "", "", "doolarada Bahamaas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Nugultaramta Butan", PluralForms{"", "nugultaramta Butan", "", "", "", "nugultaramta Butan"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Buulada Botswana", PluralForms{"", "buulada Botswana", "", "", "", "buulada Botswana"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Rubalka Belarus", PluralForms{"", "rubalka Belarus", "", "", "", "rubalka Belarus"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Doolarka Beelisa", PluralForms{"", "doolarka Beelisa", "", "", "", "doolarada Beelisa"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Doolarka Kanada", PluralForms{"", "doolarka Kanada", "", "", "", "doolarada Kanada"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Faranka Kongo", PluralForms{"", "faranka Kongo", "", "", "", "faranka Kongo"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Faranka Iswiska", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Beesada Jili", PluralForms{"", "", "", "", "", ""}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuwanta Shiinaha (Ofshoor)", PluralForms{"", "yuwanta Shiinaha (Ofshoor)", "", "", "", "yuwanta Shiinaha (Ofshoor)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuwanta Shiinaha", PluralForms{"", "yuwanta Shiinaha", "", "", "", "yuwanta Shiinaha"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Beesada Kolombiya", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kolonka Kosta Riika", PluralForms{"", "kolonka Kosta Riika", "", "", "", "kolonka Kosta Riika"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Beesada Konfatibal ee Kuuba", PluralForms{"", "beesada konfatibal ee Kuuba", "", "", "", "beesada konfatibal ee Kuuba"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Beesada Kuuba", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Eskudo Keyb Farde", PluralForms{"", "eskudo Keyb Farde", "", "", "", "eskudo Keyb Farde"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Korunada Jeek", PluralForms{"", "korunada Jeek", "", "", "", "korunada Jeek"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Faran Jabuuti", PluralForms{"", "faranka Jabuuti", "", "", "", "faranka Jabuuti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Koronka Danishka", PluralForms{"", "", "", "", "", ""}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Beesada Dominiika", PluralForms{"", "beesada Dominiika", "", "", "", "beesada Dominiika"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinaarka Aljeriya", PluralForms{"", "dinaarka Aljeriya", "", "", "", "dinaarka Aljeriya"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Kroonka Estooniya", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Bowndka Masar", PluralForms{"", "bowndka Masar", "", "", "", "bowndka Masar"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfada Eritriya", PluralForms{"", "nakfada Eritriya", "", "", "", "nafkada Eritriya"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birta Itoobbiya", PluralForms{"", "birta Itoobbiya", "", "", "", "birta Itoobbiya"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Yuuroo", PluralForms{"", "yuuroo", "", "", "", "yuuroo"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Markkada Fiinishka ah", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Doolarka Fiji", PluralForms{"", "doolarka Fiji", "", "", "", "doolarada Fiji"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Bowndka Faalklaan Aylaanis", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Bowndka Biritishka", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Laariga Joorjiya", PluralForms{"", "laariga Joorjiya", "", "", "", "laariga Joorjiya"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Sedida Gana", PluralForms{"", "sedida Gana", "", "", "", "sedida Gana"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Bowndka Gibraltar", PluralForms{"", "bowndka Gibraltar", "", "", "", "bowndka Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasida Gambiya", PluralForms{"", "", "", "", "", ""}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Faranka Gini", PluralForms{"", "faranka Gini", "", "", "", "faranka Gini"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Kuwestalka Guwatemala", PluralForms{"", "kuwestalka Guwatemala", "", "", "", "kuwestalka Guwatemala"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Doolarka Guyanes", PluralForms{"", "Doolarka Guyanes", "", "", "", "Doolarada Guyanes"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Doolarka Hoon Koon", PluralForms{"", "Doolarada Hoon Koon", "", "", "", "Doolarada Hoon Koon"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lembirada Honduras", PluralForms{"", "lembirada Honduras", "", "", "", "lembirada Honduras"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kunada Korooshiya", PluralForms{"", "kunada Korooshiya", "", "", "", "kunada Korooshiya"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Goordada Hiyati", PluralForms{"", "goordada Hiyati", "", "", "", "goordada Hiyati"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Forintiska Hangari", PluralForms{"", "forintiska Hangari", "", "", "", "forintiska Hangari"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rubiah Indonesiya", PluralForms{"", "rubiah Indonesiya", "", "", "", "rubiah Indonesiya"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "baawnka Ayrishka", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Niyuu Shekelka Israaiil", PluralForms{"", "niyuu shekelka Israaiil", "", "", "", "niyuu shekelka Israaiil"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rubiga Hindiya", PluralForms{"", "rubiga Hindiya", "", "", "", "rubiga Hindiya"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinaarka Ciraaq", PluralForms{"", "dinaarka Ciraaq", "", "", "", "dinaarka Ciraaq"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Riyaalka Iran", PluralForms{"", "riyaalka Iran", "", "", "", "riyaalka Iran"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "ISJ", PluralForms{"", "krónaha Iceland (1918–1981)", "", "", "", "krónaha Iceland (1918–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Koronada Eysland", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Doolarka Jamayka", PluralForms{"", "doolarka Jamayka", "", "", "", "doolarada Jamayka"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinaarka Urdun", PluralForms{"", "dinaarka Urdun", "", "", "", "dinaarka Urdun"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yenta Jabaan", PluralForms{"", "yenta Jabaan", "", "", "", "yenta Jabaan"}},
+		"KES": {2, 0, 2, 1, "KES", "Ksh", "Ksh", "Shilingka Kenya", PluralForms{"", "shilingka Kenya", "", "", "", "shilingka Kenya"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Somta Kiyrgiystan", PluralForms{"", "somta Kiyriygstan", "", "", "", "somta Kiyrgiystan"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riyf kambodiya", PluralForms{"", "Riyf Kambodiya", "", "", "", "Riyf kambodiya"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Faranka Komoros", PluralForms{"", "faranka Komoros", "", "", "", "faranka Komoros"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Wonka Waqooyiga Kuuriya", PluralForms{"", "wonka Waqooyiga Kuuriya", "", "", "", "wonka Waqooyiga Kuuriya"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Wonka Koonfur Kuuriya", PluralForms{"", "wonka Koonfur Kuuriya", "", "", "", "wonka Koonfur Kuuriya"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinaarka Kuweyt", PluralForms{"", "dinaarka Kuweyt", "", "", "", "dinaarka Kuweyt"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Doolarka Kayman Aylaanis", PluralForms{"", "doolarka Kayman Aylaanis", "", "", "", "Doolarada Kayman Aylaanis"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tengeda Kasakhstan", PluralForms{"", "tengeda Kasakhstan", "", "", "", "tengeda Kasakhstan"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kib Laoti", PluralForms{"", "kib Laoti", "", "", "", "kib Laoti"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Bowndka Lubnaan", PluralForms{"", "bowndka Lubnaan", "", "", "", "Bowndka Lubnaan"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rubiga Siri lanka", PluralForms{"", "rubiga Siri Lanka", "", "", "", "rubiga Siri lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Doolarka Liberiya", PluralForms{"", "doolarka Liberiya", "", "", "", "doolarka Liberiya"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesotho Loti", PluralForms{"", "Lesotho loti", "", "", "", "Lesotho lotis"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Rubalka Latfiya", PluralForms{"", "rubalka Latvia", "", "", "", "rubalka Latfiya"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinaarka Libya", PluralForms{"", "dinaarka Libya", "", "", "", "dinaarka Libya"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dirhamka Moroko", PluralForms{"", "dirhamka Moroko", "", "", "", "dirhamka Moroko"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leeyuuda Moldofa", PluralForms{"", "leeyuuda Moldofa", "", "", "", "leeyuuda Moldofa"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Arayrida Madagaskar", PluralForms{"", "arayrida Madagaskar", "", "", "", "arayrida Madagaskar"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Denaarka Masedoniya", PluralForms{"", "denaarka Masedoniya", "", "", "", "denaarka Masedoniya"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kayatda Mayanmaar", PluralForms{"", "kayatda Mayanmaar", "", "", "", "kayatda Mayanmaar"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrikta Mongoliya", PluralForms{"", "tugrikta Mongoliya", "", "", "", "tugrikta Mongoliya"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Bataka Makana", PluralForms{"", "bataka Makana", "", "", "", "bataka Makana"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Oogiya Mawritaniya (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Oogiyada Mawritaaniya", PluralForms{"", "oogiyada Mawritaniya", "", "", "", "oogiyada Mawritaniya"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rubiga Mowrishiya", PluralForms{"", "rubiga Mowrishiya", "", "", "", "rubiga Mowrishiya"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyada Maldifiya", PluralForms{"", "rufiyada Maldifiya", "", "", "", "rufiyada Maldifiya"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kawajada Malawi", PluralForms{"", "kawajada Malawi", "", "", "", "kawajada Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Beesada Meksiko", PluralForms{"", "Beesada Meksiko", "", "", "", "beesada Meksiko"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringitda Malayshiya", PluralForms{"", "ringitda Malayshiya", "", "", "", "ringitda Malayshiya"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metikalka Mosambik", PluralForms{"", "metikalka Mosambik", "", "", "", "Metikalka Mosambik"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Doolarka Namibiya", PluralForms{"", "doolarka Namibiya", "", "", "", "doolarka Namibiya"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nairada Neyjeeriya", PluralForms{"", "nairada Neyjeeriya", "", "", "", "nairada Neyjeeriya"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Kordobada Nikargow", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Koronka Norway", PluralForms{"", "", "", "", "", ""}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rubiga Nebal", PluralForms{"", "rubiga Nebal", "", "", "", "rubiga Nebal"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Doolarka Niyuu Siyalaan", PluralForms{"", "doolarka Niyuu siyalaan", "", "", "", "doolarada Niyuu Siyalaan"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Riyaalka Cumaan", PluralForms{"", "riyaalka Cumaan", "", "", "", "riyaalka Cumaan"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balbow Banama", PluralForms{"", "balbaw Banama", "", "", "", "balbow Banama"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Solsha Beeru", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kinada Babua Niyuu Gini", PluralForms{"", "kinada Babua Niyuu Gini", "", "", "", "kinada Babua Niyuu Gini"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Biso Filibin", PluralForms{"", "biso Filibin", "", "", "", "biso Filibin"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rubiga Bakistan", PluralForms{"", "rubiga Bakistan", "", "", "", "rubiga Bakistan"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Solotida Bolaan", PluralForms{"", "solotida Bolaan", "", "", "", "solotida Bolaan"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guranida Baraguway", PluralForms{"", "", "", "", "", ""}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Riyaalka Qatar", PluralForms{"", "riyaalka Qatar", "", "", "", "riyaalka Qatar"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Liyuuda Romaniya", PluralForms{"", "liyuuda Romaniya", "", "", "", "liyuuda Romaniya"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinaarka Serbiya", PluralForms{"", "dinaarka Serbiya", "", "", "", "dinaarka Serbiya"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rubalka Ruushka", PluralForms{"", "rubalka Ruushka", "", "", "", "rubalka Ruushka"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Faranka Ruwanda", PluralForms{"", "", "", "", "", ""}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyaalka Sacuudiga", PluralForms{"", "Riyaalka Sacuudiga", "", "", "", "riyaalka Sacuudiga"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Doolarka Solomon Aylaanis", PluralForms{"", "doolarka Solomon Aylaanis", "", "", "", "doolarada Solomon Aylaanis"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rubiga Siisalis", PluralForms{"", "rubiga Siisalis", "", "", "", "rubiga Siisalis"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Bowndka Suudaan", PluralForms{"", "bowndka Suudaan", "", "", "", "bowndka Suudaan"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Koronka Isweden", PluralForms{"", "", "", "", "", ""}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Doolarka Singabuur", PluralForms{"", "doolarka Singabuur", "", "", "", "doolarka Singabuur"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Bowndka St Helen", PluralForms{"", "bowndka St Helen", "", "", "", "Bowndka St Helen"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leonka Sira Leon", PluralForms{"", "leonka Sira Leon", "", "", "", "leonka Sira Leon"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leonka Sira Leon (1964—2022)", PluralForms{"", "leonka Sira Leon (1964—2022)", "", "", "", "leonka Sira Leon (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "S", "S", "Shilingka Soomaaliya", PluralForms{"", "", "", "", "", ""}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Doolarka Surinamees", PluralForms{"", "Doolarka Surinamees", "", "", "", "Doolarada Surinamees"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Bowndka Koonfurta Suudaan", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra Sao Tome & Birinsibi", PluralForms{"", "dobrada Sao Tome Birinsibi", "", "", "", "dobrada Sao Tome & Birinsibi"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Bowndka Suuriya", PluralForms{"", "bowndka Suuriya", "", "", "", "bowndka Suuriya"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeenida iswaasi", PluralForms{"", "lilengeenida Iswaasi", "", "", "", "lilangeenida iswaasi"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Baatka Taylaan", PluralForms{"", "Baatda Taylaan", "", "", "", "baatda Taylaan"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoonida Tajikistan", PluralForms{"", "soomonida Tajikistan", "", "", "", "somoonida Tajikistan"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manaata Turkmenistan", PluralForms{"", "manaata Turkmenistan", "", "", "", "manaata Turkmenistan"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinaarka Tunisiya", PluralForms{"", "dinaarka Tunisiya", "", "", "", "dinaarka Tunisiya"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Ba’angada Tonga", PluralForms{"", "ba’angada Tonga", "", "", "", "ba’angada Tonga"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Liirada Turkiga", PluralForms{"", "liirada Turkiga", "", "", "", "liirada Turkiga"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Doolarka Tirinidad iyo Tobago", PluralForms{"", "doolarka Tirinidad iyo Tobago", "", "", "", "doolarada Tirinidad iyo Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Doolarka Taywaanta Cusub", PluralForms{"", "doolarka Taywaanta Cusub", "", "", "", "doolarada Taywaanta Cusub"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Shilingka Tansaaniya", PluralForms{"", "", "", "", "", ""}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Hirfiniyada Yukreeyn", PluralForms{"", "hirfiniyada Yukreeyn", "", "", "", "hirfiniyada Yukreeyn"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Shilingka Yugandha", PluralForms{"", "shilingka Yugandha", "", "", "", "shilingka Yugandha"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "Doolarka Mareeykanka", PluralForms{"", "doolarka Mareeykanka", "", "", "", "doolarada Mareeykanka"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Beesada Urugway", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Somta Usbekistan", PluralForms{"", "somta Usbekistan", "", "", "", "somta Usbekistan"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolifar Fenesuala (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolifarada Fenesuwela", PluralForms{"", "", "", "", "", ""}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dongta Fitnaam", PluralForms{"", "dongta Fitnaam", "", "", "", "dongta Fitnaam"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Fatu Fanuatu", PluralForms{"", "fatu Fanuatu", "", "", "", "fatu Fanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala Samao", PluralForms{"", "tala Samao", "", "", "", "tala Samao"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Faranka CFA ee Bartamaha Afrika", PluralForms{"", "faranka CFA ee Bartamaha Afrika", "", "", "", "faranka CFA ee Bartamaha Afrika"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Doolarka Iist Kaaribyan", PluralForms{"", "doolarka Iist Kaaribyan", "", "", "", "doolarada Iist Kaaribyan"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Faranka CFA Galbeedka Afrika", PluralForms{"", "faranka CFA Galbeedka Afrika", "", "", "", "faranka CFA Galbeedka Afrika"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Faranka CFP", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Lacag aan la aqoon", PluralForms{"", "(halbeeg lacag aan la aqoon)", "", "", "", "(Lacag aan la aqoon)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Riyaalka Yemen", PluralForms{"", "riyaalka Yemen", "", "", "", "riyaalka Yemen"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Randka Koonfur Afrika", PluralForms{"", "randka Koonfur Afrika", "", "", "", "randka Koonfur Afrika"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kawajada Sambiya", PluralForms{"", "Kawaja Sambiya", "", "", "", "Kawajada Sambiya"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,175 +6,228 @@ var sq = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		2,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "≈",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0mijë", "other": "0\u00a0mijë"}},
+				{4, 2, map[string]string{"one": "0\u00a0mijë", "other": "0\u00a0mijë"}},
+				{5, 3, map[string]string{"one": "0\u00a0mijë", "other": "0\u00a0mijë"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{9, 1, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{10, 2, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{11, 3, map[string]string{"one": "0\u00a0mld", "other": "0\u00a0mld"}},
+				{12, 1, map[string]string{"one": "0\u00a0bln", "other": "0\u00a0bln"}},
+				{13, 2, map[string]string{"one": "0\u00a0bln", "other": "0\u00a0bln"}},
+				{14, 3, map[string]string{"one": "0\u00a0bln", "other": "0\u00a0bln"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 mijë", "other": "0 mijë"}},
+				{4, 2, map[string]string{"one": "0 mijë", "other": "0 mijë"}},
+				{5, 3, map[string]string{"one": "0 mijë", "other": "0 mijë"}},
+				{6, 1, map[string]string{"one": "0 milion", "other": "0 milion"}},
+				{7, 2, map[string]string{"one": "0 milion", "other": "0 milion"}},
+				{8, 3, map[string]string{"one": "0 milion", "other": "0 milion"}},
+				{9, 1, map[string]string{"one": "0 miliard", "other": "0 miliard"}},
+				{10, 2, map[string]string{"one": "0 miliard", "other": "0 miliard"}},
+				{11, 3, map[string]string{"one": "0 miliard", "other": "0 miliard"}},
+				{12, 1, map[string]string{"one": "0 bilion", "other": "0 bilion"}},
+				{13, 2, map[string]string{"one": "0 bilion", "other": "0 bilion"}},
+				{14, 3, map[string]string{"one": "0 bilion", "other": "0 bilion"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0mijë\u00a0¤", "other": "0\u00a0mijë\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0mijë\u00a0¤", "other": "0\u00a0mijë\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0mijë\u00a0¤", "other": "0\u00a0mijë\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mld\u00a0¤", "other": "0\u00a0mld\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0bln\u00a0¤", "other": "0\u00a0bln\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0bln\u00a0¤", "other": "0\u00a0bln\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0bln\u00a0¤", "other": "0\u00a0bln\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"≈{0}", "≥{0}", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "AFN"},
-		"ALL": {0, "ALL", "Lekë", "Lekë"},
-		"AMD": {2, "AMD", "AMD", "AMD"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "AOA"},
-		"ARS": {2, "ARS", "ARS", "ARS"},
-		"AUD": {2, "AUD", "A$", "AUD"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "AZN"},
-		"BAM": {2, "BAM", "BAM", "BAM"},
-		"BBD": {2, "BBD", "BBD", "BBD"},
-		"BDT": {2, "BDT", "BDT", "BDT"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "BMD"},
-		"BND": {2, "BND", "BND", "BND"},
-		"BOB": {2, "BOB", "BOB", "BOB"},
-		"BRL": {2, "BRL", "BRL", "BRL"},
-		"BSD": {2, "BSD", "BSD", "BSD"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "BWP"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "BZD"},
-		"CAD": {2, "CAD", "CA$", "CAD"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "CLP"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "CNY"},
-		"COP": {0, "COP", "COP", "COP"},
-		"CRC": {2, "CRC", "CRC", "CRC"},
-		"CUC": {2, "CUC", "CUC", "CUC"},
-		"CUP": {2, "CUP", "CUP", "CUP"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "CZK"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "DKK"},
-		"DOP": {2, "DOP", "DOP", "DOP"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "EGP"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "FJD"},
-		"FKP": {2, "FKP", "FKP", "FKP"},
-		"GBP": {2, "GBP", "£", "GBP"},
-		"GEL": {2, "GEL", "GEL", "GEL"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "GIP"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "GNF"},
-		"GTQ": {2, "GTQ", "GTQ", "GTQ"},
-		"GYD": {2, "GYD", "GYD", "GYD"},
-		"HKD": {2, "HKD", "HK$", "HKS"},
-		"HNL": {2, "HNL", "HNL", "HNL"},
-		"HRK": {2, "HRK", "HRK", "HRK"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "HUF"},
-		"IDR": {0, "IDR", "IDR", "IDR"},
-		"ILS": {2, "ILS", "₪", "ILS"},
-		"INR": {2, "INR", "₹", "INR"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "ISK"},
-		"JMD": {2, "JMD", "JMD", "JMD"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "JPY"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "KHR"},
-		"KMF": {0, "KMF", "KMF", "KMF"},
-		"KPW": {0, "KPW", "KPW", "KPW"},
-		"KRW": {0, "KRW", "₩", "KRW"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "KYD"},
-		"KZT": {2, "KZT", "KZT", "KZT"},
-		"LAK": {0, "LAK", "LAK", "LAK"},
-		"LBP": {0, "LBP", "LBP", "LBP"},
-		"LKR": {2, "LKR", "LKR", "LKR"},
-		"LRD": {2, "LRD", "LRD", "LRD"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "MGA"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "MMK"},
-		"MNT": {2, "MNT", "MNT", "MNT"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "MUR"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "MXN"},
-		"MYR": {2, "MYR", "MYR", "MYR"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "NAD"},
-		"NGN": {2, "NGN", "NGN", "NGN"},
-		"NIO": {2, "NIO", "NIO", "NIO"},
-		"NOK": {2, "NOK", "NOK", "NOK"},
-		"NPR": {2, "NPR", "NPR", "NPR"},
-		"NZD": {2, "NZD", "NZ$", "NZD"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "PHP"},
-		"PKR": {0, "PKR", "PKR", "PKR"},
-		"PLN": {2, "PLN", "PLN", "PLN"},
-		"PYG": {0, "PYG", "PYG", "PYG"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "RON"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "RUB"},
-		"RWF": {0, "RWF", "RWF", "RWF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "SBD"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "SEK"},
-		"SGD": {2, "SGD", "SGD", "SGD"},
-		"SHP": {2, "SHP", "SHP", "SHP"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "SRD"},
-		"SSP": {2, "SSP", "SSP", "SSP"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "STN"},
-		"SYP": {0, "SYP", "SYP", "SYP"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "THB"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "TOP"},
-		"TRY": {2, "TRY", "TRY", "TRY"},
-		"TTD": {2, "TTD", "TTD", "TTD"},
-		"TWD": {2, "TWD", "NT$", "TWD"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "UAH"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "USD"},
-		"UYU": {2, "UYU", "UYU", "UYU"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "VND"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "XCD"},
-		"XCG": {2, "XCG", "ANG", "ANG"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "ZAR"},
-		"ZMW": {2, "ZMW", "ZMW", "ZMW"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirhami i Emirateve të Bashkuara Arabe", PluralForms{"", "dirham i Emirateve të Bashkuara Arabe", "", "", "", "dirhamë të Emirateve të Bashkuara Arabe"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "AFN", "Afgani afgan", PluralForms{"", "afgan afgan", "", "", "", "afganë afganë"}},
+		"ALL": {0, 0, 0, 1, "ALL", "Lekë", "Lekë", "Leku shqiptar", PluralForms{"", "lek shqiptar", "", "", "", "lekë shqiptar"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "AMD", "Dramia armene", PluralForms{"", "drami armene", "", "", "", "drami armene"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Gilderi antilian holandez", PluralForms{"", "gilder antilian holandez", "", "", "", "gilderë antilianë holandezë"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "AOA", "Kuanza e Angolës", PluralForms{"", "kuanzë angole", "", "", "", "kuanza angole"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "ARS", "Pesoja argjentinase", PluralForms{"", "peso argjentinase", "", "", "", "peso argjentinase"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "AUD", "Dollari australian", PluralForms{"", "dollar australian", "", "", "", "dollarë australianë"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Florini aruban", PluralForms{"", "florin aruban", "", "", "", "florinë arubanë"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "AZN", "Manata azerbajxhanase", PluralForms{"", "manatë azerbajxhanase", "", "", "", "manata azerbajxhanase"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "BAM", "Marka e Bosnjë-Hercegovinës [e shkëmbyeshme]", PluralForms{"", "markë e Bosnjë-Hercegovinës [e shkëmbyeshme]", "", "", "", "marka të Bosnjë-Hercegovinës [të shkëmbyeshme]"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "BBD", "Dollari barbadian", PluralForms{"", "dollar barbadian", "", "", "", "dollarë barbadianë"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "BDT", "Taka e Bangladeshit", PluralForms{"", "takë bangladeshi", "", "", "", "taka bangladeshi"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Leva bullgare", PluralForms{"", "levë bullgare", "", "", "", "leva bullgare"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinari i Bahreinit", PluralForms{"", "dinar bahreini", "", "", "", "dinarë bahreini"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Franga burundiane", PluralForms{"", "frangë burundiane", "", "", "", "franga burundiane"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "BMD", "Dollari i Bermudeve", PluralForms{"", "dollar bermude", "", "", "", "dollarë bermude"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "BND", "Dollari i Bruneit", PluralForms{"", "dollar brunei", "", "", "", "dollarë brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "BOB", "Boliviani i Bolivisë", PluralForms{"", "bolivian i Bolivisë", "", "", "", "bolivianë të Bolivisë"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "BRL", "Reali brazilian", PluralForms{"", "real brazilian", "", "", "", "realë brazilianë"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "BSD", "Dollari i Bahamasit", PluralForms{"", "dollar bahamez", "", "", "", "dollarë bahamezë"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrumi butanez", PluralForms{"", "ngultrum butanez", "", "", "", "ngultrumë butanezë"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "BWP", "Pula botsuane", PluralForms{"", "pulë botsuane", "", "", "", "pula botsuane"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Rubla bjelloruse", PluralForms{"", "rubël bjelloruse", "", "", "", "rubla bjelloruse"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rubla bjelloruse (2000–2016)", PluralForms{"", "rubël bjelloruse (2000–2016)", "", "", "", "rubla bjelloruse (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "BZD", "Dollari i Ishujve Belize", PluralForms{"", "dollar belize", "", "", "", "dollarë belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "CAD", "Dollari kanadez", PluralForms{"", "dollar kanadez", "", "", "", "dollarë kanadezë"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Franga kongole", PluralForms{"", "frangë kongole", "", "", "", "franga kongole"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Franga zvicerane", PluralForms{"", "frangë zvicerane", "", "", "", "franga zvicerane"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "CLP", "Pesoja kiliane", PluralForms{"", "peso kiliane", "", "", "", "peso kiliane"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Juani kinez (për treg të jashtëm)", PluralForms{"", "juan kinez (për treg të jashtëm)", "", "", "", "juanë kinezë (për treg të jashtëm)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "CNY", "Juani kinez", PluralForms{"", "juan kinez", "", "", "", "juanë kinezë"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "COP", "Pesoja kolumbiane", PluralForms{"", "peso kolumbiane", "", "", "", "peso kolumbiane"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "CRC", "Koloni kostarikan", PluralForms{"", "kolon kostarikan", "", "", "", "kolonë kostarikanë"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "CUC", "Pesoja kubaneze e shkëmbyeshme", PluralForms{"", "peso kubaneze e shkëmbyeshme", "", "", "", "peso kubaneze e shkëmbyeshme"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "CUP", "Pesoja kubaneze", PluralForms{"", "peso kubaneze", "", "", "", "peso kubaneze"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Eskudoja e Kepit të Gjelbër", PluralForms{"", "eskudo e Kepit të Gjelbër", "", "", "", "eskudo të Kepit të Gjelbër"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "CZK", "Koruna e Çekisë", PluralForms{"", "korunë çeke", "", "", "", "koruna çeke"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Franga xhibutiane", PluralForms{"", "frangë xhibutiane", "", "", "", "franga xhibutiane"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "DKK", "Korona daneze", PluralForms{"", "koronë daneze", "", "", "", "korona daneze"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "DOP", "Pesoja dominikane", PluralForms{"", "peso dominikane", "", "", "", "peso dominikane"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinari algjerian", PluralForms{"", "dinar algjerian", "", "", "", "dinarë algjerianë"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "EGP", "Sterlina egjiptiane", PluralForms{"", "sterlinë egjiptiane", "", "", "", "sterlina egjiptiane"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa e Eritresë", PluralForms{"", "nakfë eritreje", "", "", "", "nakfa eritreje"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Bira etiopiane", PluralForms{"", "birë etiopiane", "", "", "", "bira etiopiane"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euroja", PluralForms{"", "euro", "", "", "", "euro"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "FJD", "Dollari i Fixhit", PluralForms{"", "dollar fixhi", "", "", "", "dollarë fixhi"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "FKP", "Stërlina e Ishujve Falkland", PluralForms{"", "stërlinë e Ishujve Falkland", "", "", "", "stërlina të Ishujve Falkland"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "GBP", "Sterlina britanike", PluralForms{"", "sterlinë britanike", "", "", "", "sterlina britanike"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "GEL", "Laria gjeorgjiane", PluralForms{"", "lari gjeorgjian", "", "", "", "lari gjeorgjiane"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Sejda ganeze", PluralForms{"", "sejdë ganeze", "", "", "", "sejda ganeze"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "GIP", "Sterlina e Gjibraltarit", PluralForms{"", "sterlinë gjibraltari", "", "", "", "sterlina gjibraltari"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi gambian", PluralForms{"", "dalas gambian", "", "", "", "dalasë gambianë"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "GNF", "Franga guinease", PluralForms{"", "frangë guineje", "", "", "", "franga guineje"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "GTQ", "Kuecali i Guatemalës", PluralForms{"", "kuecal guatemalas", "", "", "", "kuecalë guatemalas"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "GYD", "Dollari guajanez", PluralForms{"", "dollar guajanez", "", "", "", "dollarë guajanezë"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "HKS", "Dollari i Hong-Kongut", PluralForms{"", "dollar hong-kongu", "", "", "", "dollarë hong-kongu"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "HNL", "Lempira hondurase", PluralForms{"", "lempirë hondurase", "", "", "", "lempira hondurase"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "HRK", "Kuna kroate", PluralForms{"", "kunë kroate", "", "", "", "kuna kroate"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gurdi haitian", PluralForms{"", "gurd haitian", "", "", "", "gurdë haitianë"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "HUF", "Forinta hungareze", PluralForms{"", "forintë hungareze", "", "", "", "forinta hungareze"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "IDR", "Rupia indoneziane", PluralForms{"", "rupi indoneziane", "", "", "", "rupi indoneziane"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "ILS", "Shekeli izrealit", PluralForms{"", "shekel izrealit", "", "", "", "shekelë izrealit"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "INR", "Rupia indiane", PluralForms{"", "rupi indiane", "", "", "", "rupi indiane"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinari irakian", PluralForms{"", "dinar irakian", "", "", "", "dinarë irakianë"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Riali iranian", PluralForms{"", "rial iranian", "", "", "", "rialë iranianë"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "ISK", "Korona islandeze", PluralForms{"", "koronë islandeze", "", "", "", "korona islandeze"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "JMD", "Dollari xhamajkan", PluralForms{"", "dollar xhamajkan", "", "", "", "dollarë xhamajkanë"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinari jordanez", PluralForms{"", "dinar jordanez", "", "", "", "dinarë jordanezë"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "JPY", "Jeni japonez", PluralForms{"", "jen japonez", "", "", "", "jenë japonezë"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Shilinga keniane", PluralForms{"", "shilingë keniane", "", "", "", "shilinga keniane"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Soma kirgize", PluralForms{"", "somë kirgize", "", "", "", "soma kirgize"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "KHR", "Riali kamboxhian", PluralForms{"", "rial kamboxhian", "", "", "", "rialë kamboxhianë"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "KMF", "Franga komore", PluralForms{"", "frangë komore", "", "", "", "franga komore"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "KPW", "Uoni koreano-verior", PluralForms{"", "uon koreano-verior", "", "", "", "uonë koreano-veriorë"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "KRW", "Uoni koreano-jugor", PluralForms{"", "uon koreano-jugor", "", "", "", "uonë koreano-jugorë"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinari kuvajtian", PluralForms{"", "dinar kuvajtian", "", "", "", "dinarë kuvajtianë"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "KYD", "Dollari i Ishujve Kajman", PluralForms{"", "dollar i Ishujve Kajman", "", "", "", "dollarë të Ishujve Kajman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "KZT", "Tenga kazake", PluralForms{"", "tengë kazake", "", "", "", "tenga kazake"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "LAK", "Kipa e Laosit", PluralForms{"", "kipë laosi", "", "", "", "kipa laosi"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "LBP", "Sterlina libaneze", PluralForms{"", "sterlinë libaneze", "", "", "", "sterlina libaneze"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "LKR", "Rupia e Sri-Lankës", PluralForms{"", "rupi sri-lanke", "", "", "", "rupi sri-lanke"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "LRD", "Dollari liberian", PluralForms{"", "dollar liberian", "", "", "", "dollarë liberianë"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lota lesotiane", PluralForms{"", "lotë lesotiane", "", "", "", "lota lesotiane"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Lita lituaneze", PluralForms{"", "litë lituaneze", "", "", "", "lita lituaneze"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lata letoneze", PluralForms{"", "latë letoneze", "", "", "", "lata letoneze"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinari libian", PluralForms{"", "dinar libian", "", "", "", "dinarë libianë"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dirhami maroken", PluralForms{"", "dirham maroken", "", "", "", "dirhamë marokenë"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leuja moldave", PluralForms{"", "leu moldave", "", "", "", "leu moldave"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "MGA", "Arieri malagez", PluralForms{"", "arier malagez", "", "", "", "arierë malagezë"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Denari maqedonas", PluralForms{"", "denar maqedonas", "", "", "", "denarë maqedonas"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "MMK", "Kiata e Mianmarit", PluralForms{"", "kiatë mianmari", "", "", "", "kiata mianmari"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "MNT", "Tugrika mongole", PluralForms{"", "tugrikë mongole", "", "", "", "tugrika mongole"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataka e Makaos", PluralForms{"", "patakë e Makaos", "", "", "", "pataka të Makaos"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ugija mauritane (1973–2017)", PluralForms{"", "ugijë mauritane (1973–2017)", "", "", "", "ugija mauritane (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ugija mauritane", PluralForms{"", "ugijë mauritane", "", "", "", "ugija mauritane"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "MUR", "Rupia mauritiane", PluralForms{"", "rupi mauritiane", "", "", "", "rupi mauritiane"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiu i Maldivit", PluralForms{"", "rufi maldivi", "", "", "", "rufi maldivi"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kuaça malaviane", PluralForms{"", "kuaçë malaviane", "", "", "", "kuaça malaviane"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "MXN", "Pesoja meksikane", PluralForms{"", "peso meksikane", "", "", "", "peso meksikane"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "MYR", "Ringiti malajzian", PluralForms{"", "ringit malajzian", "", "", "", "ringitë malajzianë"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metikali i Mozambikut", PluralForms{"", "metikal mozambiku", "", "", "", "metikalë mozambiku"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "NAD", "Dollari i Namibisë", PluralForms{"", "dollar namibie", "", "", "", "dollarë namibie"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "NGN", "Naira nigeriane", PluralForms{"", "nairë nigeriane", "", "", "", "naira nigeriane"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "NIO", "Kordoba nikaraguane", PluralForms{"", "kordobë nikaraguane", "", "", "", "kordoba nikaraguane"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "NOK", "Korona norvegjeze", PluralForms{"", "koronë norvegjeze", "", "", "", "korona norvegjeze"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "NPR", "Rupia nepaleze", PluralForms{"", "rupi nepaleze", "", "", "", "rupi nepaleze"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "NZD", "Dollari i Zelandës së Re", PluralForms{"", "dollar i Zelandës së Re", "", "", "", "dollarë të Zelandës së Re"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Riali i Omanit", PluralForms{"", "rial omani", "", "", "", "rialë omani"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa panameze", PluralForms{"", "balboa panameze", "", "", "", "balboa panameze"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sola peruane", PluralForms{"", "solë peruane", "", "", "", "sola peruane"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina e Guinesë së Re-Papua", PluralForms{"", "kinë e Guinesë së Re-Papua", "", "", "", "kina të Guinesë së Re-Papua"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "PHP", "Pesoja filipinase", PluralForms{"", "peso filipinase", "", "", "", "peso filipinase"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "PKR", "Rupia pakistaneze", PluralForms{"", "rupi pakistaneze", "", "", "", "rupi pakistaneze"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "PLN", "Zllota polake", PluralForms{"", "zllotë polake", "", "", "", "zllota polake"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "PYG", "Guarani paraguaian", PluralForms{"", "guaran paraguaian", "", "", "", "guaranë paraguaianë"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Riali i Katarit", PluralForms{"", "rial katari", "", "", "", "rialë katari"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "RON", "Leuja rumune", PluralForms{"", "leu rumune", "", "", "", "leu rumune"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinari serb", PluralForms{"", "dinar serb", "", "", "", "dinarë serbë"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "RUB", "Rubla ruse", PluralForms{"", "rubël ruse", "", "", "", "rubla ruse"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RWF", "Franga ruandeze", PluralForms{"", "frangë ruandeze", "", "", "", "franga ruandeze"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riali saudit", PluralForms{"", "rial saudit", "", "", "", "rialë sauditë"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "SBD", "Dollari i Ishujve Solomonë", PluralForms{"", "dollar i Ishujve Solomonë", "", "", "", "dollarë të Ishujve Solomonë"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rupia e Ishujve Sishelë", PluralForms{"", "rupi e Ishujve Sishelë", "", "", "", "rupi të Ishujve Sishelë"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sterlina sudaneze", PluralForms{"", "sterlinë sudaneze", "", "", "", "sterlina sudaneze"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "SEK", "Korona suedeze", PluralForms{"", "koronë suedeze", "", "", "", "korona suedeze"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "SGD", "Dollari i Singaporit", PluralForms{"", "dollar singapori", "", "", "", "dollarë singapori"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "SHP", "Sterlina e Ishullit të Shën-Helenës", PluralForms{"", "sterlinë e Ishullit të Shën-Helenës", "", "", "", "sterlina e Ishullit të Shën-Helenës"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leoni i Siera-Leones", PluralForms{"", "leon i Siera-Leones", "", "", "", "leonë të Siera-Leones"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leoni i Siera-Leones (1964–2022)", PluralForms{"", "leon i Siera-Leones (1964–2022)", "", "", "", "leonë të Siera-Leones (1964–2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Shilinga somaleze", PluralForms{"", "shilingë somaleze", "", "", "", "shilinga somaleze"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "SRD", "Dollari surinamez", PluralForms{"", "dollar surinamez", "", "", "", "dollarë surinamezë"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "SSP", "Sterlina sudanezo-jugore", PluralForms{"", "sterlinë sudanezo-jugore", "", "", "", "sterlina sudanezo-jugore"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra e Sao-Tomes dhe Prinsipes (1977–2017)", PluralForms{"", "dobër e Sao-Tomes dhe Prinsipes (1977–2017)", "", "", "", "dobra të Sao-Tomes dhe Prinsipes (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "STN", "Dobra e Sao-Tomes dhe Prinsipes", PluralForms{"", "dobër e Sao-Tomes dhe Prinsipes", "", "", "", "dobra të Sao-Tomes dhe Prinsipes"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "SYP", "Sterlina siriane", PluralForms{"", "sterlinë siriane", "", "", "", "sterlina siriane"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni i Suazilandës", PluralForms{"", "lilangen suazilande", "", "", "", "lilangenë suazilande"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "THB", "Bata tajlandeze", PluralForms{"", "batë tajlandeze", "", "", "", "bata tajlandeze"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somona taxhike", PluralForms{"", "somonë taxhike", "", "", "", "somona taxhike"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manata turkmene", PluralForms{"", "manatë turkmene", "", "", "", "manata turkmene"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinari tunizian", PluralForms{"", "dinar tunizian", "", "", "", "dinarë tunizianë"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "TOP", "Panga tongane", PluralForms{"", "pangë tongane", "", "", "", "panga tongane"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "TRY", "Lira turke", PluralForms{"", "lirë turke", "", "", "", "lira turke"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "TTD", "Dollari i Trinidadit dhe Tobagos", PluralForms{"", "dollar i Trinidadit dhe Tobagos", "", "", "", "dollarë të Trinidadit dhe Tobagos"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "TWD", "Dollari tajvanez", PluralForms{"", "dollar tajvanez", "", "", "", "dollarë tajvanezë"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Shilinga e Tanzanisë", PluralForms{"", "shilingë tanzanie", "", "", "", "shilinga tanzanie"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "UAH", "Rivnia ukrainase", PluralForms{"", "rivni ukrainase", "", "", "", "rivni ukrainase"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Shilinga ugandeze", PluralForms{"", "shilingë ugandeze", "", "", "", "shilinga ugandeze"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "USD", "Dollari amerikan", PluralForms{"", "dollar amerikan", "", "", "", "dollarë amerikanë"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "UYU", "Pesoja uruguaiane", PluralForms{"", "peso uruguaiane", "", "", "", "peso uruguaiane"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Soma uzbeke", PluralForms{"", "somë uzbeke", "", "", "", "soma uzbeke"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolivari venezuelian (2008–2018)", PluralForms{"", "bolivar venezuelian (2008–2018)", "", "", "", "bolivarë venezuelian (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolivari venezuelas", PluralForms{"", "bolivar venezuelas", "", "", "", "bolivarë venezuelas"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "VND", "Donga vietnameze", PluralForms{"", "dongë vietnameze", "", "", "", "donga vietnameze"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatuja e Vanuatusë", PluralForms{"", "vatu vanuatuje", "", "", "", "vatu vanuatuje"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala samoane", PluralForms{"", "talë samoane", "", "", "", "tala samoane"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Franga kamerunase", PluralForms{"", "frangë kamerunase", "", "", "", "franga kamerunase"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "XCD", "Dollari i Karaibeve Lindore", PluralForms{"", "dollar i Karaibeve Lindore", "", "", "", "dollarë të Karaibeve Lindore"}},
+		"XCG": {2, 0, 2, 1, "XCG", "ANG", "ANG", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Franga e Bregut të Fildishtë", PluralForms{"", "frangë e Bregut të Fildishtë", "", "", "", "franga të Bregut të Fildishtë"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Franga franceze e Polinezisë", PluralForms{"", "frangë franceze e Polinezisë", "", "", "", "franga franceze të Polinezisë"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Valutë e panjohur", PluralForms{"", "(njësi e panjohur valutore)", "", "", "", "(njësi të panjohura valutore)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Riali i Jemenit", PluralForms{"", "rial jemeni", "", "", "", "rialë jemeni"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "ZAR", "Randi afrikano-jugor", PluralForms{"", "rand afrikano-jugor", "", "", "", "randë afrikano-jugorë"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZMW", "Kuaça e Zambikut", PluralForms{"", "kuaçë zambiku", "", "", "", "kuaça zambiku"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,294 +6,347 @@ var srCyrl = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0хиљ.", "other": "0\u00a0хиљ."}},
+				{4, 2, map[string]string{"one": "0\u00a0хиљ.", "other": "0\u00a0хиљ."}},
+				{5, 3, map[string]string{"one": "0\u00a0хиљ.", "other": "0\u00a0хиљ."}},
+				{6, 1, map[string]string{"one": "0\u00a0мил.", "other": "0\u00a0мил."}},
+				{7, 2, map[string]string{"one": "0\u00a0мил.", "other": "0\u00a0мил."}},
+				{8, 3, map[string]string{"one": "0\u00a0мил.", "other": "0\u00a0мил."}},
+				{9, 1, map[string]string{"one": "0\u00a0млрд.", "other": "0\u00a0млрд."}},
+				{10, 2, map[string]string{"one": "0\u00a0млрд.", "other": "0\u00a0млрд."}},
+				{11, 3, map[string]string{"one": "0\u00a0млрд.", "other": "0\u00a0млрд."}},
+				{12, 1, map[string]string{"one": "0\u00a0бил.", "other": "0\u00a0бил."}},
+				{13, 2, map[string]string{"one": "0\u00a0бил.", "other": "0\u00a0бил."}},
+				{14, 3, map[string]string{"one": "0\u00a0бил.", "other": "0\u00a0бил."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 хиљаде", "one": "0 хиљада", "other": "0 хиљада"}},
+				{4, 2, map[string]string{"few": "0 хиљаде", "one": "0 хиљада", "other": "0 хиљада"}},
+				{5, 3, map[string]string{"few": "0 хиљаде", "one": "0 хиљада", "other": "0 хиљада"}},
+				{6, 1, map[string]string{"few": "0 милиона", "one": "0 милион", "other": "0 милиона"}},
+				{7, 2, map[string]string{"few": "0 милиона", "one": "0 милион", "other": "0 милиона"}},
+				{8, 3, map[string]string{"few": "0 милиона", "one": "0 милион", "other": "0 милиона"}},
+				{9, 1, map[string]string{"few": "0 милијарде", "one": "0 милијарда", "other": "0 милијарди"}},
+				{10, 2, map[string]string{"few": "0 милијарде", "one": "0 милијарда", "other": "0 милијарди"}},
+				{11, 3, map[string]string{"few": "0 милијарде", "one": "0 милијарда", "other": "0 милијарди"}},
+				{12, 1, map[string]string{"few": "0 билиона", "one": "0 билион", "other": "0 билиона"}},
+				{13, 2, map[string]string{"few": "0 билиона", "one": "0 билион", "other": "0 билиона"}},
+				{14, 3, map[string]string{"few": "0 билиона", "one": "0 билион", "other": "0 билиона"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0хиљ.\u00a0¤", "one": "0\u00a0хиљ.\u00a0¤", "other": "0\u00a0хиљ.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0хиљ.\u00a0¤", "one": "0\u00a0хиљ.\u00a0¤", "other": "0\u00a0хиљ.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0хиљ.\u00a0¤", "one": "0\u00a0хиљ.\u00a0¤", "other": "0\u00a0хиљ.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0мил.\u00a0¤", "one": "0\u00a0мил.\u00a0¤", "other": "0\u00a0мил.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0мил.\u00a0¤", "one": "0\u00a0мил.\u00a0¤", "other": "0\u00a0мил.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0мил.\u00a0¤", "one": "0\u00a0мил.\u00a0¤", "other": "0\u00a0мил.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0млрд.\u00a0¤", "one": "0\u00a0млрд.\u00a0¤", "other": "0\u00a0млрд.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0млрд.\u00a0¤", "one": "0\u00a0млрд.\u00a0¤", "other": "0\u00a0млрд.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0млрд.\u00a0¤", "one": "0\u00a0млрд.\u00a0¤", "other": "0\u00a0млрд.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0бил.\u00a0¤", "one": "0\u00a0бил.\u00a0¤", "other": "0\u00a0бил.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0бил.\u00a0¤", "one": "0\u00a0бил.\u00a0¤", "other": "0\u00a0бил.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0бил.\u00a0¤", "one": "0\u00a0бил.\u00a0¤", "other": "0\u00a0бил.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "КМ", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "ლ"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Андорска пезета", PluralForms{"", "андорска пезета", "", "андорске пезете", "", "андорске пезете"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "дирхам УАЕ", PluralForms{"", "дирхам УАЕ", "", "дирхама УАЕ", "", "дирхама УАЕ"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Авганистански авгани (1927–2002)", PluralForms{"", "aвганистански авгани (1927–2002)", "", "авганистанска авгана (1927–2002)", "", "авганистанских авгана (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "авганистански авгани", PluralForms{"", "aвганистански авгани", "", "авганистанска авгана", "", "авганистанских авгана"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "албански лек", PluralForms{"", "албански лек", "", "албанска лека", "", "албанских лека"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "јерменски драм", PluralForms{"", "јерменски драм", "", "јерменска драма", "", "јерменска драма"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "холандскоантилски гулден", PluralForms{"", "холандскоантилски гулден", "", "холандскоантилска гулдена", "", "холандскоантилских гулдена"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "анголска кванза", PluralForms{"", "анголска кванза", "", "анголске кванзе", "", "анголских кванзи"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Анголијска кванза (1977–1990)", PluralForms{"", "анголијска кванза (1977–1990)", "", "анголијске кванзе (1977–1990)", "", "анголијских кванзи (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Анголијска нова кванза (1990–2000)", PluralForms{"", "анголијска нова кванза", "", "анголијске нове кванзе", "", "анголијских нових кванзи"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Анголијска кванза реађустадо (1995–1999)", PluralForms{"", "анголијска кванза реађустадо (1995–1999)", "", "анголијске кванзе реађустадо (1995–1999)", "", "анголијских кванзи реађустадо (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Аргентински аустрал", PluralForms{"", "аргентински аустрал", "", "аргентинска аустрала", "", "аргентинских аустрала"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Аргентински пезо (1983–1985)", PluralForms{"", "аргентински пезо (1983–1985)", "", "аргентинска пезоса (1983–1985)", "", "аргентинских пезоса (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "аргентински пезос", PluralForms{"", "аргентински пезос", "", "аргентинска пезоса", "", "аргентинских пезоса"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Аустријски шилинг", PluralForms{"", "аустријски шилинг", "", "аустријска шилинга", "", "аустријских шилинга"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "аустралијски долар", PluralForms{"", "аустралијски долар", "", "аустралијска долара", "", "аустралијских долара"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "арубански флорин", PluralForms{"", "арубански флорин", "", "арубанска флорина", "", "арубанских флорина"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Азербејџански манат (1993–2006)", PluralForms{"", "азербејџански манат (1993–2006)", "", "азербејџанска маната (1993–2006)", "", "азербејџанских маната (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "азербејџански манат", PluralForms{"", "азербејџански манат", "", "азербејџанска маната", "", "азербејџанских маната"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Босанско-Херцеговачки динар", PluralForms{"", "босанско-херцеговачки динар", "", "босанско-херцеговачка динара", "", "босанско-херцеговачких динара"}},
+		"BAM": {2, 0, 2, 1, "BAM", "КМ", "KM", "босанскохерцеговачка конвертибилна марка", PluralForms{"", "босанскохерцеговачка конвертибилна марка", "", "босанскохерцеговачке конвертибилне маркe", "", "босанскохерцеговачких конвертибилних марака"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "барбадошки долар", PluralForms{"", "барбадошки долар", "", "барбадошка долара", "", "барбадошких долара"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "бангладешка така", PluralForms{"", "бангладешка така", "", "бангладешке таке", "", "бангладешких така"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Белгијски франак (конвертибилни)", PluralForms{"", "белгијски франак (конвертибилни)", "", "белгијска франка (конвертибилна)", "", "белгијских франака (конвертибилних)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Белгијски франак", PluralForms{"", "белгијски франак", "", "белгијска франка", "", "белгијских франака"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Белгијски франак (финансијски)", PluralForms{"", "белгијски франак (финансијски)", "", "белгијска франка (финансијска)", "", "белгијских франака (финансијских)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Бугарски тврди лев", PluralForms{"", "бугарски тврди лев", "", "бугарска тврда лева", "", "бугарских тврдих лева"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "бугарски лев", PluralForms{"", "бугарски лев", "", "бугарска лева", "", "бугарских лева"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "бахреински динар", PluralForms{"", "бахреински динар", "", "бахреинскa динара", "", "бахреинских динара"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "бурундски франак", PluralForms{"", "бурундски франак", "", "бурундска франка", "", "бурундских франакa"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "бермудски долар", PluralForms{"", "бермудски долар", "", "бермудска долара", "", "бермудских долара"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "брунејски долар", PluralForms{"", "брунејски долар", "", "брунејска долара", "", "брунејских долара"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "боливијски боливијано", PluralForms{"", "боливијски боливијано", "", "боливијска боливијана", "", "боливијских боливијана"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Боливијски пезо", PluralForms{"", "боливијски пезо", "", "боливијска пезоса", "", "боливијских пезоса"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Боливијски мвдол", PluralForms{"", "боливијски мвдол", "", "боливијска мвдола", "", "боливијских мвдола"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Бразилски нови крузеиро (1967–1986)", PluralForms{"", "бразилски нови крузеиро (1967–1986)", "", "бразилска нова крузеира (1967–1986)", "", "бразилских нових крузеира (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Бразилијски крузадо", PluralForms{"", "бразилски крузадос", "", "бразилска крузадоса", "", "бразилских крузадоса"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Бразилски крузеиро (1990–1993)", PluralForms{"", "бразилски крузеиро (1990–1993)", "", "бразилска крузеира (1990–1993)", "", "бразилских крузеира (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "бразилски реал", PluralForms{"", "бразилски реал", "", "бразилска реала", "", "бразилских реала"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Бразилијски нови крузадо", PluralForms{"", "бразилски нови крузадо", "", "бразилска нова крузада", "", "бразилских нових крузада"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Бразилски крузеиро", PluralForms{"", "бразилски крузеиро", "", "бразилска крузеира", "", "бразилских крузеира"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "бахамски долар", PluralForms{"", "бахамски долар", "", "бахамска долара", "", "бахамских долара"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "бутански нгултрум", PluralForms{"", "бутански нгултрум", "", "бутанска нгултрума", "", "бутанских нгултрума"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Бурмански кјат", PluralForms{"", "бурмански кјат", "", "бурманска кјата", "", "бурманских кјата"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "боцванска пула", PluralForms{"", "боцванска пула", "", "боцванске пуле", "", "боцванских пула"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Белоруска нова рубља (1994–1999)", PluralForms{"", "белоруска нова рубља (1994–1999)", "", "белоруске нове рубља (1994–1999)", "", "белоруских нових рубљи (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "белоруска рубља", PluralForms{"", "белоруска рубља", "", "белоруске рубље", "", "белоруских рубљи"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Белоруска рубља (2000–2016)", PluralForms{"", "белоруска рубља (2000–2016)", "", "белоруске рубље (2000–2016)", "", "белоруских рубљи (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "белиски долар", PluralForms{"", "белиски долар", "", "белиска долара", "", "белиских долара"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "канадски долар", PluralForms{"", "канадски долар", "", "канадска долара", "", "канадских долара"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "конгоански франак", PluralForms{"", "конгоански франак", "", "конгоанска франка", "", "конгоанских франака"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR евро", PluralForms{"", "WIR евро", "", "WIR евра", "", "WIR евра"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "швајцарски франак", PluralForms{"", "швајцарски франак", "", "швајцарска франка", "", "швајцарских франака"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR франак", PluralForms{"", "WIR франак", "", "WIR франка", "", "WIR франака"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Чилеовски унидадес се фоменто", PluralForms{"", "чилеански унидадес де фоменто", "", "чилеанска унидадес де фомента", "", "чилеански унидадеси де фоменто"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "чилеански пезос", PluralForms{"", "чилеански пезос", "", "чилеанска пезоса", "", "чилеанских пезоса"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "кинески јуан (острвски)", PluralForms{"", "кинески јуан (острвски)", "", "кинеска јуана (острвска)", "", "кинеских јуана (острвских)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "кинески јуан", PluralForms{"", "кинески јуан", "", "кинеска јуана", "", "кинеских јуана"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "колумбијски пезос", PluralForms{"", "колумбијски пезос", "", "колумбијска пезоса", "", "колумбијских пезоса"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Унидад де валоршки реал", PluralForms{"", "унидад де валоршки реал", "", "нидад де валор реала", "", "унидад де валоршких реала"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "костарикански колон", PluralForms{"", "костарикански колон", "", "костариканска колона", "", "костариканских колона"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Стари српски динар", PluralForms{"", "стари српски динар", "", "стара српска динара", "", "старих српских динара"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Чехословачка тврда круна", PluralForms{"", "чехословачка тврда круна", "", "чехословачке тврде круне", "", "чехословачких тврдих круна"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "кубански конвертибилни пезос", PluralForms{"", "кубански конвертибилни пезос", "", "кубанска конвертибилна пезоса", "", "кубанских конвертибилних пезоса"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "кубански пезос", PluralForms{"", "кубански пезос", "", "кубанска пезоса", "", "кубанских пезоса"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "зеленортски ескудо", PluralForms{"", "зеленортски ескудо", "", "зеленортска ескуда", "", "зеленортских ескуда"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Кипарска фунта", PluralForms{"", "кипарска фунта", "", "кипарске фунте", "", "кипарских фунти"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "чешка круна", PluralForms{"", "чешка круна", "", "чешке круне", "", "чешких крунa"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Источно-немачка марка", PluralForms{"", "источно-немачка марка", "", "источно-немачке марке", "", "источно-немачких марака"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Немачка марка", PluralForms{"", "немачка марка", "", "немачке марке", "", "немачких марака"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "џибутски франак", PluralForms{"", "џибутски франак", "", "џибутска франка", "", "џибутских франака"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "данска круна", PluralForms{"", "данска круна", "", "данске круне", "", "данских круна"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "доминикански пезос", PluralForms{"", "доминикански пезос", "", "доминиканска пезоса", "", "доминиканских пезоса"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "алжирски динар", PluralForms{"", "алжирски динар", "", "алжирска динара", "", "алжирских динара"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Еквадорски сакр", PluralForms{"", "еквадорски сакр", "", "еквадорска сакра", "", "еквадорских сакра"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Еквадорски унидад де валор константе", PluralForms{"", "еквадорски унидад де валор константе", "", "еквадорска унидад де валор константа", "", "еквадорских унидад де валор константа"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Естонска кроон", PluralForms{"", "естонска круна", "", "естонске круне", "", "естонских круна"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "египатска фунта", PluralForms{"", "египатска фунта", "", "египатске фунте", "", "египатских фунти"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "еритрејска накфа", PluralForms{"", "еритрејска накфа", "", "еритрејске накфе", "", "еритрејских накфи"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Шпанска пезета (рачун)", PluralForms{"", "шпанска пезета (А рачун)", "", "шпанске пезете (А рачун)", "", "шпанских пезета (А рачун)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Шпанска пезета (конвертибилнирачун)", PluralForms{"", "шпанска пезета (конвертибилан рачун)", "", "шпанске пезете (конвертибилан рачун)", "", "шпанских пезета (конвертибилан рачун)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Шпанска пезета", PluralForms{"", "шпанска пезета", "", "шпанска пезета", "", "шпанске пезете"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "етиопијски бир", PluralForms{"", "етиопски бир", "", "етиопска бира", "", "етиопских бира"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "евро", PluralForms{"", "евро", "", "евра", "", "евра"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Финска марка", PluralForms{"", "финска марка", "", "финске марке", "", "финских марака"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "фиџијски долар", PluralForms{"", "фиџијски долар", "", "фиџијска долара", "", "фиџијских долара"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "фолкландска фунта", PluralForms{"", "фокландска фунта", "", "фокландске фунте", "", "фокландских фунти"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Француски франак", PluralForms{"", "француски франак", "", "француска франка", "", "француских франака"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "британска фунта", PluralForms{"", "британска фунта", "", "британске фунте", "", "британских фунти"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Грузијски купон ларит", PluralForms{"", "грузијски купон ларит", "", "грузијска купон ларита", "", "грузијских купон ларита"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "ლ", "грузијски лари", PluralForms{"", "грузијски лари", "", "грузијска ларија", "", "грузијских ларија"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Гански цеди (1979–2007)", PluralForms{"", "гански цед (1979–2007)", "", "ганска цеда (1979–2007)", "", "ганских цеда (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "гански седи", PluralForms{"", "гански седи", "", "ганска седија", "", "ганских седија"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "гибралтарска фунта", PluralForms{"", "гибралтарска фунта", "", "гибралтарске фунте", "", "гибралтарских фунти"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "гамбијски даласи", PluralForms{"", "гамбијски даласи", "", "гамбијских даласија", "", "гамбијских даласија"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "гвинејски франак", PluralForms{"", "гвинејски франак", "", "гвинејска франка", "", "гвинејских франака"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Гвинејски сили", PluralForms{"", "гвинејски сили", "", "гвинејска сила", "", "гвинејских сила"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Екваторијално-гвинејски еквеле", PluralForms{"", "екваторијално-гвинејски еквеле", "", "екваторијално-гвинејска еквела", "", "екваторијално-гвинејских еквела"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Грчка драхма", PluralForms{"", "грчка драхма", "", "грчке драхме", "", "грчких драхми"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "гватемалски кецал", PluralForms{"", "гватемалски кецал", "", "гватемалска кецала", "", "гватемалских кецала"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Португалска гвинеја ескудо", PluralForms{"", "португалско-гвинејски ескудо", "", "португалско-гвинејска ескуда", "", "португалско-гвинејских ескуда"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Гвинеја Бисао Пезо", PluralForms{"", "гвинеја-бисаошки пезо", "", "гвинеја-бисаошка пезоса", "", "гвинеја-бисаошких пезоса"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "гвајански долар", PluralForms{"", "гвајански долар", "", "гвајанска долара", "", "гвајанских долара"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "хонгконшки долар", PluralForms{"", "хонгконшки долар", "", "хонгконшка долара", "", "хонгконшких долара"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "хондурашка лемпира", PluralForms{"", "хондурашка лемпира", "", "хондурашке лемпире", "", "хондурашких лемпира"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Хрватски динар", PluralForms{"", "хрватски динар", "", "хрватска динара", "", "хрватских динара"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "хрватска куна", PluralForms{"", "хрватска куна", "", "хрватске куне", "", "хрватских куна"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "хаићански гурд", PluralForms{"", "хаићански гурд", "", "хаићанска гурда", "", "хаићанских гурда"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "мађарска форинта", PluralForms{"", "мађарска форинта", "", "мађарске форинте", "", "мађарских форинти"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "индонежанска рупија", PluralForms{"", "индонежанска рупија", "", "индонежанске рупије", "", "индонежанских рупијa"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Ирска фунта", PluralForms{"", "ирска фунта", "", "ирске фунте", "", "ирских фунти"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Израелска фунта", PluralForms{"", "израелска фунта", "", "израелске фунте", "", "израелских фунти"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Стари израелски шекели", PluralForms{"", "стари израелски шекели", "", "стари израелски шекели", "", "стари израелски шекели"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "израелски нови шекел", PluralForms{"", "израелски нови шекел", "", "израелска нова шекела", "", "израелских нових шекела"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "индијска рупија", PluralForms{"", "индијска рупија", "", "индијске рупије", "", "индијских рупија"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ирачки динар", PluralForms{"", "ирачки динар", "", "ирачка динара", "", "ирачких динара"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ирански ријал", PluralForms{"", "ирански ријал", "", "иранска ријала", "", "иранских ријала"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Стара исландска круна", PluralForms{"", "стара исландска круна", "", "стара исландска круна", "", "стара исландска круна"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "исландска круна", PluralForms{"", "исландска круна", "", "исландске круне", "", "исландских круна"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Италијанска лира", PluralForms{"", "италијанска лира", "", "италијанске лире", "", "италијанске лире"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "јамајчански долар", PluralForms{"", "jамајчански долар", "", "jамајчанска долара", "", "jамајчанскиx долара"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "јордански динар", PluralForms{"", "јордански динар", "", "јорданскa динара", "", "јорданских динара"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "јапански јен", PluralForms{"", "јапански јен", "", "јапанска јена", "", "јапанских јена"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "кенијски шилинг", PluralForms{"", "кенијски шилинг", "", "кенијска шилинга", "", "кенијских шилинга"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "киргистански сом", PluralForms{"", "киргистански сом", "", "киргистанска сома", "", "киргистанских сома"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "камбоџански ријел", PluralForms{"", "камбоџански ријел", "", "камбоџанска ријела", "", "камбоџанских ријела"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "коморски франак", PluralForms{"", "коморски франак", "", "коморскa франкa", "", "коморских франакa"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "севернокорејски вон", PluralForms{"", "севернокорејски вон", "", "севернокорејска вона", "", "севернокорејских вона"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "јужнокорејски вон", PluralForms{"", "јужнокорејски вон", "", "јужнокорејска вона", "", "јужнокорејских вона"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "кувајтски динар", PluralForms{"", "кувајтски динар", "", "кувајтскa динара", "", "кувајтских динара"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "кајмански долар", PluralForms{"", "кајмански долар", "", "кајманска долара", "", "кајманских долара"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "казахстански тенге", PluralForms{"", "казахстански тенге", "", "казахстанска тенгеа", "", "казахстанских тенгеа"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "лаоски кип", PluralForms{"", "лаоски кип", "", "лаоска кипа", "", "лаоских кипа"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "либанска фунта", PluralForms{"", "либанскa фунтa", "", "либанскe фунтe", "", "либанских фунти"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "шриланчанска рупија", PluralForms{"", "шриланчанска рупија", "", "шриланчанске рупије", "", "шриланчанских рупија"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "либеријски долар", PluralForms{"", "либеријски долар", "", "либеријска долара", "", "либеријских долара"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "лесотски лоти", PluralForms{"", "лесотски лоти", "", "лесотска лотија", "", "лесотских лотија"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Литвански литас", PluralForms{"", "литвански литас", "", "литванска литаса", "", "литванских литаса"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Литвански талонас", PluralForms{"", "литвански талонас", "", "литванска талонаса", "", "литванских талонаса"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Луксембуршки конвертибилни франак", PluralForms{"", "луксембуршки конвертибилни франак", "", "луксембуршка конвертибилна франка", "", "луксембуршких конвертибилних франака"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Луксембуршки франак", PluralForms{"", "луксембуршки франак", "", "луксембуршка франка", "", "луксембуршки франци"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Луксембуршки финансијски франак", PluralForms{"", "луксембуршки финансијски франак", "", "луксембуршка финансијска франка", "", "луксембуршких финансијских франака"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Латвијски лати", PluralForms{"", "латвијски лат", "", "латвијска лата", "", "латвијских лата"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Латвијска рубља", PluralForms{"", "латвијска рубља", "", "латвијске рубље", "", "латвијских рубљи"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "либијски динар", PluralForms{"", "либијски динар", "", "либијскa динарa", "", "либијских динарa"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "марокански дирхам", PluralForms{"", "марокански дирхам", "", "мароканска дирхама", "", "мароканских дирхама"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Марокански франак", PluralForms{"", "марокански франак", "", "мароканска франка", "", "мароканских франака"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "молдавски леј", PluralForms{"", "молдaвски леј", "", "молдaвскa леја", "", "молдaвских леја"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "малгашки аријари", PluralForms{"", "малгашки аријари", "", "малгашка аријарија", "", "малгашких аријарија"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Малагасијски франак", PluralForms{"", "малагашајски франак", "", "малагашајска франка", "", "малагашајски франци"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "македонски денар", PluralForms{"", "македонски денар", "", "македонска денара", "", "македонских денара"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Малијански франак", PluralForms{"", "малијски франак", "", "малијска франка", "", "малијских франака"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "мјанмарски кјат", PluralForms{"", "мјанмарски кјат", "", "мјанмарскa кјатa", "", "мјанмарских кјатa"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "монголски тугрик", PluralForms{"", "монголски тугрик", "", "монголскa тугрика", "", "монголских тугрика"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "макаоска патака", PluralForms{"", "макаоска патака", "", "макаоске патаке", "", "макаоских патака"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Мауританијска oгија (1973–2017)", PluralForms{"", "мауританијска oгија (1973–2017)", "", "мауританијскe oгијe (1973–2017)", "", "мауританијских oгијa (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "мауританска огија", PluralForms{"", "мауританска огија", "", "мауританске огије", "", "мауританских огија"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Малтешка лира", PluralForms{"", "малтешка лира", "", "малтешке лире", "", "малтешких лира"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Малтешка фунта", PluralForms{"", "малтешка фунта", "", "малтешке фунте", "", "малтешких фунти"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "маурицијска рупија", PluralForms{"", "маурицијска рупија", "", "маурицијскe рупијe", "", "маурицијских рупија"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "малдивска руфија", PluralForms{"", "малдивскa руфија", "", "малдивскe руфијe", "", "малдивских руфија"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "малавијска квача", PluralForms{"", "малавијска квача", "", "малавијске кваче", "", "малавијских квача"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "мексички пезос", PluralForms{"", "мексички пезос", "", "мексичка пезоса", "", "мексичких пезоса"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Мексички сребрни пезо (1861–1992)", PluralForms{"", "мексички сребрни пезо", "", "мексичка сребрна пезоса", "", "мексичких сребрних пезоса"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Мексички унидад де инверсион (UDI)", PluralForms{"", "мексички унидадс де инверзион", "", "мексичка унидадс де инверзиона", "", "мексичких унидадс де инверзиона"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "малезијски рингит", PluralForms{"", "малезијски рингит", "", "малезијска рингита", "", "малезијских рингита"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Мозамбијски ескудо", PluralForms{"", "мозамбијски ескудо", "", "мозамбијска ескуда", "", "мозамбијских ескуда"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Стари мозамбијски метикал", PluralForms{"", "стари мозамбијски метикал", "", "стара мозамбијска метикала", "", "старих мозамбијских метикала"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "мозамбички метикал", PluralForms{"", "мозамбички метикал", "", "мозамбичка метикала", "", "мозамбичких метикала"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "намибијски долар", PluralForms{"", "намибијски долар", "", "намибијска долара", "", "намибијских долара"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "нигеријска наира", PluralForms{"", "нигеријска наира", "", "нигеријске наире", "", "нигеријских наира"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "никарагванска кордоба (1988–1991)", PluralForms{"", "никарагванска кордоба (1988–1991)", "", "никарагванске кордобе (1988–1991)", "", "никарагванских кордоба (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "никарагванска кордоба", PluralForms{"", "никарагванска кордоба", "", "никарагванске кордобе", "", "никарагванских кордоба"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Холандски гулден", PluralForms{"", "холандски гулден", "", "холандска гулдена", "", "холандских гулдена"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "норвешка круна", PluralForms{"", "норвешка круна", "", "норвешке круне", "", "норвешких круна"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "непалска рупија", PluralForms{"", "непалска рупија", "", "непалске рупије", "", "непалских рупија"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "новозеландски долар", PluralForms{"", "новозеландски долар", "", "новозеландска долара", "", "новозеландских долара"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "омански ријал", PluralForms{"", "омански ријал", "", "оманскa ријала", "", "оманских ријала"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "панамска балбоа", PluralForms{"", "панамска балбоа", "", "панамске балбое", "", "панамских балбоа"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Перуански инти", PluralForms{"", "перувијски инти", "", "перувијска интија", "", "перувијских интија"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "перуански сол", PluralForms{"", "перуански сол", "", "перуанска сола", "", "перуанских сола"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Перуански сол (1863–1965)", PluralForms{"", "перуански сол (1863–1965)", "", "перуанска сола (1863–1965)", "", "перуанских сола (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "папуанска кина", PluralForms{"", "папуанска кина", "", "папуанскe кинe", "", "папуанских кинa"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "филипински пезос", PluralForms{"", "филипински пезос", "", "филипинска пезоса", "", "филипинских пезоса"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "пакистанска рупија", PluralForms{"", "пакистанска рупија", "", "пакистанске рупије", "", "пакистанских рупија"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "пољски злот", PluralForms{"", "пољски злот", "", "пољска злота", "", "пољских злота"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Пољски злоти (1950–1995)", PluralForms{"", "пољски злот (1950–1995)", "", "пољска злота (1950–1995)", "", "пољских злота (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Португалски ескудо", PluralForms{"", "португалски ескудо", "", "португалска ескуда", "", "португалских ескуда"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "парагвајски гварани", PluralForms{"", "парагвајски гварани", "", "парагвајска гваранија", "", "парагвајских гваранија"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "катарски ријал", PluralForms{"", "катарски ријал", "", "катарска ријала", "", "катарских ријала"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Родејскидолар", PluralForms{"", "родежански долар", "", "родежанска долара", "", "родежанских долара"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Румунски леј (1952–2006)", PluralForms{"", "румунски леј (1952–2006)", "", "румунскa леја (1952–2006)", "", "румунских леја (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "румунски леј", PluralForms{"", "румунски леј", "", "румунскa леја", "", "румунских леја"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "српски динар", PluralForms{"", "српски динар", "", "српска динара", "", "српских динара"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "руска рубља", PluralForms{"", "руска рубља", "", "руске рубље", "", "руских рубљи"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Руска рубља (1991–1998)", PluralForms{"", "руска рубља (1991–1998)", "", "руске рубље (1991–1998)", "", "руских рубљи (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "руандски франак", PluralForms{"", "руандски франак", "", "руандскa франка", "", "руандских франака"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "саудијски ријал", PluralForms{"", "саудијски ријал", "", "саудијска ријала", "", "саудијских ријала"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "соломонски долар", PluralForms{"", "соломонски долар", "", "соломонска долара", "", "соломонских долара"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "сејшелска рупија", PluralForms{"", "сејшелска рупија", "", "сејшелске рупије", "", "сејшелских рупија"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Стари судански динар", PluralForms{"", "стари судански динар", "", "стара суданска динара", "", "старих суданских динара"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "суданска фунта", PluralForms{"", "суданска фунта", "", "суданске фунте", "", "суданских фунти"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Стара суданска фунта", PluralForms{"", "стара суданска фунта", "", "старе суданске фунте", "", "старих суданских фунти"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "шведска круна", PluralForms{"", "шведска круна", "", "шведске круне", "", "шведских круна"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "сингапурски долар", PluralForms{"", "сингапурски долар", "", "сингапурска долара", "", "сингапурских долара"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "фунта Свете Јелене", PluralForms{"", "фунта Свете Јелене", "", "фунте Свете Јелене", "", "фунти Свете Јелене"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Словеначки толар", PluralForms{"", "словеначки толар", "", "словеначка толара", "", "словеначких толара"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Словачка круна", PluralForms{"", "словачка круна", "", "словачке круне", "", "словачких круна"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "сијералеонски леоне", PluralForms{"", "сијералеонски леоне", "", "сијералеонска леона", "", "сијералеонских леона"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "сијералеонски леоне (1964—2022)", PluralForms{"", "сијералеонски леоне (1964—2022)", "", "сијералеонска леона (1964—2022)", "", "сијералеонских леона (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "сомалијски шилинг", PluralForms{"", "сомалијски шилинг", "", "сомалијска шилинга", "", "сомалијских шилинга"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "суринамски долар", PluralForms{"", "суринамски долар", "", "суринамска долара", "", "суринамских долара"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Суринамски гилдер", PluralForms{"", "суринамски гилдер", "", "суринамска гилдера", "", "суринамских гилдера"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "јужносуданска фунта", PluralForms{"", "јужносуданска фунта", "", "јужносуданскe фунтe", "", "јужносуданских фунти"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Саотомска добра (1977–2017)", PluralForms{"", "саотомска добра (1977–2017)", "", "саотомске добре (1977–2017)", "", "саотомских добри (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "саотомска добра", PluralForms{"", "саотомска добра", "", "саотомске добре", "", "саотомских добри"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Совјетска рубља", PluralForms{"", "совјетска рубља", "", "совјетске рубље", "", "совјетских рубљи"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Салвадорски колон", PluralForms{"", "салвадорски колон", "", "салвадорска колона", "", "салвадорских колона"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "сиријска фунта", PluralForms{"", "сиријска фунта", "", "сиријске фунте", "", "сиријских фунти"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "свазилендски лилангени", PluralForms{"", "свазилендски лилангени", "", "свазилендска лилангенија", "", "свазилендских лилангенија"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "тајландски бат", PluralForms{"", "тајландски бат", "", "тајландска бата", "", "тајландских бата"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Таџихистанска рубља", PluralForms{"", "таџихистанска рубља", "", "таџихистанске рубље", "", "таџихистанских рубљи"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "таџикистански сомон", PluralForms{"", "таџикистански сомон", "", "таџикистанска сомона", "", "таџикистанских сомона"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Туркменистански манат (1993–2009)", PluralForms{"", "туркменистански манат (1993–2009)", "", "туркменистанска маната (1993–2009)", "", "туркменистански манат (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "туркменистански манат", PluralForms{"", "туркменистански манат", "", "туркменистанскa манатa", "", "туркменистанских маната"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "туниски динар", PluralForms{"", "туниски динар", "", "туниска динара", "", "туниских динара"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "тонганска панга", PluralForms{"", "тонганска панга", "", "тонганске панге", "", "тонганских панги"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Тиморшки ескудо", PluralForms{"", "тиморшки ескудо", "", "тиморшка ескуда", "", "тиморшких ескуда"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Турска лира (1922–2005)", PluralForms{"", "турска лира (1922–2005)", "", "турске лире (1922–2005)", "", "турских лира (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "турска лира", PluralForms{"", "турска лира", "", "турске лире", "", "турских лира"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "тринидадскотобашки долар", PluralForms{"", "тринидадскотобашки долар", "", "тринидадскотобашка долара", "", "тринидадскотобашких долара"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "нови тајвански долар", PluralForms{"", "нови тајвански долар", "", "нова тајванска долара", "", "нових тајванских долара"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "танзанијски шилинг", PluralForms{"", "танзанијски шилинг", "", "танзанијска шилинга", "", "танзанијских шилинга"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "украјинска гривна", PluralForms{"", "украјинска гривна", "", "украјинскe гривнe", "", "украјинских хривњи"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Украјински карбованети", PluralForms{"", "украјински карбованец", "", "украјинска карбованцива", "", "украјинских карбованцива"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Угандски шилинг (1966–1987)", PluralForms{"", "угандијски шилинг (1966–1987)", "", "угандијска шилинга (1966–1987)", "", "угандијских шилинга (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "угандски шилинг", PluralForms{"", "угандски шилинг", "", "угандскa шилинга", "", "угандских шилинга"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "амерички долар", PluralForms{"", "амерички долар", "", "америчка долара", "", "америчких долара"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "САД долар (следећи дан)", PluralForms{"", "САД долар (следећи дан)", "", "САД долара (следећи дан)", "", "САД долара (следећи дан)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "САД долар (исти дан)", PluralForms{"", "САД долар (исти дан)", "", "САД долара (исти дан)", "", "САД долара (исти дан)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Уругвајски пезо ен унидадес индексадас", PluralForms{"", "уругвајски пезо ен унидадес индексадес", "", "уругвајска пезоса ен унидадес индексадеса", "", "угвајских пезоса ен унидадес индексадеса"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Уругвајски пезо (1975–1993)", PluralForms{"", "уругвајски пезо (1975–1993)", "", "уругвајска пезоса (1975–1993)", "", "уругвајских пезоса (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "уругвајски пезос", PluralForms{"", "уругвајски пезос", "", "уругвајска пезоса", "", "уругвајских пезоса"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "узбекистански сом", PluralForms{"", "узбекистански сом", "", "узбекистанска сома", "", "узбекистанских сома"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Венецуелански боливар (1871–2008)", PluralForms{"", "венецуелански боливар (1871–2008)", "", "венецуеланска боливара (1871–2008)", "", "венецуеланских боливара (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Венецуелански боливар (2008–2018)", PluralForms{"", "венецуелански боливар (2008–2018)", "", "венецуеланска боливара (2008–2018)", "", "венецуеланских боливара (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "венецуелански боливар", PluralForms{"", "венецуелански боливар", "", "венецуеланска боливара", "", "венецуеланских боливара"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "вијетнамски донг", PluralForms{"", "вијетнамски донг", "", "вијетнамска донга", "", "вијетнамских донга"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Вијетнамски донг (1978–1985)", PluralForms{"", "вијетнамски донг (1978–1985)", "", "вијетнамска донга (1978–1985)", "", "вијетнамских донга (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "вануатски вату", PluralForms{"", "вануатски вату", "", "вануатскa ватуa", "", "вануатских ватуa"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "самоанска тала", PluralForms{"", "самоанска тала", "", "самоанске тале", "", "самоанских тала"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "централноафрички франак", PluralForms{"", "централноафрички франак", "", "централноафричка франка", "", "централноафричких франака"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Сребро", PluralForms{"", "сребро", "", "сребра", "", "сребра"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Злато", PluralForms{"", "злато", "", "злата", "", "злата"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Европска композитна јединица", PluralForms{"", "европска композитна јединица", "", "европске композитне јединице", "", "европских композитних јединица"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Европска новчана јединица", PluralForms{"", "европска новчана јединица (XBB)", "", "европске новчане јединице (XBB)", "", "европске новчане јединице (XBB)"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Европска јединица рачуна (XBC)", PluralForms{"", "европска јединица рачуна (XBC)", "", "европске јединице рачуна (XBC)", "", "европских јединица рачуна (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Европска јединица рачуна (XBD)", PluralForms{"", "европска јединица рачуна (XBD)", "", "европске јединице рачуна (XBD)", "", "европских јединица рачуна (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "источнокарипски долар", PluralForms{"", "источнокарипски долар", "", "источнокарипскa доларa", "", "источнокарипскиx доларa"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Посебна цртаћа права", PluralForms{"", "посебно цртаће право", "", "посебна цртаћа права", "", "посебних цртаћих права"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Европска валутна јединица", PluralForms{"", "европска новчана јединица (XEU)", "", "европске новчане јединице (XEU)", "", "европских новчаних јединица"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Француски златни франак", PluralForms{"", "француски златни франак", "", "француска златна франка", "", "француских златних франака"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Француски UIC-франак", PluralForms{"", "француски UIC-франак", "", "француска UIC-франка", "", "француских UIC-франака"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "западноафрички франак", PluralForms{"", "западноафрички франак", "", "западноафричка франка", "", "западноафричких франака"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Паладијум", PluralForms{"", "паладијум", "", "паладијума", "", "паладијума"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP франак", PluralForms{"", "CFP франак", "", "CFP франка", "", "CFP франака"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Платина", PluralForms{"", "платина", "", "платине", "", "платина"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET фонд", PluralForms{"", "RINET фонд", "", "RINET фонда", "", "RINET фондова"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Код тестиране валуте", PluralForms{"", "код тестиране валуте", "", "кода тестиране валуте", "", "кодова тестиране валуте"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "непозната валута", PluralForms{"", "непозната јединица валуте", "", "непознате валуте", "", "непознатих валута"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Јеменски динар", PluralForms{"", "јеменски долар", "", "јеменска долара", "", "јеменских долара"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "јеменски ријал", PluralForms{"", "јеменски ријал", "", "јеменска ријала", "", "jеменских ријала"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Југословенски тврди динар", PluralForms{"", "југословенски тврди динар", "", "југословенска тврда динара", "", "југословенских тврдих динара"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Југословенски нови динар", PluralForms{"", "југословенски нови динар", "", "југословенска нова динара", "", "југословенских нових динара"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Југословенски конвертибилни динар", PluralForms{"", "југословенски конвертибилни динар", "", "југословенска конвертибилна динара", "", "југословенских конвертибилних динара"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Јужно-афрички ранд (финансијски)", PluralForms{"", "јужноафрички ранд (финансијски)", "", "јужноафричка ранда (финансијска)", "", "јужноафричких ранда (финансијских)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "јужноафрички ранд", PluralForms{"", "јужноафрички ранд", "", "јужноафричка ранда", "", "јужноафричких ранда"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Замбијска квача (1968–2012)", PluralForms{"", "замбијска квача (1968–2012)", "", "замбијске кваче (1968–2012)", "", "замбијских квача (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "замбијска квача", PluralForms{"", "замбијска квача", "", "замбијске кваче", "", "замбијских квача"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Заирски нови заир", PluralForms{"", "заирски нови заир", "", "заирска нова заира", "", "заирских нових заира"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Заирски заир", PluralForms{"", "заирски заир", "", "заирска заира", "", "заирских заира"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Зимбабвеански долар (1980–2008)", PluralForms{"", "зимбабвејски долар (1980–2008)", "", "зимбабвејска долара (1980–2008)", "", "зимбабвејских долара (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Зимбабвеански долар (2009)", PluralForms{"", "зимбабвејски долар (2009)", "", "зимбабвејска долара (2009)", "", "зимбабвејских долара (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Зимбабвеански долар (2008)", PluralForms{"", "зимбабвејски долар (2008)", "", "зимбабвејска долара (2008)", "", "зимбабвејских долара (2008)"}},
 	},
 }
@@ -6,294 +6,347 @@ var srLatnME = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "(", "\u00a0¤)"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0hilj.", "other": "0\u00a0hilj."}},
+				{4, 2, map[string]string{"one": "0\u00a0hilj.", "other": "0\u00a0hilj."}},
+				{5, 3, map[string]string{"one": "0\u00a0hilj.", "other": "0\u00a0hilj."}},
+				{6, 1, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{7, 2, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{8, 3, map[string]string{"one": "0\u00a0mil.", "other": "0\u00a0mil."}},
+				{9, 1, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{10, 2, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{11, 3, map[string]string{"one": "0\u00a0mlrd.", "other": "0\u00a0mlrd."}},
+				{12, 1, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{13, 2, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+				{14, 3, map[string]string{"one": "0\u00a0bil.", "other": "0\u00a0bil."}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 hiljade", "one": "0 hiljada", "other": "0 hiljada"}},
+				{4, 2, map[string]string{"few": "0 hiljade", "one": "0 hiljada", "other": "0 hiljada"}},
+				{5, 3, map[string]string{"few": "0 hiljade", "one": "0 hiljada", "other": "0 hiljada"}},
+				{6, 1, map[string]string{"few": "0 miliona", "one": "0 milion", "other": "0 miliona"}},
+				{7, 2, map[string]string{"few": "0 miliona", "one": "0 milion", "other": "0 miliona"}},
+				{8, 3, map[string]string{"few": "0 miliona", "one": "0 milion", "other": "0 miliona"}},
+				{9, 1, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{10, 2, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{11, 3, map[string]string{"few": "0 milijarde", "one": "0 milijarda", "other": "0 milijardi"}},
+				{12, 1, map[string]string{"few": "0 biliona", "one": "0 bilion", "other": "0 biliona"}},
+				{13, 2, map[string]string{"few": "0 biliona", "one": "0 bilion", "other": "0 biliona"}},
+				{14, 3, map[string]string{"few": "0 biliona", "one": "0 bilion", "other": "0 biliona"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0hilj.\u00a0¤", "one": "0\u00a0hilj.\u00a0¤", "other": "0\u00a0hilj.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0hilj.\u00a0¤", "one": "0\u00a0hilj.\u00a0¤", "other": "0\u00a0hilj.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0hilj.\u00a0¤", "one": "0\u00a0hilj.\u00a0¤", "other": "0\u00a0hilj.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0mil.\u00a0¤", "one": "0\u00a0mil.\u00a0¤", "other": "0\u00a0mil.\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0mlrd.\u00a0¤", "one": "0\u00a0mlrd.\u00a0¤", "other": "0\u00a0mlrd.\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0bil.\u00a0¤", "one": "0\u00a0bil.\u00a0¤", "other": "0\u00a0bil.\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "KM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "r."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "ლ"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Andorska pezeta", PluralForms{"", "andorska pezeta", "", "andorske pezete", "", "andorske pezete"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "dirham UAE", PluralForms{"", "dirham UAE", "", "dirhama UAE", "", "dirhama UAE"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Avganistanski avgani (1927–2002)", PluralForms{"", "avganistanski avgani (1927–2002)", "", "avganistanska avgana (1927–2002)", "", "avganistanskih avgana (1927–2002)"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "avganistanski avgani", PluralForms{"", "avganistanski avgani", "", "avganistanska avgana", "", "avganistanskih avgana"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albanski lek", PluralForms{"", "albanski lek", "", "albanska leka", "", "albanskih leka"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "jermenski dram", PluralForms{"", "jermenski dram", "", "jermenska drama", "", "jermenska drama"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "holandskoantilski gulden", PluralForms{"", "holandskoantilski gulden", "", "holandskoantilska guldena", "", "holandskoantilskih guldena"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolska kvanza", PluralForms{"", "angolska kvanza", "", "angolske kvanze", "", "angolskih kvanzi"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Angolijska kvanza (1977–1990)", PluralForms{"", "angolijska kvanza (1977–1990)", "", "angolijske kvanze (1977–1990)", "", "angolijskih kvanzi (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Angolijska nova kvanza (1990–2000)", PluralForms{"", "angolijska nova kvanza", "", "angolijske nove kvanze", "", "angolijskih novih kvanzi"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Angolijska kvanza reađustado (1995–1999)", PluralForms{"", "angolijska kvanza reađustado (1995–1999)", "", "angolijske kvanze reađustado (1995–1999)", "", "angolijskih kvanzi reađustado (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Argentinski austral", PluralForms{"", "argentinski austral", "", "argentinska australa", "", "argentinskih australa"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Argentinski pezo (1983–1985)", PluralForms{"", "argentinski pezo (1983–1985)", "", "argentinska pezosa (1983–1985)", "", "argentinskih pezosa (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinski pezos", PluralForms{"", "argentinski pezos", "", "argentinska pezosa", "", "argentinskih pezosa"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Austrijski šiling", PluralForms{"", "austrijski šiling", "", "austrijska šilinga", "", "austrijskih šilinga"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "australijski dolar", PluralForms{"", "australijski dolar", "", "australijska dolara", "", "australijskih dolara"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubanski florin", PluralForms{"", "arubanski florin", "", "arubanska florina", "", "arubanskih florina"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Azerbejdžanski manat (1993–2006)", PluralForms{"", "azerbejdžanski manat (1993–2006)", "", "azerbejdžanska manata (1993–2006)", "", "azerbejdžanskih manata (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "azerbejdžanski manat", PluralForms{"", "azerbejdžanski manat", "", "azerbejdžanska manata", "", "azerbejdžanskih manata"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Bosansko-Hercegovački dinar", PluralForms{"", "bosansko-hercegovački dinar", "", "bosansko-hercegovačka dinara", "", "bosansko-hercegovačkih dinara"}},
+		"BAM": {2, 0, 2, 1, "BAM", "KM", "KM", "bosanskohercegovačka konvertibilna marka", PluralForms{"", "bosanskohercegovačka konvertibilna marka", "", "bosanskohercegovačke konvertibilne marke", "", "bosanskohercegovačkih konvertibilnih maraka"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "barbadoški dolar", PluralForms{"", "barbadoški dolar", "", "barbadoška dolara", "", "barbadoških dolara"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladeška taka", PluralForms{"", "bangladeška taka", "", "bangladeške take", "", "bangladeških taka"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Belgijski franak (konvertibilni)", PluralForms{"", "belgijski franak (konvertibilni)", "", "belgijska franka (konvertibilna)", "", "belgijskih franaka (konvertibilnih)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Belgijski franak", PluralForms{"", "belgijski franak", "", "belgijska franka", "", "belgijskih franaka"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Belgijski franak (finansijski)", PluralForms{"", "belgijski franak (finansijski)", "", "belgijska franka (finansijska)", "", "belgijskih franaka (finansijskih)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Bugarski tvrdi lev", PluralForms{"", "bugarski tvrdi lev", "", "bugarska tvrda leva", "", "bugarskih tvrdih leva"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bugarski lev", PluralForms{"", "bugarski lev", "", "bugarska leva", "", "bugarskih leva"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahreinski dinar", PluralForms{"", "bahreinski dinar", "", "bahreinska dinara", "", "bahreinskih dinara"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundski franak", PluralForms{"", "burundski franak", "", "burundska franka", "", "burundskih franaka"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "bermudski dolar", PluralForms{"", "bermudski dolar", "", "bermudska dolara", "", "bermudskih dolara"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "brunejski dolar", PluralForms{"", "brunejski dolar", "", "brunejska dolara", "", "brunejskih dolara"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "bolivijski bolivijano", PluralForms{"", "bolivijski bolivijano", "", "bolivijska bolivijana", "", "bolivijskih bolivijana"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Bolivijski pezo", PluralForms{"", "bolivijski pezo", "", "bolivijska pezosa", "", "bolivijskih pezosa"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Bolivijski mvdol", PluralForms{"", "bolivijski mvdol", "", "bolivijska mvdola", "", "bolivijskih mvdola"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Brazilski novi kruzeiro (1967–1986)", PluralForms{"", "brazilski novi kruzeiro (1967–1986)", "", "brazilska nova kruzeira (1967–1986)", "", "brazilskih novih kruzeira (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Brazilijski kruzado", PluralForms{"", "brazilski kruzados", "", "brazilska kruzadosa", "", "brazilskih kruzadosa"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Brazilski kruzeiro (1990–1993)", PluralForms{"", "brazilski kruzeiro (1990–1993)", "", "brazilska kruzeira (1990–1993)", "", "brazilskih kruzeira (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "brazilski real", PluralForms{"", "brazilski real", "", "brazilska reala", "", "brazilskih reala"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Brazilijski novi kruzado", PluralForms{"", "brazilski novi kruzado", "", "brazilska nova kruzada", "", "brazilskih novih kruzada"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Brazilski kruzeiro", PluralForms{"", "brazilski kruzeiro", "", "brazilska kruzeira", "", "brazilskih kruzeira"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "bahamski dolar", PluralForms{"", "bahamski dolar", "", "bahamska dolara", "", "bahamskih dolara"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "butanski ngultrum", PluralForms{"", "butanski ngultrum", "", "butanska ngultruma", "", "butanskih ngultruma"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Burmanski kjat", PluralForms{"", "burmanski kjat", "", "burmanska kjata", "", "burmanskih kjata"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "bocvanska pula", PluralForms{"", "bocvanska pula", "", "bocvanske pule", "", "bocvanskih pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Beloruska nova rublja (1994–1999)", PluralForms{"", "beloruska nova rublja (1994–1999)", "", "beloruske nove rublja (1994–1999)", "", "beloruskih novih rublji (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "r.", "beloruska rublja", PluralForms{"", "beloruska rublja", "", "beloruske rublje", "", "beloruskih rublji"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Beloruska rublja (2000–2016)", PluralForms{"", "beloruska rublja (2000–2016)", "", "beloruske rublje (2000–2016)", "", "beloruskih rublji (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "beliski dolar", PluralForms{"", "beliski dolar", "", "beliska dolara", "", "beliskih dolara"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "kanadski dolar", PluralForms{"", "kanadski dolar", "", "kanadska dolara", "", "kanadskih dolara"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongoanski franak", PluralForms{"", "kongoanski franak", "", "kongoanska franka", "", "kongoanskih franaka"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "WIR evro", PluralForms{"", "WIR evro", "", "WIR evra", "", "WIR evra"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "švajcarski franak", PluralForms{"", "švajcarski franak", "", "švajcarska franka", "", "švajcarskih franaka"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "WIR franak", PluralForms{"", "WIR franak", "", "WIR franka", "", "WIR franaka"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Čileovski unidades se fomento", PluralForms{"", "čileanski unidades de fomento", "", "čileanska unidades de fomenta", "", "čileanski unidadesi de fomento"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "čileanski pezos", PluralForms{"", "čileanski pezos", "", "čileanska pezosa", "", "čileanskih pezosa"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kineski juan (ostrvski)", PluralForms{"", "kineski juan (ostrvski)", "", "kineska juana (ostrvska)", "", "kineskih juana (ostrvskih)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "kineski juan", PluralForms{"", "kineski juan", "", "kineska juana", "", "kineskih juana"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "kolumbijski pezos", PluralForms{"", "kolumbijski pezos", "", "kolumbijska pezosa", "", "kolumbijskih pezosa"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Unidad de valorški real", PluralForms{"", "unidad de valorški real", "", "nidad de valor reala", "", "unidad de valorških reala"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "kostarikanski kolon", PluralForms{"", "kostarikanski kolon", "", "kostarikanska kolona", "", "kostarikanskih kolona"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Stari srpski dinar", PluralForms{"", "stari srpski dinar", "", "stara srpska dinara", "", "starih srpskih dinara"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Čehoslovačka tvrda kruna", PluralForms{"", "čehoslovačka tvrda kruna", "", "čehoslovačke tvrde krune", "", "čehoslovačkih tvrdih kruna"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubanski konvertibilni pezos", PluralForms{"", "kubanski konvertibilni pezos", "", "kubanska konvertibilna pezosa", "", "kubanskih konvertibilnih pezosa"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubanski pezos", PluralForms{"", "kubanski pezos", "", "kubanska pezosa", "", "kubanskih pezosa"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "zelenortski eskudo", PluralForms{"", "zelenortski eskudo", "", "zelenortska eskuda", "", "zelenortskih eskuda"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Kiparska funta", PluralForms{"", "kiparska funta", "", "kiparske funte", "", "kiparskih funti"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "češka kruna", PluralForms{"", "češka kruna", "", "češke krune", "", "čeških kruna"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Istočno-nemačka marka", PluralForms{"", "istočno-nemačka marka", "", "istočno-nemačke marke", "", "istočno-nemačkih maraka"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Nemačka marka", PluralForms{"", "nemačka marka", "", "nemačke marke", "", "nemačkih maraka"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "džibutski franak", PluralForms{"", "džibutski franak", "", "džibutska franka", "", "džibutskih franaka"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "danska kruna", PluralForms{"", "danska kruna", "", "danske krune", "", "danskih kruna"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "dominikanski pezos", PluralForms{"", "dominikanski pezos", "", "dominikanska pezosa", "", "dominikanskih pezosa"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "alžirski dinar", PluralForms{"", "alžirski dinar", "", "alžirska dinara", "", "alžirskih dinara"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Ekvadorski sakr", PluralForms{"", "ekvadorski sakr", "", "ekvadorska sakra", "", "ekvadorskih sakra"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Ekvadorski unidad de valor konstante", PluralForms{"", "ekvadorski unidad de valor konstante", "", "ekvadorska unidad de valor konstanta", "", "ekvadorskih unidad de valor konstanta"}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Estonska kroon", PluralForms{"", "estonska kruna", "", "estonske krune", "", "estonskih kruna"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "egipatska funta", PluralForms{"", "egipatska funta", "", "egipatske funte", "", "egipatskih funti"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritrejska nakfa", PluralForms{"", "eritrejska nakfa", "", "eritrejske nakfe", "", "eritrejskih nakfi"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Španska pezeta (račun)", PluralForms{"", "španska pezeta (A račun)", "", "španske pezete (A račun)", "", "španskih pezeta (A račun)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Španska pezeta (konvertibilniračun)", PluralForms{"", "španska pezeta (konvertibilan račun)", "", "španske pezete (konvertibilan račun)", "", "španskih pezeta (konvertibilan račun)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Španska pezeta", PluralForms{"", "španska pezeta", "", "španska pezeta", "", "španske pezete"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopijski bir", PluralForms{"", "etiopski bir", "", "etiopska bira", "", "etiopskih bira"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "evro", PluralForms{"", "evro", "", "evra", "", "evra"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Finska marka", PluralForms{"", "finska marka", "", "finske marke", "", "finskih maraka"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "fidžijski dolar", PluralForms{"", "fidžijski dolar", "", "fidžijska dolara", "", "fidžijskih dolara"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "folklandska funta", PluralForms{"", "foklandska funta", "", "foklandske funte", "", "foklandskih funti"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Francuski franak", PluralForms{"", "francuski franak", "", "francuska franka", "", "francuskih franaka"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "britanska funta", PluralForms{"", "britanska funta", "", "britanske funte", "", "britanskih funti"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Gruzijski kupon larit", PluralForms{"", "gruzijski kupon larit", "", "gruzijska kupon larita", "", "gruzijskih kupon larita"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "ლ", "gruzijski lari", PluralForms{"", "gruzijski lari", "", "gruzijska larija", "", "gruzijskih larija"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Ganski cedi (1979–2007)", PluralForms{"", "ganski ced (1979–2007)", "", "ganska ceda (1979–2007)", "", "ganskih ceda (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ganski sedi", PluralForms{"", "ganski sedi", "", "ganska sedija", "", "ganskih sedija"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltarska funta", PluralForms{"", "gibraltarska funta", "", "gibraltarske funte", "", "gibraltarskih funti"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambijski dalasi", PluralForms{"", "gambijski dalasi", "", "gambijskih dalasija", "", "gambijskih dalasija"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "gvinejski franak", PluralForms{"", "gvinejski franak", "", "gvinejska franka", "", "gvinejskih franaka"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Gvinejski sili", PluralForms{"", "gvinejski sili", "", "gvinejska sila", "", "gvinejskih sila"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Ekvatorijalno-gvinejski ekvele", PluralForms{"", "ekvatorijalno-gvinejski ekvele", "", "ekvatorijalno-gvinejska ekvela", "", "ekvatorijalno-gvinejskih ekvela"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Grčka drahma", PluralForms{"", "grčka drahma", "", "grčke drahme", "", "grčkih drahmi"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "gvatemalski kecal", PluralForms{"", "gvatemalski kecal", "", "gvatemalska kecala", "", "gvatemalskih kecala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugalska gvineja eskudo", PluralForms{"", "portugalsko-gvinejski eskudo", "", "portugalsko-gvinejska eskuda", "", "portugalsko-gvinejskih eskuda"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Gvineja Bisao Pezo", PluralForms{"", "gvineja-bisaoški pezo", "", "gvineja-bisaoška pezosa", "", "gvineja-bisaoških pezosa"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "gvajanski dolar", PluralForms{"", "gvajanski dolar", "", "gvajanska dolara", "", "gvajanskih dolara"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "hongkonški dolar", PluralForms{"", "hongkonški dolar", "", "hongkonška dolara", "", "hongkonških dolara"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduraška lempira", PluralForms{"", "honduraška lempira", "", "honduraške lempire", "", "honduraških lempira"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Hrvatski dinar", PluralForms{"", "hrvatski dinar", "", "hrvatska dinara", "", "hrvatskih dinara"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "hrvatska kuna", PluralForms{"", "hrvatska kuna", "", "hrvatske kune", "", "hrvatskih kuna"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haićanski gurd", PluralForms{"", "haićanski gurd", "", "haićanska gurda", "", "haićanskih gurda"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "mađarska forinta", PluralForms{"", "mađarska forinta", "", "mađarske forinte", "", "mađarskih forinti"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonežanska rupija", PluralForms{"", "indonežanska rupija", "", "indonežanske rupije", "", "indonežanskih rupija"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Irska funta", PluralForms{"", "irska funta", "", "irske funte", "", "irskih funti"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Izraelska funta", PluralForms{"", "izraelska funta", "", "izraelske funte", "", "izraelskih funti"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "Stari izraelski šekeli", PluralForms{"", "stari izraelski šekeli", "", "stari izraelski šekeli", "", "stari izraelski šekeli"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "izraelski novi šekel", PluralForms{"", "izraelski novi šekel", "", "izraelska nova šekela", "", "izraelskih novih šekela"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "indijska rupija", PluralForms{"", "indijska rupija", "", "indijske rupije", "", "indijskih rupija"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irački dinar", PluralForms{"", "irački dinar", "", "iračka dinara", "", "iračkih dinara"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iranski rijal", PluralForms{"", "iranski rijal", "", "iranska rijala", "", "iranskih rijala"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "Stara islandska kruna", PluralForms{"", "stara islandska kruna", "", "stara islandska kruna", "", "stara islandska kruna"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "islandska kruna", PluralForms{"", "islandska kruna", "", "islandske krune", "", "islandskih kruna"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Italijanska lira", PluralForms{"", "italijanska lira", "", "italijanske lire", "", "italijanske lire"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "jamajčanski dolar", PluralForms{"", "jamajčanski dolar", "", "jamajčanska dolara", "", "jamajčanskix dolara"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordanski dinar", PluralForms{"", "jordanski dinar", "", "jordanska dinara", "", "jordanskih dinara"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "japanski jen", PluralForms{"", "japanski jen", "", "japanska jena", "", "japanskih jena"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenijski šiling", PluralForms{"", "kenijski šiling", "", "kenijska šilinga", "", "kenijskih šilinga"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgistanski som", PluralForms{"", "kirgistanski som", "", "kirgistanska soma", "", "kirgistanskih soma"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodžanski rijel", PluralForms{"", "kambodžanski rijel", "", "kambodžanska rijela", "", "kambodžanskih rijela"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorski franak", PluralForms{"", "komorski franak", "", "komorska franka", "", "komorskih franaka"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "severnokorejski von", PluralForms{"", "severnokorejski von", "", "severnokorejska vona", "", "severnokorejskih vona"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "južnokorejski von", PluralForms{"", "južnokorejski von", "", "južnokorejska vona", "", "južnokorejskih vona"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuvajtski dinar", PluralForms{"", "kuvajtski dinar", "", "kuvajtska dinara", "", "kuvajtskih dinara"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "kajmanski dolar", PluralForms{"", "kajmanski dolar", "", "kajmanska dolara", "", "kajmanskih dolara"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazahstanski tenge", PluralForms{"", "kazahstanski tenge", "", "kazahstanska tengea", "", "kazahstanskih tengea"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laoski kip", PluralForms{"", "laoski kip", "", "laoska kipa", "", "laoskih kipa"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanska funta", PluralForms{"", "libanska funta", "", "libanske funte", "", "libanskih funti"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "šrilančanska rupija", PluralForms{"", "šrilančanska rupija", "", "šrilančanske rupije", "", "šrilančanskih rupija"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberijski dolar", PluralForms{"", "liberijski dolar", "", "liberijska dolara", "", "liberijskih dolara"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesotski loti", PluralForms{"", "lesotski loti", "", "lesotska lotija", "", "lesotskih lotija"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litvanski litas", PluralForms{"", "litvanski litas", "", "litvanska litasa", "", "litvanskih litasa"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Litvanski talonas", PluralForms{"", "litvanski talonas", "", "litvanska talonasa", "", "litvanskih talonasa"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Luksemburški konvertibilni franak", PluralForms{"", "luksemburški konvertibilni franak", "", "luksemburška konvertibilna franka", "", "luksemburških konvertibilnih franaka"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Luksemburški franak", PluralForms{"", "luksemburški franak", "", "luksemburška franka", "", "luksemburški franci"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Luksemburški finansijski franak", PluralForms{"", "luksemburški finansijski franak", "", "luksemburška finansijska franka", "", "luksemburških finansijskih franaka"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Latvijski lati", PluralForms{"", "latvijski lat", "", "latvijska lata", "", "latvijskih lata"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Latvijska rublja", PluralForms{"", "latvijska rublja", "", "latvijske rublje", "", "latvijskih rublji"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libijski dinar", PluralForms{"", "libijski dinar", "", "libijska dinara", "", "libijskih dinara"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marokanski dirham", PluralForms{"", "marokanski dirham", "", "marokanska dirhama", "", "marokanskih dirhama"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Marokanski franak", PluralForms{"", "marokanski franak", "", "marokanska franka", "", "marokanskih franaka"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavski lej", PluralForms{"", "moldavski lej", "", "moldavska leja", "", "moldavskih leja"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "malgaški arijari", PluralForms{"", "malgaški arijari", "", "malgaška arijarija", "", "malgaških arijarija"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Malagasijski franak", PluralForms{"", "malagašajski franak", "", "malagašajska franka", "", "malagašajski franci"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonski denar", PluralForms{"", "makedonski denar", "", "makedonska denara", "", "makedonskih denara"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Malijanski franak", PluralForms{"", "malijski franak", "", "malijska franka", "", "malijskih franaka"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "mjanmarski kjat", PluralForms{"", "mjanmarski kjat", "", "mjanmarska kjata", "", "mjanmarskih kjata"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolski tugrik", PluralForms{"", "mongolski tugrik", "", "mongolska tugrika", "", "mongolskih tugrika"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "makaoska pataka", PluralForms{"", "makaoska pataka", "", "makaoske patake", "", "makaoskih pataka"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mauritanijska ogija (1973–2017)", PluralForms{"", "mauritanijska ogija (1973–2017)", "", "mauritanijske ogije (1973–2017)", "", "mauritanijskih ogija (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauritanska ogija", PluralForms{"", "mauritanska ogija", "", "mauritanske ogije", "", "mauritanskih ogija"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Malteška lira", PluralForms{"", "malteška lira", "", "malteške lire", "", "malteških lira"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Malteška funta", PluralForms{"", "malteška funta", "", "malteške funte", "", "malteških funti"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauricijska rupija", PluralForms{"", "mauricijska rupija", "", "mauricijske rupije", "", "mauricijskih rupija"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldivska rufija", PluralForms{"", "maldivska rufija", "", "maldivske rufije", "", "maldivskih rufija"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malavijska kvača", PluralForms{"", "malavijska kvača", "", "malavijske kvače", "", "malavijskih kvača"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "meksički pezos", PluralForms{"", "meksički pezos", "", "meksička pezosa", "", "meksičkih pezosa"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Meksički srebrni pezo (1861–1992)", PluralForms{"", "meksički srebrni pezo", "", "meksička srebrna pezosa", "", "meksičkih srebrnih pezosa"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Meksički unidad de inversion (UDI)", PluralForms{"", "meksički unidads de inverzion", "", "meksička unidads de inverziona", "", "meksičkih unidads de inverziona"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malezijski ringit", PluralForms{"", "malezijski ringit", "", "malezijska ringita", "", "malezijskih ringita"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Mozambijski eskudo", PluralForms{"", "mozambijski eskudo", "", "mozambijska eskuda", "", "mozambijskih eskuda"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Stari mozambijski metikal", PluralForms{"", "stari mozambijski metikal", "", "stara mozambijska metikala", "", "starih mozambijskih metikala"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "mozambički metikal", PluralForms{"", "mozambički metikal", "", "mozambička metikala", "", "mozambičkih metikala"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibijski dolar", PluralForms{"", "namibijski dolar", "", "namibijska dolara", "", "namibijskih dolara"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigerijska naira", PluralForms{"", "nigerijska naira", "", "nigerijske naire", "", "nigerijskih naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nikaragvanska kordoba (1988–1991)", PluralForms{"", "nikaragvanska kordoba (1988–1991)", "", "nikaragvanske kordobe (1988–1991)", "", "nikaragvanskih kordoba (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nikaragvanska kordoba", PluralForms{"", "nikaragvanska kordoba", "", "nikaragvanske kordobe", "", "nikaragvanskih kordoba"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Holandski gulden", PluralForms{"", "holandski gulden", "", "holandska guldena", "", "holandskih guldena"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "norveška kruna", PluralForms{"", "norveška kruna", "", "norveške krune", "", "norveških kruna"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalska rupija", PluralForms{"", "nepalska rupija", "", "nepalske rupije", "", "nepalskih rupija"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "novozelandski dolar", PluralForms{"", "novozelandski dolar", "", "novozelandska dolara", "", "novozelandskih dolara"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omanski rijal", PluralForms{"", "omanski rijal", "", "omanska rijala", "", "omanskih rijala"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamska balboa", PluralForms{"", "panamska balboa", "", "panamske balboe", "", "panamskih balboa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Peruanski inti", PluralForms{"", "peruvijski inti", "", "peruvijska intija", "", "peruvijskih intija"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruanski sol", PluralForms{"", "peruanski sol", "", "peruanska sola", "", "peruanskih sola"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Peruanski sol (1863–1965)", PluralForms{"", "peruanski sol (1863–1965)", "", "peruanska sola (1863–1965)", "", "peruanskih sola (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papuanska kina", PluralForms{"", "papuanska kina", "", "papuanske kine", "", "papuanskih kina"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filipinski pezos", PluralForms{"", "filipinski pezos", "", "filipinska pezosa", "", "filipinskih pezosa"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistanska rupija", PluralForms{"", "pakistanska rupija", "", "pakistanske rupije", "", "pakistanskih rupija"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "poljski zlot", PluralForms{"", "poljski zlot", "", "poljska zlota", "", "poljskih zlota"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Poljski zloti (1950–1995)", PluralForms{"", "poljski zlot (1950–1995)", "", "poljska zlota (1950–1995)", "", "poljskih zlota (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Portugalski eskudo", PluralForms{"", "portugalski eskudo", "", "portugalska eskuda", "", "portugalskih eskuda"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paragvajski gvarani", PluralForms{"", "paragvajski gvarani", "", "paragvajska gvaranija", "", "paragvajskih gvaranija"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "katarski rijal", PluralForms{"", "katarski rijal", "", "katarska rijala", "", "katarskih rijala"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Rodejskidolar", PluralForms{"", "rodežanski dolar", "", "rodežanska dolara", "", "rodežanskih dolara"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Rumunski lej (1952–2006)", PluralForms{"", "rumunski lej (1952–2006)", "", "rumunska leja (1952–2006)", "", "rumunskih leja (1952–2006)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "rumunski lej", PluralForms{"", "rumunski lej", "", "rumunska leja", "", "rumunskih leja"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "srpski dinar", PluralForms{"", "srpski dinar", "", "srpska dinara", "", "srpskih dinara"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ruska rublja", PluralForms{"", "ruska rublja", "", "ruske rublje", "", "ruskih rublji"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Ruska rublja (1991–1998)", PluralForms{"", "ruska rublja (1991–1998)", "", "ruske rublje (1991–1998)", "", "ruskih rublji (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ruandski franak", PluralForms{"", "ruandski franak", "", "ruandska franka", "", "ruandskih franaka"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudijski rijal", PluralForms{"", "saudijski rijal", "", "saudijska rijala", "", "saudijskih rijala"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "solomonski dolar", PluralForms{"", "solomonski dolar", "", "solomonska dolara", "", "solomonskih dolara"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "sejšelska rupija", PluralForms{"", "sejšelska rupija", "", "sejšelske rupije", "", "sejšelskih rupija"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Stari sudanski dinar", PluralForms{"", "stari sudanski dinar", "", "stara sudanska dinara", "", "starih sudanskih dinara"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudanska funta", PluralForms{"", "sudanska funta", "", "sudanske funte", "", "sudanskih funti"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Stara sudanska funta", PluralForms{"", "stara sudanska funta", "", "stare sudanske funte", "", "starih sudanskih funti"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "švedska kruna", PluralForms{"", "švedska kruna", "", "švedske krune", "", "švedskih kruna"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singapurski dolar", PluralForms{"", "singapurski dolar", "", "singapurska dolara", "", "singapurskih dolara"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "funta Svete Jelene", PluralForms{"", "funta Svete Jelene", "", "funte Svete Jelene", "", "funti Svete Jelene"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Slovenački tolar", PluralForms{"", "slovenački tolar", "", "slovenačka tolara", "", "slovenačkih tolara"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Slovačka kruna", PluralForms{"", "slovačka kruna", "", "slovačke krune", "", "slovačkih kruna"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sijeraleonski leone", PluralForms{"", "sijeraleonski leone", "", "sijeraleonska leona", "", "sijeraleonskih leona"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sijeraleonski leone (1964—2022)", PluralForms{"", "sijeraleonski leone (1964—2022)", "", "sijeraleonska leona (1964—2022)", "", "sijeraleonskih leona (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somalijski šiling", PluralForms{"", "somalijski šiling", "", "somalijska šilinga", "", "somalijskih šilinga"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamski dolar", PluralForms{"", "surinamski dolar", "", "surinamska dolara", "", "surinamskih dolara"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Surinamski gilder", PluralForms{"", "surinamski gilder", "", "surinamska gildera", "", "surinamskih gildera"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "južnosudanska funta", PluralForms{"", "južnosudanska funta", "", "južnosudanske funte", "", "južnosudanskih funti"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Saotomska dobra (1977–2017)", PluralForms{"", "saotomska dobra (1977–2017)", "", "saotomske dobre (1977–2017)", "", "saotomskih dobri (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "saotomska dobra", PluralForms{"", "saotomska dobra", "", "saotomske dobre", "", "saotomskih dobri"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Sovjetska rublja", PluralForms{"", "sovjetska rublja", "", "sovjetske rublje", "", "sovjetskih rublji"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Salvadorski kolon", PluralForms{"", "salvadorski kolon", "", "salvadorska kolona", "", "salvadorskih kolona"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "sirijska funta", PluralForms{"", "sirijska funta", "", "sirijske funte", "", "sirijskih funti"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "svazilendski lilangeni", PluralForms{"", "svazilendski lilangeni", "", "svazilendska lilangenija", "", "svazilendskih lilangenija"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "tajlandski bat", PluralForms{"", "tajlandski bat", "", "tajlandska bata", "", "tajlandskih bata"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Tadžihistanska rublja", PluralForms{"", "tadžihistanska rublja", "", "tadžihistanske rublje", "", "tadžihistanskih rublji"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadžikistanski somon", PluralForms{"", "tadžikistanski somon", "", "tadžikistanska somona", "", "tadžikistanskih somona"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Turkmenistanski manat (1993–2009)", PluralForms{"", "turkmenistanski manat (1993–2009)", "", "turkmenistanska manata (1993–2009)", "", "turkmenistanski manat (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmenistanski manat", PluralForms{"", "turkmenistanski manat", "", "turkmenistanska manata", "", "turkmenistanskih manata"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tuniski dinar", PluralForms{"", "tuniski dinar", "", "tuniska dinara", "", "tuniskih dinara"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tonganska panga", PluralForms{"", "tonganska panga", "", "tonganske pange", "", "tonganskih pangi"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Timorški eskudo", PluralForms{"", "timorški eskudo", "", "timorška eskuda", "", "timorških eskuda"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Turska lira (1922–2005)", PluralForms{"", "turska lira (1922–2005)", "", "turske lire (1922–2005)", "", "turskih lira (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turska lira", PluralForms{"", "turska lira", "", "turske lire", "", "turskih lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "trinidadskotobaški dolar", PluralForms{"", "trinidadskotobaški dolar", "", "trinidadskotobaška dolara", "", "trinidadskotobaških dolara"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "novi tajvanski dolar", PluralForms{"", "novi tajvanski dolar", "", "nova tajvanska dolara", "", "novih tajvanskih dolara"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanijski šiling", PluralForms{"", "tanzanijski šiling", "", "tanzanijska šilinga", "", "tanzanijskih šilinga"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrajinska grivna", PluralForms{"", "ukrajinska grivna", "", "ukrajinske grivne", "", "ukrajinskih hrivnji"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Ukrajinski karbovaneti", PluralForms{"", "ukrajinski karbovanec", "", "ukrajinska karbovanciva", "", "ukrajinskih karbovanciva"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Ugandski šiling (1966–1987)", PluralForms{"", "ugandijski šiling (1966–1987)", "", "ugandijska šilinga (1966–1987)", "", "ugandijskih šilinga (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandski šiling", PluralForms{"", "ugandski šiling", "", "ugandska šilinga", "", "ugandskih šilinga"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "američki dolar", PluralForms{"", "američki dolar", "", "američka dolara", "", "američkih dolara"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "SAD dolar (sledeći dan)", PluralForms{"", "SAD dolar (sledeći dan)", "", "SAD dolara (sledeći dan)", "", "SAD dolara (sledeći dan)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "SAD dolar (isti dan)", PluralForms{"", "SAD dolar (isti dan)", "", "SAD dolara (isti dan)", "", "SAD dolara (isti dan)"}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Urugvajski pezo en unidades indeksadas", PluralForms{"", "urugvajski pezo en unidades indeksades", "", "urugvajska pezosa en unidades indeksadesa", "", "ugvajskih pezosa en unidades indeksadesa"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Urugvajski pezo (1975–1993)", PluralForms{"", "urugvajski pezo (1975–1993)", "", "urugvajska pezosa (1975–1993)", "", "urugvajskih pezosa (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "urugvajski pezos", PluralForms{"", "urugvajski pezos", "", "urugvajska pezosa", "", "urugvajskih pezosa"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "uzbekistanski som", PluralForms{"", "uzbekistanski som", "", "uzbekistanska soma", "", "uzbekistanskih soma"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Venecuelanski bolivar (1871–2008)", PluralForms{"", "venecuelanski bolivar (1871–2008)", "", "venecuelanska bolivara (1871–2008)", "", "venecuelanskih bolivara (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Venecuelanski bolivar (2008–2018)", PluralForms{"", "venecuelanski bolivar (2008–2018)", "", "venecuelanska bolivara (2008–2018)", "", "venecuelanskih bolivara (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venecuelanski bolivar", PluralForms{"", "venecuelanski bolivar", "", "venecuelanska bolivara", "", "venecuelanskih bolivara"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "vijetnamski dong", PluralForms{"", "vijetnamski dong", "", "vijetnamska donga", "", "vijetnamskih donga"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Vijetnamski dong (1978–1985)", PluralForms{"", "vijetnamski dong (1978–1985)", "", "vijetnamska donga (1978–1985)", "", "vijetnamskih donga (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatski vatu", PluralForms{"", "vanuatski vatu", "", "vanuatska vatua", "", "vanuatskih vatua"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "samoanska tala", PluralForms{"", "samoanska tala", "", "samoanske tale", "", "samoanskih tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "centralnoafrički franak", PluralForms{"", "centralnoafrički franak", "", "centralnoafrička franka", "", "centralnoafričkih franaka"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Srebro", PluralForms{"", "srebro", "", "srebra", "", "srebra"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Zlato", PluralForms{"", "zlato", "", "zlata", "", "zlata"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Evropska kompozitna jedinica", PluralForms{"", "evropska kompozitna jedinica", "", "evropske kompozitne jedinice", "", "evropskih kompozitnih jedinica"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Evropska novčana jedinica", PluralForms{"", "evropska novčana jedinica (XBB)", "", "evropske novčane jedinice (XBB)", "", "evropske novčane jedinice (XBB)"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Evropska jedinica računa (XBC)", PluralForms{"", "evropska jedinica računa (XBC)", "", "evropske jedinice računa (XBC)", "", "evropskih jedinica računa (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Evropska jedinica računa (XBD)", PluralForms{"", "evropska jedinica računa (XBD)", "", "evropske jedinice računa (XBD)", "", "evropskih jedinica računa (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "istočnokaripski dolar", PluralForms{"", "istočnokaripski dolar", "", "istočnokaripska dolara", "", "istočnokaripskix dolara"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Posebna crtaća prava", PluralForms{"", "posebno crtaće pravo", "", "posebna crtaća prava", "", "posebnih crtaćih prava"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Evropska valutna jedinica", PluralForms{"", "evropska novčana jedinica (XEU)", "", "evropske novčane jedinice (XEU)", "", "evropskih novčanih jedinica"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Francuski zlatni franak", PluralForms{"", "francuski zlatni franak", "", "francuska zlatna franka", "", "francuskih zlatnih franaka"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Francuski UIC-franak", PluralForms{"", "francuski UIC-franak", "", "francuska UIC-franka", "", "francuskih UIC-franaka"}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "zapadnoafrički franak", PluralForms{"", "zapadnoafrički franak", "", "zapadnoafrička franka", "", "zapadnoafričkih franaka"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paladijum", PluralForms{"", "paladijum", "", "paladijuma", "", "paladijuma"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP franak", PluralForms{"", "CFP franak", "", "CFP franka", "", "CFP franaka"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Platina", PluralForms{"", "platina", "", "platine", "", "platina"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET fond", PluralForms{"", "RINET fond", "", "RINET fonda", "", "RINET fondova"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Kod testirane valute", PluralForms{"", "kod testirane valute", "", "koda testirane valute", "", "kodova testirane valute"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "nepoznata valuta", PluralForms{"", "nepoznata jedinica valute", "", "nepoznate valute", "", "nepoznatih valuta"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Jemenski dinar", PluralForms{"", "jemenski dolar", "", "jemenska dolara", "", "jemenskih dolara"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenski rijal", PluralForms{"", "jemenski rijal", "", "jemenska rijala", "", "jemenskih rijala"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Jugoslovenski tvrdi dinar", PluralForms{"", "jugoslovenski tvrdi dinar", "", "jugoslovenska tvrda dinara", "", "jugoslovenskih tvrdih dinara"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Jugoslovenski novi dinar", PluralForms{"", "jugoslovenski novi dinar", "", "jugoslovenska nova dinara", "", "jugoslovenskih novih dinara"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Jugoslovenski konvertibilni dinar", PluralForms{"", "jugoslovenski konvertibilni dinar", "", "jugoslovenska konvertibilna dinara", "", "jugoslovenskih konvertibilnih dinara"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Južno-afrički rand (finansijski)", PluralForms{"", "južnoafrički rand (finansijski)", "", "južnoafrička randa (finansijska)", "", "južnoafričkih randa (finansijskih)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "južnoafrički rand", PluralForms{"", "južnoafrički rand", "", "južnoafrička randa", "", "južnoafričkih randa"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Zambijska kvača (1968–2012)", PluralForms{"", "zambijska kvača (1968–2012)", "", "zambijske kvače (1968–2012)", "", "zambijskih kvača (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambijska kvača", PluralForms{"", "zambijska kvača", "", "zambijske kvače", "", "zambijskih kvača"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Zairski novi zair", PluralForms{"", "zairski novi zair", "", "zairska nova zaira", "", "zairskih novih zaira"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Zairski zair", PluralForms{"", "zairski zair", "", "zairska zaira", "", "zairskih zaira"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabveanski dolar (1980–2008)", PluralForms{"", "zimbabvejski dolar (1980–2008)", "", "zimbabvejska dolara (1980–2008)", "", "zimbabvejskih dolara (1980–2008)"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabveanski dolar (2009)", PluralForms{"", "zimbabvejski dolar (2009)", "", "zimbabvejska dolara (2009)", "", "zimbabvejskih dolara (2009)"}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabveanski dolar (2008)", PluralForms{"", "zimbabvejski dolar (2008)", "", "zimbabvejska dolara (2008)", "", "zimbabvejskih dolara (2008)"}},
 	},
 }
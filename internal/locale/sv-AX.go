@@ -6,314 +6,367 @@ var svAX = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "−",
+		"×10^", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tn", "other": "0\u00a0tn"}},
+				{4, 2, map[string]string{"one": "0\u00a0tn", "other": "0\u00a0tn"}},
+				{5, 3, map[string]string{"one": "0\u00a0tn", "other": "0\u00a0tn"}},
+				{6, 1, map[string]string{"one": "0\u00a0mn", "other": "0\u00a0mn"}},
+				{7, 2, map[string]string{"one": "0\u00a0mn", "other": "0\u00a0mn"}},
+				{8, 3, map[string]string{"one": "0\u00a0mn", "other": "0\u00a0mn"}},
+				{9, 1, map[string]string{"one": "0\u00a0md", "other": "0\u00a0md"}},
+				{10, 2, map[string]string{"one": "0\u00a0md", "other": "0\u00a0md"}},
+				{11, 3, map[string]string{"one": "0\u00a0md", "other": "0\u00a0md"}},
+				{12, 1, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+				{13, 2, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+				{14, 3, map[string]string{"one": "0\u00a0bn", "other": "0\u00a0bn"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 tusen", "other": "0 tusen"}},
+				{4, 2, map[string]string{"one": "0 tusen", "other": "0 tusen"}},
+				{5, 3, map[string]string{"one": "0 tusen", "other": "0 tusen"}},
+				{6, 1, map[string]string{"one": "0 miljon", "other": "0 miljoner"}},
+				{7, 2, map[string]string{"one": "0 miljon", "other": "0 miljoner"}},
+				{8, 3, map[string]string{"one": "0 miljoner", "other": "0 miljoner"}},
+				{9, 1, map[string]string{"one": "0 miljard", "other": "0 miljarder"}},
+				{10, 2, map[string]string{"one": "0 miljarder", "other": "0 miljarder"}},
+				{11, 3, map[string]string{"one": "0 miljarder", "other": "0 miljarder"}},
+				{12, 1, map[string]string{"one": "0 biljon", "other": "0 biljoner"}},
+				{13, 2, map[string]string{"one": "0 biljoner", "other": "0 biljoner"}},
+				{14, 3, map[string]string{"one": "0 biljoner", "other": "0 biljoner"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0tn\u00a0¤", "other": "0\u00a0tn\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0tn\u00a0¤", "other": "0\u00a0tn\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0tn\u00a0¤", "other": "0\u00a0tn\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0mn\u00a0¤", "other": "0\u00a0mn\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0mn\u00a0¤", "other": "0\u00a0mn\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0mn\u00a0¤", "other": "0\u00a0mn\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0md\u00a0¤", "other": "0\u00a0md\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0md\u00a0¤", "other": "0\u00a0md\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0md\u00a0¤", "other": "0\u00a0md\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0bn\u00a0¤", "other": "0\u00a0bn\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0bn\u00a0¤", "other": "0\u00a0bn\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0bn\u00a0¤", "other": "0\u00a0bn\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "⩾{0}", "≤{0}", "{0}‒{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALK": {2, "ALK", "ALK", "ALK"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "Bds$", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BM$", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BR$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BS$", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZ$", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNX": {2, "CNX", "CNX", "CNX"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "Dkr", "kr"},
-		"DOP": {2, "DOP", "RD$", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "Ekr", "Ekr"},
-		"EGP": {2, "EGP", "EG£", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "ESP"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IE£", "IE£"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILR": {2, "ILR", "ILR", "ILR"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISJ": {2, "ISJ", "ISJ", "ISJ"},
-		"ISK": {0, "ISK", "Ikr", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JM$", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "Nkr", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "L"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "kr", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UYW": {4, "UYW", "UYW", "UYW"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "XCG", "XCG"},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XSU": {2, "XSU", "XSU", "XSU"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XUA": {2, "XUA", "XUA", "XUA"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "andorransk peseta", PluralForms{"", "andorransk peseta", "", "", "", "andorranska pesetas"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "emiratisk dirham", PluralForms{"", "", "", "", "", ""}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "afghani (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "afghansk afghani", PluralForms{"", "afghansk afghani", "", "", "", "afghanska afghani"}},
+		"ALK": {2, 0, 2, 1, "ALK", "ALK", "ALK", "albansk lek (1946–1965)", PluralForms{"", "albansk lek (1946–1965)", "", "", "", "albanska lek (1946–1965)"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "albansk lek", PluralForms{"", "albansk lek", "", "", "", "albanska leke"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "armenisk dram", PluralForms{"", "armenisk dram", "", "", "", "armeniska dram"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "antillergulden", PluralForms{"", "", "", "", "", ""}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "angolansk kwanza", PluralForms{"", "angolansk kwanza", "", "", "", "angolanska kwanza"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "angolansk kwanza (1977–1990)", PluralForms{"", "angolansk kwanza (1977–1990)", "", "", "", "angolanska kwanza (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "angolansk ny kwanza (1990–2000)", PluralForms{"", "angolansk kwanza (1990–2000)", "", "", "", "angolanska nya kwanza (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "angolansk kwanza reajustado (1995–1999)", PluralForms{"", "angolansk kwanza reajustado (1995–1999)", "", "", "", "angolanska kwanza reajustado (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "argentinsk austral", PluralForms{"", "argentinsk austral", "", "", "", "argentinska australer"}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "argentisk peso (1970–1983)", PluralForms{"", "argentisk peso (1970–1983)", "", "", "", "argentiska pesos (1970–1983)"}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "argentisk peso (1881–1969)", PluralForms{"", "argentisk peso (1881–1969)", "", "", "", "argentiska pesos (1881–1969)"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "argentinsk peso (1983–1985)", PluralForms{"", "argentinsk peso (1983–1985)", "", "", "", "argentinska pesos (1983–1985)"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "argentinsk peso", PluralForms{"", "argentinsk peso", "", "", "", "argentinska pesos"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "österrikisk schilling", PluralForms{"", "österrikisk schilling", "", "", "", "österrikiska schilling"}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "australisk dollar", PluralForms{"", "australisk dollar", "", "", "", "australiska dollar"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "arubansk florin", PluralForms{"", "arubansk florin", "", "", "", "arubanska floriner"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "azerbajdzjansk manat (1993–2006)", PluralForms{"", "azerbajdzjansk manat (1993–2006)", "", "", "", "azerbajdzjanska manat (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "azerbajdzjansk manat", PluralForms{"", "azerbajdzjansk manat", "", "", "", "azerbajdzjanska manat"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "bosnisk-hercegovinsk dinar (1992–1994)", PluralForms{"", "bosnisk-hercegovinsk dinar (1992–1994)", "", "", "", "bosnisk-hercegovinska dinarer (1992–1994)"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "bosnisk-hercegovinsk mark (konvertibel)", PluralForms{"", "bosnisk-hercegovinsk mark (konvertibel)", "", "", "", "bosnisk-hercegovinska mark (konvertibla)"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "bosnisk-hercegovinsk dinar (1994–1998)", PluralForms{"", "bosnisk-hercegovinsk dinar (1994–1998)", "", "", "", "bosnisk-hercegovinska dinarer (1994–1998)"}},
+		"BBD": {2, 0, 2, 1, "BBD", "Bds$", "$", "barbadisk dollar", PluralForms{"", "barbadisk dollar", "", "", "", "barbadiska dollar"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "bangladeshisk taka", PluralForms{"", "bangladeshisk taka", "", "", "", "bangladeshiska taka"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "belgisk franc (konvertibel)", PluralForms{"", "belgisk franc (konvertibel)", "", "", "", "belgiska franc (konvertibla)"}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "belgisk franc", PluralForms{"", "belgisk franc", "", "", "", "belgiska franc"}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "belgisk franc (finansiell)", PluralForms{"", "belgisk franc (finansiell)", "", "", "", "belgiska franc (finansiella)"}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "bulgarisk hård lev (1962–1999)", PluralForms{"", "bulgarisk hård lev (1962–1999)", "", "", "", "bulgariska hårda lev (1962–1999)"}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "bulgarisk lev (1952–1962)", PluralForms{"", "bulgarisk lev (1952–1962)", "", "", "", "bulgariska lev (1952–1962)"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "bulgarisk lev", PluralForms{"", "bulgarisk lev", "", "", "", "bulgariska leva"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "bulgarisk lev (1881–1952)", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "bahrainsk dinar", PluralForms{"", "bahrainsk dinar", "", "", "", "bahrainska dinarer"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "burundisk franc", PluralForms{"", "burundisk franc", "", "", "", "burundiska franc"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BM$", "$", "bermudisk dollar", PluralForms{"", "bermudisk dollar", "", "", "", "bermudiska dollar"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "bruneisk dollar", PluralForms{"", "bruneisk dollar", "", "", "", "bruneiska dollar"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "boliviansk boliviano", PluralForms{"", "boliviansk boliviano", "", "", "", "bolivianska bolivianos"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "boliviansk boliviano (1864–1963)", PluralForms{"", "boliviansk boliviano (1864–1963)", "", "", "", "bolivianska bolivianos (1864–1963)"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "boliviansk peso", PluralForms{"", "boliviansk peso", "", "", "", "bolivianska pesos"}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "boliviansk mvdol", PluralForms{"", "boliviansk mvdol", "", "", "", "bolivianska mvdol"}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "brasiliansk cruzeiro novo (1967–1986)", PluralForms{"", "brasiliansk cruzeiro (1967–1986)", "", "", "", "brasilianska cruzeiro novo (1967–1986)"}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "brasiliansk cruzado", PluralForms{"", "brasiliansk cruzado", "", "", "", "brasilianska cruzado"}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "brasiliansk cruzeiro (1990–1993)", PluralForms{"", "brasiliansk cruzeiro (1990–1993)", "", "", "", "brasilianska cruzeiro (1990–1993)"}},
+		"BRL": {2, 0, 2, 1, "BRL", "BR$", "R$", "brasiliansk real", PluralForms{"", "brasiliansk real", "", "", "", "brasilianska real"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "brasiliansk cruzado novo", PluralForms{"", "brasiliansk cruzado novo", "", "", "", "brasilianska cruzado novo"}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "brasiliansk cruzeiro", PluralForms{"", "brasiliansk cruzeiro", "", "", "", "brasilianska cruzeiros"}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "brasiliansk cruzeiro (1942–1967)", PluralForms{"", "brasiliansk cruzeiro (1942–1967)", "", "", "", "brasilianska cruzeiros (1942–1967)"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BS$", "$", "bahamansk dollar", PluralForms{"", "bahamansk dollar", "", "", "", "bahamanska dollar"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "bhutanesisk ngultrum", PluralForms{"", "bhutanesisk ngultrum", "", "", "", "bhutanesiska ngultrum"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "burmesisk kyat", PluralForms{"", "burmesisk kyat", "", "", "", "burmesiska kyat"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "botswansk pula", PluralForms{"", "botswansk pula", "", "", "", "botswanska pula"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "belarusisk ny rubel (1994–1999)", PluralForms{"", "belarusisk ny rubel (1994–1999)", "", "", "", "belarusiska nya rubel (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "belarusisk rubel", PluralForms{"", "belarusisk rubel", "", "", "", "belarusiska rubel"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "belarusisk rubel (2000–2016)", PluralForms{"", "belarusisk rubel (2000–2016)", "", "", "", "belarusiska rubel (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZ$", "$", "belizisk dollar", PluralForms{"", "belizisk dollar", "", "", "", "beliziska dollar"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "kanadensisk dollar", PluralForms{"", "kanadensisk dollar", "", "", "", "kanadensiska dollar"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "kongolesisk franc", PluralForms{"", "kongolesisk franc", "", "", "", "kongolesiska franc"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "euro (konvertibelt konto, WIR Bank, Schweiz)", PluralForms{"", "euro (WIR Bank)", "", "", "", "euro (konvertibelt konto, WIR Bank, Schweiz)"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "schweizisk franc", PluralForms{"", "schweizisk franc", "", "", "", "schweiziska franc"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "franc (konvertibelt konto, WIR Bank, Schweiz)", PluralForms{"", "franc (WIR Bank)", "", "", "", "franc (konvertibelt konto, WIR Bank, Schweiz)"}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "chilensk escudo (1960–1975)", PluralForms{"", "chilensk escudo (1960–1975)", "", "", "", "chilenska escudos (1960–1975)"}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "chilensk unidad de fomento", PluralForms{"", "chilensk unidad de fomento", "", "", "", "chilenska unidad de fomento"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "chilensk peso", PluralForms{"", "chilensk peso", "", "", "", "chilenska pesos"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "kinesisk yuan (offshore)", PluralForms{"", "", "", "", "", ""}},
+		"CNX": {2, 0, 2, 1, "CNX", "CNX", "CNX", "kinesisk dollar", PluralForms{"", "kinesisk dollar", "", "", "", "kinesiska dollar"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "kinesisk yuan", PluralForms{"", "kinesisk yuan", "", "", "", "kinesiska yuan"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "colombiansk peso", PluralForms{"", "colombiansk peso", "", "", "", "colombianska pesos"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "colombiansk unidad de valor real", PluralForms{"", "colombiansk unidad de valor real", "", "", "", "colombianska unidad de valor real"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "costarikansk colón", PluralForms{"", "costarikansk colón", "", "", "", "costarikanska colón"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "serbisk dinar (2002–2006)", PluralForms{"", "serbisk dinar (2002–2006)", "", "", "", "serbiska dinarer (2002–2006)"}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "tjeckoslovakisk krona (–1993)", PluralForms{"", "tjeckoslovakisk hård koruna", "", "", "", "tjeckiska hårda koruna"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "kubansk peso (konvertibel)", PluralForms{"", "kubansk peso (konvertibel)", "", "", "", "kubanska pesos (konvertibla)"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "kubansk peso", PluralForms{"", "kubansk peso", "", "", "", "kubanska pesos"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "kapverdisk escudo", PluralForms{"", "kapverdisk escudo", "", "", "", "kapverdiska escudos"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "cypriotiskt pund", PluralForms{"", "cypriotiskt pund", "", "", "", "cypriotiska pund"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "tjeckisk koruna", PluralForms{"", "tjeckisk koruna", "", "", "", "tjeckiska koruna"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "östtysk mark", PluralForms{"", "östtysk mark", "", "", "", "östtyska mark"}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "tysk mark", PluralForms{"", "tysk mark", "", "", "", "tyska mark"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "djiboutisk franc", PluralForms{"", "djiboutisk franc", "", "", "", "djiboutiska franc"}},
+		"DKK": {2, 0, 2, 50, "DKK", "Dkr", "kr", "dansk krona", PluralForms{"", "dansk krona", "", "", "", "danska kronor"}},
+		"DOP": {2, 0, 2, 1, "DOP", "RD$", "$", "dominikansk peso", PluralForms{"", "dominikansk peso", "", "", "", "dominikanska pesos"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "algerisk dinar", PluralForms{"", "algerisk dinar", "", "", "", "algeriska dinarer"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "ecuadoriansk sucre", PluralForms{"", "ecuadoriansk sucre", "", "", "", "ecuadorianska sucre"}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "ecuadoriansk unidad de valor constante", PluralForms{"", "ecuadoriansk unidad de valor constante", "", "", "", "ecuadorianska unidad de valor constante"}},
+		"EEK": {2, 0, 2, 1, "EEK", "Ekr", "Ekr", "estnisk krona", PluralForms{"", "estnisk krona", "", "", "", "estniska kronor"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EG£", "E£", "egyptiskt pund", PluralForms{"", "egyptiskt pund", "", "", "", "egyptiska pund"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "eritreansk nakfa", PluralForms{"", "eritreansk nakfa", "", "", "", "eritreanska nakfa"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "spansk peseta (konto)", PluralForms{"", "spansk peseta (konto)", "", "", "", "spanska pesetas (konto)"}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "spansk peseta (konvertibelt konto)", PluralForms{"", "spansk peseta (konvertibelt konto)", "", "", "", "spanska pesetas (konvertibelt konto)"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "ESP", "spansk peseta", PluralForms{"", "spansk peseta", "", "", "", "spanska pesetas"}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "etiopisk birr", PluralForms{"", "etiopisk birr", "", "", "", "etiopiska birr"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "euro", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "finsk mark", PluralForms{"", "finsk mark", "", "", "", "finska mark"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fijidollar", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Falklandspund", PluralForms{"", "", "", "", "", ""}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "fransk franc", PluralForms{"", "fransk franc", "", "", "", "franska franc"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "brittiskt pund", PluralForms{"", "brittiskt pund", "", "", "", "brittiska pund"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "georgisk kupon larit", PluralForms{"", "georgisk kupon larit", "", "", "", "georgiska kupon larit"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "georgisk lari", PluralForms{"", "georgisk lari", "", "", "", "georgiska lari"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ghanansk cedi (1979–2007)", PluralForms{"", "ghanansk cedi (1979–2007)", "", "", "", "ghananska cedi (1979–2007)"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ghanansk cedi", PluralForms{"", "ghanansk cedi", "", "", "", "ghananska cedi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "gibraltiskt pund", PluralForms{"", "gibraltiskt pund", "", "", "", "gibraltiska pund"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "gambisk dalasi", PluralForms{"", "gambisk dalasi", "", "", "", "gambiska dalasi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "guineansk franc", PluralForms{"", "guineansk franc", "", "", "", "guineanska franc"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "guineansk syli", PluralForms{"", "guineansk syli", "", "", "", "guineanska syli"}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "ekvatorialguineansk ekwele", PluralForms{"", "ekvatorialguineansk ekwele", "", "", "", "ekvatorialguineanska ekweler"}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "grekisk drachma", PluralForms{"", "grekisk drachma", "", "", "", "grekiska drachmer"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "guatemalansk quetzal", PluralForms{"", "guatemalansk quetzal", "", "", "", "guatemalanska quetzal"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Portugisiska Guinea-escudo", PluralForms{"", "Portugisiska Guinea-escudo", "", "", "", "Portugisiska Guinea-escudos"}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Guinea-Bissau-peso", PluralForms{"", "Guinea-Bissau-peso", "", "", "", "Guinea-Bissau-pesos"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Guyanadollar", PluralForms{"", "", "", "", "", ""}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "Hongkongdollar", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "honduransk lempira", PluralForms{"", "honduransk lempira", "", "", "", "honduranska lempira"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "kroatisk dinar", PluralForms{"", "kroatisk dinar", "", "", "", "kroatiska dinarer"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "kroatisk kuna", PluralForms{"", "kroatisk kuna", "", "", "", "kroatiska kunor"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "haitisk gourde", PluralForms{"", "haitisk gourde", "", "", "", "haitiska gourder"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ungersk forint", PluralForms{"", "ungersk forint", "", "", "", "ungerska forinter"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "indonesisk rupie", PluralForms{"", "indonesisk rupie", "", "", "", "indonesiska rupier"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IE£", "IE£", "irländskt pund", PluralForms{"", "irländskt pund", "", "", "", "irländska pund"}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "israeliskt pund", PluralForms{"", "israeliskt pund", "", "", "", "israeliska pund"}},
+		"ILR": {2, 0, 2, 1, "ILR", "ILR", "ILR", "israelisk shekel (1980–1985)", PluralForms{"", "israelisk shekel (1980–1985)", "", "", "", "israeliska shekel (1980–1985)"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "israelisk ny shekel", PluralForms{"", "israelisk ny shekel", "", "", "", "israeliska nya shekel"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "indisk rupie", PluralForms{"", "indisk rupie", "", "", "", "indiska rupier"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "irakisk dinar", PluralForms{"", "irakisk dinar", "", "", "", "irakiska dinarer"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "iransk rial", PluralForms{"", "iransk rial", "", "", "", "iranska rial"}},
+		"ISJ": {2, 0, 2, 1, "ISJ", "ISJ", "ISJ", "isländsk gammal krona", PluralForms{"", "isländsk gammal krona", "", "", "", "isländska kronor (1874–1981)"}},
+		"ISK": {0, 0, 0, 1, "ISK", "Ikr", "kr", "isländsk krona", PluralForms{"", "isländsk krona", "", "", "", "isländska kronor"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "italiensk lire", PluralForms{"", "italiensk lire", "", "", "", "italienska lire"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JM$", "$", "jamaicansk dollar", PluralForms{"", "Jamaica-dollar", "", "", "", "jamaicanska dollar"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "jordansk dinar", PluralForms{"", "jordansk dinar", "", "", "", "jordanska dinarer"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "japansk yen", PluralForms{"", "japansk yen", "", "", "", "japanska yen"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "kenyansk shilling", PluralForms{"", "kenyansk shilling", "", "", "", "kenyanska shilling"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "kirgizisk som", PluralForms{"", "kirgizisk som", "", "", "", "kirgiziska somer"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "kambodjansk riel", PluralForms{"", "kambodjansk riel", "", "", "", "kambodjanska riel"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "komorisk franc", PluralForms{"", "komorisk franc", "", "", "", "komoriska franc"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "nordkoreansk won", PluralForms{"", "nordkoreansk won", "", "", "", "nordkoreanska won"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "sydkoreansk hwan (1953–1962)", PluralForms{"", "sydkoreansk hwan (1953–1962)", "", "", "", "sydkoreanska hwan (1953–1962)"}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "sydkoreansk won (1945–1953)", PluralForms{"", "sydkoreansk won (1945–1953)", "", "", "", "sydkoreanska won (1945–1953)"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "sydkoreansk won", PluralForms{"", "sydkoreansk won", "", "", "", "sydkoreanska won"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "kuwaitisk dinar", PluralForms{"", "kuwaitisk dinar", "", "", "", "kuwaitiska dinarer"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "caymansk dollar", PluralForms{"", "caymansk dollar", "", "", "", "caymanska dollar"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "kazakisk tenge", PluralForms{"", "kazakisk tenge", "", "", "", "kazakiska tenge"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "laotisk kip", PluralForms{"", "laotisk kip", "", "", "", "laotiska kip"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "libanesiskt pund", PluralForms{"", "libanesiskt pund", "", "", "", "libanesiska pund"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "srilankesisk rupie", PluralForms{"", "srilankesisk rupie", "", "", "", "srilankesiska rupier"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "liberiansk dollar", PluralForms{"", "liberiansk dollar", "", "", "", "liberianska dollar"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "lesothisk loti", PluralForms{"", "lesothisk loti", "", "", "", "lesothiska lotier"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "litauisk litas", PluralForms{"", "litauisk litas", "", "", "", "litauiska litai"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "litauisk talonas", PluralForms{"", "litauisk talonas", "", "", "", "litauiska talonas"}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "luxemburgsk franc (konvertibel)", PluralForms{"", "luxemburgsk franc (konvertibel)", "", "", "", "luxemburgska franc (konvertibla)"}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "luxemburgsk franc", PluralForms{"", "luxemburgsk franc", "", "", "", "luxemburgska franc"}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "luxemburgsk franc (finansiell)", PluralForms{"", "luxemburgsk franc (finansiell)", "", "", "", "luxemburgska franc (finansiella)"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "lettisk lats", PluralForms{"", "lettisk lats", "", "", "", "lettiska lati"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "lettisk rubel", PluralForms{"", "lettisk rubel", "", "", "", "lettiska rubel"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "libysk dinar", PluralForms{"", "libysk dinar", "", "", "", "libyska dinarer"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "marockansk dirham", PluralForms{"", "marockansk dirham", "", "", "", "marockanska dirhamer"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "marockansk franc", PluralForms{"", "marockansk franc", "", "", "", "marockanska franc"}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "monegaskisk franc (–2001)", PluralForms{"", "monegaskisk franc (–2001)", "", "", "", "monegaskiska franc (–2001)"}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "moldavisk cupon (1992–1993)", PluralForms{"", "moldavisk cupon (1992–1993)", "", "", "", "moldaviska cupon (1992–1993)"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "moldavisk leu", PluralForms{"", "moldavisk leu", "", "", "", "moldaviska lei"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "madagaskisk ariary", PluralForms{"", "madagaskisk ariary", "", "", "", "madagaskiska ariary"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "madagaskisk franc", PluralForms{"", "madagaskisk franc", "", "", "", "madagaskiska franc"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "makedonisk denar", PluralForms{"", "makedonisk denar", "", "", "", "makedoniska denarer"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "makedonisk denar (1992–1993)", PluralForms{"", "makedonisk denar (1992–1993)", "", "", "", "makedoniska denarer (1992–1993)"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "malisk franc", PluralForms{"", "malisk franc", "", "", "", "maliska franc"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "myanmarisk kyat", PluralForms{"", "myanmarisk kyat", "", "", "", "myanmariska kyat"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "mongolisk tögrög", PluralForms{"", "mongolisk tögrög", "", "", "", "mongoliska tögrög"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "makanesisk pataca", PluralForms{"", "makanesisk pataca", "", "", "", "makanesiska pataca"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "mauretansk ouguiya (1973–2017)", PluralForms{"", "mauretansk ouguiya (1973–2017)", "", "", "", "mauretanska ouguiya (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "mauretansk ouguiya", PluralForms{"", "mauretansk ouguiya", "", "", "", "mauretanska ouguiya"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "maltesisk lire", PluralForms{"", "maltesisk lire", "", "", "", "maltesiska lire"}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "maltesiskt pund", PluralForms{"", "maltesiskt pund", "", "", "", "maltesiska pund"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "mauritisk rupie", PluralForms{"", "mauritisk rupie", "", "", "", "mauritiska rupier"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "maldivisk rupie", PluralForms{"", "maldivisk rupie", "", "", "", "maldiviska rupier"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "maldivisk rufiyaa", PluralForms{"", "maldivisk rufiyaa", "", "", "", "maldiviska rufiyer"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "malawisk kwacha", PluralForms{"", "malawisk kwacha", "", "", "", "malawiska kwacha"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "mexikansk peso", PluralForms{"", "mexikansk peso", "", "", "", "mexikanska pesos"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "mexikansk silverpeso (1861–1992)", PluralForms{"", "mexikansk silverpeso (1861–1992)", "", "", "", "mexikanska silverpesos (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "mexikansk unidad de inversion", PluralForms{"", "mexikansk unidad de inversion", "", "", "", "mexikanska unidad de inversion"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "malaysisk ringgit", PluralForms{"", "malaysisk ringgit", "", "", "", "malaysiska ringgiter"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "moçambikisk escudo", PluralForms{"", "moçambikisk escudo (1914–1980)", "", "", "", "moçambikiska escudos"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "gammal moçambikisk metical", PluralForms{"", "moçambikisk metical (1980–2006)", "", "", "", "gammla moçambikiska metical"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "moçambikisk metical", PluralForms{"", "moçambikisk metical", "", "", "", "moçambikiska metical"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "namibisk dollar", PluralForms{"", "namibisk dollar", "", "", "", "namibiska dollar"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "nigeriansk naira", PluralForms{"", "nigeriansk naira", "", "", "", "nigerianska naira"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "nicaraguansk córdoba (1998–1991)", PluralForms{"", "nicaraguansk córdoba (1998–1991)", "", "", "", "nicaraguanska córdobas (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "nicaraguansk córdoba", PluralForms{"", "nicaraguansk córdoba", "", "", "", "nicaraguanska córdobas"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "nederländsk gulden", PluralForms{"", "nederländsk gulden", "", "", "", "nederländska gulden"}},
+		"NOK": {2, 0, 0, 1, "NOK", "Nkr", "kr", "norsk krona", PluralForms{"", "norsk krona", "", "", "", "norska kronor"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "nepalesisk rupie", PluralForms{"", "nepalesisk rupie", "", "", "", "nepalesiska rupier"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "nyzeeländsk dollar", PluralForms{"", "nyzeeländsk dollar", "", "", "", "nyzeeländska dollar"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "omansk rial", PluralForms{"", "omansk rial", "", "", "", "omanska rial"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "panamansk balboa", PluralForms{"", "panamansk balboa", "", "", "", "panamanska balboa"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "peruansk inti", PluralForms{"", "peruansk inti", "", "", "", "peruanska intier"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "peruansk sol", PluralForms{"", "peruansk sol", "", "", "", "peruanska sol"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "peruansk sol (1863–1965)", PluralForms{"", "peruansk sol (1863–1965)", "", "", "", "peruanska sol (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "papuansk kina", PluralForms{"", "papuansk kina", "", "", "", "papuanska kinor"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "filippinsk peso", PluralForms{"", "filippinsk peso", "", "", "", "filippinska pesos"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "pakistansk rupie", PluralForms{"", "pakistansk rupie", "", "", "", "pakistanska rupier"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "polsk zloty", PluralForms{"", "polsk zloty", "", "", "", "polska zloty"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "polsk zloty (1950–1995)", PluralForms{"", "polsk zloty (1950–1995)", "", "", "", "polska zloty (1950–1995)"}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "portugisisk escudo", PluralForms{"", "portugisisk escudo", "", "", "", "portugisiska escudos"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "paraguayansk guarani", PluralForms{"", "paraguayansk guarani", "", "", "", "paraguayska guarani"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "qatarisk rial", PluralForms{"", "qatarisk rial", "", "", "", "qatariska rial"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "rhodesisk dollar", PluralForms{"", "rhodesisk dollar", "", "", "", "rhodesiska dollar"}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "rumänsk leu (1952–2005)", PluralForms{"", "rumänsk leu (1952–2005)", "", "", "", "rumänska leu (1952–2005)"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "L", "rumänsk leu", PluralForms{"", "rumänsk leu", "", "", "", "rumänska lei"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "serbisk dinar", PluralForms{"", "serbisk dinar", "", "", "", "serbiska dinarer"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "rysk rubel", PluralForms{"", "rysk rubel", "", "", "", "ryska rubel"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "rysk rubel (1991–1998)", PluralForms{"", "rysk rubel (1991–1998)", "", "", "", "ryska rubel (1991–1998)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "rwandisk franc", PluralForms{"", "rwandisk franc", "", "", "", "rwandiska franc"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "saudisk riyal", PluralForms{"", "saudisk riyal", "", "", "", "saudiska riyal"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Salomondollar", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "seychellisk rupie", PluralForms{"", "seychellisk rupie", "", "", "", "seychelliska rupier"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "sudansk dinar (1992–2007)", PluralForms{"", "sudansk dinar (1992–2007)", "", "", "", "sudanska dinarer (1992–2007)"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "sudanesiskt pund", PluralForms{"", "sudanesiskt pund", "", "", "", "sudanesiska pund"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "sudanskt pund (1916–1992)", PluralForms{"", "sudanskt pund (1916–1992)", "", "", "", "sudanska pund (1916–1992)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "kr", "kr", "svensk krona", PluralForms{"", "svensk krona", "", "", "", "svenska kronor"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "singaporiansk dollar", PluralForms{"", "singaporiansk dollar", "", "", "", "singaporianska dollar"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "sankthelenskt pund", PluralForms{"", "sankthelenskt pund", "", "", "", "sankthelenska pund"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "slovensk tolar", PluralForms{"", "slovensk tolar", "", "", "", "slovenska tolar"}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "slovakisk koruna", PluralForms{"", "slovakisk krona", "", "", "", "slovakiska korunor"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "sierraleonsk leone", PluralForms{"", "sierraleonsk leone", "", "", "", "sierraleonska leoner"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "sierraleonsk leone (1964—2022)", PluralForms{"", "sierraleonsk leone (1964—2022)", "", "", "", "sierraleonska leoner (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "somalisk shilling", PluralForms{"", "somalisk shilling", "", "", "", "somaliska shilling"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "surinamesisk dollar", PluralForms{"", "surinamesisk dollar", "", "", "", "surinamesiska dollar"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "surinamesisk gulden", PluralForms{"", "surinamesisk gulden", "", "", "", "surinamesiska gulden"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "sydsudanesiskt pund", PluralForms{"", "sydsudanesiskt pund", "", "", "", "sydsudanesiska pund"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "saotomeansk dobra (1977–2017)", PluralForms{"", "saotomeansk dobra (1977–2017)", "", "", "", "saotomeanska dobra (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "saotomeansk dobra", PluralForms{"", "saotomeansk dobra", "", "", "", "saotomeanska dobra"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "sovjetisk rubel", PluralForms{"", "sovjetisk rubel", "", "", "", "sovjetiska rubler"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "salvadoransk colón", PluralForms{"", "salvadoransk colón", "", "", "", "salvadoranska colón"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "syriskt pund", PluralForms{"", "syriskt pund", "", "", "", "syriska pund"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "swaziländsk lilangeni", PluralForms{"", "swaziländsk lilangeni", "", "", "", "swaziländska lilangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "thailändsk baht", PluralForms{"", "thailändsk baht", "", "", "", "thailändska baht"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "tadzjikisk rubel", PluralForms{"", "tadzjikisk rubel", "", "", "", "tadzjikiska rubler"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "tadzjikisk somoni", PluralForms{"", "tadzjikisk somoni", "", "", "", "tadzjikiska somoni"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "turkmenistansk manat (1993–2009)", PluralForms{"", "turkmenistansk manat (1993–2009)", "", "", "", "turkmenistanska manat (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "turkmenistansk manat", PluralForms{"", "turkmenistansk manat", "", "", "", "turkmenistanska manat"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "tunisisk dinar", PluralForms{"", "tunisisk dinar", "", "", "", "tunisiska dinarer"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "tongansk paʻanga", PluralForms{"", "tongansk paʻanga", "", "", "", "tonganska paʻanga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "östtimoresisk escudo", PluralForms{"", "östtimoresisk escudo", "", "", "", "östtimoresiska escudos"}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "turkisk lire (1922–2005)", PluralForms{"", "turkisk lire (1922–2005)", "", "", "", "turkiska lire (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "turkisk lira", PluralForms{"", "turkisk lira", "", "", "", "turkiska lira"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trinidaddollar", PluralForms{"", "", "", "", "", ""}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "NT$", "taiwanesisk dollar", PluralForms{"", "taiwanesisk dollar", "", "", "", "taiwanesiska dollar"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "tanzanisk shilling", PluralForms{"", "tanzanisk shilling", "", "", "", "tanzaniska shilling"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ukrainsk hryvnia", PluralForms{"", "ukrainsk hryvnia", "", "", "", "ukrainska hryvnia"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "ukrainsk karbovanetz", PluralForms{"", "ukrainsk karbovanetz (1992–1996)", "", "", "", "ukrainska karbovanetz (1992–1996)"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "ugandisk shilling (1966–1987)", PluralForms{"", "ugandisk shilling (1966–1987)", "", "", "", "ugandiska shilling (1966–1987)"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ugandisk shilling", PluralForms{"", "ugandisk shilling", "", "", "", "ugandiska shilling"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "amerikansk dollar", PluralForms{"", "amerikansk dollar", "", "", "", "amerikanska dollar"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "US-dollar (nästa dag)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "US-dollar (samma dag)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "uruguayansk peso en unidades indexadas", PluralForms{"", "uruguayansk peso en unidades indexadas", "", "", "", "uruguayanska pesos en unidades indexadas"}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "uruguayansk peso (1975–1993)", PluralForms{"", "uruguayansk peso (1975–1993)", "", "", "", "uruguayanska pesos (1975–1993)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "uruguayansk peso", PluralForms{"", "uruguayansk peso", "", "", "", "uruguayanska pesos"}},
+		"UYW": {4, 0, 4, 1, "UYW", "UYW", "UYW", "uruguayansk indexenhet för nominell lön", PluralForms{"", "", "", "", "", ""}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "uzbekisk sum", PluralForms{"", "uzbekisk sum", "", "", "", "uzbekiska sum"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "venezuelansk bolivar (1871–2008)", PluralForms{"", "venezuelansk bolivar (1871–2008)", "", "", "", "venezuelanska bolivar (1871–2008)"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "venezuelansk bolívar (2008–2018)", PluralForms{"", "venezuelansk bolívar (2008–2018)", "", "", "", "venezuelanska bolívar (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "venezuelansk bolívar", PluralForms{"", "venezuelansk bolívar", "", "", "", "venezuelanska bolívar"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "vietnamesisk dong", PluralForms{"", "vietnamesisk dong", "", "", "", "vietnamesiska dong"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "vietnamesisk dong (1978–1985)", PluralForms{"", "vietnamesisk dong (1978–1985)", "", "", "", "vietnamesiska dong (1978–1985)"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "vanuatisk vatu", PluralForms{"", "vanuatisk vatu", "", "", "", "vanuatiska vatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "västsamoansk tala", PluralForms{"", "västsamoansk tala", "", "", "", "västsamoanska tala"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "centralafrikansk franc", PluralForms{"", "centralafrikansk franc", "", "", "", "centralafrikanska franc"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "silver", PluralForms{"", "uns silver", "", "", "", "silveruns"}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "guld", PluralForms{"", "uns guld", "", "", "", "gulduns"}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "europeisk kompositenhet", PluralForms{"", "europeisk kompositenhet", "", "", "", "europeiska kompositenheter"}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "europeisk monetär enhet", PluralForms{"", "europeisk monetär enhet", "", "", "", "europeiska monetära enheter"}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "europeisk kontoenhet (XBC)", PluralForms{"", "europeisk kontoenhet (XBC)", "", "", "", "europeiska kontoenheter (XBC)"}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "europeisk kontoenhet (XBD)", PluralForms{"", "europeisk kontoenhet (XBD)", "", "", "", "europeiska kontoenheter (XBD)"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "östkaribisk dollar", PluralForms{"", "östkaribisk dollar", "", "", "", "östkaribiska dollar"}},
+		"XCG": {2, 0, 2, 1, "XCG", "XCG", "XCG", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "IMF särskild dragningsrätt", PluralForms{"", "IMF särskild dragningsrätt", "", "", "", "IMF särskilda dragningsrätter"}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "europeisk valutaenhet", PluralForms{"", "europeisk valutaenhet", "", "", "", "europeiska valutaenheter"}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "fransk guldfranc", PluralForms{"", "fransk guldfranc", "", "", "", "franska guldfranc"}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "internationella järnvägsunionens franc", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "västafrikansk franc", PluralForms{"", "västafrikansk franc", "", "", "", "västafrikanska franc"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "palladium", PluralForms{"", "uns palladium", "", "", "", "palladium"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "CFP-franc", PluralForms{"", "", "", "", "", ""}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "platina", PluralForms{"", "uns platina", "", "", "", "platina"}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "RINET-fond", PluralForms{"", "", "", "", "", ""}},
+		"XSU": {2, 0, 2, 1, "XSU", "XSU", "XSU", "latinamerikansk sucre", PluralForms{"", "latinamerikansk sucre", "", "", "", "latinamerikanska sucre"}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "testvalutaenhet", PluralForms{"", "testvalutaenhet", "", "", "", "testvalutaenheter"}},
+		"XUA": {2, 0, 2, 1, "XUA", "XUA", "XUA", "afrikansk kontoenhet", PluralForms{"", "afrikansk kontoenhet", "", "", "", "afrikanska kontoenheter"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "okänd valuta", PluralForms{"", "(okänd valutaenhet)", "", "", "", "(okända valutaenheter)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "jemenitisk dinar", PluralForms{"", "jemenitisk dinar", "", "", "", "jemenitiska dinarer"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "jemenitisk rial", PluralForms{"", "jemenitisk rial", "", "", "", "jemenitiska rial"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "jugoslavisk dinar (1966–1990)", PluralForms{"", "jugoslavisk dinar (1966–1990)", "", "", "", "jugoslaviska dinarer (1966–1990)"}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "jugoslavisk dinar (1994–2002)", PluralForms{"", "jugoslavisk dinar (1994–2002)", "", "", "", "jugoslaviska dinarer (1994–2002)"}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "jugoslavisk dinar (1990–1992)", PluralForms{"", "jugoslavisk dinar (1990–1992)", "", "", "", "jugoslaviska dinarer (1990–1992)"}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "jugoslavisk dinar (1992–1993)", PluralForms{"", "jugoslavisk dinar (1992–1993)", "", "", "", "jugoslaviska dinarer (1992–1993)"}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "sydafrikansk rand (finansiell)", PluralForms{"", "sydafrikansk rand (finansiell)", "", "", "", "sydafrikanska rand (finansiella)"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "sydafrikansk rand", PluralForms{"", "sydafrikansk rand", "", "", "", "sydafrikanska rand"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "zambisk kwacha (1968–2012)", PluralForms{"", "zambisk kwacha (1968–2012)", "", "", "", "zambiska kwacha (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "zambisk kwacha", PluralForms{"", "zambisk kwacha", "", "", "", "zambiska kwacha"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "zairisk ny zaire", PluralForms{"", "zaïrisk ny zaïre", "", "", "", "zaïriska nya zaïre"}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "zairisk zaire", PluralForms{"", "zaïrisk zaïre", "", "", "", "zaïriska zaïre"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Zimbabwe-dollar", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Zimbabwe-dollar (2009)", PluralForms{"", "", "", "", "", ""}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Zimbabwe-dollar (2008)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
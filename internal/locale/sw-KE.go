@@ -6,181 +6,234 @@ var swKE = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "elfu\u00a00", "other": "elfu\u00a00"}},
+				{4, 2, map[string]string{"one": "elfu\u00a00", "other": "elfu\u00a00"}},
+				{5, 3, map[string]string{"one": "elfu\u00a00", "other": "elfu\u00a00"}},
+				{6, 1, map[string]string{"one": "M0", "other": "M0"}},
+				{7, 2, map[string]string{"one": "M0", "other": "M0"}},
+				{8, 3, map[string]string{"one": "M0", "other": "M0"}},
+				{9, 1, map[string]string{"one": "B0", "other": "B0"}},
+				{10, 2, map[string]string{"one": "B0", "other": "B0"}},
+				{11, 3, map[string]string{"one": "B0", "other": "B0"}},
+				{12, 1, map[string]string{"one": "T0", "other": "T0"}},
+				{13, 2, map[string]string{"one": "T0", "other": "T0"}},
+				{14, 3, map[string]string{"one": "T0", "other": "T0"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "elfu 0", "other": "elfu 0"}},
+				{4, 2, map[string]string{"one": "elfu 0", "other": "elfu 0"}},
+				{5, 3, map[string]string{"one": "elfu 0", "other": "elfu 0"}},
+				{6, 1, map[string]string{"one": "milioni 0", "other": "milioni 0"}},
+				{7, 2, map[string]string{"one": "milioni 0", "other": "milioni 0"}},
+				{8, 3, map[string]string{"one": "milioni 0", "other": "milioni 0"}},
+				{9, 1, map[string]string{"one": "bilioni 0", "other": "bilioni 0"}},
+				{10, 2, map[string]string{"one": "bilioni 0", "other": "bilioni 0"}},
+				{11, 3, map[string]string{"one": "bilioni 0", "other": "bilioni 0"}},
+				{12, 1, map[string]string{"one": "trilioni 0", "other": "trilioni 0"}},
+				{13, 2, map[string]string{"one": "trilioni 0", "other": "trilioni 0"}},
+				{14, 3, map[string]string{"one": "trilioni 0", "other": "trilioni 0"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a0elfu0", "other": "¤\u00a0elfu0"}},
+				{4, 2, map[string]string{"one": "¤\u00a0elfu0", "other": "¤\u00a0elfu0"}},
+				{5, 3, map[string]string{"one": "¤\u00a0laki0", "other": "¤\u00a0laki0"}},
+				{6, 1, map[string]string{"one": "¤\u00a0M0", "other": "¤\u00a0M0"}},
+				{7, 2, map[string]string{"one": "¤\u00a0M0", "other": "¤\u00a0M0"}},
+				{8, 3, map[string]string{"one": "¤\u00a0M0", "other": "¤\u00a0M0"}},
+				{9, 1, map[string]string{"one": "¤\u00a0B0", "other": "¤\u00a0B0"}},
+				{10, 2, map[string]string{"one": "¤\u00a0B0", "other": "¤\u00a0B0"}},
+				{11, 3, map[string]string{"one": "¤\u00a0B0", "other": "¤\u00a0B0"}},
+				{12, 1, map[string]string{"one": "¤\u00a0T0", "other": "¤\u00a0T0"}},
+				{13, 2, map[string]string{"one": "¤\u00a0T0", "other": "¤\u00a0T0"}},
+				{14, 3, map[string]string{"one": "¤\u00a0T0", "other": "¤\u00a0T0"}},
+			},
+		},
+		PluralForms{"", "{0} {1}", "", "", "", "{1} {0}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "Ksh", "Ksh"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TSh", "TSh"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Diramu ya Falme za Kiarabu", PluralForms{"", "diramu ya Falme za Kiarabu", "", "", "", "diramu za Falme za Kiarabu"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghani ya Afghanistani", PluralForms{"", "afghani ya Afghanistani", "", "", "", "afghani za Afghanistani"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lek ya Albania", PluralForms{"", "lek ya Albania", "", "", "", "lek za Albania"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dram ya Armenia", PluralForms{"", "dram ya Armenia", "", "", "", "dram za Armenia"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Gilda ya Antili ya Uholanzi", PluralForms{"", "gilda ya Antili ya Uholanzi", "", "", "", "gilda za Antili ya Uholanzi"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kwanza ya Angola", PluralForms{"", "kwanza ya Angola", "", "", "", "kwanza za Angola"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso ya Ajentina", PluralForms{"", "peso ya Ajentina", "", "", "", "peso za Ajentina"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dola ya Australia", PluralForms{"", "dola ya Australia", "", "", "", "dola za Australia"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Florin ya Aruba", PluralForms{"", "florin ya Aruba", "", "", "", "florin ya Aruba"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manati ya Azabajani", PluralForms{"", "manati ya Azabajani", "", "", "", "manati za Azabajani"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Maki ya Bosnia na Hezegovina Inayoweza Kubadilishwa", PluralForms{"", "maki ya Bosnia na Hezegovina inayoweza kubadilishwa", "", "", "", "maki za Bosnia na Hezegovina zinazoweza kubadilishwa"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Dola ya Babadosi", PluralForms{"", "dola ya Babadosi", "", "", "", "dola za Babadosi"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka ya Bangladeshi", PluralForms{"", "taka ya Bangladeshi", "", "", "", "taka za Bangladeshi"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lev ya Bulgaria", PluralForms{"", "lev ya Bulgaria", "", "", "", "lev za Bulgaria"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinari ya Bahareni", PluralForms{"", "dinari ya Bahareni", "", "", "", "dinari za Bahareni"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Faranga ya Burundi", PluralForms{"", "faranga ya Burundi", "", "", "", "faranga za Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dola ya Bamuda", PluralForms{"", "dola ya Bamuda", "", "", "", "dola za Bamuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dola ya Brunei", PluralForms{"", "dola ya Brunei", "", "", "", "dola za Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviano ya Bolivia", PluralForms{"", "boliviano ya Bolivia", "", "", "", "boliviano za Bolivia"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Reale ya Brazili", PluralForms{"", "reale ya Brazili", "", "", "", "reale za Brazili"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dola ya Bahama", PluralForms{"", "dola ya Bahama", "", "", "", "dola za Bahama"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrumi ya Bhutani", PluralForms{"", "ngultrumi ya Bhutani", "", "", "", "ngultrumi za Bhutani"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula ya Botswana", PluralForms{"", "pula ya Botswana", "", "", "", "pula za Botswana"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Ruble ya Belarusi", PluralForms{"", "ruble ya Belarusi", "", "", "", "ruble za Belarusi"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Ruble ya Belarusi (2000–2016)", PluralForms{"", "Ruble ya Belarusi (2000–2016)", "", "", "", "Ruble za Belarusi (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dola ya Belize", PluralForms{"", "dola ya Belize", "", "", "", "dola za Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dola ya Kanada", PluralForms{"", "dola ya Kanada", "", "", "", "dola za Kanada"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Faranga ya Kongo", PluralForms{"", "faranga ya Kongo", "", "", "", "faranga za Kongo"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Faranga ya Uswisi", PluralForms{"", "faranga ya Uswisi", "", "", "", "faranga za Uswisi"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso ya Chile", PluralForms{"", "peso ya Chile", "", "", "", "peso za Chile"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yuan ya China (huru)", PluralForms{"", "yuan ya China (huru)", "", "", "", "yuan ya China (huru)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Yuan ya China", PluralForms{"", "yuan ya China", "", "", "", "yuan za China"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso ya Kolombia", PluralForms{"", "peso ya Kolombia", "", "", "", "peso za Kolombia"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colon ya Kostarika", PluralForms{"", "colon ya Kostarika", "", "", "", "colon za Kostarika"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso ya Kuba Inayoweza Kubadilishwa", PluralForms{"", "peso ya Kuba inayoweza kubadilishwa", "", "", "", "peso za Kuba zinazoweza kubadilishwa"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso ya Kuba", PluralForms{"", "peso ya Kuba", "", "", "", "peso za Kuba"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Eskudo ya Kepuvede", PluralForms{"", "eskudo ya Kepuvede", "", "", "", "eskudo za Kepuvede"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna ya Cheki", PluralForms{"", "koruna ya Cheki", "", "", "", "koruna za Cheki"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Faranga ya Jibuti", PluralForms{"", "faranga ya Jibuti", "", "", "", "faranga za Jibuti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Kroni ya Denmaki", PluralForms{"", "kroni ya Denmaki", "", "", "", "kroni za Denmaki"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso ya Dominika", PluralForms{"", "peso ya Dominika", "", "", "", "peso za Dominika"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinari ya Aljeria", PluralForms{"", "dinari ya Aljeria", "", "", "", "dinari za Aljeria"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Pauni ya Misri", PluralForms{"", "pauni ya Misri", "", "", "", "pauni za Misri"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa ya Eritrea", PluralForms{"", "nakfa ya Eritrea", "", "", "", "nakfa za Eritrea"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birr ya Uhabeshi", PluralForms{"", "birr ya Uhabeshi", "", "", "", "birr za Uhabeshi"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Yuro", PluralForms{"", "yuro", "", "", "", "yuro"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dola ya Fiji", PluralForms{"", "dola ya Fiji", "", "", "", "dola za Fiji"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Pauni ya Visiwa vya Falkland", PluralForms{"", "pauni ya Visiwa vya Falkland", "", "", "", "pauni za Visiwa vya Falkland"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Pauni ya Uingereza", PluralForms{"", "pauni ya Uingereza", "", "", "", "pauni za Uingereza"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lari ya Jiojia", PluralForms{"", "lari ya Jiojia", "", "", "", "lari za Jiojia"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Sedi ya Ghana", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Sidi ya Ghana", PluralForms{"", "sidi ya Ghana", "", "", "", "sidi za Ghana"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Pauni ya Jibrata", PluralForms{"", "pauni ya Jibrata", "", "", "", "pauni za Jibrata"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi ya Gambia", PluralForms{"", "dalasi ya Gambia", "", "", "", "dalasi za Gambia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Faranga ya Guinea", PluralForms{"", "faranga ya Guinea", "", "", "", "faranga za Guinea"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Faranga ya Gine", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal ya Guatemala", PluralForms{"", "quetzal ya Guatemala", "", "", "", "quetzal za Guatemala"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dola ya Guyana", PluralForms{"", "dola ya Guyana", "", "", "", "dola za Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dola ya Hong Kong", PluralForms{"", "dola ya Hong Kong", "", "", "", "dola za Hong Kong"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira ya Hondurasi", PluralForms{"", "lempira ya Hondurasi", "", "", "", "lempira za Hondurasi"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuna ya Kroeshia", PluralForms{"", "kuna ya Kroeshia", "", "", "", "kuna za Kroeshia"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Godi ya Haiti", PluralForms{"", "godi ya Haiti", "", "", "", "godi za Haiti"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Forinti ya Hungaria", PluralForms{"", "forinti ya Hungaria", "", "", "", "forinti za Hungaria"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupia ya Indonesia", PluralForms{"", "rupia ya Indonesia", "", "", "", "rupia za Indonesia"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Shekeli Mpya ya Israel", PluralForms{"", "shekeli mpya ya Israel", "", "", "", "shekeli mpya za Israel"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupia ya India", PluralForms{"", "rupia ya India", "", "", "", "rupia za India"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinari ya Iraki", PluralForms{"", "dinari ya Iraki", "", "", "", "dinari za Iraki"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Riali ya Irani", PluralForms{"", "riali ya Irani", "", "", "", "riali za Irani"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Krona ya Aisilandi", PluralForms{"", "krona ya Aisilandi", "", "", "", "krona za Aisilandi"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dola ya Jamaika", PluralForms{"", "dola ya Jamaika", "", "", "", "dola za Jamaika"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinari ya Yordani", PluralForms{"", "dinari ya Yordani", "", "", "", "dinari za Yordani"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yeni ya Japani", PluralForms{"", "yeni ya japani", "", "", "", "yeni za japani"}},
+		"KES": {2, 0, 2, 1, "KES", "Ksh", "Ksh", "Shilingi ya Kenya", PluralForms{"", "shilingi ya Kenya", "", "", "", "shilingi za Kenya"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som ya Kyrgystan", PluralForms{"", "som ya Kyrgystan", "", "", "", "som za Kyrgystan"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Rieli ya Kambodia", PluralForms{"", "rieli ya Kambodia", "", "", "", "rieli za Kambodia"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Faranga ya Komoro", PluralForms{"", "faranga ya Komoro", "", "", "", "faranga za Komoro"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won ya Korea Kaskazini", PluralForms{"", "won ya Korea Kaskazini", "", "", "", "won za Korea Kaskazini"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Won ya Korea Kusini", PluralForms{"", "won ya Korea Kusini", "", "", "", "won za Korea Kusini"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinari ya Kuwait", PluralForms{"", "dinari ya Kuwait", "", "", "", "dinari za Kuwait"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dola ya Visiwa vya Cayman", PluralForms{"", "dola ya Visiwa vya Cayman", "", "", "", "dola za Visiwa vya Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge ya Kazakistani", PluralForms{"", "tenge ya Kazakistani", "", "", "", "tenge za Kazakistani"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kip ya Laosi", PluralForms{"", "kip ya Laosi", "", "", "", "kip za Laosi"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Pauni ya Lebanoni", PluralForms{"", "pauni ya Lebanoni", "", "", "", "pauni za Lebanoni"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rupia ya Sri Lanka", PluralForms{"", "rupia ya Sri Lanka", "", "", "", "rupia za Sri Lanka"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dola ya Liberia", PluralForms{"", "dola ya Liberia", "", "", "", "dola za Liberia"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti ya Lesoto", PluralForms{"", "Loti za Lesoto", "", "", "", "Loti za Lesoto"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas ya Lithuania", PluralForms{"", "Litas ya Lithuania", "", "", "", "Litas za Lithuania"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats ya Lativia", PluralForms{"", "Lats ya Lativia", "", "", "", "Lats za Lativia"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinari ya Libya", PluralForms{"", "dinari ya Libya", "", "", "", "dinari za Libya"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Diramu ya Moroko", PluralForms{"", "diramu ya Moroko", "", "", "", "diramu za Moroko"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leu ya Moldova", PluralForms{"", "leu ya Moldova", "", "", "", "leu za Moldova"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ariari ya Madagaska", PluralForms{"", "ariari ya Madagaska", "", "", "", "Ariari za Madagaska"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Dinari ya Masedonia", PluralForms{"", "dinari ya Masedonia", "", "", "", "dinari za Masedonia"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kiati ya Myama", PluralForms{"", "kiati ya Myama", "", "", "", "kiati za Myama"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik ya Mongolia", PluralForms{"", "tugrik ya Mongolia", "", "", "", "tugrik za Mongolia"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataka ya Macau", PluralForms{"", "pataka ya Macau", "", "", "", "pataka za Macau"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya ya Mauritania (1973–2017)", PluralForms{"", "ouguiya ya Mauritania (1973–2017)", "", "", "", "ouguiya za Mauritania (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya ya Moritania", PluralForms{"", "ouguiya ya Moritania", "", "", "", "ouguiya za Moritania"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rupia ya Morisi", PluralForms{"", "rupia ya Morisi", "", "", "", "rupia za Morisi"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyaa ya Maldivi", PluralForms{"", "rufiyaa ya Maldivi", "", "", "", "rufiyaa za Maldivi"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kwacha ya Malawi", PluralForms{"", "kwacha ya Malawi", "", "", "", "kwacha za Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso ya Meksiko", PluralForms{"", "peso ya Meksiko", "", "", "", "peso za Meksiko"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringgiti ya Malesia", PluralForms{"", "ringgiti ya Malesia", "", "", "", "ringgiti za Malesia"}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metikali ya Msumbiji (1980–2006)", PluralForms{"", "metikali ya Msumbiji (1980–2006)", "", "", "", "metikali ya Msumbiji (1980–2006)"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metikali ya Msumbiji", PluralForms{"", "metikali ya Msumbiji", "", "", "", "metikali za Msumbiji"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dola ya Namibia", PluralForms{"", "dola ya Namibia", "", "", "", "dola za Namibia"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naira ya Naijeria", PluralForms{"", "naira ya Naijeria", "", "", "", "Naira za Naijeria"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Cordoba ya Nikaragwa", PluralForms{"", "cordoba ya Nikaragwa", "", "", "", "cordoba za Nikaragwa"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Kroni ya Norwe", PluralForms{"", "kroni ya Norwe", "", "", "", "kroni za Norwe"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rupia ya Nepali", PluralForms{"", "rupia ya Nepali", "", "", "", "rupia za Nepali"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dola ya Nyuzilandi", PluralForms{"", "dola ya Nyuzilandi", "", "", "", "dola za Nyuzilandi"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Riali ya Omani", PluralForms{"", "riali ya Omani", "", "", "", "riali za Omani"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa ya Panama", PluralForms{"", "balboa za Panama", "", "", "", "balboa za Panama"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol ya Peru", PluralForms{"", "sol ya Peru", "", "", "", "sol za Peru"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina ya Papua New Guinea", PluralForms{"", "kina ya Papua New Guinea", "", "", "", "kina za Papua New Guinea"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Peso ya Ufilipino", PluralForms{"", "peso ya Ufilipino", "", "", "", "peso za Ufilipino"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rupia ya Pakistani", PluralForms{"", "rupia ya Pakistani", "", "", "", "rupia za Pakistani"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Zloti ya Polandi", PluralForms{"", "zloti ya Polandi", "", "", "", "zloti za Polandi"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guarani ya Paragwai", PluralForms{"", "guarani ya Paragwai", "", "", "", "guarani za Paragwai"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Riali ya Katari", PluralForms{"", "riali ya Katari", "", "", "", "riali za Katari"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Leu ya Romania", PluralForms{"", "leu ya Romania", "", "", "", "leu za Romania"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinari ya Serbia", PluralForms{"", "dinari ya Serbia", "", "", "", "dinari za Serbia"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Ruble ya Urusi", PluralForms{"", "ruble ya Urusi", "", "", "", "ruble za Urusi"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Faranga ya Rwanda", PluralForms{"", "faranga ya Rwanda", "", "", "", "faranga za Rwanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyali ya Saudia", PluralForms{"", "riyali ya Saudia", "", "", "", "riyali za Saudia"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dola ya Visiwa vya Solomoni", PluralForms{"", "dola ya Visiwa vya Solomoni", "", "", "", "dola za Visiwa vya Solomoni"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rupia ya Ushelisheli", PluralForms{"", "rupia ya Ushelisheli", "", "", "", "rupia za Ushelisheli"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Pauni ya Sudani", PluralForms{"", "pauni ya Sudani", "", "", "", "pauni za Sudani"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Pauni ya Sudani (1957–1998)", PluralForms{"", "pauni ya Sudani (1957–1998)", "", "", "", "pauni za Sudani (1957–1998)"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Krona ya Uswidi", PluralForms{"", "krona ya Uswidi", "", "", "", "krona za Uswidi"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dola ya Singapoo", PluralForms{"", "dola ya Singapoo", "", "", "", "dola za Singapoo"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Pauni ya St. Helena", PluralForms{"", "pauni ya St. Helena", "", "", "", "pauni za St. Helena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leoni ya Siera Leoni", PluralForms{"", "leoni ya Siera Leoni", "", "", "", "leoni za Siera Leoni"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leoni ya Siera Leoni (1964—2022)", PluralForms{"", "leoni ya Siera Leoni (1964—2022)", "", "", "", "leoni za Siera Leoni (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Shilingi ya Somalia", PluralForms{"", "shilingi ya Somalia", "", "", "", "shilingi za Somalia"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dola ya Suriname", PluralForms{"", "dola ya Suriname", "", "", "", "dola za Suriname"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Pauni ya Sudani Kusini", PluralForms{"", "pauni ya Sudani Kusini", "", "", "", "pauni za Sudani Kusini"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra ya Sao Tome na Principe (1977–2017)", PluralForms{"", "dobra ya Sao Tome na Principe (1977–2017)", "", "", "", "dobra za Sao Tome na Principe (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra ya Sao Tome na Principe", PluralForms{"", "dobra ya Sao Tome na Principe", "", "", "", "dobra za Sao Tome na Principe"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Pauni ya Syria", PluralForms{"", "pauni ya Syria", "", "", "", "pauni za Syria"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni ya Uswazi", PluralForms{"", "lilangeni ya Uswazi", "", "", "", "lilangeni za Uswazi"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Bahti ya Tailandi", PluralForms{"", "bahti ya Tailandi", "", "", "", "bahti za Tailandi"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni ya Tajikistani", PluralForms{"", "somoni ya Tajikistani", "", "", "", "somoni za Tajikistani"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manati ya Turkmenistani", PluralForms{"", "manati ya Turkmenistani", "", "", "", "manati za Turkmenistani"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinari ya Tunisia", PluralForms{"", "dinari ya Tunisia", "", "", "", "dinari za Tunisia"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga ya Tonga", PluralForms{"", "paʻanga ya Tonga", "", "", "", "paʻanga za Tonga"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lira ya Uturuki", PluralForms{"", "lira ya Uturuki", "", "", "", "lira za Uturuki"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dola ya Trinidadi na Tobago", PluralForms{"", "dola ya Trinidadi na Tobago", "", "", "", "dola za Trinidadi na Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Dola ya Taiwani", PluralForms{"", "dola ya Taiwani", "", "", "", "dola za Taiwani"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TSh", "TSh", "Shilingi ya Tanzania", PluralForms{"", "shilingi ya Tanzania", "", "", "", "shilingi za Tanzania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Hryvnia ya Ukraini", PluralForms{"", "hryvnia ya Ukraini", "", "", "", "hryvnia za Ukraini"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Shilingi ya Uganda", PluralForms{"", "shilingi ya Uganda", "", "", "", "shilingi za Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dola ya Marekani", PluralForms{"", "dola ya Marekani", "", "", "", "dola za Marekani"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso ya Urugwai", PluralForms{"", "peso ya Urugwai", "", "", "", "peso za Urugwai"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Som ya Uzbekistani", PluralForms{"", "som ya Uzbekistani", "", "", "", "som za Uzbekistani"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolivar ya Venezuela (2008–2018)", PluralForms{"", "Bolivar ya Venezuela (2008–2018)", "", "", "", "Bolivar za Venezuela (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Boliva ya Venezuela", PluralForms{"", "boliva ya Venezuele", "", "", "", "boliva za Venezuela"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dong ya Vietnamu", PluralForms{"", "dong ya Vietnamu", "", "", "", "Dong za Vietnamu"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu ya Vanuatu", PluralForms{"", "vatu ya Vanuatu", "", "", "", "vatu za Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala ya Samoa", PluralForms{"", "tala ya Samoa", "", "", "", "tala za Samoa"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Faranga ya CFA ya Afrika ya Kati", PluralForms{"", "faranga ya CFA ya Afrika ya Kati", "", "", "", "faranga ya CFA ya Afrika ya Kati"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dola ya Karibi Mashariki", PluralForms{"", "dola ya Karibi Mashariki", "", "", "", "dola za Karibi Mashariki"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Faranga ya CFA ya Afrika Magharibi", PluralForms{"", "faranga ya CFA ya Afrika Magharibi", "", "", "", "faranga za CFA ya Afrika Magharibi"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Faranga ya CFP", PluralForms{"", "faranga ya CFP", "", "", "", "faranga za CFP"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Sarafu Isiyojulikana", PluralForms{"", "(sarafu isiyojulikana)", "", "", "", "(sarafu isiyojulikana)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Riali ya Yemeni", PluralForms{"", "riali ya Yemeni", "", "", "", "riali za Yemeni"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Randi ya Afrika Kusini", PluralForms{"", "randi ya Afrika Kusini", "", "", "", "randi za Afrika Kusini"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kwacha ya Zambia (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kwacha ya Zambia", PluralForms{"", "kwacha ya Zambia", "", "", "", "kwacha za Zambia"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dola ya Zimbabwe", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,176 +6,229 @@ var taSG = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤\u00a0", "", "-¤\u00a0", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0ஆ", "other": "0ஆ"}},
+				{4, 2, map[string]string{"one": "0ஆ", "other": "0ஆ"}},
+				{5, 3, map[string]string{"one": "0ஆ", "other": "0ஆ"}},
+				{6, 1, map[string]string{"one": "0மி", "other": "0மி"}},
+				{7, 2, map[string]string{"one": "0மி", "other": "0மி"}},
+				{8, 3, map[string]string{"one": "0மி", "other": "0மி"}},
+				{9, 1, map[string]string{"one": "0பி", "other": "0பி"}},
+				{10, 2, map[string]string{"one": "0பி", "other": "0பி"}},
+				{11, 3, map[string]string{"one": "0பி", "other": "0பி"}},
+				{12, 1, map[string]string{"one": "0டி", "other": "0டி"}},
+				{13, 2, map[string]string{"one": "0டி", "other": "0டி"}},
+				{14, 3, map[string]string{"one": "0டி", "other": "0டி"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 ஆயிரம்", "other": "0 ஆயிரம்"}},
+				{4, 2, map[string]string{"one": "0 ஆயிரம்", "other": "0 ஆயிரம்"}},
+				{5, 3, map[string]string{"one": "0 ஆயிரம்", "other": "0 ஆயிரம்"}},
+				{6, 1, map[string]string{"one": "0 மில்லியன்", "other": "0 மில்லியன்"}},
+				{7, 2, map[string]string{"one": "0 மில்லியன்", "other": "0 மில்லியன்"}},
+				{8, 3, map[string]string{"one": "0 மில்லியன்", "other": "0 மில்லியன்"}},
+				{9, 1, map[string]string{"one": "0 பில்லியன்", "other": "0 பில்லியன்"}},
+				{10, 2, map[string]string{"one": "0 பில்லியன்", "other": "0 பில்லியன்"}},
+				{11, 3, map[string]string{"one": "0 பில்லியன்", "other": "0 பில்லியன்"}},
+				{12, 1, map[string]string{"one": "0 டிரில்லியன்", "other": "0 டிரில்லியன்"}},
+				{13, 2, map[string]string{"one": "0 டிரில்லியன்", "other": "0 டிரில்லியன்"}},
+				{14, 3, map[string]string{"one": "0 டிரில்லியன்", "other": "0 டிரில்லியன்"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤\u00a00ஆ", "other": "¤\u00a00ஆ"}},
+				{4, 2, map[string]string{"one": "¤\u00a00ஆ", "other": "¤\u00a00ஆ"}},
+				{5, 3, map[string]string{"one": "¤\u00a00ஆ", "other": "¤\u00a00ஆ"}},
+				{6, 1, map[string]string{"one": "¤\u00a00மி", "other": "¤\u00a00மி"}},
+				{7, 2, map[string]string{"one": "¤\u00a00மி", "other": "¤\u00a00மி"}},
+				{8, 3, map[string]string{"one": "¤\u00a00மி", "other": "¤\u00a00மி"}},
+				{9, 1, map[string]string{"one": "¤\u00a00பி", "other": "¤0பி"}},
+				{10, 2, map[string]string{"one": "¤\u00a00பி", "other": "¤\u00a00பி"}},
+				{11, 3, map[string]string{"one": "¤\u00a00பி", "other": "¤0பி"}},
+				{12, 1, map[string]string{"one": "¤\u00a00டி", "other": "¤\u00a00டி"}},
+				{13, 2, map[string]string{"one": "¤\u00a00டி", "other": "¤\u00a00டி"}},
+				{14, 3, map[string]string{"one": "¤\u00a00டி", "other": "¤\u00a00டி"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "RM", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "$", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "ஐக்கிய அரபு எமிரேட்ஸ் திர்ஹாம்", PluralForms{"", "ஐக்கிய அரபு எமிரேட்ஸ் திர்ஹாம்", "", "", "", "ஐக்கிய அரபு எமிரேட்ஸ் திர்ஹாம்கள்"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "ஆஃப்கான் ஆஃப்கானி", PluralForms{"", "ஆஃப்கான் ஆஃப்கானி", "", "", "", "ஆஃப்கான் ஆஃப்கானிகள்"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "அல்பேனியன் லெக்", PluralForms{"", "அல்பேனியன் லெக்", "", "", "", "அல்பேனியன் லெகே"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "ஆர்மேனியன் ட்ராம்", PluralForms{"", "ஆர்மேனியன் ட்ராம்", "", "", "", "ஆர்மேனியன் ட்ராம்கள்"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "நெதர்லேண்ட்ஸ் அன்டிலியன் கில்டர்", PluralForms{"", "நெதர்லேண்ட்ஸ் அன்டிலியன் கில்டர்", "", "", "", "நெதர்லேண்ட்ஸ் அன்டிலியன் கில்டர்கள்"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "அங்கோலன் க்வான்ஸா", PluralForms{"", "அங்கோலன் க்வான்ஸா", "", "", "", "அங்கோலன் க்வான்ஸாக்கள்"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "அர்ஜென்டைன் பெசோ", PluralForms{"", "அர்ஜென்டைன் பெசோ", "", "", "", "அர்ஜென்டைன் பெசோக்கள்"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ஆஸ்திரேலிய டாலர்", PluralForms{"", "ஆஸ்திரேலிய டாலர்", "", "", "", "ஆஸ்திரேலிய டாலர்கள்"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "அருபன் ஃப்ளோரின்", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "அசர்பைஜானி மனத்", PluralForms{"", "அஜர்பைசானி மனத்", "", "", "", "அசர்பைஜானி மனத்கள்"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "போஸ்னியா-ஹெர்ஸேகோவினா கன்வெர்டிபில் மார்க்", PluralForms{"", "போஸ்னியா-ஹெர்ஸேகோவினா கன்வெர்டிபில் மார்க்", "", "", "", "போஸ்னியா-ஹெர்ஸேகோவினா கன்வெர்டிபில் மார்க்குகள்"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "பார்பேடியன் டாலர்", PluralForms{"", "பார்பேடியன் டாலர்", "", "", "", "பார்பேடியன் டாலர்கள்"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "பங்களாதேஷி டாகா", PluralForms{"", "பங்களாதேஷி டாகா", "", "", "", "பங்களாதேஷி டாகாக்கள்"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "பல்கேரியன் லேவ்", PluralForms{"", "பல்கேரியன் லேவ்", "", "", "", "பல்கேரியன் லேவா"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "பஹ்ரைனி தினார்", PluralForms{"", "பஹ்ரைனி தினார்", "", "", "", "பஹ்ரைனி தினார்கள்"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "புருண்டியன் ஃப்ராங்க்", PluralForms{"", "புருண்டியன் ஃப்ராங்க்", "", "", "", "புருண்டியன் ஃப்ராங்க்குகள்"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "பெர்முடன் டாலர்", PluralForms{"", "பெர்முடன் டாலர்", "", "", "", "பெர்முடன் டாலர்கள்"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "புரூனே டாலர்", PluralForms{"", "புரூனே டாலர்", "", "", "", "புரூனே டாலர்கள்"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "பொலிவியன் பொலிவியானோ", PluralForms{"", "பொலிவியன் பொலிவியானோ", "", "", "", "பொலிவியன் பொலிவியானோக்கள்"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "பிரேசிலியன் ரியால்", PluralForms{"", "பிரேசிலியன் ரியால்", "", "", "", "பிரேசிலியன் ரியால்கள்"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "பஹாமியன் டாலர்", PluralForms{"", "பஹாமியன் டாலர்", "", "", "", "பஹாமியன் டாலர்கள்"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "பூட்டானீஸ் குல்ட்ரம்", PluralForms{"", "பூட்டானீஸ் குல்ட்ரம்", "", "", "", "பூட்டானீஸ் குல்ட்ரம்கள்"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "போட்ஸ்வானன் புலா", PluralForms{"", "போட்ஸ்வானன் புலா", "", "", "", "போட்ஸ்வானன் புலாக்கள்"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "பெலருசியன் ரூபிள்", PluralForms{"", "பெலருசியன் ரூபிள்", "", "", "", "பெலருசியன் ரூபிள்கள்"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "பெலருசியன் ரூபிள் (2000–2016)", PluralForms{"", "பெலருசியன் ரூபிள் (2000–2016)", "", "", "", "பெலருசியன் ரூபிள்கள் (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "பெலீஸ் டாலர்", PluralForms{"", "பெலீஸ் டாலர்", "", "", "", "பெலீஸ் டாலர்கள்"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "கனடியன் டாலர்", PluralForms{"", "கனடியன் டாலர்", "", "", "", "கனடியன் டாலர்கள்"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "காங்கோலீஸ் ஃப்ராங்க்", PluralForms{"", "காங்கோலீஸ் ஃப்ராங்க்", "", "", "", "காங்கோலீஸ் ஃப்ராங்க்குகள்"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "சுவிஸ் ஃப்ராங்க்", PluralForms{"", "சுவிஸ் ஃப்ராங்க்", "", "", "", "சுவிஸ் ஃப்ராங்குகள்"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "சிலியன் பெசோ", PluralForms{"", "சிலியன் பெசோ", "", "", "", "சிலியன் பெசோக்கள்"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "சீன யுவான் (ஆஃப்ஷோர்)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "சீன யுவான்", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "கொலம்பியன் பெசோ", PluralForms{"", "கொலம்பியன் பெசோ", "", "", "", "கொலம்பியன் பெசோக்கள்"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "கோஸ்டா ரிகன் கொலோன்", PluralForms{"", "கோஸ்டா ரிகன் கொலோன்", "", "", "", "கோஸ்டா ரிகன் கொலோன்கள்"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "கியூபன் கன்வெர்டிபில் பெசோ", PluralForms{"", "கியூபன் கன்வெர்டிபில் பெசோ", "", "", "", "கியூபன் கன்வெர்டிபில் பெசோக்கள்"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "கியூபன் பெசோ", PluralForms{"", "கியூபன் பெசோ", "", "", "", "கியூபன் பெசோக்கள்"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "கேப் வெர்டியன் எஸ்குடோ", PluralForms{"", "கேப் வெர்டியன் எஸ்குடோ", "", "", "", "கேப் வெர்டியன் எஸ்குடோக்கள்"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "செக் குடியரசு கொருனா", PluralForms{"", "செக் குடியரசு கொருனா", "", "", "", "செக் குடியரசு கொருனாக்கள்"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "ஜிபவ்டியென் ஃப்ராங்க்", PluralForms{"", "ஜிபவ்டியென் ஃப்ராங்க்", "", "", "", "ஜிபவ்டியென் ஃப்ராங்க்குகள்"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "டேனிஷ் க்ரோன்", PluralForms{"", "டேனிஷ் க்ரோன்", "", "", "", "டேனிஷ் க்ரோனர்"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "டொமினிக்கன் பெசோ", PluralForms{"", "டொமினிக்கன் பெசோ", "", "", "", "டொமினிக்கன் பெசோக்கள்"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "அல்ஜீரியன் தினார்", PluralForms{"", "அல்ஜீரியன் தினார்", "", "", "", "அல்ஜீரியன் தினார்கள்"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "எகிப்திய பவுண்டு", PluralForms{"", "எகிப்திய பவுண்டு", "", "", "", "எகிப்திய பவுண்டுகள்"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "எரித்ரியன் நக்ஃபா", PluralForms{"", "எரித்ரியன் நக்ஃபா", "", "", "", "எரித்ரியன் நக்ஃபாக்கள்"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "எத்தியோப்பியன் பிர்", PluralForms{"", "எத்தியோப்பியன் பிர்", "", "", "", "எத்தியோப்பியன் பிர்கள்"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "யூரோ", PluralForms{"", "யூரோ", "", "", "", "யூரோக்கள்"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ஃபிஜியன் டாலர்", PluralForms{"", "ஃபிஜியன் டாலர்", "", "", "", "ஃபிஜியன் டாலர்கள்"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ஃபாக்லாந்து தீவுகள் பவுண்டு", PluralForms{"", "ஃபாக்லாந்து தீவுகள் பவுண்டு", "", "", "", "ஃபாக்லாந்து தீவுகள் பவுண்டுகள்"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "பிரிட்டிஷ் பவுண்டு", PluralForms{"", "பிரிட்டிஷ் பவுண்டு", "", "", "", "பிரிட்டிஷ் பவுண்டுகள்"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "ஜார்ஜியன் லாரி", PluralForms{"", "ஜார்ஜியன் லாரி", "", "", "", "ஜார்ஜியன் லாரிகள்"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "கானயன் சேடி", PluralForms{"", "கானயன் சேடி", "", "", "", "கானயன் சேடிகள்"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "ஜிப்ரால்டர் பவுண்டு", PluralForms{"", "ஜிப்ரால்டர் பவுண்டு", "", "", "", "ஜிப்ரால்டர் பவுண்டுகள்"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "கேம்பியன் தலாசி", PluralForms{"", "கேம்பியன் தலாசி", "", "", "", "கேம்பியன் தலாசிகள்"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "கினியன் ஃப்ராங்க்", PluralForms{"", "கினியன் ஃப்ராங்க்", "", "", "", "கினியன் ஃப்ராங்குகள்"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "குவாதெமாலன் க்யுட்ஸல்", PluralForms{"", "குவாதெமாலன் க்யுட்ஸல்", "", "", "", "குவாதெமாலன் க்யுட்ஸல்கள்"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "கயானீஸ் டாலர்", PluralForms{"", "கயானீஸ் டாலர்", "", "", "", "கயானீஸ் டாலர்கள்"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "ஹாங்காங் டாலர்", PluralForms{"", "ஹாங்காங் டாலர்", "", "", "", "ஹாங்காங் டாலர்கள்"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "ஹோன்டூரன் லெம்பீரா", PluralForms{"", "ஹோன்டூரன் லெம்பீரா", "", "", "", "ஹோன்டூரன் லெம்பீராக்கள்"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "குரோஷியன் குனா", PluralForms{"", "குரோஷியன் குனா", "", "", "", "குரோஷியன் குனாக்கள்"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "ஹைட்டியன் கோர்டே", PluralForms{"", "ஹைட்டியன் கோர்டே", "", "", "", "ஹைட்டியன் கோர்டேக்கள்"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ஹங்கேரியன் ஃபோரின்ட்", PluralForms{"", "ஹங்கேரியன் ஃபோரின்ட்", "", "", "", "ஹங்கேரியன் ஃபோரின்ட்கள்"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "இந்தோனேஷியன் ருபியா", PluralForms{"", "இந்தோனேஷியன் ருபியா", "", "", "", "இந்தோனேஷியன் ருபியாக்கள்"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "இஸ்ரேலி நியூ ஷிகேல்", PluralForms{"", "இஸ்ரேலி நியூ ஷிகேல்", "", "", "", "இஸ்ரேலி நியூ ஷிகேல்கள்"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "இந்திய ரூபாய்", PluralForms{"", "இந்திய ரூபாய்", "", "", "", "இந்திய ரூபாய்கள்"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ஈராக்கி தினார்", PluralForms{"", "ஈராக்கி தினார்", "", "", "", "ஈராக்கி தினார்கள்"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ஈரானியன் ரியால்", PluralForms{"", "ஈரானியன் ரியால்", "", "", "", "ஈரானியன் ரியால்கள்"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ஐஸ்லாண்டிக் க்ரோனா", PluralForms{"", "ஐஸ்லாண்டிக் க்ரோனா", "", "", "", "ஐஸ்லாண்டிக் க்ரோனர்"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ஜமைக்கன் டாலர்", PluralForms{"", "ஜமைக்கன் டாலர்", "", "", "", "ஜமைக்கன் டாலர்கள்"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "ஜோர்டானிய தினார்", PluralForms{"", "ஜோர்டானிய தினார்", "", "", "", "ஜோர்டானிய தினார்கள்"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "ஜப்பானிய யென்", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "கென்யன் ஷில்லிங்", PluralForms{"", "கென்யன் ஷில்லிங்", "", "", "", "கென்யன் ஷில்லிங்குகள்"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "கிர்கிஸ்தானி சோம்", PluralForms{"", "கிரிகிஸ்தானி சோம்", "", "", "", "கிர்கிஸ்தானி சோம்கள்"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "கம்போடியன் ரியெல்", PluralForms{"", "கம்போடியன் ரியெல்", "", "", "", "கம்போடியன் ரியெல்கள்"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "கமோரியன் ஃப்ராங்க்", PluralForms{"", "கமோரியன் ஃப்ராங்க்", "", "", "", "கமோரியன் ஃப்ராங்க்குகள்"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "வட கொரிய வான்", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "தென் கொரிய வான்", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "குவைத்தி தினார்", PluralForms{"", "குவைத்தி தினார்", "", "", "", "குவைத்தி தினார்கள்"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "கேமன் தீவுகள் டாலர்", PluralForms{"", "கேமன் தீவுகள் டாலர்", "", "", "", "கேமன் தீவுகள் டாலர்கள்"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "கஸகஸ்தானி டென்கே", PluralForms{"", "கஸகஸ்தானி டென்கே", "", "", "", "கஸகஸ்தானி டென்கேக்கள்"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "லவோஷியன் கிப்", PluralForms{"", "லவோஷியன் கிப்", "", "", "", "லவோஷியன் கிப்கள்"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "லெபனீஸ் பவுண்டு", PluralForms{"", "லெபனீஸ் பவுண்டு", "", "", "", "லெபனீஸ் பவுண்டுகள்"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "இலங்கை ரூபாய்", PluralForms{"", "இலங்கை ரூபாய்", "", "", "", "இலங்கை ரூபாய்கள்"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "லைபீரியன் டாலர்", PluralForms{"", "லைபீரியன் டாலர்", "", "", "", "லைபீரியன் டாலர்கள்"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "லெசோதோ லோட்டி", PluralForms{"", "லெசோதோ லோட்டி", "", "", "", "லெசோதோ லோட்டிகள்"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "லிதுவேனியன் லிடஸ்", PluralForms{"", "லிதுவேனியன் லிடாஸ்", "", "", "", "லிதுவேனியன் லிடை"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "லத்வியன் லாட்ஸ்", PluralForms{"", "லாத்வியன் லாட்ஸ்", "", "", "", "லத்வியன் லாட்டி"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "லிபியன் தினார்", PluralForms{"", "லிபியன் தினார்", "", "", "", "லிபியன் தினார்கள்"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "மொராக்கன் திர்ஹாம்", PluralForms{"", "மொராக்கன் திர்ஹாம்", "", "", "", "மொராக்கன் திர்ஹாம்கள்"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "மால்டோவன் லியூ", PluralForms{"", "மால்டோவன் லியூ", "", "", "", "மால்டோவன் லேய்"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "மலகாசி ஏரியரி", PluralForms{"", "மலகாசி ஏரியரி", "", "", "", "மலகாசி ஏரியரிகள்"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "மாசிடோனியன் டேனார்", PluralForms{"", "மாசிடோனியன் டேனார்", "", "", "", "மாசிடோனியன் டேனாரி"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "மியான்மர் கியாத்", PluralForms{"", "மியான்மர் கியாத்", "", "", "", "மியான்மர் கியாத்கள்"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "மங்கோலியன் டுக்ரிக்", PluralForms{"", "மங்கோலியன் டுக்ரிக்", "", "", "", "மங்கோலியன் டுக்ரிக்குகள்"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "மெகனீஸ் படாகா", PluralForms{"", "மெகனீஸ் படாகா", "", "", "", "மெகனீஸ் படாகாக்கள்"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "மொரிஷானியன் ஒகுயா (1973–2017)", PluralForms{"", "மொரிஷானியன் ஒகுயா (1973–2017)", "", "", "", "மொரிஷானியன் ஒகுயாக்கள் (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "மொரிஷானியன் ஒகுயா", PluralForms{"", "மொரிஷானியன் ஒகுயா", "", "", "", "மொரிஷானியன் ஒகுயாக்கள்"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "மொரீஷியன் ருபீ", PluralForms{"", "மொரீஷியன் ருபீ", "", "", "", "மொரீஷியன் ருபீக்கள்"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "மாலத்தீவு ருஃபியா", PluralForms{"", "மாலத்தீவு ருஃபியா", "", "", "", "மாலத்தீவு ருஃபியாக்கள்"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "மலாவியன் குவாச்சா", PluralForms{"", "மலாவியன் குவாச்சா", "", "", "", "மலாவியன் குவாச்சாக்கள்"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "மெக்ஸிகன் பெசோ", PluralForms{"", "மெக்ஸிகன் பெசோ", "", "", "", "மெக்ஸிகன் பெசோக்கள்"}},
+		"MYR": {2, 0, 2, 1, "MYR", "RM", "RM", "மலேஷியன் ரிங்கிட்", PluralForms{"", "மலேஷியன் ரிங்கிட்", "", "", "", "மலேஷியன் ரிங்கிட்கள்"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "மொசாம்பிகன் மெடிகல்", PluralForms{"", "மொசாம்பிகன் மெடிகல்", "", "", "", "மொசாம்பிகன் மெடிகல்கள்"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "நமீபியன் டாலர்", PluralForms{"", "நமீபியன் டாலர்", "", "", "", "நமீபியன் டாலர்கள்"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "நைஜீரியன் நைரா", PluralForms{"", "நைஜீரியன் நைரா", "", "", "", "நைஜீரியன் நைராக்கள்"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "நிகரகுவன் கோர்டோபா", PluralForms{"", "நிகரகுவன் கோர்டோபா", "", "", "", "நிகரகுவன் கோர்டோபாக்கள்"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "நார்வேஜியன் க்ரோன்", PluralForms{"", "நார்வேஜியன் க்ரோன்", "", "", "", "நார்வேஜியன் க்ரோனர்"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "நேபாளீஸ் ரூபாய்", PluralForms{"", "நேபாளீஸ் ரூபாய்", "", "", "", "நேபாளீஸ் ரூபாய்கள்"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "நியூசிலாந்து டாலர்", PluralForms{"", "நியூசிலாந்து டாலர்", "", "", "", "நியூசிலாந்து டாலர்கள்"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ஓமானி ரியால்", PluralForms{"", "ஓமானி ரியால்", "", "", "", "ஓமானி ரியால்கள்"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "பனாமானியன் பால்போவா", PluralForms{"", "பனாமானியன் பால்போவா", "", "", "", "பனாமானியன் பால்போவாக்கள்"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "பெரூவியன் சோல்", PluralForms{"", "பெரூவியன் சோல்", "", "", "", "பெரூவியன் சோல்கள்"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "பபுவா நியூ கினியன் கினா", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "பிலிப்பைன் பெசோ", PluralForms{"", "பிலிப்பைன் பெசோ", "", "", "", "பிலிப்பைன் பெசோக்கள்"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "பாகிஸ்தானி ரூபாய்", PluralForms{"", "பாகிஸ்தானி ரூபாய்", "", "", "", "பாகிஸ்தானி ரூபாய்கள்"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "போலிஷ் ஸ்லாட்டி", PluralForms{"", "போலிஷ் ஸ்லாட்டி", "", "", "", "போலிஷ் ஸ்லாட்டிகள்"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "பராகுவன் குவாரானி", PluralForms{"", "பராகுவன் குவாரானி", "", "", "", "பராகுவன் குவாரானிகள்"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "கத்தாரி ரியால்", PluralForms{"", "கத்தாரி ரியால்", "", "", "", "கத்தாரி ரியால்கள்"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "ரோமானியன் லியூ", PluralForms{"", "ரோமானியன் லியூ", "", "", "", "ரோமானியன் லேய்"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "செர்பியன் தினார்", PluralForms{"", "செர்பியன் தினார்", "", "", "", "செர்பியன் தினார்கள்"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "ரஷியன் ரூபிள்", PluralForms{"", "ரஷியன் ரூபிள்", "", "", "", "ரஷியன் ரூபிள்கள்"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ருவாண்டன் ஃப்ராங்க்", PluralForms{"", "ருவாண்டன் ஃப்ராங்க்", "", "", "", "ருவாண்டன் ஃப்ராங்க்குகள்"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "சவுதி ரியால்", PluralForms{"", "சவுதி ரியால்", "", "", "", "சவுதி ரியால்கள்"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "சாலமன் தீவுகள் டாலர்", PluralForms{"", "சாலமன் தீவுகள் டாலர்", "", "", "", "சாலமன் தீவுகள் டாலர்கள்"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "சிசீலோயிஸ் ருபீ", PluralForms{"", "சிசீலோயிஸ் ருபீ", "", "", "", "சிசீலோயிஸ் ருபீக்கள்"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "சூடானீஸ் பவுண்டு", PluralForms{"", "சூடானீஸ் பவுண்டு", "", "", "", "சூடானீஸ் பவுண்டுகள்"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "ஸ்வீடிஷ் க்ரோனா", PluralForms{"", "ஸ்வீடிஷ் க்ரோனா", "", "", "", "ஸ்வீடிஷ் க்ரோனர்"}},
+		"SGD": {2, 0, 2, 1, "SGD", "$", "$", "சிங்கப்பூர் டாலர்", PluralForms{"", "சிங்கப்பூர் டாலர்", "", "", "", "சிங்கப்பூர் டாலர்கள்"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "செயின்ட் ஹெலேனா பவுண்டு", PluralForms{"", "செயின்ட் ஹெலேனா பவுண்டு", "", "", "", "செயின்ட் ஹெலேனா பவுண்டுகள்"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "சியாரா லியோனியன் லியோன்", PluralForms{"", "சியாரா லியோனியன் லியோன்", "", "", "", "சியாரா லியோனியன் லியோன்கள்"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "சியாரா லியோனியன் லியோன் (1964—2022)", PluralForms{"", "சியாரா லியோனியன் லியோன் (1964—2022)", "", "", "", "சியாரா லியோனியன் லியோன்கள் (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "சோமாலி ஷில்லிங்", PluralForms{"", "சோமாலி ஷில்லிங்", "", "", "", "சோமாலி ஷில்லிங்குகள்"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "சுரினாமீஸ் டாலர்", PluralForms{"", "சுரினாமீஸ் டாலர்", "", "", "", "சுரினாமீஸ் டாலர்கள்"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "தெற்கு சூடானீஸ் பவுண்டு", PluralForms{"", "தெற்கு சூடானீஸ் பவுண்டு", "", "", "", "தெற்கு சூடானீஸ் பவுண்டுகள்"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "சாவ் டோமி மற்றும் பிரின்ஸ்பி டோப்ரா (1977–2017)", PluralForms{"", "சாவ் டோமி மற்றும் பிரின்ஸ்பி டோப்ரா (1977–2017)", "", "", "", "சாவ் டோமி மற்றும் பிரின்ஸ்பி டோப்ராக்கள் (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "சாவ் டோமி மற்றும் பிரின்ஸ்பி டோப்ரா", PluralForms{"", "சாவ் டோமி மற்றும் பிரின்ஸ்பி டோப்ரா", "", "", "", "சாவ் டோமி மற்றும் பிரின்ஸ்பி டோப்ராக்கள்"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "சிரியன் பவுண்டு", PluralForms{"", "சிரியன் பவுண்டு", "", "", "", "சிரியன் பவுண்டுகள்"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "சுவாஸி லிலாங்கனி", PluralForms{"", "சுவாஸி லிலாங்கனி", "", "", "", "சுவாஸி எமாலாங்கனி"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "தாய் பாட்", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "தஜிகிஸ்தானி சோமோனி", PluralForms{"", "தஜிகிஸ்தானி சோமோனி", "", "", "", "தஜிகிஸ்தானி சோமோனிகள்"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "துர்க்மெனிஸ்தானி மனத்", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "துனிஷியன் தினார்", PluralForms{"", "துனிஷியன் தினார்", "", "", "", "துனிஷியன் தினார்கள்"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "தொங்கான் பங்கா", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "துருக்கிஷ் லீரா", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "டிரினிடாட் மற்றும் டோபாகோ டாலர்", PluralForms{"", "டிரினிடாட் மற்றும் டோபாகோ டாலர்", "", "", "", "டிரினிடாட் மற்றும் டோபாகோ டாலர்கள்"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "புதிய தைவான் டாலர்", PluralForms{"", "புதிய தைவான் டாலர்", "", "", "", "புதிய தைவான் டாலர்கள்"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "தான்சானியன் ஷில்லிங்", PluralForms{"", "தான்சானியன் ஷில்லிங்", "", "", "", "தான்சானியன் ஷில்லிங்குகள்"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "உக்ரைனியன் ஹிரைவ்னியா", PluralForms{"", "உக்ரைனியன் ஹிரைவ்னியா", "", "", "", "உக்ரைனியன் ஹிரைவ்னியாக்கள்"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "உகாண்டன் ஷில்லிங்", PluralForms{"", "உகாண்டன் ஷில்லிங்", "", "", "", "உகாண்டன் ஷில்லிங்குகள்"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "அமெரிக்க டாலர்", PluralForms{"", "அமெரிக்க டாலர்", "", "", "", "அமெரிக்க டாலர்கள்"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "உருகுவேயன் பெசோ", PluralForms{"", "உருகுவேயன் பெசோ", "", "", "", "உருகுவேயன் பெசோக்கள்"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "உஸ்பெக்கிஸ்தானி சோம்", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "வெனிசுலன் போலிவர் (2008–2018)", PluralForms{"", "வெனிசுலன் போலிவர் (2008–2018)", "", "", "", "வெனிசுலன் போலிவர்கள் (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "வெனிசுலன் போலிவர்", PluralForms{"", "வெனிசுலன் போலிவர்", "", "", "", "வெனிசுலன் போலிவர்கள்"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "வியட்நாமீஸ் டாங்", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "வனுவாட்டு வாட்டு", PluralForms{"", "வனுவாட்டு வாட்டு", "", "", "", "வனுவாட்டு வாட்டுகள்"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "சமோவான் தாலா", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "மத்திய ஆப்பிரிக்க CFA ஃப்ராங்க்", PluralForms{"", "மத்திய ஆப்பிரிக்க CFA ஃப்ராங்க்", "", "", "", "மத்திய ஆப்பிரிக்க CFA ஃப்ராங்க்குகள்"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "கிழக்கு கரீபியன் டாலர்", PluralForms{"", "கிழக்கு கரீபியன் டாலர்", "", "", "", "கிழக்கு கரீபியன் டாலர்கள்"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "மேற்கு ஆப்பிரிக்க CFA ஃப்ராங்க்", PluralForms{"", "மேற்கு ஆப்பிரிக்க CFA ஃப்ராங்க்", "", "", "", "மேற்கு ஆப்பிரிக்க CFA ஃப்ராங்க்குகள்"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "ஃப்ராங்க் (CFP)", PluralForms{"", "ஃப்ராங்க் (CFP)", "", "", "", "ஃப்ராங்குகள் (CFP)"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "தெரியாத நாணயம்", PluralForms{"", "(தெரியாத நாணய அலகு)", "", "", "", "(தெரியாத நாணயம்)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "ஏமனி ரியால்", PluralForms{"", "ஏமனி ரியால்", "", "", "", "ஏமனி ரியால்கள்"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "தென் ஆப்ரிக்க ராண்ட்", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "ஸாம்பியன் குவாசா (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "ஸாம்பியன் குவாச்சா", PluralForms{"", "ஸாம்பியன் குவாச்சா", "", "", "", "ஸாம்பியன் குவாச்சாக்கள்"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
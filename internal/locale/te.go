@@ -6,176 +6,229 @@ var te = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 2, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 2, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 2, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 2, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 2, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 2, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0వే", "other": "0వే"}},
+				{4, 2, map[string]string{"one": "0వే", "other": "0వే"}},
+				{5, 3, map[string]string{"one": "0వే", "other": "0వే"}},
+				{6, 1, map[string]string{"one": "0మి", "other": "0మి"}},
+				{7, 2, map[string]string{"one": "0మి", "other": "0మి"}},
+				{8, 3, map[string]string{"one": "0మి", "other": "0మి"}},
+				{9, 1, map[string]string{"one": "0బి", "other": "0బి"}},
+				{10, 2, map[string]string{"one": "0బి", "other": "0బి"}},
+				{11, 3, map[string]string{"one": "0బి", "other": "0బి"}},
+				{12, 1, map[string]string{"one": "0ట్రి", "other": "0ట్రి"}},
+				{13, 2, map[string]string{"one": "0ట్రి", "other": "0ట్రి"}},
+				{14, 3, map[string]string{"one": "0ట్రి", "other": "0ట్రి"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 వేయి", "other": "0 వేలు"}},
+				{4, 2, map[string]string{"one": "0 వేలు", "other": "0 వేలు"}},
+				{5, 3, map[string]string{"one": "0 వేలు", "other": "0 వేలు"}},
+				{6, 1, map[string]string{"one": "0 మిలియన్", "other": "0 మిలియన్లు"}},
+				{7, 2, map[string]string{"one": "0 మిలియన్లు", "other": "0 మిలియన్లు"}},
+				{8, 3, map[string]string{"one": "0 మిలియన్లు", "other": "0 మిలియన్లు"}},
+				{9, 1, map[string]string{"one": "0 బిలియన్", "other": "0 బిలియన్లు"}},
+				{10, 2, map[string]string{"one": "0 బిలియన్లు", "other": "0 బిలియన్లు"}},
+				{11, 3, map[string]string{"one": "0 బిలియన్లు", "other": "0 బిలియన్లు"}},
+				{12, 1, map[string]string{"one": "0 ట్రిలియన్", "other": "0 ట్రిలియన్లు"}},
+				{13, 2, map[string]string{"one": "0 ట్రిలియన్లు", "other": "0 ట్రిలియన్లు"}},
+				{14, 3, map[string]string{"one": "0 ట్రిలియన్లు", "other": "0 ట్రిలియన్లు"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0వే", "other": "¤0వే"}},
+				{4, 2, map[string]string{"one": "¤0వే", "other": "¤0వే"}},
+				{5, 3, map[string]string{"one": "¤0వే", "other": "¤0వే"}},
+				{6, 1, map[string]string{"one": "¤0మి", "other": "¤0మి"}},
+				{7, 2, map[string]string{"one": "¤0మి", "other": "¤0మి"}},
+				{8, 3, map[string]string{"one": "¤0మి", "other": "¤0మి"}},
+				{9, 1, map[string]string{"one": "¤0బి", "other": "¤0బి"}},
+				{10, 2, map[string]string{"one": "¤0బి", "other": "¤0బి"}},
+				{11, 3, map[string]string{"one": "¤0బి", "other": "¤0బి"}},
+				{12, 1, map[string]string{"one": "¤0ట్రి", "other": "¤0ట్రి"}},
+				{13, 2, map[string]string{"one": "¤0ట్రి", "other": "¤0ట్రి"}},
+				{14, 3, map[string]string{"one": "¤0ట్రి", "other": "¤0ట్రి"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "యునైటెడ్ ఆరబ్ ఎమిరేట్స్ దిరామ్", PluralForms{"", "యునైటెడ్ ఆరబ్ ఎమిరేట్స్ దిరామ్", "", "", "", "యునైటెడ్ ఆరబ్ ఎమిరేట్స్ దిరామ్\u200cలు"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "ఆఫ్ఘాన్ ఆఫ్ఘాని", PluralForms{"", "ఆఫ్ఘాన్ ఆఫ్ఘాని", "", "", "", "ఆఫ్ఘాన్ ఆఫ్ఘానీలు"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "ఆల్బేనియన్ లేక్", PluralForms{"", "", "", "", "", ""}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "అమెరికన్ డ్రామ్", PluralForms{"", "అమెరికన్ డ్రామ్", "", "", "", "అమెరికన్ డ్రామ్\u200cలు"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "నెదర్లాండ్స్ యాంటిల్లియన్ గిల్\u200cడర్", PluralForms{"", "నెదర్లాండ్స్ యాంటిల్లియన్ గిల్\u200cడర్", "", "", "", "నెదర్లాండ్స్ యాంటిల్లియన్ గిల్\u200cడర్\u200cలు"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "అంగోలాన్ క్వాన్\u200cజా", PluralForms{"", "అంగోలాన్ క్వాన్\u200cజా", "", "", "", "అంగోలాన్ క్వాన్\u200cజా\u200cలు"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "అర్జెంటీనా పెసో", PluralForms{"", "అర్జెంటీనా పెసో", "", "", "", "అర్జెంటీనా పెసోలు"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ఆస్ట్రేలియన్ డాలర్", PluralForms{"", "ఆస్ట్రేలియన్ డాలర్", "", "", "", "ఆస్ట్రేలియన్ డాలర్\u200cలు"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "అరుబన్ ఫ్లోరిన్", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "అజర్బైజాన్ మానట్", PluralForms{"", "అజర్బైజాన్ మానట్", "", "", "", "అజర్బైజాన్ మానట్\u200cలు"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "బోస్నియా-హెర్జగోవినా మార్పిడి చెయ్యగలిగే మార్క్", PluralForms{"", "బోస్నియా-హెర్జగోవినా మార్పిడి చెయ్యగలిగే మార్క్", "", "", "", "బోస్నియా-హెర్జగోవినా మార్పిడి చెయ్యగలిగే మార్క్\u200cలు"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "బర్బాడియన్ డాలర్", PluralForms{"", "బర్బాడియన్ డాలర్", "", "", "", "బర్బాడియన్ డాలర్\u200cలు"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "బాంగ్లాదేశ్ టాకా", PluralForms{"", "బాంగ్లాదేశ్ టాకా", "", "", "", "బాంగ్లాదేశ్ టాకాలు"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "బల్గేరియన్ లేవ్", PluralForms{"", "బల్గేరియన్ లేవ్", "", "", "", "బల్గేరియన్ లేవ"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "బహ్రెయిన్ దినార్", PluralForms{"", "బహ్రెయిన్ దినార్", "", "", "", "బహ్రెయిన్ దినార్\u200cలు"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "బురిండియన్ ఫ్రాంక్", PluralForms{"", "బురిండియన్ ఫ్రాంక్", "", "", "", "బురిండియన్ ఫ్రాంక్\u200cలు"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "బెర్ముడన్ డాలర్", PluralForms{"", "బెర్ముడన్ డాలర్", "", "", "", "బెర్ముడన్ డాలర్\u200cలు"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "బ్రూనై డాలర్", PluralForms{"", "బ్రూనై డాలర్", "", "", "", "బ్రూనై డాలర్\u200cలు"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "బొలీవియన్ బొలీవియానో", PluralForms{"", "బొలీవియన్ బొలీవియానో", "", "", "", "బొలీవియన్ బొలీవియానోలు"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "బ్రెజిలియన్ రియల్", PluralForms{"", "బ్రెజిలియన్ రియల్", "", "", "", "బ్రెజిలియన్ రియల్\u200cలు"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "బహామియన్ డాలర్", PluralForms{"", "బహామియన్ డాలర్", "", "", "", "బహామియన్ డాలర్\u200cలు"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "భూటానీయుల గుల్\u200cట్రుమ్", PluralForms{"", "భూటానీయుల గుల్\u200cట్రుమ్", "", "", "", "భూటానీయుల గుల్\u200cట్రుమ్\u200cలు"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "బోట్స్\u200cవానా పులా", PluralForms{"", "బోట్స్\u200cవానా పులా", "", "", "", "బోట్స్\u200cవానా పులాలు"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "బెలరూసియన్ రూబల్", PluralForms{"", "బెలరూసియన్ రూబల్", "", "", "", "బెలరూసియన్ రూబల్\u200cలు"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "బెలరూసియన్ రూబల్ (2000–2016)", PluralForms{"", "బెలరూసియన్ రూబల్ (2000–2016)", "", "", "", "బెలరూసియన్ రూబల్\u200cలు (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "బెలీజ్ డాలర్", PluralForms{"", "బెలీజ్ డాలర్", "", "", "", "బెలీజ్ డాలర్\u200cలు"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "కెనడియన్ డాలర్", PluralForms{"", "కెనడియన్ డాలర్", "", "", "", "కెనడియన్ డాలర్\u200cలు"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "కొంగోలిస్ ఫ్రాంక్", PluralForms{"", "కొంగోలిస్ ఫ్రాంక్", "", "", "", "కొంగోలిస్ ఫ్రాంక్\u200cలు"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "స్విస్ ఫ్రాంక్", PluralForms{"", "స్విస్ ఫ్రాంక్", "", "", "", "స్విస్ ఫ్రాంక్\u200cలు"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "చిలియన్ పెసో", PluralForms{"", "చిలియన్ పెసో", "", "", "", "చిలియన్ పెసోలు"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "చైనీస్ యువాన్ (ఆఫ్\u200cషోర్)", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "చైనా దేశ యువాన్", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "కొలంబియన్ పెసో", PluralForms{"", "కొలంబియన్ పెసో", "", "", "", "కొలంబియన్ పెసోలు"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "కోస్టా రికన్ కోలోన్", PluralForms{"", "కోస్టా రికన్ కోలోన్", "", "", "", "కోస్టా రికన్ కోలోన్\u200cలు"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "క్యూబన్ కన్వర్టబుల్ పెసో", PluralForms{"", "క్యూబన్ కన్వర్టబుల్ పెసో", "", "", "", "క్యూబన్ కన్వర్టబుల్ పెసోలు"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "క్యూబన్ పెసో", PluralForms{"", "క్యూబన్ పెసో", "", "", "", "క్యూబన్ పెసోలు"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "కేప్ వెర్డియన్ ఎస్కుడో", PluralForms{"", "కేప్ వెర్డియన్ ఎస్కుడో", "", "", "", "కేప్ వెర్డియన్ ఎస్కుడోలు"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "చెక్ రిపబ్లిక్ కోరునా", PluralForms{"", "చెక్ రిపబ్లిక్ కోరునా", "", "", "", "చెక్ రిపబ్లిక్ కోరునాలు"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "జిబోటియన్ ఫ్రాంక్", PluralForms{"", "జిబోటియన్ ఫ్రాంక్", "", "", "", "జిబోటియన్ ఫ్రాంక్\u200cలు"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "డానిష్ క్రోన్", PluralForms{"", "డానిష్ క్రోన్", "", "", "", "డానిష్ క్రోనర్"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "డోమినికన్ పెసో", PluralForms{"", "డోమినికన్ పెసో", "", "", "", "డోమినికన్ పెసోలు"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "అల్జీరియన్ దీనార్", PluralForms{"", "అల్జీరియన్ దీనార్", "", "", "", "అల్జీరియన్ దీనార్\u200cలు"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ఈజిప్షియన్ పౌండ్", PluralForms{"", "ఈజిప్షియన్ పౌండ్", "", "", "", "ఈజిప్షియన్ పౌండ్\u200cలు"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "ఎరిట్రీన్ నక్ఫా", PluralForms{"", "ఎరిట్రీన్ నక్ఫా", "", "", "", "ఎరిట్రీన్ నక్ఫా\u200cలు"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ఇథియోపియన్ బర్", PluralForms{"", "ఇథియోపియన్ బర్", "", "", "", "ఇథియోపియన్ బర్\u200cలు"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "యురొ", PluralForms{"", "యురొ", "", "", "", "యురోలు"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ఫీజియన్ డాలర్", PluralForms{"", "ఫీజియన్ డాలర్", "", "", "", "ఫీజియన్ డాలర్\u200cలు"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ఫాక్\u200cల్యాండ్ దీవులు పౌండ్", PluralForms{"", "ఫాక్\u200cల్యాండ్ దీవులు పౌండ్", "", "", "", "ఫాక్\u200cల్యాండ్ దీవులు పౌండ్\u200cలు"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "బ్రిటిష్ పౌండ్", PluralForms{"", "బ్రిటిష్ పౌండ్", "", "", "", "బ్రిటిష్ పౌండ్\u200cలు"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "జార్జియన్ లారి", PluralForms{"", "జార్జియన్ లారి", "", "", "", "జార్జియన్ లారీలు"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "గానెయన్ సెడి", PluralForms{"", "గానెయన్ సెడి", "", "", "", "గానెయన్ సెడిలు"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "జిబ్రల్\u200cటూర్ పౌండ్", PluralForms{"", "జిబ్రల్\u200cటూర్ పౌండ్", "", "", "", "జిబ్రల్\u200cటూర్ పౌండ్\u200cలు"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "గాంబియన్ దలాసి", PluralForms{"", "గాంబియన్ దలాసి", "", "", "", "గాంబియన్ దలాసిలు"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "గ్వినియన్ ఫ్రాంక్", PluralForms{"", "గ్వినియన్ ఫ్రాంక్", "", "", "", "గ్వినియన్ ఫ్రాంక్\u200cలు"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "గ్యుటెమాలన్ క్వెట్\u200cజల్", PluralForms{"", "గ్యుటెమాలన్ క్వెట్\u200cజల్", "", "", "", "గ్యుటెమాలన్ క్వెట్\u200cజల్\u200cలు"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "గుయనియాస్ డాలర్", PluralForms{"", "గుయనియాస్ డాలర్", "", "", "", "గుయనియాస్ డాలర్\u200cలు"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "హాంకాంగ్ డాలర్", PluralForms{"", "హాంకాంగ్ డాలర్", "", "", "", "హాంకాంగ్ డాలర్\u200cలు"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "హోండురన్ లెమిపిరా", PluralForms{"", "హోండురన్ లెమిపిరా", "", "", "", "హోండురన్ లెమిపిరాలు"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "క్రొయేషియన్ క్యూన", PluralForms{"", "క్రొయేషియన్ క్యూన", "", "", "", "క్రొయేషియన్ క్యూనాలు"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "హైటియన్ గ్వోర్డే", PluralForms{"", "హైటియన్ గ్వోర్డే", "", "", "", "హైటియన్ గ్వోర్డేలు"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "హంగేరియన్ ఫోరింట్", PluralForms{"", "హంగేరియన్ ఫోరింట్", "", "", "", "హంగేరియన్ ఫోరింట్\u200cలు"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ఇండోనేషియా రూపాయి", PluralForms{"", "ఇండోనేషియా రూపాయి", "", "", "", "ఇండోనేషియా రూపాయలు"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "ఇజ్రాయేలీ న్యూ షెకెల్", PluralForms{"", "ఇజ్రాయేలీ న్యూ షెకెల్", "", "", "", "ఇజ్రాయేలీ న్యూ షెకెల్ లు"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "భారతదేశ రూపాయి", PluralForms{"", "భారతదేశ రూపాయి", "", "", "", "భారతదేశ రూపాయలు"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ఇరాకీ దీనార్", PluralForms{"", "ఇరాకీ దీనార్", "", "", "", "ఇరాకీ దీనార్\u200cలు"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ఇరానియన్ రీయల్", PluralForms{"", "ఇరానియన్ రీయల్", "", "", "", "ఇరానియన్ రీయల్\u200cలు"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ఐస్లాండిక్ క్రోనా", PluralForms{"", "ఐస్లాండిక్ క్రోనా", "", "", "", "ఐస్లాండిక్ క్రోనర్"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "జమైకన్ డాలర్", PluralForms{"", "జమైకన్ డాలర్", "", "", "", "జమైకన్ డాలర్\u200cలు"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "జోర్\u200cడానియన్ దీనార్", PluralForms{"", "జోర్\u200cడానియన్ దీనార్", "", "", "", "జోర్\u200cడానియన్ దీనార్\u200cలు"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "జపాను దేశ యెన్", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "కెన్యాన్ షిల్లింగ్", PluralForms{"", "కెన్యాన్ షిల్లింగ్", "", "", "", "కెన్యాన్ షిల్లింగ్\u200cలు"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "కిర్గిస్థాని సౌమ్", PluralForms{"", "కిర్గిస్థాని సౌమ్", "", "", "", "కిర్గిస్థాని సౌమ్\u200cలు"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "కాంబోడియన్ రీల్", PluralForms{"", "కాంబోడియన్ రీల్", "", "", "", "కాంబోడియన్ రీల్\u200cలు"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "కొమోరియన్ ఫ్రాంక్", PluralForms{"", "కొమోరియన్ ఫ్రాంక్", "", "", "", "కొమోరియన్ ఫ్రాంక్\u200cలు"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "ఉత్తర కొరియా వోన్", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "దక్షిణ కొరియా వోన్", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "కువైట్ దీనార్", PluralForms{"", "కువైట్ దీనార్", "", "", "", "కువైట్ దీనార్\u200cలు"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "కేమాన్ దీవుల డాలర్", PluralForms{"", "కేమాన్ దీవుల డాలర్", "", "", "", "కేమాన్ దీవుల డాలర్\u200cలు"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "ఖజికిస్థాన్ టెంగే", PluralForms{"", "ఖజికిస్థాన్ టెంగే", "", "", "", "ఖజికిస్థాన్ టెంగేలు"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "లాటియన్ కిప్", PluralForms{"", "లాటియన్ కిప్", "", "", "", "లాటియన్ కిప్\u200cలు"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "లెబనీస్ పౌండ్", PluralForms{"", "లెబనీస్ పౌండ్", "", "", "", "లెబనీస్ పౌండ్\u200cలు"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "శ్రీలంక రూపాయి", PluralForms{"", "శ్రీలంక రూపాయి", "", "", "", "శ్రీలంక రూపాయలు"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "లిబేరియన్ డాలర్", PluralForms{"", "లిబేరియన్ డాలర్", "", "", "", "లిబేరియన్ డాలర్\u200cలు"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "లెసోధో లోటి", PluralForms{"", "లెసోధో లోటి", "", "", "", "లెసోధో లోటిలు"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "లిథోనియన్ లీటాస్", PluralForms{"", "లిథోనియన్ లీటాస్", "", "", "", "లిథోనియన్ లీటై"}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "లాత్వియన్ లాట్స్", PluralForms{"", "లాత్వియన్ లాట్స్", "", "", "", "లాత్వియన్ లాటి"}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "లిబియన్ దీనార్", PluralForms{"", "లిబియన్ దీనార్", "", "", "", "లిబియన్ దీనార్\u200cలు"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "మోరోకన్ దిర్హామ్", PluralForms{"", "మోరోకన్ దిర్హామ్", "", "", "", "మోరోకన్ దిర్హామ్\u200cలు"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "మోల్\u200cడోవన్ ల్యూ", PluralForms{"", "మోల్\u200cడోవన్ ల్యూ", "", "", "", "మోల్\u200cడోవన్ లీ"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "మలగసీ అరియరీ", PluralForms{"", "మలగసీ అరియరీ", "", "", "", "మలగసీ అరియరీలు"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "మెసిడోనియన్ దినార్", PluralForms{"", "మెసిడోనియన్ దినార్", "", "", "", "మెసిడోనియన్ దినారి"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "మయన్మార్ క్యాట్", PluralForms{"", "మయన్మార్ క్యాట్", "", "", "", "మయన్మార్ క్యాట్\u200cలు"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "మంగోలియన్ టుగ్రిక్", PluralForms{"", "మంగోలియన్ టుగ్రిక్", "", "", "", "మంగోలియన్ టుగ్రిక్\u200cలు"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "మకనీస్ పటాక", PluralForms{"", "మకనీస్ పటాక", "", "", "", "మకనీస్ పటాకాలు"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "మౌరిటానియన్ ఒగ్యియా (1973–2017)", PluralForms{"", "మౌరిటానియన్ ఒగ్యియా (1973–2017)", "", "", "", "మౌరిటానియన్ ఒగ్యియాలు (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "మౌరిటానియన్ ఒగ్యియా", PluralForms{"", "మౌరిటానియన్ ఒగ్యియా", "", "", "", "మౌరిటానియన్ ఒగ్యియాలు"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "మారిషన్ రూపాయి", PluralForms{"", "మారిషన్ రూపాయి", "", "", "", "మారిషన్ రూపాయలు"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "మాల్దీవియన్ రుఫియా", PluralForms{"", "మాల్దీవియన్ రుఫియా", "", "", "", "మాల్దీవియన్ రుఫియాలు"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "మలావియన్ క్వాచా", PluralForms{"", "మలావియన్ క్వాచా", "", "", "", "మలావియన్ క్వాచాలు"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "మెక్సికన్ పెసో", PluralForms{"", "మెక్సికన్ పెసో", "", "", "", "మెక్సికన్ పెసోలు"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "మలేషియా రింగ్గిట్", PluralForms{"", "మలేషియా రింగ్గిట్", "", "", "", "మలేషియా రింగ్గిట్\u200cలు"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "మొజాంబికన్ మెటికల్", PluralForms{"", "మొజాంబికన్ మెటికల్", "", "", "", "మొజాంబికన్ మెటికల్\u200cలు"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "నమిబియన్ డాలర్", PluralForms{"", "నమిబియన్ డాలర్", "", "", "", "నమిబియన్ డాలర్\u200cలు"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "నైజీరియన్ నైరా", PluralForms{"", "నైజీరియన్ నైరా", "", "", "", "నైజీరియన్ నైరాలు"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "నికరగ్యుయన్ కొర్\u200cడుబు", PluralForms{"", "నికరగ్యుయన్ కొర్\u200cడుబు", "", "", "", "నికరగ్యుయన్ కొర్\u200cడుబులు"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "నార్వేజీయన్ క్రోన్", PluralForms{"", "నార్వేజీయన్ క్రోన్", "", "", "", "నార్వేజీయన్ క్రోనర్"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "నేపాలీయుల రూపాయి", PluralForms{"", "నేపాలీయుల రూపాయి", "", "", "", "నేపాలీయుల రూపాయలు"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "న్యూజిలాండ్ డాలర్", PluralForms{"", "న్యూజిలాండ్ డాలర్", "", "", "", "న్యూజిలాండ్ డాలర్\u200cలు"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ఒమాని రీయల్", PluralForms{"", "ఒమాని రీయల్", "", "", "", "ఒమాని రీయల్\u200cలు"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "పనామనియన్ బల్బోవ", PluralForms{"", "పనామనియన్ బల్బోవ", "", "", "", "పనామనియన్ బల్బోవాలు"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "పెరువియన్ సోల్", PluralForms{"", "పెరువియన్ సోల్", "", "", "", "పెరువియన్ సోల్\u200cలు"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "పప్యూ న్యూ గ్యినియన్ కినా", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "ఫిలిప్పిన్ పెసో", PluralForms{"", "ఫిలిప్పిన్ పెసో", "", "", "", "ఫిలిప్పిన్ పెసోలు"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "పాకిస్థాన్ రూపాయి", PluralForms{"", "పాకిస్థాన్ రూపాయి", "", "", "", "పాకిస్థాన్ రూపాయలు"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "పోలిష్ జ్లోటీ", PluralForms{"", "పోలిష్ జ్లోటీ", "", "", "", "పోలిష్ జ్లోటీలు"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "పరగ్వాయన్ గ్వారని", PluralForms{"", "పరగ్వాయన్ గ్వారని", "", "", "", "పరగ్వాయన్ గ్వారనీలు"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "క్వాటరి రీయల్", PluralForms{"", "క్వాటరి రీయల్", "", "", "", "క్వాటరి రీయల్\u200cలు"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "రోమానియాన్ లెయు", PluralForms{"", "రోమానియాన్ లెయు", "", "", "", "రోమానియాన్ లీ"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "సెర్బియన్ దీనార్", PluralForms{"", "సెర్బియన్ దీనార్", "", "", "", "సెర్బియన్ దీనార్\u200cలు"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "రష్యన్ రూబల్", PluralForms{"", "రష్యన్ రూబల్", "", "", "", "రష్యన్ రూబల్\u200cలు"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ర్వానడాన్ ఫ్రాంక్", PluralForms{"", "ర్వానడాన్ ఫ్రాంక్", "", "", "", "ర్వానడాన్ ఫ్రాంక్\u200cలు"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "సౌది రియల్", PluralForms{"", "సౌది రియల్", "", "", "", "సౌది రియల్\u200cలు"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "సోలోమన్ దీవుల డాలర్", PluralForms{"", "సోలోమన్ దీవుల డాలర్", "", "", "", "సోలోమన్ దీవుల డాలర్\u200cలు"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "సెయిచెల్లోయిస్ రూపాయి", PluralForms{"", "సెయిచెల్లోయిస్ రూపాయి", "", "", "", "సెయిచెల్లోయిస్ రూపాయలు"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "సుడానీస్ పౌండ్", PluralForms{"", "సుడానీస్ పౌండ్", "", "", "", "సుడానీస్ పౌండ్\u200cలు"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "స్వీడిష్ క్రోనా", PluralForms{"", "స్వీడిష్ క్రోనా", "", "", "", "స్వీడిష్ క్రోనర్"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "సింగపూర్ డాలర్", PluralForms{"", "సింగపూర్ డాలర్", "", "", "", "సింగపూర్ డాలర్\u200cలు"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "సెయింట్ హెలెనా పౌండ్", PluralForms{"", "సెయింట్ హెలెనా పౌండ్", "", "", "", "సెయింట్ హెలెనా పౌండ్\u200cలు"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "సియెరా లియోనియన్ లియోన్", PluralForms{"", "సియెరా లియోనియన్ లియోన్", "", "", "", "సియెరా లియోనియన్ లియోన్స్"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "సీయిరు లియోనియన్ లీయోన్ (1964—2022)", PluralForms{"", "సీయిరు లియోనియన్ లీయోన్ (1964—2022)", "", "", "", "సీయిరు లియోనియన్ లీయోన్\u200cలు (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "సొమాలి షిల్లింగ్", PluralForms{"", "సొమాలి షిల్లింగ్", "", "", "", "సొమాలి షిల్లింగ్\u200cలు"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "సురినామీయుల డాలర్", PluralForms{"", "సురినామీయుల డాలర్", "", "", "", "సురినామీయుల డాలర్\u200cలు"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "దక్షిణ సుడానీస్ పౌండ్", PluralForms{"", "దక్షిణ సుడానీస్ పౌండ్", "", "", "", "దక్షిణ సుడానీస్ పౌండ్\u200cలు"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "సావో టోమ్ మరియు ప్రిన్సిపి డోబ్రా (1977–2017)", PluralForms{"", "సావో టోమ్ మరియు ప్రిన్సిపి డోబ్రా (1977–2017)", "", "", "", "సావో టోమ్ మరియు ప్రిన్సిపి డోబ్రాలు (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "సావో టోమ్ మరియు ప్రిన్సిపి డోబ్రా", PluralForms{"", "సావో టోమ్ మరియు ప్రిన్సిపి డోబ్రా", "", "", "", "సావో టోమ్ మరియు ప్రిన్సిపి డోబ్రాలు"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "సిరీయన్ పౌండ్", PluralForms{"", "సిరీయన్ పౌండ్", "", "", "", "సిరీయన్ పౌండ్\u200cలు"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "స్వాజి లిలాన్గేని", PluralForms{"", "స్వాజి లిలాన్గేని", "", "", "", "స్వాజి ఎమలాన్గేని"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "థాయ్ బాట్", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "తజికిస్థాన్ సమోని", PluralForms{"", "తజికిస్థాన్ సమోని", "", "", "", "తజికిస్థాన్ సమోనీలు"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "తుర్క్\u200cమెనిస్థాని మనాట్", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "తునీషియన్ దీనార్", PluralForms{"", "తునీషియన్ దీనార్", "", "", "", "తునీషియన్ దీనార్\u200cలు"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "టోంగాన్ పాంʻగా", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "టర్కిస్ లీరా", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ట్రినిడాడ్ మరియు టొబాగో డాలర్", PluralForms{"", "ట్రినిడాడ్ మరియు టొబాగో డాలర్", "", "", "", "ట్రినిడాడ్ మరియు టొబాగో డాలర్\u200cలు"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "క్రొత్త తైవాన్ డాలర్", PluralForms{"", "క్రొత్త తైవాన్ డాలర్", "", "", "", "కొత్త తైవాన్ డాలర్\u200cలు"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "టాంజానియన్ షిల్లింగ్", PluralForms{"", "టాంజానియన్ షిల్లింగ్", "", "", "", "టాంజానియన్ షిల్లింగ్\u200cలు"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ఉక్రయినియన్ హ్రివ్\u200cనియా", PluralForms{"", "ఉక్రయినియన్ హ్రివ్\u200cనియా", "", "", "", "ఉక్రయినియన్ హ్రివ్\u200cనియాలు"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ఉగాండన్ షిల్లింగ్", PluralForms{"", "ఉగాండన్ షిల్లింగ్", "", "", "", "ఉగాండన్ షిల్లింగ్\u200cలు"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "అమెరికా డాలర్", PluralForms{"", "అమెరికా డాలర్", "", "", "", "అమెరికా డాలర్\u200cలు"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "ఉరుగ్వెయన్ పెసో", PluralForms{"", "ఉరుగ్వెయన్ పెసో", "", "", "", "ఉరుగ్వెయన్ పెసోలు"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "ఉజ్\u200cబెకిస్తాన్ సౌమ్", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "వెనుజులా బోలివర్ (2008–2018)", PluralForms{"", "వెనుజులా బోలివర్ (2008–2018)", "", "", "", "వెనుజులా బోలివర్\u200cలు (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "వెనుజులా బోలివర్", PluralForms{"", "వెనుజులా బోలివర్", "", "", "", "వెనుజులా బోలివర్\u200cలు"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "వియత్నామీయుల డాంగ్", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "వనాటు వటు", PluralForms{"", "వనాటు వటు", "", "", "", "వవాటు వటూలు"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "సమోయన్ తాలా", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "సెంట్రల్ ఆఫ్రికన్ సిఎఫ్\u200cఎ ఫ్రాంక్", PluralForms{"", "సెంట్రల్ ఆఫ్రికన్ సిఎఫ్\u200cఎ ఫ్రాంక్", "", "", "", "సెంట్రల్ ఆఫ్రికన్ సిఎఫ్\u200cఎ ఫ్రాంక్\u200cలు"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "తూర్పు కరీబియన్ డాలర్", PluralForms{"", "తూర్పు కరీబియన్ డాలర్", "", "", "", "తూర్పు కరీబియన్ డాలర్\u200cలు"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "పశ్చిమ ఆఫ్రికన్ సిఏఫ్ఏ ఫ్రాంక్", PluralForms{"", "పశ్చిమ ఆఫ్రికన్ సిఏఫ్ఏ ఫ్రాంక్", "", "", "", "పశ్చిమ ఆఫ్రికన్ సిఏఫ్ఏ ఫ్రాంక్\u200cలు"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "సిఎఫ్\u200cపి ఫ్రాంక్", PluralForms{"", "సిఎఫ్\u200cపి ఫ్రాంక్", "", "", "", "సిఎఫ్\u200cపి ఫ్రాంక్\u200cలు"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "తెలియని కరెన్సీ", PluralForms{"", "తెలియని కరెన్సీ ప్రమాణం", "", "", "", "తెలియని కరెన్సీ"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "ఎమునీ రీయల్", PluralForms{"", "ఎమునీ రీయల్", "", "", "", "ఎమునీ రీయల్\u200cలు"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "దక్షిణ ఆఫ్రికా ర్యాండ్", PluralForms{"", "", "", "", "", ""}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "జాంబియన్ క్వాచా (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "జాంబియన్ క్వాచా", PluralForms{"", "జాంబియన్ క్వాచా", "", "", "", "జాంబియన్ క్వాచాలు"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,180 +6,233 @@ var ti = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0ሽ", "other": "0\u00a0ሽ"}},
+				{4, 2, map[string]string{"one": "0\u00a0ሽ", "other": "0\u00a0ሽ"}},
+				{5, 3, map[string]string{"one": "0\u00a0ሽ", "other": "0\u00a0ሽ"}},
+				{6, 1, map[string]string{"one": "0\u00a0ሚ", "other": "0\u00a0ሚ"}},
+				{7, 2, map[string]string{"one": "0\u00a0ሚ", "other": "0\u00a0ሚ"}},
+				{8, 3, map[string]string{"one": "0\u00a0ሚ", "other": "0\u00a0ሚ"}},
+				{9, 1, map[string]string{"one": "0\u00a0ቢ", "other": "0\u00a0ቢ"}},
+				{10, 2, map[string]string{"one": "0\u00a0ቢ", "other": "0\u00a0ቢ"}},
+				{11, 3, map[string]string{"one": "0\u00a0ቢ", "other": "0\u00a0ቢ"}},
+				{12, 1, map[string]string{"one": "0\u00a0ት", "other": "0\u00a0ት"}},
+				{13, 2, map[string]string{"one": "0\u00a0ት", "other": "0\u00a0ት"}},
+				{14, 3, map[string]string{"one": "0\u00a0ት", "other": "0\u00a0ት"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 ሽሕ", "other": "0 ሽሕ"}},
+				{4, 2, map[string]string{"one": "0 ሽሕ", "other": "0 ሽሕ"}},
+				{5, 3, map[string]string{"one": "0 ሽሕ", "other": "0 ሽሕ"}},
+				{6, 1, map[string]string{"one": "0 ሚልዮን", "other": "0 ሚልዮን"}},
+				{7, 2, map[string]string{"one": "0 ሚልዮን", "other": "0 ሚልዮን"}},
+				{8, 3, map[string]string{"one": "0 ሚልዮን", "other": "0 ሚልዮን"}},
+				{9, 1, map[string]string{"one": "0 ቢልዮን", "other": "0 ቢልዮን"}},
+				{10, 2, map[string]string{"one": "0 ቢልዮን", "other": "0 ቢልዮን"}},
+				{11, 3, map[string]string{"one": "0 ቢልዮን", "other": "0 ቢልዮን"}},
+				{12, 1, map[string]string{"one": "0 ትሪልዮን", "other": "0 ትሪልዮን"}},
+				{13, 2, map[string]string{"one": "0 ትሪልዮን", "other": "0 ትሪልዮን"}},
+				{14, 3, map[string]string{"one": "0 ትሪልዮን", "other": "0 ትሪልዮን"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "¤0\u00a0ሽ", "other": "¤0\u00a0ሽ"}},
+				{4, 2, map[string]string{"one": "¤0\u00a0ሽ", "other": "¤0\u00a0ሽ"}},
+				{5, 3, map[string]string{"one": "¤0\u00a0ሽ", "other": "¤0\u00a0ሽ"}},
+				{6, 1, map[string]string{"one": "¤0\u00a0ሚ", "other": "¤0\u00a0ሚ"}},
+				{7, 2, map[string]string{"one": "¤0\u00a0ሚ", "other": "¤0\u00a0ሚ"}},
+				{8, 3, map[string]string{"one": "¤0\u00a0ሚ", "other": "¤0\u00a0ሚ"}},
+				{9, 1, map[string]string{"one": "¤0\u00a0ቢ", "other": "¤0\u00a0ቢ"}},
+				{10, 2, map[string]string{"one": "¤0\u00a0ቢ", "other": "¤0\u00a0ቢ"}},
+				{11, 3, map[string]string{"one": "¤0\u00a0ቢ", "other": "¤0\u00a0ቢ"}},
+				{12, 1, map[string]string{"one": "¤0\u00a0ት", "other": "¤0\u00a0ት"}},
+				{13, 2, map[string]string{"one": "¤0\u00a0ት", "other": "¤0\u00a0ት"}},
+				{14, 3, map[string]string{"one": "¤0\u00a0ት", "other": "¤0\u00a0ት"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "⩾{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "Br", "Br"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JPY", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "ሕቡራት ኢማራት ዓረብ ዲርሃም", PluralForms{"", "ኢማራት ዲርሃም", "", "", "", "ኢማራት ዲርሃም"}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "ኣፍጋኒስታናዊ ኣፍጋን", PluralForms{"", "ኣፍጋኒስታናዊ ኣፍጋን", "", "", "", "ኣፍጋኒስታናዊ ኣፍጋን"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "ኣልባናዊ ሌክ", PluralForms{"", "ኣልባናዊ ሌክ", "", "", "", "ኣልባናዊ ሌኬ"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "ኣርመንያዊ ድራም", PluralForms{"", "ኣርመንያዊ ድራም", "", "", "", "ኣርመንያዊ ድራም"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "ሆላንድ ኣንቲለያን ጊልደር", PluralForms{"", "ሆላንድ ኣንቲለያን ጊልደር", "", "", "", "ሆላንድ ኣንቲለያን ጊልደር"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "ኣንጎላዊ ክዋንዛ", PluralForms{"", "ኣንጎላዊ ክዋንዛ", "", "", "", "ኣንጎላዊ ክዋንዛ"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "ኣርጀንቲናዊ ፔሶ", PluralForms{"", "ኣርጀንቲናዊ ፔሶ", "", "", "", "ኣርጀንቲናዊ ፔሶ"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "ኣውስትራልያዊ ዶላር", PluralForms{"", "ኣውስትራልያዊ ዶላር", "", "", "", "ኣውስትራልያዊ ዶላር"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "ኣሩባን ፍሎሪን", PluralForms{"", "ኣሩባን ፍሎሪን", "", "", "", "ኣሩባን ፍሎሪን"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "ኣዘርባጃናዊ ማናት", PluralForms{"", "ኣዘርባጃናዊ ማናት", "", "", "", "ኣዘርባጃናዊ ማናት"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "ቦዝንያ-ሄርዘጎቪና ተቐያሪ ምልክት", PluralForms{"", "ቦዝንያ-ሄርዘጎቪና ተቐያሪ ምልክት", "", "", "", "ቦዝንያ-ሄርዘጎቪና ተቐያሪ ምልክታት"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "ባርባዲያን ዶላር", PluralForms{"", "ባርባዲያን ዶላር", "", "", "", "ባርባዲያን ዶላር"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "ባንግላደሻዊ ታካ", PluralForms{"", "ባንግላደሻዊ ታካ", "", "", "", "ባንግላደሻዊ ታካ"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "ቡልጋርያዊ ሌቭ", PluralForms{"", "ቡልጋርያዊ ሌቭ", "", "", "", "ቡልጋርያዊ ሌቫ"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "ባሕሬናዊ ዲናር", PluralForms{"", "ባሕሬናዊ ዲናር", "", "", "", "ባሕሬናዊ ዲናር"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "ብሩንዳዊ ፍራንክ", PluralForms{"", "ብሩንዳዊ ፍራንክ", "", "", "", "ብሩንዳዊ ፍራንክ"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "በርሙዳን ዶላር", PluralForms{"", "በርሙዳን ዶላር", "", "", "", "በርሙዳን ዶላር"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "ብሩነይ ዶላር", PluralForms{"", "ብሩነይ ዶላር", "", "", "", "ብሩነይ ዶላር"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "ቦሊቭያዊ ቦሊቭያኖ", PluralForms{"", "ቦሊቭያዊ ቦሊቭያኖ", "", "", "", "ቦሊቭያዊ ቦሊቭያኖ"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "የብራዚል ሪል", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "ባሃማዊ ዶላር", PluralForms{"", "ባሃማዊ ዶላር", "", "", "", "ባሃማዊ ዶላር"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "ቡታናዊ ንጉልትሩም", PluralForms{"", "ቡታናዊ ንጉልትሩም", "", "", "", "ቡታናዊ ንጉልትሩም"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ቦትስዋናዊ ፑላ", PluralForms{"", "ቦትስዋናዊ ፑላ", "", "", "", "ቦትስዋናዊ ፑላ"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "ናይ ቤላሩስ ሩብል", PluralForms{"", "ናይ ቤላሩስ ሩብል", "", "", "", "ናይ ቤላሩስ ሩብል"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "ቤሊዝ ዶላር", PluralForms{"", "ቤሊዝ ዶላር", "", "", "", "ቤሊዝ ዶላር"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "ካናዳ ዶላር", PluralForms{"", "ካናዳ ዶላር", "", "", "", "ካናዳ ዶላር"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "ኮንጎ ፍራንክ", PluralForms{"", "ኮንጎ ፍራንክ", "", "", "", "ኮንጎ ፍራንክ"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "ስዊስ ፍራንክ", PluralForms{"", "ስዊስ ፍራንክ", "", "", "", "ስዊስ ፍራንክ"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "ቺለዊ ፔሶ", PluralForms{"", "ቺለዊ ፔሶ", "", "", "", "ቺለዊ ፔሶ"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "ቻይናዊ ዩዋን (ካብ ባሕሪ ወጻኢ)", PluralForms{"", "ቻይናዊ ዩዋን (ካብ ባሕሪ ወጻኢ)", "", "", "", "ቻይናዊ ዩዋን (ካብ ባሕሪ ወጻኢ)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "ዩዋን ቻይና", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "ኮሎምብያዊ ፔሶ", PluralForms{"", "ኮሎምብያዊ ፔሶ", "", "", "", "ኮሎምብያዊ ፔሶ"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "ኮስታሪካ ኮሎን", PluralForms{"", "ኮስታሪካ ኮሎን", "", "", "", "ኮስታሪካ ኮሎን"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "ኩባውያን ተቐያሪ ፔሶ", PluralForms{"", "ኩባውያን ተቐያሪ ፔሶ", "", "", "", "ኩባውያን ተቐያሪ ፔሶ"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "ኩባዊ ፔሶ", PluralForms{"", "ኩባዊ ፔሶ", "", "", "", "ኩባዊ ፔሶ"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "ናይ ኬፕ ቨርዲ ኤስኩዶ", PluralForms{"", "ናይ ኬፕ ቨርዲ ኤስኩዶ", "", "", "", "ናይ ኬፕ ቨርዲ ኤስኩዶ"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "ናይ ቸክ ኮሩና", PluralForms{"", "ናይ ቸክ ኮሩና", "", "", "", "ናይ ቸክ ኮሩና"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "ናይ ጅቡቲ ፍራንክ", PluralForms{"", "ናይ ጅቡቲ ፍራንክ", "", "", "", "ናይ ጅቡቲ ፍራንክ"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "ናይ ዴንማርክ ክሮነር", PluralForms{"", "ናይ ዴንማርክ ክሮነር", "", "", "", "ናይ ዴንማርክ ክሮነር"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "ዶሚኒካን ፔሶ", PluralForms{"", "ዶሚኒካን ፔሶ", "", "", "", "ዶሚኒካን ፔሶ"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "ኣልጀርያዊ ዲናር", PluralForms{"", "ኣልጀርያዊ ዲናር", "", "", "", "ኣልጀርያዊ ዲናር"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "ግብጻዊ ፓውንድ", PluralForms{"", "ግብጻዊ ፓውንድ", "", "", "", "ግብጻዊ ፓውንድ"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "ኤርትራዊ ናቕፋ", PluralForms{"", "ኤርትራዊ ናቕፋ", "", "", "", "ኤርትራዊ ናቕፋ"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "Br", "Br", "ብር", PluralForms{"", "ናይ ኢትዮጵያ ብር", "", "", "", "ናይ ኢትዮጵያ ብር"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "ዩሮ", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "ዶላር ፊጂ", PluralForms{"", "ዶላር ፊጂ", "", "", "", "ዶላር ፊጂ"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "ደሴታት ፎክላንድ ፓውንድ", PluralForms{"", "ደሴታት ፎክላንድ ፓውንድ", "", "", "", "ደሴታት ፎክላንድ ፓውንድ"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "የእንግሊዝ ፓውንድ ስተርሊንግ", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "ጆርጅያዊ ላሪ", PluralForms{"", "ጆርጅያዊ ላሪ", "", "", "", "ጆርጅያዊ ላሪ"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ጋናዊ ሴዲ", PluralForms{"", "ጋናዊ ሴዲ", "", "", "", "ጋናዊ ሴዲስ"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "ጂብራልተር ፓውንድ", PluralForms{"", "ጂብራልተር ፓውንድ", "", "", "", "ጂብራልተር ፓውንድ"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "ጋምብያዊ ዳላሲ", PluralForms{"", "ጋምብያዊ ዳላሲ", "", "", "", "ጋምብያዊ ዳላሲስ"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "ናይ ጊኒ ፍራንክ", PluralForms{"", "ናይ ጊኒ ፍራንክ", "", "", "", "ናይ ጊኒ ፍራንክ"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "ጓቲማላ ኲትዛል", PluralForms{"", "ጓቲማላ ኲትዛል", "", "", "", "ጓቲማላ ኲትዛል"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "ጓያናኛ ዶላር", PluralForms{"", "ጓያናኛ ዶላር", "", "", "", "ጓያናኛ ዶላር"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "ሆንግ ኮንግ ዶላር", PluralForms{"", "ሆንግ ኮንግ ዶላር", "", "", "", "ሆንግ ኮንግ ዶላር"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "ሆንዱራስ ለምፒራ", PluralForms{"", "ሆንዱራስ ለምፒራ", "", "", "", "ሆንዱራስ ለምፒራ"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "ክሮኤሽያዊ ኩና", PluralForms{"", "ክሮኤሽያዊ ኩና", "", "", "", "ክሮኤሽያዊ ኩና"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "ናይ ሃይቲ ጎርደ", PluralForms{"", "ናይ ሃይቲ ጎርደ", "", "", "", "ናይ ሃይቲ ጎርደ"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "ሃንጋርያዊ ፎርንት", PluralForms{"", "ሃንጋርያዊ ፎርንት", "", "", "", "ሃንጋርያዊ ፎርንት"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "ኢንዶነዥያዊ ሩፒያ", PluralForms{"", "ኢንዶነዥያዊ ሩፒያ", "", "", "", "ኢንዶነዥያዊ ሩፒያ"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "እስራኤላዊ ሓድሽ ሸቃል", PluralForms{"", "እስራኤላዊ ሓድሽ ሸቃል", "", "", "", "እስራኤላዊ ሓድሽ ሸቃል"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "ናይ ሕንድ ሩፒ", PluralForms{"", "ናይ ሕንድ ሩፒ", "", "", "", "ናይ ሕንድ ሩፒ"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "ዒራቂ ዲናር", PluralForms{"", "ናይ ዒራቕ ዲናር", "", "", "", "ዒራቂ ዲናር"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "ናይ ኢራን ርያል", PluralForms{"", "ናይ ኢራን ርያል", "", "", "", "ናይ ኢራን ርያል"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ናይ ኣይስላንድ ክሮና", PluralForms{"", "ናይ ኣይስላንድ ክሮና", "", "", "", "ናይ ኣይስላንድ ክሮና"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ጃማይካ ዶላር", PluralForms{"", "ጃማይካ ዶላር", "", "", "", "ጃማይካ ዶላር"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "ዮርዳኖሳዊ ዲናር", PluralForms{"", "ዮርዳኖሳዊ ዲናር", "", "", "", "ዮርዳኖሳዊ ዲናር"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JPY", "¥", "የን ጃፓን", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "ኬንያዊ ሽልንግ", PluralForms{"", "ኬንያዊ ሽልንግ", "", "", "", "ኬንያዊ ሽልንግ"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "ኪርጊስታናዊ ሶም", PluralForms{"", "ኪርጊስታናዊ ሶም", "", "", "", "ኪርጊስታናዊ ሶም"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "ካምቦድያዊ ሪኤል", PluralForms{"", "ካምቦድያዊ ሪኤል", "", "", "", "ካምቦድያዊ ሪኤል"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "ኮሞርያዊ ፍራንክ", PluralForms{"", "ኮሞርያዊ ፍራንክ", "", "", "", "ኮሞርያዊ ፍራንክ"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "ሰሜን ኮርያዊ ዎን", PluralForms{"", "ሰሜን ኮርያዊ ዎን", "", "", "", "ሰሜን ኮርያዊ ዎን"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "ደቡብ ኮርያዊ ዎን", PluralForms{"", "ደቡብ ኮርያዊ ዎን", "", "", "", "ደቡብ ኮርያዊ ዎን"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "ኩዌቲ ዲናር", PluralForms{"", "ኩዌቲ ዲናር", "", "", "", "ኩዌቲ ዲናር"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "ደሴታት ካይመን ዶላር", PluralForms{"", "ደሴታት ካይመን ዶላር", "", "", "", "ደሴታት ካይመን ዶላር"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "ካዛኪስታናዊ ተንገ", PluralForms{"", "ካዛኪስታናዊ ተንገ", "", "", "", "ካዛኪስታናዊ ተንገ"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "ላኦስያዊ ኪፕ", PluralForms{"", "ላኦስያዊ ኪፕ", "", "", "", "ላኦስያዊ ኪፕ"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ሊባኖሳዊ ፓውንድ", PluralForms{"", "ሊባኖሳዊ ፓውንድ", "", "", "", "ሊባኖሳዊ ፓውንድ"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "ስሪላንካ ሩፒ", PluralForms{"", "ስሪላንካ ሩፒ", "", "", "", "ስሪላንካ ሩፒ"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "ላይበርያዊ ዶላር", PluralForms{"", "ላይበርያዊ ዶላር", "", "", "", "ላይበርያዊ ዶላር"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "ሌሶቶ ሎቲ", PluralForms{"", "ሌሶቶ ሎቲ", "", "", "", "ሌሶቶ ሎቲ"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "ናይ ሊብያ ዲናር", PluralForms{"", "ናይ ሊብያ ዲናር", "", "", "", "ናይ ሊብያ ዲናር"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "ሞሮካዊ ዲርሃም", PluralForms{"", "ሞሮካዊ ዲርሃም", "", "", "", "ሞሮካዊ ዲርሃም"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "ሞልዶቫን ሌው", PluralForms{"", "ሞልዶቫን ሌው", "", "", "", "ሞልዶቫን ሌይ"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "ማላጋሲ ኣሪያሪ", PluralForms{"", "ማላጋሲ ኣሪያሪ", "", "", "", "ማላጋሲ ኣሪያሪ"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "ናይ መቄዶንያ ዲናር", PluralForms{"", "ናይ መቄዶንያ ዲናር", "", "", "", "ናይ መቄዶንያ ዲናሪ"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "ሚያንማር ክያት", PluralForms{"", "ሚያንማር ክያት", "", "", "", "ሚያንማር ክያት"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "ሞንጎላዊ ቱግሪክ", PluralForms{"", "ሞንጎላዊ ቱግሪክ", "", "", "", "ሞንጎላዊ ቱግሪክ"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "ማካኒዝ ፓታካ", PluralForms{"", "ማካኒዝ ፓታካ", "", "", "", "ማካኒዝ ፓታካ"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "ሞሪታናዊ ኡጉዋያ", PluralForms{"", "ሞሪታናዊ ኡጉዋያ", "", "", "", "ሞሪታናዊ ኡጉዋያ"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "ሞሪሸስ ሩፒ", PluralForms{"", "ሞሪሸስ ሩፒ", "", "", "", "ሞሪሸስ ሩፒ"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "ማልዲቭያዊ ሩፍያ", PluralForms{"", "ማልዲቭያዊ ሩፍያ", "", "", "", "ማልዲቭያዊ ሩፍያ"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "ማላዊያዊ ኳቻ", PluralForms{"", "ማላዊያዊ ኳቻ", "", "", "", "ማላዊያዊ ኳቻ"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "ሜክሲካዊ ፔሶ", PluralForms{"", "ሜክሲካዊ ፔሶ", "", "", "", "ሜክሲካዊ ፔሶ"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "ሜክሲካዊ ብሩር ፔሶ (1861–1992)", PluralForms{"", "ሜክሲካዊ ብሩር ፔሶ (1861–1992)", "", "", "", "ሜክሲካዊ ብሩር ፔሶ (1861–1992)"}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "ኣሃዱ ወፍሪ ሜክሲኮ", PluralForms{"", "ኣሃዱ ወፍሪ ሜክሲኮ", "", "", "", "ኣሃዱ ወፍሪ ሜክሲኮ"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "ማሌዥያዊ ሪንግጊት", PluralForms{"", "ማሌዥያዊ ሪንግጊት", "", "", "", "ማሌዥያዊ ሪንግጊት"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "ሞዛምቢካዊ ሜቲካል", PluralForms{"", "ሞዛምቢካዊ ሜቲካል", "", "", "", "ሞዛምቢካዊ ሜቲካል"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "ናሚብያ ዶላር", PluralForms{"", "ናሚብያ ዶላር", "", "", "", "ናሚብያ ዶላር"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "ናይጀርያዊ ናይራ", PluralForms{"", "ናይጀርያዊ ናይራ", "", "", "", "ናይጀርያዊ ናይራስ"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "ኒካራጓ ካርዶባ (1988–1991)", PluralForms{"", "ኒካራጓ ካርዶባ (1988–1991)", "", "", "", "ኒካራጓ ካርዶባ (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "ኒካራጓ ኮርዶባ", PluralForms{"", "ኒካራጓ ኮርዶባ", "", "", "", "ኒካራጓ ኮርዶባ"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "ናይ ኖርወይ ክሮነር", PluralForms{"", "ናይ ኖርወይ ክሮነር", "", "", "", "ናይ ኖርወይ ክሮነር"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "ኔፓላዊ ሩፒ", PluralForms{"", "ኔፓላዊ ሩፒ", "", "", "", "ኔፓላዊ ሩፒ"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "ኒውዚላንዳዊ ዶላር", PluralForms{"", "ኒውዚላንዳዊ ዶላር", "", "", "", "ኒውዚላንዳዊ ዶላር"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "ኦማን ርያል", PluralForms{"", "ኦማን ርያል", "", "", "", "ኦማን ርያል"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "ፓናማያን ባልቦኣ", PluralForms{"", "ፓናማያን ባልቦኣ", "", "", "", "ፓናማያን ባልቦኣ"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "ፔሩቪያን ሶል", PluralForms{"", "ፔሩቪያን ሶል", "", "", "", "ፔሩቪያን ሶል"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "ፓፑዋ ኒው ጊኒ ኪና", PluralForms{"", "ፓፑዋ ኒው ጊኒ ኪና", "", "", "", "ፓፑዋ ኒው ጊኒ ኪና"}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "ፊሊፒንስ ፔሶ", PluralForms{"", "ፊሊፒንስ ፔሶ", "", "", "", "ፊሊፒንስ ፔሶ"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "ፓኪስታናዊ ሩፒ", PluralForms{"", "ፓኪስታናዊ ሩፒ", "", "", "", "ፓኪስታናዊ ሩፒ"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "ፖላንዳዊ ዝሎቲ", PluralForms{"", "ፖላንዳዊ ዝሎቲ", "", "", "", "ፖላንዳዊ ዝሎቲ"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "ፓራጓያዊ ጓራኒ", PluralForms{"", "ፓራጓያዊ ጓራኒ", "", "", "", "ፓራጓያዊ ጓራኒ"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "ቀጠሪ ሪያል", PluralForms{"", "ቀጠሪ ሪያል", "", "", "", "ቀጠሪ ሪያል"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "ሮማንያዊ ሌው", PluralForms{"", "ሮማንያዊ ሌው", "", "", "", "ሮማንያዊ ሌይ"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "ናይ ሰርብያን ዲናር", PluralForms{"", "ናይ ሰርብያን ዲናር", "", "", "", "ናይ ሰርብያን ዲናር"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "የራሻ ሩብል", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "ፍራንክ ሩዋንዳ", PluralForms{"", "ፍራንክ ሩዋንዳ", "", "", "", "ፍራንክ ሩዋንዳ"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "ስዑዲ ዓረብ ሪያል", PluralForms{"", "ስዑዲ ዓረብ ሪያል", "", "", "", "ስዑዲ ዓረብ ሪያል"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "ደሴታት ሰሎሞን ዶላር", PluralForms{"", "ደሴታት ሰሎሞን ዶላር", "", "", "", "ደሴታት ሰሎሞን ዶላር"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "ሲሸሎ ሩፒ", PluralForms{"", "ሲሸሎ ሩፒ", "", "", "", "ሲሸሎ ሩፒ"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "ሱዳናዊ ፓውንድ", PluralForms{"", "ሱዳናዊ ፓውንድ", "", "", "", "ሱዳናዊ ፓውንድ"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "ሽወደናዊ ክሮና", PluralForms{"", "ሽወደናዊ ክሮና", "", "", "", "ሽወደናዊ ክሮና"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "ሲንጋፖር ዶላር", PluralForms{"", "ሲንጋፖር ዶላር", "", "", "", "ሲንጋፖር ዶላር"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "ቅድስቲ ሄለና ፓውንድ", PluralForms{"", "ቅድስቲ ሄለና ፓውንድ", "", "", "", "ቅድስቲ ሄለና ፓውንድ"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "ሴራሊዮን ልዮን", PluralForms{"", "ሴራሊዮን ልዮን", "", "", "", "ሴራሊዮን ልዮንስ"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "ሴራሊዮን ልዮን (1964—2022)", PluralForms{"", "ሴራሊዮን ልዮን (1964—2022)", "", "", "", "ሴራሊዮን ልዮንስ (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "ሶማልያዊ ሽልንግ", PluralForms{"", "ሶማልያዊ ሽልንግ", "", "", "", "ሶማልያዊ ሽልንግ"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "ሱሪናማዊ ዶላር", PluralForms{"", "ሱሪናማዊ ዶላር", "", "", "", "ሱሪናማዊ ዶላር"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "ደቡብ ሱዳን ፓውንድ", PluralForms{"", "ደቡብ ሱዳን ፓውንድ", "", "", "", "ደቡብ ሱዳን ፓውንድ"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "ሳኦ ቶሜን ፕሪንሲፐ ዶብራ", PluralForms{"", "ሳኦ ቶሜን ፕሪንሲፐ ዶብራ", "", "", "", "ሳኦ ቶሜን ፕሪንሲፐ ዶብራ"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "ሳልቫዶራን ኮሎን", PluralForms{"", "ሳልቫዶራን ኮሎን", "", "", "", "ሳልቫዶራን ኮሎን"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "ሶርያዊ ፓውንድ", PluralForms{"", "ሶርያዊ ፓውንድ", "", "", "", "ሶርያዊ ፓውንድ"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "ስዋዚ ሊላንገኒ", PluralForms{"", "ስዋዚ ሊላንገኒ", "", "", "", "ስዋዚ ሊላንገኒ"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "ታይላንዳዊ ባህ", PluralForms{"", "ታይላንዳዊ ባህ", "", "", "", "ታይላንዳዊ ባህ"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "ታጂኪስታናዊ ሶሞኒ", PluralForms{"", "ታጂኪስታናዊ ሶሞኒ", "", "", "", "ታጂኪስታናዊ ሶሞኒ"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "ቱርክመኒስታናዊ ማናት", PluralForms{"", "ቱርክመኒስታናዊ ማናት", "", "", "", "ቱርክመኒስታናዊ ማናት"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "ቱኒዝያዊ ዲናር", PluralForms{"", "ቱኒዝያዊ ዲናር", "", "", "", "ቱኒዝያዊ ዲናር"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "ቶንጋዊ ፓ`ኣንጋ", PluralForms{"", "ቶንጋዊ ፓ`ኣንጋ", "", "", "", "ቶንጋዊ ፓ`ኣንጋ"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "ቱርካዊ ሊራ", PluralForms{"", "ቱርካዊ ሊራ", "", "", "", "ቱርካዊ ሊራ"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "ትሪኒዳድን ቶባጎ ዶላር", PluralForms{"", "ትሪኒዳድን ቶባጎ ዶላር", "", "", "", "ትሪኒዳድን ቶባጎ ዶላር"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "ኒው ታይዋን ዶላር", PluralForms{"", "ኒው ታይዋን ዶላር", "", "", "", "ኒው ታይዋን ዶላር"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "ታንዛንያዊ ሽልንግ", PluralForms{"", "ታንዛንያዊ ሽልንግ", "", "", "", "ታንዛንያዊ ሽልንግ"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "ዩክሬናዊት ሪቭንያ", PluralForms{"", "ዩክሬናዊት ሪቭንያ", "", "", "", "ዩክሬናዊት ሪቭንያ"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "ኡጋንዳዊ ሽልንግ", PluralForms{"", "ኡጋንዳዊ ሽልንግ", "", "", "", "ኡጋንዳዊ ሽልንግ"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "ዶላር ኣመሪካ", PluralForms{"", "", "", "", "", ""}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "ዶላር ኣመሪካ (ዝቕጽል መዓልቲ)", PluralForms{"", "ዶላር ኣመሪካ (ዝቕጽል መዓልቲ)", "", "", "", "ዶላር ኣመሪካ (ዝቕጽል መዓልቲ)"}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "ዶላር ኣመሪካ (ተመሳሳሊ መዓልቲ)", PluralForms{"", "ዶላር ኣመሪካ (ተመሳሳሊ መዓልቲ)", "", "", "", "ዶላር ኣመሪካ (ተመሳሳሊ መዓልቲ)"}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "ኡራጋያዊ ፔሶ", PluralForms{"", "ኡራጋያዊ ፔሶ", "", "", "", "ኡራጋያዊ ፔሶ"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "ኡዝቤኪስታናዊ ሶም", PluralForms{"", "ኡዝቤኪስታናዊ ሶም", "", "", "", "ኡዝቤኪስታናዊ ሶም"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "ቬንዙዌላዊ ቦሊቫር", PluralForms{"", "ቬንዙዌላዊ ቦሊቫር", "", "", "", "ቬንዙዌላዊ ቦሊቫር"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "ቬትናማዊ ዶንግ", PluralForms{"", "ቬትናማዊ ዶንግ", "", "", "", "ቬትናማዊ ዶንግ"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "ቫኑኣቱ ቫቱ", PluralForms{"", "ቫኑኣቱ ቫቱ", "", "", "", "ቫኑኣቱ ቫቱ"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "ሳሞኣዊ ታላ", PluralForms{"", "ሳሞኣዊ ታላ", "", "", "", "ሳሞኣዊ ታላ"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "ማእከላይ ኣፍሪቃ ሲኤፍኤ ፍራንክ", PluralForms{"", "ማእከላይ ኣፍሪቃ ሲኤፍኤ ፍራንክ", "", "", "", "ማእከላይ ኣፍሪቃ ሲኤፍኤ ፍራንክ"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "ብሩር", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "ወርቂ", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "ምብራቕ ካሪብያን ዶላር", PluralForms{"", "ምብራቕ ካሪብያን ዶላር", "", "", "", "ምብራቕ ካሪብያን ዶላር"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "ምዕራብ ኣፍሪቃ CFA ፍራንክ", PluralForms{"", "ምዕራብ ኣፍሪቃ ሲኤፍኤ ፍራንክ", "", "", "", "ምዕራብ ኣፍሪቃ CFA ፍራንክ"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "ሲኤፍፒ ፍራንክ", PluralForms{"", "ሲኤፍፒ ፍራንክ", "", "", "", "ሲኤፍፒ ፍራንክ"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "ዘይተፈልጠ ባጤራ", PluralForms{"", "(ዘይተፈልጠ ባጤራ)", "", "", "", "(ዘይተፈልጠ ባጤራ)"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "የመኒ ርያል", PluralForms{"", "የመኒ ርያል", "", "", "", "የመኒ ርያል"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "ናይ ደቡብ ኣፍሪቃ ራንድ", PluralForms{"", "ናይ ደቡብ ኣፍሪቃ ራንድ", "", "", "", "ናይ ደቡብ ኣፍሪቃ ራንድ"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "ዛምብያዊ ኳቻ", PluralForms{"", "ዛምብያዊ ኳቻ", "", "", "", "ዛምብያዊ ኳቻ"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,175 +6,228 @@ var tk = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "san\u00a0däl",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "\u00a0%", "-", "\u00a0%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0müň", "other": "0\u00a0müň"}},
+				{4, 2, map[string]string{"one": "0\u00a0müň", "other": "0\u00a0müň"}},
+				{5, 3, map[string]string{"one": "0\u00a0müň", "other": "0\u00a0müň"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln", "other": "0\u00a0mln"}},
+				{9, 1, map[string]string{"one": "0\u00a0mlrd", "other": "0\u00a0mlrd"}},
+				{10, 2, map[string]string{"one": "0\u00a0mlrd", "other": "0\u00a0mlrd"}},
+				{11, 3, map[string]string{"one": "0\u00a0mlrd", "other": "0\u00a0mlrd"}},
+				{12, 1, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+				{13, 2, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+				{14, 3, map[string]string{"one": "0\u00a0trln", "other": "0\u00a0trln"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0 müň", "other": "0 müň"}},
+				{4, 2, map[string]string{"one": "0 müň", "other": "0 müň"}},
+				{5, 3, map[string]string{"one": "0 müň", "other": "0 müň"}},
+				{6, 1, map[string]string{"one": "0 million", "other": "0 million"}},
+				{7, 2, map[string]string{"one": "0 million", "other": "0 million"}},
+				{8, 3, map[string]string{"one": "0 million", "other": "0 million"}},
+				{9, 1, map[string]string{"one": "0 milliard", "other": "0 milliard"}},
+				{10, 2, map[string]string{"one": "0 milliard", "other": "0 milliard"}},
+				{11, 3, map[string]string{"one": "0 milliard", "other": "0 milliard"}},
+				{12, 1, map[string]string{"one": "0 trillion", "other": "0 trillion"}},
+				{13, 2, map[string]string{"one": "0 trillion", "other": "0 trillion"}},
+				{14, 3, map[string]string{"one": "0 trillion", "other": "0 trillion"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0müň\u00a0¤", "other": "0\u00a0müň\u00a0¤"}},
+				{4, 2, map[string]string{"one": "0\u00a0müň\u00a0¤", "other": "0\u00a0müň\u00a0¤"}},
+				{5, 3, map[string]string{"one": "0\u00a0müň\u00a0¤", "other": "0\u00a0müň\u00a0¤"}},
+				{6, 1, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{7, 2, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{8, 3, map[string]string{"one": "0\u00a0mln\u00a0¤", "other": "0\u00a0mln\u00a0¤"}},
+				{9, 1, map[string]string{"one": "0\u00a0mlrd\u00a0¤", "other": "0\u00a0mlrd\u00a0¤"}},
+				{10, 2, map[string]string{"one": "0\u00a0mlrd\u00a0¤", "other": "0\u00a0mlrd\u00a0¤"}},
+				{11, 3, map[string]string{"one": "0\u00a0mlrd\u00a0¤", "other": "0\u00a0mlrd\u00a0¤"}},
+				{12, 1, map[string]string{"one": "0\u00a0trln\u00a0¤", "other": "0\u00a0trln\u00a0¤"}},
+				{13, 2, map[string]string{"one": "0\u00a0trln\u00a0¤", "other": "0\u00a0trln\u00a0¤"}},
+				{14, 3, map[string]string{"one": "0\u00a0trln\u00a0¤", "other": "0\u00a0trln\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "EUR", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Kg.", "Kg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "BAE dirhemi", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Owgan afganisi", PluralForms{"", "owgan afganisi", "", "", "", "owgan afganisi"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Alban leki", PluralForms{"", "alban leki", "", "", "", "alban leki"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Ermeni dramy", PluralForms{"", "ermeni dramy", "", "", "", "ermeni dramy"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Niderland antil guldeni", PluralForms{"", "niderland antil guldeni", "", "", "", "niderland antil guldeni"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Angola kwanzasy", PluralForms{"", "angola kwanzasy", "", "", "", "angola kwanzasy"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Argentin pesosy", PluralForms{"", "argentin pesosy", "", "", "", "argentin pesosy"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Awstraliýa dollary", PluralForms{"", "awstraliýa dollary", "", "", "", "awstraliýa dollary"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Aruba florini", PluralForms{"", "aruba florini", "", "", "", "aruba florini"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Azerbaýjan manady", PluralForms{"", "azerbaýjan manady", "", "", "", "azerbaýjan manady"}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Konwertirlenýän Bosniýa we Gersegowina markasy", PluralForms{"", "konwertirlenýän bosniýa we gersegowina markasy", "", "", "", "konwertirlenýän bosniýa we gersegowina markasy"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Barbados dollary", PluralForms{"", "barbados dollary", "", "", "", "barbados dollary"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Bangladeş takasy", PluralForms{"", "bangladeş takasy", "", "", "", "bangladeş takasy"}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Bolgar lewi", PluralForms{"", "bolgar lewi", "", "", "", "bolgar lewi"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Bahreýn dinary", PluralForms{"", "bahreýn dinary", "", "", "", "bahreýn dinary"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Burundi franky", PluralForms{"", "burundi franky", "", "", "", "burundi franky"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Bermuda dollary", PluralForms{"", "bermuda dollary", "", "", "", "bermuda dollary"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Bruneý dollary", PluralForms{"", "bruneý dollary", "", "", "", "bruneý dollary"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliwiýa boliwianosy", PluralForms{"", "boliwiýa boliwianosy", "", "", "", "boliwiýa boliwianosy"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Brazil realy", PluralForms{"", "brazil realy", "", "", "", "brazil realy"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Bagama dollary", PluralForms{"", "bagama dollary", "", "", "", "bagama dollary"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Butan ngultrumy", PluralForms{"", "butan ngultrumy", "", "", "", "butan ngultrumy"}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Botswana pulasy", PluralForms{"", "botswana pulasy", "", "", "", "botswana pulasy"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Belarus rubly", PluralForms{"", "belarus rubly", "", "", "", "belarus rubly"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Belorus rubly (2000–2016)", PluralForms{"", "belorus rubly (2000–2016)", "", "", "", "belorus rubly (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Beliz dollary", PluralForms{"", "beliz dollary", "", "", "", "beliz dollary"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Kanada dollary", PluralForms{"", "kanada dollary", "", "", "", "kanada dollary"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Kongo franky", PluralForms{"", "kongo franky", "", "", "", "kongo franky"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Şweýsar franky", PluralForms{"", "şweýsar franky", "", "", "", "şweýsar franky"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Çili pesosy", PluralForms{"", "çili pesosy", "", "", "", "çili pesosy"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Hytaý ýuany (ofşor)", PluralForms{"", "hytaý ýuany (ofşor)", "", "", "", "hytaý ýuany (ofşor)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Hytaý ýuany", PluralForms{"", "hytaý ýuany", "", "", "", "hytaý ýuany"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Kolumbiýa pesosy", PluralForms{"", "kolumbiýa pesosy", "", "", "", "kolumbiýa pesosy"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kosta-Rika kolony", PluralForms{"", "kosta-rika kolony", "", "", "", "kosta-rika kolony"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Konwertirlenýän kuba pesosy", PluralForms{"", "konwertirlenýän kuba pesosy", "", "", "", "konwertirlenýän kuba pesosy"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Kuba pesosy", PluralForms{"", "kuba pesosy", "", "", "", "kuba pesosy"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Kabo-Werde eskudosy", PluralForms{"", "kabo-werde eskudosy", "", "", "", "kabo-werde eskudosy"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Çeh kronasy", PluralForms{"", "çeh kronasy", "", "", "", "çeh kronasy"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Jibuti franky", PluralForms{"", "jibuti franky", "", "", "", "jibuti franky"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Daniýa kronasy", PluralForms{"", "daniýa kronasy", "", "", "", "daniýa kronasy"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Dominikan pesosy", PluralForms{"", "dominikan pesosy", "", "", "", "dominikan pesosy"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Alžir dinary", PluralForms{"", "alžir dinary", "", "", "", "alžir dinary"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Müsür funty", PluralForms{"", "müsür funty", "", "", "", "müsür funty"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Eritreýa nakfasy", PluralForms{"", "eritreýa nakfasy", "", "", "", "eritreýa nakfasy"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Efiopiýa byry", PluralForms{"", "efiopiýa byry", "", "", "", "efiopiýa byry"}},
+		"EUR": {2, 0, 2, 1, "EUR", "EUR", "€", "Ýewro", PluralForms{"", "ýewro", "", "", "", "ýewro"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Fiji dollary", PluralForms{"", "fiji dollary", "", "", "", "fiji dollary"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Folklend adalarynyň funty", PluralForms{"", "folklend adalarynyň funty", "", "", "", "folklend adalarynyň funty"}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "Britan funt sterlingi", PluralForms{"", "britan funt sterlingi", "", "", "", "britan funt sterlingi"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Gruzin larisi", PluralForms{"", "gruzin larisi", "", "", "", "gruzin larisi"}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Gano sedisi", PluralForms{"", "gano sedisi", "", "", "", "gano sedisi"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Gibraltar funty", PluralForms{"", "gibraltar funty", "", "", "", "gibraltar funty"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Gambiýa dalasisi", PluralForms{"", "gambiýa dalasisi", "", "", "", "gambiýa dalasisi"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Gwineý franky", PluralForms{"", "gwineý franky", "", "", "", "gwineý franky"}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Gwatemala ketsaly", PluralForms{"", "gwatemala ketsaly", "", "", "", "gwatemala ketsaly"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Gaýana dollary", PluralForms{"", "gaýana dollary", "", "", "", "gaýana dollary"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Gonkong dollary", PluralForms{"", "gonkong dollary", "", "", "", "gonkong dollary"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Gonduras lempirasy", PluralForms{"", "gonduras lempirasy", "", "", "", "gonduras lempirasy"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Horwat kunasy", PluralForms{"", "horwat kunasy", "", "", "", "horwat kunasy"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gaiti gurdy", PluralForms{"", "gaiti gurdy", "", "", "", "gaiti gurdy"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Wenger forinti", PluralForms{"", "wenger forinti", "", "", "", "wenger forinti"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Indoneziýa rupiýasy", PluralForms{"", "indoneziýa rupiýasy", "", "", "", "indoneziýa rupiýasy"}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Täze Ysraýyl şekeli", PluralForms{"", "täze ysraýyl şekeli", "", "", "", "täze ysraýyl şekeli"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Hindi rupiýasy", PluralForms{"", "hindi rupiýasy", "", "", "", "hindi rupiýasy"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Yrak dinary", PluralForms{"", "yrak dinary", "", "", "", "yrak dinary"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Eýran rialy", PluralForms{"", "eýran rialy", "", "", "", "eýran rialy"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Islandiýa kronasy", PluralForms{"", "islandiýa kronasy", "", "", "", "islandiýa kronasy"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Ýamaýka dollary", PluralForms{"", "ýamaýka dollary", "", "", "", "ýamaýka dollary"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Iordan dinary", PluralForms{"", "iordan dinary", "", "", "", "iordan dinary"}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Ýapon ýeni", PluralForms{"", "ýapon ýeni", "", "", "", "ýapon ýeni"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Keniýa şillingi", PluralForms{"", "keniýa şillingi", "", "", "", "keniýa şillingi"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Gyrgyz somy", PluralForms{"", "gyrgyz somy", "", "", "", "gyrgyz somy"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Kamboja riýeli", PluralForms{"", "kamboja riýeli", "", "", "", "kamboja riýeli"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Komor adalarynyň franky", PluralForms{"", "komor adalarynyň franky", "", "", "", "komor adalarynyň franky"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Demirgazyk Koreý wony", PluralForms{"", "demirgazyk koreý wony", "", "", "", "demirgazyk koreý wony"}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Günorta Koreý wony", PluralForms{"", "günorta koreý wony", "", "", "", "günorta koreý wony"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Kuweýt dinary", PluralForms{"", "kuweýt dinary", "", "", "", "kuweýt dinary"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Kaýman adalarynyň dollary", PluralForms{"", "kaýman adalarynyň dollary", "", "", "", "kaýman adalarynyň dollary"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Gazak teňňesi", PluralForms{"", "gazak teňňesi", "", "", "", "gazak teňňesi"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Laos kipi", PluralForms{"", "laos kipi", "", "", "", "laos kipi"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Liwan funty", PluralForms{"", "liwan funty", "", "", "", "liwan funty"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Şri-Lanka rupiýasy", PluralForms{"", "şri-lanka rupiýasy", "", "", "", "şri-lanka rupiýasy"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Liberiýa dollary", PluralForms{"", "liberiýa dollary", "", "", "", "liberiýa dollary"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Lesoto lotisi", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Liwiýa dinary", PluralForms{"", "liwiýa dinary", "", "", "", "liwiýa dinary"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Marokko dirhamy", PluralForms{"", "marokko dirhamy", "", "", "", "marokko dirhamy"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Moldaw leýi", PluralForms{"", "moldaw leýi", "", "", "", "moldaw leýi"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Malagasiý ariarisi", PluralForms{"", "malagasiý ariarisi", "", "", "", "malagasiý ariarisi"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Makedon dinary", PluralForms{"", "makedon dinary", "", "", "", "makedon dinary"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Mýanma kýaty", PluralForms{"", "mýanma kýaty", "", "", "", "mýanma kýaty"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Mongol tugrigi", PluralForms{"", "mongol tugrigi", "", "", "", "mongol tugrigi"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Makao patakasy", PluralForms{"", "makao patakasy", "", "", "", "makao patakasy"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Mawritan ugiýasy (1973–2017)", PluralForms{"", "mawritan ugiýasy (1973–2017)", "", "", "", "mawritan ugiýasy (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Mawritan ugiýasy", PluralForms{"", "mawritan ugiýasy", "", "", "", "mawritan ugiýasy"}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Mawrikiý rupiýasy", PluralForms{"", "mawrikiý rupiýasy", "", "", "", "mawrikiý rupiýasy"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Maldiw rufiýasy", PluralForms{"", "maldiw rufiýasy", "", "", "", "maldiw rufiýasy"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Malawi kwaçasy", PluralForms{"", "malawi kwaçasy", "", "", "", "malawi kwaçasy"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Meksikan pesosy", PluralForms{"", "meksikan pesosy", "", "", "", "meksikan pesosy"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Malaýziýa ringgiti", PluralForms{"", "malaýziýa ringgiti", "", "", "", "malaýziýa ringgiti"}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mozambik metikaly", PluralForms{"", "mozambik metikaly", "", "", "", "mozambik metikaly"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Namibiýa dollary", PluralForms{"", "namibiýa dollary", "", "", "", "namibiýa dollary"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Nigeriýa naýrasy", PluralForms{"", "nigeriýa naýrasy", "", "", "", "nigeriýa naýrasy"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Nikaragua kordobasy", PluralForms{"", "nikaragua kordobasy", "", "", "", "nikaragua kordobasy"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Norwegiýa kronasy", PluralForms{"", "norwegiýa kronasy", "", "", "", "norwegiýa kronasy"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Nepal rupiýasy", PluralForms{"", "nepal rupiýasy", "", "", "", "nepal rupiýasy"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Täze Zelandiýa dollary", PluralForms{"", "täze zelandiýa dollary", "", "", "", "täze zelandiýa dollary"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Oman rialy", PluralForms{"", "oman rialy", "", "", "", "oman rialy"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Panama balboasy", PluralForms{"", "panama balboasy", "", "", "", "panama balboasy"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Peru soly", PluralForms{"", "peru soly", "", "", "", "peru soly"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Papua - Täze Gwineýa kinasy", PluralForms{"", "papua - täze gwineýa kinasy", "", "", "", "papua - täze gwineýa kinasy"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Filippin pesosy", PluralForms{"", "filippin pesosy", "", "", "", "filippin pesosy"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Päkistan rupiýasy", PluralForms{"", "päkistan rupiýasy", "", "", "", "päkistan rupiýasy"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Polýak zlotysy", PluralForms{"", "polýak zlotysy", "", "", "", "polýak zlotysy"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Paragwaý guaranisi", PluralForms{"", "paragwaý guaranisi", "", "", "", "paragwaý guaranisi"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Katar rialy", PluralForms{"", "katar rialy", "", "", "", "katar rialy"}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Rumyn leýi", PluralForms{"", "rumyn leýi", "", "", "", "rumyn leýi"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Serb dinary", PluralForms{"", "serb dinary", "", "", "", "serb dinary"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rus rubly", PluralForms{"", "rus rubly", "", "", "", "rus rubly"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Ruanda franky", PluralForms{"", "ruanda franky", "", "", "", "ruanda franky"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Saud rialy", PluralForms{"", "saud rialy", "", "", "", "saud rialy"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Solomon adalarynyň dollary", PluralForms{"", "solomon adalarynyň dollary", "", "", "", "solomon adalarynyň dollary"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Seýşel rupiýasy", PluralForms{"", "seýşel rupiýasy", "", "", "", "seýşel rupiýasy"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Sudan funty", PluralForms{"", "sudan funty", "", "", "", "sudan funty"}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Şwed kronasy", PluralForms{"", "şwed kronasy", "", "", "", "şwed kronasy"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Singapur dollary", PluralForms{"", "singapur dollary", "", "", "", "singapur dollary"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Keramatly Ýelena adasynyň funty", PluralForms{"", "keramatly ýelena adasynyň funty", "", "", "", "keramatly ýelena adasynyň funty"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Sýerra-Leone leony", PluralForms{"", "sýerra-leone leony", "", "", "", "sýerra-leone leony"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Sýerra-Leone leony (1964—2022)", PluralForms{"", "sýerra-leone leony (1964—2022)", "", "", "", "sýerra-leone leony (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Somali şillingi", PluralForms{"", "somali şillingi", "", "", "", "somali şillingi"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Surinam dollary", PluralForms{"", "surinam dollary", "", "", "", "surinam dollary"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Günorta Sudan funty", PluralForms{"", "günorta sudan funty", "", "", "", "günorta sudan funty"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "San-Tome we Prinsipi dobrasy (1977–2017)", PluralForms{"", "san-tome we prinsipi dobrasy (1977–2017)", "", "", "", "san-tome we prinsipi dobrasy (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "San-Tome we Prinsipi dobrasy", PluralForms{"", "san-tome we prinsipi dobrasy", "", "", "", "san-tome we prinsipi dobrasy"}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Siriýa funty", PluralForms{"", "siriýa funty", "", "", "", "siriýa funty"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Swazi lilangeni", PluralForms{"", "swazi lilangeni", "", "", "", "swazi lilangeni"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Taýland baty", PluralForms{"", "taýland baty", "", "", "", "taýland baty"}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Täjik somonisi", PluralForms{"", "täjik somonisi", "", "", "", "täjik somonisi"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Türkmen manady", PluralForms{"", "türkmen manady", "", "", "", "türkmen manady"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Tunis dinary", PluralForms{"", "tunis dinary", "", "", "", "tunis dinary"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Tonga paangasy", PluralForms{"", "tonga paangasy", "", "", "", "tonga paangasy"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Türk lirasy", PluralForms{"", "türk lirasy", "", "", "", "türk lirasy"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Trininad we Tobago dollary", PluralForms{"", "trininad we tobago dollary", "", "", "", "trininad we tobago dollary"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Täze Taýwan dollary", PluralForms{"", "täze taýwan dollary", "", "", "", "täze taýwan dollary"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Tanzaniýa şillingi", PluralForms{"", "tanzaniýa şillingi", "", "", "", "tanzaniýa şillingi"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ukrain griwnasy", PluralForms{"", "ukrain griwnasy", "", "", "", "ukrain griwnasy"}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Uganda şillingi", PluralForms{"", "uganda şillingi", "", "", "", "uganda şillingi"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "ABŞ dollary", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Urugwaý pesosy", PluralForms{"", "urugwaý pesosy", "", "", "", "urugwaý pesosy"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Özbek somy", PluralForms{"", "özbek somy", "", "", "", "özbek somy"}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Wenesuela boliwary (2008–2018)", PluralForms{"", "wenesuela boliwary (2008–2018)", "", "", "", "wenesuela boliwary (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Wenesuela boliwary", PluralForms{"", "wenesuela boliwary", "", "", "", "wenesuela boliwary"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Wýetnam dongy", PluralForms{"", "wýetnam dongy", "", "", "", "wýetnam dongy"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Wanuatu watusy", PluralForms{"", "wanuatu watusy", "", "", "", "wanuatu watusy"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Samoa talasy", PluralForms{"", "samoa talasy", "", "", "", "samoa talasy"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "KFA BEAC franky", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Gündogar karib dollary", PluralForms{"", "gündogar karib dollary", "", "", "", "gündogar karib dollary"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Kg.", "Kg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "KFA BCEAO franky", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Fransuz ýuwaş umman franky", PluralForms{"", "fransuz ýuwaş umman franky", "", "", "", "fransuz ýuwaş umman franky"}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "Näbelli pul birligi", PluralForms{"", "näbelli pul birligi", "", "", "", "näbelli pul birligi"}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Ýemen rialy", PluralForms{"", "ýemen rialy", "", "", "", "ýemen rialy"}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Günorta Afrika rendi", PluralForms{"", "günorta afrika rendi", "", "", "", "günorta afrika rendi"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Zambiýa kwaçasy", PluralForms{"", "zambiýa kwaçasy", "", "", "", "zambiýa kwaçasy"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,290 +6,343 @@ var uk = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", "\u00a0",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"Е", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"one": "0\u00a0тис.", "other": "0\u00a0тис."}},
+				{4, 2, map[string]string{"one": "0\u00a0тис.", "other": "0\u00a0тис."}},
+				{5, 3, map[string]string{"one": "0\u00a0тис.", "other": "0\u00a0тис."}},
+				{6, 1, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{7, 2, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{8, 3, map[string]string{"one": "0\u00a0млн", "other": "0\u00a0млн"}},
+				{9, 1, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{10, 2, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{11, 3, map[string]string{"one": "0\u00a0млрд", "other": "0\u00a0млрд"}},
+				{12, 1, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+				{13, 2, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+				{14, 3, map[string]string{"one": "0\u00a0трлн", "other": "0\u00a0трлн"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0 тисячі", "many": "0 тисяч", "one": "0 тисяча", "other": "0 тисячі"}},
+				{4, 2, map[string]string{"few": "0 тисячі", "many": "0 тисяч", "one": "0 тисяча", "other": "0 тисячі"}},
+				{5, 3, map[string]string{"few": "0 тисячі", "many": "0 тисяч", "one": "0 тисяча", "other": "0 тисячі"}},
+				{6, 1, map[string]string{"few": "0 мільйони", "many": "0 мільйонів", "one": "0 мільйон", "other": "0 мільйона"}},
+				{7, 2, map[string]string{"few": "0 мільйони", "many": "0 мільйонів", "one": "0 мільйон", "other": "0 мільйона"}},
+				{8, 3, map[string]string{"few": "0 мільйони", "many": "0 мільйонів", "one": "0 мільйон", "other": "0 мільйона"}},
+				{9, 1, map[string]string{"few": "0 мільярди", "many": "0 мільярдів", "one": "0 мільярд", "other": "0 мільярда"}},
+				{10, 2, map[string]string{"few": "0 мільярди", "many": "0 мільярдів", "one": "0 мільярд", "other": "0 мільярда"}},
+				{11, 3, map[string]string{"few": "0 мільярди", "many": "0 мільярдів", "one": "0 мільярд", "other": "0 мільярда"}},
+				{12, 1, map[string]string{"few": "0 трильйони", "many": "0 трильйонів", "one": "0 трильйон", "other": "0 трильйона"}},
+				{13, 2, map[string]string{"few": "0 трильйони", "many": "0 трильйонів", "one": "0 трильйон", "other": "0 трильйона"}},
+				{14, 3, map[string]string{"few": "0 трильйони", "many": "0 трильйонів", "one": "0 трильйон", "other": "0 трильйона"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"few": "0\u00a0тис.\u00a0¤", "many": "0\u00a0тис.\u00a0¤", "one": "0\u00a0тис.\u00a0¤", "other": "0\u00a0тис.\u00a0¤"}},
+				{4, 2, map[string]string{"few": "0\u00a0тис.\u00a0¤", "many": "0\u00a0тис.\u00a0¤", "one": "0\u00a0тис.\u00a0¤", "other": "0\u00a0тис.\u00a0¤"}},
+				{5, 3, map[string]string{"few": "0\u00a0тис.\u00a0¤", "many": "0\u00a0тис.\u00a0¤", "one": "0\u00a0тис.\u00a0¤", "other": "0\u00a0тис.\u00a0¤"}},
+				{6, 1, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{7, 2, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{8, 3, map[string]string{"few": "0\u00a0млн\u00a0¤", "many": "0\u00a0млн\u00a0¤", "one": "0\u00a0млн\u00a0¤", "other": "0\u00a0млн\u00a0¤"}},
+				{9, 1, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{10, 2, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{11, 3, map[string]string{"few": "0\u00a0млрд\u00a0¤", "many": "0\u00a0млрд\u00a0¤", "one": "0\u00a0млрд\u00a0¤", "other": "0\u00a0млрд\u00a0¤"}},
+				{12, 1, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+				{13, 2, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+				{14, 3, map[string]string{"few": "0\u00a0трлн\u00a0¤", "many": "0\u00a0трлн\u00a0¤", "one": "0\u00a0трлн\u00a0¤", "other": "0\u00a0трлн\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AUD", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "BRL", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CAD", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CNY", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "EUR", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "GBP", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HKD", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "ILS", "₪"},
-		"INR": {2, "INR", "INR", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "KRW", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MXN", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZD", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "р."},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "TWD", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "₴", "₴"},
-		"UAK": {2, "UAK", "крб.", "крб."},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "USD", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "VND", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "XCD", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "андоррська песета", PluralForms{"", "андоррська песета", "", "андоррські песети", "андоррських песет", "андоррських песет"}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "дирхам ОАЕ", PluralForms{"", "дирхам ОАЕ", "", "дирхами ОАЕ", "дирхамів ОАЕ", "дирхама ОАЕ"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "афгані (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "афганський афгані", PluralForms{"", "афганський афгані", "", "афганські афгані", "афганських афгані", "афганського афгані"}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "албанський лек", PluralForms{"", "албанський лек", "", "албанські леки", "албанських леків", "албанського лека"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "вірменський драм", PluralForms{"", "вірменський драм", "", "вірменські драми", "вірменських драмів", "вірменського драма"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "нідерландський антильський гульден", PluralForms{"", "нідерландський антильський гульден", "", "нідерландські антильські гульдени", "нідерландських антильських гульденів", "нідерландського антильського гульдена"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "ангольська кванза", PluralForms{"", "ангольська кванза", "", "ангольські кванзи", "ангольських кванз", "ангольської кванзи"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "ангольська кванза (1977–1990)", PluralForms{"", "ангольська кванза (1977–1990)", "", "ангольські кванзи (1977–1990)", "ангольських кванз (1977–1990)", "ангольської кванзи (1977–1990)"}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "ангольська нова кванза (1990–2000)", PluralForms{"", "ангольська нова кванза (1990–2000)", "", "ангольські нові кванзи (1990–2000)", "ангольських нових кванз (1990–2000)", "ангольської нової кванзи (1990–2000)"}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "ангольська кванза реаджастадо (1995–1999)", PluralForms{"", "ангольська кванза реаджастадо (1995–1999)", "", "ангольські кванзи реаджастадо (1995–1999)", "ангольських кванз реаджастадо (1995–1999)", "ангольської кванзи реаджастадо (1995–1999)"}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "аргентинський австрал", PluralForms{"", "аргентинський австрал", "", "аргентинські австрали", "аргентинських австралів", "аргентинського австрала"}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "аргентинський песо (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "аргентинський песо", PluralForms{"", "аргентинський песо", "", "аргентинські песо", "аргентинських песо", "аргентинського песо"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "австрійський шилінг", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AUD", "$", "австралійський долар", PluralForms{"", "австралійський долар", "", "австралійські долари", "австралійських доларів", "австралійського долара"}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "арубський флорин", PluralForms{"", "арубський флорин", "", "арубські флорини", "арубських флоринів", "арубського флорина"}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "азербайджанський манат (1993–2006)", PluralForms{"", "азербайджанський манат (1993–2006)", "", "азербайджанські манати (1993–2006)", "азербайджанських манатів (1993–2006)", "азербайджанського маната (1993–2006)"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "азербайджанський манат", PluralForms{"", "азербайджанський манат", "", "азербайджанські манати", "азербайджанських манатів", "азербайджанського маната"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "динар (Боснія і Герцеговина)", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "конвертована марка Боснії і Герцеговини", PluralForms{"", "конвертована марка Боснії і Герцеговини", "", "конвертовані марки Боснії і Герцеговини", "конвертованих марок Боснії і Герцеговини", "конвертованої марки Боснії і Герцеговини"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "барбадоський долар", PluralForms{"", "барбадоський долар", "", "барбадоські долари", "барбадоських доларів", "барбадоського долара"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "бангладеська така", PluralForms{"", "бангладеська така", "", "бангладеські таки", "бангладеських так", "бангладеської таки"}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "бельгійський франк (конвертований)", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "бельгійський франк", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "бельгійський франк (фінансовий)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "болгарський твердий лев", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "болгарський лев", PluralForms{"", "болгарський лев", "", "болгарські леви", "болгарських левів", "болгарського лева"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "бахрейнський динар", PluralForms{"", "бахрейнський динар", "", "бахрейнські динари", "бахрейнських динарів", "бахрейнського динара"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "бурундійський франк", PluralForms{"", "бурундійський франк", "", "бурундійські франки", "бурундійських франків", "бурундійського франка"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "бермудський долар", PluralForms{"", "бермудський долар", "", "бермудські долари", "бермудських доларів", "бермудського долара"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "брунейський долар", PluralForms{"", "брунейський долар", "", "брунейські долари", "брунейських доларів", "брунейського долара"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "болівійський болівіано", PluralForms{"", "болівійський болівіано", "", "болівійські болівіано", "болівійських болівіано", "болівійського болівіано"}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "болівійське песо", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "болівійський мвдол", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "бразильське нове крузейро (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "бразильське крузадо", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "бразильське крузейро (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "BRL", "R$", "бразильський реал", PluralForms{"", "бразильський реал", "", "бразильські реали", "бразильських реалів", "бразильського реала"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "бразильське нове крузадо", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "бразильське крузейро", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "багамський долар", PluralForms{"", "багамський долар", "", "багамські долари", "багамських доларів", "багамського долара"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "бутанський нгултрум", PluralForms{"", "бутанський нгултрум", "", "бутанські нгултруми", "бутанських нгултрумів", "бутанського нгултрума"}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "бірманський кіат", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "ботсванська пула", PluralForms{"", "ботсванська пула", "", "ботсванські пули", "ботсванських пул", "ботсванської пули"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "білоруський новий рубль (1994–1999)", PluralForms{"", "білоруський новий рубль (1994–1999)", "", "білоруські нові рублі (1994–1999)", "білоруських нових рублів (1994–1999)", "білоруського нового рубля (1994–1999)"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "білоруський рубль", PluralForms{"", "білоруський рубль", "", "білоруські рублі", "білоруських рублів", "білоруського рубля"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "білоруський рубль (2000–2016)", PluralForms{"", "білоруський рубль (2000–2016)", "", "білоруські рублі (2000–2016)", "білоруських рублів (2000–2016)", "білоруського рубля (2000–2016)"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "белізький долар", PluralForms{"", "белізький долар", "", "белізькі долари", "белізьких доларів", "белізького долара"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CAD", "$", "канадський долар", PluralForms{"", "канадський долар", "", "канадські долари", "канадських доларів", "канадського долара"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "конголезький франк", PluralForms{"", "конголезький франк", "", "конголезькі франки", "конголезьких франків", "конголезького франка"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "євро WIR", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "швейцарський франк", PluralForms{"", "швейцарський франк", "", "швейцарські франки", "швейцарських франків", "швейцарського франка"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "франк WIR", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "чилійський юнідадес де фоменто", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "чилійський песо", PluralForms{"", "чилійський песо", "", "чилійські песо", "чилійських песо", "чилійського песо"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "китайський офшорний юань", PluralForms{"", "китайський офшорний юань", "", "китайські офшорні юані", "китайських офшорних юанів", "китайського офшорного юаня"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CNY", "¥", "китайський юань", PluralForms{"", "китайський юань", "", "китайські юані", "китайських юанів", "китайського юаня"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "колумбійський песо", PluralForms{"", "колумбійський песо", "", "колумбійські песо", "колумбійських песо", "колумбійського песо"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "одиниця реальної вартості", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "костариканський колон", PluralForms{"", "костариканський колон", "", "костариканські колони", "костариканських колонів", "костариканського колона"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "старий сербський динар", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "чехословацька тверда крона", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "кубинський конвертований песо", PluralForms{"", "кубинський конвертований песо", "", "кубинські конвертовані песо", "кубинських конвертованих песо", "кубинського конвертованого песо"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "кубинський песо", PluralForms{"", "кубинський песо", "", "кубинські песо", "кубинських песо", "кубинського песо"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "ескудо Кабо-Верде", PluralForms{"", "", "", "", "", ""}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "кіпрський фунт", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "чеська крона", PluralForms{"", "чеська крона", "", "чеські крони", "чеських крон", "чеської крони"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "марка НДР", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "німецька марка", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "джибутійський франк", PluralForms{"", "джибутійський франк", "", "джибутійські франки", "джибутійських франків", "джибутійського франка"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "данська крона", PluralForms{"", "данська крона", "", "данські крони", "данських крон", "данської крони"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "домініканський песо", PluralForms{"", "домініканський песо", "", "домініканські песо", "домініканських песо", "домініканського песо"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "алжирський динар", PluralForms{"", "алжирський динар", "", "алжирські динари", "алжирських динарів", "алжирського динара"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "еквадорський сукре", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "еквадорський юнідад де валор константе", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "естонська крона", PluralForms{"", "естонська крона", "", "естонські крони", "естонських крон", "естонської крони"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "єгипетський фунт", PluralForms{"", "єгипетський фунт", "", "єгипетські фунти", "єгипетських фунтів", "єгипетського фунта"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "еритрейська накфа", PluralForms{"", "еритрейська накфа", "", "еритрейські накфи", "еритрейських накф", "еритрейської накфи"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "іспанська песета (\"А\" рахунок)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "іспанська песета (конвертовані рахунки)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "іспанська песета", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "ефіопський бир", PluralForms{"", "ефіопський бир", "", "ефіопські бири", "ефіопських бирів", "ефіопського бира"}},
+		"EUR": {2, 0, 2, 1, "EUR", "EUR", "€", "євро", PluralForms{"", "", "", "", "", ""}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "фінляндська марка", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "фіджійський долар", PluralForms{"", "фіджійський долар", "", "фіджійські долари", "фіджійських доларів", "фіджійського долара"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "фунт Фолклендських островів", PluralForms{"", "фунт Фолклендських островів", "", "фунти Фолклендських островів", "фунтів Фолклендських островів", "фунта Фолклендських островів"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "французький франк", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "GBP", "£", "англійський фунт", PluralForms{"", "англійський фунт", "", "англійські фунти", "англійських фунтів", "англійського фунта"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "грузинський купон", PluralForms{"", "грузинський купон", "", "грузинські купони", "грузинських купонів", "грузинського купона"}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "грузинський ларі", PluralForms{"", "грузинський ларі", "", "грузинські ларі", "грузинських ларі", "грузинського ларі"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ганський седі (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "ганський седі", PluralForms{"", "ганський седі", "", "ганські седі", "ганських седі", "ганського седі"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "гібралтарський фунт", PluralForms{"", "гібралтарський фунт", "", "гібралтарські фунти", "гібралтарських фунтів", "гібралтарського фунта"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "гамбійський даласі", PluralForms{"", "гамбійський даласі", "", "гамбійські даласі", "гамбійських даласі", "гамбійського даласі"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "гвінейський франк", PluralForms{"", "гвінейський франк", "", "гвінейські франки", "гвінейських франків", "гвінейського франка"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "гвінейське сілі", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "еквеле (Екваторіальна Ґвінея)", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "грецька драхма", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "гватемальський кетсаль", PluralForms{"", "гватемальський кетсаль", "", "гватемальські кетсалі", "гватемальських кетсалів", "гватемальського кетсаля"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "ескудо португальської гвінеї", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "песо Гвінеї-Бісау", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "гаянський долар", PluralForms{"", "гаянський долар", "", "гаянські долари", "гаянських доларів", "гаянського долара"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HKD", "$", "гонконгський долар", PluralForms{"", "гонконгський долар", "", "гонконгські долари", "гонконгських доларів", "гонконгського долара"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "гондураська лемпіра", PluralForms{"", "гондураська лемпіра", "", "гондураські лемпіри", "гондураських лемпір", "гондураської лемпіри"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "хорватський динар", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "хорватська куна", PluralForms{"", "хорватська куна", "", "хорватські куни", "хорватських кун", "хорватської куни"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "гаїтянський гурд", PluralForms{"", "гаїтянський гурд", "", "гаїтянські гурди", "гаїтянських гурдів", "гаїтянського гурда"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "угорський форинт", PluralForms{"", "угорський форинт", "", "угорські форинти", "угорських форинтів", "угорського форинта"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "індонезійська рупія", PluralForms{"", "індонезійська рупія", "", "індонезійські рупії", "індонезійських рупій", "індонезійські рупії"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "ірландський фунт", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "ізраїльський фунт", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "ILS", "₪", "ізраїльський новий шекель", PluralForms{"", "ізраїльський новий шекель", "", "ізраїльські нові шекелі", "ізраїльських нових шекелів", "ізраїльського нового шекеля"}},
+		"INR": {2, 0, 2, 1, "INR", "INR", "₹", "індійська рупія", PluralForms{"", "індійська рупія", "", "індійські рупії", "індійських рупій", "індійської рупії"}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "іракський динар", PluralForms{"", "іракський динар", "", "іракські динари", "іракських динарів", "іракського динара"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "іранський ріал", PluralForms{"", "іранський ріал", "", "іранські ріали", "іранських ріалів", "іранського ріала"}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "ісландська крона", PluralForms{"", "ісландська крона", "", "ісландські крони", "ісландських крон", "ісландської крони"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "італійська ліра", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "ямайський долар", PluralForms{"", "ямайський долар", "", "ямайські долари", "ямайських доларів", "ямайського долара"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "йорданський динар", PluralForms{"", "йорданський динар", "", "йорданські динари", "йорданських динарів", "йорданського динара"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "японська єна", PluralForms{"", "японська єна", "", "японські єни", "японських єн", "японської єни"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "кенійський шилінг", PluralForms{"", "кенійський шилінг", "", "кенійські шилінги", "кенійських шилінгів", "кенійського шилінга"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "киргизький сом", PluralForms{"", "киргизький сом", "", "киргизькі соми", "киргизьких сомів", "киргизького сома"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "камбоджійський рієль", PluralForms{"", "камбоджійський рієль", "", "камбоджійські рієлі", "камбоджійських рієлів", "камбоджійського рієля"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "коморський франк", PluralForms{"", "коморський франк", "", "коморські франки", "коморських франків", "коморського франка"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "північнокорейський вон", PluralForms{"", "північнокорейський вон", "", "північнокорейські вони", "північнокорейських вонів", "північнокорейського вона"}},
+		"KRW": {0, 0, 0, 1, "KRW", "KRW", "₩", "південнокорейський вон", PluralForms{"", "південнокорейський вон", "", "південнокорейські вони", "південнокорейських вонів", "південнокорейського вона"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "кувейтський динар", PluralForms{"", "кувейтський динар", "", "кувейтські динари", "кувейтських динарів", "кувейтського динара"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "долар Кайманових островів", PluralForms{"", "долар Кайманових островів", "", "долари Кайманових островів", "доларів Кайманових островів", "долара Кайманових островів"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "казахстанський тенге", PluralForms{"", "казахстанський тенге", "", "казахстанські тенге", "казахстанських тенге", "казахстанського тенге"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "лаоський кіп", PluralForms{"", "лаоський кіп", "", "лаоські кіпи", "лаоських кіпів", "лаоського кіпа"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "ліванський фунт", PluralForms{"", "ліванський фунт", "", "ліванські фунти", "ліванських фунтів", "ліванського фунта"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "шрі-ланкійська рупія", PluralForms{"", "шрі-ланкійська рупія", "", "шрі-ланкійські рупії", "шрі-ланкійських рупій", "шрі-ланкійської рупії"}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "ліберійський долар", PluralForms{"", "ліберійський долар", "", "ліберійські долари", "ліберійських доларів", "ліберійського долара"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "лесотський лоті", PluralForms{"", "лесотський лоті", "", "лесотські лоті", "лесотських лоті", "лесотського лоті"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "литовський літ", PluralForms{"", "литовський літ", "", "литовські літи", "литовських літів", "литовського літа"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "литовський талон", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "люксембурґський франк (конвертований)", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "люксембурзький франк", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "люксембурґський франк (фінансовий)", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "латвійський лат", PluralForms{"", "латвійський лат", "", "латвійські лати", "латвійських латів", "латвійського лата"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "латвійський рубль", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "лівійський динар", PluralForms{"", "лівійський динар", "", "лівійські динари", "лівійських динарів", "лівійського динара"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "марокканський дирхам", PluralForms{"", "марокканський дирхам", "", "марокканські дирхами", "марокканських дирхамів", "марокканського дирхама"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "марокканський франк", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "молдовський лей", PluralForms{"", "молдовський лей", "", "молдовські леї", "молдовських леїв", "молдовського лея"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "малагасійський аріарі", PluralForms{"", "малагасійський аріарі", "", "малагасійські аріарі", "малагасійських аріарі", "малагасійського аріарі"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "мадагаскарський франк", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "македонський денар", PluralForms{"", "македонський денар", "", "македонські денари", "македонських денарів", "македонського денара"}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "малійський франк", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "кʼят Мʼянми", PluralForms{"", "кʼят Мʼянми", "", "кʼяти Мʼянми", "кʼятів Мʼянми", "кʼята Мʼянми"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "монгольський тугрик", PluralForms{"", "монгольський тугрик", "", "монгольські тугрики", "монгольських тугриків", "монгольського тугрика"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "патака Макао", PluralForms{"", "патака Макао", "", "патаки Макао", "патак Макао", "патаки Макао"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "мавританська угія (1973–2017)", PluralForms{"", "мавританська угія (1973–2017)", "", "мавританські угії (1973–2017)", "мавританських угій (1973–2017)", "мавританської угії (1973–2017)"}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "мавританська угія", PluralForms{"", "мавританська угія", "", "мавританські угії", "мавританських угій", "мавританської угії"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "мальтійська ліра", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "мальтійський фунт", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "маврикійська рупія", PluralForms{"", "маврикійська рупія", "", "маврикійські рупії", "маврикійських рупій", "маврикійської рупії"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "мальдівська руфія", PluralForms{"", "мальдівська руфія", "", "мальдівські руфії", "мальдівських руфій", "мальдівської руфії"}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "малавійська квача", PluralForms{"", "малавійська квача", "", "малавійські квачі", "малавійських квач", "малавійської квачі"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MXN", "$", "мексиканський песо", PluralForms{"", "мексиканський песо", "", "мексиканські песо", "мексиканських песо", "мексиканського песо"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "мексиканське срібне песо (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "мексиканський юнідад де інверсіон", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "малайзійський рингіт", PluralForms{"", "малайзійський рингіт", "", "малайзійські рингіти", "малайзійських рингітів", "малайзійського рингіта"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "мозамбіцький ескудо", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "старий мозамбіцький метикал", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "мозамбіцький метикал", PluralForms{"", "мозамбіцький метикал", "", "мозамбіцькі метикали", "мозамбіцьких метикалів", "мозамбіцького метикала"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "намібійський долар", PluralForms{"", "намібійський долар", "", "намібійські долари", "намібійських доларів", "намібійського долара"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "нігерійська найра", PluralForms{"", "нігерійська найра", "", "нігерійські найри", "нігерійських найр", "нігерійської найри"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "нікарагуанська кордоба (1988–1991)", PluralForms{"", "нікарагуанська кордоба (1988–1991)", "", "нікарагуанські кордоби (1988–1991)", "нікарагуанських кордоб (1988–1991)", "нікарагуанської кордоби (1988–1991)"}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "нікарагуанська кордоба", PluralForms{"", "нікарагуанська кордоба", "", "нікарагуанські кордоби", "нікарагуанських кордоб", "нікарагуанської кордоби"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "нідерландський гульден", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "норвезька крона", PluralForms{"", "норвезька крона", "", "норвезькі крони", "норвезьких крон", "норвезької крони"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "непальська рупія", PluralForms{"", "непальська рупія", "", "непальські рупії", "непальських рупій", "непальської рупії"}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZD", "$", "новозеландський долар", PluralForms{"", "новозеландський долар", "", "новозеландські долари", "новозеландських доларів", "новозеландського долара"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "оманський ріал", PluralForms{"", "оманський ріал", "", "оманські ріали", "оманських ріалів", "оманського ріала"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "панамське бальбоа", PluralForms{"", "панамське бальбоа", "", "панамські бальбоа", "панамських бальбоа", "панамського бальбоа"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "перуанський інті", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "перуанський новий сол", PluralForms{"", "перуанський новий сол", "", "перуанські нові соли", "перуанських нових солів", "перуанського нового сола"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "перуанський сол (1863–1965)", PluralForms{"", "перуанський сол (1863–1965)", "", "перуанські соли (1863–1965)", "перуанських солів (1863–1965)", "перуанського сола (1863–1965)"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "кіна Папуа-Нової Ґвінеї", PluralForms{"", "кіна Папуа-Нової Ґвінеї", "", "кіни Папуа-Нової Ґвінеї", "кін Папуа-Нової Ґвінеї", "кіни Папуа-Нової Ґвінеї"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "філіппінський песо", PluralForms{"", "філіппінський песо", "", "філіппінські песо", "філіппінських песо", "філіппінського песо"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "пакистанська рупія", PluralForms{"", "пакистанська рупія", "", "пакистанські рупії", "пакистанських рупій", "пакистанської рупії"}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "польський злотий", PluralForms{"", "польський злотий", "", "польські злоті", "польських злотих", "польського злотого"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "польський злотий (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "португальський ескудо", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "парагвайський гуарані", PluralForms{"", "парагвайський гуарані", "", "парагвайські гуарані", "парагвайських гуарані", "парагвайського гуарані"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "катарський ріал", PluralForms{"", "катарський ріал", "", "катарські ріали", "катарських ріалів", "катарського ріала"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "родезійський долар", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "старий румунський лей", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "румунський лей", PluralForms{"", "румунський лей", "", "румунські леї", "румунських леїв", "румунського лея"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "сербський динар", PluralForms{"", "сербський динар", "", "сербські динари", "сербських динарів", "сербського динара"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "російський рубль", PluralForms{"", "російський рубль", "", "російські рублі", "російських рублів", "російського рубля"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "р.", "російський рубль (1991–1998)", PluralForms{"", "російський рубль (RUR)", "", "російські рублі (RUR)", "російських рублів (RUR)", "російського рубля (RUR)"}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "руандійський франк", PluralForms{"", "руандійський франк", "", "руандійські франки", "руандійських франків", "руандійського франка"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "саудівський ріал", PluralForms{"", "саудівський ріал", "", "саудівські ріали", "саудівських ріалів", "саудівського ріала"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "долар Соломонових Островів", PluralForms{"", "долар Соломонових Островів", "", "долари Соломонових Островів", "доларів Соломонових Островів", "долара Соломонових Островів"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "сейшельська рупія", PluralForms{"", "сейшельська рупія", "", "сейшельські рупії", "сейшельських рупій", "сейшельської рупії"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "суданський динар", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "суданський фунт", PluralForms{"", "суданський фунт", "", "суданські фунти", "суданських фунтів", "суданського фунта"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "старий суданський фунт", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "шведська крона", PluralForms{"", "шведська крона", "", "шведські крони", "шведських крон", "шведської крони"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "сінгапурський долар", PluralForms{"", "сінгапурський долар", "", "сінгапурські долари", "сінгапурських доларів", "сінгапурського долара"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "фунт острова Святої Єлени", PluralForms{"", "фунт острова Святої Єлени", "", "фунти острова Святої Єлени", "фунтів острова Святої Єлени", "фунта острова Святої Єлени"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "словенський толар", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "словацька крона", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "леоне Сьєрра-Леоне", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "леоне Сьєрра-Леоне (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "сомалійський шилінг", PluralForms{"", "сомалійський шилінг", "", "сомалійські шилінги", "сомалійських шилінгів", "сомалійського шилінга"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "суринамський долар", PluralForms{"", "суринамський долар", "", "суринамські долари", "суринамських доларів", "суринамського долара"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "суринамський гульден", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "південносуданський фунт", PluralForms{"", "південносуданський фунт", "", "південносуданські фунти", "південносуданських фунтів", "південносуданського фунта"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "добра Сан-Томе і Прінсіпі (1977–2017)", PluralForms{"", "добра Сан-Томе і Прінсіпі (1977–2017)", "", "добри Сан-Томе і Принсіпі (1977–2017)", "добр Сан-Томе і Принсіпі (1977–2017)", "добри Сан-Томе і Прінсіпі (1977–2017)"}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "добра Сан-Томе і Прінсіпі", PluralForms{"", "добра Сан-Томе і Прінсіпі", "", "добри Сан-Томе і Принсіпі", "добр Сан-Томе і Принсіпі", "добри Сан-Томе і Прінсіпі"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "радянський рубль", PluralForms{"", "радянський рубль", "", "радянські рублі", "радянських рублів", "радянського рубля"}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "сальвадорський колон", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "сирійський фунт", PluralForms{"", "сирійський фунт", "", "сирійські фунти", "сирійських фунтів", "сирійського фунта"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "свазілендський лілангені", PluralForms{"", "свазілендський лілангені", "", "свазілендські лілангені", "свазілендських лілангені", "свазілендського лілангені"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "таїландський бат", PluralForms{"", "таїландський бат", "", "таїландські бати", "таїландських батів", "таїландського бата"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "таджицький рубль", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "таджицький сомоні", PluralForms{"", "таджицький сомоні", "", "таджицькі сомоні", "таджицьких сомоні", "таджицького сомоні"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "туркменський манат (1993–2009)", PluralForms{"", "туркменський манат (1993–2009)", "", "туркменські манати (1993–2009)", "туркменських манатів (1993–2009)", "туркменського маната (1993–2009)"}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "туркменський манат", PluralForms{"", "туркменський манат", "", "туркменські манати", "туркменських манатів", "туркменського маната"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "туніський динар", PluralForms{"", "туніський динар", "", "туніські динари", "туніських динарів", "туніського динара"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "тонганська паанга", PluralForms{"", "тонганська паанга", "", "тонганські паанги", "тонганських паанг", "тонганської паанги"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "тіморський ескудо", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "турецька ліра (1922–2005)", PluralForms{"", "турецька ліра (1922–2005)", "", "турецькі ліри (1922–2005)", "турецьких лір (1922–2005)", "турецької ліри (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "турецька ліра", PluralForms{"", "турецька ліра", "", "турецькі ліри", "турецьких лір", "турецької ліри"}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "долар Тринідаду і Тобаго", PluralForms{"", "долар Тринідаду і Тобаго", "", "долари Тринідаду і Тобаго", "доларів Тринідаду і Тобаго", "долара Тринідаду і Тобаго"}},
+		"TWD": {2, 0, 0, 1, "TWD", "TWD", "$", "новий тайванський долар", PluralForms{"", "новий тайванський долар", "", "нові тайванські долари", "нових тайванських доларів", "нового тайванського долара"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "танзанійський шилінг", PluralForms{"", "танзанійський шилінг", "", "танзанійські шилінги", "танзанійських шилінгів", "танзанійського шилінга"}},
+		"UAH": {2, 0, 2, 1, "UAH", "₴", "₴", "українська гривня", PluralForms{"", "українська гривня", "", "українські гривні", "українських гривень", "української гривні"}},
+		"UAK": {2, 0, 2, 1, "UAK", "крб.", "крб.", "український карбованець", PluralForms{"", "український карбованець", "", "українські карбованці", "українських карбованців", "українського карбованця"}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "угандійський шилінг (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "угандійський шилінг", PluralForms{"", "угандійський шилінг", "", "угандійські шилінги", "угандійських шилінгів", "угандійського шилінга"}},
+		"USD": {2, 0, 2, 1, "USD", "USD", "$", "долар США", PluralForms{"", "долар США", "", "долари США", "доларів США", "долара США"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "долар США (наступного дня)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "долар США (цього дня)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "уругвайський песо в індексованих одиницях", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "уругвайське песо (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "уругвайський песо", PluralForms{"", "уругвайський песо", "", "уругвайські песо", "уругвайських песо", "уругвайського песо"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "узбецький сум", PluralForms{"", "узбецький сум", "", "узбецькі суми", "узбецьких сумів", "узбецького сума"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "венесуельський болівар (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "венесуельський болівар (2008–2018)", PluralForms{"", "венесуельський болівар (2008–2018)", "", "венесуельські болівари (2008–2018)", "венесуельських боліварів (2008–2018)", "венесуельського болівара (2008–2018)"}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "венесуельський болівар", PluralForms{"", "венесуельський болівар", "", "венесуельські болівари", "венесуельських боліварів", "венесуельського болівара"}},
+		"VND": {0, 0, 0, 1, "VND", "VND", "₫", "вʼєтнамський донг", PluralForms{"", "вʼєтнамський донг", "", "вʼєтнамські донги", "вʼєтнамських донгів", "вʼєтнамського донга"}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "вануатський вату", PluralForms{"", "вануатський вату", "", "вануатські вату", "вануатських вату", "вануатського вату"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "самоанська тала", PluralForms{"", "самоанська тала", "", "самоанські тали", "самоанських тал", "самоанської тали"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "центральноафриканський франк", PluralForms{"", "центральноафриканський франк", "", "центральноафриканські франки", "центральноафриканських франків", "центральноафриканського франка"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "срібло", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "золото", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "європейська складена валютна одиниця", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "одиниця європейського валютного фонду", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "європейська розрахункова одиниця XBC", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "європейська розрахункова одиниця XBD", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "XCD", "$", "східнокарибський долар", PluralForms{"", "східнокарибський долар", "", "східнокарибські долари", "східнокарибських доларів", "східнокарибського долара"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "спеціальні права запозичення", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "європейська валютна одиниця", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "французький золотий франк", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "французький франк UIC", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "західноафриканський франк", PluralForms{"", "західноафриканський франк", "", "західноафриканські франки", "західноафриканських франків", "західноафриканського франка"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "паладій", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "французький тихоокеанський франк", PluralForms{"", "французький тихоокеанський франк", "", "французькі тихоокеанські франки", "французьких тихоокеанських франків", "французького тихоокеанського франка"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "платина", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "фонди RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "код тестування валюти", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "невідома грошова одиниця", PluralForms{"", "невідома грошова одиниця", "", "невідомі грошові одиниці", "невідомих грошових одиниць", "невідомої грошової одиниці"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "єменський динар", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "єменський ріал", PluralForms{"", "єменський ріал", "", "єменські ріали", "єменських ріалів", "єменського ріала"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "югославський твердий динар", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "югославський новий динар", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "югославський конвертований динар", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "південноафриканський фінансовий ранд", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "південноафриканський ранд", PluralForms{"", "південноафриканський ранд", "", "південноафриканські ранди", "південноафриканських рандів", "південноафриканського ранда"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "замбійська квача (1968–2012)", PluralForms{"", "замбійська квача (1968–2012)", "", "замбійські квачі (1968–2012)", "замбійських квач (1968–2012)", "замбійські квачі (1968–2012)"}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "замбійська квача", PluralForms{"", "замбійська квача", "", "замбійські квачі", "замбійських квач", "замбійської квачі"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "заїрський новий заїр", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "заїрський заїр", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "зімбабвійський долар", PluralForms{"", "зімбабвійського долара", "", "зімбабвійскі долари", "зімбабвійських доларів", "зімбабвійські долари"}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "зімбабвійський долар (2009)", PluralForms{"", "зімбабвійський долар (2009)", "", "зімбабвійські долари (2009)", "зімбабвійських доларів (2009)", "зімбабвійського долара (2009)"}},
 	},
 }
@@ -6,307 +6,360 @@ var vi = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		",", ".",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, "", "", "-", ""}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "~",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "", "\u00a0¤", "-", "\u00a0¤"}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0\u00a0N"}},
+				{4, 2, map[string]string{"other": "0\u00a0N"}},
+				{5, 3, map[string]string{"other": "0\u00a0N"}},
+				{6, 1, map[string]string{"other": "0\u00a0Tr"}},
+				{7, 2, map[string]string{"other": "0\u00a0Tr"}},
+				{8, 3, map[string]string{"other": "0\u00a0Tr"}},
+				{9, 1, map[string]string{"other": "0\u00a0T"}},
+				{10, 2, map[string]string{"other": "0\u00a0T"}},
+				{11, 3, map[string]string{"other": "0\u00a0T"}},
+				{12, 1, map[string]string{"other": "0\u00a0NT"}},
+				{13, 2, map[string]string{"other": "0\u00a0NT"}},
+				{14, 3, map[string]string{"other": "0\u00a0NT"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0 nghìn"}},
+				{4, 2, map[string]string{"other": "0 nghìn"}},
+				{5, 3, map[string]string{"other": "0 nghìn"}},
+				{6, 1, map[string]string{"other": "0 triệu"}},
+				{7, 2, map[string]string{"other": "0 triệu"}},
+				{8, 3, map[string]string{"other": "0 triệu"}},
+				{9, 1, map[string]string{"other": "0 tỷ"}},
+				{10, 2, map[string]string{"other": "0 tỷ"}},
+				{11, 3, map[string]string{"other": "0 tỷ"}},
+				{12, 1, map[string]string{"other": "0 nghìn tỷ"}},
+				{13, 2, map[string]string{"other": "0 nghìn tỷ"}},
+				{14, 3, map[string]string{"other": "0 nghìn tỷ"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0\u00a0N\u00a0¤"}},
+				{4, 2, map[string]string{"other": "0\u00a0N\u00a0¤"}},
+				{5, 3, map[string]string{"other": "0\u00a0N\u00a0¤"}},
+				{6, 1, map[string]string{"other": "0\u00a0Tr\u00a0¤"}},
+				{7, 2, map[string]string{"other": "0\u00a0Tr\u00a0¤"}},
+				{8, 3, map[string]string{"other": "0\u00a0Tr\u00a0¤"}},
+				{9, 1, map[string]string{"other": "0\u00a0T\u00a0¤"}},
+				{10, 2, map[string]string{"other": "0\u00a0T\u00a0¤"}},
+				{11, 3, map[string]string{"other": "0\u00a0T\u00a0¤"}},
+				{12, 1, map[string]string{"other": "0\u00a0NT\u00a0¤"}},
+				{13, 2, map[string]string{"other": "0\u00a0NT\u00a0¤"}},
+				{14, 3, map[string]string{"other": "0\u00a0NT\u00a0¤"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "{0}+", "≤{0}", "{0}-{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"ADP": {0, "ADP", "ADP", "ADP"},
-		"AED": {2, "AED", "AED", "AED"},
-		"AFA": {2, "AFA", "AFA", "AFA"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"AOK": {2, "AOK", "AOK", "AOK"},
-		"AON": {2, "AON", "AON", "AON"},
-		"AOR": {2, "AOR", "AOR", "AOR"},
-		"ARA": {2, "ARA", "ARA", "ARA"},
-		"ARL": {2, "ARL", "ARL", "ARL"},
-		"ARM": {2, "ARM", "ARM", "ARM"},
-		"ARP": {2, "ARP", "ARP", "ARP"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"ATS": {2, "ATS", "ATS", "ATS"},
-		"AUD": {2, "AUD", "AU$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZM": {2, "AZM", "AZM", "AZM"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAD": {2, "BAD", "BAD", "BAD"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BAN": {2, "BAN", "BAN", "BAN"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BEC": {2, "BEC", "BEC", "BEC"},
-		"BEF": {2, "BEF", "BEF", "BEF"},
-		"BEL": {2, "BEL", "BEL", "BEL"},
-		"BGL": {2, "BGL", "BGL", "BGL"},
-		"BGM": {2, "BGM", "BGM", "BGM"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BGO": {2, "BGO", "BGO", "BGO"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BOL": {2, "BOL", "BOL", "BOL"},
-		"BOP": {2, "BOP", "BOP", "BOP"},
-		"BOV": {2, "BOV", "BOV", "BOV"},
-		"BRB": {2, "BRB", "BRB", "BRB"},
-		"BRC": {2, "BRC", "BRC", "BRC"},
-		"BRE": {2, "BRE", "BRE", "BRE"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BRN": {2, "BRN", "BRN", "BRN"},
-		"BRR": {2, "BRR", "BRR", "BRR"},
-		"BRZ": {2, "BRZ", "BRZ", "BRZ"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BUK": {2, "BUK", "BUK", "BUK"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYB": {2, "BYB", "BYB", "BYB"},
-		"BYN": {2, "BYN", "BYN", "р."},
-		"BYR": {0, "BYR", "BYR", "BYR"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHE": {2, "CHE", "CHE", "CHE"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CHW": {2, "CHW", "CHW", "CHW"},
-		"CLE": {2, "CLE", "CLE", "CLE"},
-		"CLF": {4, "CLF", "CLF", "CLF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"COU": {2, "COU", "COU", "COU"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CSD": {2, "CSD", "CSD", "CSD"},
-		"CSK": {2, "CSK", "CSK", "CSK"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CYP": {2, "CYP", "CYP", "CYP"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DDM": {2, "DDM", "DDM", "DDM"},
-		"DEM": {2, "DEM", "DEM", "DEM"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"ECS": {2, "ECS", "ECS", "ECS"},
-		"ECV": {2, "ECV", "ECV", "ECV"},
-		"EEK": {2, "EEK", "EEK", "EEK"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESA": {2, "ESA", "ESA", "ESA"},
-		"ESB": {2, "ESB", "ESB", "ESB"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FIM": {2, "FIM", "FIM", "FIM"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"FRF": {2, "FRF", "FRF", "FRF"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEK": {2, "GEK", "GEK", "GEK"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GQE": {2, "GQE", "GQE", "GQE"},
-		"GRD": {2, "GRD", "GRD", "GRD"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GWE": {2, "GWE", "GWE", "GWE"},
-		"GWP": {2, "GWP", "GWP", "GWP"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRD": {2, "HRD", "HRD", "HRD"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"IEP": {2, "IEP", "IEP", "IEP"},
-		"ILP": {2, "ILP", "ILP", "ILP"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"ITL": {0, "ITL", "ITL", "ITL"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRH": {2, "KRH", "KRH", "KRH"},
-		"KRO": {2, "KRO", "KRO", "KRO"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LTT": {2, "LTT", "LTT", "LTT"},
-		"LUC": {2, "LUC", "LUC", "LUC"},
-		"LUF": {0, "LUF", "LUF", "LUF"},
-		"LUL": {2, "LUL", "LUL", "LUL"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LVR": {2, "LVR", "LVR", "LVR"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MAF": {2, "MAF", "MAF", "MAF"},
-		"MCF": {2, "MCF", "MCF", "MCF"},
-		"MDC": {2, "MDC", "MDC", "MDC"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MGF": {0, "MGF", "MGF", "MGF"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MKN": {2, "MKN", "MKN", "MKN"},
-		"MLF": {2, "MLF", "MLF", "MLF"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MTL": {2, "MTL", "MTL", "MTL"},
-		"MTP": {2, "MTP", "MTP", "MTP"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVP": {2, "MVP", "MVP", "MVP"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MXP": {2, "MXP", "MXP", "MXP"},
-		"MXV": {2, "MXV", "MXV", "MXV"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZE": {2, "MZE", "MZE", "MZE"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "NGN", "₦"},
-		"NIC": {2, "NIC", "NIC", "NIC"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NLG": {2, "NLG", "NLG", "NLG"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEI": {2, "PEI", "PEI", "PEI"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PES": {2, "PES", "PES", "PES"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "PHP", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PLZ": {2, "PLZ", "PLZ", "PLZ"},
-		"PTE": {2, "PTE", "PTE", "PTE"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RHD": {2, "RHD", "RHD", "RHD"},
-		"ROL": {2, "ROL", "ROL", "ROL"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "RUB", "₽"},
-		"RUR": {2, "RUR", "RUR", "RUR"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDD": {2, "SDD", "SDD", "SDD"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SIT": {2, "SIT", "SIT", "SIT"},
-		"SKK": {2, "SKK", "SKK", "SKK"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SRG": {2, "SRG", "SRG", "SRG"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SUR": {2, "SUR", "SUR", "SUR"},
-		"SVC": {2, "SVC", "SVC", "SVC"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "฿", "฿"},
-		"TJR": {2, "TJR", "TJR", "TJR"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMM": {0, "TMM", "TMM", "TMM"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TPE": {2, "TPE", "TPE", "TPE"},
-		"TRL": {0, "TRL", "TRL", "TRL"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "NT$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UAK": {2, "UAK", "UAK", "UAK"},
-		"UGS": {2, "UGS", "UGS", "UGS"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "US$", "$"},
-		"USN": {2, "USN", "USN", "USN"},
-		"USS": {2, "USS", "USS", "USS"},
-		"UYI": {0, "UYI", "UYI", "UYI"},
-		"UYP": {2, "UYP", "UYP", "UYP"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEB": {2, "VEB", "VEB", "VEB"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VNN": {2, "VNN", "VNN", "VNN"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XAG": {2, "XAG", "XAG", "XAG"},
-		"XAU": {2, "XAU", "XAU", "XAU"},
-		"XBA": {2, "XBA", "XBA", "XBA"},
-		"XBB": {2, "XBB", "XBB", "XBB"},
-		"XBC": {2, "XBC", "XBC", "XBC"},
-		"XBD": {2, "XBD", "XBD", "XBD"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XDR": {2, "XDR", "XDR", "XDR"},
-		"XEU": {2, "XEU", "XEU", "XEU"},
-		"XFO": {2, "XFO", "XFO", "XFO"},
-		"XFU": {2, "XFU", "XFU", "XFU"},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPD": {2, "XPD", "XPD", "XPD"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XPT": {2, "XPT", "XPT", "XPT"},
-		"XRE": {2, "XRE", "XRE", "XRE"},
-		"XTS": {2, "XTS", "XTS", "XTS"},
-		"XXX": {2, "XXX", "XXX", "XXX"},
-		"YDD": {2, "YDD", "YDD", "YDD"},
-		"YER": {0, "YER", "YER", "YER"},
-		"YUD": {2, "YUD", "YUD", "YUD"},
-		"YUM": {2, "YUM", "YUM", "YUM"},
-		"YUN": {2, "YUN", "YUN", "YUN"},
-		"YUR": {2, "YUR", "YUR", "YUR"},
-		"ZAL": {2, "ZAL", "ZAL", "ZAL"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZRN": {2, "ZRN", "ZRN", "ZRN"},
-		"ZRZ": {2, "ZRZ", "ZRZ", "ZRZ"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
-		"ZWL": {2, "ZWL", "ZWL", "ZWL"},
-		"ZWR": {2, "ZWR", "ZWR", "ZWR"},
+		"ADP": {0, 0, 0, 1, "ADP", "ADP", "ADP", "Đồng Peseta của Andora", PluralForms{"", "", "", "", "", ""}},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Dirham UAE", PluralForms{"", "", "", "", "", "dirham UAE"}},
+		"AFA": {2, 0, 2, 1, "AFA", "AFA", "AFA", "Đồng Afghani của Afghanistan (1927–2002)", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afghani Afghanistan", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lek Albania", PluralForms{"", "", "", "", "", "lek Albania"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dram Armenia", PluralForms{"", "", "", "", "", "dram Armenia"}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Guilder Antille Hà Lan", PluralForms{"", "", "", "", "", "guilder Antille Hà Lan"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "Kwanza Angola", PluralForms{"", "", "", "", "", "kwanza Angola"}},
+		"AOK": {2, 0, 2, 1, "AOK", "AOK", "AOK", "Đồng Kwanza của Angola (1977–1991)", PluralForms{"", "", "", "", "", ""}},
+		"AON": {2, 0, 2, 1, "AON", "AON", "AON", "Đồng Kwanza Mới của Angola (1990–2000)", PluralForms{"", "", "", "", "", ""}},
+		"AOR": {2, 0, 2, 1, "AOR", "AOR", "AOR", "Đồng Kwanza Điều chỉnh lại của Angola (1995–1999)", PluralForms{"", "", "", "", "", ""}},
+		"ARA": {2, 0, 2, 1, "ARA", "ARA", "ARA", "Đồng Austral của Argentina", PluralForms{"", "", "", "", "", ""}},
+		"ARL": {2, 0, 2, 1, "ARL", "ARL", "ARL", "Đồng Peso Ley của Argentina (1970–1983)", PluralForms{"", "", "", "", "", ""}},
+		"ARM": {2, 0, 2, 1, "ARM", "ARM", "ARM", "Đồng Peso Argentina (1881–1970)", PluralForms{"", "", "", "", "", ""}},
+		"ARP": {2, 0, 2, 1, "ARP", "ARP", "ARP", "Đồng Peso Argentina (1983–1985)", PluralForms{"", "", "", "", "", ""}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Peso Argentina", PluralForms{"", "", "", "", "", "peso Argentina"}},
+		"ATS": {2, 0, 2, 1, "ATS", "ATS", "ATS", "Đồng Schiling Áo", PluralForms{"", "", "", "", "", ""}},
+		"AUD": {2, 0, 2, 1, "AUD", "AU$", "$", "Đô la Australia", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Florin Aruba", PluralForms{"", "", "", "", "", ""}},
+		"AZM": {2, 0, 2, 1, "AZM", "AZM", "AZM", "Đồng Manat của Azerbaijan (1993–2006)", PluralForms{"", "", "", "", "", ""}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Manat Azerbaijan", PluralForms{"", "", "", "", "", "manat Azerbaijan"}},
+		"BAD": {2, 0, 2, 1, "BAD", "BAD", "BAD", "Đồng Dinar của Bosnia-Herzegovina (1992–1994)", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Mark Bosnia-Herzegovina có thể chuyển đổi", PluralForms{"", "", "", "", "", "mark Bosnia-Herzegovina có thể chuyển đổi"}},
+		"BAN": {2, 0, 2, 1, "BAN", "BAN", "BAN", "Đồng Dinar Mới của Bosnia-Herzegovina (1994–1997)", PluralForms{"", "", "", "", "", ""}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Đô la Barbados", PluralForms{"", "", "", "", "", "đô la Barbados"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Taka Bangladesh", PluralForms{"", "", "", "", "", ""}},
+		"BEC": {2, 0, 2, 1, "BEC", "BEC", "BEC", "Đồng Franc Bỉ (có thể chuyển đổi)", PluralForms{"", "", "", "", "", ""}},
+		"BEF": {2, 0, 2, 1, "BEF", "BEF", "BEF", "Đồng Franc Bỉ", PluralForms{"", "", "", "", "", ""}},
+		"BEL": {2, 0, 2, 1, "BEL", "BEL", "BEL", "Đồng Franc Bỉ (tài chính)", PluralForms{"", "", "", "", "", ""}},
+		"BGL": {2, 0, 2, 1, "BGL", "BGL", "BGL", "Đồng Lev Xu của Bun-ga-ri", PluralForms{"", "", "", "", "", ""}},
+		"BGM": {2, 0, 2, 1, "BGM", "BGM", "BGM", "Đồng Lev Xã hội chủ nghĩa của Bun-ga-ri", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Lev Bulgaria", PluralForms{"", "", "", "", "", "lev Bulgaria"}},
+		"BGO": {2, 0, 2, 1, "BGO", "BGO", "BGO", "Đồng Lev của Bun-ga-ri (1879–1952)", PluralForms{"", "", "", "", "", ""}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dinar Bahrain", PluralForms{"", "", "", "", "", "dinar Bahrain"}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Franc Burundi", PluralForms{"", "", "", "", "", "franc Burundi"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Đô la Bermuda", PluralForms{"", "", "", "", "", "đô la Bermuda"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Đô la Brunei", PluralForms{"", "", "", "", "", "đô la Brunei"}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Boliviano Bolivia", PluralForms{"", "", "", "", "", "boliviano Bolivia"}},
+		"BOL": {2, 0, 2, 1, "BOL", "BOL", "BOL", "Đồng Boliviano của Bolivia (1863–1963)", PluralForms{"", "", "", "", "", ""}},
+		"BOP": {2, 0, 2, 1, "BOP", "BOP", "BOP", "Đồng Peso Bolivia", PluralForms{"", "", "", "", "", ""}},
+		"BOV": {2, 0, 2, 1, "BOV", "BOV", "BOV", "Đồng Mvdol Bolivia", PluralForms{"", "", "", "", "", ""}},
+		"BRB": {2, 0, 2, 1, "BRB", "BRB", "BRB", "Đồng Cruzerio Mới của Braxin (1967–1986)", PluralForms{"", "", "", "", "", ""}},
+		"BRC": {2, 0, 2, 1, "BRC", "BRC", "BRC", "Đồng Cruzado của Braxin (1986–1989)", PluralForms{"", "", "", "", "", ""}},
+		"BRE": {2, 0, 2, 1, "BRE", "BRE", "BRE", "Đồng Cruzerio của Braxin (1990–1993)", PluralForms{"", "", "", "", "", ""}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Real Braxin", PluralForms{"", "", "", "", "", "real Braxin"}},
+		"BRN": {2, 0, 2, 1, "BRN", "BRN", "BRN", "Đồng Cruzado Mới của Braxin (1989–1990)", PluralForms{"", "", "", "", "", ""}},
+		"BRR": {2, 0, 2, 1, "BRR", "BRR", "BRR", "Đồng Cruzeiro của Braxin (1993–1994)", PluralForms{"", "", "", "", "", ""}},
+		"BRZ": {2, 0, 2, 1, "BRZ", "BRZ", "BRZ", "Đồng Cruzeiro của Braxin (1942–1967)", PluralForms{"", "", "", "", "", ""}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Đô la Bahamas", PluralForms{"", "", "", "", "", "đô la Bahamas"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ngultrum Bhutan", PluralForms{"", "", "", "", "", ""}},
+		"BUK": {2, 0, 2, 1, "BUK", "BUK", "BUK", "Đồng Kyat Miến Điện", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula Botswana", PluralForms{"", "", "", "", "", "pula Botswana"}},
+		"BYB": {2, 0, 2, 1, "BYB", "BYB", "BYB", "Đồng Rúp Mới của Belarus (1994–1999)", PluralForms{"", "", "", "", "", ""}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "р.", "Rúp Belarus", PluralForms{"", "", "", "", "", "rúp Belarus"}},
+		"BYR": {0, 0, 0, 1, "BYR", "BYR", "BYR", "Rúp Belarus (2000–2016)", PluralForms{"", "", "", "", "", ""}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Đô la Belize", PluralForms{"", "", "", "", "", "đô la Belize"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Đô la Canada", PluralForms{"", "", "", "", "", "đô la Canada"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Franc Congo", PluralForms{"", "", "", "", "", "franc Congo"}},
+		"CHE": {2, 0, 2, 1, "CHE", "CHE", "CHE", "Đồng Euro WIR", PluralForms{"", "", "", "", "", ""}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Franc Thụy sĩ", PluralForms{"", "", "", "", "", "franc Thụy sĩ"}},
+		"CHW": {2, 0, 2, 1, "CHW", "CHW", "CHW", "Đồng France WIR", PluralForms{"", "", "", "", "", ""}},
+		"CLE": {2, 0, 2, 1, "CLE", "CLE", "CLE", "Đồng Escudo của Chile", PluralForms{"", "", "", "", "", ""}},
+		"CLF": {4, 0, 4, 1, "CLF", "CLF", "CLF", "Đơn vị Kế toán của Chile (UF)", PluralForms{"", "", "", "", "", ""}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Peso Chile", PluralForms{"", "", "", "", "", "peso Chile"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Nhân dân tệ (hải ngoại)", PluralForms{"", "", "", "", "", "nhân dân tệ (hải ngoại)"}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Nhân dân tệ", PluralForms{"", "", "", "", "", "nhân dân tệ"}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Peso Colombia", PluralForms{"", "", "", "", "", "peso Colombia"}},
+		"COU": {2, 0, 2, 1, "COU", "COU", "COU", "Đơn vị Giá trị Thực của Colombia", PluralForms{"", "", "", "", "", ""}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Colón Costa Rica", PluralForms{"", "", "", "", "", "colón Costa Rica"}},
+		"CSD": {2, 0, 2, 1, "CSD", "CSD", "CSD", "Đồng Dinar của Serbia (2002–2006)", PluralForms{"", "", "", "", "", ""}},
+		"CSK": {2, 0, 2, 1, "CSK", "CSK", "CSK", "Đồng Koruna Xu của Czechoslovakia", PluralForms{"", "", "", "", "", ""}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Peso Cuba có thể chuyển đổi", PluralForms{"", "", "", "", "", "peso Cuba có thể chuyển đổi"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Peso Cuba", PluralForms{"", "", "", "", "", "peso Cuba"}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Escudo Cape Verde", PluralForms{"", "", "", "", "", "escudo Cape Verde"}},
+		"CYP": {2, 0, 2, 1, "CYP", "CYP", "CYP", "Đồng Bảng Síp", PluralForms{"", "", "", "", "", ""}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna Cộng hòa Séc", PluralForms{"", "", "", "", "", "koruna Cộng hòa Séc"}},
+		"DDM": {2, 0, 2, 1, "DDM", "DDM", "DDM", "Đồng Mark Đông Đức", PluralForms{"", "", "", "", "", ""}},
+		"DEM": {2, 0, 2, 1, "DEM", "DEM", "DEM", "Đồng Mark Đức", PluralForms{"", "", "", "", "", ""}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Franc Djibouti", PluralForms{"", "", "", "", "", "franc Djibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Krone Đan Mạch", PluralForms{"", "", "", "", "", "krone Đan Mạch"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Peso Dominica", PluralForms{"", "", "", "", "", "peso Dominica"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dinar Algeria", PluralForms{"", "", "", "", "", "dinar Algeria"}},
+		"ECS": {2, 0, 2, 1, "ECS", "ECS", "ECS", "Đồng Scure Ecuador", PluralForms{"", "", "", "", "", ""}},
+		"ECV": {2, 0, 2, 1, "ECV", "ECV", "ECV", "Đơn vị Giá trị Không đổi của Ecuador", PluralForms{"", "", "", "", "", ""}},
+		"EEK": {2, 0, 2, 1, "EEK", "EEK", "EEK", "Crun Extônia", PluralForms{"", "", "", "", "", ""}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "Bảng Ai Cập", PluralForms{"", "", "", "", "", "bảng Ai Cập"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakfa Eritrea", PluralForms{"", "", "", "", "", "nakfa Eritrea"}},
+		"ESA": {2, 0, 2, 1, "ESA", "ESA", "ESA", "Đồng Peseta Tây Ban Nha (Tài khoản)", PluralForms{"", "", "", "", "", ""}},
+		"ESB": {2, 0, 2, 1, "ESB", "ESB", "ESB", "Đồng Peseta Tây Ban Nha (tài khoản có thể chuyển đổi)", PluralForms{"", "", "", "", "", ""}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "Đồng Peseta Tây Ban Nha", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Birr Ethiopia", PluralForms{"", "", "", "", "", "birr Ethiopia"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "Euro", PluralForms{"", "", "", "", "", "euro"}},
+		"FIM": {2, 0, 2, 1, "FIM", "FIM", "FIM", "Đồng Markka Phần Lan", PluralForms{"", "", "", "", "", ""}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Đô la Fiji", PluralForms{"", "", "", "", "", "đô la Fiji"}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Bảng Quần đảo Falkland", PluralForms{"", "", "", "", "", "bảng Quần đảo Falkland"}},
+		"FRF": {2, 0, 2, 1, "FRF", "FRF", "FRF", "Franc Pháp", PluralForms{"", "", "", "", "", ""}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Bảng Anh", PluralForms{"", "", "", "", "", "bảng Anh"}},
+		"GEK": {2, 0, 2, 1, "GEK", "GEK", "GEK", "Đồng Kupon Larit của Georgia", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lari Georgia", PluralForms{"", "", "", "", "", "lari Georgia"}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "Cedi Ghana (1979–2007)", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "Cedi Ghana", PluralForms{"", "", "", "", "", "cedi Ghana"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Bảng Gibraltar", PluralForms{"", "", "", "", "", "bảng Gibraltar"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi Gambia", PluralForms{"", "", "", "", "", "dalasi Gambia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Franc Guinea", PluralForms{"", "", "", "", "", "franc Guinea"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Syli Guinea", PluralForms{"", "", "", "", "", ""}},
+		"GQE": {2, 0, 2, 1, "GQE", "GQE", "GQE", "Đồng Ekwele của Guinea Xích Đạo", PluralForms{"", "", "", "", "", ""}},
+		"GRD": {2, 0, 2, 1, "GRD", "GRD", "GRD", "Drachma Hy Lạp", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Quetzal Guatemala", PluralForms{"", "", "", "", "", "quetzal Guatemala"}},
+		"GWE": {2, 0, 2, 1, "GWE", "GWE", "GWE", "Đồng Guinea Escudo Bồ Đào Nha", PluralForms{"", "", "", "", "", ""}},
+		"GWP": {2, 0, 2, 1, "GWP", "GWP", "GWP", "Peso Guinea-Bissau", PluralForms{"", "", "", "", "", ""}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Đô la Guyana", PluralForms{"", "", "", "", "", "đô la Guyana"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Đô la Hồng Kông", PluralForms{"", "", "", "", "", "đô la Hồng Kông"}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lempira Honduras", PluralForms{"", "", "", "", "", "lempira Honduras"}},
+		"HRD": {2, 0, 2, 1, "HRD", "HRD", "HRD", "Đồng Dinar Croatia", PluralForms{"", "", "", "", "", ""}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kuna Croatia", PluralForms{"", "", "", "", "", "kuna Croatia"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gourde Haiti", PluralForms{"", "", "", "", "", "gourde Haiti"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Forint Hungary", PluralForms{"", "", "", "", "", "forint Hungary"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rupiah Indonesia", PluralForms{"", "", "", "", "", "rupiah Indonesia"}},
+		"IEP": {2, 0, 2, 1, "IEP", "IEP", "IEP", "Pao Ai-len", PluralForms{"", "", "", "", "", ""}},
+		"ILP": {2, 0, 2, 1, "ILP", "ILP", "ILP", "Pao Ixraen", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Sheqel Israel mới", PluralForms{"", "", "", "", "", "sheqel Israel mới"}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupee Ấn Độ", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dinar Iraq", PluralForms{"", "", "", "", "", "dinar Iraq"}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial Iran", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Króna Iceland", PluralForms{"", "", "", "", "", "króna Iceland"}},
+		"ITL": {0, 0, 0, 1, "ITL", "ITL", "ITL", "Lia Ý", PluralForms{"", "", "", "", "", ""}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Đô la Jamaica", PluralForms{"", "", "", "", "", "đô la Jamaica"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dinar Jordan", PluralForms{"", "", "", "", "", "dinar Jordan"}},
+		"JPY": {0, 0, 0, 1, "JPY", "¥", "¥", "Yên Nhật", PluralForms{"", "", "", "", "", "yên Nhật"}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Shilling Kenya", PluralForms{"", "", "", "", "", "shilling Kenya"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Som Kyrgyzstan", PluralForms{"", "", "", "", "", "som Kyrgyzstan"}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Riel Campuchia", PluralForms{"", "", "", "", "", "riel Campuchia"}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Franc Comoros", PluralForms{"", "", "", "", "", "franc Comoros"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Won Triều Tiên", PluralForms{"", "", "", "", "", "won Triều Tiên"}},
+		"KRH": {2, 0, 2, 1, "KRH", "KRH", "KRH", "Đồng Hwan Hàn Quốc (1953–1962)", PluralForms{"", "", "", "", "", ""}},
+		"KRO": {2, 0, 2, 1, "KRO", "KRO", "KRO", "Đồng Won Hàn Quốc (1945–1953)", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Won Hàn Quốc", PluralForms{"", "", "", "", "", "won Hàn Quốc"}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dinar Kuwait", PluralForms{"", "", "", "", "", "dinar Kuwait"}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Đô la Quần đảo Cayman", PluralForms{"", "", "", "", "", "đô la Quần đảo Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tenge Kazakhstan", PluralForms{"", "", "", "", "", "tenge Kazakhstan"}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kip Lào", PluralForms{"", "", "", "", "", "kip Lào"}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Bảng Li-băng", PluralForms{"", "", "", "", "", "bảng Li-băng"}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rupee Sri Lanka", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Đô la Liberia", PluralForms{"", "", "", "", "", "đô la Liberia"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti Lesotho", PluralForms{"", "", "", "", "", ""}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "Litas Lít-va", PluralForms{"", "", "", "", "", "litas Lít-va"}},
+		"LTT": {2, 0, 2, 1, "LTT", "LTT", "LTT", "Đồng Talonas Litva", PluralForms{"", "", "", "", "", ""}},
+		"LUC": {2, 0, 2, 1, "LUC", "LUC", "LUC", "Đồng Franc Luxembourg có thể chuyển đổi", PluralForms{"", "", "", "", "", ""}},
+		"LUF": {0, 0, 0, 1, "LUF", "LUF", "LUF", "Đồng Franc Luxembourg", PluralForms{"", "", "", "", "", ""}},
+		"LUL": {2, 0, 2, 1, "LUL", "LUL", "LUL", "Đồng Franc Luxembourg tài chính", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "Lats Latvia", PluralForms{"", "", "", "", "", "lats Lativia"}},
+		"LVR": {2, 0, 2, 1, "LVR", "LVR", "LVR", "Đồng Rúp Latvia", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dinar Libi", PluralForms{"", "", "", "", "", "dinar Libi"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dirham Ma-rốc", PluralForms{"", "", "", "", "", "dirham Ma-rốc"}},
+		"MAF": {2, 0, 2, 1, "MAF", "MAF", "MAF", "Đồng Franc Ma-rốc", PluralForms{"", "", "", "", "", ""}},
+		"MCF": {2, 0, 2, 1, "MCF", "MCF", "MCF", "Đồng Franc Monegasque", PluralForms{"", "", "", "", "", ""}},
+		"MDC": {2, 0, 2, 1, "MDC", "MDC", "MDC", "Đồng Cupon Moldova", PluralForms{"", "", "", "", "", ""}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Leu Moldova", PluralForms{"", "", "", "", "", "leu Moldova"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Ariary Madagascar", PluralForms{"", "", "", "", "", "ariary Madagascar"}},
+		"MGF": {0, 0, 0, 1, "MGF", "MGF", "MGF", "Đồng Franc Magalasy", PluralForms{"", "", "", "", "", ""}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Denar Macedonia", PluralForms{"", "", "", "", "", "denar Macedonia"}},
+		"MKN": {2, 0, 2, 1, "MKN", "MKN", "MKN", "Đồng Denar Macedonia (1992–1993)", PluralForms{"", "", "", "", "", ""}},
+		"MLF": {2, 0, 2, 1, "MLF", "MLF", "MLF", "Đồng Franc Mali", PluralForms{"", "", "", "", "", ""}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kyat Myanma", PluralForms{"", "", "", "", "", "kyat Myanma"}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Tugrik Mông Cổ", PluralForms{"", "", "", "", "", "tugrik Mông Cổ"}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pataca Ma Cao", PluralForms{"", "", "", "", "", "pataca Ma Cao"}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya Mauritania (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya Mauritania", PluralForms{"", "", "", "", "", "ouguiya Mauritania"}},
+		"MTL": {2, 0, 2, 1, "MTL", "MTL", "MTL", "Lia xứ Man-tơ", PluralForms{"", "", "", "", "", ""}},
+		"MTP": {2, 0, 2, 1, "MTP", "MTP", "MTP", "Đồng Bảng Malta", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rupee Mauritius", PluralForms{"", "", "", "", "", "rupee Mauritius"}},
+		"MVP": {2, 0, 2, 1, "MVP", "MVP", "MVP", "Rupee Maldives (1947–1981)", PluralForms{"", "", "", "", "", "Rupee Maldives (1947–1981)"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rufiyaa Maldives", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kwacha Malawi", PluralForms{"", "", "", "", "", "kwacha Malawi"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Peso Mexico", PluralForms{"", "", "", "", "", "peso Mexico"}},
+		"MXP": {2, 0, 2, 1, "MXP", "MXP", "MXP", "Đồng Peso Bạc Mê-hi-cô (1861–1992)", PluralForms{"", "", "", "", "", ""}},
+		"MXV": {2, 0, 2, 1, "MXV", "MXV", "MXV", "Đơn vị Đầu tư Mê-hi-cô", PluralForms{"", "", "", "", "", ""}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ringgit Malaysia", PluralForms{"", "", "", "", "", "ringgit Malaysia"}},
+		"MZE": {2, 0, 2, 1, "MZE", "MZE", "MZE", "Escudo Mozambique", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Đồng Metical Mozambique (1980–2006)", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Metical Mozambique", PluralForms{"", "", "", "", "", "metical Mozambique"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Đô la Namibia", PluralForms{"", "", "", "", "", "đô la Namibia"}},
+		"NGN": {2, 0, 2, 1, "NGN", "NGN", "₦", "Naira Nigeria", PluralForms{"", "", "", "", "", "naira Nigeria"}},
+		"NIC": {2, 0, 2, 1, "NIC", "NIC", "NIC", "Đồng Córdoba Nicaragua (1988–1991)", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Córdoba Nicaragua", PluralForms{"", "", "", "", "", "córdoba Nicaragua"}},
+		"NLG": {2, 0, 2, 1, "NLG", "NLG", "NLG", "Đồng Guilder Hà Lan", PluralForms{"", "", "", "", "", ""}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "Krone Na Uy", PluralForms{"", "", "", "", "", "krone Na Uy"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rupee Nepal", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Đô la New Zealand", PluralForms{"", "", "", "", "", "đô la New Zealand"}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Rial Oman", PluralForms{"", "", "", "", "", "rial Oman"}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Balboa Panama", PluralForms{"", "", "", "", "", "balboa Panama"}},
+		"PEI": {2, 0, 2, 1, "PEI", "PEI", "PEI", "Đồng Inti Peru", PluralForms{"", "", "", "", "", ""}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sol Peru", PluralForms{"", "", "", "", "", "sol Peru"}},
+		"PES": {2, 0, 2, 1, "PES", "PES", "PES", "Đồng Sol Peru (1863–1965)", PluralForms{"", "", "", "", "", ""}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kina Papua New Guinea", PluralForms{"", "", "", "", "", "kina Papua New Guinea"}},
+		"PHP": {2, 0, 2, 1, "PHP", "PHP", "₱", "Peso Philipin", PluralForms{"", "", "", "", "", "peso Philipin"}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rupee Pakistan", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Zloty Ba Lan", PluralForms{"", "", "", "", "", "zloty Ba Lan"}},
+		"PLZ": {2, 0, 2, 1, "PLZ", "PLZ", "PLZ", "Đồng Zloty Ba Lan (1950–1995)", PluralForms{"", "", "", "", "", ""}},
+		"PTE": {2, 0, 2, 1, "PTE", "PTE", "PTE", "Đồng Escudo Bồ Đào Nha", PluralForms{"", "", "", "", "", ""}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Guarani Paraguay", PluralForms{"", "", "", "", "", "guarani Paraguay"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Rial Qatar", PluralForms{"", "", "", "", "", "rial Qatar"}},
+		"RHD": {2, 0, 2, 1, "RHD", "RHD", "RHD", "Đồng Đô la Rhode", PluralForms{"", "", "", "", "", ""}},
+		"ROL": {2, 0, 2, 1, "ROL", "ROL", "ROL", "Đồng Leu Rumani (1952–2006)", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Leu Romania", PluralForms{"", "", "", "", "", "leu Romania"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dinar Serbia", PluralForms{"", "", "", "", "", "dinar Serbia"}},
+		"RUB": {2, 0, 2, 1, "RUB", "RUB", "₽", "Rúp Nga", PluralForms{"", "", "", "", "", "rúp Nga"}},
+		"RUR": {2, 0, 2, 1, "RUR", "RUR", "RUR", "Đồng Rúp Nga (1991–1998)", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Franc Rwanda", PluralForms{"", "", "", "", "", "franc Rwanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riyal Ả Rập Xê-út", PluralForms{"", "", "", "", "", "riyal Ả Rập Xê-út"}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Đô la quần đảo Solomon", PluralForms{"", "", "", "", "", "đô la Quần đảo Solomon"}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rupee Seychelles", PluralForms{"", "", "", "", "", "rupee Seychelles"}},
+		"SDD": {2, 0, 2, 1, "SDD", "SDD", "SDD", "Đồng Dinar Sudan (1992–2007)", PluralForms{"", "", "", "", "", ""}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Bảng Sudan", PluralForms{"", "", "", "", "", "bảng Sudan"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Đồng Bảng Sudan (1957–1998)", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Krona Thụy Điển", PluralForms{"", "", "", "", "", "krona Thụy Điển"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Đô la Singapore", PluralForms{"", "", "", "", "", "đô la Singapore"}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Bảng St. Helena", PluralForms{"", "", "", "", "", "bảng St. Helena"}},
+		"SIT": {2, 0, 2, 1, "SIT", "SIT", "SIT", "Tôla Xlôvênia", PluralForms{"", "", "", "", "", ""}},
+		"SKK": {2, 0, 2, 1, "SKK", "SKK", "SKK", "Cuaron Xlôvác", PluralForms{"", "", "", "", "", ""}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Leone Sierra Leone", PluralForms{"", "", "", "", "", ""}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Leone Sierra Leone (1964—2022)", PluralForms{"", "", "", "", "", ""}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Shilling Somali", PluralForms{"", "", "", "", "", "shilling Somali"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Đô la Suriname", PluralForms{"", "", "", "", "", "đô la Suriname"}},
+		"SRG": {2, 0, 2, 1, "SRG", "SRG", "SRG", "Đồng Guilder Surinam", PluralForms{"", "", "", "", "", ""}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Bảng Nam Sudan", PluralForms{"", "", "", "", "", "bảng Nam Sudan"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobra São Tomé và Príncipe (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dobra São Tomé và Príncipe", PluralForms{"", "", "", "", "", "dobra São Tomé và Príncipe"}},
+		"SUR": {2, 0, 2, 1, "SUR", "SUR", "SUR", "Đồng Rúp Sô viết", PluralForms{"", "", "", "", "", ""}},
+		"SVC": {2, 0, 2, 1, "SVC", "SVC", "SVC", "Colón El Salvador", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Bảng Syria", PluralForms{"", "", "", "", "", "bảng Syria"}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni Swaziland", PluralForms{"", "", "", "", "", "lilangeni Swaziland"}},
+		"THB": {2, 0, 2, 1, "THB", "฿", "฿", "Bạt Thái Lan", PluralForms{"", "", "", "", "", "bạt Thái Lan"}},
+		"TJR": {2, 0, 2, 1, "TJR", "TJR", "TJR", "Đồng Rúp Tajikistan", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Somoni Tajikistan", PluralForms{"", "", "", "", "", "somoni Tajikistan"}},
+		"TMM": {0, 0, 0, 1, "TMM", "TMM", "TMM", "Đồng Manat Turkmenistan (1993–2009)", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Manat Turkmenistan", PluralForms{"", "", "", "", "", "manat Turkmenistan"}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dinar Tunisia", PluralForms{"", "", "", "", "", "dinar Tunisia"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Paʻanga Tonga", PluralForms{"", "", "", "", "", "paʻanga Tonga"}},
+		"TPE": {2, 0, 2, 1, "TPE", "TPE", "TPE", "Đồng Escudo Timor", PluralForms{"", "", "", "", "", ""}},
+		"TRL": {0, 0, 0, 1, "TRL", "TRL", "TRL", "Lia Thổ Nhĩ Kỳ (1922–2005)", PluralForms{"", "", "", "", "", "lia Thổ Nhĩ Kỳ (1922–2005)"}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lia Thổ Nhĩ Kỳ", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Đô la Trinidad và Tobago", PluralForms{"", "", "", "", "", "đô la Trinidad và Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "NT$", "Đô la Đài Loan mới", PluralForms{"", "", "", "", "", "đô la Đài Loan mới"}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Shilling Tanzania", PluralForms{"", "", "", "", "", "shilling Tanzania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Hryvnia Ukraina", PluralForms{"", "", "", "", "", "hryvnia Ukraina"}},
+		"UAK": {2, 0, 2, 1, "UAK", "UAK", "UAK", "Đồng Karbovanets Ucraina", PluralForms{"", "", "", "", "", ""}},
+		"UGS": {2, 0, 2, 1, "UGS", "UGS", "UGS", "Đồng Shilling Uganda (1966–1987)", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Shilling Uganda", PluralForms{"", "", "", "", "", "shilling Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "US$", "$", "Đô la Mỹ", PluralForms{"", "", "", "", "", "đô la Mỹ"}},
+		"USN": {2, 0, 2, 1, "USN", "USN", "USN", "Đô la Mỹ (Ngày tiếp theo)", PluralForms{"", "", "", "", "", ""}},
+		"USS": {2, 0, 2, 1, "USS", "USS", "USS", "Đô la Mỹ (Cùng ngày)", PluralForms{"", "", "", "", "", ""}},
+		"UYI": {0, 0, 0, 1, "UYI", "UYI", "UYI", "Đồng Peso Uruguay (Đơn vị Theo chỉ số)", PluralForms{"", "", "", "", "", ""}},
+		"UYP": {2, 0, 2, 1, "UYP", "UYP", "UYP", "Đồng Peso Uruguay (1975–1993)", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Peso Uruguay", PluralForms{"", "", "", "", "", "peso Uruguay"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Som Uzbekistan", PluralForms{"", "", "", "", "", "som Uzbekistan"}},
+		"VEB": {2, 0, 2, 1, "VEB", "VEB", "VEB", "Đồng bolívar của Venezuela (1871–2008)", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "Bolívar Venezuela (2008–2018)", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bolívar Venezuela", PluralForms{"", "", "", "", "", "bolívar Venezuela"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Đồng Việt Nam", PluralForms{"", "", "", "", "", "đồng Việt Nam"}},
+		"VNN": {2, 0, 2, 1, "VNN", "VNN", "VNN", "Đồng Việt Nam (1978–1985)", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Vatu Vanuatu", PluralForms{"", "", "", "", "", "vatu Vanuatu"}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tala Samoa", PluralForms{"", "", "", "", "", "tala Samoa"}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Franc CFA Trung Phi", PluralForms{"", "", "", "", "", "franc CFA Trung Phi"}},
+		"XAG": {2, 0, 2, 1, "XAG", "XAG", "XAG", "Bạc", PluralForms{"", "", "", "", "", ""}},
+		"XAU": {2, 0, 2, 1, "XAU", "XAU", "XAU", "Vàng", PluralForms{"", "", "", "", "", ""}},
+		"XBA": {2, 0, 2, 1, "XBA", "XBA", "XBA", "Đơn vị Tổng hợp Châu Âu", PluralForms{"", "", "", "", "", ""}},
+		"XBB": {2, 0, 2, 1, "XBB", "XBB", "XBB", "Đơn vị Tiền tệ Châu Âu", PluralForms{"", "", "", "", "", ""}},
+		"XBC": {2, 0, 2, 1, "XBC", "XBC", "XBC", "Đơn vị Kế toán Châu Âu (XBC)", PluralForms{"", "", "", "", "", ""}},
+		"XBD": {2, 0, 2, 1, "XBD", "XBD", "XBD", "Đơn vị Kế toán Châu Âu (XBD)", PluralForms{"", "", "", "", "", ""}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Đô la Đông Caribê", PluralForms{"", "", "", "", "", "đô la Đông Caribê"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XDR": {2, 0, 2, 1, "XDR", "XDR", "XDR", "Quyền Rút vốn Đặc biệt", PluralForms{"", "", "", "", "", ""}},
+		"XEU": {2, 0, 2, 1, "XEU", "XEU", "XEU", "Đơn vị Tiền Châu Âu", PluralForms{"", "", "", "", "", ""}},
+		"XFO": {2, 0, 2, 1, "XFO", "XFO", "XFO", "Đồng France Pháp Vàng", PluralForms{"", "", "", "", "", ""}},
+		"XFU": {2, 0, 2, 1, "XFU", "XFU", "XFU", "Đồng UIC-Franc Pháp", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Franc CFA Tây Phi", PluralForms{"", "", "", "", "", "franc CFA Tây Phi"}},
+		"XPD": {2, 0, 2, 1, "XPD", "XPD", "XPD", "Paladi", PluralForms{"", "", "", "", "", ""}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Franc CFP", PluralForms{"", "", "", "", "", "franc CFP"}},
+		"XPT": {2, 0, 2, 1, "XPT", "XPT", "XPT", "Bạch kim", PluralForms{"", "", "", "", "", ""}},
+		"XRE": {2, 0, 2, 1, "XRE", "XRE", "XRE", "Quỹ RINET", PluralForms{"", "", "", "", "", ""}},
+		"XTS": {2, 0, 2, 1, "XTS", "XTS", "XTS", "Mã Tiền tệ Kiểm tra", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "XXX", "XXX", "Tiền tệ chưa biết", PluralForms{"", "", "", "", "", "(tiền tệ chưa biết)"}},
+		"YDD": {2, 0, 2, 1, "YDD", "YDD", "YDD", "Đồng Dinar Yemen", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Rial Yemen", PluralForms{"", "", "", "", "", "rial Yemen"}},
+		"YUD": {2, 0, 2, 1, "YUD", "YUD", "YUD", "Đồng Dinar Nam Tư Xu (1966–1990)", PluralForms{"", "", "", "", "", ""}},
+		"YUM": {2, 0, 2, 1, "YUM", "YUM", "YUM", "Đồng Dinar Nam Tư Mới (1994–2002)", PluralForms{"", "", "", "", "", ""}},
+		"YUN": {2, 0, 2, 1, "YUN", "YUN", "YUN", "Đồng Dinar Nam Tư Có thể chuyển đổi (1990–1992)", PluralForms{"", "", "", "", "", ""}},
+		"YUR": {2, 0, 2, 1, "YUR", "YUR", "YUR", "Đồng Dinar Nam Tư Tái cơ cấu (1992–1993)", PluralForms{"", "", "", "", "", ""}},
+		"ZAL": {2, 0, 2, 1, "ZAL", "ZAL", "ZAL", "Đồng Rand Nam Phi (tài chính)", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Rand Nam Phi", PluralForms{"", "", "", "", "", "rand Nam Phi"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Đồng kwacha của Zambia (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kwacha Zambia", PluralForms{"", "", "", "", "", "kwacha Zambia"}},
+		"ZRN": {2, 0, 2, 1, "ZRN", "ZRN", "ZRN", "Đồng Zaire Mới (1993–1998)", PluralForms{"", "", "", "", "", ""}},
+		"ZRZ": {2, 0, 2, 1, "ZRZ", "ZRZ", "ZRZ", "Đồng Zaire (1971–1993)", PluralForms{"", "", "", "", "", ""}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Đồng Đô la Zimbabwe (1980–2008)", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
+		"ZWL": {2, 0, 2, 1, "ZWL", "ZWL", "ZWL", "Đồng Đô la Zimbabwe (2009)", PluralForms{"", "", "", "", "", ""}},
+		"ZWR": {2, 0, 2, 1, "ZWR", "ZWR", "ZWR", "Đồng Đô la Zimbabwe (2008)", PluralForms{"", "", "", "", "", ""}},
 	},
 }
@@ -6,180 +6,233 @@ var yoBJ = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "dáàshì",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0 ɛgbɛ̀rún"}},
+				{4, 2, map[string]string{"other": "0 ɛgbɛ̀rún"}},
+				{5, 3, map[string]string{"other": "0 ɛgbɛ̀rún"}},
+				{6, 1, map[string]string{"other": "0 mílíɔ̀nù"}},
+				{7, 2, map[string]string{"other": "0 mílíɔ̀nù"}},
+				{8, 3, map[string]string{"other": "0 mílíɔ̀nù"}},
+				{9, 1, map[string]string{"other": "0 bilíɔ̀nù"}},
+				{10, 2, map[string]string{"other": "0 bilíɔ̀nù"}},
+				{11, 3, map[string]string{"other": "0 bilíɔ̀nù"}},
+				{12, 1, map[string]string{"other": "0 tiriliɔ̀nù"}},
+				{13, 2, map[string]string{"other": "0 tiriliɔ̀nù"}},
+				{14, 3, map[string]string{"other": "0 tiriliɔ̀nù"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "¤0K"}},
+				{4, 2, map[string]string{"other": "¤0K"}},
+				{5, 3, map[string]string{"other": "¤0K"}},
+				{6, 1, map[string]string{"other": "¤0M"}},
+				{7, 2, map[string]string{"other": "¤0M"}},
+				{8, 3, map[string]string{"other": "¤0M"}},
+				{9, 1, map[string]string{"other": "¤0B"}},
+				{10, 2, map[string]string{"other": "¤0B"}},
+				{11, 3, map[string]string{"other": "¤0B"}},
+				{12, 1, map[string]string{"other": "¤0T"}},
+				{13, 2, map[string]string{"other": "¤0T"}},
+				{14, 3, map[string]string{"other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "₦", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "₽", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Diami ti Awon Orílɛ́ède Arabu", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afugánì Afuganísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lɛ́ɛ̀kì Àlìbáníà", PluralForms{"", "", "", "", "", "lɛ́kè Àlìbéníà"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dírààmù Àmɛ́níà", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Gílídà Netherlands Antillean", PluralForms{"", "", "", "", "", "àwɔn gílídà Netherlands Antillean"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kíwánsà Angola", PluralForms{"", "", "", "", "", "àwɔn kíwánsà Angola"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Pɛ́sò Agɛntínà", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò Agɛntínà"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dɔla ti Orílɛ́ède Ástràlìá", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Fuloríìnì Àrúbà", PluralForms{"", "", "", "", "", "àwɔn fuloríìnì Àrúbà"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Mánààtì Àsàbáíjáì", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Àmi Yíyípadà Bosnia-Herzegovina", PluralForms{"", "", "", "", "", "àwɔn àmi Yíyípadà Bosnia-Herzegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Dɔ́là Bábádɔ̀ɔ̀sì", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Bábádɔ̀ɔ̀sì"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Tákà Báńgíládɛ̀ɛ̀shì", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Owó Lɛ́fì Bɔ̀lìgéríà", PluralForms{"", "", "", "", "", "Lɛ́fà Bɔ̀lìgéríà"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dina ti Orílɛ́ède Báránì", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Faransi Bùùrúndì", PluralForms{"", "", "", "", "", "àwɔn faransi Bùùrúndì"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dɔ́là Bɛ̀múdà", PluralForms{"", "", "", "", "", "àwɔ́n dɔ́là Bɛ̀múdà"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dɔ́là Bùrùnéì", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bɔlifiánò Bɔ̀lífíà", PluralForms{"", "", "", "", "", "àwɔn bɔlifiánò Bɔ̀lífíà"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Owó ti Orílɛ̀-èdè Brazil", PluralForms{"", "", "", "", "", "Awon owó ti Orílɛ̀-èdè Brazil"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dɔ́là Bàhámà", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Bàhámà"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ìngɔ́tírɔ̀mù Bútàànì", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula Bɔ̀tìsúwánà", PluralForms{"", "", "", "", "", "àwɔn pula Bɔ̀tìsúwánà"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Rɔ́bù Bɛ̀lárùùsì", PluralForms{"", "", "", "", "", "àwɔn rɔ́bù Bɛ̀lárùùsì"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dɔ́là Bɛ̀lísè", PluralForms{"", "", "", "", "", "àwɔn Dɔ́là Bɛ́lìsè"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dɔ́là Kánádà", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Kánádà"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Firanki Kongo", PluralForms{"", "", "", "", "", "àwɔn firanki Kongo"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Faransí Síwíìsì", PluralForms{"", "", "", "", "", "Faransi Siwisi"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Pɛ́sò Shílè", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò Shílè"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yúànì Sháínà", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Reminibi ti Orílɛ́ède sháínà", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Pɛ́sò Kòlóḿbíà", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò Kòlóḿbíà"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kólɔ́ɔ̀nì Kosita Ríkà", PluralForms{"", "", "", "", "", "àwɔ́n kólɔ́ɔ̀nì Kosita Ríkà"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Pɛ́sò Yíyípadà Kúbà", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò yíyípadà Kúbà"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Pɛ́sò Kúbà", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Èsìkúdò Kapú Faadì", PluralForms{"", "", "", "", "", "àwɔn èsìkúdò Kapú Faadì"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna Shɛ́ɛ̀kì", PluralForms{"", "", "", "", "", "àwɔn koruna Shɛ́ɛ̀kì"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Faransi Dibouti", PluralForms{"", "", "", "", "", "àwɔn faransi Dibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Kírónì Dáníshì", PluralForms{"", "", "", "", "", "Kírònà Dáníìshì"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Pɛ́sò Dòníníkà", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò Dòníníkà"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dina ti Orílɛ́ède Àlùgèríánì", PluralForms{"", "", "", "", "", "àwɔn dínà Àlùgèríánì"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "pɔɔn ti Orílɛ́ède Egipiti", PluralForms{"", "", "", "", "", "àwɔn pɔ́n-ún Ejipítì"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakifasì Eritira", PluralForms{"", "", "", "", "", "àwɔn nakifasì Eritira"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Báà Etópíà", PluralForms{"", "", "", "", "", "àwɔn báà Etópíà"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "owó Yúrò", PluralForms{"", "", "", "", "", "Awon owó Yúrò"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dɔ́là Fíjì", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Pɔ́n-ùn Erékùsù Falkland", PluralForms{"", "", "", "", "", "àwɔn Pɔ́n-ùn Erékùsù Falkland [ Pɔ́n-ùn Erékùsù Falkland ] 1.23 Pɔ́n-ùn Erékùsù Falkland 0.00 pɔ́n-ùn Erékùsù Falkland"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Pɔ́n-ùn ti Orilɛ̀-èdè Gɛ̀ɛ́sì", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lárì Jɔ́jíà", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "shidi ti Orílɛ́ède Gana", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "sídì Gana", PluralForms{"", "", "", "", "", "àwɔn sídì Gana"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Pɔ́n-ùn Gibúrátà", PluralForms{"", "", "", "", "", "àwɔn pɔ́n-ùn Gibúrátà"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi Gamibia", PluralForms{"", "", "", "", "", "àwɔn dalasi Gamibia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Fírànkì Gínì", PluralForms{"", "", "", "", "", "àwɔn fírànkì Gínì"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Faransi ti Orílɛ́ède Gini", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Kúɛ́tísààlì Guatimílà", PluralForms{"", "", "", "", "", "àwɔn kúɛ́tísààlì Guatimílà"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dɔ́là Gùyánà", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Gùyánà"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dɔ́là Hong Kong", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lɛmipírà Ɔ́ńdúrà", PluralForms{"", "", "", "", "", "àwɔn Lɛmipírà Ɔ́ńdúrà"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kúnà Kúróshíà", PluralForms{"", "", "", "", "", "àwɔn kúnà Kúróshíà"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gɔ́dì Àítì", PluralForms{"", "", "", "", "", "àwɔn gɔ́dì Àítì"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Fɔ́ríǹtì Hɔ̀ngérí", PluralForms{"", "", "", "", "", "àwɔn fɔ́ríǹtì Hɔ̀ngérí"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rúpìyá Indonésíà", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Shékélì Tuntun Ísírɛ̀ɛ̀lì", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupi ti Orílɛ́ède Indina", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dínárì Ìráákì", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial Iranian", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Kòrónà Icelandic", PluralForms{"", "", "", "", "", "kórónɔ̀ Áílándíìkì"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dɔ́là Jàmáíkà", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Jàmáíkà"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dínárì Jɔ́dàànì", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yeni ti Orílɛ́ède Japani", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Shiili Kenya", PluralForms{"", "", "", "", "", "àwɔ́n shiili Kenya"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Sómú Kirijísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Ráyò Kàm̀bɔ́díà", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Faransi Komori", PluralForms{"", "", "", "", "", "àwɔn faransi Komori"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Wɔ́ɔ̀nù Àríwá Kòríà", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Wɔ́ɔ̀nù Gúúsù Kòríà", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dínárì Kuwaiti", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dɔ́là Erékùsù Cayman", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Erékùsù Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tɛngé Kasakísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kíììpù Làótì", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Pɔ́n-ùn Lebanese", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rúpìì Siri Láńkà", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dɔla Liberia", PluralForms{"", "", "", "", "", "àwɔn dɔla Liberia"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti ti Orílɛ́ède Lesoto", PluralForms{"", "", "", "", "", "Lótì ti Lɛ̀sótò"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dínà Líbíyà", PluralForms{"", "", "", "", "", "àwɔn dínà Líbíyà"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dírámì Morokò", PluralForms{"", "", "", "", "", "àwɔn dírámì Morokò"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Owó Léhù Moldovan", PluralForms{"", "", "", "", "", "Léhì Moldovan"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Faransi Malagasi", PluralForms{"", "", "", "", "", "àwɔn faransi Malagasi"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Dɛ́nà Masidóníà", PluralForms{"", "", "", "", "", "dɛ́nàrì Masidóníà"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kíyàtì Myanmar", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Túgúrììkì Mòǹgólíà", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pàtákà Màkáò", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya ti Orílɛ́ède Maritania (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya ti Orílɛ́ède Maritania", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rupi Maritusi", PluralForms{"", "", "", "", "", "àwɔn rupi Maritusi"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rúfìyá Mɔ̀lìdífà", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kásà Màláwì", PluralForms{"", "", "", "", "", "àwɔn kásà Màláwì"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Pɛ́sò Mɛ́síkò", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò Mɛ́síkò"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ríngìtì Màléshíà", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metika ti Orílɛ́ède Mosamibiki", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mɛ́tíkààlì Mòsáḿbíìkì", PluralForms{"", "", "", "", "", "àwɔn mɛ́tíkààlì Mòsáḿbíìkì"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dɔla Namibíà", PluralForms{"", "", "", "", "", "àwɔn dɔla Namibíà"}},
+		"NGN": {2, 0, 2, 1, "NGN", "₦", "₦", "Náírà Nàìjíríà", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Kɔ̀dóbà Naikarágúà", PluralForms{"", "", "", "", "", "àwɔn kɔ̀dóbà Naikarágúà"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "kórónì Nɔ́wè", PluralForms{"", "", "", "", "", "kórónà Nɔ́wè"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rúpìì Nɛ̵́pààlì", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dɔ́là New Zealand", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Ráyò Omani", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Bálíbóà Pànámà", PluralForms{"", "", "", "", "", "àwɔn bálíbóà Pànámà"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sólì Pèrúù", PluralForms{"", "", "", "", "", "àwɔn sólì Pèrúù"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kínà Papua Guinea Tuntun", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Písò Fílípìnì", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rúpìì Pakisitánì", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Sílɔ̀tì Pɔ́líshì", PluralForms{"", "", "", "", "", "àwɔn sílɔ̀tì Pɔ́líshì"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Gúáránì Párágúwè", PluralForms{"", "", "", "", "", "àwɔn gúáránì Párágúwè"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Ráyò Kàtárì", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Léhù Ròméníà", PluralForms{"", "", "", "", "", "Léhì Ròméníà"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dínárì Sàbíà", PluralForms{"", "", "", "", "", "àwɔn dínárì Sàbíà"}},
+		"RUB": {2, 0, 2, 1, "RUB", "₽", "₽", "Owó ruble ti ilɛ̀ Rɔ́shíà", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Faransi Ruwanda", PluralForms{"", "", "", "", "", "àwɔn faransi Ruwanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riya ti Orílɛ́ède Saudi", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dɔ́là Erékùsù Sɔ́lómɔ́nì", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rúpì Sayiselesi", PluralForms{"", "", "", "", "", "àwɔ́n rúpì Sayiselesi"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Pɔ́n-ùn Sùdáànì", PluralForms{"", "", "", "", "", "àwɔn pɔ́n-ùn Sùdáànì"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Pɔɔun ti Orílɛ́ède Sudani", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Kòrónà Súwídìn", PluralForms{"", "", "", "", "", "Kòrónɔ̀ Súwídìn"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dɔ́là Síngápɔ̀", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Pɔ́n-un Elena", PluralForms{"", "", "", "", "", "àwɔn pɔ́n-un Elena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Líónì Sira Líonì", PluralForms{"", "", "", "", "", "àwɔn líónì Sira Líonì"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Líónì Sira Líonì (1964—2022)", PluralForms{"", "", "", "", "", "àwɔn líónì Sira Líonì (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Shílè Somali", PluralForms{"", "", "", "", "", "àwɔ́n shílè Somali"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dɔ́là Súrínámì", PluralForms{"", "", "", "", "", "àwɔn Dɔ́là Súrínámì"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Pɔ́n-un Gúúsù Sùdáànì", PluralForms{"", "", "", "", "", "àwɔn pɔ́n-un Gúúsù Sùdáànì"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobira ti Orílɛ́ède Sao tome Ati Pirisipe (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dɔbíra Sao tome àti Pirisipi", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Pɔ́n-ùn Sírìà", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni Suwasi", PluralForms{"", "", "", "", "", "emalangeni Suwasi"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Báàtì Tháì", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Sómónì Tajikístàànì", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Mánààtì Tɔkimɛnístàànì", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dínà Tunishíà", PluralForms{"", "", "", "", "", "àwɔn dínà Tunishíà"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Pàángà Tóńgà", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lírà Tɔ́kì", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dɔ́là Trinidad & Tobago", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Trinidad àti Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Dɔ́là Tàìwánì Tuntun", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Sile Tansania", PluralForms{"", "", "", "", "", "àwɔn shile Tansania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ɔrifiníyà Yukiréníà", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Shile Uganda", PluralForms{"", "", "", "", "", "àwɔn shile Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dɔ́là", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Pɛ́sò Úrúgúwè", PluralForms{"", "", "", "", "", "àwɔn pɛ́sò Úrúgúwè"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Sómú Usibɛkísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bɔ̀lífà Fɛnɛsuɛ́là", PluralForms{"", "", "", "", "", "àwɔn bɔ̀lífà Fɛnɛsuɛ́là"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dáhùn Vietnamese", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Fátù Vanuatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tálà Sàmóà", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Firanki àárín Afíríkà", PluralForms{"", "", "", "", "", "àwɔn firanki àárín Afíríkà"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dɔ́là Ilà Oòrùn Karíbíà", PluralForms{"", "", "", "", "", "àwɔn dɔ́là Ilà Oòrùn Karíbíà"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Faransì ìwɔ̀-oorùn Afíríkà", PluralForms{"", "", "", "", "", "àwɔn faransì ìwɔ̀-oorùn Afíríkà"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Fírànkì CFP", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "owóníná àìmɔ̀", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Ráyò Yɛ́mɛ̀nì", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Rándì Gúúsù Afíríkà", PluralForms{"", "", "", "", "", "rándì Gúúsù Afíríkà"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kawasha ti Orílɛ́ède Saabia (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kàwasà Sámbíà", PluralForms{"", "", "", "", "", "àwɔn kàwasà Sámbíà"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dɔla ti Orílɛ́ède Siibabuwe", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
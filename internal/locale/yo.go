@@ -6,180 +6,233 @@ var yo = LocaleData{
 	NumberInfo{"latn",
 		[10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
 		".", ",",
-		NumberFormats{NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "¤-", ""}, NumberFormat{3, 3, "¤\u00a0", "", "¤\u00a0-", ""}, NumberFormat{3, 3, "", "", "-", ""}, NumberFormat{3, 3, "¤", "", "(¤", ")"}, NumberFormat{3, 3, "¤\u00a0", "", "(¤\u00a0", ")"}, NumberFormat{3, 3, "", "", "(", ")"}},
+		1,
+		"∞", "NaN",
+		"%", "‰",
+		"+", "-",
+		"E", "×",
+		":", "dáàṣì",
+		NumberFormats{NumberFormat{3, 3, 1, 0, 3, "", "", "-", ""}, NumberFormat{3, 3, 1, 0, 0, "", "%", "-", "%"}, NumberFormat{3, 3, 0, 0, 0, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "-¤", ""}, NumberFormat{3, 3, 1, 2, 2, "", "", "-", ""}, NumberFormat{3, 3, 1, 2, 2, "¤", "", "(¤", ")"}, NumberFormat{3, 3, 1, 2, 2, "", "", "(", ")"}},
+		CompactFormats{
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0K"}},
+				{4, 2, map[string]string{"other": "0K"}},
+				{5, 3, map[string]string{"other": "0K"}},
+				{6, 1, map[string]string{"other": "0M"}},
+				{7, 2, map[string]string{"other": "0M"}},
+				{8, 3, map[string]string{"other": "0M"}},
+				{9, 1, map[string]string{"other": "0G"}},
+				{10, 2, map[string]string{"other": "0G"}},
+				{11, 3, map[string]string{"other": "0G"}},
+				{12, 1, map[string]string{"other": "0T"}},
+				{13, 2, map[string]string{"other": "0T"}},
+				{14, 3, map[string]string{"other": "0T"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "0 ẹgbẹ̀rún"}},
+				{4, 2, map[string]string{"other": "0 ẹgbẹ̀rún"}},
+				{5, 3, map[string]string{"other": "0 ẹgbẹ̀rún"}},
+				{6, 1, map[string]string{"other": "0 mílíọ̀nù"}},
+				{7, 2, map[string]string{"other": "0 mílíọ̀nù"}},
+				{8, 3, map[string]string{"other": "0 mílíọ̀nù"}},
+				{9, 1, map[string]string{"other": "0 bilíọ̀nù"}},
+				{10, 2, map[string]string{"other": "0 bilíọ̀nù"}},
+				{11, 3, map[string]string{"other": "0 bilíọ̀nù"}},
+				{12, 1, map[string]string{"other": "0 tiriliọ̀nù"}},
+				{13, 2, map[string]string{"other": "0 tiriliọ̀nù"}},
+				{14, 3, map[string]string{"other": "0 tiriliọ̀nù"}},
+			},
+			[]CompactFormat{
+				{3, 1, map[string]string{"other": "¤0K"}},
+				{4, 2, map[string]string{"other": "¤0K"}},
+				{5, 3, map[string]string{"other": "¤0K"}},
+				{6, 1, map[string]string{"other": "¤0M"}},
+				{7, 2, map[string]string{"other": "¤0M"}},
+				{8, 3, map[string]string{"other": "¤0M"}},
+				{9, 1, map[string]string{"other": "¤0B"}},
+				{10, 2, map[string]string{"other": "¤0B"}},
+				{11, 3, map[string]string{"other": "¤0B"}},
+				{12, 1, map[string]string{"other": "¤0T"}},
+				{13, 2, map[string]string{"other": "¤0T"}},
+				{14, 3, map[string]string{"other": "¤0T"}},
+			},
+		},
+		PluralForms{"", "", "", "", "", "{0} {1}"},
+		MiscPatterns{"~{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+		CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", "\u00a0"}},
 	},
 	map[string]CurrencyData{
-		"AED": {2, "AED", "AED", "AED"},
-		"AFN": {0, "AFN", "AFN", "؋"},
-		"ALL": {0, "ALL", "ALL", "ALL"},
-		"AMD": {2, "AMD", "AMD", "֏"},
-		"ANG": {2, "ANG", "ANG", "ANG"},
-		"AOA": {2, "AOA", "AOA", "Kz"},
-		"ARS": {2, "ARS", "ARS", "$"},
-		"AUD": {2, "AUD", "A$", "$"},
-		"AWG": {2, "AWG", "AWG", "AWG"},
-		"AZN": {2, "AZN", "AZN", "₼"},
-		"BAM": {2, "BAM", "BAM", "KM"},
-		"BBD": {2, "BBD", "BBD", "$"},
-		"BDT": {2, "BDT", "BDT", "৳"},
-		"BGN": {2, "BGN", "BGN", "BGN"},
-		"BHD": {3, "BHD", "BHD", "BHD"},
-		"BIF": {0, "BIF", "BIF", "BIF"},
-		"BMD": {2, "BMD", "BMD", "$"},
-		"BND": {2, "BND", "BND", "$"},
-		"BOB": {2, "BOB", "BOB", "Bs"},
-		"BRL": {2, "BRL", "R$", "R$"},
-		"BSD": {2, "BSD", "BSD", "$"},
-		"BTN": {2, "BTN", "BTN", "BTN"},
-		"BWP": {2, "BWP", "BWP", "P"},
-		"BYN": {2, "BYN", "BYN", "BYN"},
-		"BZD": {2, "BZD", "BZD", "$"},
-		"CAD": {2, "CAD", "CA$", "$"},
-		"CDF": {2, "CDF", "CDF", "CDF"},
-		"CHF": {2, "CHF", "CHF", "CHF"},
-		"CLP": {0, "CLP", "CLP", "$"},
-		"CNH": {2, "CNH", "CNH", "CNH"},
-		"CNY": {2, "CNY", "CN¥", "¥"},
-		"COP": {0, "COP", "COP", "$"},
-		"CRC": {2, "CRC", "CRC", "₡"},
-		"CUC": {2, "CUC", "CUC", "$"},
-		"CUP": {2, "CUP", "CUP", "$"},
-		"CVE": {2, "CVE", "CVE", "CVE"},
-		"CZK": {2, "CZK", "CZK", "Kč"},
-		"DJF": {0, "DJF", "DJF", "DJF"},
-		"DKK": {2, "DKK", "DKK", "kr"},
-		"DOP": {2, "DOP", "DOP", "$"},
-		"DZD": {2, "DZD", "DZD", "DZD"},
-		"EGP": {2, "EGP", "EGP", "E£"},
-		"ERN": {2, "ERN", "ERN", "ERN"},
-		"ESP": {0, "ESP", "ESP", "₧"},
-		"ETB": {2, "ETB", "ETB", "ETB"},
-		"EUR": {2, "EUR", "€", "€"},
-		"FJD": {2, "FJD", "FJD", "$"},
-		"FKP": {2, "FKP", "FKP", "£"},
-		"GBP": {2, "GBP", "£", "£"},
-		"GEL": {2, "GEL", "GEL", "₾"},
-		"GHC": {2, "GHC", "GHC", "GHC"},
-		"GHS": {2, "GHS", "GHS", "GH₵"},
-		"GIP": {2, "GIP", "GIP", "£"},
-		"GMD": {2, "GMD", "GMD", "GMD"},
-		"GNF": {0, "GNF", "GNF", "FG"},
-		"GNS": {2, "GNS", "GNS", "GNS"},
-		"GTQ": {2, "GTQ", "GTQ", "Q"},
-		"GYD": {2, "GYD", "GYD", "$"},
-		"HKD": {2, "HKD", "HK$", "$"},
-		"HNL": {2, "HNL", "HNL", "L"},
-		"HRK": {2, "HRK", "HRK", "kn"},
-		"HTG": {2, "HTG", "HTG", "HTG"},
-		"HUF": {0, "HUF", "HUF", "Ft"},
-		"IDR": {0, "IDR", "IDR", "Rp"},
-		"ILS": {2, "ILS", "₪", "₪"},
-		"INR": {2, "INR", "₹", "₹"},
-		"IQD": {0, "IQD", "IQD", "IQD"},
-		"IRR": {0, "IRR", "IRR", "IRR"},
-		"ISK": {0, "ISK", "ISK", "kr"},
-		"JMD": {2, "JMD", "JMD", "$"},
-		"JOD": {3, "JOD", "JOD", "JOD"},
-		"JPY": {0, "JPY", "JP¥", "¥"},
-		"KES": {2, "KES", "KES", "KES"},
-		"KGS": {2, "KGS", "KGS", "⃀"},
-		"KHR": {2, "KHR", "KHR", "៛"},
-		"KMF": {0, "KMF", "KMF", "CF"},
-		"KPW": {0, "KPW", "KPW", "₩"},
-		"KRW": {0, "KRW", "₩", "₩"},
-		"KWD": {3, "KWD", "KWD", "KWD"},
-		"KYD": {2, "KYD", "KYD", "$"},
-		"KZT": {2, "KZT", "KZT", "₸"},
-		"LAK": {0, "LAK", "LAK", "₭"},
-		"LBP": {0, "LBP", "LBP", "L£"},
-		"LKR": {2, "LKR", "LKR", "Rs"},
-		"LRD": {2, "LRD", "LRD", "$"},
-		"LSL": {2, "LSL", "LSL", "LSL"},
-		"LTL": {2, "LTL", "LTL", "Lt"},
-		"LVL": {2, "LVL", "LVL", "Ls"},
-		"LYD": {3, "LYD", "LYD", "LYD"},
-		"MAD": {2, "MAD", "MAD", "MAD"},
-		"MDL": {2, "MDL", "MDL", "MDL"},
-		"MGA": {0, "MGA", "MGA", "Ar"},
-		"MKD": {2, "MKD", "MKD", "MKD"},
-		"MMK": {0, "MMK", "MMK", "K"},
-		"MNT": {2, "MNT", "MNT", "₮"},
-		"MOP": {2, "MOP", "MOP", "MOP"},
-		"MRO": {0, "MRO", "MRO", "MRO"},
-		"MRU": {2, "MRU", "MRU", "MRU"},
-		"MUR": {2, "MUR", "MUR", "Rs"},
-		"MVR": {2, "MVR", "MVR", "MVR"},
-		"MWK": {2, "MWK", "MWK", "MWK"},
-		"MXN": {2, "MXN", "MX$", "$"},
-		"MYR": {2, "MYR", "MYR", "RM"},
-		"MZM": {2, "MZM", "MZM", "MZM"},
-		"MZN": {2, "MZN", "MZN", "MZN"},
-		"NAD": {2, "NAD", "NAD", "$"},
-		"NGN": {2, "NGN", "₦", "₦"},
-		"NIO": {2, "NIO", "NIO", "C$"},
-		"NOK": {2, "NOK", "NOK", "kr"},
-		"NPR": {2, "NPR", "NPR", "Rs"},
-		"NZD": {2, "NZD", "NZ$", "$"},
-		"OMR": {3, "OMR", "OMR", "OMR"},
-		"PAB": {2, "PAB", "PAB", "PAB"},
-		"PEN": {2, "PEN", "PEN", "PEN"},
-		"PGK": {2, "PGK", "PGK", "PGK"},
-		"PHP": {2, "PHP", "₱", "₱"},
-		"PKR": {0, "PKR", "PKR", "Rs"},
-		"PLN": {2, "PLN", "PLN", "zł"},
-		"PYG": {0, "PYG", "PYG", "₲"},
-		"QAR": {2, "QAR", "QAR", "QAR"},
-		"RON": {2, "RON", "RON", "lei"},
-		"RSD": {2, "RSD", "RSD", "RSD"},
-		"RUB": {2, "RUB", "₽", "₽"},
-		"RWF": {0, "RWF", "RWF", "RF"},
-		"SAR": {2, "SAR", "SAR", "SAR"},
-		"SBD": {2, "SBD", "SBD", "$"},
-		"SCR": {2, "SCR", "SCR", "SCR"},
-		"SDG": {2, "SDG", "SDG", "SDG"},
-		"SDP": {2, "SDP", "SDP", "SDP"},
-		"SEK": {2, "SEK", "SEK", "kr"},
-		"SGD": {2, "SGD", "SGD", "$"},
-		"SHP": {2, "SHP", "SHP", "£"},
-		"SLE": {2, "SLE", "SLE", "SLE"},
-		"SLL": {0, "SLL", "SLL", "SLL"},
-		"SOS": {0, "SOS", "SOS", "SOS"},
-		"SRD": {2, "SRD", "SRD", "$"},
-		"SSP": {2, "SSP", "SSP", "£"},
-		"STD": {0, "STD", "STD", "STD"},
-		"STN": {2, "STN", "STN", "Db"},
-		"SYP": {0, "SYP", "SYP", "£"},
-		"SZL": {2, "SZL", "SZL", "SZL"},
-		"THB": {2, "THB", "THB", "฿"},
-		"TJS": {2, "TJS", "TJS", "TJS"},
-		"TMT": {2, "TMT", "TMT", "TMT"},
-		"TND": {3, "TND", "TND", "TND"},
-		"TOP": {2, "TOP", "TOP", "T$"},
-		"TRY": {2, "TRY", "TRY", "₺"},
-		"TTD": {2, "TTD", "TTD", "$"},
-		"TWD": {2, "TWD", "NT$", "$"},
-		"TZS": {2, "TZS", "TZS", "TZS"},
-		"UAH": {2, "UAH", "UAH", "₴"},
-		"UGX": {0, "UGX", "UGX", "UGX"},
-		"USD": {2, "USD", "$", "$"},
-		"UYU": {2, "UYU", "UYU", "$"},
-		"UZS": {2, "UZS", "UZS", "UZS"},
-		"VEF": {2, "VEF", "VEF", "Bs"},
-		"VES": {2, "VES", "VES", "VES"},
-		"VND": {0, "VND", "₫", "₫"},
-		"VUV": {0, "VUV", "VUV", "VUV"},
-		"WST": {2, "WST", "WST", "WST"},
-		"XAF": {0, "XAF", "FCFA", "FCFA"},
-		"XCD": {2, "XCD", "EC$", "$"},
-		"XCG": {2, "XCG", "Cg.", "Cg."},
-		"XOF": {0, "XOF", "F\u202fCFA", "F\u202fCFA"},
-		"XPF": {0, "XPF", "CFPF", "CFPF"},
-		"XXX": {2, "XXX", "¤", "¤"},
-		"YER": {0, "YER", "YER", "YER"},
-		"ZAR": {2, "ZAR", "ZAR", "R"},
-		"ZMK": {0, "ZMK", "ZMK", "ZMK"},
-		"ZMW": {2, "ZMW", "ZMW", "ZK"},
-		"ZWD": {0, "ZWD", "ZWD", "ZWD"},
-		"ZWG": {2, "ZWG", "ZWG", "ZWG"},
+		"AED": {2, 0, 2, 1, "AED", "AED", "AED", "Diami ti Awon Orílẹ́ède Arabu", PluralForms{"", "", "", "", "", ""}},
+		"AFN": {0, 0, 0, 1, "AFN", "AFN", "؋", "Afugánì Afuganísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"ALL": {0, 0, 0, 1, "ALL", "ALL", "ALL", "Lẹ́ẹ̀kì Àlìbáníà", PluralForms{"", "", "", "", "", "lẹ́kè Àlìbéníà"}},
+		"AMD": {2, 0, 0, 1, "AMD", "AMD", "֏", "Dírààmù Àmẹ́níà", PluralForms{"", "", "", "", "", ""}},
+		"ANG": {2, 0, 2, 1, "ANG", "ANG", "ANG", "Gílídà Netherlands Antillean", PluralForms{"", "", "", "", "", "àwọn gílídà Netherlands Antillean"}},
+		"AOA": {2, 0, 2, 1, "AOA", "AOA", "Kz", "kíwánsà Angola", PluralForms{"", "", "", "", "", "àwọn kíwánsà Angola"}},
+		"ARS": {2, 0, 2, 1, "ARS", "ARS", "$", "Pẹ́sò Agẹntínà", PluralForms{"", "", "", "", "", "àwọn pẹ́sò Agẹntínà"}},
+		"AUD": {2, 0, 2, 1, "AUD", "A$", "$", "Dọla ti Orílẹ́ède Ástràlìá", PluralForms{"", "", "", "", "", ""}},
+		"AWG": {2, 0, 2, 1, "AWG", "AWG", "AWG", "Fuloríìnì Àrúbà", PluralForms{"", "", "", "", "", "àwọn fuloríìnì Àrúbà"}},
+		"AZN": {2, 0, 2, 1, "AZN", "AZN", "₼", "Mánààtì Àsàbáíjáì", PluralForms{"", "", "", "", "", ""}},
+		"BAM": {2, 0, 2, 1, "BAM", "BAM", "KM", "Àmi Yíyípadà Bosnia-Herzegovina", PluralForms{"", "", "", "", "", "àwọn àmi Yíyípadà Bosnia-Herzegovina"}},
+		"BBD": {2, 0, 2, 1, "BBD", "BBD", "$", "Dọ́là Bábádọ̀ọ̀sì", PluralForms{"", "", "", "", "", "àwọn dọ́là Bábádọ̀ọ̀sì"}},
+		"BDT": {2, 0, 2, 1, "BDT", "BDT", "৳", "Tákà Báńgíládẹ̀ẹ̀ṣì", PluralForms{"", "", "", "", "", ""}},
+		"BGN": {2, 0, 2, 1, "BGN", "BGN", "BGN", "Owó Lẹ́fì Bọ̀lìgéríà", PluralForms{"", "", "", "", "", "Lẹ́fà Bọ̀lìgéríà"}},
+		"BHD": {3, 0, 3, 1, "BHD", "BHD", "BHD", "Dina ti Orílẹ́ède Báránì", PluralForms{"", "", "", "", "", ""}},
+		"BIF": {0, 0, 0, 1, "BIF", "BIF", "BIF", "Faransi Bùùrúndì", PluralForms{"", "", "", "", "", "àwọn faransi Bùùrúndì"}},
+		"BMD": {2, 0, 2, 1, "BMD", "BMD", "$", "Dọ́là Bẹ̀múdà", PluralForms{"", "", "", "", "", "àwọ́n dọ́là Bẹ̀múdà"}},
+		"BND": {2, 0, 2, 1, "BND", "BND", "$", "Dọ́là Bùrùnéì", PluralForms{"", "", "", "", "", ""}},
+		"BOB": {2, 0, 2, 1, "BOB", "BOB", "Bs", "Bọlifiánò Bọ̀lífíà", PluralForms{"", "", "", "", "", "àwọn bọlifiánò Bọ̀lífíà"}},
+		"BRL": {2, 0, 2, 1, "BRL", "R$", "R$", "Owó ti Orílẹ̀-èdè Brazil", PluralForms{"", "", "", "", "", "Awon owó ti Orílẹ̀-èdè Brazil"}},
+		"BSD": {2, 0, 2, 1, "BSD", "BSD", "$", "Dọ́là Bàhámà", PluralForms{"", "", "", "", "", "àwọn dọ́là Bàhámà"}},
+		"BTN": {2, 0, 2, 1, "BTN", "BTN", "BTN", "Ìngọ́tírọ̀mù Bútàànì", PluralForms{"", "", "", "", "", ""}},
+		"BWP": {2, 0, 2, 1, "BWP", "BWP", "P", "Pula Bọ̀tìsúwánà", PluralForms{"", "", "", "", "", "àwọn pula Bọ̀tìsúwánà"}},
+		"BYN": {2, 0, 2, 1, "BYN", "BYN", "BYN", "Rọ́bù Bẹ̀lárùùsì", PluralForms{"", "", "", "", "", "àwọn rọ́bù Bẹ̀lárùùsì"}},
+		"BZD": {2, 0, 2, 1, "BZD", "BZD", "$", "Dọ́là Bẹ̀lísè", PluralForms{"", "", "", "", "", "àwọn Dọ́là Bẹ́lìsè"}},
+		"CAD": {2, 0, 2, 5, "CAD", "CA$", "$", "Dọ́là Kánádà", PluralForms{"", "", "", "", "", "àwọn dọ́là Kánádà"}},
+		"CDF": {2, 0, 2, 1, "CDF", "CDF", "CDF", "Firanki Kongo", PluralForms{"", "", "", "", "", "àwọn firanki Kongo"}},
+		"CHF": {2, 0, 2, 5, "CHF", "CHF", "CHF", "Faransí Síwíìsì", PluralForms{"", "", "", "", "", "Faransi Siwisi"}},
+		"CLP": {0, 0, 0, 1, "CLP", "CLP", "$", "Pẹ́sò Ṣílè", PluralForms{"", "", "", "", "", "àwọn pẹ́sò Ṣílè"}},
+		"CNH": {2, 0, 2, 1, "CNH", "CNH", "CNH", "Yúànì Sháínà", PluralForms{"", "", "", "", "", ""}},
+		"CNY": {2, 0, 2, 1, "CNY", "CN¥", "¥", "Reminibi ti Orílẹ́ède ṣáínà", PluralForms{"", "", "", "", "", ""}},
+		"COP": {0, 0, 0, 1, "COP", "COP", "$", "Pẹ́sò Kòlóḿbíà", PluralForms{"", "", "", "", "", "àwọn pẹ́sò Kòlóḿbíà"}},
+		"CRC": {2, 0, 0, 1, "CRC", "CRC", "₡", "Kólọ́ọ̀nì Kosita Ríkà", PluralForms{"", "", "", "", "", "àwọ́n kólọ́ọ̀nì Kosita Ríkà"}},
+		"CUC": {2, 0, 2, 1, "CUC", "CUC", "$", "Pẹ́sò Yíyípadà Kúbà", PluralForms{"", "", "", "", "", "àwọn pẹ́sò yíyípadà Kúbà"}},
+		"CUP": {2, 0, 2, 1, "CUP", "CUP", "$", "Pẹ́sò Kúbà", PluralForms{"", "", "", "", "", ""}},
+		"CVE": {2, 0, 2, 1, "CVE", "CVE", "CVE", "Èsìkúdò Kapú Faadì", PluralForms{"", "", "", "", "", "àwọn èsìkúdò Kapú Faadì"}},
+		"CZK": {2, 0, 0, 1, "CZK", "CZK", "Kč", "Koruna Ṣẹ́ẹ̀kì", PluralForms{"", "", "", "", "", "àwọn koruna Ṣẹ́ẹ̀kì"}},
+		"DJF": {0, 0, 0, 1, "DJF", "DJF", "DJF", "Faransi Dibouti", PluralForms{"", "", "", "", "", "àwọn faransi Dibouti"}},
+		"DKK": {2, 0, 2, 50, "DKK", "DKK", "kr", "Kírónì Dáníṣì", PluralForms{"", "", "", "", "", "Kírònà Dáníìṣì"}},
+		"DOP": {2, 0, 2, 1, "DOP", "DOP", "$", "Pẹ́sò Dòníníkà", PluralForms{"", "", "", "", "", "àwọn pẹ́sò Dòníníkà"}},
+		"DZD": {2, 0, 2, 1, "DZD", "DZD", "DZD", "Dina ti Orílẹ́ède Àlùgèríánì", PluralForms{"", "", "", "", "", "àwọn dínà Àlùgèríánì"}},
+		"EGP": {2, 0, 2, 1, "EGP", "EGP", "E£", "pọọn ti Orílẹ́ède Egipiti", PluralForms{"", "", "", "", "", "àwọn pọ́n-ún Ejipítì"}},
+		"ERN": {2, 0, 2, 1, "ERN", "ERN", "ERN", "Nakifasì Eritira", PluralForms{"", "", "", "", "", "àwọn nakifasì Eritira"}},
+		"ESP": {0, 0, 0, 1, "ESP", "ESP", "₧", "ESP", PluralForms{"", "", "", "", "", ""}},
+		"ETB": {2, 0, 2, 1, "ETB", "ETB", "ETB", "Báà Etópíà", PluralForms{"", "", "", "", "", "àwọn báà Etópíà"}},
+		"EUR": {2, 0, 2, 1, "EUR", "€", "€", "owó Yúrò", PluralForms{"", "", "", "", "", "Awon owó Yúrò"}},
+		"FJD": {2, 0, 2, 1, "FJD", "FJD", "$", "Dọ́là Fíjì", PluralForms{"", "", "", "", "", ""}},
+		"FKP": {2, 0, 2, 1, "FKP", "FKP", "£", "Pọ́n-ùn Erékùsù Falkland", PluralForms{"", "", "", "", "", "àwọn Pọ́n-ùn Erékùsù Falkland [ Pɔ́n-ùn Erékùsù Falkland ] 1.23 Pọ́n-ùn Erékùsù Falkland 0.00 pọ́n-ùn Erékùsù Falkland"}},
+		"GBP": {2, 0, 2, 1, "GBP", "£", "£", "Pọ́n-ùn ti Orilẹ̀-èdè Gẹ̀ẹ́sì", PluralForms{"", "", "", "", "", ""}},
+		"GEL": {2, 0, 2, 1, "GEL", "GEL", "₾", "Lárì Jọ́jíà", PluralForms{"", "", "", "", "", ""}},
+		"GHC": {2, 0, 2, 1, "GHC", "GHC", "GHC", "ṣidi ti Orílẹ́ède Gana", PluralForms{"", "", "", "", "", ""}},
+		"GHS": {2, 0, 2, 1, "GHS", "GHS", "GH₵", "sídì Gana", PluralForms{"", "", "", "", "", "àwọn sídì Gana"}},
+		"GIP": {2, 0, 2, 1, "GIP", "GIP", "£", "Pọ́n-ùn Gibúrátà", PluralForms{"", "", "", "", "", "àwọn pọ́n-ùn Gibúrátà"}},
+		"GMD": {2, 0, 2, 1, "GMD", "GMD", "GMD", "Dalasi Gamibia", PluralForms{"", "", "", "", "", "àwọn dalasi Gamibia"}},
+		"GNF": {0, 0, 0, 1, "GNF", "GNF", "FG", "Fírànkì Gínì", PluralForms{"", "", "", "", "", "àwọn fírànkì Gínì"}},
+		"GNS": {2, 0, 2, 1, "GNS", "GNS", "GNS", "Faransi ti Orílẹ́ède Gini", PluralForms{"", "", "", "", "", ""}},
+		"GTQ": {2, 0, 2, 1, "GTQ", "GTQ", "Q", "Kúẹ́tísààlì Guatimílà", PluralForms{"", "", "", "", "", "àwọn kúẹ́tísààlì Guatimílà"}},
+		"GYD": {2, 0, 0, 1, "GYD", "GYD", "$", "Dọ́là Gùyánà", PluralForms{"", "", "", "", "", "àwọn dọ́là Gùyánà"}},
+		"HKD": {2, 0, 2, 1, "HKD", "HK$", "$", "Dọ́là Hong Kong", PluralForms{"", "", "", "", "", ""}},
+		"HNL": {2, 0, 2, 1, "HNL", "HNL", "L", "Lẹmipírà Ọ́ńdúrà", PluralForms{"", "", "", "", "", "àwọn Lẹmipírà Ọ́ńdúrà"}},
+		"HRK": {2, 0, 2, 1, "HRK", "HRK", "kn", "Kúnà Kúróṣíà", PluralForms{"", "", "", "", "", "àwọn kúnà Kúróṣíà"}},
+		"HTG": {2, 0, 2, 1, "HTG", "HTG", "HTG", "Gọ́dì Àítì", PluralForms{"", "", "", "", "", "àwọn gọ́dì Àítì"}},
+		"HUF": {0, 0, 0, 1, "HUF", "HUF", "Ft", "Fọ́ríǹtì Họ̀ngérí", PluralForms{"", "", "", "", "", "àwọn fọ́ríǹtì Họ̀ngérí"}},
+		"IDR": {0, 0, 0, 1, "IDR", "IDR", "Rp", "Rúpìyá Indonésíà", PluralForms{"", "", "", "", "", ""}},
+		"ILS": {2, 0, 2, 1, "ILS", "₪", "₪", "Ṣékélì Tuntun Ísírẹ̀ẹ̀lì", PluralForms{"", "", "", "", "", ""}},
+		"INR": {2, 0, 2, 1, "INR", "₹", "₹", "Rupi ti Orílẹ́ède Indina", PluralForms{"", "", "", "", "", ""}},
+		"IQD": {0, 0, 0, 1, "IQD", "IQD", "IQD", "Dínárì Ìráákì", PluralForms{"", "", "", "", "", ""}},
+		"IRR": {0, 0, 0, 1, "IRR", "IRR", "IRR", "Rial Iranian", PluralForms{"", "", "", "", "", ""}},
+		"ISK": {0, 0, 0, 1, "ISK", "ISK", "kr", "Kòrónà Icelandic", PluralForms{"", "", "", "", "", "kórónọ̀ Áílándíìkì"}},
+		"JMD": {2, 0, 2, 1, "JMD", "JMD", "$", "Dọ́là Jàmáíkà", PluralForms{"", "", "", "", "", "àwọn dọ́là Jàmáíkà"}},
+		"JOD": {3, 0, 3, 1, "JOD", "JOD", "JOD", "Dínárì Jọ́dàànì", PluralForms{"", "", "", "", "", ""}},
+		"JPY": {0, 0, 0, 1, "JPY", "JP¥", "¥", "Yeni ti Orílẹ́ède Japani", PluralForms{"", "", "", "", "", ""}},
+		"KES": {2, 0, 2, 1, "KES", "KES", "KES", "Ṣiili Kenya", PluralForms{"", "", "", "", "", "àwọ́n ṣiili Kenya"}},
+		"KGS": {2, 0, 2, 1, "KGS", "KGS", "⃀", "Sómú Kirijísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"KHR": {2, 0, 2, 1, "KHR", "KHR", "៛", "Ráyò Kàm̀bọ́díà", PluralForms{"", "", "", "", "", ""}},
+		"KMF": {0, 0, 0, 1, "KMF", "KMF", "CF", "Faransi Komori", PluralForms{"", "", "", "", "", "àwọn faransi Komori"}},
+		"KPW": {0, 0, 0, 1, "KPW", "KPW", "₩", "Wọ́ọ̀nù Àríwá Kòríà", PluralForms{"", "", "", "", "", ""}},
+		"KRW": {0, 0, 0, 1, "KRW", "₩", "₩", "Wọ́ọ̀nù Gúúsù Kòríà", PluralForms{"", "", "", "", "", ""}},
+		"KWD": {3, 0, 3, 1, "KWD", "KWD", "KWD", "Dínárì Kuwaiti", PluralForms{"", "", "", "", "", ""}},
+		"KYD": {2, 0, 2, 1, "KYD", "KYD", "$", "Dọ́là Erékùsù Cayman", PluralForms{"", "", "", "", "", "àwọn dọ́là Erékùsù Cayman"}},
+		"KZT": {2, 0, 2, 1, "KZT", "KZT", "₸", "Tẹngé Kasakísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"LAK": {0, 0, 0, 1, "LAK", "LAK", "₭", "Kíììpù Làótì", PluralForms{"", "", "", "", "", ""}},
+		"LBP": {0, 0, 0, 1, "LBP", "LBP", "L£", "Pọ́n-ùn Lebanese", PluralForms{"", "", "", "", "", ""}},
+		"LKR": {2, 0, 2, 1, "LKR", "LKR", "Rs", "Rúpìì Siri Láńkà", PluralForms{"", "", "", "", "", ""}},
+		"LRD": {2, 0, 2, 1, "LRD", "LRD", "$", "Dọla Liberia", PluralForms{"", "", "", "", "", "àwọn dọla Liberia"}},
+		"LSL": {2, 0, 2, 1, "LSL", "LSL", "LSL", "Loti ti Orílẹ́ède Lesoto", PluralForms{"", "", "", "", "", "Lótì ti Lẹ̀sótò"}},
+		"LTL": {2, 0, 2, 1, "LTL", "LTL", "Lt", "LTL", PluralForms{"", "", "", "", "", ""}},
+		"LVL": {2, 0, 2, 1, "LVL", "LVL", "Ls", "LVL", PluralForms{"", "", "", "", "", ""}},
+		"LYD": {3, 0, 3, 1, "LYD", "LYD", "LYD", "Dínà Líbíyà", PluralForms{"", "", "", "", "", "àwọn dínà Líbíyà"}},
+		"MAD": {2, 0, 2, 1, "MAD", "MAD", "MAD", "Dírámì Morokò", PluralForms{"", "", "", "", "", "àwọn dírámì Morokò"}},
+		"MDL": {2, 0, 2, 1, "MDL", "MDL", "MDL", "Owó Léhù Moldovan", PluralForms{"", "", "", "", "", "Léhì Moldovan"}},
+		"MGA": {0, 0, 0, 1, "MGA", "MGA", "Ar", "Faransi Malagasi", PluralForms{"", "", "", "", "", "àwọn faransi Malagasi"}},
+		"MKD": {2, 0, 2, 1, "MKD", "MKD", "MKD", "Dẹ́nà Masidóníà", PluralForms{"", "", "", "", "", "dẹ́nàrì Masidóníà"}},
+		"MMK": {0, 0, 0, 1, "MMK", "MMK", "K", "Kíyàtì Myanmar", PluralForms{"", "", "", "", "", ""}},
+		"MNT": {2, 0, 0, 1, "MNT", "MNT", "₮", "Túgúrììkì Mòǹgólíà", PluralForms{"", "", "", "", "", ""}},
+		"MOP": {2, 0, 2, 1, "MOP", "MOP", "MOP", "Pàtákà Màkáò", PluralForms{"", "", "", "", "", ""}},
+		"MRO": {0, 0, 0, 1, "MRO", "MRO", "MRO", "Ouguiya ti Orílẹ́ède Maritania (1973–2017)", PluralForms{"", "", "", "", "", ""}},
+		"MRU": {2, 0, 2, 1, "MRU", "MRU", "MRU", "Ouguiya ti Orílẹ́ède Maritania", PluralForms{"", "", "", "", "", ""}},
+		"MUR": {2, 0, 0, 1, "MUR", "MUR", "Rs", "Rupi Maritusi", PluralForms{"", "", "", "", "", "àwọn rupi Maritusi"}},
+		"MVR": {2, 0, 2, 1, "MVR", "MVR", "MVR", "Rúfìyá Mọ̀lìdífà", PluralForms{"", "", "", "", "", ""}},
+		"MWK": {2, 0, 2, 1, "MWK", "MWK", "MWK", "Kásà Màláwì", PluralForms{"", "", "", "", "", "àwọn kásà Màláwì"}},
+		"MXN": {2, 0, 2, 1, "MXN", "MX$", "$", "Pẹ́sò Mẹ́síkò", PluralForms{"", "", "", "", "", "àwọn pẹ́sò Mẹ́síkò"}},
+		"MYR": {2, 0, 2, 1, "MYR", "MYR", "RM", "Ríngìtì Màléṣíà", PluralForms{"", "", "", "", "", ""}},
+		"MZM": {2, 0, 2, 1, "MZM", "MZM", "MZM", "Metika ti Orílẹ́ède Mosamibiki", PluralForms{"", "", "", "", "", ""}},
+		"MZN": {2, 0, 2, 1, "MZN", "MZN", "MZN", "Mẹ́tíkààlì Mòsáḿbíìkì", PluralForms{"", "", "", "", "", "àwọn mẹ́tíkààlì Mòsáḿbíìkì"}},
+		"NAD": {2, 0, 2, 1, "NAD", "NAD", "$", "Dọla Namibíà", PluralForms{"", "", "", "", "", "àwọn dọla Namibíà"}},
+		"NGN": {2, 0, 2, 1, "NGN", "₦", "₦", "Náírà Nàìjíríà", PluralForms{"", "", "", "", "", ""}},
+		"NIO": {2, 0, 2, 1, "NIO", "NIO", "C$", "Kọ̀dóbà Naikarágúà", PluralForms{"", "", "", "", "", "àwọn kọ̀dóbà Naikarágúà"}},
+		"NOK": {2, 0, 0, 1, "NOK", "NOK", "kr", "kórónì Nọ́wè", PluralForms{"", "", "", "", "", "kórónà Nọ́wè"}},
+		"NPR": {2, 0, 2, 1, "NPR", "NPR", "Rs", "Rúpìì Nẹ̵́pààlì", PluralForms{"", "", "", "", "", ""}},
+		"NZD": {2, 0, 2, 1, "NZD", "NZ$", "$", "Dọ́là New Zealand", PluralForms{"", "", "", "", "", ""}},
+		"OMR": {3, 0, 3, 1, "OMR", "OMR", "OMR", "Ráyò Omani", PluralForms{"", "", "", "", "", ""}},
+		"PAB": {2, 0, 2, 1, "PAB", "PAB", "PAB", "Bálíbóà Pànámà", PluralForms{"", "", "", "", "", "àwọn bálíbóà Pànámà"}},
+		"PEN": {2, 0, 2, 1, "PEN", "PEN", "PEN", "Sólì Pèrúù", PluralForms{"", "", "", "", "", "àwọn sólì Pèrúù"}},
+		"PGK": {2, 0, 2, 1, "PGK", "PGK", "PGK", "Kínà Papua Guinea Tuntun", PluralForms{"", "", "", "", "", ""}},
+		"PHP": {2, 0, 2, 1, "PHP", "₱", "₱", "Písò Fílípìnì", PluralForms{"", "", "", "", "", ""}},
+		"PKR": {0, 0, 0, 1, "PKR", "PKR", "Rs", "Rúpìì Pakisitánì", PluralForms{"", "", "", "", "", ""}},
+		"PLN": {2, 0, 2, 1, "PLN", "PLN", "zł", "Sílọ̀tì Pọ́líṣì", PluralForms{"", "", "", "", "", "àwọn sílọ̀tì Pọ́líṣì"}},
+		"PYG": {0, 0, 0, 1, "PYG", "PYG", "₲", "Gúáránì Párágúwè", PluralForms{"", "", "", "", "", "àwọn gúáránì Párágúwè"}},
+		"QAR": {2, 0, 2, 1, "QAR", "QAR", "QAR", "Ráyò Kàtárì", PluralForms{"", "", "", "", "", ""}},
+		"RON": {2, 0, 2, 1, "RON", "RON", "lei", "Léhù Ròméníà", PluralForms{"", "", "", "", "", "Léhì Ròméníà"}},
+		"RSD": {2, 0, 0, 1, "RSD", "RSD", "RSD", "Dínárì Sàbíà", PluralForms{"", "", "", "", "", "àwọn dínárì Sàbíà"}},
+		"RUB": {2, 0, 2, 1, "RUB", "₽", "₽", "Owó ruble ti ilẹ̀ Rọ́ṣíà", PluralForms{"", "", "", "", "", ""}},
+		"RWF": {0, 0, 0, 1, "RWF", "RWF", "RF", "Faransi Ruwanda", PluralForms{"", "", "", "", "", "àwọn faransi Ruwanda"}},
+		"SAR": {2, 0, 2, 1, "SAR", "SAR", "SAR", "Riya ti Orílẹ́ède Saudi", PluralForms{"", "", "", "", "", ""}},
+		"SBD": {2, 0, 2, 1, "SBD", "SBD", "$", "Dọ́là Erékùsù Sọ́lómọ́nì", PluralForms{"", "", "", "", "", ""}},
+		"SCR": {2, 0, 2, 1, "SCR", "SCR", "SCR", "Rúpì Sayiselesi", PluralForms{"", "", "", "", "", "àwọ́n rúpì Sayiselesi"}},
+		"SDG": {2, 0, 2, 1, "SDG", "SDG", "SDG", "Pọ́n-ùn Sùdáànì", PluralForms{"", "", "", "", "", "àwọn pọ́n-ùn Sùdáànì"}},
+		"SDP": {2, 0, 2, 1, "SDP", "SDP", "SDP", "Pọọun ti Orílẹ́ède Sudani", PluralForms{"", "", "", "", "", ""}},
+		"SEK": {2, 0, 0, 1, "SEK", "SEK", "kr", "Kòrónà Súwídìn", PluralForms{"", "", "", "", "", "Kòrónọ̀ Súwídìn"}},
+		"SGD": {2, 0, 2, 1, "SGD", "SGD", "$", "Dọ́là Síngápọ̀", PluralForms{"", "", "", "", "", ""}},
+		"SHP": {2, 0, 2, 1, "SHP", "SHP", "£", "Pọ́n-un Elena", PluralForms{"", "", "", "", "", "àwọn pọ́n-un Elena"}},
+		"SLE": {2, 0, 2, 1, "SLE", "SLE", "SLE", "Líónì Sira Líonì", PluralForms{"", "", "", "", "", "àwọn líónì Sira Líonì"}},
+		"SLL": {0, 0, 0, 1, "SLL", "SLL", "SLL", "Líónì Sira Líonì (1964—2022)", PluralForms{"", "", "", "", "", "àwọn líónì Sira Líonì (1964—2022)"}},
+		"SOS": {0, 0, 0, 1, "SOS", "SOS", "SOS", "Ṣílè Somali", PluralForms{"", "", "", "", "", "àwọ́n ṣílè Somali"}},
+		"SRD": {2, 0, 2, 1, "SRD", "SRD", "$", "Dọ́là Súrínámì", PluralForms{"", "", "", "", "", "àwọn Dọ́là Súrínámì"}},
+		"SSP": {2, 0, 2, 1, "SSP", "SSP", "£", "Pọ́n-un Gúúsù Sùdáànì", PluralForms{"", "", "", "", "", "àwọn pọ́n-un Gúúsù Sùdáànì"}},
+		"STD": {0, 0, 0, 1, "STD", "STD", "STD", "Dobira ti Orílẹ́ède Sao tome Ati Pirisipe (1977–2017)", PluralForms{"", "", "", "", "", ""}},
+		"STN": {2, 0, 2, 1, "STN", "STN", "Db", "Dọbíra Sao tome àti Pirisipi", PluralForms{"", "", "", "", "", ""}},
+		"SYP": {0, 0, 0, 1, "SYP", "SYP", "£", "Pọ́n-ùn Sírìà", PluralForms{"", "", "", "", "", ""}},
+		"SZL": {2, 0, 2, 1, "SZL", "SZL", "SZL", "Lilangeni Suwasi", PluralForms{"", "", "", "", "", "emalangeni Suwasi"}},
+		"THB": {2, 0, 2, 1, "THB", "THB", "฿", "Báàtì Tháì", PluralForms{"", "", "", "", "", ""}},
+		"TJS": {2, 0, 2, 1, "TJS", "TJS", "TJS", "Sómónì Tajikístàànì", PluralForms{"", "", "", "", "", ""}},
+		"TMT": {2, 0, 2, 1, "TMT", "TMT", "TMT", "Mánààtì Tọkimẹnístàànì", PluralForms{"", "", "", "", "", ""}},
+		"TND": {3, 0, 3, 1, "TND", "TND", "TND", "Dínà Tuniṣíà", PluralForms{"", "", "", "", "", "àwọn dínà Tuniṣíà"}},
+		"TOP": {2, 0, 2, 1, "TOP", "TOP", "T$", "Pàángà Tóńgà", PluralForms{"", "", "", "", "", ""}},
+		"TRY": {2, 0, 2, 1, "TRY", "TRY", "₺", "Lírà Tọ́kì", PluralForms{"", "", "", "", "", ""}},
+		"TTD": {2, 0, 2, 1, "TTD", "TTD", "$", "Dọ́là Trinidad & Tobago", PluralForms{"", "", "", "", "", "àwọn dọ́là Trinidad àti Tobago"}},
+		"TWD": {2, 0, 0, 1, "TWD", "NT$", "$", "Dọ́là Tàìwánì Tuntun", PluralForms{"", "", "", "", "", ""}},
+		"TZS": {2, 0, 0, 1, "TZS", "TZS", "TZS", "Sile Tansania", PluralForms{"", "", "", "", "", "àwọn ṣile Tansania"}},
+		"UAH": {2, 0, 2, 1, "UAH", "UAH", "₴", "Ọrifiníyà Yukiréníà", PluralForms{"", "", "", "", "", ""}},
+		"UGX": {0, 0, 0, 1, "UGX", "UGX", "UGX", "Ṣile Uganda", PluralForms{"", "", "", "", "", "àwọn ṣile Uganda"}},
+		"USD": {2, 0, 2, 1, "USD", "$", "$", "Dọ́là", PluralForms{"", "", "", "", "", ""}},
+		"UYU": {2, 0, 2, 1, "UYU", "UYU", "$", "Pẹ́sò Úrúgúwè", PluralForms{"", "", "", "", "", "àwọn pẹ́sò Úrúgúwè"}},
+		"UZS": {2, 0, 0, 1, "UZS", "UZS", "UZS", "Sómú Usibẹkísítàànì", PluralForms{"", "", "", "", "", ""}},
+		"VEF": {2, 0, 2, 1, "VEF", "VEF", "Bs", "VEF", PluralForms{"", "", "", "", "", ""}},
+		"VES": {2, 0, 2, 1, "VES", "VES", "VES", "Bọ̀lífà Fẹnẹsuẹ́là", PluralForms{"", "", "", "", "", "àwọn bọ̀lífà Fẹnẹsuẹ́là"}},
+		"VND": {0, 0, 0, 1, "VND", "₫", "₫", "Dáhùn Vietnamese", PluralForms{"", "", "", "", "", ""}},
+		"VUV": {0, 0, 0, 1, "VUV", "VUV", "VUV", "Fátù Vanuatu", PluralForms{"", "", "", "", "", ""}},
+		"WST": {2, 0, 2, 1, "WST", "WST", "WST", "Tálà Sàmóà", PluralForms{"", "", "", "", "", ""}},
+		"XAF": {0, 0, 0, 1, "XAF", "FCFA", "FCFA", "Firanki àárín Afíríkà", PluralForms{"", "", "", "", "", "àwọn firanki àárín Afíríkà"}},
+		"XCD": {2, 0, 2, 1, "XCD", "EC$", "$", "Dọ́là Ilà Oòrùn Karíbíà", PluralForms{"", "", "", "", "", "àwọn dọ́là Ilà Oòrùn Karíbíà"}},
+		"XCG": {2, 0, 2, 1, "XCG", "Cg.", "Cg.", "XCG", PluralForms{"", "", "", "", "", ""}},
+		"XOF": {0, 0, 0, 1, "XOF", "F\u202fCFA", "F\u202fCFA", "Faransì ìwọ̀-oorùn Afíríkà", PluralForms{"", "", "", "", "", "àwọn faransì ìwọ̀-oorùn Afíríkà"}},
+		"XPF": {0, 0, 0, 1, "XPF", "CFPF", "CFPF", "Fírànkì CFP", PluralForms{"", "", "", "", "", ""}},
+		"XXX": {2, 0, 2, 1, "XXX", "¤", "¤", "owóníná àìmọ̀", PluralForms{"", "", "", "", "", ""}},
+		"YER": {0, 0, 0, 1, "YER", "YER", "YER", "Ráyò Yẹ́mẹ̀nì", PluralForms{"", "", "", "", "", ""}},
+		"ZAR": {2, 0, 2, 1, "ZAR", "ZAR", "R", "Rándì Gúúsù Afíríkà", PluralForms{"", "", "", "", "", "rándì Gúúsù Afíríkà"}},
+		"ZMK": {0, 0, 0, 1, "ZMK", "ZMK", "ZMK", "Kawaṣa ti Orílẹ́ède Saabia (1968–2012)", PluralForms{"", "", "", "", "", ""}},
+		"ZMW": {2, 0, 2, 1, "ZMW", "ZMW", "ZK", "Kàwasà Sámbíà", PluralForms{"", "", "", "", "", "àwọn kàwasà Sámbíà"}},
+		"ZWD": {0, 0, 0, 1, "ZWD", "ZWD", "ZWD", "Dọla ti Orílẹ́ède Siibabuwe", PluralForms{"", "", "", "", "", ""}},
+		"ZWG": {2, 0, 2, 1, "ZWG", "ZWG", "ZWG", "ZWG", PluralForms{"", "", "", "", "", ""}},
 	},
 }
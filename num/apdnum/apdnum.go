@@ -0,0 +1,103 @@
+// Package apdnum formats [github.com/cockroachdb/apd/v3] decimals
+// with the formatters of package [github.com/ttzhou/cldr/num].
+//
+// It is a separate module so that package num itself stays free of dependencies.
+package apdnum
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/ttzhou/cldr/num"
+)
+
+// Decimal adapts an [apd.Decimal] to the [num.Decimal] interface.
+//
+// Only finite decimals can be formatted through it; infinite and NaN decimals
+// have no coefficient, which the formatters of package num report as an error.
+type Decimal struct {
+	d *apd.Decimal
+}
+
+// NewDecimal returns d as a [num.Decimal].
+func NewDecimal(d *apd.Decimal) Decimal {
+	return Decimal{d: d}
+}
+
+// Negative reports whether the decimal is negative, including negative zero.
+func (x Decimal) Negative() bool {
+	return x.d.Negative
+}
+
+// Coefficient returns the decimal's coefficient as ASCII decimal digits,
+// or the empty string if the decimal is not finite.
+func (x Decimal) Coefficient() string {
+	if x.d.Form != apd.Finite {
+		return ""
+	}
+
+	return x.d.Coeff.String()
+}
+
+// Exponent returns the decimal's exponent.
+func (x Decimal) Exponent() int32 {
+	return x.d.Exponent
+}
+
+// FormatDecimal formats the decimal d with df, e.g. 1234.50 => 1,234.5 for "en".
+// Infinite and NaN decimals are displayed using the locale's CLDR symbols,
+// as described in [num.DecimalFormatter.FormatFloat].
+//
+// A non-nil error is returned under the same conditions as [num.DecimalFormatter.FormatDecimal].
+func FormatDecimal(df num.DecimalFormatter, d *apd.Decimal) (string, error) {
+	switch d.Form {
+	case apd.Infinite:
+		return df.FormatFloat(math.Copysign(math.Inf(1), sign(d)), 64)
+	case apd.NaN, apd.NaNSignaling:
+		return df.FormatFloat(math.NaN(), 64)
+	}
+
+	return df.FormatDecimal(NewDecimal(d))
+}
+
+// MustFormatDecimal calls [FormatDecimal], and panics if it returns a non-nil error.
+func MustFormatDecimal(df num.DecimalFormatter, d *apd.Decimal) string {
+	s, err := FormatDecimal(df, d)
+	if err != nil {
+		panic(fmt.Errorf("in apdnum.MustFormatDecimal: %w", err))
+	}
+
+	return s
+}
+
+// FormatMoney formats the decimal amount d with mf for the given currency,
+// e.g. 1234.5 USD => $1,234.50 for "en" with [num.MoneyFormatter.DisplayCurrencyAsSymbol].
+//
+// A non-nil error is returned if d is infinite or NaN,
+// or under the same conditions as [num.MoneyFormatter.FormatDecimal].
+func FormatMoney(mf num.MoneyFormatter, d *apd.Decimal, c string) (string, error) {
+	if d.Form != apd.Finite {
+		return "", fmt.Errorf("%s amounts cannot be formatted (%s)", d.Form, c)
+	}
+
+	return mf.FormatDecimal(NewDecimal(d), c)
+}
+
+// MustFormatMoney calls [FormatMoney], and panics if it returns a non-nil error.
+func MustFormatMoney(mf num.MoneyFormatter, d *apd.Decimal, c string) string {
+	s, err := FormatMoney(mf, d, c)
+	if err != nil {
+		panic(fmt.Errorf("in apdnum.MustFormatMoney: %w", err))
+	}
+
+	return s
+}
+
+func sign(d *apd.Decimal) float64 {
+	if d.Negative {
+		return -1
+	}
+
+	return 1
+}
@@ -0,0 +1,89 @@
+package apdnum_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/ttzhou/cldr/num"
+	"github.com/ttzhou/cldr/num/apdnum"
+)
+
+func mustNewDecimal(s string) *apd.Decimal {
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func TestApdnum(t *testing.T) {
+	t.Run("FormatDecimal()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			scale    int8
+			decimal  *apd.Decimal
+			expected string
+		}{
+			{"en", -1, mustNewDecimal("1234.50"), "1,234.5"},
+			{"en", 2, mustNewDecimal("1.5"), "1.50"},
+			{"en", -1, mustNewDecimal("-0.00"), "-0"},
+			{"en", -1, mustNewDecimal("1.2E+4"), "12,000"},
+			{"en", -1, mustNewDecimal("-123456789012345678901234.5678"), "-123,456,789,012,345,678,901,234.5678"},
+			{"en", 2, mustNewDecimal("-Infinity"), "-∞"},
+			{"en", 2, mustNewDecimal("NaN"), "NaN"},
+			{"fr", -1, mustNewDecimal("1234.5"), "1\u202f234,5"},
+		} {
+			df := num.MustNewDecimalFormatter(tc.locale)
+			df.MustSetScale(tc.scale)
+
+			actual := apdnum.MustFormatDecimal(df, tc.decimal)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+	})
+
+	t.Run("FormatMoney()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			decimal  *apd.Decimal
+			cur      string
+			expected string
+		}{
+			{"en", mustNewDecimal("1234.5"), "USD", "USD\u00a01,234.50"},
+			{"en", mustNewDecimal("-0.125"), "USD", "-USD\u00a00.12"},
+			{"en", mustNewDecimal("1.5E+3"), "JPY", "JPY\u00a01,500"},
+		} {
+			mf := num.MustNewMoneyFormatter(tc.locale)
+			mf.SetRoundingMode(num.RoundHalfEven)
+
+			actual := apdnum.MustFormatMoney(mf, tc.decimal, tc.cur)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+	})
+
+	t.Run("FormatMoney() errors", func(t *testing.T) {
+		for i, tc := range []struct {
+			decimal  *apd.Decimal
+			expected string
+		}{
+			{mustNewDecimal("Infinity"), "Infinite amounts cannot be formatted (USD)"},
+			{mustNewDecimal("NaN"), "NaN amounts cannot be formatted (USD)"},
+			{apd.New(1, 2000000000), "exponent 2000000000 must be between -1000 and 1000 (USD)"},
+		} {
+			mf := num.MustNewMoneyFormatter("en")
+
+			_, err := apdnum.FormatMoney(mf, tc.decimal, "USD")
+			if err == nil {
+				t.Errorf("test case #%d - expected error, got nil", i+1)
+				continue
+			}
+			if err.Error() != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+			}
+		}
+	})
+}
@@ -0,0 +1,10 @@
+module github.com/ttzhou/cldr/num/apdnum
+
+go 1.25.1
+
+require (
+	github.com/cockroachdb/apd/v3 v3.2.1
+	github.com/ttzhou/cldr v0.0.0-20261017062059-42c09120b7ae
+)
+
+replace github.com/ttzhou/cldr => ../..
@@ -0,0 +1,139 @@
+package num
+
+import (
+	"strings"
+
+	"github.com/ttzhou/cldr/internal/locale"
+	"github.com/ttzhou/cldr/plural"
+)
+
+// notation is how formatted numbers are written, i.e. in full or compacted.
+type notation uint8
+
+const (
+	standardNotation notation = iota
+	compactShortNotation
+	compactLongNotation
+)
+
+// defaultCompactSignificantDigits is the number of significant digits that compacted numbers are rounded to
+// by default, as in ICU, e.g. 1234 => 1.2K for "en".
+const defaultCompactSignificantDigits = 2
+
+// formatCompactParts formats d using the locale's compact patterns cfs, e.g. 1234 => 1.2K for "en",
+// after rounding the compacted number to sig significant digits (see [digits.roundSignificant]),
+// with cs in place of any currency placeholder, and without any unit name.
+// Trailing fractional zeros of the compacted number are not displayed.
+//
+// Patterns are chosen by the locale's cardinal plural category of the compacted number, as selected by
+// [plural.Cardinal], e.g. 1 million but 2 millions in "fr", so compact notation relies on the plural package.
+//
+// Compacted numbers are rounded using the formatter's [RoundingMode], or [RoundHalfEven] under [RoundUnnecessary],
+// as compact notation abbreviates numbers by design.
+// Numbers too small to be compacted are formatted in full with the formatter's number format, with the same rounding.
+func (f numberFormatter) formatCompactParts(d digits, cfs []locale.CompactFormat, sig uint8, cs string) (formattedNumber, error) {
+	cf, k := compactFormatFor(cfs, d)
+
+	m := f.roundingMode
+	if m == RoundUnnecessary {
+		m = RoundHalfEven
+	}
+
+	c, err := d.shift(-k).roundSignificant(sig, m)
+	if err != nil {
+		return formattedNumber{}, err
+	}
+
+	// Rounding may carry into the next magnitude, e.g. 999.96K => 1000.0K, which is compacted as 1M.
+	if r := c.shift(k); r.magnitude() != d.magnitude() {
+		cf, k = compactFormatFor(cfs, r)
+		c = r.shift(-k)
+	}
+
+	c.frac = strings.TrimRight(c.frac, "0")
+	ns := f.formatNumber(c)
+
+	// Patterns are chosen by the plural category of the compacted number, e.g. 2 for 2M,
+	// but units by that of the number it stands for, i.e. 2c6.
+	pattern, ok := cf.Patterns[c.whole]
+	if !ok || len(c.frac) > 0 {
+		pattern, ok = cf.Patterns[plural.Cardinal(f.locale.Code, plural.NewOperands(c.whole, c.frac, 0))]
+	}
+
+	if !ok {
+		pattern, ok = cf.Patterns[plural.Other]
+	}
+
+	if ok {
+		// Patterns reserved for an exact number may not display it, e.g. "mille" for 1000 in "fr".
+		prefix, suffix, hasNumber := strings.Cut(pattern, "0")
+		if !hasNumber {
+			prefix, suffix, ns = "", pattern, ""
+		}
+
+		f.numberFormat = withAffixes(f.numberFormat, prefix, suffix)
+	}
+
+	prefix, suffix := f.affixes(c.neg, c.isZero(), ns, cs)
+
+	return formattedNumber{prefix, ns, suffix, plural.NewOperands(c.whole, c.frac, uint64(k))}, nil
+}
+
+// withAffixes returns nf with the positive affixes prefix and suffix, and negative affixes that add to them
+// what those of nf add to its positive affixes, e.g. prefix ($ and suffix ) for $ and "" in the "en" accounting style.
+// The minus sign otherwise goes before the prefix, e.g. -$1.2K, as CLDR patterns without negative subpatterns put it.
+func withAffixes(nf locale.NumberFormat, prefix, suffix string) locale.NumberFormat {
+	negPrefix, negSuffix := cldrMinusSignPlaceholder+prefix, suffix
+
+	if i := strings.LastIndex(nf.NegPrefix, nf.Prefix); i >= 0 {
+		negPrefix = nf.NegPrefix[:i] + prefix + nf.NegPrefix[i+len(nf.Prefix):]
+	}
+
+	if i := strings.Index(nf.NegSuffix, nf.Suffix); i >= 0 {
+		negSuffix = nf.NegSuffix[:i] + suffix + nf.NegSuffix[i+len(nf.Suffix):]
+	}
+
+	nf.Prefix, nf.Suffix = prefix, suffix
+	nf.NegPrefix, nf.NegSuffix = negPrefix, negSuffix
+
+	return nf
+}
+
+// compactFormatFor returns the format of cfs for the magnitude of d, i.e. that of the largest magnitude
+// not exceeding it, and the power of ten that d is divided by when compacted, e.g. 3 for 12345 => 12.345K in "en".
+// Numbers that are not compacted are not divided.
+func compactFormatFor(cfs []locale.CompactFormat, d digits) (locale.CompactFormat, int) {
+	mag := d.magnitude()
+
+	for i := len(cfs) - 1; i >= 0; i-- {
+		cf := cfs[i]
+		if int(cf.Magnitude) > mag {
+			continue
+		}
+
+		if cf.IntegerDigits == 0 {
+			break
+		}
+
+		return cf, int(cf.Magnitude) - int(cf.IntegerDigits) + 1
+	}
+
+	return locale.CompactFormat{}, 0
+}
+
+// roundSignificant rounds d to n significant digits using rounding mode m, without rounding away any
+// of its whole digits, e.g. 1.234 => 1.2 and 123.4 => 123 for n = 2. An n of 0 leaves d unrounded.
+func (d digits) roundSignificant(n uint8, m RoundingMode) (digits, error) {
+	if n == 0 {
+		return d, nil
+	}
+
+	_, exp := d.toScientific()
+
+	s := int(n) - 1 - exp
+	if s >= len(d.frac) {
+		return d, nil
+	}
+
+	return d.round(max(s, 0), m)
+}
@@ -1,16 +1,42 @@
 package num
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// A Decimal is a finite decimal number (-1)^Negative() × Coefficient() × 10^Exponent(),
+// which any decimal type can implement to be formatted without a lossy conversion,
+// e.g. by a [DecimalFormatter] or [MoneyFormatter].
+type Decimal interface {
+	// Negative reports whether the number is negative, including negative zero.
+	Negative() bool
+	// Coefficient returns the absolute value of the coefficient as ASCII decimal digits, e.g. "1001".
+	Coefficient() string
+	// Exponent returns the power of ten that the coefficient is multiplied by, e.g. -2.
+	// Formatters support exponents between -1000 and 1000.
+	Exponent() int32
+}
 
 // A DecimalFormatter can be used to format locale-aware
 // decimal strings using CLDR data.
 type DecimalFormatter struct {
-	scale           int8
+	scale int8
+
+	notation                 notation
+	compactSignificantDigits uint8
+
 	numberFormatter numberFormatter
 }
 
 // NewDecimalFormatter returns a [DecimalFormatter] with
-// no fixed scale (-1) and locale l.
+// no fixed scale (-1) and locale l, displaying numbers with the integer and fractional digits
+// declared by the locale's CLDR decimal pattern, e.g. at most 3 fractional digits for #,##0.### in "en",
+// rounded under [RoundHalfEven] as in ICU, e.g. 1.2345 => 1.234.
+// Every fractional digit is displayed only when opted into with [DecimalFormatter.SetFractionDigits] (0, -1)
+// or [DecimalFormatter.SetScale] (-1).
 //
 // A non-nil error is returned if the locale is not supported.
 //
@@ -25,7 +51,9 @@ func NewDecimalFormatter(l string) (DecimalFormatter, error) {
 
 	f.useStandardDecimalFormat()
 	df.numberFormatter = f
-	_ = df.SetScale(-1)
+	df.scale = -1
+	df.numberFormatter.roundingMode = RoundHalfEven
+	df.compactSignificantDigits = defaultCompactSignificantDigits
 
 	return df, nil
 }
@@ -51,6 +79,9 @@ func MustNewDecimalFormatter(l string) DecimalFormatter {
 // whereas a positive scale of 5 would be
 // whole 10, frac 1000 => 10.01000
 //
+// Numbers are then displayed with exactly scale fractional digits, or every fractional digit
+// but trailing zeros for a scale of -1, i.e. as if set by [DecimalFormatter.SetFractionDigits].
+//
 // An error is returned if the scale is unsupported, i.e.
 // if it is less than -1, or greater than the max supported scale = 20.
 func (df *DecimalFormatter) SetScale(s int8) error {
@@ -60,6 +91,12 @@ func (df *DecimalFormatter) SetScale(s int8) error {
 
 	df.scale = s
 
+	if s == -1 {
+		df.numberFormatter.setFractionDigits(0, -1)
+	} else {
+		df.numberFormatter.setFractionDigits(int(s), int(s))
+	}
+
 	return nil
 }
 
@@ -70,6 +107,72 @@ func (df *DecimalFormatter) MustSetScale(s int8) {
 	}
 }
 
+// SetMinIntegerDigits changes the minimum number of integer digits that numbers are displayed with in standard notation,
+// zero-padding them as needed, e.g. 7.5 => 007.5 with 3. The default is that of the locale's CLDR decimal pattern.
+//
+// An error is returned if n is 0, or greater than the max supported scale = 20.
+func (df *DecimalFormatter) SetMinIntegerDigits(n uint8) error {
+	if n == 0 || n > maxSupportedScale {
+		return unsupportedIntegerDigitsError(n)
+	}
+
+	df.numberFormatter.setIntegerDigits(n)
+
+	return nil
+}
+
+// MustSetMinIntegerDigits calls [DecimalFormatter.SetMinIntegerDigits], and panics if it returns an error.
+func (df *DecimalFormatter) MustSetMinIntegerDigits(n uint8) {
+	if err := df.SetMinIntegerDigits(n); err != nil {
+		panic(fmt.Errorf("in DecimalFormatter.MustSetMinIntegerDigits: %w", err))
+	}
+}
+
+// SetFractionDigits changes the minimum and maximum numbers of fractional digits that numbers are displayed with
+// in standard notation, rounding them using the formatter's [RoundingMode] and zero-padding them as needed,
+// e.g. 1.5 => 1.50 and 1.23456 => 1.2346 with at least 2 and at most 4 under [RoundHalfEven].
+// Other trailing fractional zeros are not displayed. A maximum of -1 displays every fractional digit.
+// The defaults are those of the locale's CLDR decimal pattern, e.g. 0 and 3 for #,##0.### in "en".
+//
+// Unlike [DecimalFormatter.SetScale], this does not change how [DecimalFormatter.Format] reads fractional parts.
+//
+// An error is returned if the minimum is less than 0, or the maximum less than -1, if either is greater than
+// the max supported scale = 20, or if the minimum is greater than a maximum other than -1.
+func (df *DecimalFormatter) SetFractionDigits(minimum, maximum int8) error {
+	if err := validateFractionDigits(minimum, maximum); err != nil {
+		return err
+	}
+
+	df.numberFormatter.setFractionDigits(int(minimum), int(maximum))
+
+	return nil
+}
+
+// MustSetFractionDigits calls [DecimalFormatter.SetFractionDigits], and panics if it returns an error.
+func (df *DecimalFormatter) MustSetFractionDigits(minimum, maximum int8) {
+	if err := df.SetFractionDigits(minimum, maximum); err != nil {
+		panic(fmt.Errorf("in DecimalFormatter.MustSetFractionDigits: %w", err))
+	}
+}
+
+// UsePatternDigits indicates that numbers should be displayed with the integer and fractional digits declared by
+// the locale's CLDR decimal pattern, e.g. at most 3 fractional digits for #,##0.### in "en", instead of any set by
+// [DecimalFormatter.SetScale], [DecimalFormatter.SetMinIntegerDigits] or [DecimalFormatter.SetFractionDigits].
+// This is the default.
+func (df *DecimalFormatter) UsePatternDigits() {
+	df.numberFormatter.usePatternDigits()
+}
+
+// SetRoundingMode changes how numbers with more fractional digits than
+// the formatter displays are rounded, e.g. 1.005 => 1.01 under [RoundHalfUp] with scale 2.
+//
+// The default is [RoundHalfEven], as in ICU; such numbers cannot be formatted under [RoundUnnecessary].
+// Rounding does not apply without a maximum number of fractional digits, e.g. with a scale of -1,
+// except to significant digits; see [DecimalFormatter.SetSignificantDigits].
+func (df *DecimalFormatter) SetRoundingMode(m RoundingMode) {
+	df.numberFormatter.roundingMode = m
+}
+
 // SetLocale changes the locale considered when formatting.
 // An error is returned if the locale is not supported.
 func (df *DecimalFormatter) SetLocale(l string) error {
@@ -83,10 +186,210 @@ func (df *DecimalFormatter) MustSetLocale(l string) {
 	}
 }
 
+// UseStandardNotation indicates that numbers should be displayed in full, e.g. 1,234,567 for "en".
+// This is the default.
+func (df *DecimalFormatter) UseStandardNotation() {
+	df.notation = standardNotation
+}
+
+// UseCompactShortNotation indicates that numbers should be displayed using the short compact
+// patterns defined by CLDR for the current locale, e.g. 1234567 => 1.2M for "en" and 123万 for "ja".
+//
+// The formatter's scale does not apply in compact notation;
+// see [DecimalFormatter.SetCompactSignificantDigits] instead.
+func (df *DecimalFormatter) UseCompactShortNotation() {
+	df.notation = compactShortNotation
+}
+
+// UseCompactLongNotation indicates that numbers should be displayed using the long compact
+// patterns defined by CLDR for the current locale, e.g. 1234567 => 1.2 million for "en".
+//
+// The formatter's scale does not apply in compact notation;
+// see [DecimalFormatter.SetCompactSignificantDigits] instead.
+func (df *DecimalFormatter) UseCompactLongNotation() {
+	df.notation = compactLongNotation
+}
+
+// DisplayExactly indicates that numbers should be displayed as they are. This is the default.
+func (df *DecimalFormatter) DisplayExactly() {
+	df.numberFormatter.qualifier = exactly
+}
+
+// DisplayApproximately indicates that numbers should be displayed as approximate,
+// using the locale's CLDR pattern, e.g. ~1,000 for "en" and ≈1 000 for "fr".
+func (df *DecimalFormatter) DisplayApproximately() {
+	df.numberFormatter.qualifier = approximately
+}
+
+// DisplayAtLeast indicates that numbers should be displayed as lower bounds,
+// using the locale's CLDR pattern, e.g. 1,000+ for "en" and ≥1 000 for "fr".
+func (df *DecimalFormatter) DisplayAtLeast() {
+	df.numberFormatter.qualifier = atLeast
+}
+
+// DisplayAtMost indicates that numbers should be displayed as upper bounds,
+// using the locale's CLDR pattern, e.g. ≤1,000 for "en".
+func (df *DecimalFormatter) DisplayAtMost() {
+	df.numberFormatter.qualifier = atMost
+}
+
+// DisplaySignAuto indicates that numbers should be displayed with a minus sign if negative,
+// and no sign otherwise. This is the default.
+func (df *DecimalFormatter) DisplaySignAuto() {
+	df.numberFormatter.signDisplay = signAuto
+}
+
+// DisplaySignAlways indicates that numbers should be displayed with a plus or minus sign,
+// using the locale's CLDR symbols, e.g. +5 and -5 for "en".
+func (df *DecimalFormatter) DisplaySignAlways() {
+	df.numberFormatter.signDisplay = signAlways
+}
+
+// DisplaySignNever indicates that numbers should be displayed without a sign, e.g. 5 for -5.
+func (df *DecimalFormatter) DisplaySignNever() {
+	df.numberFormatter.signDisplay = signNever
+}
+
+// DisplaySignExceptZero indicates that numbers should be displayed with a plus or minus sign,
+// as described in [DecimalFormatter.DisplaySignAlways], unless they are zero after rounding, e.g. 0.
+func (df *DecimalFormatter) DisplaySignExceptZero() {
+	df.numberFormatter.signDisplay = signExceptZero
+}
+
+// DisplaySignNegative indicates that numbers should be displayed with a minus sign
+// if negative, unless they are zero after rounding, and no sign otherwise.
+func (df *DecimalFormatter) DisplaySignNegative() {
+	df.numberFormatter.signDisplay = signNegative
+}
+
+// DisplayTrailingZerosAuto indicates that numbers should be displayed with as many trailing fractional zeros
+// as the formatter's fractional digits call for, e.g. 5.00 with scale 2. This is the default.
+func (df *DecimalFormatter) DisplayTrailingZerosAuto() {
+	df.numberFormatter.trailingZeroDisplay = trailingZerosAuto
+}
+
+// DisplayTrailingZerosStripIfInteger indicates that numbers should be displayed without a fractional part
+// if it is entirely zero after rounding, e.g. 5 but 5.50 with scale 2, and as usual otherwise.
+func (df *DecimalFormatter) DisplayTrailingZerosStripIfInteger() {
+	df.numberFormatter.trailingZeroDisplay = trailingZerosStripIfInteger
+}
+
+// UseLocaleGrouping indicates that the whole parts of numbers should be grouped as is usual for the locale, i.e. unless
+// they have fewer digits than the locale's CLDR minimum in their highest group, e.g. 1000 but 10 000 for "pl".
+// This is the default.
+func (df *DecimalFormatter) UseLocaleGrouping() {
+	df.numberFormatter.grouping = groupingLocale
+}
+
+// UseFullGrouping indicates that the whole parts of numbers should always be grouped, e.g. 1 000 for "pl".
+func (df *DecimalFormatter) UseFullGrouping() {
+	df.numberFormatter.grouping = groupingFull
+}
+
+// UseMin2Grouping indicates that the whole parts of numbers should be grouped as is usual for the locale,
+// but only if they have at least 2 digits in their highest group, e.g. 1000 but 10,000 for "en".
+func (df *DecimalFormatter) UseMin2Grouping() {
+	df.numberFormatter.grouping = groupingMin2
+}
+
+// UseNoGrouping indicates that the whole parts of numbers should never be grouped, e.g. 1000000.
+func (df *DecimalFormatter) UseNoGrouping() {
+	df.numberFormatter.grouping = groupingNone
+}
+
+// SetCompactSignificantDigits changes the number of significant digits that numbers are rounded to
+// in compact notation, using the formatter's [RoundingMode], or [RoundHalfEven] under [RoundUnnecessary],
+// e.g. 1234567 => 1.23M with 3 significant digits for "en".
+// Whole digits of a compacted number are never rounded away, e.g. 123456 => 123K, and trailing fractional zeros are
+// not displayed. The default is 2, e.g. 1234567 => 1.2M, and a value of 0 displays every digit of the compacted number.
+//
+// An error is returned if n is greater than the max supported scale = 20.
+func (df *DecimalFormatter) SetCompactSignificantDigits(n uint8) error {
+	if n > maxSupportedScale {
+		return unsupportedSignificantDigitsError(n)
+	}
+
+	df.compactSignificantDigits = n
+
+	return nil
+}
+
+// MustSetCompactSignificantDigits calls [DecimalFormatter.SetCompactSignificantDigits], and panics if it returns an error.
+func (df *DecimalFormatter) MustSetCompactSignificantDigits(n uint8) {
+	if err := df.SetCompactSignificantDigits(n); err != nil {
+		panic(fmt.Errorf("in DecimalFormatter.MustSetCompactSignificantDigits: %w", err))
+	}
+}
+
+// SetSignificantDigits changes the minimum and maximum numbers of significant digits that numbers are displayed with
+// in standard notation, rounding them using the formatter's [RoundingMode] and zero-padding them as needed,
+// e.g. 0.00123456 => 0.00123 and 123456 => 123,000 with at most 3, and 1.5 => 1.500 with at least 4 for "en".
+// Other trailing fractional zeros are not displayed. A maximum of 0 sets no maximum,
+// and the default of 0 for both displays numbers with the formatter's fractional digits alone.
+//
+// Without a maximum number of fractional digits, e.g. with a scale of -1, numbers are displayed with their
+// significant digits alone. Otherwise, the significant digits take priority over the fractional digits
+// by default; see [DecimalFormatter.UseMorePrecisionPriority]
+// and [DecimalFormatter.UseLessPrecisionPriority] to display numbers with both.
+//
+// An error is returned if either number is greater than the max supported scale = 20,
+// or if the minimum is greater than a non-zero maximum.
+func (df *DecimalFormatter) SetSignificantDigits(minimum, maximum uint8) error {
+	switch {
+	case minimum > maxSupportedScale:
+		return unsupportedSignificantDigitsError(minimum)
+	case maximum > maxSupportedScale:
+		return unsupportedSignificantDigitsError(maximum)
+	case maximum > 0 && minimum > maximum:
+		return invalidSignificantDigitsError(minimum, maximum)
+	}
+
+	df.numberFormatter.minSignificantDigits = minimum
+	df.numberFormatter.maxSignificantDigits = maximum
+
+	return nil
+}
+
+// MustSetSignificantDigits calls [DecimalFormatter.SetSignificantDigits], and panics if it returns an error.
+func (df *DecimalFormatter) MustSetSignificantDigits(minimum, maximum uint8) {
+	if err := df.SetSignificantDigits(minimum, maximum); err != nil {
+		panic(fmt.Errorf("in DecimalFormatter.MustSetSignificantDigits: %w", err))
+	}
+}
+
+// UseSignificantDigitsPriority indicates that numbers should be displayed with the formatter's significant digits
+// alone if it has any, whatever its fractional digits, e.g. 1.2345 => 1.234 with scale 2 and at most 4 significant digits.
+// This is the default.
+func (df *DecimalFormatter) UseSignificantDigitsPriority() {
+	df.numberFormatter.roundingPriority = significantDigitsPriority
+}
+
+// UseMorePrecisionPriority indicates that numbers should be displayed with whichever of the formatter's maximum
+// fractional and significant digits keeps more fractional digits, e.g. 1.2345 => 1.234 with scale 2
+// and at most 4 significant digits, but 12345.678 => 12,345.68.
+func (df *DecimalFormatter) UseMorePrecisionPriority() {
+	df.numberFormatter.roundingPriority = morePrecisionPriority
+}
+
+// UseLessPrecisionPriority indicates that numbers should be displayed with whichever of the formatter's maximum
+// fractional and significant digits keeps fewer fractional digits, e.g. 1.2345 => 1.23 with scale 2
+// and at most 4 significant digits, but 12345.678 => 12,350.
+func (df *DecimalFormatter) UseLessPrecisionPriority() {
+	df.numberFormatter.roundingPriority = lessPrecisionPriority
+}
+
 // Format formats a given number's whole and fractional parts into a locale-aware string.
+// A fractional part with more digits than the scale is read as written, e.g.
+// whole 9, frac 999 => 9.999, and rounded using the formatter's [RoundingMode] as needed.
+//
 // A non-nil error is returned if the formatting cannot be done.
 func (df DecimalFormatter) Format(w int64, f uint64) (string, error) {
-	return df.numberFormatter.format(w, f, df.scale, "")
+	d, err := newDigits(w, f, df.scale)
+	if err != nil {
+		return "", err
+	}
+
+	return df.format(d)
 }
 
 // MustFormat calls [DecimalFormatter.Format], and panics if there is an error.
@@ -98,3 +401,284 @@ func (df DecimalFormatter) MustFormat(w int64, f uint64) string {
 
 	return s
 }
+
+// FormatCoef formats the number (-1)^neg × coef × 10^exp into a locale-aware string,
+// e.g. neg true, coef 1001, exp -2 => -10.01. This represents every decimal number exactly,
+// including those with leading fractional zeros and negative numbers with a zero whole part.
+//
+// The number is displayed with the formatter's integer and fractional digits, rounded using
+// the formatter's [RoundingMode] and zero-padded as needed; see [DecimalFormatter.SetFractionDigits].
+// A non-nil error is returned if exp is not between -1000 and 1000, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatCoef(neg bool, coef uint64, exp int32) (string, error) {
+	if err := validateExponent(exp); err != nil {
+		return "", err
+	}
+
+	return df.format(newDigitsFromCoef(neg, strconv.FormatUint(coef, 10), int(exp)))
+}
+
+// MustFormatCoef calls [DecimalFormatter.FormatCoef], and panics if there is an error.
+func (df DecimalFormatter) MustFormatCoef(neg bool, coef uint64, exp int32) string {
+	s, err := df.FormatCoef(neg, coef, exp)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatDecimal formats the [Decimal] x into a locale-aware string,
+// e.g. a coefficient of "1001" and exponent of -2 => 10.01.
+// The number is then displayed as described in [DecimalFormatter.FormatCoef].
+//
+// A non-nil error is returned if the coefficient of x is not made of ASCII decimal digits,
+// if its exponent is not between -1000 and 1000, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatDecimal(x Decimal) (string, error) {
+	d, err := newDigitsFromDecimal(x)
+	if err != nil {
+		return "", err
+	}
+
+	return df.format(d)
+}
+
+// MustFormatDecimal calls [DecimalFormatter.FormatDecimal], and panics if there is an error.
+func (df DecimalFormatter) MustFormatDecimal(x Decimal) string {
+	s, err := df.FormatDecimal(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatBigInt formats the integer x into a locale-aware string.
+// With a minimum number of fractional digits, x is displayed with as many fractional zeros.
+//
+// A non-nil error is returned if the formatting cannot be done.
+func (df DecimalFormatter) FormatBigInt(x *big.Int) (string, error) {
+	return df.format(newDigitsFromBigInt(x))
+}
+
+// MustFormatBigInt calls [DecimalFormatter.FormatBigInt], and panics if there is an error.
+func (df DecimalFormatter) MustFormatBigInt(x *big.Int) string {
+	s, err := df.FormatBigInt(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatBigRat formats the rational number x into a locale-aware string,
+// after first rounding it to s fractional digits using the formatter's [RoundingMode],
+// e.g. 2/3 with s 4 => 0.6667 under [RoundHalfEven].
+//
+// The rounded number is then displayed as described in [DecimalFormatter.FormatCoef].
+// A non-nil error is returned if the formatting cannot be done, e.g. if x is not
+// exactly representable with s fractional digits and the rounding mode is [RoundUnnecessary].
+func (df DecimalFormatter) FormatBigRat(x *big.Rat, s uint8) (string, error) {
+	d, err := newDigitsFromBigRat(x, s, 0, df.numberFormatter.roundingMode)
+	if err != nil {
+		return "", err
+	}
+
+	return df.format(d)
+}
+
+// MustFormatBigRat calls [DecimalFormatter.FormatBigRat], and panics if there is an error.
+func (df DecimalFormatter) MustFormatBigRat(x *big.Rat, s uint8) string {
+	str, err := df.FormatBigRat(x, s)
+	if err != nil {
+		panic(err)
+	}
+
+	return str
+}
+
+// FormatBigFloat formats the floating-point number x into a locale-aware string,
+// using the fewest digits that uniquely identify x at its precision.
+// The number is then displayed as described in [DecimalFormatter.FormatCoef].
+//
+// A non-nil error is returned if x is infinite, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatBigFloat(x *big.Float) (string, error) {
+	d, err := newDigitsFromBigFloat(x)
+	if err != nil {
+		return "", err
+	}
+
+	return df.format(d)
+}
+
+// MustFormatBigFloat calls [DecimalFormatter.FormatBigFloat], and panics if there is an error.
+func (df DecimalFormatter) MustFormatBigFloat(x *big.Float) string {
+	s, err := df.FormatBigFloat(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatString formats the ASCII decimal string x into a locale-aware string,
+// e.g. "-123456789012345678901234.5678". The string may have a leading sign, and
+// must have digits on both sides of the decimal point, if any.
+// The number is then displayed as described in [DecimalFormatter.FormatCoef].
+//
+// A non-nil error is returned if x is not a valid decimal string, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatString(x string) (string, error) {
+	d, err := parseDigits(x)
+	if err != nil {
+		return "", err
+	}
+
+	return df.format(d)
+}
+
+// MustFormatString calls [DecimalFormatter.FormatString], and panics if there is an error.
+func (df DecimalFormatter) MustFormatString(x string) string {
+	s, err := df.FormatString(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatFloat formats the floating-point number x into a locale-aware string, using the
+// fewest digits that round-trip to x when parsed with the given bit size (32 for float32, 64 for float64),
+// e.g. 0.1 => 0.1 rather than 0.1000000000000000055511151231257827.
+// The number is then displayed as described in [DecimalFormatter.FormatCoef], so a fixed precision
+// can be applied by setting fractional digits and a [RoundingMode].
+//
+// NaN and ±Inf are displayed using the locale's CLDR symbols, e.g. NaN and -∞ for "en",
+// with the formatter's qualifier, e.g. ~∞, and sign display, except that NaN is never displayed with a sign.
+// A non-nil error is returned if the bit size is unsupported, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatFloat(x float64, bitSize int) (string, error) {
+	if bitSize != 32 && bitSize != 64 {
+		return "", unsupportedBitSizeError(bitSize)
+	}
+
+	switch {
+	case math.IsNaN(x):
+		return df.numberFormatter.formatNonFinite(false, true, df.numberFormatter.locale.Data.NumberInfo.NaN), nil
+	case math.IsInf(x, 0):
+		return df.numberFormatter.formatNonFinite(x < 0, false, df.numberFormatter.locale.Data.NumberInfo.Infinity), nil
+	}
+
+	d, err := parseDigits(strconv.FormatFloat(x, 'f', -1, bitSize))
+	if err != nil {
+		return "", err
+	}
+
+	return df.format(d)
+}
+
+// MustFormatFloat calls [DecimalFormatter.FormatFloat], and panics if there is an error.
+func (df DecimalFormatter) MustFormatFloat(x float64, bitSize int) string {
+	s, err := df.FormatFloat(x, bitSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatStringRange formats the range from the ASCII decimal string x to y into a locale-aware string,
+// e.g. "3" and "5" => 3–5 for "en". Both ends are read as described in [DecimalFormatter.FormatString].
+//
+// Affixes shared by both ends are displayed once where the locale allows, e.g. 3–5 k in "fr" with compact notation,
+// and ends that are displayed the same after rounding are displayed once as an approximate number, e.g. ~3.
+// Ranges are not displayed as bounds, e.g. with [DecimalFormatter.DisplayAtLeast].
+// A non-nil error is returned if x or y is not a valid decimal string, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatStringRange(x, y string) (string, error) {
+	dx, err := parseDigits(x)
+	if err != nil {
+		return "", err
+	}
+
+	dy, err := parseDigits(y)
+	if err != nil {
+		return "", err
+	}
+
+	return df.formatRange(dx, dy)
+}
+
+// MustFormatStringRange calls [DecimalFormatter.FormatStringRange], and panics if there is an error.
+func (df DecimalFormatter) MustFormatStringRange(x, y string) string {
+	s, err := df.FormatStringRange(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatDecimalRange formats the range from the [Decimal] x to y into a locale-aware string,
+// as described in [DecimalFormatter.FormatStringRange].
+//
+// A non-nil error is returned if the coefficient of x or y is not made of ASCII decimal digits,
+// if its exponent is not between -1000 and 1000, or if the formatting cannot be done.
+func (df DecimalFormatter) FormatDecimalRange(x, y Decimal) (string, error) {
+	dx, err := newDigitsFromDecimal(x)
+	if err != nil {
+		return "", err
+	}
+
+	dy, err := newDigitsFromDecimal(y)
+	if err != nil {
+		return "", err
+	}
+
+	return df.formatRange(dx, dy)
+}
+
+// MustFormatDecimalRange calls [DecimalFormatter.FormatDecimalRange], and panics if there is an error.
+func (df DecimalFormatter) MustFormatDecimalRange(x, y Decimal) string {
+	s, err := df.FormatDecimalRange(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// format formats d in the formatter's notation.
+func (df DecimalFormatter) format(d digits) (string, error) {
+	fn, err := df.formatParts(d)
+	if err != nil {
+		return "", err
+	}
+
+	return df.numberFormatter.formatQualified(fn), nil
+}
+
+// formatRange formats the range from start to end in the formatter's notation.
+func (df DecimalFormatter) formatRange(start, end digits) (string, error) {
+	fstart, err := df.formatParts(start)
+	if err != nil {
+		return "", err
+	}
+
+	fend, err := df.formatParts(end)
+	if err != nil {
+		return "", err
+	}
+
+	return df.numberFormatter.formatRange(fstart, fend), nil
+}
+
+// formatParts formats d in the formatter's notation, with its affixes kept apart.
+func (df DecimalFormatter) formatParts(d digits) (formattedNumber, error) {
+	cfs := df.numberFormatter.locale.Data.NumberInfo.CompactFormats
+
+	switch df.notation {
+	case compactShortNotation:
+		return df.numberFormatter.formatCompactParts(d, cfs.ShortDecimal, df.compactSignificantDigits, "")
+	case compactLongNotation:
+		return df.numberFormatter.formatCompactParts(d, cfs.LongDecimal, df.compactSignificantDigits, "")
+	default:
+		return df.numberFormatter.formatParts(d, "")
+	}
+}
@@ -0,0 +1,232 @@
+package num
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// digits is the sign and ASCII digits of a number on either side of its
+// decimal separator; this is the form every input is reduced to before
+// rounding and localization.
+//
+// The whole part never has leading zeros (except for "0" itself), while
+// the fractional part is kept exactly as given, trailing zeros included.
+type digits struct {
+	neg   bool
+	whole string
+	frac  string
+}
+
+// newDigits splits a whole part and fractional part given at scale s.
+//
+// For a non-negative scale, a fractional part with fewer digits than s is
+// left-padded with zeros, e.g. 1 at scale 3 => 001.
+func newDigits(w int64, f uint64, s int8) (digits, error) {
+	if s < -1 || s > int8(maxSupportedScale) {
+		return digits{}, unsupportedScaleError(s)
+	}
+
+	ws := strconv.FormatInt(w, 10)
+	d := digits{
+		neg:   w < 0,
+		whole: strings.TrimPrefix(ws, "-"),
+		frac:  strconv.FormatUint(f, 10),
+	}
+
+	switch {
+	case s == -1:
+		// Read as written; trailing zeros are trimmed when formatting.
+	case f == 0:
+		d.frac = strings.Repeat("0", int(s))
+	case len(d.frac) < int(s):
+		d.frac = strings.Repeat("0", int(s)-len(d.frac)) + d.frac
+	}
+
+	return d, nil
+}
+
+// validateExponent returns an error if the exponent exp of a coefficient is not between
+// -maxSupportedExponent and maxSupportedExponent, as displaying it would take too many digits.
+func validateExponent(exp int32) error {
+	if exp < -maxSupportedExponent || exp > maxSupportedExponent {
+		return unsupportedExponentError(exp)
+	}
+
+	return nil
+}
+
+// newDigitsFromCoef returns the digits of the number (-1)^neg × coef × 10^exp,
+// where coef is a string of ASCII digits, e.g. "1001", -2 => 10.01.
+func newDigitsFromCoef(neg bool, coef string, exp int) digits {
+	coef = strings.TrimLeft(coef, "0")
+
+	d := digits{neg: neg, whole: "0"}
+
+	switch {
+	case len(coef) == 0:
+		if exp < 0 {
+			d.frac = strings.Repeat("0", -exp)
+		}
+	case exp >= 0:
+		d.whole = coef + strings.Repeat("0", exp)
+	case -exp >= len(coef):
+		d.frac = strings.Repeat("0", -exp-len(coef)) + coef
+	default:
+		d.whole, d.frac = coef[:len(coef)+exp], coef[len(coef)+exp:]
+	}
+
+	return d
+}
+
+// newDigitsFromDecimal returns the digits of x.
+//
+// An error is returned if the coefficient of x is not made of ASCII decimal digits,
+// or if its exponent is not supported, as described in [validateExponent].
+func newDigitsFromDecimal(x Decimal) (digits, error) {
+	coef := x.Coefficient()
+	if coef == "" || !isASCIIDigits(coef) {
+		return digits{}, invalidDecimalCoefficientError(coef)
+	}
+
+	exp := x.Exponent()
+	if err := validateExponent(exp); err != nil {
+		return digits{}, err
+	}
+
+	return newDigitsFromCoef(x.Negative(), coef, int(exp)), nil
+}
+
+// newDigitsFromBigInt returns the digits of x.
+func newDigitsFromBigInt(x *big.Int) digits {
+	return digits{neg: x.Sign() < 0, whole: new(big.Int).Abs(x).String()}
+}
+
+// newDigitsFromBigRat returns the digits of x rounded once to a multiple of inc × 10^-s using rounding mode m,
+// as described in [digits.roundToIncrement], e.g. 2/3 => 0.67 for s = 2 under [RoundHalfEven].
+// Exact results have their trailing fractional zeros trimmed, e.g. 1/4 => 0.25 for s = 4.
+//
+// An error is returned if x has no exact representation with s fractional digits and m is [RoundUnnecessary].
+func newDigitsFromBigRat(x *big.Rat, s uint8, inc uint64, m RoundingMode) (digits, error) {
+	n := new(big.Int).Abs(x.Num())
+	n.Mul(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(s)+1), nil))
+
+	q, r := n.QuoRem(n, x.Denom(), new(big.Int))
+
+	// The quotient is truncated to s + 1 fractional digits, which are followed by a non-zero
+	// sticky digit if the truncation was inexact, so that rounding it to s digits is correct under every mode.
+	d := newDigitsFromCoef(x.Sign() < 0, q.String()+"0", -int(s)-2)
+	if r.Sign() == 0 {
+		d.frac = strings.TrimRight(d.frac, "0")
+	} else {
+		if m == RoundUnnecessary {
+			return d, inexactRationalError(x, s)
+		}
+
+		d.frac = d.frac[:len(d.frac)-1] + "1"
+	}
+
+	return d.roundToIncrement(int(s), inc, m)
+}
+
+// newDigitsFromBigFloat returns the digits of x, using the fewest digits
+// that uniquely identify x at its precision, e.g. 0.1 at 53 bits => 0.1.
+//
+// An error is returned if x is infinite.
+func newDigitsFromBigFloat(x *big.Float) (digits, error) {
+	if x.IsInf() {
+		return digits{}, unsupportedInfiniteValueError()
+	}
+
+	return parseDigits(x.Text('f', -1))
+}
+
+// parseDigits parses a plain ASCII decimal string such as "-1234.5678".
+// A leading sign is optional, and at least one digit is required on either side of the
+// decimal separator, if it is present.
+func parseDigits(s string) (digits, error) {
+	d := digits{}
+
+	t := s
+	if len(t) > 0 && (t[0] == '-' || t[0] == '+') {
+		d.neg = t[0] == '-'
+		t = t[1:]
+	}
+
+	w, f, hasSep := strings.Cut(t, ".")
+	if len(w) == 0 || (hasSep && len(f) == 0) || !isASCIIDigits(w) || !isASCIIDigits(f) {
+		return d, invalidDecimalStringError(s)
+	}
+
+	d.whole = strings.TrimLeft(w, "0")
+	if len(d.whole) == 0 {
+		d.whole = "0"
+	}
+
+	d.frac = f
+
+	return d, nil
+}
+
+// shift returns d multiplied by 10^n, moving |n| digits between the whole and fractional parts
+// and zero-padding as needed, e.g. 0.125 shifted by 2 => 12.5, and 1234 shifted by -3 => 1.234.
+func (d digits) shift(n int) digits {
+	if n < 0 {
+		if len(d.whole) <= -n {
+			d.whole = strings.Repeat("0", -n-len(d.whole)+1) + d.whole
+		}
+
+		w := len(d.whole) + n
+		d.whole, d.frac = d.whole[:w], d.whole[w:]+d.frac
+
+		return d
+	}
+
+	if len(d.frac) < n {
+		d.frac += strings.Repeat("0", n-len(d.frac))
+	}
+
+	d.whole = strings.TrimLeft(d.whole+d.frac[:n], "0")
+	if len(d.whole) == 0 {
+		d.whole = "0"
+	}
+
+	d.frac = d.frac[n:]
+
+	return d
+}
+
+// isZero reports whether d is zero, whatever its sign and number of fractional zeros.
+func (d digits) isZero() bool {
+	return d.whole == "0" && strings.Trim(d.frac, "0") == ""
+}
+
+// magnitude returns the power of ten of the leading whole digit of d, e.g. 3 for 1234.5,
+// or -1 if d has no non-zero whole digits.
+func (d digits) magnitude() int {
+	return len(strings.TrimLeft(d.whole, "0")) - 1
+}
+
+func isASCIIDigits(s string) bool {
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns d as a plain ASCII decimal string, e.g. -12.340.
+func (d digits) String() string {
+	s := d.whole
+	if len(d.frac) > 0 {
+		s += "." + d.frac
+	}
+
+	if d.neg {
+		s = "-" + s
+	}
+
+	return s
+}
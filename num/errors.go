@@ -1,9 +1,18 @@
 package num
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
 
 const (
-	maxSupportedScale = uint8(len(fracFormats) - 1)
+	// Up to 20 digits scale, which is the number of digits in largest uint64 number.
+	maxSupportedScale = uint8(20)
+
+	// Up to 1000 in magnitude for exponents, which bounds the number of digits to display
+	// well beyond those of any float64.
+	maxSupportedExponent = 1000
 )
 
 func unsupportedLocaleError(l string) error {
@@ -22,6 +31,66 @@ func unsupportedScaleError(s int8) error {
 	return fmt.Errorf("scale %d exceeds max supported scale %d", s, maxSupportedScale)
 }
 
-func fractionalScaleError(f uint64, s uint8) error {
-	return fmt.Errorf("fractional part %d exceeds scale %d", f, s)
+func unsupportedIntegerDigitsError(n uint8) error {
+	return fmt.Errorf("integer digits %d must be between 1 and max supported scale %d", n, maxSupportedScale)
+}
+
+func unsupportedFractionDigitsError(n, lowest int8) error {
+	return fmt.Errorf("fraction digits %d must be between %d and max supported scale %d", n, lowest, maxSupportedScale)
+}
+
+func invalidFractionDigitsError(minimum, maximum int8) error {
+	return fmt.Errorf("minimum fraction digits %d exceeds maximum fraction digits %d", minimum, maximum)
+}
+
+func unsupportedExponentError(exp int32) error {
+	return fmt.Errorf("exponent %d must be between %d and %d", exp, -maxSupportedExponent, maxSupportedExponent)
+}
+
+func fractionalScaleError(f string, s int) error {
+	return fmt.Errorf("fractional part %s exceeds scale %d", f, s)
+}
+
+func inexactRationalError(x *big.Rat, s uint8) error {
+	return fmt.Errorf("%s cannot be represented exactly at scale %d", x.RatString(), s)
+}
+
+func roundingIncrementError(d digits, s int, inc uint64) error {
+	return fmt.Errorf("%s is not a multiple of rounding increment %s", d, roundingIncrement{inc, uint8(s)})
+}
+
+func unsupportedRoundingIncrementError(ri roundingIncrement, s uint8) error {
+	return fmt.Errorf("rounding increment %s cannot be expressed at scale %d", ri, s)
+}
+
+func invalidDecimalStringError(s string) error {
+	return fmt.Errorf("invalid decimal string: %q", s)
+}
+
+func unsupportedInfiniteValueError() error {
+	return errors.New("infinite values cannot be formatted")
+}
+
+func unsupportedBitSizeError(b int) error {
+	return fmt.Errorf("unsupported float bit size %d; must be 32 or 64", b)
+}
+
+func invalidDecimalCoefficientError(c string) error {
+	return fmt.Errorf("invalid decimal coefficient: %q", c)
+}
+
+func invalidRoundingIncrementError(s string) error {
+	return fmt.Errorf("invalid rounding increment: %q", s)
+}
+
+func unsupportedRoundingModeError(m RoundingMode) error {
+	return fmt.Errorf("unsupported rounding mode %d", m)
+}
+
+func unsupportedSignificantDigitsError(n uint8) error {
+	return fmt.Errorf("significant digits %d exceeds max supported significant digits %d", n, maxSupportedScale)
+}
+
+func invalidSignificantDigitsError(minimum, maximum uint8) error {
+	return fmt.Errorf("minimum significant digits %d exceeds maximum significant digits %d", minimum, maximum)
 }
@@ -3,48 +3,95 @@
 package num
 
 import (
-	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/ttzhou/cldr/internal/locale"
+	"github.com/ttzhou/cldr/plural"
 )
 
 const (
-	cldrCurSymbolPlaceholder = "¤"
+	cldrCurSymbolPlaceholder     = "¤"
+	cldrPercentSymbolPlaceholder = "%"
+	cldrMinusSignPlaceholder     = "-"
+	cldrPlusSignPlaceholder      = "+"
 )
 
-// Up to 20 digits scale, which is the number of digits in largest uint64 number.
-var fracFormats = [...]string{
-	"%0d",
-	"%01d",
-	"%02d",
-	"%03d",
-	"%04d",
-	"%05d",
-	"%06d",
-	"%07d",
-	"%08d",
-	"%09d",
-	"%10d",
-	"%11d",
-	"%12d",
-	"%13d",
-	"%14d",
-	"%15d",
-	"%16d",
-	"%17d",
-	"%18d",
-	"%19d",
-	"%20d",
-}
-
 type numberFormatter struct {
 	locale locale.Locale
 
-	numberFormat locale.NumberFormat
+	// The locale's currency spacing rules; see [numberFormatter.spaceCurrency].
+	beforeCurrency, afterCurrency currencySpacingRule
+
+	numberFormat      locale.NumberFormat
+	roundingMode      RoundingMode
+	roundingIncrement uint64
+
+	// Forms of a unit name by plural category, e.g. One: "euro" in "fr", and the patterns
+	// combining them with formatted numbers; numbers are displayed without a unit if there are no forms.
+	unitNames    locale.PluralForms
+	unitPatterns locale.PluralForms
+
+	// Whether numbers are displayed as exact, approximate, or as lower or upper bounds, e.g. 1,000+ for "en".
+	qualifier qualifier
+
+	// When numbers are displayed with a plus or minus sign, e.g. +5 if always.
+	signDisplay signDisplay
+
+	// When trailing fractional zeros are displayed, e.g. 5 for 5.00 if stripped from whole numbers.
+	trailingZeroDisplay trailingZeroDisplay
+
+	// When whole parts are displayed with grouping separators, e.g. 1000 but 10 000 for "pl" by default.
+	grouping grouping
+
+	// Bounds on the numbers of integer and fractional digits displayed, e.g. 007.50 for 7.5 with at least 3 integer
+	// and 2 fractional digits, where a maximum of -1 displays every fractional digit.
+	// Those not set are declared by the number format, e.g. at most 3 fractional digits for #,##0.### in "en".
+	minIntegerDigits                     uint8
+	minFractionDigits, maxFractionDigits int
+	hasIntegerDigits, hasFractionDigits  bool
+
+	// Bounds on the number of significant digits displayed, e.g. 123,000 for 123456 at most 3; 0 for none.
+	// Both fraction digits and significant digits apply to a number according to the rounding priority.
+	minSignificantDigits, maxSignificantDigits uint8
+	roundingPriority                           roundingPriority
 }
 
+type grouping uint8
+
+const (
+	groupingLocale grouping = iota
+	groupingFull
+	groupingMin2
+	groupingNone
+)
+
+type trailingZeroDisplay uint8
+
+const (
+	trailingZerosAuto trailingZeroDisplay = iota
+	trailingZerosStripIfInteger
+)
+
+type signDisplay uint8
+
+const (
+	signAuto signDisplay = iota
+	signAlways
+	signNever
+	signExceptZero
+	signNegative
+)
+
+type qualifier uint8
+
+const (
+	exactly qualifier = iota
+	approximately
+	atLeast
+	atMost
+)
+
 func newNumberFormatter(l string) (numberFormatter, error) {
 	f := numberFormatter{}
 
@@ -57,47 +104,199 @@ func newNumberFormatter(l string) (numberFormatter, error) {
 	return f, nil
 }
 
-func (f numberFormatter) format(w int64, fn uint64, s int8, cs string) (string, error) {
-	fs, err := f.formatFrac(fn, s)
+// A formattedNumber is a formatted number with its affixes kept apart, so that ranges can share them,
+// along with the plural operands of the number it stands for, which select the form of any unit name.
+type formattedNumber struct {
+	prefix, number, suffix string
+
+	ops plural.Operands
+}
+
+func (fn formattedNumber) String() string {
+	return fn.prefix + fn.number + fn.suffix
+}
+
+// format formats d with the formatter's integer and fractional digits, rounding or zero-padding as needed,
+// with cs in place of any currency placeholder. Its significant digits, if any, apply as described in [numberFormatter.round].
+func (f numberFormatter) format(d digits, cs string) (string, error) {
+	fn, err := f.formatParts(d, cs)
 	if err != nil {
 		return "", err
 	}
 
-	sb := strings.Builder{}
+	return f.formatQualified(fn), nil
+}
+
+// formatParts formats d as described in [numberFormatter.format], without any unit name.
+func (f numberFormatter) formatParts(d digits, cs string) (formattedNumber, error) {
+	d, err := f.round(d)
+	if err != nil {
+		return formattedNumber{}, err
+	}
+
+	if f.trailingZeroDisplay == trailingZerosStripIfInteger && strings.Trim(d.frac, "0") == "" {
+		d.frac = ""
+	}
+
+	ops := plural.NewOperands(d.whole, d.frac, 0)
+	neg, zero := d.neg, d.isZero()
+
+	if n := f.integerDigits(); len(d.whole) < n {
+		d.whole = strings.Repeat("0", n-len(d.whole)) + d.whole
+	}
+
+	ns := f.formatNumber(d)
+	prefix, suffix := f.affixes(neg, zero, ns, cs)
+
+	return formattedNumber{prefix, ns, suffix, ops}, nil
+}
+
+// formatNumber formats the whole and fractional parts of d, without its sign or any affixes.
+func (f numberFormatter) formatNumber(d digits) string {
+	ns := f.formatWhole(d.whole)
+	if len(d.frac) > 0 {
+		ns += f.locale.Data.NumberInfo.FractionalSeparator + f.formatFrac(d.frac)
+	}
+
+	return ns
+}
+
+// formatAffixes surrounds the formatted number ns with the pattern's positive or negative affixes,
+// with cs in place of any currency placeholder. ns may also be a symbol such as infinity, e.g. -∞.
+// The number is negative and/or zero, after any rounding, as given; see [numberFormatter.affixes].
+func (f numberFormatter) formatAffixes(neg, zero bool, ns string, cs string) string {
+	prefix, suffix := f.affixes(neg, zero, ns, cs)
+
+	return prefix + ns + suffix
+}
+
+// affixes returns the pattern's prefix and suffix for the formatted number ns that is negative and/or zero as given,
+// with the locale's plus or minus sign if the formatter's sign display calls for one,
+// and cs in place of any currency placeholder, spaced apart from ns as described in [numberFormatter.spaceCurrency].
+//
+// Plus signs go where the pattern puts minus signs, e.g. +$5.00 for -$5.00 in "en", or before the prefix
+// of patterns that display negative numbers without one, e.g. +$5.00 for ($5.00) in the accounting style.
+func (f numberFormatter) affixes(neg, zero bool, ns, cs string) (string, string) {
+	nf := f.numberFormat
+	prefix, suffix := nf.Prefix, nf.Suffix
+
+	switch f.sign(neg, zero) {
+	case -1:
+		prefix, suffix = nf.NegPrefix, nf.NegSuffix
+	case 1:
+		if strings.Contains(nf.NegPrefix+nf.NegSuffix, cldrMinusSignPlaceholder) {
+			r := strings.NewReplacer(cldrMinusSignPlaceholder, cldrPlusSignPlaceholder)
+			prefix, suffix = r.Replace(nf.NegPrefix), r.Replace(nf.NegSuffix)
+		} else {
+			prefix = cldrPlusSignPlaceholder + prefix
+		}
+	}
+
+	prefix, suffix = f.spaceCurrency(prefix, suffix, ns, cs)
+
+	r := strings.NewReplacer(
+		cldrMinusSignPlaceholder, f.locale.Data.NumberInfo.MinusSign,
+		cldrPlusSignPlaceholder, f.locale.Data.NumberInfo.PlusSign,
+		cldrCurSymbolPlaceholder, cs,
+	)
+
+	return r.Replace(prefix), r.Replace(suffix)
+}
+
+// sign returns the sign displayed for a number that is negative and/or zero as given,
+// i.e. -1 for a minus sign, 1 for a plus sign and 0 for neither.
+func (f numberFormatter) sign(neg, zero bool) int {
+	switch f.signDisplay {
+	case signAlways:
+		if neg {
+			return -1
+		}
+
+		return 1
+	case signNever:
+		return 0
+	case signExceptZero:
+		switch {
+		case zero:
+			return 0
+		case neg:
+			return -1
+		default:
+			return 1
+		}
+	case signNegative:
+		if neg && !zero {
+			return -1
+		}
 
-	isNegative := w < 0
-	if isNegative {
-		sb.WriteString(f.numberFormat.NegPrefix)
+		return 0
+	default:
+		if neg {
+			return -1
+		}
+
+		return 0
+	}
+}
 
-		w *= -1
-	} else {
-		sb.WriteString(f.numberFormat.Prefix)
+// formatNonFinite formats the locale's symbol ns for NaN, or for an infinity that is negative as given,
+// with the pattern's affixes and the formatter's sign display and qualifier. NaN is never displayed with a sign.
+func (f numberFormatter) formatNonFinite(neg, nan bool, ns string) string {
+	if nan {
+		f.signDisplay = signNever
 	}
 
-	sb.WriteString(f.formatWhole(uint64(w)))
+	prefix, suffix := f.affixes(neg, false, ns, "")
+
+	return f.formatQualified(formattedNumber{prefix, ns, suffix, plural.Operands{}})
+}
+
+// formatQualified combines the formatted number fn with the locale's pattern for the formatter's qualifier,
+// e.g. 1,000 => 1,000+ in "en" if it is a lower bound, and then with the form of any unit name.
+func (f numberFormatter) formatQualified(fn formattedNumber) string {
+	mp := f.locale.Data.NumberInfo.MiscPatterns
+
+	pattern := "{0}"
 
-	if len(fs) > 0 {
-		sb.WriteString(f.locale.Data.NumberInfo.FractionalSeparator)
-		sb.WriteString(fs)
+	switch f.qualifier {
+	case approximately:
+		pattern = mp.Approximately
+	case atLeast:
+		pattern = mp.AtLeast
+	case atMost:
+		pattern = mp.AtMost
 	}
 
-	if isNegative {
-		sb.WriteString(f.numberFormat.NegSuffix)
-	} else {
-		sb.WriteString(f.numberFormat.Suffix)
+	return f.formatUnit(fn.ops, strings.Replace(pattern, "{0}", fn.String(), 1))
+}
+
+// formatUnit combines the formatted number ns with the form of the formatter's unit name, if any,
+// for the plural category of the number with operands ops, e.g. 1.00 => 1.00 US dollars in "en".
+func (f numberFormatter) formatUnit(ops plural.Operands, ns string) string {
+	if f.unitNames.Other == "" {
+		return ns
 	}
 
-	ns := strings.NewReplacer(cldrCurSymbolPlaceholder, cs).Replace(sb.String())
+	return f.formatUnitForm(plural.Cardinal(f.locale.Code, ops), ns)
+}
 
-	return ns, nil
+// formatUnitForm combines the formatted number ns with the form of the formatter's unit name for the plural category.
+func (f numberFormatter) formatUnitForm(category string, ns string) string {
+	return strings.NewReplacer(
+		"{0}", ns,
+		"{1}", f.unitNames.Form(category),
+	).Replace(f.unitPatterns.Form(category))
 }
 
-func (f numberFormatter) formatWhole(n uint64) string {
-	ns := strconv.FormatUint(n, 10)
+func (f numberFormatter) formatWhole(ns string) string {
 	nss := strings.Split(ns, "")
 	l, bufSize := len(nss), len(nss)
 
 	pgs, sgs := int(f.numberFormat.PrimaryGroupSize), int(f.numberFormat.SecondaryGroupSize)
+	if !f.isGrouped(l) {
+		pgs = l
+	}
+
 	if l > pgs {
 		bufSize += ((l - 1 - pgs) / sgs) + 1
 	}
@@ -106,13 +305,7 @@ func (f numberFormatter) formatWhole(n uint64) string {
 
 	gs, cnt, bi, ni := pgs, 0, bufSize-1, l-1
 	for ni >= 0 {
-		d := nss[ni]
-		if f.locale.Data.NumberInfo.NumberSystem != "latn" {
-			di, _ := strconv.Atoi(d)
-			d = f.locale.Data.NumberInfo.Digits[di]
-		}
-
-		buf[bi] = d
+		buf[bi] = f.localizeDigit(nss[ni])
 
 		cnt++
 		if cnt == gs && bi > 0 {
@@ -129,54 +322,46 @@ func (f numberFormatter) formatWhole(n uint64) string {
 	return strings.Join(buf, "")
 }
 
-func (f numberFormatter) formatFrac(n uint64, s int8) (string, error) {
-	var ns string
+// isGrouped reports whether a whole part with l digits is displayed with grouping separators,
+// i.e. if its highest group would have at least the minimum number of digits for the formatter's grouping,
+// e.g. 10 000 but 1000 for "pl", whose minimum is 2.
+func (f numberFormatter) isGrouped(l int) bool {
+	minimum := int(f.locale.Data.NumberInfo.MinimumGroupingDigits)
+
+	switch f.grouping {
+	case groupingNone:
+		return false
+	case groupingFull:
+		minimum = 1
+	case groupingMin2:
+		minimum = max(minimum, 2)
+	}
 
-	if s > 0 {
-		us := uint8(s)
-		if us > maxSupportedScale {
-			return "", unsupportedScaleError(s)
-		}
-		if countDigits(n) > us {
-			return "", fractionalScaleError(n, us)
-		}
+	return l-int(f.numberFormat.PrimaryGroupSize) >= minimum
+}
 
-		ns = fmt.Sprintf(fracFormats[s], n)
-	} else if s == 0 {
-		if n > 0 {
-			return "", fractionalScaleError(n, 0)
-		}
-		return "", nil
-	} else if s == -1 {
-		ns = strings.TrimRight(strconv.FormatUint(n, 10), "0")
-	} else {
-		return "", unsupportedScaleError(s)
-	}
-
-	if f.locale.Data.NumberInfo.NumberSystem != "latn" {
-		ss := strings.Split(ns, "")
-		for i, d := range ss {
-			di, _ := strconv.Atoi(d)
-			d = f.locale.Data.NumberInfo.Digits[di]
-			ss[i] = d
-		}
+func (f numberFormatter) formatFrac(ns string) string {
+	if f.locale.Data.NumberInfo.NumberSystem == "latn" {
+		return ns
+	}
 
-		ns = strings.Join(ss, "")
+	ss := strings.Split(ns, "")
+	for i, d := range ss {
+		ss[i] = f.localizeDigit(d)
 	}
 
-	return ns, nil
+	return strings.Join(ss, "")
 }
 
-func countDigits(n uint64) uint8 {
-	if n == 0 {
-		return 1
-	}
-	count := 0
-	for n != 0 {
-		n /= 10
-		count++
+// localizeDigit maps a single ASCII digit to the digit of the locale's numbering system.
+func (f numberFormatter) localizeDigit(d string) string {
+	if f.locale.Data.NumberInfo.NumberSystem == "latn" {
+		return d
 	}
-	return uint8(count)
+
+	di, _ := strconv.Atoi(d)
+
+	return f.locale.Data.NumberInfo.Digits[di]
 }
 
 func (f *numberFormatter) setLocale(l string) error {
@@ -187,6 +372,10 @@ func (f *numberFormatter) setLocale(l string) error {
 
 	f.locale = lc
 
+	spacing := lc.Data.NumberInfo.CurrencySpacing
+	f.beforeCurrency = newCurrencySpacingRule(spacing.BeforeCurrency)
+	f.afterCurrency = newCurrencySpacingRule(spacing.AfterCurrency)
+
 	return nil
 }
 
@@ -194,8 +383,20 @@ func (f *numberFormatter) useStandardDecimalFormat() {
 	f.numberFormat = f.locale.Data.NumberInfo.Formats.StandardDecimal
 }
 
-func (f *numberFormatter) useStandardCurrencyAlphaFormat() {
-	f.numberFormat = f.locale.Data.NumberInfo.Formats.StandardCurrencyAlpha
+// useStandardPercentFormat uses the locale's percent format, with sym in place of
+// the percent placeholder, e.g. the locale's per mille sign.
+func (f *numberFormatter) useStandardPercentFormat(sym string) {
+	nf := f.locale.Data.NumberInfo.Formats.StandardPercent
+	r := strings.NewReplacer(cldrPercentSymbolPlaceholder, sym)
+
+	nf.Prefix, nf.Suffix = r.Replace(nf.Prefix), r.Replace(nf.Suffix)
+	nf.NegPrefix, nf.NegSuffix = r.Replace(nf.NegPrefix), r.Replace(nf.NegSuffix)
+
+	f.numberFormat = nf
+}
+
+func (f *numberFormatter) useStandardScientificFormat() {
+	f.numberFormat = f.locale.Data.NumberInfo.Formats.StandardScientific
 }
 
 func (f *numberFormatter) useStandardCurrencySymbolFormat() {
@@ -206,10 +407,6 @@ func (f *numberFormatter) useStandardCurrencyNoSymbolFormat() {
 	f.numberFormat = f.locale.Data.NumberInfo.Formats.StandardCurrencyNoSymbol
 }
 
-func (f *numberFormatter) useAccountingCurrencyAlphaFormat() {
-	f.numberFormat = f.locale.Data.NumberInfo.Formats.AccountingCurrencyAlpha
-}
-
 func (f *numberFormatter) useAccountingCurrencySymbolFormat() {
 	f.numberFormat = f.locale.Data.NumberInfo.Formats.AccountingCurrencySymbol
 }
@@ -0,0 +1,11 @@
+module github.com/ttzhou/cldr/num/govaluesnum
+
+go 1.25.1
+
+require (
+	github.com/govalues/decimal v0.1.29
+	github.com/govalues/money v0.2.3
+	github.com/ttzhou/cldr v0.0.0-20261017062059-42c09120b7ae
+)
+
+replace github.com/ttzhou/cldr => ../..
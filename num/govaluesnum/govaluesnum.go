@@ -0,0 +1,76 @@
+// Package govaluesnum formats [github.com/govalues/money] and [github.com/govalues/decimal]
+// values with the formatters of package [github.com/ttzhou/cldr/num].
+//
+// It is a separate module so that package num itself stays free of dependencies.
+package govaluesnum
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/money"
+	"github.com/ttzhou/cldr/num"
+)
+
+// FormatAmount formats the amount a with mf in the amount's currency, using the amount's own scale and sign,
+// e.g. USD 91411.206 => $91,411.206 for "en" with [num.MoneyFormatter.DisplayCurrencyAsSymbol].
+//
+// A non-nil error is returned under the same conditions as [num.MoneyFormatter.FormatCoef].
+func FormatAmount(mf num.MoneyFormatter, a money.Amount) (string, error) {
+	mf.UseAmountScale()
+
+	return formatMoney(mf, a.Decimal(), a.Curr())
+}
+
+// MustFormatAmount calls [FormatAmount], and panics if it returns a non-nil error.
+func MustFormatAmount(mf num.MoneyFormatter, a money.Amount) string {
+	s, err := FormatAmount(mf, a)
+	if err != nil {
+		panic(fmt.Errorf("in govaluesnum.MustFormatAmount: %w", err))
+	}
+
+	return s
+}
+
+// FormatExchangeRate formats the exchange rate r with mf as an amount of its quote currency per unit of
+// its base currency, using the rate's own scale, e.g. EUR/USD 1.0850 => $1.0850 for "en".
+//
+// A non-nil error is returned under the same conditions as [num.MoneyFormatter.FormatCoef].
+func FormatExchangeRate(mf num.MoneyFormatter, r money.ExchangeRate) (string, error) {
+	mf.UseAmountScale()
+
+	return formatMoney(mf, r.Decimal(), r.Quote())
+}
+
+// MustFormatExchangeRate calls [FormatExchangeRate], and panics if it returns a non-nil error.
+func MustFormatExchangeRate(mf num.MoneyFormatter, r money.ExchangeRate) string {
+	s, err := FormatExchangeRate(mf, r)
+	if err != nil {
+		panic(fmt.Errorf("in govaluesnum.MustFormatExchangeRate: %w", err))
+	}
+
+	return s
+}
+
+// FormatDecimal formats the decimal d with df, using the decimal's own coefficient, scale and sign without loss,
+// e.g. 1.50 => 1.5 for "en". The number is displayed with df's configuration as described in
+// [num.DecimalFormatter.FormatCoef], e.g. 1.50 => 1.50 after df.SetScale(int8(d.Scale())).
+//
+// A non-nil error is returned under the same conditions as [num.DecimalFormatter.FormatCoef].
+func FormatDecimal(df num.DecimalFormatter, d decimal.Decimal) (string, error) {
+	return df.FormatCoef(d.IsNeg(), d.Coef(), -int32(d.Scale()))
+}
+
+// MustFormatDecimal calls [FormatDecimal], and panics if it returns a non-nil error.
+func MustFormatDecimal(df num.DecimalFormatter, d decimal.Decimal) string {
+	s, err := FormatDecimal(df, d)
+	if err != nil {
+		panic(fmt.Errorf("in govaluesnum.MustFormatDecimal: %w", err))
+	}
+
+	return s
+}
+
+func formatMoney(mf num.MoneyFormatter, d decimal.Decimal, c money.Currency) (string, error) {
+	return mf.FormatCoef(d.IsNeg(), d.Coef(), -int32(d.Scale()), c.Code())
+}
@@ -0,0 +1,93 @@
+package govaluesnum_test
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/money"
+	"github.com/ttzhou/cldr/num"
+	"github.com/ttzhou/cldr/num/govaluesnum"
+)
+
+func TestGovaluesnum(t *testing.T) {
+	t.Run("FormatAmount()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			amount   money.Amount
+			expected string
+		}{
+			{"en", money.MustNewAmount("USD", 91411206, 3), "USD\u00a091,411.206"},
+			{"en", money.MustNewAmount("USD", 5, 0), "USD\u00a05.00"},
+			{"en", money.MustNewAmount("USD", -5, 1), "-USD\u00a00.50"},
+			{"en", money.MustNewAmount("JPY", 1500, 0), "JPY\u00a01,500"},
+			{"fr", money.MustNewAmount("EUR", -100010, 2), "-1\u202f000,10\u00a0EUR"},
+		} {
+			mf := num.MustNewMoneyFormatter(tc.locale)
+
+			actual, err := govaluesnum.FormatAmount(mf, tc.amount)
+			if err != nil {
+				t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+				continue
+			}
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+
+			actual = govaluesnum.MustFormatAmount(mf, tc.amount)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+	})
+
+	t.Run("FormatExchangeRate()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			rate     money.ExchangeRate
+			expected string
+		}{
+			{"en", money.MustNewExchRate("EUR", "USD", 10850, 4), "USD\u00a01.0850"},
+			{"de", money.MustNewExchRate("USD", "EUR", 92, 2), "0,92\u00a0EUR"},
+		} {
+			mf := num.MustNewMoneyFormatter(tc.locale)
+
+			actual := govaluesnum.MustFormatExchangeRate(mf, tc.rate)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+	})
+
+	t.Run("FormatDecimal()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			scale    int8
+			decimal  decimal.Decimal
+			expected string
+		}{
+			{"en", -1, decimal.MustNew(150, 2), "1.5"},
+			{"en", 2, decimal.MustNew(150, 2), "1.50"},
+			{"en", 3, decimal.MustNew(150, 2), "1.500"},
+			{"en", -1, decimal.MustNew(-1001, 2), "-10.01"},
+			{"en", -1, decimal.MustNew(1234567, 0), "1,234,567"},
+			{"en", -1, decimal.MustNew(-9223372036854775807, 19), "-0.9223372036854775807"},
+			{"bn", -1, decimal.MustNew(123456789, 3), "১,২৩,৪৫৬.৭৮৯"},
+		} {
+			df := num.MustNewDecimalFormatter(tc.locale)
+			df.MustSetScale(tc.scale)
+
+			actual := govaluesnum.MustFormatDecimal(df, tc.decimal)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+
+		df := num.MustNewDecimalFormatter("en")
+		df.MustSetFractionDigits(0, 1)
+		df.SetRoundingMode(num.RoundHalfEven)
+
+		if actual := govaluesnum.MustFormatDecimal(df, decimal.MustNew(125, 2)); actual != "1.2" {
+			t.Errorf("got: %v, expected: 1.2", actual)
+		}
+	})
+}
@@ -2,8 +2,8 @@ package num
 
 import (
 	"fmt"
-	"slices"
-	"unicode"
+	"math/big"
+	"strconv"
 
 	"github.com/ttzhou/cldr/internal/locale"
 )
@@ -12,6 +12,13 @@ import (
 // monetary amounts using CLDR data.
 type MoneyFormatter struct {
 	useAccountingStyle bool
+	useCashRounding    bool
+	useAmountScale     bool
+
+	roundingIncrement roundingIncrement
+
+	notation                 notation
+	compactSignificantDigits uint8
 
 	currencyStyle currencyStyle
 	currencyLabel currencyLabel
@@ -32,6 +39,7 @@ func NewMoneyFormatter(l string) (MoneyFormatter, error) {
 	}
 
 	mf.numberFormatter = f
+	mf.compactSignificantDigits = defaultCompactSignificantDigits
 	mf.UseStandardStyle()
 	mf.DisplayCurrencyAsCode()
 
@@ -62,6 +70,14 @@ func (mf *MoneyFormatter) MustSetLocale(l string) {
 	}
 }
 
+// SetRoundingMode changes how amounts with more fractional digits than
+// the currency's minor digits are rounded, e.g. 1.005 => 1.01 USD under [RoundHalfUp].
+//
+// The default is [RoundUnnecessary], under which such amounts cannot be formatted.
+func (mf *MoneyFormatter) SetRoundingMode(m RoundingMode) {
+	mf.numberFormatter.roundingMode = m
+}
+
 // UseStandardStyle indicates that monetary amounts should be formatted
 // in the standard, non-accounting style defined by CLDR for the current locale, if relevant.
 func (mf *MoneyFormatter) UseStandardStyle() {
@@ -74,6 +90,127 @@ func (mf *MoneyFormatter) UseAccountingStyle() {
 	mf.useAccountingStyle = true
 }
 
+// SetRoundingIncrement changes the increment that monetary amounts are rounded to a multiple of,
+// e.g. "0.25" for commodities quoted in quarter ticks, overriding the currency's own increments.
+// Amounts are formatted with at least the increment's scale, and rounded using the formatter's [RoundingMode].
+//
+// A zero increment, e.g. "0", restores the default of using the currency's rounding increment
+// defined by CLDR, if any (see [MoneyFormatter.UseStandardRounding] and [MoneyFormatter.UseCashRounding]).
+//
+// An error is returned if the increment is not a non-negative decimal string
+// with at most 20 fractional digits.
+func (mf *MoneyFormatter) SetRoundingIncrement(inc string) error {
+	ri, err := parseRoundingIncrement(inc)
+	if err != nil {
+		return err
+	}
+
+	mf.roundingIncrement = ri
+
+	return nil
+}
+
+// MustSetRoundingIncrement calls [MoneyFormatter.SetRoundingIncrement], and panics if it returns an error.
+func (mf *MoneyFormatter) MustSetRoundingIncrement(inc string) {
+	if err := mf.SetRoundingIncrement(inc); err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustSetRoundingIncrement: %w", err))
+	}
+}
+
+// UseStandardRounding indicates that monetary amounts should be rounded
+// to the currency's minor digits and rounding increment defined by CLDR, e.g. 0.01 for CHF.
+func (mf *MoneyFormatter) UseStandardRounding() {
+	mf.useCashRounding = false
+}
+
+// UseCashRounding indicates that monetary amounts should be rounded
+// to the smallest cash denomination defined by CLDR for the currency, if any,
+// e.g. 0.05 for CHF, or no minor digits for CZK.
+//
+// Amounts are rounded using the formatter's [RoundingMode].
+func (mf *MoneyFormatter) UseCashRounding() {
+	mf.useCashRounding = true
+}
+
+// UseCurrencyScale indicates that monetary amounts should be displayed with the currency's
+// minor digits (or those of the rounding increment in use), rounding any extra fractional digits.
+// This is the default.
+func (mf *MoneyFormatter) UseCurrencyScale() {
+	mf.useAmountScale = false
+}
+
+// UseAmountScale indicates that monetary amounts with more fractional digits than would be displayed under
+// [MoneyFormatter.UseCurrencyScale] should be displayed with all of their digits instead of being rounded,
+// e.g. neg false, coef 91411206, exp -3 => 91,411.206 USD.
+//
+// Amounts with fewer fractional digits are still zero-padded and rounded as described in [MoneyFormatter.Format].
+func (mf *MoneyFormatter) UseAmountScale() {
+	mf.useAmountScale = true
+}
+
+// UseStandardNotation indicates that monetary amounts should be displayed in full, e.g. $1,234,567.00 for "en".
+// This is the default.
+func (mf *MoneyFormatter) UseStandardNotation() {
+	mf.notation = standardNotation
+}
+
+// UseCompactShortNotation indicates that monetary amounts should be displayed using the short compact currency
+// patterns defined by CLDR for the current locale, e.g. 1234567 USD => $1.2M for "en" and 1,2 Mio. $ for "de",
+// with the currency label and standard or accounting style in use.
+//
+// The currency's minor digits and rounding increments do not apply in compact notation;
+// see [MoneyFormatter.SetCompactSignificantDigits] instead.
+func (mf *MoneyFormatter) UseCompactShortNotation() {
+	mf.notation = compactShortNotation
+}
+
+// SetCompactSignificantDigits changes the number of significant digits that amounts are rounded to
+// in compact notation, using the formatter's [RoundingMode], or [RoundHalfEven] under [RoundUnnecessary],
+// e.g. 1234567 USD => $1.23M with 3 significant digits for "en".
+// Whole digits of a compacted amount are never rounded away, e.g. 123456 USD => $123K, and trailing fractional zeros are
+// not displayed. The default is 2, e.g. 1234567 USD => $1.2M, and a value of 0 displays every digit of the compacted amount.
+//
+// An error is returned if n is greater than the max supported scale = 20.
+func (mf *MoneyFormatter) SetCompactSignificantDigits(n uint8) error {
+	if n > maxSupportedScale {
+		return unsupportedSignificantDigitsError(n)
+	}
+
+	mf.compactSignificantDigits = n
+
+	return nil
+}
+
+// MustSetCompactSignificantDigits calls [MoneyFormatter.SetCompactSignificantDigits], and panics if it returns an error.
+func (mf *MoneyFormatter) MustSetCompactSignificantDigits(n uint8) {
+	if err := mf.SetCompactSignificantDigits(n); err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustSetCompactSignificantDigits: %w", err))
+	}
+}
+
+// UseLocaleGrouping informs the formatter that the whole parts of amounts should be grouped as is usual for the locale, i.e. unless
+// they have fewer digits than the locale's CLDR minimum in their highest group, e.g. 1000 but 10 000 for "pl".
+// This is the default.
+func (mf *MoneyFormatter) UseLocaleGrouping() {
+	mf.numberFormatter.grouping = groupingLocale
+}
+
+// UseFullGrouping informs the formatter that the whole parts of amounts should always be grouped, e.g. 1 000 for "pl".
+func (mf *MoneyFormatter) UseFullGrouping() {
+	mf.numberFormatter.grouping = groupingFull
+}
+
+// UseMin2Grouping informs the formatter that the whole parts of amounts should be grouped as is usual for the locale,
+// but only if they have at least 2 digits in their highest group, e.g. 1000 but 10,000 for "en".
+func (mf *MoneyFormatter) UseMin2Grouping() {
+	mf.numberFormatter.grouping = groupingMin2
+}
+
+// UseNoGrouping informs the formatter that the whole parts of amounts should never be grouped, e.g. 1000000.
+func (mf *MoneyFormatter) UseNoGrouping() {
+	mf.numberFormatter.grouping = groupingNone
+}
+
 // DisplayCurrencyAsCode informs the formatter to format currency labels as its 3 letter ISO code.
 func (mf *MoneyFormatter) DisplayCurrencyAsCode() {
 	mf.currencyStyle = code
@@ -89,6 +226,78 @@ func (mf *MoneyFormatter) DisplayCurrencyAsSymbolNarrow() {
 	mf.currencyStyle = symbolnarrow
 }
 
+// DisplayCurrencyAsName informs the formatter to format currency labels as its CLDR display name,
+// in the form for the plural category of the formatted amount, e.g. 1,00 euro and 2,00 euros for "fr".
+// Amounts are formatted as they are with no currency symbol, and combined with the name using the locale's patterns.
+func (mf *MoneyFormatter) DisplayCurrencyAsName() {
+	mf.currencyStyle = name
+}
+
+// DisplayExactly informs the formatter to format amounts as they are. This is the default.
+func (mf *MoneyFormatter) DisplayExactly() {
+	mf.numberFormatter.qualifier = exactly
+}
+
+// DisplayApproximately informs the formatter to format amounts as approximate,
+// using the locale's CLDR pattern, e.g. ~$1,000.00 for "en" and ≈1 000,00 € for "fr".
+func (mf *MoneyFormatter) DisplayApproximately() {
+	mf.numberFormatter.qualifier = approximately
+}
+
+// DisplayAtLeast informs the formatter to format amounts as lower bounds,
+// using the locale's CLDR pattern, e.g. $1,000.00+ for "en" and ≥1 000,00 € for "fr".
+func (mf *MoneyFormatter) DisplayAtLeast() {
+	mf.numberFormatter.qualifier = atLeast
+}
+
+// DisplayAtMost informs the formatter to format amounts as upper bounds,
+// using the locale's CLDR pattern, e.g. ≤$1,000.00 for "en".
+func (mf *MoneyFormatter) DisplayAtMost() {
+	mf.numberFormatter.qualifier = atMost
+}
+
+// DisplaySignAuto informs the formatter that amounts should be displayed with a minus sign if negative,
+// and no sign otherwise. This is the default.
+func (mf *MoneyFormatter) DisplaySignAuto() {
+	mf.numberFormatter.signDisplay = signAuto
+}
+
+// DisplaySignAlways informs the formatter that amounts should be displayed with a plus or minus sign,
+// using the locale's CLDR symbols, e.g. +$5.00 and -$5.00 for "en",
+// or +$5.00 and ($5.00) in the accounting style.
+func (mf *MoneyFormatter) DisplaySignAlways() {
+	mf.numberFormatter.signDisplay = signAlways
+}
+
+// DisplaySignNever informs the formatter that amounts should be displayed without a sign, e.g. $5.00 for -$5.00.
+func (mf *MoneyFormatter) DisplaySignNever() {
+	mf.numberFormatter.signDisplay = signNever
+}
+
+// DisplaySignExceptZero informs the formatter that amounts should be displayed with a plus or minus sign,
+// as described in [MoneyFormatter.DisplaySignAlways], unless they are zero after rounding, e.g. $0.00.
+func (mf *MoneyFormatter) DisplaySignExceptZero() {
+	mf.numberFormatter.signDisplay = signExceptZero
+}
+
+// DisplaySignNegative informs the formatter that amounts should be displayed with a minus sign
+// if negative, unless they are zero after rounding, and no sign otherwise.
+func (mf *MoneyFormatter) DisplaySignNegative() {
+	mf.numberFormatter.signDisplay = signNegative
+}
+
+// DisplayTrailingZerosAuto informs the formatter that amounts should be displayed with as many trailing fractional
+// zeros as the currency's minor digits call for, e.g. $5.00 for "en". This is the default.
+func (mf *MoneyFormatter) DisplayTrailingZerosAuto() {
+	mf.numberFormatter.trailingZeroDisplay = trailingZerosAuto
+}
+
+// DisplayTrailingZerosStripIfInteger informs the formatter that amounts should be displayed without a fractional part
+// if it is entirely zero after rounding, e.g. $5 but $5.50 for "en", and as usual otherwise.
+func (mf *MoneyFormatter) DisplayTrailingZerosStripIfInteger() {
+	mf.numberFormatter.trailingZeroDisplay = trailingZerosStripIfInteger
+}
+
 // DisplayNoCurrency informs the formatter to format with no currency symbol.
 func (mf *MoneyFormatter) DisplayNoCurrency() {
 	mf.currencyStyle = none
@@ -96,20 +305,19 @@ func (mf *MoneyFormatter) DisplayNoCurrency() {
 
 // Format formats a given number's whole and fractional parts into a locale-aware string
 // for the given currency.
+// A fractional part with more digits than the currency's minor digits is read as written,
+// e.g. whole 9, frac 999 => 9.999, and rounded using the formatter's [RoundingMode].
+//
 // A non-nil error is returned if:
 //   - the currency is not supported for the formatter's currently set locale
-//   - the fractional part exceeds the number of minor digits for the currency (e.g. 2 minor units would fail for JPY, which has no minor, but not for USD)
+//   - the fractional part exceeds the number of minor digits for the currency and the
+//     rounding mode is [RoundUnnecessary] (e.g. 2 minor units would fail for JPY, which has no minor, but not for USD)
+//   - the rounding mode is [RoundUnnecessary] and the amount is not a multiple of the rounding increment in use
+//     (e.g. 1.03 CHF under cash rounding)
 func (mf MoneyFormatter) Format(w int64, f uint64, c string) (string, error) {
-	ci, setCurrencyErr := mf.setCurrency(c)
-	if setCurrencyErr != nil {
-		return "", setCurrencyErr
-	}
-
-	s, formatErr := mf.numberFormatter.format(w, f, int8(ci.MinorDigits), string(mf.currencyLabel))
-	if formatErr != nil {
-		return "", fmt.Errorf("%w (%s)", formatErr, c)
-	}
-	return s, nil
+	return mf.format(c, func(cd locale.CurrencyData) (digits, error) {
+		return newDigits(w, f, int8(cd.MinorDigits))
+	})
 }
 
 // MustFormat calls [MoneyFormatter.Format], and panics if it returns a non-nil error.
@@ -122,6 +330,288 @@ func (mf MoneyFormatter) MustFormat(w int64, f uint64, c string) string {
 	return s
 }
 
+// FormatCoef formats the number (-1)^neg × coef × 10^exp into a locale-aware string for the given currency,
+// e.g. neg true, coef 1001, exp -2 => -10.01. This represents every decimal amount exactly,
+// including those with leading fractional zeros and negative amounts with a zero whole part.
+//
+// The amount is rounded to the currency's minor digits as described in [MoneyFormatter.Format],
+// which also describes the errors returned. An error is also returned if exp is not between -1000 and 1000.
+func (mf MoneyFormatter) FormatCoef(neg bool, coef uint64, exp int32, c string) (string, error) {
+	return mf.format(c, func(locale.CurrencyData) (digits, error) {
+		if err := validateExponent(exp); err != nil {
+			return digits{}, err
+		}
+
+		return newDigitsFromCoef(neg, strconv.FormatUint(coef, 10), int(exp)), nil
+	})
+}
+
+// MustFormatCoef calls [MoneyFormatter.FormatCoef], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatCoef(neg bool, coef uint64, exp int32, c string) string {
+	s, err := mf.FormatCoef(neg, coef, exp, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatCoef: %w", err))
+	}
+
+	return s
+}
+
+// FormatDecimal formats the [Decimal] amount x into a locale-aware string for the given currency,
+// e.g. a coefficient of "1001" and exponent of -2 => 10.01.
+//
+// The amount is rounded to the currency's minor digits as described in [MoneyFormatter.Format],
+// which also describes the errors returned. An error is also returned if the coefficient of x
+// is not made of ASCII decimal digits, or if its exponent is not between -1000 and 1000.
+func (mf MoneyFormatter) FormatDecimal(x Decimal, c string) (string, error) {
+	return mf.format(c, func(locale.CurrencyData) (digits, error) {
+		return newDigitsFromDecimal(x)
+	})
+}
+
+// MustFormatDecimal calls [MoneyFormatter.FormatDecimal], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatDecimal(x Decimal, c string) string {
+	s, err := mf.FormatDecimal(x, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatDecimal: %w", err))
+	}
+
+	return s
+}
+
+// FormatBigInt formats the whole amount x into a locale-aware string for the given currency,
+// e.g. 1000000000000000000000 => 1,000,000,000,000,000,000,000.00 USD.
+//
+// A non-nil error is returned if the currency is not supported for the formatter's currently set locale.
+func (mf MoneyFormatter) FormatBigInt(x *big.Int, c string) (string, error) {
+	return mf.format(c, func(locale.CurrencyData) (digits, error) {
+		return newDigitsFromBigInt(x), nil
+	})
+}
+
+// MustFormatBigInt calls [MoneyFormatter.FormatBigInt], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatBigInt(x *big.Int, c string) string {
+	s, err := mf.FormatBigInt(x, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatBigInt: %w", err))
+	}
+
+	return s
+}
+
+// FormatBigRat formats the rational amount x into a locale-aware string for the given currency,
+// e.g. 1/3 => 0.33 USD under [RoundHalfEven].
+//
+// The amount is rounded once, directly to the currency's minor digits and rounding increment, as described in
+// [MoneyFormatter.Format], which also describes the errors returned; as rationals have no scale of their own,
+// this also applies under [MoneyFormatter.UseAmountScale]. An error is also returned if the amount cannot be
+// represented exactly with the currency's minor digits and the rounding mode is [RoundUnnecessary].
+func (mf MoneyFormatter) FormatBigRat(x *big.Rat, c string) (string, error) {
+	return mf.format(c, func(cd locale.CurrencyData) (digits, error) {
+		s, inc, err := mf.roundingScale(cd)
+		if err != nil {
+			return digits{}, err
+		}
+
+		return newDigitsFromBigRat(x, s, inc, mf.numberFormatter.roundingMode)
+	})
+}
+
+// MustFormatBigRat calls [MoneyFormatter.FormatBigRat], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatBigRat(x *big.Rat, c string) string {
+	s, err := mf.FormatBigRat(x, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatBigRat: %w", err))
+	}
+
+	return s
+}
+
+// FormatBigFloat formats the floating-point amount x into a locale-aware string for the given currency,
+// using the fewest digits that uniquely identify x at its precision.
+//
+// The amount is rounded to the currency's minor digits as described in [MoneyFormatter.Format],
+// which also describes the errors returned. An error is also returned if x is infinite.
+func (mf MoneyFormatter) FormatBigFloat(x *big.Float, c string) (string, error) {
+	return mf.format(c, func(locale.CurrencyData) (digits, error) {
+		return newDigitsFromBigFloat(x)
+	})
+}
+
+// MustFormatBigFloat calls [MoneyFormatter.FormatBigFloat], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatBigFloat(x *big.Float, c string) string {
+	s, err := mf.FormatBigFloat(x, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatBigFloat: %w", err))
+	}
+
+	return s
+}
+
+// FormatString formats the ASCII decimal string x into a locale-aware string for the given currency,
+// e.g. "-123456789012345678901234.5678". The string may have a leading sign, and
+// must have digits on both sides of the decimal point, if any.
+//
+// The amount is rounded to the currency's minor digits as described in [MoneyFormatter.Format],
+// which also describes the errors returned. An error is also returned if x is not a valid decimal string.
+func (mf MoneyFormatter) FormatString(x string, c string) (string, error) {
+	return mf.format(c, func(locale.CurrencyData) (digits, error) {
+		return parseDigits(x)
+	})
+}
+
+// MustFormatString calls [MoneyFormatter.FormatString], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatString(x string, c string) string {
+	s, err := mf.FormatString(x, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatString: %w", err))
+	}
+
+	return s
+}
+
+// FormatStringRange formats the range from the ASCII decimal string x to y into a locale-aware string
+// for the given currency, e.g. "3" and "5" => $3.00 – $5.00 for "en". Both ends are read as described
+// in [MoneyFormatter.FormatString], and rounded as described in [MoneyFormatter.Format].
+//
+// Currency labels and other affixes shared by both ends are displayed once where the locale allows,
+// e.g. 3,00–5,00 € for "de", as are currency names, in the form for the plural category of the range.
+// Ends that are displayed the same after rounding are displayed once as an approximate amount, e.g. ~$3.00.
+// Ranges are not displayed as bounds, e.g. with [MoneyFormatter.DisplayAtLeast].
+//
+// The errors returned are those described in [MoneyFormatter.FormatString].
+func (mf MoneyFormatter) FormatStringRange(x, y string, c string) (string, error) {
+	return mf.formatRange(c,
+		func(locale.CurrencyData) (digits, error) { return parseDigits(x) },
+		func(locale.CurrencyData) (digits, error) { return parseDigits(y) },
+	)
+}
+
+// MustFormatStringRange calls [MoneyFormatter.FormatStringRange], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatStringRange(x, y string, c string) string {
+	s, err := mf.FormatStringRange(x, y, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatStringRange: %w", err))
+	}
+
+	return s
+}
+
+// FormatDecimalRange formats the range from the [Decimal] amount x to y into a locale-aware string
+// for the given currency, as described in [MoneyFormatter.FormatStringRange].
+//
+// The errors returned are those described in [MoneyFormatter.FormatDecimal].
+func (mf MoneyFormatter) FormatDecimalRange(x, y Decimal, c string) (string, error) {
+	return mf.formatRange(c,
+		func(locale.CurrencyData) (digits, error) { return newDigitsFromDecimal(x) },
+		func(locale.CurrencyData) (digits, error) { return newDigitsFromDecimal(y) },
+	)
+}
+
+// MustFormatDecimalRange calls [MoneyFormatter.FormatDecimalRange], and panics if it returns a non-nil error.
+func (mf MoneyFormatter) MustFormatDecimalRange(x, y Decimal, c string) string {
+	s, err := mf.FormatDecimalRange(x, y, c)
+	if err != nil {
+		panic(fmt.Errorf("in MoneyFormatter.MustFormatDecimalRange: %w", err))
+	}
+
+	return s
+}
+
+// format formats the digits returned by toDigits for currency c,
+// which is given the currency's data so that inputs relative to its minor digits can be interpreted.
+func (mf MoneyFormatter) format(c string, toDigits func(locale.CurrencyData) (digits, error)) (string, error) {
+	f, fns, err := mf.formatParts(c, toDigits)
+	if err != nil {
+		return "", err
+	}
+
+	return f.formatQualified(fns[0]), nil
+}
+
+// formatRange formats the range from the digits returned by toStart to those returned by toEnd for currency c.
+func (mf MoneyFormatter) formatRange(c string, toStart, toEnd func(locale.CurrencyData) (digits, error)) (string, error) {
+	f, fns, err := mf.formatParts(c, toStart, toEnd)
+	if err != nil {
+		return "", err
+	}
+
+	return f.formatRange(fns[0], fns[1]), nil
+}
+
+// formatParts formats the digits returned by each of toDigits for currency c, with their affixes kept apart.
+// It also returns the number formatter set up for the currency, which displays any currency names.
+func (mf MoneyFormatter) formatParts(
+	c string, toDigits ...func(locale.CurrencyData) (digits, error),
+) (numberFormatter, []formattedNumber, error) {
+	ci, setCurrencyErr := mf.setCurrency(c)
+	if setCurrencyErr != nil {
+		return mf.numberFormatter, nil, setCurrencyErr
+	}
+
+	scale, inc, incErr := mf.roundingScale(ci)
+	if incErr != nil {
+		return mf.numberFormatter, nil, fmt.Errorf("%w (%s)", incErr, c)
+	}
+
+	fns := make([]formattedNumber, len(toDigits))
+
+	for i, td := range toDigits {
+		d, err := td(ci)
+		if err != nil {
+			return mf.numberFormatter, nil, fmt.Errorf("%w (%s)", err, c)
+		}
+
+		if mf.notation == compactShortNotation {
+			fn, compactErr := mf.numberFormatter.formatCompactParts(
+				d, mf.compactFormats(), mf.compactSignificantDigits, string(mf.currencyLabel),
+			)
+			if compactErr != nil {
+				return mf.numberFormatter, nil, fmt.Errorf("%w (%s)", compactErr, c)
+			}
+
+			fns[i] = fn
+
+			continue
+		}
+
+		s, f := int(scale), mf.numberFormatter
+		if mf.useAmountScale && len(d.frac) > s {
+			s, f.roundingIncrement = len(d.frac), 0
+		} else {
+			f.roundingIncrement = inc
+		}
+
+		f.setFractionDigits(s, s)
+
+		fn, formatErr := f.formatParts(d, string(mf.currencyLabel))
+		if formatErr != nil {
+			return mf.numberFormatter, nil, fmt.Errorf("%w (%s)", formatErr, c)
+		}
+
+		fns[i] = fn
+	}
+
+	return mf.numberFormatter, fns, nil
+}
+
+// roundingScale returns the scale that amounts in the currency described by cd are rounded to,
+// and the rounding increment as a coefficient at that scale, from the currency's minor digits and rounding,
+// its cash digits and rounding under cash rounding, or the formatter's rounding increment if set.
+//
+// An error is returned if the formatter's rounding increment cannot be expressed at the currency's scale.
+func (mf MoneyFormatter) roundingScale(cd locale.CurrencyData) (uint8, uint64, error) {
+	scale, inc := cd.MinorDigits, uint64(cd.Rounding)
+	if mf.useCashRounding {
+		scale, inc = cd.CashDigits, uint64(cd.CashRounding)
+	}
+
+	if !mf.roundingIncrement.isZero() {
+		return mf.roundingIncrement.at(scale)
+	}
+
+	return scale, inc, nil
+}
+
 type currencyStyle uint8
 
 const (
@@ -129,6 +619,7 @@ const (
 	symbol
 	symbolnarrow
 	none
+	name
 )
 
 type currencyLabel string
@@ -137,10 +628,6 @@ func (cl currencyLabel) isEmpty() bool {
 	return len(cl) == 0
 }
 
-func (cl currencyLabel) containsAlphaChars() bool {
-	return slices.ContainsFunc([]rune(cl), func(r rune) bool { return unicode.IsLetter(r) })
-}
-
 func (mf *MoneyFormatter) setCurrency(c string) (locale.CurrencyData, error) {
 	cd, ok := mf.numberFormatter.locale.Data.SupportedCurrencies[c]
 	if !ok {
@@ -154,27 +641,51 @@ func (mf *MoneyFormatter) setCurrency(c string) (locale.CurrencyData, error) {
 		mf.currencyLabel = currencyLabel(cd.DisplaySymbol)
 	case symbolnarrow:
 		mf.currencyLabel = currencyLabel(cd.DisplaySymbolNarrow)
-	case none:
+	case none, name:
 		mf.currencyLabel = currencyLabel("")
 	}
 
-	if !mf.useAccountingStyle {
-		if mf.currencyLabel.isEmpty() {
-			mf.numberFormatter.useStandardCurrencyNoSymbolFormat()
-		} else if mf.currencyLabel.containsAlphaChars() {
-			mf.numberFormatter.useStandardCurrencyAlphaFormat()
-		} else {
-			mf.numberFormatter.useStandardCurrencySymbolFormat()
-		}
-	} else {
-		if mf.currencyLabel.isEmpty() {
-			mf.numberFormatter.useAccountingCurrencyNoSymbolFormat()
-		} else if mf.currencyLabel.containsAlphaChars() {
-			mf.numberFormatter.useAccountingCurrencyAlphaFormat()
-		} else {
-			mf.numberFormatter.useAccountingCurrencySymbolFormat()
-		}
+	mf.numberFormatter.unitNames = locale.PluralForms{}
+
+	if mf.currencyStyle == name {
+		mf.numberFormatter.unitNames = currencyNames(cd)
+		mf.numberFormatter.unitPatterns = mf.numberFormatter.locale.Data.NumberInfo.CurrencyUnitPatterns
+	}
+
+	// Labels are spaced apart from amounts by the locale's currency spacing rules, e.g. USD 5.00 but $5.00 in "en".
+	switch {
+	case !mf.useAccountingStyle && mf.currencyLabel.isEmpty():
+		mf.numberFormatter.useStandardCurrencyNoSymbolFormat()
+	case !mf.useAccountingStyle:
+		mf.numberFormatter.useStandardCurrencySymbolFormat()
+	case mf.currencyLabel.isEmpty():
+		mf.numberFormatter.useAccountingCurrencyNoSymbolFormat()
+	default:
+		mf.numberFormatter.useAccountingCurrencySymbolFormat()
 	}
 
 	return cd, nil
 }
+
+// compactFormats returns the locale's short compact patterns for the currency label in use,
+// which are those of decimals if there is no label.
+func (mf MoneyFormatter) compactFormats() []locale.CompactFormat {
+	cfs := mf.numberFormatter.locale.Data.NumberInfo.CompactFormats
+
+	if mf.currencyLabel.isEmpty() {
+		return cfs.ShortDecimal
+	}
+
+	return cfs.ShortCurrencySymbol
+}
+
+// currencyNames returns the display names of a currency by plural category, with its
+// display name for the "other" category if it has no name specific to it.
+func currencyNames(cd locale.CurrencyData) locale.PluralForms {
+	names := cd.DisplayNames
+	if names.Other == "" {
+		names.Other = cd.DisplayName
+	}
+
+	return names
+}
@@ -0,0 +1,491 @@
+package num
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+const (
+	percentShift  = 2
+	perMilleShift = 3
+)
+
+// A PercentFormatter can be used to format locale-aware
+// percentages using CLDR data, e.g. 0.12 => 12%.
+type PercentFormatter struct {
+	usePerMille bool
+
+	numberFormatter numberFormatter
+}
+
+// NewPercentFormatter returns a [PercentFormatter] with locale l, formatting numbers as percentages
+// with the integer and fractional digits declared by the locale's CLDR percent pattern,
+// e.g. no fractional digits for #,##0% in "en", rounded under [RoundHalfEven] as in ICU, e.g. 0.125 => 12%.
+// Every fractional digit is displayed only when opted into with [PercentFormatter.SetFractionDigits] (0, -1)
+// or [PercentFormatter.SetScale] (-1).
+//
+// A non-nil error is returned if the locale is not supported.
+func NewPercentFormatter(l string) (PercentFormatter, error) {
+	pf := PercentFormatter{}
+
+	f, err := newNumberFormatter(l)
+	if err != nil {
+		return pf, err
+	}
+
+	pf.numberFormatter = f
+	pf.numberFormatter.roundingMode = RoundHalfEven
+	pf.UsePercent()
+
+	return pf, nil
+}
+
+// MustNewPercentFormatter calls [NewPercentFormatter], and panics if its error result is not nil.
+// Otherwise, it returns the non-error result.
+func MustNewPercentFormatter(l string) PercentFormatter {
+	pf, err := NewPercentFormatter(l)
+	if err != nil {
+		panic(fmt.Errorf("in num.MustNewPercentFormatter: %w", err))
+	}
+
+	return pf
+}
+
+// SetScale changes the number of fractional digits displayed, after the number
+// is scaled by 100 (or 1000 per mille), e.g. 0.12345 => 12.35% with scale 2.
+// A scale of -1 displays the scaled number without trailing fractional zeros.
+// This is equivalent to [PercentFormatter.SetFractionDigits] with s and s, or 0 and -1 for a scale of -1.
+//
+// An error is returned if the scale is unsupported, i.e.
+// if it is less than -1, or greater than the max supported scale = 20.
+func (pf *PercentFormatter) SetScale(s int8) error {
+	if s < -1 || s > int8(maxSupportedScale) {
+		return unsupportedScaleError(s)
+	}
+
+	if s == -1 {
+		pf.numberFormatter.setFractionDigits(0, -1)
+	} else {
+		pf.numberFormatter.setFractionDigits(int(s), int(s))
+	}
+
+	return nil
+}
+
+// MustSetScale calls [PercentFormatter.SetScale], and panics if it returns an error.
+func (pf *PercentFormatter) MustSetScale(s int8) {
+	if err := pf.SetScale(s); err != nil {
+		panic(fmt.Errorf("in PercentFormatter.MustSetScale: %w", err))
+	}
+}
+
+// SetMinIntegerDigits changes the minimum number of integer digits that scaled numbers are displayed with,
+// zero-padding them as needed, e.g. 0.075 => 07.5% with 2. The default is that of the locale's CLDR percent pattern.
+//
+// An error is returned if n is 0, or greater than the max supported scale = 20.
+func (pf *PercentFormatter) SetMinIntegerDigits(n uint8) error {
+	if n == 0 || n > maxSupportedScale {
+		return unsupportedIntegerDigitsError(n)
+	}
+
+	pf.numberFormatter.setIntegerDigits(n)
+
+	return nil
+}
+
+// MustSetMinIntegerDigits calls [PercentFormatter.SetMinIntegerDigits], and panics if it returns an error.
+func (pf *PercentFormatter) MustSetMinIntegerDigits(n uint8) {
+	if err := pf.SetMinIntegerDigits(n); err != nil {
+		panic(fmt.Errorf("in PercentFormatter.MustSetMinIntegerDigits: %w", err))
+	}
+}
+
+// SetFractionDigits changes the minimum and maximum numbers of fractional digits that scaled numbers are displayed
+// with, rounding them using the formatter's [RoundingMode] and zero-padding them as needed,
+// e.g. 0.125 => 12.50% and 0.123456 => 12.346% with at least 2 and at most 3 under [RoundHalfEven].
+// Other trailing fractional zeros are not displayed. A maximum of -1 displays every fractional digit.
+// The defaults are those of the locale's CLDR percent pattern, e.g. 0 and 0 for #,##0% in "en".
+//
+// An error is returned if the minimum is less than 0, or the maximum less than -1, if either is greater than
+// the max supported scale = 20, or if the minimum is greater than a maximum other than -1.
+func (pf *PercentFormatter) SetFractionDigits(minimum, maximum int8) error {
+	if err := validateFractionDigits(minimum, maximum); err != nil {
+		return err
+	}
+
+	pf.numberFormatter.setFractionDigits(int(minimum), int(maximum))
+
+	return nil
+}
+
+// MustSetFractionDigits calls [PercentFormatter.SetFractionDigits], and panics if it returns an error.
+func (pf *PercentFormatter) MustSetFractionDigits(minimum, maximum int8) {
+	if err := pf.SetFractionDigits(minimum, maximum); err != nil {
+		panic(fmt.Errorf("in PercentFormatter.MustSetFractionDigits: %w", err))
+	}
+}
+
+// UsePatternDigits indicates that scaled numbers should be displayed with the integer and fractional digits declared
+// by the locale's CLDR percent pattern, e.g. no fractional digits for #,##0% in "en", instead of any set by
+// [PercentFormatter.SetScale], [PercentFormatter.SetMinIntegerDigits] or [PercentFormatter.SetFractionDigits].
+// This is the default.
+func (pf *PercentFormatter) UsePatternDigits() {
+	pf.numberFormatter.usePatternDigits()
+}
+
+// SetRoundingMode changes how scaled numbers with more fractional digits than
+// the formatter displays are rounded, e.g. 0.12345 => 12.35% under [RoundHalfUp] with scale 2.
+//
+// The default is [RoundHalfEven], as in ICU; such numbers cannot be formatted under [RoundUnnecessary].
+// Rounding does not apply without a maximum number of fractional digits, e.g. with a scale of -1.
+func (pf *PercentFormatter) SetRoundingMode(m RoundingMode) {
+	pf.numberFormatter.roundingMode = m
+}
+
+// SetLocale changes the locale considered when formatting.
+// An error is returned if the locale is not supported.
+func (pf *PercentFormatter) SetLocale(l string) error {
+	return pf.numberFormatter.setLocale(l)
+}
+
+// MustSetLocale calls [PercentFormatter.SetLocale], and panics if it returns an error.
+func (pf *PercentFormatter) MustSetLocale(l string) {
+	if err := pf.SetLocale(l); err != nil {
+		panic(fmt.Errorf("in PercentFormatter.MustSetLocale: %w", err))
+	}
+}
+
+// DisplayExactly indicates that percentages should be displayed as they are. This is the default.
+func (pf *PercentFormatter) DisplayExactly() {
+	pf.numberFormatter.qualifier = exactly
+}
+
+// DisplayApproximately indicates that percentages should be displayed as approximate,
+// using the locale's CLDR pattern, e.g. ~12% for "en".
+func (pf *PercentFormatter) DisplayApproximately() {
+	pf.numberFormatter.qualifier = approximately
+}
+
+// DisplayAtLeast indicates that percentages should be displayed as lower bounds,
+// using the locale's CLDR pattern, e.g. 12%+ for "en".
+func (pf *PercentFormatter) DisplayAtLeast() {
+	pf.numberFormatter.qualifier = atLeast
+}
+
+// DisplayAtMost indicates that percentages should be displayed as upper bounds,
+// using the locale's CLDR pattern, e.g. ≤12% for "en".
+func (pf *PercentFormatter) DisplayAtMost() {
+	pf.numberFormatter.qualifier = atMost
+}
+
+// DisplaySignAuto indicates that percentages should be displayed with a minus sign if negative,
+// and no sign otherwise. This is the default.
+func (pf *PercentFormatter) DisplaySignAuto() {
+	pf.numberFormatter.signDisplay = signAuto
+}
+
+// DisplaySignAlways indicates that percentages should be displayed with a plus or minus sign,
+// using the locale's CLDR symbols, e.g. +5% and -5% for "en".
+func (pf *PercentFormatter) DisplaySignAlways() {
+	pf.numberFormatter.signDisplay = signAlways
+}
+
+// DisplaySignNever indicates that percentages should be displayed without a sign, e.g. 5% for -5%.
+func (pf *PercentFormatter) DisplaySignNever() {
+	pf.numberFormatter.signDisplay = signNever
+}
+
+// DisplaySignExceptZero indicates that percentages should be displayed with a plus or minus sign,
+// as described in [PercentFormatter.DisplaySignAlways], unless they are zero after rounding, e.g. 0%.
+func (pf *PercentFormatter) DisplaySignExceptZero() {
+	pf.numberFormatter.signDisplay = signExceptZero
+}
+
+// DisplaySignNegative indicates that percentages should be displayed with a minus sign
+// if negative, unless they are zero after rounding, and no sign otherwise.
+func (pf *PercentFormatter) DisplaySignNegative() {
+	pf.numberFormatter.signDisplay = signNegative
+}
+
+// UseLocaleGrouping indicates that the whole parts of percentages should be grouped as is usual for the locale, i.e. unless
+// they have fewer digits than the locale's CLDR minimum in their highest group, e.g. 1000 but 10 000 for "pl".
+// This is the default.
+func (pf *PercentFormatter) UseLocaleGrouping() {
+	pf.numberFormatter.grouping = groupingLocale
+}
+
+// UseFullGrouping indicates that the whole parts of percentages should always be grouped, e.g. 1 000 for "pl".
+func (pf *PercentFormatter) UseFullGrouping() {
+	pf.numberFormatter.grouping = groupingFull
+}
+
+// UseMin2Grouping indicates that the whole parts of percentages should be grouped as is usual for the locale,
+// but only if they have at least 2 digits in their highest group, e.g. 1000 but 10,000 for "en".
+func (pf *PercentFormatter) UseMin2Grouping() {
+	pf.numberFormatter.grouping = groupingMin2
+}
+
+// UseNoGrouping indicates that the whole parts of percentages should never be grouped, e.g. 1000000.
+func (pf *PercentFormatter) UseNoGrouping() {
+	pf.numberFormatter.grouping = groupingNone
+}
+
+// UsePercent indicates that numbers should be multiplied by 100 and displayed
+// with the locale's percent sign, e.g. 0.12 => 12 % for "fr". This is the default.
+func (pf *PercentFormatter) UsePercent() {
+	pf.usePerMille = false
+}
+
+// UsePerMille indicates that numbers should be multiplied by 1000 and displayed
+// with the locale's per mille sign in place of its percent sign, e.g. 0.012 => 12‰ for "en".
+func (pf *PercentFormatter) UsePerMille() {
+	pf.usePerMille = true
+}
+
+// FormatCoef formats the number (-1)^neg × coef × 10^exp as a locale-aware percentage,
+// e.g. neg false, coef 1234, exp -4 => 12.34%.
+//
+// The percentage is displayed with the formatter's integer and fractional digits, rounded using
+// the formatter's [RoundingMode] and zero-padded as needed; see [PercentFormatter.SetFractionDigits].
+// A non-nil error is returned if exp is not between -1000 and 1000, or if the formatting cannot be done.
+func (pf PercentFormatter) FormatCoef(neg bool, coef uint64, exp int32) (string, error) {
+	if err := validateExponent(exp); err != nil {
+		return "", err
+	}
+
+	return pf.format(newDigitsFromCoef(neg, strconv.FormatUint(coef, 10), int(exp)))
+}
+
+// MustFormatCoef calls [PercentFormatter.FormatCoef], and panics if there is an error.
+func (pf PercentFormatter) MustFormatCoef(neg bool, coef uint64, exp int32) string {
+	s, err := pf.FormatCoef(neg, coef, exp)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatDecimal formats the [Decimal] x as a locale-aware percentage,
+// e.g. a coefficient of "1234" and exponent of -4 => 12.34%.
+// The percentage is then displayed as described in [PercentFormatter.FormatCoef].
+//
+// A non-nil error is returned if the coefficient of x is not made of ASCII decimal digits,
+// if its exponent is not between -1000 and 1000, or if the formatting cannot be done.
+func (pf PercentFormatter) FormatDecimal(x Decimal) (string, error) {
+	d, err := newDigitsFromDecimal(x)
+	if err != nil {
+		return "", err
+	}
+
+	return pf.format(d)
+}
+
+// MustFormatDecimal calls [PercentFormatter.FormatDecimal], and panics if there is an error.
+func (pf PercentFormatter) MustFormatDecimal(x Decimal) string {
+	s, err := pf.FormatDecimal(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatBigRat formats the rational number x as a locale-aware percentage,
+// after first rounding the percentage to s fractional digits using the formatter's [RoundingMode],
+// e.g. 1/3 with s 1 => 33.3% under [RoundHalfEven].
+//
+// The rounded percentage is then displayed as described in [PercentFormatter.FormatCoef].
+// A non-nil error is returned if the formatting cannot be done, e.g. if the percentage is not
+// exactly representable with s fractional digits and the rounding mode is [RoundUnnecessary].
+func (pf PercentFormatter) FormatBigRat(x *big.Rat, s uint8) (string, error) {
+	y := new(big.Rat).Mul(x, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(pf.shift())), nil)))
+
+	d, err := newDigitsFromBigRat(y, s, 0, pf.numberFormatter.roundingMode)
+	if err != nil {
+		return "", err
+	}
+
+	return pf.formatShifted(d)
+}
+
+// MustFormatBigRat calls [PercentFormatter.FormatBigRat], and panics if there is an error.
+func (pf PercentFormatter) MustFormatBigRat(x *big.Rat, s uint8) string {
+	str, err := pf.FormatBigRat(x, s)
+	if err != nil {
+		panic(err)
+	}
+
+	return str
+}
+
+// FormatString formats the ASCII decimal string x as a locale-aware percentage,
+// e.g. "0.125" => 12.5%. The string may have a leading sign, and
+// must have digits on both sides of the decimal point, if any.
+// The percentage is then displayed as described in [PercentFormatter.FormatCoef].
+//
+// A non-nil error is returned if x is not a valid decimal string, or if the formatting cannot be done.
+func (pf PercentFormatter) FormatString(x string) (string, error) {
+	d, err := parseDigits(x)
+	if err != nil {
+		return "", err
+	}
+
+	return pf.format(d)
+}
+
+// MustFormatString calls [PercentFormatter.FormatString], and panics if there is an error.
+func (pf PercentFormatter) MustFormatString(x string) string {
+	s, err := pf.FormatString(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatFloat formats the floating-point number x as a locale-aware percentage, reading x using the
+// fewest digits that round-trip to x when parsed with the given bit size (32 for float32, 64 for float64),
+// e.g. 0.07 => 7% rather than 7.000000000000001%.
+// The percentage is then displayed as described in [PercentFormatter.FormatCoef].
+//
+// NaN and ±Inf are displayed using the locale's CLDR symbols, e.g. NaN% and -∞% for "en",
+// with the formatter's qualifier and sign display, except that NaN is never displayed with a sign.
+// A non-nil error is returned if the bit size is unsupported, or if the formatting cannot be done.
+func (pf PercentFormatter) FormatFloat(x float64, bitSize int) (string, error) {
+	if bitSize != 32 && bitSize != 64 {
+		return "", unsupportedBitSizeError(bitSize)
+	}
+
+	pf.usePercentFormat()
+
+	switch {
+	case math.IsNaN(x):
+		return pf.numberFormatter.formatNonFinite(false, true, pf.numberFormatter.locale.Data.NumberInfo.NaN), nil
+	case math.IsInf(x, 0):
+		return pf.numberFormatter.formatNonFinite(x < 0, false, pf.numberFormatter.locale.Data.NumberInfo.Infinity), nil
+	}
+
+	d, err := parseDigits(strconv.FormatFloat(x, 'f', -1, bitSize))
+	if err != nil {
+		return "", err
+	}
+
+	return pf.format(d)
+}
+
+// MustFormatFloat calls [PercentFormatter.FormatFloat], and panics if there is an error.
+func (pf PercentFormatter) MustFormatFloat(x float64, bitSize int) string {
+	s, err := pf.FormatFloat(x, bitSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatStringRange formats the range from the ASCII decimal string x to y as locale-aware percentages,
+// e.g. "0.03" and "0.05" => 3–5 % for "fr". Both ends are read as described in [PercentFormatter.FormatString],
+// and the range is displayed as described in [DecimalFormatter.FormatStringRange].
+//
+// A non-nil error is returned if x or y is not a valid decimal string, or if the formatting cannot be done.
+func (pf PercentFormatter) FormatStringRange(x, y string) (string, error) {
+	dx, err := parseDigits(x)
+	if err != nil {
+		return "", err
+	}
+
+	dy, err := parseDigits(y)
+	if err != nil {
+		return "", err
+	}
+
+	return pf.formatRange(dx, dy)
+}
+
+// MustFormatStringRange calls [PercentFormatter.FormatStringRange], and panics if there is an error.
+func (pf PercentFormatter) MustFormatStringRange(x, y string) string {
+	s, err := pf.FormatStringRange(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatDecimalRange formats the range from the [Decimal] x to y as locale-aware percentages,
+// as described in [PercentFormatter.FormatStringRange].
+//
+// A non-nil error is returned if the coefficient of x or y is not made of ASCII decimal digits,
+// if its exponent is not between -1000 and 1000, or if the formatting cannot be done.
+func (pf PercentFormatter) FormatDecimalRange(x, y Decimal) (string, error) {
+	dx, err := newDigitsFromDecimal(x)
+	if err != nil {
+		return "", err
+	}
+
+	dy, err := newDigitsFromDecimal(y)
+	if err != nil {
+		return "", err
+	}
+
+	return pf.formatRange(dx, dy)
+}
+
+// MustFormatDecimalRange calls [PercentFormatter.FormatDecimalRange], and panics if there is an error.
+func (pf PercentFormatter) MustFormatDecimalRange(x, y Decimal) string {
+	s, err := pf.FormatDecimalRange(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// shift returns the power of ten that numbers are multiplied by, i.e. 2 for percent and 3 for per mille.
+func (pf PercentFormatter) shift() int {
+	if pf.usePerMille {
+		return perMilleShift
+	}
+
+	return percentShift
+}
+
+// format formats the unscaled number d, e.g. 0.12 => 12%.
+func (pf PercentFormatter) format(d digits) (string, error) {
+	return pf.formatShifted(d.shift(pf.shift()))
+}
+
+// formatShifted formats d, which has already been multiplied by 100 (or 1000 per mille).
+func (pf PercentFormatter) formatShifted(d digits) (string, error) {
+	pf.usePercentFormat()
+
+	return pf.numberFormatter.format(d, "")
+}
+
+// formatRange formats the range from the unscaled numbers start to end, e.g. 0.03 and 0.05 => 3% – 5%.
+func (pf PercentFormatter) formatRange(start, end digits) (string, error) {
+	pf.usePercentFormat()
+
+	fstart, err := pf.numberFormatter.formatParts(start.shift(pf.shift()), "")
+	if err != nil {
+		return "", err
+	}
+
+	fend, err := pf.numberFormatter.formatParts(end.shift(pf.shift()), "")
+	if err != nil {
+		return "", err
+	}
+
+	return pf.numberFormatter.formatRange(fstart, fend), nil
+}
+
+func (pf *PercentFormatter) usePercentFormat() {
+	if pf.usePerMille {
+		pf.numberFormatter.useStandardPercentFormat(pf.numberFormatter.locale.Data.NumberInfo.PerMille)
+	} else {
+		pf.numberFormatter.useStandardPercentFormat(pf.numberFormatter.locale.Data.NumberInfo.PercentSign)
+	}
+}
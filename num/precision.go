@@ -0,0 +1,148 @@
+package num
+
+import (
+	"strings"
+)
+
+type roundingPriority uint8
+
+const (
+	significantDigitsPriority roundingPriority = iota
+	morePrecisionPriority
+	lessPrecisionPriority
+)
+
+// validateFractionDigits returns an error if minimum and maximum are not valid numbers of fractional digits,
+// as described in [DecimalFormatter.SetFractionDigits].
+func validateFractionDigits(minimum, maximum int8) error {
+	switch {
+	case minimum < 0 || minimum > int8(maxSupportedScale):
+		return unsupportedFractionDigitsError(minimum, 0)
+	case maximum < -1 || maximum > int8(maxSupportedScale):
+		return unsupportedFractionDigitsError(maximum, -1)
+	case maximum != -1 && minimum > maximum:
+		return invalidFractionDigitsError(minimum, maximum)
+	}
+
+	return nil
+}
+
+// setIntegerDigits sets the minimum number of integer digits displayed, instead of that of the number format.
+func (f *numberFormatter) setIntegerDigits(n uint8) {
+	f.minIntegerDigits, f.hasIntegerDigits = n, true
+}
+
+// setFractionDigits sets the minimum and maximum numbers of fractional digits displayed,
+// instead of those of the number format. A maximum of -1 displays every fractional digit.
+func (f *numberFormatter) setFractionDigits(minimum, maximum int) {
+	f.minFractionDigits, f.maxFractionDigits, f.hasFractionDigits = minimum, maximum, true
+}
+
+// usePatternDigits displays numbers with the integer and fractional digits declared by the number format.
+func (f *numberFormatter) usePatternDigits() {
+	f.hasIntegerDigits, f.hasFractionDigits = false, false
+}
+
+// integerDigits returns the minimum number of integer digits displayed.
+func (f numberFormatter) integerDigits() int {
+	if f.hasIntegerDigits {
+		return int(f.minIntegerDigits)
+	}
+
+	return int(f.numberFormat.MinIntegerDigits)
+}
+
+// fractionDigits returns the minimum and maximum numbers of fractional digits displayed,
+// where a maximum of -1 displays every fractional digit.
+func (f numberFormatter) fractionDigits() (int, int) {
+	if f.hasFractionDigits {
+		return f.minFractionDigits, f.maxFractionDigits
+	}
+
+	return int(f.numberFormat.MinFractionDigits), int(f.numberFormat.MaxFractionDigits)
+}
+
+// round rounds d to the formatter's precision, i.e. to at most its maximum number of fractional digits,
+// and zero-pads it to at least its minimum number, unless its significant digits take priority.
+//
+// Significant digits take priority if there is no maximum, or under the significant digits priority;
+// otherwise, d is rounded to whichever of the maximum fractional and significant digits keeps more, or fewer,
+// digits, e.g. 1.2345 => 1.23 with at most 2 fractional and 4 significant digits under the less precision priority.
+// The significant digits are preferred on a tie under the more precision priority, and the fractional ones under the other.
+func (f numberFormatter) round(d digits) (digits, error) {
+	minFrac, maxFrac := f.fractionDigits()
+
+	if f.minSignificantDigits == 0 && f.maxSignificantDigits == 0 {
+		return f.roundToFractionDigits(d, minFrac, maxFrac)
+	}
+
+	if maxFrac == -1 || f.roundingPriority == significantDigitsPriority {
+		return f.roundToSignificantDigits(d)
+	}
+
+	// Significant digits with no maximum are always more precise.
+	_, exp := d.toScientific()
+	sigMorePrecise := f.maxSignificantDigits == 0 || exp-int(f.maxSignificantDigits)+1 <= -maxFrac
+
+	if sigMorePrecise == (f.roundingPriority == morePrecisionPriority) {
+		return f.roundToSignificantDigits(d)
+	}
+
+	return f.roundToFractionDigits(d, minFrac, maxFrac)
+}
+
+// roundToFractionDigits rounds d to at most maxFrac fractional digits, or none if -1, using the formatter's
+// rounding increment, and then zero-pads it to at least minFrac, e.g. 1.5 => 1.50 for 2 and 1.50 => 1.5 for 1.
+// Other trailing fractional zeros are not displayed.
+func (f numberFormatter) roundToFractionDigits(d digits, minFrac, maxFrac int) (digits, error) {
+	if maxFrac >= 0 {
+		var err error
+
+		d, err = d.roundToIncrement(maxFrac, f.roundingIncrement, f.roundingMode)
+		if err != nil {
+			return d, err
+		}
+	}
+
+	n := max(len(strings.TrimRight(d.frac, "0")), minFrac)
+	if n > len(d.frac) {
+		d.frac += strings.Repeat("0", n-len(d.frac))
+	}
+
+	d.frac = d.frac[:n]
+
+	return d, nil
+}
+
+// roundToSignificantDigits rounds d to at most the formatter's maximum number of significant digits,
+// including whole digits, e.g. 123456 => 123000 and 0.0012345 => 0.00123 for 3, and then zero-pads it
+// to at least its minimum number, e.g. 1.5 => 1.500 for 4. Other trailing fractional zeros are not displayed.
+func (f numberFormatter) roundToSignificantDigits(d digits) (digits, error) {
+	if f.maxSignificantDigits > 0 {
+		_, exp := d.toScientific()
+
+		// Digits are kept down to the power of ten p, which is negative for fractional digits.
+		p := exp - int(f.maxSignificantDigits) + 1
+
+		var err error
+		if p > 0 {
+			d, err = d.shift(-p).round(0, f.roundingMode)
+			d = d.shift(p)
+		} else {
+			d, err = d.round(-p, f.roundingMode)
+		}
+
+		if err != nil {
+			return d, err
+		}
+	}
+
+	d.frac = strings.TrimRight(d.frac, "0")
+
+	_, exp := d.toScientific()
+	if n := int(f.minSignificantDigits) - 1 - exp; n > len(d.frac) {
+		d.frac += strings.Repeat("0", n-len(d.frac))
+	}
+
+	return d, nil
+}
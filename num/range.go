@@ -0,0 +1,76 @@
+package num
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ttzhou/cldr/plural"
+)
+
+// formatRange formats the range from start to end with the locale's range pattern, e.g. $3.00 – $5.00 for "en".
+//
+// Affixes shared by both ends are displayed once if they are longer than a single character,
+// e.g. 3,00–5,00 € for "de", and the pattern is otherwise spaced out if the start of the range has affixes.
+// Ends that are displayed the same, e.g. after rounding, are displayed once with the locale's
+// approximately pattern, e.g. ~$3.00 for "en".
+func (f numberFormatter) formatRange(start, end formattedNumber) string {
+	mp := f.locale.Data.NumberInfo.MiscPatterns
+
+	if start.String() == end.String() {
+		return f.formatUnit(start.ops, strings.Replace(mp.Approximately, "{0}", start.String(), 1))
+	}
+
+	var s string
+
+	switch {
+	case start.prefix == end.prefix && start.suffix == end.suffix && utf8.RuneCountInString(start.prefix+start.suffix) > 1:
+		s = start.prefix + formatRangePattern(mp.Range, start.number, end.number) + start.suffix
+	case start.prefix == "" && start.suffix == "":
+		s = formatRangePattern(mp.Range, start.String(), end.String())
+	default:
+		s = formatRangePattern(spacedRangePattern(mp.Range), start.String(), end.String())
+	}
+
+	if f.unitNames.Other == "" {
+		return s
+	}
+
+	// Units are displayed once, in the form for the plural category of the range, e.g. 1–2 рубля in "ru".
+	category := plural.Range(
+		f.locale.Code,
+		plural.Cardinal(f.locale.Code, start.ops),
+		plural.Cardinal(f.locale.Code, end.ops),
+	)
+
+	return f.formatUnitForm(category, s)
+}
+
+// formatRangePattern replaces the placeholders of the range pattern with the formatted start and end.
+func formatRangePattern(pattern, start, end string) string {
+	return strings.NewReplacer("{0}", start, "{1}", end).Replace(pattern)
+}
+
+// spacedRangePattern returns the range pattern with spaces around the text between
+// its placeholders, unless it already has them, e.g. "{0} – {1}" for "{0}–{1}".
+func spacedRangePattern(pattern string) string {
+	before, rest, ok := strings.Cut(pattern, "{0}")
+	if !ok {
+		return pattern
+	}
+
+	sep, after, ok := strings.Cut(rest, "{1}")
+	if !ok {
+		return pattern
+	}
+
+	if r, _ := utf8.DecodeRuneInString(sep); !unicode.IsSpace(r) {
+		sep = " " + sep
+	}
+
+	if r, _ := utf8.DecodeLastRuneInString(sep); !unicode.IsSpace(r) {
+		sep += " "
+	}
+
+	return before + "{0}" + sep + "{1}" + after
+}
@@ -0,0 +1,238 @@
+package num
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// A RoundingMode determines how a number is rounded when it has more
+// fractional digits than the scale it is formatted with.
+//
+// The zero value is [RoundUnnecessary], under which formatting such a number
+// results in an error rather than rounding it.
+type RoundingMode uint8
+
+const (
+	// RoundUnnecessary does not round; a fractional part exceeding the scale is an error.
+	RoundUnnecessary RoundingMode = iota
+	// RoundHalfEven rounds towards the nearest neighbour, or the even neighbour if equidistant.
+	RoundHalfEven
+	// RoundHalfUp rounds towards the nearest neighbour, or away from zero if equidistant.
+	RoundHalfUp
+	// RoundHalfDown rounds towards the nearest neighbour, or towards zero if equidistant.
+	RoundHalfDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero, i.e. truncates.
+	RoundDown
+)
+
+// A roundingIncrement is a positive decimal that numbers are rounded
+// to a multiple of, e.g. 0.25, expressed as coef × 10^-scale.
+type roundingIncrement struct {
+	coef  uint64
+	scale uint8
+}
+
+// parseRoundingIncrement parses a positive decimal string such as "0.25" into a [roundingIncrement].
+// The zero value is returned for a zero increment, e.g. "0.00".
+func parseRoundingIncrement(s string) (roundingIncrement, error) {
+	d, err := parseDigits(s)
+	if err != nil || d.neg || len(d.frac) > int(maxSupportedScale) {
+		return roundingIncrement{}, invalidRoundingIncrementError(s)
+	}
+
+	coef, err := strconv.ParseUint(d.whole+d.frac, 10, 64)
+	if err != nil {
+		return roundingIncrement{}, invalidRoundingIncrementError(s)
+	}
+
+	if coef == 0 {
+		return roundingIncrement{}, nil
+	}
+
+	return roundingIncrement{coef, uint8(len(d.frac))}, nil
+}
+
+// isZero reports whether ri is the zero value, i.e. no increment.
+func (ri roundingIncrement) isZero() bool {
+	return ri.coef == 0
+}
+
+// at returns the scale to round to when formatting with at least scale s,
+// along with ri expressed as a coefficient at that scale, e.g. 0.5 at scale 2 => 2, 50.
+//
+// An error is returned if the coefficient at that scale overflows.
+func (ri roundingIncrement) at(s uint8) (uint8, uint64, error) {
+	if ri.scale >= s {
+		return ri.scale, ri.coef, nil
+	}
+
+	hi, coef := bits.Mul64(ri.coef, uint64(math.Pow10(int(s-ri.scale))))
+	if hi != 0 {
+		return s, 0, unsupportedRoundingIncrementError(ri, s)
+	}
+
+	return s, coef, nil
+}
+
+// String returns ri as a plain ASCII decimal string, e.g. 0.25.
+func (ri roundingIncrement) String() string {
+	d, _ := newDigits(0, ri.coef, int8(ri.scale))
+	if len(d.frac) > int(ri.scale) {
+		d.whole, d.frac = d.frac[:len(d.frac)-int(ri.scale)], d.frac[len(d.frac)-int(ri.scale):]
+	}
+
+	return d.String()
+}
+
+// round rounds d to s fractional digits using rounding mode m,
+// carrying into the whole part where needed, e.g. 9.999 => 10.00 for s = 2.
+//
+// An error is returned if d has more than s fractional digits and m is [RoundUnnecessary].
+func (d digits) round(s int, m RoundingMode) (digits, error) {
+	if len(d.frac) <= s {
+		return d, nil
+	}
+
+	if m == RoundUnnecessary {
+		return d, fractionalScaleError(d.frac, s)
+	}
+
+	kept, dropped := d.frac[:s], d.frac[s:]
+
+	// Dropping only zeros never changes the value, regardless of mode.
+	if strings.Trim(dropped, "0") == "" {
+		return digits{d.neg, d.whole, kept}, nil
+	}
+
+	n := d.whole + kept
+
+	up, err := m.roundsAwayFromZero(d.neg, compareToHalf(dropped), (n[len(n)-1]-'0')%2 == 1)
+	if err != nil {
+		return d, err
+	}
+
+	if !up {
+		return digits{d.neg, d.whole, kept}, nil
+	}
+
+	n = increment(n)
+
+	return digits{d.neg, n[:len(n)-s], n[len(n)-s:]}, nil
+}
+
+// roundToIncrement rounds d to the nearest multiple of inc × 10^-s using rounding mode m,
+// e.g. 1.03 => 1.05 for s = 2, inc = 5 under [RoundHalfEven].
+// An increment of 0 or 1 is the same as calling [digits.round].
+//
+// An error is returned if d is not already such a multiple and m is [RoundUnnecessary].
+func (d digits) roundToIncrement(s int, inc uint64, m RoundingMode) (digits, error) {
+	if inc <= 1 {
+		return d.round(s, m)
+	}
+
+	frac := d.frac
+	if len(frac) < s {
+		frac += strings.Repeat("0", s-len(frac))
+	}
+
+	n, _ := new(big.Int).SetString(d.whole+frac, 10)
+	i := new(big.Int).SetUint64(inc)
+	i.Mul(i, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac)-s)), nil))
+
+	q, r := new(big.Int).QuoRem(n, i, new(big.Int))
+	if r.Sign() != 0 {
+		if m == RoundUnnecessary {
+			return d, roundingIncrementError(d, s, inc)
+		}
+
+		up, err := m.roundsAwayFromZero(d.neg, new(big.Int).Lsh(r, 1).Cmp(i), q.Bit(0) == 1)
+		if err != nil {
+			return d, err
+		}
+
+		if up {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	ns := q.Mul(q, i).String()
+	if len(ns) <= len(frac) {
+		ns = strings.Repeat("0", len(frac)-len(ns)+1) + ns
+	}
+
+	wl := len(ns) - len(frac)
+
+	return digits{d.neg, ns[:wl], ns[wl : wl+s]}, nil
+}
+
+// roundsAwayFromZero reports whether a number with the given sign is rounded away from zero under m,
+// where half compares its discarded remainder to one half (-1, 0 or 1), and
+// odd reports whether the number would be odd if rounded towards zero.
+//
+// [RoundUnnecessary] is expected to be handled by the caller.
+func (m RoundingMode) roundsAwayFromZero(neg bool, half int, odd bool) (bool, error) {
+	switch m {
+	case RoundDown:
+		return false, nil
+	case RoundUp:
+		return true, nil
+	case RoundCeiling:
+		return !neg, nil
+	case RoundFloor:
+		return neg, nil
+	case RoundHalfEven, RoundHalfUp, RoundHalfDown:
+		switch {
+		case half != 0:
+			return half > 0, nil
+		case m == RoundHalfUp:
+			return true, nil
+		case m == RoundHalfDown:
+			return false, nil
+		default:
+			return odd, nil
+		}
+	default:
+		return false, unsupportedRoundingModeError(m)
+	}
+}
+
+// compareToHalf compares the fraction 0.ds to one half,
+// returning -1 if it is smaller, 0 if equal, and 1 if larger.
+func compareToHalf(ds string) int {
+	switch {
+	case ds[0] < '5':
+		return -1
+	case ds[0] > '5':
+		return 1
+	case strings.Trim(ds[1:], "0") == "":
+		return 0
+	default:
+		return 1
+	}
+}
+
+// increment adds one to the ASCII digit string ds, growing it by one digit on overflow.
+func increment(ds string) string {
+	b := []byte(ds)
+
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+
+			return string(b)
+		}
+
+		b[i] = '0'
+	}
+
+	return "1" + string(b)
+}
@@ -0,0 +1,331 @@
+package num
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// superscriptDigits maps ASCII digits and the minus sign to their Unicode superscript forms.
+var superscriptDigits = strings.NewReplacer(
+	"0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+	"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹",
+	"-", "⁻",
+)
+
+// A ScientificFormatter can be used to format locale-aware numbers in
+// scientific or engineering notation using CLDR data, e.g. 12345 => 1.2345E4.
+type ScientificFormatter struct {
+	useEngineering bool
+	useSuperscript bool
+
+	significantDigits uint8
+	numberFormatter   numberFormatter
+}
+
+// NewScientificFormatter returns a [ScientificFormatter] with locale l, formatting numbers
+// in scientific notation with as many significant digits as needed (0).
+//
+// A non-nil error is returned if the locale is not supported.
+//
+// See [ScientificFormatter.SetSignificantDigits] for a description of significant digits.
+func NewScientificFormatter(l string) (ScientificFormatter, error) {
+	sf := ScientificFormatter{}
+
+	f, err := newNumberFormatter(l)
+	if err != nil {
+		return sf, err
+	}
+
+	f.useStandardScientificFormat()
+
+	sf.numberFormatter = f
+	sf.UseScientificNotation()
+	sf.DisplayExponentAsSymbol()
+	_ = sf.SetSignificantDigits(0)
+
+	return sf, nil
+}
+
+// MustNewScientificFormatter calls [NewScientificFormatter], and panics if its error result is not nil.
+// Otherwise, it returns the non-error result.
+func MustNewScientificFormatter(l string) ScientificFormatter {
+	sf, err := NewScientificFormatter(l)
+	if err != nil {
+		panic(fmt.Errorf("in num.MustNewScientificFormatter: %w", err))
+	}
+
+	return sf
+}
+
+// SetSignificantDigits changes the number of significant digits displayed in the mantissa,
+// e.g. 12345 => 1.23E4 with 3 significant digits. A value of 0 displays every significant
+// digit of the number without trailing zeros, e.g. 12300 => 1.23E4.
+//
+// An error is returned if n is greater than the max supported scale = 20.
+func (sf *ScientificFormatter) SetSignificantDigits(n uint8) error {
+	if n > maxSupportedScale {
+		return unsupportedSignificantDigitsError(n)
+	}
+
+	sf.significantDigits = n
+
+	return nil
+}
+
+// MustSetSignificantDigits calls [ScientificFormatter.SetSignificantDigits], and panics if it returns an error.
+func (sf *ScientificFormatter) MustSetSignificantDigits(n uint8) {
+	if err := sf.SetSignificantDigits(n); err != nil {
+		panic(fmt.Errorf("in ScientificFormatter.MustSetSignificantDigits: %w", err))
+	}
+}
+
+// SetRoundingMode changes how numbers with more significant digits than the formatter's
+// are rounded, e.g. 12345 => 1.24E4 under [RoundHalfUp] with 3 significant digits.
+//
+// The default is [RoundUnnecessary], under which such numbers cannot be formatted.
+// Rounding does not apply when significant digits is 0.
+func (sf *ScientificFormatter) SetRoundingMode(m RoundingMode) {
+	sf.numberFormatter.roundingMode = m
+}
+
+// SetLocale changes the locale considered when formatting.
+// An error is returned if the locale is not supported.
+func (sf *ScientificFormatter) SetLocale(l string) error {
+	if err := sf.numberFormatter.setLocale(l); err != nil {
+		return err
+	}
+
+	sf.numberFormatter.useStandardScientificFormat()
+
+	return nil
+}
+
+// MustSetLocale calls [ScientificFormatter.SetLocale], and panics if it returns an error.
+func (sf *ScientificFormatter) MustSetLocale(l string) {
+	if err := sf.SetLocale(l); err != nil {
+		panic(fmt.Errorf("in ScientificFormatter.MustSetLocale: %w", err))
+	}
+}
+
+// UseScientificNotation indicates that the mantissa should have
+// a single whole digit, e.g. 12345 => 1.2345E4. This is the default.
+func (sf *ScientificFormatter) UseScientificNotation() {
+	sf.useEngineering = false
+}
+
+// UseEngineeringNotation indicates that the exponent should be a multiple of 3,
+// with one to three whole digits in the mantissa, e.g. 12345 => 12.345E3.
+func (sf *ScientificFormatter) UseEngineeringNotation() {
+	sf.useEngineering = true
+}
+
+// DisplayExponentAsSymbol indicates that the exponent should be displayed after the
+// locale's exponential symbol, e.g. 1.23E4 for "en" and 1,23×10^4 for "sv". This is the default.
+func (sf *ScientificFormatter) DisplayExponentAsSymbol() {
+	sf.useSuperscript = false
+}
+
+// DisplayExponentAsSuperscript indicates that the exponent should be displayed as a
+// superscripted power of ten after the locale's superscripting exponent symbol, e.g. 1.23×10⁴ for "en".
+func (sf *ScientificFormatter) DisplayExponentAsSuperscript() {
+	sf.useSuperscript = true
+}
+
+// FormatCoef formats the number (-1)^neg × coef × 10^exp in locale-aware scientific notation,
+// e.g. neg false, coef 1234, exp 1 => 1.234E4.
+//
+// With significant digits of 0, trailing zeros of the mantissa are not displayed; otherwise the mantissa is
+// displayed with exactly that many significant digits, rounded using the formatter's [RoundingMode].
+// A non-nil error is returned if exp is not between -1000 and 1000, or if the formatting cannot be done.
+func (sf ScientificFormatter) FormatCoef(neg bool, coef uint64, exp int32) (string, error) {
+	if err := validateExponent(exp); err != nil {
+		return "", err
+	}
+
+	return sf.format(newDigitsFromCoef(neg, strconv.FormatUint(coef, 10), int(exp)))
+}
+
+// MustFormatCoef calls [ScientificFormatter.FormatCoef], and panics if there is an error.
+func (sf ScientificFormatter) MustFormatCoef(neg bool, coef uint64, exp int32) string {
+	s, err := sf.FormatCoef(neg, coef, exp)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatDecimal formats the [Decimal] x in locale-aware scientific notation,
+// e.g. a coefficient of "1234" and exponent of 1 => 1.234E4.
+// The number is then displayed as described in [ScientificFormatter.FormatCoef].
+//
+// A non-nil error is returned if the coefficient of x is not made of ASCII decimal digits,
+// if its exponent is not between -1000 and 1000, or if the formatting cannot be done.
+func (sf ScientificFormatter) FormatDecimal(x Decimal) (string, error) {
+	d, err := newDigitsFromDecimal(x)
+	if err != nil {
+		return "", err
+	}
+
+	return sf.format(d)
+}
+
+// MustFormatDecimal calls [ScientificFormatter.FormatDecimal], and panics if there is an error.
+func (sf ScientificFormatter) MustFormatDecimal(x Decimal) string {
+	s, err := sf.FormatDecimal(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatBigFloat formats the floating-point number x in locale-aware scientific notation,
+// using the fewest digits that uniquely identify x at its precision.
+// The number is then displayed as described in [ScientificFormatter.FormatCoef].
+//
+// A non-nil error is returned if x is infinite, or if the formatting cannot be done.
+func (sf ScientificFormatter) FormatBigFloat(x *big.Float) (string, error) {
+	d, err := newDigitsFromBigFloat(x)
+	if err != nil {
+		return "", err
+	}
+
+	return sf.format(d)
+}
+
+// MustFormatBigFloat calls [ScientificFormatter.FormatBigFloat], and panics if there is an error.
+func (sf ScientificFormatter) MustFormatBigFloat(x *big.Float) string {
+	s, err := sf.FormatBigFloat(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatString formats the ASCII decimal string x in locale-aware scientific notation,
+// e.g. "-0.00125" => -1.25E-3. The string may have a leading sign, and
+// must have digits on both sides of the decimal point, if any.
+// The number is then displayed as described in [ScientificFormatter.FormatCoef].
+//
+// A non-nil error is returned if x is not a valid decimal string, or if the formatting cannot be done.
+func (sf ScientificFormatter) FormatString(x string) (string, error) {
+	d, err := parseDigits(x)
+	if err != nil {
+		return "", err
+	}
+
+	return sf.format(d)
+}
+
+// MustFormatString calls [ScientificFormatter.FormatString], and panics if there is an error.
+func (sf ScientificFormatter) MustFormatString(x string) string {
+	s, err := sf.FormatString(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// FormatFloat formats the floating-point number x in locale-aware scientific notation, reading x using the
+// fewest digits that round-trip to x when parsed with the given bit size (32 for float32, 64 for float64),
+// e.g. 6.02214076e23 => 6.02214076E23.
+// The number is then displayed as described in [ScientificFormatter.FormatCoef].
+//
+// NaN and ±Inf are displayed using the locale's CLDR symbols, e.g. NaN and -∞ for "en",
+// with the formatter's sign display, except that NaN is never displayed with a sign.
+// A non-nil error is returned if the bit size is unsupported, or if the formatting cannot be done.
+func (sf ScientificFormatter) FormatFloat(x float64, bitSize int) (string, error) {
+	if bitSize != 32 && bitSize != 64 {
+		return "", unsupportedBitSizeError(bitSize)
+	}
+
+	switch {
+	case math.IsNaN(x):
+		return sf.numberFormatter.formatNonFinite(false, true, sf.numberFormatter.locale.Data.NumberInfo.NaN), nil
+	case math.IsInf(x, 0):
+		return sf.numberFormatter.formatNonFinite(x < 0, false, sf.numberFormatter.locale.Data.NumberInfo.Infinity), nil
+	}
+
+	d, err := parseDigits(strconv.FormatFloat(x, 'f', -1, bitSize))
+	if err != nil {
+		return "", err
+	}
+
+	return sf.format(d)
+}
+
+// MustFormatFloat calls [ScientificFormatter.FormatFloat], and panics if there is an error.
+func (sf ScientificFormatter) MustFormatFloat(x float64, bitSize int) string {
+	s, err := sf.FormatFloat(x, bitSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// format formats d as its mantissa followed by the locale's exponent, e.g. 12345 => 1.2345E4.
+func (sf ScientificFormatter) format(d digits) (string, error) {
+	m, exp := d.toScientific()
+
+	if sf.significantDigits == 0 {
+		m.frac = strings.TrimRight(m.frac, "0")
+	} else {
+		var err error
+
+		s := sf.significantDigits - 1
+
+		m, err = m.round(int(s), sf.numberFormatter.roundingMode)
+		if err != nil {
+			return "", err
+		}
+
+		// Rounding up may carry into a second whole digit, e.g. 9.99 => 10.0.
+		if len(m.whole) > 1 {
+			m.whole, m.frac = m.whole[:1], (m.whole[1:] + m.frac)[:s]
+			exp++
+		}
+
+		m.frac += strings.Repeat("0", int(s)-len(m.frac))
+	}
+
+	if sf.useEngineering {
+		n := ((exp % 3) + 3) % 3
+		m, exp = m.shift(n), exp-n
+	}
+
+	nf := sf.numberFormatter
+	ni := nf.locale.Data.NumberInfo
+
+	ns := nf.formatNumber(m)
+	if sf.useSuperscript {
+		ns += ni.SuperscriptingExponent + nf.formatWhole("10") + superscriptDigits.Replace(strconv.Itoa(exp))
+	} else {
+		ns += ni.Exponential
+		if exp < 0 {
+			ns += ni.MinusSign
+		}
+		ns += nf.formatFrac(strconv.Itoa(max(exp, -exp)))
+	}
+
+	return nf.formatAffixes(m.neg, m.isZero(), ns, ""), nil
+}
+
+// toScientific returns the mantissa and exponent of d in scientific notation, i.e. with
+// a single non-zero whole digit, e.g. 1234.5 => 1.2345, 3. Zero is returned as 0, 0.
+func (d digits) toScientific() (digits, int) {
+	ds := d.whole + d.frac
+
+	i := strings.IndexFunc(ds, func(r rune) bool { return r != '0' })
+	if i < 0 {
+		return digits{d.neg, "0", ""}, 0
+	}
+
+	return digits{d.neg, ds[i : i+1], ds[i+1:]}, len(d.whole) - 1 - i
+}
@@ -0,0 +1,73 @@
+// Package shopspringnum formats [github.com/shopspring/decimal] values
+// with the formatters of package [github.com/ttzhou/cldr/num].
+//
+// It is a separate module so that package num itself stays free of dependencies.
+package shopspringnum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+	"github.com/ttzhou/cldr/num"
+)
+
+// Decimal adapts a [decimal.Decimal] to the [num.Decimal] interface.
+type Decimal struct {
+	d decimal.Decimal
+}
+
+// NewDecimal returns d as a [num.Decimal].
+func NewDecimal(d decimal.Decimal) Decimal {
+	return Decimal{d: d}
+}
+
+// Negative reports whether the decimal is negative.
+func (x Decimal) Negative() bool {
+	return x.d.IsNegative()
+}
+
+// Coefficient returns the absolute value of the decimal's coefficient as ASCII decimal digits.
+func (x Decimal) Coefficient() string {
+	return new(big.Int).Abs(x.d.Coefficient()).String()
+}
+
+// Exponent returns the decimal's exponent.
+func (x Decimal) Exponent() int32 {
+	return x.d.Exponent()
+}
+
+// FormatDecimal formats the decimal d with df, e.g. 1234.5 => 1,234.5 for "en".
+//
+// A non-nil error is returned under the same conditions as [num.DecimalFormatter.FormatDecimal].
+func FormatDecimal(df num.DecimalFormatter, d decimal.Decimal) (string, error) {
+	return df.FormatDecimal(NewDecimal(d))
+}
+
+// MustFormatDecimal calls [FormatDecimal], and panics if it returns a non-nil error.
+func MustFormatDecimal(df num.DecimalFormatter, d decimal.Decimal) string {
+	s, err := FormatDecimal(df, d)
+	if err != nil {
+		panic(fmt.Errorf("in shopspringnum.MustFormatDecimal: %w", err))
+	}
+
+	return s
+}
+
+// FormatMoney formats the decimal amount d with mf for the given currency,
+// e.g. 1234.5 USD => $1,234.50 for "en" with [num.MoneyFormatter.DisplayCurrencyAsSymbol].
+//
+// A non-nil error is returned under the same conditions as [num.MoneyFormatter.FormatDecimal].
+func FormatMoney(mf num.MoneyFormatter, d decimal.Decimal, c string) (string, error) {
+	return mf.FormatDecimal(NewDecimal(d), c)
+}
+
+// MustFormatMoney calls [FormatMoney], and panics if it returns a non-nil error.
+func MustFormatMoney(mf num.MoneyFormatter, d decimal.Decimal, c string) string {
+	s, err := FormatMoney(mf, d, c)
+	if err != nil {
+		panic(fmt.Errorf("in shopspringnum.MustFormatMoney: %w", err))
+	}
+
+	return s
+}
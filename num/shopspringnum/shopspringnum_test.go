@@ -0,0 +1,77 @@
+package shopspringnum_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/ttzhou/cldr/num"
+	"github.com/ttzhou/cldr/num/shopspringnum"
+)
+
+func TestShopspringnum(t *testing.T) {
+	t.Run("FormatDecimal()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			scale    int8
+			decimal  decimal.Decimal
+			expected string
+		}{
+			{"en", -1, decimal.RequireFromString("1234.5"), "1,234.5"},
+			{"en", 2, decimal.RequireFromString("1.5"), "1.50"},
+			{"en", -1, decimal.RequireFromString("-0.0001"), "-0.0001"},
+			{"en", -1, decimal.New(12, 3), "12,000"},
+			{"en", -1, decimal.RequireFromString("-123456789012345678901234.5678"), "-123,456,789,012,345,678,901,234.5678"},
+			{"bn", -1, decimal.RequireFromString("123456.789"), "১,২৩,৪৫৬.৭৮৯"},
+		} {
+			df := num.MustNewDecimalFormatter(tc.locale)
+			df.MustSetScale(tc.scale)
+
+			actual := shopspringnum.MustFormatDecimal(df, tc.decimal)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+	})
+
+	t.Run("FormatDecimal() errors", func(t *testing.T) {
+		for i, tc := range []struct {
+			decimal  decimal.Decimal
+			expected string
+		}{
+			{decimal.New(1, 2000000000), "exponent 2000000000 must be between -1000 and 1000"},
+			{decimal.New(-1, -1001), "exponent -1001 must be between -1000 and 1000"},
+		} {
+			df := num.MustNewDecimalFormatter("en")
+
+			_, err := shopspringnum.FormatDecimal(df, tc.decimal)
+			if err == nil {
+				t.Errorf("test case #%d - expected error, got nil", i+1)
+				continue
+			}
+			if err.Error() != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+			}
+		}
+	})
+
+	t.Run("FormatMoney()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			decimal  decimal.Decimal
+			cur      string
+			expected string
+		}{
+			{"en", decimal.RequireFromString("1234.5"), "USD", "USD\u00a01,234.50"},
+			{"en", decimal.RequireFromString("-0.125"), "USD", "-USD\u00a00.12"},
+			{"de", decimal.RequireFromString("31000000000000000000000.005"), "EUR", "31.000.000.000.000.000.000.000,00\u00a0EUR"},
+		} {
+			mf := num.MustNewMoneyFormatter(tc.locale)
+			mf.SetRoundingMode(num.RoundHalfEven)
+
+			actual := shopspringnum.MustFormatMoney(mf, tc.decimal, tc.cur)
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+	})
+}
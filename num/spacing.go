@@ -0,0 +1,193 @@
+package num
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ttzhou/cldr/internal/locale"
+)
+
+// spaceCurrency inserts the locale's CLDR currency spacing into the affixes prefix and suffix of the formatted
+// number ns, between the currency placeholder and the number, if the pattern's positive affixes place the currency
+// next to the number and its currency spacing rules match cs and ns, e.g. USD 5.00 but $5.00 for ¤#,##0.00 in "en".
+// Sign placeholders between the currency placeholder and the number, as in explicit negative subpatterns
+// such as ¤-#,##0.00 in "de-CH", are skipped, e.g. USD-5.00.
+// As in ICU, this replaces CLDR's alphaNextToNumber patterns, which are not loaded.
+func (f numberFormatter) spaceCurrency(prefix, suffix, ns, cs string) (string, string) {
+	if cs == "" || ns == "" {
+		return prefix, suffix
+	}
+
+	if i := strings.LastIndex(prefix, cldrCurSymbolPlaceholder); i >= 0 &&
+		strings.HasSuffix(f.numberFormat.Prefix, cldrCurSymbolPlaceholder) {
+		i += len(cldrCurSymbolPlaceholder)
+
+		c, _ := utf8.DecodeLastRuneInString(cs)
+		n, _ := utf8.DecodeRuneInString(ns)
+
+		if isSignPlaceholders(prefix[i:]) && f.afterCurrency.matches(c, n) {
+			prefix = prefix[:i] + f.afterCurrency.insertBetween + prefix[i:]
+		}
+	}
+
+	if i := strings.Index(suffix, cldrCurSymbolPlaceholder); i >= 0 &&
+		strings.HasPrefix(f.numberFormat.Suffix, cldrCurSymbolPlaceholder) {
+		c, _ := utf8.DecodeRuneInString(cs)
+		n, _ := utf8.DecodeLastRuneInString(ns)
+
+		if isSignPlaceholders(suffix[:i]) && f.beforeCurrency.matches(c, n) {
+			suffix = suffix[:i] + f.beforeCurrency.insertBetween + suffix[i:]
+		}
+	}
+
+	return prefix, suffix
+}
+
+// isSignPlaceholders reports whether s consists of sign placeholders only, if any.
+func isSignPlaceholders(s string) bool {
+	return strings.Trim(s, cldrMinusSignPlaceholder+cldrPlusSignPlaceholder) == ""
+}
+
+// A currencySpacingRule is a CLDR currency spacing rule with its UnicodeSets parsed,
+// so that they are parsed once per locale rather than for every formatted number.
+type currencySpacingRule struct {
+	currencyMatch, surroundingMatch runeSet
+	insertBetween                   string
+}
+
+// newCurrencySpacingRule parses the UnicodeSets of rule. Rules with unsupported UnicodeSets never apply.
+func newCurrencySpacingRule(rule locale.CurrencySpacingRule) currencySpacingRule {
+	csr := currencySpacingRule{insertBetween: rule.InsertBetween}
+
+	currencyMatch, ok := parseRuneSet(rule.CurrencyMatch)
+	if !ok {
+		return csr
+	}
+
+	surroundingMatch, ok := parseRuneSet(rule.SurroundingMatch)
+	if !ok {
+		return csr
+	}
+
+	csr.currencyMatch, csr.surroundingMatch = currencyMatch, surroundingMatch
+
+	return csr
+}
+
+// matches reports whether the rule applies to the currency symbol's character c
+// and the number's character n next to each other.
+func (csr currencySpacingRule) matches(c, n rune) bool {
+	return csr.currencyMatch != nil && csr.currencyMatch(c) && csr.surroundingMatch(n)
+}
+
+// A runeSet reports whether a rune is in a set of runes.
+type runeSet func(rune) bool
+
+// parseRuneSet parses the UnicodeSet s, as used by CLDR data, e.g. "[[:^S:]&[:^Z:]]" for runes that are neither
+// symbols nor separators. Sets may contain runes, ranges such as "a-z", general categories such as "[:Nd:]"
+// or "[:digit:]", and nested sets, combined by union, intersection ("&") or difference ("-"), and may be negated.
+// It reports false if s is not such a set.
+func parseRuneSet(s string) (runeSet, bool) {
+	set, rest, ok := parseRuneSetPrefix(s)
+
+	return set, ok && rest == ""
+}
+
+// parseRuneSetPrefix parses the UnicodeSet at the start of s as described in [parseRuneSet], returning the rest of s.
+func parseRuneSetPrefix(s string) (runeSet, string, bool) {
+	if name, ok := strings.CutPrefix(s, "[:"); ok {
+		name, rest, found := strings.Cut(name, ":]")
+		if !found {
+			return nil, "", false
+		}
+
+		name, neg := strings.CutPrefix(name, "^")
+		if name == "digit" {
+			name = "Nd"
+		}
+
+		table, ok := unicode.Categories[name]
+		if !ok {
+			return nil, "", false
+		}
+
+		return func(r rune) bool { return unicode.Is(table, r) != neg }, rest, true
+	}
+
+	s, ok := strings.CutPrefix(s, "[")
+	if !ok {
+		return nil, "", false
+	}
+
+	s, neg := strings.CutPrefix(s, "^")
+
+	var set runeSet = func(rune) bool { return false }
+
+	op := byte(0)
+
+	for len(s) > 0 && s[0] != ']' {
+		if (s[0] == '&' || s[0] == '-') && strings.HasPrefix(s[1:], "[") {
+			op, s = s[0], s[1:]
+
+			continue
+		}
+
+		var item runeSet
+
+		if s[0] == '[' {
+			var ok bool
+
+			item, s, ok = parseRuneSetPrefix(s)
+			if !ok {
+				return nil, "", false
+			}
+		} else {
+			var lo, hi rune
+
+			lo, s = cutSetRune(s)
+			hi = lo
+
+			if len(s) > 1 && s[0] == '-' && s[1] != ']' {
+				hi, s = cutSetRune(s[1:])
+			}
+
+			item = func(r rune) bool { return lo <= r && r <= hi }
+		}
+
+		prev := set
+
+		switch op {
+		case '&':
+			set = func(r rune) bool { return prev(r) && item(r) }
+		case '-':
+			set = func(r rune) bool { return prev(r) && !item(r) }
+		default:
+			set = func(r rune) bool { return prev(r) || item(r) }
+		}
+
+		op = 0
+	}
+
+	if len(s) == 0 {
+		return nil, "", false
+	}
+
+	if neg {
+		inner := set
+		set = func(r rune) bool { return !inner(r) }
+	}
+
+	return set, s[1:], true
+}
+
+// cutSetRune returns the first rune of the non-empty s, which is escaped if it follows a backslash, and the rest of s.
+func cutSetRune(s string) (rune, string) {
+	if s[0] == '\\' && len(s) > 1 {
+		s = s[1:]
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+
+	return r, s[size:]
+}
@@ -0,0 +1,7 @@
+package plural
+
+import "fmt"
+
+func invalidNumberError(x string) error {
+	return fmt.Errorf("invalid number: %q", x)
+}
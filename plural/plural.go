@@ -0,0 +1,450 @@
+// Package plural contains CLDR plural rules, which determine the plural category
+// of a number in a given language, e.g. "one" for 1 and "other" for 1.5 in "en",
+// either as a count (cardinal), or as a rank (ordinal), e.g. "two" for 22 in "en" (22nd),
+// as well as the plural category of ranges of numbers, e.g. "few" for 1–3 in "ru".
+//
+// See https://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules.
+package plural
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CLDR plural categories; every language uses Other, while
+// the others are only used by languages with rules for them.
+const (
+	Zero  = "zero"
+	One   = "one"
+	Two   = "two"
+	Few   = "few"
+	Many  = "many"
+	Other = "other"
+)
+
+// Operands are the CLDR plural operands of a decimal number, e.g. for 1.50:
+// i = 1, v = 2, w = 1, f = 50, t = 5 and e = 0. The number n itself is i.f (see [Operands.N]).
+//
+// Numbers in compact notation, e.g. 1.2c6 for 1.2 million, have the operands of the number
+// they stand for, e.g. i = 1200000, with the power of 10 they were divided by as e.
+//
+// Operands with more than 18 digits keep only their last 18 digits, offset by 10^18, so that
+// they still never equal a small number, and still have the same remainder for any rule's modulus.
+//
+// See https://unicode.org/reports/tr35/tr35-numbers.html#Operands.
+type Operands struct {
+	I uint64 // integer digits of n
+	V uint64 // number of visible fraction digits of n, with trailing zeros
+	W uint64 // number of visible fraction digits of n, without trailing zeros
+	F uint64 // visible fraction digits of n, with trailing zeros
+	T uint64 // visible fraction digits of n, without trailing zeros
+	E uint64 // exponent of the power of 10 used in compact decimal notation, also known as c
+}
+
+// NewOperands returns the operands of the number with the given whole and fractional ASCII digits,
+// in compact notation with exponent e, e.g. "1", "50", 0 for 1.50, or "1", "2", 6 for 1.2c6.
+func NewOperands(whole, frac string, e uint64) Operands {
+	if e > 0 {
+		n := min(e, uint64(len(frac)))
+		whole, frac = whole+frac[:n], frac[n:]
+
+		// Zeros beyond the last digits operands keep do not change them.
+		whole = strings.TrimLeft(whole+strings.Repeat("0", int(min(e-n, maxOperandDigits+1))), "0")
+	}
+
+	trimmed := strings.TrimRight(frac, "0")
+
+	return Operands{
+		I: operand(whole),
+		V: uint64(len(frac)),
+		W: uint64(len(trimmed)),
+		F: operand(frac),
+		T: operand(trimmed),
+		E: e,
+	}
+}
+
+// ParseOperands returns the operands of a number in CLDR sample syntax, i.e. decimal notation
+// with an optional exponent for compact notation, e.g. "1.50", "-3" or "1.2c6". Signs are ignored.
+func ParseOperands(x string) (Operands, error) {
+	s := strings.TrimPrefix(x, "-")
+
+	var e uint64
+
+	if i := strings.IndexAny(s, "ce"); i >= 0 {
+		n, err := strconv.ParseUint(s[i+1:], 10, 64)
+		if err != nil {
+			return Operands{}, invalidNumberError(x)
+		}
+
+		s, e = s[:i], n
+	}
+
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if !isDigits(whole) || (hasFrac && !isDigits(frac)) {
+		return Operands{}, invalidNumberError(x)
+	}
+
+	return NewOperands(whole, frac, e), nil
+}
+
+// N returns the number n of the operands, excluding any exponent e, which is only
+// approximate for numbers with more digits than a float64 can represent exactly.
+func (ops Operands) N() float64 {
+	return float64(ops.I) + float64(ops.F)/math.Pow10(int(ops.V))
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+const (
+	maxOperandDigits = 18
+	operandOffset    = uint64(1e18)
+)
+
+func operand(ds string) uint64 {
+	if len(ds) <= maxOperandDigits {
+		n, _ := strconv.ParseUint("0"+ds, 10, 64)
+
+		return n
+	}
+
+	n, _ := strconv.ParseUint(ds[len(ds)-maxOperandDigits:], 10, 64)
+
+	return operandOffset + n
+}
+
+// value returns the value of the operand named o, and whether it is an integer,
+// which is only ever false for n.
+func (ops Operands) value(o byte) (uint64, bool) {
+	switch o {
+	case 'n':
+		return ops.I, ops.F == 0
+	case 'i':
+		return ops.I, true
+	case 'v':
+		return ops.V, true
+	case 'w':
+		return ops.W, true
+	case 'f':
+		return ops.F, true
+	case 't':
+		return ops.T, true
+	default: // 'c', 'e'
+		return ops.E, true
+	}
+}
+
+// Cardinal returns the plural category of a number with operands ops in locale l,
+// e.g. "few" for 3 in "pl". Locales are matched to the CLDR rules of their closest parent,
+// e.g. "pt-BR" to "pt"; locales without rules only use [Other].
+func Cardinal(l string, ops Operands) string {
+	return cardinal[language(cardinal, l)].category(ops)
+}
+
+// Ordinal returns the ordinal plural category of a number with operands ops in locale l,
+// e.g. "two" for 22 in "en" (22nd), with locales matched like in [Cardinal].
+func Ordinal(l string, ops Operands) string {
+	return ordinal[language(ordinal, l)].category(ops)
+}
+
+// Select returns the cardinal plural category of the number x, in CLDR sample syntax
+// (see [ParseOperands]), in locale l, e.g. "other" for "1.50" in "pl".
+func Select(l, x string) (string, error) {
+	ops, err := ParseOperands(x)
+	if err != nil {
+		return "", err
+	}
+
+	return Cardinal(l, ops), nil
+}
+
+// MustSelect is like [Select], but panics on errors.
+func MustSelect(l, x string) string {
+	category, err := Select(l, x)
+	if err != nil {
+		panic(err)
+	}
+
+	return category
+}
+
+// Languages returns the languages with CLDR cardinal plural rules, in sorted order.
+func Languages() []string {
+	return slices.Sorted(maps.Keys(cardinalRules))
+}
+
+// CardinalRules returns the CLDR cardinal plural rules of locale l, matched like in [Cardinal],
+// in CLDR category order, i.e. with the rule for the [Other] category last.
+func CardinalRules(l string) []Rule {
+	return slices.Clone(cardinalRules[language(cardinal, l)])
+}
+
+// SelectOrdinal returns the ordinal plural category of the number x, in CLDR sample syntax
+// (see [ParseOperands]), in locale l, e.g. "few" for "3" in "en" (3rd) and "many" for "8" in "it" (l'8°).
+func SelectOrdinal(l, x string) (string, error) {
+	ops, err := ParseOperands(x)
+	if err != nil {
+		return "", err
+	}
+
+	return Ordinal(l, ops), nil
+}
+
+// MustSelectOrdinal is like [SelectOrdinal], but panics on errors.
+func MustSelectOrdinal(l, x string) string {
+	category, err := SelectOrdinal(l, x)
+	if err != nil {
+		panic(err)
+	}
+
+	return category
+}
+
+// OrdinalLanguages returns the languages with CLDR ordinal plural rules, in sorted order.
+func OrdinalLanguages() []string {
+	return slices.Sorted(maps.Keys(ordinalRules))
+}
+
+// OrdinalRules returns the CLDR ordinal plural rules of locale l, like [CardinalRules].
+func OrdinalRules(l string) []Rule {
+	return slices.Clone(ordinalRules[language(ordinal, l)])
+}
+
+// Range returns the plural category of a range of numbers in locale l, e.g. 1–3 in "1–3 items",
+// from the cardinal plural categories of its start and end, e.g. "few" for "one" and "few" in "ru".
+// Ranges between categories CLDR has no data for are in the category of their end.
+func Range(l, start, end string) string {
+	if category, ok := ranges[language(ranges, l)][[2]string{start, end}]; ok {
+		return category
+	}
+
+	return end
+}
+
+// SelectRange returns the plural category of the range of numbers from x to y, in CLDR sample syntax
+// (see [ParseOperands]), in locale l, e.g. "few" for "1" and "2" in "ru", and "other" for them in "ar".
+func SelectRange(l, x, y string) (string, error) {
+	start, err := Select(l, x)
+	if err != nil {
+		return "", err
+	}
+
+	end, err := Select(l, y)
+	if err != nil {
+		return "", err
+	}
+
+	return Range(l, start, end), nil
+}
+
+// MustSelectRange is like [SelectRange], but panics on errors.
+func MustSelectRange(l, x, y string) string {
+	category, err := SelectRange(l, x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return category
+}
+
+// language returns the closest parent of locale l with rules, or "" if it has none.
+func language[V any](rules map[string]V, l string) string {
+	for {
+		if _, ok := rules[l]; ok {
+			return l
+		}
+
+		i := strings.LastIndex(l, "-")
+		if i < 0 {
+			return ""
+		}
+
+		l = l[:i]
+	}
+}
+
+// A Rule is a plural category, the condition numbers must satisfy to be in it, in CLDR plural rule syntax,
+// and CLDR's sample numbers in it, e.g. "one", "i = 1 and v = 0" and "@integer 1" in "en".
+// Rules for the [Other] category have no condition, as they apply to numbers satisfying no other rule.
+type Rule struct {
+	Category  string
+	Condition string
+	Samples   string
+}
+
+// A relation compares an operand, optionally modulo some number, to a list of ranges,
+// e.g. i % 10 = 2..4,6 or n != 1.
+type relation struct {
+	operand byte
+	modulus uint64
+	negated bool
+	ranges  [][2]uint64
+}
+
+func (r relation) matches(ops Operands) bool {
+	x, isInt := ops.value(r.operand)
+	if r.modulus > 0 {
+		x %= r.modulus
+	}
+
+	in := false
+
+	for _, rg := range r.ranges {
+		if isInt && x >= rg[0] && x <= rg[1] {
+			in = true
+
+			break
+		}
+	}
+
+	return in != r.negated
+}
+
+// A condition is satisfied if all the relations of any of its or-separated groups are.
+type condition [][]relation
+
+func (c condition) matches(ops Operands) bool {
+	for _, and := range c {
+		matches := true
+
+		for _, r := range and {
+			if !r.matches(ops) {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+type compiledRule struct {
+	category  string
+	condition condition
+}
+
+type ruleSet []compiledRule
+
+func (rs ruleSet) category(ops Operands) string {
+	for _, r := range rs {
+		if r.condition.matches(ops) {
+			return r.category
+		}
+	}
+
+	return Other
+}
+
+var (
+	cardinal = compileRules(cardinalRules)
+	ordinal  = compileRules(ordinalRules)
+	ranges   = compileRanges(rangeCategories)
+)
+
+// A rangeCategory is the plural category of ranges from numbers in the start category
+// to numbers in the end category, e.g. "few" for "one" and "few" in "ru".
+type rangeCategory struct {
+	start    string
+	end      string
+	category string
+}
+
+func compileRanges(rcs map[string][]rangeCategory) map[string]map[[2]string]string {
+	compiled := make(map[string]map[[2]string]string, len(rcs))
+
+	for l, rs := range rcs {
+		compiled[l] = make(map[[2]string]string, len(rs))
+		for _, r := range rs {
+			compiled[l][[2]string{r.start, r.end}] = r.category
+		}
+	}
+
+	return compiled
+}
+
+func compileRules(rules map[string][]Rule) map[string]ruleSet {
+	compiled := make(map[string]ruleSet, len(rules))
+
+	for l, rs := range rules {
+		set := make(ruleSet, 0, len(rs))
+		for _, r := range rs {
+			if r.Condition != "" {
+				set = append(set, compiledRule{r.Category, parseCondition(r.Condition)})
+			}
+		}
+
+		compiled[l] = set
+	}
+
+	return compiled
+}
+
+// parseCondition parses a condition in CLDR plural rule syntax, e.g. "n % 10 = 1 and n % 100 != 11".
+// Conditions are generated from CLDR data, and hence assumed to be valid.
+func parseCondition(s string) condition {
+	var c condition
+
+	for or := range strings.SplitSeq(s, " or ") {
+		var and []relation
+
+		for rs := range strings.SplitSeq(or, " and ") {
+			and = append(and, parseRelation(rs))
+		}
+
+		c = append(c, and)
+	}
+
+	return c
+}
+
+func parseRelation(s string) relation {
+	r := relation{}
+
+	expr, ranges, negated := strings.Cut(s, "!=")
+	if !negated {
+		expr, ranges, _ = strings.Cut(s, "=")
+	}
+
+	r.negated = negated
+
+	operand, modulus, hasModulus := strings.Cut(expr, "%")
+
+	r.operand = strings.TrimSpace(operand)[0]
+	if hasModulus {
+		r.modulus, _ = strconv.ParseUint(strings.TrimSpace(modulus), 10, 64)
+	}
+
+	for rg := range strings.SplitSeq(strings.TrimSpace(ranges), ",") {
+		from, to, isRange := strings.Cut(rg, "..")
+		if !isRange {
+			to = from
+		}
+
+		lo, _ := strconv.ParseUint(from, 10, 64)
+		hi, _ := strconv.ParseUint(to, 10, 64)
+
+		r.ranges = append(r.ranges, [2]uint64{lo, hi})
+	}
+
+	return r
+}
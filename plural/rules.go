@@ -0,0 +1,434 @@
+package plural
+
+// Code generated by running "go generate" in this directory; DO NOT EDIT.
+
+// Cardinal plural rules by language, in CLDR category order, with CLDR's sample numbers for each category;
+// numbers matching none of the rules' conditions are in the "other" category, which has none.
+var cardinalRules = map[string][]Rule{
+	"af":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ak":       {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"am":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"an":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ar":       {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"few", "n % 100 = 3..10", "@integer 3~10, 103~110, 1003, … @decimal 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 103.0, 1003.0, …"}, {"many", "n % 100 = 11..99", "@integer 11~26, 111, 1011, … @decimal 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 111.0, 1011.0, …"}, {"other", "", "@integer 100~102, 200~202, 300~302, 400~402, 500~502, 600, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ars":      {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"few", "n % 100 = 3..10", "@integer 3~10, 103~110, 1003, … @decimal 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 103.0, 1003.0, …"}, {"many", "n % 100 = 11..99", "@integer 11~26, 111, 1011, … @decimal 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 111.0, 1011.0, …"}, {"other", "", "@integer 100~102, 200~202, 300~302, 400~402, 500~502, 600, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"as":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"asa":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ast":      {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"az":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bal":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"be":       {{"one", "n % 10 = 1 and n % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 71.0, 81.0, 101.0, 1001.0, …"}, {"few", "n % 10 = 2..4 and n % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, … @decimal 2.0, 3.0, 4.0, 22.0, 23.0, 24.0, 32.0, 33.0, 102.0, 1002.0, …"}, {"many", "n % 10 = 0 or n % 10 = 5..9 or n % 100 = 11..14", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 11.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@decimal 0.1~0.9, 1.1~1.7, 10.1, 100.1, 1000.1, …"}},
+	"bem":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bez":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bg":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bho":      {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"blo":      {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bm":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bn":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bo":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"br":       {{"one", "n % 10 = 1 and n % 100 != 11,71,91", "@integer 1, 21, 31, 41, 51, 61, 81, 101, 1001, … @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 81.0, 101.0, 1001.0, …"}, {"two", "n % 10 = 2 and n % 100 != 12,72,92", "@integer 2, 22, 32, 42, 52, 62, 82, 102, 1002, … @decimal 2.0, 22.0, 32.0, 42.0, 52.0, 62.0, 82.0, 102.0, 1002.0, …"}, {"few", "n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99", "@integer 3, 4, 9, 23, 24, 29, 33, 34, 39, 43, 44, 49, 103, 1003, … @decimal 3.0, 4.0, 9.0, 23.0, 24.0, 29.0, 33.0, 34.0, 103.0, 1003.0, …"}, {"many", "n != 0 and n % 1000000 = 0", "@integer 1000000, … @decimal 1000000.0, 1000000.00, 1000000.000, 1000000.0000, …"}, {"other", "", "@integer 0, 5~8, 10~20, 100, 1000, 10000, 100000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, …"}},
+	"brx":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"bs":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, … @decimal 0.2~0.4, 1.2~1.4, 2.2~2.4, 3.2~3.4, 4.2~4.4, 5.2, 10.2, 100.2, 1000.2, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.5~1.0, 1.5~2.0, 2.5~2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ca":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"ce":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ceb":      {{"one", "v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9", "@integer 0~3, 5, 7, 8, 10~13, 15, 17, 18, 20, 21, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.3, 0.5, 0.7, 0.8, 1.0~1.3, 1.5, 1.7, 1.8, 2.0, 2.1, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 4, 6, 9, 14, 16, 19, 24, 26, 104, 1004, … @decimal 0.4, 0.6, 0.9, 1.4, 1.6, 1.9, 2.4, 2.6, 10.4, 100.4, 1000.4, …"}},
+	"cgg":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"chr":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ckb":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"cs":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"few", "i = 2..4 and v = 0", "@integer 2~4"}, {"many", "v != 0", "@decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"csw":      {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"cv":       {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"cy":       {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"few", "n = 3", "@integer 3 @decimal 3.0, 3.00, 3.000, 3.0000"}, {"many", "n = 6", "@integer 6 @decimal 6.0, 6.00, 6.000, 6.0000"}, {"other", "", "@integer 4, 5, 7~20, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"da":       {{"one", "n = 1 or t != 0 and i = 0,1", "@integer 1 @decimal 0.1~1.6"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 2.0~3.4, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"de":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"doi":      {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"dsb":      {{"one", "v = 0 and i % 100 = 1 or f % 100 = 1", "@integer 1, 101, 201, 301, 401, 501, 601, 701, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"two", "v = 0 and i % 100 = 2 or f % 100 = 2", "@integer 2, 102, 202, 302, 402, 502, 602, 702, 1002, … @decimal 0.2, 1.2, 2.2, 3.2, 4.2, 5.2, 6.2, 7.2, 10.2, 100.2, 1000.2, …"}, {"few", "v = 0 and i % 100 = 3..4 or f % 100 = 3..4", "@integer 3, 4, 103, 104, 203, 204, 303, 304, 403, 404, 503, 504, 603, 604, 703, 704, 1003, … @decimal 0.3, 0.4, 1.3, 1.4, 2.3, 2.4, 3.3, 3.4, 4.3, 4.4, 5.3, 5.4, 6.3, 6.4, 7.3, 7.4, 10.3, 100.3, 1000.3, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.5~1.0, 1.5~2.0, 2.5~2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"dv":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"dz":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ee":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"el":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"en":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"eo":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"es":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"et":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"eu":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"fa":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ff":       {{"one", "i = 0,1", "@integer 0, 1 @decimal 0.0~1.5"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"fi":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"fil":      {{"one", "v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9", "@integer 0~3, 5, 7, 8, 10~13, 15, 17, 18, 20, 21, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.3, 0.5, 0.7, 0.8, 1.0~1.3, 1.5, 1.7, 1.8, 2.0, 2.1, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 4, 6, 9, 14, 16, 19, 24, 26, 104, 1004, … @decimal 0.4, 0.6, 0.9, 1.4, 1.6, 1.9, 2.4, 2.6, 10.4, 100.4, 1000.4, …"}},
+	"fo":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"fr":       {{"one", "i = 0,1", "@integer 0, 1 @decimal 0.0~1.5"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"fur":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"fy":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ga":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"few", "n = 3..6", "@integer 3~6 @decimal 3.0, 4.0, 5.0, 6.0, 3.00, 4.00, 5.00, 6.00, 3.000, 4.000, 5.000, 6.000, 3.0000, 4.0000, 5.0000, 6.0000"}, {"many", "n = 7..10", "@integer 7~10 @decimal 7.0, 8.0, 9.0, 10.0, 7.00, 8.00, 9.00, 10.00, 7.000, 8.000, 9.000, 10.000, 7.0000, 8.0000, 9.0000, 10.0000"}, {"other", "", "@integer 0, 11~25, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"gd":       {{"one", "n = 1,11", "@integer 1, 11 @decimal 1.0, 11.0, 1.00, 11.00, 1.000, 11.000, 1.0000"}, {"two", "n = 2,12", "@integer 2, 12 @decimal 2.0, 12.0, 2.00, 12.00, 2.000, 12.000, 2.0000"}, {"few", "n = 3..10,13..19", "@integer 3~10, 13~19 @decimal 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 19.0, 3.00"}, {"other", "", "@integer 0, 20~34, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"gl":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"gsw":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"gu":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"guw":      {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"gv":       {{"one", "v = 0 and i % 10 = 1", "@integer 1, 11, 21, 31, 41, 51, 61, 71, 101, 1001, …"}, {"two", "v = 0 and i % 10 = 2", "@integer 2, 12, 22, 32, 42, 52, 62, 72, 102, 1002, …"}, {"few", "v = 0 and i % 100 = 0,20,40,60,80", "@integer 0, 20, 40, 60, 80, 100, 120, 140, 1000, 10000, 100000, 1000000, …"}, {"many", "v != 0", "@decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 3~10, 13~19, 23, 103, 1003, …"}},
+	"ha":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"haw":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"he":       {{"one", "i = 1 and v = 0 or i = 0 and v != 0", "@integer 1 @decimal 0.0~0.9, 0.00~0.05"}, {"two", "i = 2 and v = 0", "@integer 2"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.0~2.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"hi":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"hnj":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"hr":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, … @decimal 0.2~0.4, 1.2~1.4, 2.2~2.4, 3.2~3.4, 4.2~4.4, 5.2, 10.2, 100.2, 1000.2, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.5~1.0, 1.5~2.0, 2.5~2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"hsb":      {{"one", "v = 0 and i % 100 = 1 or f % 100 = 1", "@integer 1, 101, 201, 301, 401, 501, 601, 701, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"two", "v = 0 and i % 100 = 2 or f % 100 = 2", "@integer 2, 102, 202, 302, 402, 502, 602, 702, 1002, … @decimal 0.2, 1.2, 2.2, 3.2, 4.2, 5.2, 6.2, 7.2, 10.2, 100.2, 1000.2, …"}, {"few", "v = 0 and i % 100 = 3..4 or f % 100 = 3..4", "@integer 3, 4, 103, 104, 203, 204, 303, 304, 403, 404, 503, 504, 603, 604, 703, 704, 1003, … @decimal 0.3, 0.4, 1.3, 1.4, 2.3, 2.4, 3.3, 3.4, 4.3, 4.4, 5.3, 5.4, 6.3, 6.4, 7.3, 7.4, 10.3, 100.3, 1000.3, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.5~1.0, 1.5~2.0, 2.5~2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"hu":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"hy":       {{"one", "i = 0,1", "@integer 0, 1 @decimal 0.0~1.5"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ia":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"id":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ie":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ig":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ii":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"in":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"io":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"is":       {{"one", "t = 0 and i % 10 = 1 and i % 100 != 11 or t % 10 = 1 and t % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.0, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.2~0.9, 1.2~1.8, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"it":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"iu":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"iw":       {{"one", "i = 1 and v = 0 or i = 0 and v != 0", "@integer 1 @decimal 0.0~0.9, 0.00~0.05"}, {"two", "i = 2 and v = 0", "@integer 2"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.0~2.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ja":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"jbo":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"jgo":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ji":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"jmc":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"jv":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"jw":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ka":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kab":      {{"one", "i = 0,1", "@integer 0, 1 @decimal 0.0~1.5"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kaj":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kcg":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kde":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kea":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kk":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kkj":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kl":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"km":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kn":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ko":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kok":      {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kok-Latn": {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ks":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ksb":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ksh":      {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ku":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"kw":       {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n % 100 = 2,22,42,62,82 or n % 1000 = 0 and n % 100000 = 1000..20000,40000,60000,80000 or n != 0 and n % 1000000 = 100000", "@integer 2, 22, 42, 62, 82, 102, 122, 142, 1000, 10000, 100000, … @decimal 2.0, 22.0, 42.0, 62.0, 82.0, 102.0, 122.0, 142.0, 1000.0, 10000.0, 100000.0, …"}, {"few", "n % 100 = 3,23,43,63,83", "@integer 3, 23, 43, 63, 83, 103, 123, 143, 1003, … @decimal 3.0, 23.0, 43.0, 63.0, 83.0, 103.0, 123.0, 143.0, 1003.0, …"}, {"many", "n != 1 and n % 100 = 1,21,41,61,81", "@integer 21, 41, 61, 81, 101, 121, 141, 161, 1001, … @decimal 21.0, 41.0, 61.0, 81.0, 101.0, 121.0, 141.0, 161.0, 1001.0, …"}, {"other", "", "@integer 4~19, 100, 1004, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.1, 1000000.0, …"}},
+	"ky":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lag":      {{"zero", "n = 0", "@integer 0 @decimal 0.0, 0.00, 0.000, 0.0000"}, {"one", "i = 0,1 and n != 0", "@integer 1 @decimal 0.1~1.6"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lb":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lg":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lij":      {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lkt":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lld":      {{"one", "i = 1 and v = 0", "@integer 1"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"ln":       {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lo":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lt":       {{"one", "n % 10 = 1 and n % 100 != 11..19", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 71.0, 81.0, 101.0, 1001.0, …"}, {"few", "n % 10 = 2..9 and n % 100 != 11..19", "@integer 2~9, 22~29, 102, 1002, … @decimal 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 22.0, 102.0, 1002.0, …"}, {"many", "f != 0", "@decimal 0.1~0.9, 1.1~1.7, 10.1, 100.1, 1000.1, …"}, {"other", "", "@integer 0, 10~20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"lv":       {{"zero", "n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19", "@integer 0, 10~20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"one", "n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.0, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"other", "", "@integer 2~9, 22~29, 102, 1002, … @decimal 0.2~0.9, 1.2~1.9, 10.2, 100.2, 1000.2, …"}},
+	"mas":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"mg":       {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"mgo":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"mk":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.2~1.0, 1.2~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ml":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"mn":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"mo":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"few", "v != 0 or n = 0 or n != 1 and n % 100 = 1..19", "@integer 0, 2~16, 101, 1001, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 20~35, 100, 1000, 10000, 100000, 1000000, …"}},
+	"mr":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ms":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"mt":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"few", "n = 0 or n % 100 = 3..10", "@integer 0, 3~10, 103~109, 1003, … @decimal 0.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 103.0, 1003.0, …"}, {"many", "n % 100 = 11..19", "@integer 11~19, 111~117, 1011, … @decimal 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 111.0, 1011.0, …"}, {"other", "", "@integer 20~35, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"my":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nah":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"naq":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nb":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nd":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ne":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nl":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nn":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nnh":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"no":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nqo":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nr":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nso":      {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ny":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"nyn":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"om":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"or":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"os":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"osa":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"pa":       {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"pap":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"pcm":      {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"pl":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, …"}, {"many", "v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}, {"other", "", "@decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"prg":      {{"zero", "n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19", "@integer 0, 10~20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"one", "n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.0, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"other", "", "@integer 2~9, 22~29, 102, 1002, … @decimal 0.2~0.9, 1.2~1.9, 10.2, 100.2, 1000.2, …"}},
+	"ps":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"pt":       {{"one", "i = 0..1", "@integer 0, 1 @decimal 0.0~1.5"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"pt-PT":    {{"one", "i = 1 and v = 0", "@integer 1"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"rm":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ro":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"few", "v != 0 or n = 0 or n != 1 and n % 100 = 1..19", "@integer 0, 2~16, 101, 1001, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 20~35, 100, 1000, 10000, 100000, 1000000, …"}},
+	"rof":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"root":     {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ru":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, …"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, …"}, {"many", "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}, {"other", "", "@decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"rwk":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sah":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"saq":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sat":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sc":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"scn":      {{"one", "i = 1 and v = 0", "@integer 1"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"sd":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sdh":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"se":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"seh":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ses":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sg":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sgs":      {{"one", "n % 10 = 1 and n % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 71.0, 81.0, 101.0, 1001.0, …"}, {"two", "n = 2", "@integer 2 @decimal 2.0"}, {"few", "n != 2 and n % 10 = 2..9 and n % 100 != 11..19", "@integer 3~9, 22~29, 102, 1002, … @decimal 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 22.0, 102.0, 1002.0, …"}, {"many", "f != 0", "@decimal 0.1~0.9, 1.1~1.7, 10.1, 100.1, 1000.1, …"}, {"other", "", "@integer 0, 10~20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sh":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, … @decimal 0.2~0.4, 1.2~1.4, 2.2~2.4, 3.2~3.4, 4.2~4.4, 5.2, 10.2, 100.2, 1000.2, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.5~1.0, 1.5~2.0, 2.5~2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"shi":      {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"few", "n = 2..10", "@integer 2~10 @decimal 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 2.00, 3.00, 4.00, 5.00, 6.00, 7.00, 8.00"}, {"other", "", "@integer 11~26, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~1.9, 2.1~2.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"si":       {{"one", "n = 0,1 or i = 0 and f = 1", "@integer 0, 1 @decimal 0.0, 0.1, 1.0, 0.00, 0.01, 1.00, 0.000, 0.001, 1.000, 0.0000, 0.0001, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.2~0.9, 1.1~1.8, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sk":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"few", "i = 2..4 and v = 0", "@integer 2~4"}, {"many", "v != 0", "@decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sl":       {{"one", "v = 0 and i % 100 = 1", "@integer 1, 101, 201, 301, 401, 501, 601, 701, 1001, …"}, {"two", "v = 0 and i % 100 = 2", "@integer 2, 102, 202, 302, 402, 502, 602, 702, 1002, …"}, {"few", "v = 0 and i % 100 = 3..4 or v != 0", "@integer 3, 4, 103, 104, 203, 204, 303, 304, 403, 404, 503, 504, 603, 604, 703, 704, 1003, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sma":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"smi":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"smj":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"smn":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sms":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"two", "n = 2", "@integer 2 @decimal 2.0, 2.00, 2.000, 2.0000"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sn":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"so":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sq":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sr":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, … @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1, …"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, … @decimal 0.2~0.4, 1.2~1.4, 2.2~2.4, 3.2~3.4, 4.2~4.4, 5.2, 10.2, 100.2, 1000.2, …"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0, 0.5~1.0, 1.5~2.0, 2.5~2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ss":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ssy":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"st":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"su":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sv":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"sw":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"syr":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ta":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"te":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"teo":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"th":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ti":       {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"tig":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"tk":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"tl":       {{"one", "v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9", "@integer 0~3, 5, 7, 8, 10~13, 15, 17, 18, 20, 21, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.3, 0.5, 0.7, 0.8, 1.0~1.3, 1.5, 1.7, 1.8, 2.0, 2.1, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}, {"other", "", "@integer 4, 6, 9, 14, 16, 19, 24, 26, 104, 1004, … @decimal 0.4, 0.6, 0.9, 1.4, 1.6, 1.9, 2.4, 2.6, 10.4, 100.4, 1000.4, …"}},
+	"tn":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"to":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"tpi":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"tr":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ts":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"tzm":      {{"one", "n = 0..1 or n = 11..99", "@integer 0, 1, 11~24 @decimal 0.0, 1.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 19.0, 20.0, 21.0, 22.0, 23.0, 24.0"}, {"other", "", "@integer 2~10, 100~106, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ug":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"uk":       {{"one", "v = 0 and i % 10 = 1 and i % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, …"}, {"few", "v = 0 and i % 10 = 2..4 and i % 100 != 12..14", "@integer 2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002, …"}, {"many", "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}, {"other", "", "@decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ur":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"uz":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"ve":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"vec":      {{"one", "i = 1 and v = 0", "@integer 1"}, {"many", "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5", "@integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, … @decimal 1.0000001c6, 1.1c6, 2.0000001c6, 2.1c6, 3.0000001c6, 3.1c6, …"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1c3, 2c3, 3c3, 4c3, 5c3, 6c3, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, 1.0001c3, 1.1c3, 2.0001c3, 2.1c3, 3.0001c3, 3.1c3, …"}},
+	"vi":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"vo":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"vun":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"wa":       {{"one", "n = 0..1", "@integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 0.1~0.9, 1.1~1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"wae":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"wo":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"xh":       {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"xog":      {{"one", "n = 1", "@integer 1 @decimal 1.0, 1.00, 1.000, 1.0000"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"yi":       {{"one", "i = 1 and v = 0", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"yo":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"yue":      {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"zh":       {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, … @decimal 0.0~1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+	"zu":       {{"one", "i = 0 or n = 1", "@integer 0, 1 @decimal 0.0~1.0, 0.00~0.04"}, {"other", "", "@integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 1.1~2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …"}},
+}
+
+// Ordinal plural rules by language, e.g. for 1st, 2nd, 3rd and 4th in "en", like cardinalRules.
+var ordinalRules = map[string][]Rule{
+	"af":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"am":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"an":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ar":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"as":  {{"one", "n = 1,5,7,8,9,10", "@integer 1, 5, 7~10"}, {"two", "n = 2,3", "@integer 2, 3"}, {"few", "n = 4", "@integer 4"}, {"many", "n = 6", "@integer 6"}, {"other", "", "@integer 0, 11~25, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ast": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"az":  {{"one", "i % 10 = 1,2,5,7,8 or i % 100 = 20,50,70,80", "@integer 1, 2, 5, 7, 8, 11, 12, 15, 17, 18, 20~22, 25, 101, 1001, …"}, {"few", "i % 10 = 3,4 or i % 1000 = 100,200,300,400,500,600,700,800,900", "@integer 3, 4, 13, 14, 23, 24, 33, 34, 43, 44, 53, 54, 63, 64, 73, 74, 100, 1003, …"}, {"many", "i = 0 or i % 10 = 6 or i % 100 = 40,60,90", "@integer 0, 6, 16, 26, 36, 40, 46, 56, 106, 1006, …"}, {"other", "", "@integer 9, 10, 19, 29, 30, 39, 49, 59, 69, 79, 109, 1000, 10000, 100000, 1000000, …"}},
+	"bal": {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"be":  {{"few", "n % 10 = 2,3 and n % 100 != 12,13", "@integer 2, 3, 22, 23, 32, 33, 42, 43, 52, 53, 62, 63, 72, 73, 82, 83, 102, 1002, …"}, {"other", "", "@integer 0, 1, 4~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"bg":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"blo": {{"zero", "i = 0", "@integer 0"}, {"one", "i = 1", "@integer 1"}, {"few", "i = 2,3,4,5,6", "@integer 2~6"}, {"other", "", "@integer 7~22, 100, 1000, 10000, 100000, 1000000, …"}},
+	"bn":  {{"one", "n = 1,5,7,8,9,10", "@integer 1, 5, 7~10"}, {"two", "n = 2,3", "@integer 2, 3"}, {"few", "n = 4", "@integer 4"}, {"many", "n = 6", "@integer 6"}, {"other", "", "@integer 0, 11~25, 100, 1000, 10000, 100000, 1000000, …"}},
+	"bs":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ca":  {{"one", "n = 1,3", "@integer 1, 3"}, {"two", "n = 2", "@integer 2"}, {"few", "n = 4", "@integer 4"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ce":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"cs":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"cy":  {{"zero", "n = 0,7,8,9", "@integer 0, 7~9"}, {"one", "n = 1", "@integer 1"}, {"two", "n = 2", "@integer 2"}, {"few", "n = 3,4", "@integer 3, 4"}, {"many", "n = 5,6", "@integer 5, 6"}, {"other", "", "@integer 10~25, 100, 1000, 10000, 100000, 1000000, …"}},
+	"da":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"de":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"dsb": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"el":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"en":  {{"one", "n % 10 = 1 and n % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, …"}, {"two", "n % 10 = 2 and n % 100 != 12", "@integer 2, 22, 32, 42, 52, 62, 72, 82, 102, 1002, …"}, {"few", "n % 10 = 3 and n % 100 != 13", "@integer 3, 23, 33, 43, 53, 63, 73, 83, 103, 1003, …"}, {"other", "", "@integer 0, 4~18, 100, 1000, 10000, 100000, 1000000, …"}},
+	"es":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"et":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"eu":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"fa":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"fi":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"fil": {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"fr":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"fy":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ga":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"gd":  {{"one", "n = 1,11", "@integer 1, 11"}, {"two", "n = 2,12", "@integer 2, 12"}, {"few", "n = 3,13", "@integer 3, 13"}, {"other", "", "@integer 0, 4~10, 14~21, 100, 1000, 10000, 100000, 1000000, …"}},
+	"gl":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"gsw": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"gu":  {{"one", "n = 1", "@integer 1"}, {"two", "n = 2,3", "@integer 2, 3"}, {"few", "n = 4", "@integer 4"}, {"many", "n = 6", "@integer 6"}, {"other", "", "@integer 0, 5, 7~20, 100, 1000, 10000, 100000, 1000000, …"}},
+	"he":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"hi":  {{"one", "n = 1", "@integer 1"}, {"two", "n = 2,3", "@integer 2, 3"}, {"few", "n = 4", "@integer 4"}, {"many", "n = 6", "@integer 6"}, {"other", "", "@integer 0, 5, 7~20, 100, 1000, 10000, 100000, 1000000, …"}},
+	"hr":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"hsb": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"hu":  {{"one", "n = 1,5", "@integer 1, 5"}, {"other", "", "@integer 0, 2~4, 6~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"hy":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ia":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"id":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"is":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"it":  {{"many", "n = 11,8,80,800", "@integer 8, 11, 80, 800"}, {"other", "", "@integer 0~7, 9, 10, 12~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ja":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ka":  {{"one", "i = 1", "@integer 1"}, {"many", "i = 0 or i % 100 = 2..20,40,60,80", "@integer 0, 2~16, 102, 1002, …"}, {"other", "", "@integer 21~36, 100, 1000, 10000, 100000, 1000000, …"}},
+	"kk":  {{"many", "n % 10 = 6 or n % 10 = 9 or n % 10 = 0 and n != 0", "@integer 6, 9, 10, 16, 19, 20, 26, 29, 30, 36, 39, 40, 100, 1000, 10000, 100000, 1000000, …"}, {"other", "", "@integer 0~5, 7, 8, 11~15, 17, 18, 21, 101, 1001, …"}},
+	"km":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"kn":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ko":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"kw":  {{"one", "n = 1..4 or n % 100 = 1..4,21..24,41..44,61..64,81..84", "@integer 1~4, 21~24, 41~44, 61~64, 101, 1001, …"}, {"many", "n = 5 or n % 100 = 5", "@integer 5, 105, 205, 305, 405, 505, 605, 705, 1005, …"}, {"other", "", "@integer 0, 6~20, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ky":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"lij": {{"many", "n = 11,8,80..89,800..899", "@integer 8, 11, 80~89, 800~803"}, {"other", "", "@integer 0~7, 9, 10, 12~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"lld": {{"many", "n = 11,8,80,800", "@integer 8, 11, 80, 800"}, {"other", "", "@integer 0~7, 9, 10, 12~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"lo":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"lt":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"lv":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"mk":  {{"one", "i % 10 = 1 and i % 100 != 11", "@integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, …"}, {"two", "i % 10 = 2 and i % 100 != 12", "@integer 2, 22, 32, 42, 52, 62, 72, 82, 102, 1002, …"}, {"many", "i % 10 = 7,8 and i % 100 != 17,18", "@integer 7, 8, 27, 28, 37, 38, 47, 48, 57, 58, 67, 68, 77, 78, 87, 88, 107, 1007, …"}, {"other", "", "@integer 0, 3~6, 9~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ml":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"mn":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"mr":  {{"one", "n = 1", "@integer 1"}, {"two", "n = 2,3", "@integer 2, 3"}, {"few", "n = 4", "@integer 4"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ms":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"my":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"nb":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ne":  {{"one", "n = 1..4", "@integer 1~4"}, {"other", "", "@integer 0, 5~19, 100, 1000, 10000, 100000, 1000000, …"}},
+	"nl":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"or":  {{"one", "n = 1,5,7..9", "@integer 1, 5, 7~9"}, {"two", "n = 2,3", "@integer 2, 3"}, {"few", "n = 4", "@integer 4"}, {"many", "n = 6", "@integer 6"}, {"other", "", "@integer 0, 10~24, 100, 1000, 10000, 100000, 1000000, …"}},
+	"pa":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"pl":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"prg": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ps":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"pt":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ro":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ru":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sc":  {{"many", "n = 11,8,80,800", "@integer 8, 11, 80, 800"}, {"other", "", "@integer 0~7, 9, 10, 12~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"scn": {{"many", "n = 11,8,80,800", "@integer 8, 11, 80, 800"}, {"other", "", "@integer 0~7, 9, 10, 12~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sd":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"si":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sk":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sl":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sq":  {{"one", "n = 1", "@integer 1"}, {"many", "n % 10 = 4 and n % 100 != 14", "@integer 4, 24, 34, 44, 54, 64, 74, 84, 104, 1004, …"}, {"other", "", "@integer 0, 2, 3, 5~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sr":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sv":  {{"one", "n % 10 = 1,2 and n % 100 != 11,12", "@integer 1, 2, 21, 22, 31, 32, 41, 42, 51, 52, 61, 62, 71, 72, 81, 82, 101, 1001, …"}, {"other", "", "@integer 0, 3~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"sw":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ta":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"te":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"th":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"tk":  {{"few", "n % 10 = 6,9 or n = 10", "@integer 6, 9, 10, 16, 19, 26, 29, 36, 39, 106, 1006, …"}, {"other", "", "@integer 0~5, 7, 8, 11~15, 17, 18, 20, 100, 1000, 10000, 100000, 1000000, …"}},
+	"tpi": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"tr":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"uk":  {{"few", "n % 10 = 3 and n % 100 != 13", "@integer 3, 23, 33, 43, 53, 63, 73, 83, 103, 1003, …"}, {"other", "", "@integer 0~2, 4~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"ur":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"uz":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"vec": {{"many", "n = 11,8,80,800", "@integer 8, 11, 80, 800"}, {"other", "", "@integer 0~7, 9, 10, 12~17, 100, 1000, 10000, 100000, 1000000, …"}},
+	"vi":  {{"one", "n = 1", "@integer 1"}, {"other", "", "@integer 0, 2~16, 100, 1000, 10000, 100000, 1000000, …"}},
+	"yue": {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"zh":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+	"zu":  {{"other", "", "@integer 0~15, 100, 1000, 10000, 100000, 1000000, …"}},
+}
+
+// Plural categories of ranges by language, from the categories of their start and end,
+// e.g. {"one", "few", "few"} for 1–3 in "ru".
+var rangeCategories = map[string][]rangeCategory{
+	"af":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"ak":  {{"one", "one", "other"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"am":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"an":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"ar":  {{"zero", "one", "zero"}, {"zero", "two", "zero"}, {"zero", "few", "few"}, {"zero", "many", "many"}, {"zero", "other", "other"}, {"one", "two", "other"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"two", "few", "few"}, {"two", "many", "many"}, {"two", "other", "other"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "other"}, {"other", "two", "other"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"as":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"az":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"be":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"bg":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"bn":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"bs":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "other", "other"}},
+	"ca":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"cs":  {{"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"cy":  {{"zero", "one", "one"}, {"zero", "two", "two"}, {"zero", "few", "few"}, {"zero", "many", "many"}, {"zero", "other", "other"}, {"one", "two", "two"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"two", "few", "few"}, {"two", "many", "many"}, {"two", "other", "other"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "two", "two"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"da":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"de":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"el":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"en":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"es":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"et":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"eu":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"fa":  {{"one", "one", "other"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"fi":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"fil": {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"fr":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"ga":  {{"one", "two", "two"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"two", "few", "few"}, {"two", "many", "many"}, {"two", "other", "other"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "two", "two"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"gl":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"gsw": {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"gu":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"he":  {{"one", "two", "other"}, {"one", "other", "other"}, {"two", "other", "other"}, {"other", "one", "other"}, {"other", "two", "other"}, {"other", "other", "other"}},
+	"hi":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"hr":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "other", "other"}},
+	"hu":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"hy":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"ia":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"id":  {{"other", "other", "other"}},
+	"io":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"is":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"it":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"ja":  {{"other", "other", "other"}},
+	"ka":  {{"one", "other", "one"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"kk":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"km":  {{"other", "other", "other"}},
+	"kn":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"ko":  {{"other", "other", "other"}},
+	"ky":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"lij": {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"lo":  {{"other", "other", "other"}},
+	"lt":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"lv":  {{"zero", "zero", "other"}, {"zero", "one", "one"}, {"zero", "other", "other"}, {"one", "zero", "other"}, {"one", "one", "one"}, {"one", "other", "other"}, {"other", "zero", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"mk":  {{"one", "one", "other"}, {"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"ml":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"mn":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"mr":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"ms":  {{"other", "other", "other"}},
+	"my":  {{"other", "other", "other"}},
+	"nb":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"ne":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"nl":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"no":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"or":  {{"one", "one", "other"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"pa":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"pcm": {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"pl":  {{"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"ps":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"pt":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+	"ro":  {{"one", "few", "few"}, {"one", "other", "other"}, {"few", "one", "few"}, {"few", "few", "few"}, {"few", "other", "other"}, {"other", "few", "few"}, {"other", "other", "other"}},
+	"ru":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"sc":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"scn": {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"sd":  {{"one", "one", "other"}, {"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"si":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"sk":  {{"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"sl":  {{"one", "one", "few"}, {"one", "two", "two"}, {"one", "few", "few"}, {"one", "other", "other"}, {"two", "one", "few"}, {"two", "two", "two"}, {"two", "few", "few"}, {"two", "other", "other"}, {"few", "one", "few"}, {"few", "two", "two"}, {"few", "few", "few"}, {"few", "other", "other"}, {"other", "one", "few"}, {"other", "two", "two"}, {"other", "few", "few"}, {"other", "other", "other"}},
+	"sq":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"sr":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "other", "other"}},
+	"sv":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"sw":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"ta":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"te":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"th":  {{"other", "other", "other"}},
+	"tk":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"tr":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"ug":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"uk":  {{"one", "one", "one"}, {"one", "few", "few"}, {"one", "many", "many"}, {"one", "other", "other"}, {"few", "one", "one"}, {"few", "few", "few"}, {"few", "many", "many"}, {"few", "other", "other"}, {"many", "one", "one"}, {"many", "few", "few"}, {"many", "many", "many"}, {"many", "other", "other"}, {"other", "one", "one"}, {"other", "few", "few"}, {"other", "many", "many"}, {"other", "other", "other"}},
+	"ur":  {{"one", "other", "other"}, {"other", "one", "other"}, {"other", "other", "other"}},
+	"uz":  {{"one", "other", "other"}, {"other", "one", "one"}, {"other", "other", "other"}},
+	"vi":  {{"other", "other", "other"}},
+	"yue": {{"other", "other", "other"}},
+	"zh":  {{"other", "other", "other"}},
+	"zu":  {{"one", "one", "one"}, {"one", "other", "other"}, {"other", "other", "other"}},
+}
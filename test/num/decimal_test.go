@@ -3,6 +3,8 @@ package num_test
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ttzhou/cldr/num"
@@ -16,6 +18,17 @@ type decimalTestCase struct {
 	expected string
 }
 
+// testDecimal implements [num.Decimal].
+type testDecimal struct {
+	neg  bool
+	coef string
+	exp  int32
+}
+
+func (x testDecimal) Negative() bool      { return x.neg }
+func (x testDecimal) Coefficient() string { return x.coef }
+func (x testDecimal) Exponent() int32     { return x.exp }
+
 func TestDecimalFormatter(t *testing.T) {
 	t.Run("NewDecimalFormatter()", func(t *testing.T) {
 		t.Run("unsupported locales", func(t *testing.T) {
@@ -62,6 +75,7 @@ func TestDecimalFormatter(t *testing.T) {
 				{"en", 1000000, math.MaxUint64, 19, fmt.Sprintf("fractional part %d exceeds scale 19", uint(math.MaxUint64))},
 			} {
 				nf := num.MustNewDecimalFormatter(tc.locale)
+				nf.SetRoundingMode(num.RoundUnnecessary)
 				_ = nf.SetScale(tc.scale)
 
 				_, err := nf.Format(tc.whole, tc.frac)
@@ -76,6 +90,962 @@ func TestDecimalFormatter(t *testing.T) {
 			}
 		})
 
+		t.Run("rounding modes", func(t *testing.T) {
+			for i, tc := range []struct {
+				decimalTestCase
+				mode num.RoundingMode
+			}{
+				{decimalTestCase{"en", 1, 125, 2, "1.12"}, num.RoundHalfEven},
+				{decimalTestCase{"en", 1, 135, 2, "1.14"}, num.RoundHalfEven},
+				{decimalTestCase{"en", 1, 1251, 2, "1.13"}, num.RoundHalfEven},
+				{decimalTestCase{"en", 1, 125, 2, "1.13"}, num.RoundHalfUp},
+				{decimalTestCase{"en", -1, 125, 2, "-1.13"}, num.RoundHalfUp},
+				{decimalTestCase{"en", 1, 125, 2, "1.12"}, num.RoundHalfDown},
+				{decimalTestCase{"en", 1, 1251, 2, "1.13"}, num.RoundHalfDown},
+				{decimalTestCase{"en", 1, 121, 2, "1.13"}, num.RoundCeiling},
+				{decimalTestCase{"en", -1, 129, 2, "-1.12"}, num.RoundCeiling},
+				{decimalTestCase{"en", 1, 129, 2, "1.12"}, num.RoundFloor},
+				{decimalTestCase{"en", -1, 121, 2, "-1.13"}, num.RoundFloor},
+				{decimalTestCase{"en", -1, 121, 2, "-1.13"}, num.RoundUp},
+				{decimalTestCase{"en", 1, 1200, 2, "1.12"}, num.RoundUp},
+				{decimalTestCase{"en", -1, 129, 2, "-1.12"}, num.RoundDown},
+				{decimalTestCase{"en", 9, 999, 2, "10.00"}, num.RoundHalfEven},
+				{decimalTestCase{"en", 999, 5, 0, "1,000"}, num.RoundHalfUp},
+				{decimalTestCase{"en", 999, 5, 0, "999"}, num.RoundHalfDown},
+				{decimalTestCase{"en", 1, 999, -1, "1.999"}, num.RoundHalfEven},
+				{decimalTestCase{"bn", 99999, 99, 1, "১,০০,০০০.০"}, num.RoundHalfEven},
+			} {
+				nf := num.MustNewDecimalFormatter(tc.locale)
+				nf.MustSetScale(tc.scale)
+				nf.SetRoundingMode(tc.mode)
+
+				actual, err := nf.Format(tc.whole, tc.frac)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("coefficient and exponent", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				neg      bool
+				coef     uint64
+				exp      int32
+				scale    int8
+				expected string
+			}{
+				{"en", false, 1001, -2, -1, "10.01"},
+				{"en", false, 1001, -2, 3, "10.010"},
+				{"en", false, 1000, -2, -1, "10"},
+				{"en", false, 1000, -2, 2, "10.00"},
+				{"en", true, 5, -1, -1, "-0.5"},
+				{"en", true, 0, 0, -1, "-0"},
+				{"en", true, 0, -2, 2, "-0.00"},
+				{"en", false, 5, -25, -1, "0.0000000000000000000000005"},
+				{"en", false, 12, 3, -1, "12,000"},
+				{"en", false, 0, 3, 0, "0"},
+				{"en", true, 9223372036854775808, 0, -1, "-9,223,372,036,854,775,808"},
+				{"en", false, math.MaxUint64, -20, -1, "0.18446744073709551615"},
+				{"bn", true, 123456789, -3, 2, "-১,২৩,৪৫৬.৭৯"},
+			} {
+				nf := num.MustNewDecimalFormatter(tc.locale)
+				nf.MustSetScale(tc.scale)
+				nf.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := nf.FormatCoef(tc.neg, tc.coef, tc.exp)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = nf.MustFormatCoef(tc.neg, tc.coef, tc.exp)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("coefficient and exponent errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				exp      int32
+				expected string
+			}{
+				{1001, "exponent 1001 must be between -1000 and 1000"},
+				{-1001, "exponent -1001 must be between -1000 and 1000"},
+				{math.MaxInt32, "exponent 2147483647 must be between -1000 and 1000"},
+				{math.MinInt32, "exponent -2147483648 must be between -1000 and 1000"},
+			} {
+				nf := num.MustNewDecimalFormatter("en")
+
+				_, err := nf.FormatCoef(false, 5, tc.exp)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+				if err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+
+			nf := num.MustNewDecimalFormatter("en")
+			nf.MustSetScale(0)
+
+			if actual := nf.MustFormatCoef(false, 1, 1000); actual != "10"+strings.Repeat(",000", 333) {
+				t.Errorf("got: %v, expected 1 followed by 1000 zeros", actual)
+			}
+		})
+
+		t.Run("arbitrary precision", func(t *testing.T) {
+			bigInt, _ := new(big.Int).SetString("-123456789012345678901234", 10)
+			bigFloat, _, _ := big.ParseFloat("123456789.125", 10, 200, big.ToNearestEven)
+
+			for i, tc := range []struct {
+				locale   string
+				scale    int8
+				format   func(num.DecimalFormatter) (string, error)
+				expected string
+			}{
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigInt(bigInt) }, "-123,456,789,012,345,678,901,234"},
+				{"en", 2, func(df num.DecimalFormatter) (string, error) { return df.FormatBigInt(big.NewInt(0)) }, "0.00"},
+				{"hi", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigInt(bigInt) }, "-1,23,45,67,89,01,23,45,67,89,01,234"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(2, 3), 4) }, "0.6667"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(-1, 4), 4) }, "-0.25"},
+				{"en", 4, func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(-1, 4), 4) }, "-0.2500"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(1, 8), 2) }, "0.12"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(-1, 200), 2) }, "-0"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(1, 3), 25) }, "0.3333333333333333333333333"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigFloat(bigFloat) }, "123,456,789.125"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatBigFloat(big.NewFloat(0.1)) }, "0.1"},
+				{"en", 2, func(df num.DecimalFormatter) (string, error) { return df.FormatBigFloat(big.NewFloat(1e21)) }, "1,000,000,000,000,000,000,000.00"},
+				{"en", -1, func(df num.DecimalFormatter) (string, error) {
+					return df.FormatString("-123456789012345678901234.5678")
+				}, "-123,456,789,012,345,678,901,234.5678"},
+				{"en", 2, func(df num.DecimalFormatter) (string, error) { return df.FormatString("+0.125") }, "0.12"},
+				{"fr", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatString("1234.50") }, "1\u202f234,5"},
+				{"ar-EG", -1, func(df num.DecimalFormatter) (string, error) { return df.FormatString("-1234.5") }, "\u061c-١٬٢٣٤٫٥"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.MustSetScale(tc.scale)
+				df.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := tc.format(df)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("arbitrary precision errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				format   func(num.DecimalFormatter) (string, error)
+				expected string
+			}{
+				{func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(1, 8), 2) }, "fractional part 125 exceeds scale 2"},
+				{func(df num.DecimalFormatter) (string, error) { return df.FormatBigRat(big.NewRat(2, 3), 4) }, "2/3 cannot be represented exactly at scale 4"},
+				{func(df num.DecimalFormatter) (string, error) { return df.FormatBigFloat(new(big.Float).SetInf(true)) }, "infinite values cannot be formatted"},
+				{func(df num.DecimalFormatter) (string, error) { return df.FormatString("1e10") }, "invalid decimal string: \"1e10\""},
+				{func(df num.DecimalFormatter) (string, error) { return df.FormatString(".5") }, "invalid decimal string: \".5\""},
+			} {
+				df := num.MustNewDecimalFormatter("en")
+				df.SetRoundingMode(num.RoundUnnecessary)
+
+				_, err := tc.format(df)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+				if err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+		})
+
+		t.Run("decimal interface", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				scale    int8
+				decimal  testDecimal
+				expected string
+			}{
+				{"en", -1, testDecimal{false, "1001", -2}, "10.01"},
+				{"en", -1, testDecimal{true, "5", -3}, "-0.005"},
+				{"en", 2, testDecimal{false, "12", 3}, "12,000.00"},
+				{"en", -1, testDecimal{true, "0", 0}, "-0"},
+				{"en", -1, testDecimal{false, "1234567890123456789012345678", -4}, "123,456,789,012,345,678,901,234.5678"},
+				{"en", 2, testDecimal{false, "1005", -3}, "1.00"},
+				{"de", -1, testDecimal{false, "0012345", -1}, "1.234,5"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.MustSetScale(tc.scale)
+				df.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := df.FormatDecimal(tc.decimal)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = df.MustFormatDecimal(tc.decimal)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			for i, tc := range []struct {
+				decimal  testDecimal
+				expected string
+			}{
+				{testDecimal{false, "", 0}, "invalid decimal coefficient: \"\""},
+				{testDecimal{false, "-12", 0}, "invalid decimal coefficient: \"-12\""},
+				{testDecimal{false, "1.5", 0}, "invalid decimal coefficient: \"1.5\""},
+				{testDecimal{false, "1", 2000000000}, "exponent 2000000000 must be between -1000 and 1000"},
+				{testDecimal{true, "1", -1001}, "exponent -1001 must be between -1000 and 1000"},
+			} {
+				df := num.MustNewDecimalFormatter("en")
+
+				_, err := df.FormatDecimal(tc.decimal)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+				if err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+		})
+
+		t.Run("floats", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				x        float64
+				bitSize  int
+				scale    int8
+				expected string
+			}{
+				{"en", 0.1, 64, -1, "0.1"},
+				{"en", 0.30000000000000004, 64, -1, "0.30000000000000004"},
+				{"en", 0.30000000000000004, 64, 2, "0.30"},
+				{"en", float64(float32(0.1)), 32, -1, "0.1"},
+				{"en", float64(float32(0.1)), 64, -1, "0.10000000149011612"},
+				{"en", 2.675, 64, 2, "2.68"},
+				{"en", -1234567.5, 64, 0, "-1,234,568"},
+				{"en", 1e21, 64, -1, "1,000,000,000,000,000,000,000"},
+				{"en", 5e-7, 64, -1, "0.0000005"},
+				{"en", math.Copysign(0, -1), 64, -1, "-0"},
+				{"en", math.NaN(), 64, 2, "NaN"},
+				{"en", math.Inf(1), 64, 2, "∞"},
+				{"en", math.Inf(-1), 64, 2, "-∞"},
+				{"fr", -1234.5, 64, -1, "-1\u202f234,5"},
+				{"ru", math.NaN(), 64, -1, "не\u00a0число"},
+				{"ar-EG", 1234.5, 64, -1, "١٬٢٣٤٫٥"},
+				{"zh-Hant", math.NaN(), 64, -1, "非數值"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.MustSetScale(tc.scale)
+				df.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := df.FormatFloat(tc.x, tc.bitSize)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = df.MustFormatFloat(tc.x, tc.bitSize)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("float errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				x        float64
+				bitSize  int
+				scale    int8
+				expected string
+			}{
+				{1.5, 16, -1, "unsupported float bit size 16; must be 32 or 64"},
+				{0.30000000000000004, 64, 2, "fractional part 30000000000000004 exceeds scale 2"},
+			} {
+				df := num.MustNewDecimalFormatter("en")
+				df.MustSetScale(tc.scale)
+				df.SetRoundingMode(num.RoundUnnecessary)
+
+				_, err := df.FormatFloat(tc.x, tc.bitSize)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+				if err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+		})
+
+		t.Run("compact notation", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				long     bool
+				sig      uint8
+				x        string
+				expected string
+			}{
+				{"en", false, 0, "1234", "1.234K"},
+				{"en", false, 2, "1234", "1.2K"},
+				{"en", false, 2, "123456", "123K"},
+				{"en", false, 3, "999960", "1M"},
+				{"en", false, 2, "-1500000", "-1.5M"},
+				{"en", false, 2, "999", "999"},
+				{"en", false, 2, "12.345", "12"},
+				{"en", false, 2, "0", "0"},
+				{"en", false, 2, "1000000000000000", "1,000T"},
+				{"en", true, 2, "1234", "1.2 thousand"},
+				{"en", true, 2, "2000000", "2 million"},
+				{"fr", true, 2, "1000", "mille"},
+				{"fr", true, 2, "1000000", "1 million"},
+				{"fr", true, 2, "2000000", "2 millions"},
+				{"pl", true, 2, "1500000", "1,5 miliona"},
+				{"pl", true, 2, "2000000", "2 miliony"},
+				{"pl", true, 2, "5000000", "5 milionów"},
+				{"de", false, 2, "1234567", "1,2\u00a0Mio."},
+				{"ja", false, 0, "1234567", "123.4567万"},
+				{"ja", false, 2, "123456789", "1.2億"},
+				{"hi", false, 2, "1234567", "12\u00a0लाख"},
+				{"hi", true, 2, "12345678", "1.2 करोड़"},
+				{"ar-EG", false, 2, "1234567", "١٫٢\u00a0مليون"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				if tc.long {
+					df.UseCompactLongNotation()
+				} else {
+					df.UseCompactShortNotation()
+				}
+				df.MustSetCompactSignificantDigits(tc.sig)
+				df.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := df.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			df := num.MustNewDecimalFormatter("en")
+			df.UseCompactShortNotation()
+			df.UseStandardNotation()
+
+			actual := df.MustFormat(1234, 0)
+			if actual != "1,234" {
+				t.Errorf("standard notation - got: %v, expected: %v", actual, "1,234")
+			}
+
+			err := df.SetCompactSignificantDigits(21)
+			expected := "significant digits 21 exceeds max supported significant digits 20"
+			if err == nil || err.Error() != expected {
+				t.Errorf("significant digits error - got: %v, expected: %v", err, expected)
+			}
+		})
+
+		t.Run("compact notation defaults", func(t *testing.T) {
+			for i, tc := range []struct {
+				long     bool
+				x        string
+				expected string
+			}{
+				{false, "1234", "1.2K"},
+				{false, "1250", "1.2K"},
+				{false, "999999", "1M"},
+				{false, "123456", "123K"},
+				{false, "1234567", "1.2M"},
+				{false, "-1500000", "-1.5M"},
+				{false, "12.345", "12"},
+				{true, "1234", "1.2 thousand"},
+			} {
+				df := num.MustNewDecimalFormatter("en")
+				if tc.long {
+					df.UseCompactLongNotation()
+				} else {
+					df.UseCompactShortNotation()
+				}
+
+				actual, err := df.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Numbers too small to compact are rounded to significant digits at any supported exponent.
+			df := num.MustNewDecimalFormatter("en")
+			df.UseCompactShortNotation()
+
+			for i, tc := range []struct {
+				exp      int32
+				expected string
+			}{
+				{-300, "0." + strings.Repeat("0", 294) + "12"},
+				{-1000, "0." + strings.Repeat("0", 994) + "12"},
+			} {
+				actual, err := df.FormatCoef(false, 123456, tc.exp)
+				if err != nil {
+					t.Errorf("exponent test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("exponent test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("qualifiers", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale    string
+				qualifier string
+				compact   bool
+				expected  string
+			}{
+				{"en", "approximately", false, "~1,000"},
+				{"en", "at least", false, "1,000+"},
+				{"en", "at most", false, "≤1,000"},
+				{"en", "at least", true, "1K+"},
+				{"en", "exactly", false, "1,000"},
+				{"fr", "approximately", false, "≈1\u202f000"},
+				{"fr", "at least", false, "≥1\u202f000"},
+				{"es", "at least", false, "Más de 1000"},
+				{"ja", "approximately", false, "約 1,000"},
+				{"ja", "at most", false, "1,000 以下"},
+				{"fa", "at least", false, "\u200e۱٬۰۰۰+\u200e"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				// Qualifiers replace one another, e.g. exactly replaces approximately.
+				df.DisplayApproximately()
+
+				switch tc.qualifier {
+				case "approximately":
+					df.DisplayApproximately()
+				case "at least":
+					df.DisplayAtLeast()
+				case "at most":
+					df.DisplayAtMost()
+				case "exactly":
+					df.DisplayExactly()
+				}
+
+				if tc.compact {
+					df.UseCompactShortNotation()
+				}
+
+				actual, err := df.Format(1000, 0)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Qualifiers apply to NaN and infinities as they do to other numbers.
+			for i, tc := range []struct {
+				locale    string
+				qualifier string
+				x         float64
+				expected  string
+			}{
+				{"en", "approximately", math.NaN(), "~NaN"},
+				{"en", "at least", math.Inf(1), "∞+"},
+				{"en", "at most", math.Inf(-1), "≤-∞"},
+				{"fr", "approximately", math.Inf(1), "≈∞"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+
+				switch tc.qualifier {
+				case "approximately":
+					df.DisplayApproximately()
+				case "at least":
+					df.DisplayAtLeast()
+				case "at most":
+					df.DisplayAtMost()
+				}
+
+				if actual := df.MustFormatFloat(tc.x, 64); actual != tc.expected {
+					t.Errorf("non-finite test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("sign display", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				sign     string
+				x        string
+				expected string
+			}{
+				{"en", "auto", "5", "5.00"},
+				{"en", "auto", "-5", "-5.00"},
+				{"en", "auto", "-0.001", "-0.00"},
+				{"en", "always", "5", "+5.00"},
+				{"en", "always", "0", "+0.00"},
+				{"en", "always", "-5", "-5.00"},
+				{"en", "never", "-5", "5.00"},
+				{"en", "except zero", "5", "+5.00"},
+				{"en", "except zero", "-5", "-5.00"},
+				{"en", "except zero", "-0.001", "0.00"},
+				{"en", "negative", "5", "5.00"},
+				{"en", "negative", "-5", "-5.00"},
+				{"en", "negative", "-0.001", "0.00"},
+				{"sv", "auto", "-5", "−5,00"},
+				{"sv", "always", "5", "+5,00"},
+				{"ar", "always", "5", "\u200e+5.00"},
+				{"ar", "auto", "-5", "\u200e-5.00"},
+				{"ar-EG", "always", "5", "\u061c+٥٫٠٠"},
+				{"fa", "always", "-5", "\u200e−۵٫۰۰"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.SetRoundingMode(num.RoundHalfEven)
+				df.MustSetScale(2)
+
+				switch tc.sign {
+				case "auto":
+					df.DisplaySignAlways()
+					df.DisplaySignAuto()
+				case "always":
+					df.DisplaySignAlways()
+				case "never":
+					df.DisplaySignNever()
+				case "except zero":
+					df.DisplaySignExceptZero()
+				case "negative":
+					df.DisplaySignNegative()
+				}
+
+				actual, err := df.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			df := num.MustNewDecimalFormatter("en")
+			df.UseCompactShortNotation()
+			df.DisplaySignAlways()
+
+			if actual := df.MustFormat(1200, 0); actual != "+1.2K" {
+				t.Errorf("compact notation - got: %v, expected: %v", actual, "+1.2K")
+			}
+
+			for i, tc := range []struct {
+				x        float64
+				expected string
+			}{
+				{math.NaN(), "NaN"},
+				{math.Inf(1), "+∞"},
+				{math.Inf(-1), "-∞"},
+			} {
+				if actual := df.MustFormatFloat(tc.x, 64); actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// NaN is never displayed with a sign.
+			for i, sign := range []func(*num.DecimalFormatter){
+				(*num.DecimalFormatter).DisplaySignExceptZero,
+				(*num.DecimalFormatter).DisplaySignNegative,
+				(*num.DecimalFormatter).DisplaySignNever,
+			} {
+				df := num.MustNewDecimalFormatter("en")
+				sign(&df)
+
+				if actual := df.MustFormatFloat(math.NaN(), 64); actual != "NaN" {
+					t.Errorf("NaN test case #%d - got: %v, expected: %v", i+1, actual, "NaN")
+				}
+			}
+		})
+
+		t.Run("grouping", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				grouping string
+				x        string
+				expected string
+			}{
+				{"en", "locale", "1000", "1,000"},
+				{"pl", "locale", "1000", "1000"},
+				{"pl", "locale", "10000", "10\u00a0000"},
+				{"pl", "locale", "1234567", "1\u00a0234\u00a0567"},
+				{"es", "locale", "1000", "1000"},
+				{"es", "locale", "10000", "10.000"},
+				{"es-MX", "locale", "1000", "1,000"},
+				{"pl", "full", "1000", "1\u00a0000"},
+				{"en", "min2", "1000", "1000"},
+				{"en", "min2", "10000", "10,000"},
+				{"hi", "min2", "100000", "1,00,000"},
+				{"en", "none", "1234567", "1234567"},
+				{"pl", "none", "10000", "10000"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+
+				switch tc.grouping {
+				case "locale":
+					df.UseNoGrouping()
+					df.UseLocaleGrouping()
+				case "full":
+					df.UseFullGrouping()
+				case "min2":
+					df.UseMin2Grouping()
+				case "none":
+					df.UseNoGrouping()
+				}
+
+				actual, err := df.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("integer and fraction digits", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				minInt   uint8
+				minFrac  int8
+				maxFrac  int8
+				x        string
+				expected string
+			}{
+				{"en", 0, 0, 0, "1.23456", "1.235"},
+				{"en", 0, 0, 0, "1.50", "1.5"},
+				{"en", 0, 0, 0, "1234.5", "1,234.5"},
+				{"en", 7, 0, 0, "123", "0,000,123"},
+				{"en", 7, 0, 0, "-0.5", "-0,000,000.5"},
+				{"en", 3, 2, 4, "7.5", "007.50"},
+				{"en", 3, 2, 4, "1.23456", "001.2346"},
+				{"en", 3, 2, 4, "1.100000", "001.10"},
+				{"en", 0, 1, -1, "7", "7.0"},
+				{"en", 0, 1, -1, "1.23456789", "1.23456789"},
+				{"de", 2, 2, 2, "5", "05,00"},
+				{"ar-EG", 2, 0, 1, "5.25", "٠٥٫٢"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.SetRoundingMode(num.RoundHalfEven)
+
+				if tc.maxFrac != 0 {
+					df.MustSetFractionDigits(tc.minFrac, tc.maxFrac)
+				} else {
+					df.UsePatternDigits()
+				}
+
+				if tc.minInt > 0 {
+					df.MustSetMinIntegerDigits(tc.minInt)
+				}
+
+				actual, err := df.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Fraction digits only change how numbers are displayed, unlike the scale.
+			df := num.MustNewDecimalFormatter("en")
+			df.SetRoundingMode(num.RoundHalfEven)
+			df.MustSetScale(3)
+			df.MustSetFractionDigits(0, 2)
+
+			if actual := df.MustFormat(1, 15); actual != "1.02" {
+				t.Errorf("scale - got: %v, expected: %v", actual, "1.02")
+			}
+
+			df.UsePatternDigits()
+
+			if actual := df.MustFormat(1, 15); actual != "1.015" {
+				t.Errorf("pattern digits - got: %v, expected: %v", actual, "1.015")
+			}
+
+			df = num.MustNewDecimalFormatter("en")
+			df.SetRoundingMode(num.RoundUnnecessary)
+
+			if _, err := df.FormatString("1.2345"); err == nil {
+				t.Errorf("expected error for rounding to the pattern's fraction digits, got nil")
+			}
+		})
+
+		t.Run("defaults", func(t *testing.T) {
+			df := num.MustNewDecimalFormatter("en")
+
+			for i, tc := range []struct {
+				format   func() (string, error)
+				expected string
+			}{
+				{func() (string, error) { return df.Format(1, 2345) }, "1.234"},
+				{func() (string, error) { return df.FormatFloat(0.1234, 64) }, "0.123"},
+				{func() (string, error) { return df.FormatString("1.23456") }, "1.235"},
+				{func() (string, error) { return df.FormatString("1234567.50") }, "1,234,567.5"},
+				{func() (string, error) { return df.FormatString("-0.000001") }, "-0"},
+				{func() (string, error) { return df.FormatString("1.0005") }, "1"},
+			} {
+				actual, err := tc.format()
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Every fractional digit is displayed only when opted into.
+			df.MustSetFractionDigits(0, -1)
+
+			if actual := df.MustFormatFloat(0.1234, 64); actual != "0.1234" {
+				t.Errorf("all fraction digits - got: %v, expected: %v", actual, "0.1234")
+			}
+
+			df = num.MustNewDecimalFormatter("en")
+			df.MustSetScale(-1)
+
+			if actual := df.MustFormatString("1.23456"); actual != "1.23456" {
+				t.Errorf("scale -1 - got: %v, expected: %v", actual, "1.23456")
+			}
+		})
+
+		t.Run("trailing zeros", func(t *testing.T) {
+			df := num.MustNewDecimalFormatter("en")
+			df.SetRoundingMode(num.RoundHalfEven)
+			df.MustSetScale(2)
+			df.DisplayTrailingZerosStripIfInteger()
+
+			for i, tc := range []struct {
+				x        string
+				expected string
+			}{
+				{"5", "5"},
+				{"5.5", "5.50"},
+				{"4.999", "5"},
+				{"5.001", "5"},
+				{"1234.00", "1,234"},
+			} {
+				if actual := df.MustFormatString(tc.x); actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			df.DisplayTrailingZerosAuto()
+
+			if actual := df.MustFormatString("5"); actual != "5.00" {
+				t.Errorf("auto - got: %v, expected: %v", actual, "5.00")
+			}
+		})
+
+		t.Run("integer and fraction digits errors", func(t *testing.T) {
+			df := num.MustNewDecimalFormatter("en")
+
+			for i, tc := range []struct {
+				err      error
+				expected string
+			}{
+				{df.SetMinIntegerDigits(0), "integer digits 0 must be between 1 and max supported scale 20"},
+				{df.SetMinIntegerDigits(21), "integer digits 21 must be between 1 and max supported scale 20"},
+				{df.SetFractionDigits(-1, 2), "fraction digits -1 must be between 0 and max supported scale 20"},
+				{df.SetFractionDigits(0, -2), "fraction digits -2 must be between -1 and max supported scale 20"},
+				{df.SetFractionDigits(0, 21), "fraction digits 21 must be between -1 and max supported scale 20"},
+				{df.SetFractionDigits(3, 2), "minimum fraction digits 3 exceeds maximum fraction digits 2"},
+			} {
+				if tc.err == nil || tc.err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, tc.err, tc.expected)
+				}
+			}
+		})
+
+		t.Run("significant digits", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				scale    int8
+				minimum  uint8
+				maximum  uint8
+				priority string
+				x        string
+				expected string
+			}{
+				{"en", -1, 0, 3, "", "0.00123456", "0.00123"},
+				{"en", -1, 0, 3, "", "123456", "123,000"},
+				{"en", -1, 0, 3, "", "-0.0001", "-0.0001"},
+				{"en", -1, 0, 4, "", "999.96", "1,000"},
+				{"en", -1, 4, 0, "", "1.5", "1.500"},
+				{"en", -1, 3, 0, "", "0", "0.00"},
+				{"en", -1, 2, 3, "", "1234.5", "1,230"},
+				{"de", -1, 0, 2, "", "0.0456", "0,046"},
+				{"en", 2, 0, 4, "", "1.2345", "1.234"},
+				{"en", 2, 0, 4, "significant", "12345.678", "12,350"},
+				{"en", 2, 0, 4, "more", "1.2345", "1.234"},
+				{"en", 2, 0, 4, "more", "12345.678", "12,345.68"},
+				{"en", 2, 0, 4, "less", "1.2345", "1.23"},
+				{"en", 2, 0, 4, "less", "12345.678", "12,350"},
+				{"en", 2, 4, 0, "more", "1.5", "1.500"},
+				{"en", 2, 4, 0, "less", "1.5", "1.50"},
+				{"en", 0, 2, 2, "more", "123.4", "123"},
+				{"en", 0, 2, 2, "less", "123.4", "120"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.SetRoundingMode(num.RoundHalfEven)
+				df.MustSetScale(tc.scale)
+				df.MustSetSignificantDigits(tc.minimum, tc.maximum)
+
+				switch tc.priority {
+				case "significant":
+					df.UseLessPrecisionPriority()
+					df.UseSignificantDigitsPriority()
+				case "more":
+					df.UseMorePrecisionPriority()
+				case "less":
+					df.UseLessPrecisionPriority()
+				}
+
+				actual, err := df.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Significant digits apply to numbers with many fractional digits, up to the supported exponents.
+			df := num.MustNewDecimalFormatter("en")
+			df.SetRoundingMode(num.RoundHalfEven)
+			df.MustSetSignificantDigits(0, 3)
+
+			for i, tc := range []struct {
+				exp      int32
+				expected string
+			}{
+				{-300, "0." + strings.Repeat("0", 294) + "123"},
+				{-1000, "0." + strings.Repeat("0", 994) + "123"},
+				{1000, "1,230" + strings.Repeat(",000", 334)},
+			} {
+				actual, err := df.FormatCoef(false, 123456, tc.exp)
+				if err != nil {
+					t.Errorf("exponent test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("exponent test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("significant digits errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				minimum  uint8
+				maximum  uint8
+				expected string
+			}{
+				{21, 0, "significant digits 21 exceeds max supported significant digits 20"},
+				{0, 21, "significant digits 21 exceeds max supported significant digits 20"},
+				{3, 2, "minimum significant digits 3 exceeds maximum significant digits 2"},
+			} {
+				df := num.MustNewDecimalFormatter("en")
+
+				err := df.SetSignificantDigits(tc.minimum, tc.maximum)
+				if err == nil || err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+		})
+
+		t.Run("ranges", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				notation string
+				x        string
+				y        string
+				expected string
+			}{
+				{"en", "", "3", "5", "3–5"},
+				{"en", "", "1234", "5678", "1,234–5,678"},
+				{"en", "", "-3", "5", "-3 – 5"},
+				{"en", "", "3", "-5", "3–-5"},
+				{"en", "", "-5", "-3", "-5 – -3"},
+				{"en", "", "3", "3", "~3"},
+				{"en", "short", "3000", "5000", "3K – 5K"},
+				{"en", "short", "3000", "5000000", "3K – 5M"},
+				{"en", "long", "3000", "5000", "3–5 thousand"},
+				{"fr", "short", "3000", "5000", "3–5\u00a0k"},
+				{"fr", "long", "3000", "5000", "3–5 mille"},
+				{"ar-EG", "", "3", "5", "٣–٥"},
+			} {
+				df := num.MustNewDecimalFormatter(tc.locale)
+				df.SetRoundingMode(num.RoundHalfEven)
+
+				switch tc.notation {
+				case "short":
+					df.UseCompactShortNotation()
+				case "long":
+					df.UseCompactLongNotation()
+				}
+
+				actual, err := df.FormatStringRange(tc.x, tc.y)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Ends that round to the same number are displayed as an approximate number.
+			df := num.MustNewDecimalFormatter("en")
+			df.SetRoundingMode(num.RoundHalfEven)
+			df.MustSetScale(0)
+
+			actual := df.MustFormatDecimalRange(testDecimal{false, "29", -1}, testDecimal{false, "32", -1})
+			if actual != "~3" {
+				t.Errorf("approximately - got: %v, expected: %v", actual, "~3")
+			}
+
+			for i, tc := range [][2]string{{"x", "1"}, {"1", "y"}} {
+				if _, err := df.FormatStringRange(tc[0], tc[1]); err == nil {
+					t.Errorf("test case #%d - expected error but did not receive one", i+1)
+				}
+			}
+		})
+
 		t.Run("expected outputs", func(t *testing.T) {
 			for i, tc := range []decimalTestCase{
 				{"en", 1, 0, 0, "1"},
@@ -93,6 +1063,7 @@ func TestDecimalFormatter(t *testing.T) {
 				{"en", 1, 1010, 9, "1.000001010"},
 				{"en", 1, math.MaxUint64, -1, "1.18446744073709551615"},
 				{"en", 1, math.MaxUint64, 20, "1.18446744073709551615"},
+				{"en", math.MinInt64, 0, 0, "-9,223,372,036,854,775,808"},
 
 				{"en-US", 100, 100, -1, "100.1"},
 				{"en-US", 1000, 100, -1, "1,000.1"},
@@ -1,6 +1,9 @@
 package num_test
 
 import (
+	"math"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ttzhou/cldr/num"
@@ -53,6 +56,793 @@ func TestMoneyFormatter(t *testing.T) {
 			}
 		})
 
+		t.Run("rounding modes", func(t *testing.T) {
+			for i, tc := range []struct {
+				moneyTestCase
+				mode num.RoundingMode
+			}{
+				{moneyTestCase{"en", 1, 240, "USD", "USD\u00a01.24"}, num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 245, "USD", "USD\u00a01.24"}, num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 245, "USD", "USD\u00a01.25"}, num.RoundHalfUp},
+				{moneyTestCase{"en", 9, 999, "USD", "USD\u00a010.00"}, num.RoundHalfEven},
+				{moneyTestCase{"en", -9, 991, "USD", "-USD\u00a09.99"}, num.RoundCeiling},
+				{moneyTestCase{"en", 1000000, 2, "JPY", "JPY\u00a01,000,000"}, num.RoundHalfEven},
+				{moneyTestCase{"en", 1000000, 2, "JPY", "JPY\u00a01,000,001"}, num.RoundUp},
+				{moneyTestCase{"fr", 999, 995, "EUR", "1\u202f000,00\u00a0EUR"}, num.RoundHalfUp},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(tc.mode)
+
+				actual, err := mf.Format(tc.whole, tc.frac, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("cash rounding", func(t *testing.T) {
+			for i, tc := range []struct {
+				moneyTestCase
+				mode num.RoundingMode
+			}{
+				{moneyTestCase{"de-CH", 1, 0, "CHF", "CHF\u00a01.00"}, num.RoundUnnecessary},
+				{moneyTestCase{"de-CH", 1, 5, "CHF", "CHF\u00a01.05"}, num.RoundUnnecessary},
+				{moneyTestCase{"de-CH", 1, 3, "CHF", "CHF\u00a01.05"}, num.RoundHalfEven},
+				{moneyTestCase{"de-CH", 1, 2, "CHF", "CHF\u00a01.00"}, num.RoundHalfEven},
+				{moneyTestCase{"de-CH", 1, 225, "CHF", "CHF\u00a01.20"}, num.RoundHalfEven},
+				{moneyTestCase{"de-CH", 1, 225, "CHF", "CHF\u00a01.25"}, num.RoundHalfUp},
+				{moneyTestCase{"de-CH", 9, 98, "CHF", "CHF\u00a010.00"}, num.RoundHalfUp},
+				// The negative subpattern of "de-CH", ¤-#,##0.00, has no space before the minus sign.
+				{moneyTestCase{"de-CH", -1, 3, "CHF", "CHF-1.05"}, num.RoundHalfUp},
+				{moneyTestCase{"da", 10, 26, "DKK", "10,50\u00a0DKK"}, num.RoundHalfEven},
+				{moneyTestCase{"da", 10, 24, "DKK", "10,00\u00a0DKK"}, num.RoundHalfEven},
+				{moneyTestCase{"cs", 10, 50, "CZK", "10\u00a0CZK"}, num.RoundHalfEven},
+				{moneyTestCase{"cs", 11, 50, "CZK", "12\u00a0CZK"}, num.RoundHalfEven},
+				{moneyTestCase{"cs", 10, 5, "CZK", "10\u00a0CZK"}, num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 5, "USD", "USD\u00a01.05"}, num.RoundUnnecessary},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(tc.mode)
+				mf.UseCashRounding()
+
+				actual, err := mf.Format(tc.whole, tc.frac, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("de-CH")
+			mf.UseCashRounding()
+			mf.UseStandardRounding()
+			if actual := mf.MustFormat(1, 3, "CHF"); actual != "CHF\u00a01.03" {
+				t.Errorf("standard rounding - got: %v, expected: %v", actual, "CHF\u00a01.03")
+			}
+		})
+
+		t.Run("cash rounding error", func(t *testing.T) {
+			for i, tc := range []moneyTestCase{
+				{"de-CH", 1, 3, "CHF", "1.03 is not a multiple of rounding increment 0.05 (CHF)"},
+				{"da", -10, 26, "DKK", "-10.26 is not a multiple of rounding increment 0.50 (DKK)"},
+				{"cs", 10, 50, "CZK", "fractional part 50 exceeds scale 0 (CZK)"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.UseCashRounding()
+
+				_, err := mf.Format(tc.whole, tc.frac, tc.cur)
+				if err == nil {
+					t.Errorf("test case #%d - expected error but did not receive one", i+1)
+					continue
+				}
+				got := err.Error()
+				if got != tc.expected {
+					t.Errorf("test case #%d - got: %s, expected: %s", i+1, got, tc.expected)
+				}
+			}
+		})
+
+		t.Run("rounding increments", func(t *testing.T) {
+			for i, tc := range []struct {
+				moneyTestCase
+				inc  string
+				mode num.RoundingMode
+			}{
+				{moneyTestCase{"en", 1, 25, "USD", "USD\u00a01.25"}, "0.25", num.RoundUnnecessary},
+				{moneyTestCase{"en", 1, 37, "USD", "USD\u00a01.25"}, "0.25", num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 38, "USD", "USD\u00a01.50"}, "0.25", num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 375, "USD", "USD\u00a01.50"}, "0.25", num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 125, "USD", "USD\u00a01.00"}, "0.25", num.RoundHalfEven},
+				{moneyTestCase{"en", -1, 26, "USD", "-USD\u00a01.25"}, "0.25", num.RoundCeiling},
+				{moneyTestCase{"en", 1, 1, "USD", "USD\u00a01.125"}, "0.125", num.RoundUp},
+				{moneyTestCase{"en", 12, 0, "JPY", "JPY\u00a010"}, "5", num.RoundHalfEven},
+				{moneyTestCase{"en", 13, 0, "JPY", "JPY\u00a015"}, "5", num.RoundHalfEven},
+				{moneyTestCase{"en", 1, 3, "CHF", "CHF\u00a01.03"}, "0", num.RoundUnnecessary},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(tc.mode)
+				mf.MustSetRoundingIncrement(tc.inc)
+
+				actual, err := mf.Format(tc.whole, tc.frac, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("rounding increment errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				moneyTestCase
+				inc string
+			}{
+				{moneyTestCase{"en", 1, 3, "USD", "invalid rounding increment: \"-0.25\""}, "-0.25"},
+				{moneyTestCase{"en", 1, 3, "USD", "invalid rounding increment: \".25\""}, ".25"},
+				{moneyTestCase{"en", 1, 3, "USD", "invalid rounding increment: \"0,25\""}, "0,25"},
+				{moneyTestCase{"en", 1, 3, "USD", "1.03 is not a multiple of rounding increment 0.25 (USD)"}, "0.25"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+
+				err := mf.SetRoundingIncrement(tc.inc)
+				if err == nil {
+					_, err = mf.Format(tc.whole, tc.frac, tc.cur)
+				}
+				if err == nil {
+					t.Errorf("test case #%d - expected error but did not receive one", i+1)
+					continue
+				}
+				got := err.Error()
+				if got != tc.expected {
+					t.Errorf("test case #%d - got: %s, expected: %s", i+1, got, tc.expected)
+				}
+			}
+		})
+
+		t.Run("coefficient and exponent", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				neg      bool
+				coef     uint64
+				exp      int32
+				cur      string
+				expected string
+			}{
+				{"en", false, 1001, -2, "USD", "USD\u00a010.01"},
+				{"en", false, 1, -2, "USD", "USD\u00a00.01"},
+				{"en", true, 5, -1, "USD", "-USD\u00a00.50"},
+				{"en", false, 5, 0, "USD", "USD\u00a05.00"},
+				{"en", false, 5, 2, "JPY", "JPY\u00a0500"},
+				{"en", false, 91411206, -3, "USD", "USD\u00a091,411.21"},
+				{"en", true, 1005, -3, "BHD", "-BHD\u00a01.005"},
+				{"fr", true, 100010, -2, "EUR", "-1\u202f000,10\u00a0EUR"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := mf.FormatCoef(tc.neg, tc.coef, tc.exp, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = mf.MustFormatCoef(tc.neg, tc.coef, tc.exp, tc.cur)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("en")
+
+			_, err := mf.FormatCoef(false, 5, math.MaxInt32, "USD")
+			if expected := "exponent 2147483647 must be between -1000 and 1000 (USD)"; err == nil || err.Error() != expected {
+				t.Errorf("got: %v, expected: %v", err, expected)
+			}
+		})
+
+		t.Run("arbitrary precision", func(t *testing.T) {
+			bigInt, _ := new(big.Int).SetString("31000000000000000000000", 10)
+
+			for i, tc := range []struct {
+				locale   string
+				format   func(num.MoneyFormatter) (string, error)
+				expected string
+			}{
+				{"en", func(mf num.MoneyFormatter) (string, error) { return mf.FormatBigInt(bigInt, "USD") }, "USD\u00a031,000,000,000,000,000,000,000.00"},
+				{"en", func(mf num.MoneyFormatter) (string, error) { return mf.FormatBigRat(big.NewRat(-1, 3), "USD") }, "-USD\u00a00.33"},
+				{"en", func(mf num.MoneyFormatter) (string, error) { return mf.FormatBigRat(big.NewRat(1, 8), "BHD") }, "BHD\u00a00.125"},
+				{"en", func(mf num.MoneyFormatter) (string, error) {
+					mf.UseCashRounding()
+					return mf.FormatBigRat(big.NewRat(3, 40), "CHF")
+				}, "CHF\u00a00.10"},
+				{"en", func(mf num.MoneyFormatter) (string, error) {
+					mf.UseCashRounding()
+					return mf.FormatBigRat(big.NewRat(-1, 40), "CHF")
+				}, "-CHF\u00a00.00"},
+				{"en", func(mf num.MoneyFormatter) (string, error) {
+					mf.UseAmountScale()
+					return mf.FormatBigRat(big.NewRat(2, 3), "USD")
+				}, "USD\u00a00.67"},
+				{"en", func(mf num.MoneyFormatter) (string, error) { return mf.FormatBigFloat(big.NewFloat(19.99), "USD") }, "USD\u00a019.99"},
+				{"en", func(mf num.MoneyFormatter) (string, error) { return mf.FormatString("1234567.891", "JPY") }, "JPY\u00a01,234,568"},
+				{"de", func(mf num.MoneyFormatter) (string, error) {
+					return mf.FormatString("-123456789012345678901234.5678", "EUR")
+				}, "-123.456.789.012.345.678.901.234,57\u00a0EUR"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := tc.format(mf)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("en")
+
+			_, err := mf.FormatBigRat(big.NewRat(2, 3), "USD")
+			if expected := "2/3 cannot be represented exactly at scale 2 (USD)"; err == nil || err.Error() != expected {
+				t.Errorf("got: %v, expected: %v", err, expected)
+			}
+		})
+
+		t.Run("decimal interface", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				decimal  testDecimal
+				cur      string
+				expected string
+			}{
+				{"en", testDecimal{false, "1001", -2}, "USD", "USD\u00a010.01"},
+				{"en", testDecimal{true, "125", -3}, "USD", "-USD\u00a00.12"},
+				{"en", testDecimal{false, "15", 2}, "JPY", "JPY\u00a01,500"},
+				{"de", testDecimal{false, "310000000000000000000000", -1}, "EUR", "31.000.000.000.000.000.000.000,00\u00a0EUR"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := mf.FormatDecimal(tc.decimal, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = mf.MustFormatDecimal(tc.decimal, tc.cur)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("en")
+
+			_, err := mf.FormatDecimal(testDecimal{false, "1e3", 0}, "USD")
+			if err == nil || err.Error() != "invalid decimal coefficient: \"1e3\" (USD)" {
+				t.Errorf("got: %v, expected: invalid decimal coefficient error", err)
+			}
+		})
+
+		t.Run("amount scale", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				cash     bool
+				neg      bool
+				coef     uint64
+				exp      int32
+				cur      string
+				expected string
+			}{
+				{"en", false, false, 91411206, -3, "USD", "USD\u00a091,411.206"},
+				{"en", false, true, 5, -1, "USD", "-USD\u00a00.50"},
+				{"en", false, false, 1500, -3, "JPY", "JPY\u00a01.500"},
+				{"en", false, false, 3, -2, "CHF", "CHF\u00a00.03"},
+				{"en", true, false, 5, -2, "CHF", "CHF\u00a00.05"},
+				{"en", true, false, 3, -1, "CHF", "CHF\u00a00.30"},
+				{"de", false, false, 123456789, -4, "EUR", "12.345,6789\u00a0EUR"},
+				{"en", false, false, 1234, -22, "USD", "USD\u00a00." + strings.Repeat("0", 18) + "1234"},
+				{"en", false, false, 1234, -256, "USD", "USD\u00a00." + strings.Repeat("0", 252) + "1234"},
+				{"en", false, false, 1234, -1000, "USD", "USD\u00a00." + strings.Repeat("0", 996) + "1234"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.UseAmountScale()
+				if tc.cash {
+					mf.UseCashRounding()
+				}
+
+				actual, err := mf.FormatCoef(tc.neg, tc.coef, tc.exp, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("en")
+			mf.UseAmountScale()
+			mf.UseCurrencyScale()
+
+			if _, err := mf.FormatCoef(false, 91411206, -3, "USD"); err == nil {
+				t.Errorf("expected error after UseCurrencyScale, got nil")
+			}
+		})
+
+		t.Run("compact notation", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale     string
+				label      string
+				accounting bool
+				sig        uint8
+				x          string
+				cur        string
+				expected   string
+			}{
+				{"en", "symbol", false, 2, "1234567", "USD", "$1.2M"},
+				{"en", "symbol", false, 0, "1234567", "USD", "$1.234567M"},
+				{"en", "symbol", false, 2, "-1234567", "USD", "-$1.2M"},
+				{"en", "symbol", true, 2, "-1234567", "USD", "($1.2M)"},
+				{"en", "symbol", false, 2, "999.5", "USD", "$1K"},
+				{"en", "symbol", false, 2, "12.345", "USD", "$12"},
+				{"en", "code", false, 2, "1234567", "USD", "USD\u00a01.2M"},
+				{"en", "none", false, 2, "1234567", "USD", "1.2M"},
+				{"de", "symbol", false, 2, "1234567", "EUR", "1,2\u00a0Mio.\u00a0€"},
+				{"de", "symbol", false, 2, "1234", "EUR", "1.234\u00a0€"},
+				{"de", "code", false, 2, "-1234567", "EUR", "-1,2\u00a0Mio.\u00a0EUR"},
+				{"fr", "symbol", false, 2, "-1234567", "EUR", "-1,2\u00a0M\u00a0€"},
+				{"fr", "symbol", true, 2, "-1234567", "EUR", "(1,2\u00a0M\u00a0€)"},
+				{"ja", "symbol", false, 2, "123456789", "JPY", "￥1.2億"},
+				{"en-IN", "symbol", false, 2, "1234567", "INR", "₹12L"},
+				{"ar-EG", "symbol", false, 2, "1234567", "EGP", "١٫٢\u00a0مليون\u00a0ج.م.\u200f"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.UseCompactShortNotation()
+				mf.MustSetCompactSignificantDigits(tc.sig)
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				switch tc.label {
+				case "symbol":
+					mf.DisplayCurrencyAsSymbol()
+				case "none":
+					mf.DisplayNoCurrency()
+				}
+
+				if tc.accounting {
+					mf.UseAccountingStyle()
+				}
+
+				actual, err := mf.FormatString(tc.x, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("en")
+			mf.UseCompactShortNotation()
+			mf.UseStandardNotation()
+
+			actual := mf.MustFormat(1234, 0, "USD")
+			if actual != "USD\u00a01,234.00" {
+				t.Errorf("standard notation - got: %v, expected: %v", actual, "USD\u00a01,234.00")
+			}
+
+			err := mf.SetCompactSignificantDigits(21)
+			expected := "significant digits 21 exceeds max supported significant digits 20"
+			if err == nil || err.Error() != expected {
+				t.Errorf("significant digits error - got: %v, expected: %v", err, expected)
+			}
+		})
+		t.Run("compact notation defaults", func(t *testing.T) {
+			for i, tc := range []struct {
+				x        string
+				expected string
+			}{
+				{"1234567", "$1.2M"},
+				{"999999", "$1M"},
+				{"123456", "$123K"},
+				{"-1234", "-$1.2K"},
+			} {
+				mf := num.MustNewMoneyFormatter("en")
+				mf.UseCompactShortNotation()
+				mf.DisplayCurrencyAsSymbol()
+
+				actual, err := mf.FormatString(tc.x, "USD")
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("currency names", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale     string
+				accounting bool
+				compact    bool
+				x          string
+				cur        string
+				expected   string
+			}{
+				{"en", false, false, "1", "USD", "1.00 US dollars"},
+				{"en", false, false, "-1234.5", "USD", "-1,234.50 US dollars"},
+				{"en", true, false, "-1234.5", "USD", "(1,234.50) US dollars"},
+				{"en", false, true, "1234567", "USD", "1.2M US dollars"},
+				{"fr", false, false, "1", "EUR", "1,00 euro"},
+				{"fr", false, false, "2", "EUR", "2,00 euros"},
+				{"fr", false, true, "2000000", "EUR", "2\u00a0M euros"},
+				{"en", false, true, "1000000", "USD", "1M US dollars"},
+				{"pl", false, true, "2000000", "PLN", "2\u00a0mln złotych polskich"},
+				{"de", false, false, "1", "EUR", "1,00 Euro"},
+				{"pl", false, false, "2", "PLN", "2,00 złotego polskiego"},
+				{"ja", false, false, "3", "JPY", "3円"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.DisplayCurrencyAsName()
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				if tc.accounting {
+					mf.UseAccountingStyle()
+				}
+
+				if tc.compact {
+					mf.UseCompactShortNotation()
+					mf.MustSetCompactSignificantDigits(2)
+				}
+
+				actual, err := mf.FormatString(tc.x, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("qualifiers", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale    string
+				qualifier string
+				label     string
+				expected  string
+			}{
+				{"en", "approximately", "symbol", "~$1,000.00"},
+				{"en", "at least", "symbol", "$1,000.00+"},
+				{"en", "at most", "symbol", "≤$1,000.00"},
+				{"en", "at least", "compact", "$1K+"},
+				{"en", "at most", "name", "≤1,000.00 US dollars"},
+				{"en", "exactly", "symbol", "$1,000.00"},
+				{"fr", "at least", "symbol", "≥1\u202f000,00\u00a0$US"},
+				{"de", "approximately", "name", "≈1.000,00 US-Dollar"},
+				{"ja", "at least", "symbol", "$1,000.00 以上"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				// Qualifiers replace one another, e.g. exactly replaces approximately.
+				mf.DisplayApproximately()
+
+				switch tc.qualifier {
+				case "approximately":
+					mf.DisplayApproximately()
+				case "at least":
+					mf.DisplayAtLeast()
+				case "at most":
+					mf.DisplayAtMost()
+				case "exactly":
+					mf.DisplayExactly()
+				}
+
+				switch tc.label {
+				case "symbol":
+					mf.DisplayCurrencyAsSymbol()
+				case "compact":
+					mf.DisplayCurrencyAsSymbol()
+					mf.UseCompactShortNotation()
+				case "name":
+					mf.DisplayCurrencyAsName()
+				}
+
+				actual, err := mf.Format(1000, 0, "USD")
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Ranges are displayed as they are.
+			mf := num.MustNewMoneyFormatter("en")
+			mf.DisplayCurrencyAsSymbol()
+			mf.DisplayAtLeast()
+
+			actual := mf.MustFormatStringRange("3", "5", "USD")
+			if actual != "$3.00 – $5.00" {
+				t.Errorf("range - got: %v, expected: %v", actual, "$3.00 – $5.00")
+			}
+		})
+
+		t.Run("sign display", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale     string
+				sign       string
+				accounting bool
+				x          string
+				cur        string
+				expected   string
+			}{
+				{"en", "always", false, "5", "USD", "+$5.00"},
+				{"en", "always", false, "-5", "USD", "-$5.00"},
+				{"en", "always", true, "5", "USD", "+$5.00"},
+				{"en", "always", true, "-5", "USD", "($5.00)"},
+				{"en", "never", false, "-5", "USD", "$5.00"},
+				{"en", "never", true, "-5", "USD", "$5.00"},
+				{"en", "except zero", false, "0", "USD", "$0.00"},
+				{"en", "except zero", false, "0.001", "USD", "$0.00"},
+				{"en", "except zero", true, "5", "USD", "+$5.00"},
+				{"en", "negative", false, "-0.001", "USD", "$0.00"},
+				{"de", "always", false, "5", "EUR", "+5,00\u00a0€"},
+				{"sv", "auto", false, "-5", "SEK", "−5,00\u00a0kr"},
+				{"he", "always", false, "5", "ILS", "\u200f\u200e+5.00\u00a0\u200f₪"},
+				{"he", "auto", false, "-5", "ILS", "\u200f\u200e-5.00\u00a0\u200f₪"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.DisplayCurrencyAsSymbol()
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				if tc.accounting {
+					mf.UseAccountingStyle()
+				}
+
+				switch tc.sign {
+				case "auto":
+					mf.DisplaySignNever()
+					mf.DisplaySignAuto()
+				case "always":
+					mf.DisplaySignAlways()
+				case "never":
+					mf.DisplaySignNever()
+				case "except zero":
+					mf.DisplaySignExceptZero()
+				case "negative":
+					mf.DisplaySignNegative()
+				}
+
+				actual, err := mf.FormatString(tc.x, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("currency spacing", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale     string
+				label      string
+				accounting bool
+				x          string
+				cur        string
+				expected   string
+			}{
+				{"en", "code", false, "5", "USD", "USD\u00a05.00"},
+				{"en", "code", false, "-5", "USD", "-USD\u00a05.00"},
+				{"en", "code", true, "-5", "USD", "(USD\u00a05.00)"},
+				{"en", "symbol", false, "5", "CHF", "CHF\u00a05.00"},
+				{"en", "symbol", false, "5", "CAD", "CA$5.00"},
+				{"en", "symbol", false, "5", "USD", "$5.00"},
+				{"en-CA", "symbol", false, "5", "USD", "US$5.00"},
+				{"es-MX", "code", false, "1234.5", "USD", "USD\u00a01,234.50"},
+				{"en-IN", "code", false, "1234567", "USD", "USD\u00a012,34,567.00"},
+				{"de-CH", "code", false, "-5", "USD", "USD-5.00"},
+				{"nl", "code", false, "-5", "USD", "USD\u00a0-5,00"},
+				{"de", "code", false, "5", "USD", "5,00\u00a0USD"},
+				{"en", "short", false, "1200", "USD", "USD\u00a01.2K"},
+				{"en", "short", false, "-1200", "USD", "-USD\u00a01.2K"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				switch tc.label {
+				case "symbol":
+					mf.DisplayCurrencyAsSymbol()
+				case "short":
+					mf.UseCompactShortNotation()
+				}
+
+				if tc.accounting {
+					mf.UseAccountingStyle()
+				}
+
+				actual, err := mf.FormatString(tc.x, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("trailing zeros", func(t *testing.T) {
+			mf := num.MustNewMoneyFormatter("en")
+			mf.SetRoundingMode(num.RoundHalfEven)
+			mf.DisplayCurrencyAsSymbol()
+			mf.DisplayTrailingZerosStripIfInteger()
+
+			for i, tc := range []struct {
+				locale   string
+				x        string
+				cur      string
+				expected string
+			}{
+				{"en", "5", "USD", "$5"},
+				{"en", "5.5", "USD", "$5.50"},
+				{"en", "4.999", "USD", "$5"},
+				{"en", "5", "JPY", "¥5"},
+				{"de", "1234", "EUR", "1.234\u00a0€"},
+			} {
+				mf.MustSetLocale(tc.locale)
+
+				actual, err := mf.FormatString(tc.x, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf.MustSetLocale("en")
+			mf.DisplayCurrencyAsName()
+
+			if actual := mf.MustFormatString("1.00", "USD"); actual != "1 US dollar" {
+				t.Errorf("currency names - got: %v, expected: %v", actual, "1 US dollar")
+			}
+
+			mf.DisplayCurrencyAsSymbol()
+			mf.DisplayTrailingZerosAuto()
+
+			if actual := mf.MustFormatString("5", "USD"); actual != "$5.00" {
+				t.Errorf("auto - got: %v, expected: %v", actual, "$5.00")
+			}
+		})
+
+		t.Run("grouping", func(t *testing.T) {
+			mf := num.MustNewMoneyFormatter("pl")
+			mf.DisplayCurrencyAsSymbol()
+
+			for i, tc := range []struct {
+				grouping func()
+				x        string
+				expected string
+			}{
+				{mf.UseLocaleGrouping, "1000", "1000,00\u00a0€"},
+				{mf.UseLocaleGrouping, "10000", "10\u00a0000,00\u00a0€"},
+				{mf.UseFullGrouping, "1000", "1\u00a0000,00\u00a0€"},
+				{mf.UseMin2Grouping, "1000", "1000,00\u00a0€"},
+				{mf.UseNoGrouping, "10000", "10000,00\u00a0€"},
+			} {
+				tc.grouping()
+
+				actual, err := mf.FormatString(tc.x, "EUR")
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("ranges", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				label    string
+				x        string
+				y        string
+				cur      string
+				expected string
+			}{
+				{"en", "symbol", "3", "5", "USD", "$3.00 – $5.00"},
+				{"en", "symbol", "3", "-5", "USD", "$3.00 – -$5.00"},
+				{"en", "symbol", "-5", "-3", "USD", "-$5.00–3.00"},
+				{"en", "accounting", "-5", "-3", "USD", "($5.00–3.00)"},
+				{"en", "code", "3", "5", "USD", "USD\u00a03.00–5.00"},
+				{"en", "symbol", "3", "3", "USD", "~$3.00"},
+				{"en", "symbol", "2.999", "3.001", "USD", "~$3.00"},
+				{"en", "compact", "3000", "5000", "USD", "$3–5K"},
+				{"en", "compact", "3000", "5000000", "USD", "$3K – $5M"},
+				{"en", "name", "3", "5", "USD", "3.00–5.00 US dollars"},
+				{"en", "name", "-1", "1", "USD", "-1.00 – 1.00 US dollars"},
+				{"ru", "name", "1", "2", "RUB", "1,00–2,00 российского рубля"},
+				{"de", "symbol", "3", "5", "EUR", "3,00–5,00\u00a0€"},
+				{"fr", "symbol", "3", "3", "EUR", "≈3,00\u00a0€"},
+				{"ja", "symbol", "3", "5", "JPY", "￥3 ～ ￥5"},
+				{"zh", "symbol", "3", "5", "CNY", "¥3.00 - ¥5.00"},
+			} {
+				mf := num.MustNewMoneyFormatter(tc.locale)
+				mf.SetRoundingMode(num.RoundHalfEven)
+
+				switch tc.label {
+				case "symbol":
+					mf.DisplayCurrencyAsSymbol()
+				case "accounting":
+					mf.DisplayCurrencyAsSymbol()
+					mf.UseAccountingStyle()
+				case "compact":
+					mf.DisplayCurrencyAsSymbol()
+					mf.UseCompactShortNotation()
+				case "name":
+					mf.DisplayCurrencyAsName()
+				}
+
+				actual, err := mf.FormatStringRange(tc.x, tc.y, tc.cur)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			mf := num.MustNewMoneyFormatter("en")
+			mf.DisplayCurrencyAsSymbol()
+
+			actual := mf.MustFormatDecimalRange(testDecimal{false, "3", 0}, testDecimal{false, "5", 0}, "USD")
+			if actual != "$3.00 – $5.00" {
+				t.Errorf("decimal interface - got: %v, expected: %v", actual, "$3.00 – $5.00")
+			}
+
+			for i, tc := range []struct {
+				x        string
+				y        string
+				cur      string
+				expected string
+			}{
+				{"3", "5", "XYX", "unsupported currency \"XYX\" for locale \"en\""},
+				{"3", "5.001", "USD", "fractional part 001 exceeds scale 2 (USD)"},
+				{"x", "5", "USD", "invalid decimal string: \"x\" (USD)"},
+			} {
+				_, err := mf.FormatStringRange(tc.x, tc.y, tc.cur)
+				if err == nil || err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+		})
+
 		t.Run("unsupported currencies for locale", func(t *testing.T) {
 			for i, tc := range []moneyTestCase{
 				{"en", 100000, 1, "XYX", "unsupported currency \"XYX\" for locale \"en\""},
@@ -86,8 +876,9 @@ func TestMoneyFormatter(t *testing.T) {
 				{"bn", 100000, 1, "USD", "১,০০,০০০.০১\u00a0USD"},
 				{"bn", 100000, 10, "USD", "১,০০,০০০.১০\u00a0USD"},
 
+				// The standard "ar" pattern marks numbers with U+200F, its accounting pattern with U+061C.
 				{"en", 100000, 1, "BHD", "BHD\u00a0100,000.001"},
-				{"ar", 100000, 1, "BHD", "\u061c100,000.001\u00a0BHD"},
+				{"ar", 100000, 1, "BHD", "\u200f100,000.001\u00a0BHD"},
 			} {
 				mf := num.MustNewMoneyFormatter(tc.locale)
 				_ = mf.SetLocale(tc.locale)
@@ -107,10 +898,11 @@ func TestMoneyFormatter(t *testing.T) {
 				{"en", 1, 0o1, "USD", "$1.01"},
 				{"fr", 1000, 10, "USD", "1\u202f000,10\u00a0$US"},
 				{"fr-CA", 1000, 10, "USD", "1\u00a0000,10\u00a0$\u00a0US"},
-				{"en-CA", 1000, 10, "USD", "US$\u00a01,000.10"},
+				// Currency spacing only applies next to a letter, so "US$" is not spaced from digits.
+				{"en-CA", 1000, 10, "USD", "US$1,000.10"},
 				{"bn", 100000, 1, "USD", "১,০০,০০০.০১\u00a0US$"},
 				{"en", 100000, 1, "BHD", "BHD\u00a0100,000.001"},
-				{"ar", 100000, 1, "BHD", "\u061c100,000.001\u00a0د.ب.\u200f"},
+				{"ar", 100000, 1, "BHD", "\u200f100,000.001\u00a0د.ب.\u200f"},
 			} {
 				mf := num.MustNewMoneyFormatter(tc.locale)
 				_ = mf.SetLocale(tc.locale)
@@ -132,7 +924,7 @@ func TestMoneyFormatter(t *testing.T) {
 				{"fr-CA", 1000, 10, "USD", "1\u00a0000,10\u00a0$"},
 				{"bn", 100000, 1, "USD", "১,০০,০০০.০১$"},
 				{"en", 100000, 1, "BHD", "BHD\u00a0100,000.001"},
-				{"ar", 100000, 1, "BHD", "\u061c100,000.001\u00a0د.ب.\u200f"},
+				{"ar", 100000, 1, "BHD", "\u200f100,000.001\u00a0د.ب.\u200f"},
 			} {
 				mf := num.MustNewMoneyFormatter(tc.locale)
 				_ = mf.SetLocale(tc.locale)
@@ -194,7 +986,8 @@ func TestMoneyFormatter(t *testing.T) {
 				{"en", -1, 0o1, "USD", "($1.01)"},
 				{"fr", -1000, 10, "USD", "(1\u202f000,10\u00a0$US)"},
 				{"fr-CA", -1000, 10, "USD", "(1\u00a0000,10\u00a0$\u00a0US)"},
-				{"en-CA", -1000, 10, "USD", "(US$\u00a01,000.10)"},
+				// Currency spacing only applies next to a letter, so "US$" is not spaced from digits.
+				{"en-CA", -1000, 10, "USD", "(US$1,000.10)"},
 				{"ar", -100000, 1, "BHD", "(\u061c100,000.001\u00a0د.ب.\u200f)"},
 			} {
 				mf := num.MustNewMoneyFormatter(tc.locale)
@@ -0,0 +1,420 @@
+package num_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ttzhou/cldr/num"
+)
+
+func TestPercentFormatter(t *testing.T) {
+	t.Run("NewPercentFormatter()", func(t *testing.T) {
+		t.Run("unsupported locales", func(t *testing.T) {
+			for i, tc := range []string{"xx", "en-XX"} {
+				_, err := num.NewPercentFormatter(tc)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+
+				expected := fmt.Sprintf("unsupported locale: %q", tc)
+				if err.Error() != expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, expected)
+				}
+			}
+		})
+	})
+
+	t.Run("Format()", func(t *testing.T) {
+		t.Run("percent", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				scale    int8
+				x        string
+				expected string
+			}{
+				{"en", -1, "0.12", "12%"},
+				{"en", -1, "-0.125", "-12.5%"},
+				{"en", 2, "0.12", "12.00%"},
+				{"en", -1, "1234.5", "123,450%"},
+				{"en", -1, "0", "0%"},
+				{"fr", -1, "0.12", "12\u00a0%"},
+				{"fr", -1, "12.345", "1\u202f234,5\u00a0%"},
+				{"de", -1, "-0.5", "-50\u00a0%"},
+				{"tr", -1, "0.12", "%12"},
+				{"eu", -1, "0.12", "%\u00a012"},
+				{"hi", -1, "1234.5", "1,23,450%"},
+				{"ar-EG", -1, "0.12", "١٢٪؜"},
+				{"fa", -1, "0.12", "۱۲٪"},
+			} {
+				pf := num.MustNewPercentFormatter(tc.locale)
+				pf.MustSetScale(tc.scale)
+
+				actual, err := pf.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = pf.MustFormatString(tc.x)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("per mille", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				x        string
+				expected string
+			}{
+				{"en", "0.012", "12‰"},
+				{"en", "-0.0125", "-12.5‰"},
+				{"fr", "0.012", "12\u00a0‰"},
+				{"ar-EG", "0.012", "١٢؉"},
+			} {
+				pf := num.MustNewPercentFormatter(tc.locale)
+				pf.MustSetScale(-1)
+				pf.UsePerMille()
+
+				actual := pf.MustFormatString(tc.x)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			pf := num.MustNewPercentFormatter("en")
+			pf.MustSetScale(-1)
+			pf.UsePerMille()
+			pf.UsePercent()
+
+			if actual := pf.MustFormatString("0.012"); actual != "1.2%" {
+				t.Errorf("got: %v, expected: %v", actual, "1.2%")
+			}
+		})
+
+		t.Run("rounding modes", func(t *testing.T) {
+			for i, tc := range []struct {
+				mode     num.RoundingMode
+				scale    int8
+				x        string
+				expected string
+			}{
+				{num.RoundHalfEven, 0, "0.125", "12%"},
+				{num.RoundHalfUp, 0, "0.125", "13%"},
+				{num.RoundHalfEven, 1, "0.12345", "12.3%"},
+				{num.RoundCeiling, 0, "-0.129", "-12%"},
+				{num.RoundFloor, 0, "-0.121", "-13%"},
+			} {
+				pf := num.MustNewPercentFormatter("en")
+				pf.MustSetScale(tc.scale)
+				pf.SetRoundingMode(tc.mode)
+
+				actual, err := pf.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("inputs", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				scale    int8
+				format   func(num.PercentFormatter) (string, error)
+				expected string
+			}{
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatCoef(false, 1234, -4) }, "12.34%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatCoef(true, 5, -3) }, "-0.5%"},
+				{"en", 1, func(pf num.PercentFormatter) (string, error) { return pf.FormatCoef(false, 3, 0) }, "300.0%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) {
+					return pf.FormatDecimal(testDecimal{false, "1234", -4})
+				}, "12.34%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatBigRat(big.NewRat(1, 3), 1) }, "33.3%"},
+				{"en", 2, func(pf num.PercentFormatter) (string, error) { return pf.FormatBigRat(big.NewRat(-1, 8), 1) }, "-12.50%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatFloat(0.07, 64) }, "7%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatFloat(float64(float32(0.1)), 32) }, "10%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatFloat(math.NaN(), 64) }, "NaN%"},
+				{"en", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatFloat(math.Inf(-1), 64) }, "-∞%"},
+				{"fr", -1, func(pf num.PercentFormatter) (string, error) { return pf.FormatFloat(math.Inf(1), 64) }, "∞\u00a0%"},
+			} {
+				pf := num.MustNewPercentFormatter(tc.locale)
+				pf.MustSetScale(tc.scale)
+				pf.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := tc.format(pf)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("integer and fraction digits", func(t *testing.T) {
+			pf := num.MustNewPercentFormatter("en")
+			pf.SetRoundingMode(num.RoundHalfEven)
+			pf.UsePatternDigits()
+
+			for i, tc := range []struct {
+				x        string
+				expected string
+			}{
+				{"0.125", "12%"},
+				{"0.135", "14%"},
+			} {
+				if actual := pf.MustFormatString(tc.x); actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			pf.MustSetMinIntegerDigits(2)
+			pf.MustSetFractionDigits(2, 3)
+
+			for i, tc := range []struct {
+				x        string
+				expected string
+			}{
+				{"0.075", "07.50%"},
+				{"0.123456", "12.346%"},
+			} {
+				if actual := pf.MustFormatString(tc.x); actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			pf.UsePatternDigits()
+
+			if actual := pf.MustFormatString("0.075"); actual != "8%" {
+				t.Errorf("pattern digits - got: %v, expected: %v", actual, "8%")
+			}
+
+			if err := pf.SetFractionDigits(2, 1); err == nil {
+				t.Errorf("expected error for minimum fraction digits exceeding maximum, got nil")
+			}
+
+			if err := pf.SetMinIntegerDigits(0); err == nil {
+				t.Errorf("expected error for 0 integer digits, got nil")
+			}
+		})
+
+		t.Run("defaults", func(t *testing.T) {
+			pf := num.MustNewPercentFormatter("en")
+
+			for i, tc := range []struct {
+				format   func() (string, error)
+				expected string
+			}{
+				{func() (string, error) { return pf.FormatFloat(0.125, 64) }, "12%"},
+				{func() (string, error) { return pf.FormatString("0.135") }, "14%"},
+				{func() (string, error) { return pf.FormatString("0.123456") }, "12%"},
+				{func() (string, error) { return pf.FormatString("-1.50") }, "-150%"},
+			} {
+				actual, err := tc.format()
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			// Every fractional digit is displayed only when opted into.
+			pf.MustSetFractionDigits(0, -1)
+
+			if actual := pf.MustFormatFloat(0.125, 64); actual != "12.5%" {
+				t.Errorf("all fraction digits - got: %v, expected: %v", actual, "12.5%")
+			}
+		})
+
+		t.Run("qualifiers", func(t *testing.T) {
+			pf := num.MustNewPercentFormatter("en")
+
+			for i, tc := range []struct {
+				display  func()
+				expected string
+			}{
+				{pf.DisplayApproximately, "~12%"},
+				{pf.DisplayAtLeast, "12%+"},
+				{pf.DisplayAtMost, "≤12%"},
+				{pf.DisplayExactly, "12%"},
+			} {
+				tc.display()
+
+				if actual := pf.MustFormatString("0.12"); actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("sign display", func(t *testing.T) {
+			pf := num.MustNewPercentFormatter("en")
+			pf.MustSetScale(-1)
+			pf.DisplaySignAlways()
+
+			if actual := pf.MustFormatString("0.052"); actual != "+5.2%" {
+				t.Errorf("always - got: %v, expected: %v", actual, "+5.2%")
+			}
+
+			if actual := pf.MustFormatFloat(math.NaN(), 64); actual != "NaN%" {
+				t.Errorf("always NaN - got: %v, expected: %v", actual, "NaN%")
+			}
+
+			if actual := pf.MustFormatFloat(math.Inf(1), 64); actual != "+∞%" {
+				t.Errorf("always infinity - got: %v, expected: %v", actual, "+∞%")
+			}
+
+			pf.MustSetLocale("fr")
+			pf.DisplaySignExceptZero()
+
+			for i, tc := range []struct {
+				x        string
+				expected string
+			}{
+				{"0.052", "+5,2\u00a0%"},
+				{"-0.052", "-5,2\u00a0%"},
+				{"0", "0\u00a0%"},
+			} {
+				if actual := pf.MustFormatString(tc.x); actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			pf.DisplaySignNever()
+			pf.DisplaySignNegative()
+
+			if actual := pf.MustFormatString("-0.052"); actual != "-5,2\u00a0%" {
+				t.Errorf("negative - got: %v, expected: %v", actual, "-5,2\u00a0%")
+			}
+
+			pf.DisplaySignAuto()
+
+			if actual := pf.MustFormatString("0.052"); actual != "5,2\u00a0%" {
+				t.Errorf("auto - got: %v, expected: %v", actual, "5,2\u00a0%")
+			}
+		})
+
+		t.Run("grouping", func(t *testing.T) {
+			pf := num.MustNewPercentFormatter("en")
+			pf.UseMin2Grouping()
+
+			if actual := pf.MustFormatString("12.34"); actual != "1234%" {
+				t.Errorf("min2 - got: %v, expected: %v", actual, "1234%")
+			}
+
+			pf.UseFullGrouping()
+
+			if actual := pf.MustFormatString("12.34"); actual != "1,234%" {
+				t.Errorf("full - got: %v, expected: %v", actual, "1,234%")
+			}
+
+			pf.UseNoGrouping()
+
+			if actual := pf.MustFormatString("123.45"); actual != "12345%" {
+				t.Errorf("none - got: %v, expected: %v", actual, "12345%")
+			}
+
+			pf.UseLocaleGrouping()
+
+			if actual := pf.MustFormatString("12.34"); actual != "1,234%" {
+				t.Errorf("locale - got: %v, expected: %v", actual, "1,234%")
+			}
+		})
+
+		t.Run("ranges", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				x        string
+				y        string
+				expected string
+			}{
+				{"en", "0.03", "0.05", "3% – 5%"},
+				{"en", "0.05", "0.05", "~5%"},
+				{"fr", "0.03", "0.05", "3–5\u00a0%"},
+				{"de", "0.03", "0.05", "3–5\u00a0%"},
+			} {
+				pf := num.MustNewPercentFormatter(tc.locale)
+
+				actual, err := pf.FormatStringRange(tc.x, tc.y)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			pf := num.MustNewPercentFormatter("en")
+			pf.UsePerMille()
+
+			actual := pf.MustFormatDecimalRange(testDecimal{false, "3", -3}, testDecimal{false, "5", -3})
+			if actual != "3‰ – 5‰" {
+				t.Errorf("per mille - got: %v, expected: %v", actual, "3‰ – 5‰")
+			}
+
+			if _, err := pf.FormatStringRange("0.03", "y"); err == nil {
+				t.Errorf("expected error for invalid decimal string, got nil")
+			}
+		})
+
+		t.Run("errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				scale    int8
+				format   func(num.PercentFormatter) (string, error)
+				expected string
+			}{
+				{0, func(pf num.PercentFormatter) (string, error) { return pf.FormatString("0.125") }, "fractional part 5 exceeds scale 0"},
+				{-1, func(pf num.PercentFormatter) (string, error) { return pf.FormatString("1e2") }, "invalid decimal string: \"1e2\""},
+				{-1, func(pf num.PercentFormatter) (string, error) { return pf.FormatFloat(0.5, 16) }, "unsupported float bit size 16; must be 32 or 64"},
+				{-1, func(pf num.PercentFormatter) (string, error) {
+					return pf.FormatDecimal(testDecimal{false, "", 0})
+				}, "invalid decimal coefficient: \"\""},
+			} {
+				pf := num.MustNewPercentFormatter("en")
+				pf.MustSetScale(tc.scale)
+				pf.SetRoundingMode(num.RoundUnnecessary)
+
+				_, err := tc.format(pf)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+				if err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+
+			pf := num.MustNewPercentFormatter("en")
+			if err := pf.SetScale(21); err == nil {
+				t.Errorf("expected error for scale 21, got nil")
+			}
+		})
+
+		t.Run("locale changes", func(t *testing.T) {
+			pf := num.MustNewPercentFormatter("en")
+			pf.MustSetLocale("fr")
+
+			if actual := pf.MustFormatString("0.12"); actual != "12\u00a0%" {
+				t.Errorf("got: %v, expected: %v", actual, "12\u00a0%")
+			}
+
+			if err := pf.SetLocale("xx"); err == nil {
+				t.Errorf("expected error for unsupported locale, got nil")
+			}
+		})
+	})
+}
@@ -0,0 +1,242 @@
+package num_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ttzhou/cldr/num"
+)
+
+func TestScientificFormatter(t *testing.T) {
+	t.Run("NewScientificFormatter()", func(t *testing.T) {
+		t.Run("unsupported locales", func(t *testing.T) {
+			for i, tc := range []string{"xx", "en-XX"} {
+				_, err := num.NewScientificFormatter(tc)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+
+				expected := fmt.Sprintf("unsupported locale: %q", tc)
+				if err.Error() != expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, expected)
+				}
+			}
+		})
+	})
+
+	t.Run("Format()", func(t *testing.T) {
+		t.Run("scientific", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				sig      uint8
+				x        string
+				expected string
+			}{
+				{"en", 0, "12345", "1.2345E4"},
+				{"en", 0, "12300", "1.23E4"},
+				{"en", 0, "-0.00125", "-1.25E-3"},
+				{"en", 0, "7", "7E0"},
+				{"en", 0, "0", "0E0"},
+				{"en", 3, "12345", "1.23E4"},
+				{"en", 3, "12", "1.20E1"},
+				{"en", 3, "0", "0.00E0"},
+				{"en", 3, "9.996", "1.00E1"},
+				{"fr", 0, "1234567", "1,234567E6"},
+				{"sv", 3, "-12345", "−1,23×10^4"},
+				{"el", 0, "12345", "1,2345e4"},
+				{"ar-EG", 3, "12345", "١٫٢٣اس٤"},
+				{"fa", 0, "0.0125", "۱٫۲۵×۱۰^\u200e−۲"},
+				{"sv", 3, "-0.000123", "−1,23×10^−4"},
+			} {
+				sf := num.MustNewScientificFormatter(tc.locale)
+				sf.MustSetSignificantDigits(tc.sig)
+				sf.SetRoundingMode(num.RoundHalfEven)
+
+				actual, err := sf.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+
+				actual = sf.MustFormatString(tc.x)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("engineering", func(t *testing.T) {
+			for i, tc := range []struct {
+				sig      uint8
+				x        string
+				expected string
+			}{
+				{0, "12345", "12.345E3"},
+				{0, "123456", "123.456E3"},
+				{0, "1234", "1.234E3"},
+				{0, "0.00125", "1.25E-3"},
+				{0, "0.0125", "12.5E-3"},
+				{0, "-0.125", "-125E-3"},
+				{2, "123456", "120E3"},
+				{3, "99999", "100E3"},
+			} {
+				sf := num.MustNewScientificFormatter("en")
+				sf.MustSetSignificantDigits(tc.sig)
+				sf.SetRoundingMode(num.RoundHalfEven)
+				sf.UseEngineeringNotation()
+
+				actual, err := sf.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			sf := num.MustNewScientificFormatter("en")
+			sf.UseEngineeringNotation()
+			sf.UseScientificNotation()
+
+			if actual := sf.MustFormatString("12345"); actual != "1.2345E4" {
+				t.Errorf("got: %v, expected: %v", actual, "1.2345E4")
+			}
+		})
+
+		t.Run("superscript", func(t *testing.T) {
+			for i, tc := range []struct {
+				locale   string
+				x        string
+				expected string
+			}{
+				{"en", "12300", "1.23×10⁴"},
+				{"en", "-0.00125", "-1.25×10⁻³"},
+				{"de", "12300", "1,23·10⁴"},
+			} {
+				sf := num.MustNewScientificFormatter(tc.locale)
+				sf.DisplayExponentAsSuperscript()
+
+				actual := sf.MustFormatString(tc.x)
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+
+			sf := num.MustNewScientificFormatter("en")
+			sf.DisplayExponentAsSuperscript()
+			sf.DisplayExponentAsSymbol()
+
+			if actual := sf.MustFormatString("12300"); actual != "1.23E4" {
+				t.Errorf("got: %v, expected: %v", actual, "1.23E4")
+			}
+		})
+
+		t.Run("rounding modes", func(t *testing.T) {
+			for i, tc := range []struct {
+				mode     num.RoundingMode
+				x        string
+				expected string
+			}{
+				{num.RoundHalfEven, "12250", "1.22E4"},
+				{num.RoundHalfUp, "12250", "1.23E4"},
+				{num.RoundDown, "-12399", "-1.23E4"},
+				{num.RoundFloor, "-12301", "-1.24E4"},
+				{num.RoundCeiling, "0.0012301", "1.24E-3"},
+			} {
+				sf := num.MustNewScientificFormatter("en")
+				sf.MustSetSignificantDigits(3)
+				sf.SetRoundingMode(tc.mode)
+
+				actual, err := sf.FormatString(tc.x)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("inputs", func(t *testing.T) {
+			for i, tc := range []struct {
+				format   func(num.ScientificFormatter) (string, error)
+				expected string
+			}{
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatCoef(false, 1234, 1) }, "1.234E4"},
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatCoef(true, 5, -3) }, "-5E-3"},
+				{func(sf num.ScientificFormatter) (string, error) {
+					return sf.FormatDecimal(testDecimal{false, "602214076", 15})
+				}, "6.02214076E23"},
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatBigFloat(big.NewFloat(0.25)) }, "2.5E-1"},
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatFloat(6.62607015e-34, 64) }, "6.62607015E-34"},
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatFloat(float64(float32(0.1)), 32) }, "1E-1"},
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatFloat(math.NaN(), 64) }, "NaN"},
+				{func(sf num.ScientificFormatter) (string, error) { return sf.FormatFloat(math.Inf(-1), 64) }, "-∞"},
+			} {
+				sf := num.MustNewScientificFormatter("en")
+
+				actual, err := tc.format(sf)
+				if err != nil {
+					t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+					continue
+				}
+				if actual != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+				}
+			}
+		})
+
+		t.Run("errors", func(t *testing.T) {
+			for i, tc := range []struct {
+				sig      uint8
+				format   func(num.ScientificFormatter) (string, error)
+				expected string
+			}{
+				{3, func(sf num.ScientificFormatter) (string, error) { return sf.FormatString("12345") }, "fractional part 2345 exceeds scale 2"},
+				{0, func(sf num.ScientificFormatter) (string, error) { return sf.FormatString("1e2") }, "invalid decimal string: \"1e2\""},
+				{0, func(sf num.ScientificFormatter) (string, error) { return sf.FormatFloat(0.5, 16) }, "unsupported float bit size 16; must be 32 or 64"},
+				{0, func(sf num.ScientificFormatter) (string, error) {
+					return sf.FormatBigFloat(new(big.Float).SetInf(false))
+				}, "infinite values cannot be formatted"},
+			} {
+				sf := num.MustNewScientificFormatter("en")
+				sf.MustSetSignificantDigits(tc.sig)
+
+				_, err := tc.format(sf)
+				if err == nil {
+					t.Errorf("test case #%d - expected error, got nil", i+1)
+					continue
+				}
+				if err.Error() != tc.expected {
+					t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, tc.expected)
+				}
+			}
+
+			sf := num.MustNewScientificFormatter("en")
+			if err := sf.SetSignificantDigits(21); err == nil {
+				t.Errorf("expected error for 21 significant digits, got nil")
+			}
+		})
+
+		t.Run("locale changes", func(t *testing.T) {
+			sf := num.MustNewScientificFormatter("en")
+			sf.MustSetLocale("sv")
+
+			if actual := sf.MustFormatString("12345"); actual != "1,2345×10^4" {
+				t.Errorf("got: %v, expected: %v", actual, "1,2345×10^4")
+			}
+
+			if err := sf.SetLocale("xx"); err == nil {
+				t.Errorf("expected error for unsupported locale, got nil")
+			}
+		})
+	})
+}
@@ -0,0 +1,307 @@
+package plural_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ttzhou/cldr/plural"
+)
+
+// samples expands CLDR sample numbers, e.g. "@integer 0, 2~4, … @decimal 0.0~0.2" into
+// 0, 2, 3, 4, 0.0, 0.1 and 0.2, leaving out the "…" that marks them as incomplete.
+func samples(t *testing.T, s string) []string {
+	t.Helper()
+
+	var xs []string
+
+	for _, list := range strings.Split(s, "@") {
+		_, list, _ = strings.Cut(list, " ")
+
+		for sample := range strings.SplitSeq(list, ",") {
+			sample = strings.TrimSpace(sample)
+			if sample == "" || sample == "…" {
+				continue
+			}
+
+			from, to, isRange := strings.Cut(sample, "~")
+			if !isRange {
+				xs = append(xs, sample)
+				continue
+			}
+
+			// Ranges step by the last digit of their bounds, which have the same number of fraction digits.
+			_, frac, _ := strings.Cut(from, ".")
+			lo, err1 := strconv.ParseUint(strings.Replace(from, ".", "", 1), 10, 64)
+			hi, err2 := strconv.ParseUint(strings.Replace(to, ".", "", 1), 10, 64)
+			if err1 != nil || err2 != nil {
+				t.Fatalf("invalid sample range: %q", sample)
+			}
+
+			for n := lo; n <= hi; n++ {
+				x := fmt.Sprintf("%0*d", len(frac)+1, n)
+				if len(frac) > 0 {
+					x = x[:len(x)-len(frac)] + "." + x[len(x)-len(frac):]
+				}
+
+				xs = append(xs, x)
+			}
+		}
+	}
+
+	return xs
+}
+
+func TestPlural(t *testing.T) {
+	t.Run("ParseOperands()", func(t *testing.T) {
+		for i, tc := range []struct {
+			x        string
+			expected plural.Operands
+		}{
+			{"1", plural.Operands{I: 1}},
+			{"-1", plural.Operands{I: 1}},
+			{"1.50", plural.Operands{I: 1, V: 2, W: 1, F: 50, T: 5}},
+			{"0.0", plural.Operands{V: 1}},
+			{"1000000", plural.Operands{I: 1000000}},
+			{"1.2c3", plural.Operands{I: 1200, E: 3}},
+			{"1.2e3", plural.Operands{I: 1200, E: 3}},
+			{"1.0000001c6", plural.Operands{I: 1000000, V: 1, W: 1, F: 1, T: 1, E: 6}},
+			{"123456789012345678901", plural.Operands{I: 1e18 + 456789012345678901}},
+			{"1c40", plural.Operands{I: 1e18, E: 40}},
+		} {
+			actual, err := plural.ParseOperands(tc.x)
+			if err != nil {
+				t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+				continue
+			}
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %+v, expected: %+v", i+1, actual, tc.expected)
+			}
+		}
+
+		if n := plural.NewOperands("12", "05", 0).N(); n != 12.05 {
+			t.Errorf("N() - got: %v, expected: %v", n, 12.05)
+		}
+	})
+
+	t.Run("ParseOperands() errors", func(t *testing.T) {
+		for i, tc := range []string{"", "abc", "1.", ".5", "1e", "1c-1", "--1", "+1", "1,5", "1.2.3"} {
+			_, err := plural.ParseOperands(tc)
+			if err == nil {
+				t.Errorf("test case #%d - expected error but did not receive one", i+1)
+				continue
+			}
+
+			expected := fmt.Sprintf("invalid number: %q", tc)
+			if err.Error() != expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, err, expected)
+			}
+		}
+
+		if _, err := plural.Select("en", "abc"); err == nil {
+			t.Errorf("Select() - expected error but did not receive one")
+		}
+	})
+
+	t.Run("Select()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			x        string
+			expected string
+		}{
+			{"en", "1", plural.One},
+			{"en", "1.0", plural.Other},
+			{"en", "2", plural.Other},
+			{"pl", "1", plural.One},
+			{"pl", "3", plural.Few},
+			{"pl", "22", plural.Few},
+			{"pl", "5", plural.Many},
+			{"pl", "12", plural.Many},
+			{"pl", "1.50", plural.Other},
+			{"fr", "1.5", plural.One},
+			{"fr", "1000000", plural.Many},
+			{"fr", "1c3", plural.Other},
+			{"fr", "1.2c6", plural.Many},
+			{"ar", "0", plural.Zero},
+			{"ar", "2", plural.Two},
+			{"ar", "103", plural.Few},
+			{"ar", "111", plural.Many},
+			{"ru", "21", plural.One},
+			{"ru", "11", plural.Many},
+			{"ja", "1", plural.Other},
+			{"pt-BR", "0", plural.One},
+			{"pt-PT", "0", plural.Other},
+			{"cv", "0", plural.Zero},
+			{"cv", "0.0", plural.Zero},
+			{"cv", "1", plural.One},
+			{"cv", "2", plural.Other},
+			{"ie", "1", plural.One},
+			{"ie", "1.0", plural.Other},
+			{"kok", "0", plural.One},
+			{"kok-Latn", "1", plural.One},
+			{"kok", "2", plural.Other},
+			{"sgs", "21", plural.One},
+			{"sgs", "2", plural.Two},
+			{"sgs", "22", plural.Few},
+			{"sgs", "12", plural.Other},
+			{"sgs", "0.5", plural.Many},
+			{"sgs", "10", plural.Other},
+			{"xx", "1", plural.Other},
+		} {
+			actual, err := plural.Select(tc.locale, tc.x)
+			if err != nil {
+				t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+				continue
+			}
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+
+		if actual := plural.MustSelect("uk", "1.5"); actual != plural.Other {
+			t.Errorf("MustSelect() - got: %v, expected: %v", actual, plural.Other)
+		}
+	})
+
+	t.Run("SelectOrdinal()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			x        string
+			expected string
+		}{
+			{"en", "1", plural.One},
+			{"en", "2", plural.Two},
+			{"en", "3", plural.Few},
+			{"en", "4", plural.Other},
+			{"en", "11", plural.Other},
+			{"en", "22", plural.Two},
+			{"en", "113", plural.Other},
+			{"en-GB", "23", plural.Few},
+			{"cy", "0", plural.Zero},
+			{"cy", "5", plural.Many},
+			{"cy", "10", plural.Other},
+			{"it", "8", plural.Many},
+			{"it", "11", plural.Many},
+			{"it", "3", plural.Other},
+			{"fr", "1", plural.One},
+			{"fr", "2", plural.Other},
+			{"sv", "32", plural.One},
+			{"ja", "1", plural.Other},
+			{"xx", "1", plural.Other},
+		} {
+			actual, err := plural.SelectOrdinal(tc.locale, tc.x)
+			if err != nil {
+				t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+				continue
+			}
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+
+		if actual := plural.MustSelectOrdinal("en", "-1"); actual != plural.One {
+			t.Errorf("MustSelectOrdinal() - got: %v, expected: %v", actual, plural.One)
+		}
+
+		if _, err := plural.SelectOrdinal("en", "1st"); err == nil {
+			t.Errorf("SelectOrdinal() - expected error but did not receive one")
+		}
+	})
+
+	t.Run("SelectRange()", func(t *testing.T) {
+		for i, tc := range []struct {
+			locale   string
+			x        string
+			y        string
+			expected string
+		}{
+			{"en", "1", "2", plural.Other},
+			{"ru", "1", "2", plural.Few},
+			{"ru", "1", "5", plural.Many},
+			{"ru", "0", "1", plural.One},
+			{"ru", "1.5", "2", plural.Few},
+			{"ar", "0", "1", plural.Zero},
+			{"ar", "1", "2", plural.Other},
+			{"ar", "3", "100", plural.Other},
+			{"fr", "0", "1", plural.One},
+			{"fr", "1", "2", plural.Other},
+			{"lv", "0", "1", plural.One},
+			{"pl", "1", "2", plural.Few},
+			{"pl", "2", "5", plural.Many},
+			{"pt-BR", "0", "1", plural.One},
+			{"ja", "1", "2", plural.Other},
+			{"xx", "1", "2", plural.Other},
+		} {
+			actual, err := plural.SelectRange(tc.locale, tc.x, tc.y)
+			if err != nil {
+				t.Errorf("test case #%d - unexpected error: %v", i+1, err)
+				continue
+			}
+			if actual != tc.expected {
+				t.Errorf("test case #%d - got: %v, expected: %v", i+1, actual, tc.expected)
+			}
+		}
+
+		// Ranges without CLDR data are in the category of their end.
+		if actual := plural.Range("en", plural.Few, plural.Many); actual != plural.Many {
+			t.Errorf("Range() - got: %v, expected: %v", actual, plural.Many)
+		}
+
+		if actual := plural.MustSelectRange("uk", "1", "3"); actual != plural.Few {
+			t.Errorf("MustSelectRange() - got: %v, expected: %v", actual, plural.Few)
+		}
+
+		for i, tc := range [][2]string{{"x", "1"}, {"1", "y"}} {
+			if _, err := plural.SelectRange("en", tc[0], tc[1]); err == nil {
+				t.Errorf("test case #%d - expected error but did not receive one", i+1)
+			}
+		}
+	})
+
+	t.Run("CLDR samples", func(t *testing.T) {
+		for _, pt := range []struct {
+			name      string
+			languages []string
+			rules     func(string) []plural.Rule
+			selector  func(string, string) (string, error)
+		}{
+			{"cardinal", plural.Languages(), plural.CardinalRules, plural.Select},
+			{"ordinal", plural.OrdinalLanguages(), plural.OrdinalRules, plural.SelectOrdinal},
+		} {
+			if len(pt.languages) == 0 {
+				t.Fatalf("%s - no languages with plural rules", pt.name)
+			}
+
+			for _, l := range pt.languages {
+				rules := pt.rules(l)
+				if len(rules) == 0 || rules[len(rules)-1].Category != plural.Other {
+					t.Errorf("%s %s - missing rule for %q category", pt.name, l, plural.Other)
+					continue
+				}
+
+				for _, r := range rules {
+					xs := samples(t, r.Samples)
+					if len(xs) == 0 {
+						t.Errorf("%s %s - no samples for %q category", pt.name, l, r.Category)
+					}
+
+					for _, x := range xs {
+						actual, err := pt.selector(l, x)
+						if err != nil {
+							t.Errorf("%s %s - unexpected error: %v", pt.name, l, err)
+							continue
+						}
+						if actual != r.Category {
+							t.Errorf("%s %s %s - got: %v, expected: %v", pt.name, l, x, actual, r.Category)
+						}
+					}
+				}
+			}
+		}
+
+		if rules := plural.CardinalRules("xx"); rules != nil {
+			t.Errorf("unknown language - got: %v, expected: %v", rules, nil)
+		}
+	})
+}